@@ -0,0 +1,65 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+)
+
+func TestAccount(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create account email=ops@example.com name=production role=OrganizationAdmin billing-access=deny").
+			Mock(&organizationsMock{
+				CreateAccountFunc: func(param0 *organizations.CreateAccountInput) (*organizations.CreateAccountOutput, error) {
+					return &organizations.CreateAccountOutput{CreateAccountStatus: &organizations.CreateAccountStatus{
+						Id: String("car-1234abcd"), State: String("IN_PROGRESS"),
+					}}, nil
+				},
+				DescribeCreateAccountStatusFunc: func(param0 *organizations.DescribeCreateAccountStatusInput) (*organizations.DescribeCreateAccountStatusOutput, error) {
+					return &organizations.DescribeCreateAccountStatusOutput{CreateAccountStatus: &organizations.CreateAccountStatus{
+						Id: String("car-1234abcd"), State: String("SUCCEEDED"), AccountId: String("111111111111"),
+					}}, nil
+				},
+			}).ExpectInput("CreateAccount", &organizations.CreateAccountInput{
+			Email:                  String("ops@example.com"),
+			AccountName:            String("production"),
+			RoleName:               String("OrganizationAdmin"),
+			IamUserAccessToBilling: String("DENY"),
+		}).ExpectInput("DescribeCreateAccountStatus", &organizations.DescribeCreateAccountStatusInput{
+			CreateAccountRequestId: String("car-1234abcd"),
+		}).ExpectCommandResult("111111111111").ExpectCalls("CreateAccount", "DescribeCreateAccountStatus").Run(t)
+	})
+
+	t.Run("move", func(t *testing.T) {
+		Template("move account id=111111111111 destination=ou-1234-abcd").
+			Mock(&organizationsMock{
+				ListParentsFunc: func(param0 *organizations.ListParentsInput) (*organizations.ListParentsOutput, error) {
+					return &organizations.ListParentsOutput{Parents: []*organizations.Parent{{Id: String("r-1234"), Type: String("ROOT")}}}, nil
+				},
+				MoveAccountFunc: func(param0 *organizations.MoveAccountInput) (*organizations.MoveAccountOutput, error) {
+					return &organizations.MoveAccountOutput{}, nil
+				},
+			}).ExpectInput("ListParents", &organizations.ListParentsInput{
+			ChildId: String("111111111111"),
+		}).ExpectInput("MoveAccount", &organizations.MoveAccountInput{
+			AccountId:           String("111111111111"),
+			SourceParentId:      String("r-1234"),
+			DestinationParentId: String("ou-1234-abcd"),
+		}).ExpectCommandResult("111111111111").ExpectCalls("ListParents", "ListParents", "MoveAccount").
+			ExpectRevert("move account destination=r-1234 id=111111111111 source=ou-1234-abcd").Run(t)
+	})
+
+	t.Run("move with source", func(t *testing.T) {
+		Template("move account id=111111111111 source=ou-1234-abcd destination=r-1234").
+			Mock(&organizationsMock{
+				MoveAccountFunc: func(param0 *organizations.MoveAccountInput) (*organizations.MoveAccountOutput, error) {
+					return &organizations.MoveAccountOutput{}, nil
+				},
+			}).ExpectInput("MoveAccount", &organizations.MoveAccountInput{
+			AccountId:           String("111111111111"),
+			SourceParentId:      String("ou-1234-abcd"),
+			DestinationParentId: String("r-1234"),
+		}).ExpectCalls("MoveAccount").
+			ExpectRevert("move account destination=ou-1234-abcd id=111111111111 source=r-1234").Run(t)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/wallix/awless/graph"
 	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/driver"
 )
 
 type ATBuilder struct {
@@ -20,6 +21,8 @@ type ATBuilder struct {
 	ignoredInput map[string]struct{}
 	fillers      map[string]string
 	expectRevert string
+	expectErr    string
+	middlewares  []driver.Middleware
 	mock         mock
 	graph        *graph.Graph
 }
@@ -76,6 +79,16 @@ func (b *ATBuilder) ExpectRevert(revert string) *ATBuilder {
 	return b
 }
 
+func (b *ATBuilder) ExpectError(msg string) *ATBuilder {
+	b.expectErr = msg
+	return b
+}
+
+func (b *ATBuilder) Middlewares(mws ...driver.Middleware) *ATBuilder {
+	b.middlewares = mws
+	return b
+}
+
 func (b *ATBuilder) Run(t *testing.T, l ...*logger.Logger) {
 	t.Helper()
 	b.mock.SetInputs(b.expectInput)
@@ -95,7 +108,7 @@ func (b *ATBuilder) Run(t *testing.T, l ...*logger.Logger) {
 		return awsspec.CommandFactory.Build(strings.Join(tokens, ""))()
 	}).WithMissingHolesFunc(func(key string, paramPaths []string, isOptional bool) string {
 		return b.fillers[key]
-	}).Build()
+	}).WithMiddlewares(b.middlewares...).Build()
 	compiled, cenv, err := template.Compile(tpl, cenv, template.NewRunnerCompileMode)
 	if err != nil {
 		t.Fatal(err)
@@ -105,11 +118,14 @@ func (b *ATBuilder) Run(t *testing.T, l ...*logger.Logger) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if ran.HasErrors() {
-		for _, cmd := range ran.CommandNodesIterator() {
-			if cmd.Err() != nil {
-				t.Fatal(cmd.Err())
-			}
+	if b.expectErr != "" {
+		if !ran.HasErrors() {
+			t.Fatalf("got no error, want error containing %q", b.expectErr)
+		}
+	}
+	for _, cmd := range ran.CommandNodesIterator() {
+		if err := cmd.Err(); err != nil && (b.expectErr == "" || !strings.Contains(err.Error(), b.expectErr)) {
+			t.Fatal(err)
 		}
 	}
 	if len(b.expectCalls) > 0 {
@@ -62,6 +62,122 @@ func TestBucket(t *testing.T) {
 			}).ExpectInput("DeleteBucketWebsite", &s3.DeleteBucketWebsiteInput{
 			Bucket: String("my-bucket-to-update"),
 		}).ExpectCalls("DeleteBucketWebsite").Run(t)
+
+		Template("update bucket name=my-bucket-to-update public-website=true error-document=404.html").
+			Mock(&s3Mock{
+				PutBucketWebsiteFunc: func(param0 *s3.PutBucketWebsiteInput) (*s3.PutBucketWebsiteOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("PutBucketWebsite", &s3.PutBucketWebsiteInput{
+			Bucket: String("my-bucket-to-update"),
+			WebsiteConfiguration: &s3.WebsiteConfiguration{
+				IndexDocument: &s3.IndexDocument{Suffix: String("index.html")},
+				ErrorDocument: &s3.ErrorDocument{Key: String("404.html")},
+			},
+		}).ExpectCalls("PutBucketWebsite").Run(t)
+
+		t.Run("policy and versioning", func(t *testing.T) {
+			policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::my-bucket/*"}]}`
+			Template("update bucket name=my-bucket policy='"+policy+"' versioning=enabled").
+				Mock(&s3Mock{
+					PutBucketPolicyFunc: func(param0 *s3.PutBucketPolicyInput) (*s3.PutBucketPolicyOutput, error) {
+						return nil, nil
+					},
+					PutBucketVersioningFunc: func(param0 *s3.PutBucketVersioningInput) (*s3.PutBucketVersioningOutput, error) {
+						return nil, nil
+					},
+				}).ExpectInput("PutBucketPolicy", &s3.PutBucketPolicyInput{
+				Bucket: String("my-bucket"),
+				Policy: String(policy),
+			}).ExpectInput("PutBucketVersioning", &s3.PutBucketVersioningInput{
+				Bucket:                  String("my-bucket"),
+				VersioningConfiguration: &s3.VersioningConfiguration{Status: String("Enabled")},
+			}).ExpectCalls("PutBucketPolicy", "PutBucketVersioning").Run(t)
+
+			Template("update bucket name=my-bucket policy=none versioning=suspended").
+				Mock(&s3Mock{
+					DeleteBucketPolicyFunc: func(param0 *s3.DeleteBucketPolicyInput) (*s3.DeleteBucketPolicyOutput, error) {
+						return nil, nil
+					},
+					PutBucketVersioningFunc: func(param0 *s3.PutBucketVersioningInput) (*s3.PutBucketVersioningOutput, error) {
+						return nil, nil
+					},
+				}).ExpectInput("DeleteBucketPolicy", &s3.DeleteBucketPolicyInput{
+				Bucket: String("my-bucket"),
+			}).ExpectInput("PutBucketVersioning", &s3.PutBucketVersioningInput{
+				Bucket:                  String("my-bucket"),
+				VersioningConfiguration: &s3.VersioningConfiguration{Status: String("Suspended")},
+			}).ExpectCalls("DeleteBucketPolicy", "PutBucketVersioning").Run(t)
+		})
+
+		t.Run("cors and lifecycle", func(t *testing.T) {
+			Template(`update bucket name=my-bucket cors='{"CORSRules":[{"AllowedMethods":["GET","HEAD"],"AllowedOrigins":["https://example.com"],"MaxAgeSeconds":3600}]}' `+
+				`lifecycle='{"Rules":[{"ID":"expire-logs","Status":"Enabled","Filter":{"Prefix":"logs/"},"Expiration":{"Days":30}}]}'`).
+				Mock(&s3Mock{
+					PutBucketCorsFunc: func(param0 *s3.PutBucketCorsInput) (*s3.PutBucketCorsOutput, error) {
+						return nil, nil
+					},
+					PutBucketLifecycleConfigurationFunc: func(param0 *s3.PutBucketLifecycleConfigurationInput) (*s3.PutBucketLifecycleConfigurationOutput, error) {
+						return nil, nil
+					},
+				}).ExpectInput("PutBucketCors", &s3.PutBucketCorsInput{
+				Bucket: String("my-bucket"),
+				CORSConfiguration: &s3.CORSConfiguration{CORSRules: []*s3.CORSRule{
+					{AllowedMethods: []*string{String("GET"), String("HEAD")}, AllowedOrigins: []*string{String("https://example.com")}, MaxAgeSeconds: Int64(3600)},
+				}},
+			}).ExpectInput("PutBucketLifecycleConfiguration", &s3.PutBucketLifecycleConfigurationInput{
+				Bucket: String("my-bucket"),
+				LifecycleConfiguration: &s3.BucketLifecycleConfiguration{Rules: []*s3.LifecycleRule{
+					{ID: String("expire-logs"), Status: String("Enabled"), Filter: &s3.LifecycleRuleFilter{Prefix: String("logs/")}, Expiration: &s3.LifecycleExpiration{Days: Int64(30)}},
+				}},
+			}).ExpectCalls("PutBucketCors", "PutBucketLifecycleConfiguration").Run(t)
+
+			Template("update bucket name=my-bucket cors=none lifecycle=none").
+				Mock(&s3Mock{
+					DeleteBucketCorsFunc: func(param0 *s3.DeleteBucketCorsInput) (*s3.DeleteBucketCorsOutput, error) {
+						return nil, nil
+					},
+					DeleteBucketLifecycleFunc: func(param0 *s3.DeleteBucketLifecycleInput) (*s3.DeleteBucketLifecycleOutput, error) {
+						return nil, nil
+					},
+				}).ExpectInput("DeleteBucketCors", &s3.DeleteBucketCorsInput{Bucket: String("my-bucket")}).
+				ExpectInput("DeleteBucketLifecycle", &s3.DeleteBucketLifecycleInput{Bucket: String("my-bucket")}).
+				ExpectCalls("DeleteBucketCors", "DeleteBucketLifecycle").Run(t)
+		})
+
+		t.Run("encryption", func(t *testing.T) {
+			Template("update bucket name=my-bucket encryption=kms kms-key=alias/my-key").
+				Mock(&s3Mock{
+					PutBucketEncryptionFunc: func(param0 *s3.PutBucketEncryptionInput) (*s3.PutBucketEncryptionOutput, error) {
+						return nil, nil
+					},
+				}).ExpectInput("PutBucketEncryption", &s3.PutBucketEncryptionInput{
+				Bucket: String("my-bucket"),
+				ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{Rules: []*s3.ServerSideEncryptionRule{
+					{ApplyServerSideEncryptionByDefault: &s3.ServerSideEncryptionByDefault{SSEAlgorithm: String("aws:kms"), KMSMasterKeyID: String("alias/my-key")}},
+				}},
+			}).ExpectCalls("PutBucketEncryption").Run(t)
+
+			Template("update bucket name=my-bucket encryption=AES256").
+				Mock(&s3Mock{
+					PutBucketEncryptionFunc: func(param0 *s3.PutBucketEncryptionInput) (*s3.PutBucketEncryptionOutput, error) {
+						return nil, nil
+					},
+				}).ExpectInput("PutBucketEncryption", &s3.PutBucketEncryptionInput{
+				Bucket: String("my-bucket"),
+				ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{Rules: []*s3.ServerSideEncryptionRule{
+					{ApplyServerSideEncryptionByDefault: &s3.ServerSideEncryptionByDefault{SSEAlgorithm: String("AES256")}},
+				}},
+			}).ExpectCalls("PutBucketEncryption").Run(t)
+
+			Template("update bucket name=my-bucket encryption=none").
+				Mock(&s3Mock{
+					DeleteBucketEncryptionFunc: func(param0 *s3.DeleteBucketEncryptionInput) (*s3.DeleteBucketEncryptionOutput, error) {
+						return nil, nil
+					},
+				}).ExpectInput("DeleteBucketEncryption", &s3.DeleteBucketEncryptionInput{Bucket: String("my-bucket")}).
+				ExpectCalls("DeleteBucketEncryption").Run(t)
+		})
 	})
 
 	t.Run("delete", func(t *testing.T) {
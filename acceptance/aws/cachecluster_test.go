@@ -0,0 +1,77 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+)
+
+func TestCachecluster(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create cachecluster engine=redis id=my-cache type=cache.t2.micro count=1 subnetgroup=my-cache-subnets "+
+			"securitygroups=sg-1234,sg-5678 port=6380 version=3.2.10 availabilityzone=eu-west-1a").
+			Mock(&elasticacheMock{
+				CreateCacheClusterFunc: func(param0 *elasticache.CreateCacheClusterInput) (*elasticache.CreateCacheClusterOutput, error) {
+					return &elasticache.CreateCacheClusterOutput{CacheCluster: &elasticache.CacheCluster{CacheClusterId: String("my-cache")}}, nil
+				},
+			}).ExpectInput("CreateCacheCluster", &elasticache.CreateCacheClusterInput{
+			CacheClusterId:            String("my-cache"),
+			Engine:                    String("redis"),
+			CacheNodeType:             String("cache.t2.micro"),
+			NumCacheNodes:             Int64(1),
+			CacheSubnetGroupName:      String("my-cache-subnets"),
+			SecurityGroupIds:          []*string{String("sg-1234"), String("sg-5678")},
+			Port:                      Int64(6380),
+			EngineVersion:             String("3.2.10"),
+			PreferredAvailabilityZone: String("eu-west-1a"),
+		}).ExpectCommandResult("my-cache").ExpectCalls("CreateCacheCluster").
+			ExpectRevert("delete cachecluster id=my-cache").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete cachecluster id=my-cache").
+			Mock(&elasticacheMock{
+				DeleteCacheClusterFunc: func(param0 *elasticache.DeleteCacheClusterInput) (*elasticache.DeleteCacheClusterOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DeleteCacheCluster", &elasticache.DeleteCacheClusterInput{CacheClusterId: String("my-cache")}).
+			ExpectCalls("DeleteCacheCluster").Run(t)
+	})
+
+	t.Run("check", func(t *testing.T) {
+		Template("check cachecluster id=my-cache state=not-found timeout=1").
+			Mock(&elasticacheMock{
+				DescribeCacheClustersFunc: func(param0 *elasticache.DescribeCacheClustersInput) (*elasticache.DescribeCacheClustersOutput, error) {
+					return nil, awserr.New(elasticache.ErrCodeCacheClusterNotFoundFault, "not found", nil)
+				},
+			}).ExpectInput("DescribeCacheClusters", &elasticache.DescribeCacheClustersInput{CacheClusterId: String("my-cache")}).
+			ExpectCalls("DescribeCacheClusters").Run(t)
+	})
+}
+
+func TestCachesubnetgroup(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create cachesubnetgroup name=my-cache-subnets description='subnets for cache' subnets=subnet-1,subnet-2").
+			Mock(&elasticacheMock{
+				CreateCacheSubnetGroupFunc: func(param0 *elasticache.CreateCacheSubnetGroupInput) (*elasticache.CreateCacheSubnetGroupOutput, error) {
+					return &elasticache.CreateCacheSubnetGroupOutput{CacheSubnetGroup: &elasticache.CacheSubnetGroup{CacheSubnetGroupName: String("my-cache-subnets")}}, nil
+				},
+			}).ExpectInput("CreateCacheSubnetGroup", &elasticache.CreateCacheSubnetGroupInput{
+			CacheSubnetGroupName:        String("my-cache-subnets"),
+			CacheSubnetGroupDescription: String("subnets for cache"),
+			SubnetIds:                   []*string{String("subnet-1"), String("subnet-2")},
+		}).ExpectCommandResult("my-cache-subnets").ExpectCalls("CreateCacheSubnetGroup").
+			ExpectRevert("delete cachesubnetgroup name=my-cache-subnets").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete cachesubnetgroup name=my-cache-subnets").
+			Mock(&elasticacheMock{
+				DeleteCacheSubnetGroupFunc: func(param0 *elasticache.DeleteCacheSubnetGroupInput) (*elasticache.DeleteCacheSubnetGroupOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DeleteCacheSubnetGroup", &elasticache.DeleteCacheSubnetGroupInput{CacheSubnetGroupName: String("my-cache-subnets")}).
+			ExpectCalls("DeleteCacheSubnetGroup").Run(t)
+	})
+}
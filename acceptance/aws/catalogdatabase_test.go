@@ -0,0 +1,35 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/glue"
+)
+
+func TestCatalogDatabase(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create catalogdatabase name=weblogs description='Access logs' location=s3://my-bucket/logs/").
+			Mock(&glueMock{
+				CreateDatabaseFunc: func(param0 *glue.CreateDatabaseInput) (*glue.CreateDatabaseOutput, error) {
+					return &glue.CreateDatabaseOutput{}, nil
+				},
+			}).ExpectInput("CreateDatabase", &glue.CreateDatabaseInput{
+			DatabaseInput: &glue.DatabaseInput{
+				Name:        String("weblogs"),
+				Description: String("Access logs"),
+				LocationUri: String("s3://my-bucket/logs/"),
+			},
+		}).ExpectCommandResult("weblogs").ExpectCalls("CreateDatabase").
+			ExpectRevert("delete catalogdatabase name=weblogs").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete catalogdatabase name=weblogs").
+			Mock(&glueMock{
+				DeleteDatabaseFunc: func(param0 *glue.DeleteDatabaseInput) (*glue.DeleteDatabaseOutput, error) {
+					return &glue.DeleteDatabaseOutput{}, nil
+				},
+			}).ExpectInput("DeleteDatabase", &glue.DeleteDatabaseInput{Name: String("weblogs")}).
+			ExpectCalls("DeleteDatabase").Run(t)
+	})
+}
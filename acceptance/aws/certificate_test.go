@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/route53"
 )
 
 func TestCertificate(t *testing.T) {
@@ -57,6 +58,64 @@ func TestCertificate(t *testing.T) {
 		}
 	})
 
+	t.Run("create with DNS validation", func(t *testing.T) {
+		validationOptions := []*acm.DomainValidation{
+			{DomainName: String("my.domain.com"), ResourceRecord: &acm.ResourceRecord{Name: String("_x1.my.domain.com."), Type: String("CNAME"), Value: String("_x2.acm-validations.aws.")}},
+			{DomainName: String("*.my.domain.com"), ResourceRecord: &acm.ResourceRecord{Name: String("_x1.my.domain.com."), Type: String("CNAME"), Value: String("_x2.acm-validations.aws.")}},
+		}
+		t.Run("without zone", func(t *testing.T) {
+			Template("create certificate domain=my.domain.com validation=dns").
+				Mock(&acmMock{
+					RequestCertificateFunc: func(param0 *acm.RequestCertificateInput) (*acm.RequestCertificateOutput, error) {
+						return &acm.RequestCertificateOutput{CertificateArn: String("arn:my:new:certificate")}, nil
+					},
+					DescribeCertificateFunc: func(param0 *acm.DescribeCertificateInput) (*acm.DescribeCertificateOutput, error) {
+						return &acm.DescribeCertificateOutput{Certificate: &acm.CertificateDetail{DomainValidationOptions: validationOptions[:1]}}, nil
+					},
+				}).ExpectInput("RequestCertificate", &acm.RequestCertificateInput{
+				DomainName:       String("my.domain.com"),
+				ValidationMethod: String("DNS"),
+			}).ExpectInput("DescribeCertificate", &acm.DescribeCertificateInput{CertificateArn: String("arn:my:new:certificate")}).
+				ExpectCommandResult("arn:my:new:certificate").ExpectCalls("RequestCertificate", "DescribeCertificate").
+				ExpectRevert("delete certificate arn=arn:my:new:certificate").Run(t)
+		})
+
+		t.Run("with zone", func(t *testing.T) {
+			Template("create certificate domains=my.domain.com,*.my.domain.com validation=dns zone=/hostedzone/Z1234").
+				Mock(&acmRoute53Mock{
+					acmMock: &acmMock{
+						RequestCertificateFunc: func(param0 *acm.RequestCertificateInput) (*acm.RequestCertificateOutput, error) {
+							return &acm.RequestCertificateOutput{CertificateArn: String("arn:my:new:certificate")}, nil
+						},
+						DescribeCertificateFunc: func(param0 *acm.DescribeCertificateInput) (*acm.DescribeCertificateOutput, error) {
+							return &acm.DescribeCertificateOutput{Certificate: &acm.CertificateDetail{DomainValidationOptions: validationOptions}}, nil
+						},
+					},
+					route53Mock: &route53Mock{
+						ChangeResourceRecordSetsFunc: func(param0 *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+							return &route53.ChangeResourceRecordSetsOutput{ChangeInfo: &route53.ChangeInfo{Id: String("/change/C1234")}}, nil
+						},
+					},
+				}).ExpectInput("RequestCertificate", &acm.RequestCertificateInput{
+				DomainName:              String("my.domain.com"),
+				SubjectAlternativeNames: []*string{String("*.my.domain.com")},
+				ValidationMethod:        String("DNS"),
+			}).ExpectInput("DescribeCertificate", &acm.DescribeCertificateInput{CertificateArn: String("arn:my:new:certificate")}).
+				ExpectInput("ChangeResourceRecordSets", &route53.ChangeResourceRecordSetsInput{
+					HostedZoneId: String("/hostedzone/Z1234"),
+					ChangeBatch: &route53.ChangeBatch{Changes: []*route53.Change{
+						{Action: String("UPSERT"), ResourceRecordSet: &route53.ResourceRecordSet{
+							Name:            String("_x1.my.domain.com."),
+							Type:            String("CNAME"),
+							TTL:             Int64(300),
+							ResourceRecords: []*route53.ResourceRecord{{Value: String("_x2.acm-validations.aws.")}},
+						}},
+					}},
+				}).ExpectCommandResult("arn:my:new:certificate").ExpectCalls("RequestCertificate", "DescribeCertificate", "ChangeResourceRecordSets").
+				ExpectRevert("delete certificate arn=arn:my:new:certificate").Run(t)
+		})
+	})
+
 	t.Run("delete", func(t *testing.T) {
 		Template("delete certificate arn=arn:certificate:to:delete").
 			Mock(&acmMock{
@@ -67,6 +126,14 @@ func TestCertificate(t *testing.T) {
 			ExpectCalls("DeleteCertificate").Run(t)
 	})
 
+	t.Run("wait", func(t *testing.T) {
+		Template("wait certificate arn=arn:certificate:to:wait state=ISSUED timeout=1m").Mock(&acmMock{
+			DescribeCertificateFunc: func(input *acm.DescribeCertificateInput) (*acm.DescribeCertificateOutput, error) {
+				return &acm.DescribeCertificateOutput{Certificate: &acm.CertificateDetail{CertificateArn: String("arn:certificate:to:wait"), Status: String("ISSUED")}}, nil
+			}}).ExpectInput("DescribeCertificate", &acm.DescribeCertificateInput{CertificateArn: String("arn:certificate:to:wait")}).
+			ExpectCalls("DescribeCertificate").Run(t)
+	})
+
 	t.Run("check", func(t *testing.T) {
 		Template("check certificate arn=arn:certificate:to:check state=issued timeout=1").Mock(&acmMock{
 			DescribeCertificateFunc: func(input *acm.DescribeCertificateInput) (*acm.DescribeCertificateOutput, error) {
@@ -75,3 +142,35 @@ func TestCertificate(t *testing.T) {
 			ExpectCalls("DescribeCertificate").Run(t)
 	})
 }
+
+// acmRoute53Mock serves both the certificate and the record commands,
+// for certificates validated with records created in a hosted zone
+type acmRoute53Mock struct {
+	*acmMock
+	*route53Mock
+}
+
+func (m *acmRoute53Mock) Calls() map[string]int {
+	calls := make(map[string]int)
+	for _, mock := range []mock{m.acmMock, m.route53Mock} {
+		for call, count := range mock.Calls() {
+			calls[call] += count
+		}
+	}
+	return calls
+}
+
+func (m *acmRoute53Mock) SetInputs(inputs map[string]interface{}) {
+	m.acmMock.SetInputs(inputs)
+	m.route53Mock.SetInputs(inputs)
+}
+
+func (m *acmRoute53Mock) SetIgnored(ignored map[string]struct{}) {
+	m.acmMock.SetIgnored(ignored)
+	m.route53Mock.SetIgnored(ignored)
+}
+
+func (m *acmRoute53Mock) SetTesting(t *testing.T) {
+	m.acmMock.SetTesting(t)
+	m.route53Mock.SetTesting(t)
+}
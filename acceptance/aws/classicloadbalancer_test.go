@@ -0,0 +1,70 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elb"
+)
+
+func TestClassicloadbalancer(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create classicloadbalancer name=web subnets=sub-1234,sub-2345 protocol=http port=80 instance-port=8080 "+
+			"scheme=internal securitygroups=sg-1234 healthcheck=HTTP:8080/health").Mock(&elbMock{
+			CreateLoadBalancerFunc: func(input *elb.CreateLoadBalancerInput) (*elb.CreateLoadBalancerOutput, error) {
+				return &elb.CreateLoadBalancerOutput{DNSName: String("web.elb.amazonaws.com")}, nil
+			},
+			ConfigureHealthCheckFunc: func(input *elb.ConfigureHealthCheckInput) (*elb.ConfigureHealthCheckOutput, error) {
+				return nil, nil
+			}}).
+			ExpectInput("CreateLoadBalancer", &elb.CreateLoadBalancerInput{
+				LoadBalancerName: String("web"),
+				Subnets:          []*string{String("sub-1234"), String("sub-2345")},
+				Listeners:        []*elb.Listener{{Protocol: String("http"), LoadBalancerPort: Int64(80), InstancePort: Int64(8080)}},
+				Scheme:           String("internal"),
+				SecurityGroups:   []*string{String("sg-1234")},
+			}).
+			ExpectInput("ConfigureHealthCheck", &elb.ConfigureHealthCheckInput{
+				LoadBalancerName: String("web"),
+				HealthCheck: &elb.HealthCheck{
+					Target:             String("HTTP:8080/health"),
+					Interval:           Int64(30),
+					Timeout:            Int64(5),
+					HealthyThreshold:   Int64(10),
+					UnhealthyThreshold: Int64(2),
+				},
+			}).
+			ExpectCommandResult("web").ExpectCalls("CreateLoadBalancer", "ConfigureHealthCheck").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete classicloadbalancer name=web").Mock(&elbMock{
+			DeleteLoadBalancerFunc: func(input *elb.DeleteLoadBalancerInput) (*elb.DeleteLoadBalancerOutput, error) {
+				return nil, nil
+			}}).
+			ExpectInput("DeleteLoadBalancer", &elb.DeleteLoadBalancerInput{
+				LoadBalancerName: String("web"),
+			}).ExpectCalls("DeleteLoadBalancer").Run(t)
+	})
+
+	t.Run("attach", func(t *testing.T) {
+		Template("attach classicloadbalancer name=web instance=i-1234").Mock(&elbMock{
+			RegisterInstancesWithLoadBalancerFunc: func(input *elb.RegisterInstancesWithLoadBalancerInput) (*elb.RegisterInstancesWithLoadBalancerOutput, error) {
+				return nil, nil
+			}}).
+			ExpectInput("RegisterInstancesWithLoadBalancer", &elb.RegisterInstancesWithLoadBalancerInput{
+				LoadBalancerName: String("web"),
+				Instances:        []*elb.Instance{{InstanceId: String("i-1234")}},
+			}).ExpectCalls("RegisterInstancesWithLoadBalancer").Run(t)
+	})
+
+	t.Run("detach", func(t *testing.T) {
+		Template("detach classicloadbalancer name=web instance=i-1234").Mock(&elbMock{
+			DeregisterInstancesFromLoadBalancerFunc: func(input *elb.DeregisterInstancesFromLoadBalancerInput) (*elb.DeregisterInstancesFromLoadBalancerOutput, error) {
+				return nil, nil
+			}}).
+			ExpectInput("DeregisterInstancesFromLoadBalancer", &elb.DeregisterInstancesFromLoadBalancerInput{
+				LoadBalancerName: String("web"),
+				Instances:        []*elb.Instance{{InstanceId: String("i-1234")}},
+			}).ExpectCalls("DeregisterInstancesFromLoadBalancer").Run(t)
+	})
+}
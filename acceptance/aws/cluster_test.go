@@ -0,0 +1,92 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/redshift"
+)
+
+func TestCluster(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create cluster id=my-warehouse type=dc2.large nodes=4 username=admin password=MyPassw0rd dbname=sales "+
+			"subnetgroup=my-clustersubnets securitygroups=sg-1234,sg-5678 availabilityzone=eu-west-1a port=5440 public=false encrypted=true").
+			Mock(&redshiftMock{
+				CreateClusterFunc: func(param0 *redshift.CreateClusterInput) (*redshift.CreateClusterOutput, error) {
+					return &redshift.CreateClusterOutput{Cluster: &redshift.Cluster{ClusterIdentifier: String("my-warehouse")}}, nil
+				},
+			}).ExpectInput("CreateCluster", &redshift.CreateClusterInput{
+			ClusterIdentifier:      String("my-warehouse"),
+			NodeType:               String("dc2.large"),
+			ClusterType:            String("multi-node"),
+			NumberOfNodes:          Int64(4),
+			MasterUsername:         String("admin"),
+			MasterUserPassword:     String("MyPassw0rd"),
+			DBName:                 String("sales"),
+			ClusterSubnetGroupName: String("my-clustersubnets"),
+			VpcSecurityGroupIds:    []*string{String("sg-1234"), String("sg-5678")},
+			AvailabilityZone:       String("eu-west-1a"),
+			Port:                   Int64(5440),
+			PubliclyAccessible:     Bool(false),
+			Encrypted:              Bool(true),
+		}).ExpectCommandResult("my-warehouse").ExpectCalls("CreateCluster").
+			ExpectRevert("delete cluster id=my-warehouse skip-snapshot=true").Run(t)
+	})
+
+	t.Run("create single node", func(t *testing.T) {
+		Template("create cluster id=my-warehouse type=dc2.large username=admin password=MyPassw0rd").
+			Mock(&redshiftMock{
+				CreateClusterFunc: func(param0 *redshift.CreateClusterInput) (*redshift.CreateClusterOutput, error) {
+					return &redshift.CreateClusterOutput{Cluster: &redshift.Cluster{ClusterIdentifier: String("my-warehouse")}}, nil
+				},
+			}).ExpectInput("CreateCluster", &redshift.CreateClusterInput{
+			ClusterIdentifier:  String("my-warehouse"),
+			NodeType:           String("dc2.large"),
+			ClusterType:        String("single-node"),
+			MasterUsername:     String("admin"),
+			MasterUserPassword: String("MyPassw0rd"),
+		}).ExpectCommandResult("my-warehouse").ExpectCalls("CreateCluster").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete cluster id=my-warehouse snapshot=my-warehouse-final").
+			Mock(&redshiftMock{
+				DeleteClusterFunc: func(param0 *redshift.DeleteClusterInput) (*redshift.DeleteClusterOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DeleteCluster", &redshift.DeleteClusterInput{
+			ClusterIdentifier:              String("my-warehouse"),
+			FinalClusterSnapshotIdentifier: String("my-warehouse-final"),
+		}).ExpectCalls("DeleteCluster").Run(t)
+	})
+
+	t.Run("resize", func(t *testing.T) {
+		Template("resize cluster id=my-warehouse nodes=8").
+			Mock(&redshiftMock{
+				DescribeClustersFunc: func(param0 *redshift.DescribeClustersInput) (*redshift.DescribeClustersOutput, error) {
+					return &redshift.DescribeClustersOutput{Clusters: []*redshift.Cluster{
+						{ClusterIdentifier: String("my-warehouse"), NodeType: String("dc2.large"), NumberOfNodes: Int64(4)},
+					}}, nil
+				},
+				ModifyClusterFunc: func(param0 *redshift.ModifyClusterInput) (*redshift.ModifyClusterOutput, error) {
+					return &redshift.ModifyClusterOutput{Cluster: &redshift.Cluster{ClusterIdentifier: String("my-warehouse")}}, nil
+				},
+			}).ExpectInput("ModifyCluster", &redshift.ModifyClusterInput{
+			ClusterIdentifier: String("my-warehouse"),
+			ClusterType:       String("multi-node"),
+			NumberOfNodes:     Int64(8),
+		}).ExpectInput("DescribeClusters", &redshift.DescribeClustersInput{ClusterIdentifier: String("my-warehouse")}).
+			ExpectCommandResult("my-warehouse").ExpectCalls("DescribeClusters", "ModifyCluster").
+			ExpectRevert("check cluster id=my-warehouse state=available timeout=3600\nresize cluster id=my-warehouse nodes=4").Run(t)
+	})
+
+	t.Run("check", func(t *testing.T) {
+		Template("check cluster id=my-warehouse state=not-found timeout=1").
+			Mock(&redshiftMock{
+				DescribeClustersFunc: func(param0 *redshift.DescribeClustersInput) (*redshift.DescribeClustersOutput, error) {
+					return nil, awserr.New(redshift.ErrCodeClusterNotFoundFault, "not found", nil)
+				},
+			}).ExpectInput("DescribeClusters", &redshift.DescribeClustersInput{ClusterIdentifier: String("my-warehouse")}).
+			ExpectCalls("DescribeClusters").Run(t)
+	})
+}
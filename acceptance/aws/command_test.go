@@ -0,0 +1,33 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+func TestCommand(t *testing.T) {
+	t.Run("run", func(t *testing.T) {
+		Template("run command instance=i-1234 script='df -h\nuptime' comment=check").
+			Mock(&ssmMock{
+				SendCommandFunc: func(input *ssm.SendCommandInput) (*ssm.SendCommandOutput, error) {
+					return &ssm.SendCommandOutput{Command: &ssm.Command{CommandId: String("cmd-1234")}}, nil
+				},
+				GetCommandInvocationFunc: func(input *ssm.GetCommandInvocationInput) (*ssm.GetCommandInvocationOutput, error) {
+					return &ssm.GetCommandInvocationOutput{
+						CommandId:             String("cmd-1234"),
+						InstanceId:            String("i-1234"),
+						Status:                String("Success"),
+						ResponseCode:          Int64(0),
+						StandardOutputContent: String("/dev/xvda1 8G\n 10:00:00 up 3 days\n"),
+					}, nil
+				},
+			}).ExpectInput("SendCommand", &ssm.SendCommandInput{
+			DocumentName: String("AWS-RunShellScript"),
+			InstanceIds:  []*string{String("i-1234")},
+			Comment:      String("check"),
+			Parameters:   map[string][]*string{"commands": {String("df -h\nuptime")}},
+		}).ExpectInput("GetCommandInvocation", &ssm.GetCommandInvocationInput{CommandId: String("cmd-1234"), InstanceId: String("i-1234")}).
+			ExpectCommandResult("/dev/xvda1 8G\n 10:00:00 up 3 days").ExpectCalls("SendCommand", "GetCommandInvocation").Run(t)
+	})
+}
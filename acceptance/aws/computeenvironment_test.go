@@ -0,0 +1,71 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/batch"
+)
+
+func TestComputeEnvironment(t *testing.T) {
+	t.Run("create managed", func(t *testing.T) {
+		Template("create computeenvironment name=my-env type=managed service-role=arn:aws:iam::0123456789:role/AWSBatchServiceRole instance-role=ecsInstanceRole "+
+			"instance-types=[m4.large,c4.large] max-vcpus=16 subnets=[subnet-1,subnet-2] securitygroups=sg-1 keypair=my-key").
+			Mock(&batchMock{
+				CreateComputeEnvironmentFunc: func(param0 *batch.CreateComputeEnvironmentInput) (*batch.CreateComputeEnvironmentOutput, error) {
+					return &batch.CreateComputeEnvironmentOutput{ComputeEnvironmentArn: String("arn:aws:batch:us-east-1:0123456789:compute-environment/my-env")}, nil
+				},
+			}).ExpectInput("CreateComputeEnvironment", &batch.CreateComputeEnvironmentInput{
+			ComputeEnvironmentName: String("my-env"),
+			Type:                   String("MANAGED"),
+			ServiceRole:            String("arn:aws:iam::0123456789:role/AWSBatchServiceRole"),
+			ComputeResources: &batch.ComputeResource{
+				Type:             String("EC2"),
+				MinvCpus:         Int64(0),
+				MaxvCpus:         Int64(16),
+				InstanceTypes:    []*string{String("m4.large"), String("c4.large")},
+				InstanceRole:     String("ecsInstanceRole"),
+				Subnets:          []*string{String("subnet-1"), String("subnet-2")},
+				SecurityGroupIds: []*string{String("sg-1")},
+				Ec2KeyPair:       String("my-key"),
+			},
+		}).ExpectCommandResult("arn:aws:batch:us-east-1:0123456789:compute-environment/my-env").ExpectCalls("CreateComputeEnvironment").
+			ExpectRevert("delete computeenvironment id=arn:aws:batch:us-east-1:0123456789:compute-environment/my-env").Run(t)
+	})
+
+	t.Run("create unmanaged", func(t *testing.T) {
+		Template("create computeenvironment name=my-env type=unmanaged service-role=AWSBatchServiceRole").
+			Mock(&batchMock{
+				CreateComputeEnvironmentFunc: func(param0 *batch.CreateComputeEnvironmentInput) (*batch.CreateComputeEnvironmentOutput, error) {
+					return &batch.CreateComputeEnvironmentOutput{ComputeEnvironmentArn: String("arn:aws:batch:us-east-1:0123456789:compute-environment/my-env")}, nil
+				},
+			}).ExpectInput("CreateComputeEnvironment", &batch.CreateComputeEnvironmentInput{
+			ComputeEnvironmentName: String("my-env"),
+			Type:                   String("UNMANAGED"),
+			ServiceRole:            String("AWSBatchServiceRole"),
+		}).ExpectCommandResult("arn:aws:batch:us-east-1:0123456789:compute-environment/my-env").ExpectCalls("CreateComputeEnvironment").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete computeenvironment id=my-env").
+			Mock(&batchMock{
+				UpdateComputeEnvironmentFunc: func(param0 *batch.UpdateComputeEnvironmentInput) (*batch.UpdateComputeEnvironmentOutput, error) {
+					return &batch.UpdateComputeEnvironmentOutput{}, nil
+				},
+				DescribeComputeEnvironmentsFunc: func(param0 *batch.DescribeComputeEnvironmentsInput) (*batch.DescribeComputeEnvironmentsOutput, error) {
+					return &batch.DescribeComputeEnvironmentsOutput{ComputeEnvironments: []*batch.ComputeEnvironmentDetail{
+						{ComputeEnvironmentName: String("my-env"), State: String("DISABLED"), Status: String("VALID")},
+					}}, nil
+				},
+				DeleteComputeEnvironmentFunc: func(param0 *batch.DeleteComputeEnvironmentInput) (*batch.DeleteComputeEnvironmentOutput, error) {
+					return &batch.DeleteComputeEnvironmentOutput{}, nil
+				},
+			}).ExpectInput("UpdateComputeEnvironment", &batch.UpdateComputeEnvironmentInput{
+			ComputeEnvironment: String("my-env"),
+			State:              String("DISABLED"),
+		}).ExpectInput("DescribeComputeEnvironments", &batch.DescribeComputeEnvironmentsInput{
+			ComputeEnvironments: []*string{String("my-env")},
+		}).ExpectInput("DeleteComputeEnvironment", &batch.DeleteComputeEnvironmentInput{
+			ComputeEnvironment: String("my-env"),
+		}).ExpectCalls("UpdateComputeEnvironment", "DescribeComputeEnvironments", "DeleteComputeEnvironment").Run(t)
+	})
+}
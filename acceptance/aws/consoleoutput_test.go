@@ -0,0 +1,36 @@
+package awsat
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestConsoleoutput(t *testing.T) {
+	t.Run("get", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "awless-at-consoleoutput")
+		if err != nil {
+			t.Fatal(err)
+		}
+		file := filepath.Join(dir, "console.log")
+
+		Template("get consoleoutput instance=i-1234 file="+file).
+			Mock(&ec2Mock{
+				GetConsoleOutputFunc: func(input *ec2.GetConsoleOutputInput) (*ec2.GetConsoleOutputOutput, error) {
+					return &ec2.GetConsoleOutputOutput{InstanceId: String("i-1234"), Output: String(base64.StdEncoding.EncodeToString([]byte("Linux version 4.9\nlogin:")))}, nil
+				},
+			}).ExpectInput("GetConsoleOutput", &ec2.GetConsoleOutputInput{InstanceId: String("i-1234")}).
+			ExpectCommandResult(file).ExpectCalls("GetConsoleOutput").Run(t)
+
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := string(content), "Linux version 4.9\nlogin:"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	})
+}
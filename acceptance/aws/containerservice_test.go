@@ -0,0 +1,74 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestContainerService(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create containerservice cluster=my-cluster-name name=web containertask=web-task:2 desired-count=2 launch-type=fargate "+
+			"subnets=[sub-1234,sub-2345] securitygroups=sg-1234 public-ip=false loadbalancer.container-name=nginx loadbalancer.container-port=80 "+
+			"loadbalancer.targetgroup=arn:of:my:targetgroup").
+			Mock(&ecsMock{
+				CreateServiceFunc: func(param0 *ecs.CreateServiceInput) (*ecs.CreateServiceOutput, error) {
+					return &ecs.CreateServiceOutput{
+						Service: &ecs.Service{ServiceArn: String("arn:of:my:new:service")},
+					}, nil
+				},
+			}).ExpectInput("CreateService", &ecs.CreateServiceInput{
+			Cluster:        String("my-cluster-name"),
+			ServiceName:    String("web"),
+			TaskDefinition: String("web-task:2"),
+			DesiredCount:   Int64(2),
+			LaunchType:     String("FARGATE"),
+			NetworkConfiguration: &ecs.NetworkConfiguration{AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+				Subnets:        []*string{String("sub-1234"), String("sub-2345")},
+				SecurityGroups: []*string{String("sg-1234")},
+				AssignPublicIp: String("DISABLED"),
+			}},
+			LoadBalancers: []*ecs.LoadBalancer{
+				{
+					ContainerName:  String("nginx"),
+					ContainerPort:  Int64(80),
+					TargetGroupArn: String("arn:of:my:targetgroup"),
+				},
+			},
+		}).ExpectCommandResult("arn:of:my:new:service").ExpectCalls("CreateService").
+			ExpectRevert("delete containerservice cluster=my-cluster-name name=web").Run(t)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		Template("update containerservice cluster=my-cluster-name name=web containertask=web-task:3 desired-count=4").
+			Mock(&ecsMock{
+				UpdateServiceFunc: func(param0 *ecs.UpdateServiceInput) (*ecs.UpdateServiceOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("UpdateService", &ecs.UpdateServiceInput{
+			Cluster:        String("my-cluster-name"),
+			Service:        String("web"),
+			TaskDefinition: String("web-task:3"),
+			DesiredCount:   Int64(4),
+		}).ExpectCalls("UpdateService").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete containerservice cluster=my-cluster-name name=web").
+			Mock(&ecsMock{
+				UpdateServiceFunc: func(param0 *ecs.UpdateServiceInput) (*ecs.UpdateServiceOutput, error) {
+					return nil, nil
+				},
+				DeleteServiceFunc: func(param0 *ecs.DeleteServiceInput) (*ecs.DeleteServiceOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("UpdateService", &ecs.UpdateServiceInput{
+			Cluster:      String("my-cluster-name"),
+			Service:      String("web"),
+			DesiredCount: Int64(0),
+		}).ExpectInput("DeleteService", &ecs.DeleteServiceInput{
+			Cluster: String("my-cluster-name"),
+			Service: String("web"),
+		}).ExpectCalls("UpdateService", "DeleteService").Run(t)
+	})
+}
@@ -51,6 +51,27 @@ func TestContainerTask(t *testing.T) {
 			}).
 				ExpectCommandResult("arn:of:new:task").ExpectCalls("RunTask").Run(t)
 		})
+		t.Run("task on fargate", func(t *testing.T) {
+			Template("start containertask name=my-new-task cluster=my-cluster-name desired-count=1 type=task launch-type=fargate subnets=[sub-1234,sub-2345] securitygroups=sg-1234 public-ip=true").
+				Mock(&ecsMock{
+					RunTaskFunc: func(param0 *ecs.RunTaskInput) (*ecs.RunTaskOutput, error) {
+						return &ecs.RunTaskOutput{
+							Tasks: []*ecs.Task{{TaskArn: String("arn:of:new:task")}},
+						}, nil
+					},
+				}).ExpectInput("RunTask", &ecs.RunTaskInput{
+				TaskDefinition: String("my-new-task"),
+				Cluster:        String("my-cluster-name"),
+				Count:          Int64(1),
+				LaunchType:     String("FARGATE"),
+				NetworkConfiguration: &ecs.NetworkConfiguration{AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+					Subnets:        []*string{String("sub-1234"), String("sub-2345")},
+					SecurityGroups: []*string{String("sg-1234")},
+					AssignPublicIp: String("ENABLED"),
+				}},
+			}).
+				ExpectCommandResult("arn:of:new:task").ExpectCalls("RunTask").Run(t)
+		})
 	})
 
 	t.Run("stop", func(t *testing.T) {
@@ -165,6 +186,35 @@ func TestContainerTask(t *testing.T) {
 				NetworkMode: String("bridge"),
 			}).ExpectCommandResult("arn:of:my:updated:definition").ExpectCalls("DescribeTaskDefinition", "RegisterTaskDefinition").Run(t)
 		})
+
+		t.Run("first container in fargate task", func(t *testing.T) {
+			Template("attach containertask name=my-task container-name=nginx image=nginx memory-hard-limit=512 ports=80 launch-type=fargate cpu=256 memory=512 execution-role=arn:of:execution:role").
+				Mock(&ecsMock{
+					DescribeTaskDefinitionFunc: func(param0 *ecs.DescribeTaskDefinitionInput) (*ecs.DescribeTaskDefinitionOutput, error) {
+						return nil, awserr.New("ClientException", "unable to describe task definition", errors.New("task does not exist"))
+					},
+					RegisterTaskDefinitionFunc: func(param0 *ecs.RegisterTaskDefinitionInput) (*ecs.RegisterTaskDefinitionOutput, error) {
+						return &ecs.RegisterTaskDefinitionOutput{TaskDefinition: &ecs.TaskDefinition{TaskDefinitionArn: String("arn:of:my:new:definition")}}, nil
+					},
+				}).ExpectInput("DescribeTaskDefinition", &ecs.DescribeTaskDefinitionInput{
+				TaskDefinition: String("my-task"),
+			}).ExpectInput("RegisterTaskDefinition", &ecs.RegisterTaskDefinitionInput{
+				Family: String("my-task"),
+				ContainerDefinitions: []*ecs.ContainerDefinition{
+					{
+						Name:         String("nginx"),
+						Image:        String("nginx"),
+						Memory:       Int64(512),
+						PortMappings: []*ecs.PortMapping{{ContainerPort: Int64(80)}},
+					},
+				},
+				RequiresCompatibilities: []*string{String("FARGATE")},
+				NetworkMode:             String("awsvpc"),
+				Cpu:                     String("256"),
+				Memory:                  String("512"),
+				ExecutionRoleArn:        String("arn:of:execution:role"),
+			}).ExpectCommandResult("arn:of:my:new:definition").ExpectCalls("DescribeTaskDefinition", "RegisterTaskDefinition").Run(t)
+		})
 	})
 
 	t.Run("detach", func(t *testing.T) {
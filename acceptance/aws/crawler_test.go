@@ -0,0 +1,40 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/glue"
+)
+
+func TestCrawler(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create crawler name=weblogs-crawler role=AWSGlueServiceRole-weblogs database=weblogs targets=[s3://my-bucket/logs/,s3://my-bucket/events/] "+
+			"schedule='cron(0 2 * * ? *)' table-prefix=raw_").
+			Mock(&glueMock{
+				CreateCrawlerFunc: func(param0 *glue.CreateCrawlerInput) (*glue.CreateCrawlerOutput, error) {
+					return &glue.CreateCrawlerOutput{}, nil
+				},
+			}).ExpectInput("CreateCrawler", &glue.CreateCrawlerInput{
+			Name:         String("weblogs-crawler"),
+			Role:         String("AWSGlueServiceRole-weblogs"),
+			DatabaseName: String("weblogs"),
+			Targets: &glue.CrawlerTargets{S3Targets: []*glue.S3Target{
+				{Path: String("s3://my-bucket/logs/")},
+				{Path: String("s3://my-bucket/events/")},
+			}},
+			Schedule:    String("cron(0 2 * * ? *)"),
+			TablePrefix: String("raw_"),
+		}).ExpectCommandResult("weblogs-crawler").ExpectCalls("CreateCrawler").
+			ExpectRevert("delete crawler name=weblogs-crawler").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete crawler name=weblogs-crawler").
+			Mock(&glueMock{
+				DeleteCrawlerFunc: func(param0 *glue.DeleteCrawlerInput) (*glue.DeleteCrawlerOutput, error) {
+					return &glue.DeleteCrawlerOutput{}, nil
+				},
+			}).ExpectInput("DeleteCrawler", &glue.DeleteCrawlerInput{Name: String("weblogs-crawler")}).
+			ExpectCalls("DeleteCrawler").Run(t)
+	})
+}
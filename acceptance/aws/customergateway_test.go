@@ -0,0 +1,44 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestCustomergateway(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create customergateway publicip=203.0.113.12 bgp-asn=65000 name=my-office").
+			Mock(&ec2Mock{
+				CreateCustomerGatewayFunc: func(input *ec2.CreateCustomerGatewayInput) (*ec2.CreateCustomerGatewayOutput, error) {
+					return &ec2.CreateCustomerGatewayOutput{CustomerGateway: &ec2.CustomerGateway{CustomerGatewayId: String("cgw-1234")}}, nil
+				},
+				CreateTagsRequestFunc: func(input *ec2.CreateTagsInput) (req *request.Request, output *ec2.CreateTagsOutput) {
+					output = &ec2.CreateTagsOutput{}
+					req = request.New(aws.Config{}, metadata.ClientInfo{}, request.Handlers{}, nil, &request.Operation{}, input, output)
+					return
+				},
+			}).ExpectInput("CreateCustomerGateway", &ec2.CreateCustomerGatewayInput{
+			PublicIp: String("203.0.113.12"),
+			BgpAsn:   Int64(65000),
+			Type:     String("ipsec.1"),
+		}).ExpectInput("CreateTagsRequest", &ec2.CreateTagsInput{
+			Resources: []*string{String("cgw-1234")},
+			Tags:      []*ec2.Tag{{Key: String("Name"), Value: String("my-office")}},
+		}).ExpectCommandResult("cgw-1234").ExpectCalls("CreateCustomerGateway", "CreateTagsRequest").
+			ExpectRevert("delete customergateway id=cgw-1234").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete customergateway id=cgw-1234").
+			Mock(&ec2Mock{
+				DeleteCustomerGatewayFunc: func(input *ec2.DeleteCustomerGatewayInput) (*ec2.DeleteCustomerGatewayOutput, error) {
+					return &ec2.DeleteCustomerGatewayOutput{}, nil
+				},
+			}).ExpectInput("DeleteCustomerGateway", &ec2.DeleteCustomerGatewayInput{CustomerGatewayId: String("cgw-1234")}).
+			ExpectCalls("DeleteCustomerGateway").Run(t)
+	})
+}
@@ -128,4 +128,18 @@ func TestDatabase(t *testing.T) {
 			ForceFailover:        Bool(true),
 		}).ExpectCalls("RebootDBInstance").Run(t)
 	})
+	t.Run("restore", func(t *testing.T) {
+		Template("restore database snapshot=mydb-final name=mydb-restored type=db.t2.small subnetgroup=my-subnets").
+			Mock(&rdsMock{
+				RestoreDBInstanceFromDBSnapshotFunc: func(param0 *rds.RestoreDBInstanceFromDBSnapshotInput) (*rds.RestoreDBInstanceFromDBSnapshotOutput, error) {
+					return &rds.RestoreDBInstanceFromDBSnapshotOutput{DBInstance: &rds.DBInstance{DBInstanceIdentifier: String("mydb-restored")}}, nil
+				},
+			}).ExpectInput("RestoreDBInstanceFromDBSnapshot", &rds.RestoreDBInstanceFromDBSnapshotInput{
+			DBSnapshotIdentifier: String("mydb-final"),
+			DBInstanceIdentifier: String("mydb-restored"),
+			DBInstanceClass:      String("db.t2.small"),
+			DBSubnetGroupName:    String("my-subnets"),
+		}).ExpectCommandResult("mydb-restored").ExpectCalls("RestoreDBInstanceFromDBSnapshot").
+			ExpectRevert("delete database id=mydb-restored skip-snapshot=true").Run(t)
+	})
 }
@@ -0,0 +1,34 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+func TestDbparametergroup(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create dbparametergroup name=my-dbparams family=postgres9.6 description=db-params-description").
+			Mock(&rdsMock{
+				CreateDBParameterGroupFunc: func(param0 *rds.CreateDBParameterGroupInput) (*rds.CreateDBParameterGroupOutput, error) {
+					return &rds.CreateDBParameterGroupOutput{
+						DBParameterGroup: &rds.DBParameterGroup{DBParameterGroupName: String("new-dbparams-name")},
+					}, nil
+				},
+			}).ExpectInput("CreateDBParameterGroup", &rds.CreateDBParameterGroupInput{
+			DBParameterGroupName:   String("my-dbparams"),
+			DBParameterGroupFamily: String("postgres9.6"),
+			Description:            String("db-params-description"),
+		}).ExpectCommandResult("new-dbparams-name").ExpectCalls("CreateDBParameterGroup").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete dbparametergroup name=dbparams-to-delete").
+			Mock(&rdsMock{
+				DeleteDBParameterGroupFunc: func(param0 *rds.DeleteDBParameterGroupInput) (*rds.DeleteDBParameterGroupOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DeleteDBParameterGroup", &rds.DeleteDBParameterGroupInput{DBParameterGroupName: String("dbparams-to-delete")}).
+			ExpectCalls("DeleteDBParameterGroup").Run(t)
+	})
+}
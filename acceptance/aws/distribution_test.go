@@ -6,6 +6,9 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/wallix/awless/aws/spec"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
 )
 
 func TestDistribution(t *testing.T) {
@@ -212,6 +215,108 @@ func TestDistribution(t *testing.T) {
 		})
 	})
 
+	t.Run("create with origin and cache behaviors", func(t *testing.T) {
+		awsspec.CallerReferenceFunc = func() string {
+			return "callerReference"
+		}
+		defaultBehavior := func(viewerPolicy string) *cloudfront.DefaultCacheBehavior {
+			return &cloudfront.DefaultCacheBehavior{
+				MinTTL: Int64(0),
+				ForwardedValues: &cloudfront.ForwardedValues{
+					Cookies:     &cloudfront.CookiePreference{Forward: String("all")},
+					QueryString: Bool(true),
+				},
+				TrustedSigners:       &cloudfront.TrustedSigners{Enabled: Bool(false), Quantity: Int64(0)},
+				TargetOriginId:       String("orig_1"),
+				ViewerProtocolPolicy: String(viewerPolicy),
+			}
+		}
+		t.Run("bucket", func(t *testing.T) {
+			Template("create distribution origin=my-website cache-behaviors=[/images/*:86400,/api/*]").
+				Mock(&cloudfrontMock{
+					CreateDistributionFunc: func(param0 *cloudfront.CreateDistributionInput) (*cloudfront.CreateDistributionOutput, error) {
+						return &cloudfront.CreateDistributionOutput{Distribution: &cloudfront.Distribution{Id: String("new-distribution-id")}}, nil
+					},
+				}).ExpectInput("CreateDistribution", &cloudfront.CreateDistributionInput{
+				DistributionConfig: &cloudfront.DistributionConfig{
+					CallerReference:      String("callerReference"),
+					Comment:              String("my-website.s3.amazonaws.com"),
+					DefaultCacheBehavior: defaultBehavior("allow-all"),
+					CacheBehaviors: &cloudfront.CacheBehaviors{
+						Quantity: Int64(2),
+						Items: []*cloudfront.CacheBehavior{
+							{
+								PathPattern: String("/images/*"), DefaultTTL: Int64(86400), MinTTL: Int64(0),
+								ForwardedValues:      defaultBehavior("allow-all").ForwardedValues,
+								TrustedSigners:       defaultBehavior("allow-all").TrustedSigners,
+								TargetOriginId:       String("orig_1"),
+								ViewerProtocolPolicy: String("allow-all"),
+							},
+							{
+								PathPattern: String("/api/*"), MinTTL: Int64(0),
+								ForwardedValues:      defaultBehavior("allow-all").ForwardedValues,
+								TrustedSigners:       defaultBehavior("allow-all").TrustedSigners,
+								TargetOriginId:       String("orig_1"),
+								ViewerProtocolPolicy: String("allow-all"),
+							},
+						},
+					},
+					Enabled: Bool(true),
+					Origins: &cloudfront.Origins{
+						Quantity: Int64(1),
+						Items: []*cloudfront.Origin{{
+							Id:             String("orig_1"),
+							DomainName:     String("my-website.s3.amazonaws.com"),
+							S3OriginConfig: &cloudfront.S3OriginConfig{OriginAccessIdentity: String("")},
+						}},
+					},
+				},
+			}).ExpectCommandResult("new-distribution-id").ExpectCalls("CreateDistribution").Run(t)
+		})
+		t.Run("loadbalancer", func(t *testing.T) {
+			g := graph.NewGraph()
+			g.AddResource(resourcetest.LoadBalancer("arn:of:my-lb").Prop(properties.Name, "my-lb").Prop(properties.PublicDNS, "my-lb-1234.eu-west-1.elb.amazonaws.com").Build())
+			Template("create distribution origin=my-lb https-behaviour=redirect-to-https").
+				Mock(&cloudfrontMock{
+					CreateDistributionFunc: func(param0 *cloudfront.CreateDistributionInput) (*cloudfront.CreateDistributionOutput, error) {
+						return &cloudfront.CreateDistributionOutput{Distribution: &cloudfront.Distribution{Id: String("new-distribution-id")}}, nil
+					},
+				}).Graph(g).ExpectInput("CreateDistribution", &cloudfront.CreateDistributionInput{
+				DistributionConfig: &cloudfront.DistributionConfig{
+					CallerReference:      String("callerReference"),
+					Comment:              String("my-lb-1234.eu-west-1.elb.amazonaws.com"),
+					DefaultCacheBehavior: defaultBehavior("redirect-to-https"),
+					Enabled:              Bool(true),
+					Origins: &cloudfront.Origins{
+						Quantity: Int64(1),
+						Items: []*cloudfront.Origin{{
+							Id:         String("orig_1"),
+							DomainName: String("my-lb-1234.eu-west-1.elb.amazonaws.com"),
+							CustomOriginConfig: &cloudfront.CustomOriginConfig{
+								HTTPPort:             Int64(80),
+								HTTPSPort:            Int64(443),
+								OriginProtocolPolicy: String("http-only"),
+							},
+						}},
+					},
+				},
+			}).ExpectCommandResult("new-distribution-id").ExpectCalls("CreateDistribution").Run(t)
+		})
+	})
+
+	t.Run("wait", func(t *testing.T) {
+		Template("wait distribution id=my-distribution-id timeout=1m").Mock(&cloudfrontMock{
+			GetDistributionFunc: func(input *cloudfront.GetDistributionInput) (*cloudfront.GetDistributionOutput, error) {
+				return &cloudfront.GetDistributionOutput{
+					Distribution: &cloudfront.Distribution{
+						Status: String("Deployed"),
+					},
+				}, nil
+			}}).ExpectInput("GetDistribution", &cloudfront.GetDistributionInput{
+			Id: String("my-distribution-id"),
+		}).ExpectCalls("GetDistribution").Run(t)
+	})
+
 	t.Run("check", func(t *testing.T) {
 		Template("check distribution id=my-distribution-id state=deployed timeout=1").Mock(&cloudfrontMock{
 			GetDistributionFunc: func(input *cloudfront.GetDistributionInput) (*cloudfront.GetDistributionOutput, error) {
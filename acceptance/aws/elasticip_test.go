@@ -56,15 +56,40 @@ func TestElasticip(t *testing.T) {
 	})
 
 	t.Run("detach", func(t *testing.T) {
-		Template("detach elasticip association=ipassoc-12345").
-			Mock(&ec2Mock{
-				DisassociateAddressFunc: func(param0 *ec2.DisassociateAddressInput) (*ec2.DisassociateAddressOutput, error) {
-					return nil, nil
-				},
-			}).ExpectInput("DisassociateAddress", &ec2.DisassociateAddressInput{
-			AssociationId: String("ipassoc-12345"),
-		}).
-			ExpectCalls("DisassociateAddress").Run(t)
-	})
+		t.Run("by association", func(t *testing.T) {
+			Template("detach elasticip association=ipassoc-12345").
+				Mock(&ec2Mock{
+					DescribeAddressesFunc: func(input *ec2.DescribeAddressesInput) (*ec2.DescribeAddressesOutput, error) {
+						return &ec2.DescribeAddressesOutput{Addresses: []*ec2.Address{
+							{AllocationId: String("eipalloc-0123456"), AssociationId: String("ipassoc-12345"), InstanceId: String("i-1234"), NetworkInterfaceId: String("eni-2345"), PrivateIpAddress: String("10.0.0.42")},
+						}}, nil
+					},
+					DisassociateAddressFunc: func(param0 *ec2.DisassociateAddressInput) (*ec2.DisassociateAddressOutput, error) {
+						return nil, nil
+					},
+				}).ExpectInput("DescribeAddresses", &ec2.DescribeAddressesInput{Filters: []*ec2.Filter{
+				{Name: String("association-id"), Values: []*string{String("ipassoc-12345")}},
+			}}).ExpectInput("DisassociateAddress", &ec2.DisassociateAddressInput{
+				AssociationId: String("ipassoc-12345"),
+			}).ExpectCalls("DescribeAddresses", "DisassociateAddress").
+				ExpectRevert("attach elasticip id=eipalloc-0123456 networkinterface=eni-2345 privateip=10.0.0.42").Run(t)
+		})
 
+		t.Run("by id", func(t *testing.T) {
+			Template("detach elasticip id=eipalloc-0123456").
+				Mock(&ec2Mock{
+					DescribeAddressesFunc: func(input *ec2.DescribeAddressesInput) (*ec2.DescribeAddressesOutput, error) {
+						return &ec2.DescribeAddressesOutput{Addresses: []*ec2.Address{
+							{AllocationId: String("eipalloc-0123456"), AssociationId: String("ipassoc-12345"), InstanceId: String("i-1234")},
+						}}, nil
+					},
+					DisassociateAddressFunc: func(param0 *ec2.DisassociateAddressInput) (*ec2.DisassociateAddressOutput, error) {
+						return nil, nil
+					},
+				}).ExpectInput("DescribeAddresses", &ec2.DescribeAddressesInput{AllocationIds: []*string{String("eipalloc-0123456")}}).
+				ExpectInput("DisassociateAddress", &ec2.DisassociateAddressInput{AssociationId: String("ipassoc-12345")}).
+				ExpectCalls("DescribeAddresses", "DescribeAddresses", "DisassociateAddress").
+				ExpectRevert("attach elasticip id=eipalloc-0123456 instance=i-1234").Run(t)
+		})
+	})
 }
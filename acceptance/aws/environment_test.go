@@ -0,0 +1,55 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+)
+
+func TestEnvironment(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create environment application=my-app name=my-app-prod solution-stack='64bit Amazon Linux running Docker' version=v1 cname=my-app "+
+			"options=['aws:autoscaling:asg:MinSize=2','aws:elasticbeanstalk:application:environment:STAGE=prod']").
+			Mock(&elasticbeanstalkMock{
+				CreateEnvironmentFunc: func(param0 *elasticbeanstalk.CreateEnvironmentInput) (*elasticbeanstalk.EnvironmentDescription, error) {
+					return &elasticbeanstalk.EnvironmentDescription{EnvironmentId: String("e-abcd1234")}, nil
+				},
+			}).ExpectInput("CreateEnvironment", &elasticbeanstalk.CreateEnvironmentInput{
+			ApplicationName:   String("my-app"),
+			EnvironmentName:   String("my-app-prod"),
+			SolutionStackName: String("64bit Amazon Linux running Docker"),
+			VersionLabel:      String("v1"),
+			CNAMEPrefix:       String("my-app"),
+			OptionSettings: []*elasticbeanstalk.ConfigurationOptionSetting{
+				{Namespace: String("aws:autoscaling:asg"), OptionName: String("MinSize"), Value: String("2")},
+				{Namespace: String("aws:elasticbeanstalk:application:environment"), OptionName: String("STAGE"), Value: String("prod")},
+			},
+		}).ExpectCommandResult("e-abcd1234").ExpectCalls("CreateEnvironment").
+			ExpectRevert("terminate environment id=e-abcd1234").Run(t)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		Template("update environment id=e-abcd1234 version=v2 options='aws:autoscaling:asg:MaxSize=8'").
+			Mock(&elasticbeanstalkMock{
+				UpdateEnvironmentFunc: func(param0 *elasticbeanstalk.UpdateEnvironmentInput) (*elasticbeanstalk.EnvironmentDescription, error) {
+					return &elasticbeanstalk.EnvironmentDescription{EnvironmentId: String("e-abcd1234")}, nil
+				},
+			}).ExpectInput("UpdateEnvironment", &elasticbeanstalk.UpdateEnvironmentInput{
+			EnvironmentId: String("e-abcd1234"),
+			VersionLabel:  String("v2"),
+			OptionSettings: []*elasticbeanstalk.ConfigurationOptionSetting{
+				{Namespace: String("aws:autoscaling:asg"), OptionName: String("MaxSize"), Value: String("8")},
+			},
+		}).ExpectCommandResult("e-abcd1234").ExpectCalls("UpdateEnvironment").Run(t)
+	})
+
+	t.Run("terminate", func(t *testing.T) {
+		Template("terminate environment id=e-abcd1234").
+			Mock(&elasticbeanstalkMock{
+				TerminateEnvironmentFunc: func(param0 *elasticbeanstalk.TerminateEnvironmentInput) (*elasticbeanstalk.EnvironmentDescription, error) {
+					return &elasticbeanstalk.EnvironmentDescription{EnvironmentId: String("e-abcd1234"), Status: String("Terminating")}, nil
+				},
+			}).ExpectInput("TerminateEnvironment", &elasticbeanstalk.TerminateEnvironmentInput{EnvironmentId: String("e-abcd1234")}).
+			ExpectCalls("TerminateEnvironment").Run(t)
+	})
+}
@@ -9,6 +9,9 @@ import (
 	"os"
 
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
 )
 
 func TestFunction(t *testing.T) {
@@ -61,6 +64,81 @@ func TestFunction(t *testing.T) {
 				},
 			}).ExpectCommandResult("new-function-id").ExpectCalls("CreateFunction").Run(t)
 		})
+		t.Run("with role name and environment", func(t *testing.T) {
+			g := graph.NewGraph()
+			g.AddResource(resourcetest.Role("AROA1234").Prop(properties.Name, "lambda-role").Prop(properties.Arn, "arn:aws:iam::0123456789:role/lambda-role").Build())
+			Template("fn = create function name=my-function-name handler=index.handler role=lambda-role runtime=nodejs6.10 "+
+				"bucket=my-function-bucket object=function.zip environment=[STAGE:prod,URL:http://host:8080]\n"+
+				"invoke function id=$fn").
+				Mock(&lambdaMock{
+					CreateFunctionFunc: func(param0 *lambda.CreateFunctionInput) (*lambda.FunctionConfiguration, error) {
+						return &lambda.FunctionConfiguration{FunctionArn: String("new-function-arn")}, nil
+					},
+					InvokeFunc: func(param0 *lambda.InvokeInput) (*lambda.InvokeOutput, error) {
+						return &lambda.InvokeOutput{Payload: []byte("\"ok\"")}, nil
+					},
+				}).Graph(g).ExpectInput("CreateFunction", &lambda.CreateFunctionInput{
+				FunctionName: String("my-function-name"),
+				Handler:      String("index.handler"),
+				Role:         String("arn:aws:iam::0123456789:role/lambda-role"),
+				Runtime:      String("nodejs6.10"),
+				Code: &lambda.FunctionCode{
+					S3Bucket: String("my-function-bucket"),
+					S3Key:    String("function.zip"),
+				},
+				Environment: &lambda.Environment{Variables: map[string]*string{"STAGE": String("prod"), "URL": String("http://host:8080")}},
+			}).ExpectInput("Invoke", &lambda.InvokeInput{
+				FunctionName:   String("new-function-arn"),
+				InvocationType: String("RequestResponse"),
+			}).ExpectCommandResult("new-function-arn").ExpectCalls("CreateFunction", "Invoke").
+				ExpectRevert("delete function id=new-function-arn").Run(t)
+		})
+	})
+
+	t.Run("update", func(t *testing.T) {
+		t.Run("code", func(t *testing.T) {
+			Template("update function id=my-function bucket=my-bucket object=function-v2.zip publish=true").
+				Mock(&lambdaMock{
+					UpdateFunctionCodeFunc: func(param0 *lambda.UpdateFunctionCodeInput) (*lambda.FunctionConfiguration, error) {
+						return &lambda.FunctionConfiguration{FunctionArn: String("my-function-arn")}, nil
+					},
+				}).ExpectInput("UpdateFunctionCode", &lambda.UpdateFunctionCodeInput{
+				FunctionName: String("my-function"),
+				S3Bucket:     String("my-bucket"),
+				S3Key:        String("function-v2.zip"),
+				Publish:      Bool(true),
+			}).ExpectCommandResult("my-function-arn").ExpectCalls("UpdateFunctionCode").Run(t)
+		})
+		t.Run("configuration", func(t *testing.T) {
+			Template("update function id=my-function memory=256 timeout=30").
+				Mock(&lambdaMock{
+					GetFunctionConfigurationFunc: func(param0 *lambda.GetFunctionConfigurationInput) (*lambda.FunctionConfiguration, error) {
+						return &lambda.FunctionConfiguration{MemorySize: Int64(128), Timeout: Int64(3), Handler: String("index.handler")}, nil
+					},
+					UpdateFunctionConfigurationFunc: func(param0 *lambda.UpdateFunctionConfigurationInput) (*lambda.FunctionConfiguration, error) {
+						return &lambda.FunctionConfiguration{FunctionArn: String("my-function-arn")}, nil
+					},
+				}).ExpectInput("UpdateFunctionConfiguration", &lambda.UpdateFunctionConfigurationInput{
+				FunctionName: String("my-function"),
+				MemorySize:   Int64(256),
+				Timeout:      Int64(30),
+			}).IgnoreInput("GetFunctionConfiguration").ExpectCommandResult("my-function-arn").
+				ExpectCalls("GetFunctionConfiguration", "UpdateFunctionConfiguration").
+				ExpectRevert("update function id=my-function memory=128 timeout=3").Run(t)
+		})
+	})
+
+	t.Run("invoke async", func(t *testing.T) {
+		Template("invoke function id=my-function payload='{\"key\": 1}' async=true").
+			Mock(&lambdaMock{
+				InvokeFunc: func(param0 *lambda.InvokeInput) (*lambda.InvokeOutput, error) {
+					return &lambda.InvokeOutput{StatusCode: Int64(202)}, nil
+				},
+			}).ExpectInput("Invoke", &lambda.InvokeInput{
+			FunctionName:   String("my-function"),
+			InvocationType: String("Event"),
+			Payload:        []byte(`{"key": 1}`),
+		}).ExpectCommandResult("my-function").ExpectCalls("Invoke").Run(t)
 	})
 
 	t.Run("delete", func(t *testing.T) {
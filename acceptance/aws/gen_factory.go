@@ -19,22 +19,41 @@ package awsat
 
 import (
 	"github.com/aws/aws-sdk-go/service/acm/acmiface"
+	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling/applicationautoscalingiface"
+	"github.com/aws/aws-sdk-go/service/athena/athenaiface"
 	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
+	"github.com/aws/aws-sdk-go/service/batch/batchiface"
 	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
+	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
 	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents/cloudwatcheventsiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
 	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk/elasticbeanstalkiface"
+	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"github.com/aws/aws-sdk-go/service/glue/glueiface"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/aws/aws-sdk-go/service/organizations/organizationsiface"
 	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/aws/aws-sdk-go/service/redshift/redshiftiface"
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/wallix/awless/aws/ec2transitgateway/ec2transitgatewayiface"
+	"github.com/wallix/awless/aws/eks/eksiface"
+	"github.com/wallix/awless/aws/secretsmanager/secretsmanageriface"
 	"github.com/wallix/awless/aws/spec"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
@@ -56,12 +75,24 @@ func NewAcceptanceFactory(mock interface{}, g cloud.GraphAPI, l ...*logger.Logge
 
 func (f *AcceptanceFactory) Build(key string) func() interface{} {
 	switch key {
+	case "acceptpeeringconnection":
+		return func() interface{} {
+			cmd := awsspec.NewAcceptPeeringconnection(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "attachalarm":
 		return func() interface{} {
 			cmd := awsspec.NewAttachAlarm(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(cloudwatchiface.CloudWatchAPI))
 			return cmd
 		}
+	case "attachclassicloadbalancer":
+		return func() interface{} {
+			cmd := awsspec.NewAttachClassicloadbalancer(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elbiface.ELBAPI))
+			return cmd
+		}
 	case "attachcontainertask":
 		return func() interface{} {
 			cmd := awsspec.NewAttachContainertask(nil, f.Graph, f.Logger)
@@ -104,6 +135,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(iamiface.IAMAPI))
 			return cmd
 		}
+	case "attachnetworkacl":
+		return func() interface{} {
+			cmd := awsspec.NewAttachNetworkacl(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "attachnetworkinterface":
 		return func() interface{} {
 			cmd := awsspec.NewAttachNetworkinterface(nil, f.Graph, f.Logger)
@@ -134,6 +171,24 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "attachservicecontrolpolicy":
+		return func() interface{} {
+			cmd := awsspec.NewAttachServicecontrolpolicy(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(organizationsiface.OrganizationsAPI))
+			return cmd
+		}
+	case "attachtarget":
+		return func() interface{} {
+			cmd := awsspec.NewAttachTarget(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(cloudwatcheventsiface.CloudWatchEventsAPI))
+			return cmd
+		}
+	case "attachtransitgatewayroutetable":
+		return func() interface{} {
+			cmd := awsspec.NewAttachTransitgatewayroutetable(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2transitgatewayiface.EC2TransitGatewayAPI))
+			return cmd
+		}
 	case "attachuser":
 		return func() interface{} {
 			cmd := awsspec.NewAttachUser(nil, f.Graph, f.Logger)
@@ -146,18 +201,42 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "attachvpngateway":
+		return func() interface{} {
+			cmd := awsspec.NewAttachVpngateway(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "authenticateregistry":
 		return func() interface{} {
 			cmd := awsspec.NewAuthenticateRegistry(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(ecriface.ECRAPI))
 			return cmd
 		}
+	case "cancelspotrequest":
+		return func() interface{} {
+			cmd := awsspec.NewCancelSpotrequest(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
+	case "checkcachecluster":
+		return func() interface{} {
+			cmd := awsspec.NewCheckCachecluster(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticacheiface.ElastiCacheAPI))
+			return cmd
+		}
 	case "checkcertificate":
 		return func() interface{} {
 			cmd := awsspec.NewCheckCertificate(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(acmiface.ACMAPI))
 			return cmd
 		}
+	case "checkcluster":
+		return func() interface{} {
+			cmd := awsspec.NewCheckCluster(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(redshiftiface.RedshiftAPI))
+			return cmd
+		}
 	case "checkdatabase":
 		return func() interface{} {
 			cmd := awsspec.NewCheckDatabase(nil, f.Graph, f.Logger)
@@ -170,12 +249,24 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(cloudfrontiface.CloudFrontAPI))
 			return cmd
 		}
+	case "checkhttp":
+		return func() interface{} {
+			cmd := awsspec.NewCheckHttp(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elbv2iface.ELBV2API))
+			return cmd
+		}
 	case "checkinstance":
 		return func() interface{} {
 			cmd := awsspec.NewCheckInstance(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "checkkubernetescluster":
+		return func() interface{} {
+			cmd := awsspec.NewCheckKubernetescluster(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(eksiface.EKSAPI))
+			return cmd
+		}
 	case "checkloadbalancer":
 		return func() interface{} {
 			cmd := awsspec.NewCheckLoadbalancer(nil, f.Graph, f.Logger)
@@ -206,12 +297,42 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "checktcp":
+		return func() interface{} {
+			cmd := awsspec.NewCheckTcp(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
+	case "checktransitgateway":
+		return func() interface{} {
+			cmd := awsspec.NewCheckTransitgateway(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2transitgatewayiface.EC2TransitGatewayAPI))
+			return cmd
+		}
+	case "checktransitgatewayattachment":
+		return func() interface{} {
+			cmd := awsspec.NewCheckTransitgatewayattachment(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2transitgatewayiface.EC2TransitGatewayAPI))
+			return cmd
+		}
+	case "checktransitgatewayroutetable":
+		return func() interface{} {
+			cmd := awsspec.NewCheckTransitgatewayroutetable(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2transitgatewayiface.EC2TransitGatewayAPI))
+			return cmd
+		}
 	case "checkvolume":
 		return func() interface{} {
 			cmd := awsspec.NewCheckVolume(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "checkvpnconnection":
+		return func() interface{} {
+			cmd := awsspec.NewCheckVpnconnection(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "copyimage":
 		return func() interface{} {
 			cmd := awsspec.NewCopyImage(nil, f.Graph, f.Logger)
@@ -230,12 +351,30 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(iamiface.IAMAPI))
 			return cmd
 		}
+	case "createaccount":
+		return func() interface{} {
+			cmd := awsspec.NewCreateAccount(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(organizationsiface.OrganizationsAPI))
+			return cmd
+		}
 	case "createalarm":
 		return func() interface{} {
 			cmd := awsspec.NewCreateAlarm(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(cloudwatchiface.CloudWatchAPI))
 			return cmd
 		}
+	case "createalias":
+		return func() interface{} {
+			cmd := awsspec.NewCreateAlias(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(kmsiface.KMSAPI))
+			return cmd
+		}
+	case "createapplication":
+		return func() interface{} {
+			cmd := awsspec.NewCreateApplication(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticbeanstalkiface.ElasticBeanstalkAPI))
+			return cmd
+		}
 	case "createappscalingpolicy":
 		return func() interface{} {
 			cmd := awsspec.NewCreateAppscalingpolicy(nil, f.Graph, f.Logger)
@@ -254,54 +393,150 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(s3iface.S3API))
 			return cmd
 		}
+	case "createcachecluster":
+		return func() interface{} {
+			cmd := awsspec.NewCreateCachecluster(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticacheiface.ElastiCacheAPI))
+			return cmd
+		}
+	case "createcachesubnetgroup":
+		return func() interface{} {
+			cmd := awsspec.NewCreateCachesubnetgroup(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticacheiface.ElastiCacheAPI))
+			return cmd
+		}
+	case "createcatalogdatabase":
+		return func() interface{} {
+			cmd := awsspec.NewCreateCatalogdatabase(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(glueiface.GlueAPI))
+			return cmd
+		}
 	case "createcertificate":
 		return func() interface{} {
 			cmd := awsspec.NewCreateCertificate(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(acmiface.ACMAPI))
 			return cmd
 		}
+	case "createclassicloadbalancer":
+		return func() interface{} {
+			cmd := awsspec.NewCreateClassicloadbalancer(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elbiface.ELBAPI))
+			return cmd
+		}
+	case "createcluster":
+		return func() interface{} {
+			cmd := awsspec.NewCreateCluster(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(redshiftiface.RedshiftAPI))
+			return cmd
+		}
+	case "createcomputeenvironment":
+		return func() interface{} {
+			cmd := awsspec.NewCreateComputeenvironment(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(batchiface.BatchAPI))
+			return cmd
+		}
 	case "createcontainercluster":
 		return func() interface{} {
 			cmd := awsspec.NewCreateContainercluster(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(ecsiface.ECSAPI))
 			return cmd
 		}
+	case "createcontainerservice":
+		return func() interface{} {
+			cmd := awsspec.NewCreateContainerservice(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ecsiface.ECSAPI))
+			return cmd
+		}
+	case "createcrawler":
+		return func() interface{} {
+			cmd := awsspec.NewCreateCrawler(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(glueiface.GlueAPI))
+			return cmd
+		}
+	case "createcustomergateway":
+		return func() interface{} {
+			cmd := awsspec.NewCreateCustomergateway(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "createdatabase":
 		return func() interface{} {
 			cmd := awsspec.NewCreateDatabase(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(rdsiface.RDSAPI))
 			return cmd
 		}
+	case "createdbparametergroup":
+		return func() interface{} {
+			cmd := awsspec.NewCreateDbparametergroup(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(rdsiface.RDSAPI))
+			return cmd
+		}
 	case "createdbsubnetgroup":
 		return func() interface{} {
 			cmd := awsspec.NewCreateDbsubnetgroup(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(rdsiface.RDSAPI))
 			return cmd
 		}
+	case "createdeployment":
+		return func() interface{} {
+			cmd := awsspec.NewCreateDeployment(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(apigatewayiface.APIGatewayAPI))
+			return cmd
+		}
 	case "createdistribution":
 		return func() interface{} {
 			cmd := awsspec.NewCreateDistribution(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(cloudfrontiface.CloudFrontAPI))
 			return cmd
 		}
+	case "createegressonlyinternetgateway":
+		return func() interface{} {
+			cmd := awsspec.NewCreateEgressonlyinternetgateway(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "createelasticip":
 		return func() interface{} {
 			cmd := awsspec.NewCreateElasticip(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "createenvironment":
+		return func() interface{} {
+			cmd := awsspec.NewCreateEnvironment(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticbeanstalkiface.ElasticBeanstalkAPI))
+			return cmd
+		}
 	case "createfunction":
 		return func() interface{} {
 			cmd := awsspec.NewCreateFunction(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(lambdaiface.LambdaAPI))
 			return cmd
 		}
+	case "creategrant":
+		return func() interface{} {
+			cmd := awsspec.NewCreateGrant(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(kmsiface.KMSAPI))
+			return cmd
+		}
 	case "creategroup":
 		return func() interface{} {
 			cmd := awsspec.NewCreateGroup(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(iamiface.IAMAPI))
 			return cmd
 		}
+	case "createhost":
+		return func() interface{} {
+			cmd := awsspec.NewCreateHost(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
+	case "createhostreservation":
+		return func() interface{} {
+			cmd := awsspec.NewCreateHostreservation(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "createimage":
 		return func() interface{} {
 			cmd := awsspec.NewCreateImage(nil, f.Graph, f.Logger)
@@ -326,12 +561,36 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "createinvalidation":
+		return func() interface{} {
+			cmd := awsspec.NewCreateInvalidation(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(cloudfrontiface.CloudFrontAPI))
+			return cmd
+		}
+	case "createjobqueue":
+		return func() interface{} {
+			cmd := awsspec.NewCreateJobqueue(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(batchiface.BatchAPI))
+			return cmd
+		}
+	case "createkey":
+		return func() interface{} {
+			cmd := awsspec.NewCreateKey(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(kmsiface.KMSAPI))
+			return cmd
+		}
 	case "createkeypair":
 		return func() interface{} {
 			cmd := awsspec.NewCreateKeypair(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "createkubernetescluster":
+		return func() interface{} {
+			cmd := awsspec.NewCreateKubernetescluster(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(eksiface.EKSAPI))
+			return cmd
+		}
 	case "createlaunchconfiguration":
 		return func() interface{} {
 			cmd := awsspec.NewCreateLaunchconfiguration(nil, f.Graph, f.Logger)
@@ -350,12 +609,24 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(elbv2iface.ELBV2API))
 			return cmd
 		}
+	case "createloggroup":
+		return func() interface{} {
+			cmd := awsspec.NewCreateLoggroup(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(cloudwatchlogsiface.CloudWatchLogsAPI))
+			return cmd
+		}
 	case "createloginprofile":
 		return func() interface{} {
 			cmd := awsspec.NewCreateLoginprofile(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(iamiface.IAMAPI))
 			return cmd
 		}
+	case "createmethod":
+		return func() interface{} {
+			cmd := awsspec.NewCreateMethod(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(apigatewayiface.APIGatewayAPI))
+			return cmd
+		}
 	case "createmfadevice":
 		return func() interface{} {
 			cmd := awsspec.NewCreateMfadevice(nil, f.Graph, f.Logger)
@@ -368,18 +639,60 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "createnetworkacl":
+		return func() interface{} {
+			cmd := awsspec.NewCreateNetworkacl(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
+	case "createnetworkaclrule":
+		return func() interface{} {
+			cmd := awsspec.NewCreateNetworkaclrule(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "createnetworkinterface":
 		return func() interface{} {
 			cmd := awsspec.NewCreateNetworkinterface(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "createparameter":
+		return func() interface{} {
+			cmd := awsspec.NewCreateParameter(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ssmiface.SSMAPI))
+			return cmd
+		}
+	case "createpeeringconnection":
+		return func() interface{} {
+			cmd := awsspec.NewCreatePeeringconnection(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
+	case "createplacementgroup":
+		return func() interface{} {
+			cmd := awsspec.NewCreatePlacementgroup(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "createpolicy":
 		return func() interface{} {
 			cmd := awsspec.NewCreatePolicy(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(iamiface.IAMAPI))
 			return cmd
 		}
+	case "createpolicyversion":
+		return func() interface{} {
+			cmd := awsspec.NewCreatePolicyversion(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(iamiface.IAMAPI))
+			return cmd
+		}
+	case "createpresignedurl":
+		return func() interface{} {
+			cmd := awsspec.NewCreatePresignedurl(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(s3iface.S3API))
+			return cmd
+		}
 	case "createqueue":
 		return func() interface{} {
 			cmd := awsspec.NewCreateQueue(nil, f.Graph, f.Logger)
@@ -398,6 +711,18 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ecriface.ECRAPI))
 			return cmd
 		}
+	case "createresource":
+		return func() interface{} {
+			cmd := awsspec.NewCreateResource(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(apigatewayiface.APIGatewayAPI))
+			return cmd
+		}
+	case "createrestapi":
+		return func() interface{} {
+			cmd := awsspec.NewCreateRestapi(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(apigatewayiface.APIGatewayAPI))
+			return cmd
+		}
 	case "createrole":
 		return func() interface{} {
 			cmd := awsspec.NewCreateRole(nil, f.Graph, f.Logger)
@@ -416,6 +741,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "createrule":
+		return func() interface{} {
+			cmd := awsspec.NewCreateRule(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(cloudwatcheventsiface.CloudWatchEventsAPI))
+			return cmd
+		}
 	case "creates3object":
 		return func() interface{} {
 			cmd := awsspec.NewCreateS3object(nil, f.Graph, f.Logger)
@@ -434,6 +765,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(autoscalingiface.AutoScalingAPI))
 			return cmd
 		}
+	case "createsecret":
+		return func() interface{} {
+			cmd := awsspec.NewCreateSecret(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(secretsmanageriface.SecretsManagerAPI))
+			return cmd
+		}
 	case "createsecuritygroup":
 		return func() interface{} {
 			cmd := awsspec.NewCreateSecuritygroup(nil, f.Graph, f.Logger)
@@ -446,12 +783,36 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "createspotfleet":
+		return func() interface{} {
+			cmd := awsspec.NewCreateSpotfleet(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
+	case "createspotinstance":
+		return func() interface{} {
+			cmd := awsspec.NewCreateSpotinstance(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "createstack":
 		return func() interface{} {
 			cmd := awsspec.NewCreateStack(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(cloudformationiface.CloudFormationAPI))
 			return cmd
 		}
+	case "createstage":
+		return func() interface{} {
+			cmd := awsspec.NewCreateStage(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(apigatewayiface.APIGatewayAPI))
+			return cmd
+		}
+	case "createstatemachine":
+		return func() interface{} {
+			cmd := awsspec.NewCreateStatemachine(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(sfniface.SFNAPI))
+			return cmd
+		}
 	case "createsubnet":
 		return func() interface{} {
 			cmd := awsspec.NewCreateSubnet(nil, f.Graph, f.Logger)
@@ -464,6 +825,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(snsiface.SNSAPI))
 			return cmd
 		}
+	case "createtable":
+		return func() interface{} {
+			cmd := awsspec.NewCreateTable(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(dynamodbiface.DynamoDBAPI))
+			return cmd
+		}
 	case "createtag":
 		return func() interface{} {
 			cmd := awsspec.NewCreateTag(nil, f.Graph, f.Logger)
@@ -482,6 +849,30 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(snsiface.SNSAPI))
 			return cmd
 		}
+	case "createtrail":
+		return func() interface{} {
+			cmd := awsspec.NewCreateTrail(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(cloudtrailiface.CloudTrailAPI))
+			return cmd
+		}
+	case "createtransitgateway":
+		return func() interface{} {
+			cmd := awsspec.NewCreateTransitgateway(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2transitgatewayiface.EC2TransitGatewayAPI))
+			return cmd
+		}
+	case "createtransitgatewayattachment":
+		return func() interface{} {
+			cmd := awsspec.NewCreateTransitgatewayattachment(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2transitgatewayiface.EC2TransitGatewayAPI))
+			return cmd
+		}
+	case "createtransitgatewayroutetable":
+		return func() interface{} {
+			cmd := awsspec.NewCreateTransitgatewayroutetable(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2transitgatewayiface.EC2TransitGatewayAPI))
+			return cmd
+		}
 	case "createuser":
 		return func() interface{} {
 			cmd := awsspec.NewCreateUser(nil, f.Graph, f.Logger)
@@ -500,6 +891,24 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "createvpcendpoint":
+		return func() interface{} {
+			cmd := awsspec.NewCreateVpcendpoint(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
+	case "createvpnconnection":
+		return func() interface{} {
+			cmd := awsspec.NewCreateVpnconnection(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
+	case "createvpngateway":
+		return func() interface{} {
+			cmd := awsspec.NewCreateVpngateway(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "createzone":
 		return func() interface{} {
 			cmd := awsspec.NewCreateZone(nil, f.Graph, f.Logger)
@@ -518,6 +927,18 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(cloudwatchiface.CloudWatchAPI))
 			return cmd
 		}
+	case "deletealias":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteAlias(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(kmsiface.KMSAPI))
+			return cmd
+		}
+	case "deleteapplication":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteApplication(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticbeanstalkiface.ElasticBeanstalkAPI))
+			return cmd
+		}
 	case "deleteappscalingpolicy":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteAppscalingpolicy(nil, f.Graph, f.Logger)
@@ -536,42 +957,114 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(s3iface.S3API))
 			return cmd
 		}
+	case "deletecachecluster":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteCachecluster(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticacheiface.ElastiCacheAPI))
+			return cmd
+		}
+	case "deletecachesubnetgroup":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteCachesubnetgroup(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticacheiface.ElastiCacheAPI))
+			return cmd
+		}
+	case "deletecatalogdatabase":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteCatalogdatabase(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(glueiface.GlueAPI))
+			return cmd
+		}
 	case "deletecertificate":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteCertificate(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(acmiface.ACMAPI))
 			return cmd
 		}
+	case "deleteclassicloadbalancer":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteClassicloadbalancer(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elbiface.ELBAPI))
+			return cmd
+		}
+	case "deletecluster":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteCluster(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(redshiftiface.RedshiftAPI))
+			return cmd
+		}
+	case "deletecomputeenvironment":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteComputeenvironment(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(batchiface.BatchAPI))
+			return cmd
+		}
 	case "deletecontainercluster":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteContainercluster(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(ecsiface.ECSAPI))
 			return cmd
 		}
+	case "deletecontainerservice":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteContainerservice(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ecsiface.ECSAPI))
+			return cmd
+		}
 	case "deletecontainertask":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteContainertask(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(ecsiface.ECSAPI))
 			return cmd
 		}
+	case "deletecrawler":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteCrawler(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(glueiface.GlueAPI))
+			return cmd
+		}
+	case "deletecustomergateway":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteCustomergateway(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "deletedatabase":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteDatabase(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(rdsiface.RDSAPI))
 			return cmd
 		}
+	case "deletedbparametergroup":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteDbparametergroup(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(rdsiface.RDSAPI))
+			return cmd
+		}
 	case "deletedbsubnetgroup":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteDbsubnetgroup(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(rdsiface.RDSAPI))
 			return cmd
 		}
+	case "deletedeployment":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteDeployment(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(apigatewayiface.APIGatewayAPI))
+			return cmd
+		}
 	case "deletedistribution":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteDistribution(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(cloudfrontiface.CloudFrontAPI))
 			return cmd
 		}
+	case "deleteegressonlyinternetgateway":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteEgressonlyinternetgateway(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "deleteelasticip":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteElasticip(nil, f.Graph, f.Logger)
@@ -584,12 +1077,24 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(lambdaiface.LambdaAPI))
 			return cmd
 		}
+	case "deletegrant":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteGrant(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(kmsiface.KMSAPI))
+			return cmd
+		}
 	case "deletegroup":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteGroup(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(iamiface.IAMAPI))
 			return cmd
 		}
+	case "deletehost":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteHost(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "deleteimage":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteImage(nil, f.Graph, f.Logger)
@@ -614,12 +1119,36 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "deletejobdefinition":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteJobdefinition(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(batchiface.BatchAPI))
+			return cmd
+		}
+	case "deletejobqueue":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteJobqueue(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(batchiface.BatchAPI))
+			return cmd
+		}
+	case "deletekey":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteKey(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(kmsiface.KMSAPI))
+			return cmd
+		}
 	case "deletekeypair":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteKeypair(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "deletekubernetescluster":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteKubernetescluster(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(eksiface.EKSAPI))
+			return cmd
+		}
 	case "deletelaunchconfiguration":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteLaunchconfiguration(nil, f.Graph, f.Logger)
@@ -638,12 +1167,24 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(elbv2iface.ELBV2API))
 			return cmd
 		}
+	case "deleteloggroup":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteLoggroup(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(cloudwatchlogsiface.CloudWatchLogsAPI))
+			return cmd
+		}
 	case "deleteloginprofile":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteLoginprofile(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(iamiface.IAMAPI))
 			return cmd
 		}
+	case "deletemethod":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteMethod(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(apigatewayiface.APIGatewayAPI))
+			return cmd
+		}
 	case "deletemfadevice":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteMfadevice(nil, f.Graph, f.Logger)
@@ -656,18 +1197,54 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "deletenetworkacl":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteNetworkacl(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
+	case "deletenetworkaclrule":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteNetworkaclrule(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "deletenetworkinterface":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteNetworkinterface(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "deleteparameter":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteParameter(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ssmiface.SSMAPI))
+			return cmd
+		}
+	case "deletepeeringconnection":
+		return func() interface{} {
+			cmd := awsspec.NewDeletePeeringconnection(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
+	case "deleteplacementgroup":
+		return func() interface{} {
+			cmd := awsspec.NewDeletePlacementgroup(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "deletepolicy":
 		return func() interface{} {
 			cmd := awsspec.NewDeletePolicy(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(iamiface.IAMAPI))
 			return cmd
 		}
+	case "deletepolicyversion":
+		return func() interface{} {
+			cmd := awsspec.NewDeletePolicyversion(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(iamiface.IAMAPI))
+			return cmd
+		}
 	case "deletequeue":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteQueue(nil, f.Graph, f.Logger)
@@ -686,6 +1263,18 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ecriface.ECRAPI))
 			return cmd
 		}
+	case "deleteresource":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteResource(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(apigatewayiface.APIGatewayAPI))
+			return cmd
+		}
+	case "deleterestapi":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteRestapi(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(apigatewayiface.APIGatewayAPI))
+			return cmd
+		}
 	case "deleterole":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteRole(nil, f.Graph, f.Logger)
@@ -704,6 +1293,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "deleterule":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteRule(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(cloudwatcheventsiface.CloudWatchEventsAPI))
+			return cmd
+		}
 	case "deletes3object":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteS3object(nil, f.Graph, f.Logger)
@@ -722,6 +1317,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(autoscalingiface.AutoScalingAPI))
 			return cmd
 		}
+	case "deletesecret":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteSecret(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(secretsmanageriface.SecretsManagerAPI))
+			return cmd
+		}
 	case "deletesecuritygroup":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteSecuritygroup(nil, f.Graph, f.Logger)
@@ -740,6 +1341,18 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(cloudformationiface.CloudFormationAPI))
 			return cmd
 		}
+	case "deletestage":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteStage(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(apigatewayiface.APIGatewayAPI))
+			return cmd
+		}
+	case "deletestatemachine":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteStatemachine(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(sfniface.SFNAPI))
+			return cmd
+		}
 	case "deletesubnet":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteSubnet(nil, f.Graph, f.Logger)
@@ -752,6 +1365,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(snsiface.SNSAPI))
 			return cmd
 		}
+	case "deletetable":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteTable(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(dynamodbiface.DynamoDBAPI))
+			return cmd
+		}
 	case "deletetag":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteTag(nil, f.Graph, f.Logger)
@@ -770,6 +1389,30 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(snsiface.SNSAPI))
 			return cmd
 		}
+	case "deletetrail":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteTrail(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(cloudtrailiface.CloudTrailAPI))
+			return cmd
+		}
+	case "deletetransitgateway":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteTransitgateway(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2transitgatewayiface.EC2TransitGatewayAPI))
+			return cmd
+		}
+	case "deletetransitgatewayattachment":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteTransitgatewayattachment(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2transitgatewayiface.EC2TransitGatewayAPI))
+			return cmd
+		}
+	case "deletetransitgatewayroutetable":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteTransitgatewayroutetable(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2transitgatewayiface.EC2TransitGatewayAPI))
+			return cmd
+		}
 	case "deleteuser":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteUser(nil, f.Graph, f.Logger)
@@ -788,6 +1431,24 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "deletevpcendpoint":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteVpcendpoint(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
+	case "deletevpnconnection":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteVpnconnection(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
+	case "deletevpngateway":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteVpngateway(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "deletezone":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteZone(nil, f.Graph, f.Logger)
@@ -800,6 +1461,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(cloudwatchiface.CloudWatchAPI))
 			return cmd
 		}
+	case "detachclassicloadbalancer":
+		return func() interface{} {
+			cmd := awsspec.NewDetachClassicloadbalancer(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elbiface.ELBAPI))
+			return cmd
+		}
 	case "detachcontainertask":
 		return func() interface{} {
 			cmd := awsspec.NewDetachContainertask(nil, f.Graph, f.Logger)
@@ -836,6 +1503,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(iamiface.IAMAPI))
 			return cmd
 		}
+	case "detachnetworkacl":
+		return func() interface{} {
+			cmd := awsspec.NewDetachNetworkacl(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "detachnetworkinterface":
 		return func() interface{} {
 			cmd := awsspec.NewDetachNetworkinterface(nil, f.Graph, f.Logger)
@@ -866,6 +1539,24 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "detachservicecontrolpolicy":
+		return func() interface{} {
+			cmd := awsspec.NewDetachServicecontrolpolicy(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(organizationsiface.OrganizationsAPI))
+			return cmd
+		}
+	case "detachtarget":
+		return func() interface{} {
+			cmd := awsspec.NewDetachTarget(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(cloudwatcheventsiface.CloudWatchEventsAPI))
+			return cmd
+		}
+	case "detachtransitgatewayroutetable":
+		return func() interface{} {
+			cmd := awsspec.NewDetachTransitgatewayroutetable(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2transitgatewayiface.EC2TransitGatewayAPI))
+			return cmd
+		}
 	case "detachuser":
 		return func() interface{} {
 			cmd := awsspec.NewDetachUser(nil, f.Graph, f.Logger)
@@ -878,12 +1569,78 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "detachvpngateway":
+		return func() interface{} {
+			cmd := awsspec.NewDetachVpngateway(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
+	case "disablekey":
+		return func() interface{} {
+			cmd := awsspec.NewDisableKey(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(kmsiface.KMSAPI))
+			return cmd
+		}
+	case "downloads3object":
+		return func() interface{} {
+			cmd := awsspec.NewDownloadS3object(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(s3iface.S3API))
+			return cmd
+		}
+	case "enablekey":
+		return func() interface{} {
+			cmd := awsspec.NewEnableKey(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(kmsiface.KMSAPI))
+			return cmd
+		}
+	case "getconsoleoutput":
+		return func() interface{} {
+			cmd := awsspec.NewGetConsoleoutput(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
+	case "getscreenshot":
+		return func() interface{} {
+			cmd := awsspec.NewGetScreenshot(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "importimage":
 		return func() interface{} {
 			cmd := awsspec.NewImportImage(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "invokefunction":
+		return func() interface{} {
+			cmd := awsspec.NewInvokeFunction(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(lambdaiface.LambdaAPI))
+			return cmd
+		}
+	case "moveaccount":
+		return func() interface{} {
+			cmd := awsspec.NewMoveAccount(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(organizationsiface.OrganizationsAPI))
+			return cmd
+		}
+	case "rebootinstance":
+		return func() interface{} {
+			cmd := awsspec.NewRebootInstance(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
+	case "registerjobdefinition":
+		return func() interface{} {
+			cmd := awsspec.NewRegisterJobdefinition(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(batchiface.BatchAPI))
+			return cmd
+		}
+	case "resizecluster":
+		return func() interface{} {
+			cmd := awsspec.NewResizeCluster(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(redshiftiface.RedshiftAPI))
+			return cmd
+		}
 	case "restartdatabase":
 		return func() interface{} {
 			cmd := awsspec.NewRestartDatabase(nil, f.Graph, f.Logger)
@@ -896,6 +1653,24 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "restoredatabase":
+		return func() interface{} {
+			cmd := awsspec.NewRestoreDatabase(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(rdsiface.RDSAPI))
+			return cmd
+		}
+	case "restorevolume":
+		return func() interface{} {
+			cmd := awsspec.NewRestoreVolume(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
+	case "runcommand":
+		return func() interface{} {
+			cmd := awsspec.NewRunCommand(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ssmiface.SSMAPI))
+			return cmd
+		}
 	case "startalarm":
 		return func() interface{} {
 			cmd := awsspec.NewStartAlarm(nil, f.Graph, f.Logger)
@@ -914,12 +1689,24 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(rdsiface.RDSAPI))
 			return cmd
 		}
+	case "startexecution":
+		return func() interface{} {
+			cmd := awsspec.NewStartExecution(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(sfniface.SFNAPI))
+			return cmd
+		}
 	case "startinstance":
 		return func() interface{} {
 			cmd := awsspec.NewStartInstance(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "startquery":
+		return func() interface{} {
+			cmd := awsspec.NewStartQuery(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(athenaiface.AthenaAPI))
+			return cmd
+		}
 	case "stopalarm":
 		return func() interface{} {
 			cmd := awsspec.NewStopAlarm(nil, f.Graph, f.Logger)
@@ -938,18 +1725,42 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(rdsiface.RDSAPI))
 			return cmd
 		}
+	case "stopexecution":
+		return func() interface{} {
+			cmd := awsspec.NewStopExecution(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(sfniface.SFNAPI))
+			return cmd
+		}
 	case "stopinstance":
 		return func() interface{} {
 			cmd := awsspec.NewStopInstance(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "submitjob":
+		return func() interface{} {
+			cmd := awsspec.NewSubmitJob(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(batchiface.BatchAPI))
+			return cmd
+		}
+	case "terminateenvironment":
+		return func() interface{} {
+			cmd := awsspec.NewTerminateEnvironment(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticbeanstalkiface.ElasticBeanstalkAPI))
+			return cmd
+		}
 	case "updatebucket":
 		return func() interface{} {
 			cmd := awsspec.NewUpdateBucket(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(s3iface.S3API))
 			return cmd
 		}
+	case "updatecontainerservice":
+		return func() interface{} {
+			cmd := awsspec.NewUpdateContainerservice(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ecsiface.ECSAPI))
+			return cmd
+		}
 	case "updatecontainertask":
 		return func() interface{} {
 			cmd := awsspec.NewUpdateContainertask(nil, f.Graph, f.Logger)
@@ -962,6 +1773,18 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(cloudfrontiface.CloudFrontAPI))
 			return cmd
 		}
+	case "updateenvironment":
+		return func() interface{} {
+			cmd := awsspec.NewUpdateEnvironment(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticbeanstalkiface.ElasticBeanstalkAPI))
+			return cmd
+		}
+	case "updatefunction":
+		return func() interface{} {
+			cmd := awsspec.NewUpdateFunction(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(lambdaiface.LambdaAPI))
+			return cmd
+		}
 	case "updateimage":
 		return func() interface{} {
 			cmd := awsspec.NewUpdateImage(nil, f.Graph, f.Logger)
@@ -974,12 +1797,36 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "updateloggroup":
+		return func() interface{} {
+			cmd := awsspec.NewUpdateLoggroup(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(cloudwatchlogsiface.CloudWatchLogsAPI))
+			return cmd
+		}
 	case "updateloginprofile":
 		return func() interface{} {
 			cmd := awsspec.NewUpdateLoginprofile(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(iamiface.IAMAPI))
 			return cmd
 		}
+	case "updatenetworkaclrule":
+		return func() interface{} {
+			cmd := awsspec.NewUpdateNetworkaclrule(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
+	case "updatenetworkinterface":
+		return func() interface{} {
+			cmd := awsspec.NewUpdateNetworkinterface(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
+	case "updateparameter":
+		return func() interface{} {
+			cmd := awsspec.NewUpdateParameter(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ssmiface.SSMAPI))
+			return cmd
+		}
 	case "updatepolicy":
 		return func() interface{} {
 			cmd := awsspec.NewUpdatePolicy(nil, f.Graph, f.Logger)
@@ -992,6 +1839,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(route53iface.Route53API))
 			return cmd
 		}
+	case "updaterepository":
+		return func() interface{} {
+			cmd := awsspec.NewUpdateRepository(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ecriface.ECRAPI))
+			return cmd
+		}
 	case "updates3object":
 		return func() interface{} {
 			cmd := awsspec.NewUpdateS3object(nil, f.Graph, f.Logger)
@@ -1004,6 +1857,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(autoscalingiface.AutoScalingAPI))
 			return cmd
 		}
+	case "updatesecret":
+		return func() interface{} {
+			cmd := awsspec.NewUpdateSecret(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(secretsmanageriface.SecretsManagerAPI))
+			return cmd
+		}
 	case "updatesecuritygroup":
 		return func() interface{} {
 			cmd := awsspec.NewUpdateSecuritygroup(nil, f.Graph, f.Logger)
@@ -1016,18 +1875,54 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(cloudformationiface.CloudFormationAPI))
 			return cmd
 		}
+	case "updatestatemachine":
+		return func() interface{} {
+			cmd := awsspec.NewUpdateStatemachine(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(sfniface.SFNAPI))
+			return cmd
+		}
 	case "updatesubnet":
 		return func() interface{} {
 			cmd := awsspec.NewUpdateSubnet(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "updatetable":
+		return func() interface{} {
+			cmd := awsspec.NewUpdateTable(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(dynamodbiface.DynamoDBAPI))
+			return cmd
+		}
 	case "updatetargetgroup":
 		return func() interface{} {
 			cmd := awsspec.NewUpdateTargetgroup(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(elbv2iface.ELBV2API))
 			return cmd
 		}
+	case "updatevpc":
+		return func() interface{} {
+			cmd := awsspec.NewUpdateVpc(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
+	case "waitcertificate":
+		return func() interface{} {
+			cmd := awsspec.NewWaitCertificate(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(acmiface.ACMAPI))
+			return cmd
+		}
+	case "waitdistribution":
+		return func() interface{} {
+			cmd := awsspec.NewWaitDistribution(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(cloudfrontiface.CloudFrontAPI))
+			return cmd
+		}
+	case "waitkubernetescluster":
+		return func() interface{} {
+			cmd := awsspec.NewWaitKubernetescluster(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(eksiface.EKSAPI))
+			return cmd
+		}
 	}
 	return nil
 }
@@ -0,0 +1,50 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestHost(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create host type=m4.large availabilityzone=eu-west-1a auto-placement=OFF").
+			Mock(&ec2Mock{
+				AllocateHostsFunc: func(input *ec2.AllocateHostsInput) (*ec2.AllocateHostsOutput, error) {
+					return &ec2.AllocateHostsOutput{HostIds: []*string{String("h-1234")}}, nil
+				},
+			}).ExpectInput("AllocateHosts", &ec2.AllocateHostsInput{
+			InstanceType:     String("m4.large"),
+			AvailabilityZone: String("eu-west-1a"),
+			AutoPlacement:    String("off"),
+			Quantity:         Int64(1),
+		}).ExpectCommandResult("h-1234").ExpectCalls("AllocateHosts").
+			ExpectRevert("delete host id=h-1234").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete host id=h-1234").
+			Mock(&ec2Mock{
+				ReleaseHostsFunc: func(input *ec2.ReleaseHostsInput) (*ec2.ReleaseHostsOutput, error) {
+					return &ec2.ReleaseHostsOutput{Successful: []*string{String("h-1234")}}, nil
+				},
+			}).ExpectInput("ReleaseHosts", &ec2.ReleaseHostsInput{HostIds: []*string{String("h-1234")}}).
+			ExpectCalls("ReleaseHosts").Run(t)
+	})
+}
+
+func TestHostreservation(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create hostreservation offering=hro-1234 hosts=[h-1234,h-2345] limit-price=1000 currency=usd").
+			Mock(&ec2Mock{
+				PurchaseHostReservationFunc: func(input *ec2.PurchaseHostReservationInput) (*ec2.PurchaseHostReservationOutput, error) {
+					return &ec2.PurchaseHostReservationOutput{Purchase: []*ec2.Purchase{{HostReservationId: String("hr-1234"), HostIdSet: input.HostIdSet}}}, nil
+				},
+			}).ExpectInput("PurchaseHostReservation", &ec2.PurchaseHostReservationInput{
+			OfferingId:   String("hro-1234"),
+			HostIdSet:    []*string{String("h-1234"), String("h-2345")},
+			LimitPrice:   String("1000"),
+			CurrencyCode: String("USD"),
+		}).ExpectCommandResult("hr-1234").ExpectCalls("PurchaseHostReservation").Run(t)
+	})
+}
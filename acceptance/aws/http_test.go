@@ -0,0 +1,43 @@
+package awsat
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+)
+
+func TestHttp(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("check", func(t *testing.T) {
+		Template(fmt.Sprintf("check http url=%s/health status=204 timeout=5s", srv.URL)).
+			Mock(&elbv2Mock{}).Run(t)
+	})
+
+	t.Run("check loadbalancer", func(t *testing.T) {
+		Template(fmt.Sprintf("check http url=http://lb:%s/health loadbalancer=arn:my:lb status=204 timeout=1m", u.Port())).
+			Mock(&elbv2Mock{
+				DescribeLoadBalancersFunc: func(param0 *elbv2.DescribeLoadBalancersInput) (*elbv2.DescribeLoadBalancersOutput, error) {
+					return &elbv2.DescribeLoadBalancersOutput{LoadBalancers: []*elbv2.LoadBalancer{
+						{LoadBalancerArn: String("arn:my:lb"), DNSName: String(u.Hostname())},
+					}}, nil
+				},
+			}).ExpectInput("DescribeLoadBalancers", &elbv2.DescribeLoadBalancersInput{LoadBalancerArns: []*string{String("arn:my:lb")}}).
+			ExpectCalls("DescribeLoadBalancers").Run(t)
+	})
+}
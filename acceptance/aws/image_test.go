@@ -34,6 +34,25 @@ func TestImage(t *testing.T) {
 				NoReboot:    Bool(true),
 			}).ExpectCommandResult("new-image-id").ExpectCalls("CreateImage").Run(t)
 		})
+
+		t.Run("from snapshot", func(t *testing.T) {
+			Template("create image name=my-image-name snapshot=snap-1234 description='a restored image'").
+				Mock(&ec2Mock{
+					RegisterImageFunc: func(param0 *ec2.RegisterImageInput) (*ec2.RegisterImageOutput, error) {
+						return &ec2.RegisterImageOutput{ImageId: String("registered-image-id")}, nil
+					},
+				}).ExpectInput("RegisterImage", &ec2.RegisterImageInput{
+				Name:               String("my-image-name"),
+				Description:        String("a restored image"),
+				Architecture:       String("x86_64"),
+				VirtualizationType: String("hvm"),
+				RootDeviceName:     String("/dev/xvda"),
+				BlockDeviceMappings: []*ec2.BlockDeviceMapping{
+					{DeviceName: String("/dev/xvda"), Ebs: &ec2.EbsBlockDevice{SnapshotId: String("snap-1234"), DeleteOnTermination: Bool(true)}},
+				},
+			}).ExpectCommandResult("registered-image-id").ExpectCalls("RegisterImage").
+				ExpectRevert("delete image id=registered-image-id").Run(t)
+		})
 	})
 
 	t.Run("copy", func(t *testing.T) {
@@ -51,6 +70,36 @@ func TestImage(t *testing.T) {
 		}).ExpectCommandResult("my-imagecopy-id").ExpectCalls("CopyImage").Run(t)
 	})
 
+	t.Run("copy to region with key", func(t *testing.T) {
+		Template("copy image name=my-image-name source-id=my-origin-id source-region=us-west-2 region=eu-west-3 kms-key=alias/images").
+			Mock(&ec2Mock{
+				CopyImageFunc: func(param0 *ec2.CopyImageInput) (*ec2.CopyImageOutput, error) {
+					return &ec2.CopyImageOutput{ImageId: String("my-imagecopy-id")}, nil
+				},
+			}).ExpectInput("CopyImage", &ec2.CopyImageInput{
+			Name:          String("my-image-name"),
+			SourceImageId: String("my-origin-id"),
+			SourceRegion:  String("us-west-2"),
+			Encrypted:     Bool(true),
+			KmsKeyId:      String("alias/images"),
+		}).ExpectCommandResult("my-imagecopy-id").ExpectCalls("CopyImage").
+			ExpectRevert("delete image delete-snapshots=true id=my-imagecopy-id region=eu-west-3").Run(t)
+	})
+
+	t.Run("update launch permission", func(t *testing.T) {
+		Template("update image id=ami-1234 account=123456789012 operation=add").
+			Mock(&ec2Mock{
+				ModifyImageAttributeFunc: func(param0 *ec2.ModifyImageAttributeInput) (*ec2.ModifyImageAttributeOutput, error) {
+					return &ec2.ModifyImageAttributeOutput{}, nil
+				},
+			}).ExpectInput("ModifyImageAttribute", &ec2.ModifyImageAttributeInput{
+			ImageId:       String("ami-1234"),
+			Attribute:     String("launchPermission"),
+			OperationType: String("add"),
+			UserIds:       []*string{String("123456789012")},
+		}).ExpectCalls("ModifyImageAttribute").Run(t)
+	})
+
 	t.Run("import", func(t *testing.T) {
 		t.Run("from ebs snapshot", func(t *testing.T) {
 			Template("import image architecture=x86_64 description='my image desc' license=BYOL platform=Linux role=vmimport snapshot=my-ebs-snapshot").
@@ -2,8 +2,10 @@ package awsat
 
 import (
 	"encoding/base64"
+	"errors"
 	"io/ioutil"
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -80,19 +82,168 @@ func TestInstance(t *testing.T) {
 				},
 			}).ExpectCommandResult("new-instance-id").ExpectCalls("RunInstances", "CreateTagsRequest").Run(t)
 		})
+
+		t.Run("with launch settings", func(t *testing.T) {
+			_, bootFile, cleanup := generateTmpFile("#!/bin/bash\nyum install -y nginx\n")
+			defer cleanup()
+
+			Template("create instance image=ami-1234 name=myinstance subnet=sub_1 type=t2.nano count=1 "+
+				"userdata=file("+bootFile+") role=arn:aws:iam::123456789012:instance-profile/web "+
+				"block-devices=[/dev/xvda:30,/dev/sdb:500:io1:5000] tags=[env:prod,team:web]").
+				Mock(&ec2Mock{
+					RunInstancesFunc: func(input *ec2.RunInstancesInput) (*ec2.Reservation, error) {
+						return &ec2.Reservation{Instances: []*ec2.Instance{{InstanceId: String("new-instance-id")}}}, nil
+					},
+					CreateTagsRequestFunc: func(input *ec2.CreateTagsInput) (req *request.Request, output *ec2.CreateTagsOutput) {
+						output = &ec2.CreateTagsOutput{}
+						req = request.New(aws.Config{}, metadata.ClientInfo{}, request.Handlers{}, nil, &request.Operation{}, input, output)
+						return
+					},
+				}).ExpectInput("RunInstances", &ec2.RunInstancesInput{
+				SubnetId:           String("sub_1"),
+				ImageId:            String("ami-1234"),
+				InstanceType:       String("t2.nano"),
+				MinCount:           Int64(1),
+				MaxCount:           Int64(1),
+				UserData:           String(base64.StdEncoding.EncodeToString([]byte("#!/bin/bash\nyum install -y nginx\n"))),
+				IamInstanceProfile: &ec2.IamInstanceProfileSpecification{Arn: String("arn:aws:iam::123456789012:instance-profile/web")},
+				BlockDeviceMappings: []*ec2.BlockDeviceMapping{
+					{DeviceName: String("/dev/xvda"), Ebs: &ec2.EbsBlockDevice{VolumeSize: Int64(30), DeleteOnTermination: Bool(true)}},
+					{DeviceName: String("/dev/sdb"), Ebs: &ec2.EbsBlockDevice{VolumeSize: Int64(500), VolumeType: String("io1"), Iops: Int64(5000), DeleteOnTermination: Bool(true)}},
+				},
+				TagSpecifications: []*ec2.TagSpecification{
+					{ResourceType: String("instance"), Tags: []*ec2.Tag{{Key: String("env"), Value: String("prod")}, {Key: String("team"), Value: String("web")}}},
+					{ResourceType: String("volume"), Tags: []*ec2.Tag{{Key: String("env"), Value: String("prod")}, {Key: String("team"), Value: String("web")}}},
+				},
+			}).IgnoreInput("CreateTagsRequest").ExpectCommandResult("new-instance-id").ExpectCalls("RunInstances", "CreateTagsRequest").
+				ExpectRevert("delete instance id=new-instance-id").Run(t)
+		})
+
+		t.Run("with placement", func(t *testing.T) {
+			Template("create instance image=ami-1234 name=myinstance subnet=sub_1 type=c4.8xlarge count=2 placementgroup=my-cluster host=h-1234").
+				Mock(&ec2Mock{
+					RunInstancesFunc: func(input *ec2.RunInstancesInput) (*ec2.Reservation, error) {
+						return &ec2.Reservation{Instances: []*ec2.Instance{{InstanceId: String("new-instance-id")}}}, nil
+					},
+					CreateTagsRequestFunc: func(input *ec2.CreateTagsInput) (req *request.Request, output *ec2.CreateTagsOutput) {
+						output = &ec2.CreateTagsOutput{}
+						req = request.New(aws.Config{}, metadata.ClientInfo{}, request.Handlers{}, nil, &request.Operation{}, input, output)
+						return
+					},
+				}).ExpectInput("RunInstances", &ec2.RunInstancesInput{
+				SubnetId:     String("sub_1"),
+				ImageId:      String("ami-1234"),
+				InstanceType: String("c4.8xlarge"),
+				MinCount:     Int64(2),
+				MaxCount:     Int64(2),
+				Placement:    &ec2.Placement{GroupName: String("my-cluster"), HostId: String("h-1234"), Tenancy: String("host")},
+			}).IgnoreInput("CreateTagsRequest").ExpectCommandResult("new-instance-id").ExpectCalls("RunInstances", "CreateTagsRequest").
+				ExpectRevert("delete instance id=new-instance-id").Run(t)
+		})
 	})
 
 	t.Run("update", func(t *testing.T) {
-		Template("update instance id=id-1234 type=t2.micro lock=true").Mock(&ec2Mock{
-			ModifyInstanceAttributeFunc: func(param0 *ec2.ModifyInstanceAttributeInput) (*ec2.ModifyInstanceAttributeOutput, error) {
-				return nil, nil
-			},
-		}).ExpectInput("ModifyInstanceAttribute", &ec2.ModifyInstanceAttributeInput{
-			InstanceId:            String("id-1234"),
-			InstanceType:          &ec2.AttributeValue{Value: String("t2.micro")},
-			DisableApiTermination: &ec2.AttributeBooleanValue{Value: Bool(true)},
-		}).
-			ExpectCalls("ModifyInstanceAttribute").Run(t)
+		t.Run("stopped", func(t *testing.T) {
+			Template("update instance id=id-1234 type=t2.micro lock=true").Mock(&ec2Mock{
+				DescribeInstanceAttributeFunc: func(param0 *ec2.DescribeInstanceAttributeInput) (*ec2.DescribeInstanceAttributeOutput, error) {
+					switch StringValue(param0.Attribute) {
+					case "instanceType":
+						return &ec2.DescribeInstanceAttributeOutput{InstanceType: &ec2.AttributeValue{Value: String("t2.nano")}}, nil
+					default:
+						return &ec2.DescribeInstanceAttributeOutput{DisableApiTermination: &ec2.AttributeBooleanValue{Value: Bool(false)}}, nil
+					}
+				},
+				DescribeInstancesFunc: func(param0 *ec2.DescribeInstancesInput) (*ec2.DescribeInstancesOutput, error) {
+					return &ec2.DescribeInstancesOutput{Reservations: []*ec2.Reservation{{Instances: []*ec2.Instance{{InstanceId: String("id-1234"), State: &ec2.InstanceState{Name: String("stopped")}}}}}}, nil
+				},
+				ModifyInstanceAttributeFunc: func(param0 *ec2.ModifyInstanceAttributeInput) (*ec2.ModifyInstanceAttributeOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("ModifyInstanceAttribute", &ec2.ModifyInstanceAttributeInput{
+				InstanceId:            String("id-1234"),
+				InstanceType:          &ec2.AttributeValue{Value: String("t2.micro")},
+				DisableApiTermination: &ec2.AttributeBooleanValue{Value: Bool(true)},
+			}).ExpectInput("DescribeInstances", &ec2.DescribeInstancesInput{InstanceIds: []*string{String("id-1234")}}).IgnoreInput("DescribeInstanceAttribute").
+				ExpectCalls("DescribeInstanceAttribute", "DescribeInstanceAttribute", "DescribeInstances", "ModifyInstanceAttribute").
+				ExpectRevert("update instance id=id-1234 lock=false type=t2.nano").Run(t)
+		})
+
+		t.Run("running", func(t *testing.T) {
+			states := []string{"running", "stopped"}
+			Template("update instance id=id-1234 type=t2.micro").Mock(&ec2Mock{
+				DescribeInstanceAttributeFunc: func(param0 *ec2.DescribeInstanceAttributeInput) (*ec2.DescribeInstanceAttributeOutput, error) {
+					return &ec2.DescribeInstanceAttributeOutput{InstanceType: &ec2.AttributeValue{Value: String("t2.nano")}}, nil
+				},
+				DescribeInstancesFunc: func(param0 *ec2.DescribeInstancesInput) (*ec2.DescribeInstancesOutput, error) {
+					state := states[0]
+					states = states[1:]
+					return &ec2.DescribeInstancesOutput{Reservations: []*ec2.Reservation{{Instances: []*ec2.Instance{{InstanceId: String("id-1234"), State: &ec2.InstanceState{Name: String(state)}}}}}}, nil
+				},
+				StopInstancesFunc: func(param0 *ec2.StopInstancesInput) (*ec2.StopInstancesOutput, error) {
+					return &ec2.StopInstancesOutput{}, nil
+				},
+				ModifyInstanceAttributeFunc: func(param0 *ec2.ModifyInstanceAttributeInput) (*ec2.ModifyInstanceAttributeOutput, error) {
+					return nil, nil
+				},
+				StartInstancesWithContextFunc: func(param0 aws.Context, param1 *ec2.StartInstancesInput, param2 ...request.Option) (*ec2.StartInstancesOutput, error) {
+					if got, want := param1, (&ec2.StartInstancesInput{InstanceIds: []*string{String("id-1234")}}); !reflect.DeepEqual(got, want) {
+						t.Fatalf("got %#v, want %#v", got, want)
+					}
+					return &ec2.StartInstancesOutput{}, nil
+				},
+			}).ExpectInput("StopInstances", &ec2.StopInstancesInput{InstanceIds: []*string{String("id-1234")}}).
+				ExpectInput("ModifyInstanceAttribute", &ec2.ModifyInstanceAttributeInput{InstanceId: String("id-1234"), InstanceType: &ec2.AttributeValue{Value: String("t2.micro")}}).
+				IgnoreInput("DescribeInstanceAttribute", "DescribeInstances", "StartInstancesWithContext").
+				ExpectCalls("DescribeInstanceAttribute", "DescribeInstances", "StopInstances", "DescribeInstances", "ModifyInstanceAttribute", "StartInstancesWithContext").
+				ExpectRevert("update instance id=id-1234 type=t2.nano").Run(t)
+		})
+
+		t.Run("running with failing change", func(t *testing.T) {
+			states := []string{"running", "stopped"}
+			Template("update instance id=id-1234 type=t2.micro").Mock(&ec2Mock{
+				DescribeInstanceAttributeFunc: func(param0 *ec2.DescribeInstanceAttributeInput) (*ec2.DescribeInstanceAttributeOutput, error) {
+					return &ec2.DescribeInstanceAttributeOutput{InstanceType: &ec2.AttributeValue{Value: String("t2.nano")}}, nil
+				},
+				DescribeInstancesFunc: func(param0 *ec2.DescribeInstancesInput) (*ec2.DescribeInstancesOutput, error) {
+					state := states[0]
+					states = states[1:]
+					return &ec2.DescribeInstancesOutput{Reservations: []*ec2.Reservation{{Instances: []*ec2.Instance{{InstanceId: String("id-1234"), State: &ec2.InstanceState{Name: String(state)}}}}}}, nil
+				},
+				StopInstancesFunc: func(param0 *ec2.StopInstancesInput) (*ec2.StopInstancesOutput, error) {
+					return &ec2.StopInstancesOutput{}, nil
+				},
+				ModifyInstanceAttributeFunc: func(param0 *ec2.ModifyInstanceAttributeInput) (*ec2.ModifyInstanceAttributeOutput, error) {
+					return nil, errors.New("InsufficientInstanceCapacity")
+				},
+				StartInstancesWithContextFunc: func(param0 aws.Context, param1 *ec2.StartInstancesInput, param2 ...request.Option) (*ec2.StartInstancesOutput, error) {
+					return &ec2.StartInstancesOutput{}, nil
+				},
+			}).IgnoreInput("DescribeInstanceAttribute", "DescribeInstances", "StopInstances", "ModifyInstanceAttribute", "StartInstancesWithContext").
+				ExpectCalls("DescribeInstanceAttribute", "DescribeInstances", "StopInstances", "DescribeInstances", "ModifyInstanceAttribute", "StartInstancesWithContext").
+				ExpectError("InsufficientInstanceCapacity").Run(t)
+		})
+
+		t.Run("running with failing stop wait", func(t *testing.T) {
+			states := []string{"running"}
+			Template("update instance id=id-1234 type=t2.micro").Mock(&ec2Mock{
+				DescribeInstanceAttributeFunc: func(param0 *ec2.DescribeInstanceAttributeInput) (*ec2.DescribeInstanceAttributeOutput, error) {
+					return &ec2.DescribeInstanceAttributeOutput{InstanceType: &ec2.AttributeValue{Value: String("t2.nano")}}, nil
+				},
+				DescribeInstancesFunc: func(param0 *ec2.DescribeInstancesInput) (*ec2.DescribeInstancesOutput, error) {
+					if len(states) == 0 {
+						return nil, errors.New("connection reset")
+					}
+					state := states[0]
+					states = states[1:]
+					return &ec2.DescribeInstancesOutput{Reservations: []*ec2.Reservation{{Instances: []*ec2.Instance{{InstanceId: String("id-1234"), State: &ec2.InstanceState{Name: String(state)}}}}}}, nil
+				},
+				StopInstancesFunc: func(param0 *ec2.StopInstancesInput) (*ec2.StopInstancesOutput, error) {
+					return &ec2.StopInstancesOutput{}, nil
+				},
+			}).IgnoreInput("DescribeInstanceAttribute", "DescribeInstances", "StopInstances").
+				ExpectCalls("DescribeInstanceAttribute", "DescribeInstances", "StopInstances", "DescribeInstances").
+				ExpectError("connection reset").Run(t)
+		})
 	})
 
 	t.Run("delete", func(t *testing.T) {
@@ -155,6 +306,15 @@ func TestInstance(t *testing.T) {
 		})
 	})
 
+	t.Run("reboot", func(t *testing.T) {
+		Template("reboot instance id=id-1234").Mock(&ec2Mock{
+			RebootInstancesFunc: func(param0 *ec2.RebootInstancesInput) (*ec2.RebootInstancesOutput, error) {
+				return &ec2.RebootInstancesOutput{}, nil
+			},
+		}).ExpectInput("RebootInstances", &ec2.RebootInstancesInput{InstanceIds: []*string{String("id-1234")}}).
+			ExpectCalls("RebootInstances").Run(t)
+	})
+
 	t.Run("restart", func(t *testing.T) {
 		t.Run("one id", func(t *testing.T) {
 			Template("restart instance id=id-1234").Mock(&ec2Mock{
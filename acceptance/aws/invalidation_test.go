@@ -0,0 +1,41 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/wallix/awless/aws/spec"
+)
+
+func TestInvalidation(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		awsspec.CallerReferenceFunc = func() string {
+			return "callerReference"
+		}
+		Template("create invalidation distribution=my-distribution-id path=[/index.html,/css/*]").Mock(&cloudfrontMock{
+			CreateInvalidationFunc: func(input *cloudfront.CreateInvalidationInput) (*cloudfront.CreateInvalidationOutput, error) {
+				return &cloudfront.CreateInvalidationOutput{Invalidation: &cloudfront.Invalidation{Id: String("new-invalidation-id")}}, nil
+			}}).
+			ExpectInput("CreateInvalidation", &cloudfront.CreateInvalidationInput{
+				DistributionId: String("my-distribution-id"),
+				InvalidationBatch: &cloudfront.InvalidationBatch{
+					CallerReference: String("callerReference"),
+					Paths:           &cloudfront.Paths{Items: []*string{String("/index.html"), String("/css/*")}, Quantity: Int64(2)},
+				},
+			}).ExpectCommandResult("new-invalidation-id").ExpectCalls("CreateInvalidation").Run(t)
+	})
+
+	t.Run("create with wildcard path", func(t *testing.T) {
+		Template("create invalidation distribution=my-distribution-id path=/*").Mock(&cloudfrontMock{
+			CreateInvalidationFunc: func(input *cloudfront.CreateInvalidationInput) (*cloudfront.CreateInvalidationOutput, error) {
+				return &cloudfront.CreateInvalidationOutput{Invalidation: &cloudfront.Invalidation{Id: String("new-invalidation-id")}}, nil
+			}}).
+			ExpectInput("CreateInvalidation", &cloudfront.CreateInvalidationInput{
+				DistributionId: String("my-distribution-id"),
+				InvalidationBatch: &cloudfront.InvalidationBatch{
+					CallerReference: String("callerReference"),
+					Paths:           &cloudfront.Paths{Items: []*string{String("/*")}, Quantity: Int64(1)},
+				},
+			}).ExpectCommandResult("new-invalidation-id").ExpectCalls("CreateInvalidation").Run(t)
+	})
+}
@@ -0,0 +1,26 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/batch"
+)
+
+func TestJob(t *testing.T) {
+	t.Run("submit", func(t *testing.T) {
+		Template("submit job name=my-job queue=my-queue definition=my-job-definition parameters=message:bye command=[echo,Ref::message,now]").
+			Mock(&batchMock{
+				SubmitJobFunc: func(param0 *batch.SubmitJobInput) (*batch.SubmitJobOutput, error) {
+					return &batch.SubmitJobOutput{JobId: String("876da822-4198-45f2-a252-6cea32512ea8"), JobName: String("my-job")}, nil
+				},
+			}).ExpectInput("SubmitJob", &batch.SubmitJobInput{
+			JobName:       String("my-job"),
+			JobQueue:      String("my-queue"),
+			JobDefinition: String("my-job-definition"),
+			Parameters:    map[string]*string{"message": String("bye")},
+			ContainerOverrides: &batch.ContainerOverrides{
+				Command: []*string{String("echo"), String("Ref::message"), String("now")},
+			},
+		}).ExpectCommandResult("876da822-4198-45f2-a252-6cea32512ea8").ExpectCalls("SubmitJob").Run(t)
+	})
+}
@@ -0,0 +1,47 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/batch"
+)
+
+func TestJobDefinition(t *testing.T) {
+	t.Run("register", func(t *testing.T) {
+		Template("register jobdefinition name=my-job-definition image=busybox vcpus=1 memory=128 command=[echo,Ref::message] "+
+			"role=arn:aws:iam::0123456789:role/job-role parameters=message:hello retries=3").
+			Mock(&batchMock{
+				RegisterJobDefinitionFunc: func(param0 *batch.RegisterJobDefinitionInput) (*batch.RegisterJobDefinitionOutput, error) {
+					return &batch.RegisterJobDefinitionOutput{
+						JobDefinitionArn:  String("arn:aws:batch:us-east-1:0123456789:job-definition/my-job-definition:1"),
+						JobDefinitionName: String("my-job-definition"),
+						Revision:          Int64(1),
+					}, nil
+				},
+			}).ExpectInput("RegisterJobDefinition", &batch.RegisterJobDefinitionInput{
+			JobDefinitionName: String("my-job-definition"),
+			Type:              String("container"),
+			ContainerProperties: &batch.ContainerProperties{
+				Image:      String("busybox"),
+				Vcpus:      Int64(1),
+				Memory:     Int64(128),
+				Command:    []*string{String("echo"), String("Ref::message")},
+				JobRoleArn: String("arn:aws:iam::0123456789:role/job-role"),
+			},
+			Parameters:    map[string]*string{"message": String("hello")},
+			RetryStrategy: &batch.RetryStrategy{Attempts: Int64(3)},
+		}).ExpectCommandResult("arn:aws:batch:us-east-1:0123456789:job-definition/my-job-definition:1").ExpectCalls("RegisterJobDefinition").
+			ExpectRevert("delete jobdefinition id=arn:aws:batch:us-east-1:0123456789:job-definition/my-job-definition:1").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete jobdefinition id=my-job-definition:1").
+			Mock(&batchMock{
+				DeregisterJobDefinitionFunc: func(param0 *batch.DeregisterJobDefinitionInput) (*batch.DeregisterJobDefinitionOutput, error) {
+					return &batch.DeregisterJobDefinitionOutput{}, nil
+				},
+			}).ExpectInput("DeregisterJobDefinition", &batch.DeregisterJobDefinitionInput{
+			JobDefinition: String("my-job-definition:1"),
+		}).ExpectCalls("DeregisterJobDefinition").Run(t)
+	})
+}
@@ -0,0 +1,57 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/batch"
+)
+
+func TestJobQueue(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create jobqueue name=my-queue computeenvironments=[my-env,my-spot-env]").
+			Mock(&batchMock{
+				CreateJobQueueFunc: func(param0 *batch.CreateJobQueueInput) (*batch.CreateJobQueueOutput, error) {
+					return &batch.CreateJobQueueOutput{JobQueueArn: String("arn:aws:batch:us-east-1:0123456789:job-queue/my-queue")}, nil
+				},
+			}).ExpectInput("CreateJobQueue", &batch.CreateJobQueueInput{
+			JobQueueName: String("my-queue"),
+			Priority:     Int64(1),
+			ComputeEnvironmentOrder: []*batch.ComputeEnvironmentOrder{
+				{ComputeEnvironment: String("my-env"), Order: Int64(1)},
+				{ComputeEnvironment: String("my-spot-env"), Order: Int64(2)},
+			},
+		}).ExpectCommandResult("arn:aws:batch:us-east-1:0123456789:job-queue/my-queue").ExpectCalls("CreateJobQueue").
+			ExpectRevert("delete jobqueue id=arn:aws:batch:us-east-1:0123456789:job-queue/my-queue").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		var deleted bool
+		Template("delete jobqueue id=my-queue").
+			Mock(&batchMock{
+				UpdateJobQueueFunc: func(param0 *batch.UpdateJobQueueInput) (*batch.UpdateJobQueueOutput, error) {
+					return &batch.UpdateJobQueueOutput{}, nil
+				},
+				DescribeJobQueuesFunc: func(param0 *batch.DescribeJobQueuesInput) (*batch.DescribeJobQueuesOutput, error) {
+					if deleted {
+						return &batch.DescribeJobQueuesOutput{JobQueues: []*batch.JobQueueDetail{
+							{JobQueueName: String("my-queue"), State: String("DISABLED"), Status: String("DELETED")},
+						}}, nil
+					}
+					return &batch.DescribeJobQueuesOutput{JobQueues: []*batch.JobQueueDetail{
+						{JobQueueName: String("my-queue"), State: String("DISABLED"), Status: String("VALID")},
+					}}, nil
+				},
+				DeleteJobQueueFunc: func(param0 *batch.DeleteJobQueueInput) (*batch.DeleteJobQueueOutput, error) {
+					deleted = true
+					return &batch.DeleteJobQueueOutput{}, nil
+				},
+			}).ExpectInput("UpdateJobQueue", &batch.UpdateJobQueueInput{
+			JobQueue: String("my-queue"),
+			State:    String("DISABLED"),
+		}).ExpectInput("DescribeJobQueues", &batch.DescribeJobQueuesInput{
+			JobQueues: []*string{String("my-queue")},
+		}).ExpectInput("DeleteJobQueue", &batch.DeleteJobQueueInput{
+			JobQueue: String("my-queue"),
+		}).ExpectCalls("UpdateJobQueue", "DescribeJobQueues", "DeleteJobQueue", "DescribeJobQueues").Run(t)
+	})
+}
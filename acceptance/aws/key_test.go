@@ -0,0 +1,110 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+)
+
+func TestKey(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create key description='Encryption of the backups'").
+			Mock(&kmsMock{
+				CreateKeyFunc: func(param0 *kms.CreateKeyInput) (*kms.CreateKeyOutput, error) {
+					return &kms.CreateKeyOutput{KeyMetadata: &kms.KeyMetadata{KeyId: String("1234abcd-12ab-34cd-56ef-1234567890ab")}}, nil
+				},
+			}).ExpectInput("CreateKey", &kms.CreateKeyInput{
+			Description: String("Encryption of the backups"),
+		}).ExpectCommandResult("1234abcd-12ab-34cd-56ef-1234567890ab").ExpectCalls("CreateKey").
+			ExpectRevert("delete key id=1234abcd-12ab-34cd-56ef-1234567890ab").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete key id=1234abcd-12ab-34cd-56ef-1234567890ab pending-days=7").
+			Mock(&kmsMock{
+				ScheduleKeyDeletionFunc: func(param0 *kms.ScheduleKeyDeletionInput) (*kms.ScheduleKeyDeletionOutput, error) {
+					return &kms.ScheduleKeyDeletionOutput{}, nil
+				},
+			}).ExpectInput("ScheduleKeyDeletion", &kms.ScheduleKeyDeletionInput{
+			KeyId:               String("1234abcd-12ab-34cd-56ef-1234567890ab"),
+			PendingWindowInDays: Int64(7),
+		}).ExpectCalls("ScheduleKeyDeletion").Run(t)
+	})
+
+	t.Run("enable", func(t *testing.T) {
+		Template("enable key id=1234abcd-12ab-34cd-56ef-1234567890ab").
+			Mock(&kmsMock{
+				EnableKeyFunc: func(param0 *kms.EnableKeyInput) (*kms.EnableKeyOutput, error) {
+					return &kms.EnableKeyOutput{}, nil
+				},
+			}).ExpectInput("EnableKey", &kms.EnableKeyInput{
+			KeyId: String("1234abcd-12ab-34cd-56ef-1234567890ab"),
+		}).ExpectCalls("EnableKey").ExpectRevert("disable key id=1234abcd-12ab-34cd-56ef-1234567890ab").Run(t)
+	})
+
+	t.Run("disable", func(t *testing.T) {
+		Template("disable key id=1234abcd-12ab-34cd-56ef-1234567890ab").
+			Mock(&kmsMock{
+				DisableKeyFunc: func(param0 *kms.DisableKeyInput) (*kms.DisableKeyOutput, error) {
+					return &kms.DisableKeyOutput{}, nil
+				},
+			}).ExpectInput("DisableKey", &kms.DisableKeyInput{
+			KeyId: String("1234abcd-12ab-34cd-56ef-1234567890ab"),
+		}).ExpectCalls("DisableKey").ExpectRevert("enable key id=1234abcd-12ab-34cd-56ef-1234567890ab").Run(t)
+	})
+}
+
+func TestAlias(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create alias name=backups key=1234abcd-12ab-34cd-56ef-1234567890ab").
+			Mock(&kmsMock{
+				CreateAliasFunc: func(param0 *kms.CreateAliasInput) (*kms.CreateAliasOutput, error) {
+					return &kms.CreateAliasOutput{}, nil
+				},
+			}).ExpectInput("CreateAlias", &kms.CreateAliasInput{
+			AliasName:   String("alias/backups"),
+			TargetKeyId: String("1234abcd-12ab-34cd-56ef-1234567890ab"),
+		}).ExpectCommandResult("alias/backups").ExpectCalls("CreateAlias").
+			ExpectRevert("delete alias name=alias/backups").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete alias name=alias/backups").
+			Mock(&kmsMock{
+				DeleteAliasFunc: func(param0 *kms.DeleteAliasInput) (*kms.DeleteAliasOutput, error) {
+					return &kms.DeleteAliasOutput{}, nil
+				},
+			}).ExpectInput("DeleteAlias", &kms.DeleteAliasInput{
+			AliasName: String("alias/backups"),
+		}).ExpectCalls("DeleteAlias").Run(t)
+	})
+}
+
+func TestGrant(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create grant key=1234abcd-12ab-34cd-56ef-1234567890ab grantee=arn:aws:iam::123456789012:role/backup operations=Encrypt,Decrypt name=backups").
+			Mock(&kmsMock{
+				CreateGrantFunc: func(param0 *kms.CreateGrantInput) (*kms.CreateGrantOutput, error) {
+					return &kms.CreateGrantOutput{GrantId: String("0c237476b39f8bc44e45212e08498fbe")}, nil
+				},
+			}).ExpectInput("CreateGrant", &kms.CreateGrantInput{
+			KeyId:            String("1234abcd-12ab-34cd-56ef-1234567890ab"),
+			GranteePrincipal: String("arn:aws:iam::123456789012:role/backup"),
+			Operations:       []*string{String("Encrypt"), String("Decrypt")},
+			Name:             String("backups"),
+		}).ExpectCommandResult("0c237476b39f8bc44e45212e08498fbe").ExpectCalls("CreateGrant").
+			ExpectRevert("delete grant id=0c237476b39f8bc44e45212e08498fbe key=1234abcd-12ab-34cd-56ef-1234567890ab").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete grant id=0c237476b39f8bc44e45212e08498fbe key=1234abcd-12ab-34cd-56ef-1234567890ab").
+			Mock(&kmsMock{
+				RevokeGrantFunc: func(param0 *kms.RevokeGrantInput) (*kms.RevokeGrantOutput, error) {
+					return &kms.RevokeGrantOutput{}, nil
+				},
+			}).ExpectInput("RevokeGrant", &kms.RevokeGrantInput{
+			GrantId: String("0c237476b39f8bc44e45212e08498fbe"),
+			KeyId:   String("1234abcd-12ab-34cd-56ef-1234567890ab"),
+		}).ExpectCalls("RevokeGrant").Run(t)
+	})
+}
@@ -3,6 +3,7 @@ package awsat
 import (
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -37,6 +38,51 @@ func TestKeypair(t *testing.T) {
 			PublicKeyMaterial: []byte("encrypted keypair"),
 		}).
 			ExpectCommandResult("my-kp").ExpectCalls("ImportKeyPair").Run(t)
+
+		console.GenerateED25519SSHKeyPair = func() ([]byte, []byte, error) {
+			return []byte("ed25519 keypair"), []byte{}, nil
+		}
+		Template("create keypair name=my-ed-kp type=ed25519").
+			Mock(&ec2Mock{
+				ImportKeyPairFunc: func(param0 *ec2.ImportKeyPairInput) (*ec2.ImportKeyPairOutput, error) {
+					return &ec2.ImportKeyPairOutput{KeyName: String("my-ed-kp")}, nil
+				},
+			}).ExpectInput("ImportKeyPair", &ec2.ImportKeyPairInput{
+			KeyName:           String("my-ed-kp"),
+			PublicKeyMaterial: []byte("ed25519 keypair"),
+		}).
+			ExpectCommandResult("my-ed-kp").ExpectCalls("ImportKeyPair").Run(t)
+		if _, err := os.Stat(filepath.Join(tmpFolder, "my-ed-kp.pem")); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("import", func(t *testing.T) {
+		tmpFolder, err := ioutil.TempDir("", "tmpfolder")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(tmpFolder)
+		os.Setenv("__AWLESS_KEYS_DIR", tmpFolder)
+		pubKey := []byte("ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIPmjNViVZCoFb+aZY3OAUgXdNbvGAp0d6qtQ9KDboa1f jsmith@laptop\n")
+		pubKeyPath := filepath.Join(tmpFolder, "id_ed25519.pub")
+		if err = ioutil.WriteFile(pubKeyPath, pubKey, 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		Template("create keypair name=my-kp import="+pubKeyPath).
+			Mock(&ec2Mock{
+				ImportKeyPairFunc: func(param0 *ec2.ImportKeyPairInput) (*ec2.ImportKeyPairOutput, error) {
+					return &ec2.ImportKeyPairOutput{KeyName: String("my-kp")}, nil
+				},
+			}).ExpectInput("ImportKeyPair", &ec2.ImportKeyPairInput{
+			KeyName:           String("my-kp"),
+			PublicKeyMaterial: pubKey,
+		}).
+			ExpectCommandResult("my-kp").ExpectCalls("ImportKeyPair").Run(t)
+		if _, err := os.Stat(filepath.Join(tmpFolder, "my-kp.pem")); !os.IsNotExist(err) {
+			t.Fatal("no private key expected when importing a public key")
+		}
 	})
 
 	t.Run("delete", func(t *testing.T) {
@@ -0,0 +1,86 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/wallix/awless/aws/eks"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestKubernetescluster(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create kubernetescluster name=my-k8s role=arn:aws:iam::0123456789:role/eks-role subnets=[subnet-1,subnet-2] securitygroups=sg-1 version='1.10'").
+			Mock(&eksMock{
+				CreateClusterFunc: func(param0 *eks.CreateClusterInput) (*eks.CreateClusterOutput, error) {
+					return &eks.CreateClusterOutput{Cluster: &eks.Cluster{Name: String("my-k8s"), Status: String("CREATING")}}, nil
+				},
+			}).ExpectInput("CreateCluster", &eks.CreateClusterInput{
+			Name:    String("my-k8s"),
+			RoleArn: String("arn:aws:iam::0123456789:role/eks-role"),
+			ResourcesVpcConfig: &eks.VpcConfigRequest{
+				SubnetIds:        []*string{String("subnet-1"), String("subnet-2")},
+				SecurityGroupIds: []*string{String("sg-1")},
+			},
+			Version: String("1.10"),
+		}).ExpectCommandResult("my-k8s").ExpectCalls("CreateCluster").
+			ExpectRevert("check kubernetescluster name=my-k8s state=active timeout=1800\ndelete kubernetescluster name=my-k8s").Run(t)
+	})
+
+	t.Run("create with role name", func(t *testing.T) {
+		g := graph.NewGraph()
+		g.AddResource(resourcetest.Role("AROA1234").Prop(properties.Name, "eks-role").Prop(properties.Arn, "arn:aws:iam::0123456789:role/eks-role").Build())
+		Template("create kubernetescluster name=my-k8s role=eks-role subnets=[subnet-1,subnet-2]").
+			Mock(&eksMock{
+				CreateClusterFunc: func(param0 *eks.CreateClusterInput) (*eks.CreateClusterOutput, error) {
+					return &eks.CreateClusterOutput{Cluster: &eks.Cluster{Name: String("my-k8s"), Status: String("CREATING")}}, nil
+				},
+			}).Graph(g).ExpectInput("CreateCluster", &eks.CreateClusterInput{
+			Name:    String("my-k8s"),
+			RoleArn: String("arn:aws:iam::0123456789:role/eks-role"),
+			ResourcesVpcConfig: &eks.VpcConfigRequest{
+				SubnetIds: []*string{String("subnet-1"), String("subnet-2")},
+			},
+		}).ExpectCommandResult("my-k8s").ExpectCalls("CreateCluster").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete kubernetescluster name=my-k8s").
+			Mock(&eksMock{
+				DeleteClusterFunc: func(param0 *eks.DeleteClusterInput) (*eks.DeleteClusterOutput, error) {
+					return &eks.DeleteClusterOutput{Cluster: &eks.Cluster{Name: String("my-k8s"), Status: String("DELETING")}}, nil
+				},
+			}).ExpectInput("DeleteCluster", &eks.DeleteClusterInput{Name: String("my-k8s")}).
+			ExpectCalls("DeleteCluster").Run(t)
+	})
+
+	t.Run("wait", func(t *testing.T) {
+		Template("wait kubernetescluster name=my-k8s state=ACTIVE timeout=1m").
+			Mock(&eksMock{
+				DescribeClusterFunc: func(param0 *eks.DescribeClusterInput) (*eks.DescribeClusterOutput, error) {
+					return &eks.DescribeClusterOutput{Cluster: &eks.Cluster{
+						Name:                 String("my-k8s"),
+						Status:               String("ACTIVE"),
+						Endpoint:             String("https://ABCD1234.sk1.us-west-2.eks.amazonaws.com"),
+						CertificateAuthority: &eks.Certificate{Data: String("LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0t")},
+					}}, nil
+				},
+			}).ExpectInput("DescribeCluster", &eks.DescribeClusterInput{Name: String("my-k8s")}).
+			ExpectCommandResult(`{"name":"my-k8s","endpoint":"https://ABCD1234.sk1.us-west-2.eks.amazonaws.com","certificate-authority-data":"LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0t"}`).
+			ExpectCalls("DescribeCluster", "DescribeCluster").Run(t)
+	})
+
+	t.Run("check", func(t *testing.T) {
+		t.Run("deleted", func(t *testing.T) {
+			Template("check kubernetescluster name=my-k8s state=not-found timeout=1").
+				Mock(&eksMock{
+					DescribeClusterFunc: func(param0 *eks.DescribeClusterInput) (*eks.DescribeClusterOutput, error) {
+						return nil, awserr.New(eks.ErrCodeResourceNotFoundException, "No cluster found for name: my-k8s.", nil)
+					},
+				}).ExpectInput("DescribeCluster", &eks.DescribeClusterInput{Name: String("my-k8s")}).
+				ExpectCalls("DescribeCluster").Run(t)
+		})
+	})
+}
@@ -0,0 +1,86 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+)
+
+func TestLoggroup(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create loggroup name=/my-app/access-logs retention=30 key=arn:aws:kms:eu-west-1:123456789012:key/my-key").
+			Mock(&cloudwatchlogsMock{
+				CreateLogGroupFunc: func(param0 *cloudwatchlogs.CreateLogGroupInput) (*cloudwatchlogs.CreateLogGroupOutput, error) {
+					return &cloudwatchlogs.CreateLogGroupOutput{}, nil
+				},
+				PutRetentionPolicyFunc: func(param0 *cloudwatchlogs.PutRetentionPolicyInput) (*cloudwatchlogs.PutRetentionPolicyOutput, error) {
+					return &cloudwatchlogs.PutRetentionPolicyOutput{}, nil
+				},
+			}).ExpectInput("CreateLogGroup", &cloudwatchlogs.CreateLogGroupInput{
+			LogGroupName: String("/my-app/access-logs"),
+			KmsKeyId:     String("arn:aws:kms:eu-west-1:123456789012:key/my-key"),
+		}).ExpectInput("PutRetentionPolicy", &cloudwatchlogs.PutRetentionPolicyInput{
+			LogGroupName:    String("/my-app/access-logs"),
+			RetentionInDays: Int64(30),
+		}).ExpectCommandResult("/my-app/access-logs").ExpectCalls("CreateLogGroup", "PutRetentionPolicy").
+			ExpectRevert("delete loggroup name=/my-app/access-logs").Run(t)
+	})
+
+	t.Run("create without retention", func(t *testing.T) {
+		Template("create loggroup name=my-logs").
+			Mock(&cloudwatchlogsMock{
+				CreateLogGroupFunc: func(param0 *cloudwatchlogs.CreateLogGroupInput) (*cloudwatchlogs.CreateLogGroupOutput, error) {
+					return &cloudwatchlogs.CreateLogGroupOutput{}, nil
+				},
+			}).ExpectInput("CreateLogGroup", &cloudwatchlogs.CreateLogGroupInput{
+			LogGroupName: String("my-logs"),
+		}).ExpectCommandResult("my-logs").ExpectCalls("CreateLogGroup").Run(t)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		Template("update loggroup name=my-logs retention=90").
+			Mock(&cloudwatchlogsMock{
+				DescribeLogGroupsFunc: func(param0 *cloudwatchlogs.DescribeLogGroupsInput) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+					return &cloudwatchlogs.DescribeLogGroupsOutput{LogGroups: []*cloudwatchlogs.LogGroup{
+						{LogGroupName: String("my-logs-archive"), RetentionInDays: Int64(365)},
+						{LogGroupName: String("my-logs"), RetentionInDays: Int64(14)},
+					}}, nil
+				},
+				PutRetentionPolicyFunc: func(param0 *cloudwatchlogs.PutRetentionPolicyInput) (*cloudwatchlogs.PutRetentionPolicyOutput, error) {
+					return &cloudwatchlogs.PutRetentionPolicyOutput{}, nil
+				},
+			}).ExpectInput("DescribeLogGroups", &cloudwatchlogs.DescribeLogGroupsInput{LogGroupNamePrefix: String("my-logs")}).
+			ExpectInput("PutRetentionPolicy", &cloudwatchlogs.PutRetentionPolicyInput{
+				LogGroupName:    String("my-logs"),
+				RetentionInDays: Int64(90),
+			}).ExpectCalls("DescribeLogGroups", "PutRetentionPolicy").
+			ExpectRevert("update loggroup name=my-logs retention=14").Run(t)
+	})
+
+	t.Run("update to never expire", func(t *testing.T) {
+		Template("update loggroup name=my-logs retention=0").
+			Mock(&cloudwatchlogsMock{
+				DescribeLogGroupsFunc: func(param0 *cloudwatchlogs.DescribeLogGroupsInput) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+					return &cloudwatchlogs.DescribeLogGroupsOutput{LogGroups: []*cloudwatchlogs.LogGroup{
+						{LogGroupName: String("my-logs"), RetentionInDays: Int64(7)},
+					}}, nil
+				},
+				DeleteRetentionPolicyFunc: func(param0 *cloudwatchlogs.DeleteRetentionPolicyInput) (*cloudwatchlogs.DeleteRetentionPolicyOutput, error) {
+					return &cloudwatchlogs.DeleteRetentionPolicyOutput{}, nil
+				},
+			}).ExpectInput("DescribeLogGroups", &cloudwatchlogs.DescribeLogGroupsInput{LogGroupNamePrefix: String("my-logs")}).
+			ExpectInput("DeleteRetentionPolicy", &cloudwatchlogs.DeleteRetentionPolicyInput{LogGroupName: String("my-logs")}).
+			ExpectCalls("DescribeLogGroups", "DeleteRetentionPolicy").
+			ExpectRevert("update loggroup name=my-logs retention=7").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete loggroup name=my-logs").
+			Mock(&cloudwatchlogsMock{
+				DeleteLogGroupFunc: func(param0 *cloudwatchlogs.DeleteLogGroupInput) (*cloudwatchlogs.DeleteLogGroupOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DeleteLogGroup", &cloudwatchlogs.DeleteLogGroupInput{LogGroupName: String("my-logs")}).
+			ExpectCalls("DeleteLogGroup").Run(t)
+	})
+}
@@ -3,6 +3,13 @@ package awsat
 import (
 	"reflect"
 	"testing"
+
+	"github.com/wallix/awless/aws/ec2transitgateway"
+	"github.com/wallix/awless/aws/ec2transitgateway/ec2transitgatewayiface"
+	"github.com/wallix/awless/aws/eks"
+	"github.com/wallix/awless/aws/eks/eksiface"
+	"github.com/wallix/awless/aws/secretsmanager"
+	"github.com/wallix/awless/aws/secretsmanager/secretsmanageriface"
 )
 
 type mock interface {
@@ -54,3 +61,140 @@ func (m *basicMock) verifyInput(call string, got interface{}) {
 		m.t.Fatalf("got %#v, want %#v", got, want)
 	}
 }
+
+// secretsmanagerMock is written by hand, the Secrets Manager client not being in the SDK
+type secretsmanagerMock struct {
+	basicMock
+	secretsmanageriface.SecretsManagerAPI
+	CreateSecretFunc func(param0 *secretsmanager.CreateSecretInput) (*secretsmanager.CreateSecretOutput, error)
+	UpdateSecretFunc func(param0 *secretsmanager.UpdateSecretInput) (*secretsmanager.UpdateSecretOutput, error)
+	DeleteSecretFunc func(param0 *secretsmanager.DeleteSecretInput) (*secretsmanager.DeleteSecretOutput, error)
+}
+
+func (m *secretsmanagerMock) CreateSecret(param0 *secretsmanager.CreateSecretInput) (*secretsmanager.CreateSecretOutput, error) {
+	m.addCall("CreateSecret")
+	m.verifyInput("CreateSecret", param0)
+	return m.CreateSecretFunc(param0)
+}
+
+func (m *secretsmanagerMock) UpdateSecret(param0 *secretsmanager.UpdateSecretInput) (*secretsmanager.UpdateSecretOutput, error) {
+	m.addCall("UpdateSecret")
+	m.verifyInput("UpdateSecret", param0)
+	return m.UpdateSecretFunc(param0)
+}
+
+func (m *secretsmanagerMock) DeleteSecret(param0 *secretsmanager.DeleteSecretInput) (*secretsmanager.DeleteSecretOutput, error) {
+	m.addCall("DeleteSecret")
+	m.verifyInput("DeleteSecret", param0)
+	return m.DeleteSecretFunc(param0)
+}
+
+// eksMock is written by hand, the EKS client not being in the SDK
+type eksMock struct {
+	basicMock
+	eksiface.EKSAPI
+	CreateClusterFunc   func(param0 *eks.CreateClusterInput) (*eks.CreateClusterOutput, error)
+	DeleteClusterFunc   func(param0 *eks.DeleteClusterInput) (*eks.DeleteClusterOutput, error)
+	DescribeClusterFunc func(param0 *eks.DescribeClusterInput) (*eks.DescribeClusterOutput, error)
+}
+
+func (m *eksMock) CreateCluster(param0 *eks.CreateClusterInput) (*eks.CreateClusterOutput, error) {
+	m.addCall("CreateCluster")
+	m.verifyInput("CreateCluster", param0)
+	return m.CreateClusterFunc(param0)
+}
+
+func (m *eksMock) DeleteCluster(param0 *eks.DeleteClusterInput) (*eks.DeleteClusterOutput, error) {
+	m.addCall("DeleteCluster")
+	m.verifyInput("DeleteCluster", param0)
+	return m.DeleteClusterFunc(param0)
+}
+
+func (m *eksMock) DescribeCluster(param0 *eks.DescribeClusterInput) (*eks.DescribeClusterOutput, error) {
+	m.addCall("DescribeCluster")
+	m.verifyInput("DescribeCluster", param0)
+	return m.DescribeClusterFunc(param0)
+}
+
+// ec2transitgatewayMock is written by hand, the transit gateway calls not being in the vendored EC2 client
+type ec2transitgatewayMock struct {
+	basicMock
+	ec2transitgatewayiface.EC2TransitGatewayAPI
+	CreateTransitGatewayFunc                 func(param0 *ec2transitgateway.CreateTransitGatewayInput) (*ec2transitgateway.CreateTransitGatewayOutput, error)
+	DeleteTransitGatewayFunc                 func(param0 *ec2transitgateway.DeleteTransitGatewayInput) (*ec2transitgateway.DeleteTransitGatewayOutput, error)
+	DescribeTransitGatewaysFunc              func(param0 *ec2transitgateway.DescribeTransitGatewaysInput) (*ec2transitgateway.DescribeTransitGatewaysOutput, error)
+	CreateTransitGatewayVpcAttachmentFunc    func(param0 *ec2transitgateway.CreateTransitGatewayVpcAttachmentInput) (*ec2transitgateway.CreateTransitGatewayVpcAttachmentOutput, error)
+	DeleteTransitGatewayVpcAttachmentFunc    func(param0 *ec2transitgateway.DeleteTransitGatewayVpcAttachmentInput) (*ec2transitgateway.DeleteTransitGatewayVpcAttachmentOutput, error)
+	DescribeTransitGatewayAttachmentsFunc    func(param0 *ec2transitgateway.DescribeTransitGatewayAttachmentsInput) (*ec2transitgateway.DescribeTransitGatewayAttachmentsOutput, error)
+	CreateTransitGatewayRouteTableFunc       func(param0 *ec2transitgateway.CreateTransitGatewayRouteTableInput) (*ec2transitgateway.CreateTransitGatewayRouteTableOutput, error)
+	DeleteTransitGatewayRouteTableFunc       func(param0 *ec2transitgateway.DeleteTransitGatewayRouteTableInput) (*ec2transitgateway.DeleteTransitGatewayRouteTableOutput, error)
+	DescribeTransitGatewayRouteTablesFunc    func(param0 *ec2transitgateway.DescribeTransitGatewayRouteTablesInput) (*ec2transitgateway.DescribeTransitGatewayRouteTablesOutput, error)
+	AssociateTransitGatewayRouteTableFunc    func(param0 *ec2transitgateway.AssociateTransitGatewayRouteTableInput) (*ec2transitgateway.AssociateTransitGatewayRouteTableOutput, error)
+	DisassociateTransitGatewayRouteTableFunc func(param0 *ec2transitgateway.DisassociateTransitGatewayRouteTableInput) (*ec2transitgateway.DisassociateTransitGatewayRouteTableOutput, error)
+}
+
+func (m *ec2transitgatewayMock) CreateTransitGateway(param0 *ec2transitgateway.CreateTransitGatewayInput) (*ec2transitgateway.CreateTransitGatewayOutput, error) {
+	m.addCall("CreateTransitGateway")
+	m.verifyInput("CreateTransitGateway", param0)
+	return m.CreateTransitGatewayFunc(param0)
+}
+
+func (m *ec2transitgatewayMock) DeleteTransitGateway(param0 *ec2transitgateway.DeleteTransitGatewayInput) (*ec2transitgateway.DeleteTransitGatewayOutput, error) {
+	m.addCall("DeleteTransitGateway")
+	m.verifyInput("DeleteTransitGateway", param0)
+	return m.DeleteTransitGatewayFunc(param0)
+}
+
+func (m *ec2transitgatewayMock) DescribeTransitGateways(param0 *ec2transitgateway.DescribeTransitGatewaysInput) (*ec2transitgateway.DescribeTransitGatewaysOutput, error) {
+	m.addCall("DescribeTransitGateways")
+	m.verifyInput("DescribeTransitGateways", param0)
+	return m.DescribeTransitGatewaysFunc(param0)
+}
+
+func (m *ec2transitgatewayMock) CreateTransitGatewayVpcAttachment(param0 *ec2transitgateway.CreateTransitGatewayVpcAttachmentInput) (*ec2transitgateway.CreateTransitGatewayVpcAttachmentOutput, error) {
+	m.addCall("CreateTransitGatewayVpcAttachment")
+	m.verifyInput("CreateTransitGatewayVpcAttachment", param0)
+	return m.CreateTransitGatewayVpcAttachmentFunc(param0)
+}
+
+func (m *ec2transitgatewayMock) DeleteTransitGatewayVpcAttachment(param0 *ec2transitgateway.DeleteTransitGatewayVpcAttachmentInput) (*ec2transitgateway.DeleteTransitGatewayVpcAttachmentOutput, error) {
+	m.addCall("DeleteTransitGatewayVpcAttachment")
+	m.verifyInput("DeleteTransitGatewayVpcAttachment", param0)
+	return m.DeleteTransitGatewayVpcAttachmentFunc(param0)
+}
+
+func (m *ec2transitgatewayMock) DescribeTransitGatewayAttachments(param0 *ec2transitgateway.DescribeTransitGatewayAttachmentsInput) (*ec2transitgateway.DescribeTransitGatewayAttachmentsOutput, error) {
+	m.addCall("DescribeTransitGatewayAttachments")
+	m.verifyInput("DescribeTransitGatewayAttachments", param0)
+	return m.DescribeTransitGatewayAttachmentsFunc(param0)
+}
+
+func (m *ec2transitgatewayMock) CreateTransitGatewayRouteTable(param0 *ec2transitgateway.CreateTransitGatewayRouteTableInput) (*ec2transitgateway.CreateTransitGatewayRouteTableOutput, error) {
+	m.addCall("CreateTransitGatewayRouteTable")
+	m.verifyInput("CreateTransitGatewayRouteTable", param0)
+	return m.CreateTransitGatewayRouteTableFunc(param0)
+}
+
+func (m *ec2transitgatewayMock) DeleteTransitGatewayRouteTable(param0 *ec2transitgateway.DeleteTransitGatewayRouteTableInput) (*ec2transitgateway.DeleteTransitGatewayRouteTableOutput, error) {
+	m.addCall("DeleteTransitGatewayRouteTable")
+	m.verifyInput("DeleteTransitGatewayRouteTable", param0)
+	return m.DeleteTransitGatewayRouteTableFunc(param0)
+}
+
+func (m *ec2transitgatewayMock) DescribeTransitGatewayRouteTables(param0 *ec2transitgateway.DescribeTransitGatewayRouteTablesInput) (*ec2transitgateway.DescribeTransitGatewayRouteTablesOutput, error) {
+	m.addCall("DescribeTransitGatewayRouteTables")
+	m.verifyInput("DescribeTransitGatewayRouteTables", param0)
+	return m.DescribeTransitGatewayRouteTablesFunc(param0)
+}
+
+func (m *ec2transitgatewayMock) AssociateTransitGatewayRouteTable(param0 *ec2transitgateway.AssociateTransitGatewayRouteTableInput) (*ec2transitgateway.AssociateTransitGatewayRouteTableOutput, error) {
+	m.addCall("AssociateTransitGatewayRouteTable")
+	m.verifyInput("AssociateTransitGatewayRouteTable", param0)
+	return m.AssociateTransitGatewayRouteTableFunc(param0)
+}
+
+func (m *ec2transitgatewayMock) DisassociateTransitGatewayRouteTable(param0 *ec2transitgateway.DisassociateTransitGatewayRouteTableInput) (*ec2transitgateway.DisassociateTransitGatewayRouteTableOutput, error) {
+	m.addCall("DisassociateTransitGatewayRouteTable")
+	m.verifyInput("DisassociateTransitGatewayRouteTable", param0)
+	return m.DisassociateTransitGatewayRouteTableFunc(param0)
+}
@@ -20,6 +20,24 @@ func TestNATGateway(t *testing.T) {
 			ExpectCommandResult("new-natgateway-id").ExpectCalls("CreateNatGateway").Run(t)
 	})
 
+	t.Run("create allocating elastic IP", func(t *testing.T) {
+		Template("create natgateway subnet=sub-23456").
+			Mock(&ec2Mock{
+				AllocateAddressFunc: func(param0 *ec2.AllocateAddressInput) (*ec2.AllocateAddressOutput, error) {
+					return &ec2.AllocateAddressOutput{AllocationId: String("eipalloc-1234"), PublicIp: String("1.2.3.4")}, nil
+				},
+				CreateNatGatewayFunc: func(param0 *ec2.CreateNatGatewayInput) (*ec2.CreateNatGatewayOutput, error) {
+					return &ec2.CreateNatGatewayOutput{NatGateway: &ec2.NatGateway{NatGatewayId: String("new-natgateway-id")}}, nil
+				},
+			}).ExpectInput("AllocateAddress", &ec2.AllocateAddressInput{Domain: String("vpc")}).
+			ExpectInput("CreateNatGateway", &ec2.CreateNatGatewayInput{
+				AllocationId: String("eipalloc-1234"),
+				SubnetId:     String("sub-23456"),
+			}).
+			ExpectCommandResult("new-natgateway-id").ExpectCalls("AllocateAddress", "CreateNatGateway").
+			ExpectRevert("delete natgateway id=new-natgateway-id release-elasticip=true").Run(t)
+	})
+
 	t.Run("delete", func(t *testing.T) {
 		Template("delete natgateway id=ngw-1234").
 			Mock(&ec2Mock{
@@ -30,6 +48,32 @@ func TestNATGateway(t *testing.T) {
 			ExpectCalls("DeleteNatGateway").Run(t)
 	})
 
+	t.Run("delete releasing elastic IP", func(t *testing.T) {
+		var described int
+		Template("delete natgateway id=ngw-1234 release-elasticip=true").
+			Mock(&ec2Mock{
+				DescribeNatGatewaysFunc: func(param0 *ec2.DescribeNatGatewaysInput) (*ec2.DescribeNatGatewaysOutput, error) {
+					described++
+					state := "available"
+					if described > 1 {
+						state = "deleted"
+					}
+					return &ec2.DescribeNatGatewaysOutput{NatGateways: []*ec2.NatGateway{
+						{NatGatewayId: String("ngw-1234"), State: String(state), NatGatewayAddresses: []*ec2.NatGatewayAddress{{AllocationId: String("eipalloc-1234")}}},
+					}}, nil
+				},
+				DeleteNatGatewayFunc: func(param0 *ec2.DeleteNatGatewayInput) (*ec2.DeleteNatGatewayOutput, error) {
+					return &ec2.DeleteNatGatewayOutput{NatGatewayId: String("ngw-1234")}, nil
+				},
+				ReleaseAddressFunc: func(param0 *ec2.ReleaseAddressInput) (*ec2.ReleaseAddressOutput, error) {
+					return &ec2.ReleaseAddressOutput{}, nil
+				},
+			}).ExpectInput("DescribeNatGateways", &ec2.DescribeNatGatewaysInput{NatGatewayIds: []*string{String("ngw-1234")}}).
+			ExpectInput("DeleteNatGateway", &ec2.DeleteNatGatewayInput{NatGatewayId: String("ngw-1234")}).
+			ExpectInput("ReleaseAddress", &ec2.ReleaseAddressInput{AllocationId: String("eipalloc-1234")}).
+			ExpectCalls("DescribeNatGateways", "DeleteNatGateway", "DescribeNatGateways", "ReleaseAddress").Run(t)
+	})
+
 	t.Run("check", func(t *testing.T) {
 		Template("check natgateway id=ngw-1234 state=available timeout=1").
 			Mock(&ec2Mock{
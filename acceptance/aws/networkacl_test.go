@@ -0,0 +1,146 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestNetworkacl(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create networkacl vpc=vpc-1234").
+			Mock(&ec2Mock{
+				CreateNetworkAclFunc: func(input *ec2.CreateNetworkAclInput) (*ec2.CreateNetworkAclOutput, error) {
+					return &ec2.CreateNetworkAclOutput{NetworkAcl: &ec2.NetworkAcl{NetworkAclId: String("acl-1234")}}, nil
+				},
+			}).ExpectInput("CreateNetworkAcl", &ec2.CreateNetworkAclInput{VpcId: String("vpc-1234")}).
+			ExpectCommandResult("acl-1234").ExpectCalls("CreateNetworkAcl").
+			ExpectRevert("delete networkacl id=acl-1234").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete networkacl id=acl-1234").
+			Mock(&ec2Mock{
+				DeleteNetworkAclFunc: func(input *ec2.DeleteNetworkAclInput) (*ec2.DeleteNetworkAclOutput, error) {
+					return &ec2.DeleteNetworkAclOutput{}, nil
+				},
+			}).ExpectInput("DeleteNetworkAcl", &ec2.DeleteNetworkAclInput{NetworkAclId: String("acl-1234")}).
+			ExpectCalls("DeleteNetworkAcl").Run(t)
+	})
+
+	t.Run("attach", func(t *testing.T) {
+		Template("attach networkacl id=acl-1234 subnet=sub-1234").
+			Mock(&ec2Mock{
+				DescribeNetworkAclsFunc: func(input *ec2.DescribeNetworkAclsInput) (*ec2.DescribeNetworkAclsOutput, error) {
+					return &ec2.DescribeNetworkAclsOutput{NetworkAcls: []*ec2.NetworkAcl{
+						{NetworkAclId: String("acl-default"), VpcId: String("vpc-1234"), IsDefault: Bool(true), Associations: []*ec2.NetworkAclAssociation{
+							{NetworkAclAssociationId: String("aclassoc-1"), NetworkAclId: String("acl-default"), SubnetId: String("sub-1234")},
+						}},
+					}}, nil
+				},
+				ReplaceNetworkAclAssociationFunc: func(input *ec2.ReplaceNetworkAclAssociationInput) (*ec2.ReplaceNetworkAclAssociationOutput, error) {
+					return &ec2.ReplaceNetworkAclAssociationOutput{NewAssociationId: String("aclassoc-2")}, nil
+				},
+			}).ExpectInput("DescribeNetworkAcls", &ec2.DescribeNetworkAclsInput{Filters: []*ec2.Filter{
+			{Name: String("association.subnet-id"), Values: []*string{String("sub-1234")}},
+		}}).ExpectInput("ReplaceNetworkAclAssociation", &ec2.ReplaceNetworkAclAssociationInput{AssociationId: String("aclassoc-1"), NetworkAclId: String("acl-1234")}).
+			ExpectCommandResult("aclassoc-2").ExpectCalls("DescribeNetworkAcls", "DescribeNetworkAcls", "ReplaceNetworkAclAssociation").
+			ExpectRevert("attach networkacl id=acl-default subnet=sub-1234").Run(t)
+	})
+
+	t.Run("detach", func(t *testing.T) {
+		Template("detach networkacl subnet=sub-1234").
+			Mock(&ec2Mock{
+				DescribeNetworkAclsFunc: func(input *ec2.DescribeNetworkAclsInput) (*ec2.DescribeNetworkAclsOutput, error) {
+					if StringValue(input.Filters[0].Name) == "vpc-id" {
+						return &ec2.DescribeNetworkAclsOutput{NetworkAcls: []*ec2.NetworkAcl{{NetworkAclId: String("acl-default"), VpcId: String("vpc-1234"), IsDefault: Bool(true)}}}, nil
+					}
+					return &ec2.DescribeNetworkAclsOutput{NetworkAcls: []*ec2.NetworkAcl{
+						{NetworkAclId: String("acl-1234"), VpcId: String("vpc-1234"), IsDefault: Bool(false), Associations: []*ec2.NetworkAclAssociation{
+							{NetworkAclAssociationId: String("aclassoc-1"), NetworkAclId: String("acl-1234"), SubnetId: String("sub-1234")},
+						}},
+					}}, nil
+				},
+				ReplaceNetworkAclAssociationFunc: func(input *ec2.ReplaceNetworkAclAssociationInput) (*ec2.ReplaceNetworkAclAssociationOutput, error) {
+					return &ec2.ReplaceNetworkAclAssociationOutput{NewAssociationId: String("aclassoc-2")}, nil
+				},
+			}).IgnoreInput("DescribeNetworkAcls").
+			ExpectInput("ReplaceNetworkAclAssociation", &ec2.ReplaceNetworkAclAssociationInput{AssociationId: String("aclassoc-1"), NetworkAclId: String("acl-default")}).
+			ExpectCommandResult("aclassoc-2").ExpectCalls("DescribeNetworkAcls", "DescribeNetworkAcls", "DescribeNetworkAcls", "ReplaceNetworkAclAssociation").
+			ExpectRevert("attach networkacl id=acl-1234 subnet=sub-1234").Run(t)
+	})
+}
+
+func TestNetworkaclrule(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		t.Run("tcp", func(t *testing.T) {
+			Template("create networkaclrule networkacl=acl-1234 number=100 action=allow protocol=tcp portrange=1024-65535 cidr=0.0.0.0/0").
+				Mock(&ec2Mock{
+					CreateNetworkAclEntryFunc: func(input *ec2.CreateNetworkAclEntryInput) (*ec2.CreateNetworkAclEntryOutput, error) {
+						return &ec2.CreateNetworkAclEntryOutput{}, nil
+					},
+				}).ExpectInput("CreateNetworkAclEntry", &ec2.CreateNetworkAclEntryInput{
+				NetworkAclId: String("acl-1234"),
+				RuleNumber:   Int64(100),
+				RuleAction:   String("allow"),
+				Protocol:     String("6"),
+				PortRange:    &ec2.PortRange{From: Int64(1024), To: Int64(65535)},
+				CidrBlock:    String("0.0.0.0/0"),
+				Egress:       Bool(false),
+			}).ExpectCalls("CreateNetworkAclEntry").
+				ExpectRevert("delete networkaclrule networkacl=acl-1234 number=100").Run(t)
+		})
+
+		t.Run("outbound icmp ipv6", func(t *testing.T) {
+			Template("create networkaclrule networkacl=acl-1234 number=110 action=deny protocol=icmpv6 cidr=::/0 outbound=true").
+				Mock(&ec2Mock{
+					CreateNetworkAclEntryFunc: func(input *ec2.CreateNetworkAclEntryInput) (*ec2.CreateNetworkAclEntryOutput, error) {
+						return &ec2.CreateNetworkAclEntryOutput{}, nil
+					},
+				}).ExpectInput("CreateNetworkAclEntry", &ec2.CreateNetworkAclEntryInput{
+				NetworkAclId:  String("acl-1234"),
+				RuleNumber:    Int64(110),
+				RuleAction:    String("deny"),
+				Protocol:      String("58"),
+				IcmpTypeCode:  &ec2.IcmpTypeCode{Type: Int64(-1), Code: Int64(-1)},
+				Ipv6CidrBlock: String("::/0"),
+				Egress:        Bool(true),
+			}).ExpectCalls("CreateNetworkAclEntry").
+				ExpectRevert("delete networkaclrule networkacl=acl-1234 number=110 outbound=true").Run(t)
+		})
+	})
+
+	t.Run("update", func(t *testing.T) {
+		Template("update networkaclrule networkacl=acl-1234 number=100 action=deny protocol=any cidr=10.0.0.0/8").
+			Mock(&ec2Mock{
+				DescribeNetworkAclsFunc: func(input *ec2.DescribeNetworkAclsInput) (*ec2.DescribeNetworkAclsOutput, error) {
+					return &ec2.DescribeNetworkAclsOutput{NetworkAcls: []*ec2.NetworkAcl{{NetworkAclId: String("acl-1234"), Entries: []*ec2.NetworkAclEntry{
+						{RuleNumber: Int64(100), Egress: Bool(true), RuleAction: String("allow"), Protocol: String("-1"), CidrBlock: String("0.0.0.0/0")},
+						{RuleNumber: Int64(100), Egress: Bool(false), RuleAction: String("allow"), Protocol: String("6"), CidrBlock: String("0.0.0.0/0"), PortRange: &ec2.PortRange{From: Int64(22), To: Int64(22)}},
+					}}}}, nil
+				},
+				ReplaceNetworkAclEntryFunc: func(input *ec2.ReplaceNetworkAclEntryInput) (*ec2.ReplaceNetworkAclEntryOutput, error) {
+					return &ec2.ReplaceNetworkAclEntryOutput{}, nil
+				},
+			}).ExpectInput("DescribeNetworkAcls", &ec2.DescribeNetworkAclsInput{NetworkAclIds: []*string{String("acl-1234")}}).
+			ExpectInput("ReplaceNetworkAclEntry", &ec2.ReplaceNetworkAclEntryInput{
+				NetworkAclId: String("acl-1234"),
+				RuleNumber:   Int64(100),
+				RuleAction:   String("deny"),
+				Protocol:     String("-1"),
+				CidrBlock:    String("10.0.0.0/8"),
+				Egress:       Bool(false),
+			}).ExpectCalls("DescribeNetworkAcls", "ReplaceNetworkAclEntry").
+			ExpectRevert("update networkaclrule action=allow cidr=0.0.0.0/0 networkacl=acl-1234 number=100 portrange=22 protocol=tcp").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete networkaclrule networkacl=acl-1234 number=100 outbound=true").
+			Mock(&ec2Mock{
+				DeleteNetworkAclEntryFunc: func(input *ec2.DeleteNetworkAclEntryInput) (*ec2.DeleteNetworkAclEntryOutput, error) {
+					return &ec2.DeleteNetworkAclEntryOutput{}, nil
+				},
+			}).ExpectInput("DeleteNetworkAclEntry", &ec2.DeleteNetworkAclEntryInput{NetworkAclId: String("acl-1234"), RuleNumber: Int64(100), Egress: Bool(true)}).
+			ExpectCalls("DeleteNetworkAclEntry").Run(t)
+	})
+}
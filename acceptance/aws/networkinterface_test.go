@@ -22,6 +22,54 @@ func TestNetworkInterface(t *testing.T) {
 			ExpectCommandResult("new-networkinterface-id").ExpectCalls("CreateNetworkInterface").Run(t)
 	})
 
+	t.Run("create with secondary private ips", func(t *testing.T) {
+		Template("create networkinterface subnet=sub-1234 privateip=10.0.0.10 secondary-privateips=[10.0.0.11,10.0.0.12]").
+			Mock(&ec2Mock{
+				CreateNetworkInterfaceFunc: func(param0 *ec2.CreateNetworkInterfaceInput) (*ec2.CreateNetworkInterfaceOutput, error) {
+					return &ec2.CreateNetworkInterfaceOutput{NetworkInterface: &ec2.NetworkInterface{NetworkInterfaceId: String("new-networkinterface-id")}}, nil
+				},
+				AssignPrivateIpAddressesFunc: func(param0 *ec2.AssignPrivateIpAddressesInput) (*ec2.AssignPrivateIpAddressesOutput, error) {
+					return &ec2.AssignPrivateIpAddressesOutput{}, nil
+				},
+			}).ExpectInput("CreateNetworkInterface", &ec2.CreateNetworkInterfaceInput{
+			SubnetId:         String("sub-1234"),
+			PrivateIpAddress: String("10.0.0.10"),
+		}).ExpectInput("AssignPrivateIpAddresses", &ec2.AssignPrivateIpAddressesInput{
+			NetworkInterfaceId: String("new-networkinterface-id"),
+			PrivateIpAddresses: []*string{String("10.0.0.11"), String("10.0.0.12")},
+		}).ExpectCommandResult("new-networkinterface-id").ExpectCalls("CreateNetworkInterface", "AssignPrivateIpAddresses").
+			ExpectRevert("delete networkinterface id=new-networkinterface-id").Run(t)
+	})
+
+	t.Run("create with secondary count", func(t *testing.T) {
+		Template("create networkinterface subnet=sub-1234 secondary-count=2").
+			Mock(&ec2Mock{
+				CreateNetworkInterfaceFunc: func(param0 *ec2.CreateNetworkInterfaceInput) (*ec2.CreateNetworkInterfaceOutput, error) {
+					return &ec2.CreateNetworkInterfaceOutput{NetworkInterface: &ec2.NetworkInterface{NetworkInterfaceId: String("new-networkinterface-id")}}, nil
+				},
+			}).ExpectInput("CreateNetworkInterface", &ec2.CreateNetworkInterfaceInput{
+			SubnetId:                       String("sub-1234"),
+			SecondaryPrivateIpAddressCount: Int64(2),
+		}).ExpectCommandResult("new-networkinterface-id").ExpectCalls("CreateNetworkInterface").Run(t)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		Template("update networkinterface id=ni-1234 securitygroups=[sg-1234,sg-2345] source-dest-check=false").
+			Mock(&ec2Mock{
+				DescribeNetworkInterfacesFunc: func(param0 *ec2.DescribeNetworkInterfacesInput) (*ec2.DescribeNetworkInterfacesOutput, error) {
+					return &ec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: []*ec2.NetworkInterface{
+						{NetworkInterfaceId: String("ni-1234"), Groups: []*ec2.GroupIdentifier{{GroupId: String("sg-default")}}, SourceDestCheck: Bool(true)},
+					}}, nil
+				},
+				ModifyNetworkInterfaceAttributeFunc: func(param0 *ec2.ModifyNetworkInterfaceAttributeInput) (*ec2.ModifyNetworkInterfaceAttributeOutput, error) {
+					return &ec2.ModifyNetworkInterfaceAttributeOutput{}, nil
+				},
+			}).ExpectInput("DescribeNetworkInterfaces", &ec2.DescribeNetworkInterfacesInput{NetworkInterfaceIds: []*string{String("ni-1234")}}).
+			IgnoreInput("ModifyNetworkInterfaceAttribute").
+			ExpectCalls("DescribeNetworkInterfaces", "ModifyNetworkInterfaceAttribute", "ModifyNetworkInterfaceAttribute").
+			ExpectRevert("update networkinterface id=ni-1234 securitygroups=[sg-default] source-dest-check=true").Run(t)
+	})
+
 	t.Run("delete", func(t *testing.T) {
 		Template("delete networkinterface id=ni-1234").
 			Mock(&ec2Mock{
@@ -47,20 +95,32 @@ func TestNetworkInterface(t *testing.T) {
 	})
 
 	t.Run("detach", func(t *testing.T) {
+		attached := &ec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: []*ec2.NetworkInterface{
+			{NetworkInterfaceId: String("ni-1234"), Attachment: &ec2.NetworkInterfaceAttachment{AttachmentId: String("my-attachment-id"), InstanceId: String("i-2345"), DeviceIndex: Int64(1)}},
+		}}
 		t.Run("with attachment id", func(t *testing.T) {
-			Template("detach networkinterface attachment=id-of-attachment").
+			Template("detach networkinterface attachment=my-attachment-id").
 				Mock(&ec2Mock{
+					DescribeNetworkInterfacesFunc: func(param0 *ec2.DescribeNetworkInterfacesInput) (*ec2.DescribeNetworkInterfacesOutput, error) {
+						return attached, nil
+					},
 					DetachNetworkInterfaceFunc: func(param0 *ec2.DetachNetworkInterfaceInput) (*ec2.DetachNetworkInterfaceOutput, error) {
 						return nil, nil
 					},
-				}).ExpectInput("DetachNetworkInterface", &ec2.DetachNetworkInterfaceInput{
-				AttachmentId: String("id-of-attachment"),
+				}).ExpectInput("DescribeNetworkInterfaces", &ec2.DescribeNetworkInterfacesInput{
+				Filters: []*ec2.Filter{{Name: String("attachment.attachment-id"), Values: []*string{String("my-attachment-id")}}},
+			}).ExpectInput("DetachNetworkInterface", &ec2.DetachNetworkInterfaceInput{
+				AttachmentId: String("my-attachment-id"),
 			}).
-				ExpectCalls("DetachNetworkInterface").Run(t)
+				ExpectCalls("DescribeNetworkInterfaces", "DetachNetworkInterface").
+				ExpectRevert("attach networkinterface device-index=1 id=ni-1234 instance=i-2345").Run(t)
 		})
 		t.Run("with instance and network interface", func(t *testing.T) {
 			Template("detach networkinterface id=ni-1234 instance=i-2345 force=true").
 				Mock(&ec2Mock{
+					DescribeNetworkInterfacesFunc: func(param0 *ec2.DescribeNetworkInterfacesInput) (*ec2.DescribeNetworkInterfacesOutput, error) {
+						return attached, nil
+					},
 					DescribeInstancesFunc: func(param0 *ec2.DescribeInstancesInput) (*ec2.DescribeInstancesOutput, error) {
 						return &ec2.DescribeInstancesOutput{Reservations: []*ec2.Reservation{{
 							Instances: []*ec2.Instance{
@@ -73,7 +133,9 @@ func TestNetworkInterface(t *testing.T) {
 					DetachNetworkInterfaceFunc: func(param0 *ec2.DetachNetworkInterfaceInput) (*ec2.DetachNetworkInterfaceOutput, error) {
 						return nil, nil
 					},
-				}).ExpectInput("DescribeInstances", &ec2.DescribeInstancesInput{
+				}).ExpectInput("DescribeNetworkInterfaces", &ec2.DescribeNetworkInterfacesInput{
+				NetworkInterfaceIds: []*string{String("ni-1234")},
+			}).ExpectInput("DescribeInstances", &ec2.DescribeInstancesInput{
 				Filters: []*ec2.Filter{
 					{Name: String("network-interface.network-interface-id"), Values: []*string{String("ni-1234")}},
 					{Name: String("instance-id"), Values: []*string{String("i-2345")}},
@@ -82,7 +144,8 @@ func TestNetworkInterface(t *testing.T) {
 				AttachmentId: String("my-attachment-id"),
 				Force:        Bool(true),
 			}).
-				ExpectCalls("DescribeInstances", "DetachNetworkInterface").Run(t)
+				ExpectCalls("DescribeNetworkInterfaces", "DescribeInstances", "DetachNetworkInterface").
+				ExpectRevert("attach networkinterface device-index=1 id=ni-1234 instance=i-2345").Run(t)
 		})
 	})
 	t.Run("check", func(t *testing.T) {
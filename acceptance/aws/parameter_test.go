@@ -0,0 +1,85 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/wallix/awless/aws/spec"
+)
+
+func TestParameter(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create parameter name=/my-app/db-password value=s3cr3t secure=true description='Database password'").
+			Mock(&ssmMock{
+				PutParameterFunc: func(param0 *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+					return &ssm.PutParameterOutput{Version: Int64(1)}, nil
+				},
+			}).ExpectInput("PutParameter", &ssm.PutParameterInput{
+			Name:        String("/my-app/db-password"),
+			Value:       String("s3cr3t"),
+			Description: String("Database password"),
+			Type:        String("SecureString"),
+		}).ExpectCommandResult("/my-app/db-password").ExpectCalls("PutParameter").
+			ExpectRevert("delete parameter name=/my-app/db-password").Run(t)
+	})
+
+	t.Run("create not secure", func(t *testing.T) {
+		Template("create parameter name=/my-app/db-host value=db.internal").
+			Mock(&ssmMock{
+				PutParameterFunc: func(param0 *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+					return &ssm.PutParameterOutput{Version: Int64(1)}, nil
+				},
+			}).ExpectInput("PutParameter", &ssm.PutParameterInput{
+			Name:  String("/my-app/db-host"),
+			Value: String("db.internal"),
+			Type:  String("String"),
+		}).ExpectCommandResult("/my-app/db-host").ExpectCalls("PutParameter").Run(t)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		Template("update parameter name=/my-app/db-password value=n3ws3cr3t").
+			Mock(&ssmMock{
+				GetParameterFunc: func(param0 *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+					return &ssm.GetParameterOutput{Parameter: &ssm.Parameter{Name: String("/my-app/db-password"), Type: String("SecureString")}}, nil
+				},
+				PutParameterFunc: func(param0 *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+					return &ssm.PutParameterOutput{Version: Int64(2)}, nil
+				},
+			}).ExpectInput("GetParameter", &ssm.GetParameterInput{Name: String("/my-app/db-password")}).
+			ExpectInput("PutParameter", &ssm.PutParameterInput{
+				Name:      String("/my-app/db-password"),
+				Value:     String("n3ws3cr3t"),
+				Type:      String("SecureString"),
+				Overwrite: Bool(true),
+			}).ExpectCalls("GetParameter", "PutParameter").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete parameter name=/my-app/db-password").
+			Mock(&ssmMock{
+				DeleteParameterFunc: func(param0 *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+					return &ssm.DeleteParameterOutput{}, nil
+				},
+			}).ExpectInput("DeleteParameter", &ssm.DeleteParameterInput{Name: String("/my-app/db-password")}).
+			ExpectCalls("DeleteParameter").Run(t)
+	})
+
+	t.Run("value read with ssm()", func(t *testing.T) {
+		mock := &ssmMock{
+			GetParameterFunc: func(param0 *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return &ssm.GetParameterOutput{Parameter: &ssm.Parameter{Name: String("/my-app/db-password"), Value: String("s3cr3t")}}, nil
+			},
+			PutParameterFunc: func(param0 *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return &ssm.PutParameterOutput{Version: Int64(1)}, nil
+			},
+		}
+		Template("create parameter name=/other-app/db-password value=ssm(/my-app/db-password) secure=true").
+			Mock(mock).Middlewares(awsspec.ResolveSSMParameters(mock)).
+			ExpectInput("GetParameter", &ssm.GetParameterInput{Name: String("/my-app/db-password"), WithDecryption: Bool(true)}).
+			ExpectInput("PutParameter", &ssm.PutParameterInput{
+				Name:  String("/other-app/db-password"),
+				Value: String("s3cr3t"),
+				Type:  String("SecureString"),
+			}).ExpectCommandResult("/other-app/db-password").ExpectCalls("GetParameter", "PutParameter").Run(t)
+	})
+}
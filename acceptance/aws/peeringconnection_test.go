@@ -0,0 +1,55 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestPeeringconnection(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create peeringconnection vpc=vpc-1234 peer-vpc=vpc-2345 peer-owner=123456789012 peer-region=us-east-1 name=my-peering").
+			Mock(&ec2Mock{
+				CreateVpcPeeringConnectionFunc: func(input *ec2.CreateVpcPeeringConnectionInput) (*ec2.CreateVpcPeeringConnectionOutput, error) {
+					return &ec2.CreateVpcPeeringConnectionOutput{VpcPeeringConnection: &ec2.VpcPeeringConnection{VpcPeeringConnectionId: String("pcx-1234")}}, nil
+				},
+				CreateTagsRequestFunc: func(input *ec2.CreateTagsInput) (req *request.Request, output *ec2.CreateTagsOutput) {
+					output = &ec2.CreateTagsOutput{}
+					req = request.New(aws.Config{}, metadata.ClientInfo{}, request.Handlers{}, nil, &request.Operation{}, input, output)
+					return
+				},
+			}).ExpectInput("CreateVpcPeeringConnection", &ec2.CreateVpcPeeringConnectionInput{
+			VpcId:       String("vpc-1234"),
+			PeerVpcId:   String("vpc-2345"),
+			PeerOwnerId: String("123456789012"),
+			PeerRegion:  String("us-east-1"),
+		}).ExpectInput("CreateTagsRequest", &ec2.CreateTagsInput{
+			Resources: []*string{String("pcx-1234")},
+			Tags:      []*ec2.Tag{{Key: String("Name"), Value: String("my-peering")}},
+		}).ExpectCommandResult("pcx-1234").ExpectCalls("CreateVpcPeeringConnection", "CreateTagsRequest").
+			ExpectRevert("delete peeringconnection id=pcx-1234").Run(t)
+	})
+
+	t.Run("accept", func(t *testing.T) {
+		Template("accept peeringconnection id=pcx-1234").
+			Mock(&ec2Mock{
+				AcceptVpcPeeringConnectionFunc: func(input *ec2.AcceptVpcPeeringConnectionInput) (*ec2.AcceptVpcPeeringConnectionOutput, error) {
+					return &ec2.AcceptVpcPeeringConnectionOutput{VpcPeeringConnection: &ec2.VpcPeeringConnection{VpcPeeringConnectionId: String("pcx-1234")}}, nil
+				},
+			}).ExpectInput("AcceptVpcPeeringConnection", &ec2.AcceptVpcPeeringConnectionInput{VpcPeeringConnectionId: String("pcx-1234")}).
+			ExpectCommandResult("pcx-1234").ExpectCalls("AcceptVpcPeeringConnection").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete peeringconnection id=pcx-1234").
+			Mock(&ec2Mock{
+				DeleteVpcPeeringConnectionFunc: func(input *ec2.DeleteVpcPeeringConnectionInput) (*ec2.DeleteVpcPeeringConnectionOutput, error) {
+					return &ec2.DeleteVpcPeeringConnectionOutput{Return: Bool(true)}, nil
+				},
+			}).ExpectInput("DeleteVpcPeeringConnection", &ec2.DeleteVpcPeeringConnectionInput{VpcPeeringConnectionId: String("pcx-1234")}).
+			ExpectCalls("DeleteVpcPeeringConnection").Run(t)
+	})
+}
@@ -0,0 +1,30 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestPlacementgroup(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create placementgroup name=my-cluster strategy=Cluster").
+			Mock(&ec2Mock{
+				CreatePlacementGroupFunc: func(input *ec2.CreatePlacementGroupInput) (*ec2.CreatePlacementGroupOutput, error) {
+					return &ec2.CreatePlacementGroupOutput{}, nil
+				},
+			}).ExpectInput("CreatePlacementGroup", &ec2.CreatePlacementGroupInput{GroupName: String("my-cluster"), Strategy: String("cluster")}).
+			ExpectCommandResult("my-cluster").ExpectCalls("CreatePlacementGroup").
+			ExpectRevert("delete placementgroup name=my-cluster").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete placementgroup name=my-cluster").
+			Mock(&ec2Mock{
+				DeletePlacementGroupFunc: func(input *ec2.DeletePlacementGroupInput) (*ec2.DeletePlacementGroupOutput, error) {
+					return &ec2.DeletePlacementGroupOutput{}, nil
+				},
+			}).ExpectInput("DeletePlacementGroup", &ec2.DeletePlacementGroupInput{GroupName: String("my-cluster")}).
+			ExpectCalls("DeletePlacementGroup").Run(t)
+	})
+}
@@ -1,7 +1,9 @@
 package awsat
 
 import (
+	"io/ioutil"
 	"net/url"
+	"os"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/iam"
@@ -154,7 +156,119 @@ func TestPolicy(t *testing.T) {
    }
   }
  ]
-}`)}).ExpectCalls("ListPolicyVersions", "GetPolicyVersion", "CreatePolicyVersion").Run(t)
+}`)}).ExpectCalls("ListPolicyVersions", "ListPolicyVersions", "GetPolicyVersion", "CreatePolicyVersion").
+			ExpectRevert("update policy arn=arn:my:arn:of:policy:to:update default-version=v2").Run(t)
+	})
+
+	t.Run("update with document", func(t *testing.T) {
+		current := `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": ["s3:GetObject"], "Resource": "*"}]}`
+		tmpFile, err := ioutil.TempFile("", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.Remove(tmpFile.Name())
+		document := `{
+ "Version": "2012-10-17",
+ "Statement": [
+  {"Resource": "*", "Action": "s3:GetObject", "Effect": "Allow"},
+  {"Effect": "Allow", "Action": ["s3:PutObject"], "Resource": "*"}
+ ]
+}`
+		ioutil.WriteFile(tmpFile.Name(), []byte(document), 0600)
+
+		mock := func() *iamMock {
+			return &iamMock{
+				ListPolicyVersionsFunc: func(input *iam.ListPolicyVersionsInput) (*iam.ListPolicyVersionsOutput, error) {
+					return &iam.ListPolicyVersionsOutput{Versions: []*iam.PolicyVersion{{VersionId: String("v1"), IsDefaultVersion: Bool(true)}}}, nil
+				},
+				GetPolicyVersionFunc: func(input *iam.GetPolicyVersionInput) (*iam.GetPolicyVersionOutput, error) {
+					return &iam.GetPolicyVersionOutput{PolicyVersion: &iam.PolicyVersion{Document: String(url.QueryEscape(current)), VersionId: String("v1")}}, nil
+				},
+				CreatePolicyVersionFunc: func(input *iam.CreatePolicyVersionInput) (*iam.CreatePolicyVersionOutput, error) {
+					return &iam.CreatePolicyVersionOutput{PolicyVersion: &iam.PolicyVersion{VersionId: String("v2")}}, nil
+				},
+			}
+		}
+		Template("update policy arn=arn:my:policy document-file="+tmpFile.Name()).Mock(mock()).
+			ExpectInput("ListPolicyVersions", &iam.ListPolicyVersionsInput{PolicyArn: String("arn:my:policy")}).
+			ExpectInput("GetPolicyVersion", &iam.GetPolicyVersionInput{PolicyArn: String("arn:my:policy"), VersionId: String("v1")}).
+			ExpectInput("CreatePolicyVersion", &iam.CreatePolicyVersionInput{
+				PolicyArn:      String("arn:my:policy"),
+				PolicyDocument: String(document),
+				SetAsDefault:   Bool(true),
+			}).ExpectCalls("ListPolicyVersions", "ListPolicyVersions", "GetPolicyVersion", "CreatePolicyVersion").
+			ExpectRevert("update policy arn=arn:my:policy default-version=v1").Run(t)
+
+		t.Run("unchanged", func(t *testing.T) {
+			Template("update policy arn=arn:my:policy document='{\"Statement\": {\"Effect\": \"Allow\", \"Resource\": [\"*\"], \"Action\": \"s3:GetObject\"}, \"Version\": \"2012-10-17\"}'").Mock(mock()).
+				IgnoreInput("ListPolicyVersions", "GetPolicyVersion").ExpectCalls("ListPolicyVersions", "ListPolicyVersions", "GetPolicyVersion").Run(t)
+		})
+	})
+
+	t.Run("update default version", func(t *testing.T) {
+		Template("update policy arn=arn:my:policy default-version=v1").
+			Mock(&iamMock{
+				ListPolicyVersionsFunc: func(input *iam.ListPolicyVersionsInput) (*iam.ListPolicyVersionsOutput, error) {
+					return &iam.ListPolicyVersionsOutput{Versions: []*iam.PolicyVersion{
+						{VersionId: String("v1"), IsDefaultVersion: Bool(false)},
+						{VersionId: String("v2"), IsDefaultVersion: Bool(true)},
+					}}, nil
+				},
+				SetDefaultPolicyVersionFunc: func(input *iam.SetDefaultPolicyVersionInput) (*iam.SetDefaultPolicyVersionOutput, error) {
+					return &iam.SetDefaultPolicyVersionOutput{}, nil
+				},
+			}).ExpectInput("ListPolicyVersions", &iam.ListPolicyVersionsInput{
+			PolicyArn: String("arn:my:policy"),
+		}).ExpectInput("SetDefaultPolicyVersion", &iam.SetDefaultPolicyVersionInput{
+			PolicyArn: String("arn:my:policy"),
+			VersionId: String("v1"),
+		}).ExpectCalls("ListPolicyVersions", "SetDefaultPolicyVersion").
+			ExpectRevert("update policy arn=arn:my:policy default-version=v2").Run(t)
+	})
+
+	t.Run("create from document", func(t *testing.T) {
+		document := `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": "*"}]}`
+		Template("create policy name=S3Full document='"+document+"'").
+			Mock(&iamMock{
+				CreatePolicyFunc: func(input *iam.CreatePolicyInput) (*iam.CreatePolicyOutput, error) {
+					return &iam.CreatePolicyOutput{Policy: &iam.Policy{Arn: String("new-policy-arn")}}, nil
+				},
+			}).ExpectInput("CreatePolicy", &iam.CreatePolicyInput{
+			PolicyName:     String("S3Full"),
+			PolicyDocument: String(document),
+		}).ExpectCommandResult("new-policy-arn").ExpectCalls("CreatePolicy").Run(t)
+	})
+
+	t.Run("create version", func(t *testing.T) {
+		document := `{"Version": "2012-10-17", "Statement": [{"Effect": "Deny", "Action": "s3:*", "Resource": "*"}]}`
+		Template("create policyversion arn=arn:my:policy default=true document='"+document+"'").
+			Mock(&iamMock{
+				ListPolicyVersionsFunc: func(input *iam.ListPolicyVersionsInput) (*iam.ListPolicyVersionsOutput, error) {
+					return &iam.ListPolicyVersionsOutput{Versions: []*iam.PolicyVersion{{VersionId: String("v1"), IsDefaultVersion: Bool(true)}}}, nil
+				},
+				CreatePolicyVersionFunc: func(input *iam.CreatePolicyVersionInput) (*iam.CreatePolicyVersionOutput, error) {
+					return &iam.CreatePolicyVersionOutput{PolicyVersion: &iam.PolicyVersion{VersionId: String("v2")}}, nil
+				},
+			}).ExpectInput("ListPolicyVersions", &iam.ListPolicyVersionsInput{
+			PolicyArn: String("arn:my:policy"),
+		}).ExpectInput("CreatePolicyVersion", &iam.CreatePolicyVersionInput{
+			PolicyArn:      String("arn:my:policy"),
+			PolicyDocument: String(document),
+			SetAsDefault:   Bool(true),
+		}).ExpectCommandResult("v2").ExpectCalls("ListPolicyVersions", "CreatePolicyVersion").
+			ExpectRevert("update policy arn=arn:my:policy default-version=v1\ndelete policyversion arn=arn:my:policy version=v2").Run(t)
+	})
+
+	t.Run("delete version", func(t *testing.T) {
+		Template("delete policyversion arn=arn:my:policy version=v1").
+			Mock(&iamMock{
+				DeletePolicyVersionFunc: func(input *iam.DeletePolicyVersionInput) (*iam.DeletePolicyVersionOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DeletePolicyVersion", &iam.DeletePolicyVersionInput{
+			PolicyArn: String("arn:my:policy"),
+			VersionId: String("v1"),
+		}).ExpectCalls("DeletePolicyVersion").Run(t)
 	})
 
 	t.Run("delete", func(t *testing.T) {
@@ -0,0 +1,56 @@
+package awsat
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func TestPresignedurl(t *testing.T) {
+	client := s3.New(session.Must(session.NewSession(&aws.Config{
+		Region:      aws.String("us-west-2"),
+		Credentials: credentials.NewStaticCredentials("AKIDEXAMPLE", "SECRET", ""),
+	})))
+
+	t.Run("get", func(t *testing.T) {
+		var signed *url.URL
+		Template("url = create presignedurl bucket=my-reports key=2017/report.csv expiry=2h").Mock(&s3Mock{
+			GetObjectRequestFunc: func(input *s3.GetObjectInput) (*request.Request, *s3.GetObjectOutput) {
+				req, out := client.GetObjectRequest(input)
+				req.Handlers.Sign.PushBack(func(r *request.Request) { signed = r.HTTPRequest.URL })
+				return req, out
+			},
+		}).ExpectInput("GetObjectRequest", &s3.GetObjectInput{
+			Bucket: String("my-reports"),
+			Key:    String("2017/report.csv"),
+		}).ExpectCalls("GetObjectRequest").Run(t)
+		if got, want := signed.Host+signed.Path, "my-reports.s3.us-west-2.amazonaws.com/2017/report.csv"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := signed.Query().Get("X-Amz-Expires"), "7200"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	})
+
+	t.Run("put", func(t *testing.T) {
+		var query url.Values
+		Template("create presignedurl bucket=my-uploads key=incoming/data.json method=put").Mock(&s3Mock{
+			PutObjectRequestFunc: func(input *s3.PutObjectInput) (*request.Request, *s3.PutObjectOutput) {
+				req, out := client.PutObjectRequest(input)
+				req.Handlers.Sign.PushBack(func(r *request.Request) { query = r.HTTPRequest.URL.Query() })
+				return req, out
+			},
+		}).ExpectInput("PutObjectRequest", &s3.PutObjectInput{
+			Bucket: String("my-uploads"),
+			Key:    String("incoming/data.json"),
+		}).ExpectCalls("PutObjectRequest").Run(t)
+		if got, want := query.Get("X-Amz-Expires"), "3600"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	})
+}
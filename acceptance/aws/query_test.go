@@ -0,0 +1,30 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/athena"
+)
+
+func TestQuery(t *testing.T) {
+	t.Run("start", func(t *testing.T) {
+		Template("start query database=weblogs query='SELECT status, count(*) FROM access GROUP BY status' output=s3://my-bucket/results/").
+			Mock(&athenaMock{
+				StartQueryExecutionFunc: func(param0 *athena.StartQueryExecutionInput) (*athena.StartQueryExecutionOutput, error) {
+					return &athena.StartQueryExecutionOutput{QueryExecutionId: String("a1b2c3d4")}, nil
+				},
+				GetQueryExecutionFunc: func(param0 *athena.GetQueryExecutionInput) (*athena.GetQueryExecutionOutput, error) {
+					return &athena.GetQueryExecutionOutput{QueryExecution: &athena.QueryExecution{
+						QueryExecutionId:    String("a1b2c3d4"),
+						Status:              &athena.QueryExecutionStatus{State: String("SUCCEEDED")},
+						ResultConfiguration: &athena.ResultConfiguration{OutputLocation: String("s3://my-bucket/results/a1b2c3d4.csv")},
+					}}, nil
+				},
+			}).ExpectInput("StartQueryExecution", &athena.StartQueryExecutionInput{
+			QueryString:           String("SELECT status, count(*) FROM access GROUP BY status"),
+			QueryExecutionContext: &athena.QueryExecutionContext{Database: String("weblogs")},
+			ResultConfiguration:   &athena.ResultConfiguration{OutputLocation: String("s3://my-bucket/results/")},
+		}).ExpectInput("GetQueryExecution", &athena.GetQueryExecutionInput{QueryExecutionId: String("a1b2c3d4")}).
+			ExpectCommandResult("s3://my-bucket/results/a1b2c3d4.csv").ExpectCalls("StartQueryExecution", "GetQueryExecution").Run(t)
+	})
+}
@@ -29,6 +29,68 @@ func TestQueue(t *testing.T) {
 			ExpectCommandResult("my-queue-url").ExpectCalls("CreateQueue").Run(t)
 	})
 
+	t.Run("create fifo", func(t *testing.T) {
+		Template("create queue name=jobs.fifo fifo=true content-deduplication=true").
+			Mock(&sqsMock{
+				CreateQueueFunc: func(param0 *sqs.CreateQueueInput) (*sqs.CreateQueueOutput, error) {
+					return &sqs.CreateQueueOutput{QueueUrl: String("jobs-url")}, nil
+				},
+			}).ExpectInput("CreateQueue", &sqs.CreateQueueInput{
+			QueueName: String("jobs.fifo"),
+			Attributes: map[string]*string{
+				"FifoQueue":                 String("true"),
+				"ContentBasedDeduplication": String("true"),
+			},
+		}).
+			ExpectCommandResult("jobs-url").ExpectCalls("CreateQueue").Run(t)
+	})
+
+	t.Run("create with dead letter queue declaration", func(t *testing.T) {
+		Template("dlq = create queue name=jobs-failed\ncreate queue name=jobs dead-letter-queue=$dlq max-receive-count=3").
+			Mock(&sqsMock{
+				CreateQueueFunc: func(input *sqs.CreateQueueInput) (*sqs.CreateQueueOutput, error) {
+					switch StringValue(input.QueueName) {
+					case "jobs-failed":
+						return &sqs.CreateQueueOutput{QueueUrl: String("https://sqs.eu-west-1.amazonaws.com/123456789012/jobs-failed")}, nil
+					case "jobs":
+						if got, want := StringValue(input.Attributes["RedrivePolicy"]), `{"deadLetterTargetArn":"jobs-failed-arn","maxReceiveCount":"3"}`; got != want {
+							t.Fatalf("got %s, want %s", got, want)
+						}
+						return &sqs.CreateQueueOutput{QueueUrl: String("jobs-url")}, nil
+					}
+					t.Fatalf("unexpected queue %s", StringValue(input.QueueName))
+					return nil, nil
+				},
+				GetQueueAttributesFunc: func(param0 *sqs.GetQueueAttributesInput) (*sqs.GetQueueAttributesOutput, error) {
+					return &sqs.GetQueueAttributesOutput{Attributes: map[string]*string{"QueueArn": String("jobs-failed-arn")}}, nil
+				},
+			}).IgnoreInput("CreateQueue").
+			ExpectInput("GetQueueAttributes", &sqs.GetQueueAttributesInput{QueueUrl: String("https://sqs.eu-west-1.amazonaws.com/123456789012/jobs-failed"), AttributeNames: []*string{String("QueueArn")}}).
+			ExpectCalls("CreateQueue", "GetQueueAttributes", "CreateQueue").
+			ExpectRevert("delete queue url=jobs-url\ndelete queue url=https://sqs.eu-west-1.amazonaws.com/123456789012/jobs-failed").Run(t)
+	})
+
+	t.Run("create with dead letter queue name", func(t *testing.T) {
+		Template("create queue name=jobs dead-letter-queue=jobs-failed").
+			Mock(&sqsMock{
+				CreateQueueFunc: func(param0 *sqs.CreateQueueInput) (*sqs.CreateQueueOutput, error) {
+					return &sqs.CreateQueueOutput{QueueUrl: String("jobs-url")}, nil
+				},
+				GetQueueUrlFunc: func(param0 *sqs.GetQueueUrlInput) (*sqs.GetQueueUrlOutput, error) {
+					return &sqs.GetQueueUrlOutput{QueueUrl: String("jobs-failed-url")}, nil
+				},
+				GetQueueAttributesFunc: func(param0 *sqs.GetQueueAttributesInput) (*sqs.GetQueueAttributesOutput, error) {
+					return &sqs.GetQueueAttributesOutput{Attributes: map[string]*string{"QueueArn": String("jobs-failed-arn")}}, nil
+				},
+			}).ExpectInput("GetQueueUrl", &sqs.GetQueueUrlInput{QueueName: String("jobs-failed")}).
+			ExpectInput("GetQueueAttributes", &sqs.GetQueueAttributesInput{QueueUrl: String("jobs-failed-url"), AttributeNames: []*string{String("QueueArn")}}).
+			ExpectInput("CreateQueue", &sqs.CreateQueueInput{
+				QueueName:  String("jobs"),
+				Attributes: map[string]*string{"RedrivePolicy": String(`{"deadLetterTargetArn":"jobs-failed-arn","maxReceiveCount":"5"}`)},
+			}).
+			ExpectCommandResult("jobs-url").ExpectCalls("GetQueueUrl", "GetQueueAttributes", "CreateQueue").Run(t)
+	})
+
 	t.Run("delete", func(t *testing.T) {
 		Template("delete queue url=queue-url-to-delete").
 			Mock(&sqsMock{
@@ -67,6 +67,85 @@ func TestRecord(t *testing.T) {
 		})
 	})
 
+	t.Run("create alias", func(t *testing.T) {
+		g := graph.NewGraph()
+		g.AddResource(resourcetest.LoadBalancer("arn:aws:elasticloadbalancing:eu-west-1:0123456789:loadbalancer/app/web/50dc6c495c0c9188").
+			Prop(properties.Name, "web").Prop(properties.PublicDNS, "web-1234567890.eu-west-1.elb.amazonaws.com").Prop(properties.Zone, "Z32O12XQLNTSW2").Build())
+		g.AddResource(resourcetest.Distribution("E2QWRUHAPOMQZL").Prop(properties.PublicDNS, "d111111abcdef8.cloudfront.net").Build())
+		changeAlias := func(action, name, dns, zone string, evaluate bool) *route53.ChangeResourceRecordSetsInput {
+			return &route53.ChangeResourceRecordSetsInput{
+				HostedZoneId: String("/hostedzone/1234ABCD"),
+				ChangeBatch: &route53.ChangeBatch{
+					Changes: []*route53.Change{
+						{
+							ResourceRecordSet: &route53.ResourceRecordSet{
+								Name:        String(name),
+								Type:        String("A"),
+								AliasTarget: &route53.AliasTarget{DNSName: String(dns), HostedZoneId: String(zone), EvaluateTargetHealth: Bool(evaluate)},
+							},
+							Action: String(action),
+						},
+					},
+				},
+			}
+		}
+		mock := func() *route53Mock {
+			return &route53Mock{
+				ChangeResourceRecordSetsFunc: func(param0 *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+					return &route53.ChangeResourceRecordSetsOutput{ChangeInfo: &route53.ChangeInfo{Id: String("change-id")}}, nil
+				},
+			}
+		}
+
+		t.Run("of loadbalancer", func(t *testing.T) {
+			Template("create record zone=/hostedzone/1234ABCD name=www.domain.com type=A alias=web").Mock(mock()).Graph(g).
+				ExpectInput("ChangeResourceRecordSets", changeAlias("CREATE", "www.domain.com", "web-1234567890.eu-west-1.elb.amazonaws.com", "Z32O12XQLNTSW2", true)).
+				ExpectCommandResult("change-id").ExpectCalls("ChangeResourceRecordSets").
+				ExpectRevert("delete record alias=web name=www.domain.com type=A zone=/hostedzone/1234ABCD").Run(t)
+		})
+
+		t.Run("of distribution", func(t *testing.T) {
+			Template("update record zone=/hostedzone/1234ABCD name=cdn.domain.com type=A alias=E2QWRUHAPOMQZL").Mock(mock()).Graph(g).
+				ExpectInput("ChangeResourceRecordSets", changeAlias("UPSERT", "cdn.domain.com", "d111111abcdef8.cloudfront.net", "Z2FDTNDATAQYW2", false)).
+				ExpectCommandResult("change-id").ExpectCalls("ChangeResourceRecordSets").Run(t)
+		})
+
+		t.Run("of cloudfront domain", func(t *testing.T) {
+			Template("create record zone=/hostedzone/1234ABCD name=cdn.domain.com type=A alias=d222222abcdef8.cloudfront.net").Mock(mock()).
+				ExpectInput("ChangeResourceRecordSets", changeAlias("CREATE", "cdn.domain.com", "d222222abcdef8.cloudfront.net", "Z2FDTNDATAQYW2", false)).
+				ExpectCommandResult("change-id").ExpectCalls("ChangeResourceRecordSets").Run(t)
+		})
+
+		t.Run("with zone", func(t *testing.T) {
+			Template("create record zone=/hostedzone/1234ABCD name=api.domain.com type=A alias=dualstack.api-123.eu-west-1.elb.amazonaws.com alias-zone=Z32O12XQLNTSW2").Mock(mock()).
+				ExpectInput("ChangeResourceRecordSets", changeAlias("CREATE", "api.domain.com", "dualstack.api-123.eu-west-1.elb.amazonaws.com", "Z32O12XQLNTSW2", false)).
+				ExpectCommandResult("change-id").ExpectCalls("ChangeResourceRecordSets").Run(t)
+		})
+
+		t.Run("delete from awless-id", func(t *testing.T) {
+			g := graph.NewGraph()
+			zone := resourcetest.Zone("/hostedzone/1234ABCD").Build()
+			record := resourcetest.Record("awls-alias").Prop(properties.Name, "www.domain.com.").Prop(properties.Type, "A").
+				Prop(properties.Alias, "dualstack.web-1234567890.eu-west-1.elb.amazonaws.com.").Prop(properties.AliasZone, "Z32O12XQLNTSW2").Build()
+			g.AddResource(zone, record)
+			g.AddParentRelation(zone, record)
+			m := mock()
+			m.ListResourceRecordSetsFunc = func(param0 *route53.ListResourceRecordSetsInput) (*route53.ListResourceRecordSetsOutput, error) {
+				return &route53.ListResourceRecordSetsOutput{ResourceRecordSets: []*route53.ResourceRecordSet{
+					{Name: String("www.domain.com."), Type: String("A"), AliasTarget: &route53.AliasTarget{
+						DNSName: String("dualstack.web-1234567890.eu-west-1.elb.amazonaws.com."), HostedZoneId: String("Z32O12XQLNTSW2"), EvaluateTargetHealth: Bool(true),
+					}},
+				}}, nil
+			}
+			Template("delete record id=awls-alias").Mock(m).Graph(g).
+				ExpectInput("ListResourceRecordSets", &route53.ListResourceRecordSetsInput{
+					HostedZoneId: String("/hostedzone/1234ABCD"), StartRecordName: String("www.domain.com."), StartRecordType: String("A"), MaxItems: String("1"),
+				}).
+				ExpectInput("ChangeResourceRecordSets", changeAlias("DELETE", "www.domain.com.", "dualstack.web-1234567890.eu-west-1.elb.amazonaws.com.", "Z32O12XQLNTSW2", true)).
+				ExpectCommandResult("change-id").ExpectCalls("ListResourceRecordSets", "ChangeResourceRecordSets").Run(t)
+		})
+	})
+
 	t.Run("update", func(t *testing.T) {
 		Template("update record zone=/hostedzone/1234ABCD name=myupdated.domain.com type=A value=127.0.0.1 ttl=60").
 			Mock(&route53Mock{
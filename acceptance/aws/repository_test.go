@@ -26,4 +26,19 @@ func TestRepository(t *testing.T) {
 				RepositoryName: String("any-repo"),
 			}).ExpectCalls("DeleteRepository").Run(t)
 	})
+	t.Run("update policy", func(t *testing.T) {
+		policy := `{"Version":"2008-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"ecr:BatchGetImage"}]}`
+		_, filepath, cleanup := generateTmpFile(policy)
+		defer cleanup()
+
+		Template("update repository name=any-repo policy-file="+filepath+" account=123456789012").Mock(&ecrMock{
+			SetRepositoryPolicyFunc: func(input *ecr.SetRepositoryPolicyInput) (*ecr.SetRepositoryPolicyOutput, error) {
+				return nil, nil
+			}}).
+			ExpectInput("SetRepositoryPolicy", &ecr.SetRepositoryPolicyInput{
+				RepositoryName: String("any-repo"),
+				PolicyText:     String(policy),
+				RegistryId:     String("123456789012"),
+			}).ExpectCalls("SetRepositoryPolicy").Run(t)
+	})
 }
@@ -0,0 +1,169 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apigateway"
+)
+
+func TestRestapi(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create restapi name=hello description='Greetings API'").
+			Mock(&apigatewayMock{
+				CreateRestApiFunc: func(param0 *apigateway.CreateRestApiInput) (*apigateway.RestApi, error) {
+					return &apigateway.RestApi{Id: String("a1b2c3d4e5")}, nil
+				},
+			}).ExpectInput("CreateRestApi", &apigateway.CreateRestApiInput{
+			Name:        String("hello"),
+			Description: String("Greetings API"),
+		}).ExpectCommandResult("a1b2c3d4e5").ExpectCalls("CreateRestApi").
+			ExpectRevert("delete restapi id=a1b2c3d4e5").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete restapi id=a1b2c3d4e5").
+			Mock(&apigatewayMock{
+				DeleteRestApiFunc: func(param0 *apigateway.DeleteRestApiInput) (*apigateway.DeleteRestApiOutput, error) {
+					return &apigateway.DeleteRestApiOutput{}, nil
+				},
+			}).ExpectInput("DeleteRestApi", &apigateway.DeleteRestApiInput{RestApiId: String("a1b2c3d4e5")}).
+			ExpectCalls("DeleteRestApi").Run(t)
+	})
+}
+
+func TestResource(t *testing.T) {
+	t.Run("create under root", func(t *testing.T) {
+		Template("create resource restapi=a1b2c3d4e5 path=greetings").
+			Mock(&apigatewayMock{
+				GetResourcesFunc: func(param0 *apigateway.GetResourcesInput) (*apigateway.GetResourcesOutput, error) {
+					return &apigateway.GetResourcesOutput{Items: []*apigateway.Resource{
+						{Id: String("x1y2z3"), Path: String("/users")},
+						{Id: String("r0o0t0"), Path: String("/")},
+					}}, nil
+				},
+				CreateResourceFunc: func(param0 *apigateway.CreateResourceInput) (*apigateway.Resource, error) {
+					return &apigateway.Resource{Id: String("f6g7h8")}, nil
+				},
+			}).ExpectInput("GetResources", &apigateway.GetResourcesInput{RestApiId: String("a1b2c3d4e5")}).
+			ExpectInput("CreateResource", &apigateway.CreateResourceInput{
+				RestApiId: String("a1b2c3d4e5"),
+				ParentId:  String("r0o0t0"),
+				PathPart:  String("greetings"),
+			}).ExpectCommandResult("f6g7h8").ExpectCalls("GetResources", "CreateResource").
+			ExpectRevert("delete resource id=f6g7h8 restapi=a1b2c3d4e5").Run(t)
+	})
+
+	t.Run("create with parent", func(t *testing.T) {
+		Template("create resource restapi=a1b2c3d4e5 parent=f6g7h8 path='{name}'").
+			Mock(&apigatewayMock{
+				CreateResourceFunc: func(param0 *apigateway.CreateResourceInput) (*apigateway.Resource, error) {
+					return &apigateway.Resource{Id: String("l2m3n4")}, nil
+				},
+			}).ExpectInput("CreateResource", &apigateway.CreateResourceInput{
+			RestApiId: String("a1b2c3d4e5"),
+			ParentId:  String("f6g7h8"),
+			PathPart:  String("{name}"),
+		}).ExpectCommandResult("l2m3n4").ExpectCalls("CreateResource").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete resource restapi=a1b2c3d4e5 id=f6g7h8").
+			Mock(&apigatewayMock{
+				DeleteResourceFunc: func(param0 *apigateway.DeleteResourceInput) (*apigateway.DeleteResourceOutput, error) {
+					return &apigateway.DeleteResourceOutput{}, nil
+				},
+			}).ExpectInput("DeleteResource", &apigateway.DeleteResourceInput{RestApiId: String("a1b2c3d4e5"), ResourceId: String("f6g7h8")}).
+			ExpectCalls("DeleteResource").Run(t)
+	})
+}
+
+func TestMethod(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create method restapi=a1b2c3d4e5 resource=f6g7h8 http-method=get function=arn:aws:lambda:us-west-2:123456789012:function:hello").
+			Mock(&apigatewayMock{
+				PutMethodFunc: func(param0 *apigateway.PutMethodInput) (*apigateway.Method, error) {
+					return &apigateway.Method{HttpMethod: String("GET")}, nil
+				},
+				PutIntegrationFunc: func(param0 *apigateway.PutIntegrationInput) (*apigateway.Integration, error) {
+					return &apigateway.Integration{}, nil
+				},
+			}).ExpectInput("PutMethod", &apigateway.PutMethodInput{
+			RestApiId:         String("a1b2c3d4e5"),
+			ResourceId:        String("f6g7h8"),
+			HttpMethod:        String("GET"),
+			AuthorizationType: String("NONE"),
+		}).ExpectInput("PutIntegration", &apigateway.PutIntegrationInput{
+			RestApiId:             String("a1b2c3d4e5"),
+			ResourceId:            String("f6g7h8"),
+			HttpMethod:            String("GET"),
+			Type:                  String("AWS_PROXY"),
+			IntegrationHttpMethod: String("POST"),
+			Uri:                   String("arn:aws:apigateway:us-west-2:lambda:path/2015-03-31/functions/arn:aws:lambda:us-west-2:123456789012:function:hello/invocations"),
+		}).ExpectCommandResult("GET").ExpectCalls("PutMethod", "PutIntegration").
+			ExpectRevert("delete method http-method=GET resource=f6g7h8 restapi=a1b2c3d4e5").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete method restapi=a1b2c3d4e5 resource=f6g7h8 http-method=GET").
+			Mock(&apigatewayMock{
+				DeleteMethodFunc: func(param0 *apigateway.DeleteMethodInput) (*apigateway.DeleteMethodOutput, error) {
+					return &apigateway.DeleteMethodOutput{}, nil
+				},
+			}).ExpectInput("DeleteMethod", &apigateway.DeleteMethodInput{
+			RestApiId:  String("a1b2c3d4e5"),
+			ResourceId: String("f6g7h8"),
+			HttpMethod: String("GET"),
+		}).ExpectCalls("DeleteMethod").Run(t)
+	})
+}
+
+func TestDeployment(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create deployment restapi=a1b2c3d4e5 stage=prod").
+			Mock(&apigatewayMock{
+				CreateDeploymentFunc: func(param0 *apigateway.CreateDeploymentInput) (*apigateway.Deployment, error) {
+					return &apigateway.Deployment{Id: String("i9j0k1")}, nil
+				},
+			}).ExpectInput("CreateDeployment", &apigateway.CreateDeploymentInput{
+			RestApiId: String("a1b2c3d4e5"),
+			StageName: String("prod"),
+		}).ExpectCommandResult("i9j0k1").ExpectCalls("CreateDeployment").
+			ExpectRevert("delete stage name=prod restapi=a1b2c3d4e5\ndelete deployment id=i9j0k1 restapi=a1b2c3d4e5").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete deployment restapi=a1b2c3d4e5 id=i9j0k1").
+			Mock(&apigatewayMock{
+				DeleteDeploymentFunc: func(param0 *apigateway.DeleteDeploymentInput) (*apigateway.DeleteDeploymentOutput, error) {
+					return &apigateway.DeleteDeploymentOutput{}, nil
+				},
+			}).ExpectInput("DeleteDeployment", &apigateway.DeleteDeploymentInput{RestApiId: String("a1b2c3d4e5"), DeploymentId: String("i9j0k1")}).
+			ExpectCalls("DeleteDeployment").Run(t)
+	})
+}
+
+func TestStage(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create stage restapi=a1b2c3d4e5 name=staging deployment=i9j0k1").
+			Mock(&apigatewayMock{
+				CreateStageFunc: func(param0 *apigateway.CreateStageInput) (*apigateway.Stage, error) {
+					return &apigateway.Stage{StageName: String("staging")}, nil
+				},
+			}).ExpectInput("CreateStage", &apigateway.CreateStageInput{
+			RestApiId:    String("a1b2c3d4e5"),
+			StageName:    String("staging"),
+			DeploymentId: String("i9j0k1"),
+		}).ExpectCommandResult("staging").ExpectCalls("CreateStage").
+			ExpectRevert("delete stage name=staging restapi=a1b2c3d4e5").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete stage restapi=a1b2c3d4e5 name=staging").
+			Mock(&apigatewayMock{
+				DeleteStageFunc: func(param0 *apigateway.DeleteStageInput) (*apigateway.DeleteStageOutput, error) {
+					return &apigateway.DeleteStageOutput{}, nil
+				},
+			}).ExpectInput("DeleteStage", &apigateway.DeleteStageInput{RestApiId: String("a1b2c3d4e5"), StageName: String("staging")}).
+			ExpectCalls("DeleteStage").Run(t)
+	})
+}
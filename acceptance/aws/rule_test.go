@@ -0,0 +1,90 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+)
+
+func TestRule(t *testing.T) {
+	t.Run("create with schedule", func(t *testing.T) {
+		Template("create rule name=nightly schedule='cron(0 2 * * ? *)' description='Nightly backup'").
+			Mock(&cloudwatcheventsMock{
+				PutRuleFunc: func(param0 *cloudwatchevents.PutRuleInput) (*cloudwatchevents.PutRuleOutput, error) {
+					return &cloudwatchevents.PutRuleOutput{RuleArn: String("arn:aws:events:eu-west-1:0123456789:rule/nightly")}, nil
+				},
+			}).ExpectInput("PutRule", &cloudwatchevents.PutRuleInput{
+			Name:               String("nightly"),
+			ScheduleExpression: String("cron(0 2 * * ? *)"),
+			Description:        String("Nightly backup"),
+			State:              String("ENABLED"),
+		}).ExpectCommandResult("nightly").ExpectCalls("PutRule").
+			ExpectRevert("delete rule name=nightly").Run(t)
+	})
+
+	t.Run("create with event pattern", func(t *testing.T) {
+		Template(`create rule name=ec2-changes pattern='{"source":["aws.ec2"]}' enabled=false`).
+			Mock(&cloudwatcheventsMock{
+				PutRuleFunc: func(param0 *cloudwatchevents.PutRuleInput) (*cloudwatchevents.PutRuleOutput, error) {
+					return &cloudwatchevents.PutRuleOutput{RuleArn: String("arn:aws:events:eu-west-1:0123456789:rule/ec2-changes")}, nil
+				},
+			}).ExpectInput("PutRule", &cloudwatchevents.PutRuleInput{
+			Name:         String("ec2-changes"),
+			EventPattern: String(`{"source":["aws.ec2"]}`),
+			State:        String("DISABLED"),
+		}).ExpectCommandResult("ec2-changes").ExpectCalls("PutRule").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete rule name=nightly").
+			Mock(&cloudwatcheventsMock{
+				DeleteRuleFunc: func(param0 *cloudwatchevents.DeleteRuleInput) (*cloudwatchevents.DeleteRuleOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DeleteRule", &cloudwatchevents.DeleteRuleInput{Name: String("nightly")}).
+			ExpectCalls("DeleteRule").Run(t)
+	})
+}
+
+func TestTarget(t *testing.T) {
+	t.Run("attach function", func(t *testing.T) {
+		Template("attach target rule=nightly function=arn:aws:lambda:eu-west-1:0123456789:function:backup").
+			Mock(&cloudwatcheventsMock{
+				PutTargetsFunc: func(param0 *cloudwatchevents.PutTargetsInput) (*cloudwatchevents.PutTargetsOutput, error) {
+					return &cloudwatchevents.PutTargetsOutput{}, nil
+				},
+			}).ExpectInput("PutTargets", &cloudwatchevents.PutTargetsInput{
+			Rule: String("nightly"),
+			Targets: []*cloudwatchevents.Target{
+				{Id: String("backup"), Arn: String("arn:aws:lambda:eu-west-1:0123456789:function:backup")},
+			},
+		}).ExpectCommandResult("backup").ExpectCalls("PutTargets").
+			ExpectRevert("detach target id=backup rule=nightly").Run(t)
+	})
+
+	t.Run("attach queue with id and input", func(t *testing.T) {
+		Template(`attach target rule=nightly queue=arn:aws:sqs:eu-west-1:0123456789:jobs id=jobs-queue input='{"job":"cleanup"}'`).
+			Mock(&cloudwatcheventsMock{
+				PutTargetsFunc: func(param0 *cloudwatchevents.PutTargetsInput) (*cloudwatchevents.PutTargetsOutput, error) {
+					return &cloudwatchevents.PutTargetsOutput{}, nil
+				},
+			}).ExpectInput("PutTargets", &cloudwatchevents.PutTargetsInput{
+			Rule: String("nightly"),
+			Targets: []*cloudwatchevents.Target{
+				{Id: String("jobs-queue"), Arn: String("arn:aws:sqs:eu-west-1:0123456789:jobs"), Input: String(`{"job":"cleanup"}`)},
+			},
+		}).ExpectCommandResult("jobs-queue").ExpectCalls("PutTargets").Run(t)
+	})
+
+	t.Run("detach", func(t *testing.T) {
+		Template("detach target rule=nightly id=backup").
+			Mock(&cloudwatcheventsMock{
+				RemoveTargetsFunc: func(param0 *cloudwatchevents.RemoveTargetsInput) (*cloudwatchevents.RemoveTargetsOutput, error) {
+					return &cloudwatchevents.RemoveTargetsOutput{}, nil
+				},
+			}).ExpectInput("RemoveTargets", &cloudwatchevents.RemoveTargetsInput{
+			Rule: String("nightly"),
+			Ids:  []*string{String("backup")},
+		}).ExpectCalls("RemoveTargets").Run(t)
+	})
+}
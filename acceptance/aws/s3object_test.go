@@ -1,12 +1,17 @@
 package awsat
 
 import (
+	"io/ioutil"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"os"
 
 	"path/filepath"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/wallix/awless/aws/spec"
 )
@@ -52,6 +57,103 @@ func TestS3object(t *testing.T) {
 		})
 	})
 
+	t.Run("create directory", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "awless-at-s3objects")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		if err = os.MkdirAll(filepath.Join(dir, "css"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		for path, content := range map[string]string{"index.html": "<html></html>", "css/site.css": "body {}"} {
+			if err = ioutil.WriteFile(filepath.Join(dir, path), []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		defer func(factory func(*os.File) (*awsspec.ProgressReadSeeker, error)) {
+			awsspec.ProgressBarFactory = factory
+		}(awsspec.ProgressBarFactory)
+		awsspec.ProgressBarFactory = awsspec.NewProgressReader
+
+		var uploaded []string
+		putObject := func(input *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+			if StringValue(input.Bucket) != "my-website" || StringValue(input.ACL) != "public-read" {
+				t.Fatalf("unexpected input %s", input)
+			}
+			uploaded = append(uploaded, StringValue(input.Key))
+			return &s3.PutObjectOutput{}, nil
+		}
+
+		t.Run("all files", func(t *testing.T) {
+			uploaded = nil
+			Template("create s3object file="+dir+" bucket=my-website acl=public-read name=site").Mock(&s3Mock{
+				PutObjectFunc: putObject,
+			}).IgnoreInput("PutObject").ExpectCommandResult("site/").ExpectCalls("PutObject", "PutObject").Run(t)
+			if got, want := uploaded, []string{"site/css/site.css", "site/index.html"}; !reflect.DeepEqual(got, want) {
+				t.Fatalf("got %v, want %v", got, want)
+			}
+		})
+
+		t.Run("sync", func(t *testing.T) {
+			uploaded = nil
+			Template("create s3object file="+dir+" bucket=my-website acl=public-read sync=true").Mock(&s3Mock{
+				ListObjectsV2Func: func(input *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error) {
+					if StringValue(input.Bucket) != "my-website" || input.Prefix != nil {
+						t.Fatalf("unexpected input %s", input)
+					}
+					if input.ContinuationToken == nil {
+						return &s3.ListObjectsV2Output{
+							Contents:              []*s3.Object{{Key: String("index.html"), Size: Int64(13), LastModified: aws.Time(time.Now().Add(time.Hour))}},
+							IsTruncated:           Bool(true),
+							NextContinuationToken: String("next"),
+						}, nil
+					}
+					return &s3.ListObjectsV2Output{
+						Contents: []*s3.Object{{Key: String("css/site.css"), Size: Int64(7), LastModified: aws.Time(time.Now().Add(-time.Hour))}},
+					}, nil
+				},
+				PutObjectFunc: putObject,
+			}).IgnoreInput("ListObjectsV2", "PutObject").ExpectCalls("ListObjectsV2", "ListObjectsV2", "PutObject").Run(t)
+			if got, want := uploaded, []string{"css/site.css"}; !reflect.DeepEqual(got, want) {
+				t.Fatalf("got %v, want %v", got, want)
+			}
+		})
+	})
+
+	t.Run("download", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "awless-at-download")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		getObject := func(input *s3.GetObjectInput) (*s3.GetObjectOutput, error) {
+			return &s3.GetObjectOutput{Body: ioutil.NopCloser(strings.NewReader("id,total\n1,42\n")), ContentLength: Int64(14)}, nil
+		}
+
+		Template("download s3object bucket=my-reports name=2017/report.csv file="+dir).Mock(&s3Mock{
+			GetObjectFunc: getObject,
+		}).ExpectInput("GetObject", &s3.GetObjectInput{
+			Bucket: String("my-reports"),
+			Key:    String("2017/report.csv"),
+		}).ExpectCommandResult(filepath.Join(dir, "report.csv")).ExpectCalls("GetObject").Run(t)
+		if content, err := ioutil.ReadFile(filepath.Join(dir, "report.csv")); err != nil || string(content) != "id,total\n1,42\n" {
+			t.Fatalf("got %q (%v)", content, err)
+		}
+
+		file := filepath.Join(dir, "previous.csv")
+		Template("download s3object bucket=my-reports name=2017/report.csv version=v2 file="+file).Mock(&s3Mock{
+			GetObjectFunc: getObject,
+		}).ExpectInput("GetObject", &s3.GetObjectInput{
+			Bucket:    String("my-reports"),
+			Key:       String("2017/report.csv"),
+			VersionId: String("v2"),
+		}).ExpectCommandResult(file).ExpectCalls("GetObject").Run(t)
+		if _, err := os.Stat(file); err != nil {
+			t.Fatal(err)
+		}
+	})
+
 	t.Run("update", func(t *testing.T) {
 		Template("update s3object name=any-file bucket=other-bucket acl=public-read version=2").Mock(&s3Mock{
 			PutObjectAclFunc: func(input *s3.PutObjectAclInput) (*s3.PutObjectAclOutput, error) {
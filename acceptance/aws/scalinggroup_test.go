@@ -29,6 +29,20 @@ func TestScalingGroup(t *testing.T) {
 
 	t.Run("update", func(t *testing.T) {
 		Template("update scalinggroup name=new-autoscaling launchconfiguration=config max-size=12 min-size=10 subnets=sub_1,sub_2 cooldown=3 desired-capacity=12 healthcheck-grace-period=4 healthcheck-type=healthy new-instances-protected=true").Mock(&autoscalingMock{
+			DescribeAutoScalingGroupsFunc: func(input *autoscaling.DescribeAutoScalingGroupsInput) (*autoscaling.DescribeAutoScalingGroupsOutput, error) {
+				return &autoscaling.DescribeAutoScalingGroupsOutput{AutoScalingGroups: []*autoscaling.Group{{
+					AutoScalingGroupName:             String("new-autoscaling"),
+					LaunchConfigurationName:          String("old-config"),
+					MaxSize:                          Int64(4),
+					MinSize:                          Int64(2),
+					DefaultCooldown:                  Int64(300),
+					DesiredCapacity:                  Int64(3),
+					HealthCheckGracePeriod:           Int64(0),
+					HealthCheckType:                  String("EC2"),
+					NewInstancesProtectedFromScaleIn: Bool(false),
+					VPCZoneIdentifier:                String("sub_1"),
+				}}}, nil
+			},
 			UpdateAutoScalingGroupFunc: func(input *autoscaling.UpdateAutoScalingGroupInput) (*autoscaling.UpdateAutoScalingGroupOutput, error) {
 				return nil, nil
 			}}).
@@ -43,7 +57,10 @@ func TestScalingGroup(t *testing.T) {
 				HealthCheckType:                  String("healthy"),
 				NewInstancesProtectedFromScaleIn: Bool(true),
 				VPCZoneIdentifier:                String("sub_1,sub_2"),
-			}).ExpectCalls("UpdateAutoScalingGroup").Run(t)
+			}).ExpectInput("DescribeAutoScalingGroups", &autoscaling.DescribeAutoScalingGroupsInput{
+				AutoScalingGroupNames: []*string{String("new-autoscaling")},
+			}).ExpectCalls("DescribeAutoScalingGroups", "UpdateAutoScalingGroup").
+			ExpectRevert("update scalinggroup cooldown=300 desired-capacity=3 healthcheck-grace-period=0 healthcheck-type=EC2 launchconfiguration=old-config max-size=4 min-size=2 name=new-autoscaling new-instances-protected=false subnets=[sub_1]").Run(t)
 	})
 
 	t.Run("delete", func(t *testing.T) {
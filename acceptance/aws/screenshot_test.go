@@ -0,0 +1,36 @@
+package awsat
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestScreenshot(t *testing.T) {
+	t.Run("get", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "awless-at-screenshot")
+		if err != nil {
+			t.Fatal(err)
+		}
+		file := filepath.Join(dir, "screen.jpg")
+
+		Template("get screenshot instance=i-1234 wake-up=true file="+file).
+			Mock(&ec2Mock{
+				GetConsoleScreenshotFunc: func(input *ec2.GetConsoleScreenshotInput) (*ec2.GetConsoleScreenshotOutput, error) {
+					return &ec2.GetConsoleScreenshotOutput{InstanceId: String("i-1234"), ImageData: String(base64.StdEncoding.EncodeToString([]byte("jpg data")))}, nil
+				},
+			}).ExpectInput("GetConsoleScreenshot", &ec2.GetConsoleScreenshotInput{InstanceId: String("i-1234"), WakeUp: Bool(true)}).
+			ExpectCommandResult(file).ExpectCalls("GetConsoleScreenshot").Run(t)
+
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := string(content), "jpg data"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/wallix/awless/aws/secretsmanager"
+)
+
+func TestSecret(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create secret name=my-app/db-password value=s3cr3t description='Database password'").
+			Mock(&secretsmanagerMock{
+				CreateSecretFunc: func(param0 *secretsmanager.CreateSecretInput) (*secretsmanager.CreateSecretOutput, error) {
+					return &secretsmanager.CreateSecretOutput{Name: String("my-app/db-password")}, nil
+				},
+			}).ExpectInput("CreateSecret", &secretsmanager.CreateSecretInput{
+			Name:         String("my-app/db-password"),
+			SecretString: String("s3cr3t"),
+			Description:  String("Database password"),
+		}).ExpectCommandResult("my-app/db-password").ExpectCalls("CreateSecret").
+			ExpectRevert("delete secret name=my-app/db-password").Run(t)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		Template("update secret name=my-app/db-password value=n3ws3cr3t key=alias/my-app").
+			Mock(&secretsmanagerMock{
+				UpdateSecretFunc: func(param0 *secretsmanager.UpdateSecretInput) (*secretsmanager.UpdateSecretOutput, error) {
+					return &secretsmanager.UpdateSecretOutput{Name: String("my-app/db-password")}, nil
+				},
+			}).ExpectInput("UpdateSecret", &secretsmanager.UpdateSecretInput{
+			SecretId:     String("my-app/db-password"),
+			SecretString: String("n3ws3cr3t"),
+			KmsKeyId:     String("alias/my-app"),
+		}).ExpectCalls("UpdateSecret").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete secret name=my-app/db-password recovery-window=7").
+			Mock(&secretsmanagerMock{
+				DeleteSecretFunc: func(param0 *secretsmanager.DeleteSecretInput) (*secretsmanager.DeleteSecretOutput, error) {
+					return &secretsmanager.DeleteSecretOutput{Name: String("my-app/db-password")}, nil
+				},
+			}).ExpectInput("DeleteSecret", &secretsmanager.DeleteSecretInput{
+			SecretId:             String("my-app/db-password"),
+			RecoveryWindowInDays: Int64(7),
+		}).ExpectCalls("DeleteSecret").Run(t)
+	})
+
+	t.Run("delete without recovery", func(t *testing.T) {
+		Template("delete secret name=my-app/db-password force=true").
+			Mock(&secretsmanagerMock{
+				DeleteSecretFunc: func(param0 *secretsmanager.DeleteSecretInput) (*secretsmanager.DeleteSecretOutput, error) {
+					return &secretsmanager.DeleteSecretOutput{Name: String("my-app/db-password")}, nil
+				},
+			}).ExpectInput("DeleteSecret", &secretsmanager.DeleteSecretInput{
+			SecretId:                   String("my-app/db-password"),
+			ForceDeleteWithoutRecovery: Bool(true),
+		}).ExpectCalls("DeleteSecret").Run(t)
+	})
+}
@@ -38,6 +38,39 @@ func TestSecuritygroup(t *testing.T) {
 				}).ExpectCalls("AuthorizeSecurityGroupIngress").Run(t)
 		})
 
+		t.Run("inbound authorize with source", func(t *testing.T) {
+			Template("update securitygroup id=my-secgroup-id inbound=authorize protocol=tcp source=sg-123456 portrange=5432").Mock(&ec2Mock{
+				AuthorizeSecurityGroupIngressFunc: func(input *ec2.AuthorizeSecurityGroupIngressInput) (*ec2.AuthorizeSecurityGroupIngressOutput, error) {
+					return nil, nil
+				}}).
+				ExpectInput("AuthorizeSecurityGroupIngress", &ec2.AuthorizeSecurityGroupIngressInput{
+					GroupId: String("my-secgroup-id"),
+					IpPermissions: []*ec2.IpPermission{
+						{
+							UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: String("sg-123456")}},
+							IpProtocol:       String("tcp"),
+							FromPort:         Int64(5432),
+							ToPort:           Int64(5432),
+						},
+					},
+				}).ExpectCalls("AuthorizeSecurityGroupIngress").Run(t)
+			Template("update securitygroup id=my-secgroup-id inbound=authorize protocol=tcp source=10.10.10.0/24 portrange=5432").Mock(&ec2Mock{
+				AuthorizeSecurityGroupIngressFunc: func(input *ec2.AuthorizeSecurityGroupIngressInput) (*ec2.AuthorizeSecurityGroupIngressOutput, error) {
+					return nil, nil
+				}}).
+				ExpectInput("AuthorizeSecurityGroupIngress", &ec2.AuthorizeSecurityGroupIngressInput{
+					GroupId: String("my-secgroup-id"),
+					IpPermissions: []*ec2.IpPermission{
+						{
+							IpProtocol: String("tcp"),
+							IpRanges:   []*ec2.IpRange{{CidrIp: String("10.10.10.0/24")}},
+							FromPort:   Int64(5432),
+							ToPort:     Int64(5432),
+						},
+					},
+				}).ExpectCalls("AuthorizeSecurityGroupIngress").Run(t)
+		})
+
 		t.Run("inbound authorize", func(t *testing.T) {
 			Template("update securitygroup id=my-secgroup-id inbound=authorize protocol=tcp cidr=10.10.10.0/24 portrange=10-22").Mock(&ec2Mock{
 				AuthorizeSecurityGroupIngressFunc: func(input *ec2.AuthorizeSecurityGroupIngressInput) (*ec2.AuthorizeSecurityGroupIngressOutput, error) {
@@ -106,6 +139,42 @@ func TestSecuritygroup(t *testing.T) {
 					},
 				}).ExpectCalls("RevokeSecurityGroupEgress").Run(t)
 		})
+		t.Run("rule sets", func(t *testing.T) {
+			Template("update securitygroup id=my-secgroup-id inbound-rules=[tcp:22:10.0.0.0/8,tcp:443:0.0.0.0/0] outbound-rules=[any:any:0.0.0.0/0]").Mock(&ec2Mock{
+				DescribeSecurityGroupsFunc: func(input *ec2.DescribeSecurityGroupsInput) (*ec2.DescribeSecurityGroupsOutput, error) {
+					return &ec2.DescribeSecurityGroupsOutput{SecurityGroups: []*ec2.SecurityGroup{{
+						GroupId: String("my-secgroup-id"),
+						IpPermissions: []*ec2.IpPermission{
+							{IpProtocol: String("tcp"), IpRanges: []*ec2.IpRange{{CidrIp: String("10.0.0.0/8")}, {CidrIp: String("0.0.0.0/0")}}, FromPort: Int64(22), ToPort: Int64(22)},
+						},
+						IpPermissionsEgress: []*ec2.IpPermission{
+							{IpProtocol: String("-1"), IpRanges: []*ec2.IpRange{{CidrIp: String("0.0.0.0/0")}}},
+						},
+					}}}, nil
+				},
+				AuthorizeSecurityGroupIngressFunc: func(input *ec2.AuthorizeSecurityGroupIngressInput) (*ec2.AuthorizeSecurityGroupIngressOutput, error) {
+					return nil, nil
+				},
+				RevokeSecurityGroupIngressFunc: func(input *ec2.RevokeSecurityGroupIngressInput) (*ec2.RevokeSecurityGroupIngressOutput, error) {
+					return nil, nil
+				}}).
+				ExpectInput("DescribeSecurityGroups", &ec2.DescribeSecurityGroupsInput{
+					GroupIds: []*string{String("my-secgroup-id")},
+				}).
+				ExpectInput("AuthorizeSecurityGroupIngress", &ec2.AuthorizeSecurityGroupIngressInput{
+					GroupId: String("my-secgroup-id"),
+					IpPermissions: []*ec2.IpPermission{
+						{IpProtocol: String("tcp"), IpRanges: []*ec2.IpRange{{CidrIp: String("0.0.0.0/0")}}, FromPort: Int64(443), ToPort: Int64(443)},
+					},
+				}).
+				ExpectInput("RevokeSecurityGroupIngress", &ec2.RevokeSecurityGroupIngressInput{
+					GroupId: String("my-secgroup-id"),
+					IpPermissions: []*ec2.IpPermission{
+						{IpProtocol: String("tcp"), IpRanges: []*ec2.IpRange{{CidrIp: String("0.0.0.0/0")}}, FromPort: Int64(22), ToPort: Int64(22)},
+					},
+				}).ExpectCalls("DescribeSecurityGroups", "DescribeSecurityGroups", "AuthorizeSecurityGroupIngress", "RevokeSecurityGroupIngress").
+				ExpectRevert("update securitygroup id=my-secgroup-id inbound-rules=[tcp:22:10.0.0.0/8,tcp:22:0.0.0.0/0] outbound-rules=[any:any:0.0.0.0/0]").Run(t)
+		})
 	})
 
 	t.Run("delete", func(t *testing.T) {
@@ -0,0 +1,35 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+)
+
+func TestServicecontrolpolicy(t *testing.T) {
+	t.Run("attach", func(t *testing.T) {
+		Template("attach servicecontrolpolicy id=p-examplepolicyid111 target=ou-1234-abcd").
+			Mock(&organizationsMock{
+				AttachPolicyFunc: func(param0 *organizations.AttachPolicyInput) (*organizations.AttachPolicyOutput, error) {
+					return &organizations.AttachPolicyOutput{}, nil
+				},
+			}).ExpectInput("AttachPolicy", &organizations.AttachPolicyInput{
+			PolicyId: String("p-examplepolicyid111"),
+			TargetId: String("ou-1234-abcd"),
+		}).ExpectCalls("AttachPolicy").
+			ExpectRevert("detach servicecontrolpolicy id=p-examplepolicyid111 target=ou-1234-abcd").Run(t)
+	})
+
+	t.Run("detach", func(t *testing.T) {
+		Template("detach servicecontrolpolicy id=p-examplepolicyid111 target=111111111111").
+			Mock(&organizationsMock{
+				DetachPolicyFunc: func(param0 *organizations.DetachPolicyInput) (*organizations.DetachPolicyOutput, error) {
+					return &organizations.DetachPolicyOutput{}, nil
+				},
+			}).ExpectInput("DetachPolicy", &organizations.DetachPolicyInput{
+			PolicyId: String("p-examplepolicyid111"),
+			TargetId: String("111111111111"),
+		}).ExpectCalls("DetachPolicy").
+			ExpectRevert("attach servicecontrolpolicy id=p-examplepolicyid111 target=111111111111").Run(t)
+	})
+}
@@ -0,0 +1,51 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestSpotfleet(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		t.Run("across pools", func(t *testing.T) {
+			Template("create spotfleet capacity=4 fleet-role=arn:my:fleet:role image=ami-1234 types=[t2.nano,t2.micro] subnets=[sub-1234,sub-5678] securitygroups=sg-1234 price=0.02 strategy=diversified").
+				Mock(&ec2Mock{
+					RequestSpotFleetFunc: func(input *ec2.RequestSpotFleetInput) (*ec2.RequestSpotFleetOutput, error) {
+						return &ec2.RequestSpotFleetOutput{SpotFleetRequestId: String("sfr-1234")}, nil
+					},
+				}).ExpectInput("RequestSpotFleet", &ec2.RequestSpotFleetInput{
+				SpotFleetRequestConfig: &ec2.SpotFleetRequestConfigData{
+					IamFleetRole:       String("arn:my:fleet:role"),
+					TargetCapacity:     Int64(4),
+					SpotPrice:          String("0.02"),
+					AllocationStrategy: String("diversified"),
+					LaunchSpecifications: []*ec2.SpotFleetLaunchSpecification{
+						{ImageId: String("ami-1234"), InstanceType: String("t2.nano"), SubnetId: String("sub-1234,sub-5678"), SecurityGroups: []*ec2.GroupIdentifier{{GroupId: String("sg-1234")}}},
+						{ImageId: String("ami-1234"), InstanceType: String("t2.micro"), SubnetId: String("sub-1234,sub-5678"), SecurityGroups: []*ec2.GroupIdentifier{{GroupId: String("sg-1234")}}},
+					},
+				},
+			}).ExpectCommandResult("sfr-1234").ExpectCalls("RequestSpotFleet").
+				ExpectRevert("cancel spotrequest id=sfr-1234 terminate-instances=true").Run(t)
+		})
+
+		t.Run("maintained", func(t *testing.T) {
+			Template("create spotfleet capacity=2 fleet-role=arn:my:fleet:role image=ami-1234 types=t2.nano keypair=mykp role=myrole persistent=true interruption=hibernate").
+				Mock(&ec2Mock{
+					RequestSpotFleetFunc: func(input *ec2.RequestSpotFleetInput) (*ec2.RequestSpotFleetOutput, error) {
+						return &ec2.RequestSpotFleetOutput{SpotFleetRequestId: String("sfr-1234")}, nil
+					},
+				}).ExpectInput("RequestSpotFleet", &ec2.RequestSpotFleetInput{
+				SpotFleetRequestConfig: &ec2.SpotFleetRequestConfigData{
+					IamFleetRole:                 String("arn:my:fleet:role"),
+					TargetCapacity:               Int64(2),
+					Type:                         String("maintain"),
+					InstanceInterruptionBehavior: String("hibernate"),
+					LaunchSpecifications: []*ec2.SpotFleetLaunchSpecification{
+						{ImageId: String("ami-1234"), InstanceType: String("t2.nano"), KeyName: String("mykp"), IamInstanceProfile: &ec2.IamInstanceProfileSpecification{Name: String("myrole")}},
+					},
+				},
+			}).ExpectCommandResult("sfr-1234").ExpectCalls("RequestSpotFleet").Run(t)
+		})
+	})
+}
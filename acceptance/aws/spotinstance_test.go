@@ -0,0 +1,62 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestSpotinstance(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		t.Run("one-time", func(t *testing.T) {
+			Template("create spotinstance image=ami-1234 type=t2.nano subnet=sub-1234 count=2 price=0.01 keypair=mykp securitygroup=sg-1234 role=myrole").
+				Mock(&ec2Mock{
+					RequestSpotInstancesFunc: func(input *ec2.RequestSpotInstancesInput) (*ec2.RequestSpotInstancesOutput, error) {
+						return &ec2.RequestSpotInstancesOutput{SpotInstanceRequests: []*ec2.SpotInstanceRequest{{SpotInstanceRequestId: String("sir-1234")}, {SpotInstanceRequestId: String("sir-5678")}}}, nil
+					},
+				}).ExpectInput("RequestSpotInstances", &ec2.RequestSpotInstancesInput{
+				InstanceCount: Int64(2),
+				SpotPrice:     String("0.01"),
+				LaunchSpecification: &ec2.RequestSpotLaunchSpecification{
+					ImageId:            String("ami-1234"),
+					InstanceType:       String("t2.nano"),
+					SubnetId:           String("sub-1234"),
+					KeyName:            String("mykp"),
+					SecurityGroupIds:   []*string{String("sg-1234")},
+					IamInstanceProfile: &ec2.IamInstanceProfileSpecification{Name: String("myrole")},
+				},
+			}).ExpectCommandResult("sir-1234").ExpectCalls("RequestSpotInstances").
+				ExpectRevert("cancel spotrequest id=sir-1234 terminate-instances=true").Run(t)
+		})
+
+		t.Run("persistent with name", func(t *testing.T) {
+			Template("create spotinstance image=ami-1234 type=t2.nano subnet=sub-1234 persistent=true interruption=stop name=myspot").
+				Mock(&ec2Mock{
+					RequestSpotInstancesFunc: func(input *ec2.RequestSpotInstancesInput) (*ec2.RequestSpotInstancesOutput, error) {
+						return &ec2.RequestSpotInstancesOutput{SpotInstanceRequests: []*ec2.SpotInstanceRequest{{SpotInstanceRequestId: String("sir-1234")}}}, nil
+					},
+					CreateTagsRequestFunc: func(input *ec2.CreateTagsInput) (req *request.Request, output *ec2.CreateTagsOutput) {
+						output = &ec2.CreateTagsOutput{}
+						req = request.New(aws.Config{}, metadata.ClientInfo{}, request.Handlers{}, nil, &request.Operation{}, input, output)
+						return
+					},
+				}).ExpectInput("RequestSpotInstances", &ec2.RequestSpotInstancesInput{
+				Type:                         String("persistent"),
+				InstanceInterruptionBehavior: String("stop"),
+				LaunchSpecification: &ec2.RequestSpotLaunchSpecification{
+					ImageId:      String("ami-1234"),
+					InstanceType: String("t2.nano"),
+					SubnetId:     String("sub-1234"),
+				},
+			}).ExpectInput("CreateTagsRequest", &ec2.CreateTagsInput{
+				Resources: []*string{String("sir-1234")},
+				Tags: []*ec2.Tag{
+					{Key: String("Name"), Value: String("myspot")},
+				},
+			}).ExpectCommandResult("sir-1234").ExpectCalls("RequestSpotInstances", "CreateTagsRequest").Run(t)
+		})
+	})
+}
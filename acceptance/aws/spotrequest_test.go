@@ -0,0 +1,55 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestSpotrequest(t *testing.T) {
+	t.Run("cancel", func(t *testing.T) {
+		t.Run("spot instance request", func(t *testing.T) {
+			Template("cancel spotrequest id=sir-1234").
+				Mock(&ec2Mock{
+					CancelSpotInstanceRequestsFunc: func(input *ec2.CancelSpotInstanceRequestsInput) (*ec2.CancelSpotInstanceRequestsOutput, error) {
+						return &ec2.CancelSpotInstanceRequestsOutput{}, nil
+					},
+				}).ExpectInput("CancelSpotInstanceRequests", &ec2.CancelSpotInstanceRequestsInput{
+				SpotInstanceRequestIds: []*string{String("sir-1234")},
+			}).ExpectCalls("CancelSpotInstanceRequests").Run(t)
+		})
+
+		t.Run("spot instance request and its instance", func(t *testing.T) {
+			Template("cancel spotrequest id=sir-1234 terminate-instances=true").
+				Mock(&ec2Mock{
+					DescribeSpotInstanceRequestsFunc: func(input *ec2.DescribeSpotInstanceRequestsInput) (*ec2.DescribeSpotInstanceRequestsOutput, error) {
+						return &ec2.DescribeSpotInstanceRequestsOutput{SpotInstanceRequests: []*ec2.SpotInstanceRequest{{SpotInstanceRequestId: String("sir-1234"), InstanceId: String("i-1234")}}}, nil
+					},
+					CancelSpotInstanceRequestsFunc: func(input *ec2.CancelSpotInstanceRequestsInput) (*ec2.CancelSpotInstanceRequestsOutput, error) {
+						return &ec2.CancelSpotInstanceRequestsOutput{}, nil
+					},
+					TerminateInstancesFunc: func(input *ec2.TerminateInstancesInput) (*ec2.TerminateInstancesOutput, error) {
+						return &ec2.TerminateInstancesOutput{}, nil
+					},
+				}).ExpectInput("DescribeSpotInstanceRequests", &ec2.DescribeSpotInstanceRequestsInput{
+				SpotInstanceRequestIds: []*string{String("sir-1234")},
+			}).ExpectInput("CancelSpotInstanceRequests", &ec2.CancelSpotInstanceRequestsInput{
+				SpotInstanceRequestIds: []*string{String("sir-1234")},
+			}).ExpectInput("TerminateInstances", &ec2.TerminateInstancesInput{
+				InstanceIds: []*string{String("i-1234")},
+			}).ExpectCalls("DescribeSpotInstanceRequests", "CancelSpotInstanceRequests", "TerminateInstances").Run(t)
+		})
+
+		t.Run("spot fleet request", func(t *testing.T) {
+			Template("cancel spotrequest id=sfr-1234 terminate-instances=true").
+				Mock(&ec2Mock{
+					CancelSpotFleetRequestsFunc: func(input *ec2.CancelSpotFleetRequestsInput) (*ec2.CancelSpotFleetRequestsOutput, error) {
+						return &ec2.CancelSpotFleetRequestsOutput{SuccessfulFleetRequests: []*ec2.CancelSpotFleetRequestsSuccessItem{{SpotFleetRequestId: String("sfr-1234")}}}, nil
+					},
+				}).ExpectInput("CancelSpotFleetRequests", &ec2.CancelSpotFleetRequestsInput{
+				SpotFleetRequestIds: []*string{String("sfr-1234")},
+				TerminateInstances:  Bool(true),
+			}).ExpectCalls("CancelSpotFleetRequests").Run(t)
+		})
+	})
+}
@@ -36,6 +36,17 @@ func TestStack(t *testing.T) {
 		}).ExpectCommandResult("new-stack-id").ExpectCalls("CreateStack").Run(t)
 	})
 
+	t.Run("create from S3 template", func(t *testing.T) {
+		Template("create stack name=new-stack template-url=https://s3.amazonaws.com/mybucket/stack.yml parameters=InstanceType:t2.micro").Mock(&cloudformationMock{
+			CreateStackFunc: func(input *cloudformation.CreateStackInput) (*cloudformation.CreateStackOutput, error) {
+				return &cloudformation.CreateStackOutput{StackId: String("new-stack-id")}, nil
+			}}).ExpectInput("CreateStack", &cloudformation.CreateStackInput{
+			StackName:   String("new-stack"),
+			TemplateURL: String("https://s3.amazonaws.com/mybucket/stack.yml"),
+			Parameters:  []*cloudformation.Parameter{{ParameterKey: String("InstanceType"), ParameterValue: String("t2.micro")}},
+		}).ExpectCommandResult("new-stack-id").ExpectCalls("CreateStack").Run(t)
+	})
+
 	t.Run("update", func(t *testing.T) {
 		_, polUpdateFilePath, clean := generateTmpFile("update policy content")
 		defer clean()
@@ -57,6 +68,16 @@ func TestStack(t *testing.T) {
 		}).ExpectCommandResult("any-stack-id").ExpectCalls("UpdateStack").Run(t)
 	})
 
+	t.Run("update from S3 template", func(t *testing.T) {
+		Template("update stack name=other-name template-url=https://s3.amazonaws.com/mybucket/stack.yml").Mock(&cloudformationMock{
+			UpdateStackFunc: func(input *cloudformation.UpdateStackInput) (*cloudformation.UpdateStackOutput, error) {
+				return &cloudformation.UpdateStackOutput{StackId: String("any-stack-id")}, nil
+			}}).ExpectInput("UpdateStack", &cloudformation.UpdateStackInput{
+			StackName:   String("other-name"),
+			TemplateURL: String("https://s3.amazonaws.com/mybucket/stack.yml"),
+		}).ExpectCommandResult("any-stack-id").ExpectCalls("UpdateStack").Run(t)
+	})
+
 	t.Run("delete", func(t *testing.T) {
 		Template("delete stack name=any-stack-name retain-resources=1,2").Mock(&cloudformationMock{
 			DeleteStackFunc: func(input *cloudformation.DeleteStackInput) (*cloudformation.DeleteStackOutput, error) {
@@ -0,0 +1,83 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestStatemachine(t *testing.T) {
+	definition := `{"StartAt": "Hello", "States": {"Hello": {"Type": "Pass", "End": true}}}`
+
+	t.Run("create", func(t *testing.T) {
+		_, definitionFile, cleanup := generateTmpFile(definition)
+		defer cleanup()
+
+		g := graph.NewGraph()
+		g.AddResource(resourcetest.Role("AROA1234").Prop(properties.Name, "states-role").Prop(properties.Arn, "arn:aws:iam::0123456789:role/states-role").Build())
+		Template("create statemachine name=hello-workflow definition=file("+definitionFile+") role=states-role").
+			Mock(&sfnMock{
+				CreateStateMachineFunc: func(param0 *sfn.CreateStateMachineInput) (*sfn.CreateStateMachineOutput, error) {
+					return &sfn.CreateStateMachineOutput{StateMachineArn: String("arn:aws:states:us-east-1:0123456789:stateMachine:hello-workflow")}, nil
+				},
+			}).Graph(g).ExpectInput("CreateStateMachine", &sfn.CreateStateMachineInput{
+			Name:       String("hello-workflow"),
+			Definition: String(definition),
+			RoleArn:    String("arn:aws:iam::0123456789:role/states-role"),
+		}).ExpectCommandResult("arn:aws:states:us-east-1:0123456789:stateMachine:hello-workflow").ExpectCalls("CreateStateMachine").
+			ExpectRevert("delete statemachine id=arn:aws:states:us-east-1:0123456789:stateMachine:hello-workflow").Run(t)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		Template("update statemachine id=arn:aws:states:us-east-1:0123456789:stateMachine:hello-workflow role=arn:aws:iam::0123456789:role/new-role").
+			Mock(&sfnMock{
+				UpdateStateMachineFunc: func(param0 *sfn.UpdateStateMachineInput) (*sfn.UpdateStateMachineOutput, error) {
+					return &sfn.UpdateStateMachineOutput{}, nil
+				},
+			}).ExpectInput("UpdateStateMachine", &sfn.UpdateStateMachineInput{
+			StateMachineArn: String("arn:aws:states:us-east-1:0123456789:stateMachine:hello-workflow"),
+			RoleArn:         String("arn:aws:iam::0123456789:role/new-role"),
+		}).ExpectCalls("UpdateStateMachine").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete statemachine id=arn:aws:states:us-east-1:0123456789:stateMachine:hello-workflow").
+			Mock(&sfnMock{
+				DeleteStateMachineFunc: func(param0 *sfn.DeleteStateMachineInput) (*sfn.DeleteStateMachineOutput, error) {
+					return &sfn.DeleteStateMachineOutput{}, nil
+				},
+			}).ExpectInput("DeleteStateMachine", &sfn.DeleteStateMachineInput{StateMachineArn: String("arn:aws:states:us-east-1:0123456789:stateMachine:hello-workflow")}).
+			ExpectCalls("DeleteStateMachine").Run(t)
+	})
+}
+
+func TestExecution(t *testing.T) {
+	t.Run("start", func(t *testing.T) {
+		Template("start execution statemachine=arn:aws:states:us-east-1:0123456789:stateMachine:hello-workflow name=run-1 input='{\"who\": \"world\"}'").
+			Mock(&sfnMock{
+				StartExecutionFunc: func(param0 *sfn.StartExecutionInput) (*sfn.StartExecutionOutput, error) {
+					return &sfn.StartExecutionOutput{ExecutionArn: String("arn:aws:states:us-east-1:0123456789:execution:hello-workflow:run-1")}, nil
+				},
+			}).ExpectInput("StartExecution", &sfn.StartExecutionInput{
+			StateMachineArn: String("arn:aws:states:us-east-1:0123456789:stateMachine:hello-workflow"),
+			Name:            String("run-1"),
+			Input:           String(`{"who": "world"}`),
+		}).ExpectCommandResult("arn:aws:states:us-east-1:0123456789:execution:hello-workflow:run-1").ExpectCalls("StartExecution").
+			ExpectRevert("stop execution id=arn:aws:states:us-east-1:0123456789:execution:hello-workflow:run-1").Run(t)
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		Template("stop execution id=arn:aws:states:us-east-1:0123456789:execution:hello-workflow:run-1 cause='Order cancelled'").
+			Mock(&sfnMock{
+				StopExecutionFunc: func(param0 *sfn.StopExecutionInput) (*sfn.StopExecutionOutput, error) {
+					return &sfn.StopExecutionOutput{}, nil
+				},
+			}).ExpectInput("StopExecution", &sfn.StopExecutionInput{
+			ExecutionArn: String("arn:aws:states:us-east-1:0123456789:execution:hello-workflow:run-1"),
+			Cause:        String("Order cancelled"),
+		}).ExpectCalls("StopExecution").Run(t)
+	})
+}
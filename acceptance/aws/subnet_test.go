@@ -60,13 +60,36 @@ func TestSubnet(t *testing.T) {
 
 	t.Run("update", func(t *testing.T) {
 		Template("update subnet id=any-subnet-id public=true").Mock(&ec2Mock{
+			DescribeSubnetsFunc: func(input *ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error) {
+				return &ec2.DescribeSubnetsOutput{Subnets: []*ec2.Subnet{{SubnetId: String("any-subnet-id"), MapPublicIpOnLaunch: Bool(false)}}}, nil
+			},
 			ModifySubnetAttributeFunc: func(input *ec2.ModifySubnetAttributeInput) (*ec2.ModifySubnetAttributeOutput, error) {
 				return nil, nil
 			}}).
+			ExpectInput("DescribeSubnets", &ec2.DescribeSubnetsInput{SubnetIds: []*string{String("any-subnet-id")}}).
 			ExpectInput("ModifySubnetAttribute", &ec2.ModifySubnetAttributeInput{
 				MapPublicIpOnLaunch: &ec2.AttributeBooleanValue{Value: Bool(true)},
 				SubnetId:            String("any-subnet-id"),
-			}).ExpectCalls("ModifySubnetAttribute").Run(t)
+			}).ExpectCalls("DescribeSubnets", "ModifySubnetAttribute").
+			ExpectRevert("update subnet id=any-subnet-id public=false").Run(t)
+	})
+
+	t.Run("update ipv6", func(t *testing.T) {
+		Template("update subnet id=any-subnet-id ipv6=2600:1f18:22b3:7a00::/64 assign-ipv6=true").Mock(&ec2Mock{
+			AssociateSubnetCidrBlockFunc: func(input *ec2.AssociateSubnetCidrBlockInput) (*ec2.AssociateSubnetCidrBlockOutput, error) {
+				return &ec2.AssociateSubnetCidrBlockOutput{}, nil
+			},
+			ModifySubnetAttributeFunc: func(input *ec2.ModifySubnetAttributeInput) (*ec2.ModifySubnetAttributeOutput, error) {
+				return nil, nil
+			}}).
+			ExpectInput("AssociateSubnetCidrBlock", &ec2.AssociateSubnetCidrBlockInput{
+				Ipv6CidrBlock: String("2600:1f18:22b3:7a00::/64"),
+				SubnetId:      String("any-subnet-id"),
+			}).
+			ExpectInput("ModifySubnetAttribute", &ec2.ModifySubnetAttributeInput{
+				AssignIpv6AddressOnCreation: &ec2.AttributeBooleanValue{Value: Bool(true)},
+				SubnetId:                    String("any-subnet-id"),
+			}).ExpectCalls("AssociateSubnetCidrBlock", "ModifySubnetAttribute").Run(t)
 	})
 
 	t.Run("delete", func(t *testing.T) {
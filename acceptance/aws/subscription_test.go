@@ -14,11 +14,40 @@ func TestSubscription(t *testing.T) {
 			}}).
 			ExpectInput("Subscribe", &sns.SubscribeInput{
 				Endpoint: String("any-endpoint"),
-				Protocol: String("HTTP"),
+				Protocol: String("http"),
 				TopicArn: String("any-topic"),
 			}).ExpectCommandResult("subscription-arn").ExpectCalls("Subscribe").Run(t)
 	})
 
+	t.Run("create on declared topic", func(t *testing.T) {
+		Template("alerts = create topic name=alerts\ncreate subscription topic=$alerts protocol=sqs endpoint=arn:aws:sqs:eu-west-1:0123456789:jobs").Mock(&snsMock{
+			CreateTopicFunc: func(input *sns.CreateTopicInput) (*sns.CreateTopicOutput, error) {
+				return &sns.CreateTopicOutput{TopicArn: String("arn:aws:sns:eu-west-1:0123456789:alerts")}, nil
+			},
+			SubscribeFunc: func(input *sns.SubscribeInput) (*sns.SubscribeOutput, error) {
+				return &sns.SubscribeOutput{SubscriptionArn: String("arn:aws:sns:eu-west-1:0123456789:alerts:e3f1")}, nil
+			}}).
+			ExpectInput("CreateTopic", &sns.CreateTopicInput{Name: String("alerts")}).
+			ExpectInput("Subscribe", &sns.SubscribeInput{
+				Endpoint: String("arn:aws:sqs:eu-west-1:0123456789:jobs"),
+				Protocol: String("sqs"),
+				TopicArn: String("arn:aws:sns:eu-west-1:0123456789:alerts"),
+			}).ExpectCalls("CreateTopic", "Subscribe").
+			ExpectRevert("delete subscription id=arn:aws:sns:eu-west-1:0123456789:alerts:e3f1\ndelete topic id=arn:aws:sns:eu-west-1:0123456789:alerts").Run(t)
+	})
+
+	t.Run("create pending confirmation", func(t *testing.T) {
+		Template("create subscription topic=any-topic endpoint=ops@example.com protocol=email").Mock(&snsMock{
+			SubscribeFunc: func(input *sns.SubscribeInput) (*sns.SubscribeOutput, error) {
+				return &sns.SubscribeOutput{SubscriptionArn: String("pending confirmation")}, nil
+			}}).
+			ExpectInput("Subscribe", &sns.SubscribeInput{
+				Endpoint: String("ops@example.com"),
+				Protocol: String("email"),
+				TopicArn: String("any-topic"),
+			}).ExpectCommandResult("pending confirmation").ExpectCalls("Subscribe").Run(t)
+	})
+
 	t.Run("delete", func(t *testing.T) {
 		Template("delete subscription id=any-subscription-arn").Mock(&snsMock{
 			UnsubscribeFunc: func(input *sns.UnsubscribeInput) (*sns.UnsubscribeOutput, error) {
@@ -0,0 +1,69 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+func TestTable(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create table name=events hash-key=source range-key=timestamp range-key-type=number read-capacity=10").
+			Mock(&dynamodbMock{
+				CreateTableFunc: func(param0 *dynamodb.CreateTableInput) (*dynamodb.CreateTableOutput, error) {
+					return &dynamodb.CreateTableOutput{TableDescription: &dynamodb.TableDescription{TableName: String("events")}}, nil
+				},
+			}).ExpectInput("CreateTable", &dynamodb.CreateTableInput{
+			TableName: String("events"),
+			KeySchema: []*dynamodb.KeySchemaElement{
+				{AttributeName: String("source"), KeyType: String("HASH")},
+				{AttributeName: String("timestamp"), KeyType: String("RANGE")},
+			},
+			AttributeDefinitions: []*dynamodb.AttributeDefinition{
+				{AttributeName: String("source"), AttributeType: String("S")},
+				{AttributeName: String("timestamp"), AttributeType: String("N")},
+			},
+			ProvisionedThroughput: &dynamodb.ProvisionedThroughput{ReadCapacityUnits: Int64(10), WriteCapacityUnits: Int64(5)},
+		}).ExpectCommandResult("events").ExpectCalls("CreateTable").Run(t)
+	})
+
+	t.Run("update throughput", func(t *testing.T) {
+		Template("update table name=events write-capacity=20").
+			Mock(&dynamodbMock{
+				DescribeTableFunc: func(param0 *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error) {
+					return &dynamodb.DescribeTableOutput{Table: &dynamodb.TableDescription{
+						ProvisionedThroughput: &dynamodb.ProvisionedThroughputDescription{ReadCapacityUnits: Int64(10), WriteCapacityUnits: Int64(5)},
+					}}, nil
+				},
+				UpdateTableFunc: func(param0 *dynamodb.UpdateTableInput) (*dynamodb.UpdateTableOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DescribeTable", &dynamodb.DescribeTableInput{TableName: String("events")}).
+			ExpectInput("UpdateTable", &dynamodb.UpdateTableInput{
+				TableName:             String("events"),
+				ProvisionedThroughput: &dynamodb.ProvisionedThroughput{ReadCapacityUnits: Int64(10), WriteCapacityUnits: Int64(20)},
+			}).ExpectCommandResult("events").ExpectCalls("DescribeTable", "UpdateTable").Run(t)
+	})
+
+	t.Run("update ttl", func(t *testing.T) {
+		Template("update table name=sessions ttl-attribute=expires").
+			Mock(&dynamodbMock{
+				UpdateTimeToLiveFunc: func(param0 *dynamodb.UpdateTimeToLiveInput) (*dynamodb.UpdateTimeToLiveOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("UpdateTimeToLive", &dynamodb.UpdateTimeToLiveInput{
+			TableName:               String("sessions"),
+			TimeToLiveSpecification: &dynamodb.TimeToLiveSpecification{AttributeName: String("expires"), Enabled: Bool(true)},
+		}).ExpectCommandResult("sessions").ExpectCalls("UpdateTimeToLive").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete table name=events").
+			Mock(&dynamodbMock{
+				DeleteTableFunc: func(param0 *dynamodb.DeleteTableInput) (*dynamodb.DeleteTableOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DeleteTable", &dynamodb.DeleteTableInput{TableName: String("events")}).
+			ExpectCalls("DeleteTable").Run(t)
+	})
+}
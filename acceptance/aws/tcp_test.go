@@ -0,0 +1,44 @@
+package awsat
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestTcp(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+	port := l.Addr().(*net.TCPAddr).Port
+
+	t.Run("check", func(t *testing.T) {
+		Template(fmt.Sprintf("check tcp host=127.0.0.1 port=%d timeout=5s", port)).
+			Mock(&ec2Mock{}).Run(t)
+	})
+
+	t.Run("check instance", func(t *testing.T) {
+		Template(fmt.Sprintf("check tcp instance=i-1234 port=%d timeout=300", port)).
+			Mock(&ec2Mock{
+				DescribeInstancesFunc: func(param0 *ec2.DescribeInstancesInput) (*ec2.DescribeInstancesOutput, error) {
+					return &ec2.DescribeInstancesOutput{Reservations: []*ec2.Reservation{
+						{Instances: []*ec2.Instance{{InstanceId: String("i-1234"), PrivateIpAddress: String("127.0.0.1")}}},
+					}}, nil
+				},
+			}).ExpectInput("DescribeInstances", &ec2.DescribeInstancesInput{InstanceIds: []*string{String("i-1234")}}).
+			ExpectCalls("DescribeInstances").Run(t)
+	})
+}
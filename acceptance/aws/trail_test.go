@@ -0,0 +1,38 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+)
+
+func TestTrail(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create trail name=audit bucket=my-audit-logs multiregion=true").
+			Mock(&cloudtrailMock{
+				CreateTrailFunc: func(param0 *cloudtrail.CreateTrailInput) (*cloudtrail.CreateTrailOutput, error) {
+					return &cloudtrail.CreateTrailOutput{TrailARN: String("arn:aws:cloudtrail:us-east-1:0123456789:trail/audit")}, nil
+				},
+				StartLoggingFunc: func(param0 *cloudtrail.StartLoggingInput) (*cloudtrail.StartLoggingOutput, error) {
+					return &cloudtrail.StartLoggingOutput{}, nil
+				},
+			}).ExpectInput("CreateTrail", &cloudtrail.CreateTrailInput{
+			Name:               String("audit"),
+			S3BucketName:       String("my-audit-logs"),
+			IsMultiRegionTrail: Bool(true),
+		}).ExpectInput("StartLogging", &cloudtrail.StartLoggingInput{
+			Name: String("arn:aws:cloudtrail:us-east-1:0123456789:trail/audit"),
+		}).ExpectCommandResult("arn:aws:cloudtrail:us-east-1:0123456789:trail/audit").ExpectCalls("CreateTrail", "StartLogging").
+			ExpectRevert("delete trail id=arn:aws:cloudtrail:us-east-1:0123456789:trail/audit").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete trail id=arn:aws:cloudtrail:us-east-1:0123456789:trail/audit").
+			Mock(&cloudtrailMock{
+				DeleteTrailFunc: func(param0 *cloudtrail.DeleteTrailInput) (*cloudtrail.DeleteTrailOutput, error) {
+					return &cloudtrail.DeleteTrailOutput{}, nil
+				},
+			}).ExpectInput("DeleteTrail", &cloudtrail.DeleteTrailInput{Name: String("arn:aws:cloudtrail:us-east-1:0123456789:trail/audit")}).
+			ExpectCalls("DeleteTrail").Run(t)
+	})
+}
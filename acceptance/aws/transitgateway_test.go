@@ -0,0 +1,134 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/wallix/awless/aws/ec2transitgateway"
+)
+
+func TestTransitgateway(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create transitgateway description=hub amazon-asn=64512 dns-support=enable default-association=disable").
+			Mock(&ec2transitgatewayMock{
+				CreateTransitGatewayFunc: func(input *ec2transitgateway.CreateTransitGatewayInput) (*ec2transitgateway.CreateTransitGatewayOutput, error) {
+					return &ec2transitgateway.CreateTransitGatewayOutput{TransitGateway: &ec2transitgateway.TransitGateway{TransitGatewayId: String("tgw-1234")}}, nil
+				},
+			}).ExpectInput("CreateTransitGateway", &ec2transitgateway.CreateTransitGatewayInput{
+			Description: String("hub"),
+			Options: &ec2transitgateway.TransitGatewayRequestOptions{
+				AmazonSideAsn:                Int64(64512),
+				DnsSupport:                   String("enable"),
+				DefaultRouteTableAssociation: String("disable"),
+			},
+		}).ExpectCommandResult("tgw-1234").ExpectCalls("CreateTransitGateway").
+			ExpectRevert("check transitgateway id=tgw-1234 state=available timeout=600\ndelete transitgateway id=tgw-1234").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete transitgateway id=tgw-1234").
+			Mock(&ec2transitgatewayMock{
+				DeleteTransitGatewayFunc: func(input *ec2transitgateway.DeleteTransitGatewayInput) (*ec2transitgateway.DeleteTransitGatewayOutput, error) {
+					return &ec2transitgateway.DeleteTransitGatewayOutput{}, nil
+				},
+			}).ExpectInput("DeleteTransitGateway", &ec2transitgateway.DeleteTransitGatewayInput{TransitGatewayId: String("tgw-1234")}).
+			ExpectCalls("DeleteTransitGateway").Run(t)
+	})
+
+	t.Run("check", func(t *testing.T) {
+		Template("check transitgateway id=tgw-1234 state=available timeout=1").
+			Mock(&ec2transitgatewayMock{
+				DescribeTransitGatewaysFunc: func(input *ec2transitgateway.DescribeTransitGatewaysInput) (*ec2transitgateway.DescribeTransitGatewaysOutput, error) {
+					return &ec2transitgateway.DescribeTransitGatewaysOutput{TransitGateways: []*ec2transitgateway.TransitGateway{
+						{TransitGatewayId: String("tgw-1234"), State: String("available")},
+					}}, nil
+				},
+			}).ExpectInput("DescribeTransitGateways", &ec2transitgateway.DescribeTransitGatewaysInput{TransitGatewayIds: []*string{String("tgw-1234")}}).
+			ExpectCalls("DescribeTransitGateways").Run(t)
+	})
+}
+
+func TestTransitgatewayattachment(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create transitgatewayattachment transitgateway=tgw-1234 vpc=vpc-1234 subnets=[subnet-1,subnet-2]").
+			Mock(&ec2transitgatewayMock{
+				CreateTransitGatewayVpcAttachmentFunc: func(input *ec2transitgateway.CreateTransitGatewayVpcAttachmentInput) (*ec2transitgateway.CreateTransitGatewayVpcAttachmentOutput, error) {
+					return &ec2transitgateway.CreateTransitGatewayVpcAttachmentOutput{TransitGatewayVpcAttachment: &ec2transitgateway.TransitGatewayVpcAttachment{TransitGatewayAttachmentId: String("tgw-attach-1234")}}, nil
+				},
+			}).ExpectInput("CreateTransitGatewayVpcAttachment", &ec2transitgateway.CreateTransitGatewayVpcAttachmentInput{
+			TransitGatewayId: String("tgw-1234"),
+			VpcId:            String("vpc-1234"),
+			SubnetIds:        []*string{String("subnet-1"), String("subnet-2")},
+		}).ExpectCommandResult("tgw-attach-1234").ExpectCalls("CreateTransitGatewayVpcAttachment").
+			ExpectRevert("check transitgatewayattachment id=tgw-attach-1234 state=available timeout=600\ndelete transitgatewayattachment id=tgw-attach-1234").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete transitgatewayattachment id=tgw-attach-1234").
+			Mock(&ec2transitgatewayMock{
+				DeleteTransitGatewayVpcAttachmentFunc: func(input *ec2transitgateway.DeleteTransitGatewayVpcAttachmentInput) (*ec2transitgateway.DeleteTransitGatewayVpcAttachmentOutput, error) {
+					return &ec2transitgateway.DeleteTransitGatewayVpcAttachmentOutput{}, nil
+				},
+			}).ExpectInput("DeleteTransitGatewayVpcAttachment", &ec2transitgateway.DeleteTransitGatewayVpcAttachmentInput{TransitGatewayAttachmentId: String("tgw-attach-1234")}).
+			ExpectCalls("DeleteTransitGatewayVpcAttachment").Run(t)
+	})
+
+	t.Run("check deleted", func(t *testing.T) {
+		Template("check transitgatewayattachment id=tgw-attach-1234 state=not-found timeout=1").
+			Mock(&ec2transitgatewayMock{
+				DescribeTransitGatewayAttachmentsFunc: func(input *ec2transitgateway.DescribeTransitGatewayAttachmentsInput) (*ec2transitgateway.DescribeTransitGatewayAttachmentsOutput, error) {
+					return nil, awserr.New("InvalidTransitGatewayAttachmentID.NotFound", "Transit Gateway Attachment tgw-attach-1234 was deleted or does not exist.", nil)
+				},
+			}).ExpectInput("DescribeTransitGatewayAttachments", &ec2transitgateway.DescribeTransitGatewayAttachmentsInput{TransitGatewayAttachmentIds: []*string{String("tgw-attach-1234")}}).
+			ExpectCalls("DescribeTransitGatewayAttachments").Run(t)
+	})
+}
+
+func TestTransitgatewayroutetable(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create transitgatewayroutetable transitgateway=tgw-1234").
+			Mock(&ec2transitgatewayMock{
+				CreateTransitGatewayRouteTableFunc: func(input *ec2transitgateway.CreateTransitGatewayRouteTableInput) (*ec2transitgateway.CreateTransitGatewayRouteTableOutput, error) {
+					return &ec2transitgateway.CreateTransitGatewayRouteTableOutput{TransitGatewayRouteTable: &ec2transitgateway.TransitGatewayRouteTable{TransitGatewayRouteTableId: String("tgw-rtb-1234")}}, nil
+				},
+			}).ExpectInput("CreateTransitGatewayRouteTable", &ec2transitgateway.CreateTransitGatewayRouteTableInput{TransitGatewayId: String("tgw-1234")}).
+			ExpectCommandResult("tgw-rtb-1234").ExpectCalls("CreateTransitGatewayRouteTable").
+			ExpectRevert("check transitgatewayroutetable id=tgw-rtb-1234 state=available timeout=600\ndelete transitgatewayroutetable id=tgw-rtb-1234").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete transitgatewayroutetable id=tgw-rtb-1234").
+			Mock(&ec2transitgatewayMock{
+				DeleteTransitGatewayRouteTableFunc: func(input *ec2transitgateway.DeleteTransitGatewayRouteTableInput) (*ec2transitgateway.DeleteTransitGatewayRouteTableOutput, error) {
+					return &ec2transitgateway.DeleteTransitGatewayRouteTableOutput{}, nil
+				},
+			}).ExpectInput("DeleteTransitGatewayRouteTable", &ec2transitgateway.DeleteTransitGatewayRouteTableInput{TransitGatewayRouteTableId: String("tgw-rtb-1234")}).
+			ExpectCalls("DeleteTransitGatewayRouteTable").Run(t)
+	})
+
+	t.Run("attach", func(t *testing.T) {
+		Template("attach transitgatewayroutetable id=tgw-rtb-1234 attachment=tgw-attach-1234").
+			Mock(&ec2transitgatewayMock{
+				AssociateTransitGatewayRouteTableFunc: func(input *ec2transitgateway.AssociateTransitGatewayRouteTableInput) (*ec2transitgateway.AssociateTransitGatewayRouteTableOutput, error) {
+					return &ec2transitgateway.AssociateTransitGatewayRouteTableOutput{}, nil
+				},
+			}).ExpectInput("AssociateTransitGatewayRouteTable", &ec2transitgateway.AssociateTransitGatewayRouteTableInput{
+			TransitGatewayRouteTableId: String("tgw-rtb-1234"),
+			TransitGatewayAttachmentId: String("tgw-attach-1234"),
+		}).ExpectCalls("AssociateTransitGatewayRouteTable").
+			ExpectRevert("detach transitgatewayroutetable attachment=tgw-attach-1234 id=tgw-rtb-1234").Run(t)
+	})
+
+	t.Run("detach", func(t *testing.T) {
+		Template("detach transitgatewayroutetable id=tgw-rtb-1234 attachment=tgw-attach-1234").
+			Mock(&ec2transitgatewayMock{
+				DisassociateTransitGatewayRouteTableFunc: func(input *ec2transitgateway.DisassociateTransitGatewayRouteTableInput) (*ec2transitgateway.DisassociateTransitGatewayRouteTableOutput, error) {
+					return &ec2transitgateway.DisassociateTransitGatewayRouteTableOutput{}, nil
+				},
+			}).ExpectInput("DisassociateTransitGatewayRouteTable", &ec2transitgateway.DisassociateTransitGatewayRouteTableInput{
+			TransitGatewayRouteTableId: String("tgw-rtb-1234"),
+			TransitGatewayAttachmentId: String("tgw-attach-1234"),
+		}).ExpectCalls("DisassociateTransitGatewayRouteTable").
+			ExpectRevert("attach transitgatewayroutetable attachment=tgw-attach-1234 id=tgw-rtb-1234").Run(t)
+	})
+}
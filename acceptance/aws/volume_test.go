@@ -18,6 +18,34 @@ func TestVolume(t *testing.T) {
 			}).ExpectCommandResult("new-volume-id").ExpectCalls("CreateVolume").Run(t)
 	})
 
+	t.Run("create encrypted with key", func(t *testing.T) {
+		Template("create volume availabilityzone=eu-west-1a size=100 type=io1 iops=2000 kms-key=alias/volumes").Mock(&ec2Mock{
+			CreateVolumeFunc: func(input *ec2.CreateVolumeInput) (*ec2.Volume, error) {
+				return &ec2.Volume{VolumeId: String("new-volume-id")}, nil
+			}}).
+			ExpectInput("CreateVolume", &ec2.CreateVolumeInput{
+				AvailabilityZone: String("eu-west-1a"),
+				Size:             Int64(100),
+				VolumeType:       String("io1"),
+				Iops:             Int64(2000),
+				Encrypted:        Bool(true),
+				KmsKeyId:         String("alias/volumes"),
+			}).ExpectCommandResult("new-volume-id").ExpectCalls("CreateVolume").Run(t)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		Template("restore volume snapshot=snap-1234 availabilityzone=eu-west-1a type=gp2").Mock(&ec2Mock{
+			CreateVolumeFunc: func(input *ec2.CreateVolumeInput) (*ec2.Volume, error) {
+				return &ec2.Volume{VolumeId: String("restored-volume-id")}, nil
+			}}).
+			ExpectInput("CreateVolume", &ec2.CreateVolumeInput{
+				SnapshotId:       String("snap-1234"),
+				AvailabilityZone: String("eu-west-1a"),
+				VolumeType:       String("gp2"),
+			}).ExpectCommandResult("restored-volume-id").ExpectCalls("CreateVolume").
+			ExpectRevert("delete volume id=restored-volume-id").Run(t)
+	})
+
 	t.Run("delete", func(t *testing.T) {
 		Template("delete volume id=any-volume-id").Mock(&ec2Mock{
 			DeleteVolumeFunc: func(*ec2.DeleteVolumeInput) (*ec2.DeleteVolumeOutput, error) {
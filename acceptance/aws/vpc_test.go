@@ -29,6 +29,17 @@ func TestVPC(t *testing.T) {
 			}).ExpectCommandResult("new-vpc-id").ExpectCalls("CreateVpc", "CreateTagsRequest").Run(t)
 	})
 
+	t.Run("update", func(t *testing.T) {
+		Template("update vpc id=any-vpc-id ipv6=auto").Mock(&ec2Mock{
+			AssociateVpcCidrBlockFunc: func(input *ec2.AssociateVpcCidrBlockInput) (*ec2.AssociateVpcCidrBlockOutput, error) {
+				return &ec2.AssociateVpcCidrBlockOutput{}, nil
+			}},
+		).ExpectInput("AssociateVpcCidrBlock", &ec2.AssociateVpcCidrBlockInput{
+			VpcId:                       String("any-vpc-id"),
+			AmazonProvidedIpv6CidrBlock: Bool(true),
+		}).ExpectCalls("AssociateVpcCidrBlock").Run(t)
+	})
+
 	t.Run("delete", func(t *testing.T) {
 		Template("delete vpc id=any-vpc-id").Mock(&ec2Mock{
 			DeleteVpcFunc: func(input *ec2.DeleteVpcInput) (*ec2.DeleteVpcOutput, error) {
@@ -0,0 +1,51 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestVpcendpoint(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		t.Run("gateway", func(t *testing.T) {
+			Template("create vpcendpoint vpc=vpc-1234 service=com.amazonaws.eu-west-1.s3 routetables=[rtb-1234,rtb-2345]").
+				Mock(&ec2Mock{
+					CreateVpcEndpointFunc: func(input *ec2.CreateVpcEndpointInput) (*ec2.CreateVpcEndpointOutput, error) {
+						return &ec2.CreateVpcEndpointOutput{VpcEndpoint: &ec2.VpcEndpoint{VpcEndpointId: String("vpce-1234")}}, nil
+					},
+				}).ExpectInput("CreateVpcEndpoint", &ec2.CreateVpcEndpointInput{
+				VpcId:         String("vpc-1234"),
+				ServiceName:   String("com.amazonaws.eu-west-1.s3"),
+				RouteTableIds: []*string{String("rtb-1234"), String("rtb-2345")},
+			}).ExpectCommandResult("vpce-1234").ExpectCalls("CreateVpcEndpoint").
+				ExpectRevert("delete vpcendpoint id=vpce-1234").Run(t)
+		})
+
+		t.Run("interface", func(t *testing.T) {
+			Template("create vpcendpoint vpc=vpc-1234 service=com.amazonaws.eu-west-1.ssm type=interface subnets=[sub-1234,sub-2345] securitygroups=sg-1234 private-dns=true").
+				Mock(&ec2Mock{
+					CreateVpcEndpointFunc: func(input *ec2.CreateVpcEndpointInput) (*ec2.CreateVpcEndpointOutput, error) {
+						return &ec2.CreateVpcEndpointOutput{VpcEndpoint: &ec2.VpcEndpoint{VpcEndpointId: String("vpce-1234")}}, nil
+					},
+				}).ExpectInput("CreateVpcEndpoint", &ec2.CreateVpcEndpointInput{
+				VpcId:             String("vpc-1234"),
+				ServiceName:       String("com.amazonaws.eu-west-1.ssm"),
+				VpcEndpointType:   String("Interface"),
+				SubnetIds:         []*string{String("sub-1234"), String("sub-2345")},
+				SecurityGroupIds:  []*string{String("sg-1234")},
+				PrivateDnsEnabled: Bool(true),
+			}).ExpectCommandResult("vpce-1234").ExpectCalls("CreateVpcEndpoint").Run(t)
+		})
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete vpcendpoint id=vpce-1234").
+			Mock(&ec2Mock{
+				DeleteVpcEndpointsFunc: func(input *ec2.DeleteVpcEndpointsInput) (*ec2.DeleteVpcEndpointsOutput, error) {
+					return &ec2.DeleteVpcEndpointsOutput{}, nil
+				},
+			}).ExpectInput("DeleteVpcEndpoints", &ec2.DeleteVpcEndpointsInput{VpcEndpointIds: []*string{String("vpce-1234")}}).
+			ExpectCalls("DeleteVpcEndpoints").Run(t)
+	})
+}
@@ -0,0 +1,63 @@
+package awsat
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestVpnconnection(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		configFile := filepath.Join(dir, "vpn.xml")
+
+		Template("create vpnconnection customergateway=cgw-1234 vpngateway=vgw-1234 static-routes-only=true config-file="+configFile).
+			Mock(&ec2Mock{
+				CreateVpnConnectionFunc: func(input *ec2.CreateVpnConnectionInput) (*ec2.CreateVpnConnectionOutput, error) {
+					return &ec2.CreateVpnConnectionOutput{VpnConnection: &ec2.VpnConnection{VpnConnectionId: String("vpn-1234"), CustomerGatewayConfiguration: String("<vpn_connection/>")}}, nil
+				},
+			}).ExpectInput("CreateVpnConnection", &ec2.CreateVpnConnectionInput{
+			CustomerGatewayId: String("cgw-1234"),
+			VpnGatewayId:      String("vgw-1234"),
+			Type:              String("ipsec.1"),
+			Options:           &ec2.VpnConnectionOptionsSpecification{StaticRoutesOnly: Bool(true)},
+		}).ExpectCommandResult("vpn-1234").ExpectCalls("CreateVpnConnection").
+			ExpectRevert("delete vpnconnection id=vpn-1234").Run(t)
+
+		content, err := ioutil.ReadFile(configFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := string(content), "<vpn_connection/>"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete vpnconnection id=vpn-1234").
+			Mock(&ec2Mock{
+				DeleteVpnConnectionFunc: func(input *ec2.DeleteVpnConnectionInput) (*ec2.DeleteVpnConnectionOutput, error) {
+					return &ec2.DeleteVpnConnectionOutput{}, nil
+				},
+			}).ExpectInput("DeleteVpnConnection", &ec2.DeleteVpnConnectionInput{VpnConnectionId: String("vpn-1234")}).
+			ExpectCalls("DeleteVpnConnection").Run(t)
+	})
+
+	t.Run("check", func(t *testing.T) {
+		Template("check vpnconnection id=vpn-1234 state=deleted timeout=1").
+			Mock(&ec2Mock{
+				DescribeVpnConnectionsFunc: func(input *ec2.DescribeVpnConnectionsInput) (*ec2.DescribeVpnConnectionsOutput, error) {
+					return nil, awserr.New("InvalidVpnConnectionID.NotFound", "not found", nil)
+				},
+			}).ExpectInput("DescribeVpnConnections", &ec2.DescribeVpnConnectionsInput{VpnConnectionIds: []*string{String("vpn-1234")}}).
+			ExpectCalls("DescribeVpnConnections").Run(t)
+	})
+}
@@ -0,0 +1,53 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestVpngateway(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create vpngateway amazon-asn=64512 availabilityzone=us-west-2a").
+			Mock(&ec2Mock{
+				CreateVpnGatewayFunc: func(input *ec2.CreateVpnGatewayInput) (*ec2.CreateVpnGatewayOutput, error) {
+					return &ec2.CreateVpnGatewayOutput{VpnGateway: &ec2.VpnGateway{VpnGatewayId: String("vgw-1234")}}, nil
+				},
+			}).ExpectInput("CreateVpnGateway", &ec2.CreateVpnGatewayInput{
+			AmazonSideAsn:    Int64(64512),
+			AvailabilityZone: String("us-west-2a"),
+			Type:             String("ipsec.1"),
+		}).ExpectCommandResult("vgw-1234").ExpectCalls("CreateVpnGateway").
+			ExpectRevert("delete vpngateway id=vgw-1234").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete vpngateway id=vgw-1234").
+			Mock(&ec2Mock{
+				DeleteVpnGatewayFunc: func(input *ec2.DeleteVpnGatewayInput) (*ec2.DeleteVpnGatewayOutput, error) {
+					return &ec2.DeleteVpnGatewayOutput{}, nil
+				},
+			}).ExpectInput("DeleteVpnGateway", &ec2.DeleteVpnGatewayInput{VpnGatewayId: String("vgw-1234")}).
+			ExpectCalls("DeleteVpnGateway").Run(t)
+	})
+
+	t.Run("attach", func(t *testing.T) {
+		Template("attach vpngateway id=vgw-1234 vpc=vpc-1234").
+			Mock(&ec2Mock{
+				AttachVpnGatewayFunc: func(input *ec2.AttachVpnGatewayInput) (*ec2.AttachVpnGatewayOutput, error) {
+					return &ec2.AttachVpnGatewayOutput{}, nil
+				},
+			}).ExpectInput("AttachVpnGateway", &ec2.AttachVpnGatewayInput{VpnGatewayId: String("vgw-1234"), VpcId: String("vpc-1234")}).
+			ExpectCalls("AttachVpnGateway").ExpectRevert("detach vpngateway id=vgw-1234 vpc=vpc-1234").Run(t)
+	})
+
+	t.Run("detach", func(t *testing.T) {
+		Template("detach vpngateway id=vgw-1234 vpc=vpc-1234").
+			Mock(&ec2Mock{
+				DetachVpnGatewayFunc: func(input *ec2.DetachVpnGatewayInput) (*ec2.DetachVpnGatewayOutput, error) {
+					return &ec2.DetachVpnGatewayOutput{}, nil
+				},
+			}).ExpectInput("DetachVpnGateway", &ec2.DetachVpnGatewayInput{VpnGatewayId: String("vgw-1234"), VpcId: String("vpc-1234")}).
+			ExpectCalls("DetachVpnGateway").Run(t)
+	})
+}
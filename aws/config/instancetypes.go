@@ -0,0 +1,352 @@
+package awsconfig
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/aws/aws-sdk-go/service/pricing/pricingiface"
+)
+
+// InstanceType holds the specs of an EC2 instance type.
+// Price is the hourly on-demand price in USD of a Linux instance (0 when unknown).
+type InstanceType struct {
+	Name      string  `json:"name"`
+	VCPU      int     `json:"vcpu"`
+	MemoryGiB float64 `json:"memory"`
+	Network   string  `json:"network"`
+	Price     float64 `json:"price,omitempty"`
+}
+
+func (t InstanceType) Family() string {
+	return strings.SplitN(t.Name, ".", 2)[0]
+}
+
+// InstanceTypesCatalog lists the instance types available in a region
+type InstanceTypesCatalog struct {
+	Region    string         `json:"region,omitempty"`
+	Refreshed time.Time      `json:"refreshed,omitempty"`
+	Types     []InstanceType `json:"types"`
+}
+
+// IsEmbedded returns true if the catalog is the one shipped with awless,
+// not refreshed from AWS for a specific region
+func (c *InstanceTypesCatalog) IsEmbedded() bool {
+	return c.Region == ""
+}
+
+func (c *InstanceTypesCatalog) Lookup(name string) (InstanceType, bool) {
+	for _, t := range c.Types {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return InstanceType{}, false
+}
+
+func (c *InstanceTypesCatalog) Names() (names []string) {
+	for _, t := range c.Types {
+		names = append(names, t.Name)
+	}
+	return
+}
+
+// Validate returns an error if an instance type is not in the catalog.
+// For the embedded catalog, only types of a known family are checked, since newer families may be missing.
+func (c *InstanceTypesCatalog) Validate(name string) error {
+	if !isValidInstanceType(name) {
+		return fmt.Errorf("'%s' is not a valid instance type", name)
+	}
+	if _, ok := c.Lookup(name); ok {
+		return nil
+	}
+	family := InstanceType{Name: name}.Family()
+	var sameFamily []string
+	for _, t := range c.Types {
+		if t.Family() == family {
+			sameFamily = append(sameFamily, t.Name)
+		}
+	}
+	if c.IsEmbedded() && len(sameFamily) == 0 {
+		return nil
+	}
+	msg := fmt.Sprintf("'%s' is not an available instance type", name)
+	if !c.IsEmbedded() {
+		msg += fmt.Sprintf(" in region %s", c.Region)
+	}
+	if len(sameFamily) > 0 {
+		msg += fmt.Sprintf(" (%s family: %s)", family, strings.Join(sameFamily, ", "))
+	}
+	return errors.New(msg)
+}
+
+type InstanceTypeFilter struct {
+	MinCPU    int
+	MinMemory float64
+	MaxPrice  float64
+	Families  []string
+}
+
+// Filter returns the instance types matching the filter, sorted by price then name
+func (c *InstanceTypesCatalog) Filter(f InstanceTypeFilter) (out []InstanceType) {
+	for _, t := range c.Types {
+		if f.MinCPU > 0 && t.VCPU < f.MinCPU {
+			continue
+		}
+		if f.MinMemory > 0 && t.MemoryGiB < f.MinMemory {
+			continue
+		}
+		if f.MaxPrice > 0 && (t.Price == 0 || t.Price > f.MaxPrice) {
+			continue
+		}
+		if len(f.Families) > 0 && !stringInSlice(t.Family(), f.Families) {
+			continue
+		}
+		out = append(out, t)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Price == out[j].Price {
+			return out[i].Name < out[j].Name
+		}
+		return out[i].Price < out[j].Price
+	})
+	return
+}
+
+// InstanceTypesCatalogDir is where the catalogs refreshed for each region are stored
+var InstanceTypesCatalogDir = func() string {
+	return filepath.Join(os.Getenv("__AWLESS_CACHE"), "instancetypes")
+}
+
+// LoadInstanceTypes returns the catalog refreshed for a region if any,
+// or the embedded one
+func LoadInstanceTypes(region string) *InstanceTypesCatalog {
+	if region != "" {
+		content, err := ioutil.ReadFile(filepath.Join(InstanceTypesCatalogDir(), region+".json"))
+		if err == nil {
+			catalog := new(InstanceTypesCatalog)
+			if err = json.Unmarshal(content, catalog); err == nil && len(catalog.Types) > 0 {
+				return catalog
+			}
+		}
+	}
+	return &InstanceTypesCatalog{Types: embeddedInstanceTypes}
+}
+
+// SaveInstanceTypes stores the catalog of a region, to be used by next LoadInstanceTypes
+func SaveInstanceTypes(catalog *InstanceTypesCatalog) error {
+	if catalog.Region == "" {
+		return fmt.Errorf("cannot save instance types catalog: empty region")
+	}
+	content, err := json.MarshalIndent(catalog, "", " ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(InstanceTypesCatalogDir(), 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(InstanceTypesCatalogDir(), catalog.Region+".json"), content, 0600)
+}
+
+// FetchInstanceTypes builds the catalog of a region from the AWS Price List API
+func FetchInstanceTypes(api pricingiface.PricingAPI, region string) (*InstanceTypesCatalog, error) {
+	location, err := pricingLocation(region)
+	if err != nil {
+		return nil, err
+	}
+	filter := func(field, value string) *pricing.Filter {
+		return &pricing.Filter{Type: aws.String(pricing.FilterTypeTermMatch), Field: aws.String(field), Value: aws.String(value)}
+	}
+	input := &pricing.GetProductsInput{
+		ServiceCode: aws.String("AmazonEC2"),
+		Filters: []*pricing.Filter{
+			filter("location", location),
+			filter("operatingSystem", "Linux"),
+			filter("tenancy", "Shared"),
+			filter("preInstalledSw", "NA"),
+		},
+	}
+
+	types := make(map[string]InstanceType)
+	var parseErr error
+	err = api.GetProductsPages(input, func(out *pricing.GetProductsOutput, lastPage bool) bool {
+		for _, product := range out.PriceList {
+			t, err := parsePricingProduct(product)
+			if err != nil {
+				parseErr = err
+				return false
+			}
+			if t.Name == "" {
+				continue
+			}
+			if existing, ok := types[t.Name]; ok && existing.Price > 0 && (t.Price == 0 || existing.Price <= t.Price) {
+				continue
+			}
+			types[t.Name] = t
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	catalog := &InstanceTypesCatalog{Region: region, Refreshed: time.Now().UTC()}
+	for _, t := range types {
+		catalog.Types = append(catalog.Types, t)
+	}
+	sort.Slice(catalog.Types, func(i, j int) bool { return catalog.Types[i].Name < catalog.Types[j].Name })
+	return catalog, nil
+}
+
+// Locations of regions as named by the AWS Price List API
+var pricingLocations = map[string]string{
+	"us-east-1":      "US East (N. Virginia)",
+	"us-east-2":      "US East (Ohio)",
+	"us-west-1":      "US West (N. California)",
+	"us-west-2":      "US West (Oregon)",
+	"ca-central-1":   "Canada (Central)",
+	"eu-west-1":      "EU (Ireland)",
+	"eu-west-2":      "EU (London)",
+	"eu-west-3":      "EU (Paris)",
+	"eu-central-1":   "EU (Frankfurt)",
+	"ap-northeast-1": "Asia Pacific (Tokyo)",
+	"ap-northeast-2": "Asia Pacific (Seoul)",
+	"ap-northeast-3": "Asia Pacific (Osaka-Local)",
+	"ap-southeast-1": "Asia Pacific (Singapore)",
+	"ap-southeast-2": "Asia Pacific (Sydney)",
+	"ap-south-1":     "Asia Pacific (Mumbai)",
+	"sa-east-1":      "South America (Sao Paulo)",
+	"us-gov-west-1":  "AWS GovCloud (US)",
+}
+
+func pricingLocation(region string) (string, error) {
+	if location, ok := pricingLocations[region]; ok {
+		return location, nil
+	}
+	return "", fmt.Errorf("no price list for region '%s'", region)
+}
+
+func parsePricingProduct(product aws.JSONValue) (InstanceType, error) {
+	var t InstanceType
+	attributes, _ := jsonPath(product, "product", "attributes").(map[string]interface{})
+	name, _ := attributes["instanceType"].(string)
+	if name == "" {
+		return t, nil
+	}
+	t.Name = name
+	t.Network, _ = attributes["networkPerformance"].(string)
+	if vcpu, ok := attributes["vcpu"].(string); ok {
+		t.VCPU, _ = strconv.Atoi(vcpu)
+	}
+	if mem, ok := attributes["memory"].(string); ok {
+		mem = strings.TrimSpace(strings.TrimSuffix(mem, "GiB"))
+		t.MemoryGiB, _ = strconv.ParseFloat(strings.Replace(mem, ",", "", -1), 64)
+	}
+	onDemand, _ := jsonPath(product, "terms", "OnDemand").(map[string]interface{})
+	for _, term := range onDemand {
+		dimensions, _ := jsonPath(term, "priceDimensions").(map[string]interface{})
+		for _, dim := range dimensions {
+			usd, _ := jsonPath(dim, "pricePerUnit", "USD").(string)
+			if usd == "" {
+				continue
+			}
+			price, err := strconv.ParseFloat(usd, 64)
+			if err != nil {
+				return t, fmt.Errorf("instance type %s: invalid price '%s': %s", name, usd, err)
+			}
+			if price > 0 && (t.Price == 0 || price < t.Price) {
+				t.Price = price
+			}
+		}
+	}
+	return t, nil
+}
+
+func jsonPath(v interface{}, keys ...string) interface{} {
+	for _, k := range keys {
+		var m map[string]interface{}
+		switch vv := v.(type) {
+		case aws.JSONValue:
+			m = vv
+		case map[string]interface{}:
+			m = vv
+		default:
+			return nil
+		}
+		v = m[k]
+	}
+	return v
+}
+
+// Specs of usual instance types, with their prices in us-east-1
+var embeddedInstanceTypes = []InstanceType{
+	{Name: "t2.nano", VCPU: 1, MemoryGiB: 0.5, Network: "Low", Price: 0.0058},
+	{Name: "t2.micro", VCPU: 1, MemoryGiB: 1, Network: "Low to Moderate", Price: 0.0116},
+	{Name: "t2.small", VCPU: 1, MemoryGiB: 2, Network: "Low to Moderate", Price: 0.023},
+	{Name: "t2.medium", VCPU: 2, MemoryGiB: 4, Network: "Low to Moderate", Price: 0.0464},
+	{Name: "t2.large", VCPU: 2, MemoryGiB: 8, Network: "Low to Moderate", Price: 0.0928},
+	{Name: "t2.xlarge", VCPU: 4, MemoryGiB: 16, Network: "Moderate", Price: 0.1856},
+	{Name: "t2.2xlarge", VCPU: 8, MemoryGiB: 32, Network: "Moderate", Price: 0.3712},
+	{Name: "m4.large", VCPU: 2, MemoryGiB: 8, Network: "Moderate", Price: 0.1},
+	{Name: "m4.xlarge", VCPU: 4, MemoryGiB: 16, Network: "High", Price: 0.2},
+	{Name: "m4.2xlarge", VCPU: 8, MemoryGiB: 32, Network: "High", Price: 0.4},
+	{Name: "m4.4xlarge", VCPU: 16, MemoryGiB: 64, Network: "High", Price: 0.8},
+	{Name: "m4.10xlarge", VCPU: 40, MemoryGiB: 160, Network: "10 Gigabit", Price: 2},
+	{Name: "m4.16xlarge", VCPU: 64, MemoryGiB: 256, Network: "25 Gigabit", Price: 3.2},
+	{Name: "m5.large", VCPU: 2, MemoryGiB: 8, Network: "Up to 10 Gigabit", Price: 0.096},
+	{Name: "m5.xlarge", VCPU: 4, MemoryGiB: 16, Network: "Up to 10 Gigabit", Price: 0.192},
+	{Name: "m5.2xlarge", VCPU: 8, MemoryGiB: 32, Network: "Up to 10 Gigabit", Price: 0.384},
+	{Name: "m5.4xlarge", VCPU: 16, MemoryGiB: 64, Network: "Up to 10 Gigabit", Price: 0.768},
+	{Name: "m5.12xlarge", VCPU: 48, MemoryGiB: 192, Network: "10 Gigabit", Price: 2.304},
+	{Name: "m5.24xlarge", VCPU: 96, MemoryGiB: 384, Network: "25 Gigabit", Price: 4.608},
+	{Name: "c4.large", VCPU: 2, MemoryGiB: 3.75, Network: "Moderate", Price: 0.1},
+	{Name: "c4.xlarge", VCPU: 4, MemoryGiB: 7.5, Network: "High", Price: 0.199},
+	{Name: "c4.2xlarge", VCPU: 8, MemoryGiB: 15, Network: "High", Price: 0.398},
+	{Name: "c4.4xlarge", VCPU: 16, MemoryGiB: 30, Network: "High", Price: 0.796},
+	{Name: "c4.8xlarge", VCPU: 36, MemoryGiB: 60, Network: "10 Gigabit", Price: 1.591},
+	{Name: "c5.large", VCPU: 2, MemoryGiB: 4, Network: "Up to 10 Gigabit", Price: 0.085},
+	{Name: "c5.xlarge", VCPU: 4, MemoryGiB: 8, Network: "Up to 10 Gigabit", Price: 0.17},
+	{Name: "c5.2xlarge", VCPU: 8, MemoryGiB: 16, Network: "Up to 10 Gigabit", Price: 0.34},
+	{Name: "c5.4xlarge", VCPU: 16, MemoryGiB: 32, Network: "Up to 10 Gigabit", Price: 0.68},
+	{Name: "c5.9xlarge", VCPU: 36, MemoryGiB: 72, Network: "10 Gigabit", Price: 1.53},
+	{Name: "c5.18xlarge", VCPU: 72, MemoryGiB: 144, Network: "25 Gigabit", Price: 3.06},
+	{Name: "r4.large", VCPU: 2, MemoryGiB: 15.25, Network: "Up to 10 Gigabit", Price: 0.133},
+	{Name: "r4.xlarge", VCPU: 4, MemoryGiB: 30.5, Network: "Up to 10 Gigabit", Price: 0.266},
+	{Name: "r4.2xlarge", VCPU: 8, MemoryGiB: 61, Network: "Up to 10 Gigabit", Price: 0.532},
+	{Name: "r4.4xlarge", VCPU: 16, MemoryGiB: 122, Network: "Up to 10 Gigabit", Price: 1.064},
+	{Name: "r4.8xlarge", VCPU: 32, MemoryGiB: 244, Network: "10 Gigabit", Price: 2.128},
+	{Name: "r4.16xlarge", VCPU: 64, MemoryGiB: 488, Network: "25 Gigabit", Price: 4.256},
+	{Name: "x1.16xlarge", VCPU: 64, MemoryGiB: 976, Network: "10 Gigabit", Price: 6.669},
+	{Name: "x1.32xlarge", VCPU: 128, MemoryGiB: 1952, Network: "25 Gigabit", Price: 13.338},
+	{Name: "i3.large", VCPU: 2, MemoryGiB: 15.25, Network: "Up to 10 Gigabit", Price: 0.156},
+	{Name: "i3.xlarge", VCPU: 4, MemoryGiB: 30.5, Network: "Up to 10 Gigabit", Price: 0.312},
+	{Name: "i3.2xlarge", VCPU: 8, MemoryGiB: 61, Network: "Up to 10 Gigabit", Price: 0.624},
+	{Name: "i3.4xlarge", VCPU: 16, MemoryGiB: 122, Network: "Up to 10 Gigabit", Price: 1.248},
+	{Name: "i3.8xlarge", VCPU: 32, MemoryGiB: 244, Network: "10 Gigabit", Price: 2.496},
+	{Name: "i3.16xlarge", VCPU: 64, MemoryGiB: 488, Network: "25 Gigabit", Price: 4.992},
+	{Name: "d2.xlarge", VCPU: 4, MemoryGiB: 30.5, Network: "Moderate", Price: 0.69},
+	{Name: "d2.2xlarge", VCPU: 8, MemoryGiB: 61, Network: "High", Price: 1.38},
+	{Name: "d2.4xlarge", VCPU: 16, MemoryGiB: 122, Network: "High", Price: 2.76},
+	{Name: "d2.8xlarge", VCPU: 36, MemoryGiB: 244, Network: "10 Gigabit", Price: 5.52},
+	{Name: "p2.xlarge", VCPU: 4, MemoryGiB: 61, Network: "High", Price: 0.9},
+	{Name: "p2.8xlarge", VCPU: 32, MemoryGiB: 488, Network: "10 Gigabit", Price: 7.2},
+	{Name: "p2.16xlarge", VCPU: 64, MemoryGiB: 732, Network: "20 Gigabit", Price: 14.4},
+	{Name: "p3.2xlarge", VCPU: 8, MemoryGiB: 61, Network: "Up to 10 Gigabit", Price: 3.06},
+	{Name: "p3.8xlarge", VCPU: 32, MemoryGiB: 244, Network: "10 Gigabit", Price: 12.24},
+	{Name: "p3.16xlarge", VCPU: 64, MemoryGiB: 488, Network: "25 Gigabit", Price: 24.48},
+	{Name: "g3.4xlarge", VCPU: 16, MemoryGiB: 122, Network: "Up to 10 Gigabit", Price: 1.14},
+	{Name: "g3.8xlarge", VCPU: 32, MemoryGiB: 244, Network: "10 Gigabit", Price: 2.28},
+	{Name: "g3.16xlarge", VCPU: 64, MemoryGiB: 488, Network: "20 Gigabit", Price: 4.56},
+}
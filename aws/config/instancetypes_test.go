@@ -0,0 +1,157 @@
+package awsconfig
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/aws/aws-sdk-go/service/pricing/pricingiface"
+)
+
+func TestValidateInstanceTypes(t *testing.T) {
+	embedded := LoadInstanceTypes("")
+	if !embedded.IsEmbedded() {
+		t.Fatal("expected embedded catalog")
+	}
+	refreshed := &InstanceTypesCatalog{Region: "eu-west-3", Types: []InstanceType{{Name: "t2.micro"}, {Name: "t2.small"}, {Name: "m5.large"}}}
+
+	tcases := []struct {
+		catalog *InstanceTypesCatalog
+		name    string
+		expErr  string
+	}{
+		{catalog: embedded, name: "t2.micro"},
+		{catalog: embedded, name: "z9.large"},
+		{catalog: embedded, name: "invalid", expErr: "'invalid' is not a valid instance type"},
+		{catalog: embedded, name: "t2.huge", expErr: "'t2.huge' is not an available instance type (t2 family: t2.nano, t2.micro"},
+		{catalog: refreshed, name: "t2.small"},
+		{catalog: refreshed, name: "t2.nano", expErr: "'t2.nano' is not an available instance type in region eu-west-3 (t2 family: t2.micro, t2.small)"},
+		{catalog: refreshed, name: "z9.large", expErr: "'z9.large' is not an available instance type in region eu-west-3"},
+	}
+	for i, tcase := range tcases {
+		err := tcase.catalog.Validate(tcase.name)
+		if tcase.expErr == "" {
+			if err != nil {
+				t.Fatalf("%d: %s", i+1, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Fatalf("%d: expected error got none", i+1)
+		}
+		if got, want := err.Error(), tcase.expErr; !strings.HasPrefix(got, want) {
+			t.Fatalf("%d: got %s, want %s", i+1, got, want)
+		}
+	}
+}
+
+func TestFilterInstanceTypes(t *testing.T) {
+	catalog := &InstanceTypesCatalog{Types: []InstanceType{
+		{Name: "t2.micro", VCPU: 1, MemoryGiB: 1, Price: 0.0116},
+		{Name: "t2.xlarge", VCPU: 4, MemoryGiB: 16, Price: 0.1856},
+		{Name: "c5.xlarge", VCPU: 4, MemoryGiB: 8, Price: 0.17},
+		{Name: "m5.xlarge", VCPU: 4, MemoryGiB: 16, Price: 0.192},
+		{Name: "x9.large", VCPU: 8, MemoryGiB: 64},
+	}}
+	names := func(types []InstanceType) (out []string) {
+		for _, t := range types {
+			out = append(out, t.Name)
+		}
+		return
+	}
+	tcases := []struct {
+		filter InstanceTypeFilter
+		exp    []string
+	}{
+		{filter: InstanceTypeFilter{}, exp: []string{"x9.large", "t2.micro", "c5.xlarge", "t2.xlarge", "m5.xlarge"}},
+		{filter: InstanceTypeFilter{MinCPU: 4, MaxPrice: 0.19}, exp: []string{"c5.xlarge", "t2.xlarge"}},
+		{filter: InstanceTypeFilter{MinMemory: 16}, exp: []string{"x9.large", "t2.xlarge", "m5.xlarge"}},
+		{filter: InstanceTypeFilter{Families: []string{"t2", "m5"}, MinCPU: 2}, exp: []string{"t2.xlarge", "m5.xlarge"}},
+	}
+	for i, tcase := range tcases {
+		if got, want := names(catalog.Filter(tcase.filter)), tcase.exp; !reflect.DeepEqual(got, want) {
+			t.Fatalf("%d: got %v, want %v", i+1, got, want)
+		}
+	}
+}
+
+func TestFetchSaveAndLoadInstanceTypes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awless-instancetypes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defaultDir := InstanceTypesCatalogDir
+	defer func() { InstanceTypesCatalogDir = defaultDir }()
+	InstanceTypesCatalogDir = func() string { return dir }
+
+	product := func(name, vcpu, memory, price string) aws.JSONValue {
+		var v aws.JSONValue
+		content := `{"product": {"attributes": {"instanceType": "` + name + `", "vcpu": "` + vcpu + `", "memory": "` + memory + `", "networkPerformance": "Moderate"}},
+		"terms": {"OnDemand": {"ABC.DEF": {"priceDimensions": {"ABC.DEF.GHI": {"pricePerUnit": {"USD": "` + price + `"}}}}}}}`
+		if err := json.Unmarshal([]byte(content), &v); err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	api := &mockPricing{pages: [][]aws.JSONValue{
+		{product("t2.micro", "1", "1 GiB", "0.0132"), product("m5.large", "2", "8 GiB", "0.1120000000")},
+		{product("t2.micro", "1", "1 GiB", "0.0000000000"), product("x1e.32xlarge", "128", "3,904 GiB", "32.0")},
+	}}
+
+	catalog, err := FetchInstanceTypes(api, "eu-west-3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := api.location, "EU (Paris)"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	exp := []InstanceType{
+		{Name: "m5.large", VCPU: 2, MemoryGiB: 8, Network: "Moderate", Price: 0.112},
+		{Name: "t2.micro", VCPU: 1, MemoryGiB: 1, Network: "Moderate", Price: 0.0132},
+		{Name: "x1e.32xlarge", VCPU: 128, MemoryGiB: 3904, Network: "Moderate", Price: 32},
+	}
+	if got, want := catalog.Types, exp; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+
+	if err = SaveInstanceTypes(catalog); err != nil {
+		t.Fatal(err)
+	}
+	loaded := LoadInstanceTypes("eu-west-3")
+	if got, want := loaded.Types, exp; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+	if !LoadInstanceTypes("us-east-1").IsEmbedded() {
+		t.Fatal("expected embedded catalog for region not refreshed")
+	}
+
+	if _, err = FetchInstanceTypes(api, "xx-test-1"); err == nil {
+		t.Fatal("expected error for unknown region")
+	}
+}
+
+type mockPricing struct {
+	pricingiface.PricingAPI
+	pages    [][]aws.JSONValue
+	location string
+}
+
+func (m *mockPricing) GetProductsPages(input *pricing.GetProductsInput, fn func(*pricing.GetProductsOutput, bool) bool) error {
+	for _, f := range input.Filters {
+		if aws.StringValue(f.Field) == "location" {
+			m.location = aws.StringValue(f.Value)
+		}
+	}
+	for i, page := range m.pages {
+		if !fn(&pricing.GetProductsOutput{PriceList: page}, i == len(m.pages)-1) {
+			break
+		}
+	}
+	return nil
+}
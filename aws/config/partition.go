@@ -0,0 +1,125 @@
+package awsconfig
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+const (
+	StandardPartition = endpoints.AwsPartitionID
+	ChinaPartition    = endpoints.AwsCnPartitionID
+	GovCloudPartition = endpoints.AwsUsGovPartitionID
+)
+
+var partitionsDefaultRegion = map[string]string{
+	StandardPartition: endpoints.UsEast1RegionID,
+	ChinaPartition:    endpoints.CnNorth1RegionID,
+	GovCloudPartition: endpoints.UsGovWest1RegionID,
+}
+
+var partitionsConsoleHost = map[string]string{
+	StandardPartition: "console.aws.amazon.com",
+	ChinaPartition:    "console.amazonaws.cn",
+	GovCloudPartition: "console.amazonaws-us-gov.com",
+}
+
+// PartitionForRegion returns the partition ID (aws, aws-cn, aws-us-gov) of a region.
+// It defaults to the standard partition when the region is unknown.
+func PartitionForRegion(region string) string {
+	if p, ok := resolvePartition(region); ok {
+		return p.ID()
+	}
+	return StandardPartition
+}
+
+// DefaultRegionForPartition returns the region used by services, such as S3 or IAM,
+// as the implicit region of a partition (ex: us-east-1 for the standard partition)
+func DefaultRegionForPartition(partition string) string {
+	if r, ok := partitionsDefaultRegion[partition]; ok {
+		return r
+	}
+	return partitionsDefaultRegion[StandardPartition]
+}
+
+// IsDefaultRegionOfPartition returns true if the given region is the implicit region of its partition
+func IsDefaultRegionOfPartition(region string) bool {
+	return DefaultRegionForPartition(PartitionForRegion(region)) == region
+}
+
+// DNSSuffixForRegion returns the DNS suffix of the endpoints in the partition of a region
+// (ex: amazonaws.com, amazonaws.com.cn)
+func DNSSuffixForRegion(region string) string {
+	if PartitionForRegion(region) == ChinaPartition {
+		return "amazonaws.com.cn"
+	}
+	return "amazonaws.com"
+}
+
+// ConsoleURL returns the AWS web console URL for a region according to its partition
+func ConsoleURL(region string) string {
+	host := partitionsConsoleHost[PartitionForRegion(region)]
+	if region == "" {
+		return fmt.Sprintf("https://%s", host)
+	}
+	return fmt.Sprintf("https://%s/console/home?region=%s", host, region)
+}
+
+// ARNPartition returns the partition of an ARN or an empty string when the ARN is invalid
+func ARNPartition(s string) string {
+	parsed, err := arn.Parse(s)
+	if err != nil {
+		return ""
+	}
+	return parsed.Partition
+}
+
+// IsAWSManagedPolicyARN returns true if the ARN is the one of a policy managed by AWS,
+// whatever the partition (ex: arn:aws-cn:iam::aws:policy/ReadOnlyAccess)
+func IsAWSManagedPolicyARN(s string) bool {
+	parsed, err := arn.Parse(s)
+	if err != nil {
+		return false
+	}
+	return parsed.Service == "iam" && parsed.AccountID == "aws" && strings.HasPrefix(parsed.Resource, "policy/")
+}
+
+// IsValidRegionForPartition returns an error if a region is not valid
+// or does not belong to the given partition
+func IsValidRegionForPartition(region, partition string) error {
+	if !IsValidRegion(region) {
+		return fmt.Errorf("'%s' is not a valid region", region)
+	}
+	if got := PartitionForRegion(region); got != partition {
+		return fmt.Errorf("region '%s' belongs to partition '%s', not '%s'", region, got, partition)
+	}
+	return nil
+}
+
+func resolvePartition(region string) (endpoints.Partition, bool) {
+	if region == "" {
+		return endpoints.Partition{}, false
+	}
+	partitions := endpoints.DefaultResolver().(endpoints.EnumPartitions).Partitions()
+	for _, p := range partitions {
+		if _, ok := p.Regions()[region]; ok {
+			return p, true
+		}
+	}
+	for _, p := range partitions {
+		if p.ID() == StandardPartition {
+			continue
+		}
+		if strings.HasPrefix(region, regionPrefixPerPartition[p.ID()]) {
+			return p, true
+		}
+	}
+	return endpoints.Partition{}, false
+}
+
+var regionPrefixPerPartition = map[string]string{
+	ChinaPartition:    "cn-",
+	GovCloudPartition: "us-gov-",
+}
@@ -0,0 +1,73 @@
+package awsconfig
+
+import "testing"
+
+func TestPartitions(t *testing.T) {
+	tcases := []struct {
+		region, partition, defaultRegion, dnsSuffix string
+	}{
+		{region: "eu-west-1", partition: "aws", defaultRegion: "us-east-1", dnsSuffix: "amazonaws.com"},
+		{region: "us-east-1", partition: "aws", defaultRegion: "us-east-1", dnsSuffix: "amazonaws.com"},
+		{region: "cn-north-1", partition: "aws-cn", defaultRegion: "cn-north-1", dnsSuffix: "amazonaws.com.cn"},
+		{region: "cn-northwest-1", partition: "aws-cn", defaultRegion: "cn-north-1", dnsSuffix: "amazonaws.com.cn"},
+		{region: "us-gov-west-1", partition: "aws-us-gov", defaultRegion: "us-gov-west-1", dnsSuffix: "amazonaws.com"},
+		{region: "us-gov-east-1", partition: "aws-us-gov", defaultRegion: "us-gov-west-1", dnsSuffix: "amazonaws.com"},
+		{region: "", partition: "aws", defaultRegion: "us-east-1", dnsSuffix: "amazonaws.com"},
+	}
+	for i, tcase := range tcases {
+		if got, want := PartitionForRegion(tcase.region), tcase.partition; got != want {
+			t.Fatalf("%d: got %s, want %s", i+1, got, want)
+		}
+		if got, want := DefaultRegionForPartition(tcase.partition), tcase.defaultRegion; got != want {
+			t.Fatalf("%d: got %s, want %s", i+1, got, want)
+		}
+		if got, want := DNSSuffixForRegion(tcase.region), tcase.dnsSuffix; got != want {
+			t.Fatalf("%d: got %s, want %s", i+1, got, want)
+		}
+	}
+
+	if got, want := IsDefaultRegionOfPartition("cn-north-1"), true; got != want {
+		t.Fatalf("got %t, want %t", got, want)
+	}
+	if got, want := IsDefaultRegionOfPartition("us-east-1"), true; got != want {
+		t.Fatalf("got %t, want %t", got, want)
+	}
+	if got, want := IsDefaultRegionOfPartition("eu-west-1"), false; got != want {
+		t.Fatalf("got %t, want %t", got, want)
+	}
+	if err := IsValidRegionForPartition("cn-north-1", "aws"); err == nil {
+		t.Fatal("expected error got none")
+	}
+	if err := IsValidRegionForPartition("us-gov-west-1", "aws-us-gov"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPartitionARN(t *testing.T) {
+	if got, want := ARNPartition("arn:aws-cn:iam::123456789012:user/john"), "aws-cn"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := ARNPartition("invalid"), ""; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	for _, managed := range []string{"arn:aws:iam::aws:policy/AmazonEC2FullAccess", "arn:aws-us-gov:iam::aws:policy/ReadOnlyAccess", "arn:aws-cn:iam::aws:policy/service-role/AmazonEC2RoleforSSM"} {
+		if !IsAWSManagedPolicyARN(managed) {
+			t.Fatalf("expected %s to be managed by AWS", managed)
+		}
+	}
+	if IsAWSManagedPolicyARN("arn:aws:iam::123456789012:policy/MyPolicy") {
+		t.Fatal("expected policy not to be managed by AWS")
+	}
+}
+
+func TestConsoleURL(t *testing.T) {
+	if got, want := ConsoleURL("eu-west-1"), "https://console.aws.amazon.com/console/home?region=eu-west-1"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := ConsoleURL("cn-north-1"), "https://console.amazonaws.cn/console/home?region=cn-north-1"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := ConsoleURL("us-gov-west-1"), "https://console.amazonaws-us-gov.com/console/home?region=us-gov-west-1"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
@@ -78,36 +78,49 @@ func StdinRegionSelector() string {
 }
 
 func StdinInstanceTypeSelector() string {
-	fmt.Println("Please choose one instance type")
+	catalog := LoadInstanceTypes("")
+	fmt.Println("Please choose one instance type: (Ctrl+C to quit, Tab for completion)")
 	fmt.Println()
 	fmt.Println("Here are few examples:")
 
-	var instanceType string
 	t := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
-	fmt.Fprintln(t, "\tinstance type\tvCPU\tMemory (GiB)")
-	fmt.Fprintln(t, "\tt2.nano\t1\t0.5")
-	fmt.Fprintln(t, "\tt2.micro\t1\t1")
-	fmt.Fprintln(t, "\tt2.small\t1\t2")
-	fmt.Fprintln(t, "\tt2.medium\t2\t4")
-	fmt.Fprintln(t, "\tt2.large\t2\t8")
-	fmt.Fprintln(t, "\tt2.xlarge\t4\t16")
-	fmt.Fprintln(t, "\tt2.2xlarge\t8\t32")
-	fmt.Fprintln(t, "\tm4.large\t2\t8")
-	fmt.Fprintln(t, "\tm4.xlarge\t4\t16")
-	fmt.Fprintln(t, "\tc4.large\t2\t3.75")
-	fmt.Fprintln(t, "\tc4.xlarge\t4\t7.5")
+	fmt.Fprintln(t, "\tinstance type\tvCPU\tMemory (GiB)\tNetwork\tPrice ($/hour)")
+	var typeItems []readline.PrefixCompleterInterface
+	for _, it := range catalog.Types {
+		typeItems = append(typeItems, readline.PcItem(it.Name))
+		if stringInSlice(it.Family(), []string{"t2", "m5", "c5"}) {
+			fmt.Fprintf(t, "\t%s\t%d\t%g\t%s\t%g\n", it.Name, it.VCPU, it.MemoryGiB, it.Network, it.Price)
+		}
+	}
 	fmt.Fprintln(t, "\t...")
 	t.Flush()
-
 	fmt.Println()
-	fmt.Print("Value ? > ")
-	fmt.Scan(&instanceType)
-	for !isValidInstanceType(instanceType) {
-		fmt.Printf("'%s' is not a valid instance type\n", instanceType)
-		fmt.Print("Value ? > ")
-		fmt.Scan(&instanceType)
-	}
-	return instanceType
+
+	rl, err := readline.NewEx(&readline.Config{
+		Prompt:       "Value ? > ",
+		AutoComplete: readline.NewPrefixCompleter(typeItems...),
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error while selecting instance type: %s", err)
+		return ""
+	}
+	defer rl.Close()
+
+	for {
+		line, err := rl.Readline()
+		if err == readline.ErrInterrupt || err == io.EOF {
+			os.Exit(1)
+		} else if err != nil {
+			fmt.Fprintf(os.Stderr, "error while selecting instance type: %s", err)
+			return ""
+		}
+		instanceType := strings.TrimSpace(line)
+		if err = catalog.Validate(instanceType); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			continue
+		}
+		return instanceType
+	}
 }
 
 func IsValidRegion(given string) bool {
@@ -167,6 +180,41 @@ func AllProfiles() (profiles []string) {
 	return profiles
 }
 
+// ProfileRegion returns the region set for the profile in the AWS config file, or an empty string
+func ProfileRegion(profile string) string {
+	out, err := ioutil.ReadFile(filepath.Join(awsHomeFunc(), "config"))
+	if err != nil {
+		return ""
+	}
+	var inProfile bool
+	for _, line := range strings.Split(string(out), "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section := strings.TrimSpace(strings.Trim(line, "[]"))
+			inProfile = strings.TrimSpace(strings.TrimPrefix(section, "profile ")) == profile
+			continue
+		}
+		if kv := strings.SplitN(line, "=", 2); inProfile && len(kv) == 2 && strings.TrimSpace(kv[0]) == "region" {
+			return strings.TrimSpace(kv[1])
+		}
+	}
+	return ""
+}
+
+// ValidateRegionForProfile returns an error if the region is not valid or is not in the partition
+// of the region set for the profile, credentials being only valid in their partition
+func ValidateRegionForProfile(region, profile string) error {
+	profileRegion := ProfileRegion(profile)
+	if !IsValidRegion(profileRegion) {
+		_, err := ParseRegion(region)
+		return err
+	}
+	if err := IsValidRegionForPartition(region, PartitionForRegion(profileRegion)); err != nil {
+		return fmt.Errorf("profile '%s': %s", profile, err)
+	}
+	return nil
+}
+
 func stringInSlice(s string, slice []string) bool {
 	for _, v := range slice {
 		if v == s {
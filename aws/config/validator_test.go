@@ -96,3 +96,49 @@ func TestInstanceTypeValid(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateRegionForProfile(t *testing.T) {
+	awsHomeTmp, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func(f func() string) {
+		os.RemoveAll(awsHomeTmp)
+		awsHomeFunc = f
+	}(awsHomeFunc)
+
+	awsHomeFunc = func() string {
+		return awsHomeTmp
+	}
+
+	ioutil.WriteFile(filepath.Join(awsHomeTmp, "config"), []byte(`[default]
+region = eu-west-1
+[profile china]
+output = json
+region = cn-north-1
+[profile noregion]
+output = json
+`), 0600)
+
+	tcases := []struct {
+		region, profile string
+		expRegion       string
+		expErr          bool
+	}{
+		{region: "eu-central-1", profile: "default", expRegion: "eu-west-1"},
+		{region: "cn-north-1", profile: "default", expRegion: "eu-west-1", expErr: true},
+		{region: "cn-northwest-1", profile: "china", expRegion: "cn-north-1"},
+		{region: "us-east-1", profile: "china", expRegion: "cn-north-1", expErr: true},
+		{region: "us-gov-west-1", profile: "noregion"},
+		{region: "eu-test", profile: "noregion", expErr: true},
+		{region: "eu-west-2", profile: "nothere"},
+	}
+	for i, tcase := range tcases {
+		if got, want := ProfileRegion(tcase.profile), tcase.expRegion; got != want {
+			t.Fatalf("%d: got %s, want %s", i+1, got, want)
+		}
+		if err := ValidateRegionForProfile(tcase.region, tcase.profile); (err != nil) != tcase.expErr {
+			t.Fatalf("%d: got %v, want error %t", i+1, err, tcase.expErr)
+		}
+	}
+}
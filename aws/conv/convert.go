@@ -26,25 +26,38 @@ import (
 	"net"
 	"net/url"
 	"reflect"
+	"strings"
 	"sync"
 	"time"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/batch"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/wallix/awless/aws/ec2transitgateway"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/graph"
@@ -74,8 +87,30 @@ func InitResource(source interface{}) (*graph.Resource, error) {
 		res = graph.InitResource(cloud.InternetGateway, awssdk.StringValue(ss.InternetGatewayId))
 	case *ec2.NatGateway:
 		res = graph.InitResource(cloud.NatGateway, awssdk.StringValue(ss.NatGatewayId))
+	case *ec2.VpcPeeringConnection:
+		res = graph.InitResource(cloud.PeeringConnection, awssdk.StringValue(ss.VpcPeeringConnectionId))
+	case *ec2.VpcEndpoint:
+		res = graph.InitResource(cloud.VpcEndpoint, awssdk.StringValue(ss.VpcEndpointId))
+	case *ec2.CustomerGateway:
+		res = graph.InitResource(cloud.CustomerGateway, awssdk.StringValue(ss.CustomerGatewayId))
+	case *ec2.VpnGateway:
+		res = graph.InitResource(cloud.VpnGateway, awssdk.StringValue(ss.VpnGatewayId))
+	case *ec2.VpnConnection:
+		res = graph.InitResource(cloud.VpnConnection, awssdk.StringValue(ss.VpnConnectionId))
+	case *ec2transitgateway.TransitGateway:
+		res = graph.InitResource(cloud.TransitGateway, awssdk.StringValue(ss.TransitGatewayId))
+	case *ec2transitgateway.TransitGatewayAttachment:
+		res = graph.InitResource(cloud.TransitGatewayAttachment, awssdk.StringValue(ss.TransitGatewayAttachmentId))
+	case *ec2transitgateway.TransitGatewayRouteTable:
+		res = graph.InitResource(cloud.TransitGatewayRouteTable, awssdk.StringValue(ss.TransitGatewayRouteTableId))
 	case *ec2.RouteTable:
 		res = graph.InitResource(cloud.RouteTable, awssdk.StringValue(ss.RouteTableId))
+	case *ec2.NetworkAcl:
+		res = graph.InitResource(cloud.NetworkACL, awssdk.StringValue(ss.NetworkAclId))
+	case *ec2.PlacementGroup:
+		res = graph.InitResource(cloud.PlacementGroup, awssdk.StringValue(ss.GroupName))
+	case *ec2.Host:
+		res = graph.InitResource(cloud.Host, awssdk.StringValue(ss.HostId))
 	case *ec2.AvailabilityZone:
 		res = graph.InitResource(cloud.AvailabilityZone, awssdk.StringValue(ss.ZoneName))
 	case *ec2.Address:
@@ -86,6 +121,12 @@ func InitResource(source interface{}) (*graph.Resource, error) {
 		}
 	case *ec2.Snapshot:
 		res = graph.InitResource(cloud.Snapshot, awssdk.StringValue(ss.SnapshotId))
+	case *ec2.SpotInstanceRequest:
+		res = graph.InitResource(cloud.SpotRequest, awssdk.StringValue(ss.SpotInstanceRequestId))
+	case *ec2.SpotFleetRequestConfig:
+		res = graph.InitResource(cloud.SpotFleet, awssdk.StringValue(ss.SpotFleetRequestId))
+	case *ec2.ReservedInstances:
+		res = graph.InitResource(cloud.Reservation, awssdk.StringValue(ss.ReservedInstancesId))
 	case *ec2.NetworkInterface:
 		res = graph.InitResource(cloud.NetworkInterface, awssdk.StringValue(ss.NetworkInterfaceId))
 	// Loadbalancer
@@ -95,11 +136,35 @@ func InitResource(source interface{}) (*graph.Resource, error) {
 		res = graph.InitResource(cloud.TargetGroup, awssdk.StringValue(ss.TargetGroupArn))
 	case *elbv2.Listener:
 		res = graph.InitResource(cloud.Listener, awssdk.StringValue(ss.ListenerArn))
+	case *elb.LoadBalancerDescription:
+		res = graph.InitResource(cloud.ClassicLoadBalancer, awssdk.StringValue(ss.LoadBalancerName))
 		// Database
 	case *rds.DBInstance:
 		res = graph.InitResource(cloud.Database, awssdk.StringValue(ss.DBInstanceIdentifier))
 	case *rds.DBSubnetGroup:
 		res = graph.InitResource(cloud.DbSubnetGroup, awssdk.StringValue(ss.DBSubnetGroupArn))
+	case *dynamodb.TableDescription:
+		res = graph.InitResource(cloud.Table, awssdk.StringValue(ss.TableName))
+	case *rds.DBParameterGroup:
+		res = graph.InitResource(cloud.DbParameterGroup, awssdk.StringValue(ss.DBParameterGroupName))
+	case *elasticache.CacheCluster:
+		res = graph.InitResource(cloud.CacheCluster, awssdk.StringValue(ss.CacheClusterId))
+	case *elasticache.CacheSubnetGroup:
+		res = graph.InitResource(cloud.CacheSubnetGroup, awssdk.StringValue(ss.CacheSubnetGroupName))
+	case *redshift.Cluster:
+		res = graph.InitResource(cloud.Cluster, awssdk.StringValue(ss.ClusterIdentifier))
+		// Batch
+	case *batch.ComputeEnvironmentDetail:
+		res = graph.InitResource(cloud.ComputeEnvironment, awssdk.StringValue(ss.ComputeEnvironmentArn))
+	case *batch.JobQueueDetail:
+		res = graph.InitResource(cloud.JobQueue, awssdk.StringValue(ss.JobQueueArn))
+	case *batch.JobDetail:
+		res = graph.InitResource(cloud.Job, awssdk.StringValue(ss.JobId))
+		// Direct Connect
+	case *directconnect.Connection:
+		res = graph.InitResource(cloud.DxConnection, awssdk.StringValue(ss.ConnectionId))
+	case *directconnect.VirtualInterface:
+		res = graph.InitResource(cloud.VirtualInterface, awssdk.StringValue(ss.VirtualInterfaceId))
 		// Autoscaling
 	case *autoscaling.LaunchConfiguration:
 		res = graph.InitResource(cloud.LaunchConfiguration, awssdk.StringValue(ss.LaunchConfigurationARN))
@@ -112,6 +177,8 @@ func InitResource(source interface{}) (*graph.Resource, error) {
 		res = graph.InitResource(cloud.Repository, awssdk.StringValue(ss.RepositoryArn))
 	case *ecs.Cluster:
 		res = graph.InitResource(cloud.ContainerCluster, awssdk.StringValue(ss.ClusterArn))
+	case *ecs.Service:
+		res = graph.InitResource(cloud.ContainerService, awssdk.StringValue(ss.ServiceArn))
 	case *ecs.TaskDefinition:
 		res = graph.InitResource(cloud.ContainerTask, awssdk.StringValue(ss.TaskDefinitionArn))
 	case *ecs.Container:
@@ -140,6 +207,12 @@ func InitResource(source interface{}) (*graph.Resource, error) {
 		res = graph.InitResource(cloud.InstanceProfile, awssdk.StringValue(ss.InstanceProfileId))
 	case *iam.VirtualMFADevice:
 		res = graph.InitResource(cloud.MFADevice, awssdk.StringValue(ss.SerialNumber))
+	case *kms.KeyMetadata:
+		res = graph.InitResource(cloud.Key, awssdk.StringValue(ss.KeyId))
+	case *organizations.Account:
+		res = graph.InitResource(cloud.Account, awssdk.StringValue(ss.Id))
+	case *organizations.OrganizationalUnit:
+		res = graph.InitResource(cloud.OrganizationalUnit, awssdk.StringValue(ss.Id))
 	// S3
 	case *s3.Bucket:
 		res = graph.InitResource(cloud.Bucket, awssdk.StringValue(ss.Name))
@@ -159,12 +232,22 @@ func InitResource(source interface{}) (*graph.Resource, error) {
 		// Lambda
 	case *lambda.FunctionConfiguration:
 		res = graph.InitResource(cloud.Function, awssdk.StringValue(ss.FunctionArn))
+	case *sfn.StateMachineListItem:
+		res = graph.InitResource(cloud.StateMachine, awssdk.StringValue(ss.StateMachineArn))
+	case *sfn.ExecutionListItem:
+		res = graph.InitResource(cloud.Execution, awssdk.StringValue(ss.ExecutionArn))
 		// Monitoring
 	case *cloudwatch.Metric:
 		id := HashFields(awssdk.StringValue(ss.Namespace), awssdk.StringValue(ss.MetricName))
 		res = graph.InitResource(cloud.Metric, id)
 	case *cloudwatch.MetricAlarm:
 		res = graph.InitResource(cloud.Alarm, awssdk.StringValue(ss.AlarmArn))
+	case *cloudwatchlogs.LogGroup:
+		res = graph.InitResource(cloud.LogGroup, awssdk.StringValue(ss.LogGroupName))
+	case *cloudtrail.Trail:
+		res = graph.InitResource(cloud.Trail, awssdk.StringValue(ss.TrailARN))
+	case *cloudtrail.Event:
+		res = graph.InitResource(cloud.TrailEvent, awssdk.StringValue(ss.EventId))
 		// cdn
 	case *cloudfront.DistributionSummary:
 		res = graph.InitResource(cloud.Distribution, awssdk.StringValue(ss.Id))
@@ -219,6 +302,9 @@ func NewResource(source interface{}) (*graph.Resource, error) {
 			}
 			if t.fetch != nil {
 				val, err := t.fetch(source)
+				if err == ErrTagNotFound {
+					return
+				}
 				if err != nil {
 					errc <- fmt.Errorf("type [%s]: prop '%v': %s", res.Type(), p, err)
 				}
@@ -281,6 +367,23 @@ var extractValueAsStringFn = func(i interface{}) (interface{}, error) {
 	return fmt.Sprint(val), err
 }
 
+var extractFieldAsStringFn = func(field string) transformFn {
+	return func(i interface{}) (interface{}, error) {
+		val, err := extractFieldFn(field)(i)
+		return fmt.Sprint(val), err
+	}
+}
+
+// Extract the resource name ending an ARN (i.e. the topic name of arn:aws:sns:eu-west-1:0123456789:alerts)
+var extractArnResourceNameFn = func(i interface{}) (interface{}, error) {
+	s, ok := i.(*string)
+	if !ok {
+		return nil, fmt.Errorf("extract arn resource name: not a *string but a %T", i)
+	}
+	arn := awssdk.StringValue(s)
+	return arn[strings.LastIndex(arn, ":")+1:], nil
+}
+
 // Extract time forcing timezone to UTC (friendlier when running test in different timezones i.e. travis)
 var extractTimeFn = func(i interface{}) (interface{}, error) {
 	t, ok := i.(*time.Time)
@@ -298,6 +401,16 @@ var extractTimeFn = func(i interface{}) (interface{}, error) {
 	return nil, fmt.Errorf("extract time: expected time pointer, got: %T", i)
 }
 
+// Extract time given as milliseconds since epoch, forcing timezone to UTC
+var extractTimeFromMillisFn = func(i interface{}) (interface{}, error) {
+	ms, ok := i.(*int64)
+	if !ok {
+		return nil, fmt.Errorf("extract time from milliseconds: expected int64 pointer, got: %T", i)
+	}
+	msec := awssdk.Int64Value(ms)
+	return time.Unix(msec/1000, (msec%1000)*int64(time.Millisecond)).UTC(), nil
+}
+
 // Extract time that have a Z directly after the time without a space which means UTC
 // (https://en.wikipedia.org/wiki/ISO_8601#UTC)
 var extractTimeWithZSuffixFn = func(i interface{}) (interface{}, error) {
@@ -405,6 +518,68 @@ var extractRouteTableAssociationsFn = func(i interface{}) (interface{}, error) {
 	return keyVals, nil
 }
 
+var extractNetworkACLAssociationsFn = func(i interface{}) (interface{}, error) {
+	if _, ok := i.([]*ec2.NetworkAclAssociation); !ok {
+		return nil, fmt.Errorf("extract network acl associations: not an association slice but a %T", i)
+	}
+	var keyVals []*graph.KeyValue
+	for _, assoc := range i.([]*ec2.NetworkAclAssociation) {
+		keyval := &graph.KeyValue{KeyName: awssdk.StringValue(assoc.NetworkAclAssociationId), Value: awssdk.StringValue(assoc.SubnetId)}
+		keyVals = append(keyVals, keyval)
+	}
+	return keyVals, nil
+}
+
+var extractNetworkACLRulesFn = func(egress bool) transformFn {
+	return func(i interface{}) (interface{}, error) {
+		if _, ok := i.([]*ec2.NetworkAclEntry); !ok {
+			return nil, fmt.Errorf("extract network acl rules: not an entry slice but a %T", i)
+		}
+		var rules []*graph.NetworkACLRule
+		for _, entry := range i.([]*ec2.NetworkAclEntry) {
+			if awssdk.BoolValue(entry.Egress) != egress {
+				continue
+			}
+			rule := &graph.NetworkACLRule{Number: awssdk.Int64Value(entry.RuleNumber), Action: awssdk.StringValue(entry.RuleAction)}
+
+			switch protocol := awssdk.StringValue(entry.Protocol); protocol {
+			case "-1":
+				rule.Protocol = "any"
+			case "6":
+				rule.Protocol = "tcp"
+			case "17":
+				rule.Protocol = "udp"
+			case "1":
+				rule.Protocol = "icmp"
+			case "58":
+				rule.Protocol = "icmpv6"
+			default:
+				rule.Protocol = protocol
+			}
+			if entry.PortRange != nil && rule.Protocol != "any" {
+				rule.PortRange = graph.PortRange{FromPort: awssdk.Int64Value(entry.PortRange.From), ToPort: awssdk.Int64Value(entry.PortRange.To)}
+			} else {
+				rule.PortRange = graph.PortRange{Any: true}
+			}
+
+			cidr := awssdk.StringValue(entry.CidrBlock)
+			if notEmpty(entry.Ipv6CidrBlock) {
+				cidr = awssdk.StringValue(entry.Ipv6CidrBlock)
+			}
+			if cidr != "" {
+				_, ipnet, err := net.ParseCIDR(cidr)
+				if err != nil {
+					return rules, err
+				}
+				rule.IPRange = ipnet
+			}
+			rules = append(rules, rule)
+		}
+		graph.NetworkACLRules(rules).Sort()
+		return rules, nil
+	}
+}
+
 var extractFieldFn = func(field string) transformFn {
 	return func(i interface{}) (interface{}, error) {
 		value := reflect.ValueOf(i)
@@ -426,6 +601,56 @@ var extractFieldFn = func(field string) transformFn {
 	}
 }
 
+// Extract the name of the attribute of a DynamoDB key schema having the given key type (HASH or RANGE)
+var extractKeySchemaAttributeFn = func(keyType string) transformFn {
+	return func(i interface{}) (interface{}, error) {
+		schema, ok := i.([]*dynamodb.KeySchemaElement)
+		if !ok {
+			return nil, fmt.Errorf("extract key schema: not a key schema element slice but a %T", i)
+		}
+		for _, elem := range schema {
+			if awssdk.StringValue(elem.KeyType) == keyType {
+				return awssdk.StringValue(elem.AttributeName), nil
+			}
+		}
+		return nil, ErrTagNotFound
+	}
+}
+
+// Extract a field of the endpoint of a cache cluster: its configuration endpoint for Memcached,
+// or the endpoint of its first node for Redis (only fetched with ShowCacheNodeInfo)
+var extractCacheClusterEndpointFn = func(field string) fetchFn {
+	return func(i interface{}) (interface{}, error) {
+		cluster, ok := i.(*elasticache.CacheCluster)
+		if !ok {
+			return nil, fmt.Errorf("extract cache cluster endpoint: not a cache cluster but a %T", i)
+		}
+		endpoint := cluster.ConfigurationEndpoint
+		if endpoint == nil && len(cluster.CacheNodes) > 0 {
+			endpoint = cluster.CacheNodes[0].Endpoint
+		}
+		if endpoint == nil {
+			return nil, ErrTagNotFound
+		}
+		return extractFieldFn(field)(endpoint)
+	}
+}
+
+// Extract the listeners of a classic load balancer as PROTOCOL:PORT->INSTANCE_PROTOCOL:INSTANCE_PORT (ex: HTTP:80->HTTP:8080)
+var extractClassicListenersFn = func(i interface{}) (interface{}, error) {
+	descriptions, ok := i.([]*elb.ListenerDescription)
+	if !ok {
+		return nil, fmt.Errorf("extract classic listeners: not a listener description slice but a %T", i)
+	}
+	var res []string
+	for _, desc := range descriptions {
+		if l := desc.Listener; l != nil {
+			res = append(res, fmt.Sprintf("%s:%d->%s:%d", awssdk.StringValue(l.Protocol), awssdk.Int64Value(l.LoadBalancerPort), awssdk.StringValue(l.InstanceProtocol), awssdk.Int64Value(l.InstancePort)))
+		}
+	}
+	return res, nil
+}
+
 var extractTagsFn = func(i interface{}) (interface{}, error) {
 	var out []string
 	switch tags := i.(type) {
@@ -444,6 +669,67 @@ var extractTagsFn = func(i interface{}) (interface{}, error) {
 	return out, nil
 }
 
+// Extract the IPv6 CIDR blocks currently associated to a VPC or a subnet
+var extractIPv6CidrBlocksFn = func(i interface{}) (interface{}, error) {
+	var out []string
+	switch assocs := i.(type) {
+	case []*ec2.VpcIpv6CidrBlockAssociation:
+		for _, a := range assocs {
+			if a.Ipv6CidrBlockState != nil && isAssociatedCidrBlockState(a.Ipv6CidrBlockState.State) {
+				out = append(out, awssdk.StringValue(a.Ipv6CidrBlock))
+			}
+		}
+	case []*ec2.SubnetIpv6CidrBlockAssociation:
+		for _, a := range assocs {
+			if a.Ipv6CidrBlockState != nil && isAssociatedCidrBlockState(a.Ipv6CidrBlockState.State) {
+				out = append(out, awssdk.StringValue(a.Ipv6CidrBlock))
+			}
+		}
+	default:
+		return nil, fmt.Errorf("extract ipv6 cidr blocks: not a cidr block association slice, but a %T", i)
+	}
+
+	return out, nil
+}
+
+func isAssociatedCidrBlockState(state *string) bool {
+	switch awssdk.StringValue(state) {
+	case ec2.VpcCidrBlockStateCodeAssociated, ec2.VpcCidrBlockStateCodeAssociating:
+		return true
+	}
+	return false
+}
+
+var extractInstanceIPv6AddressesFn = func(i interface{}) (interface{}, error) {
+	nis, ok := i.([]*ec2.InstanceNetworkInterface)
+	if !ok {
+		return nil, fmt.Errorf("extract instance ipv6 addresses: not a network interface slice, but a %T", i)
+	}
+	var out []string
+	for _, ni := range nis {
+		for _, addr := range ni.Ipv6Addresses {
+			out = append(out, awssdk.StringValue(addr.Ipv6Address))
+		}
+	}
+
+	return out, nil
+}
+
+var extractSecondaryPrivateIPsFn = func(i interface{}) (interface{}, error) {
+	addrs, ok := i.([]*ec2.NetworkInterfacePrivateIpAddress)
+	if !ok {
+		return nil, fmt.Errorf("extract secondary private ips: not a private ip address slice, but a %T", i)
+	}
+	var out []string
+	for _, addr := range addrs {
+		if !awssdk.BoolValue(addr.Primary) {
+			out = append(out, awssdk.StringValue(addr.PrivateIpAddress))
+		}
+	}
+
+	return out, nil
+}
+
 var extractTagFn = func(key string) transformFn {
 	return func(i interface{}) (interface{}, error) {
 		tags, ok := i.([]*ec2.Tag)
@@ -26,6 +26,7 @@ import (
 	"github.com/wallix/awless/graph"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elasticache"
 )
 
 func TestTransformFunctions(t *testing.T) {
@@ -203,6 +204,35 @@ func TestTransformFunctions(t *testing.T) {
 		}
 	})
 
+	t.Run("extractIPv6CidrBlocks", func(t *testing.T) {
+		t.Parallel()
+		assocs := []*ec2.VpcIpv6CidrBlockAssociation{
+			{Ipv6CidrBlock: awssdk.String("2600:1f18:22b3:7a00::/56"), Ipv6CidrBlockState: &ec2.VpcCidrBlockState{State: awssdk.String("associated")}},
+			{Ipv6CidrBlock: awssdk.String("2600:1f18:1111:2200::/56"), Ipv6CidrBlockState: &ec2.VpcCidrBlockState{State: awssdk.String("disassociated")}},
+		}
+
+		val, err := extractIPv6CidrBlocksFn(assocs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := val, []string{"2600:1f18:22b3:7a00::/56"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+
+		nis := []*ec2.InstanceNetworkInterface{
+			{Ipv6Addresses: []*ec2.InstanceIpv6Address{{Ipv6Address: awssdk.String("2600:1f18:22b3:7a00::10")}}},
+			{},
+			{Ipv6Addresses: []*ec2.InstanceIpv6Address{{Ipv6Address: awssdk.String("2600:1f18:22b3:7a01::20")}}},
+		}
+		val, err = extractInstanceIPv6AddressesFn(nis)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := val, []string{"2600:1f18:22b3:7a00::10", "2600:1f18:22b3:7a01::20"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
 	t.Run("extractRoutesSlice", func(t *testing.T) {
 		t.Parallel()
 		routes := []*ec2.Route{
@@ -351,4 +381,36 @@ func TestTransformFunctions(t *testing.T) {
 			t.Fatalf("got %t, want %t", got, want)
 		}
 	})
+
+	t.Run("extractCacheClusterEndpoint", func(t *testing.T) {
+		t.Parallel()
+		node := &elasticache.CacheNode{Endpoint: &elasticache.Endpoint{Address: awssdk.String("redis.0001.cache.amazonaws.com"), Port: awssdk.Int64(6379)}}
+		memcached := &elasticache.CacheCluster{
+			ConfigurationEndpoint: &elasticache.Endpoint{Address: awssdk.String("memcached.cfg.cache.amazonaws.com"), Port: awssdk.Int64(11211)},
+			CacheNodes:            []*elasticache.CacheNode{node},
+		}
+		tcases := []struct {
+			cluster    *elasticache.CacheCluster
+			expAddress interface{}
+			expPort    interface{}
+			expErr     error
+		}{
+			{cluster: memcached, expAddress: "memcached.cfg.cache.amazonaws.com", expPort: int64(11211)},
+			{cluster: &elasticache.CacheCluster{CacheNodes: []*elasticache.CacheNode{node}}, expAddress: "redis.0001.cache.amazonaws.com", expPort: int64(6379)},
+			{cluster: &elasticache.CacheCluster{}, expErr: ErrTagNotFound},
+		}
+		for i, tcase := range tcases {
+			address, err := extractCacheClusterEndpointFn("Address")(tcase.cluster)
+			if tcase.expErr != nil {
+				if err != tcase.expErr {
+					t.Fatalf("%d: got %v, want %v", i+1, err, tcase.expErr)
+				}
+				continue
+			}
+			port, _ := extractCacheClusterEndpointFn("Port")(tcase.cluster)
+			if address != tcase.expAddress || port != tcase.expPort {
+				t.Fatalf("%d: got %v:%v, want %v:%v", i+1, address, port, tcase.expAddress, tcase.expPort)
+			}
+		}
+	})
 }
@@ -46,14 +46,16 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.PublicDNS:         {name: "PublicDnsName", transform: extractValueFn},
 		properties.RootDevice:        {name: "RootDeviceName", transform: extractValueFn},
 		properties.RootDeviceType:    {name: "RootDeviceType", transform: extractValueFn},
+		properties.IPv6Addresses:     {name: "NetworkInterfaces", transform: extractInstanceIPv6AddressesFn},
 		properties.Tags:              {name: "Tags", transform: extractTagsFn},
 	},
 	cloud.Vpc: {
-		properties.Name:    {name: "Tags", transform: extractTagFn("Name")},
-		properties.Default: {name: "IsDefault", transform: extractValueFn},
-		properties.State:   {name: "State", transform: extractValueFn},
-		properties.CIDR:    {name: "CidrBlock", transform: extractValueFn},
-		properties.Tags:    {name: "Tags", transform: extractTagsFn},
+		properties.Name:      {name: "Tags", transform: extractTagFn("Name")},
+		properties.Default:   {name: "IsDefault", transform: extractValueFn},
+		properties.State:     {name: "State", transform: extractValueFn},
+		properties.CIDR:      {name: "CidrBlock", transform: extractValueFn},
+		properties.IPv6CIDRs: {name: "Ipv6CidrBlockAssociationSet", transform: extractIPv6CidrBlocksFn},
+		properties.Tags:      {name: "Tags", transform: extractTagsFn},
 	},
 	cloud.Subnet: {
 		properties.Name:             {name: "Tags", transform: extractTagFn("Name")},
@@ -61,6 +63,7 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Public:           {name: "MapPublicIpOnLaunch", transform: extractValueFn},
 		properties.State:            {name: "State", transform: extractValueFn},
 		properties.CIDR:             {name: "CidrBlock", transform: extractValueFn},
+		properties.IPv6CIDRs:        {name: "Ipv6CidrBlockAssociationSet", transform: extractIPv6CidrBlocksFn},
 		properties.AvailabilityZone: {name: "AvailabilityZone", transform: extractValueFn},
 		properties.Default:          {name: "DefaultForAz", transform: extractValueFn},
 		properties.Tags:             {name: "Tags", transform: extractTagsFn},
@@ -83,14 +86,17 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.State:            {name: "State", transform: extractValueFn},
 		properties.Size:             {name: "Size", transform: extractValueFn},
 		properties.Encrypted:        {name: "Encrypted", transform: extractValueFn},
+		properties.Key:              {name: "KmsKeyId", transform: extractValueFn},
 		properties.Created:          {name: "CreateTime", transform: extractTimeFn},
 		properties.AvailabilityZone: {name: "AvailabilityZone", transform: extractValueFn},
 		properties.Instances:        {name: "Attachments", transform: extractStringSliceValues("InstanceId")},
+		properties.Devices:          {name: "Attachments", transform: extractStringSliceValues("Device")},
 		properties.Tags:             {name: "Tags", transform: extractTagsFn},
 	},
 	cloud.Snapshot: {
 		properties.Description: {name: "Description", transform: extractValueFn},
 		properties.Encrypted:   {name: "Encrypted", transform: extractValueFn},
+		properties.Key:         {name: "KmsKeyId", transform: extractValueFn},
 		properties.Owner:       {name: "OwnerId", transform: extractValueFn},
 		properties.Progress:    {name: "Progress", transform: extractValueFn},
 		properties.Created:     {name: "StartTime", transform: extractValueFn},
@@ -128,6 +134,42 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.State:        {name: "Status", transform: extractValueFn},
 		properties.StateMessage: {name: "StatusMessage", transform: extractValueFn},
 	},
+	cloud.SpotRequest: {
+		properties.Name:             {name: "Tags", transform: extractTagFn("Name")},
+		properties.Type:             {name: "LaunchSpecification", transform: extractFieldFn("InstanceType")},
+		properties.Image:            {name: "LaunchSpecification", transform: extractFieldFn("ImageId")},
+		properties.KeyPair:          {name: "LaunchSpecification", transform: extractFieldFn("KeyName")},
+		properties.SpotPrice:        {name: "SpotPrice", transform: extractValueFn},
+		properties.State:            {name: "State", transform: extractValueFn},
+		properties.StateMessage:     {name: "Status", transform: extractFieldFn("Message")},
+		properties.Instance:         {name: "InstanceId", transform: extractValueFn},
+		properties.AvailabilityZone: {name: "LaunchedAvailabilityZone", transform: extractValueFn},
+		properties.Created:          {name: "CreateTime", transform: extractTimeFn},
+		properties.Tags:             {name: "Tags", transform: extractTagsFn},
+	},
+	cloud.SpotFleet: {
+		properties.State:           {name: "SpotFleetRequestState", transform: extractValueFn},
+		properties.StateMessage:    {name: "ActivityStatus", transform: extractValueFn},
+		properties.Type:            {name: "SpotFleetRequestConfig", transform: extractFieldFn("Type")},
+		properties.DesiredCapacity: {name: "SpotFleetRequestConfig", transform: extractFieldFn("TargetCapacity")},
+		properties.SpotPrice:       {name: "SpotFleetRequestConfig", transform: extractFieldFn("SpotPrice")},
+		properties.Role:            {name: "SpotFleetRequestConfig", transform: extractFieldFn("IamFleetRole")},
+		properties.Created:         {name: "CreateTime", transform: extractTimeFn},
+	},
+	cloud.Reservation: {
+		properties.Name:             {name: "Tags", transform: extractTagFn("Name")},
+		properties.Type:             {name: "InstanceType", transform: extractValueFn},
+		properties.InstanceCount:    {name: "InstanceCount", transform: extractValueFn},
+		properties.State:            {name: "State", transform: extractValueFn},
+		properties.Scope:            {name: "Scope", transform: extractValueFn},
+		properties.AvailabilityZone: {name: "AvailabilityZone", transform: extractValueFn},
+		properties.Description:      {name: "ProductDescription", transform: extractValueFn},
+		properties.OfferingClass:    {name: "OfferingClass", transform: extractValueFn},
+		properties.OfferingType:     {name: "OfferingType", transform: extractValueFn},
+		properties.Launched:         {name: "Start", transform: extractTimeFn},
+		properties.Expires:          {name: "End", transform: extractTimeFn},
+		properties.Tags:             {name: "Tags", transform: extractTagsFn},
+	},
 	cloud.InternetGateway: {
 		properties.Name: {name: "Tags", transform: extractTagFn("Name")},
 		properties.Vpcs: {name: "Attachments", transform: extractStringSliceValues("VpcId")},
@@ -139,6 +181,78 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Vpc:     {name: "VpcId", transform: extractValueFn},
 		properties.State:   {name: "State", transform: extractValueFn},
 	},
+	cloud.PeeringConnection: {
+		properties.Name:      {name: "Tags", transform: extractTagFn("Name")},
+		properties.State:     {name: "Status", transform: extractFieldFn("Code")},
+		properties.Vpc:       {name: "RequesterVpcInfo", transform: extractFieldFn("VpcId")},
+		properties.PeerVpc:   {name: "AccepterVpcInfo", transform: extractFieldFn("VpcId")},
+		properties.PeerOwner: {name: "AccepterVpcInfo", transform: extractFieldFn("OwnerId")},
+		properties.Expires:   {name: "ExpirationTime", transform: extractTimeFn},
+		properties.Tags:      {name: "Tags", transform: extractTagsFn},
+	},
+	cloud.VpcEndpoint: {
+		properties.Service:        {name: "ServiceName", transform: extractValueFn},
+		properties.Type:           {name: "VpcEndpointType", transform: extractValueFn},
+		properties.State:          {name: "State", transform: extractValueFn},
+		properties.Vpc:            {name: "VpcId", transform: extractValueFn},
+		properties.RouteTables:    {name: "RouteTableIds", transform: extractStringPointerSliceValues},
+		properties.Subnets:        {name: "SubnetIds", transform: extractStringPointerSliceValues},
+		properties.SecurityGroups: {name: "Groups", transform: extractStringSliceValues("GroupId")},
+		properties.Created:        {name: "CreationTimestamp", transform: extractTimeFn},
+	},
+	cloud.CustomerGateway: {
+		properties.Name:     {name: "Tags", transform: extractTagFn("Name")},
+		properties.PublicIP: {name: "IpAddress", transform: extractValueFn},
+		properties.ASN:      {name: "BgpAsn", transform: extractValueFn},
+		properties.Type:     {name: "Type", transform: extractValueFn},
+		properties.State:    {name: "State", transform: extractValueFn},
+		properties.Tags:     {name: "Tags", transform: extractTagsFn},
+	},
+	cloud.VpnGateway: {
+		properties.Name:             {name: "Tags", transform: extractTagFn("Name")},
+		properties.ASN:              {name: "AmazonSideAsn", transform: extractValueAsStringFn},
+		properties.AvailabilityZone: {name: "AvailabilityZone", transform: extractValueFn},
+		properties.Type:             {name: "Type", transform: extractValueFn},
+		properties.State:            {name: "State", transform: extractValueFn},
+		properties.Vpcs:             {name: "VpcAttachments", transform: extractStringSliceValues("VpcId")},
+		properties.Tags:             {name: "Tags", transform: extractTagsFn},
+	},
+	cloud.VpnConnection: {
+		properties.Name:            {name: "Tags", transform: extractTagFn("Name")},
+		properties.CustomerGateway: {name: "CustomerGatewayId", transform: extractValueFn},
+		properties.VpnGateway:      {name: "VpnGatewayId", transform: extractValueFn},
+		properties.Type:            {name: "Type", transform: extractValueFn},
+		properties.State:           {name: "State", transform: extractValueFn},
+		properties.Tags:            {name: "Tags", transform: extractTagsFn},
+	},
+	cloud.TransitGateway: {
+		properties.Name:        {name: "Tags", transform: extractTagFn("Name")},
+		properties.Arn:         {name: "TransitGatewayArn", transform: extractValueFn},
+		properties.Description: {name: "Description", transform: extractValueFn},
+		properties.ASN:         {name: "Options", transform: extractFieldAsStringFn("AmazonSideAsn")},
+		properties.State:       {name: "State", transform: extractValueFn},
+		properties.Owner:       {name: "OwnerId", transform: extractValueFn},
+		properties.Created:     {name: "CreationTime", transform: extractTimeFn},
+		properties.Tags:        {name: "Tags", transform: extractTagsFn},
+	},
+	cloud.TransitGatewayAttachment: {
+		properties.Name:           {name: "Tags", transform: extractTagFn("Name")},
+		properties.TransitGateway: {name: "TransitGatewayId", transform: extractValueFn},
+		properties.RouteTable:     {name: "Association", transform: extractFieldFn("TransitGatewayRouteTableId")},
+		properties.Type:           {name: "ResourceType", transform: extractValueFn},
+		properties.State:          {name: "State", transform: extractValueFn},
+		properties.Owner:          {name: "ResourceOwnerId", transform: extractValueFn},
+		properties.Created:        {name: "CreationTime", transform: extractTimeFn},
+		properties.Tags:           {name: "Tags", transform: extractTagsFn},
+	},
+	cloud.TransitGatewayRouteTable: {
+		properties.Name:           {name: "Tags", transform: extractTagFn("Name")},
+		properties.TransitGateway: {name: "TransitGatewayId", transform: extractValueFn},
+		properties.Default:        {name: "DefaultAssociationRouteTable", transform: extractValueFn},
+		properties.State:          {name: "State", transform: extractValueFn},
+		properties.Created:        {name: "CreationTime", transform: extractTimeFn},
+		properties.Tags:           {name: "Tags", transform: extractTagsFn},
+	},
 	cloud.RouteTable: {
 		properties.Name:         {name: "Tags", transform: extractTagFn("Name")},
 		properties.Vpc:          {name: "VpcId", transform: extractValueFn},
@@ -147,6 +261,28 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Associations: {name: "Associations", transform: extractRouteTableAssociationsFn},
 		properties.Tags:         {name: "Tags", transform: extractTagsFn},
 	},
+	cloud.NetworkACL: {
+		properties.Name:             {name: "Tags", transform: extractTagFn("Name")},
+		properties.Vpc:              {name: "VpcId", transform: extractValueFn},
+		properties.Default:          {name: "IsDefault", transform: extractValueFn},
+		properties.InboundACLRules:  {name: "Entries", transform: extractNetworkACLRulesFn(false)},
+		properties.OutboundACLRules: {name: "Entries", transform: extractNetworkACLRulesFn(true)},
+		properties.Associations:     {name: "Associations", transform: extractNetworkACLAssociationsFn},
+		properties.Tags:             {name: "Tags", transform: extractTagsFn},
+	},
+	cloud.PlacementGroup: {
+		properties.Name:     {name: "GroupName", transform: extractValueFn},
+		properties.Strategy: {name: "Strategy", transform: extractValueFn},
+		properties.State:    {name: "State", transform: extractValueFn},
+	},
+	cloud.Host: {
+		properties.Type:             {name: "HostProperties", transform: extractFieldFn("InstanceType")},
+		properties.AvailabilityZone: {name: "AvailabilityZone", transform: extractValueFn},
+		properties.State:            {name: "State", transform: extractValueFn},
+		properties.AutoPlacement:    {name: "AutoPlacement", transform: extractValueFn},
+		properties.Instances:        {name: "Instances", transform: extractStringSliceValues("InstanceId")},
+		properties.HostReservation:  {name: "HostReservationId", transform: extractValueFn},
+	},
 	cloud.AvailabilityZone: {
 		properties.Name:     {name: "ZoneName", transform: extractValueFn},
 		properties.State:    {name: "State", transform: extractValueFn},
@@ -154,30 +290,34 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Messages: {name: "Messages", transform: extractStringSliceValues("Message")},
 	},
 	cloud.ElasticIP: {
-		properties.Name:        {name: "PublicIp", transform: extractValueFn},
-		properties.PublicIP:    {name: "PublicIp", transform: extractValueFn},
-		properties.PrivateIP:   {name: "PrivateIpAddress", transform: extractValueFn},
-		properties.Association: {name: "AssociationId", transform: extractValueFn},
+		properties.Name:             {name: "PublicIp", transform: extractValueFn},
+		properties.PublicIP:         {name: "PublicIp", transform: extractValueFn},
+		properties.PrivateIP:        {name: "PrivateIpAddress", transform: extractValueFn},
+		properties.Association:      {name: "AssociationId", transform: extractValueFn},
+		properties.Domain:           {name: "Domain", transform: extractValueFn},
+		properties.Instance:         {name: "InstanceId", transform: extractValueFn},
+		properties.NetworkInterface: {name: "NetworkInterfaceId", transform: extractValueFn},
 	},
 	cloud.NetworkInterface: {
-		properties.PublicIP:         {name: "Association", transform: extractFieldFn("PublicIp")},
-		properties.PublicDNS:        {name: "Association", transform: extractFieldFn("PublicDnsName")},
-		properties.Attachment:       {name: "Attachment", transform: extractFieldFn("AttachmentId")},
-		properties.Instance:         {name: "Attachment", transform: extractFieldFn("InstanceId")},
-		properties.InstanceOwner:    {name: "Attachment", transform: extractFieldFn("InstanceOwnerId")},
-		properties.AvailabilityZone: {name: "AvailabilityZone", transform: extractValueFn},
-		properties.Description:      {name: "Description", transform: extractValueFn},
-		properties.SecurityGroups:   {name: "Groups", transform: extractStringSliceValues("GroupId")},
-		properties.Type:             {name: "InterfaceType", transform: extractValueFn},
-		properties.IPv6Addresses:    {name: "Ipv6Addresses", transform: extractStringSliceValues("Ipv6Address")},
-		properties.MACAddress:       {name: "MacAddress", transform: extractValueFn},
-		properties.Owner:            {name: "OwnerId", transform: extractValueFn},
-		properties.PrivateDNS:       {name: "PrivateDnsName", transform: extractValueFn},
-		properties.PrivateIP:        {name: "PrivateIpAddress", transform: extractValueFn},
-		properties.State:            {name: "Status", transform: extractValueFn},
-		properties.Subnet:           {name: "SubnetId", transform: extractValueFn},
-		properties.Vpc:              {name: "VpcId", transform: extractValueFn},
-		properties.Tags:             {name: "TagSet", transform: extractTagsFn},
+		properties.PublicIP:            {name: "Association", transform: extractFieldFn("PublicIp")},
+		properties.PublicDNS:           {name: "Association", transform: extractFieldFn("PublicDnsName")},
+		properties.Attachment:          {name: "Attachment", transform: extractFieldFn("AttachmentId")},
+		properties.Instance:            {name: "Attachment", transform: extractFieldFn("InstanceId")},
+		properties.InstanceOwner:       {name: "Attachment", transform: extractFieldFn("InstanceOwnerId")},
+		properties.AvailabilityZone:    {name: "AvailabilityZone", transform: extractValueFn},
+		properties.Description:         {name: "Description", transform: extractValueFn},
+		properties.SecurityGroups:      {name: "Groups", transform: extractStringSliceValues("GroupId")},
+		properties.Type:                {name: "InterfaceType", transform: extractValueFn},
+		properties.IPv6Addresses:       {name: "Ipv6Addresses", transform: extractStringSliceValues("Ipv6Address")},
+		properties.MACAddress:          {name: "MacAddress", transform: extractValueFn},
+		properties.Owner:               {name: "OwnerId", transform: extractValueFn},
+		properties.PrivateDNS:          {name: "PrivateDnsName", transform: extractValueFn},
+		properties.PrivateIP:           {name: "PrivateIpAddress", transform: extractValueFn},
+		properties.SecondaryPrivateIPs: {name: "PrivateIpAddresses", transform: extractSecondaryPrivateIPsFn},
+		properties.State:               {name: "Status", transform: extractValueFn},
+		properties.Subnet:              {name: "SubnetId", transform: extractValueFn},
+		properties.Vpc:                 {name: "VpcId", transform: extractValueFn},
+		properties.Tags:                {name: "TagSet", transform: extractTagsFn},
 	},
 	// LoadBalancer
 	cloud.LoadBalancer: {
@@ -219,6 +359,20 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Protocol:     {name: "Protocol", transform: extractValueFn},
 		properties.CipherSuite:  {name: "SslPolicy", transform: extractValueFn},
 	},
+	cloud.ClassicLoadBalancer: {
+		properties.Name:              {name: "LoadBalancerName", transform: extractValueFn},
+		properties.AvailabilityZones: {name: "AvailabilityZones", transform: extractStringPointerSliceValues},
+		properties.Subnets:           {name: "Subnets", transform: extractStringPointerSliceValues},
+		properties.SecurityGroups:    {name: "SecurityGroups", transform: extractStringPointerSliceValues},
+		properties.Instances:         {name: "Instances", transform: extractStringSliceValues("InstanceId")},
+		properties.Listeners:         {name: "ListenerDescriptions", transform: extractClassicListenersFn},
+		properties.HealthCheck:       {name: "HealthCheck", transform: extractFieldFn("Target")},
+		properties.Zone:              {name: "CanonicalHostedZoneNameID", transform: extractValueFn},
+		properties.Created:           {name: "CreatedTime", transform: extractTimeFn},
+		properties.PublicDNS:         {name: "DNSName", transform: extractValueFn},
+		properties.Scheme:            {name: "Scheme", transform: extractValueFn},
+		properties.Vpc:               {name: "VPCId", transform: extractValueFn},
+	},
 	//Database
 	cloud.Database: {
 		properties.Storage:                   {name: "AllocatedStorage", transform: extractValueFn},
@@ -236,7 +390,7 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.ParameterGroups:           {name: "DBParameterGroups", transform: extractStringSliceValues("DBParameterGroupName")},
 		properties.DBSecurityGroups:          {name: "DBSecurityGroups", transform: extractStringSliceValues("DBSecurityGroupName")},
 		properties.DBSubnetGroup:             {name: "DBSubnetGroup", transform: extractFieldFn("DBSubnetGroupName")},
-		properties.Port:                      {name: "DbInstancePort", transform: extractValueFn},
+		properties.Port:                      {name: "Endpoint", transform: extractFieldFn("Port")},
 		properties.GlobalID:                  {name: "DbiResourceId", transform: extractValueFn},
 		properties.PublicDNS:                 {name: "Endpoint", transform: extractFieldFn("Address")},
 		properties.Zone:                      {name: "Endpoint", transform: extractFieldFn("HostedZoneId")},
@@ -269,6 +423,109 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Subnets:     {name: "Subnets", transform: extractStringSliceValues("SubnetIdentifier")},
 		properties.Vpc:         {name: "VpcId", transform: extractValueFn},
 	},
+	cloud.DbParameterGroup: {
+		properties.Name:        {name: "DBParameterGroupName", transform: extractValueFn},
+		properties.Arn:         {name: "DBParameterGroupArn", transform: extractValueFn},
+		properties.Description: {name: "Description", transform: extractValueFn},
+		properties.Family:      {name: "DBParameterGroupFamily", transform: extractValueFn},
+	},
+	//NoSQL
+	cloud.Table: {
+		properties.Name:          {name: "TableName", transform: extractValueFn},
+		properties.Arn:           {name: "TableArn", transform: extractValueFn},
+		properties.State:         {name: "TableStatus", transform: extractValueFn},
+		properties.Created:       {name: "CreationDateTime", transform: extractTimeFn},
+		properties.Size:          {name: "TableSizeBytes", transform: extractValueFn},
+		properties.ItemCount:     {name: "ItemCount", transform: extractValueFn},
+		properties.ReadCapacity:  {name: "ProvisionedThroughput", transform: extractFieldFn("ReadCapacityUnits")},
+		properties.WriteCapacity: {name: "ProvisionedThroughput", transform: extractFieldFn("WriteCapacityUnits")},
+		properties.HashKey:       {name: "KeySchema", transform: extractKeySchemaAttributeFn("HASH")},
+		properties.RangeKey:      {name: "KeySchema", transform: extractKeySchemaAttributeFn("RANGE")},
+	},
+	//Cache
+	cloud.CacheCluster: {
+		properties.Name:             {name: "CacheClusterId", transform: extractValueFn},
+		properties.State:            {name: "CacheClusterStatus", transform: extractValueFn},
+		properties.Created:          {name: "CacheClusterCreateTime", transform: extractValueFn},
+		properties.Engine:           {name: "Engine", transform: extractValueFn},
+		properties.EngineVersion:    {name: "EngineVersion", transform: extractValueFn},
+		properties.Class:            {name: "CacheNodeType", transform: extractValueFn},
+		properties.NodeCount:        {name: "NumCacheNodes", transform: extractValueFn},
+		properties.AvailabilityZone: {name: "PreferredAvailabilityZone", transform: extractValueFn},
+		properties.CacheSubnetGroup: {name: "CacheSubnetGroupName", transform: extractValueFn},
+		properties.SecurityGroups:   {name: "SecurityGroups", transform: extractStringSliceValues("SecurityGroupId")},
+		properties.Endpoint:         {fetch: extractCacheClusterEndpointFn("Address")},
+		properties.Port:             {fetch: extractCacheClusterEndpointFn("Port")},
+		properties.AutoUpgrade:      {name: "AutoMinorVersionUpgrade", transform: extractValueFn},
+	},
+	cloud.CacheSubnetGroup: {
+		properties.Name:        {name: "CacheSubnetGroupName", transform: extractValueFn},
+		properties.Description: {name: "CacheSubnetGroupDescription", transform: extractValueFn},
+		properties.Subnets:     {name: "Subnets", transform: extractStringSliceValues("SubnetIdentifier")},
+		properties.Vpc:         {name: "VpcId", transform: extractValueFn},
+	},
+	//Datawarehouse
+	cloud.Cluster: {
+		properties.Name:             {name: "ClusterIdentifier", transform: extractValueFn},
+		properties.State:            {name: "ClusterStatus", transform: extractValueFn},
+		properties.Created:          {name: "ClusterCreateTime", transform: extractValueFn},
+		properties.Class:            {name: "NodeType", transform: extractValueFn},
+		properties.NodeCount:        {name: "NumberOfNodes", transform: extractValueFn},
+		properties.Version:          {name: "ClusterVersion", transform: extractValueFn},
+		properties.Username:         {name: "MasterUsername", transform: extractValueFn},
+		properties.AvailabilityZone: {name: "AvailabilityZone", transform: extractValueFn},
+		properties.Vpc:              {name: "VpcId", transform: extractValueFn},
+		properties.SecurityGroups:   {name: "VpcSecurityGroups", transform: extractStringSliceValues("VpcSecurityGroupId")},
+		properties.Endpoint:         {name: "Endpoint", transform: extractFieldFn("Address")},
+		properties.Port:             {name: "Endpoint", transform: extractFieldFn("Port")},
+		properties.Public:           {name: "PubliclyAccessible", transform: extractValueFn},
+		properties.Encrypted:        {name: "Encrypted", transform: extractValueFn},
+	},
+	//Batch
+	cloud.ComputeEnvironment: {
+		properties.Name:         {name: "ComputeEnvironmentName", transform: extractValueFn},
+		properties.Arn:          {name: "ComputeEnvironmentArn", transform: extractValueFn},
+		properties.Type:         {name: "Type", transform: extractValueFn},
+		properties.State:        {name: "State", transform: extractValueFn},
+		properties.StateMessage: {name: "StatusReason", transform: extractValueFn},
+		properties.Role:         {name: "ServiceRole", transform: extractValueFn},
+		properties.Cluster:      {name: "EcsClusterArn", transform: extractValueFn},
+	},
+	cloud.JobQueue: {
+		properties.Name:         {name: "JobQueueName", transform: extractValueFn},
+		properties.Arn:          {name: "JobQueueArn", transform: extractValueFn},
+		properties.State:        {name: "State", transform: extractValueFn},
+		properties.StateMessage: {name: "StatusReason", transform: extractValueFn},
+	},
+	cloud.Job: {
+		properties.Name:         {name: "JobName", transform: extractValueFn},
+		properties.State:        {name: "Status", transform: extractValueFn},
+		properties.StateMessage: {name: "StatusReason", transform: extractValueFn},
+		properties.Created:      {name: "CreatedAt", transform: extractTimeFromMillisFn},
+		properties.Launched:     {name: "StartedAt", transform: extractTimeFromMillisFn},
+		properties.Stopped:      {name: "StoppedAt", transform: extractTimeFromMillisFn},
+		properties.ExitCode:     {name: "Container", transform: extractFieldFn("ExitCode")},
+	},
+	//Direct Connect
+	cloud.DxConnection: {
+		properties.Name:      {name: "ConnectionName", transform: extractValueFn},
+		properties.State:     {name: "ConnectionState", transform: extractValueFn},
+		properties.Bandwidth: {name: "Bandwidth", transform: extractValueFn},
+		properties.Location:  {name: "Location", transform: extractValueFn},
+		properties.VLAN:      {name: "Vlan", transform: extractValueFn},
+		properties.Owner:     {name: "OwnerAccount", transform: extractValueFn},
+	},
+	cloud.VirtualInterface: {
+		properties.Name:       {name: "VirtualInterfaceName", transform: extractValueFn},
+		properties.Type:       {name: "VirtualInterfaceType", transform: extractValueFn},
+		properties.State:      {name: "VirtualInterfaceState", transform: extractValueFn},
+		properties.Connection: {name: "ConnectionId", transform: extractValueFn},
+		properties.VpnGateway: {name: "VirtualGatewayId", transform: extractValueFn},
+		properties.ASN:        {name: "Asn", transform: extractValueAsStringFn},
+		properties.VLAN:       {name: "Vlan", transform: extractValueFn},
+		properties.Location:   {name: "Location", transform: extractValueFn},
+		properties.Owner:      {name: "OwnerAccount", transform: extractValueFn},
+	},
 	//Autoscaling
 	cloud.LaunchConfiguration: {
 		properties.Name:           {name: "LaunchConfigurationName", transform: extractValueFn},
@@ -325,6 +582,20 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.RunningTasksCount:                 {name: "RunningTasksCount", transform: extractValueFn},
 		properties.State:                             {name: "Status", transform: extractValueFn},
 	},
+	cloud.ContainerService: {
+		properties.Name:              {name: "ServiceName", transform: extractValueFn},
+		properties.Arn:               {name: "ServiceArn", transform: extractValueFn},
+		properties.Cluster:           {name: "ClusterArn", transform: extractValueFn},
+		properties.ContainerTask:     {name: "TaskDefinition", transform: extractValueFn},
+		properties.DesiredCount:      {name: "DesiredCount", transform: extractValueFn},
+		properties.RunningTasksCount: {name: "RunningCount", transform: extractValueFn},
+		properties.PendingTasksCount: {name: "PendingCount", transform: extractValueFn},
+		properties.LaunchType:        {name: "LaunchType", transform: extractValueFn},
+		properties.Role:              {name: "RoleArn", transform: extractValueFn},
+		properties.TargetGroups:      {name: "LoadBalancers", transform: extractStringSliceValues("TargetGroupArn")},
+		properties.State:             {name: "Status", transform: extractValueFn},
+		properties.Created:           {name: "CreatedAt", transform: extractValueFn},
+	},
 	cloud.ContainerTask: {
 		properties.Name:             {name: "Family", transform: extractValueFn},
 		properties.Arn:              {name: "TaskDefinitionArn", transform: extractValueFn},
@@ -400,6 +671,24 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 	cloud.MFADevice: {
 		properties.AttachedAt: {name: "EnableDate", transform: extractTimeFn},
 	},
+	cloud.Key: {
+		properties.Arn:         {name: "Arn", transform: extractValueFn},
+		properties.Description: {name: "Description", transform: extractValueFn},
+		properties.State:       {name: "KeyState", transform: extractValueFn},
+		properties.Enabled:     {name: "Enabled", transform: extractValueFn},
+		properties.Created:     {name: "CreationDate", transform: extractTimeFn},
+	},
+	cloud.Account: {
+		properties.Name:   {name: "Name", transform: extractValueFn},
+		properties.Arn:    {name: "Arn", transform: extractValueFn},
+		properties.Email:  {name: "Email", transform: extractValueFn},
+		properties.State:  {name: "Status", transform: extractValueFn},
+		properties.Joined: {name: "JoinedTimestamp", transform: extractTimeFn},
+	},
+	cloud.OrganizationalUnit: {
+		properties.Name: {name: "Name", transform: extractValueFn},
+		properties.Arn:  {name: "Arn", transform: extractValueFn},
+	},
 	//S3
 	cloud.Bucket: {
 		properties.Created: {name: "CreationDate", transform: extractTimeFn},
@@ -420,7 +709,8 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Topic:    {name: "TopicArn", transform: extractValueFn},
 	},
 	cloud.Topic: {
-		properties.Arn: {name: "TopicArn", transform: extractValueFn},
+		properties.Name: {name: "TopicArn", transform: extractArnResourceNameFn},
+		properties.Arn:  {name: "TopicArn", transform: extractValueFn},
 	},
 	// DNS
 	cloud.Zone: {
@@ -440,6 +730,7 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Region:                {name: "Region", transform: extractValueFn},
 		properties.Records:               {name: "ResourceRecords", transform: extractStringSliceValues("Value")},
 		properties.Alias:                 {name: "AliasTarget", transform: extractFieldFn("DNSName")},
+		properties.AliasZone:             {name: "AliasTarget", transform: extractFieldFn("HostedZoneId")},
 		properties.Set:                   {name: "SetIdentifier", transform: extractValueFn},
 		properties.TTL:                   {name: "TTL", transform: extractValueFn},
 		properties.TrafficPolicyInstance: {name: "TrafficPolicyInstanceId", transform: extractValueFn},
@@ -461,6 +752,19 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Timeout:     {name: "Timeout", transform: extractValueFn},
 		properties.Version:     {name: "Version", transform: extractValueFn},
 	},
+	cloud.StateMachine: {
+		properties.Name:    {name: "Name", transform: extractValueFn},
+		properties.Arn:     {name: "StateMachineArn", transform: extractValueFn},
+		properties.Created: {name: "CreationDate", transform: extractTimeFn},
+	},
+	cloud.Execution: {
+		properties.Name:         {name: "Name", transform: extractValueFn},
+		properties.Arn:          {name: "ExecutionArn", transform: extractValueFn},
+		properties.State:        {name: "Status", transform: extractValueFn},
+		properties.Launched:     {name: "StartDate", transform: extractTimeFn},
+		properties.Stopped:      {name: "StopDate", transform: extractTimeFn},
+		properties.StateMachine: {name: "StateMachineArn", transform: extractValueFn},
+	},
 	// Monitoring
 	cloud.Metric: {
 		properties.Name:       {name: "MetricName", transform: extractValueFn},
@@ -481,6 +785,25 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Updated:                 {name: "StateUpdatedTimestamp", transform: extractValueFn},
 		properties.State:                   {name: "StateValue", transform: extractValueFn},
 	},
+	cloud.LogGroup: {
+		properties.Name:      {name: "LogGroupName", transform: extractValueFn},
+		properties.Arn:       {name: "Arn", transform: extractValueFn},
+		properties.Created:   {name: "CreationTime", transform: extractTimeFromMillisFn},
+		properties.Retention: {name: "RetentionInDays", transform: extractValueFn},
+		properties.Size:      {name: "StoredBytes", transform: extractValueFn},
+	},
+	cloud.Trail: {
+		properties.Name:   {name: "Name", transform: extractValueFn},
+		properties.Arn:    {name: "TrailARN", transform: extractValueFn},
+		properties.Bucket: {name: "S3BucketName", transform: extractValueFn},
+		properties.Region: {name: "HomeRegion", transform: extractValueFn},
+		properties.Key:    {name: "KmsKeyId", transform: extractValueFn},
+	},
+	cloud.TrailEvent: {
+		properties.Name:     {name: "EventName", transform: extractValueFn},
+		properties.Username: {name: "Username", transform: extractValueFn},
+		properties.Created:  {name: "EventTime", transform: extractTimeFn},
+	},
 	// CDN
 	cloud.Distribution: {
 		properties.Arn:                {name: "ARN", transform: extractValueFn},
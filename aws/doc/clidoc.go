@@ -13,7 +13,7 @@ func AwlessCommandDefinitionsDoc(action, entity, fallbackDef string) string {
 }
 
 var commandDefinitionsDoc = map[string]string{
-	"copy.image": "Copy an EC2 image from given source region to current awless region",
+	"copy.image": "Copy an EC2 image from given source region to given region, both defaulting to current awless region",
 }
 
 func AwlessExamplesDoc(action, entity string) string {
@@ -36,10 +36,20 @@ func exampleDoc(key string) string {
 }
 
 var cliExamplesDoc = map[string][]string{
-	"attach.alarm":         {},
-	"attach.containertask": {},
+	"accept.peeringconnection": {
+		"awless accept peeringconnection id=pcx-1a2b3c4d",
+	},
+	"attach.alarm": {},
+	"attach.classicloadbalancer": {
+		"awless attach classicloadbalancer name=web instance=@web-1",
+	},
+	"attach.containertask": {
+		"awless attach containertask name=web-task container-name=nginx image=nginx memory-hard-limit=512 ports=80",
+		"awless attach containertask name=web-task container-name=nginx image=nginx memory-hard-limit=512 ports=80 launch-type=fargate cpu=256 memory=512",
+	},
 	"attach.elasticip": {
 		"awless attach elasticip id=eipalloc-1c517b26 instance=@redis",
+		"awless attach elasticip id=eipalloc-1c517b26 networkinterface=eni-1a2b3c4d privateip=10.0.0.42",
 	},
 	"attach.instance": {},
 	"attach.instanceprofile": {
@@ -52,6 +62,9 @@ var cliExamplesDoc = map[string][]string{
 		"awless attach listener certificate=@www.mysite.com id=arn:aws:elasticloadbalancing:.../00683da53db92e54",
 		"awless attach listener certificate=arn:aws:acm:...a7b691c218 id=arn:aws:elasticloadbalancing:.../00683da53db92e54",
 	},
+	"attach.networkacl": {
+		"awless attach networkacl id=acl-1a2b3c4d subnet=@my-subnet",
+	},
 	"attach.policy": {
 		"awless attach policy role=MyNewRole service=ec2 access=readonly",
 		"awless attach policy user=jsmith service=s3 access=readonly",
@@ -65,14 +78,38 @@ var cliExamplesDoc = map[string][]string{
 	"attach.securitygroup": {
 		"awless attach securitygroup id=sg-0714247d instance=@redis",
 	},
+	"attach.servicecontrolpolicy": {
+		"awless attach servicecontrolpolicy id=p-examplepolicyid111 target=ou-1234-abcd5678",
+	},
+	"attach.target": {
+		"awless attach target rule=nightly function=@backup",
+		"awless attach target rule=ec2-changes queue=@jobs input='{\"job\":\"inventory\"}'",
+	},
+	"attach.transitgatewayroutetable": {
+		"awless attach transitgatewayroutetable id=tgw-rtb-0123456789abcdef0 attachment=@spoke-1",
+	},
 	"attach.user": {
 		"awless attach user name=jsmith group=AdminGroup",
 	},
 	"attach.volume": {
 		"awless attach volume id=vol-123oefwejf device=/dev/sdh instance=@redis",
 	},
+	"attach.vpngateway": {
+		"awless attach vpngateway id=@my-vgw vpc=@my-vpc",
+	},
 	"authenticate.registry": {
 		"awless authenticate registry",
+		"awless authenticate registry no-docker-login=true",
+	},
+	"cancel.spotrequest": {
+		"awless cancel spotrequest id=sir-1a2b3c4d terminate-instances=true",
+		"awless cancel spotrequest id=sfr-1a2b3c4d-5e6f-7a8b-9c0d-1e2f3a4b5c6d",
+	},
+	"check.cachecluster": {
+		"awless check cachecluster id=my-cache state=available timeout=600",
+	},
+	"check.cluster": {
+		"awless check cluster id=my-warehouse state=available timeout=1800",
 	},
 	"check.database": {
 		"awless check database id=@mydb state=available timeout=180",
@@ -80,9 +117,16 @@ var cliExamplesDoc = map[string][]string{
 	"check.distribution": {
 		"awless check distribution id=@mydistr state=Deployed timeout=180",
 	},
+	"check.http": {
+		"awless check http url=https://myservice.example.com/health status=200 timeout=5m",
+		"awless check http url=http://lb:8080/health loadbalancer=@myloadb timeout=300",
+	},
 	"check.instance": {
 		"awless check instance id=@redis state=running timeout=180",
 	},
+	"check.kubernetescluster": {
+		"awless check kubernetescluster name=my-k8s state=active timeout=1800",
+	},
 	"check.loadbalancer": {
 		"awless check loadbalancer id=@myloadb state=active timeout=180",
 	},
@@ -95,21 +139,50 @@ var cliExamplesDoc = map[string][]string{
 	"check.securitygroup": {
 		"awless check securitygroup id=@mysshsecgroup state=unused timeout=180",
 	},
+	"check.tcp": {
+		"awless check tcp host=10.0.1.12 port=5432 timeout=5m",
+		"awless check tcp instance=@mydb port=5432 timeout=300",
+	},
+	"check.transitgateway": {
+		"awless check transitgateway id=tgw-0123456789abcdef0 state=available timeout=600",
+	},
+	"check.transitgatewayattachment": {
+		"awless check transitgatewayattachment id=tgw-attach-0123456789abcdef0 state=deleted timeout=600",
+	},
+	"check.transitgatewayroutetable": {
+		"awless check transitgatewayroutetable id=tgw-rtb-0123456789abcdef0 state=available timeout=300",
+	},
 	"check.volume": {
 		"awless check volume id=vol-12r1o3rp state=available timeout=180",
 	},
+	"check.vpnconnection": {
+		"awless check vpnconnection id=vpn-1a2b3c4d state=deleted timeout=180",
+	},
 	"copy.image": {
 		"awless copy image name=my-ami-name source-id=ami-23or2or source-region=us-west-2",
+		"awless copy image name=my-ami-name source-id=ami-23or2or region=eu-west-3 # Copy an AMI of the current region to eu-west-3",
+		"awless copy image name=my-ami-name source-id=ami-23or2or source-region=us-west-2 kms-key=alias/images",
 	},
 	"copy.snapshot": {
 		"awless copy snapshot source-id=efwqwdr2or source-region=us-west-2",
+		"awless copy snapshot source-id=snap-0123456789abcdef0 source-region=us-west-2 kms-key=alias/backups",
 	},
 	"create.accesskey": {
 		"awless create accesskey user=jsmith no-prompt=true",
 	},
+	"create.account": {
+		"awless create account email=ops@example.com name=production",
+		"awless create account email=audit@example.com name=audit billing-access=deny timeout=1200",
+	},
 	"create.alarm": {
 		" awless create alarm namespace=AWS/EC2 dimensions=AutoScalingGroupName:instancesScalingGroup evaluation-periods=2 metric=CPUUtilization name=scaleinAlarm operator=GreaterThanOrEqualToThreshold period=300 statistic-function=Average threshold=75",
 	},
+	"create.alias": {
+		"awless create alias name=backups key=1234abcd-12ab-34cd-56ef-1234567890ab",
+	},
+	"create.application": {
+		"awless create application name=my-app description='My web application'",
+	},
 	"create.appscalingpolicy": {
 		" awless create appscalingpolicy dimension=ecs:service:DesiredCount name=ScaleOutPolicy resource=service/my-ecs-cluster/my-service-deployment-name service-namespace=ecs stepscaling-adjustment-type=ChangeInCapacity stepscaling-adjustments=0::+1 type=StepScaling stepscaling-aggregation-type=Average stepscaling-cooldown=60",
 	},
@@ -119,29 +192,96 @@ var cliExamplesDoc = map[string][]string{
 	"create.bucket": {
 		"awless create bucket name=my-bucket-name acl=public-read",
 	},
+	"create.cachecluster": {
+		"awless create cachecluster engine=redis id=my-cache type=cache.t2.micro subnetgroup=@my-cachesubnetgroup securitygroups=@redis-sg",
+		"awless create cachecluster engine=memcached id=sessions type=cache.m4.large count=3 port=11211",
+	},
+	"create.cachesubnetgroup": {
+		"awless create cachesubnetgroup name=my-cachesubnetgroup description=\"subnets for cache\" subnets=[@my-firstsubnet, @my-secondsubnet]",
+	},
+	"create.catalogdatabase": {
+		"awless create catalogdatabase name=weblogs description='Access logs of the websites'",
+	},
+	"create.certificate": {
+		"awless create certificate domain=www.my.domain.com",
+		"awless create certificate domains=[my.domain.com,www.my.domain.com] validation=dns zone=@my.domain.com",
+		"awless create certificate domain=api.my.domain.com validation=dns",
+	},
+	"create.cluster": {
+		"awless create cluster id=my-warehouse type=dc2.large username=admin password=MyPassw0rd",
+		"awless create cluster id=my-warehouse type=dc2.large nodes=4 username=admin password=MyPassw0rd subnetgroup=@my-clustersubnets securitygroups=@warehouse-sg",
+	},
+	"create.classicloadbalancer": {
+		"awless create classicloadbalancer name=web subnets=[@public-1,@public-2] protocol=http port=80 instance-port=8080 healthcheck=HTTP:8080/health",
+		"awless create classicloadbalancer name=secure subnets=subnet-1 protocol=https port=443 instance-protocol=http instance-port=80 certificate=arn:aws:acm:us-east-1:0123456789:certificate/1234",
+	},
+	"create.computeenvironment": {
+		"awless create computeenvironment name=my-compute-env type=managed service-role=AWSBatchServiceRole instance-role=ecsInstanceRole instance-types=optimal max-vcpus=16 subnets=[@subnet-1,@subnet-2] securitygroups=@my-secgroup",
+		"awless create computeenvironment name=my-spot-env type=managed resource-type=spot bid-percentage=50 spotfleet-role=arn:aws:iam::0123456789:role/AmazonEC2SpotFleetRole service-role=AWSBatchServiceRole instance-role=ecsInstanceRole instance-types=[m4.large] max-vcpus=8 subnets=@subnet-1 securitygroups=@my-secgroup",
+	},
 	"create.containercluster": {
 		"awless create containercluster name=mycluster",
 	},
+	"create.containerservice": {
+		"awless create containerservice cluster=mycluster name=web containertask=web-task desired-count=2",
+		"awless create containerservice cluster=mycluster name=web containertask=web-task desired-count=2 launch-type=fargate subnets=[@private-subnet-1,@private-subnet-2] securitygroups=@web-sg",
+	},
+	"create.crawler": {
+		"awless create crawler name=weblogs-crawler role=AWSGlueServiceRole-weblogs database=weblogs targets=s3://my-bucket/logs/",
+		"awless create crawler name=weblogs-crawler role=AWSGlueServiceRole-weblogs database=weblogs targets=[s3://my-bucket/logs/,s3://my-bucket/events/] schedule='cron(0 2 * * ? *)'",
+	},
+	"create.customergateway": {
+		"awless create customergateway publicip=203.0.113.12 bgp-asn=65000 name=my-office",
+	},
 	"create.database": {
 		"awless create database engine=postgres id=mystartup-prod-db subnetgroup=@my-dbsubnetgroup password=notsafe dbname=mydb size=5 type=db.t2.small username=admin vpcsecuritygroups=@postgres_sg",
 	},
+	"create.dbparametergroup": {
+		"awless create dbparametergroup name=mydbparams family=postgres9.6 description=\"tuned postgres parameters\"",
+	},
 	"create.dbsubnetgroup": {
 		"awless create dbsubnetgroup name=mydbsubnetgroup description=\"subnets for peps db\" subnets=[@my-firstsubnet, @my-secondsubnet]",
 	},
+	"create.deployment": {
+		"awless create deployment restapi=a1b2c3d4e5 stage=prod",
+	},
 	"create.distribution": {
 		"awless create distribution origin-domain=mybucket.s3.amazonaws.com",
+		"awless create distribution origin=mybucket default-file=index.html cache-behaviors=[/images/*:86400]",
+		"awless create distribution origin=@my-loadbalancer https-behaviour=redirect-to-https",
+	},
+	"create.egressonlyinternetgateway": {
+		"awless create egressonlyinternetgateway vpc=@my-vpc",
 	},
 	"create.elasticip": {
 		"awless create elasticip domain=vpc",
 	},
-	"create.function": {},
+	"create.environment": {
+		"awless create environment application=my-app name=my-app-prod solution-stack='64bit Amazon Linux 2017.09 v2.8.4 running Docker 17.09.1-ce' cname=my-app",
+		"awless create environment application=my-app name=my-app-prod solution-stack='64bit Amazon Linux 2017.09 v2.8.4 running Docker 17.09.1-ce' version=v1 options=['aws:autoscaling:asg:MinSize=2','aws:elasticbeanstalk:application:environment:STAGE=prod']",
+	},
+	"create.function": {
+		"awless create function name=my-function handler=index.handler runtime=nodejs6.10 role=@lambda-role zipfile=./function.zip",
+		"awless create function name=my-function handler=main.handler runtime=python3.6 role=@lambda-role bucket=my-bucket object=function.zip environment=[STAGE:prod,DEBUG:false]",
+	},
+	"create.grant": {
+		"awless create grant key=1234abcd-12ab-34cd-56ef-1234567890ab grantee=@backup-role operations=Encrypt,Decrypt,GenerateDataKey",
+	},
 	"create.group": {
 		"awless create name=admins",
 	},
+	"create.host": {
+		"awless create host type=m4.large availabilityzone=eu-west-1a auto-placement=off",
+		"awless create instance type=m4.large host=h-0123456789abcdef0",
+	},
+	"create.hostreservation": {
+		"awless create hostreservation offering=hro-0123456789abcdef0 hosts=h-0123456789abcdef0 limit-price=1000",
+	},
 	"create.image": {
 		"awless create image instance=@my-instance-name name=redis-image description='redis prod image'",
 		"awless create image instance=i-0ee436a45561c04df name=redis-image reboot=true",
 		"awless create image instance=@redis-prod name=redis-prod-image",
+		"awless create image snapshot=snap-0123456789abcdef0 name=restored-image",
 	},
 	"create.instance": {
 		"awless create image=ami-123456 # Start to create instance from specific image",
@@ -155,127 +295,559 @@ var cliExamplesDoc = map[string][]string{
 		"awless create instance distro=debian:debian:jessie lock=true",
 		"awless create instance distro=amazonlinux securitygroup=@my-ssh-secgroup",
 		"awless create instance distro=amazonlinux:::::instance-store",
+		"", // create empty line for clarity
+		"awless create instance distro=amazonlinux type=t2.micro userdata=file(./boot.sh) role=arn:aws:iam::123456789012:instance-profile/web",
+		"awless create instance distro=amazonlinux type=m4.large block-devices=[/dev/xvda:30,/dev/sdb:500:io1:5000] tags=[env:prod,team:web]",
+	},
+	"create.instanceprofile": {},
+	"create.internetgateway": {},
+	"create.invalidation": {
+		"awless create invalidation distribution=@mydistr path=/*",
+		"awless create invalidation distribution=@mydistr path=[/index.html,/css/*]",
+	},
+	"create.jobqueue": {
+		"awless create jobqueue name=my-queue computeenvironments=my-compute-env",
+		"awless create jobqueue name=high-priority priority=10 computeenvironments=[my-compute-env,my-spot-env]",
+	},
+	"create.key": {
+		"awless create key description='Encryption of the backups'",
+	},
+	"create.keypair": {
+		"awless create keypair name=jsmith",
+		"awless create keypair name=jsmith type=ed25519",
+		"awless create keypair name=jsmith import=~/.ssh/id_ed25519.pub",
+	},
+	"create.kubernetescluster": {
+		"awless create kubernetescluster name=my-k8s role=eks-service-role subnets=[@private-1,@private-2] securitygroups=@k8s-control-plane",
+		"awless create kubernetescluster name=my-k8s role=arn:aws:iam::0123456789:role/eks-service-role subnets=[subnet-1234,subnet-5678] version='1.10'",
 	},
-	"create.instanceprofile":     {},
-	"create.internetgateway":     {},
-	"create.keypair":             {},
 	"create.launchconfiguration": {},
 	"create.listener":            {},
 	"create.loadbalancer":        {},
 	"create.loginprofile":        {},
-	"create.natgateway":          {},
-	"create.policy":              {},
-	"create.queue":               {},
-	"create.record":              {},
-	"create.repository":          {},
-	"create.role":                {},
-	"create.route":               {},
-	"create.routetable":          {},
-	"create.s3object":            {},
-	"create.scalinggroup":        {},
-	"create.scalingpolicy":       {},
+	"create.loggroup": {
+		"awless create loggroup name=my-app/access-logs retention=30",
+	},
+	"create.method": {
+		"awless create method restapi=a1b2c3d4e5 resource=f6g7h8 http-method=GET function=arn:aws:lambda:us-west-2:123456789012:function:hello",
+	},
+	"create.natgateway": {
+		"awless create natgateway subnet=@my-public-subnet",
+		"awless create natgateway subnet=subnet-1a2b3c4d elasticip-id=eipalloc-1a2b3c4d",
+	},
+	"create.networkacl": {
+		"awless create networkacl vpc=@my-vpc name=my-acl",
+	},
+	"create.networkaclrule": {
+		"awless create networkaclrule networkacl=acl-1a2b3c4d number=100 action=allow protocol=tcp portrange=443 cidr=0.0.0.0/0",
+		"awless create networkaclrule networkacl=acl-1a2b3c4d number=200 action=deny protocol=any cidr=203.0.113.0/24 outbound=true",
+	},
+	"create.networkinterface": {
+		"awless create networkinterface subnet=@my-subnet securitygroups=[@web,@ssh] description=web-secondary",
+		"awless create networkinterface subnet=@my-subnet privateip=10.0.0.10 secondary-privateips=[10.0.0.11,10.0.0.12]",
+		"awless create networkinterface subnet=@my-subnet secondary-count=2",
+	},
+	"create.parameter": {
+		"awless create parameter name=/my-app/db-password value=s3cr3t secure=true",
+		"awless create parameter name=/my-app/db-host value=db.internal description='Database host'",
+	},
+	"create.peeringconnection": {
+		"awless create peeringconnection vpc=@my-vpc peer-vpc=@other-vpc name=my-peering",
+		"awless create peeringconnection vpc=vpc-1a2b3c4d peer-vpc=vpc-2b3c4d5e peer-owner=123456789012 peer-region=us-east-1",
+	},
+	"create.placementgroup": {
+		"awless create placementgroup name=my-hpc-cluster strategy=cluster",
+		"awless create instance distro=amazonlinux type=c4.8xlarge placementgroup=my-hpc-cluster",
+	},
+	"create.policy": {
+		"awless create policy name=ec2-readonly effect=Allow action=ec2:Describe* resource=all",
+		"awless create policy name=s3-logs document-file=./s3-logs-policy.json description='Write access to the logs bucket'",
+	},
+	"create.policyversion": {
+		"awless create policyversion arn=arn:aws:iam::123456789012:policy/s3-logs document-file=./s3-logs-policy.json default=true",
+	},
+	"create.presignedurl": {
+		"awless create presignedurl bucket=my-reports key=2017/report.csv expiry=1h",
+		"awless create presignedurl bucket=my-uploads key=incoming/data.json method=put expiry=15m",
+	},
+	"create.queue": {
+		"awless create queue name=jobs visibility-timeout=120",
+		"awless create queue name=jobs.fifo fifo=true content-deduplication=true",
+		"awless create queue name=jobs dead-letter-queue=jobs-failed max-receive-count=3",
+	},
+	"create.record": {
+		"awless create record zone=@my.domain.com name=api.my.domain.com type=A value=10.0.1.15 ttl=300",
+		"awless create record zone=@my.domain.com name=www.my.domain.com type=A alias=my-loadbalancer",
+		"awless create record zone=@my.domain.com name=cdn.my.domain.com type=A alias=d111111abcdef8.cloudfront.net",
+	},
+	"create.repository": {},
+	"create.resource": {
+		"awless create resource restapi=a1b2c3d4e5 path=greetings",
+		"awless create resource restapi=a1b2c3d4e5 parent=f6g7h8 path='{name}'",
+	},
+	"create.restapi": {
+		"awless create restapi name=hello description='Greetings API'",
+	},
+	"create.role":       {},
+	"create.route":      {},
+	"create.routetable": {},
+	"create.rule": {
+		"awless create rule name=nightly schedule='cron(0 2 * * ? *)'",
+		"awless create rule name=ec2-changes pattern='{\"source\":[\"aws.ec2\"],\"detail-type\":[\"EC2 Instance State-change Notification\"]}'",
+	},
+	"create.s3object": {
+		"awless create s3object bucket=my-bucket file=./backup.tar.gz connections=4",
+		"awless create s3object bucket=my-website file=./public acl=public-read sync=true",
+	},
+	"create.scalinggroup":  {},
+	"create.scalingpolicy": {},
+	"create.secret": {
+		"awless create secret name=my-app/db-password value=s3cr3t description='Database password'",
+	},
 	"create.securitygroup": {
 		"awless create securitygroup vpc=@myvpc name=ssh-only description=ssh-access",
 		"(... see more params at `awless update securitygroup -h`)",
 	},
-	"create.snapshot":            {},
-	"create.stack":               {},
-	"create.subnet":              {},
-	"create.subscription":        {},
-	"create.tag":                 {},
-	"create.targetgroup":         {},
-	"create.topic":               {},
-	"create.user":                {},
-	"create.volume":              {},
-	"create.vpc":                 {},
-	"create.zone":                {},
-	"delete.accesskey":           {},
-	"delete.alarm":               {},
-	"delete.appscalingpolicy":    {},
-	"delete.appscalingtarget":    {},
-	"delete.bucket":              {},
-	"delete.containercluster":    {},
-	"delete.containertask":       {},
-	"delete.database":            {},
-	"delete.dbsubnetgroup":       {},
-	"delete.distribution":        {},
-	"delete.elasticip":           {},
-	"delete.function":            {},
-	"delete.group":               {},
-	"delete.image":               {},
-	"delete.instance":            {},
-	"delete.instanceprofile":     {},
-	"delete.internetgateway":     {},
-	"delete.keypair":             {},
+	"create.snapshot": {},
+	"create.spotfleet": {
+		"awless create spotfleet capacity=10 fleet-role=arn:aws:iam::0123456789:role/aws-ec2-spot-fleet-tagging-role image=@redis types=[m4.large,c4.large,r4.large] subnets=[@sub-a,@sub-b] strategy=diversified",
+		"awless create spotfleet capacity=4 fleet-role=arn:aws:iam::0123456789:role/aws-ec2-spot-fleet-tagging-role image=ami-123456 types=c5.xlarge price=0.1 persistent=true interruption=stop",
+	},
+	"create.spotinstance": {
+		"awless create spotinstance image=@redis type=m4.large subnet=@my-subnet price=0.05 name=redis-spot",
+		"awless create spotinstance image=ami-123456 type=t2.micro subnet=@my-subnet persistent=true interruption=hibernate",
+	},
+	"create.stack": {
+		"awless create stack name=mystack template-file=./mystack.yml parameters=[InstanceType:t2.micro,KeyName:mykey]",
+		"awless create stack name=mystack template-url=https://s3.amazonaws.com/mybucket/mystack.yml capabilities=CAPABILITY_IAM",
+	},
+	"create.stage": {
+		"awless create stage restapi=a1b2c3d4e5 name=staging deployment=i9j0k1",
+	},
+	"create.statemachine": {
+		"awless create statemachine name=order-workflow definition=file(./definition.json) role=stepfunctions-exec-role",
+	},
+	"create.subnet": {},
+	"create.subscription": {
+		"awless create subscription topic=arn:aws:sns:eu-west-1:0123456789:alerts protocol=email endpoint=ops@example.com",
+		"awless create subscription topic=@alerts protocol=sqs endpoint=arn:aws:sqs:eu-west-1:0123456789:jobs",
+	},
+	"create.table": {
+		"awless create table name=users hash-key=id",
+		"awless create table name=events hash-key=source range-key=timestamp range-key-type=number read-capacity=10 write-capacity=5",
+	},
+	"create.tag":         {},
+	"create.targetgroup": {},
+	"create.topic": {
+		"awless create topic name=alerts",
+	},
+	"create.trail": {
+		"awless create trail name=audit bucket=my-audit-logs multiregion=true",
+	},
+	"create.transitgateway": {
+		"awless create transitgateway name=hub",
+		"awless create transitgateway name=hub amazon-asn=64600 default-association=disable default-propagation=disable",
+	},
+	"create.transitgatewayattachment": {
+		"awless create transitgatewayattachment name=spoke-1 transitgateway=@hub vpc=@spoke-1 subnets=[@spoke-1-a,@spoke-1-b]",
+	},
+	"create.transitgatewayroutetable": {
+		"awless create transitgatewayroutetable name=spokes transitgateway=@hub",
+	},
+	"create.user": {},
+	"create.volume": {
+		"awless create volume availabilityzone=eu-west-1a size=20 type=gp2",
+		"awless create volume availabilityzone=eu-west-1a size=100 type=io1 iops=2000 kms-key=alias/volumes",
+	},
+	"create.vpc": {},
+	"create.vpcendpoint": {
+		"awless create vpcendpoint vpc=@my-vpc service=com.amazonaws.eu-west-1.s3 routetables=[@my-routetable]",
+		"awless create vpcendpoint vpc=@my-vpc service=com.amazonaws.eu-west-1.ssm type=interface subnets=[@sub-a,@sub-b] securitygroups=@my-sg private-dns=true",
+	},
+	"create.vpnconnection": {
+		"awless create vpnconnection customergateway=@my-office vpngateway=@my-vgw config-file=./my-office-vpn.txt",
+		"awless create vpnconnection customergateway=cgw-1a2b3c4d vpngateway=vgw-1a2b3c4d static-routes-only=true name=my-vpn",
+	},
+	"create.vpngateway": {
+		"awless create vpngateway name=my-vgw",
+		"awless create vpngateway amazon-asn=64512 availabilityzone=us-west-2a",
+	},
+	"create.zone":      {},
+	"delete.accesskey": {},
+	"delete.alarm":     {},
+	"delete.alias": {
+		"awless delete alias name=backups",
+	},
+	"delete.application": {
+		"awless delete application name=my-app force=true",
+	},
+	"delete.appscalingpolicy": {},
+	"delete.appscalingtarget": {},
+	"delete.bucket":           {},
+	"delete.cachecluster":     {},
+	"delete.cachesubnetgroup": {},
+	"delete.catalogdatabase": {
+		"awless delete catalogdatabase name=weblogs",
+	},
+	"delete.certificate": {
+		"awless delete certificate arn=arn:aws:acm:us-east-1:0123456789:certificate/1234",
+	},
+	"delete.classicloadbalancer": {},
+	"delete.cluster": {
+		"awless delete cluster id=my-warehouse snapshot=my-warehouse-final",
+		"awless delete cluster id=my-warehouse skip-snapshot=true",
+	},
+	"delete.computeenvironment": {
+		"awless delete computeenvironment id=my-compute-env",
+	},
+	"delete.containercluster": {},
+	"delete.containerservice": {
+		"awless delete containerservice cluster=mycluster name=web",
+	},
+	"delete.containertask": {},
+	"delete.customergateway": {
+		"awless delete customergateway id=cgw-1a2b3c4d",
+	},
+	"delete.database":         {},
+	"delete.dbparametergroup": {},
+	"delete.dbsubnetgroup":    {},
+	"delete.crawler": {
+		"awless delete crawler name=weblogs-crawler",
+	},
+	"delete.deployment": {
+		"awless delete deployment restapi=a1b2c3d4e5 id=i9j0k1",
+	},
+	"delete.distribution": {},
+	"delete.egressonlyinternetgateway": {
+		"awless delete egressonlyinternetgateway id=eigw-0a1b2c3d4e5f67890",
+	},
+	"delete.elasticip": {},
+	"delete.function":  {},
+	"delete.grant": {
+		"awless delete grant id=0c237476b39f8bc44e45212e08498fbe3151305030726c0590dd8d3e9f3d6a60 key=1234abcd-12ab-34cd-56ef-1234567890ab",
+	},
+	"delete.group": {},
+	"delete.host": {
+		"awless delete host id=h-0123456789abcdef0",
+	},
+	"delete.image": {
+		"awless delete image id=ami-23or2or delete-snapshots=true",
+		"awless delete image id=ami-23or2or region=eu-west-3",
+	},
+	"delete.instance":        {},
+	"delete.instanceprofile": {},
+	"delete.internetgateway": {},
+	"delete.jobdefinition": {
+		"awless delete jobdefinition id=my-job-definition:1",
+	},
+	"delete.jobqueue": {
+		"awless delete jobqueue id=my-queue",
+	},
+	"delete.key": {
+		"awless delete key id=1234abcd-12ab-34cd-56ef-1234567890ab",
+		"awless delete key id=@backups pending-days=7",
+	},
+	"delete.keypair": {},
+	"delete.kubernetescluster": {
+		"awless delete kubernetescluster name=my-k8s",
+	},
 	"delete.launchconfiguration": {},
 	"delete.listener":            {},
 	"delete.loadbalancer":        {},
 	"delete.loginprofile":        {},
-	"delete.natgateway":          {},
-	"delete.policy":              {},
-	"delete.queue":               {},
-	"delete.record":              {},
-	"delete.repository":          {},
-	"delete.role":                {},
-	"delete.route":               {},
-	"delete.routetable":          {},
-	"delete.s3object":            {},
-	"delete.scalinggroup":        {},
-	"delete.scalingpolicy":       {},
-	"delete.securitygroup":       {},
-	"delete.snapshot":            {},
-	"delete.stack":               {},
-	"delete.subnet":              {},
-	"delete.subscription":        {},
-	"delete.tag":                 {},
-	"delete.targetgroup":         {},
-	"delete.topic":               {},
+	"delete.loggroup": {
+		"awless delete loggroup name=my-app/access-logs",
+	},
+	"delete.method": {
+		"awless delete method restapi=a1b2c3d4e5 resource=f6g7h8 http-method=GET",
+	},
+	"delete.natgateway": {
+		"awless delete natgateway id=nat-1a2b3c4d",
+		"awless delete natgateway id=nat-1a2b3c4d release-elasticip=true",
+	},
+	"delete.networkacl": {
+		"awless delete networkacl id=acl-1a2b3c4d",
+	},
+	"delete.networkaclrule": {
+		"awless delete networkaclrule networkacl=acl-1a2b3c4d number=100",
+		"awless delete networkaclrule networkacl=acl-1a2b3c4d number=200 outbound=true",
+	},
+	"delete.parameter": {
+		"awless delete parameter name=/my-app/db-password",
+	},
+	"delete.peeringconnection": {
+		"awless delete peeringconnection id=pcx-1a2b3c4d",
+	},
+	"delete.placementgroup": {
+		"awless delete placementgroup name=my-hpc-cluster",
+	},
+	"delete.policy": {},
+	"delete.policyversion": {
+		"awless delete policyversion arn=arn:aws:iam::123456789012:policy/s3-logs version=v2",
+	},
+	"delete.queue":      {},
+	"delete.record":     {},
+	"delete.repository": {},
+	"delete.resource": {
+		"awless delete resource restapi=a1b2c3d4e5 id=f6g7h8",
+	},
+	"delete.restapi": {
+		"awless delete restapi id=a1b2c3d4e5",
+	},
+	"delete.role":          {},
+	"delete.route":         {},
+	"delete.routetable":    {},
+	"delete.rule":          {},
+	"delete.s3object":      {},
+	"delete.scalinggroup":  {},
+	"delete.scalingpolicy": {},
+	"delete.secret": {
+		"awless delete secret name=my-app/db-password recovery-window=7",
+	},
+	"delete.securitygroup": {},
+	"delete.snapshot":      {},
+	"delete.stack": {
+		"awless delete stack name=mystack",
+	},
+	"delete.stage": {
+		"awless delete stage restapi=a1b2c3d4e5 name=staging",
+	},
+	"delete.statemachine": {
+		"awless delete statemachine id=arn:aws:states:us-east-1:0123456789:stateMachine:order-workflow",
+	},
+	"delete.subnet":       {},
+	"delete.subscription": {},
+	"delete.table":        {},
+	"delete.tag":          {},
+	"delete.targetgroup":  {},
+	"delete.topic":        {},
+	"delete.trail": {
+		"awless delete trail id=arn:aws:cloudtrail:us-east-1:0123456789:trail/audit",
+	},
+	"delete.transitgateway": {
+		"awless delete transitgateway id=@hub",
+	},
+	"delete.transitgatewayattachment": {
+		"awless delete transitgatewayattachment id=@spoke-1",
+	},
+	"delete.transitgatewayroutetable": {
+		"awless delete transitgatewayroutetable id=@spokes",
+	},
 	"delete.user": {
 		"awless delete user name=john",
 	},
-	"delete.volume":          {},
-	"delete.vpc":             {},
-	"delete.zone":            {},
-	"detach.alarm":           {},
-	"detach.containertask":   {},
-	"detach.elasticip":       {},
+	"delete.volume": {},
+	"delete.vpc":    {},
+	"delete.vpcendpoint": {
+		"awless delete vpcendpoint id=vpce-1a2b3c4d",
+	},
+	"delete.vpnconnection": {
+		"awless delete vpnconnection id=vpn-1a2b3c4d",
+	},
+	"delete.vpngateway": {
+		"awless delete vpngateway id=vgw-1a2b3c4d",
+	},
+	"delete.zone":  {},
+	"detach.alarm": {},
+	"detach.classicloadbalancer": {
+		"awless detach classicloadbalancer name=web instance=@web-1",
+	},
+	"detach.containertask": {},
+	"detach.elasticip": {
+		"awless detach elasticip id=eipalloc-1c517b26",
+		"awless detach elasticip association=eipassoc-2f3a4b5c",
+	},
 	"detach.instance":        {},
 	"detach.instanceprofile": {},
 	"detach.internetgateway": {},
-	"detach.policy":          {},
-	"detach.role":            {},
-	"detach.routetable":      {},
-	"detach.securitygroup":   {},
-	"detach.user":            {},
-	"detach.volume":          {},
-	"import.image":           {},
-	"start.alarm":            {},
-	"start.containertask":    {},
-	"start.instance":         {},
-	"stop.alarm":             {},
-	"stop.containertask":     {},
-	"stop.instance":          {},
-	"update.bucket":          {},
-	"update.containertask":   {},
-	"update.distribution":    {},
-	"update.instance":        {},
+	"detach.networkacl": {
+		"awless detach networkacl subnet=@my-subnet",
+	},
+	"detach.policy":               {},
+	"detach.role":                 {},
+	"detach.routetable":           {},
+	"detach.securitygroup":        {},
+	"detach.servicecontrolpolicy": {},
+	"detach.target":               {},
+	"detach.transitgatewayroutetable": {
+		"awless detach transitgatewayroutetable id=@spokes attachment=@spoke-1",
+	},
+	"detach.user":   {},
+	"detach.volume": {},
+	"detach.vpngateway": {
+		"awless detach vpngateway id=vgw-1a2b3c4d vpc=vpc-1a2b3c4d",
+	},
+	"disable.key": {
+		"awless disable key id=@backups",
+	},
+	"download.s3object": {
+		"awless download s3object bucket=my-reports name=2017/report.csv",
+		"awless download s3object bucket=my-reports name=2017/report.csv file=./reports/ version=3HL4kqtJlcpXroDTDmJ",
+	},
+	"enable.key": {
+		"awless enable key id=1234abcd-12ab-34cd-56ef-1234567890ab",
+	},
+	"get.consoleoutput": {
+		"awless get consoleoutput instance=@redis-prod",
+		"awless get consoleoutput instance=i-0ee436a45561c04df file=./console.log",
+	},
+	"get.screenshot": {
+		"awless get screenshot instance=@windows-bastion wake-up=true",
+	},
+	"import.image": {},
+	"move.account": {
+		"awless move account id=111111111111 destination=ou-1234-abcd5678",
+	},
+	"invoke.function": {
+		"awless invoke function id=my-function payload='{\"name\": \"awless\"}'",
+		"awless invoke function id=my-function async=true",
+	},
+	"reboot.instance": {
+		"awless reboot instance id=@redis-prod",
+	},
+	"register.jobdefinition": {
+		"awless register jobdefinition name=my-job-definition image=busybox vcpus=1 memory=128 command=[echo,Ref::message] parameters=message:hello",
+	},
+	"resize.cluster": {
+		"awless resize cluster id=my-warehouse nodes=8",
+		"awless resize cluster id=my-warehouse type=dc2.8xlarge nodes=2",
+	},
+	"restore.database": {
+		"awless restore database snapshot=rds:mydb-2018-01-10-00-05 name=mydb-restored",
+		"awless restore database snapshot=mydb-final name=mydb subnetgroup=@my-dbsubnets type=db.t2.small",
+	},
+	"restore.volume": {
+		"awless restore volume snapshot=snap-0123456789abcdef0 availabilityzone=eu-west-1a",
+		"awless restore volume snapshot=@my-backup availabilityzone=eu-west-1a type=gp2 size=20",
+		"awless restore volume snapshot=snap-0123456789abcdef0 availabilityzone=eu-west-1a encrypted=true",
+	},
+	"run.command": {
+		"awless run command instance=@web-1 script=file(./install-nginx.sh)",
+		"awless run command instance=i-0ee436a45561c04df script='df -h' comment='disk usage'",
+	},
+	"start.alarm": {},
+	"start.containertask": {
+		"awless start containertask cluster=mycluster name=batch-task type=task desired-count=1 launch-type=fargate subnets=@private-subnet public-ip=false",
+	},
+	"start.execution": {
+		"awless start execution statemachine=arn:aws:states:us-east-1:0123456789:stateMachine:order-workflow input=file(./order.json)",
+	},
+	"start.instance": {},
+	"start.query": {
+		"awless start query database=weblogs query='SELECT status, count(*) FROM access GROUP BY status' output=s3://my-bucket/athena-results/",
+		"awless start query query=file(./report.sql) output=s3://my-bucket/athena-results/ timeout=1h",
+	},
+	"stop.alarm":         {},
+	"stop.containertask": {},
+	"stop.execution": {
+		"awless stop execution id=arn:aws:states:us-east-1:0123456789:execution:order-workflow:run-1 cause='Order cancelled'",
+	},
+	"stop.instance": {},
+	"submit.job": {
+		"awless submit job name=my-job queue=my-queue definition=my-job-definition",
+		"awless submit job name=my-job queue=my-queue definition=my-job-definition:2 parameters=message:bye",
+	},
+	"terminate.environment": {
+		"awless terminate environment id=e-abcd1234",
+	},
+	"update.bucket": {
+		"awless update bucket name=my-bucket versioning=enabled encryption=aes256",
+		"awless update bucket name=my-website public-website=true error-document=404.html",
+		"awless update bucket name=my-bucket lifecycle='{\"Rules\":[{\"Status\":\"Enabled\",\"Filter\":{\"Prefix\":\"logs/\"},\"Expiration\":{\"Days\":30}}]}'",
+		"awless update bucket name=my-bucket policy=none cors=none",
+	},
+	"update.containerservice": {
+		"awless update containerservice cluster=mycluster name=web desired-count=4",
+		"awless update containerservice cluster=mycluster name=web containertask=web-task:2",
+	},
+	"update.containertask": {},
+	"update.distribution": {
+		"awless update distribution id=@mydistr origin=@my-loadbalancer",
+	},
+	"update.environment": {
+		"awless update environment id=e-abcd1234 version=v2",
+		"awless update environment id=e-abcd1234 options='aws:autoscaling:asg:MaxSize=8'",
+	},
+	"update.function": {
+		"awless update function id=my-function zipfile=./function.zip publish=true",
+		"awless update function id=my-function memory=256 timeout=30 environment=[STAGE:staging]",
+	},
+	"update.instance": {
+		"awless update instance id=@redis-prod type=m4.large # stops the running instance, changes its type then starts it",
+		"awless update instance id=@redis-prod lock=true",
+	},
+	"update.loggroup": {
+		"awless update loggroup name=my-app/access-logs retention=90",
+		"awless update loggroup name=my-app/access-logs retention=0 # Log events never expire",
+	},
 	"update.image": {
 		"awless update image id=@my-image description=new-description",
 		"awless update image id=ami-bd6bb2c5 groups=all operation=add # Make an AMI public",
 		"awless update image id=ami-bd6bb2c5 groups=all operation=remove # Make an AMI private",
-		"awless update image id=@my-image accounts=3456728198326 operation=add # Grants launch permission to an AWS account",
+		"awless update image id=@my-image account=3456728198326 operation=add # Grants launch permission to an AWS account",
 		"awless update image id=@my-image accounts=[3456728198326,546371829387] operation=remove  # Remove launch permission to multiple AWS accounts",
 	},
 	"update.loginprofile": {},
-	"update.policy":       {},
-	"update.record":       {},
+	"update.networkaclrule": {
+		"awless update networkaclrule networkacl=acl-1a2b3c4d number=100 action=allow protocol=tcp portrange=22 cidr=10.0.0.0/8",
+	},
+	"update.networkinterface": {
+		"awless update networkinterface id=eni-1a2b3c4d securitygroups=[@web,@ssh]",
+		"awless update networkinterface id=eni-1a2b3c4d source-dest-check=false",
+	},
+	"update.parameter": {
+		"awless update parameter name=/my-app/db-password value=n3ws3cr3t",
+	},
+	"update.policy": {
+		"awless update policy arn=arn:aws:iam::123456789012:policy/s3-logs effect=Allow action=s3:PutObject resource=arn:aws:s3:::logs/*",
+		"awless update policy arn=arn:aws:iam::123456789012:policy/s3-logs document-file=./s3-logs-policy.json",
+		"awless update policy arn=arn:aws:iam::123456789012:policy/s3-logs default-version=v1",
+	},
+	"update.record": {},
+	"update.repository": {
+		"awless update repository name=my-repo policy-file=/path/to/repository-policy.json",
+	},
 	"update.s3object":     {},
 	"update.scalinggroup": {},
+	"update.secret": {
+		"awless update secret name=my-app/db-password value=ssm(/my-app/db-password)",
+	},
 	"update.securitygroup": {
 		"awless update securitygroup id=@ssh-only inbound=authorize protocol=tcp cidr=0.0.0.0/0 portrange=26257",
 		"awless update securitygroup id=@ssh-only inbound=authorize protocol=tcp securitygroup=sg-123457 portrange=8080",
+		"awless update securitygroup id=@web inbound=authorize protocol=tcp cidr=::/0 portrange=443",
+		"awless update securitygroup id=@db inbound=authorize protocol=tcp source=sg:front-stack/web portrange=5432",
+		"awless update securitygroup id=@web inbound-rules=[tcp:443:0.0.0.0/0,tcp:22:10.0.0.0/8] outbound-rules=[any:any:0.0.0.0/0]",
+	},
+	"update.stack": {
+		"awless update stack name=mystack template-url=https://s3.amazonaws.com/mybucket/mystack.yml",
+		"awless update stack name=mystack use-previous-template=true parameters=[InstanceType:t2.small]",
+	},
+	"update.statemachine": {
+		"awless update statemachine id=arn:aws:states:us-east-1:0123456789:stateMachine:order-workflow definition=file(./definition.json)",
+	},
+	"update.subnet": {
+		"awless update subnet id=@my-subnet public=true",
+		"awless update subnet id=@my-subnet ipv6=2600:1f18:22b3:7a00::/64 assign-ipv6=true",
+	},
+	"update.table": {
+		"awless update table name=users read-capacity=20 write-capacity=10",
+		"awless update table name=sessions ttl-attribute=expires ttl-enabled=true",
 	},
-	"update.stack":       {},
-	"update.subnet":      {},
 	"update.targetgroup": {},
+	"update.vpc": {
+		"awless update vpc id=@my-vpc ipv6=auto",
+	},
+	"wait.certificate": {
+		"awless wait certificate arn=arn:aws:acm:us-east-1:0123456789:certificate/1234 state=ISSUED",
+		"awless wait certificate arn=arn:aws:acm:us-east-1:0123456789:certificate/1234 timeout=1h",
+	},
+	"wait.distribution": {
+		"awless wait distribution id=@mydistr",
+		"awless wait distribution id=@mydistr timeout=45m",
+	},
+	"wait.kubernetescluster": {
+		"awless wait kubernetescluster name=my-k8s",
+		"awless wait kubernetescluster name=my-k8s state=ACTIVE timeout=45m",
+	},
 }
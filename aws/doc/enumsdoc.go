@@ -1,6 +1,7 @@
 package awsdoc
 
 import (
+	"github.com/wallix/awless/aws/config"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/cloud/properties"
 )
@@ -9,10 +10,11 @@ var (
 	timeouts      = []string{"10", "60", "180", "300", "600", "900"}
 	boolean       = []string{"true", "false"}
 	services      = []string{"iam", "ec2", "s3", "route53", "elbv2", "rds", "autoscaling", "lambda", "sns", "sqs", "cloudwatch", "cloudfront", "ecr", "ecs", "applicationautoscaling", "acm", "sts", "cloudformation"}
-	instanceTypes = []string{"t2.nano", "t2.micro", "t2.small", "t2.medium", "t2.large", "t2.xlarge", "t2.2xlarge", "m4.large", "m4.xlarge", "c4.large", "c4.xlarge"}
+	instanceTypes = awsconfig.LoadInstanceTypes("").Names()
 	s3ACLs        = []string{"private", "public-read", "public-read-write", "aws-exec-read", "authenticated-read", "bucket-owner-read", "bucket-owner-full-control", "log-delivery-write"}
 	distros       = []string{"amazonlinux", "canonical:ubuntu", "redhat:rhel", "debian:debian", "centos:centos", "suselinux", "windows:server"}
-	regions       = []string{"us-east-1", "us-east-2", "us-west-1", "us-west-2", "eu-west-1", "eu-west-2", "eu-west-3", "eu-central-1", "ca-central-1", "ap-northeast-1", "ap-northeast-2", "ap-southeast-1", "ap-southeast-2", "ap-south-1", "sa-east-1"}
+	regions       = []string{"us-east-1", "us-east-2", "us-west-1", "us-west-2", "eu-west-1", "eu-west-2", "eu-west-3", "eu-central-1", "ca-central-1", "ap-northeast-1", "ap-northeast-2", "ap-southeast-1", "ap-southeast-2", "ap-south-1", "sa-east-1", "cn-north-1", "cn-northwest-1", "us-gov-west-1"}
+	logRetentions = []string{"1", "3", "5", "7", "14", "30", "60", "90", "120", "150", "180", "365", "400", "545", "731", "1827", "3653"}
 )
 
 var EnumDoc = map[string][]string{
@@ -29,9 +31,15 @@ var EnumDoc = map[string][]string{
 	"check.distribution.state":   {"Deployed", "InProgress", "not-found"},
 	"check.distribution.timeout": timeouts,
 
+	"check.http.status":  {"200", "204", "301", "302", "401", "403"},
+	"check.http.timeout": timeouts,
+
 	"check.instance.state":   {"pending", "running", "shutting-down", "terminated", "stopping", "stopped", "not-found"},
 	"check.instance.timeout": timeouts,
 
+	"check.kubernetescluster.state":   {"active", "creating", "deleting", "failed", "not-found"},
+	"check.kubernetescluster.timeout": timeouts,
+
 	"check.loadbalancer.state":   {"provisioning", "active", "failed", "not-found"},
 	"check.loadbalancer.timeout": timeouts,
 
@@ -47,9 +55,23 @@ var EnumDoc = map[string][]string{
 	"check.securitygroup.state":   {"unused"},
 	"check.securitygroup.timeout": timeouts,
 
+	"check.tcp.timeout": timeouts,
+
+	"check.transitgateway.state":   {"pending", "available", "modifying", "deleting", "deleted", "not-found"},
+	"check.transitgateway.timeout": timeouts,
+
+	"check.transitgatewayattachment.state":   {"pendingAcceptance", "rollingBack", "pending", "available", "modifying", "deleting", "deleted", "failed", "rejected", "rejecting", "failing", "not-found"},
+	"check.transitgatewayattachment.timeout": timeouts,
+
+	"check.transitgatewayroutetable.state":   {"pending", "available", "deleting", "deleted", "not-found"},
+	"check.transitgatewayroutetable.timeout": timeouts,
+
 	"check.volume.state":   {"available", "in-use", "not-found"},
 	"check.volume.timeout": timeouts,
 
+	"check.vpnconnection.state":   {"pending", "available", "deleting", "deleted", "not-found"},
+	"check.vpnconnection.timeout": timeouts,
+
 	"create.accesskey.save": boolean,
 
 	"create.alarm.operator":           {"GreaterThanThreshold", "LessThanThreshold", "LessThanOrEqualToThreshold", "GreaterThanOrEqualToThreshold"},
@@ -68,6 +90,8 @@ var EnumDoc = map[string][]string{
 
 	"create.bucket.acl": s3ACLs,
 
+	"create.certificate.validation": {"email", "dns"},
+
 	"create.database.engine":             {"mysql", "mariadb", "postgres", "aurora", "oracle-se1", "oracle-se2", "oracle-se", "oracle-ee", "sqlserver-ee", "sqlserver-se", "sqlserver-ex", "sqlserver-web"},
 	"create.database.copytagstosnapshot": boolean,
 	"create.database.encrypted":          boolean,
@@ -92,13 +116,21 @@ var EnumDoc = map[string][]string{
 	"create.instance.type":     instanceTypes,
 	"create.instance.lock":     boolean,
 	"create.instance.userdata": {""},
+	"create.instance.tenancy":  {"default", "dedicated", "host"},
+
+	"create.grant.operations": {"Decrypt", "Encrypt", "GenerateDataKey", "GenerateDataKeyWithoutPlaintext", "ReEncryptFrom", "ReEncryptTo", "CreateGrant", "RetireGrant", "DescribeKey"},
+
+	"create.host.auto-placement": {"on", "off"},
+
+	"create.hostreservation.currency": {"USD"},
 
 	"create.image.reboot": boolean,
 
 	"create.keypair.encrypted": boolean,
+	"create.keypair.type":      {"rsa", "ed25519"},
 
 	"create.launchconfiguration.distro":   distros,
-	"create.launchconfiguration.type":     {"t2.nano", "t2.micro", "t2.small", "t2.medium", "t2.large", "t2.xlarge", "t2.2xlarge", "m4.large", "m4.xlarge", "c4.large", "c4.xlarge"},
+	"create.launchconfiguration.type":     instanceTypes,
 	"create.launchconfiguration.userdata": {""},
 	"create.launchconfiguration.public":   boolean,
 
@@ -106,6 +138,19 @@ var EnumDoc = map[string][]string{
 	"create.listener.protocol":   {"HTTP", "HTTPS"},
 	"create.listener.sslpolicy":  {"ELBSecurityPolicy-2016-08", "ELBSecurityPolicy-TLS-1-2-2017-01", "ELBSecurityPolicy-TLS-1-1-2017-01", "ELBSecurityPolicy-2015-05", "ELBSecurityPolicy-TLS-1-0-2015-04"},
 
+	"create.loggroup.retention": logRetentions,
+
+	"create.method.authorization": {"NONE", "AWS_IAM", "CUSTOM", "COGNITO_USER_POOLS"},
+	"create.method.http-method":   {"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS", "ANY"},
+
+	"create.networkaclrule.action":   {"allow", "deny"},
+	"create.networkaclrule.protocol": {"tcp", "udp", "icmp", "icmpv6", "any"},
+	"create.networkaclrule.outbound": boolean,
+
+	"create.parameter.secure": boolean,
+
+	"create.placementgroup.strategy": {"cluster", "spread"},
+
 	"create.policy.action":   {""},
 	"create.policy.effect":   {"Allow", "Deny"},
 	"create.policy.resource": {"*"},
@@ -121,10 +166,31 @@ var EnumDoc = map[string][]string{
 	"create.stack.capabilities": {"CAPABILITY_IAM", "CAPABILITY_NAMED_IAM"},
 	"create.stack.on-failure":   {"DO_NOTHING", "ROLLBACK", "DELETE"},
 
+	"create.peeringconnection.peer-region": regions,
+
 	"create.subnet.public": boolean,
 
 	"create.subscription.protocol": {"http", "https", "email", "email-json", "sms", "sqs", "lambda"},
 
+	"create.transitgateway.auto-accept":         {"enable", "disable"},
+	"create.transitgateway.default-association": {"enable", "disable"},
+	"create.transitgateway.default-propagation": {"enable", "disable"},
+	"create.transitgateway.dns-support":         {"enable", "disable"},
+	"create.transitgateway.vpn-ecmp-support":    {"enable", "disable"},
+
+	"create.transitgatewayattachment.dns-support":  {"enable", "disable"},
+	"create.transitgatewayattachment.ipv6-support": {"enable", "disable"},
+
+	"create.vpcendpoint.private-dns": boolean,
+	"create.vpcendpoint.type":        {"gateway", "interface"},
+
+	"create.customergateway.type": {"ipsec.1"},
+
+	"create.vpngateway.type": {"ipsec.1"},
+
+	"create.vpnconnection.static-routes-only": boolean,
+	"create.vpnconnection.type":               {"ipsec.1"},
+
 	"create.zone.isprivate": boolean,
 
 	"copy.image.source-id":     {""},
@@ -136,10 +202,18 @@ var EnumDoc = map[string][]string{
 
 	"delete.image.delete-snapshots": boolean,
 
+	"delete.key.pending-days": {"7", "14", "30"},
+
+	"delete.natgateway.release-elasticip": boolean,
+
+	"delete.networkaclrule.outbound": boolean,
+
 	"delete.policy.all-versions": boolean,
 
 	"delete.record.type": {"A", "AAAA", "CNAME", "MX", "NAPTR", "NS", "PTR", "SOA", "SPF", "SRV", "TXT"},
 
+	"delete.secret.force": boolean,
+
 	"detach.networkinterface.force": boolean,
 
 	"detach.policy.access":  {"readonly", "full"},
@@ -151,6 +225,8 @@ var EnumDoc = map[string][]string{
 
 	"restart.database.with-failover": boolean,
 
+	"get.screenshot.wake-up": boolean,
+
 	"start.containertask.type": {"task", "service"},
 
 	"stop.containertask.type": {"task", "service"},
@@ -170,6 +246,14 @@ var EnumDoc = map[string][]string{
 
 	"update.instance.type": instanceTypes,
 
+	"update.loggroup.retention": append([]string{"0"}, logRetentions...),
+
+	"update.networkaclrule.action":   {"allow", "deny"},
+	"update.networkaclrule.protocol": {"tcp", "udp", "icmp", "icmpv6", "any"},
+	"update.networkaclrule.outbound": boolean,
+
+	"update.parameter.secure": boolean,
+
 	"update.policy.effect": {"Allow", "Deny"},
 
 	"update.s3object.acl": s3ACLs,
@@ -186,6 +270,10 @@ var EnumDoc = map[string][]string{
 	"update.subnet.public": boolean,
 
 	"update.record.type": {"A", "AAAA", "CNAME", "MX", "NAPTR", "NS", "PTR", "SOA", "SPF", "SRV", "TXT"},
+
+	"wait.certificate.state": {"issued", "pending_validation"},
+
+	"wait.kubernetescluster.state": {"active", "failed"},
 }
 
 type ParamType struct {
@@ -200,9 +288,25 @@ var ParamTypeDoc = map[string]*ParamType{
 
 	"attach.role.instanceprofile": {ResourceType: cloud.InstanceProfile, PropertyName: properties.Name},
 
+	"attach.target.function": {ResourceType: cloud.Function, PropertyName: properties.Arn},
+	"attach.target.queue":    {ResourceType: cloud.Queue, PropertyName: properties.Arn},
+	"attach.target.topic":    {ResourceType: cloud.Topic, PropertyName: properties.Arn},
+
 	"create.accesskey.user": {ResourceType: cloud.User, PropertyName: properties.Name},
 
-	"create.instance.role": {ResourceType: cloud.Role, PropertyName: properties.Name},
+	"create.containerservice.cluster": {ResourceType: cloud.ContainerCluster, PropertyName: properties.Name},
+	"delete.containerservice.cluster": {ResourceType: cloud.ContainerCluster, PropertyName: properties.Name},
+	"update.containerservice.cluster": {ResourceType: cloud.ContainerCluster, PropertyName: properties.Name},
+	"start.containertask.cluster":     {ResourceType: cloud.ContainerCluster, PropertyName: properties.Name},
+	"stop.containertask.cluster":      {ResourceType: cloud.ContainerCluster, PropertyName: properties.Name},
+	"update.containertask.cluster":    {ResourceType: cloud.ContainerCluster, PropertyName: properties.Name},
+
+	"create.grant.grantee": {ResourceType: cloud.Role, PropertyName: properties.Arn},
+	"create.grant.retiree": {ResourceType: cloud.Role, PropertyName: properties.Arn},
+
+	"create.instance.role":           {ResourceType: cloud.Role, PropertyName: properties.Name},
+	"create.instance.placementgroup": {ResourceType: cloud.PlacementGroup, PropertyName: properties.Name},
+	"delete.placementgroup.name":     {ResourceType: cloud.PlacementGroup, PropertyName: properties.Name},
 
 	"create.record.values": {ResourceType: cloud.Record, PropertyName: properties.Records},
 
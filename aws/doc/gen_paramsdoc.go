@@ -18,8 +18,12 @@ limitations under the License.
 package awsdoc
 
 var generatedParamsDoc = map[string]map[string]string{
-	"attach.alarm":         {},
-	"attach.containertask": {},
+	"accept.peeringconnection": {
+		"id": "The ID of the VPC peering connection",
+	},
+	"attach.alarm":               {},
+	"attach.classicloadbalancer": {},
+	"attach.containertask":       {},
 	"attach.elasticip": {
 		"allow-reassociation": "For a VPC in an EC2-Classic account, specify true to allow an Elastic IP address that is already associated with an instance or network interface to be reassociated with the specified instance or network interface",
 		"id":               "The allocation ID",
@@ -44,6 +48,7 @@ var generatedParamsDoc = map[string]map[string]string{
 		"mfa-code-2": "A subsequent authentication code emitted by the device",
 		"user":       "The name of the IAM user for whom you want to enable the MFA device",
 	},
+	"attach.networkacl": {},
 	"attach.networkinterface": {
 		"device-index": "The index of the device for the network interface attachment",
 		"id":           "The ID of the network interface",
@@ -59,6 +64,12 @@ var generatedParamsDoc = map[string]map[string]string{
 		"subnet": "The ID of the subnet",
 	},
 	"attach.securitygroup": {},
+	"attach.target":        {},
+	"attach.servicecontrolpolicy": {
+		"id":     "The unique identifier (ID) of the policy that you want to attach to the target",
+		"target": "The unique identifier (ID) of the root, OU, or account that you want to attach the policy to",
+	},
+	"attach.transitgatewayroutetable": {},
 	"attach.user": {
 		"group": "The name of the group to update",
 		"name":  "The name of the user to add",
@@ -68,20 +79,35 @@ var generatedParamsDoc = map[string]map[string]string{
 		"id":       "The ID of the EBS volume",
 		"instance": "The ID of the instance",
 	},
+	"attach.vpngateway": {
+		"id":  "The ID of the virtual private gateway",
+		"vpc": "The ID of the VPC",
+	},
 	"authenticate.registry":  {},
+	"cancel.spotrequest":     {},
+	"check.cachecluster":     {},
 	"check.certificate":      {},
+	"check.cluster":          {},
 	"check.database":         {},
 	"check.distribution":     {},
+	"check.http":             {},
 	"check.instance":         {},
+	"check.kubernetescluster": {},
 	"check.loadbalancer":     {},
 	"check.natgateway":       {},
 	"check.networkinterface": {},
 	"check.scalinggroup":     {},
 	"check.securitygroup":    {},
+	"check.tcp":              {},
+	"check.transitgateway": {},
+	"check.transitgatewayattachment": {},
+	"check.transitgatewayroutetable": {},
 	"check.volume":           {},
+	"check.vpnconnection":    {},
 	"copy.image": {
 		"description":   "A description for the new AMI in the destination region",
 		"encrypted":     "Specifies whether the destination snapshots of the copied image should be encrypted",
+		"kms-key":       "An identifier for the symmetric AWS Key Management Service (AWS KMS) customer master key (CMK) to use when creating the encrypted volumes",
 		"name":          "The name of the new AMI in the destination region",
 		"source-id":     "The ID of the AMI to copy",
 		"source-region": "The name of the region that contains the AMI to copy",
@@ -89,12 +115,18 @@ var generatedParamsDoc = map[string]map[string]string{
 	"copy.snapshot": {
 		"description":   "A description for the EBS snapshot",
 		"encrypted":     "Specifies whether the destination snapshot should be encrypted",
+		"kms-key":       "An identifier for the AWS Key Management Service (AWS KMS) customer master key (CMK) to use when creating the encrypted volume",
 		"source-id":     "The ID of the EBS snapshot to copy",
 		"source-region": "The ID of the region that contains the snapshot to be copied",
 	},
 	"create.accesskey": {
 		"user": "The name of the IAM user that the new key will belong to",
 	},
+	"create.account": {
+		"email": "The email address of the owner to assign to the new member account",
+		"name":  "The friendly name of the member account",
+		"role":  "The name of an IAM role that AWS Organizations automatically preconfigures in the new member account",
+	},
 	"create.alarm": {
 		"alarm-actions":            "The actions to execute when this alarm transitions to the ALARM state from any other state",
 		"description":              "The description for the alarm",
@@ -112,6 +144,8 @@ var generatedParamsDoc = map[string]map[string]string{
 		"threshold":                "The value against which the specified statistic is compared",
 		"unit":                     "The unit of measure for the statistic",
 	},
+	"create.alias": {},
+	"create.application": {},
 	"create.appscalingpolicy": {
 		"dimension":         "The scalable dimension",
 		"name":              "The name of the scaling policy",
@@ -131,16 +165,39 @@ var generatedParamsDoc = map[string]map[string]string{
 		"acl":  "The canned ACL to apply to the bucket",
 		"name": "",
 	},
-	"create.certificate": {},
+	"create.cachecluster":     {},
+	"create.cachesubnetgroup": {},
+	"create.certificate":      {},
+	"create.catalogdatabase": {},
+	"create.classicloadbalancer": {
+		"scheme":         "The nodes of an Internet-facing load balancer have public IP addresses",
+		"securitygroups": "[Application Load Balancers] The IDs of the security groups to assign to the load balancer",
+		"subnets":        "The IDs of the subnets to attach to the load balancer",
+	},
+	"create.cluster": {},
+	"create.computeenvironment": {},
 	"create.containercluster": {
 		"name": "The name of your cluster",
 	},
-	"create.database":      {},
-	"create.dbsubnetgroup": {},
-	"create.distribution":  {},
+	"create.containerservice": {},
+	"create.customergateway": {
+		"bgp-asn":  "For devices that support BGP, the customer gateway's BGP ASN",
+		"publicip": "The Internet-routable IP address for the customer gateway's outside interface. The address must be static",
+		"type":     "The type of VPN connection that this customer gateway supports (ipsec.1)",
+	},
+	"create.database":         {},
+	"create.crawler": {},
+	"create.dbparametergroup": {},
+	"create.dbsubnetgroup":    {},
+	"create.deployment": {},
+	"create.distribution":     {},
+	"create.egressonlyinternetgateway": {
+		"vpc": "The ID of the VPC for which to create the egress-only Internet gateway",
+	},
 	"create.elasticip": {
 		"domain": "Set to vpc to allocate the address for use with instances in a VPC",
 	},
+	"create.environment": {},
 	"create.function": {
 		"description": "A short, user-defined function description",
 		"handler":     "The function within your code that Lambda calls to begin execution",
@@ -151,32 +208,55 @@ var generatedParamsDoc = map[string]map[string]string{
 		"runtime":     "The runtime environment for the Lambda function you are uploading",
 		"timeout":     "The function execution time at which Lambda should terminate the function",
 	},
+	"create.grant": {},
 	"create.group": {
 		"name": "The name of the group to create",
 	},
+	"create.host": {
+		"auto-placement":   "This is enabled by default",
+		"availabilityzone": "The Availability Zone for the Dedicated Hosts",
+		"type":             "Specify the instance type that you want your Dedicated Hosts to be configured for",
+	},
+	"create.hostreservation": {
+		"currency":    "The currency in which the totalUpfrontPrice, LimitPrice, and totalHourlyPrice amounts are specified",
+		"hosts":       "The ID/s of the Dedicated Host/s that the reservation will be associated with",
+		"limit-price": "The specified limit is checked against the total upfront cost of the reservation (calculated as the offering's upfront cost multiplied by the host count)",
+		"offering":    "The offering ID of the reservation",
+	},
 	"create.image": {
-		"description": "A description for the new image",
-		"instance":    "The ID of the instance",
-		"name":        "A name for the new image",
-		"reboot":      "By default, Amazon EC2 attempts to shut down and reboot the instance before creating the image",
+		"architecture": "The architecture of the AMI",
+		"description":  "A description for the new image",
+		"instance":     "The ID of the instance",
+		"name":         "A name for the new image",
+		"reboot":       "By default, Amazon EC2 attempts to shut down and reboot the instance before creating the image",
+		"snapshot":     "The ID of the snapshot",
 	},
 	"create.instance": {
-		"image":         "The ID of the AMI, which you can get by calling DescribeImages",
-		"ip":            "The primary IPv4 address",
-		"keypair":       "The name of the key pair",
-		"lock":          "If you set this parameter to true, you can't terminate the instance using the Amazon EC2 console, CLI, or API; otherwise, you can",
-		"securitygroup": "One or more security group IDs",
-		"subnet":        "The ID of the subnet to launch the instance into",
-		"type":          "The instance type",
-		"userdata":      "The user data to make available to the instance",
+		"block-devices":  "One or more block device mapping entries",
+		"host":           "The ID of the Dedicated Host on which the instance resides",
+		"image":          "The ID of the AMI, which you can get by calling DescribeImages",
+		"ip":             "The primary IPv4 address",
+		"keypair":        "The name of the key pair",
+		"lock":           "If you set this parameter to true, you can't terminate the instance using the Amazon EC2 console, CLI, or API; otherwise, you can",
+		"placementgroup": "The name of the placement group the instance is in (for cluster compute instances)",
+		"securitygroup":  "One or more security group IDs",
+		"subnet":         "The ID of the subnet to launch the instance into",
+		"tags":           "The tags to apply to the resources during launch",
+		"tenancy":        "The tenancy of the instance (if the instance is running in a VPC)",
+		"type":           "The instance type",
+		"userdata":       "The user data to make available to the instance",
 	},
 	"create.instanceprofile": {
 		"name": "The name of the instance profile to create",
 	},
 	"create.internetgateway": {},
+	"create.invalidation":    {},
+	"create.key":             {},
+	"create.jobqueue": {},
 	"create.keypair": {
 		"name": "A unique name for the key pair",
 	},
+	"create.kubernetescluster": {},
 	"create.launchconfiguration": {
 		"image":          "The ID of the Amazon Machine Image (AMI) to use to launch your EC2 instances",
 		"keypair":        "The name of the key pair",
@@ -203,26 +283,51 @@ var generatedParamsDoc = map[string]map[string]string{
 		"subnets":         "The IDs of the subnets to attach to the load balancer",
 		"type":            "The type of load balancer to create",
 	},
+	"create.loggroup": {},
 	"create.loginprofile": {
 		"password":       "The new password for the user",
 		"password-reset": "Specifies whether the user is required to set a new password on next sign-in",
 		"username":       "The name of the IAM user to create a password for",
 	},
+	"create.method": {},
 	"create.mfadevice": {},
 	"create.natgateway": {
 		"elasticip-id": "The allocation ID of an Elastic IP address to associate with the NAT gateway",
 		"subnet":       "The subnet in which to create the NAT gateway",
 	},
+	"create.networkacl": {
+		"vpc": "The ID of the VPC",
+	},
+	"create.networkaclrule": {},
 	"create.networkinterface": {
-		"description":    "A description for the network interface",
-		"privateip":      "The primary private IPv4 address of the network interface",
-		"securitygroups": "The IDs of one or more security groups",
-		"subnet":         "The ID of the subnet to associate with the network interface",
+		"description":     "A description for the network interface",
+		"privateip":       "The primary private IPv4 address of the network interface",
+		"secondary-count": "The number of secondary private IPv4 addresses to assign to a network interface",
+		"securitygroups":  "The IDs of one or more security groups",
+		"subnet":          "The ID of the subnet to associate with the network interface",
+	},
+	"create.parameter": {},
+	"create.peeringconnection": {
+		"peer-owner":  "The AWS account ID of the owner of the accepter VPC",
+		"peer-region": "The region code for the accepter VPC, if the accepter VPC is located in a region other than the region in which you make the request",
+		"peer-vpc":    "The ID of the VPC with which you are creating the VPC peering connection",
+		"vpc":         "The ID of the requester VPC",
+	},
+	"create.placementgroup": {
+		"name":     "A name for the placement group",
+		"strategy": "The placement strategy",
 	},
 	"create.policy": {
 		"description": "A friendly description of the policy",
+		"document":    "The JSON policy document that you want to use as the content for the new policy",
 		"name":        "The friendly name of the policy",
 	},
+	"create.policyversion": {
+		"arn":      "The Amazon Resource Name (ARN) of the IAM policy to which you want to add a new version",
+		"default":  "Specifies whether to set this version as the policy's default version",
+		"document": "The JSON policy document that you want to use as the content for this new version of the policy",
+	},
+	"create.presignedurl": {},
 	"create.queue": {
 		"name": "The name of the new queue",
 	},
@@ -230,6 +335,8 @@ var generatedParamsDoc = map[string]map[string]string{
 	"create.repository": {
 		"name": "The name to use for the repository",
 	},
+	"create.resource": {},
+	"create.restapi": {},
 	"create.role": {},
 	"create.route": {
 		"cidr":    "The IPv4 CIDR address block used for the destination match",
@@ -239,6 +346,7 @@ var generatedParamsDoc = map[string]map[string]string{
 	"create.routetable": {
 		"vpc": "The ID of the VPC",
 	},
+	"create.rule":     {},
 	"create.s3object": {},
 	"create.scalinggroup": {
 		"cooldown":                 "The amount of time, in seconds, after a scaling activity completes before another scaling activity can start",
@@ -261,6 +369,7 @@ var generatedParamsDoc = map[string]map[string]string{
 		"name":                 "The name of the scaling policy",
 		"scalinggroup":         "The name of the Auto Scaling group",
 	},
+	"create.secret": {},
 	"create.securitygroup": {
 		"description": "A description for the security group",
 		"name":        "The name of the security group",
@@ -270,6 +379,17 @@ var generatedParamsDoc = map[string]map[string]string{
 		"description": "A description for the snapshot",
 		"volume":      "The ID of the EBS volume",
 	},
+	"create.spotfleet": {},
+	"create.spotinstance": {
+		"count":         "The maximum number of Spot instances to launch",
+		"image":         "The ID of the AMI",
+		"keypair":       "The name of the key pair",
+		"price":         "The maximum hourly price (bid) for any Spot instance launched to fulfill the request",
+		"securitygroup": "One or more security group IDs",
+		"subnet":        "The ID of the subnet in which to launch the instance",
+		"type":          "The instance type",
+		"userdata":      "The user data to make available to the instances",
+	},
 	"create.stack": {
 		"capabilities":     "A list of values that you must specify before AWS CloudFormation can create certain stacks",
 		"disable-rollback": "Set to true to disable rollback of the stack if stack creation failed",
@@ -282,11 +402,15 @@ var generatedParamsDoc = map[string]map[string]string{
 		"role":             "The Amazon Resource Name (ARN) of an AWS Identity and Access Management (IAM) role that AWS CloudFormation assumes to create the stack",
 		"tags":             "Key-value pairs to associate with this stack",
 		"template-file":    "Structure containing the template body with a minimum length of 1 byte and a maximum length of 51,200 bytes",
+		"template-url":     "Location of file containing the template body",
 		"timeout":          "The amount of time that can pass before the stack status becomes CREATE_FAILED; if DisableRollback is not set or is set to false, the stack will be rolled back",
 	},
+	"create.stage": {},
+	"create.statemachine": {},
 	"create.subnet": {
 		"availabilityzone": "The Availability Zone for the subnet",
 		"cidr":             "The IPv4 network range for the subnet, in CIDR notation",
+		"ipv6":             "The IPv6 network range for the subnet, in CIDR notation",
 		"vpc":              "The ID of the VPC",
 	},
 	"create.subscription": {
@@ -294,7 +418,8 @@ var generatedParamsDoc = map[string]map[string]string{
 		"protocol": "The protocol you want to use",
 		"topic":    "The ARN of the topic you want to subscribe to",
 	},
-	"create.tag": {},
+	"create.table": {},
+	"create.tag":   {},
 	"create.targetgroup": {
 		"healthcheckinterval": "The approximate amount of time, in seconds, between health checks of an individual target",
 		"healthcheckpath":     "[HTTP/HTTPS health checks] The ping path that is the destination on the targets for health checks",
@@ -311,15 +436,45 @@ var generatedParamsDoc = map[string]map[string]string{
 	"create.topic": {
 		"name": "The name of the topic you want to create",
 	},
+	"create.trail": {},
+	"create.transitgateway": {},
+	"create.transitgatewayattachment": {},
+	"create.transitgatewayroutetable": {},
 	"create.user": {
 		"name": "The name of the user to create",
 	},
 	"create.volume": {
 		"availabilityzone": "The Availability Zone in which to create the volume",
+		"encrypted":        "Specifies whether the volume should be encrypted",
+		"iops":             "The number of I/O operations per second (IOPS) to provision for the volume, with a maximum ratio of 50 IOPS/GiB",
+		"kms-key":          "An identifier for the AWS Key Management Service (AWS KMS) customer master key (CMK) to use when creating the encrypted volume",
 		"size":             "The size of the volume, in GiBs",
+		"type":             "The volume type",
 	},
 	"create.vpc": {
 		"cidr": "The IPv4 network range for the VPC, in CIDR notation",
+		"ipv6": "Requests an Amazon-provided IPv6 CIDR block with a /56 prefix length for the VPC",
+	},
+	"create.vpcendpoint": {
+		"policy":         "A policy to attach to the endpoint that controls access to the service",
+		"private-dns":    "Indicate whether to associate a private hosted zone with the specified VPC",
+		"routetables":    "One or more route table IDs",
+		"securitygroups": "The ID of one or more security groups to associate with the endpoint network interface",
+		"service":        "The service name",
+		"subnets":        "The ID of one or more subnets in which to create an endpoint network interface",
+		"type":           "The type of endpoint",
+		"vpc":            "The ID of the VPC in which the endpoint will be used",
+	},
+	"create.vpnconnection": {
+		"customergateway":    "The ID of the customer gateway",
+		"static-routes-only": "Indicate whether the VPN connection uses static routes only. If you are creating a VPN connection for a device that does not support BGP, you must specify true",
+		"type":               "The type of VPN connection (ipsec.1)",
+		"vpngateway":         "The ID of the virtual private gateway",
+	},
+	"create.vpngateway": {
+		"amazon-asn":       "A private Autonomous System Number (ASN) for the Amazon side of a BGP session",
+		"availabilityzone": "The Availability Zone for the virtual private gateway",
+		"type":             "The type of VPN connection this virtual private gateway supports",
 	},
 	"create.zone": {
 		"callerreference": "A unique string that identifies the request and that allows failed CreateHostedZone requests to be retried without the risk of executing the operation twice",
@@ -333,6 +488,8 @@ var generatedParamsDoc = map[string]map[string]string{
 	"delete.alarm": {
 		"name": "The alarms to be deleted",
 	},
+	"delete.alias": {},
+	"delete.application": {},
 	"delete.appscalingpolicy": {
 		"dimension":         "The scalable dimension",
 		"name":              "The name of the scaling policy",
@@ -347,18 +504,34 @@ var generatedParamsDoc = map[string]map[string]string{
 	"delete.bucket": {
 		"name": "",
 	},
+	"delete.cachecluster":     {},
+	"delete.cachesubnetgroup": {},
+	"delete.catalogdatabase": {},
 	"delete.certificate": {
 		"arn": "String that contains the ARN of the ACM Certificate to be deleted",
 	},
+	"delete.classicloadbalancer": {},
+	"delete.cluster":             {},
+	"delete.computeenvironment": {},
 	"delete.containercluster": {
 		"id": "The short name or full Amazon Resource Name (ARN) of the cluster to delete",
 	},
-	"delete.containertask": {},
+	"delete.containerservice": {},
+	"delete.containertask":    {},
+	"delete.crawler": {},
+	"delete.customergateway": {
+		"id": "The ID of the customer gateway",
+	},
 	"delete.database": {
 		"id": "Contains a user-supplied database identifier",
 	},
-	"delete.dbsubnetgroup": {},
-	"delete.distribution":  {},
+	"delete.dbparametergroup": {},
+	"delete.dbsubnetgroup":    {},
+	"delete.deployment": {},
+	"delete.distribution":     {},
+	"delete.egressonlyinternetgateway": {
+		"id": "The ID of the egress-only Internet gateway",
+	},
 	"delete.elasticip": {
 		"id": "The allocation ID",
 		"ip": "The Elastic IP address",
@@ -367,9 +540,13 @@ var generatedParamsDoc = map[string]map[string]string{
 		"id":      "The Lambda function to delete",
 		"version": "Using this optional parameter you can specify a function version (but not the $LATEST version) to direct AWS Lambda to delete a specific function version",
 	},
+	"delete.grant": {},
 	"delete.group": {
 		"name": "The name of the IAM group to delete",
 	},
+	"delete.host": {
+		"id": "The IDs of the Dedicated Hosts you want to release",
+	},
 	"delete.image": {},
 	"delete.instance": {
 		"ids": "One or more instance IDs",
@@ -380,9 +557,13 @@ var generatedParamsDoc = map[string]map[string]string{
 	"delete.internetgateway": {
 		"id": "The ID of the Internet gateway",
 	},
+	"delete.jobdefinition": {},
+	"delete.jobqueue": {},
+	"delete.key": {},
 	"delete.keypair": {
 		"name": "The name of the key pair",
 	},
+	"delete.kubernetescluster": {},
 	"delete.launchconfiguration": {},
 	"delete.listener": {
 		"id": "The Amazon Resource Name (ARN) of the listener",
@@ -390,21 +571,38 @@ var generatedParamsDoc = map[string]map[string]string{
 	"delete.loadbalancer": {
 		"id": "The Amazon Resource Name (ARN) of the load balancer",
 	},
+	"delete.loggroup": {},
 	"delete.loginprofile": {
 		"username": "The name of the user whose password you want to delete",
 	},
+	"delete.method": {},
 	"delete.mfadevice": {
 		"id": "The serial number that uniquely identifies the MFA device",
 	},
 	"delete.natgateway": {
 		"id": "The ID of the NAT gateway",
 	},
+	"delete.networkacl": {
+		"id": "The ID of the network ACL",
+	},
+	"delete.networkaclrule": {},
 	"delete.networkinterface": {
 		"id": "The ID of the network interface",
 	},
+	"delete.parameter": {},
+	"delete.peeringconnection": {
+		"id": "The ID of the VPC peering connection",
+	},
+	"delete.placementgroup": {
+		"name": "The name of the placement group",
+	},
 	"delete.policy": {
 		"arn": "The Amazon Resource Name (ARN) of the IAM policy you want to delete",
 	},
+	"delete.policyversion": {
+		"arn":     "The Amazon Resource Name (ARN) of the IAM policy from which you want to delete a version",
+		"version": "The policy version to delete",
+	},
 	"delete.queue": {
 		"url": "The URL of the Amazon SQS queue to delete",
 	},
@@ -414,6 +612,8 @@ var generatedParamsDoc = map[string]map[string]string{
 		"force":   "If a repository contains images, forces the deletion",
 		"name":    "The name of the repository to delete",
 	},
+	"delete.resource": {},
+	"delete.restapi": {},
 	"delete.role": {},
 	"delete.route": {
 		"cidr":  "The IPv4 CIDR range for the route",
@@ -422,6 +622,7 @@ var generatedParamsDoc = map[string]map[string]string{
 	"delete.routetable": {
 		"id": "The ID of the route table",
 	},
+	"delete.rule": {},
 	"delete.s3object": {
 		"bucket": "",
 		"name":   "",
@@ -433,6 +634,7 @@ var generatedParamsDoc = map[string]map[string]string{
 	"delete.scalingpolicy": {
 		"id": "The name or Amazon Resource Name (ARN) of the policy",
 	},
+	"delete.secret": {},
 	"delete.securitygroup": {
 		"id": "The ID of the security group",
 	},
@@ -443,19 +645,26 @@ var generatedParamsDoc = map[string]map[string]string{
 		"name":             "The name or the unique stack ID that is associated with the stack",
 		"retain-resources": "For stacks in the DELETE_FAILED state, a list of resource logical IDs that are associated with the resources you want to retain",
 	},
+	"delete.stage": {},
+	"delete.statemachine": {},
 	"delete.subnet": {
 		"id": "The ID of the subnet",
 	},
 	"delete.subscription": {
 		"id": "The ARN of the subscription to be deleted",
 	},
-	"delete.tag": {},
+	"delete.table": {},
+	"delete.tag":   {},
 	"delete.targetgroup": {
 		"id": "The Amazon Resource Name (ARN) of the target group",
 	},
 	"delete.topic": {
 		"id": "The ARN of the topic you want to delete",
 	},
+	"delete.trail": {},
+	"delete.transitgateway": {},
+	"delete.transitgatewayattachment": {},
+	"delete.transitgatewayroutetable": {},
 	"delete.user": {
 		"name": "The name of the user to delete",
 	},
@@ -465,14 +674,22 @@ var generatedParamsDoc = map[string]map[string]string{
 	"delete.vpc": {
 		"id": "The ID of the VPC",
 	},
+	"delete.vpcendpoint": {
+		"id": "One or more VPC endpoint IDs",
+	},
+	"delete.vpnconnection": {
+		"id": "The ID of the VPN connection",
+	},
+	"delete.vpngateway": {
+		"id": "The ID of the virtual private gateway",
+	},
 	"delete.zone": {
 		"id": "The ID of the hosted zone you want to delete",
 	},
-	"detach.alarm":         {},
-	"detach.containertask": {},
-	"detach.elasticip": {
-		"association": "The association ID",
-	},
+	"detach.alarm":               {},
+	"detach.classicloadbalancer": {},
+	"detach.containertask":       {},
+	"detach.elasticip":           {},
 	"detach.instance": {
 		"targetgroup": "The Amazon Resource Name (ARN) of the target group",
 	},
@@ -485,6 +702,7 @@ var generatedParamsDoc = map[string]map[string]string{
 		"id":   "The serial number that uniquely identifies the MFA device",
 		"user": "The name of the user whose MFA device you want to deactivate",
 	},
+	"detach.networkacl": {},
 	"detach.networkinterface": {},
 	"detach.policy":           {},
 	"detach.role": {
@@ -495,6 +713,12 @@ var generatedParamsDoc = map[string]map[string]string{
 		"association": "The association ID representing the current association between the route table and subnet",
 	},
 	"detach.securitygroup": {},
+	"detach.target":        {},
+	"detach.servicecontrolpolicy": {
+		"id":     "The unique identifier (ID) of the policy you want to detach",
+		"target": "The unique identifier (ID) of the root, OU, or account that you want to detach the policy from",
+	},
+	"detach.transitgatewayroutetable": {},
 	"detach.user": {
 		"group": "The name of the group to update",
 		"name":  "The name of the user to remove",
@@ -505,6 +729,15 @@ var generatedParamsDoc = map[string]map[string]string{
 		"id":       "The ID of the volume",
 		"instance": "The ID of the instance",
 	},
+	"detach.vpngateway": {
+		"id":  "The ID of the virtual private gateway",
+		"vpc": "The ID of the VPC",
+	},
+	"disable.key":       {},
+	"download.s3object": {},
+	"enable.key":        {},
+	"get.consoleoutput": {},
+	"get.screenshot":    {},
 	"import.image": {
 		"architecture": "The architecture of the virtual machine",
 		"description":  "A description string for the import image task",
@@ -512,12 +745,47 @@ var generatedParamsDoc = map[string]map[string]string{
 		"platform":     "The operating system of the virtual machine",
 		"role":         "The name of the role to use when not using the default role, 'vmimport'",
 	},
+	"invoke.function": {},
+	"reboot.instance": {
+		"ids": "One or more instance IDs",
+	},
+	"resize.cluster":  {},
+	"move.account": {
+		"destination": "The unique identifier (ID) of the root or organizational unit that you want to move the account to",
+		"id":          "The unique identifier (ID) of the account that you want to move",
+		"source":      "The unique identifier (ID) of the root or organizational unit that you want to move the account from",
+	},
+	"register.jobdefinition": {},
 	"restart.database": {
 		"id": "Contains a user-supplied database identifier",
 	},
 	"restart.instance": {
 		"ids": "One or more instance IDs",
 	},
+	"restore.database": {
+		"autoupgrade":      "Indicates that minor version upgrades will be applied automatically to the DB instance during the maintenance window",
+		"availabilityzone": "The EC2 Availability Zone that the database instance will be created in",
+		"iops":             "Specifies the amount of provisioned IOPS for the DB instance, expressed in I/O operations per second",
+		"multiaz":          "Specifies if the DB instance is a Multi-AZ deployment",
+		"name":             "Name of the DB instance to create from the DB snapshot",
+		"optiongroup":      "The name of the option group to be used for the restored DB instance",
+		"port":             "The port number on which the database accepts connections",
+		"public":           "Specifies the accessibility options for the DB instance",
+		"snapshot":         "The identifier for the DB snapshot to restore from",
+		"storagetype":      "Specifies the storage type to be associated with the DB instance",
+		"subnetgroup":      "The DB subnet group name to use for the new instance",
+		"type":             "The compute and memory capacity of the Amazon RDS DB instance",
+	},
+	"restore.volume": {
+		"availabilityzone": "The Availability Zone in which to create the volume",
+		"encrypted":        "Specifies whether the volume should be encrypted",
+		"iops":             "The number of I/O operations per second (IOPS) to provision for the volume",
+		"kms-key":          "An identifier for the AWS Key Management Service (AWS KMS) customer master key (CMK) to use when creating the encrypted volume",
+		"size":             "The size of the volume, in GiBs. Default is the snapshot size",
+		"snapshot":         "The snapshot from which to create the volume",
+		"type":             "The volume type",
+	},
+	"run.command": {},
 	"start.alarm": {
 		"names": "The names of the alarms",
 	},
@@ -525,9 +793,11 @@ var generatedParamsDoc = map[string]map[string]string{
 	"start.database": {
 		"id": "Contains a user-supplied database identifier",
 	},
+	"start.execution": {},
 	"start.instance": {
 		"ids": "One or more instance IDs",
 	},
+	"start.query": {},
 	"stop.alarm": {
 		"names": "The names of the alarms",
 	},
@@ -535,10 +805,19 @@ var generatedParamsDoc = map[string]map[string]string{
 	"stop.database": {
 		"id": "Contains a user-supplied database identifier",
 	},
+	"stop.execution": {},
 	"stop.instance": {
 		"ids": "One or more instance IDs",
 	},
+	"submit.job": {},
+	"terminate.environment": {},
 	"update.bucket": {},
+	"update.containerservice": {
+		"cluster":       "The short name or full Amazon Resource Name (ARN) of the cluster that your service is running on",
+		"containertask": "The family and revision (family:revision) or full ARN of the task definition to run in your service",
+		"desired-count": "The number of instantiations of the task to place and keep running in your service",
+		"name":          "The name of the service to update",
+	},
 	"update.containertask": {
 		"cluster":         "The short name or full Amazon Resource Name (ARN) of the cluster that your service is running on",
 		"deployment-name": "The name of the service to update",
@@ -546,20 +825,33 @@ var generatedParamsDoc = map[string]map[string]string{
 		"name":            "The family and revision (family:revision) or full ARN of the task definition to run in your service",
 	},
 	"update.distribution": {},
+	"update.function":     {},
 	"update.image":        {},
+	"update.environment": {},
 	"update.instance": {
 		"id":   "The ID of the instance",
 		"lock": "If the value is true, you can't terminate the instance using the Amazon EC2 console, CLI, or API; otherwise, you can",
 	},
+	"update.loggroup": {},
 	"update.loginprofile": {
 		"password":       "The new password for the specified IAM user",
 		"password-reset": "Allows this new password to be used only once by requiring the specified IAM user to set a new password on next sign-in",
 		"username":       "The name of the user whose password you want to update",
 	},
+	"update.networkaclrule": {},
+	"update.networkinterface": {},
+	"update.parameter": {},
 	"update.policy": {
-		"arn": "The Amazon Resource Name (ARN) of the IAM policy to which you want to add a new version",
+		"arn":             "The Amazon Resource Name (ARN) of the IAM policy to which you want to add a new version",
+		"default-version": "The version of the policy to set as the default (operative) version",
 	},
 	"update.record": {},
+	"update.repository": {
+		"account":     "The AWS account ID associated with the registry that contains the repository",
+		"force":       "If the policy you are attempting to set on a repository policy would prevent you from setting another policy in the future, you must force the operation",
+		"name":        "The name of the repository to receive the policy",
+		"policy-file": "The path to the JSON file containing the repository policy text to apply to the repository",
+	},
 	"update.s3object": {
 		"acl":     "The canned ACL to apply to the object",
 		"bucket":  "",
@@ -578,6 +870,7 @@ var generatedParamsDoc = map[string]map[string]string{
 		"new-instances-protected": "Indicates whether newly launched instances are protected from termination by Auto Scaling when scaling in",
 		"subnets":                 "The ID of the subnet, if you are launching into a VPC",
 	},
+	"update.secret":        {},
 	"update.securitygroup": {},
 	"update.stack": {
 		"capabilities":          "A list of values that you must specify before AWS CloudFormation can update certain stacks",
@@ -590,11 +883,15 @@ var generatedParamsDoc = map[string]map[string]string{
 		"role":                  "The Amazon Resource Name (ARN) of an AWS Identity and Access Management (IAM) role that AWS CloudFormation assumes to update the stack",
 		"tags":                  "Key-value pairs to associate with this stack",
 		"template-file":         "Structure containing the template body with a minimum length of 1 byte and a maximum length of 51,200 bytes",
+		"template-url":          "Location of file containing the template body",
 		"use-previous-template": "Reuse the existing template that is associated with the stack that you are updating",
 	},
-	"update.subnet": {
-		"id":     "The ID of the subnet",
-		"public": "Specify true to indicate that network interfaces created in the specified subnet should be assigned a public IPv4 address",
-	},
+	"update.statemachine": {},
+	"update.subnet":      {},
+	"update.table":       {},
 	"update.targetgroup": {},
+	"update.vpc":         {},
+	"wait.certificate":   {},
+	"wait.distribution":  {},
+	"wait.kubernetescluster": {},
 }
@@ -24,6 +24,10 @@ var manualParamsDoc = map[string]map[string]string{
 		"name":       "The Name of the Alarm to update",
 		"action-arn": "The Amazon Resource Name (ARN) of the action to execute when this alarm transitions to the ALARM state from any other state",
 	},
+	"attach.classicloadbalancer": {
+		"instance": "The ID of the instance to register with the classic load balancer",
+		"name":     "The name of the classic load balancer",
+	},
 	"attach.containertask": {
 		"container-name":    "The name of a container",
 		"name":              "The name of the new or existing task containing the container to attach",
@@ -34,6 +38,10 @@ var manualParamsDoc = map[string]map[string]string{
 		"privileged":        "When this parameter is true, the container is given elevated privileges on the host container instance",
 		"workdir":           "The working directory in which to run commands inside the container",
 		"ports":             "The list of port mappings for the container. Port mappings allow containers to access ports on the host container instance to send or receive traffic (format [host-port:]container-port[/protocol][,[host-port:]container-port[/protocol]])",
+		"launch-type":       "The launch type the task is made compatible with: 'ec2' (default) or 'fargate'. Fargate tasks use the awsvpc network mode",
+		"cpu":               "The number of cpu units used by the task (ex: 256 for 0.25 vCPU). Required with launch-type=fargate",
+		"memory":            "The amount of memory (in MiB) used by the task. Required with launch-type=fargate",
+		"execution-role":    "The ARN of the role allowing the ECS agent to pull images and send logs on your behalf. Required with launch-type=fargate to pull images from private repositories",
 	},
 	"attach.elasticip": {
 		"allow-reassociation": "Specify false to ensure the operation fails if the Elastic IP address is already associated with another resource",
@@ -53,6 +61,10 @@ var manualParamsDoc = map[string]map[string]string{
 	"attach.mfadevice": {
 		"no-prompt": "Use 'true' to disable the prompt that asks to append the mfadevice to ~/.aws/config file",
 	},
+	"attach.networkacl": {
+		"id":     "The ID of the network ACL to associate with the subnet, replacing its current network ACL",
+		"subnet": "The ID of the subnet",
+	},
 	"attach.policy": {
 		"access":  "Type of access to retrieve an AWS policy",
 		"service": "Service string to retrieve an AWS policy",
@@ -65,16 +77,42 @@ var manualParamsDoc = map[string]map[string]string{
 		"id":       "The ID of the Security Group to add to the instance",
 		"instance": "The ID of the Instance",
 	},
+	"attach.target": {
+		"rule":     "The name of the rule whose events are sent to the target",
+		"function": "The ARN of the Lambda function to invoke, which must allow events.amazonaws.com to invoke it",
+		"queue":    "The ARN of the SQS queue to send the events to, whose policy must allow events.amazonaws.com to send messages",
+		"topic":    "The ARN of the SNS topic to publish the events to, whose policy must allow events.amazonaws.com to publish",
+		"id":       "The ID of the target in the rule (defaults to the name of the function, queue or topic)",
+		"input":    "The JSON text sent to the target instead of the matched event",
+	},
+	"attach.transitgatewayroutetable": {
+		"id":         "The ID of the transit gateway route table",
+		"attachment": "The ID of the transit gateway attachment to associate with the route table",
+	},
 	"authenticate.registry": {
 		"accounts":        "A list of AWS account IDs that are associated with the registries for which to authenticate",
 		"no-confirm":      "Do not ask confirmation before effectively running `docker login` command",
-		"no-docker-login": "Set to 'true' to disable the prompt and automatic execution of `docker login` command",
+		"no-docker-login": "Set to 'true' to only display the `docker login` command and the registry password, without prompt nor execution",
+	},
+	"cancel.spotrequest": {
+		"id":                  "The ID of the spot instance request (sir-...) or spot fleet request (sfr-...) to cancel",
+		"terminate-instances": "True to also terminate the instances of the request, otherwise they keep running",
+	},
+	"check.cachecluster": {
+		"id":      "The ID of the ElastiCache cluster to check",
+		"state":   "The state of the ElastiCache cluster to reach",
+		"timeout": "The time (in seconds) after which the check is failed",
 	},
 	"check.certificate": {
 		"arn":     "The Amazon Resource Name (ARN) of the certificate to check",
 		"state":   "The state of the certificate to reach",
 		"timeout": "The time (in seconds) after which the check is failed",
 	},
+	"check.cluster": {
+		"id":      "The identifier of the Redshift cluster to check",
+		"state":   "The state of the Redshift cluster to reach",
+		"timeout": "The time (in seconds) after which the check is failed",
+	},
 	"check.database": {
 		"id":      "The ID of the RDS Database to check",
 		"state":   "The state of the RDS Database to reach",
@@ -85,11 +123,22 @@ var manualParamsDoc = map[string]map[string]string{
 		"state":   "The state of the CloudFront Distribution to reach",
 		"timeout": "The time (in seconds) after which the check is failed",
 	},
+	"check.http": {
+		"url":          "The HTTP or HTTPS URL to request until it answers the expected status",
+		"loadbalancer": "The ARN of a load balancer whose DNS name replaces the host of the URL",
+		"status":       "The HTTP status code to expect (default: 200)",
+		"timeout":      "The time (in seconds or as a duration, ex: 5m) after which the check is failed",
+	},
 	"check.instance": {
 		"id":      "The ID of the EC2 Instance to check",
 		"state":   "The state of the EC2 Instance to reach",
 		"timeout": "The time (in seconds) after which the check is failed",
 	},
+	"check.kubernetescluster": {
+		"name":    "The name of the EKS cluster to check",
+		"state":   "The state of the EKS cluster to reach",
+		"timeout": "The time (in seconds) after which the check is failed",
+	},
 	"check.loadbalancer": {
 		"id":      "The ID of the ELBv2 Loadbalancer to check",
 		"state":   "The state of the ELBv2 Loadbalancer to reach",
@@ -115,21 +164,70 @@ var manualParamsDoc = map[string]map[string]string{
 		"state":   "The state of the EC2 Security Group to reach",
 		"timeout": "The time (in seconds) after which the check is failed",
 	},
+	"check.tcp": {
+		"host":     "The hostname or IP address to connect to",
+		"instance": "The ID of the instance whose private IP to connect to",
+		"port":     "The TCP port that must accept connections",
+		"timeout":  "The time (in seconds or as a duration, ex: 5m) after which the check is failed",
+	},
+	"attach.servicecontrolpolicy": {
+		"id":     "The ID of the service control policy (ex: p-examplepolicyid111)",
+		"target": "The ID of the root, organizational unit or account the policy applies to",
+	},
+	"check.transitgateway": {
+		"id":      "The ID of the transit gateway to check",
+		"state":   "The state of the transit gateway to reach",
+		"timeout": "The time (in seconds) after which the check is failed",
+	},
+	"check.transitgatewayattachment": {
+		"id":      "The ID of the transit gateway attachment to check",
+		"state":   "The state of the transit gateway attachment to reach",
+		"timeout": "The time (in seconds) after which the check is failed",
+	},
+	"check.transitgatewayroutetable": {
+		"id":      "The ID of the transit gateway route table to check",
+		"state":   "The state of the transit gateway route table to reach",
+		"timeout": "The time (in seconds) after which the check is failed",
+	},
 	"check.volume": {
 		"id":      "The ID of the EC2 Volume to check",
 		"state":   "The state of the EC2 Volume to reach",
 		"timeout": "The time (in seconds) after which the check is failed",
 	},
+	"check.vpnconnection": {
+		"id":      "The ID of the VPN connection to check",
+		"state":   "The state of the VPN connection to reach",
+		"timeout": "The time (in seconds) after which the check is failed",
+	},
+	"copy.image": {
+		"region":        "The region the AMI is copied to, the current region by default",
+		"source-region": "The region of the AMI to copy, the current region by default",
+	},
 	"create.accesskey": {
 		"user":      "The name of the user for which the access key will be generated",
 		"save":      "Use 'true' to save the access key in ~/.aws/credentials under 'user' profile; use 'false' to disable the prompt",
 		"no-prompt": "Deprecated - use the save param",
 	},
+	"create.account": {
+		"email":          "The email address of the owner of the new member account, unique across AWS",
+		"name":           "The friendly name of the member account",
+		"role":           "The name of the IAM role created in the new account and assumable by the master account (default: OrganizationAccountAccessRole)",
+		"billing-access": "Whether IAM users of the new account can access its billing information: allow or deny (default: allow)",
+		"timeout":        "The time (in seconds) to wait for the asynchronous creation of the account to complete (default: 600)",
+	},
 	"create.alarm": {
 		"operator":           "The arithmetic operation to use when comparing the specified statistic and threshold",
 		"statistic-function": "The statistic for the metric associated with the alarm, other than percentile",
 		"unit":               "The unit of measure for the statistic",
 	},
+	"create.alias": {
+		"key":  "The ID or ARN of the KMS key the alias refers to",
+		"name": "The name of the alias, prefixed with 'alias/' when omitted (ex: alias/backups or backups)",
+	},
+	"create.application": {
+		"name":        "The name of the Elastic Beanstalk application, unique in the region",
+		"description": "A description of the application",
+	},
 	"create.appscalingtarget": {
 		"dimension":         "The scalable dimension associated with the scalable target",
 		"resource":          "The identifier of the resource associated with the scalable target (eg. for ECS: service/cluster-name/service-deployment-name, for EC2 spot-fleet: spot-fleet-request/sfr-73fbd2ce-aa30-494c-8788-1cee4EXAMPLE, for EMR cluster: instancegroup/j-2EEZNYKUA1NTV/ig-1791Y4E1L8YI0, for AppStream 2.0 fleet: fleet/sample-fleet, for DynamoDB table: table/my-table, for DynamoDB global secondary index: table/my-table/index/my-table-index)",
@@ -150,10 +248,103 @@ var manualParamsDoc = map[string]map[string]string{
 		"acl":  "The canned ACL to apply to the bucket",
 		"name": "The name of bucket to create",
 	},
+	"create.cachecluster": {
+		"id":               "The identifier of the cache cluster, unique in the region (1 to 20 alphanumeric characters or hyphens)",
+		"engine":           "The cache engine of the cluster: redis or memcached",
+		"type":             "The compute and memory capacity of the nodes (ex: cache.t2.micro, cache.m4.large)",
+		"count":            "The number of cache nodes: 1 for Redis, 1 to 20 for Memcached",
+		"subnetgroup":      "The name of the cache subnet group in which to create the cluster",
+		"securitygroups":   "The IDs of the VPC security groups of the cluster",
+		"port":             "The port on which the nodes accept connections (defaults to 6379 for Redis and 11211 for Memcached)",
+		"version":          "The version of the cache engine",
+		"availabilityzone": "The availability zone in which to create the nodes",
+	},
+	"create.cachesubnetgroup": {
+		"description": "The description for the cache subnet group",
+		"name":        "The name for the cache subnet group",
+		"subnets":     "The EC2 Subnet IDs for the cache subnet group",
+	},
+	"create.catalogdatabase": {
+		"name":        "The name of the Glue Data Catalog database (lowercase)",
+		"description": "A description of the database",
+		"location":    "The location of the database (ex: s3://my-bucket/datalake/)",
+	},
 	"create.certificate": {
+		"domain":             "The fully qualified domain name (FQDN) of the certificate, in place of domains when there is a single one",
 		"domains":            "Main and Additional Fully qualified domain names (FQDNs) to be included in the Certificate name and Subject Alternative Name of the ACM Certificate",
+		"validation":         "The method to validate the ownership of the domains: by email (default) or by DNS records",
+		"zone":               "The ID of the hosted zone in which to create the DNS records validating the domains (with validation=dns)",
 		"validation-domains": "The domain name that you want ACM to use to send you validation emails. This domain name is the suffix of the email addresses that you want ACM to use. This must be the same as the DomainName value or a superdomain of the domain value",
 	},
+	"create.cluster": {
+		"id":               "The identifier of the Redshift cluster, unique in the account (lowercase alphanumeric characters or hyphens)",
+		"type":             "The node type of the cluster (ex: dc2.large, ds2.xlarge)",
+		"nodes":            "The number of compute nodes of the cluster: 1 for a single-node cluster, 2 or more for a multi-node cluster (defaults to 1)",
+		"username":         "The user name of the master user of the cluster",
+		"password":         "The password of the master user of the cluster, of 8 to 64 characters with at least an uppercase letter, a lowercase letter and a number",
+		"dbname":           "The name of the first database created in the cluster (defaults to dev)",
+		"subnetgroup":      "The name of the cluster subnet group in which to create the cluster in a VPC",
+		"securitygroups":   "The IDs of the VPC security groups of the cluster",
+		"availabilityzone": "The availability zone in which to create the cluster",
+		"port":             "The port on which the cluster accepts connections (defaults to 5439)",
+		"public":           "Set to true for the cluster to be reachable from outside its VPC",
+		"encrypted":        "Set to true to encrypt the data of the cluster at rest",
+	},
+	"create.classicloadbalancer": {
+		"certificate":       "The ARN of the server certificate of the listener, for HTTPS and SSL listeners",
+		"healthcheck":       "The health check of the instances as PROTOCOL:PORT[/PATH] (ex: HTTP:80/health, TCP:22)",
+		"instance-port":     "The port on which the instances listen",
+		"instance-protocol": "The protocol to route traffic to the instances: HTTP, HTTPS, TCP or SSL (defaults to the protocol of the listener)",
+		"name":              "The name of the classic load balancer, unique in the region",
+		"port":              "The port on which the load balancer listens",
+		"protocol":          "The protocol of the listener: HTTP, HTTPS, TCP or SSL",
+		"scheme":            "The nodes of an internet-facing load balancer have public IP addresses (internet-facing or internal)",
+		"securitygroups":    "The IDs of the security groups to assign to the load balancer",
+		"subnets":           "The IDs of the subnets to attach to the load balancer, one per availability zone",
+	},
+	"create.containerservice": {
+		"cluster":                     "The short name or full Amazon Resource Name (ARN) of the cluster on which to run the service",
+		"name":                        "The name of the service",
+		"containertask":               "The family and revision (family:revision) or full ARN of the task definition to run in the service",
+		"desired-count":               "The number of instantiations of the task to place and keep running in the service",
+		"launch-type":                 "The launch type on which to run the service: 'ec2' or 'fargate'",
+		"subnets":                     "The IDs of the subnets of the tasks of the service. Required with launch-type=fargate",
+		"securitygroups":              "The IDs of the security groups of the tasks of the service",
+		"public-ip":                   "Whether the tasks of the service receive a public IP address",
+		"role":                        "The name or full Amazon Resource Name (ARN) of the IAM role that allows Amazon ECS to make calls to your load balancer on your behalf",
+		"loadbalancer.container-name": "The name of the container (as it appears in a container definition) to associate with the load balancer",
+		"loadbalancer.container-port": "The port on the container to associate with the load balancer",
+		"loadbalancer.targetgroup":    "The full Amazon Resource Name (ARN) of the Elastic Load Balancing target group associated with the service",
+	},
+	"create.computeenvironment": {
+		"name":           "The name of the Batch compute environment",
+		"type":           "The type of the compute environment (managed or unmanaged). AWS Batch provisions the instances of managed environments",
+		"service-role":   "The name or ARN of the IAM role allowing AWS Batch to make calls to other services on your behalf",
+		"resource-type":  "The type of instances launched in a managed compute environment (EC2 or SPOT)",
+		"min-vcpus":      "The minimum number of vCPUs the managed compute environment maintains",
+		"max-vcpus":      "The maximum number of vCPUs the managed compute environment can reach",
+		"desired-vcpus":  "The number of vCPUs the managed compute environment should start with",
+		"instance-types": "The instances types that may be launched (ex: [m4.large,c4.xlarge] or [optimal] to pick from the latest C, M and R families)",
+		"instance-role":  "The name or ARN of the instance profile attached to the launched instances",
+		"subnets":        "The subnets in which the instances are launched",
+		"securitygroups": "The security groups applied to the launched instances",
+		"keypair":        "The name of the keypair used to access the launched instances",
+		"bid-percentage": "The maximum percentage of the On-Demand price paid for Spot instances",
+		"spotfleet-role": "The ARN of the Amazon EC2 Spot Fleet IAM role applied to a SPOT compute environment",
+	},
+	"create.crawler": {
+		"name":         "The name of the Glue crawler",
+		"role":         "The name or ARN of the IAM role allowing the crawler to access the data and the Data Catalog",
+		"database":     "The name of the Data Catalog database in which the crawler writes the discovered tables",
+		"targets":      "The S3 paths crawled (ex: [s3://my-bucket/logs/,s3://my-bucket/events/])",
+		"schedule":     "A cron expression to run the crawler periodically (ex: 'cron(15 12 * * ? *)'), the crawler running on demand when not set",
+		"table-prefix": "The prefix added to the names of the created tables",
+		"description":  "A description of the crawler",
+	},
+	"create.customergateway": {
+		"name": "The 'Name' Tag for the customer gateway to create",
+		"type": "The type of VPN connection supported by the customer gateway, ipsec.1 by default",
+	},
 	"create.database": {
 		"autoupgrade":        "Set to true to indicate that minor version patches are applied automatically",
 		"availabilityzone":   "Specifies the name of the Availability Zone the DB instance is located in",
@@ -188,13 +379,25 @@ var manualParamsDoc = map[string]map[string]string{
 		"version":            "Indicates the database engine version",
 		"vpcsecuritygroups":  "A list of EC2 VPC security groups to associate with this DB instance",
 	},
+	"create.dbparametergroup": {
+		"description": "The description for the DB parameter group",
+		"family":      "The DB parameter group family name (ex: mysql5.7, postgres9.6), a DB parameter group can be associated with one and only one family",
+		"name":        "The name of the DB parameter group",
+	},
 	"create.dbsubnetgroup": {
 		"description": "The description for the DB subnet group",
 		"name":        "The name for the DB subnet group",
 		"subnets":     "The EC2 Subnet IDs for the DB subnet group",
 	},
+	"create.deployment": {
+		"restapi":     "The ID of the REST API to deploy",
+		"stage":       "The name of the stage the deployment is made callable in, created if it does not exist (ex: prod)",
+		"description": "A description of the deployment",
+	},
 	"create.distribution": {
 		"origin-domain":   "The DNS name of the Amazon S3 bucket from which you want CloudFront to get objects for this origin, for example, myawsbucket.s3.amazonaws.com",
+		"origin":          "The S3 bucket, or the load balancer (name, arn or DNS name) of the local graph, from which CloudFront gets the objects",
+		"cache-behaviors": "A list of PATTERN[:TTL] cache behaviors, caching the objects matching the path pattern for TTL seconds (ex: [/images/*:86400,/api/*:0])",
 		"certificate":     "The Amazon Resource Name (ARN) of the AWS Certificate Manager (ACM) certificate you want to use for TSL connection",
 		"comment":         "Any comments you want to include about the distribution",
 		"default-file":    "The object that you want CloudFront to request from your origin when a viewer requests the root URL for your distribution (http://www.example.com)",
@@ -210,29 +413,79 @@ var manualParamsDoc = map[string]map[string]string{
 	"create.elasticip": {
 		"domain": "Set to vpc to allocate the address for use with instances in a VPC else the address is for use with instances in EC2-Classic",
 	},
+	"create.environment": {
+		"application":    "The name of the Elastic Beanstalk application the environment belongs to",
+		"name":           "The name of the environment, unique in the account",
+		"solution-stack": "The name of the platform running the environment (ex: '64bit Amazon Linux 2017.09 v2.8.4 running Docker 17.09.1-ce')",
+		"version":        "The label of the application version to deploy, the sample application being deployed when not set",
+		"cname":          "The prefix of the CNAME of the environment (ex: my-app for my-app.eu-west-1.elasticbeanstalk.com)",
+		"description":    "A description of the environment",
+		"options":        "The configuration option settings of the environment given using this format: [namespace:option=value,...] (ex: ['aws:autoscaling:asg:MinSize=2','aws:elasticbeanstalk:application:environment:STAGE=prod'])",
+	},
 	"create.function": {
 		"bucket":        "Amazon S3 bucket name where the .zip file containing your deployment package is stored. This bucket must reside in the same AWS region where you are creating the Lambda function",
 		"object":        "The Amazon S3 object (the deployment package) key name you want to upload",
 		"objectversion": "The Amazon S3 object (the deployment package) version you want to upload",
 		"runtime":       "The runtime environment for the Lambda function you are uploading",
 		"zipfile":       "The path toward the zip file containing your deployment package",
+		"role":          "The ARN, id or name of the IAM role that Lambda assumes when it executes your function",
+		"environment":   "The environment variables of the function, as a list of key:value (ex: [STAGE:prod,DEBUG:false])",
+	},
+	"create.grant": {
+		"grantee":    "The ARN of the principal (ex: role, user) allowed to use the key",
+		"key":        "The ID or ARN of the KMS key",
+		"name":       "A friendly name identifying the grant",
+		"operations": "The operations the grant allows (ex: Encrypt, Decrypt, GenerateDataKey, ReEncryptFrom, ReEncryptTo, CreateGrant, RetireGrant, DescribeKey)",
+		"retiree":    "The ARN of the principal allowed to retire the grant",
 	},
 	"create.group": {
 		"name": "The name of the group to create",
 	},
+	"create.host": {
+		"auto-placement": "Whether the host accepts untargeted instance launches matching its instance type (on) or only launches targeting it (off)",
+		"type":           "The instance type the dedicated host supports, a single dedicated host being allocated",
+	},
 	"create.instance": {
-		"count":  "The number of instances to launch",
-		"name":   "The name of the instance to launch",
-		"role":   "The name of the instance profile (role) to launch the instance with",
-		"image":  "The ID of an AMI for the instance to be launched",
-		"distro": "The distro query to resolve official community free bare distro AMI from current region. See `awless search images -h`",
+		"count":         "The number of instances to launch",
+		"name":          "The name of the instance to launch",
+		"role":          "The name or the ARN of the instance profile (role) to launch the instance with",
+		"image":         "The ID of an AMI for the instance to be launched",
+		"distro":        "The distro query to resolve official community free bare distro AMI from current region. See `awless search images -h`",
+		"host":          "The ID of the dedicated host to launch the instance on, the tenancy defaulting then to host",
+		"userdata":      "The user data to make available to the instance: a local file path, a remote URL, an inline script starting with # or its content with file(path)",
+		"block-devices": "The EBS volumes to launch the instance with, as device:size[:type[:iops]] in GiB (ex: [/dev/xvda:30,/dev/sdb:500:io1:5000]), deleted on instance termination",
+		"tags":          "The tags applied at launch on the instance and its volumes, as key:value (ex: [env:prod,team:web])",
 	},
 	"create.image": {
-		"reboot": "True to shut down and reboot the instance before creating the image, otherwise no reboot and file system integrity on the created image cannot be guaranteed",
+		"reboot":       "True to shut down and reboot the instance before creating the image, otherwise no reboot and file system integrity on the created image cannot be guaranteed",
+		"snapshot":     "The EBS snapshot of the root volume of the image, registered as an HVM image instead of creating the image of an instance",
+		"architecture": "The architecture of the image registered from a snapshot: i386, x86_64 (default) or arm64",
+	},
+	"create.invalidation": {
+		"distribution": "The ID of the distribution whose cached objects are invalidated",
+		"path":         "The path, or list of paths, of the objects to invalidate, with an optional trailing * wildcard (ex: /*, [/index.html,/css/*])",
+	},
+	"create.jobqueue": {
+		"name":                "The name of the Batch job queue",
+		"priority":            "The priority of the job queue, queues with a higher priority being evaluated first when sharing compute environments",
+		"computeenvironments": "The names or ARNs of the compute environments of the queue, in the order in which the scheduler should try them",
+	},
+	"create.key": {
+		"description": "A description of the KMS key",
+		"policy":      "The key policy as a JSON document, AWS giving full access to the account when not set",
 	},
 	"create.keypair": {
 		"name":      "The name of the keypair to create (it will also be the name of the file stored in ~/.awless/keys)",
-		"encrypted": "Set to 'true' if you want to encrypt the keypair"},
+		"encrypted": "Set to 'true' if you want to encrypt the keypair (RSA keys only)",
+		"type":      "The type of the key generated locally: rsa (4096 bits, default) or ed25519",
+		"import":    "Path of an existing SSH public key to import instead of generating a key pair (ex: ~/.ssh/id_ed25519.pub)"},
+	"create.kubernetescluster": {
+		"name":           "The name of the EKS cluster, running the Kubernetes control plane",
+		"role":           "The IAM role allowing EKS to manage AWS resources for Kubernetes, as ARN or as name of a synced role",
+		"subnets":        "The subnets, in at least two availability zones, where EKS places the network interfaces of the control plane",
+		"securitygroups": "The security groups of the network interfaces of the control plane",
+		"version":        "The Kubernetes version of the cluster, quoted to be kept as text (ex: '1.10'), the latest one available when not set",
+	},
 	"create.launchconfiguration": {
 		"distro": "The distro query to resolve official community bare distro AMI from current region. See `awless search images -h`",
 		"public": "Used for groups that launch instances into a virtual private cloud (VPC). Specifies whether to assign a public IP address to each instance",
@@ -244,34 +497,102 @@ var manualParamsDoc = map[string]map[string]string{
 		"protocol":    "The protocol for connections from clients to the load balancer",
 		"sslpolicy":   "The security policy that defines which ciphers and protocols are supported",
 	},
+	"create.loggroup": {
+		"name":      "The name of the log group, unique in the region (ex: /aws/lambda/my-function, my-app/access-logs)",
+		"key":       "The Amazon Resource Name (ARN) of the KMS key used to encrypt the log events",
+		"retention": "The number of days the log events are kept (1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1827 or 3653), never expiring when not set",
+	},
+	"create.method": {
+		"restapi":       "The ID of the REST API of the resource",
+		"resource":      "The ID of the resource the method is added to",
+		"http-method":   "The HTTP verb of the method (GET, POST, PUT, PATCH, DELETE, HEAD, OPTIONS or ANY)",
+		"function":      "The ARN of the Lambda function the requests are proxied to. The function must allow its invocation by API Gateway",
+		"authorization": "The type of authorization of the method callers (NONE, AWS_IAM, CUSTOM or COGNITO_USER_POOLS), defaults to NONE",
+	},
 	"create.mfadevice": {
 		"name": "The name of the virtual MFA device",
 	},
+	"create.natgateway": {
+		"elasticip-id": "The allocation ID of an Elastic IP address to associate with the NAT gateway. When not given, a new Elastic IP address is allocated",
+	},
+	"create.networkacl": {
+		"name": "The 'Name' Tag for the network ACL to create",
+	},
+	"create.networkaclrule": {
+		"networkacl": "The ID of the network ACL",
+		"number":     "The rule number (1-32766), rules being evaluated in increasing number order",
+		"action":     "Whether to allow or deny the traffic that matches the rule",
+		"protocol":   "The protocol: tcp, udp, icmp, icmpv6 or any",
+		"cidr":       "The IPv4 or IPv6 network range to allow or deny",
+		"portrange":  "The portrange for TCP/UDP rules: any, 80, 1024-65535...",
+		"outbound":   "Whether the rule applies to traffic leaving the subnet, false (inbound rule) by default",
+	},
+	"create.networkinterface": {
+		"secondary-privateips": "The secondary private IPv4 addresses to assign to the network interface once created",
+	},
+	"create.parameter": {
+		"name":        "The fully qualified name of the parameter, hierarchies being separated by '/' (ex: /my-app/db-password)",
+		"value":       "The value of the parameter",
+		"description": "A description of the parameter",
+		"secure":      "Set to 'true' to encrypt the value as a SecureString, to be used for secrets",
+		"key":         "The ID or ARN of the KMS key encrypting a secure parameter, the account default key being used when not set",
+	},
+	"create.peeringconnection": {
+		"name":        "The 'Name' Tag for the VPC peering connection to create",
+		"peer-owner":  "The AWS account ID of the owner of the accepter VPC, the current account by default",
+		"peer-region": "The region of the accepter VPC, the current region by default",
+	},
 	"create.policy": {
-		"name":        "The friendly name of the policy",
-		"description": "A friendly description of the policy",
-		"effect":      "The Effect element is required and specifies whether the policy will result in an allow or an explicit deny",
-		"action":      "The Action elements describing the actions that will be allowed or denied. You specify a value using a namespace that identifies a service followed by the name of the action to allow or deny (eg. sqs:SendMessage, s3:*). Use a list for multiple actions",
-		"resource":    "The Amazon Resource Name (ARN) of the Resource element which specifies the object or objects that the policy covers",
-		"conditions":  "List of conditions necessary for the policy to be in effect (e.g. [aws:UserAgent!=My user agent,s3:prefix=~home/,aws:CurrentTime>=2013-06-30T00:00:00Z,aws:SourceIp!=203.0.113.0/24,aws:SourceArn==arn:aws:sns:eu-west-1:*:*])",
+		"name":          "The friendly name of the policy",
+		"description":   "A friendly description of the policy",
+		"effect":        "The Effect element is required and specifies whether the policy will result in an allow or an explicit deny",
+		"action":        "The Action elements describing the actions that will be allowed or denied. You specify a value using a namespace that identifies a service followed by the name of the action to allow or deny (eg. sqs:SendMessage, s3:*). Use a list for multiple actions",
+		"resource":      "The Amazon Resource Name (ARN) of the Resource element which specifies the object or objects that the policy covers",
+		"conditions":    "List of conditions necessary for the policy to be in effect (e.g. [aws:UserAgent!=My user agent,s3:prefix=~home/,aws:CurrentTime>=2013-06-30T00:00:00Z,aws:SourceIp!=203.0.113.0/24,aws:SourceArn==arn:aws:sns:eu-west-1:*:*])",
+		"document":      "The JSON policy document given inline, instead of the effect, action and resource of its statement",
+		"document-file": "The path to the file containing the JSON policy document, instead of the effect, action and resource of its statement",
+	},
+	"create.policyversion": {
+		"document-file": "The path to the file containing the JSON policy document of the version",
+	},
+	"create.presignedurl": {
+		"bucket": "The name of the bucket of the object",
+		"key":    "The key of the object",
+		"expiry": "The validity of the URL, in seconds or as a duration up to 7 days (ex: 3600, 1h, defaults to 1h)",
+		"method": "get to download the object with the URL (default), put to upload it",
 	},
 	"create.queue": {
-		"delay":              "The length of time, in seconds, for which the delivery of all messages in the queue is delayed. Valid values: An integer from 0 to 900 seconds (15 minutes). The default is 0",
-		"max-msg-size":       "The limit of how many bytes a message can contain before Amazon SQS rejects it. Valid values: An integer from 1024 bytes (1 KiB) to 262144 bytes (256 KiB). The default is 262144 (256 KiB)",
-		"retention-period":   "The length of time, in seconds, for which Amazon SQS retains a message. Valid values: An integer from 60 seconds (1 minute) to 1209600 seconds (14 days). The default is 345600 (4 days)",
-		"policy":             "The queue's policy",
-		"msg-wait":           "The length of time, in seconds, for which a ReceiveMessage action waits for a message to arrive. Valid values: An integer from 0 to 20 (seconds). The default is 0",
-		"redrive-policy":     "The parameters for the dead letter queue functionality of the source queue",
-		"visibility-timeout": "The visibility timeout for the queue. Valid values: An integer from 0 to 43200 (12 hours). The default is 30",
+		"delay":                 "The length of time, in seconds, for which the delivery of all messages in the queue is delayed. Valid values: An integer from 0 to 900 seconds (15 minutes). The default is 0",
+		"max-msg-size":          "The limit of how many bytes a message can contain before Amazon SQS rejects it. Valid values: An integer from 1024 bytes (1 KiB) to 262144 bytes (256 KiB). The default is 262144 (256 KiB)",
+		"retention-period":      "The length of time, in seconds, for which Amazon SQS retains a message. Valid values: An integer from 60 seconds (1 minute) to 1209600 seconds (14 days). The default is 345600 (4 days)",
+		"policy":                "The queue's policy",
+		"msg-wait":              "The length of time, in seconds, for which a ReceiveMessage action waits for a message to arrive. Valid values: An integer from 0 to 20 (seconds). The default is 0",
+		"redrive-policy":        "The parameters for the dead letter queue functionality of the source queue",
+		"visibility-timeout":    "The visibility timeout for the queue. Valid values: An integer from 0 to 43200 (12 hours). The default is 30",
+		"fifo":                  "Set to true to create a FIFO queue, whose name must end with .fifo",
+		"content-deduplication": "Set to true to enable content-based deduplication of the messages of a FIFO queue",
+		"dead-letter-queue":     "The URL, ARN or name of the queue receiving the messages failing to be processed max-receive-count times. Cannot be used with redrive-policy",
+		"max-receive-count":     "The number of times a message is received before being moved to the dead letter queue. The default is 5",
 	},
 	"create.record": {
-		"zone":    "The ID of the hosted zone that contains the resource record sets that you want to change",
-		"name":    "The name of the domain you want to perform the action on. Enter a fully qualified domain name, for example, www.example.com. You can optionally include a trailing dot",
-		"type":    "The DNS record type",
-		"value":   "The new DNS record value",
-		"values":  "The new DNS record value(s)",
-		"ttl":     "The resource record cache time to live (TTL), in seconds",
-		"comment": "Any comments you want to include about a change batch request",
+		"alias":      "The load balancer (name, arn or DNS name) or CloudFront distribution (id or domain) the record is an alias of, resolved from the local graph, or any DNS name given with alias-zone",
+		"alias-zone": "The hosted zone of the alias target, when not a load balancer or a distribution of the local graph",
+		"zone":       "The ID of the hosted zone that contains the resource record sets that you want to change",
+		"name":       "The name of the domain you want to perform the action on. Enter a fully qualified domain name, for example, www.example.com. You can optionally include a trailing dot",
+		"type":       "The DNS record type",
+		"value":      "The new DNS record value",
+		"values":     "The new DNS record value(s)",
+		"ttl":        "The resource record cache time to live (TTL), in seconds",
+		"comment":    "Any comments you want to include about a change batch request",
+	},
+	"create.resource": {
+		"restapi": "The ID of the REST API of the resource",
+		"path":    "The last part of the path of the resource (ex: greetings, or '{name}' quoted for a path parameter)",
+		"parent":  "The ID of the parent resource, the root resource '/' of the API when not set",
+	},
+	"create.restapi": {
+		"name":        "The name of the REST API",
+		"description": "A description of the REST API",
 	},
 	"create.role": {
 		"conditions":        "List of conditions necessary for the policy to be in effect (e.g. [aws:UserAgent!=My user agent,s3:prefix=~home/,aws:CurrentTime>=2013-06-30T00:00:00Z,aws:SourceIp!=203.0.113.0/24,aws:SourceArn==arn:aws:sns:eu-west-1:*:*])",
@@ -281,11 +602,22 @@ var manualParamsDoc = map[string]map[string]string{
 		"principal-service": "The AWS Service that can assume this role to perform actions and access resources of the role (e.g. 'ec2.amazonaws.com')",
 		"sleep-after":       "The amount of time in seconds you want to wait after creating the role (usually used to be sure that the role creation has been propagated)",
 	},
+	"create.rule": {
+		"name":        "The name of the rule, unique in the region",
+		"schedule":    "The schedule of the rule, as a cron or rate expression (ex: 'cron(0 2 * * ? *)', 'rate(5 minutes)')",
+		"pattern":     "The JSON event pattern of the events matched by the rule (ex: '{\"source\":[\"aws.ec2\"]}')",
+		"description": "The description of the rule",
+		"role":        "The ARN of the IAM role used by the rule to send the events to its targets",
+		"enabled":     "Set to false to create the rule disabled (defaults to true)",
+	},
 	"create.s3object": {
-		"bucket": "Name of the bucket to which object will be added",
-		"file":   "The path toward to file to upload",
-		"name":   "The name of the Object to create (by default the file name is used)",
-		"acl":    "The canned ACL to apply to the object",
+		"bucket":      "Name of the bucket to which object will be added",
+		"file":        "The path toward to file to upload, or to a directory whose files are uploaded recursively",
+		"name":        "The name of the Object to create (by default the file name is used), or the prefix of the keys of the files of a directory (at the root of the bucket by default)",
+		"acl":         "The canned ACL to apply to the object",
+		"bwlimit":     "The max bandwidth of the upload in bytes per second, with an optional K, M or G unit (ex: 5MB/s)",
+		"connections": "The number of parts of the file uploaded concurrently (files larger than 5MB only)",
+		"sync":        "Set to true to only upload the files of the directory missing from the bucket, of a different size or modified since their upload",
 	},
 	"create.scalinggroup": {
 		"healthcheck-type": "The service to use for the health checks",
@@ -294,23 +626,72 @@ var manualParamsDoc = map[string]map[string]string{
 		"adjustment-type":    "The adjustment type",
 		"adjustment-scaling": "The amount by which to scale, based on the specified adjustment type (e.g. '-2', '3')",
 	},
+	"create.secret": {
+		"name":        "The name of the secret, hierarchies being separated by '/' (ex: my-app/db-password)",
+		"value":       "The value of the secret, encrypted with KMS",
+		"description": "A description of the secret",
+		"key":         "The ID or ARN of the KMS key encrypting the secret, the account default key being used when not set",
+	},
+	"create.spotfleet": {
+		"capacity":       "The number of instances the fleet launches and, when persistent, maintains",
+		"fleet-role":     "The ARN of the IAM role granting the Spot fleet permission to launch and terminate instances on your behalf",
+		"image":          "The ID of the AMI of the instances",
+		"types":          "The instance types of the fleet: their pools in each of the given subnets are the ones the fleet launches instances in",
+		"subnets":        "The subnets (of different availability zones) the fleet can launch instances in",
+		"price":          "The maximum hourly price paid for an instance of the fleet, the On-Demand price by default",
+		"strategy":       "How instances are allocated across the pools: lowestprice (default) or diversified",
+		"keypair":        "The name of the key pair of the instances",
+		"securitygroups": "The IDs of the security groups of the instances",
+		"userdata":       "The user data to make available to the instances",
+		"role":           "The name of the instance profile (role) to launch the instances with",
+		"interruption":   "The behavior of interrupted instances: terminate (default), or stop and hibernate for persistent fleets",
+		"persistent":     "True for the fleet to maintain its capacity by replacing interrupted instances, otherwise the request is one-time",
+	},
+	"create.spotinstance": {
+		"name":         "The name of the spot instance request",
+		"price":        "The maximum hourly price paid for the instance, the On-Demand price by default",
+		"role":         "The name of the instance profile (role) to launch the instance with",
+		"interruption": "The behavior of interrupted instances: terminate (default), or stop and hibernate for persistent requests",
+		"persistent":   "True for the request to stay open and relaunch the instance after its interruption, otherwise the request is one-time",
+	},
 	"create.stack": {
 		"capabilities":  "A list of values that you must specify before AWS CloudFormation can create certain stacks",
 		"on-failure":    "Determines what action will be taken if stack creation fails",
 		"parameters":    "A list of Parameters that specify input parameters for the stack given using this format: [key1:val1,key2:val2,...]",
 		"policy-file":   "The path to the file containing the stack policy body",
 		"template-file": "The path to the file containing the template body with a minimum size of 1 byte and a maximum size of 51,200 bytes",
+		"template-url":  "The S3 URL of the file containing the template body with a maximum size of 460,800 bytes (ex: https://s3.amazonaws.com/mybucket/template.yml)",
 		"stack-file":    "The path to the file containing Parameters/Tags/StackPolices definition (http://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/continuous-delivery-codepipeline-cfn-artifacts.html#w2ab2c13c15c15). Values passed via CLI has higher priority than ones defined in StackFile",
 	},
+	"create.stage": {
+		"name":        "The name of the stage, part of the URL of the API (ex: prod)",
+		"restapi":     "The ID of the REST API of the stage",
+		"deployment":  "The ID of the deployment served by the stage",
+		"description": "A description of the stage",
+	},
+	"create.statemachine": {
+		"name":       "The name of the state machine",
+		"definition": "The Amazon States Language definition of the state machine, usually given with file(path) (ex: definition=file(./definition.json))",
+		"role":       "The name or ARN of the IAM role assumed by Step Functions to run the state machine",
+	},
 	"create.subnet": {
 		"name":   "The 'Name' Tag for the subnet to create",
 		"public": "A value (true) to indicate that network interfaces created in this subnet should be assigned a public IPv4 address (instances, etc.)",
 	},
 	"create.subscription": {
 		"endpoint": "The endpoint that you want to receive notifications. Endpoints vary by protocol: For the http or https protocol, the endpoint is a URL beginning with 'http://' or 'https://', for the email or email-json protocol, the endpoint is an email address, for the sms protocol, the endpoint is a phone number of an SMS-enabled, for the sqs protocol, the endpoint is the ARN of an Amazon SQS queue, for the application protocol, the endpoint is the EndpointArn of a mobile app and device, for the lambda protocol, the endpoint is the ARN of an AWS Lambda function",
-		"protocol": "The protocol you want to use",
+		"protocol": "The protocol you want to use: http, https, email, email-json, sms, sqs, application or lambda",
 		"topic":    "The ARN of the topic you want to subscribe to",
 	},
+	"create.table": {
+		"name":           "The name of the table to create",
+		"hash-key":       "The name of the attribute used as partition key of the table",
+		"hash-key-type":  "The type of the partition key attribute: string (default), number or binary",
+		"range-key":      "The name of the attribute used as sort key of the table",
+		"range-key-type": "The type of the sort key attribute: string (default), number or binary",
+		"read-capacity":  "The maximum number of strongly consistent reads consumed per second (default 5)",
+		"write-capacity": "The maximum number of writes consumed per second (default 5)",
+	},
 	"create.tag": {
 		"resource": "The ID of the resource on which you want to add a tag",
 		"key":      "The Tag key",
@@ -319,9 +700,56 @@ var manualParamsDoc = map[string]map[string]string{
 	"create.targetgroup": {
 		"matcher": "The HTTP codes to use when checking for a successful response from a target",
 	},
+	"create.trail": {
+		"name":        "The name of the trail",
+		"bucket":      "The name of the S3 bucket receiving the log files, its policy allowing CloudTrail to write in it",
+		"prefix":      "The S3 key prefix of the log files",
+		"multiregion": "Set to 'true' to record the events of all regions",
+		"key":         "The ID or ARN of the KMS key encrypting the log files",
+	},
+	"create.transitgateway": {
+		"name":                "The name of the transit gateway",
+		"description":         "A description of the transit gateway",
+		"amazon-asn":          "The private Autonomous System Number (ASN) of the Amazon side of a BGP session (default: 64512)",
+		"dns-support":         "Whether to enable DNS resolution of public hostnames to private IPs across the attached VPCs (default: enable)",
+		"vpn-ecmp-support":    "Whether to enable Equal Cost Multipath Protocol support between VPN connections (default: enable)",
+		"default-association": "Whether the attachments are associated with the default route table automatically (default: enable)",
+		"default-propagation": "Whether the attachments propagate routes to the default route table automatically (default: enable)",
+		"auto-accept":         "Whether the attachment requests of other accounts are accepted automatically (default: disable)",
+	},
+	"create.transitgatewayattachment": {
+		"transitgateway": "The ID of the transit gateway",
+		"vpc":            "The ID of the VPC to attach",
+		"subnets":        "The subnets, one per availability zone, where the transit gateway places its network interfaces in the VPC",
+		"name":           "The name of the transit gateway attachment",
+		"dns-support":    "Whether to enable DNS support for the attachment (default: enable)",
+		"ipv6-support":   "Whether to enable IPv6 support for the attachment (default: disable)",
+	},
+	"create.transitgatewayroutetable": {
+		"transitgateway": "The ID of the transit gateway",
+		"name":           "The name of the transit gateway route table",
+	},
 	"create.vpc": {
 		"name": "The 'Name' Tag for the VPC to create",
 	},
+	"create.vpcendpoint": {
+		"policy":         "The JSON policy document controlling access to the service, full access by default (gateway endpoints only)",
+		"private-dns":    "True to associate a private hosted zone with the VPC, resolving the default DNS name of the service to the endpoint (interface endpoints only)",
+		"routetables":    "The route tables routing the traffic to the service through the endpoint (gateway endpoints only)",
+		"securitygroups": "The security groups of the endpoint network interfaces (interface endpoints only)",
+		"service":        "The name of the service, for instance com.amazonaws.eu-west-1.s3",
+		"subnets":        "The subnets in which to create the endpoint network interfaces, one per availability zone (interface endpoints only)",
+		"type":           "The type of endpoint: gateway (S3 and DynamoDB, by default) or interface",
+	},
+	"create.vpnconnection": {
+		"config-file": "The path of the file in which to save the customer gateway configuration (tunnels, pre-shared keys, ...) returned once the connection is created",
+		"name":        "The 'Name' Tag for the VPN connection to create",
+		"type":        "The type of VPN connection, ipsec.1 by default",
+	},
+	"create.vpngateway": {
+		"name": "The 'Name' Tag for the virtual private gateway to create",
+		"type": "The type of VPN connection supported by the virtual private gateway, ipsec.1 by default",
+	},
 	"create.zone": {
 		"comment":   "Any comments that you want to include about the hosted zone",
 		"isprivate": "A value that indicates whether this is a private hosted zone",
@@ -334,30 +762,76 @@ var manualParamsDoc = map[string]map[string]string{
 	"delete.alarm": {
 		"name": "The name of the alarm(s) to be deleted",
 	},
+	"delete.alias": {
+		"name": "The name of the alias, prefixed with 'alias/' when omitted",
+	},
+	"delete.application": {
+		"name":  "The name of the application to be deleted",
+		"force": "Set to true to terminate the running environments of the application, deletion failing otherwise",
+	},
 	"delete.bucket": {
 		"name": "The name of the bucket to be deleted",
 	},
+	"delete.catalogdatabase": {
+		"name": "The name of the Data Catalog database to delete, along with all its tables",
+	},
+	"delete.cachecluster": {
+		"id": "The ID of the cache cluster to delete",
+	},
+	"delete.cachesubnetgroup": {
+		"name": "The name of the cache subnet group to delete",
+	},
+	"delete.cluster": {
+		"id":            "The identifier of the Redshift cluster to delete",
+		"skip-snapshot": "Set to true to delete the cluster without taking a final snapshot",
+		"snapshot":      "The identifier of the final snapshot taken before deleting the cluster, required unless skip-snapshot=true",
+	},
+	"delete.classicloadbalancer": {
+		"name": "The name of the classic load balancer",
+	},
+	"delete.containerservice": {
+		"cluster": "The short name or full Amazon Resource Name (ARN) of the cluster of the service",
+		"name":    "The name of the service to be deleted. Its tasks are stopped first",
+	},
 	"delete.containertask": {
 		"name":         "The name of the containertask to be deleted",
 		"all-versions": "Set to 'true' to delete all existing versions of the containertask to be deleted",
 	},
+	"delete.computeenvironment": {
+		"id": "The name or ARN of the compute environment to delete, disabled first if needed",
+	},
+	"delete.crawler": {
+		"name": "The name of the crawler to delete",
+	},
 	"delete.database": {
 		"id":            "The ID of the database to be deleted",
 		"skip-snapshot": "Determines whether a final DB snapshot is created before the DB instance is deleted. If true is specified, no DBSnapshot is created. If false is specified, a DB snapshot is created before the DB instance is deleted",
 		"snapshot":      "The ID of the new DBSnapshot created when skip-snapshot=false",
 	},
+	"delete.dbparametergroup": {
+		"name": "The name of the DB parameter group to be deleted",
+	},
 	"delete.dbsubnetgroup": {
 		"name": "The name of the database subnet group to be deleted",
 	},
+	"delete.deployment": {
+		"id":      "The ID of the deployment to be deleted, not served by any stage",
+		"restapi": "The ID of the REST API of the deployment",
+	},
 	"delete.distribution": {
 		"id": "The ID of the distribution to be deleted",
 	},
 	"delete.function": {
 		"id": "The ID of the Lambda function to be deleted",
 	},
+	"delete.grant": {
+		"id":  "The ID of the grant to revoke",
+		"key": "The ID or ARN of the KMS key of the grant",
+	},
 	"delete.image": {
 		"id":               "The ID of the AMI to be deleted",
 		"delete-snapshots": "Set to 'true' to also delete the snapshots created from this image",
+		"region":           "The region of the AMI, the current region by default",
 	},
 	"delete.instance": {
 		"ids": "The ID(s) of the instance(s) to be deleted",
@@ -366,44 +840,130 @@ var manualParamsDoc = map[string]map[string]string{
 	"delete.internetgateway": {
 		"id": "The ID of the Internet gateway to be deleted",
 	},
+	"delete.jobdefinition": {
+		"id": "The ARN or name:revision of the job definition to deregister",
+	},
+	"delete.jobqueue": {
+		"id": "The name or ARN of the job queue to delete, disabled first if needed",
+	},
+	"delete.key": {
+		"id":           "The ID or ARN of the KMS key to delete",
+		"pending-days": "The number of days (7 to 30, 30 by default) AWS waits before deleting the key",
+	},
 	"delete.keypair": {
 		"name": "The name of the key pair to be deleted",
 	},
+	"delete.kubernetescluster": {
+		"name": "The name of the EKS cluster to be deleted, once its worker nodes are deleted",
+	},
 	"delete.launchconfiguration": {
 		"name": "The name of the launch configuration to be deleted",
 	},
+	"delete.loggroup": {
+		"name": "The name of the log group to be deleted, with all its log streams and events",
+	},
+	"delete.method": {
+		"restapi":     "The ID of the REST API of the resource",
+		"resource":    "The ID of the resource of the method",
+		"http-method": "The HTTP verb of the method to be deleted",
+	},
+	"delete.natgateway": {
+		"id":                "The ID of the NAT gateway",
+		"release-elasticip": "True to release the Elastic IP addresses of the NAT gateway once it is deleted",
+	},
+	"delete.networkaclrule": {
+		"networkacl": "The ID of the network ACL",
+		"number":     "The number of the rule to delete",
+		"outbound":   "Whether the rule to delete is an outbound rule, false by default",
+	},
+	"delete.parameter": {
+		"name": "The name of the parameter to be deleted",
+	},
 	"delete.policy": {
 		"all-versions": "Set to 'true' to delete all existing versions of the policy to be deleted",
 	},
+	"delete.policyversion": {
+		"version": "The ID of the version to delete (ex: v2), the default version of a policy cannot be deleted",
+	},
 	"delete.record": {
-		"id":     "The awless id (cf `awless list records`) of the record to delete",
-		"zone":   "The ID of the hosted zone that contains the resource record sets that you want to delete",
-		"name":   "The name of the domain you want to perform the action on. Enter a fully qualified domain name, for example, www.example.com. You can optionally include a trailing dot",
-		"type":   "The DNS record type",
-		"value":  "The DNS record value to delete",
-		"values": "The DNS record value(s) to delete",
-		"ttl":    "The resource record cache time to live (TTL), in seconds",
+		"alias":      "The alias target of the record to delete (see create record)",
+		"alias-zone": "The hosted zone of the alias target, when not a load balancer or a distribution of the local graph",
+		"id":         "The awless id (cf `awless list records`) of the record to delete",
+		"zone":       "The ID of the hosted zone that contains the resource record sets that you want to delete",
+		"name":       "The name of the domain you want to perform the action on. Enter a fully qualified domain name, for example, www.example.com. You can optionally include a trailing dot",
+		"type":       "The DNS record type",
+		"value":      "The DNS record value to delete",
+		"values":     "The DNS record value(s) to delete",
+		"ttl":        "The resource record cache time to live (TTL), in seconds",
+	},
+	"delete.resource": {
+		"id":      "The ID of the resource to be deleted, with its child resources and methods",
+		"restapi": "The ID of the REST API of the resource",
+	},
+	"delete.restapi": {
+		"id": "The ID of the REST API to be deleted, with all its resources, deployments and stages",
 	},
 	"delete.role": {
 		"name": "The name of the role to be deleted",
 	},
+	"delete.rule": {
+		"name": "The name of the rule to be deleted, which must not have targets anymore",
+	},
 	"delete.s3object": {
 		"bucket": "The name of the bucket containing the object to be deleted",
 		"name":   "The name (i.e. key) of the object to be deleted",
 	},
+	"delete.secret": {
+		"name":            "The name or ARN of the secret to be deleted",
+		"force":           "Set to 'true' to delete the secret immediately, without any recovery window",
+		"recovery-window": "The number of days (7 to 30, 30 by default) the secret can be restored before being permanently deleted",
+	},
+	"delete.stage": {
+		"name":    "The name of the stage to be deleted",
+		"restapi": "The ID of the REST API of the stage",
+	},
+	"delete.statemachine": {
+		"id": "The ARN of the state machine to be deleted",
+	},
+	"delete.table": {
+		"name": "The name of the table to delete",
+	},
 	"delete.tag": {
 		"resource": "The ID of the resource on which you want to remove a tag",
 		"key":      "The Tag key",
 		"value":    "The Tag value",
 	},
+	"delete.trail": {
+		"id": "The name or ARN of the trail to be deleted",
+	},
+	"delete.transitgateway": {
+		"id": "The ID of the transit gateway to delete",
+	},
+	"delete.transitgatewayattachment": {
+		"id": "The ID of the transit gateway attachment to delete",
+	},
+	"delete.transitgatewayroutetable": {
+		"id": "The ID of the transit gateway route table to delete",
+	},
+	"delete.vpcendpoint": {
+		"id": "The ID of the VPC endpoint",
+	},
 	"detach.alarm": {
 		"name":       "The name of the alarm",
 		"action-arn": "The Amazon Resource Name (ARN) to be detached of the ALARM actions",
 	},
+	"detach.classicloadbalancer": {
+		"instance": "The ID of the instance to deregister from the classic load balancer",
+		"name":     "The name of the classic load balancer",
+	},
 	"detach.containertask": {
 		"container-name": "The name of the container to detach",
 		"name":           "The name of the existing container task containing the container to detach",
 	},
+	"detach.elasticip": {
+		"association": "The association ID of the elastic IP",
+		"id":          "The allocation ID of the elastic IP to detach from its instance or network interface",
+	},
 	"detach.instance": {
 		"id": "The ID of the instance to be detached from target group",
 	},
@@ -411,6 +971,9 @@ var manualParamsDoc = map[string]map[string]string{
 		"instance": "The ID of the Instance",
 		"name":     "The name of the InstanceProfile to detach from the Instance",
 	},
+	"detach.networkacl": {
+		"subnet": "The ID of the subnet to associate back with the default network ACL of its VPC",
+	},
 	"detach.networkinterface": {
 		"attachment": "The ID of the attachment",
 		"force":      "Specifies whether to force a detachment",
@@ -430,6 +993,45 @@ var manualParamsDoc = map[string]map[string]string{
 		"id":       "The ID of the security group",
 		"instance": "The ID of the instance to be detached",
 	},
+	"detach.servicecontrolpolicy": {
+		"id":     "The ID of the service control policy",
+		"target": "The ID of the root, organizational unit or account to detach the policy from",
+	},
+	"detach.target": {
+		"rule": "The name of the rule to remove the target from",
+		"id":   "The ID of the target in the rule",
+	},
+	"detach.transitgatewayroutetable": {
+		"id":         "The ID of the transit gateway route table",
+		"attachment": "The ID of the transit gateway attachment to disassociate from the route table",
+	},
+	"disable.key": {
+		"id": "The ID or ARN of the KMS key to disable",
+	},
+	"download.s3object": {
+		"bucket":  "The name of the bucket containing the object to download",
+		"name":    "The key of the object to download",
+		"file":    "The path of the local file written, or of the directory it is written in (defaults to the name of the object in the current directory)",
+		"version": "The version of the object to download (the latest by default)",
+	},
+	"enable.key": {
+		"id": "The ID or ARN of the KMS key to enable",
+	},
+	"get.consoleoutput": {
+		"instance": "The ID of the instance",
+		"file":     "The local file to write the console output to, otherwise written on stdout",
+	},
+	"get.screenshot": {
+		"instance": "The ID of the instance",
+		"file":     "The local JPG file to write the screenshot to (default: INSTANCE-ID.jpg)",
+		"wake-up":  "True to wake up the display of the instance before the screenshot",
+	},
+	"invoke.function": {
+		"id":      "The name or ARN of the Lambda function to invoke",
+		"payload": "The JSON event given to the function",
+		"async":   "Specify true to invoke the function asynchronously, without waiting for its response",
+		"version": "The version or alias of the function to invoke",
+	},
 	"import.image": {
 		"architecture": "The architecture of the virtual machine",
 		"url":          "The URL to the Amazon S3-based disk image being imported. The URL can either be a https URL (https://..) or an Amazon S3 URL (s3://..)",
@@ -439,12 +1041,41 @@ var manualParamsDoc = map[string]map[string]string{
 		"license":      "The license type to be used for the Amazon Machine Image (AMI) after importing",
 		"platform":     "The operating system of the virtual machine",
 	},
+	"move.account": {
+		"id":          "The ID of the account to move",
+		"destination": "The ID of the root or organizational unit to move the account to",
+		"source":      "The ID of the root or organizational unit currently containing the account (default: looked up from the account)",
+	},
+	"reboot.instance": {
+		"id": "The ID of the instance to be rebooted",
+	},
+	"register.jobdefinition": {
+		"name":       "The name of the job definition, registering it again creating a new revision",
+		"image":      "The Docker image used to start the container of the jobs",
+		"vcpus":      "The number of vCPUs reserved for the container",
+		"memory":     "The hard limit of memory (in MiB) presented to the container",
+		"command":    "The command passed to the container, with optional Ref::param placeholders (ex: [echo,Ref::message])",
+		"role":       "The name or ARN of the IAM role the container can assume for AWS permissions",
+		"parameters": "The default values of the Ref::param placeholders of the command using this format: [key1:val1,key2:val2,...]",
+		"retries":    "The number of times a failed job is attempted (between 1 and 10)",
+	},
+	"resize.cluster": {
+		"id":    "The identifier of the Redshift cluster to resize",
+		"nodes": "The new number of compute nodes of the cluster",
+		"type":  "The new node type of the cluster",
+	},
 	"restart.instance": {
 		"id": "The ID of the instance to be restarted",
 	},
 	"restart.database": {
 		"with-failover": "When true, the reboot is conducted through a MultiAZ failover",
 	},
+	"run.command": {
+		"instance": "The ID of the instance running the SSM agent to run the script on",
+		"script":   "The shell script to run on the instance, usually given with file() (ex: script=file(./setup.sh))",
+		"comment":  "A description of the command, listed with the commands run on the instance",
+		"timeout":  "The number of seconds to wait for the script to complete (default: 600)",
+	},
 	"start.containertask": {
 		"cluster":                     "The short name or full Amazon Resource Name (ARN) of the cluster on which to run your task",
 		"type":                        "The type of task to launch",
@@ -455,19 +1086,49 @@ var manualParamsDoc = map[string]map[string]string{
 		"name":            "The name of the container task to start",
 		"deployment-name": "The deployment name of the service (e.g. prod, staging...)",
 		"role":            "The name or full Amazon Resource Name (ARN) of the IAM role that allows Amazon ECS to make calls to your load balancer on your behalf",
+		"launch-type":     "The launch type on which to run the task or service: 'ec2' or 'fargate'",
+		"subnets":         "The IDs of the subnets of the tasks. Required with launch-type=fargate",
+		"securitygroups":  "The IDs of the security groups of the tasks",
+		"public-ip":       "Whether the tasks receive a public IP address",
 	},
 	"start.instance": {
 		"id": "The ID of the instance to be started",
 	},
+	"start.execution": {
+		"statemachine": "The ARN of the state machine to execute",
+		"name":         "The name of the execution, unique for the state machine (a UUID is generated when not set)",
+		"input":        "The JSON input of the execution, usually given with file(path)",
+	},
+	"start.query": {
+		"query":    "The SQL query run by Athena, usually given with file(path) (ex: query=file(./report.sql))",
+		"output":   "The S3 location where Athena stores the results of the query (ex: s3://my-bucket/athena-results/)",
+		"database": "The Data Catalog database in which the query runs, when its tables are not qualified",
+		"timeout":  "The maximum time to wait for the query to complete, in seconds or as a duration (ex: 300 or 5m). Defaults to 30 minutes",
+	},
 	"stop.containertask": {
 		"cluster":         "The short name or full Amazon Resource Name (ARN) of the cluster on which to run your task",
 		"type":            "The type of task to launch",
 		"deployment-name": "The deployment name of the service (e.g. prod, staging...)",
 		"run-arn":         "The ID or full Amazon Resource Name (ARN) entry of the run of the task to stop",
 	},
+	"stop.execution": {
+		"id":    "The ARN of the execution to be stopped",
+		"cause": "A description of the cause of the stop",
+		"error": "An error code identifying the cause of the stop",
+	},
 	"stop.instance": {
 		"id": "The ID of the instance to be stopped",
 	},
+	"submit.job": {
+		"name":       "The name of the job",
+		"queue":      "The name or ARN of the job queue the job is submitted to",
+		"definition": "The name, name:revision or ARN of the job definition of the job, the latest active revision being used when not given",
+		"parameters": "The values of the Ref::param placeholders of the command overriding those of the definition, using this format: [key1:val1,key2:val2,...]",
+		"command":    "The command overriding the one of the job definition",
+	},
+	"terminate.environment": {
+		"id": "The ID of the environment to be terminated",
+	},
 	"update.bucket": {
 		"name":              "The name of the bucket to update",
 		"acl":               "The canned ACL to apply to the bucket",
@@ -475,10 +1136,39 @@ var manualParamsDoc = map[string]map[string]string{
 		"redirect-hostname": "Hostname where HTTP requests will be redirected when publishing website",
 		"index-suffix":      "A suffix that is appended to a request that is for a directory on the website endpoint",
 		"enforce-https":     "Use HTTPS rather than HTTP when redirecting requests",
+		"error-document":    "The key of the object returned by the website on 4XX errors (ex: 404.html)",
+		"policy":            "The bucket policy as a JSON document, or 'none' to remove it",
+		"versioning":        "The versioning state of the bucket: enabled or suspended",
+		"cors":              "The CORS configuration as a JSON document (ex: {\"CORSRules\":[{\"AllowedMethods\":[\"GET\"],\"AllowedOrigins\":[\"*\"]}]}), or 'none' to remove it",
+		"lifecycle":         "The lifecycle configuration as a JSON document (ex: {\"Rules\":[{\"Status\":\"Enabled\",\"Filter\":{\"Prefix\":\"logs/\"},\"Expiration\":{\"Days\":30}}]}), or 'none' to remove it",
+		"encryption":        "The default encryption of the objects: aes256, kms or none to remove it",
+		"kms-key":           "The ID, ARN or alias of the KMS key encrypting the objects with encryption=kms (the AWS managed key by default)",
+	},
+	"update.environment": {
+		"id":      "The ID of the environment to update",
+		"version": "The label of the application version to deploy",
+		"options": "The configuration option settings to change given using this format: [namespace:option=value,...]",
+	},
+	"update.function": {
+		"id":            "The name or ARN of the Lambda function to update",
+		"bucket":        "Amazon S3 bucket name where the .zip file containing the new deployment package is stored",
+		"object":        "The Amazon S3 object (the deployment package) key name of the new code",
+		"objectversion": "The Amazon S3 object (the deployment package) version of the new code",
+		"zipfile":       "The path toward the zip file containing the new deployment package",
+		"publish":       "Specify true to publish a new version of the function after updating its code",
+		"handler":       "The function within your code that Lambda calls to begin execution",
+		"role":          "The ARN, id or name of the IAM role that Lambda assumes when it executes your function",
+		"runtime":       "The runtime environment for the Lambda function",
+		"description":   "A short, user-defined function description",
+		"memory":        "The amount of memory, in MB, your Lambda function is given",
+		"timeout":       "The function execution time at which Lambda should terminate the function",
+		"environment":   "The environment variables of the function, replacing the existing ones, as a list of key:value (ex: [STAGE:prod,DEBUG:false])",
 	},
 	"update.distribution": {
 		"id":              "The ID of the distribution to update",
 		"origin-domain":   "The DNS name of the Amazon S3 bucket from which you want CloudFront to get objects for this origin, for example, myawsbucket.s3.amazonaws.com",
+		"origin":          "The S3 bucket, or the load balancer (name, arn or DNS name) of the local graph, from which CloudFront gets the objects",
+		"cache-behaviors": "A list of PATTERN[:TTL] cache behaviors replacing the existing ones, caching the objects matching the path pattern for TTL seconds (ex: [/images/*:86400,/api/*:0])",
 		"certificate":     "The Amazon Resource Name (ARN) of the AWS Certificate Manager (ACM) certificate you want to use for TSL connection",
 		"comment":         "Any comments you want to include about the distribution",
 		"default-file":    "The object that you want CloudFront to request from your origin when a viewer requests the root URL for your distribution (http://www.example.com)",
@@ -492,6 +1182,7 @@ var manualParamsDoc = map[string]map[string]string{
 		"enable":          "Enable/Disable the distribution",
 	},
 	"update.image": {
+		"account":       "The AWS account ID whose launch permission is added or removed",
 		"accounts":      "List (one or more) AWS account IDs",
 		"description":   "A new description for the AMI",
 		"id":            "The ID of the AMI",
@@ -500,23 +1191,55 @@ var manualParamsDoc = map[string]map[string]string{
 		"product-codes": "One or more DevPay product codes. After adding a product code, it cannot be removed",
 	},
 	"update.instance": {
-		"type": "Changes the instance type to the specified value",
+		"type": "Changes the instance type to the specified value, a running instance being stopped then started back",
+	},
+	"update.loggroup": {
+		"name":      "The name of the log group to update",
+		"retention": "The number of days the log events are kept (1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1827 or 3653), 0 for the events to never expire",
+	},
+	"update.networkaclrule": {
+		"networkacl": "The ID of the network ACL",
+		"number":     "The number of the rule to update",
+		"action":     "Whether to allow or deny the traffic that matches the rule",
+		"protocol":   "The protocol: tcp, udp, icmp, icmpv6 or any",
+		"cidr":       "The IPv4 or IPv6 network range to allow or deny",
+		"portrange":  "The portrange for TCP/UDP rules: any, 80, 1024-65535...",
+		"outbound":   "Whether the rule to update is an outbound rule, false by default",
+	},
+	"update.networkinterface": {
+		"id":                "The ID of the network interface",
+		"description":       "The new description of the network interface",
+		"securitygroups":    "The IDs of the security groups replacing the current ones of the network interface",
+		"source-dest-check": "Whether source/destination checking is enabled on the network interface",
+	},
+	"update.parameter": {
+		"name":        "The name of the parameter to be updated",
+		"value":       "The new value of the parameter",
+		"description": "The new description of the parameter",
+		"secure":      "Set to 'true' to store the value as a SecureString, the current type of the parameter being kept when not set",
+		"key":         "The ID or ARN of the KMS key encrypting a secure parameter",
 	},
+
 	"update.policy": {
-		"arn":        "The Amazon Resource Name (ARN) of the IAM policy you want to attach",
-		"effect":     "The Effect element is required and specifies whether the policy will result in an allow or an explicit deny",
-		"action":     "The Action elements describing the actions that will be allowed or denied. You specify a value using a namespace that identifies a service followed by the name of the action to allow or deny (eg. sqs:SendMessage, s3:*). Use a list for multiple actions",
-		"resource":   "The Amazon Resource Name (ARN) of the Resource element which specifies the object or objects that the policy covers",
-		"conditions": "List of conditions necessary for the policy to be in effect (e.g. [aws:UserAgent!=My user agent,s3:prefix=~home/,aws:CurrentTime>=2013-06-30T00:00:00Z,aws:SourceIp!=203.0.113.0/24,aws:SourceArn==arn:aws:sns:eu-west-1:*:*])",
+		"arn":             "The Amazon Resource Name (ARN) of the IAM policy you want to attach",
+		"effect":          "The Effect element is required and specifies whether the policy will result in an allow or an explicit deny",
+		"action":          "The Action elements describing the actions that will be allowed or denied. You specify a value using a namespace that identifies a service followed by the name of the action to allow or deny (eg. sqs:SendMessage, s3:*). Use a list for multiple actions",
+		"resource":        "The Amazon Resource Name (ARN) of the Resource element which specifies the object or objects that the policy covers",
+		"conditions":      "List of conditions necessary for the policy to be in effect (e.g. [aws:UserAgent!=My user agent,s3:prefix=~home/,aws:CurrentTime>=2013-06-30T00:00:00Z,aws:SourceIp!=203.0.113.0/24,aws:SourceArn==arn:aws:sns:eu-west-1:*:*])",
+		"document":        "The JSON policy document given inline replacing the current one. No version is created when its statements are the ones of the current document",
+		"document-file":   "The path to the file containing the JSON policy document replacing the current one. No version is created when its statements are the ones of the current document",
+		"default-version": "The ID of an existing version of the policy to set as default (ex: v1)",
 	},
 	"update.record": {
-		"zone":    "The ID of the hosted zone that contains the resource record sets that you want to change",
-		"name":    "The name of the domain you want to perform the action on. Enter a fully qualified domain name, for example, www.example.com. You can optionally include a trailing dot",
-		"type":    "The DNS record type",
-		"value":   "The current or new DNS record value",
-		"values":  "The current or new DNS record value(s)",
-		"ttl":     "The resource record cache time to live (TTL), in seconds",
-		"comment": "Any comments you want to include about a change batch request",
+		"alias":      "The load balancer (name, arn or DNS name) or CloudFront distribution (id or domain) the record is an alias of, resolved from the local graph, or any DNS name given with alias-zone",
+		"alias-zone": "The hosted zone of the alias target, when not a load balancer or a distribution of the local graph",
+		"zone":       "The ID of the hosted zone that contains the resource record sets that you want to change",
+		"name":       "The name of the domain you want to perform the action on. Enter a fully qualified domain name, for example, www.example.com. You can optionally include a trailing dot",
+		"type":       "The DNS record type",
+		"value":      "The current or new DNS record value",
+		"values":     "The current or new DNS record value(s)",
+		"ttl":        "The resource record cache time to live (TTL), in seconds",
+		"comment":    "Any comments you want to include about a change batch request",
 	},
 	"update.s3object": {
 		"acl":     "The canned ACL to apply to the bucket",
@@ -524,14 +1247,23 @@ var manualParamsDoc = map[string]map[string]string{
 		"name":    "The name of the object to be updated",
 		"version": "Used to reference a specific version of the object",
 	},
+	"update.secret": {
+		"name":        "The name or ARN of the secret to be updated",
+		"value":       "The new value of the secret, stored in a new version",
+		"description": "The new description of the secret",
+		"key":         "The ID or ARN of the KMS key encrypting the new versions of the secret",
+	},
 	"update.securitygroup": {
-		"id":            "The ID of the security group to be updated",
-		"cidr":          "The CIDR IPv4 address range",
-		"securitygroup": "The ID of the source security group. Cannot be used when using cidr param",
-		"protocol":      "The IP protocol name or number",
-		"inbound":       "Set inbound to either authorize or revoke, to update the security group ingress rules",
-		"outbound":      "Set outbound to either authorize or revoke, to update the security group egress rules",
-		"portrange":     "The portrange for the rule to update: any, 80, 22-23...",
+		"id":             "The ID of the security group to be updated",
+		"cidr":           "The CIDR IPv4 or IPv6 address range",
+		"securitygroup":  "The ID of the source security group. Cannot be used when using cidr param",
+		"source":         "The source of the rule: a CIDR, a security group ID or sg:STACK/NAME for the security group named NAME of another stack",
+		"protocol":       "The IP protocol name or number",
+		"inbound":        "Set inbound to either authorize or revoke, to update the security group ingress rules",
+		"outbound":       "Set outbound to either authorize or revoke, to update the security group egress rules",
+		"portrange":      "The portrange for the rule to update: any, 80, 22-23...",
+		"inbound-rules":  "The full set of ingress rules of the security group as [protocol:portrange:source,...] (ex: [tcp:22:10.0.0.0/8,any:any:sg-123456]) or none: missing rules are authorized and extra rules revoked",
+		"outbound-rules": "The full set of egress rules of the security group as [protocol:portrange:source,...] (ex: [any:any:0.0.0.0/0]) or none: missing rules are authorized and extra rules revoked",
 	},
 	"update.stack": {
 		"capabilities":       "A list of values that you must specify before AWS CloudFormation can update certain stacks",
@@ -539,8 +1271,26 @@ var manualParamsDoc = map[string]map[string]string{
 		"policy-file":        "The path to the file containing the stack policy body",
 		"policy-update-file": "The path to the file containing the temporary overriding stack policy",
 		"template-file":      "The path to the file containing the template body with a minimum size of 1 byte and a maximum size of 51,200 bytes",
+		"template-url":       "The S3 URL of the file containing the template body with a maximum size of 460,800 bytes (ex: https://s3.amazonaws.com/mybucket/template.yml)",
 		"stack-file":         "The path to the file containing Parameters/Tags/StackPolices definition (http://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/continuous-delivery-codepipeline-cfn-artifacts.html#w2ab2c13c15c15). Values passed via CLI has higher priority than ones defined in StackFile",
 	},
+	"update.statemachine": {
+		"id":         "The ARN of the state machine to be updated",
+		"definition": "The new Amazon States Language definition of the state machine",
+		"role":       "The name or ARN of the new IAM role assumed by Step Functions to run the state machine",
+	},	"update.subnet": {
+		"id":          "The ID of the subnet",
+		"public":      "Specify true to indicate that network interfaces created in the specified subnet should be assigned a public IPv4 address",
+		"ipv6":        "The IPv6 network range to associate with the subnet, in CIDR notation (a /64 prefix within the IPv6 CIDR block of its VPC)",
+		"assign-ipv6": "Specify true to indicate that network interfaces created in the specified subnet should be assigned an IPv6 address",
+	},
+	"update.table": {
+		"name":           "The name of the table to update",
+		"read-capacity":  "The new maximum number of strongly consistent reads consumed per second",
+		"write-capacity": "The new maximum number of writes consumed per second",
+		"ttl-attribute":  "The name of the attribute holding the expiration time (in epoch seconds) of the items",
+		"ttl-enabled":    "Specify true to enable Time To Live on the table, false to disable it (default true)",
+	},
 	"update.targetgroup": {
 		"id": "The Amazon Resource Name (ARN) of the target group",
 		"deregistrationdelay": "The amount time for Elastic Load Balancing to wait before changing the state of a deregistering target from draining to unused. The range is 0-3600 seconds. The default value is 300 seconds",
@@ -558,4 +1308,22 @@ var manualParamsDoc = map[string]map[string]string{
 		"stickiness":          "Indicates whether sticky sessions (of type load balancer cookies) are enabled",
 		"stickinessduration":  "The time period, in seconds, during which requests from a client should be routed to the same target. After this time period expires, the load balancer-generated cookie is considered stale. The range is 1 second to 1 week (604800 seconds). The default value is 1 day (86400 seconds)",
 	},
+	"update.vpc": {
+		"id":   "The ID of the VPC",
+		"ipv6": "Set to 'auto' to associate an Amazon-provided IPv6 CIDR block with a /56 prefix length to the VPC",
+	},
+	"wait.certificate": {
+		"arn":     "The Amazon Resource Name (ARN) of the certificate to wait for",
+		"state":   "The state of the certificate to reach (default issued)",
+		"timeout": "The time (seconds or duration, ex: 45m) after which the wait for the validation is failed (default 30m)",
+	},
+	"wait.distribution": {
+		"id":      "The ID of the CloudFront Distribution to wait for",
+		"timeout": "The time (seconds or duration, ex: 45m) after which the wait for the deployment is failed (default 30m)",
+	},
+	"wait.kubernetescluster": {
+		"name":    "The name of the EKS cluster to wait for, its endpoint and certificate authority data being returned as JSON",
+		"state":   "The state of the cluster to reach (default active)",
+		"timeout": "The time (seconds or duration, ex: 45m) after which the wait for the control plane is failed (default 30m)",
+	},
 }
@@ -0,0 +1,91 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ec2instanceconnect is a client of the AWS EC2 Instance Connect API (version 2018-04-02),
+// limited to the calls and fields used by awless.
+//
+// The vendored aws-sdk-go (1.12.55) predates this service, which the SDK added in 1.20.11.
+// The types mirror service/ec2instanceconnect of aws-sdk-go 1.20.11. Unlike the SDK, the service
+// name is the endpoint ID, which is also the signing name and the key of its rate limit.
+// Replace this package with the SDK one when bumping the vendored aws-sdk-go past this version.
+package ec2instanceconnect
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/private/protocol/jsonrpc"
+)
+
+const (
+	ServiceName = "ec2-instance-connect"
+	EndpointsID = ServiceName
+)
+
+// EC2InstanceConnect provides the API operation methods for making requests to AWS EC2 Instance Connect
+type EC2InstanceConnect struct {
+	*client.Client
+}
+
+// New creates a new instance of the EC2InstanceConnect client with a session
+func New(p client.ConfigProvider, cfgs ...*aws.Config) *EC2InstanceConnect {
+	c := p.ClientConfig(EndpointsID, cfgs...)
+	svc := &EC2InstanceConnect{
+		Client: client.New(
+			*c.Config,
+			metadata.ClientInfo{
+				ServiceName:   ServiceName,
+				SigningName:   c.SigningName,
+				SigningRegion: c.SigningRegion,
+				Endpoint:      c.Endpoint,
+				APIVersion:    "2018-04-02",
+				JSONVersion:   "1.1",
+				TargetPrefix:  "AWSEC2InstanceConnectService",
+			},
+			c.Handlers,
+		),
+	}
+	svc.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	svc.Handlers.Build.PushBackNamed(jsonrpc.BuildHandler)
+	svc.Handlers.Unmarshal.PushBackNamed(jsonrpc.UnmarshalHandler)
+	svc.Handlers.UnmarshalMeta.PushBackNamed(jsonrpc.UnmarshalMetaHandler)
+	svc.Handlers.UnmarshalError.PushBackNamed(jsonrpc.UnmarshalErrorHandler)
+	return svc
+}
+
+// SendSSHPublicKey pushes a SSH public key for an OS user of an instance, accepted for 60 seconds
+func (c *EC2InstanceConnect) SendSSHPublicKey(input *SendSSHPublicKeyInput) (*SendSSHPublicKeyOutput, error) {
+	if input == nil {
+		input = &SendSSHPublicKeyInput{}
+	}
+	output := &SendSSHPublicKeyOutput{}
+	op := &request.Operation{Name: "SendSSHPublicKey", HTTPMethod: "POST", HTTPPath: "/"}
+	return output, c.NewRequest(op, input, output).Send()
+}
+
+type SendSSHPublicKeyInput struct {
+	AvailabilityZone *string `min:"6" type:"string" required:"true"`
+	InstanceId       *string `min:"10" type:"string" required:"true"`
+	InstanceOSUser   *string `min:"1" type:"string" required:"true"`
+	SSHPublicKey     *string `min:"256" type:"string" required:"true"`
+}
+
+type SendSSHPublicKeyOutput struct {
+	RequestId *string `type:"string"`
+	Success   *bool   `type:"boolean"`
+}
@@ -0,0 +1,463 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ec2transitgateway is a client of the transit gateway calls of the Amazon EC2 API (version 2016-11-15),
+// limited to the calls and fields used by awless.
+//
+// The vendored aws-sdk-go (1.12.55) predates transit gateways, which the SDK added to service/ec2 in 1.15.83.
+// The types mirror service/ec2 of aws-sdk-go 1.15.83. The client sends its requests to the EC2 endpoint,
+// its service name being the one of EC2, which is also the key of its rate limit.
+// Replace this package with the SDK one when bumping the vendored aws-sdk-go past this version.
+package ec2transitgateway
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/private/protocol/ec2query"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+const (
+	ServiceName = "ec2"
+	EndpointsID = ServiceName
+)
+
+const (
+	TransitGatewayStatePending   = "pending"
+	TransitGatewayStateAvailable = "available"
+	TransitGatewayStateModifying = "modifying"
+	TransitGatewayStateDeleting  = "deleting"
+	TransitGatewayStateDeleted   = "deleted"
+)
+
+const (
+	TransitGatewayAttachmentStatePendingAcceptance = "pendingAcceptance"
+	TransitGatewayAttachmentStateRollingBack       = "rollingBack"
+	TransitGatewayAttachmentStatePending           = "pending"
+	TransitGatewayAttachmentStateAvailable         = "available"
+	TransitGatewayAttachmentStateModifying         = "modifying"
+	TransitGatewayAttachmentStateDeleting          = "deleting"
+	TransitGatewayAttachmentStateDeleted           = "deleted"
+	TransitGatewayAttachmentStateFailed            = "failed"
+	TransitGatewayAttachmentStateRejected          = "rejected"
+	TransitGatewayAttachmentStateRejecting         = "rejecting"
+	TransitGatewayAttachmentStateFailing           = "failing"
+)
+
+const (
+	TransitGatewayAttachmentResourceTypeVpc           = "vpc"
+	TransitGatewayAttachmentResourceTypeVpn           = "vpn"
+	TransitGatewayAttachmentResourceTypeDirectConnect = "direct-connect"
+)
+
+const (
+	TransitGatewayRouteTableStatePending   = "pending"
+	TransitGatewayRouteTableStateAvailable = "available"
+	TransitGatewayRouteTableStateDeleting  = "deleting"
+	TransitGatewayRouteTableStateDeleted   = "deleted"
+)
+
+// EC2TransitGateway provides the transit gateway operation methods for making requests to Amazon EC2
+type EC2TransitGateway struct {
+	*client.Client
+}
+
+// New creates a new instance of the EC2TransitGateway client with a session
+func New(p client.ConfigProvider, cfgs ...*aws.Config) *EC2TransitGateway {
+	c := p.ClientConfig(EndpointsID, cfgs...)
+	svc := &EC2TransitGateway{
+		Client: client.New(
+			*c.Config,
+			metadata.ClientInfo{
+				ServiceName:   ServiceName,
+				SigningName:   c.SigningName,
+				SigningRegion: c.SigningRegion,
+				Endpoint:      c.Endpoint,
+				APIVersion:    "2016-11-15",
+			},
+			c.Handlers,
+		),
+	}
+	svc.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	svc.Handlers.Build.PushBackNamed(ec2query.BuildHandler)
+	svc.Handlers.Unmarshal.PushBackNamed(ec2query.UnmarshalHandler)
+	svc.Handlers.UnmarshalMeta.PushBackNamed(ec2query.UnmarshalMetaHandler)
+	svc.Handlers.UnmarshalError.PushBackNamed(ec2query.UnmarshalErrorHandler)
+	return svc
+}
+
+func (c *EC2TransitGateway) send(name string, input, output interface{}) error {
+	return c.NewRequest(&request.Operation{Name: name, HTTPMethod: "POST", HTTPPath: "/"}, input, output).Send()
+}
+
+// CreateTransitGateway creates a transit gateway, usable once available
+func (c *EC2TransitGateway) CreateTransitGateway(input *CreateTransitGatewayInput) (*CreateTransitGatewayOutput, error) {
+	if input == nil {
+		input = &CreateTransitGatewayInput{}
+	}
+	output := &CreateTransitGatewayOutput{}
+	return output, c.send("CreateTransitGateway", input, output)
+}
+
+// DeleteTransitGateway deletes a transit gateway without attachments
+func (c *EC2TransitGateway) DeleteTransitGateway(input *DeleteTransitGatewayInput) (*DeleteTransitGatewayOutput, error) {
+	if input == nil {
+		input = &DeleteTransitGatewayInput{}
+	}
+	output := &DeleteTransitGatewayOutput{}
+	return output, c.send("DeleteTransitGateway", input, output)
+}
+
+// DescribeTransitGateways returns a page of transit gateways
+func (c *EC2TransitGateway) DescribeTransitGateways(input *DescribeTransitGatewaysInput) (*DescribeTransitGatewaysOutput, error) {
+	if input == nil {
+		input = &DescribeTransitGatewaysInput{}
+	}
+	output := &DescribeTransitGatewaysOutput{}
+	return output, c.send("DescribeTransitGateways", input, output)
+}
+
+// CreateTransitGatewayVpcAttachment attaches a VPC to a transit gateway through one subnet per availability zone
+func (c *EC2TransitGateway) CreateTransitGatewayVpcAttachment(input *CreateTransitGatewayVpcAttachmentInput) (*CreateTransitGatewayVpcAttachmentOutput, error) {
+	if input == nil {
+		input = &CreateTransitGatewayVpcAttachmentInput{}
+	}
+	output := &CreateTransitGatewayVpcAttachmentOutput{}
+	return output, c.send("CreateTransitGatewayVpcAttachment", input, output)
+}
+
+// DeleteTransitGatewayVpcAttachment detaches a VPC from a transit gateway
+func (c *EC2TransitGateway) DeleteTransitGatewayVpcAttachment(input *DeleteTransitGatewayVpcAttachmentInput) (*DeleteTransitGatewayVpcAttachmentOutput, error) {
+	if input == nil {
+		input = &DeleteTransitGatewayVpcAttachmentInput{}
+	}
+	output := &DeleteTransitGatewayVpcAttachmentOutput{}
+	return output, c.send("DeleteTransitGatewayVpcAttachment", input, output)
+}
+
+// DescribeTransitGatewayAttachments returns a page of attachments, of VPCs or VPN connections, to transit gateways
+func (c *EC2TransitGateway) DescribeTransitGatewayAttachments(input *DescribeTransitGatewayAttachmentsInput) (*DescribeTransitGatewayAttachmentsOutput, error) {
+	if input == nil {
+		input = &DescribeTransitGatewayAttachmentsInput{}
+	}
+	output := &DescribeTransitGatewayAttachmentsOutput{}
+	return output, c.send("DescribeTransitGatewayAttachments", input, output)
+}
+
+// CreateTransitGatewayRouteTable creates a route table in a transit gateway
+func (c *EC2TransitGateway) CreateTransitGatewayRouteTable(input *CreateTransitGatewayRouteTableInput) (*CreateTransitGatewayRouteTableOutput, error) {
+	if input == nil {
+		input = &CreateTransitGatewayRouteTableInput{}
+	}
+	output := &CreateTransitGatewayRouteTableOutput{}
+	return output, c.send("CreateTransitGatewayRouteTable", input, output)
+}
+
+// DeleteTransitGatewayRouteTable deletes a transit gateway route table without associations
+func (c *EC2TransitGateway) DeleteTransitGatewayRouteTable(input *DeleteTransitGatewayRouteTableInput) (*DeleteTransitGatewayRouteTableOutput, error) {
+	if input == nil {
+		input = &DeleteTransitGatewayRouteTableInput{}
+	}
+	output := &DeleteTransitGatewayRouteTableOutput{}
+	return output, c.send("DeleteTransitGatewayRouteTable", input, output)
+}
+
+// DescribeTransitGatewayRouteTables returns a page of transit gateway route tables
+func (c *EC2TransitGateway) DescribeTransitGatewayRouteTables(input *DescribeTransitGatewayRouteTablesInput) (*DescribeTransitGatewayRouteTablesOutput, error) {
+	if input == nil {
+		input = &DescribeTransitGatewayRouteTablesInput{}
+	}
+	output := &DescribeTransitGatewayRouteTablesOutput{}
+	return output, c.send("DescribeTransitGatewayRouteTables", input, output)
+}
+
+// AssociateTransitGatewayRouteTable routes the traffic of an attachment with a route table,
+// an attachment being associated with one route table at most
+func (c *EC2TransitGateway) AssociateTransitGatewayRouteTable(input *AssociateTransitGatewayRouteTableInput) (*AssociateTransitGatewayRouteTableOutput, error) {
+	if input == nil {
+		input = &AssociateTransitGatewayRouteTableInput{}
+	}
+	output := &AssociateTransitGatewayRouteTableOutput{}
+	return output, c.send("AssociateTransitGatewayRouteTable", input, output)
+}
+
+// DisassociateTransitGatewayRouteTable removes the association of an attachment with a route table
+func (c *EC2TransitGateway) DisassociateTransitGatewayRouteTable(input *DisassociateTransitGatewayRouteTableInput) (*DisassociateTransitGatewayRouteTableOutput, error) {
+	if input == nil {
+		input = &DisassociateTransitGatewayRouteTableInput{}
+	}
+	output := &DisassociateTransitGatewayRouteTableOutput{}
+	return output, c.send("DisassociateTransitGatewayRouteTable", input, output)
+}
+
+type TransitGateway struct {
+	CreationTime      *time.Time             `locationName:"creationTime" type:"timestamp" timestampFormat:"iso8601"`
+	Description       *string                `locationName:"description" type:"string"`
+	Options           *TransitGatewayOptions `locationName:"options" type:"structure"`
+	OwnerId           *string                `locationName:"ownerId" type:"string"`
+	State             *string                `locationName:"state" type:"string" enum:"TransitGatewayState"`
+	Tags              []*ec2.Tag             `locationName:"tagSet" locationNameList:"item" type:"list"`
+	TransitGatewayArn *string                `locationName:"transitGatewayArn" type:"string"`
+	TransitGatewayId  *string                `locationName:"transitGatewayId" type:"string"`
+}
+
+type TransitGatewayOptions struct {
+	AmazonSideAsn                  *int64  `locationName:"amazonSideAsn" type:"long"`
+	AssociationDefaultRouteTableId *string `locationName:"associationDefaultRouteTableId" type:"string"`
+	AutoAcceptSharedAttachments    *string `locationName:"autoAcceptSharedAttachments" type:"string" enum:"AutoAcceptSharedAttachmentsValue"`
+	DefaultRouteTableAssociation   *string `locationName:"defaultRouteTableAssociation" type:"string" enum:"DefaultRouteTableAssociationValue"`
+	DefaultRouteTablePropagation   *string `locationName:"defaultRouteTablePropagation" type:"string" enum:"DefaultRouteTablePropagationValue"`
+	DnsSupport                     *string `locationName:"dnsSupport" type:"string" enum:"DnsSupportValue"`
+	PropagationDefaultRouteTableId *string `locationName:"propagationDefaultRouteTableId" type:"string"`
+	VpnEcmpSupport                 *string `locationName:"vpnEcmpSupport" type:"string" enum:"VpnEcmpSupportValue"`
+}
+
+type TransitGatewayRequestOptions struct {
+	AmazonSideAsn                *int64  `type:"long"`
+	AutoAcceptSharedAttachments  *string `type:"string" enum:"AutoAcceptSharedAttachmentsValue"`
+	DefaultRouteTableAssociation *string `type:"string" enum:"DefaultRouteTableAssociationValue"`
+	DefaultRouteTablePropagation *string `type:"string" enum:"DefaultRouteTablePropagationValue"`
+	DnsSupport                   *string `type:"string" enum:"DnsSupportValue"`
+	VpnEcmpSupport               *string `type:"string" enum:"VpnEcmpSupportValue"`
+}
+
+type TransitGatewayAttachment struct {
+	Association                *TransitGatewayAttachmentAssociation `locationName:"association" type:"structure"`
+	CreationTime               *time.Time                           `locationName:"creationTime" type:"timestamp" timestampFormat:"iso8601"`
+	ResourceId                 *string                              `locationName:"resourceId" type:"string"`
+	ResourceOwnerId            *string                              `locationName:"resourceOwnerId" type:"string"`
+	ResourceType               *string                              `locationName:"resourceType" type:"string" enum:"TransitGatewayAttachmentResourceType"`
+	State                      *string                              `locationName:"state" type:"string" enum:"TransitGatewayAttachmentState"`
+	Tags                       []*ec2.Tag                           `locationName:"tagSet" locationNameList:"item" type:"list"`
+	TransitGatewayAttachmentId *string                              `locationName:"transitGatewayAttachmentId" type:"string"`
+	TransitGatewayId           *string                              `locationName:"transitGatewayId" type:"string"`
+	TransitGatewayOwnerId      *string                              `locationName:"transitGatewayOwnerId" type:"string"`
+}
+
+type TransitGatewayAttachmentAssociation struct {
+	State                      *string `locationName:"state" type:"string" enum:"TransitGatewayAssociationState"`
+	TransitGatewayRouteTableId *string `locationName:"transitGatewayRouteTableId" type:"string"`
+}
+
+type TransitGatewayVpcAttachment struct {
+	CreationTime               *time.Time                          `locationName:"creationTime" type:"timestamp" timestampFormat:"iso8601"`
+	Options                    *TransitGatewayVpcAttachmentOptions `locationName:"options" type:"structure"`
+	State                      *string                             `locationName:"state" type:"string" enum:"TransitGatewayAttachmentState"`
+	SubnetIds                  []*string                           `locationName:"subnetIds" locationNameList:"item" type:"list"`
+	Tags                       []*ec2.Tag                          `locationName:"tagSet" locationNameList:"item" type:"list"`
+	TransitGatewayAttachmentId *string                             `locationName:"transitGatewayAttachmentId" type:"string"`
+	TransitGatewayId           *string                             `locationName:"transitGatewayId" type:"string"`
+	VpcId                      *string                             `locationName:"vpcId" type:"string"`
+	VpcOwnerId                 *string                             `locationName:"vpcOwnerId" type:"string"`
+}
+
+type TransitGatewayVpcAttachmentOptions struct {
+	DnsSupport  *string `locationName:"dnsSupport" type:"string" enum:"DnsSupportValue"`
+	Ipv6Support *string `locationName:"ipv6Support" type:"string" enum:"Ipv6SupportValue"`
+}
+
+type CreateTransitGatewayVpcAttachmentRequestOptions struct {
+	DnsSupport  *string `type:"string" enum:"DnsSupportValue"`
+	Ipv6Support *string `type:"string" enum:"Ipv6SupportValue"`
+}
+
+type TransitGatewayRouteTable struct {
+	CreationTime                 *time.Time `locationName:"creationTime" type:"timestamp" timestampFormat:"iso8601"`
+	DefaultAssociationRouteTable *bool      `locationName:"defaultAssociationRouteTable" type:"boolean"`
+	DefaultPropagationRouteTable *bool      `locationName:"defaultPropagationRouteTable" type:"boolean"`
+	State                        *string    `locationName:"state" type:"string" enum:"TransitGatewayRouteTableState"`
+	Tags                         []*ec2.Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
+	TransitGatewayId             *string    `locationName:"transitGatewayId" type:"string"`
+	TransitGatewayRouteTableId   *string    `locationName:"transitGatewayRouteTableId" type:"string"`
+}
+
+type TransitGatewayAssociation struct {
+	ResourceId                 *string `locationName:"resourceId" type:"string"`
+	ResourceType               *string `locationName:"resourceType" type:"string" enum:"TransitGatewayAttachmentResourceType"`
+	State                      *string `locationName:"state" type:"string" enum:"TransitGatewayAssociationState"`
+	TransitGatewayAttachmentId *string `locationName:"transitGatewayAttachmentId" type:"string"`
+	TransitGatewayRouteTableId *string `locationName:"transitGatewayRouteTableId" type:"string"`
+}
+
+type CreateTransitGatewayInput struct {
+	Description *string                       `type:"string"`
+	DryRun      *bool                         `type:"boolean"`
+	Options     *TransitGatewayRequestOptions `type:"structure"`
+}
+
+// SetDryRun sets the DryRun field's value.
+func (s *CreateTransitGatewayInput) SetDryRun(v bool) *CreateTransitGatewayInput {
+	s.DryRun = &v
+	return s
+}
+
+type CreateTransitGatewayOutput struct {
+	TransitGateway *TransitGateway `locationName:"transitGateway" type:"structure"`
+}
+
+type DeleteTransitGatewayInput struct {
+	DryRun           *bool   `type:"boolean"`
+	TransitGatewayId *string `type:"string" required:"true"`
+}
+
+// SetDryRun sets the DryRun field's value.
+func (s *DeleteTransitGatewayInput) SetDryRun(v bool) *DeleteTransitGatewayInput {
+	s.DryRun = &v
+	return s
+}
+
+type DeleteTransitGatewayOutput struct {
+	TransitGateway *TransitGateway `locationName:"transitGateway" type:"structure"`
+}
+
+type DescribeTransitGatewaysInput struct {
+	DryRun            *bool         `type:"boolean"`
+	Filters           []*ec2.Filter `locationName:"Filter" locationNameList:"Filter" type:"list"`
+	MaxResults        *int64        `min:"5" type:"integer"`
+	NextToken         *string       `type:"string"`
+	TransitGatewayIds []*string     `locationNameList:"item" type:"list"`
+}
+
+type DescribeTransitGatewaysOutput struct {
+	NextToken       *string           `locationName:"nextToken" type:"string"`
+	TransitGateways []*TransitGateway `locationName:"transitGatewaySet" locationNameList:"item" type:"list"`
+}
+
+type CreateTransitGatewayVpcAttachmentInput struct {
+	DryRun           *bool                                            `type:"boolean"`
+	Options          *CreateTransitGatewayVpcAttachmentRequestOptions `type:"structure"`
+	SubnetIds        []*string                                        `locationNameList:"item" type:"list" required:"true"`
+	TransitGatewayId *string                                          `type:"string" required:"true"`
+	VpcId            *string                                          `type:"string" required:"true"`
+}
+
+// SetDryRun sets the DryRun field's value.
+func (s *CreateTransitGatewayVpcAttachmentInput) SetDryRun(v bool) *CreateTransitGatewayVpcAttachmentInput {
+	s.DryRun = &v
+	return s
+}
+
+type CreateTransitGatewayVpcAttachmentOutput struct {
+	TransitGatewayVpcAttachment *TransitGatewayVpcAttachment `locationName:"transitGatewayVpcAttachment" type:"structure"`
+}
+
+type DeleteTransitGatewayVpcAttachmentInput struct {
+	DryRun                     *bool   `type:"boolean"`
+	TransitGatewayAttachmentId *string `type:"string" required:"true"`
+}
+
+// SetDryRun sets the DryRun field's value.
+func (s *DeleteTransitGatewayVpcAttachmentInput) SetDryRun(v bool) *DeleteTransitGatewayVpcAttachmentInput {
+	s.DryRun = &v
+	return s
+}
+
+type DeleteTransitGatewayVpcAttachmentOutput struct {
+	TransitGatewayVpcAttachment *TransitGatewayVpcAttachment `locationName:"transitGatewayVpcAttachment" type:"structure"`
+}
+
+type DescribeTransitGatewayAttachmentsInput struct {
+	DryRun                      *bool         `type:"boolean"`
+	Filters                     []*ec2.Filter `locationName:"Filter" locationNameList:"Filter" type:"list"`
+	MaxResults                  *int64        `min:"5" type:"integer"`
+	NextToken                   *string       `type:"string"`
+	TransitGatewayAttachmentIds []*string     `type:"list"`
+}
+
+type DescribeTransitGatewayAttachmentsOutput struct {
+	NextToken                 *string                     `locationName:"nextToken" type:"string"`
+	TransitGatewayAttachments []*TransitGatewayAttachment `locationName:"transitGatewayAttachments" locationNameList:"item" type:"list"`
+}
+
+type CreateTransitGatewayRouteTableInput struct {
+	DryRun           *bool   `type:"boolean"`
+	TransitGatewayId *string `type:"string" required:"true"`
+}
+
+// SetDryRun sets the DryRun field's value.
+func (s *CreateTransitGatewayRouteTableInput) SetDryRun(v bool) *CreateTransitGatewayRouteTableInput {
+	s.DryRun = &v
+	return s
+}
+
+type CreateTransitGatewayRouteTableOutput struct {
+	TransitGatewayRouteTable *TransitGatewayRouteTable `locationName:"transitGatewayRouteTable" type:"structure"`
+}
+
+type DeleteTransitGatewayRouteTableInput struct {
+	DryRun                     *bool   `type:"boolean"`
+	TransitGatewayRouteTableId *string `type:"string" required:"true"`
+}
+
+// SetDryRun sets the DryRun field's value.
+func (s *DeleteTransitGatewayRouteTableInput) SetDryRun(v bool) *DeleteTransitGatewayRouteTableInput {
+	s.DryRun = &v
+	return s
+}
+
+type DeleteTransitGatewayRouteTableOutput struct {
+	TransitGatewayRouteTable *TransitGatewayRouteTable `locationName:"transitGatewayRouteTable" type:"structure"`
+}
+
+type DescribeTransitGatewayRouteTablesInput struct {
+	DryRun                      *bool         `type:"boolean"`
+	Filters                     []*ec2.Filter `locationName:"Filter" locationNameList:"Filter" type:"list"`
+	MaxResults                  *int64        `min:"5" type:"integer"`
+	NextToken                   *string       `type:"string"`
+	TransitGatewayRouteTableIds []*string     `locationNameList:"item" type:"list"`
+}
+
+type DescribeTransitGatewayRouteTablesOutput struct {
+	NextToken                 *string                     `locationName:"nextToken" type:"string"`
+	TransitGatewayRouteTables []*TransitGatewayRouteTable `locationName:"transitGatewayRouteTables" locationNameList:"item" type:"list"`
+}
+
+type AssociateTransitGatewayRouteTableInput struct {
+	DryRun                     *bool   `type:"boolean"`
+	TransitGatewayAttachmentId *string `type:"string" required:"true"`
+	TransitGatewayRouteTableId *string `type:"string" required:"true"`
+}
+
+// SetDryRun sets the DryRun field's value.
+func (s *AssociateTransitGatewayRouteTableInput) SetDryRun(v bool) *AssociateTransitGatewayRouteTableInput {
+	s.DryRun = &v
+	return s
+}
+
+type AssociateTransitGatewayRouteTableOutput struct {
+	Association *TransitGatewayAssociation `locationName:"association" type:"structure"`
+}
+
+type DisassociateTransitGatewayRouteTableInput struct {
+	DryRun                     *bool   `type:"boolean"`
+	TransitGatewayAttachmentId *string `type:"string" required:"true"`
+	TransitGatewayRouteTableId *string `type:"string" required:"true"`
+}
+
+// SetDryRun sets the DryRun field's value.
+func (s *DisassociateTransitGatewayRouteTableInput) SetDryRun(v bool) *DisassociateTransitGatewayRouteTableInput {
+	s.DryRun = &v
+	return s
+}
+
+type DisassociateTransitGatewayRouteTableOutput struct {
+	Association *TransitGatewayAssociation `locationName:"association" type:"structure"`
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ec2transitgatewayiface provides an interface of the EC2TransitGateway client, to mock it in tests
+package ec2transitgatewayiface
+
+import (
+	"github.com/wallix/awless/aws/ec2transitgateway"
+)
+
+type EC2TransitGatewayAPI interface {
+	CreateTransitGateway(*ec2transitgateway.CreateTransitGatewayInput) (*ec2transitgateway.CreateTransitGatewayOutput, error)
+	DeleteTransitGateway(*ec2transitgateway.DeleteTransitGatewayInput) (*ec2transitgateway.DeleteTransitGatewayOutput, error)
+	DescribeTransitGateways(*ec2transitgateway.DescribeTransitGatewaysInput) (*ec2transitgateway.DescribeTransitGatewaysOutput, error)
+	CreateTransitGatewayVpcAttachment(*ec2transitgateway.CreateTransitGatewayVpcAttachmentInput) (*ec2transitgateway.CreateTransitGatewayVpcAttachmentOutput, error)
+	DeleteTransitGatewayVpcAttachment(*ec2transitgateway.DeleteTransitGatewayVpcAttachmentInput) (*ec2transitgateway.DeleteTransitGatewayVpcAttachmentOutput, error)
+	DescribeTransitGatewayAttachments(*ec2transitgateway.DescribeTransitGatewayAttachmentsInput) (*ec2transitgateway.DescribeTransitGatewayAttachmentsOutput, error)
+	CreateTransitGatewayRouteTable(*ec2transitgateway.CreateTransitGatewayRouteTableInput) (*ec2transitgateway.CreateTransitGatewayRouteTableOutput, error)
+	DeleteTransitGatewayRouteTable(*ec2transitgateway.DeleteTransitGatewayRouteTableInput) (*ec2transitgateway.DeleteTransitGatewayRouteTableOutput, error)
+	DescribeTransitGatewayRouteTables(*ec2transitgateway.DescribeTransitGatewayRouteTablesInput) (*ec2transitgateway.DescribeTransitGatewayRouteTablesOutput, error)
+	AssociateTransitGatewayRouteTable(*ec2transitgateway.AssociateTransitGatewayRouteTableInput) (*ec2transitgateway.AssociateTransitGatewayRouteTableOutput, error)
+	DisassociateTransitGatewayRouteTable(*ec2transitgateway.DisassociateTransitGatewayRouteTableInput) (*ec2transitgateway.DisassociateTransitGatewayRouteTableOutput, error)
+}
+
+var _ EC2TransitGatewayAPI = (*ec2transitgateway.EC2TransitGateway)(nil)
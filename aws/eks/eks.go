@@ -0,0 +1,168 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package eks is a client of the Amazon Elastic Container Service for Kubernetes API (version 2017-11-01),
+// limited to the calls and fields used by the awless drivers.
+//
+// The vendored aws-sdk-go (1.12.55) predates this service, which the SDK added in 1.14.0.
+// The types mirror service/eks of aws-sdk-go 1.14.0. Replace this package with the SDK one
+// when bumping the vendored aws-sdk-go past this version.
+package eks
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/private/protocol/restjson"
+)
+
+const (
+	ServiceName = "eks"
+	EndpointsID = ServiceName
+)
+
+const (
+	ClusterStatusCreating = "CREATING"
+	ClusterStatusActive   = "ACTIVE"
+	ClusterStatusDeleting = "DELETING"
+	ClusterStatusFailed   = "FAILED"
+)
+
+const (
+	ErrCodeResourceInUseException    = "ResourceInUseException"
+	ErrCodeResourceNotFoundException = "ResourceNotFoundException"
+)
+
+// EKS provides the API operation methods for making requests to Amazon EKS
+type EKS struct {
+	*client.Client
+}
+
+// New creates a new instance of the EKS client with a session
+func New(p client.ConfigProvider, cfgs ...*aws.Config) *EKS {
+	c := p.ClientConfig(EndpointsID, cfgs...)
+	svc := &EKS{
+		Client: client.New(
+			*c.Config,
+			metadata.ClientInfo{
+				ServiceName:   ServiceName,
+				SigningName:   c.SigningName,
+				SigningRegion: c.SigningRegion,
+				Endpoint:      c.Endpoint,
+				APIVersion:    "2017-11-01",
+				JSONVersion:   "1.1",
+			},
+			c.Handlers,
+		),
+	}
+	svc.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	svc.Handlers.Build.PushBackNamed(restjson.BuildHandler)
+	svc.Handlers.Unmarshal.PushBackNamed(restjson.UnmarshalHandler)
+	svc.Handlers.UnmarshalMeta.PushBackNamed(restjson.UnmarshalMetaHandler)
+	svc.Handlers.UnmarshalError.PushBackNamed(restjson.UnmarshalErrorHandler)
+	return svc
+}
+
+func (c *EKS) send(name, method, path string, input, output interface{}) error {
+	return c.NewRequest(&request.Operation{Name: name, HTTPMethod: method, HTTPPath: path}, input, output).Send()
+}
+
+// CreateCluster creates a cluster, whose control plane is ready once the cluster is ACTIVE
+func (c *EKS) CreateCluster(input *CreateClusterInput) (*CreateClusterOutput, error) {
+	if input == nil {
+		input = &CreateClusterInput{}
+	}
+	output := &CreateClusterOutput{}
+	return output, c.send("CreateCluster", "POST", "/clusters", input, output)
+}
+
+// DeleteCluster deletes the control plane of a cluster
+func (c *EKS) DeleteCluster(input *DeleteClusterInput) (*DeleteClusterOutput, error) {
+	if input == nil {
+		input = &DeleteClusterInput{}
+	}
+	output := &DeleteClusterOutput{}
+	return output, c.send("DeleteCluster", "DELETE", "/clusters/{name}", input, output)
+}
+
+// DescribeCluster returns the status of a cluster, and its endpoint and certificate authority once ACTIVE
+func (c *EKS) DescribeCluster(input *DescribeClusterInput) (*DescribeClusterOutput, error) {
+	if input == nil {
+		input = &DescribeClusterInput{}
+	}
+	output := &DescribeClusterOutput{}
+	return output, c.send("DescribeCluster", "GET", "/clusters/{name}", input, output)
+}
+
+type Certificate struct {
+	Data *string `locationName:"data" type:"string"`
+}
+
+type Cluster struct {
+	Arn                  *string            `locationName:"arn" type:"string"`
+	CertificateAuthority *Certificate       `locationName:"certificateAuthority" type:"structure"`
+	ClientRequestToken   *string            `locationName:"clientRequestToken" type:"string"`
+	CreatedAt            *time.Time         `locationName:"createdAt" type:"timestamp" timestampFormat:"unix"`
+	Endpoint             *string            `locationName:"endpoint" type:"string"`
+	Name                 *string            `locationName:"name" type:"string"`
+	ResourcesVpcConfig   *VpcConfigResponse `locationName:"resourcesVpcConfig" type:"structure"`
+	RoleArn              *string            `locationName:"roleArn" type:"string"`
+	Status               *string            `locationName:"status" type:"string" enum:"ClusterStatus"`
+	Version              *string            `locationName:"version" type:"string"`
+}
+
+type VpcConfigRequest struct {
+	SecurityGroupIds []*string `locationName:"securityGroupIds" type:"list"`
+	SubnetIds        []*string `locationName:"subnetIds" type:"list" required:"true"`
+}
+
+type VpcConfigResponse struct {
+	SecurityGroupIds []*string `locationName:"securityGroupIds" type:"list"`
+	SubnetIds        []*string `locationName:"subnetIds" type:"list"`
+	VpcId            *string   `locationName:"vpcId" type:"string"`
+}
+
+type CreateClusterInput struct {
+	ClientRequestToken *string           `locationName:"clientRequestToken" type:"string" idempotencyToken:"true"`
+	Name               *string           `locationName:"name" min:"1" type:"string" required:"true"`
+	ResourcesVpcConfig *VpcConfigRequest `locationName:"resourcesVpcConfig" type:"structure" required:"true"`
+	RoleArn            *string           `locationName:"roleArn" type:"string" required:"true"`
+	Version            *string           `locationName:"version" type:"string"`
+}
+
+type CreateClusterOutput struct {
+	Cluster *Cluster `locationName:"cluster" type:"structure"`
+}
+
+type DeleteClusterInput struct {
+	Name *string `location:"uri" locationName:"name" type:"string" required:"true"`
+}
+
+type DeleteClusterOutput struct {
+	Cluster *Cluster `locationName:"cluster" type:"structure"`
+}
+
+type DescribeClusterInput struct {
+	Name *string `location:"uri" locationName:"name" type:"string" required:"true"`
+}
+
+type DescribeClusterOutput struct {
+	Cluster *Cluster `locationName:"cluster" type:"structure"`
+}
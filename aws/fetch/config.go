@@ -6,21 +6,33 @@ import (
 	"github.com/aws/aws-sdk-go/service/acm/acmiface"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling/applicationautoscalingiface"
 	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
+	"github.com/aws/aws-sdk-go/service/batch/batchiface"
 	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
+	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
 	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/aws/aws-sdk-go/service/directconnect/directconnectiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
 	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/aws/aws-sdk-go/service/organizations/organizationsiface"
 	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/aws/aws-sdk-go/service/redshift/redshiftiface"
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"github.com/wallix/awless/aws/ec2transitgateway/ec2transitgatewayiface"
 
 	"github.com/wallix/awless/logger"
 )
@@ -29,6 +41,7 @@ type AWSAPI struct {
 	Iam                    iamiface.IAMAPI
 	Ec2                    ec2iface.EC2API
 	Elbv2                  elbv2iface.ELBV2API
+	Elb                    elbiface.ELBAPI
 	Rds                    rdsiface.RDSAPI
 	Autoscaling            autoscalingiface.AutoScalingAPI
 	Ecr                    ecriface.ECRAPI
@@ -41,9 +54,20 @@ type AWSAPI struct {
 	Route53                route53iface.Route53API
 	Lambda                 lambdaiface.LambdaAPI
 	Cloudwatch             cloudwatchiface.CloudWatchAPI
+	Cloudwatchlogs         cloudwatchlogsiface.CloudWatchLogsAPI
+	Cloudtrail             cloudtrailiface.CloudTrailAPI
 	Cloudfront             cloudfrontiface.CloudFrontAPI
 	Cloudformation         cloudformationiface.CloudFormationAPI
 	Acm                    acmiface.ACMAPI
+	Dynamodb               dynamodbiface.DynamoDBAPI
+	Elasticache            elasticacheiface.ElastiCacheAPI
+	Redshift               redshiftiface.RedshiftAPI
+	Kms                    kmsiface.KMSAPI
+	Sfn                    sfniface.SFNAPI
+	Batch                  batchiface.BatchAPI
+	Directconnect          directconnectiface.DirectConnectAPI
+	Ec2transitgateway      ec2transitgatewayiface.EC2TransitGatewayAPI
+	Organizations          organizationsiface.OrganizationsAPI
 }
 
 type Config struct {
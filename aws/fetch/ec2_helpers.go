@@ -0,0 +1,93 @@
+package awsfetch
+
+import (
+	"sort"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+)
+
+// fetchRunningOnDemandInstances returns the running instances that can be covered by a reservation,
+// leaving out spot and scheduled instances
+func fetchRunningOnDemandInstances(api ec2iface.EC2API) ([]*ec2.Instance, error) {
+	var instances []*ec2.Instance
+	input := &ec2.DescribeInstancesInput{Filters: []*ec2.Filter{{Name: awssdk.String("instance-state-name"), Values: []*string{awssdk.String(ec2.InstanceStateNameRunning)}}}}
+	err := api.DescribeInstancesPages(input, func(out *ec2.DescribeInstancesOutput, lastPage bool) bool {
+		for _, reservation := range out.Reservations {
+			for _, inst := range reservation.Instances {
+				if inst.InstanceLifecycle == nil {
+					instances = append(instances, inst)
+				}
+			}
+		}
+		return out.NextToken != nil
+	})
+	return instances, err
+}
+
+// reservationsCoverage returns the IDs of the instances covered by each active reservation.
+// Zonal reservations apply first, then regional ones. An instance is covered when it matches
+// the instance type, tenancy and platform of a reservation (and its zone for zonal ones),
+// until the instance count of the reservation is reached. Instance size flexibility is not considered.
+func reservationsCoverage(reservations []*ec2.ReservedInstances, instances []*ec2.Instance) map[string][]string {
+	var active []*ec2.ReservedInstances
+	for _, r := range reservations {
+		if awssdk.StringValue(r.State) == ec2.ReservedInstanceStateActive {
+			active = append(active, r)
+		}
+	}
+	sort.SliceStable(active, func(i, j int) bool {
+		zonalI := awssdk.StringValue(active[i].Scope) != ec2.ScopeRegion
+		zonalJ := awssdk.StringValue(active[j].Scope) != ec2.ScopeRegion
+		if zonalI != zonalJ {
+			return zonalI
+		}
+		return awssdk.StringValue(active[i].ReservedInstancesId) < awssdk.StringValue(active[j].ReservedInstancesId)
+	})
+
+	sorted := make([]*ec2.Instance, len(instances))
+	copy(sorted, instances)
+	sort.Slice(sorted, func(i, j int) bool {
+		return awssdk.StringValue(sorted[i].InstanceId) < awssdk.StringValue(sorted[j].InstanceId)
+	})
+
+	coverage := make(map[string][]string)
+	covered := make(map[string]bool)
+	for _, r := range active {
+		id := awssdk.StringValue(r.ReservedInstancesId)
+		for _, inst := range sorted {
+			if int64(len(coverage[id])) >= awssdk.Int64Value(r.InstanceCount) {
+				break
+			}
+			instID := awssdk.StringValue(inst.InstanceId)
+			if covered[instID] || !reservationMatches(r, inst) {
+				continue
+			}
+			covered[instID] = true
+			coverage[id] = append(coverage[id], instID)
+		}
+	}
+	return coverage
+}
+
+func reservationMatches(r *ec2.ReservedInstances, inst *ec2.Instance) bool {
+	if awssdk.StringValue(r.InstanceType) != awssdk.StringValue(inst.InstanceType) {
+		return false
+	}
+	var zone, tenancy string
+	if inst.Placement != nil {
+		zone = awssdk.StringValue(inst.Placement.AvailabilityZone)
+		tenancy = awssdk.StringValue(inst.Placement.Tenancy)
+	}
+	if awssdk.StringValue(r.Scope) != ec2.ScopeRegion && awssdk.StringValue(r.AvailabilityZone) != zone {
+		return false
+	}
+	if t := awssdk.StringValue(r.InstanceTenancy); t != "" && tenancy != "" && t != tenancy {
+		return false
+	}
+	windowsReservation := strings.Contains(awssdk.StringValue(r.ProductDescription), "Windows")
+	windowsInstance := strings.EqualFold(awssdk.StringValue(inst.Platform), ec2.PlatformValuesWindows)
+	return windowsReservation == windowsInstance
+}
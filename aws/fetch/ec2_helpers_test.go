@@ -0,0 +1,39 @@
+package awsfetch
+
+import (
+	"reflect"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestReservationsCoverage(t *testing.T) {
+	instance := func(id, typ, zone string) *ec2.Instance {
+		return &ec2.Instance{InstanceId: awssdk.String(id), InstanceType: awssdk.String(typ), Placement: &ec2.Placement{AvailabilityZone: awssdk.String(zone), Tenancy: awssdk.String("default")}}
+	}
+	instances := []*ec2.Instance{
+		instance("inst_4", "t2.micro", "eu-west-1a"),
+		instance("inst_1", "t2.micro", "eu-west-1a"),
+		instance("inst_2", "t2.micro", "eu-west-1b"),
+		instance("inst_3", "m4.large", "eu-west-1a"),
+		{InstanceId: awssdk.String("inst_5"), InstanceType: awssdk.String("t2.micro"), Platform: awssdk.String("windows"), Placement: &ec2.Placement{AvailabilityZone: awssdk.String("eu-west-1a")}},
+	}
+	reservations := []*ec2.ReservedInstances{
+		{ReservedInstancesId: awssdk.String("ri_1"), State: awssdk.String("active"), Scope: awssdk.String("Region"), InstanceType: awssdk.String("t2.micro"), InstanceCount: awssdk.Int64(2), InstanceTenancy: awssdk.String("default"), ProductDescription: awssdk.String("Linux/UNIX")},
+		{ReservedInstancesId: awssdk.String("ri_2"), State: awssdk.String("active"), Scope: awssdk.String("Availability Zone"), AvailabilityZone: awssdk.String("eu-west-1a"), InstanceType: awssdk.String("t2.micro"), InstanceCount: awssdk.Int64(1), ProductDescription: awssdk.String("Linux/UNIX")},
+		{ReservedInstancesId: awssdk.String("ri_3"), State: awssdk.String("retired"), Scope: awssdk.String("Region"), InstanceType: awssdk.String("m4.large"), InstanceCount: awssdk.Int64(1), ProductDescription: awssdk.String("Linux/UNIX")},
+		{ReservedInstancesId: awssdk.String("ri_4"), State: awssdk.String("active"), Scope: awssdk.String("Availability Zone"), AvailabilityZone: awssdk.String("eu-west-1b"), InstanceType: awssdk.String("m4.large"), InstanceCount: awssdk.Int64(1), ProductDescription: awssdk.String("Linux/UNIX")},
+		{ReservedInstancesId: awssdk.String("ri_5"), State: awssdk.String("active"), Scope: awssdk.String("Region"), InstanceType: awssdk.String("t2.micro"), InstanceCount: awssdk.Int64(3), ProductDescription: awssdk.String("Windows")},
+	}
+
+	coverage := reservationsCoverage(reservations, instances)
+	expected := map[string][]string{
+		"ri_2": {"inst_1"},
+		"ri_1": {"inst_2", "inst_4"},
+		"ri_5": {"inst_5"},
+	}
+	if got, want := coverage, expected; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
@@ -26,16 +26,23 @@ import (
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
-	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/batch"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/directconnect"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/wallix/awless/aws/conv"
 	"github.com/wallix/awless/fetch"
@@ -267,6 +274,136 @@ func BuildInfraFetchFuncs(conf *Config) fetch.Funcs {
 		return resources, objects, nil
 	}
 
+	funcs["peeringconnection"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*ec2.VpcPeeringConnection
+
+		if !conf.getBoolDefaultTrue("aws.infra.peeringconnection.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[peeringconnection]")
+			return resources, objects, nil
+		}
+
+		out, err := conf.APIs.Ec2.DescribeVpcPeeringConnections(&ec2.DescribeVpcPeeringConnectionsInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, output := range out.VpcPeeringConnections {
+			objects = append(objects, output)
+			res, err := awsconv.NewResource(output)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+
+		return resources, objects, nil
+	}
+
+	funcs["vpcendpoint"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*ec2.VpcEndpoint
+
+		if !conf.getBoolDefaultTrue("aws.infra.vpcendpoint.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[vpcendpoint]")
+			return resources, objects, nil
+		}
+
+		out, err := conf.APIs.Ec2.DescribeVpcEndpoints(&ec2.DescribeVpcEndpointsInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, output := range out.VpcEndpoints {
+			objects = append(objects, output)
+			res, err := awsconv.NewResource(output)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+
+		return resources, objects, nil
+	}
+
+	funcs["customergateway"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*ec2.CustomerGateway
+
+		if !conf.getBoolDefaultTrue("aws.infra.customergateway.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[customergateway]")
+			return resources, objects, nil
+		}
+
+		out, err := conf.APIs.Ec2.DescribeCustomerGateways(&ec2.DescribeCustomerGatewaysInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, output := range out.CustomerGateways {
+			objects = append(objects, output)
+			res, err := awsconv.NewResource(output)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+
+		return resources, objects, nil
+	}
+
+	funcs["vpngateway"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*ec2.VpnGateway
+
+		if !conf.getBoolDefaultTrue("aws.infra.vpngateway.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[vpngateway]")
+			return resources, objects, nil
+		}
+
+		out, err := conf.APIs.Ec2.DescribeVpnGateways(&ec2.DescribeVpnGatewaysInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, output := range out.VpnGateways {
+			objects = append(objects, output)
+			res, err := awsconv.NewResource(output)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+
+		return resources, objects, nil
+	}
+
+	funcs["vpnconnection"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*ec2.VpnConnection
+
+		if !conf.getBoolDefaultTrue("aws.infra.vpnconnection.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[vpnconnection]")
+			return resources, objects, nil
+		}
+
+		out, err := conf.APIs.Ec2.DescribeVpnConnections(&ec2.DescribeVpnConnectionsInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, output := range out.VpnConnections {
+			objects = append(objects, output)
+			res, err := awsconv.NewResource(output)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+
+		return resources, objects, nil
+	}
+
 	funcs["routetable"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
 		var resources []*graph.Resource
 		var objects []*ec2.RouteTable
@@ -293,6 +430,84 @@ func BuildInfraFetchFuncs(conf *Config) fetch.Funcs {
 		return resources, objects, nil
 	}
 
+	funcs["networkacl"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*ec2.NetworkAcl
+
+		if !conf.getBoolDefaultTrue("aws.infra.networkacl.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[networkacl]")
+			return resources, objects, nil
+		}
+
+		out, err := conf.APIs.Ec2.DescribeNetworkAcls(&ec2.DescribeNetworkAclsInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, output := range out.NetworkAcls {
+			objects = append(objects, output)
+			res, err := awsconv.NewResource(output)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+
+		return resources, objects, nil
+	}
+
+	funcs["placementgroup"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*ec2.PlacementGroup
+
+		if !conf.getBoolDefaultTrue("aws.infra.placementgroup.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[placementgroup]")
+			return resources, objects, nil
+		}
+
+		out, err := conf.APIs.Ec2.DescribePlacementGroups(&ec2.DescribePlacementGroupsInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, output := range out.PlacementGroups {
+			objects = append(objects, output)
+			res, err := awsconv.NewResource(output)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+
+		return resources, objects, nil
+	}
+
+	funcs["host"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*ec2.Host
+
+		if !conf.getBoolDefaultTrue("aws.infra.host.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[host]")
+			return resources, objects, nil
+		}
+
+		out, err := conf.APIs.Ec2.DescribeHosts(&ec2.DescribeHostsInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, output := range out.Hosts {
+			objects = append(objects, output)
+			res, err := awsconv.NewResource(output)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+
+		return resources, objects, nil
+	}
+
 	funcs["availabilityzone"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
 		var resources []*graph.Resource
 		var objects []*ec2.AvailabilityZone
@@ -454,6 +669,63 @@ func BuildInfraFetchFuncs(conf *Config) fetch.Funcs {
 		return resources, objects, nil
 	}
 
+	funcs["spotrequest"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*ec2.SpotInstanceRequest
+
+		if !conf.getBoolDefaultTrue("aws.infra.spotrequest.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[spotrequest]")
+			return resources, objects, nil
+		}
+
+		out, err := conf.APIs.Ec2.DescribeSpotInstanceRequests(&ec2.DescribeSpotInstanceRequestsInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, output := range out.SpotInstanceRequests {
+			objects = append(objects, output)
+			res, err := awsconv.NewResource(output)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+
+		return resources, objects, nil
+	}
+
+	funcs["spotfleet"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*ec2.SpotFleetRequestConfig
+
+		if !conf.getBoolDefaultTrue("aws.infra.spotfleet.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[spotfleet]")
+			return resources, objects, nil
+		}
+		var badResErr error
+		err := conf.APIs.Ec2.DescribeSpotFleetRequestsPages(&ec2.DescribeSpotFleetRequestsInput{},
+			func(out *ec2.DescribeSpotFleetRequestsOutput, lastPage bool) (shouldContinue bool) {
+				for _, output := range out.SpotFleetRequestConfigs {
+					if badResErr != nil {
+						return false
+					}
+					objects = append(objects, output)
+					var res *graph.Resource
+					if res, badResErr = awsconv.NewResource(output); badResErr != nil {
+						return false
+					}
+					resources = append(resources, res)
+				}
+				return out.NextToken != nil
+			})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		return resources, objects, badResErr
+	}
+
 	funcs["loadbalancer"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
 		var resources []*graph.Resource
 		var objects []*elbv2.LoadBalancer
@@ -511,6 +783,37 @@ func BuildInfraFetchFuncs(conf *Config) fetch.Funcs {
 		return resources, objects, nil
 	}
 
+	funcs["classicloadbalancer"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*elb.LoadBalancerDescription
+
+		if !conf.getBoolDefaultTrue("aws.infra.classicloadbalancer.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[classicloadbalancer]")
+			return resources, objects, nil
+		}
+		var badResErr error
+		err := conf.APIs.Elb.DescribeLoadBalancersPages(&elb.DescribeLoadBalancersInput{},
+			func(out *elb.DescribeLoadBalancersOutput, lastPage bool) (shouldContinue bool) {
+				for _, output := range out.LoadBalancerDescriptions {
+					if badResErr != nil {
+						return false
+					}
+					objects = append(objects, output)
+					var res *graph.Resource
+					if res, badResErr = awsconv.NewResource(output); badResErr != nil {
+						return false
+					}
+					resources = append(resources, res)
+				}
+				return out.NextMarker != nil
+			})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		return resources, objects, badResErr
+	}
+
 	funcs["database"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
 		var resources []*graph.Resource
 		var objects []*rds.DBInstance
@@ -573,6 +876,37 @@ func BuildInfraFetchFuncs(conf *Config) fetch.Funcs {
 		return resources, objects, badResErr
 	}
 
+	funcs["dbparametergroup"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*rds.DBParameterGroup
+
+		if !conf.getBoolDefaultTrue("aws.infra.dbparametergroup.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[dbparametergroup]")
+			return resources, objects, nil
+		}
+		var badResErr error
+		err := conf.APIs.Rds.DescribeDBParameterGroupsPages(&rds.DescribeDBParameterGroupsInput{},
+			func(out *rds.DescribeDBParameterGroupsOutput, lastPage bool) (shouldContinue bool) {
+				for _, output := range out.DBParameterGroups {
+					if badResErr != nil {
+						return false
+					}
+					objects = append(objects, output)
+					var res *graph.Resource
+					if res, badResErr = awsconv.NewResource(output); badResErr != nil {
+						return false
+					}
+					resources = append(resources, res)
+				}
+				return out.Marker != nil
+			})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		return resources, objects, badResErr
+	}
+
 	funcs["launchconfiguration"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
 		var resources []*graph.Resource
 		var objects []*autoscaling.LaunchConfiguration
@@ -727,6 +1061,199 @@ func BuildInfraFetchFuncs(conf *Config) fetch.Funcs {
 
 		return resources, objects, badResErr
 	}
+
+	funcs["cachecluster"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*elasticache.CacheCluster
+
+		if !conf.getBoolDefaultTrue("aws.infra.cachecluster.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[cachecluster]")
+			return resources, objects, nil
+		}
+		var badResErr error
+		err := conf.APIs.Elasticache.DescribeCacheClustersPages(&elasticache.DescribeCacheClustersInput{ShowCacheNodeInfo: awssdk.Bool(true)},
+			func(out *elasticache.DescribeCacheClustersOutput, lastPage bool) (shouldContinue bool) {
+				for _, output := range out.CacheClusters {
+					if badResErr != nil {
+						return false
+					}
+					objects = append(objects, output)
+					var res *graph.Resource
+					if res, badResErr = awsconv.NewResource(output); badResErr != nil {
+						return false
+					}
+					resources = append(resources, res)
+				}
+				return out.Marker != nil
+			})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		return resources, objects, badResErr
+	}
+
+	funcs["cachesubnetgroup"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*elasticache.CacheSubnetGroup
+
+		if !conf.getBoolDefaultTrue("aws.infra.cachesubnetgroup.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[cachesubnetgroup]")
+			return resources, objects, nil
+		}
+		var badResErr error
+		err := conf.APIs.Elasticache.DescribeCacheSubnetGroupsPages(&elasticache.DescribeCacheSubnetGroupsInput{},
+			func(out *elasticache.DescribeCacheSubnetGroupsOutput, lastPage bool) (shouldContinue bool) {
+				for _, output := range out.CacheSubnetGroups {
+					if badResErr != nil {
+						return false
+					}
+					objects = append(objects, output)
+					var res *graph.Resource
+					if res, badResErr = awsconv.NewResource(output); badResErr != nil {
+						return false
+					}
+					resources = append(resources, res)
+				}
+				return out.Marker != nil
+			})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		return resources, objects, badResErr
+	}
+
+	funcs["cluster"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*redshift.Cluster
+
+		if !conf.getBoolDefaultTrue("aws.infra.cluster.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[cluster]")
+			return resources, objects, nil
+		}
+		var badResErr error
+		err := conf.APIs.Redshift.DescribeClustersPages(&redshift.DescribeClustersInput{},
+			func(out *redshift.DescribeClustersOutput, lastPage bool) (shouldContinue bool) {
+				for _, output := range out.Clusters {
+					if badResErr != nil {
+						return false
+					}
+					objects = append(objects, output)
+					var res *graph.Resource
+					if res, badResErr = awsconv.NewResource(output); badResErr != nil {
+						return false
+					}
+					resources = append(resources, res)
+				}
+				return out.Marker != nil
+			})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		return resources, objects, badResErr
+	}
+	funcs["computeenvironment"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*batch.ComputeEnvironmentDetail
+
+		if !conf.getBoolDefaultTrue("aws.infra.computeenvironment.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[computeenvironment]")
+			return resources, objects, nil
+		}
+
+		out, err := conf.APIs.Batch.DescribeComputeEnvironments(&batch.DescribeComputeEnvironmentsInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, output := range out.ComputeEnvironments {
+			objects = append(objects, output)
+			res, err := awsconv.NewResource(output)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+
+		return resources, objects, nil
+	}
+	funcs["jobqueue"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*batch.JobQueueDetail
+
+		if !conf.getBoolDefaultTrue("aws.infra.jobqueue.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[jobqueue]")
+			return resources, objects, nil
+		}
+
+		out, err := conf.APIs.Batch.DescribeJobQueues(&batch.DescribeJobQueuesInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, output := range out.JobQueues {
+			objects = append(objects, output)
+			res, err := awsconv.NewResource(output)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+
+		return resources, objects, nil
+	}
+	funcs["dxconnection"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*directconnect.Connection
+
+		if !conf.getBoolDefaultTrue("aws.infra.dxconnection.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[dxconnection]")
+			return resources, objects, nil
+		}
+
+		out, err := conf.APIs.Directconnect.DescribeConnections(&directconnect.DescribeConnectionsInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, output := range out.Connections {
+			objects = append(objects, output)
+			res, err := awsconv.NewResource(output)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+
+		return resources, objects, nil
+	}
+	funcs["virtualinterface"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*directconnect.VirtualInterface
+
+		if !conf.getBoolDefaultTrue("aws.infra.virtualinterface.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[virtualinterface]")
+			return resources, objects, nil
+		}
+
+		out, err := conf.APIs.Directconnect.DescribeVirtualInterfaces(&directconnect.DescribeVirtualInterfacesInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, output := range out.VirtualInterfaces {
+			objects = append(objects, output)
+			res, err := awsconv.NewResource(output)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+
+		return resources, objects, nil
+	}
 	return funcs
 }
 func BuildAccessFetchFuncs(conf *Config) fetch.Funcs {
@@ -943,6 +1470,37 @@ func BuildLambdaFetchFuncs(conf *Config) fetch.Funcs {
 
 		return resources, objects, badResErr
 	}
+
+	funcs["statemachine"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*sfn.StateMachineListItem
+
+		if !conf.getBoolDefaultTrue("aws.lambda.statemachine.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource lambda[statemachine]")
+			return resources, objects, nil
+		}
+		var badResErr error
+		err := conf.APIs.Sfn.ListStateMachinesPages(&sfn.ListStateMachinesInput{},
+			func(out *sfn.ListStateMachinesOutput, lastPage bool) (shouldContinue bool) {
+				for _, output := range out.StateMachines {
+					if badResErr != nil {
+						return false
+					}
+					objects = append(objects, output)
+					var res *graph.Resource
+					if res, badResErr = awsconv.NewResource(output); badResErr != nil {
+						return false
+					}
+					resources = append(resources, res)
+				}
+				return out.NextToken != nil
+			})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		return resources, objects, badResErr
+	}
 	return funcs
 }
 func BuildMonitoringFetchFuncs(conf *Config) fetch.Funcs {
@@ -1011,25 +1569,19 @@ func BuildMonitoringFetchFuncs(conf *Config) fetch.Funcs {
 
 		return resources, objects, badResErr
 	}
-	return funcs
-}
-func BuildCdnFetchFuncs(conf *Config) fetch.Funcs {
-	funcs := make(map[string]fetch.Func)
-
-	addManualCdnFetchFuncs(conf, funcs)
 
-	funcs["distribution"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+	funcs["loggroup"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
 		var resources []*graph.Resource
-		var objects []*cloudfront.DistributionSummary
+		var objects []*cloudwatchlogs.LogGroup
 
-		if !conf.getBoolDefaultTrue("aws.cdn.distribution.sync") && !getBoolFromContext(ctx, "force") {
-			conf.Log.Verbose("sync: *disabled* for resource cdn[distribution]")
+		if !conf.getBoolDefaultTrue("aws.monitoring.loggroup.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource monitoring[loggroup]")
 			return resources, objects, nil
 		}
 		var badResErr error
-		err := conf.APIs.Cloudfront.ListDistributionsPages(&cloudfront.ListDistributionsInput{},
-			func(out *cloudfront.ListDistributionsOutput, lastPage bool) (shouldContinue bool) {
-				for _, output := range out.DistributionList.Items {
+		err := conf.APIs.Cloudwatchlogs.DescribeLogGroupsPages(&cloudwatchlogs.DescribeLogGroupsInput{},
+			func(out *cloudwatchlogs.DescribeLogGroupsOutput, lastPage bool) (shouldContinue bool) {
+				for _, output := range out.LogGroups {
 					if badResErr != nil {
 						return false
 					}
@@ -1040,7 +1592,7 @@ func BuildCdnFetchFuncs(conf *Config) fetch.Funcs {
 					}
 					resources = append(resources, res)
 				}
-				return out.DistributionList.NextMarker != nil
+				return out.NextToken != nil
 			})
 		if err != nil {
 			return resources, objects, err
@@ -1048,25 +1600,51 @@ func BuildCdnFetchFuncs(conf *Config) fetch.Funcs {
 
 		return resources, objects, badResErr
 	}
+
+	funcs["trail"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*cloudtrail.Trail
+
+		if !conf.getBoolDefaultTrue("aws.monitoring.trail.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource monitoring[trail]")
+			return resources, objects, nil
+		}
+
+		out, err := conf.APIs.Cloudtrail.DescribeTrails(&cloudtrail.DescribeTrailsInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, output := range out.TrailList {
+			objects = append(objects, output)
+			res, err := awsconv.NewResource(output)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+
+		return resources, objects, nil
+	}
 	return funcs
 }
-func BuildCloudformationFetchFuncs(conf *Config) fetch.Funcs {
+func BuildCdnFetchFuncs(conf *Config) fetch.Funcs {
 	funcs := make(map[string]fetch.Func)
 
-	addManualCloudformationFetchFuncs(conf, funcs)
+	addManualCdnFetchFuncs(conf, funcs)
 
-	funcs["stack"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+	funcs["distribution"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
 		var resources []*graph.Resource
-		var objects []*cloudformation.Stack
+		var objects []*cloudfront.DistributionSummary
 
-		if !conf.getBoolDefaultTrue("aws.cloudformation.stack.sync") && !getBoolFromContext(ctx, "force") {
-			conf.Log.Verbose("sync: *disabled* for resource cloudformation[stack]")
+		if !conf.getBoolDefaultTrue("aws.cdn.distribution.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource cdn[distribution]")
 			return resources, objects, nil
 		}
 		var badResErr error
-		err := conf.APIs.Cloudformation.DescribeStacksPages(&cloudformation.DescribeStacksInput{},
-			func(out *cloudformation.DescribeStacksOutput, lastPage bool) (shouldContinue bool) {
-				for _, output := range out.Stacks {
+		err := conf.APIs.Cloudfront.ListDistributionsPages(&cloudfront.ListDistributionsInput{},
+			func(out *cloudfront.ListDistributionsOutput, lastPage bool) (shouldContinue bool) {
+				for _, output := range out.DistributionList.Items {
 					if badResErr != nil {
 						return false
 					}
@@ -1077,7 +1655,7 @@ func BuildCloudformationFetchFuncs(conf *Config) fetch.Funcs {
 					}
 					resources = append(resources, res)
 				}
-				return out.NextToken != nil
+				return out.DistributionList.NextMarker != nil
 			})
 		if err != nil {
 			return resources, objects, err
@@ -1087,3 +1665,9 @@ func BuildCloudformationFetchFuncs(conf *Config) fetch.Funcs {
 	}
 	return funcs
 }
+func BuildCloudformationFetchFuncs(conf *Config) fetch.Funcs {
+	funcs := make(map[string]fetch.Func)
+
+	addManualCloudformationFetchFuncs(conf, funcs)
+	return funcs
+}
@@ -0,0 +1,122 @@
+package awsfetch
+
+import (
+	"encoding/json"
+	"sort"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+)
+
+func getAliasesPerKey(api kmsiface.KMSAPI) (map[string][]string, error) {
+	aliases := make(map[string][]string)
+	err := api.ListAliasesPages(&kms.ListAliasesInput{}, func(out *kms.ListAliasesOutput, lastPage bool) bool {
+		for _, alias := range out.Aliases {
+			if alias.TargetKeyId == nil {
+				continue
+			}
+			keyID := awssdk.StringValue(alias.TargetKeyId)
+			aliases[keyID] = append(aliases[keyID], awssdk.StringValue(alias.AliasName))
+		}
+		return out.NextMarker != nil
+	})
+	return aliases, err
+}
+
+// fetchKeyPrincipals returns the ARNs of the principals allowed to use the key,
+// either through its grants or through the statements of its key policy
+func fetchKeyPrincipals(api kmsiface.KMSAPI, keyID *string) ([]string, error) {
+	var principals []string
+	err := api.ListGrantsPages(&kms.ListGrantsInput{KeyId: keyID}, func(out *kms.ListGrantsResponse, lastPage bool) bool {
+		for _, grant := range out.Grants {
+			principals = appendIfNotInSlice(principals, awssdk.StringValue(grant.GranteePrincipal))
+		}
+		return out.NextMarker != nil
+	})
+	if err != nil {
+		return principals, err
+	}
+
+	out, err := api.GetKeyPolicy(&kms.GetKeyPolicyInput{KeyId: keyID, PolicyName: awssdk.String("default")})
+	if err != nil {
+		return principals, err
+	}
+	fromPolicy, err := extractPolicyPrincipals(awssdk.StringValue(out.Policy))
+	if err != nil {
+		return principals, err
+	}
+	for _, p := range fromPolicy {
+		principals = appendIfNotInSlice(principals, p)
+	}
+	sort.Strings(principals)
+	return principals, nil
+}
+
+type keyPolicyStatement struct {
+	Effect    string
+	Principal *keyPolicyPrincipal
+}
+
+type keyPolicyPrincipal struct {
+	AWS stringOrSlice
+}
+
+// UnmarshalJSON ignores principals given as a wildcard string, as '*' is not a principal of the account
+func (p *keyPolicyPrincipal) UnmarshalJSON(b []byte) error {
+	var wildcard string
+	if err := json.Unmarshal(b, &wildcard); err == nil {
+		return nil
+	}
+	type principal keyPolicyPrincipal
+	return json.Unmarshal(b, (*principal)(p))
+}
+
+// extractPolicyPrincipals returns the AWS principals of the statements allowing access in a key policy
+func extractPolicyPrincipals(policy string) ([]string, error) {
+	if policy == "" {
+		return nil, nil
+	}
+	var doc struct {
+		Statement json.RawMessage
+	}
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, err
+	}
+	var statements []*keyPolicyStatement
+	if err := json.Unmarshal(doc.Statement, &statements); err != nil {
+		var single keyPolicyStatement
+		if err = json.Unmarshal(doc.Statement, &single); err != nil {
+			return nil, err
+		}
+		statements = append(statements, &single)
+	}
+
+	var principals []string
+	for _, statement := range statements {
+		if statement.Effect != "Allow" || statement.Principal == nil {
+			continue
+		}
+		for _, p := range statement.Principal.AWS {
+			principals = appendIfNotInSlice(principals, p)
+		}
+	}
+	return principals, nil
+}
+
+// stringOrSlice unmarshals policy elements given either as a single string or as a list
+type stringOrSlice []string
+
+func (s *stringOrSlice) UnmarshalJSON(b []byte) error {
+	var single string
+	if err := json.Unmarshal(b, &single); err == nil {
+		*s = []string{single}
+		return nil
+	}
+	var multi []string
+	if err := json.Unmarshal(b, &multi); err != nil {
+		return err
+	}
+	*s = multi
+	return nil
+}
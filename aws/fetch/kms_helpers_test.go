@@ -0,0 +1,36 @@
+package awsfetch
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtractPolicyPrincipals(t *testing.T) {
+	tcases := []struct {
+		policy string
+		exp    []string
+	}{
+		{policy: "", exp: nil},
+		{
+			policy: `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"}},{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:role/admin","arn:aws:iam::123456789012:root"]}}]}`,
+			exp:    []string{"arn:aws:iam::123456789012:root", "arn:aws:iam::123456789012:role/admin"},
+		},
+		{
+			policy: `{"Statement":{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:user/john"}}}`,
+			exp:    []string{"arn:aws:iam::123456789012:user/john"},
+		},
+		{
+			policy: `{"Statement":[{"Effect":"Deny","Principal":{"AWS":"arn:aws:iam::123456789012:user/john"}},{"Effect":"Allow","Principal":"*"}]}`,
+			exp:    nil,
+		},
+	}
+	for i, tcase := range tcases {
+		principals, err := extractPolicyPrincipals(tcase.policy)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if got, want := principals, tcase.exp; !reflect.DeepEqual(got, want) {
+			t.Fatalf("%d: got %v, want %v", i, got, want)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package awsfetch
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -10,13 +11,24 @@ import (
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/wallix/awless/aws/config"
 	"github.com/wallix/awless/aws/conv"
+	"github.com/wallix/awless/aws/ec2transitgateway"
+	"github.com/wallix/awless/aws/trail"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/cloud/rdf"
@@ -25,6 +37,136 @@ import (
 )
 
 func addManualInfraFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
+	funcs["reservation"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*ec2.ReservedInstances
+
+		if !conf.getBoolDefaultTrue("aws.infra.reservation.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[reservation]")
+			return resources, objects, nil
+		}
+
+		out, err := conf.APIs.Ec2.DescribeReservedInstances(&ec2.DescribeReservedInstancesInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+		if len(out.ReservedInstances) == 0 {
+			return resources, objects, nil
+		}
+
+		instances, err := fetchRunningOnDemandInstances(conf.APIs.Ec2)
+		if err != nil {
+			return resources, objects, err
+		}
+		coverage := reservationsCoverage(out.ReservedInstances, instances)
+
+		for _, reserved := range out.ReservedInstances {
+			objects = append(objects, reserved)
+			res, err := awsconv.NewResource(reserved)
+			if err != nil {
+				return resources, objects, err
+			}
+			if covered, ok := coverage[awssdk.StringValue(reserved.ReservedInstancesId)]; ok {
+				res.Properties()[properties.Instances] = covered
+			}
+			if count := awssdk.Int64Value(reserved.InstanceCount); count > 0 && awssdk.StringValue(reserved.State) == ec2.ReservedInstanceStateActive {
+				res.Properties()[properties.Utilization] = int64(len(coverage[awssdk.StringValue(reserved.ReservedInstancesId)])) * 100 / count
+			}
+			resources = append(resources, res)
+		}
+		return resources, objects, nil
+	}
+
+	funcs["transitgateway"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*ec2transitgateway.TransitGateway
+
+		if !conf.getBoolDefaultTrue("aws.infra.transitgateway.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[transitgateway]")
+			return resources, objects, nil
+		}
+
+		input := &ec2transitgateway.DescribeTransitGatewaysInput{}
+		for {
+			out, err := conf.APIs.Ec2transitgateway.DescribeTransitGateways(input)
+			if err != nil {
+				return resources, objects, err
+			}
+			for _, output := range out.TransitGateways {
+				objects = append(objects, output)
+				res, err := awsconv.NewResource(output)
+				if err != nil {
+					return resources, objects, err
+				}
+				resources = append(resources, res)
+			}
+			if out.NextToken == nil {
+				return resources, objects, nil
+			}
+			input.NextToken = out.NextToken
+		}
+	}
+
+	funcs["transitgatewayattachment"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*ec2transitgateway.TransitGatewayAttachment
+
+		if !conf.getBoolDefaultTrue("aws.infra.transitgatewayattachment.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[transitgatewayattachment]")
+			return resources, objects, nil
+		}
+
+		input := &ec2transitgateway.DescribeTransitGatewayAttachmentsInput{}
+		for {
+			out, err := conf.APIs.Ec2transitgateway.DescribeTransitGatewayAttachments(input)
+			if err != nil {
+				return resources, objects, err
+			}
+			for _, output := range out.TransitGatewayAttachments {
+				objects = append(objects, output)
+				res, err := awsconv.NewResource(output)
+				if err != nil {
+					return resources, objects, err
+				}
+				resources = append(resources, res)
+			}
+			if out.NextToken == nil {
+				return resources, objects, nil
+			}
+			input.NextToken = out.NextToken
+		}
+	}
+
+	funcs["transitgatewayroutetable"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*ec2transitgateway.TransitGatewayRouteTable
+
+		if !conf.getBoolDefaultTrue("aws.infra.transitgatewayroutetable.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[transitgatewayroutetable]")
+			return resources, objects, nil
+		}
+
+		input := &ec2transitgateway.DescribeTransitGatewayRouteTablesInput{}
+		for {
+			out, err := conf.APIs.Ec2transitgateway.DescribeTransitGatewayRouteTables(input)
+			if err != nil {
+				return resources, objects, err
+			}
+			for _, output := range out.TransitGatewayRouteTables {
+				objects = append(objects, output)
+				res, err := awsconv.NewResource(output)
+				if err != nil {
+					return resources, objects, err
+				}
+				resources = append(resources, res)
+			}
+			if out.NextToken == nil {
+				return resources, objects, nil
+			}
+			input.NextToken = out.NextToken
+		}
+	}
+
 	funcs["containerinstance"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
 		var objects []*ecs.ContainerInstance
 		var resources []*graph.Resource
@@ -301,6 +443,86 @@ func addManualInfraFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 		return resources, objects, nil
 	}
 
+	funcs["containerservice"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*ecs.Service
+
+		if !conf.getBoolDefaultTrue("aws.infra.containerservice.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[containerservice]")
+			return resources, objects, nil
+		}
+
+		clusterArns, err := getClusterArns(ctx, cache, conf.APIs.Ecs)
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, cluster := range clusterArns {
+			var serviceArns []string
+			err := conf.APIs.Ecs.ListServicesPages(&ecs.ListServicesInput{Cluster: awssdk.String(cluster)}, func(out *ecs.ListServicesOutput, lastPage bool) (shouldContinue bool) {
+				serviceArns = append(serviceArns, awssdk.StringValueSlice(out.ServiceArns)...)
+				return out.NextToken != nil
+			})
+			if err != nil {
+				return resources, objects, err
+			}
+
+			for _, arns := range sliceOfSlice(serviceArns, 10) {
+				servicesOut, err := conf.APIs.Ecs.DescribeServices(&ecs.DescribeServicesInput{Cluster: awssdk.String(cluster), Services: awssdk.StringSlice(arns)})
+				if err != nil {
+					return resources, objects, err
+				}
+
+				for _, service := range servicesOut.Services {
+					objects = append(objects, service)
+					res, err := awsconv.NewResource(service)
+					if err != nil {
+						return resources, objects, err
+					}
+					resources = append(resources, res)
+				}
+			}
+		}
+		return resources, objects, nil
+	}
+
+	funcs["table"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*dynamodb.TableDescription
+
+		if !conf.getBoolDefaultTrue("aws.infra.table.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[table]")
+			return resources, objects, nil
+		}
+
+		var names []*string
+		err := conf.APIs.Dynamodb.ListTablesPages(&dynamodb.ListTablesInput{},
+			func(out *dynamodb.ListTablesOutput, lastPage bool) (shouldContinue bool) {
+				names = append(names, out.TableNames...)
+				return out.LastEvaluatedTableName != nil
+			})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, name := range names {
+			out, err := conf.APIs.Dynamodb.DescribeTable(&dynamodb.DescribeTableInput{TableName: name})
+			if e, ok := err.(awserr.Error); ok && e.Code() == dynamodb.ErrCodeResourceNotFoundException {
+				continue
+			}
+			if err != nil {
+				return resources, objects, err
+			}
+			objects = append(objects, out.Table)
+			res, err := awsconv.NewResource(out.Table)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+		return resources, objects, nil
+	}
+
 	funcs["listener"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
 		var objects []*elbv2.Listener
 		var resources []*graph.Resource
@@ -362,6 +584,67 @@ func addManualInfraFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 			}
 		}
 	}
+
+	funcs["job"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*batch.JobDetail
+
+		if !conf.getBoolDefaultTrue("aws.infra.job.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[job]")
+			return resources, objects, nil
+		}
+
+		queuesOut, err := conf.APIs.Batch.DescribeJobQueues(&batch.DescribeJobQueuesInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		// jobs can only be listed per queue and per status
+		var jobIds []*string
+		for _, queue := range queuesOut.JobQueues {
+			for _, status := range jobStatuses {
+				out, err := conf.APIs.Batch.ListJobs(&batch.ListJobsInput{JobQueue: queue.JobQueueArn, JobStatus: awssdk.String(status)})
+				if err != nil {
+					return resources, objects, err
+				}
+				for _, job := range out.JobSummaryList {
+					jobIds = append(jobIds, job.JobId)
+				}
+			}
+		}
+
+		for i := 0; i < len(jobIds); i += maxDescribedJobs {
+			end := i + maxDescribedJobs
+			if end > len(jobIds) {
+				end = len(jobIds)
+			}
+			out, err := conf.APIs.Batch.DescribeJobs(&batch.DescribeJobsInput{Jobs: jobIds[i:end]})
+			if err != nil {
+				return resources, objects, err
+			}
+			for _, job := range out.Jobs {
+				objects = append(objects, job)
+				res, err := awsconv.NewResource(job)
+				if err != nil {
+					return resources, objects, err
+				}
+				resources = append(resources, res)
+			}
+		}
+		return resources, objects, nil
+	}
+}
+
+const maxDescribedJobs = 100
+
+var jobStatuses = []string{
+	batch.JobStatusSubmitted,
+	batch.JobStatusPending,
+	batch.JobStatusRunnable,
+	batch.JobStatusStarting,
+	batch.JobStatusRunning,
+	batch.JobStatusSucceeded,
+	batch.JobStatusFailed,
 }
 
 func addManualAccessFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
@@ -530,7 +813,7 @@ func addManualAccessFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 					errC <- e
 					return
 				}
-				if strings.HasPrefix(awssdk.StringValue(p.Arn), "arn:aws:iam::aws:policy") {
+				if awsconfig.IsAWSManagedPolicyARN(awssdk.StringValue(p.Arn)) {
 					res.Properties()[properties.Type] = "AWS Managed"
 				} else {
 					res.Properties()[properties.Type] = "Customer Managed"
@@ -650,7 +933,122 @@ func addManualAccessFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 			}
 		}
 	}
+
+	funcs["key"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*kms.KeyMetadata
+
+		if !conf.getBoolDefaultTrue("aws.access.key.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource access[key]")
+			return resources, objects, nil
+		}
+
+		aliases, err := getAliasesPerKey(conf.APIs.Kms)
+		if err != nil {
+			return resources, objects, err
+		}
+
+		var keyIDs []*string
+		err = conf.APIs.Kms.ListKeysPages(&kms.ListKeysInput{},
+			func(out *kms.ListKeysOutput, lastPage bool) (shouldContinue bool) {
+				for _, key := range out.Keys {
+					keyIDs = append(keyIDs, key.KeyId)
+				}
+				return out.NextMarker != nil
+			})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, id := range keyIDs {
+			out, err := conf.APIs.Kms.DescribeKey(&kms.DescribeKeyInput{KeyId: id})
+			if err != nil {
+				return resources, objects, err
+			}
+			objects = append(objects, out.KeyMetadata)
+			res, err := awsconv.NewResource(out.KeyMetadata)
+			if err != nil {
+				return resources, objects, err
+			}
+			if names := aliases[awssdk.StringValue(id)]; len(names) > 0 {
+				res.Properties()[properties.Name] = strings.TrimPrefix(names[0], "alias/")
+				res.Properties()[properties.Aliases] = names
+			}
+			principals, err := fetchKeyPrincipals(conf.APIs.Kms, id)
+			if err != nil {
+				return resources, objects, fmt.Errorf("fetching principals of key %s: %s", awssdk.StringValue(id), err)
+			}
+			if len(principals) > 0 {
+				res.Properties()[properties.Principals] = principals
+			}
+			resources = append(resources, res)
+		}
+		return resources, objects, nil
+	}
+
+	funcs["account"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*organizations.Account
+
+		if !conf.getBoolDefaultTrue("aws.access.account.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource access[account]")
+			return resources, objects, nil
+		}
+
+		tree, err := getOrganizationTree(ctx, cache, conf.APIs.Organizations)
+		if err != nil {
+			return resources, objects, err
+		}
+		for _, account := range tree.accounts {
+			objects = append(objects, account)
+			res, err := awsconv.NewResource(account)
+			if err != nil {
+				return resources, objects, err
+			}
+			addOrganizationParent(res, tree.parents[awssdk.StringValue(account.Id)])
+			resources = append(resources, res)
+		}
+		return resources, objects, nil
+	}
+
+	funcs["organizationalunit"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*organizations.OrganizationalUnit
+
+		if !conf.getBoolDefaultTrue("aws.access.organizationalunit.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource access[organizationalunit]")
+			return resources, objects, nil
+		}
+
+		tree, err := getOrganizationTree(ctx, cache, conf.APIs.Organizations)
+		if err != nil {
+			return resources, objects, err
+		}
+		for _, unit := range tree.units {
+			objects = append(objects, unit)
+			res, err := awsconv.NewResource(unit)
+			if err != nil {
+				return resources, objects, err
+			}
+			addOrganizationParent(res, tree.parents[awssdk.StringValue(unit.Id)])
+			resources = append(resources, res)
+		}
+		return resources, objects, nil
+	}
 }
+
+// addOrganizationParent places accounts and organizational units under their parent unit,
+// the roots of the organization not being synced
+func addOrganizationParent(res *graph.Resource, parentID string) {
+	if parentID == "" {
+		return
+	}
+	res.Properties()[properties.Parent] = parentID
+	if strings.HasPrefix(parentID, "ou-") {
+		res.AddRelation(rdf.ChildrenOfRel, graph.InitResource(cloud.OrganizationalUnit, parentID))
+	}
+}
+
 func addManualStorageFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 	funcs["bucket"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
 		var resources []*graph.Resource
@@ -742,6 +1140,9 @@ func addManualMessagingFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 				objectsC <- url
 				res := graph.InitResource(cloud.Queue, awssdk.StringValue(url))
 				res.Properties()[properties.ID] = awssdk.StringValue(url)
+				if parts := strings.Split(awssdk.StringValue(url), "/"); len(parts) > 0 {
+					res.Properties()[properties.Name] = parts[len(parts)-1]
+				}
 				attrs, err := conf.APIs.Sqs.GetQueueAttributes(&sqs.GetQueueAttributesInput{AttributeNames: []*string{awssdk.String("All")}, QueueUrl: url})
 				if e, ok := err.(awserr.RequestFailure); ok && (e.Code() == sqs.ErrCodeQueueDoesNotExist || e.Code() == sqs.ErrCodeQueueDeletedRecently) {
 					return
@@ -782,6 +1183,27 @@ func addManualMessagingFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 							errC <- err
 						}
 						res.Properties()[properties.Delay] = delay
+					case "VisibilityTimeout":
+						timeout, err := strconv.Atoi(awssdk.StringValue(v))
+						if err != nil {
+							errC <- err
+						}
+						res.Properties()[properties.VisibilityTimeout] = timeout
+					case "FifoQueue":
+						res.Properties()[properties.Fifo] = awssdk.StringValue(v) == "true"
+					case "RedrivePolicy":
+						var redrive struct {
+							DeadLetterTargetArn string
+							MaxReceiveCount     json.Number
+						}
+						if err := json.Unmarshal([]byte(awssdk.StringValue(v)), &redrive); err != nil {
+							errC <- err
+							return
+						}
+						res.Properties()[properties.DeadLetterQueue] = redrive.DeadLetterTargetArn
+						if count, err := redrive.MaxReceiveCount.Int64(); err == nil {
+							res.Properties()[properties.MaxReceiveCount] = int(count)
+						}
 					}
 
 				}
@@ -915,10 +1337,140 @@ func addManualDnsFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 	}
 }
 func addManualLambdaFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
+	funcs["execution"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*sfn.ExecutionListItem
+
+		if !conf.getBoolDefaultTrue("aws.lambda.execution.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource lambda[execution]")
+			return resources, objects, nil
+		}
+
+		var machineArns []*string
+		err := conf.APIs.Sfn.ListStateMachinesPages(&sfn.ListStateMachinesInput{},
+			func(out *sfn.ListStateMachinesOutput, lastPage bool) (shouldContinue bool) {
+				for _, machine := range out.StateMachines {
+					machineArns = append(machineArns, machine.StateMachineArn)
+				}
+				return out.NextToken != nil
+			})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		// only the most recent executions of each state machine are synced, as they are kept 90 days
+		for _, arn := range machineArns {
+			out, err := conf.APIs.Sfn.ListExecutions(&sfn.ListExecutionsInput{StateMachineArn: arn, MaxResults: awssdk.Int64(recentExecutionsCount)})
+			if err != nil {
+				return resources, objects, err
+			}
+			for _, execution := range out.Executions {
+				objects = append(objects, execution)
+				res, err := awsconv.NewResource(execution)
+				if err != nil {
+					return resources, objects, err
+				}
+				resources = append(resources, res)
+			}
+		}
+		return resources, objects, nil
+	}
 }
+
+const recentExecutionsCount = 10
+
 func addManualMonitoringFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
+	funcs["trailevent"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*cloudtrail.Event
+
+		if !conf.getBoolDefaultTrue("aws.monitoring.trailevent.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource monitoring[trailevent]")
+			return resources, objects, nil
+		}
+
+		// only the recent events changing resources are synced, read-only and failed calls being left out
+		input := &cloudtrail.LookupEventsInput{StartTime: awssdk.Time(time.Now().Add(-recentTrailEventsWindow))}
+		var badResErr error
+		err := conf.APIs.Cloudtrail.LookupEventsPages(input, func(out *cloudtrail.LookupEventsOutput, lastPage bool) (shouldContinue bool) {
+			for _, e := range out.Events {
+				event, err := awstrail.NewEvent(e)
+				if err != nil {
+					badResErr = err
+					return false
+				}
+				if !event.IsChange() {
+					continue
+				}
+				objects = append(objects, e)
+				res, err := awsconv.NewResource(e)
+				if err != nil {
+					badResErr = err
+					return false
+				}
+				res.Properties()[properties.Type] = event.Action
+				res.Properties()[properties.Source] = event.Source
+				res.Properties()[properties.Username] = event.Principal
+				resources = append(resources, res)
+				if len(resources) >= recentTrailEventsCount {
+					return false
+				}
+			}
+			return out.NextToken != nil
+		})
+		if err != nil {
+			return resources, objects, err
+		}
+		return resources, objects, badResErr
+	}
 }
+
+const (
+	recentTrailEventsWindow = 24 * time.Hour
+	recentTrailEventsCount  = 100
+)
+
 func addManualCdnFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 }
 func addManualCloudformationFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
+	funcs["stack"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*cloudformation.Stack
+
+		if !conf.getBoolDefaultTrue("aws.cloudformation.stack.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource cloudformation[stack]")
+			return resources, objects, nil
+		}
+
+		err := conf.APIs.Cloudformation.DescribeStacksPages(&cloudformation.DescribeStacksInput{},
+			func(out *cloudformation.DescribeStacksOutput, lastPage bool) (shouldContinue bool) {
+				objects = append(objects, out.Stacks...)
+				return out.NextToken != nil
+			})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, stack := range objects {
+			res, err := awsconv.NewResource(stack)
+			if err != nil {
+				return resources, objects, err
+			}
+			err = conf.APIs.Cloudformation.ListStackResourcesPages(&cloudformation.ListStackResourcesInput{StackName: stack.StackId},
+				func(out *cloudformation.ListStackResourcesOutput, lastPage bool) (shouldContinue bool) {
+					for _, member := range out.StackResourceSummaries {
+						if id := awssdk.StringValue(member.PhysicalResourceId); id != "" && awssdk.StringValue(member.ResourceStatus) != cloudformation.ResourceStatusDeleteComplete {
+							res.AddRelation(rdf.StackMembersRel, graph.NotFoundResource(id))
+						}
+					}
+					return out.NextToken != nil
+				})
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+
+		return resources, objects, nil
+	}
 }
@@ -0,0 +1,74 @@
+package awsfetch
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/organizations/organizationsiface"
+	"github.com/wallix/awless/fetch"
+)
+
+type organizationTree struct {
+	accounts []*organizations.Account
+	units    []*organizations.OrganizationalUnit
+	// ids of the root or organizational unit containing each account or unit
+	parents map[string]string
+}
+
+// getOrganizationTree walks the organization from its roots down to its accounts.
+// An empty tree is returned when the account is not a member of an organization.
+func getOrganizationTree(ctx context.Context, cache fetch.Cache, api organizationsiface.OrganizationsAPI) (*organizationTree, error) {
+	val, err := cache.Get("getOrganizationTree", func() (interface{}, error) {
+		tree := &organizationTree{parents: make(map[string]string)}
+
+		var parentIDs []string
+		err := api.ListRootsPages(&organizations.ListRootsInput{}, func(out *organizations.ListRootsOutput, lastPage bool) bool {
+			for _, root := range out.Roots {
+				parentIDs = append(parentIDs, awssdk.StringValue(root.Id))
+			}
+			return out.NextToken != nil
+		})
+		if e, ok := err.(awserr.Error); ok && e.Code() == organizations.ErrCodeAWSOrganizationsNotInUseException {
+			return tree, nil
+		}
+		if err != nil {
+			return tree, err
+		}
+
+		for len(parentIDs) > 0 {
+			parentID := parentIDs[0]
+			parentIDs = parentIDs[1:]
+
+			err := api.ListOrganizationalUnitsForParentPages(&organizations.ListOrganizationalUnitsForParentInput{ParentId: awssdk.String(parentID)},
+				func(out *organizations.ListOrganizationalUnitsForParentOutput, lastPage bool) bool {
+					for _, unit := range out.OrganizationalUnits {
+						tree.units = append(tree.units, unit)
+						tree.parents[awssdk.StringValue(unit.Id)] = parentID
+						parentIDs = append(parentIDs, awssdk.StringValue(unit.Id))
+					}
+					return out.NextToken != nil
+				})
+			if err != nil {
+				return tree, err
+			}
+			err = api.ListAccountsForParentPages(&organizations.ListAccountsForParentInput{ParentId: awssdk.String(parentID)},
+				func(out *organizations.ListAccountsForParentOutput, lastPage bool) bool {
+					for _, account := range out.Accounts {
+						tree.accounts = append(tree.accounts, account)
+						tree.parents[awssdk.StringValue(account.Id)] = parentID
+					}
+					return out.NextToken != nil
+				})
+			if err != nil {
+				return tree, err
+			}
+		}
+		return tree, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return val.(*organizationTree), nil
+}
@@ -9,6 +9,7 @@ import (
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/wallix/awless/aws/config"
 	"github.com/wallix/awless/aws/conv"
 	"github.com/wallix/awless/cloud/rdf"
 	"github.com/wallix/awless/fetch"
@@ -52,13 +53,30 @@ func forEachBucketParallel(ctx context.Context, cache fetch.Cache, api s3iface.S
 	return nil
 }
 
+// fetchObjectsForBucket fetches all the objects of the bucket, or only the ones
+// whose key starts with the prefix user filter, if any
 func fetchObjectsForBucket(ctx context.Context, api s3iface.S3API, bucket *s3.Bucket, resourcesC chan<- *graph.Resource) error {
-	out, err := api.ListObjects(&s3.ListObjectsInput{Bucket: bucket.Name})
-	if err != nil {
-		return err
+	input := &s3.ListObjectsInput{Bucket: bucket.Name}
+	if prefix, ok := getUserFiltersFromContext(ctx)["prefix"]; ok {
+		input.Prefix = awssdk.String(prefix)
+	}
+	for {
+		out, err := api.ListObjects(input)
+		if err != nil {
+			return err
+		}
+		if err = sendObjectsOfBucket(out.Contents, bucket, resourcesC); err != nil {
+			return err
+		}
+		if !awssdk.BoolValue(out.IsTruncated) || len(out.Contents) == 0 {
+			return nil
+		}
+		input.Marker = out.Contents[len(out.Contents)-1].Key
 	}
+}
 
-	for _, output := range out.Contents {
+func sendObjectsOfBucket(objects []*s3.Object, bucket *s3.Bucket, resourcesC chan<- *graph.Resource) error {
+	for _, output := range objects {
 		res, err := awsconv.NewResource(output)
 		if err != nil {
 			return err
@@ -122,7 +140,7 @@ func getBucketsPerRegion(ctx context.Context, api s3iface.S3API) ([]*s3.Bucket,
 			region, _ := ctx.Value("region").(string)
 			switch awssdk.StringValue(loc.LocationConstraint) {
 			case "":
-				if region == "us-east-1" {
+				if awsconfig.IsDefaultRegionOfPartition(region) {
 					bucketc <- b
 				}
 			case region:
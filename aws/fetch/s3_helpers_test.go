@@ -3,6 +3,8 @@ package awsfetch
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
@@ -61,6 +63,36 @@ func TestFetchFunctions(t *testing.T) {
 			}
 		}
 	})
+	t.Run("fetchObjectsForBucket", func(t *testing.T) {
+		mock := &mockS3{objects: map[string][]*s3.Object{"bucket_1": {
+			{Key: awssdk.String("index.html")}, {Key: awssdk.String("logs/1.log")}, {Key: awssdk.String("logs/2.log")}, {Key: awssdk.String("logs/3.log")},
+		}}, pageSize: 2}
+		fetch := func(ctx context.Context) (keys []string) {
+			resourcesC := make(chan *graph.Resource)
+			done := make(chan struct{})
+			go func() {
+				for r := range resourcesC {
+					if r.Type() == "s3object" {
+						keys = append(keys, r.Id())
+					}
+				}
+				close(done)
+			}()
+			if err := fetchObjectsForBucket(ctx, mock, &s3.Bucket{Name: awssdk.String("bucket_1")}, resourcesC); err != nil {
+				t.Fatal(err)
+			}
+			close(resourcesC)
+			<-done
+			return
+		}
+		if got, want := fetch(context.Background()), []string{"index.html", "logs/1.log", "logs/2.log", "logs/3.log"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		ctx := context.WithValue(context.Background(), "filters", []string{"bucket=bucket_1", "prefix=logs/"})
+		if got, want := fetch(ctx), []string{"logs/1.log", "logs/2.log", "logs/3.log"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
 }
 
 type mockS3 struct {
@@ -68,6 +100,8 @@ type mockS3 struct {
 	buckets map[string][]*s3.Bucket
 	objects map[string][]*s3.Object
 	grants  map[string][]*s3.Grant
+	// pageSize paginates the listing of objects when set
+	pageSize int
 }
 
 func (m *mockS3) GetBucketAcl(input *s3.GetBucketAclInput) (*s3.GetBucketAclOutput, error) {
@@ -82,7 +116,17 @@ func (m *mockS3) ListBuckets(input *s3.ListBucketsInput) (*s3.ListBucketsOutput,
 	return &s3.ListBucketsOutput{Buckets: buckets}, nil
 }
 func (m *mockS3) ListObjects(input *s3.ListObjectsInput) (*s3.ListObjectsOutput, error) {
-	return &s3.ListObjectsOutput{Contents: m.objects[awssdk.StringValue(input.Bucket)]}, nil
+	var objects []*s3.Object
+	for _, obj := range m.objects[awssdk.StringValue(input.Bucket)] {
+		key := awssdk.StringValue(obj.Key)
+		if strings.HasPrefix(key, awssdk.StringValue(input.Prefix)) && key > awssdk.StringValue(input.Marker) {
+			objects = append(objects, obj)
+		}
+	}
+	if m.pageSize > 0 && len(objects) > m.pageSize {
+		return &s3.ListObjectsOutput{Contents: objects[:m.pageSize], IsTruncated: awssdk.Bool(true)}, nil
+	}
+	return &s3.ListObjectsOutput{Contents: objects}, nil
 }
 func (m *mockS3) GetBucketLocation(input *s3.GetBucketLocationInput) (*s3.GetBucketLocationOutput, error) {
 	for region, buckets := range m.buckets {
@@ -0,0 +1,142 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package secretsmanager is a client of the AWS Secrets Manager API (version 2017-10-17),
+// limited to the calls and fields used by the awless drivers.
+//
+// The vendored aws-sdk-go (1.12.55) predates this service, which the SDK added in 1.13.28.
+// The types mirror service/secretsmanager of aws-sdk-go 1.15.8, the first release with
+// DeleteSecretInput.ForceDeleteWithoutRecovery. Replace this package with the SDK one
+// when bumping the vendored aws-sdk-go past this version.
+package secretsmanager
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/private/protocol/jsonrpc"
+)
+
+const (
+	ServiceName = "secretsmanager"
+	EndpointsID = ServiceName
+)
+
+// SecretsManager provides the API operation methods for making requests to AWS Secrets Manager
+type SecretsManager struct {
+	*client.Client
+}
+
+// New creates a new instance of the SecretsManager client with a session
+func New(p client.ConfigProvider, cfgs ...*aws.Config) *SecretsManager {
+	c := p.ClientConfig(EndpointsID, cfgs...)
+	svc := &SecretsManager{
+		Client: client.New(
+			*c.Config,
+			metadata.ClientInfo{
+				ServiceName:   ServiceName,
+				SigningName:   c.SigningName,
+				SigningRegion: c.SigningRegion,
+				Endpoint:      c.Endpoint,
+				APIVersion:    "2017-10-17",
+				JSONVersion:   "1.1",
+				TargetPrefix:  "secretsmanager",
+			},
+			c.Handlers,
+		),
+	}
+	svc.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	svc.Handlers.Build.PushBackNamed(jsonrpc.BuildHandler)
+	svc.Handlers.Unmarshal.PushBackNamed(jsonrpc.UnmarshalHandler)
+	svc.Handlers.UnmarshalMeta.PushBackNamed(jsonrpc.UnmarshalMetaHandler)
+	svc.Handlers.UnmarshalError.PushBackNamed(jsonrpc.UnmarshalErrorHandler)
+	return svc
+}
+
+func (c *SecretsManager) send(name string, input, output interface{}) error {
+	return c.NewRequest(&request.Operation{Name: name, HTTPMethod: "POST", HTTPPath: "/"}, input, output).Send()
+}
+
+// CreateSecret creates a new secret, storing its value in its initial version
+func (c *SecretsManager) CreateSecret(input *CreateSecretInput) (*CreateSecretOutput, error) {
+	if input == nil {
+		input = &CreateSecretInput{}
+	}
+	output := &CreateSecretOutput{}
+	return output, c.send("CreateSecret", input, output)
+}
+
+// UpdateSecret modifies the details of a secret, storing its value in a new version when given
+func (c *SecretsManager) UpdateSecret(input *UpdateSecretInput) (*UpdateSecretOutput, error) {
+	if input == nil {
+		input = &UpdateSecretInput{}
+	}
+	output := &UpdateSecretOutput{}
+	return output, c.send("UpdateSecret", input, output)
+}
+
+// DeleteSecret deletes a secret after a recovery window, or immediately when forced
+func (c *SecretsManager) DeleteSecret(input *DeleteSecretInput) (*DeleteSecretOutput, error) {
+	if input == nil {
+		input = &DeleteSecretInput{}
+	}
+	output := &DeleteSecretOutput{}
+	return output, c.send("DeleteSecret", input, output)
+}
+
+type CreateSecretInput struct {
+	ClientRequestToken *string `min:"32" type:"string" idempotencyToken:"true"`
+	Description        *string `type:"string"`
+	KmsKeyId           *string `type:"string"`
+	Name               *string `min:"1" type:"string" required:"true"`
+	SecretString       *string `type:"string"`
+}
+
+type CreateSecretOutput struct {
+	ARN       *string `min:"20" type:"string"`
+	Name      *string `min:"1" type:"string"`
+	VersionId *string `min:"32" type:"string"`
+}
+
+type UpdateSecretInput struct {
+	ClientRequestToken *string `min:"32" type:"string" idempotencyToken:"true"`
+	Description        *string `type:"string"`
+	KmsKeyId           *string `type:"string"`
+	SecretId           *string `min:"1" type:"string" required:"true"`
+	SecretString       *string `type:"string"`
+}
+
+type UpdateSecretOutput struct {
+	ARN       *string `min:"20" type:"string"`
+	Name      *string `min:"1" type:"string"`
+	VersionId *string `min:"32" type:"string"`
+}
+
+type DeleteSecretInput struct {
+	ForceDeleteWithoutRecovery *bool   `type:"boolean"`
+	RecoveryWindowInDays       *int64  `type:"long"`
+	SecretId                   *string `min:"1" type:"string" required:"true"`
+}
+
+type DeleteSecretOutput struct {
+	ARN          *string    `min:"20" type:"string"`
+	DeletionDate *time.Time `type:"timestamp" timestampFormat:"unix"`
+	Name         *string    `min:"1" type:"string"`
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsservices
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/wallix/awless/aws/spec"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/logger"
+)
+
+// DefaultAccountRole is the role assumed in the accounts of the statements given no role,
+// the one created by AWS Organizations in the accounts it creates
+const DefaultAccountRole = "OrganizationAccountAccessRole"
+
+var accountIDRegex = regexp.MustCompile(`^[0-9]{12}$`)
+
+var accounts *accountSessions
+
+// CommandInAccount builds the command of the given key (ex: createinstance) authenticated
+// in another account than the one of the profile, by assuming the given role in it
+func CommandInAccount(account, role, key string) (interface{}, error) {
+	if accounts == nil {
+		return nil, errors.New("AWS services not initialized")
+	}
+	sess, err := accounts.session(account, role)
+	if err != nil {
+		return nil, err
+	}
+	factory := &awsspec.AWSFactory{Log: accounts.log, Sess: sess, Graph: graph.NewGraph()}
+	newCommandFunc := factory.Build(key)
+	if newCommandFunc == nil {
+		return nil, fmt.Errorf("unknown AWS command '%s'", key)
+	}
+	return newCommandFunc(), nil
+}
+
+// accountSessions derives from the session of the profile the sessions of the roles assumed
+// in other accounts. Each role is assumed once per run, its credentials being cached on disk
+// like the ones of the profile until they expire
+type accountSessions struct {
+	base    *session.Session
+	profile string
+	log     *logger.Logger
+
+	mu       sync.Mutex
+	sessions map[string]*session.Session
+}
+
+func newAccountSessions(base *session.Session, profile string, log *logger.Logger) *accountSessions {
+	return &accountSessions{base: base, profile: profile, log: log, sessions: make(map[string]*session.Session)}
+}
+
+func (a *accountSessions) session(account, role string) (*session.Session, error) {
+	roleARN, err := AccountRoleARN(account, role, awssdk.StringValue(a.base.Config.Region))
+	if err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if sess, ok := a.sessions[roleARN]; ok {
+		return sess, nil
+	}
+	parsed, _ := arn.Parse(roleARN)
+	a.log.ExtraVerbosef("assuming role '%s' in account %s", roleARN, parsed.AccountID)
+	creds := credentials.NewCredentials(&fileCacheProvider{
+		creds: stscreds.NewCredentials(a.base, roleARN, func(p *stscreds.AssumeRoleProvider) {
+			p.RoleSessionName = "awless"
+		}),
+		profile: fmt.Sprintf("%s-%s-%s", a.profile, parsed.AccountID, strings.Replace(strings.TrimPrefix(parsed.Resource, "role/"), "/", "-", -1)),
+		log:     a.log,
+	})
+	sess := a.base.Copy(&awssdk.Config{Credentials: creds})
+	a.sessions[roleARN] = sess
+	return sess, nil
+}
+
+// AccountRoleARN returns the ARN of the role assumed to run statements in the account,
+// in the partition of the region
+func AccountRoleARN(account, role, region string) (string, error) {
+	partition := "aws"
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+		partition = p.ID()
+	}
+	return accountRoleARN(account, role, partition)
+}
+
+// accountRoleARN returns the ARN of the role to assume in the account, given either
+// as an account id and a role name or as the ARN of the role
+func accountRoleARN(account, role, partition string) (string, error) {
+	if strings.HasPrefix(account, "arn:") {
+		if role != "" {
+			return "", fmt.Errorf("role '%s' given with the role ARN '%s'", role, account)
+		}
+		parsed, err := arn.Parse(account)
+		if err != nil || parsed.Service != "iam" || !strings.HasPrefix(parsed.Resource, "role/") || !accountIDRegex.MatchString(parsed.AccountID) {
+			return "", fmt.Errorf("invalid role ARN '%s'", account)
+		}
+		return account, nil
+	}
+	if !accountIDRegex.MatchString(account) {
+		return "", fmt.Errorf("invalid account '%s': expecting a 12-digit account id or a role ARN", account)
+	}
+	if role == "" {
+		role = DefaultAccountRole
+	}
+	return fmt.Sprintf("arn:%s:iam::%s:role/%s", partition, account, strings.TrimPrefix(role, "/")), nil
+}
@@ -0,0 +1,69 @@
+package awsservices
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/wallix/awless/logger"
+)
+
+func TestAccountRoleARN(t *testing.T) {
+	tcases := []struct {
+		account, role, partition string
+		exp, expErr              string
+	}{
+		{account: "123456789012", partition: "aws", exp: "arn:aws:iam::123456789012:role/OrganizationAccountAccessRole"},
+		{account: "012345678901", role: "deployer", partition: "aws", exp: "arn:aws:iam::012345678901:role/deployer"},
+		{account: "123456789012", role: "ci/deployer", partition: "aws-cn", exp: "arn:aws-cn:iam::123456789012:role/ci/deployer"},
+		{account: "arn:aws:iam::123456789012:role/deployer", partition: "aws", exp: "arn:aws:iam::123456789012:role/deployer"},
+		{account: "arn:aws:iam::123456789012:role/deployer", role: "admin", partition: "aws", expErr: "role 'admin' given with the role ARN 'arn:aws:iam::123456789012:role/deployer'"},
+		{account: "arn:aws:iam::123456789012:user/jsmith", partition: "aws", expErr: "invalid role ARN 'arn:aws:iam::123456789012:user/jsmith'"},
+		{account: "12345", partition: "aws", expErr: "invalid account '12345': expecting a 12-digit account id or a role ARN"},
+	}
+	for i, tcase := range tcases {
+		got, err := accountRoleARN(tcase.account, tcase.role, tcase.partition)
+		if tcase.expErr != "" {
+			if err == nil || err.Error() != tcase.expErr {
+				t.Fatalf("%d: got %v, want %s", i+1, err, tcase.expErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if got != tcase.exp {
+			t.Fatalf("%d: got %s, want %s", i+1, got, tcase.exp)
+		}
+	}
+}
+
+func TestAccountSessionsAreShared(t *testing.T) {
+	base := session.Must(session.NewSession(&awssdk.Config{Region: awssdk.String("cn-north-1")}))
+	sessions := newAccountSessions(base, "default", logger.DiscardLogger)
+
+	deployer, err := sessions.session("123456789012", "deployer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := sessions.session("arn:aws-cn:iam::123456789012:role/deployer", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deployer != again {
+		t.Fatal("expected the session of the role to be reused")
+	}
+	admin, err := sessions.session("123456789012", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if admin == deployer {
+		t.Fatal("expected distinct sessions for distinct roles")
+	}
+	if admin.Config.Credentials == base.Config.Credentials {
+		t.Fatal("expected the credentials of the assumed role")
+	}
+	if got, want := len(sessions.sessions), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsservices
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/wallix/awless/logger"
+)
+
+// Services whose endpoints have to be reachable for awless to sync and run templates
+var requiredEndpointServices = []string{"sts", "iam", "ec2", "s3"}
+
+// RequiredEndpoints returns the hosts (with port) awless sends requests to in a region
+func RequiredEndpoints(region string) ([]string, error) {
+	var hosts []string
+	for _, service := range requiredEndpointServices {
+		e, err := endpoints.DefaultResolver().EndpointFor(service, region)
+		if err != nil {
+			return hosts, fmt.Errorf("resolving %s endpoint: %s", service, err)
+		}
+		u, err := url.Parse(e.URL)
+		if err != nil {
+			return hosts, fmt.Errorf("resolving %s endpoint: %s", service, err)
+		}
+		host := u.Host
+		if u.Port() == "" {
+			host = host + ":443"
+		}
+		hosts = append(hosts, host)
+	}
+	return hosts, nil
+}
+
+// CredentialsHealth reports where the credentials of a profile come from
+// and who they authenticate as
+type CredentialsHealth struct {
+	Provider string
+	Identity *Identity
+}
+
+// CheckCredentials resolves the credentials of a profile, using the awless cache
+// but without prompting for new ones, then verifies them against AWS STS
+func CheckCredentials(profile, region string) (*CredentialsHealth, error) {
+	sess, err := newSessionResolver().withRegion(region).withProfile(profile).resolve()
+	if err != nil {
+		return nil, err
+	}
+	sess.Config.Credentials = credentials.NewCredentials(&fileCacheProvider{
+		creds:   sess.Config.Credentials,
+		profile: profile,
+		log:     logger.DiscardLogger,
+	})
+	val, err := sess.Config.Credentials.Get()
+	if err != nil {
+		return nil, err
+	}
+	health := &CredentialsHealth{Provider: val.ProviderName}
+
+	access := &Access{STSAPI: sts.New(sess)}
+	if health.Identity, err = access.GetIdentity(); err != nil {
+		return health, err
+	}
+	return health, nil
+}
@@ -0,0 +1,25 @@
+package awsservices
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRequiredEndpoints(t *testing.T) {
+	hosts, err := RequiredEndpoints("eu-west-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"sts.amazonaws.com:443", "iam.amazonaws.com:443", "ec2.eu-west-1.amazonaws.com:443", "s3.eu-west-1.amazonaws.com:443"}
+	if got, want := hosts, expected; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	hosts, err = RequiredEndpoints("cn-north-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := hosts[2], "ec2.cn-north-1.amazonaws.com.cn:443"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
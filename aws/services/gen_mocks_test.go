@@ -27,34 +27,58 @@ import (
 	"github.com/aws/aws-sdk-go/service/acm/acmiface"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/batch/batchiface"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/aws/aws-sdk-go/service/directconnect/directconnectiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/organizations/organizationsiface"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/redshift/redshiftiface"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/wallix/awless/aws/ec2transitgateway"
+	"github.com/wallix/awless/aws/ec2transitgateway/ec2transitgatewayiface"
 	"github.com/wallix/awless/cloud"
 )
 
@@ -62,21 +86,32 @@ import (
 
 type mockEc2 struct {
 	ec2iface.EC2API
-	instances         []*ec2.Instance
-	subnets           []*ec2.Subnet
-	vpcs              []*ec2.Vpc
-	keypairinfos      []*ec2.KeyPairInfo
-	securitygroups    []*ec2.SecurityGroup
-	volumes           []*ec2.Volume
-	internetgateways  []*ec2.InternetGateway
-	natgateways       []*ec2.NatGateway
-	routetables       []*ec2.RouteTable
-	availabilityzones []*ec2.AvailabilityZone
-	images            []*ec2.Image
-	importimagetasks  []*ec2.ImportImageTask
-	addresss          []*ec2.Address
-	snapshots         []*ec2.Snapshot
-	networkinterfaces []*ec2.NetworkInterface
+	instances               []*ec2.Instance
+	subnets                 []*ec2.Subnet
+	vpcs                    []*ec2.Vpc
+	keypairinfos            []*ec2.KeyPairInfo
+	securitygroups          []*ec2.SecurityGroup
+	volumes                 []*ec2.Volume
+	internetgateways        []*ec2.InternetGateway
+	natgateways             []*ec2.NatGateway
+	vpcpeeringconnections   []*ec2.VpcPeeringConnection
+	vpcendpoints            []*ec2.VpcEndpoint
+	customergateways        []*ec2.CustomerGateway
+	vpngateways             []*ec2.VpnGateway
+	vpnconnections          []*ec2.VpnConnection
+	routetables             []*ec2.RouteTable
+	networkacls             []*ec2.NetworkAcl
+	placementgroups         []*ec2.PlacementGroup
+	hosts                   []*ec2.Host
+	availabilityzones       []*ec2.AvailabilityZone
+	images                  []*ec2.Image
+	importimagetasks        []*ec2.ImportImageTask
+	addresss                []*ec2.Address
+	snapshots               []*ec2.Snapshot
+	networkinterfaces       []*ec2.NetworkInterface
+	spotinstancerequests    []*ec2.SpotInstanceRequest
+	spotfleetrequestconfigs []*ec2.SpotFleetRequestConfig
+	reservedinstancess      []*ec2.ReservedInstances
 }
 
 func (m *mockEc2) Name() string {
@@ -156,10 +191,42 @@ func (m *mockEc2) DescribeNatGateways(input *ec2.DescribeNatGatewaysInput) (*ec2
 	return &ec2.DescribeNatGatewaysOutput{NatGateways: m.natgateways}, nil
 }
 
+func (m *mockEc2) DescribeVpcPeeringConnections(input *ec2.DescribeVpcPeeringConnectionsInput) (*ec2.DescribeVpcPeeringConnectionsOutput, error) {
+	return &ec2.DescribeVpcPeeringConnectionsOutput{VpcPeeringConnections: m.vpcpeeringconnections}, nil
+}
+
+func (m *mockEc2) DescribeVpcEndpoints(input *ec2.DescribeVpcEndpointsInput) (*ec2.DescribeVpcEndpointsOutput, error) {
+	return &ec2.DescribeVpcEndpointsOutput{VpcEndpoints: m.vpcendpoints}, nil
+}
+
+func (m *mockEc2) DescribeCustomerGateways(input *ec2.DescribeCustomerGatewaysInput) (*ec2.DescribeCustomerGatewaysOutput, error) {
+	return &ec2.DescribeCustomerGatewaysOutput{CustomerGateways: m.customergateways}, nil
+}
+
+func (m *mockEc2) DescribeVpnGateways(input *ec2.DescribeVpnGatewaysInput) (*ec2.DescribeVpnGatewaysOutput, error) {
+	return &ec2.DescribeVpnGatewaysOutput{VpnGateways: m.vpngateways}, nil
+}
+
+func (m *mockEc2) DescribeVpnConnections(input *ec2.DescribeVpnConnectionsInput) (*ec2.DescribeVpnConnectionsOutput, error) {
+	return &ec2.DescribeVpnConnectionsOutput{VpnConnections: m.vpnconnections}, nil
+}
+
 func (m *mockEc2) DescribeRouteTables(input *ec2.DescribeRouteTablesInput) (*ec2.DescribeRouteTablesOutput, error) {
 	return &ec2.DescribeRouteTablesOutput{RouteTables: m.routetables}, nil
 }
 
+func (m *mockEc2) DescribeNetworkAcls(input *ec2.DescribeNetworkAclsInput) (*ec2.DescribeNetworkAclsOutput, error) {
+	return &ec2.DescribeNetworkAclsOutput{NetworkAcls: m.networkacls}, nil
+}
+
+func (m *mockEc2) DescribePlacementGroups(input *ec2.DescribePlacementGroupsInput) (*ec2.DescribePlacementGroupsOutput, error) {
+	return &ec2.DescribePlacementGroupsOutput{PlacementGroups: m.placementgroups}, nil
+}
+
+func (m *mockEc2) DescribeHosts(input *ec2.DescribeHostsInput) (*ec2.DescribeHostsOutput, error) {
+	return &ec2.DescribeHostsOutput{Hosts: m.hosts}, nil
+}
+
 func (m *mockEc2) DescribeAvailabilityZones(input *ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error) {
 	return &ec2.DescribeAvailabilityZonesOutput{AvailabilityZones: m.availabilityzones}, nil
 }
@@ -197,6 +264,27 @@ func (m *mockEc2) DescribeNetworkInterfaces(input *ec2.DescribeNetworkInterfaces
 	return &ec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: m.networkinterfaces}, nil
 }
 
+func (m *mockEc2) DescribeSpotInstanceRequests(input *ec2.DescribeSpotInstanceRequestsInput) (*ec2.DescribeSpotInstanceRequestsOutput, error) {
+	return &ec2.DescribeSpotInstanceRequestsOutput{SpotInstanceRequests: m.spotinstancerequests}, nil
+}
+
+func (m *mockEc2) DescribeSpotFleetRequestsPages(input *ec2.DescribeSpotFleetRequestsInput, fn func(p *ec2.DescribeSpotFleetRequestsOutput, lastPage bool) (shouldContinue bool)) error {
+	var pages [][]*ec2.SpotFleetRequestConfig
+	for i := 0; i < len(m.spotfleetrequestconfigs); i += 2 {
+		page := []*ec2.SpotFleetRequestConfig{m.spotfleetrequestconfigs[i]}
+		if i+1 < len(m.spotfleetrequestconfigs) {
+			page = append(page, m.spotfleetrequestconfigs[i+1])
+		}
+		pages = append(pages, page)
+	}
+	for i, page := range pages {
+		fn(&ec2.DescribeSpotFleetRequestsOutput{SpotFleetRequestConfigs: page, NextToken: aws.String(strconv.Itoa(i + 1))},
+			i < len(pages),
+		)
+	}
+	return nil
+}
+
 type mockElbv2 struct {
 	elbv2iface.ELBV2API
 	loadbalancers            []*elbv2.LoadBalancer
@@ -262,10 +350,69 @@ func (m *mockElbv2) DescribeTargetGroups(input *elbv2.DescribeTargetGroupsInput)
 	return &elbv2.DescribeTargetGroupsOutput{TargetGroups: m.targetgroups}, nil
 }
 
+type mockElb struct {
+	elbiface.ELBAPI
+	loadbalancerdescriptions []*elb.LoadBalancerDescription
+}
+
+func (m *mockElb) Name() string {
+	return ""
+}
+
+func (m *mockElb) Region() string {
+	return ""
+}
+
+func (m *mockElb) Profile() string {
+	return ""
+}
+
+func (m *mockElb) Provider() string {
+	return ""
+}
+
+func (m *mockElb) ProviderAPI() string {
+	return ""
+}
+
+func (m *mockElb) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockElb) Fetch(context.Context) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockElb) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockElb) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockElb) DescribeLoadBalancersPages(input *elb.DescribeLoadBalancersInput, fn func(p *elb.DescribeLoadBalancersOutput, lastPage bool) (shouldContinue bool)) error {
+	var pages [][]*elb.LoadBalancerDescription
+	for i := 0; i < len(m.loadbalancerdescriptions); i += 2 {
+		page := []*elb.LoadBalancerDescription{m.loadbalancerdescriptions[i]}
+		if i+1 < len(m.loadbalancerdescriptions) {
+			page = append(page, m.loadbalancerdescriptions[i+1])
+		}
+		pages = append(pages, page)
+	}
+	for i, page := range pages {
+		fn(&elb.DescribeLoadBalancersOutput{LoadBalancerDescriptions: page, NextMarker: aws.String(strconv.Itoa(i + 1))},
+			i < len(pages),
+		)
+	}
+	return nil
+}
+
 type mockRds struct {
 	rdsiface.RDSAPI
-	dbinstances    []*rds.DBInstance
-	dbsubnetgroups []*rds.DBSubnetGroup
+	dbinstances       []*rds.DBInstance
+	dbsubnetgroups    []*rds.DBSubnetGroup
+	dbparametergroups []*rds.DBParameterGroup
 }
 
 func (m *mockRds) Name() string {
@@ -338,6 +485,23 @@ func (m *mockRds) DescribeDBSubnetGroupsPages(input *rds.DescribeDBSubnetGroupsI
 	return nil
 }
 
+func (m *mockRds) DescribeDBParameterGroupsPages(input *rds.DescribeDBParameterGroupsInput, fn func(p *rds.DescribeDBParameterGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	var pages [][]*rds.DBParameterGroup
+	for i := 0; i < len(m.dbparametergroups); i += 2 {
+		page := []*rds.DBParameterGroup{m.dbparametergroups[i]}
+		if i+1 < len(m.dbparametergroups) {
+			page = append(page, m.dbparametergroups[i+1])
+		}
+		pages = append(pages, page)
+	}
+	for i, page := range pages {
+		fn(&rds.DescribeDBParameterGroupsOutput{DBParameterGroups: page, Marker: aws.String(strconv.Itoa(i + 1))},
+			i < len(pages),
+		)
+	}
+	return nil
+}
+
 type mockAutoscaling struct {
 	autoscalingiface.AutoScalingAPI
 	launchconfigurations []*autoscaling.LaunchConfiguration
@@ -490,6 +654,325 @@ func (m *mockAcm) ListCertificatesPages(input *acm.ListCertificatesInput, fn fun
 	return nil
 }
 
+type mockDynamodb struct {
+	dynamodbiface.DynamoDBAPI
+	tabledescriptions []*dynamodb.TableDescription
+}
+
+func (m *mockDynamodb) Name() string {
+	return ""
+}
+
+func (m *mockDynamodb) Region() string {
+	return ""
+}
+
+func (m *mockDynamodb) Profile() string {
+	return ""
+}
+
+func (m *mockDynamodb) Provider() string {
+	return ""
+}
+
+func (m *mockDynamodb) ProviderAPI() string {
+	return ""
+}
+
+func (m *mockDynamodb) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockDynamodb) Fetch(context.Context) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockDynamodb) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockDynamodb) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+type mockElasticache struct {
+	elasticacheiface.ElastiCacheAPI
+	cacheclusters     []*elasticache.CacheCluster
+	cachesubnetgroups []*elasticache.CacheSubnetGroup
+}
+
+func (m *mockElasticache) Name() string {
+	return ""
+}
+
+func (m *mockElasticache) Region() string {
+	return ""
+}
+
+func (m *mockElasticache) Profile() string {
+	return ""
+}
+
+func (m *mockElasticache) Provider() string {
+	return ""
+}
+
+func (m *mockElasticache) ProviderAPI() string {
+	return ""
+}
+
+func (m *mockElasticache) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockElasticache) Fetch(context.Context) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockElasticache) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockElasticache) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockElasticache) DescribeCacheClustersPages(input *elasticache.DescribeCacheClustersInput, fn func(p *elasticache.DescribeCacheClustersOutput, lastPage bool) (shouldContinue bool)) error {
+	var pages [][]*elasticache.CacheCluster
+	for i := 0; i < len(m.cacheclusters); i += 2 {
+		page := []*elasticache.CacheCluster{m.cacheclusters[i]}
+		if i+1 < len(m.cacheclusters) {
+			page = append(page, m.cacheclusters[i+1])
+		}
+		pages = append(pages, page)
+	}
+	for i, page := range pages {
+		fn(&elasticache.DescribeCacheClustersOutput{CacheClusters: page, Marker: aws.String(strconv.Itoa(i + 1))},
+			i < len(pages),
+		)
+	}
+	return nil
+}
+
+func (m *mockElasticache) DescribeCacheSubnetGroupsPages(input *elasticache.DescribeCacheSubnetGroupsInput, fn func(p *elasticache.DescribeCacheSubnetGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	var pages [][]*elasticache.CacheSubnetGroup
+	for i := 0; i < len(m.cachesubnetgroups); i += 2 {
+		page := []*elasticache.CacheSubnetGroup{m.cachesubnetgroups[i]}
+		if i+1 < len(m.cachesubnetgroups) {
+			page = append(page, m.cachesubnetgroups[i+1])
+		}
+		pages = append(pages, page)
+	}
+	for i, page := range pages {
+		fn(&elasticache.DescribeCacheSubnetGroupsOutput{CacheSubnetGroups: page, Marker: aws.String(strconv.Itoa(i + 1))},
+			i < len(pages),
+		)
+	}
+	return nil
+}
+
+type mockRedshift struct {
+	redshiftiface.RedshiftAPI
+	clusters []*redshift.Cluster
+}
+
+func (m *mockRedshift) Name() string {
+	return ""
+}
+
+func (m *mockRedshift) Region() string {
+	return ""
+}
+
+func (m *mockRedshift) Profile() string {
+	return ""
+}
+
+func (m *mockRedshift) Provider() string {
+	return ""
+}
+
+func (m *mockRedshift) ProviderAPI() string {
+	return ""
+}
+
+func (m *mockRedshift) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockRedshift) Fetch(context.Context) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockRedshift) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockRedshift) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockRedshift) DescribeClustersPages(input *redshift.DescribeClustersInput, fn func(p *redshift.DescribeClustersOutput, lastPage bool) (shouldContinue bool)) error {
+	var pages [][]*redshift.Cluster
+	for i := 0; i < len(m.clusters); i += 2 {
+		page := []*redshift.Cluster{m.clusters[i]}
+		if i+1 < len(m.clusters) {
+			page = append(page, m.clusters[i+1])
+		}
+		pages = append(pages, page)
+	}
+	for i, page := range pages {
+		fn(&redshift.DescribeClustersOutput{Clusters: page, Marker: aws.String(strconv.Itoa(i + 1))},
+			i < len(pages),
+		)
+	}
+	return nil
+}
+
+type mockBatch struct {
+	batchiface.BatchAPI
+	computeenvironmentdetails []*batch.ComputeEnvironmentDetail
+	jobqueuedetails           []*batch.JobQueueDetail
+	jobdetails                []*batch.JobDetail
+}
+
+func (m *mockBatch) Name() string {
+	return ""
+}
+
+func (m *mockBatch) Region() string {
+	return ""
+}
+
+func (m *mockBatch) Profile() string {
+	return ""
+}
+
+func (m *mockBatch) Provider() string {
+	return ""
+}
+
+func (m *mockBatch) ProviderAPI() string {
+	return ""
+}
+
+func (m *mockBatch) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockBatch) Fetch(context.Context) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockBatch) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockBatch) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockBatch) DescribeComputeEnvironments(input *batch.DescribeComputeEnvironmentsInput) (*batch.DescribeComputeEnvironmentsOutput, error) {
+	return &batch.DescribeComputeEnvironmentsOutput{ComputeEnvironments: m.computeenvironmentdetails}, nil
+}
+
+func (m *mockBatch) DescribeJobQueues(input *batch.DescribeJobQueuesInput) (*batch.DescribeJobQueuesOutput, error) {
+	return &batch.DescribeJobQueuesOutput{JobQueues: m.jobqueuedetails}, nil
+}
+
+type mockDirectconnect struct {
+	directconnectiface.DirectConnectAPI
+	connections       []*directconnect.Connection
+	virtualinterfaces []*directconnect.VirtualInterface
+}
+
+func (m *mockDirectconnect) Name() string {
+	return ""
+}
+
+func (m *mockDirectconnect) Region() string {
+	return ""
+}
+
+func (m *mockDirectconnect) Profile() string {
+	return ""
+}
+
+func (m *mockDirectconnect) Provider() string {
+	return ""
+}
+
+func (m *mockDirectconnect) ProviderAPI() string {
+	return ""
+}
+
+func (m *mockDirectconnect) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockDirectconnect) Fetch(context.Context) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockDirectconnect) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockDirectconnect) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockDirectconnect) DescribeConnections(input *directconnect.DescribeConnectionsInput) (*directconnect.Connections, error) {
+	return &directconnect.Connections{Connections: m.connections}, nil
+}
+
+func (m *mockDirectconnect) DescribeVirtualInterfaces(input *directconnect.DescribeVirtualInterfacesInput) (*directconnect.DescribeVirtualInterfacesOutput, error) {
+	return &directconnect.DescribeVirtualInterfacesOutput{VirtualInterfaces: m.virtualinterfaces}, nil
+}
+
+type mockEc2transitgateway struct {
+	ec2transitgatewayiface.EC2TransitGatewayAPI
+	transitgateways           []*ec2transitgateway.TransitGateway
+	transitgatewayattachments []*ec2transitgateway.TransitGatewayAttachment
+	transitgatewayroutetables []*ec2transitgateway.TransitGatewayRouteTable
+}
+
+func (m *mockEc2transitgateway) Name() string {
+	return ""
+}
+
+func (m *mockEc2transitgateway) Region() string {
+	return ""
+}
+
+func (m *mockEc2transitgateway) Profile() string {
+	return ""
+}
+
+func (m *mockEc2transitgateway) Provider() string {
+	return ""
+}
+
+func (m *mockEc2transitgateway) ProviderAPI() string {
+	return ""
+}
+
+func (m *mockEc2transitgateway) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockEc2transitgateway) Fetch(context.Context) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockEc2transitgateway) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockEc2transitgateway) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
 type mockIam struct {
 	iamiface.IAMAPI
 	userdetails          []*iam.UserDetail
@@ -590,6 +1073,93 @@ func (m *mockIam) ListVirtualMFADevicesPages(input *iam.ListVirtualMFADevicesInp
 	return nil
 }
 
+type mockKms struct {
+	kmsiface.KMSAPI
+	keys        []*kms.KeyMetadata
+	aliases     []*kms.AliasListEntry
+	grants      map[string][]*kms.GrantListEntry
+	keyPolicies map[string]string
+}
+
+func (m *mockKms) Name() string {
+	return ""
+}
+
+func (m *mockKms) Region() string {
+	return ""
+}
+
+func (m *mockKms) Profile() string {
+	return ""
+}
+
+func (m *mockKms) Provider() string {
+	return ""
+}
+
+func (m *mockKms) ProviderAPI() string {
+	return ""
+}
+
+func (m *mockKms) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockKms) Fetch(context.Context) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockKms) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockKms) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+type mockOrganizations struct {
+	organizationsiface.OrganizationsAPI
+	roots    []*organizations.Root
+	accounts map[string][]*organizations.Account
+	units    map[string][]*organizations.OrganizationalUnit
+}
+
+func (m *mockOrganizations) Name() string {
+	return ""
+}
+
+func (m *mockOrganizations) Region() string {
+	return ""
+}
+
+func (m *mockOrganizations) Profile() string {
+	return ""
+}
+
+func (m *mockOrganizations) Provider() string {
+	return ""
+}
+
+func (m *mockOrganizations) ProviderAPI() string {
+	return ""
+}
+
+func (m *mockOrganizations) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockOrganizations) Fetch(context.Context) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockOrganizations) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockOrganizations) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
 type mockS3 struct {
 	s3iface.S3API
 	buckets map[string][]*s3.Bucket
@@ -872,6 +1442,65 @@ func (m *mockLambda) ListFunctionsPages(input *lambda.ListFunctionsInput, fn fun
 	return nil
 }
 
+type mockSfn struct {
+	sfniface.SFNAPI
+	statemachinelistitems []*sfn.StateMachineListItem
+	executionlistitems    map[string][]*sfn.ExecutionListItem
+}
+
+func (m *mockSfn) Name() string {
+	return ""
+}
+
+func (m *mockSfn) Region() string {
+	return ""
+}
+
+func (m *mockSfn) Profile() string {
+	return ""
+}
+
+func (m *mockSfn) Provider() string {
+	return ""
+}
+
+func (m *mockSfn) ProviderAPI() string {
+	return ""
+}
+
+func (m *mockSfn) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockSfn) Fetch(context.Context) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockSfn) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockSfn) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockSfn) ListStateMachinesPages(input *sfn.ListStateMachinesInput, fn func(p *sfn.ListStateMachinesOutput, lastPage bool) (shouldContinue bool)) error {
+	var pages [][]*sfn.StateMachineListItem
+	for i := 0; i < len(m.statemachinelistitems); i += 2 {
+		page := []*sfn.StateMachineListItem{m.statemachinelistitems[i]}
+		if i+1 < len(m.statemachinelistitems) {
+			page = append(page, m.statemachinelistitems[i+1])
+		}
+		pages = append(pages, page)
+	}
+	for i, page := range pages {
+		fn(&sfn.ListStateMachinesOutput{StateMachines: page, NextToken: aws.String(strconv.Itoa(i + 1))},
+			i < len(pages),
+		)
+	}
+	return nil
+}
+
 type mockCloudwatch struct {
 	cloudwatchiface.CloudWatchAPI
 	metrics      []*cloudwatch.Metric
@@ -948,6 +1577,110 @@ func (m *mockCloudwatch) DescribeAlarmsPages(input *cloudwatch.DescribeAlarmsInp
 	return nil
 }
 
+type mockCloudwatchlogs struct {
+	cloudwatchlogsiface.CloudWatchLogsAPI
+	loggroups []*cloudwatchlogs.LogGroup
+}
+
+func (m *mockCloudwatchlogs) Name() string {
+	return ""
+}
+
+func (m *mockCloudwatchlogs) Region() string {
+	return ""
+}
+
+func (m *mockCloudwatchlogs) Profile() string {
+	return ""
+}
+
+func (m *mockCloudwatchlogs) Provider() string {
+	return ""
+}
+
+func (m *mockCloudwatchlogs) ProviderAPI() string {
+	return ""
+}
+
+func (m *mockCloudwatchlogs) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockCloudwatchlogs) Fetch(context.Context) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockCloudwatchlogs) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockCloudwatchlogs) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockCloudwatchlogs) DescribeLogGroupsPages(input *cloudwatchlogs.DescribeLogGroupsInput, fn func(p *cloudwatchlogs.DescribeLogGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	var pages [][]*cloudwatchlogs.LogGroup
+	for i := 0; i < len(m.loggroups); i += 2 {
+		page := []*cloudwatchlogs.LogGroup{m.loggroups[i]}
+		if i+1 < len(m.loggroups) {
+			page = append(page, m.loggroups[i+1])
+		}
+		pages = append(pages, page)
+	}
+	for i, page := range pages {
+		fn(&cloudwatchlogs.DescribeLogGroupsOutput{LogGroups: page, NextToken: aws.String(strconv.Itoa(i + 1))},
+			i < len(pages),
+		)
+	}
+	return nil
+}
+
+type mockCloudtrail struct {
+	cloudtrailiface.CloudTrailAPI
+	trails []*cloudtrail.Trail
+	events []*cloudtrail.Event
+}
+
+func (m *mockCloudtrail) Name() string {
+	return ""
+}
+
+func (m *mockCloudtrail) Region() string {
+	return ""
+}
+
+func (m *mockCloudtrail) Profile() string {
+	return ""
+}
+
+func (m *mockCloudtrail) Provider() string {
+	return ""
+}
+
+func (m *mockCloudtrail) ProviderAPI() string {
+	return ""
+}
+
+func (m *mockCloudtrail) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockCloudtrail) Fetch(context.Context) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockCloudtrail) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockCloudtrail) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockCloudtrail) DescribeTrails(input *cloudtrail.DescribeTrailsInput) (*cloudtrail.DescribeTrailsOutput, error) {
+	return &cloudtrail.DescribeTrailsOutput{TrailList: m.trails}, nil
+}
+
 type mockCloudfront struct {
 	cloudfrontiface.CloudFrontAPI
 	distributionsummarys []*cloudfront.DistributionSummary
@@ -991,7 +1724,8 @@ func (m *mockCloudfront) FetchByType(context.Context, string) (cloud.GraphAPI, e
 
 type mockCloudformation struct {
 	cloudformationiface.CloudFormationAPI
-	stacks []*cloudformation.Stack
+	stacks         []*cloudformation.Stack
+	stackResources map[string][]*cloudformation.StackResourceSummary
 }
 
 func (m *mockCloudformation) Name() string {
@@ -1109,6 +1843,7 @@ type mockEcs struct {
 	ecsiface.ECSAPI
 	clusters                []*ecs.Cluster
 	clusterNames            []*string
+	services                map[string][]*ecs.Service
 	taskdefinitions         []*ecs.TaskDefinition
 	taskdefinitionNames     []*string
 	tasks                   map[string][]*ecs.Task
@@ -34,36 +34,60 @@ import (
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling/applicationautoscalingiface"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/batch/batchiface"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/aws/aws-sdk-go/service/directconnect/directconnectiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/organizations/organizationsiface"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/redshift/redshiftiface"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"github.com/wallix/awless/aws/ec2transitgateway"
+	"github.com/wallix/awless/aws/ec2transitgateway/ec2transitgatewayiface"
 	"github.com/wallix/awless/aws/fetch"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/fetch"
@@ -95,27 +119,53 @@ var ResourceTypes = []string{
 	"volume",
 	"internetgateway",
 	"natgateway",
+	"peeringconnection",
+	"vpcendpoint",
+	"customergateway",
+	"vpngateway",
+	"vpnconnection",
 	"routetable",
+	"networkacl",
+	"placementgroup",
+	"host",
 	"availabilityzone",
 	"image",
 	"importimagetask",
 	"elasticip",
 	"snapshot",
 	"networkinterface",
+	"spotrequest",
+	"spotfleet",
+	"reservation",
 	"loadbalancer",
 	"targetgroup",
 	"listener",
+	"classicloadbalancer",
 	"database",
 	"dbsubnetgroup",
+	"dbparametergroup",
 	"launchconfiguration",
 	"scalinggroup",
 	"scalingpolicy",
 	"repository",
 	"containercluster",
+	"containerservice",
 	"containertask",
 	"container",
 	"containerinstance",
 	"certificate",
+	"table",
+	"cachecluster",
+	"cachesubnetgroup",
+	"cluster",
+	"computeenvironment",
+	"jobqueue",
+	"job",
+	"dxconnection",
+	"virtualinterface",
+	"transitgateway",
+	"transitgatewayattachment",
+	"transitgatewayroutetable",
 	"user",
 	"group",
 	"role",
@@ -123,6 +173,9 @@ var ResourceTypes = []string{
 	"accesskey",
 	"instanceprofile",
 	"mfadevice",
+	"key",
+	"account",
+	"organizationalunit",
 	"bucket",
 	"s3object",
 	"subscription",
@@ -131,8 +184,13 @@ var ResourceTypes = []string{
 	"zone",
 	"record",
 	"function",
+	"statemachine",
+	"execution",
 	"metric",
 	"alarm",
+	"loggroup",
+	"trail",
+	"trailevent",
 	"distribution",
 	"stack",
 }
@@ -140,20 +198,32 @@ var ResourceTypes = []string{
 var ServicePerAPI = map[string]string{
 	"ec2":         "infra",
 	"elbv2":       "infra",
+	"elb":         "infra",
 	"rds":         "infra",
 	"autoscaling": "infra",
 	"ecr":         "infra",
 	"ecs":         "infra",
 	"applicationautoscaling": "infra",
 	"acm":            "infra",
+	"dynamodb":       "infra",
+	"elasticache":    "infra",
+	"redshift":               "infra",
+	"batch":                  "infra",
+	"directconnect":          "infra",
+	"ec2transitgateway":      "infra",
 	"iam":            "access",
 	"sts":            "access",
+	"kms":                    "access",
+	"organizations":          "access",
 	"s3":             "storage",
 	"sns":            "messaging",
 	"sqs":            "messaging",
 	"route53":        "dns",
 	"lambda":         "lambda",
+	"sfn":            "lambda",
 	"cloudwatch":     "monitoring",
+	"cloudwatchlogs":         "monitoring",
+	"cloudtrail":             "monitoring",
 	"cloudfront":     "cdn",
 	"cloudformation": "cloudformation",
 }
@@ -167,27 +237,53 @@ var ServicePerResourceType = map[string]string{
 	"volume":              "infra",
 	"internetgateway":     "infra",
 	"natgateway":          "infra",
+	"peeringconnection":   "infra",
+	"vpcendpoint":         "infra",
+	"customergateway":     "infra",
+	"vpngateway":          "infra",
+	"vpnconnection":       "infra",
 	"routetable":          "infra",
+	"networkacl":          "infra",
+	"placementgroup":      "infra",
+	"host":                "infra",
 	"availabilityzone":    "infra",
 	"image":               "infra",
 	"importimagetask":     "infra",
 	"elasticip":           "infra",
 	"snapshot":            "infra",
 	"networkinterface":    "infra",
+	"spotrequest":         "infra",
+	"spotfleet":           "infra",
+	"reservation":         "infra",
 	"loadbalancer":        "infra",
 	"targetgroup":         "infra",
 	"listener":            "infra",
+	"classicloadbalancer": "infra",
 	"database":            "infra",
 	"dbsubnetgroup":       "infra",
+	"dbparametergroup":    "infra",
 	"launchconfiguration": "infra",
 	"scalinggroup":        "infra",
 	"scalingpolicy":       "infra",
 	"repository":          "infra",
 	"containercluster":    "infra",
+	"containerservice":    "infra",
 	"containertask":       "infra",
 	"container":           "infra",
 	"containerinstance":   "infra",
 	"certificate":         "infra",
+	"table":               "infra",
+	"cachecluster":        "infra",
+	"cachesubnetgroup":    "infra",
+	"cluster":             "infra",
+	"computeenvironment":  "infra",
+	"jobqueue":            "infra",
+	"job":                 "infra",
+	"dxconnection":        "infra",
+	"virtualinterface":    "infra",
+	"transitgateway":           "infra",
+	"transitgatewayattachment": "infra",
+	"transitgatewayroutetable": "infra",
 	"user":                "access",
 	"group":               "access",
 	"role":                "access",
@@ -195,6 +291,9 @@ var ServicePerResourceType = map[string]string{
 	"accesskey":           "access",
 	"instanceprofile":     "access",
 	"mfadevice":           "access",
+	"key":                 "access",
+	"account":             "access",
+	"organizationalunit":  "access",
 	"bucket":              "storage",
 	"s3object":            "storage",
 	"subscription":        "messaging",
@@ -203,8 +302,13 @@ var ServicePerResourceType = map[string]string{
 	"zone":                "dns",
 	"record":              "dns",
 	"function":            "lambda",
+	"statemachine":        "lambda",
+	"execution":           "lambda",
 	"metric":              "monitoring",
 	"alarm":               "monitoring",
+	"loggroup":            "monitoring",
+	"trail":               "monitoring",
+	"trailevent":          "monitoring",
 	"distribution":        "cdn",
 	"stack":               "cloudformation",
 }
@@ -218,27 +322,53 @@ var APIPerResourceType = map[string]string{
 	"volume":              "ec2",
 	"internetgateway":     "ec2",
 	"natgateway":          "ec2",
+	"peeringconnection":   "ec2",
+	"vpcendpoint":         "ec2",
+	"customergateway":     "ec2",
+	"vpngateway":          "ec2",
+	"vpnconnection":       "ec2",
 	"routetable":          "ec2",
+	"networkacl":          "ec2",
+	"placementgroup":      "ec2",
+	"host":                "ec2",
 	"availabilityzone":    "ec2",
 	"image":               "ec2",
 	"importimagetask":     "ec2",
 	"elasticip":           "ec2",
 	"snapshot":            "ec2",
 	"networkinterface":    "ec2",
+	"spotrequest":         "ec2",
+	"spotfleet":           "ec2",
+	"reservation":         "ec2",
 	"loadbalancer":        "elbv2",
 	"targetgroup":         "elbv2",
 	"listener":            "elbv2",
+	"classicloadbalancer": "elb",
 	"database":            "rds",
 	"dbsubnetgroup":       "rds",
+	"dbparametergroup":    "rds",
 	"launchconfiguration": "autoscaling",
 	"scalinggroup":        "autoscaling",
 	"scalingpolicy":       "autoscaling",
 	"repository":          "ecr",
 	"containercluster":    "ecs",
+	"containerservice":    "ecs",
 	"containertask":       "ecs",
 	"container":           "ecs",
 	"containerinstance":   "ecs",
 	"certificate":         "acm",
+	"table":               "dynamodb",
+	"cachecluster":        "elasticache",
+	"cachesubnetgroup":    "elasticache",
+	"cluster":             "redshift",
+	"computeenvironment":  "batch",
+	"jobqueue":            "batch",
+	"job":                 "batch",
+	"dxconnection":        "directconnect",
+	"virtualinterface":    "directconnect",
+	"transitgateway":           "ec2transitgateway",
+	"transitgatewayattachment": "ec2transitgateway",
+	"transitgatewayroutetable": "ec2transitgateway",
 	"user":                "iam",
 	"group":               "iam",
 	"role":                "iam",
@@ -246,6 +376,9 @@ var APIPerResourceType = map[string]string{
 	"accesskey":           "iam",
 	"instanceprofile":     "iam",
 	"mfadevice":           "iam",
+	"key":                 "kms",
+	"account":             "organizations",
+	"organizationalunit":  "organizations",
 	"bucket":              "s3",
 	"s3object":            "s3",
 	"subscription":        "sns",
@@ -254,8 +387,13 @@ var APIPerResourceType = map[string]string{
 	"zone":                "route53",
 	"record":              "route53",
 	"function":            "lambda",
+	"statemachine":        "sfn",
+	"execution":           "sfn",
 	"metric":              "cloudwatch",
 	"alarm":               "cloudwatch",
+	"loggroup":            "cloudwatchlogs",
+	"trail":               "cloudtrail",
+	"trailevent":          "cloudtrail",
 	"distribution":        "cloudfront",
 	"stack":               "cloudformation",
 }
@@ -267,34 +405,55 @@ type Infra struct {
 	log             *logger.Logger
 	ec2iface.EC2API
 	elbv2iface.ELBV2API
+	elbiface.ELBAPI
 	rdsiface.RDSAPI
 	autoscalingiface.AutoScalingAPI
 	ecriface.ECRAPI
 	ecsiface.ECSAPI
 	applicationautoscalingiface.ApplicationAutoScalingAPI
 	acmiface.ACMAPI
+	dynamodbiface.DynamoDBAPI
+	elasticacheiface.ElastiCacheAPI
+	redshiftiface.RedshiftAPI
+	batchiface.BatchAPI
+	directconnectiface.DirectConnectAPI
+	ec2transitgatewayiface.EC2TransitGatewayAPI
 }
 
 func NewInfra(sess *session.Session, profile string, extraConf map[string]interface{}, log *logger.Logger) cloud.Service {
 	region := awssdk.StringValue(sess.Config.Region)
 	ec2API := ec2.New(sess)
 	elbv2API := elbv2.New(sess)
+	elbAPI := elb.New(sess)
 	rdsAPI := rds.New(sess)
 	autoscalingAPI := autoscaling.New(sess)
 	ecrAPI := ecr.New(sess)
 	ecsAPI := ecs.New(sess)
 	applicationautoscalingAPI := applicationautoscaling.New(sess)
 	acmAPI := acm.New(sess)
+	dynamodbAPI := dynamodb.New(sess)
+	elasticacheAPI := elasticache.New(sess)
+	redshiftAPI := redshift.New(sess)
+	batchAPI := batch.New(sess)
+	directconnectAPI := directconnect.New(sess)
+	ec2transitgatewayAPI := ec2transitgateway.New(sess)
 
 	fetchConfig := awsfetch.NewConfig(
 		ec2API,
 		elbv2API,
+		elbAPI,
 		rdsAPI,
 		autoscalingAPI,
 		ecrAPI,
 		ecsAPI,
 		applicationautoscalingAPI,
 		acmAPI,
+		dynamodbAPI,
+		elasticacheAPI,
+		redshiftAPI,
+		batchAPI,
+		directconnectAPI,
+		ec2transitgatewayAPI,
 	)
 	fetchConfig.Extra = extraConf
 	fetchConfig.Log = log
@@ -302,17 +461,24 @@ func NewInfra(sess *session.Session, profile string, extraConf map[string]interf
 	return &Infra{
 		EC2API:         ec2API,
 		ELBV2API:       elbv2API,
+		ELBAPI:         elbAPI,
 		RDSAPI:         rdsAPI,
 		AutoScalingAPI: autoscalingAPI,
 		ECRAPI:         ecrAPI,
 		ECSAPI:         ecsAPI,
 		ApplicationAutoScalingAPI: applicationautoscalingAPI,
-		ACMAPI:  acmAPI,
-		fetcher: fetch.NewFetcher(awsfetch.BuildInfraFetchFuncs(fetchConfig)),
-		config:  extraConf,
-		region:  region,
-		profile: profile,
-		log:     log,
+		ACMAPI:         acmAPI,
+		DynamoDBAPI:    dynamodbAPI,
+		ElastiCacheAPI: elasticacheAPI,
+		RedshiftAPI:               redshiftAPI,
+		BatchAPI:                  batchAPI,
+		DirectConnectAPI:          directconnectAPI,
+		EC2TransitGatewayAPI:      ec2transitgatewayAPI,
+		fetcher:        fetch.NewFetcher(awsfetch.BuildInfraFetchFuncs(fetchConfig)),
+		config:         extraConf,
+		region:         region,
+		profile:        profile,
+		log:            log,
 	}
 }
 
@@ -338,27 +504,53 @@ func (s *Infra) ResourceTypes() []string {
 		"volume",
 		"internetgateway",
 		"natgateway",
+		"peeringconnection",
+		"vpcendpoint",
+		"customergateway",
+		"vpngateway",
+		"vpnconnection",
 		"routetable",
+		"networkacl",
+		"placementgroup",
+		"host",
 		"availabilityzone",
 		"image",
 		"importimagetask",
 		"elasticip",
 		"snapshot",
 		"networkinterface",
+		"spotrequest",
+		"spotfleet",
+		"reservation",
 		"loadbalancer",
 		"targetgroup",
 		"listener",
+		"classicloadbalancer",
 		"database",
 		"dbsubnetgroup",
+		"dbparametergroup",
 		"launchconfiguration",
 		"scalinggroup",
 		"scalingpolicy",
 		"repository",
 		"containercluster",
+		"containerservice",
 		"containertask",
 		"container",
 		"containerinstance",
 		"certificate",
+		"table",
+		"cachecluster",
+		"cachesubnetgroup",
+		"cluster",
+		"computeenvironment",
+		"jobqueue",
+		"job",
+		"dxconnection",
+		"virtualinterface",
+		"transitgateway",
+		"transitgatewayattachment",
+		"transitgatewayroutetable",
 	}
 }
 
@@ -572,6 +764,116 @@ func (s *Infra) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
+	if getBool(s.config, "aws.infra.peeringconnection.sync", true) {
+		list, err := s.fetcher.Get("peeringconnection_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ec2.VpcPeeringConnection); !ok {
+			return gph, errors.New("cannot cast to '[]*ec2.VpcPeeringConnection' type from fetch context")
+		}
+		for _, r := range list.([]*ec2.VpcPeeringConnection) {
+			for _, fn := range addParentsFns["peeringconnection"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ec2.VpcPeeringConnection) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.vpcendpoint.sync", true) {
+		list, err := s.fetcher.Get("vpcendpoint_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ec2.VpcEndpoint); !ok {
+			return gph, errors.New("cannot cast to '[]*ec2.VpcEndpoint' type from fetch context")
+		}
+		for _, r := range list.([]*ec2.VpcEndpoint) {
+			for _, fn := range addParentsFns["vpcendpoint"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ec2.VpcEndpoint) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.customergateway.sync", true) {
+		list, err := s.fetcher.Get("customergateway_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ec2.CustomerGateway); !ok {
+			return gph, errors.New("cannot cast to '[]*ec2.CustomerGateway' type from fetch context")
+		}
+		for _, r := range list.([]*ec2.CustomerGateway) {
+			for _, fn := range addParentsFns["customergateway"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ec2.CustomerGateway) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.vpngateway.sync", true) {
+		list, err := s.fetcher.Get("vpngateway_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ec2.VpnGateway); !ok {
+			return gph, errors.New("cannot cast to '[]*ec2.VpnGateway' type from fetch context")
+		}
+		for _, r := range list.([]*ec2.VpnGateway) {
+			for _, fn := range addParentsFns["vpngateway"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ec2.VpnGateway) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.vpnconnection.sync", true) {
+		list, err := s.fetcher.Get("vpnconnection_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ec2.VpnConnection); !ok {
+			return gph, errors.New("cannot cast to '[]*ec2.VpnConnection' type from fetch context")
+		}
+		for _, r := range list.([]*ec2.VpnConnection) {
+			for _, fn := range addParentsFns["vpnconnection"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ec2.VpnConnection) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
 	if getBool(s.config, "aws.infra.routetable.sync", true) {
 		list, err := s.fetcher.Get("routetable_objects")
 		if err != nil {
@@ -594,6 +896,72 @@ func (s *Infra) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
+	if getBool(s.config, "aws.infra.networkacl.sync", true) {
+		list, err := s.fetcher.Get("networkacl_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ec2.NetworkAcl); !ok {
+			return gph, errors.New("cannot cast to '[]*ec2.NetworkAcl' type from fetch context")
+		}
+		for _, r := range list.([]*ec2.NetworkAcl) {
+			for _, fn := range addParentsFns["networkacl"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ec2.NetworkAcl) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.placementgroup.sync", true) {
+		list, err := s.fetcher.Get("placementgroup_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ec2.PlacementGroup); !ok {
+			return gph, errors.New("cannot cast to '[]*ec2.PlacementGroup' type from fetch context")
+		}
+		for _, r := range list.([]*ec2.PlacementGroup) {
+			for _, fn := range addParentsFns["placementgroup"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ec2.PlacementGroup) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.host.sync", true) {
+		list, err := s.fetcher.Get("host_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ec2.Host); !ok {
+			return gph, errors.New("cannot cast to '[]*ec2.Host' type from fetch context")
+		}
+		for _, r := range list.([]*ec2.Host) {
+			for _, fn := range addParentsFns["host"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ec2.Host) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
 	if getBool(s.config, "aws.infra.availabilityzone.sync", true) {
 		list, err := s.fetcher.Get("availabilityzone_objects")
 		if err != nil {
@@ -712,10 +1080,406 @@ func (s *Infra) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 		if _, ok := list.([]*ec2.NetworkInterface); !ok {
 			return gph, errors.New("cannot cast to '[]*ec2.NetworkInterface' type from fetch context")
 		}
-		for _, r := range list.([]*ec2.NetworkInterface) {
-			for _, fn := range addParentsFns["networkinterface"] {
+		for _, r := range list.([]*ec2.NetworkInterface) {
+			for _, fn := range addParentsFns["networkinterface"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ec2.NetworkInterface) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.spotrequest.sync", true) {
+		list, err := s.fetcher.Get("spotrequest_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ec2.SpotInstanceRequest); !ok {
+			return gph, errors.New("cannot cast to '[]*ec2.SpotInstanceRequest' type from fetch context")
+		}
+		for _, r := range list.([]*ec2.SpotInstanceRequest) {
+			for _, fn := range addParentsFns["spotrequest"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ec2.SpotInstanceRequest) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.spotfleet.sync", true) {
+		list, err := s.fetcher.Get("spotfleet_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ec2.SpotFleetRequestConfig); !ok {
+			return gph, errors.New("cannot cast to '[]*ec2.SpotFleetRequestConfig' type from fetch context")
+		}
+		for _, r := range list.([]*ec2.SpotFleetRequestConfig) {
+			for _, fn := range addParentsFns["spotfleet"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ec2.SpotFleetRequestConfig) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.reservation.sync", true) {
+		list, err := s.fetcher.Get("reservation_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ec2.ReservedInstances); !ok {
+			return gph, errors.New("cannot cast to '[]*ec2.ReservedInstances' type from fetch context")
+		}
+		for _, r := range list.([]*ec2.ReservedInstances) {
+			for _, fn := range addParentsFns["reservation"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ec2.ReservedInstances) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.loadbalancer.sync", true) {
+		list, err := s.fetcher.Get("loadbalancer_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*elbv2.LoadBalancer); !ok {
+			return gph, errors.New("cannot cast to '[]*elbv2.LoadBalancer' type from fetch context")
+		}
+		for _, r := range list.([]*elbv2.LoadBalancer) {
+			for _, fn := range addParentsFns["loadbalancer"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *elbv2.LoadBalancer) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.targetgroup.sync", true) {
+		list, err := s.fetcher.Get("targetgroup_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*elbv2.TargetGroup); !ok {
+			return gph, errors.New("cannot cast to '[]*elbv2.TargetGroup' type from fetch context")
+		}
+		for _, r := range list.([]*elbv2.TargetGroup) {
+			for _, fn := range addParentsFns["targetgroup"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *elbv2.TargetGroup) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.listener.sync", true) {
+		list, err := s.fetcher.Get("listener_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*elbv2.Listener); !ok {
+			return gph, errors.New("cannot cast to '[]*elbv2.Listener' type from fetch context")
+		}
+		for _, r := range list.([]*elbv2.Listener) {
+			for _, fn := range addParentsFns["listener"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *elbv2.Listener) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.classicloadbalancer.sync", true) {
+		list, err := s.fetcher.Get("classicloadbalancer_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*elb.LoadBalancerDescription); !ok {
+			return gph, errors.New("cannot cast to '[]*elb.LoadBalancerDescription' type from fetch context")
+		}
+		for _, r := range list.([]*elb.LoadBalancerDescription) {
+			for _, fn := range addParentsFns["classicloadbalancer"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *elb.LoadBalancerDescription) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.database.sync", true) {
+		list, err := s.fetcher.Get("database_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*rds.DBInstance); !ok {
+			return gph, errors.New("cannot cast to '[]*rds.DBInstance' type from fetch context")
+		}
+		for _, r := range list.([]*rds.DBInstance) {
+			for _, fn := range addParentsFns["database"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *rds.DBInstance) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.dbsubnetgroup.sync", true) {
+		list, err := s.fetcher.Get("dbsubnetgroup_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*rds.DBSubnetGroup); !ok {
+			return gph, errors.New("cannot cast to '[]*rds.DBSubnetGroup' type from fetch context")
+		}
+		for _, r := range list.([]*rds.DBSubnetGroup) {
+			for _, fn := range addParentsFns["dbsubnetgroup"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *rds.DBSubnetGroup) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.dbparametergroup.sync", true) {
+		list, err := s.fetcher.Get("dbparametergroup_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*rds.DBParameterGroup); !ok {
+			return gph, errors.New("cannot cast to '[]*rds.DBParameterGroup' type from fetch context")
+		}
+		for _, r := range list.([]*rds.DBParameterGroup) {
+			for _, fn := range addParentsFns["dbparametergroup"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *rds.DBParameterGroup) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.launchconfiguration.sync", true) {
+		list, err := s.fetcher.Get("launchconfiguration_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*autoscaling.LaunchConfiguration); !ok {
+			return gph, errors.New("cannot cast to '[]*autoscaling.LaunchConfiguration' type from fetch context")
+		}
+		for _, r := range list.([]*autoscaling.LaunchConfiguration) {
+			for _, fn := range addParentsFns["launchconfiguration"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *autoscaling.LaunchConfiguration) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.scalinggroup.sync", true) {
+		list, err := s.fetcher.Get("scalinggroup_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*autoscaling.Group); !ok {
+			return gph, errors.New("cannot cast to '[]*autoscaling.Group' type from fetch context")
+		}
+		for _, r := range list.([]*autoscaling.Group) {
+			for _, fn := range addParentsFns["scalinggroup"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *autoscaling.Group) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.scalingpolicy.sync", true) {
+		list, err := s.fetcher.Get("scalingpolicy_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*autoscaling.ScalingPolicy); !ok {
+			return gph, errors.New("cannot cast to '[]*autoscaling.ScalingPolicy' type from fetch context")
+		}
+		for _, r := range list.([]*autoscaling.ScalingPolicy) {
+			for _, fn := range addParentsFns["scalingpolicy"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *autoscaling.ScalingPolicy) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.repository.sync", true) {
+		list, err := s.fetcher.Get("repository_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ecr.Repository); !ok {
+			return gph, errors.New("cannot cast to '[]*ecr.Repository' type from fetch context")
+		}
+		for _, r := range list.([]*ecr.Repository) {
+			for _, fn := range addParentsFns["repository"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ecr.Repository) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.containercluster.sync", true) {
+		list, err := s.fetcher.Get("containercluster_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ecs.Cluster); !ok {
+			return gph, errors.New("cannot cast to '[]*ecs.Cluster' type from fetch context")
+		}
+		for _, r := range list.([]*ecs.Cluster) {
+			for _, fn := range addParentsFns["containercluster"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ecs.Cluster) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.containerservice.sync", true) {
+		list, err := s.fetcher.Get("containerservice_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ecs.Service); !ok {
+			return gph, errors.New("cannot cast to '[]*ecs.Service' type from fetch context")
+		}
+		for _, r := range list.([]*ecs.Service) {
+			for _, fn := range addParentsFns["containerservice"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ecs.Service) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.containertask.sync", true) {
+		list, err := s.fetcher.Get("containertask_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ecs.TaskDefinition); !ok {
+			return gph, errors.New("cannot cast to '[]*ecs.TaskDefinition' type from fetch context")
+		}
+		for _, r := range list.([]*ecs.TaskDefinition) {
+			for _, fn := range addParentsFns["containertask"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ecs.TaskDefinition) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.container.sync", true) {
+		list, err := s.fetcher.Get("container_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ecs.Container); !ok {
+			return gph, errors.New("cannot cast to '[]*ecs.Container' type from fetch context")
+		}
+		for _, r := range list.([]*ecs.Container) {
+			for _, fn := range addParentsFns["container"] {
 				wg.Add(1)
-				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ec2.NetworkInterface) {
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ecs.Container) {
 					defer wg.Done()
 					err := f(gph, snap, region, res)
 					if err != nil {
@@ -726,18 +1490,18 @@ func (s *Infra) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
-	if getBool(s.config, "aws.infra.loadbalancer.sync", true) {
-		list, err := s.fetcher.Get("loadbalancer_objects")
+	if getBool(s.config, "aws.infra.containerinstance.sync", true) {
+		list, err := s.fetcher.Get("containerinstance_objects")
 		if err != nil {
 			return gph, err
 		}
-		if _, ok := list.([]*elbv2.LoadBalancer); !ok {
-			return gph, errors.New("cannot cast to '[]*elbv2.LoadBalancer' type from fetch context")
+		if _, ok := list.([]*ecs.ContainerInstance); !ok {
+			return gph, errors.New("cannot cast to '[]*ecs.ContainerInstance' type from fetch context")
 		}
-		for _, r := range list.([]*elbv2.LoadBalancer) {
-			for _, fn := range addParentsFns["loadbalancer"] {
+		for _, r := range list.([]*ecs.ContainerInstance) {
+			for _, fn := range addParentsFns["containerinstance"] {
 				wg.Add(1)
-				go func(f addParentFn, snap tstore.RDFGraph, region string, res *elbv2.LoadBalancer) {
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ecs.ContainerInstance) {
 					defer wg.Done()
 					err := f(gph, snap, region, res)
 					if err != nil {
@@ -748,18 +1512,18 @@ func (s *Infra) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
-	if getBool(s.config, "aws.infra.targetgroup.sync", true) {
-		list, err := s.fetcher.Get("targetgroup_objects")
+	if getBool(s.config, "aws.infra.certificate.sync", true) {
+		list, err := s.fetcher.Get("certificate_objects")
 		if err != nil {
 			return gph, err
 		}
-		if _, ok := list.([]*elbv2.TargetGroup); !ok {
-			return gph, errors.New("cannot cast to '[]*elbv2.TargetGroup' type from fetch context")
+		if _, ok := list.([]*acm.CertificateSummary); !ok {
+			return gph, errors.New("cannot cast to '[]*acm.CertificateSummary' type from fetch context")
 		}
-		for _, r := range list.([]*elbv2.TargetGroup) {
-			for _, fn := range addParentsFns["targetgroup"] {
+		for _, r := range list.([]*acm.CertificateSummary) {
+			for _, fn := range addParentsFns["certificate"] {
 				wg.Add(1)
-				go func(f addParentFn, snap tstore.RDFGraph, region string, res *elbv2.TargetGroup) {
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *acm.CertificateSummary) {
 					defer wg.Done()
 					err := f(gph, snap, region, res)
 					if err != nil {
@@ -770,18 +1534,18 @@ func (s *Infra) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
-	if getBool(s.config, "aws.infra.listener.sync", true) {
-		list, err := s.fetcher.Get("listener_objects")
+	if getBool(s.config, "aws.infra.table.sync", true) {
+		list, err := s.fetcher.Get("table_objects")
 		if err != nil {
 			return gph, err
 		}
-		if _, ok := list.([]*elbv2.Listener); !ok {
-			return gph, errors.New("cannot cast to '[]*elbv2.Listener' type from fetch context")
+		if _, ok := list.([]*dynamodb.TableDescription); !ok {
+			return gph, errors.New("cannot cast to '[]*dynamodb.TableDescription' type from fetch context")
 		}
-		for _, r := range list.([]*elbv2.Listener) {
-			for _, fn := range addParentsFns["listener"] {
+		for _, r := range list.([]*dynamodb.TableDescription) {
+			for _, fn := range addParentsFns["table"] {
 				wg.Add(1)
-				go func(f addParentFn, snap tstore.RDFGraph, region string, res *elbv2.Listener) {
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *dynamodb.TableDescription) {
 					defer wg.Done()
 					err := f(gph, snap, region, res)
 					if err != nil {
@@ -792,18 +1556,18 @@ func (s *Infra) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
-	if getBool(s.config, "aws.infra.database.sync", true) {
-		list, err := s.fetcher.Get("database_objects")
+	if getBool(s.config, "aws.infra.cachecluster.sync", true) {
+		list, err := s.fetcher.Get("cachecluster_objects")
 		if err != nil {
 			return gph, err
 		}
-		if _, ok := list.([]*rds.DBInstance); !ok {
-			return gph, errors.New("cannot cast to '[]*rds.DBInstance' type from fetch context")
+		if _, ok := list.([]*elasticache.CacheCluster); !ok {
+			return gph, errors.New("cannot cast to '[]*elasticache.CacheCluster' type from fetch context")
 		}
-		for _, r := range list.([]*rds.DBInstance) {
-			for _, fn := range addParentsFns["database"] {
+		for _, r := range list.([]*elasticache.CacheCluster) {
+			for _, fn := range addParentsFns["cachecluster"] {
 				wg.Add(1)
-				go func(f addParentFn, snap tstore.RDFGraph, region string, res *rds.DBInstance) {
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *elasticache.CacheCluster) {
 					defer wg.Done()
 					err := f(gph, snap, region, res)
 					if err != nil {
@@ -814,18 +1578,18 @@ func (s *Infra) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
-	if getBool(s.config, "aws.infra.dbsubnetgroup.sync", true) {
-		list, err := s.fetcher.Get("dbsubnetgroup_objects")
+	if getBool(s.config, "aws.infra.cachesubnetgroup.sync", true) {
+		list, err := s.fetcher.Get("cachesubnetgroup_objects")
 		if err != nil {
 			return gph, err
 		}
-		if _, ok := list.([]*rds.DBSubnetGroup); !ok {
-			return gph, errors.New("cannot cast to '[]*rds.DBSubnetGroup' type from fetch context")
+		if _, ok := list.([]*elasticache.CacheSubnetGroup); !ok {
+			return gph, errors.New("cannot cast to '[]*elasticache.CacheSubnetGroup' type from fetch context")
 		}
-		for _, r := range list.([]*rds.DBSubnetGroup) {
-			for _, fn := range addParentsFns["dbsubnetgroup"] {
+		for _, r := range list.([]*elasticache.CacheSubnetGroup) {
+			for _, fn := range addParentsFns["cachesubnetgroup"] {
 				wg.Add(1)
-				go func(f addParentFn, snap tstore.RDFGraph, region string, res *rds.DBSubnetGroup) {
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *elasticache.CacheSubnetGroup) {
 					defer wg.Done()
 					err := f(gph, snap, region, res)
 					if err != nil {
@@ -836,18 +1600,18 @@ func (s *Infra) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
-	if getBool(s.config, "aws.infra.launchconfiguration.sync", true) {
-		list, err := s.fetcher.Get("launchconfiguration_objects")
+	if getBool(s.config, "aws.infra.cluster.sync", true) {
+		list, err := s.fetcher.Get("cluster_objects")
 		if err != nil {
 			return gph, err
 		}
-		if _, ok := list.([]*autoscaling.LaunchConfiguration); !ok {
-			return gph, errors.New("cannot cast to '[]*autoscaling.LaunchConfiguration' type from fetch context")
+		if _, ok := list.([]*redshift.Cluster); !ok {
+			return gph, errors.New("cannot cast to '[]*redshift.Cluster' type from fetch context")
 		}
-		for _, r := range list.([]*autoscaling.LaunchConfiguration) {
-			for _, fn := range addParentsFns["launchconfiguration"] {
+		for _, r := range list.([]*redshift.Cluster) {
+			for _, fn := range addParentsFns["cluster"] {
 				wg.Add(1)
-				go func(f addParentFn, snap tstore.RDFGraph, region string, res *autoscaling.LaunchConfiguration) {
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *redshift.Cluster) {
 					defer wg.Done()
 					err := f(gph, snap, region, res)
 					if err != nil {
@@ -858,18 +1622,19 @@ func (s *Infra) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
-	if getBool(s.config, "aws.infra.scalinggroup.sync", true) {
-		list, err := s.fetcher.Get("scalinggroup_objects")
+
+	if getBool(s.config, "aws.infra.computeenvironment.sync", true) {
+		list, err := s.fetcher.Get("computeenvironment_objects")
 		if err != nil {
 			return gph, err
 		}
-		if _, ok := list.([]*autoscaling.Group); !ok {
-			return gph, errors.New("cannot cast to '[]*autoscaling.Group' type from fetch context")
+		if _, ok := list.([]*batch.ComputeEnvironmentDetail); !ok {
+			return gph, errors.New("cannot cast to '[]*batch.ComputeEnvironmentDetail' type from fetch context")
 		}
-		for _, r := range list.([]*autoscaling.Group) {
-			for _, fn := range addParentsFns["scalinggroup"] {
+		for _, r := range list.([]*batch.ComputeEnvironmentDetail) {
+			for _, fn := range addParentsFns["computeenvironment"] {
 				wg.Add(1)
-				go func(f addParentFn, snap tstore.RDFGraph, region string, res *autoscaling.Group) {
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *batch.ComputeEnvironmentDetail) {
 					defer wg.Done()
 					err := f(gph, snap, region, res)
 					if err != nil {
@@ -880,18 +1645,19 @@ func (s *Infra) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
-	if getBool(s.config, "aws.infra.scalingpolicy.sync", true) {
-		list, err := s.fetcher.Get("scalingpolicy_objects")
+
+	if getBool(s.config, "aws.infra.jobqueue.sync", true) {
+		list, err := s.fetcher.Get("jobqueue_objects")
 		if err != nil {
 			return gph, err
 		}
-		if _, ok := list.([]*autoscaling.ScalingPolicy); !ok {
-			return gph, errors.New("cannot cast to '[]*autoscaling.ScalingPolicy' type from fetch context")
+		if _, ok := list.([]*batch.JobQueueDetail); !ok {
+			return gph, errors.New("cannot cast to '[]*batch.JobQueueDetail' type from fetch context")
 		}
-		for _, r := range list.([]*autoscaling.ScalingPolicy) {
-			for _, fn := range addParentsFns["scalingpolicy"] {
+		for _, r := range list.([]*batch.JobQueueDetail) {
+			for _, fn := range addParentsFns["jobqueue"] {
 				wg.Add(1)
-				go func(f addParentFn, snap tstore.RDFGraph, region string, res *autoscaling.ScalingPolicy) {
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *batch.JobQueueDetail) {
 					defer wg.Done()
 					err := f(gph, snap, region, res)
 					if err != nil {
@@ -902,18 +1668,19 @@ func (s *Infra) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
-	if getBool(s.config, "aws.infra.repository.sync", true) {
-		list, err := s.fetcher.Get("repository_objects")
+
+	if getBool(s.config, "aws.infra.job.sync", true) {
+		list, err := s.fetcher.Get("job_objects")
 		if err != nil {
 			return gph, err
 		}
-		if _, ok := list.([]*ecr.Repository); !ok {
-			return gph, errors.New("cannot cast to '[]*ecr.Repository' type from fetch context")
+		if _, ok := list.([]*batch.JobDetail); !ok {
+			return gph, errors.New("cannot cast to '[]*batch.JobDetail' type from fetch context")
 		}
-		for _, r := range list.([]*ecr.Repository) {
-			for _, fn := range addParentsFns["repository"] {
+		for _, r := range list.([]*batch.JobDetail) {
+			for _, fn := range addParentsFns["job"] {
 				wg.Add(1)
-				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ecr.Repository) {
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *batch.JobDetail) {
 					defer wg.Done()
 					err := f(gph, snap, region, res)
 					if err != nil {
@@ -924,18 +1691,18 @@ func (s *Infra) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
-	if getBool(s.config, "aws.infra.containercluster.sync", true) {
-		list, err := s.fetcher.Get("containercluster_objects")
+	if getBool(s.config, "aws.infra.dxconnection.sync", true) {
+		list, err := s.fetcher.Get("dxconnection_objects")
 		if err != nil {
 			return gph, err
 		}
-		if _, ok := list.([]*ecs.Cluster); !ok {
-			return gph, errors.New("cannot cast to '[]*ecs.Cluster' type from fetch context")
+		if _, ok := list.([]*directconnect.Connection); !ok {
+			return gph, errors.New("cannot cast to '[]*directconnect.Connection' type from fetch context")
 		}
-		for _, r := range list.([]*ecs.Cluster) {
-			for _, fn := range addParentsFns["containercluster"] {
+		for _, r := range list.([]*directconnect.Connection) {
+			for _, fn := range addParentsFns["dxconnection"] {
 				wg.Add(1)
-				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ecs.Cluster) {
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *directconnect.Connection) {
 					defer wg.Done()
 					err := f(gph, snap, region, res)
 					if err != nil {
@@ -946,18 +1713,18 @@ func (s *Infra) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
-	if getBool(s.config, "aws.infra.containertask.sync", true) {
-		list, err := s.fetcher.Get("containertask_objects")
+	if getBool(s.config, "aws.infra.virtualinterface.sync", true) {
+		list, err := s.fetcher.Get("virtualinterface_objects")
 		if err != nil {
 			return gph, err
 		}
-		if _, ok := list.([]*ecs.TaskDefinition); !ok {
-			return gph, errors.New("cannot cast to '[]*ecs.TaskDefinition' type from fetch context")
+		if _, ok := list.([]*directconnect.VirtualInterface); !ok {
+			return gph, errors.New("cannot cast to '[]*directconnect.VirtualInterface' type from fetch context")
 		}
-		for _, r := range list.([]*ecs.TaskDefinition) {
-			for _, fn := range addParentsFns["containertask"] {
+		for _, r := range list.([]*directconnect.VirtualInterface) {
+			for _, fn := range addParentsFns["virtualinterface"] {
 				wg.Add(1)
-				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ecs.TaskDefinition) {
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *directconnect.VirtualInterface) {
 					defer wg.Done()
 					err := f(gph, snap, region, res)
 					if err != nil {
@@ -968,18 +1735,18 @@ func (s *Infra) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
-	if getBool(s.config, "aws.infra.container.sync", true) {
-		list, err := s.fetcher.Get("container_objects")
+	if getBool(s.config, "aws.infra.transitgateway.sync", true) {
+		list, err := s.fetcher.Get("transitgateway_objects")
 		if err != nil {
 			return gph, err
 		}
-		if _, ok := list.([]*ecs.Container); !ok {
-			return gph, errors.New("cannot cast to '[]*ecs.Container' type from fetch context")
+		if _, ok := list.([]*ec2transitgateway.TransitGateway); !ok {
+			return gph, errors.New("cannot cast to '[]*ec2transitgateway.TransitGateway' type from fetch context")
 		}
-		for _, r := range list.([]*ecs.Container) {
-			for _, fn := range addParentsFns["container"] {
+		for _, r := range list.([]*ec2transitgateway.TransitGateway) {
+			for _, fn := range addParentsFns["transitgateway"] {
 				wg.Add(1)
-				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ecs.Container) {
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ec2transitgateway.TransitGateway) {
 					defer wg.Done()
 					err := f(gph, snap, region, res)
 					if err != nil {
@@ -990,18 +1757,18 @@ func (s *Infra) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
-	if getBool(s.config, "aws.infra.containerinstance.sync", true) {
-		list, err := s.fetcher.Get("containerinstance_objects")
+	if getBool(s.config, "aws.infra.transitgatewayattachment.sync", true) {
+		list, err := s.fetcher.Get("transitgatewayattachment_objects")
 		if err != nil {
 			return gph, err
 		}
-		if _, ok := list.([]*ecs.ContainerInstance); !ok {
-			return gph, errors.New("cannot cast to '[]*ecs.ContainerInstance' type from fetch context")
+		if _, ok := list.([]*ec2transitgateway.TransitGatewayAttachment); !ok {
+			return gph, errors.New("cannot cast to '[]*ec2transitgateway.TransitGatewayAttachment' type from fetch context")
 		}
-		for _, r := range list.([]*ecs.ContainerInstance) {
-			for _, fn := range addParentsFns["containerinstance"] {
+		for _, r := range list.([]*ec2transitgateway.TransitGatewayAttachment) {
+			for _, fn := range addParentsFns["transitgatewayattachment"] {
 				wg.Add(1)
-				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ecs.ContainerInstance) {
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ec2transitgateway.TransitGatewayAttachment) {
 					defer wg.Done()
 					err := f(gph, snap, region, res)
 					if err != nil {
@@ -1012,18 +1779,18 @@ func (s *Infra) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
-	if getBool(s.config, "aws.infra.certificate.sync", true) {
-		list, err := s.fetcher.Get("certificate_objects")
+	if getBool(s.config, "aws.infra.transitgatewayroutetable.sync", true) {
+		list, err := s.fetcher.Get("transitgatewayroutetable_objects")
 		if err != nil {
 			return gph, err
 		}
-		if _, ok := list.([]*acm.CertificateSummary); !ok {
-			return gph, errors.New("cannot cast to '[]*acm.CertificateSummary' type from fetch context")
+		if _, ok := list.([]*ec2transitgateway.TransitGatewayRouteTable); !ok {
+			return gph, errors.New("cannot cast to '[]*ec2transitgateway.TransitGatewayRouteTable' type from fetch context")
 		}
-		for _, r := range list.([]*acm.CertificateSummary) {
-			for _, fn := range addParentsFns["certificate"] {
+		for _, r := range list.([]*ec2transitgateway.TransitGatewayRouteTable) {
+			for _, fn := range addParentsFns["transitgatewayroutetable"] {
 				wg.Add(1)
-				go func(f addParentFn, snap tstore.RDFGraph, region string, res *acm.CertificateSummary) {
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ec2transitgateway.TransitGatewayRouteTable) {
 					defer wg.Done()
 					err := f(gph, snap, region, res)
 					if err != nil {
@@ -1069,28 +1836,36 @@ type Access struct {
 	log             *logger.Logger
 	iamiface.IAMAPI
 	stsiface.STSAPI
+	kmsiface.KMSAPI
+	organizationsiface.OrganizationsAPI
 }
 
 func NewAccess(sess *session.Session, profile string, extraConf map[string]interface{}, log *logger.Logger) cloud.Service {
 	region := "global"
 	iamAPI := iam.New(sess)
 	stsAPI := sts.New(sess)
+	kmsAPI := kms.New(sess)
+	organizationsAPI := organizations.New(sess)
 
 	fetchConfig := awsfetch.NewConfig(
 		iamAPI,
 		stsAPI,
+		kmsAPI,
+		organizationsAPI,
 	)
 	fetchConfig.Extra = extraConf
 	fetchConfig.Log = log
 
 	return &Access{
-		IAMAPI:  iamAPI,
-		STSAPI:  stsAPI,
-		fetcher: fetch.NewFetcher(awsfetch.BuildAccessFetchFuncs(fetchConfig)),
-		config:  extraConf,
-		region:  region,
-		profile: profile,
-		log:     log,
+		IAMAPI:           iamAPI,
+		STSAPI:           stsAPI,
+		KMSAPI:           kmsAPI,
+		OrganizationsAPI: organizationsAPI,
+		fetcher:          fetch.NewFetcher(awsfetch.BuildAccessFetchFuncs(fetchConfig)),
+		config:           extraConf,
+		region:           region,
+		profile:          profile,
+		log:              log,
 	}
 }
 
@@ -1115,6 +1890,9 @@ func (s *Access) ResourceTypes() []string {
 		"accesskey",
 		"instanceprofile",
 		"mfadevice",
+		"key",
+		"account",
+		"organizationalunit",
 	}
 }
 
@@ -1306,6 +2084,72 @@ func (s *Access) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
+	if getBool(s.config, "aws.access.key.sync", true) {
+		list, err := s.fetcher.Get("key_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*kms.KeyMetadata); !ok {
+			return gph, errors.New("cannot cast to '[]*kms.KeyMetadata' type from fetch context")
+		}
+		for _, r := range list.([]*kms.KeyMetadata) {
+			for _, fn := range addParentsFns["key"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *kms.KeyMetadata) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.access.account.sync", true) {
+		list, err := s.fetcher.Get("account_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*organizations.Account); !ok {
+			return gph, errors.New("cannot cast to '[]*organizations.Account' type from fetch context")
+		}
+		for _, r := range list.([]*organizations.Account) {
+			for _, fn := range addParentsFns["account"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *organizations.Account) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.access.organizationalunit.sync", true) {
+		list, err := s.fetcher.Get("organizationalunit_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*organizations.OrganizationalUnit); !ok {
+			return gph, errors.New("cannot cast to '[]*organizations.OrganizationalUnit' type from fetch context")
+		}
+		for _, r := range list.([]*organizations.OrganizationalUnit) {
+			for _, fn := range addParentsFns["organizationalunit"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *organizations.OrganizationalUnit) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
 
 	go func() {
 		wg.Wait()
@@ -1826,20 +2670,24 @@ type Lambda struct {
 	config          map[string]interface{}
 	log             *logger.Logger
 	lambdaiface.LambdaAPI
+	sfniface.SFNAPI
 }
 
 func NewLambda(sess *session.Session, profile string, extraConf map[string]interface{}, log *logger.Logger) cloud.Service {
 	region := awssdk.StringValue(sess.Config.Region)
 	lambdaAPI := lambda.New(sess)
+	sfnAPI := sfn.New(sess)
 
 	fetchConfig := awsfetch.NewConfig(
 		lambdaAPI,
+		sfnAPI,
 	)
 	fetchConfig.Extra = extraConf
 	fetchConfig.Log = log
 
 	return &Lambda{
 		LambdaAPI: lambdaAPI,
+		SFNAPI:    sfnAPI,
 		fetcher:   fetch.NewFetcher(awsfetch.BuildLambdaFetchFuncs(fetchConfig)),
 		config:    extraConf,
 		region:    region,
@@ -1863,6 +2711,8 @@ func (s *Lambda) Profile() string {
 func (s *Lambda) ResourceTypes() []string {
 	return []string{
 		"function",
+		"statemachine",
+		"execution",
 	}
 }
 
@@ -1922,6 +2772,50 @@ func (s *Lambda) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
+	if getBool(s.config, "aws.lambda.statemachine.sync", true) {
+		list, err := s.fetcher.Get("statemachine_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*sfn.StateMachineListItem); !ok {
+			return gph, errors.New("cannot cast to '[]*sfn.StateMachineListItem' type from fetch context")
+		}
+		for _, r := range list.([]*sfn.StateMachineListItem) {
+			for _, fn := range addParentsFns["statemachine"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *sfn.StateMachineListItem) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.lambda.execution.sync", true) {
+		list, err := s.fetcher.Get("execution_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*sfn.ExecutionListItem); !ok {
+			return gph, errors.New("cannot cast to '[]*sfn.ExecutionListItem' type from fetch context")
+		}
+		for _, r := range list.([]*sfn.ExecutionListItem) {
+			for _, fn := range addParentsFns["execution"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *sfn.ExecutionListItem) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
 
 	go func() {
 		wg.Wait()
@@ -1956,25 +2850,33 @@ type Monitoring struct {
 	config          map[string]interface{}
 	log             *logger.Logger
 	cloudwatchiface.CloudWatchAPI
+	cloudwatchlogsiface.CloudWatchLogsAPI
+	cloudtrailiface.CloudTrailAPI
 }
 
 func NewMonitoring(sess *session.Session, profile string, extraConf map[string]interface{}, log *logger.Logger) cloud.Service {
 	region := awssdk.StringValue(sess.Config.Region)
 	cloudwatchAPI := cloudwatch.New(sess)
+	cloudwatchlogsAPI := cloudwatchlogs.New(sess)
+	cloudtrailAPI := cloudtrail.New(sess)
 
 	fetchConfig := awsfetch.NewConfig(
 		cloudwatchAPI,
+		cloudwatchlogsAPI,
+		cloudtrailAPI,
 	)
 	fetchConfig.Extra = extraConf
 	fetchConfig.Log = log
 
 	return &Monitoring{
-		CloudWatchAPI: cloudwatchAPI,
-		fetcher:       fetch.NewFetcher(awsfetch.BuildMonitoringFetchFuncs(fetchConfig)),
-		config:        extraConf,
-		region:        region,
-		profile:       profile,
-		log:           log,
+		CloudWatchAPI:     cloudwatchAPI,
+		CloudWatchLogsAPI: cloudwatchlogsAPI,
+		CloudTrailAPI:     cloudtrailAPI,
+		fetcher:           fetch.NewFetcher(awsfetch.BuildMonitoringFetchFuncs(fetchConfig)),
+		config:            extraConf,
+		region:            region,
+		profile:           profile,
+		log:               log,
 	}
 }
 
@@ -1994,6 +2896,9 @@ func (s *Monitoring) ResourceTypes() []string {
 	return []string{
 		"metric",
 		"alarm",
+		"loggroup",
+		"trail",
+		"trailevent",
 	}
 }
 
@@ -2075,6 +2980,72 @@ func (s *Monitoring) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
+	if getBool(s.config, "aws.monitoring.loggroup.sync", true) {
+		list, err := s.fetcher.Get("loggroup_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*cloudwatchlogs.LogGroup); !ok {
+			return gph, errors.New("cannot cast to '[]*cloudwatchlogs.LogGroup' type from fetch context")
+		}
+		for _, r := range list.([]*cloudwatchlogs.LogGroup) {
+			for _, fn := range addParentsFns["loggroup"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *cloudwatchlogs.LogGroup) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.monitoring.trail.sync", true) {
+		list, err := s.fetcher.Get("trail_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*cloudtrail.Trail); !ok {
+			return gph, errors.New("cannot cast to '[]*cloudtrail.Trail' type from fetch context")
+		}
+		for _, r := range list.([]*cloudtrail.Trail) {
+			for _, fn := range addParentsFns["trail"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *cloudtrail.Trail) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.monitoring.trailevent.sync", true) {
+		list, err := s.fetcher.Get("trailevent_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*cloudtrail.Event); !ok {
+			return gph, errors.New("cannot cast to '[]*cloudtrail.Event' type from fetch context")
+		}
+		for _, r := range list.([]*cloudtrail.Event) {
+			for _, fn := range addParentsFns["trailevent"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *cloudtrail.Event) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
 
 	go func() {
 		wg.Wait()
@@ -35,8 +35,13 @@ func Init(profile, region string, extraConf map[string]interface{}, log *logger.
 		return errors.New("empty AWS region. Set it with `awless config set aws.region`")
 	}
 
+	rates, err := parseRateLimits(extraConf)
+	if err != nil {
+		return err
+	}
+
 	sb := newSessionResolver().withRegion(region).withProfile(profile).withNetworkMonitor(enableNetworkMonitor)
-	sb = sb.withProfileSetter(profileSetterCallback).withLogger(log).withCredentialResolvers()
+	sb = sb.withProfileSetter(profileSetterCallback).withLogger(log).withCredentialResolvers().withRateLimits(rates)
 
 	sess, err := sb.resolve()
 	if err != nil {
@@ -63,6 +68,8 @@ func Init(profile, region string, extraConf map[string]interface{}, log *logger.
 	cloud.ServiceRegistry[CdnService.Name()] = CdnService
 	cloud.ServiceRegistry[CloudformationService.Name()] = CloudformationService
 
+	accounts = newAccountSessions(sess, profile, log)
+
 	awsspec.CommandFactory = &awsspec.AWSFactory{
 		Log:  log,
 		Sess: sess,
@@ -17,12 +17,14 @@ limitations under the License.
 package awsservices
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 	"sync"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/wallix/awless/aws/config"
 	"github.com/wallix/awless/cloud"
 )
 
@@ -67,7 +69,7 @@ func ResourceTypesPerServiceName() map[string][]string {
 var arnResourceInfoRegex = regexp.MustCompile(`(root)|([\w-.]*)/([\w-./]*)`)
 
 type Identity struct {
-	Account, Arn, UserId, ResourceType, ResourcePath, Resource string
+	Account, Arn, UserId, ResourceType, ResourcePath, Resource, Partition string
 }
 
 func (i *Identity) IsRoot() bool {
@@ -89,6 +91,7 @@ func (s *Access) GetIdentity() (*Identity, error) {
 		Arn:     awssdk.StringValue(resp.Arn),
 		UserId:  awssdk.StringValue(resp.UserId),
 	}
+	ident.Partition = awsconfig.ARNPartition(ident.Arn)
 
 	splits := strings.Split(ident.Arn, ":")
 	if l := len(splits); l > 0 {
@@ -212,3 +215,44 @@ func (s *Access) GetUserPolicies(username string) (*UserPolicies, error) {
 
 	return all, nil
 }
+
+// SimulateActions returns the decision (ex: implicitDeny) of each of the actions
+// the identity of the session is not allowed to perform, according to the IAM policy simulation
+// of the policies of its user or role on all resources
+func (s *Access) SimulateActions(actions []string) (map[string]string, error) {
+	me, err := s.GetIdentity()
+	if err != nil {
+		return nil, err
+	}
+	denied := make(map[string]string)
+	if me.IsRoot() || len(actions) == 0 {
+		return denied, nil
+	}
+	var principal string
+	switch me.ResourceType {
+	case "user":
+		principal = me.Arn
+	case "assumed-role":
+		role, err := s.GetRole(&iam.GetRoleInput{RoleName: awssdk.String(strings.SplitN(me.Resource, "/", 2)[0])})
+		if err != nil {
+			return nil, fmt.Errorf("get role of %s: %s", me.Arn, err)
+		}
+		principal = awssdk.StringValue(role.Role.Arn)
+	default:
+		return nil, fmt.Errorf("cannot simulate the policies of %s", me.Arn)
+	}
+
+	input := &iam.SimulatePrincipalPolicyInput{PolicySourceArn: awssdk.String(principal), ActionNames: awssdk.StringSlice(actions)}
+	err = s.SimulatePrincipalPolicyPages(input, func(out *iam.SimulatePolicyResponse, lastPage bool) bool {
+		for _, result := range out.EvaluationResults {
+			if decision := awssdk.StringValue(result.EvalDecision); decision != iam.PolicyEvaluationDecisionTypeAllowed {
+				denied[awssdk.StringValue(result.EvalActionName)] = decision
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("simulate policies of %s: %s", principal, err)
+	}
+	return denied, nil
+}
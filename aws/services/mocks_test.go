@@ -5,14 +5,23 @@ import (
 	"strconv"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/wallix/awless/aws/ec2transitgateway"
 )
 
 func (m *mockEc2) DescribeInstancesPages(input *ec2.DescribeInstancesInput, fn func(p *ec2.DescribeInstancesOutput, lastPage bool) (shouldContinue bool)) error {
@@ -20,6 +29,22 @@ func (m *mockEc2) DescribeInstancesPages(input *ec2.DescribeInstancesInput, fn f
 	return nil
 }
 
+func (m *mockEc2) DescribeReservedInstances(input *ec2.DescribeReservedInstancesInput) (*ec2.DescribeReservedInstancesOutput, error) {
+	return &ec2.DescribeReservedInstancesOutput{ReservedInstances: m.reservedinstancess}, nil
+}
+
+func (m *mockEc2transitgateway) DescribeTransitGateways(input *ec2transitgateway.DescribeTransitGatewaysInput) (*ec2transitgateway.DescribeTransitGatewaysOutput, error) {
+	return &ec2transitgateway.DescribeTransitGatewaysOutput{TransitGateways: m.transitgateways}, nil
+}
+
+func (m *mockEc2transitgateway) DescribeTransitGatewayAttachments(input *ec2transitgateway.DescribeTransitGatewayAttachmentsInput) (*ec2transitgateway.DescribeTransitGatewayAttachmentsOutput, error) {
+	return &ec2transitgateway.DescribeTransitGatewayAttachmentsOutput{TransitGatewayAttachments: m.transitgatewayattachments}, nil
+}
+
+func (m *mockEc2transitgateway) DescribeTransitGatewayRouteTables(input *ec2transitgateway.DescribeTransitGatewayRouteTablesInput) (*ec2transitgateway.DescribeTransitGatewayRouteTablesOutput, error) {
+	return &ec2transitgateway.DescribeTransitGatewayRouteTablesOutput{TransitGatewayRouteTables: m.transitgatewayroutetables}, nil
+}
+
 func (m *mockElbv2) DescribeListenersPages(input *elbv2.DescribeListenersInput, fn func(p *elbv2.DescribeListenersOutput, lastPage bool) (shouldContinue bool)) error {
 	listeners := make(map[string][]*elbv2.Listener)
 	for _, l := range m.listeners {
@@ -187,3 +212,136 @@ func (m *mockEcs) ListContainerInstancesPages(input *ecs.ListContainerInstancesI
 func (m *mockEcs) DescribeContainerInstances(input *ecs.DescribeContainerInstancesInput) (*ecs.DescribeContainerInstancesOutput, error) {
 	return &ecs.DescribeContainerInstancesOutput{ContainerInstances: m.containerinstances[awssdk.StringValue(input.Cluster)]}, nil
 }
+
+func (m *mockEcs) ListServicesPages(input *ecs.ListServicesInput, fn func(p *ecs.ListServicesOutput, lastPage bool) (shouldContinue bool)) error {
+	var arns []*string
+	for _, service := range m.services[awssdk.StringValue(input.Cluster)] {
+		arns = append(arns, service.ServiceArn)
+	}
+	fn(&ecs.ListServicesOutput{ServiceArns: arns}, true)
+	return nil
+}
+
+func (m *mockEcs) DescribeServices(input *ecs.DescribeServicesInput) (*ecs.DescribeServicesOutput, error) {
+	var services []*ecs.Service
+	for _, service := range m.services[awssdk.StringValue(input.Cluster)] {
+		for _, inputS := range input.Services {
+			if awssdk.StringValue(service.ServiceArn) == awssdk.StringValue(inputS) {
+				services = append(services, service)
+			}
+		}
+	}
+	return &ecs.DescribeServicesOutput{Services: services}, nil
+}
+
+func (m *mockDynamodb) ListTablesPages(input *dynamodb.ListTablesInput, fn func(p *dynamodb.ListTablesOutput, lastPage bool) (shouldContinue bool)) error {
+	for i, table := range m.tabledescriptions {
+		out := &dynamodb.ListTablesOutput{TableNames: []*string{table.TableName}}
+		if i < len(m.tabledescriptions)-1 {
+			out.LastEvaluatedTableName = table.TableName
+		}
+		if !fn(out, i == len(m.tabledescriptions)-1) {
+			break
+		}
+	}
+	return nil
+}
+
+func (m *mockDynamodb) DescribeTable(input *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error) {
+	for _, table := range m.tabledescriptions {
+		if awssdk.StringValue(table.TableName) == awssdk.StringValue(input.TableName) {
+			return &dynamodb.DescribeTableOutput{Table: table}, nil
+		}
+	}
+	return nil, awserr.New(dynamodb.ErrCodeResourceNotFoundException, "table not found", nil)
+}
+
+func (m *mockKms) ListKeysPages(input *kms.ListKeysInput, fn func(p *kms.ListKeysOutput, lastPage bool) (shouldContinue bool)) error {
+	var entries []*kms.KeyListEntry
+	for _, key := range m.keys {
+		entries = append(entries, &kms.KeyListEntry{KeyId: key.KeyId, KeyArn: key.Arn})
+	}
+	fn(&kms.ListKeysOutput{Keys: entries}, true)
+	return nil
+}
+
+func (m *mockKms) DescribeKey(input *kms.DescribeKeyInput) (*kms.DescribeKeyOutput, error) {
+	for _, key := range m.keys {
+		if awssdk.StringValue(key.KeyId) == awssdk.StringValue(input.KeyId) {
+			return &kms.DescribeKeyOutput{KeyMetadata: key}, nil
+		}
+	}
+	return nil, awserr.New(kms.ErrCodeNotFoundException, "key not found", nil)
+}
+
+func (m *mockKms) ListAliasesPages(input *kms.ListAliasesInput, fn func(p *kms.ListAliasesOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&kms.ListAliasesOutput{Aliases: m.aliases}, true)
+	return nil
+}
+
+func (m *mockKms) ListGrantsPages(input *kms.ListGrantsInput, fn func(p *kms.ListGrantsResponse, lastPage bool) (shouldContinue bool)) error {
+	fn(&kms.ListGrantsResponse{Grants: m.grants[awssdk.StringValue(input.KeyId)]}, true)
+	return nil
+}
+
+func (m *mockKms) GetKeyPolicy(input *kms.GetKeyPolicyInput) (*kms.GetKeyPolicyOutput, error) {
+	return &kms.GetKeyPolicyOutput{Policy: awssdk.String(m.keyPolicies[awssdk.StringValue(input.KeyId)])}, nil
+}
+
+func (m *mockSfn) ListExecutions(input *sfn.ListExecutionsInput) (*sfn.ListExecutionsOutput, error) {
+	executions := m.executionlistitems[awssdk.StringValue(input.StateMachineArn)]
+	if max := int(awssdk.Int64Value(input.MaxResults)); max > 0 && len(executions) > max {
+		executions = executions[:max]
+	}
+	return &sfn.ListExecutionsOutput{Executions: executions}, nil
+}
+
+func (m *mockCloudformation) ListStackResourcesPages(input *cloudformation.ListStackResourcesInput, fn func(p *cloudformation.ListStackResourcesOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&cloudformation.ListStackResourcesOutput{StackResourceSummaries: m.stackResources[awssdk.StringValue(input.StackName)]}, true)
+	return nil
+}
+
+func (m *mockBatch) ListJobs(input *batch.ListJobsInput) (*batch.ListJobsOutput, error) {
+	var summaries []*batch.JobSummary
+	for _, job := range m.jobdetails {
+		if awssdk.StringValue(job.JobQueue) == awssdk.StringValue(input.JobQueue) && awssdk.StringValue(job.Status) == awssdk.StringValue(input.JobStatus) {
+			summaries = append(summaries, &batch.JobSummary{JobId: job.JobId, JobName: job.JobName})
+		}
+	}
+	return &batch.ListJobsOutput{JobSummaryList: summaries}, nil
+}
+
+func (m *mockBatch) DescribeJobs(input *batch.DescribeJobsInput) (*batch.DescribeJobsOutput, error) {
+	var jobs []*batch.JobDetail
+	for _, id := range input.Jobs {
+		for _, job := range m.jobdetails {
+			if awssdk.StringValue(job.JobId) == awssdk.StringValue(id) {
+				jobs = append(jobs, job)
+			}
+		}
+	}
+	return &batch.DescribeJobsOutput{Jobs: jobs}, nil
+}
+
+func (m *mockCloudtrail) LookupEventsPages(input *cloudtrail.LookupEventsInput, fn func(p *cloudtrail.LookupEventsOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&cloudtrail.LookupEventsOutput{Events: m.events}, true)
+	return nil
+}
+
+func (m *mockOrganizations) ListRootsPages(input *organizations.ListRootsInput, fn func(p *organizations.ListRootsOutput, lastPage bool) (shouldContinue bool)) error {
+	if m.roots == nil {
+		return awserr.New(organizations.ErrCodeAWSOrganizationsNotInUseException, "not in an organization", nil)
+	}
+	fn(&organizations.ListRootsOutput{Roots: m.roots}, true)
+	return nil
+}
+
+func (m *mockOrganizations) ListOrganizationalUnitsForParentPages(input *organizations.ListOrganizationalUnitsForParentInput, fn func(p *organizations.ListOrganizationalUnitsForParentOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&organizations.ListOrganizationalUnitsForParentOutput{OrganizationalUnits: m.units[awssdk.StringValue(input.ParentId)]}, true)
+	return nil
+}
+
+func (m *mockOrganizations) ListAccountsForParentPages(input *organizations.ListAccountsForParentInput, fn func(p *organizations.ListAccountsForParentOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&organizations.ListAccountsForParentOutput{Accounts: m.accounts[awssdk.StringValue(input.ParentId)]}, true)
+	return nil
+}
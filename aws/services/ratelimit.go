@@ -0,0 +1,196 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsservices
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/wallix/awless/logger"
+)
+
+const (
+	RateLimitConfigPrefix = "aws.ratelimit."
+	defaultRateLimitKey   = "default"
+
+	// requests retried on throttling errors, others keep the SDK default
+	maxThrottleRetries = 10
+	defaultMaxRetries  = 3
+
+	// on throttling errors, the rate of a service is divided by 2, down to minRate.
+	// It then increases by recoveryStep on each successful request, up to its configured rate
+	minRate          = 0.5
+	recoveryStep     = 0.1
+	unlimitedRecover = 20.0
+)
+
+// rateLimiter spaces out the requests sent to an AWS service. Its rate is configured,
+// in requests per second (0 is unlimited), and adapts when AWS throttles the requests
+type rateLimiter struct {
+	mu         sync.Mutex
+	configured float64
+	current    float64
+	next       time.Time
+}
+
+func newRateLimiter(rate float64) *rateLimiter {
+	return &rateLimiter{configured: rate, current: rate}
+}
+
+// reserve returns how long to wait before sending a request
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.current <= 0 {
+		return 0
+	}
+	if l.next.Before(now) {
+		l.next = now
+	}
+	wait := l.next.Sub(now)
+	l.next = l.next.Add(time.Duration(float64(time.Second) / l.current))
+	return wait
+}
+
+func (l *rateLimiter) throttled() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.current <= 0 {
+		l.current = unlimitedRecover
+	}
+	l.current = l.current / 2
+	if l.current < minRate {
+		l.current = minRate
+	}
+	return l.current
+}
+
+func (l *rateLimiter) succeeded() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.current <= 0 || l.current == l.configured {
+		return
+	}
+	l.current += recoveryStep
+	switch {
+	case l.configured > 0 && l.current >= l.configured:
+		l.current = l.configured
+	case l.configured <= 0 && l.current >= unlimitedRecover:
+		l.current = 0
+	}
+}
+
+type rateLimiters struct {
+	mu       sync.Mutex
+	rates    map[string]float64
+	limiters map[string]*rateLimiter
+}
+
+func newRateLimiters(rates map[string]float64) *rateLimiters {
+	return &rateLimiters{rates: rates, limiters: make(map[string]*rateLimiter)}
+}
+
+func (r *rateLimiters) forService(service string) *rateLimiter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	l, ok := r.limiters[service]
+	if !ok {
+		rate, ok := r.rates[service]
+		if !ok {
+			rate = r.rates[defaultRateLimitKey]
+		}
+		l = newRateLimiter(rate)
+		r.limiters[service] = l
+	}
+	return l
+}
+
+// parseRateLimits extracts the rates of the aws.ratelimit.<service> config keys
+func parseRateLimits(conf map[string]interface{}) (map[string]float64, error) {
+	rates := make(map[string]float64)
+	for k, v := range conf {
+		if !strings.HasPrefix(k, RateLimitConfigPrefix) {
+			continue
+		}
+		rate, err := strconv.ParseFloat(fmt.Sprint(v), 64)
+		if err != nil || rate < 0 {
+			return rates, fmt.Errorf("invalid rate limit '%v' for %s: expecting a number of requests per second", v, k)
+		}
+		rates[strings.TrimPrefix(k, RateLimitConfigPrefix)] = rate
+	}
+	return rates, nil
+}
+
+// addRateLimitHandlers makes the requests of the session wait for their service rate,
+// and retry on throttling errors while reducing the rate of the throttled service
+func addRateLimitHandlers(sess *session.Session, rates map[string]float64, log *logger.Logger) {
+	limiters := newRateLimiters(rates)
+
+	sess.Config.Retryer = throttleRetryer{client.DefaultRetryer{NumMaxRetries: maxThrottleRetries}}
+
+	sess.Handlers.Send.PushFrontNamed(request.NamedHandler{
+		Name: "awless.RateLimit",
+		Fn: func(r *request.Request) {
+			wait := limiters.forService(r.ClientInfo.ServiceName).reserve(time.Now())
+			if wait <= 0 {
+				return
+			}
+			// when cancelled, the request is sent with its cancelled context and fails right away
+			select {
+			case <-time.After(wait):
+			case <-r.Context().Done():
+			}
+		},
+	})
+	sess.Handlers.Retry.PushBackNamed(request.NamedHandler{
+		Name: "awless.AdaptiveThrottling",
+		Fn: func(r *request.Request) {
+			if r.IsErrorThrottle() {
+				rate := limiters.forService(r.ClientInfo.ServiceName).throttled()
+				if log != nil {
+					log.ExtraVerbosef("%s throttled: slowing down %s requests to %.1f/s", r.Operation.Name, r.ClientInfo.ServiceName, rate)
+				}
+			}
+		},
+	})
+	sess.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "awless.AdaptiveThrottlingRecovery",
+		Fn: func(r *request.Request) {
+			if r.Error == nil {
+				limiters.forService(r.ClientInfo.ServiceName).succeeded()
+			}
+		},
+	})
+}
+
+// throttleRetryer retries longer than the SDK default on throttling errors
+type throttleRetryer struct {
+	client.DefaultRetryer
+}
+
+func (t throttleRetryer) ShouldRetry(r *request.Request) bool {
+	if !t.DefaultRetryer.ShouldRetry(r) {
+		return false
+	}
+	return r.IsErrorThrottle() || r.RetryCount < defaultMaxRetries
+}
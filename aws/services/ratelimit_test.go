@@ -0,0 +1,127 @@
+package awsservices
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/wallix/awless/aws/sessionmanager"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Now()
+	l := newRateLimiter(4)
+	var waits []time.Duration
+	for i := 0; i < 3; i++ {
+		waits = append(waits, l.reserve(now))
+	}
+	if got, want := waits, []time.Duration{0, 250 * time.Millisecond, 500 * time.Millisecond}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	if got, want := l.throttled(), 2.0; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := 0; i < 100; i++ {
+		l.succeeded()
+	}
+	if got, want := l.current, 4.0; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	unlimited := newRateLimiter(0)
+	if got := unlimited.reserve(now); got != 0 {
+		t.Fatalf("got %v, want no wait", got)
+	}
+	if got, want := unlimited.throttled(), unlimitedRecover/2; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := 0; i < 10; i++ {
+		unlimited.throttled()
+	}
+	if got, want := unlimited.current, minRate; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := 0; i < 1000; i++ {
+		unlimited.succeeded()
+	}
+	if got := unlimited.reserve(now); got != 0 {
+		t.Fatalf("got %v, want no wait once recovered", got)
+	}
+}
+
+func TestParseRateLimits(t *testing.T) {
+	rates, err := parseRateLimits(map[string]interface{}{"aws.ratelimit.default": 0, "aws.ratelimit.ec2": 10, "aws.ratelimit.iam": "2.5", "aws.infra.sync": true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := rates, map[string]float64{"default": 0, "ec2": 10, "iam": 2.5}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	limiters := newRateLimiters(map[string]float64{"default": 1, "ec2": 10})
+	if got, want := limiters.forService("ec2").configured, 10.0; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := limiters.forService("s3").configured, 1.0; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if _, err = parseRateLimits(map[string]interface{}{"aws.ratelimit.ec2": "fast"}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestThrottleRetryer(t *testing.T) {
+	retryer := throttleRetryer{client.DefaultRetryer{NumMaxRetries: maxThrottleRetries}}
+	newReq := func(code string, status, retryCount int) *request.Request {
+		return &request.Request{Error: awserr.New(code, "", nil), HTTPResponse: &http.Response{StatusCode: status}, RetryCount: retryCount}
+	}
+	if !retryer.ShouldRetry(newReq("RequestLimitExceeded", 503, 6)) {
+		t.Fatal("expected throttled request to be retried")
+	}
+	if !retryer.ShouldRetry(newReq("Throttling", 400, 6)) {
+		t.Fatal("expected throttled request to be retried")
+	}
+	if !retryer.ShouldRetry(newReq("InternalError", 500, 1)) {
+		t.Fatal("expected server error to be retried")
+	}
+	if retryer.ShouldRetry(newReq("InternalError", 500, defaultMaxRetries)) {
+		t.Fatal("expected server error not to be retried more than default")
+	}
+	if retryer.ShouldRetry(newReq("InvalidParameterValue", 400, 0)) {
+		t.Fatal("expected client error not to be retried")
+	}
+}
+
+func TestRateLimitHandlersOnHandWrittenClients(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	sess := session.Must(session.NewSession(&aws.Config{
+		Region:      aws.String("us-east-1"),
+		Endpoint:    aws.String(server.URL),
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+	}))
+	addRateLimitHandlers(sess, map[string]float64{"default": 0, "ssm": 20}, nil)
+
+	api := sessionmanager.New(sess)
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := api.StartSession(&sessionmanager.StartSessionInput{Target: aws.String("i-1234567890")}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := time.Since(start), 100*time.Millisecond; got < want {
+		t.Fatalf("got %v, want at least %v between 3 ssm requests at 20/s", got, want)
+	}
+}
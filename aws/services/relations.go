@@ -26,11 +26,16 @@ import (
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/wallix/awless/aws/conv"
+	"github.com/wallix/awless/aws/ec2transitgateway"
 	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/graph"
 	tstore "github.com/wallix/triplestore"
 )
@@ -58,6 +63,9 @@ var addParentsFns = map[string][]addParentFn{
 		funcBuilder{parent: cloud.Subnet, fieldName: "SubnetId"}.build(),
 		funcBuilder{parent: cloud.SecurityGroup, fieldName: "GroupId", listName: "SecurityGroups", relation: APPLIES_ON}.build(),
 		funcBuilder{parent: cloud.Keypair, fieldName: "KeyName", relation: APPLIES_ON}.build(),
+		funcBuilder{parent: cloud.SpotRequest, fieldName: "SpotInstanceRequestId", relation: APPLIES_ON}.build(),
+		funcBuilder{parent: cloud.PlacementGroup, fieldName: "Placement.GroupName", relation: APPLIES_ON}.build(),
+		funcBuilder{parent: cloud.Host, fieldName: "Placement.HostId", relation: APPLIES_ON}.build(),
 	},
 	cloud.SecurityGroup: {
 		funcBuilder{parent: cloud.Vpc, fieldName: "VpcId"}.build(),
@@ -70,11 +78,39 @@ var addParentsFns = map[string][]addParentFn{
 		addRegionParent,
 		funcBuilder{parent: cloud.Vpc, fieldName: "VpcId"}.build(),
 		funcBuilder{parent: cloud.Subnet, fieldName: "SubnetId", relation: DEPENDING_ON}.build(),
+		funcBuilder{parent: cloud.ElasticIP, fieldName: "AllocationId", listName: "NatGatewayAddresses", relation: APPLIES_ON}.build(),
+	},
+	cloud.PeeringConnection: {
+		addRegionParent,
+		funcBuilder{parent: cloud.Vpc, fieldName: "RequesterVpcInfo.VpcId", relation: DEPENDING_ON}.build(),
+		funcBuilder{parent: cloud.Vpc, fieldName: "AccepterVpcInfo.VpcId", relation: DEPENDING_ON}.build(),
+	},
+	cloud.VpcEndpoint: {
+		funcBuilder{parent: cloud.Vpc, fieldName: "VpcId"}.build(),
+		funcBuilder{parent: cloud.RouteTable, stringListName: "RouteTableIds", relation: DEPENDING_ON}.build(),
+		funcBuilder{parent: cloud.Subnet, stringListName: "SubnetIds", relation: DEPENDING_ON}.build(),
+		funcBuilder{parent: cloud.SecurityGroup, fieldName: "GroupId", listName: "Groups", relation: APPLIES_ON}.build(),
+	},
+	cloud.CustomerGateway: {addRegionParent},
+	cloud.VpnGateway: {
+		addRegionParent,
+		funcBuilder{parent: cloud.Vpc, fieldName: "VpcId", listName: "VpcAttachments", relation: DEPENDING_ON}.build(),
+	},
+	cloud.VpnConnection: {
+		addRegionParent,
+		funcBuilder{parent: cloud.CustomerGateway, fieldName: "CustomerGatewayId", relation: DEPENDING_ON}.build(),
+		funcBuilder{parent: cloud.VpnGateway, fieldName: "VpnGatewayId", relation: DEPENDING_ON}.build(),
 	},
 	cloud.RouteTable: {
 		funcBuilder{parent: cloud.Subnet, fieldName: "SubnetId", listName: "Associations", relation: DEPENDING_ON}.build(),
 		funcBuilder{parent: cloud.Vpc, fieldName: "VpcId"}.build(),
 	},
+	cloud.NetworkACL: {
+		funcBuilder{parent: cloud.Subnet, fieldName: "SubnetId", listName: "Associations", relation: DEPENDING_ON}.build(),
+		funcBuilder{parent: cloud.Vpc, fieldName: "VpcId"}.build(),
+	},
+	cloud.PlacementGroup: {addRegionParent},
+	cloud.Host:           {addRegionParent},
 	cloud.Volume: {
 		funcBuilder{parent: cloud.AvailabilityZone, fieldName: "AvailabilityZone"}.build(),
 		funcBuilder{parent: cloud.Instance, fieldName: "InstanceId", listName: "Attachments", relation: DEPENDING_ON}.build(),
@@ -82,6 +118,7 @@ var addParentsFns = map[string][]addParentFn{
 	cloud.ElasticIP: {
 		addRegionParent,
 		funcBuilder{parent: cloud.Instance, fieldName: "InstanceId", relation: DEPENDING_ON}.build(),
+		funcBuilder{parent: cloud.NetworkInterface, fieldName: "NetworkInterfaceId", relation: DEPENDING_ON}.build(),
 	},
 	cloud.Snapshot: {
 		addRegionParent,
@@ -92,6 +129,16 @@ var addParentsFns = map[string][]addParentFn{
 		funcBuilder{parent: cloud.SecurityGroup, fieldName: "GroupId", listName: "Groups", relation: APPLIES_ON}.build(),
 		funcBuilder{parent: cloud.Instance, fieldName: "Attachment.InstanceId", relation: DEPENDING_ON}.build(),
 	},
+	cloud.SpotRequest: {
+		addRegionParent,
+	},
+	cloud.SpotFleet: {
+		addRegionParent,
+	},
+	cloud.Reservation: {
+		addRegionParent,
+		reservationAddCoveredInstancesRelations,
+	},
 	// Loadbalancer
 	cloud.LoadBalancer: {
 		funcBuilder{parent: cloud.Vpc, fieldName: "VpcId"}.build(),
@@ -102,6 +149,12 @@ var addParentsFns = map[string][]addParentFn{
 	cloud.Listener: {
 		funcBuilder{parent: cloud.LoadBalancer, fieldName: "LoadBalancerArn"}.build(),
 	},
+	cloud.ClassicLoadBalancer: {
+		funcBuilder{parent: cloud.Vpc, fieldName: "VPCId"}.build(),
+		funcBuilder{parent: cloud.Subnet, stringListName: "Subnets", relation: DEPENDING_ON}.build(),
+		funcBuilder{parent: cloud.SecurityGroup, stringListName: "SecurityGroups", relation: APPLIES_ON}.build(),
+		funcBuilder{parent: cloud.Instance, fieldName: "InstanceId", listName: "Instances", relation: DEPENDING_ON}.build(),
+	},
 	cloud.TargetGroup: {
 		funcBuilder{parent: cloud.Vpc, fieldName: "VpcId"}.build(),
 		funcBuilder{parent: cloud.LoadBalancer, stringListName: "LoadBalancerArns", relation: APPLIES_ON}.build(),
@@ -111,6 +164,55 @@ var addParentsFns = map[string][]addParentFn{
 	cloud.Database: {
 		funcBuilder{parent: cloud.AvailabilityZone, fieldName: "AvailabilityZone"}.build(),
 		funcBuilder{parent: cloud.SecurityGroup, listName: "VpcSecurityGroups", fieldName: "VpcSecurityGroupId", relation: APPLIES_ON}.build(),
+		funcBuilder{parent: cloud.DbParameterGroup, listName: "DBParameterGroups", fieldName: "DBParameterGroupName", relation: APPLIES_ON}.build(),
+		addDatabaseSubnets,
+	},
+	cloud.DbSubnetGroup: {
+		funcBuilder{parent: cloud.Vpc, fieldName: "VpcId"}.build(),
+		funcBuilder{parent: cloud.Subnet, listName: "Subnets", fieldName: "SubnetIdentifier", relation: DEPENDING_ON}.build(),
+	},
+	cloud.DbParameterGroup: {addRegionParent},
+	// NoSQL
+	cloud.Table: {addRegionParent},
+	// Cache
+	cloud.CacheCluster: {
+		addRegionParent,
+		funcBuilder{parent: cloud.SecurityGroup, listName: "SecurityGroups", fieldName: "SecurityGroupId", relation: APPLIES_ON}.build(),
+		funcBuilder{parent: cloud.CacheSubnetGroup, fieldName: "CacheSubnetGroupName", relation: APPLIES_ON}.build(),
+	},
+	cloud.CacheSubnetGroup: {
+		funcBuilder{parent: cloud.Vpc, fieldName: "VpcId"}.build(),
+		funcBuilder{parent: cloud.Subnet, listName: "Subnets", fieldName: "SubnetIdentifier", relation: DEPENDING_ON}.build(),
+	},
+	// Datawarehouse
+	cloud.Cluster: {
+		addRegionParent,
+		funcBuilder{parent: cloud.SecurityGroup, listName: "VpcSecurityGroups", fieldName: "VpcSecurityGroupId", relation: APPLIES_ON}.build(),
+	},
+	// Batch
+	cloud.ComputeEnvironment: {addRegionParent},
+	cloud.JobQueue: {
+		addRegionParent,
+		funcBuilder{parent: cloud.ComputeEnvironment, fieldName: "ComputeEnvironment", listName: "ComputeEnvironmentOrder", relation: DEPENDING_ON}.build(),
+	},
+	cloud.Job: {
+		funcBuilder{parent: cloud.JobQueue, fieldName: "JobQueue"}.build(),
+	},
+	// Direct Connect
+	cloud.DxConnection: {addRegionParent},
+	cloud.VirtualInterface: {
+		funcBuilder{parent: cloud.DxConnection, fieldName: "ConnectionId"}.build(),
+		funcBuilder{parent: cloud.VpnGateway, fieldName: "VirtualGatewayId", relation: DEPENDING_ON}.build(),
+	},
+	// Transit Gateway
+	cloud.TransitGateway: {addRegionParent},
+	cloud.TransitGatewayAttachment: {
+		funcBuilder{parent: cloud.TransitGateway, fieldName: "TransitGatewayId"}.build(),
+		funcBuilder{parent: cloud.TransitGatewayRouteTable, fieldName: "Association.TransitGatewayRouteTableId", relation: APPLIES_ON}.build(),
+		addTransitGatewayAttachmentResource,
+	},
+	cloud.TransitGatewayRouteTable: {
+		funcBuilder{parent: cloud.TransitGateway, fieldName: "TransitGatewayId"}.build(),
 	},
 	// Autoscaling
 	cloud.LaunchConfiguration: {
@@ -128,29 +230,45 @@ var addParentsFns = map[string][]addParentFn{
 	cloud.ContainerInstance: {
 		funcBuilder{parent: cloud.Instance, fieldName: "Ec2InstanceId", relation: APPLIES_ON}.build(),
 	},
+	cloud.ContainerService: {
+		funcBuilder{parent: cloud.ContainerCluster, fieldName: "ClusterArn"}.build(),
+		funcBuilder{parent: cloud.ContainerTask, fieldName: "TaskDefinition", relation: DEPENDING_ON}.build(),
+		funcBuilder{parent: cloud.TargetGroup, fieldName: "TargetGroupArn", listName: "LoadBalancers", relation: DEPENDING_ON}.build(),
+	},
 	cloud.Subscription: {
 		funcBuilder{parent: cloud.Topic, fieldName: "TopicArn"}.build(),
 	},
 	cloud.Vpc:              {addRegionParent},
 	cloud.AvailabilityZone: {addRegionParent},
 	cloud.Keypair:          {addRegionParent},
-	cloud.Image:            {addRegionParent},
+	cloud.Image:            {addRegionParent, addImageSnapshots},
 	cloud.Repository:       {addRegionParent},
 	cloud.ContainerCluster: {addRegionParent},
 	cloud.ContainerTask:    {addRegionParent},
 	cloud.Certificate:      {addRegionParent},
 	cloud.User:             {userAddGroupsRelations, addManagedPoliciesRelations},
 	cloud.Role:             {addManagedPoliciesRelations},
+	cloud.Key:              {keyAddPrincipalsRelations},
 	cloud.Group:            {addManagedPoliciesRelations},
 	cloud.Bucket:           {addRegionParent},
 	cloud.Function:         {addRegionParent},
+	cloud.StateMachine:     {addRegionParent},
 	cloud.Topic:            {addRegionParent},
 	cloud.Alarm:            {addRegionParent, addAlarmMetric},
 	cloud.Metric:           {addRegionParent},
+	cloud.LogGroup:         {addRegionParent},
+	cloud.TrailEvent:       {trailEventAddResourcesRelations},
 	cloud.Stack:            {addRegionParent},
 	cloud.MFADevice: {
 		funcBuilder{parent: cloud.User, fieldName: "User.UserId", relation: DEPENDING_ON}.build(),
 	},
+	cloud.Execution: {
+		funcBuilder{parent: cloud.StateMachine, fieldName: "StateMachineArn"}.build(),
+	},
+	cloud.Trail: {
+		addRegionParent,
+		funcBuilder{parent: cloud.Bucket, fieldName: "S3BucketName", relation: DEPENDING_ON}.build(),
+	},
 }
 
 func (fb funcBuilder) build() addParentFn {
@@ -385,6 +503,64 @@ func userAddGroupsRelations(g *graph.Graph, snap tstore.RDFGraph, region string,
 	return nil
 }
 
+// keyAddPrincipalsRelations relates the key to the roles and users it has been granted to,
+// the principals of the key being resolved at fetch time from its grants and key policy
+func keyAddPrincipalsRelations(g *graph.Graph, snap tstore.RDFGraph, region string, i interface{}) error {
+	n, err := awsconv.InitResource(i)
+	if err != nil {
+		return err
+	}
+	keys, err := graph.ResolveResourcesWithProp(snap, cloud.Key, properties.ID, n.Id())
+	if err != nil {
+		return err
+	}
+	if len(keys) != 1 {
+		return nil
+	}
+	principals, ok := keys[0].Properties()[properties.Principals].([]string)
+	if !ok {
+		return nil
+	}
+
+	for _, arn := range principals {
+		for _, typ := range []string{cloud.Role, cloud.User} {
+			resources, err := graph.ResolveResourcesWithProp(snap, typ, properties.Arn, arn)
+			if err != nil {
+				return err
+			}
+			for _, res := range resources {
+				g.AddAppliesOnRelation(n, res)
+			}
+		}
+	}
+	return nil
+}
+
+// reservationAddCoveredInstancesRelations relates the reservation to the running instances it covers,
+// the coverage being computed at fetch time
+func reservationAddCoveredInstancesRelations(g *graph.Graph, snap tstore.RDFGraph, region string, i interface{}) error {
+	n, err := awsconv.InitResource(i)
+	if err != nil {
+		return err
+	}
+	reservations, err := graph.ResolveResourcesWithProp(snap, cloud.Reservation, properties.ID, n.Id())
+	if err != nil {
+		return err
+	}
+	if len(reservations) != 1 {
+		return nil
+	}
+	instances, ok := reservations[0].Properties()[properties.Instances].([]string)
+	if !ok {
+		return nil
+	}
+
+	for _, id := range instances {
+		g.AddAppliesOnRelation(n, graph.InitResource(cloud.Instance, id))
+	}
+	return nil
+}
+
 func fetchTargetsAndAddRelations(g *graph.Graph, snap tstore.RDFGraph, region string, i interface{}) error {
 	group, ok := i.(*elbv2.TargetGroup)
 	if !ok {
@@ -432,6 +608,75 @@ func addScalingGroupSubnets(g *graph.Graph, snap tstore.RDFGraph, region string,
 	return nil
 }
 
+func addTransitGatewayAttachmentResource(g *graph.Graph, snap tstore.RDFGraph, region string, i interface{}) error {
+	attachment, ok := i.(*ec2transitgateway.TransitGatewayAttachment)
+	if !ok {
+		return fmt.Errorf("add transit gateway attachment relation: not a transit gateway attachment, but a %T", i)
+	}
+	id := awssdk.StringValue(attachment.ResourceId)
+	if id == "" {
+		return nil
+	}
+	var resourceType string
+	switch awssdk.StringValue(attachment.ResourceType) {
+	case ec2transitgateway.TransitGatewayAttachmentResourceTypeVpc:
+		resourceType = cloud.Vpc
+	case ec2transitgateway.TransitGatewayAttachmentResourceTypeVpn:
+		resourceType = cloud.VpnConnection
+	default:
+		return nil
+	}
+	res, err := awsconv.InitResource(attachment)
+	if err != nil {
+		return err
+	}
+	return addRelation(g, graph.InitResource(resourceType, id), res, DEPENDING_ON)
+}
+
+func addDatabaseSubnets(g *graph.Graph, snap tstore.RDFGraph, region string, i interface{}) error {
+	db, ok := i.(*rds.DBInstance)
+	if !ok {
+		return fmt.Errorf("add database relation: not a database, but a %T", i)
+	}
+	if db.DBSubnetGroup == nil {
+		return nil
+	}
+	res, err := awsconv.InitResource(db)
+	if err != nil {
+		return err
+	}
+	for _, subnet := range db.DBSubnetGroup.Subnets {
+		if id := awssdk.StringValue(subnet.SubnetIdentifier); id != "" {
+			if err = addRelation(g, graph.InitResource(cloud.Subnet, id), res, DEPENDING_ON); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func addImageSnapshots(g *graph.Graph, snap tstore.RDFGraph, region string, i interface{}) error {
+	image, ok := i.(*ec2.Image)
+	if !ok {
+		return fmt.Errorf("add image relation: not an image, but a %T", i)
+	}
+	res, err := awsconv.InitResource(image)
+	if err != nil {
+		return err
+	}
+	for _, device := range image.BlockDeviceMappings {
+		if device.Ebs == nil {
+			continue
+		}
+		if id := awssdk.StringValue(device.Ebs.SnapshotId); id != "" {
+			if err = addRelation(g, graph.InitResource(cloud.Snapshot, id), res, DEPENDING_ON); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func addAlarmMetric(g *graph.Graph, snap tstore.RDFGraph, region string, i interface{}) error {
 	alarm, ok := i.(*cloudwatch.MetricAlarm)
 	if !ok {
@@ -451,3 +696,22 @@ func addAlarmMetric(g *graph.Graph, snap tstore.RDFGraph, region string, i inter
 	}
 	return nil
 }
+
+func trailEventAddResourcesRelations(g *graph.Graph, snap tstore.RDFGraph, region string, i interface{}) error {
+	event, ok := i.(*cloudtrail.Event)
+	if !ok {
+		return fmt.Errorf("add trail event resources relations: not a trail event, but a %T", i)
+	}
+	res, err := awsconv.InitResource(event)
+	if err != nil {
+		return err
+	}
+	for _, r := range event.Resources {
+		if name := awssdk.StringValue(r.ResourceName); name != "" {
+			if err = g.AddAppliesOnRelation(res, graph.NotFoundResource(name)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -29,18 +29,31 @@ import (
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/batch"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/wallix/awless/aws/ec2transitgateway"
 	"github.com/wallix/awless/aws/fetch"
 	"github.com/wallix/awless/cloud"
 	p "github.com/wallix/awless/cloud/properties"
@@ -70,9 +83,9 @@ func TestBuildAccessRdfGraph(t *testing.T) {
 	}
 
 	roles := []*iam.RoleDetail{
-		{RoleId: awssdk.String("role_1"), RolePolicyList: []*iam.PolicyDetail{{PolicyName: awssdk.String("npolicy_1")}}, AttachedManagedPolicies: []*iam.AttachedPolicy{{PolicyName: awssdk.String("nmanaged_policy_1")}}, AssumeRolePolicyDocument: awssdk.String(url.QueryEscape(assumeRoleDoc))},
+		{RoleId: awssdk.String("role_1"), Arn: awssdk.String("arn:aws:iam::123456789012:role/nrole_1"), RolePolicyList: []*iam.PolicyDetail{{PolicyName: awssdk.String("npolicy_1")}}, AttachedManagedPolicies: []*iam.AttachedPolicy{{PolicyName: awssdk.String("nmanaged_policy_1")}}, AssumeRolePolicyDocument: awssdk.String(url.QueryEscape(assumeRoleDoc))},
 		{RoleId: awssdk.String("role_2"), RolePolicyList: []*iam.PolicyDetail{{PolicyName: awssdk.String("npolicy_1")}}},
-		{RoleId: awssdk.String("role_3"), RolePolicyList: []*iam.PolicyDetail{{PolicyName: awssdk.String("npolicy_2")}}, AttachedManagedPolicies: []*iam.AttachedPolicy{{PolicyName: awssdk.String("nmanaged_policy_2")}}},
+		{RoleId: awssdk.String("role_3"), Arn: awssdk.String("arn:aws:iam::123456789012:role/nrole_3"), RolePolicyList: []*iam.PolicyDetail{{PolicyName: awssdk.String("npolicy_2")}}, AttachedManagedPolicies: []*iam.AttachedPolicy{{PolicyName: awssdk.String("nmanaged_policy_2")}}},
 		{RoleId: awssdk.String("role_4"), RolePolicyList: []*iam.PolicyDetail{{PolicyName: awssdk.String("npolicy_4")}}},
 	}
 
@@ -175,11 +188,39 @@ func TestBuildAccessRdfGraph(t *testing.T) {
 		{SerialNumber: awssdk.String("mfa-device-2")},
 	}
 
+	keys := []*kms.KeyMetadata{
+		{KeyId: awssdk.String("key_1"), Arn: awssdk.String("arn:aws:kms:eu-west-1:123456789012:key/key_1"), Description: awssdk.String("backups"), KeyState: awssdk.String("Enabled"), Enabled: awssdk.Bool(true), CreationDate: awssdk.Time(now)},
+		{KeyId: awssdk.String("key_2"), KeyState: awssdk.String("PendingDeletion"), Enabled: awssdk.Bool(false)},
+	}
+	keyPolicy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:*","Resource":"*"},` +
+		`{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:role/nrole_3"]},"Action":["kms:Encrypt","kms:Decrypt"],"Resource":"*"}]}`
+	kmsMock := &mockKms{
+		keys:        keys,
+		aliases:     []*kms.AliasListEntry{{AliasName: awssdk.String("alias/backups"), TargetKeyId: awssdk.String("key_1")}, {AliasName: awssdk.String("alias/aws/s3")}},
+		grants:      map[string][]*kms.GrantListEntry{"key_1": {{GrantId: awssdk.String("grant_1"), GranteePrincipal: awssdk.String("arn:aws:iam::123456789012:role/nrole_1")}}},
+		keyPolicies: map[string]string{"key_1": keyPolicy},
+	}
+
+	organizationsMock := &mockOrganizations{
+		roots: []*organizations.Root{{Id: awssdk.String("r-1")}},
+		units: map[string][]*organizations.OrganizationalUnit{
+			"r-1":  {{Id: awssdk.String("ou-1"), Name: awssdk.String("prod"), Arn: awssdk.String("arn:aws:organizations::111111111111:ou/o-1/ou-1")}},
+			"ou-1": {{Id: awssdk.String("ou-2"), Name: awssdk.String("web")}},
+		},
+		accounts: map[string][]*organizations.Account{
+			"r-1":  {{Id: awssdk.String("111111111111"), Name: awssdk.String("master"), Email: awssdk.String("master@example.com"), Status: awssdk.String("ACTIVE"), JoinedTimestamp: awssdk.Time(now)}},
+			"ou-1": {{Id: awssdk.String("222222222222"), Name: awssdk.String("prod-db"), Status: awssdk.String("ACTIVE")}},
+			"ou-2": {{Id: awssdk.String("333333333333"), Name: awssdk.String("prod-web"), Status: awssdk.String("SUSPENDED")}},
+		},
+	}
+
 	mock := &mockIam{groupdetails: groups, userdetails: usersDetails, roledetails: roles, managedpolicydetails: managedPolicies, users: users, virtualmfadevices: mfaDevices}
 	access := Access{
-		IAMAPI:  mock,
-		region:  "eu-west-1",
-		fetcher: fetch.NewFetcher(awsfetch.BuildAccessFetchFuncs(awsfetch.NewConfig(mock))),
+		IAMAPI:           mock,
+		KMSAPI:           kmsMock,
+		OrganizationsAPI: organizationsMock,
+		region:           "eu-west-1",
+		fetcher:          fetch.NewFetcher(awsfetch.BuildAccessFetchFuncs(awsfetch.NewConfig(mock, kmsMock, organizationsMock))),
 	}
 
 	g, err := access.Fetch(context.Background())
@@ -188,7 +229,7 @@ func TestBuildAccessRdfGraph(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	resources, err := g.Find(cloud.NewQuery("policy", "group", "role", "user", cloud.MFADevice))
+	resources, err := g.Find(cloud.NewQuery("policy", "group", "role", "user", cloud.MFADevice, cloud.Key, cloud.Account, cloud.OrganizationalUnit))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -198,6 +239,9 @@ func TestBuildAccessRdfGraph(t *testing.T) {
 		if p, ok := res.Properties()[p.InlinePolicies].([]string); ok {
 			sort.Strings(p)
 		}
+		if p, ok := res.Properties()[p.Principals].([]string); ok {
+			sort.Strings(p)
+		}
 	}
 
 	expected := map[string]cloud.Resource{
@@ -208,9 +252,9 @@ func TestBuildAccessRdfGraph(t *testing.T) {
 		"group_2":          resourcetest.Group("group_2").Prop(p.Name, "ngroup_2").Prop(p.InlinePolicies, []string{"npolicy_1"}).Build(),
 		"group_3":          resourcetest.Group("group_3").Prop(p.Name, "ngroup_3").Prop(p.InlinePolicies, []string{"npolicy_2"}).Build(),
 		"group_4":          resourcetest.Group("group_4").Prop(p.Name, "ngroup_4").Prop(p.InlinePolicies, []string{"npolicy_4"}).Build(),
-		"role_1":           resourcetest.Role("role_1").Prop(p.InlinePolicies, []string{"npolicy_1"}).Prop(p.TrustPolicy, assumeRoleDoc).Build(),
+		"role_1":           resourcetest.Role("role_1").Prop(p.Arn, "arn:aws:iam::123456789012:role/nrole_1").Prop(p.InlinePolicies, []string{"npolicy_1"}).Prop(p.TrustPolicy, assumeRoleDoc).Build(),
 		"role_2":           resourcetest.Role("role_2").Prop(p.InlinePolicies, []string{"npolicy_1"}).Build(),
-		"role_3":           resourcetest.Role("role_3").Prop(p.InlinePolicies, []string{"npolicy_2"}).Build(),
+		"role_3":           resourcetest.Role("role_3").Prop(p.Arn, "arn:aws:iam::123456789012:role/nrole_3").Prop(p.InlinePolicies, []string{"npolicy_2"}).Build(),
 		"role_4":           resourcetest.Role("role_4").Prop(p.InlinePolicies, []string{"npolicy_4"}).Build(),
 		"usr_1":            resourcetest.User("usr_1").Prop(p.InlinePolicies, []string{"npolicy_1", "npolicy_2"}).Prop(p.PasswordLastUsed, time.Unix(1486139077, 0).UTC()).Build(),
 		"usr_2":            resourcetest.User("usr_2").Prop(p.InlinePolicies, []string{"npolicy_1"}).Build(),
@@ -225,9 +269,22 @@ func TestBuildAccessRdfGraph(t *testing.T) {
 		"usr_11":           resourcetest.User("usr_11").Build(),
 		"mfa-device-1":     resourcetest.MfaDevice("mfa-device-1").Prop(p.AttachedAt, now).Build(),
 		"mfa-device-2":     resourcetest.MfaDevice("mfa-device-2").Build(),
+		"key_1": resourcetest.Key("key_1").Prop(p.Arn, "arn:aws:kms:eu-west-1:123456789012:key/key_1").Prop(p.Name, "backups").Prop(p.Aliases, []string{"alias/backups"}).
+			Prop(p.Description, "backups").Prop(p.State, "Enabled").Prop(p.Enabled, true).Prop(p.Created, now).
+			Prop(p.Principals, []string{"arn:aws:iam::123456789012:role/nrole_1", "arn:aws:iam::123456789012:role/nrole_3", "arn:aws:iam::123456789012:root"}).Build(),
+		"key_2": resourcetest.Key("key_2").Prop(p.State, "PendingDeletion").Prop(p.Enabled, false).Build(),
+		"ou-1":  resourcetest.OrganizationalUnit("ou-1").Prop(p.Name, "prod").Prop(p.Arn, "arn:aws:organizations::111111111111:ou/o-1/ou-1").Prop(p.Parent, "r-1").Build(),
+		"ou-2":  resourcetest.OrganizationalUnit("ou-2").Prop(p.Name, "web").Prop(p.Parent, "ou-1").Build(),
+		"111111111111": resourcetest.Account("111111111111").Prop(p.Name, "master").Prop(p.Email, "master@example.com").Prop(p.State, "ACTIVE").
+			Prop(p.Joined, now).Prop(p.Parent, "r-1").Build(),
+		"222222222222": resourcetest.Account("222222222222").Prop(p.Name, "prod-db").Prop(p.State, "ACTIVE").Prop(p.Parent, "ou-1").Build(),
+		"333333333333": resourcetest.Account("333333333333").Prop(p.Name, "prod-web").Prop(p.State, "SUSPENDED").Prop(p.Parent, "ou-2").Build(),
 	}
 
-	expectedChildren := map[string][]string{}
+	expectedChildren := map[string][]string{
+		"ou-1": {"222222222222", "ou-2"},
+		"ou-2": {"333333333333"},
+	}
 
 	expectedAppliedOn := map[string][]string{
 		"group_1":          {"usr_1", "usr_2", "usr_3"},
@@ -237,6 +294,7 @@ func TestBuildAccessRdfGraph(t *testing.T) {
 		"managed_policy_2": {"group_2", "role_3", "usr_3"},
 		"managed_policy_3": {"group_3", "usr_6"},
 		"mfa-device-1":     {"usr_1"},
+		"key_1":            {"role_1", "role_3"},
 	}
 
 	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
@@ -247,7 +305,7 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 	instances := []*ec2.Instance{
 		{InstanceId: awssdk.String("inst_1"), SubnetId: awssdk.String("sub_1"), VpcId: awssdk.String("vpc_1"), Tags: []*ec2.Tag{{Key: awssdk.String("Name"), Value: awssdk.String("instance1-name")}}},
 		{InstanceId: awssdk.String("inst_2"), SubnetId: awssdk.String("sub_2"), VpcId: awssdk.String("vpc_1"), SecurityGroups: []*ec2.GroupIdentifier{{GroupId: awssdk.String("securitygroup_1")}}},
-		{InstanceId: awssdk.String("inst_3"), SubnetId: awssdk.String("sub_3"), VpcId: awssdk.String("vpc_2")},
+		{InstanceId: awssdk.String("inst_3"), SubnetId: awssdk.String("sub_3"), VpcId: awssdk.String("vpc_2"), Placement: &ec2.Placement{GroupName: awssdk.String("pg_1"), HostId: awssdk.String("h_1")}},
 		{InstanceId: awssdk.String("inst_4"), SubnetId: awssdk.String("sub_3"), VpcId: awssdk.String("vpc_2"), SecurityGroups: []*ec2.GroupIdentifier{{GroupId: awssdk.String("securitygroup_1")}, {GroupId: awssdk.String("securitygroup_2")}}, KeyName: awssdk.String("my_key")},
 		{InstanceId: awssdk.String("inst_5"), SubnetId: nil, VpcId: nil, KeyName: awssdk.String("unexisting_key")}, // terminated instance (no vpc, subnet ids)
 		{
@@ -311,7 +369,49 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 	}
 
 	natgws := []*ec2.NatGateway{
-		{NatGatewayId: awssdk.String("natgw_1"), VpcId: awssdk.String("vpc_1"), SubnetId: awssdk.String("sub_1")},
+		{NatGatewayId: awssdk.String("natgw_1"), VpcId: awssdk.String("vpc_1"), SubnetId: awssdk.String("sub_1"), NatGatewayAddresses: []*ec2.NatGatewayAddress{{AllocationId: awssdk.String("eipalloc_1")}}},
+	}
+
+	peerings := []*ec2.VpcPeeringConnection{
+		{VpcPeeringConnectionId: awssdk.String("pcx_1"), RequesterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{VpcId: awssdk.String("vpc_1")}, AccepterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{VpcId: awssdk.String("vpc_2"), OwnerId: awssdk.String("123456789012")}, Status: &ec2.VpcPeeringConnectionStateReason{Code: awssdk.String("active")}},
+	}
+
+	endpoints := []*ec2.VpcEndpoint{
+		{VpcEndpointId: awssdk.String("vpce_1"), VpcId: awssdk.String("vpc_1"), VpcEndpointType: awssdk.String("Gateway"), ServiceName: awssdk.String("com.amazonaws.eu-west-1.s3"), RouteTableIds: []*string{awssdk.String("rt_1")}},
+		{VpcEndpointId: awssdk.String("vpce_2"), VpcId: awssdk.String("vpc_2"), VpcEndpointType: awssdk.String("Interface"), SubnetIds: []*string{awssdk.String("sub_3")}, Groups: []*ec2.SecurityGroupIdentifier{{GroupId: awssdk.String("securitygroup_2")}}},
+	}
+
+	customerGateways := []*ec2.CustomerGateway{
+		{CustomerGatewayId: awssdk.String("cgw_1"), IpAddress: awssdk.String("203.0.113.12"), BgpAsn: awssdk.String("65000"), Type: awssdk.String("ipsec.1"), State: awssdk.String("available")},
+	}
+
+	vpnGateways := []*ec2.VpnGateway{
+		{VpnGatewayId: awssdk.String("vgw_1"), AmazonSideAsn: awssdk.Int64(64512), Type: awssdk.String("ipsec.1"), State: awssdk.String("available"), VpcAttachments: []*ec2.VpcAttachment{{VpcId: awssdk.String("vpc_1"), State: awssdk.String("attached")}}},
+	}
+
+	vpnConnections := []*ec2.VpnConnection{
+		{VpnConnectionId: awssdk.String("vpn_1"), CustomerGatewayId: awssdk.String("cgw_1"), VpnGatewayId: awssdk.String("vgw_1"), Type: awssdk.String("ipsec.1"), State: awssdk.String("available")},
+	}
+
+	dxConnections := []*directconnect.Connection{
+		{ConnectionId: awssdk.String("dxcon_1"), ConnectionName: awssdk.String("my_dx"), ConnectionState: awssdk.String("available"), Bandwidth: awssdk.String("1Gbps"), Location: awssdk.String("EqDC2"), OwnerAccount: awssdk.String("123456789012")},
+	}
+
+	virtualInterfaces := []*directconnect.VirtualInterface{
+		{VirtualInterfaceId: awssdk.String("dxvif_1"), VirtualInterfaceName: awssdk.String("my_vif"), VirtualInterfaceType: awssdk.String("private"), VirtualInterfaceState: awssdk.String("available"), ConnectionId: awssdk.String("dxcon_1"), VirtualGatewayId: awssdk.String("vgw_1"), Asn: awssdk.Int64(65000), Vlan: awssdk.Int64(101)},
+	}
+
+	transitGateways := []*ec2transitgateway.TransitGateway{
+		{TransitGatewayId: awssdk.String("tgw_1"), Description: awssdk.String("hub"), State: awssdk.String("available"), OwnerId: awssdk.String("123456789012"), Options: &ec2transitgateway.TransitGatewayOptions{AmazonSideAsn: awssdk.Int64(64512)}},
+	}
+
+	transitGatewayAttachments := []*ec2transitgateway.TransitGatewayAttachment{
+		{TransitGatewayAttachmentId: awssdk.String("tgw-attach_1"), TransitGatewayId: awssdk.String("tgw_1"), ResourceType: awssdk.String("vpc"), ResourceId: awssdk.String("vpc_1"), State: awssdk.String("available"), Association: &ec2transitgateway.TransitGatewayAttachmentAssociation{TransitGatewayRouteTableId: awssdk.String("tgw-rtb_1")}},
+		{TransitGatewayAttachmentId: awssdk.String("tgw-attach_2"), TransitGatewayId: awssdk.String("tgw_1"), ResourceType: awssdk.String("vpn"), ResourceId: awssdk.String("vpn_1"), State: awssdk.String("available")},
+	}
+
+	transitGatewayRouteTables := []*ec2transitgateway.TransitGatewayRouteTable{
+		{TransitGatewayRouteTableId: awssdk.String("tgw-rtb_1"), TransitGatewayId: awssdk.String("tgw_1"), DefaultAssociationRouteTable: awssdk.Bool(true), State: awssdk.String("available")},
 	}
 
 	routeTables := []*ec2.RouteTable{
@@ -325,11 +425,40 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		},
 	}
 
+	placementGroups := []*ec2.PlacementGroup{
+		{GroupName: awssdk.String("pg_1"), Strategy: awssdk.String("cluster"), State: awssdk.String("available")},
+	}
+
+	hosts := []*ec2.Host{
+		{HostId: awssdk.String("h_1"), AvailabilityZone: awssdk.String("us-west-1a"), State: awssdk.String("available"), AutoPlacement: awssdk.String("off"), HostReservationId: awssdk.String("hr_1"), HostProperties: &ec2.HostProperties{InstanceType: awssdk.String("c4.large")}, Instances: []*ec2.HostInstance{{InstanceId: awssdk.String("inst_3")}}},
+	}
+
+	networkACLs := []*ec2.NetworkAcl{
+		{
+			NetworkAclId: awssdk.String("acl_1"),
+			VpcId:        awssdk.String("vpc_1"),
+			IsDefault:    awssdk.Bool(false),
+			Associations: []*ec2.NetworkAclAssociation{
+				{NetworkAclId: awssdk.String("acl_1"), SubnetId: awssdk.String("sub_1"), NetworkAclAssociationId: awssdk.String("aclassoc_1")},
+			},
+			Entries: []*ec2.NetworkAclEntry{
+				{RuleNumber: awssdk.Int64(32767), RuleAction: awssdk.String("deny"), Protocol: awssdk.String("-1"), CidrBlock: awssdk.String("0.0.0.0/0"), Egress: awssdk.Bool(false)},
+				{RuleNumber: awssdk.Int64(100), RuleAction: awssdk.String("allow"), Protocol: awssdk.String("6"), CidrBlock: awssdk.String("10.20.0.0/16"), PortRange: &ec2.PortRange{From: awssdk.Int64(22), To: awssdk.Int64(22)}, Egress: awssdk.Bool(false)},
+				{RuleNumber: awssdk.Int64(100), RuleAction: awssdk.String("allow"), Protocol: awssdk.String("-1"), CidrBlock: awssdk.String("0.0.0.0/0"), Egress: awssdk.Bool(true)},
+			},
+		},
+	}
+
 	images := []*ec2.Image{
-		{ImageId: awssdk.String("img_1")},
+		{ImageId: awssdk.String("img_1"), BlockDeviceMappings: []*ec2.BlockDeviceMapping{{DeviceName: awssdk.String("/dev/xvda"), Ebs: &ec2.EbsBlockDevice{SnapshotId: awssdk.String("snap_1")}}, {DeviceName: awssdk.String("/dev/sdb"), VirtualName: awssdk.String("ephemeral0")}}},
 		{ImageId: awssdk.String("img_2"), Name: awssdk.String("img_2_name"), Architecture: awssdk.String("img_2_arch"), Hypervisor: awssdk.String("img_2_hyper"), CreationDate: awssdk.String("2010-04-01T12:05:01.000Z")},
 	}
 
+	addresses := []*ec2.Address{
+		{AllocationId: awssdk.String("eipalloc_1"), PublicIp: awssdk.String("1.1.1.1"), Domain: awssdk.String("vpc")},
+		{AllocationId: awssdk.String("eipalloc_2"), PublicIp: awssdk.String("2.2.2.2"), Domain: awssdk.String("vpc"), AssociationId: awssdk.String("eipassoc_2"), InstanceId: awssdk.String("inst_1"), NetworkInterfaceId: awssdk.String("eni-1"), PrivateIpAddress: awssdk.String("10.0.0.1")},
+	}
+
 	networkInterfaces := []*ec2.NetworkInterface{
 		{
 			Association:        &ec2.NetworkInterfaceAssociation{PublicIp: awssdk.String("1.2.3.4"), PublicDnsName: awssdk.String("my.ip.dns.name")},
@@ -347,6 +476,11 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 			Status:             awssdk.String("in-use"),
 			SubnetId:           awssdk.String("sub_1"),
 			VpcId:              awssdk.String("vpc_1"),
+			PrivateIpAddresses: []*ec2.NetworkInterfacePrivateIpAddress{
+				{PrivateIpAddress: awssdk.String("10.10.20.12"), Primary: awssdk.Bool(true)},
+				{PrivateIpAddress: awssdk.String("10.0.0.1"), Primary: awssdk.Bool(false)},
+				{PrivateIpAddress: awssdk.String("10.0.0.2"), Primary: awssdk.Bool(false)},
+			},
 		},
 		{
 			NetworkInterfaceId: awssdk.String("eni-2"),
@@ -377,6 +511,12 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		"tg_1": {{HealthCheckPort: awssdk.String("80"), Target: &elbv2.TargetDescription{Id: awssdk.String("inst_1"), Port: awssdk.Int64(443)}}},
 		"tg_2": {{Target: &elbv2.TargetDescription{Id: awssdk.String("inst_2"), Port: awssdk.Int64(80)}}, {Target: &elbv2.TargetDescription{Id: awssdk.String("inst_3"), Port: awssdk.Int64(80)}}},
 	}
+	classicLbs := []*elb.LoadBalancerDescription{
+		{LoadBalancerName: awssdk.String("classic_1"), VPCId: awssdk.String("vpc_1"), Subnets: []*string{awssdk.String("sub_1"), awssdk.String("sub_2")}, SecurityGroups: []*string{awssdk.String("securitygroup_1")},
+			Instances: []*elb.Instance{{InstanceId: awssdk.String("inst_1")}}, Scheme: awssdk.String("internet-facing"), DNSName: awssdk.String("classic-1.elb.amazonaws.com"), HealthCheck: &elb.HealthCheck{Target: awssdk.String("HTTP:8080/health")},
+			ListenerDescriptions: []*elb.ListenerDescription{{Listener: &elb.Listener{Protocol: awssdk.String("HTTP"), LoadBalancerPort: awssdk.Int64(80), InstanceProtocol: awssdk.String("HTTP"), InstancePort: awssdk.Int64(8080)}}}},
+		{LoadBalancerName: awssdk.String("classic_2"), VPCId: awssdk.String("vpc_2")},
+	}
 
 	//Autoscaling
 	launchConfigs := []*autoscaling.LaunchConfiguration{
@@ -434,6 +574,22 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 			Status:               awssdk.String("ACTIVE"),
 		},
 	}
+	services := map[string][]*ecs.Service{
+		"clust_1": {
+			{
+				ServiceArn:     awssdk.String("svc_1"),
+				ServiceName:    awssdk.String("container-service-1"),
+				ClusterArn:     awssdk.String("clust_1"),
+				TaskDefinition: awssdk.String("cs_2:1"),
+				DesiredCount:   awssdk.Int64(3),
+				RunningCount:   awssdk.Int64(2),
+				PendingCount:   awssdk.Int64(1),
+				LaunchType:     awssdk.String("FARGATE"),
+				Status:         awssdk.String("ACTIVE"),
+				LoadBalancers:  []*ecs.LoadBalancer{{TargetGroupArn: awssdk.String("tg_1"), ContainerName: awssdk.String("web"), ContainerPort: awssdk.Int64(80)}},
+			},
+		},
+	}
 	tasksNames := map[string][]*string{
 		"clust_1": {awssdk.String("task_1")},
 		"clust_2": {awssdk.String("task_2"), awssdk.String("task_3")},
@@ -548,29 +704,36 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		{CertificateArn: awssdk.String("arn:certif_3456"), DomainName: awssdk.String("domain-name.3")},
 	}
 
-	mock := &mockEc2{vpcs: vpcs, securitygroups: securityGroups, subnets: subnets, instances: instances, keypairinfos: keypairs, internetgateways: igws, routetables: routeTables, images: images, availabilityzones: availabilityZones, natgateways: natgws, networkinterfaces: networkInterfaces}
+	mock := &mockEc2{vpcs: vpcs, securitygroups: securityGroups, subnets: subnets, instances: instances, keypairinfos: keypairs, internetgateways: igws, routetables: routeTables, networkacls: networkACLs, images: images, availabilityzones: availabilityZones, natgateways: natgws, vpcpeeringconnections: peerings, vpcendpoints: endpoints, customergateways: customerGateways, vpngateways: vpnGateways, vpnconnections: vpnConnections, addresss: addresses, networkinterfaces: networkInterfaces, placementgroups: placementGroups, hosts: hosts}
 	mockLb := &mockElbv2{loadbalancers: lbPages, targetgroups: targetGroups, listeners: listeners, targethealthdescriptions: targetHealths}
+	mockClassicLb := &mockElb{loadbalancerdescriptions: classicLbs}
 	mockEcr := &mockEcr{repositorys: repositories}
-	mockEcs := &mockEcs{clusterNames: clusterNames, clusters: clusters, taskdefinitionNames: defNames, taskdefinitions: tasksDef, tasksNames: tasksNames, tasks: tasks, containerinstancesNames: containerInstancesNames, containerinstances: containerInstances}
+	mockEcs := &mockEcs{clusterNames: clusterNames, clusters: clusters, services: services, taskdefinitionNames: defNames, taskdefinitions: tasksDef, tasksNames: tasksNames, tasks: tasks, containerinstancesNames: containerInstancesNames, containerinstances: containerInstances}
 	mockRds := &mockRds{}
 	mockAcm := &mockAcm{certificatesummarys: certificates}
 	mockAutoscaling := &mockAutoscaling{launchconfigurations: launchConfigs, groups: scalingGroups}
+	mockDirectconnect := &mockDirectconnect{connections: dxConnections, virtualinterfaces: virtualInterfaces}
+	mockEc2transitgateway := &mockEc2transitgateway{transitgateways: transitGateways, transitgatewayattachments: transitGatewayAttachments, transitgatewayroutetables: transitGatewayRouteTables}
 	InfraService = &Infra{
 		EC2API:         mock,
 		ECRAPI:         mockEcr,
 		ECSAPI:         mockEcs,
 		ELBV2API:       mockLb,
+		ELBAPI:         mockClassicLb,
 		RDSAPI:         mockRds,
 		ACMAPI:         mockAcm,
 		AutoScalingAPI: mockAutoscaling,
+		DynamoDBAPI:    &mockDynamodb{},
+		ElastiCacheAPI: &mockElasticache{},
+		RedshiftAPI:    &mockRedshift{},
 		region:         "eu-west-1",
-		fetcher:        fetch.NewFetcher(awsfetch.BuildInfraFetchFuncs(awsfetch.NewConfig(mock, mockEcr, mockEcs, mockLb, mockRds, mockAutoscaling, mockAcm))),
+		fetcher:        fetch.NewFetcher(awsfetch.BuildInfraFetchFuncs(awsfetch.NewConfig(mock, mockEcr, mockEcs, mockLb, mockClassicLb, mockRds, mockAutoscaling, mockAcm, &mockDynamodb{}, &mockElasticache{}, &mockRedshift{}, &mockBatch{}, mockDirectconnect, mockEc2transitgateway))),
 	}
 	g, err := InfraService.Fetch(context.Background())
 	if err != nil {
 		t.Fatal(err)
 	}
-	resources, err := g.Find(cloud.NewQuery("region", "instance", "vpc", "securitygroup", "subnet", "keypair", "internetgateway", cloud.NatGateway, "routetable", "loadbalancer", "targetgroup", "listener", "launchconfiguration", "scalinggroup", "image", "availabilityzone", "repository", cloud.ContainerCluster, cloud.ContainerTask, cloud.Container, cloud.ContainerInstance, cloud.NetworkInterface, cloud.Certificate))
+	resources, err := g.Find(cloud.NewQuery("region", "instance", "vpc", "securitygroup", "subnet", "keypair", "internetgateway", cloud.NatGateway, cloud.ElasticIP, cloud.PeeringConnection, cloud.VpcEndpoint, cloud.CustomerGateway, cloud.VpnGateway, cloud.VpnConnection, cloud.DxConnection, cloud.VirtualInterface, cloud.TransitGateway, cloud.TransitGatewayAttachment, cloud.TransitGatewayRouteTable, "routetable", cloud.NetworkACL, "loadbalancer", "targetgroup", "listener", cloud.ClassicLoadBalancer, "launchconfiguration", "scalinggroup", "image", "availabilityzone", "repository", cloud.ContainerCluster, cloud.ContainerService, cloud.ContainerTask, cloud.Container, cloud.ContainerInstance, cloud.NetworkInterface, cloud.Certificate, cloud.PlacementGroup, cloud.Host))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -583,6 +746,12 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		if p, ok := res.Properties()[p.Vpcs].([]string); ok {
 			sort.Strings(p)
 		}
+		if p, ok := res.Properties()[p.Subnets].([]string); ok {
+			sort.Strings(p)
+		}
+		if p, ok := res.Properties()[p.SecondaryPrivateIPs].([]string); ok {
+			sort.Strings(p)
+		}
 		if p, ok := res.Properties()[p.Messages].([]string); ok {
 			sort.Strings(p)
 		}
@@ -618,13 +787,16 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		if p, ok := res.Properties()[p.IPv6Addresses].([]string); ok {
 			sort.Strings(p)
 		}
+		if p, ok := res.Properties()[p.InboundACLRules].([]*graph.NetworkACLRule); ok {
+			graph.NetworkACLRules(p).Sort()
+		}
 	}
 
 	expected := map[string]cloud.Resource{
 		"eu-west-1": resourcetest.Region("eu-west-1").Build(),
 		"inst_1":    resourcetest.Instance("inst_1").Prop(p.Subnet, "sub_1").Prop(p.Vpc, "vpc_1").Prop(p.Name, "instance1-name").Prop(p.Tags, []string{"Name=instance1-name"}).Build(),
 		"inst_2":    resourcetest.Instance("inst_2").Prop(p.Subnet, "sub_2").Prop(p.Vpc, "vpc_1").Prop(p.SecurityGroups, []string{"securitygroup_1"}).Build(),
-		"inst_3":    resourcetest.Instance("inst_3").Prop(p.Subnet, "sub_3").Prop(p.Vpc, "vpc_2").Build(),
+		"inst_3":    resourcetest.Instance("inst_3").Prop(p.Subnet, "sub_3").Prop(p.Vpc, "vpc_2").Prop(p.PlacementGroup, "pg_1").Prop(p.Host, "h_1").Build(),
 		"inst_4":    resourcetest.Instance("inst_4").Prop(p.Subnet, "sub_3").Prop(p.Vpc, "vpc_2").Prop(p.SecurityGroups, []string{"securitygroup_1", "securitygroup_2"}).Prop(p.KeyPair, "my_key").Build(),
 		"inst_5":    resourcetest.Instance("inst_5").Prop(p.KeyPair, "unexisting_key").Build(),
 		"inst_6": resourcetest.Instance("inst_6").Prop(p.Name, "inst_6_name").Prop(p.Tags, []string{"Name=inst_6_name"}).Prop(p.Type, "t2.micro").Prop(p.Subnet, "sub_3").Prop(p.Vpc, "vpc_2").Prop(p.PublicIP, "1.2.3.4").Prop(p.PrivateIP, "10.0.0.1").
@@ -636,20 +808,45 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		"securitygroup_1": resourcetest.SecurityGroup("securitygroup_1").Prop(p.Name, "my_securitygroup").Prop(p.Vpc, "vpc_1").
 			Prop(p.InboundRules, []*graph.FirewallRule{{PortRange: graph.PortRange{FromPort: 22, ToPort: 80, Any: false}, Protocol: "tcp", Sources: []string{"group_1", "group_2"}}}).
 			Prop(p.OutboundRules, []*graph.FirewallRule{{PortRange: graph.PortRange{FromPort: 0, ToPort: 65535, Any: false}, Protocol: "tcp", IPRanges: []*net.IPNet{{IP: net.IP{0xa, 0x14, 0x0, 0x0}, Mask: net.CIDRMask(16, 32)}}}}).Build(),
-		"securitygroup_2":  resourcetest.SecurityGroup("securitygroup_2").Prop(p.Vpc, "vpc_1").Build(),
-		"sub_1":            resourcetest.Subnet("sub_1").Prop(p.Vpc, "vpc_1").Build(),
-		"sub_2":            resourcetest.Subnet("sub_2").Prop(p.Vpc, "vpc_1").Build(),
-		"sub_3":            resourcetest.Subnet("sub_3").Prop(p.Vpc, "vpc_2").Build(),
-		"sub_4":            resourcetest.Subnet("sub_4").Build(),
-		"us-west-1a":       resourcetest.AvailabilityZone("us-west-1a").Prop(p.Name, "us-west-1a").Prop(p.State, "available").Prop(p.Region, "us-west-1").Prop(p.Messages, []string{"msg 1", "msg 2"}).Build(),
-		"us-west-1b":       resourcetest.AvailabilityZone("us-west-1b").Prop(p.Name, "us-west-1b").Build(),
-		"my_key":           resourcetest.KeyPair("my_key").Build(),
-		"igw_1":            resourcetest.InternetGw("igw_1").Prop(p.Vpcs, []string{"vpc_2"}).Build(),
-		"natgw_1":          resourcetest.NatGw("natgw_1").Prop(p.Vpc, "vpc_1").Prop(p.Subnet, "sub_1").Build(),
-		"rt_1":             resourcetest.RouteTable("rt_1").Prop(p.Vpc, "vpc_1").Prop(p.Default, true).Prop(p.Associations, []*graph.KeyValue{{KeyName: "assoc_1", Value: "sub_1"}, {KeyName: "assoc_2", Value: "sub_2"}}).Build(),
-		"lb_1":             resourcetest.LoadBalancer("lb_1").Prop(p.Arn, "lb_1").Prop(p.Name, "my_loadbalancer").Prop(p.Vpc, "vpc_1").Build(),
-		"lb_2":             resourcetest.LoadBalancer("lb_2").Prop(p.Arn, "lb_2").Prop(p.Vpc, "vpc_2").Build(),
-		"lb_3":             resourcetest.LoadBalancer("lb_3").Prop(p.Arn, "lb_3").Prop(p.Vpc, "vpc_1").Build(),
+		"securitygroup_2": resourcetest.SecurityGroup("securitygroup_2").Prop(p.Vpc, "vpc_1").Build(),
+		"sub_1":           resourcetest.Subnet("sub_1").Prop(p.Vpc, "vpc_1").Build(),
+		"sub_2":           resourcetest.Subnet("sub_2").Prop(p.Vpc, "vpc_1").Build(),
+		"sub_3":           resourcetest.Subnet("sub_3").Prop(p.Vpc, "vpc_2").Build(),
+		"sub_4":           resourcetest.Subnet("sub_4").Build(),
+		"us-west-1a":      resourcetest.AvailabilityZone("us-west-1a").Prop(p.Name, "us-west-1a").Prop(p.State, "available").Prop(p.Region, "us-west-1").Prop(p.Messages, []string{"msg 1", "msg 2"}).Build(),
+		"us-west-1b":      resourcetest.AvailabilityZone("us-west-1b").Prop(p.Name, "us-west-1b").Build(),
+		"my_key":          resourcetest.KeyPair("my_key").Build(),
+		"igw_1":           resourcetest.InternetGw("igw_1").Prop(p.Vpcs, []string{"vpc_2"}).Build(),
+		"natgw_1":         resourcetest.NatGw("natgw_1").Prop(p.Vpc, "vpc_1").Prop(p.Subnet, "sub_1").Build(),
+		"eipalloc_1":      resourcetest.ElasticIP("eipalloc_1").Prop(p.Name, "1.1.1.1").Prop(p.PublicIP, "1.1.1.1").Prop(p.Domain, "vpc").Build(),
+		"eipalloc_2":      resourcetest.ElasticIP("eipalloc_2").Prop(p.Name, "2.2.2.2").Prop(p.PublicIP, "2.2.2.2").Prop(p.Domain, "vpc").Prop(p.Association, "eipassoc_2").Prop(p.Instance, "inst_1").Prop(p.NetworkInterface, "eni-1").Prop(p.PrivateIP, "10.0.0.1").Build(),
+		"pcx_1":           resourcetest.PeeringConnection("pcx_1").Prop(p.Vpc, "vpc_1").Prop(p.PeerVpc, "vpc_2").Prop(p.PeerOwner, "123456789012").Prop(p.State, "active").Build(),
+		"vpce_1":          resourcetest.VpcEndpoint("vpce_1").Prop(p.Vpc, "vpc_1").Prop(p.Type, "Gateway").Prop(p.Service, "com.amazonaws.eu-west-1.s3").Prop(p.RouteTables, []string{"rt_1"}).Build(),
+		"vpce_2":          resourcetest.VpcEndpoint("vpce_2").Prop(p.Vpc, "vpc_2").Prop(p.Type, "Interface").Prop(p.Subnets, []string{"sub_3"}).Prop(p.SecurityGroups, []string{"securitygroup_2"}).Build(),
+		"cgw_1":           resourcetest.CustomerGateway("cgw_1").Prop(p.PublicIP, "203.0.113.12").Prop(p.ASN, "65000").Prop(p.Type, "ipsec.1").Prop(p.State, "available").Build(),
+		"vgw_1":           resourcetest.VpnGateway("vgw_1").Prop(p.ASN, "64512").Prop(p.Type, "ipsec.1").Prop(p.State, "available").Prop(p.Vpcs, []string{"vpc_1"}).Build(),
+		"vpn_1":           resourcetest.VpnConnection("vpn_1").Prop(p.CustomerGateway, "cgw_1").Prop(p.VpnGateway, "vgw_1").Prop(p.Type, "ipsec.1").Prop(p.State, "available").Build(),
+		"dxcon_1":         resourcetest.DxConnection("dxcon_1").Prop(p.Name, "my_dx").Prop(p.State, "available").Prop(p.Bandwidth, "1Gbps").Prop(p.Location, "EqDC2").Prop(p.Owner, "123456789012").Build(),
+		"dxvif_1":         resourcetest.VirtualInterface("dxvif_1").Prop(p.Name, "my_vif").Prop(p.Type, "private").Prop(p.State, "available").Prop(p.Connection, "dxcon_1").Prop(p.VpnGateway, "vgw_1").Prop(p.ASN, "65000").Prop(p.VLAN, 101).Build(),
+		"tgw_1":           resourcetest.TransitGateway("tgw_1").Prop(p.Description, "hub").Prop(p.ASN, "64512").Prop(p.State, "available").Prop(p.Owner, "123456789012").Build(),
+		"tgw-attach_1":    resourcetest.TransitGatewayAttachment("tgw-attach_1").Prop(p.TransitGateway, "tgw_1").Prop(p.RouteTable, "tgw-rtb_1").Prop(p.Type, "vpc").Prop(p.State, "available").Build(),
+		"tgw-attach_2":    resourcetest.TransitGatewayAttachment("tgw-attach_2").Prop(p.TransitGateway, "tgw_1").Prop(p.Type, "vpn").Prop(p.State, "available").Build(),
+		"tgw-rtb_1":       resourcetest.TransitGatewayRouteTable("tgw-rtb_1").Prop(p.TransitGateway, "tgw_1").Prop(p.Default, true).Prop(p.State, "available").Build(),
+		"rt_1":            resourcetest.RouteTable("rt_1").Prop(p.Vpc, "vpc_1").Prop(p.Default, true).Prop(p.Associations, []*graph.KeyValue{{KeyName: "assoc_1", Value: "sub_1"}, {KeyName: "assoc_2", Value: "sub_2"}}).Build(),
+		"lb_1":            resourcetest.LoadBalancer("lb_1").Prop(p.Arn, "lb_1").Prop(p.Name, "my_loadbalancer").Prop(p.Vpc, "vpc_1").Build(),
+		"lb_2":            resourcetest.LoadBalancer("lb_2").Prop(p.Arn, "lb_2").Prop(p.Vpc, "vpc_2").Build(),
+		"lb_3":            resourcetest.LoadBalancer("lb_3").Prop(p.Arn, "lb_3").Prop(p.Vpc, "vpc_1").Build(),
+		"pg_1":            resourcetest.PlacementGroup("pg_1").Prop(p.Name, "pg_1").Prop(p.Strategy, "cluster").Prop(p.State, "available").Build(),
+		"h_1":             resourcetest.Host("h_1").Prop(p.Type, "c4.large").Prop(p.AvailabilityZone, "us-west-1a").Prop(p.State, "available").Prop(p.AutoPlacement, "off").Prop(p.Instances, []string{"inst_3"}).Prop(p.HostReservation, "hr_1").Build(),
+		"acl_1": resourcetest.NetworkACL("acl_1").Prop(p.Vpc, "vpc_1").Prop(p.Default, false).Prop(p.Associations, []*graph.KeyValue{{KeyName: "aclassoc_1", Value: "sub_1"}}).
+			Prop(p.InboundACLRules, []*graph.NetworkACLRule{
+				{Number: 100, Action: "allow", Protocol: "tcp", PortRange: graph.PortRange{FromPort: 22, ToPort: 22}, IPRange: &net.IPNet{IP: net.IP{0xa, 0x14, 0x0, 0x0}, Mask: net.CIDRMask(16, 32)}},
+				{Number: 32767, Action: "deny", Protocol: "any", PortRange: graph.PortRange{Any: true}, IPRange: &net.IPNet{IP: net.IP{0x0, 0x0, 0x0, 0x0}, Mask: net.CIDRMask(0, 32)}},
+			}).
+			Prop(p.OutboundACLRules, []*graph.NetworkACLRule{{Number: 100, Action: "allow", Protocol: "any", PortRange: graph.PortRange{Any: true}, IPRange: &net.IPNet{IP: net.IP{0x0, 0x0, 0x0, 0x0}, Mask: net.CIDRMask(0, 32)}}}).Build(),
+		"classic_1": resourcetest.ClassicLoadBalancer("classic_1").Prop(p.Name, "classic_1").Prop(p.Vpc, "vpc_1").Prop(p.Subnets, []string{"sub_1", "sub_2"}).Prop(p.SecurityGroups, []string{"securitygroup_1"}).
+			Prop(p.Instances, []string{"inst_1"}).Prop(p.Scheme, "internet-facing").Prop(p.PublicDNS, "classic-1.elb.amazonaws.com").Prop(p.HealthCheck, "HTTP:8080/health").Prop(p.Listeners, []string{"HTTP:80->HTTP:8080"}).Build(),
+		"classic_2":        resourcetest.ClassicLoadBalancer("classic_2").Prop(p.Name, "classic_2").Prop(p.Vpc, "vpc_2").Build(),
 		"tg_1":             resourcetest.TargetGroup("tg_1").Prop(p.Arn, "tg_1").Prop(p.Vpc, "vpc_1").Build(),
 		"tg_2":             resourcetest.TargetGroup("tg_2").Prop(p.Arn, "tg_2").Prop(p.Vpc, "vpc_2").Build(),
 		"list_1":           resourcetest.Listener("list_1").Prop(p.Arn, "list_1").Prop(p.LoadBalancer, "lb_1").Build(),
@@ -667,6 +864,8 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		"clust_1":          resourcetest.ContainerCluster("clust_1").Prop(p.Arn, "clust_1").Prop(p.Name, "my_cust_1").Prop(p.PendingTasksCount, 1).Prop(p.ActiveServicesCount, 3).Prop(p.RegisteredContainerInstancesCount, 3).Prop(p.RunningTasksCount, 2).Prop(p.State, "ACTIVE").Build(),
 		"clust_2":          resourcetest.ContainerCluster("clust_2").Prop(p.Arn, "clust_2").Build(),
 		"clust_3":          resourcetest.ContainerCluster("clust_3").Prop(p.Arn, "clust_3").Prop(p.Name, "my_cust_3").Build(),
+		"svc_1": resourcetest.ContainerService("svc_1").Prop(p.Arn, "svc_1").Prop(p.Name, "container-service-1").Prop(p.Cluster, "clust_1").Prop(p.ContainerTask, "cs_2:1").Prop(p.DesiredCount, 3).Prop(p.RunningTasksCount, 2).
+			Prop(p.PendingTasksCount, 1).Prop(p.LaunchType, "FARGATE").Prop(p.State, "ACTIVE").Prop(p.TargetGroups, []string{"tg_1"}).Build(),
 		"cs_1:1": resourcetest.ContainerTask("cs_1:1").Prop(p.Arn, "cs_1:1").Prop(p.ContainersImages, []*graph.KeyValue{{"cont_name_1", "image_1"}, {"cont_name_2", "image_2"}, {"cont_name_3", "image_3"}}).Prop(p.Name, "cs_1").Prop(p.Version, "1").
 			Prop(p.State, "1 task running").Prop(p.Role, "role:arn").Prop(p.Deployments, []*graph.KeyValue{{"clust_2", "cs_1 (running task)"}}).Build(),
 		"cs_2:1": resourcetest.ContainerTask("cs_2:1").Prop(p.Arn, "cs_2:1").Prop(p.Name, "cs_2").Prop(p.State, "1 service running").Prop(p.Version, "1").Prop(p.Deployments, []*graph.KeyValue{{"clust_1", "container-service-1 (running service)"}}).Build(),
@@ -684,7 +883,7 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		"cont_inst_3": resourcetest.ContainerInstance("cont_inst_3").Prop(p.Arn, "cont_inst_3").Prop(p.Instance, "inst_1").Prop(p.Cluster, "clust_2").Build(),
 		"eni-1": resourcetest.NetworkInterface("eni-1").Prop(p.PublicIP, "1.2.3.4").Prop(p.PublicDNS, "my.ip.dns.name").Prop(p.Attachment, "eni-attach-12345").Prop(p.Instance, "inst_1").Prop(p.InstanceOwner, "12345678").
 			Prop(p.AvailabilityZone, "us-west-1b").Prop(p.Description, "my network interface description").Prop(p.SecurityGroups, []string{"securitygroup_1", "securitygroup_2"}).Prop(p.Type, "type").Prop(p.IPv6Addresses, []string{"ab:cd:ef::", "cd:ef:ab::"}).Prop(p.MACAddress, "01:23:34:56:78:9a").
-			Prop(p.Owner, "12345678").Prop(p.PrivateDNS, "my.private.dns.name").Prop(p.PrivateIP, "10.10.20.12").Prop(p.State, "in-use").Prop(p.Subnet, "sub_1").Prop(p.Vpc, "vpc_1").Build(),
+			Prop(p.Owner, "12345678").Prop(p.PrivateDNS, "my.private.dns.name").Prop(p.PrivateIP, "10.10.20.12").Prop(p.SecondaryPrivateIPs, []string{"10.0.0.1", "10.0.0.2"}).Prop(p.State, "in-use").Prop(p.Subnet, "sub_1").Prop(p.Vpc, "vpc_1").Build(),
 		"eni-2":           resourcetest.NetworkInterface("eni-2").Prop(p.Subnet, "sub_3").Prop(p.Vpc, "vpc_2").Build(),
 		"arn:certif_1234": resourcetest.Certificate("arn:certif_1234").Prop(p.Arn, "arn:certif_1234").Prop(p.Name, "domain-name.1").Build(),
 		"arn:certif_2345": resourcetest.Certificate("arn:certif_2345").Prop(p.Arn, "arn:certif_2345").Prop(p.Name, "domain-name.2").Build(),
@@ -692,29 +891,46 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 	}
 
 	expectedChildren := map[string][]string{
-		"eu-west-1": {"arn:certif_1234", "arn:certif_2345", "arn:certif_3456", "asg_arn_1", "asg_arn_2", "clust_1", "clust_2", "clust_3", "cs_1:1", "cs_2:1", "cs_2:2", "cs_3:1", "igw_1", "img_1", "img_2", "launchconfig_arn", "my_key", "natgw_1", "repo_1", "repo_2", "repo_3", "us-west-1a", "us-west-1b", "vpc_1", "vpc_2"},
+		"eu-west-1": {"arn:certif_1234", "arn:certif_2345", "arn:certif_3456", "asg_arn_1", "asg_arn_2", "cgw_1", "clust_1", "clust_2", "clust_3", "cs_1:1", "cs_2:1", "cs_2:2", "cs_3:1", "dxcon_1", "eipalloc_1", "eipalloc_2", "h_1", "igw_1", "img_1", "img_2", "launchconfig_arn", "my_key", "natgw_1", "pcx_1", "pg_1", "repo_1", "repo_2", "repo_3", "tgw_1", "us-west-1a", "us-west-1b", "vgw_1", "vpc_1", "vpc_2", "vpn_1"},
+		"dxcon_1":   {"dxvif_1"},
+		"tgw_1":     {"tgw-attach_1", "tgw-attach_2", "tgw-rtb_1"},
 		"lb_1":      {"list_1", "list_1.2"},
 		"lb_2":      {"list_2"},
 		"lb_3":      {"list_3"},
 		"sub_1":     {"eni-1", "inst_1"},
 		"sub_2":     {"inst_2"},
 		"sub_3":     {"eni-2", "inst_3", "inst_4", "inst_6"},
-		"vpc_1":     {"lb_1", "lb_3", "natgw_1", "rt_1", "securitygroup_1", "securitygroup_2", "sub_1", "sub_2", "tg_1"},
-		"vpc_2":     {"lb_2", "sub_3", "tg_2"},
-		"clust_1":   {"cont_inst_1", "cont_inst_2", "container_1", "container_2", "container_3"},
+		"vpc_1":     {"acl_1", "classic_1", "lb_1", "lb_3", "natgw_1", "rt_1", "securitygroup_1", "securitygroup_2", "sub_1", "sub_2", "tg_1", "vpce_1"},
+		"vpc_2":     {"classic_2", "lb_2", "sub_3", "tg_2", "vpce_2"},
+		"clust_1":   {"cont_inst_1", "cont_inst_2", "container_1", "container_2", "container_3", "svc_1"},
 		"clust_2":   {"cont_inst_3", "container_4", "container_5"},
 	}
 
 	expectedAppliedOn := map[string][]string{
+		"classic_1":       {"inst_1", "sub_1", "sub_2"},
 		"igw_1":           {"vpc_2"},
 		"lb_1":            {"tg_1"},
 		"lb_2":            {"tg_2"},
 		"lb_3":            {"tg_1"},
 		"my_key":          {"inst_4", "inst_6", "launchconfig_arn"},
 		"natgw_1":         {"sub_1"},
+		"eipalloc_1":      {"natgw_1"},
+		"eipalloc_2":      {"eni-1", "inst_1"},
+		"pcx_1":           {"vpc_1", "vpc_2"},
+		"vpce_1":          {"rt_1"},
+		"vpce_2":          {"sub_3"},
+		"vgw_1":           {"vpc_1"},
+		"vpn_1":           {"cgw_1", "vgw_1"},
+		"dxvif_1":         {"vgw_1"},
+		"tgw-attach_1":    {"vpc_1"},
+		"tgw-attach_2":    {"vpn_1"},
+		"tgw-rtb_1":       {"tgw-attach_1"},
 		"rt_1":            {"sub_1", "sub_2"},
-		"securitygroup_1": {"eni-1", "inst_2", "inst_4", "inst_6", "lb_3"},
-		"securitygroup_2": {"eni-1", "inst_4", "lb_3"},
+		"acl_1":           {"sub_1"},
+		"pg_1":            {"inst_3"},
+		"h_1":             {"inst_3"},
+		"securitygroup_1": {"classic_1", "eni-1", "inst_2", "inst_4", "inst_6", "lb_3"},
+		"securitygroup_2": {"eni-1", "inst_4", "lb_3", "vpce_2"},
 		"tg_1":            {"inst_1"},
 		"tg_2":            {"inst_2", "inst_3"},
 		"asg_arn_1":       {"inst_1", "inst_3", "sub_1", "sub_2"},
@@ -729,8 +945,412 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		"cont_inst_2":     {"container_4"},
 		"cont_inst_3":     {"container_5"},
 		"eni-1":           {"inst_1"},
+		"svc_1":           {"cs_2:1", "tg_1"},
+	}
+
+	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
+}
+
+func TestBuildDatabaseRdfGraph(t *testing.T) {
+	dbSubnets := []*rds.Subnet{{SubnetIdentifier: awssdk.String("sub_1")}, {SubnetIdentifier: awssdk.String("sub_2")}}
+	mockRds := &mockRds{
+		dbinstances: []*rds.DBInstance{
+			{
+				DBInstanceIdentifier: awssdk.String("db_1"),
+				AvailabilityZone:     awssdk.String("eu-west-1a"),
+				DBParameterGroups:    []*rds.DBParameterGroupStatus{{DBParameterGroupName: awssdk.String("params_1")}},
+				DBSubnetGroup:        &rds.DBSubnetGroup{DBSubnetGroupName: awssdk.String("subgroup_1"), VpcId: awssdk.String("vpc_1"), Subnets: dbSubnets},
+			},
+			{DBInstanceIdentifier: awssdk.String("db_2")},
+		},
+		dbsubnetgroups: []*rds.DBSubnetGroup{
+			{DBSubnetGroupArn: awssdk.String("subgroup_1_arn"), DBSubnetGroupName: awssdk.String("subgroup_1"), VpcId: awssdk.String("vpc_1"), Subnets: dbSubnets},
+		},
+		dbparametergroups: []*rds.DBParameterGroup{
+			{DBParameterGroupName: awssdk.String("params_1"), DBParameterGroupArn: awssdk.String("params_1_arn"), DBParameterGroupFamily: awssdk.String("postgres9.6"), Description: awssdk.String("my params")},
+		},
+	}
+	mockEc2 := &mockEc2{
+		vpcs:    []*ec2.Vpc{{VpcId: awssdk.String("vpc_1")}},
+		subnets: []*ec2.Subnet{{SubnetId: awssdk.String("sub_1"), VpcId: awssdk.String("vpc_1")}, {SubnetId: awssdk.String("sub_2"), VpcId: awssdk.String("vpc_1")}},
+	}
+	infra := Infra{
+		EC2API:         mockEc2,
+		ELBV2API:       &mockElbv2{},
+		ELBAPI:         &mockElb{},
+		RDSAPI:         mockRds,
+		AutoScalingAPI: &mockAutoscaling{},
+		ECRAPI:         &mockEcr{},
+		ECSAPI:         &mockEcs{},
+		ACMAPI:         &mockAcm{},
+		DynamoDBAPI:    &mockDynamodb{},
+		ElastiCacheAPI: &mockElasticache{},
+		RedshiftAPI:    &mockRedshift{},
+		region:         "eu-west-1",
+		fetcher: fetch.NewFetcher(awsfetch.BuildInfraFetchFuncs(awsfetch.NewConfig(
+			mockEc2, &mockElbv2{}, &mockElb{}, mockRds, &mockEcr{}, &mockEcs{}, &mockAutoscaling{}, &mockAcm{}, &mockDynamodb{}, &mockElasticache{}, &mockRedshift{}, &mockBatch{}, &mockDirectconnect{}, &mockEc2transitgateway{},
+		))),
+	}
+
+	g, err := infra.Fetch(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	resources, err := g.Find(cloud.NewQuery(cloud.Region, cloud.Vpc, cloud.Subnet, cloud.Database, cloud.DbSubnetGroup, cloud.DbParameterGroup))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, res := range resources {
+		if p, ok := res.Properties()[p.Subnets].([]string); ok {
+			sort.Strings(p)
+		}
+	}
+
+	expected := map[string]cloud.Resource{
+		"eu-west-1": resourcetest.Region("eu-west-1").Build(),
+		"vpc_1":     resourcetest.VPC("vpc_1").Build(),
+		"sub_1":     resourcetest.Subnet("sub_1").Prop(p.Vpc, "vpc_1").Build(),
+		"sub_2":     resourcetest.Subnet("sub_2").Prop(p.Vpc, "vpc_1").Build(),
+		"db_1":      resourcetest.Database("db_1").Prop(p.AvailabilityZone, "eu-west-1a").Prop(p.ParameterGroups, []string{"params_1"}).Prop(p.DBSubnetGroup, "subgroup_1").Build(),
+		"db_2":      resourcetest.Database("db_2").Build(),
+		"subgroup_1_arn": resourcetest.DbSubnetGroup("subgroup_1_arn").Prop(p.Arn, "subgroup_1_arn").Prop(p.Name, "subgroup_1").Prop(p.Vpc, "vpc_1").
+			Prop(p.Subnets, []string{"sub_1", "sub_2"}).Build(),
+		"params_1": resourcetest.DbParameterGroup("params_1").Prop(p.Name, "params_1").Prop(p.Arn, "params_1_arn").Prop(p.Family, "postgres9.6").Prop(p.Description, "my params").Build(),
+	}
+	expectedChildren := map[string][]string{
+		"eu-west-1": {"params_1", "vpc_1"},
+		"vpc_1":     {"sub_1", "sub_2", "subgroup_1_arn"},
+	}
+	expectedAppliedOn := map[string][]string{
+		"params_1":       {"db_1"},
+		"db_1":           {"sub_1", "sub_2"},
+		"subgroup_1_arn": {"sub_1", "sub_2"},
+	}
+	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
+
+}
+
+func TestBuildTableRdfGraph(t *testing.T) {
+	created := time.Date(2017, 3, 10, 19, 15, 30, 0, time.UTC)
+	mockDynamodb := &mockDynamodb{
+		tabledescriptions: []*dynamodb.TableDescription{
+			{
+				TableName:             awssdk.String("users"),
+				TableArn:              awssdk.String("arn:aws:dynamodb:eu-west-1:0123456789:table/users"),
+				TableStatus:           awssdk.String("ACTIVE"),
+				CreationDateTime:      awssdk.Time(created),
+				TableSizeBytes:        awssdk.Int64(2048),
+				ItemCount:             awssdk.Int64(12),
+				ProvisionedThroughput: &dynamodb.ProvisionedThroughputDescription{ReadCapacityUnits: awssdk.Int64(10), WriteCapacityUnits: awssdk.Int64(5)},
+				KeySchema: []*dynamodb.KeySchemaElement{
+					{AttributeName: awssdk.String("id"), KeyType: awssdk.String("HASH")},
+					{AttributeName: awssdk.String("date"), KeyType: awssdk.String("RANGE")},
+				},
+			},
+			{TableName: awssdk.String("events"), KeySchema: []*dynamodb.KeySchemaElement{{AttributeName: awssdk.String("key"), KeyType: awssdk.String("HASH")}}},
+		},
+	}
+	infra := Infra{
+		EC2API:         &mockEc2{},
+		ELBV2API:       &mockElbv2{},
+		ELBAPI:         &mockElb{},
+		RDSAPI:         &mockRds{},
+		AutoScalingAPI: &mockAutoscaling{},
+		ECRAPI:         &mockEcr{},
+		ECSAPI:         &mockEcs{},
+		ACMAPI:         &mockAcm{},
+		DynamoDBAPI:    mockDynamodb,
+		ElastiCacheAPI: &mockElasticache{},
+		RedshiftAPI:    &mockRedshift{},
+		region:         "eu-west-1",
+		fetcher: fetch.NewFetcher(awsfetch.BuildInfraFetchFuncs(awsfetch.NewConfig(
+			&mockEc2{}, &mockElbv2{}, &mockElb{}, &mockRds{}, &mockEcr{}, &mockEcs{}, &mockAutoscaling{}, &mockAcm{}, mockDynamodb, &mockElasticache{}, &mockRedshift{}, &mockBatch{}, &mockDirectconnect{}, &mockEc2transitgateway{},
+		))),
+	}
+
+	g, err := infra.Fetch(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	resources, err := g.Find(cloud.NewQuery(cloud.Region, cloud.Table))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]cloud.Resource{
+		"eu-west-1": resourcetest.Region("eu-west-1").Build(),
+		"users": resourcetest.Table("users").Prop(p.Name, "users").Prop(p.Arn, "arn:aws:dynamodb:eu-west-1:0123456789:table/users").Prop(p.State, "ACTIVE").
+			Prop(p.Created, created).Prop(p.Size, 2048).Prop(p.ItemCount, 12).Prop(p.ReadCapacity, 10).Prop(p.WriteCapacity, 5).
+			Prop(p.HashKey, "id").Prop(p.RangeKey, "date").Build(),
+		"events": resourcetest.Table("events").Prop(p.Name, "events").Prop(p.HashKey, "key").Build(),
+	}
+	expectedChildren := map[string][]string{
+		"eu-west-1": {"events", "users"},
+	}
+	compareResources(t, g, resources, expected, expectedChildren, nil)
+}
+
+func TestBuildCacheRdfGraph(t *testing.T) {
+	created := time.Date(2017, 3, 10, 19, 15, 30, 0, time.UTC)
+	mockElasticache := &mockElasticache{
+		cacheclusters: []*elasticache.CacheCluster{
+			{
+				CacheClusterId:            awssdk.String("cache_1"),
+				CacheClusterStatus:        awssdk.String("available"),
+				CacheClusterCreateTime:    awssdk.Time(created),
+				Engine:                    awssdk.String("redis"),
+				EngineVersion:             awssdk.String("3.2.10"),
+				CacheNodeType:             awssdk.String("cache.t2.micro"),
+				NumCacheNodes:             awssdk.Int64(1),
+				PreferredAvailabilityZone: awssdk.String("eu-west-1a"),
+				CacheSubnetGroupName:      awssdk.String("subgroup_1"),
+				SecurityGroups:            []*elasticache.SecurityGroupMembership{{SecurityGroupId: awssdk.String("sg_1"), Status: awssdk.String("active")}},
+			},
+			{
+				CacheClusterId:        awssdk.String("cache_2"),
+				Engine:                awssdk.String("memcached"),
+				NumCacheNodes:         awssdk.Int64(3),
+				ConfigurationEndpoint: &elasticache.Endpoint{Address: awssdk.String("cache-2.cfg.euw1.cache.amazonaws.com"), Port: awssdk.Int64(11211)},
+			},
+		},
+		cachesubnetgroups: []*elasticache.CacheSubnetGroup{
+			{
+				CacheSubnetGroupName:        awssdk.String("subgroup_1"),
+				CacheSubnetGroupDescription: awssdk.String("my cache subnets"),
+				VpcId:                       awssdk.String("vpc_1"),
+				Subnets:                     []*elasticache.Subnet{{SubnetIdentifier: awssdk.String("sub_1")}, {SubnetIdentifier: awssdk.String("sub_2")}},
+			},
+		},
+	}
+	mockEc2 := &mockEc2{
+		vpcs:           []*ec2.Vpc{{VpcId: awssdk.String("vpc_1")}},
+		subnets:        []*ec2.Subnet{{SubnetId: awssdk.String("sub_1"), VpcId: awssdk.String("vpc_1")}, {SubnetId: awssdk.String("sub_2"), VpcId: awssdk.String("vpc_1")}},
+		securitygroups: []*ec2.SecurityGroup{{GroupId: awssdk.String("sg_1"), VpcId: awssdk.String("vpc_1")}},
+	}
+	infra := Infra{
+		EC2API:         mockEc2,
+		ELBV2API:       &mockElbv2{},
+		ELBAPI:         &mockElb{},
+		RDSAPI:         &mockRds{},
+		AutoScalingAPI: &mockAutoscaling{},
+		ECRAPI:         &mockEcr{},
+		ECSAPI:         &mockEcs{},
+		ACMAPI:         &mockAcm{},
+		DynamoDBAPI:    &mockDynamodb{},
+		ElastiCacheAPI: mockElasticache,
+		RedshiftAPI:    &mockRedshift{},
+		region:         "eu-west-1",
+		fetcher: fetch.NewFetcher(awsfetch.BuildInfraFetchFuncs(awsfetch.NewConfig(
+			mockEc2, &mockElbv2{}, &mockElb{}, &mockRds{}, &mockEcr{}, &mockEcs{}, &mockAutoscaling{}, &mockAcm{}, &mockDynamodb{}, mockElasticache, &mockRedshift{}, &mockBatch{}, &mockDirectconnect{}, &mockEc2transitgateway{},
+		))),
+	}
+
+	g, err := infra.Fetch(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	resources, err := g.Find(cloud.NewQuery(cloud.Region, cloud.Vpc, cloud.Subnet, cloud.SecurityGroup, cloud.CacheCluster, cloud.CacheSubnetGroup))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, res := range resources {
+		if p, ok := res.Properties()[p.Subnets].([]string); ok {
+			sort.Strings(p)
+		}
 	}
 
+	expected := map[string]cloud.Resource{
+		"eu-west-1": resourcetest.Region("eu-west-1").Build(),
+		"vpc_1":     resourcetest.VPC("vpc_1").Build(),
+		"sub_1":     resourcetest.Subnet("sub_1").Prop(p.Vpc, "vpc_1").Build(),
+		"sub_2":     resourcetest.Subnet("sub_2").Prop(p.Vpc, "vpc_1").Build(),
+		"sg_1":      resourcetest.SecurityGroup("sg_1").Prop(p.Vpc, "vpc_1").Build(),
+		"cache_1": resourcetest.CacheCluster("cache_1").Prop(p.Name, "cache_1").Prop(p.State, "available").Prop(p.Created, created).
+			Prop(p.Engine, "redis").Prop(p.EngineVersion, "3.2.10").Prop(p.Class, "cache.t2.micro").Prop(p.NodeCount, 1).
+			Prop(p.AvailabilityZone, "eu-west-1a").Prop(p.CacheSubnetGroup, "subgroup_1").Prop(p.SecurityGroups, []string{"sg_1"}).Build(),
+		"cache_2": resourcetest.CacheCluster("cache_2").Prop(p.Name, "cache_2").Prop(p.Engine, "memcached").Prop(p.NodeCount, 3).
+			Prop(p.Endpoint, "cache-2.cfg.euw1.cache.amazonaws.com").Prop(p.Port, 11211).Build(),
+		"subgroup_1": resourcetest.CacheSubnetGroup("subgroup_1").Prop(p.Name, "subgroup_1").Prop(p.Description, "my cache subnets").Prop(p.Vpc, "vpc_1").
+			Prop(p.Subnets, []string{"sub_1", "sub_2"}).Build(),
+	}
+	expectedChildren := map[string][]string{
+		"eu-west-1": {"cache_1", "cache_2", "vpc_1"},
+		"vpc_1":     {"sg_1", "sub_1", "sub_2", "subgroup_1"},
+	}
+	expectedAppliedOn := map[string][]string{
+		"sg_1":       {"cache_1"},
+		"subgroup_1": {"cache_1", "sub_1", "sub_2"},
+	}
+	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
+}
+
+func TestBuildDatawarehouseRdfGraph(t *testing.T) {
+	created := time.Date(2017, 3, 10, 19, 15, 30, 0, time.UTC)
+	mockRedshift := &mockRedshift{
+		clusters: []*redshift.Cluster{
+			{
+				ClusterIdentifier:  awssdk.String("warehouse_1"),
+				ClusterStatus:      awssdk.String("available"),
+				ClusterCreateTime:  awssdk.Time(created),
+				NodeType:           awssdk.String("dc2.large"),
+				NumberOfNodes:      awssdk.Int64(2),
+				ClusterVersion:     awssdk.String("1.0"),
+				MasterUsername:     awssdk.String("admin"),
+				AvailabilityZone:   awssdk.String("eu-west-1a"),
+				VpcId:              awssdk.String("vpc_1"),
+				VpcSecurityGroups:  []*redshift.VpcSecurityGroupMembership{{VpcSecurityGroupId: awssdk.String("sg_1"), Status: awssdk.String("active")}},
+				Endpoint:           &redshift.Endpoint{Address: awssdk.String("warehouse-1.abc.eu-west-1.redshift.amazonaws.com"), Port: awssdk.Int64(5439)},
+				PubliclyAccessible: awssdk.Bool(false),
+				Encrypted:          awssdk.Bool(true),
+			},
+			{
+				ClusterIdentifier: awssdk.String("warehouse_2"),
+				ClusterStatus:     awssdk.String("creating"),
+				NodeType:          awssdk.String("ds2.xlarge"),
+				NumberOfNodes:     awssdk.Int64(1),
+			},
+		},
+	}
+	mockEc2 := &mockEc2{
+		vpcs:           []*ec2.Vpc{{VpcId: awssdk.String("vpc_1")}},
+		securitygroups: []*ec2.SecurityGroup{{GroupId: awssdk.String("sg_1"), VpcId: awssdk.String("vpc_1")}},
+	}
+	infra := Infra{
+		EC2API:         mockEc2,
+		ELBV2API:       &mockElbv2{},
+		ELBAPI:         &mockElb{},
+		RDSAPI:         &mockRds{},
+		AutoScalingAPI: &mockAutoscaling{},
+		ECRAPI:         &mockEcr{},
+		ECSAPI:         &mockEcs{},
+		ACMAPI:         &mockAcm{},
+		DynamoDBAPI:    &mockDynamodb{},
+		ElastiCacheAPI: &mockElasticache{},
+		RedshiftAPI:    mockRedshift,
+		region:         "eu-west-1",
+		fetcher: fetch.NewFetcher(awsfetch.BuildInfraFetchFuncs(awsfetch.NewConfig(
+			mockEc2, &mockElbv2{}, &mockElb{}, &mockRds{}, &mockEcr{}, &mockEcs{}, &mockAutoscaling{}, &mockAcm{}, &mockDynamodb{}, &mockElasticache{}, mockRedshift, &mockBatch{}, &mockDirectconnect{}, &mockEc2transitgateway{},
+		))),
+	}
+
+	g, err := infra.Fetch(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	resources, err := g.Find(cloud.NewQuery(cloud.Region, cloud.Vpc, cloud.SecurityGroup, cloud.Cluster))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]cloud.Resource{
+		"eu-west-1": resourcetest.Region("eu-west-1").Build(),
+		"vpc_1":     resourcetest.VPC("vpc_1").Build(),
+		"sg_1":      resourcetest.SecurityGroup("sg_1").Prop(p.Vpc, "vpc_1").Build(),
+		"warehouse_1": resourcetest.Cluster("warehouse_1").Prop(p.Name, "warehouse_1").Prop(p.State, "available").Prop(p.Created, created).
+			Prop(p.Class, "dc2.large").Prop(p.NodeCount, 2).Prop(p.Version, "1.0").Prop(p.Username, "admin").Prop(p.AvailabilityZone, "eu-west-1a").
+			Prop(p.Vpc, "vpc_1").Prop(p.SecurityGroups, []string{"sg_1"}).Prop(p.Endpoint, "warehouse-1.abc.eu-west-1.redshift.amazonaws.com").
+			Prop(p.Port, 5439).Prop(p.Public, false).Prop(p.Encrypted, true).Build(),
+		"warehouse_2": resourcetest.Cluster("warehouse_2").Prop(p.Name, "warehouse_2").Prop(p.State, "creating").Prop(p.Class, "ds2.xlarge").
+			Prop(p.NodeCount, 1).Build(),
+	}
+	expectedChildren := map[string][]string{
+		"eu-west-1": {"vpc_1", "warehouse_1", "warehouse_2"},
+		"vpc_1":     {"sg_1"},
+	}
+	expectedAppliedOn := map[string][]string{
+		"sg_1": {"warehouse_1"},
+	}
+	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
+}
+
+func TestBuildBatchRdfGraph(t *testing.T) {
+	created := time.Date(2017, 3, 10, 19, 15, 30, 0, time.UTC)
+	mockBatch := &mockBatch{
+		computeenvironmentdetails: []*batch.ComputeEnvironmentDetail{
+			{
+				ComputeEnvironmentArn:  awssdk.String("env_1_arn"),
+				ComputeEnvironmentName: awssdk.String("env_1"),
+				Type:                   awssdk.String("MANAGED"),
+				State:                  awssdk.String("ENABLED"),
+				Status:                 awssdk.String("VALID"),
+				StatusReason:           awssdk.String("ComputeEnvironment Healthy"),
+				ServiceRole:            awssdk.String("role_arn"),
+				EcsClusterArn:          awssdk.String("cluster_arn"),
+			},
+			{ComputeEnvironmentArn: awssdk.String("env_2_arn"), ComputeEnvironmentName: awssdk.String("env_2"), Type: awssdk.String("UNMANAGED")},
+		},
+		jobqueuedetails: []*batch.JobQueueDetail{
+			{
+				JobQueueArn:  awssdk.String("queue_1_arn"),
+				JobQueueName: awssdk.String("queue_1"),
+				State:        awssdk.String("ENABLED"),
+				ComputeEnvironmentOrder: []*batch.ComputeEnvironmentOrder{
+					{ComputeEnvironment: awssdk.String("env_1_arn"), Order: awssdk.Int64(1)},
+					{ComputeEnvironment: awssdk.String("env_2_arn"), Order: awssdk.Int64(2)},
+				},
+			},
+			{JobQueueArn: awssdk.String("queue_2_arn"), JobQueueName: awssdk.String("queue_2"), State: awssdk.String("DISABLED")},
+		},
+		jobdetails: []*batch.JobDetail{
+			{
+				JobId:     awssdk.String("job_1"),
+				JobName:   awssdk.String("my-job"),
+				JobQueue:  awssdk.String("queue_1_arn"),
+				Status:    awssdk.String("SUCCEEDED"),
+				CreatedAt: awssdk.Int64(created.Unix() * 1000),
+				StartedAt: awssdk.Int64(created.Add(time.Minute).Unix() * 1000),
+				StoppedAt: awssdk.Int64(created.Add(2*time.Minute).Unix() * 1000),
+				Container: &batch.ContainerDetail{ExitCode: awssdk.Int64(0)},
+			},
+			{JobId: awssdk.String("job_2"), JobName: awssdk.String("other-job"), JobQueue: awssdk.String("queue_1_arn"), Status: awssdk.String("RUNNING")},
+		},
+	}
+	infra := Infra{
+		EC2API:         &mockEc2{},
+		ELBV2API:       &mockElbv2{},
+		ELBAPI:         &mockElb{},
+		RDSAPI:         &mockRds{},
+		AutoScalingAPI: &mockAutoscaling{},
+		ECRAPI:         &mockEcr{},
+		ECSAPI:         &mockEcs{},
+		ACMAPI:         &mockAcm{},
+		DynamoDBAPI:    &mockDynamodb{},
+		ElastiCacheAPI: &mockElasticache{},
+		RedshiftAPI:    &mockRedshift{},
+		BatchAPI:       mockBatch,
+		region:         "eu-west-1",
+		fetcher: fetch.NewFetcher(awsfetch.BuildInfraFetchFuncs(awsfetch.NewConfig(
+			&mockEc2{}, &mockElbv2{}, &mockElb{}, &mockRds{}, &mockEcr{}, &mockEcs{}, &mockAutoscaling{}, &mockAcm{}, &mockDynamodb{}, &mockElasticache{}, &mockRedshift{}, mockBatch, &mockDirectconnect{}, &mockEc2transitgateway{},
+		))),
+	}
+
+	g, err := infra.Fetch(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	resources, err := g.Find(cloud.NewQuery(cloud.Region, cloud.ComputeEnvironment, cloud.JobQueue, cloud.Job))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]cloud.Resource{
+		"eu-west-1": resourcetest.Region("eu-west-1").Build(),
+		"env_1_arn": resourcetest.ComputeEnvironment("env_1_arn").Prop(p.Name, "env_1").Prop(p.Arn, "env_1_arn").Prop(p.Type, "MANAGED").Prop(p.State, "ENABLED").
+			Prop(p.StateMessage, "ComputeEnvironment Healthy").Prop(p.Role, "role_arn").Prop(p.Cluster, "cluster_arn").Build(),
+		"env_2_arn":   resourcetest.ComputeEnvironment("env_2_arn").Prop(p.Name, "env_2").Prop(p.Arn, "env_2_arn").Prop(p.Type, "UNMANAGED").Build(),
+		"queue_1_arn": resourcetest.JobQueue("queue_1_arn").Prop(p.Name, "queue_1").Prop(p.Arn, "queue_1_arn").Prop(p.State, "ENABLED").Build(),
+		"queue_2_arn": resourcetest.JobQueue("queue_2_arn").Prop(p.Name, "queue_2").Prop(p.Arn, "queue_2_arn").Prop(p.State, "DISABLED").Build(),
+		"job_1": resourcetest.Job("job_1").Prop(p.Name, "my-job").Prop(p.State, "SUCCEEDED").Prop(p.Created, created).Prop(p.Launched, created.Add(time.Minute)).
+			Prop(p.Stopped, created.Add(2*time.Minute)).Prop(p.ExitCode, 0).Build(),
+		"job_2": resourcetest.Job("job_2").Prop(p.Name, "other-job").Prop(p.State, "RUNNING").Build(),
+	}
+	expectedChildren := map[string][]string{
+		"eu-west-1":   {"env_1_arn", "env_2_arn", "queue_1_arn", "queue_2_arn"},
+		"queue_1_arn": {"job_1", "job_2"},
+	}
+	expectedAppliedOn := map[string][]string{
+		"queue_1_arn": {"env_1_arn", "env_2_arn"},
+	}
 	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
 }
 
@@ -826,6 +1446,9 @@ func TestBuildDnsRdfGraph(t *testing.T) {
 			{Type: awssdk.String("A"), TTL: awssdk.Int64(30), Name: awssdk.String("subdomain1.my.second.domain"), ResourceRecords: []*route53.ResourceRecord{{Value: awssdk.String("5.6.7.8")}}},
 			{Type: awssdk.String("CNAME"), TTL: awssdk.Int64(10), Name: awssdk.String("subdomain3.my.second.domain"), ResourceRecords: []*route53.ResourceRecord{{Value: awssdk.String("6.7.8.9")}}},
 		},
+		"/hostedzone/34567": {
+			{Type: awssdk.String("A"), Name: awssdk.String("www.my.third.domain"), AliasTarget: &route53.AliasTarget{DNSName: awssdk.String("dualstack.web-123.eu-west-1.elb.amazonaws.com."), HostedZoneId: awssdk.String("Z32O12XQLNTSW2")}},
+		},
 	}
 	mockRoute53 := &mockRoute53{hostedzones: zonePages, resourcerecordsets: recordPages}
 
@@ -859,10 +1482,12 @@ func TestBuildDnsRdfGraph(t *testing.T) {
 		"awls-be1e0b6a":     resourcetest.Record("awls-be1e0b6a").Prop(p.Name, "subdomain3.my.first.domain").Prop(p.Zone, "my.first.domain").Prop(p.Type, "CNAME").Prop(p.TTL, 60).Prop(p.Records, []string{"4.5.6.7"}).Build(),
 		"awls-9c420a99":     resourcetest.Record("awls-9c420a99").Prop(p.Name, "subdomain1.my.second.domain").Prop(p.Zone, "my.second.domain").Prop(p.Type, "A").Prop(p.TTL, 30).Prop(p.Records, []string{"5.6.7.8"}).Build(),
 		"awls-c9b80bbe":     resourcetest.Record("awls-c9b80bbe").Prop(p.Name, "subdomain3.my.second.domain").Prop(p.Zone, "my.second.domain").Prop(p.Type, "CNAME").Prop(p.TTL, 10).Prop(p.Records, []string{"6.7.8.9"}).Build(),
+		"awls-520e07aa":     resourcetest.Record("awls-520e07aa").Prop(p.Name, "www.my.third.domain").Prop(p.Zone, "my.third.domain").Prop(p.Type, "A").Prop(p.Alias, "dualstack.web-123.eu-west-1.elb.amazonaws.com.").Prop(p.AliasZone, "Z32O12XQLNTSW2").Build(),
 	}
 	expectedChildren := map[string][]string{
 		"/hostedzone/12345": {"awls-91fa0a45", "awls-920c0a46", "awls-be1e0b6a"},
 		"/hostedzone/23456": {"awls-9c420a99", "awls-c9b80bbe"},
+		"/hostedzone/34567": {"awls-520e07aa"},
 	}
 	expectedAppliedOn := map[string][]string{}
 
@@ -873,7 +1498,7 @@ func TestBuildNotificationGraph(t *testing.T) {
 	topics := []*sns.Topic{
 		{TopicArn: awssdk.String("topic_arn_1")},
 		{TopicArn: awssdk.String("topic_arn_2")},
-		{TopicArn: awssdk.String("topic_arn_3")},
+		{TopicArn: awssdk.String("arn:aws:sns:eu-west-1:0123456789:alerts")},
 	}
 
 	subscriptions := []*sns.Subscription{
@@ -892,6 +1517,9 @@ func TestBuildNotificationGraph(t *testing.T) {
 		},
 		"queue_3": {
 			"ApproximateNumberOfMessages": awssdk.String("12"),
+			"FifoQueue":                   awssdk.String("true"),
+			"VisibilityTimeout":           awssdk.String("60"),
+			"RedrivePolicy":               awssdk.String(`{"deadLetterTargetArn":"queue_2_arn","maxReceiveCount":3}`),
 		},
 	}
 
@@ -917,12 +1545,12 @@ func TestBuildNotificationGraph(t *testing.T) {
 		"endpoint_1":  resourcetest.Subscription("endpoint_1").Prop(p.Endpoint, "endpoint_1").Build(),
 		"endpoint_2":  resourcetest.Subscription("endpoint_2").Prop(p.Endpoint, "endpoint_2").Prop(p.Owner, "subscr_owner").Prop(p.Protocol, "subscr_prot").Prop(p.Arn, "subscr_arn").Prop(p.Topic, "topic_arn_2").Build(),
 		"endpoint_3":  resourcetest.Subscription("endpoint_3").Prop(p.Endpoint, "endpoint_3").Prop(p.Topic, "topic_arn_2").Build(),
-		"topic_arn_1": resourcetest.Topic("topic_arn_1").Prop(p.Arn, "topic_arn_1").Build(),
-		"topic_arn_2": resourcetest.Topic("topic_arn_2").Prop(p.Arn, "topic_arn_2").Build(),
-		"topic_arn_3": resourcetest.Topic("topic_arn_3").Prop(p.Arn, "topic_arn_3").Build(),
+		"topic_arn_1": resourcetest.Topic("topic_arn_1").Prop(p.Arn, "topic_arn_1").Prop(p.Name, "topic_arn_1").Build(),
+		"topic_arn_2": resourcetest.Topic("topic_arn_2").Prop(p.Arn, "topic_arn_2").Prop(p.Name, "topic_arn_2").Build(),
+		"arn:aws:sns:eu-west-1:0123456789:alerts": resourcetest.Topic("arn:aws:sns:eu-west-1:0123456789:alerts").Prop(p.Arn, "arn:aws:sns:eu-west-1:0123456789:alerts").Prop(p.Name, "alerts").Build(),
 	}
 	expectedChildren := map[string][]string{
-		"eu-west-1":   {"topic_arn_1", "topic_arn_2", "topic_arn_3"},
+		"eu-west-1":   {"arn:aws:sns:eu-west-1:0123456789:alerts", "topic_arn_1", "topic_arn_2"},
 		"topic_arn_2": {"endpoint_2", "endpoint_3"},
 	}
 	expectedAppliedOn := map[string][]string{}
@@ -935,9 +1563,9 @@ func TestBuildNotificationGraph(t *testing.T) {
 	}
 
 	expected = map[string]cloud.Resource{
-		"queue_1": resourcetest.Queue("queue_1").Build(),
-		"queue_2": resourcetest.Queue("queue_2").Prop(p.ApproximateMessageCount, 4).Prop(p.Created, time.Unix(1494419259, 0).UTC()).Prop(p.Modified, time.Unix(1494332859, 0).UTC()).Prop(p.Arn, "queue_2_arn").Prop(p.Delay, 15).Build(),
-		"queue_3": resourcetest.Queue("queue_3").Prop(p.ApproximateMessageCount, 12).Build(),
+		"queue_1": resourcetest.Queue("queue_1").Prop(p.Name, "queue_1").Build(),
+		"queue_2": resourcetest.Queue("queue_2").Prop(p.Name, "queue_2").Prop(p.ApproximateMessageCount, 4).Prop(p.Created, time.Unix(1494419259, 0).UTC()).Prop(p.Modified, time.Unix(1494332859, 0).UTC()).Prop(p.Arn, "queue_2_arn").Prop(p.Delay, 15).Build(),
+		"queue_3": resourcetest.Queue("queue_3").Prop(p.Name, "queue_3").Prop(p.ApproximateMessageCount, 12).Prop(p.Fifo, true).Prop(p.VisibilityTimeout, 60).Prop(p.DeadLetterQueue, "queue_2_arn").Prop(p.MaxReceiveCount, 3).Build(),
 	}
 	expectedChildren = map[string][]string{}
 	expectedAppliedOn = map[string][]string{}
@@ -966,11 +1594,24 @@ func TestBuildLambdaGraph(t *testing.T) {
 		{FunctionArn: awssdk.String("func_3_arn")},
 	}
 
+	now := time.Now().UTC()
+	machines := []*sfn.StateMachineListItem{
+		{StateMachineArn: awssdk.String("machine_1_arn"), Name: awssdk.String("machine_1"), CreationDate: awssdk.Time(now)},
+		{StateMachineArn: awssdk.String("machine_2_arn"), Name: awssdk.String("machine_2")},
+	}
+	executions := map[string][]*sfn.ExecutionListItem{
+		"machine_1_arn": {
+			{ExecutionArn: awssdk.String("exec_1_arn"), Name: awssdk.String("exec_1"), StateMachineArn: awssdk.String("machine_1_arn"), Status: awssdk.String("SUCCEEDED"), StartDate: awssdk.Time(now.Add(-time.Hour)), StopDate: awssdk.Time(now)},
+			{ExecutionArn: awssdk.String("exec_2_arn"), Name: awssdk.String("exec_2"), StateMachineArn: awssdk.String("machine_1_arn"), Status: awssdk.String("RUNNING"), StartDate: awssdk.Time(now)},
+		},
+	}
+
 	mock := &mockLambda{functionconfigurations: functions}
+	sfnMock := &mockSfn{statemachinelistitems: machines, executionlistitems: executions}
 
 	service := Lambda{
-		LambdaAPI: mock, region: "eu-west-1",
-		fetcher: fetch.NewFetcher(awsfetch.BuildLambdaFetchFuncs(awsfetch.NewConfig(mock))),
+		LambdaAPI: mock, SFNAPI: sfnMock, region: "eu-west-1",
+		fetcher: fetch.NewFetcher(awsfetch.BuildLambdaFetchFuncs(awsfetch.NewConfig(mock, sfnMock))),
 	}
 
 	g, err := service.Fetch(context.Background())
@@ -978,7 +1619,7 @@ func TestBuildLambdaGraph(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	resources, err := g.Find(cloud.NewQuery("function"))
+	resources, err := g.Find(cloud.NewQuery("function", "statemachine", "execution"))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -988,11 +1629,17 @@ func TestBuildLambdaGraph(t *testing.T) {
 		"func_2_arn": resourcetest.Function("func_2_arn").Prop(p.Arn, "func_2_arn").Prop(p.Name, "func_2_name").Prop(p.Hash, "abcdef123456789").Prop(p.Size, 1234).
 			Prop(p.Description, "my function desc").Prop(p.Handler, "handl").Prop(p.Modified, time.Unix(1136214245, 0).UTC()).Prop(p.Memory, 1234).Prop(p.Role, "role").
 			Prop(p.Runtime, "runtime").Prop(p.Timeout, 60).Prop(p.Version, "v2").Build(),
-		"func_3_arn": resourcetest.Function("func_3_arn").Prop(p.Arn, "func_3_arn").Build(),
+		"func_3_arn":    resourcetest.Function("func_3_arn").Prop(p.Arn, "func_3_arn").Build(),
+		"machine_1_arn": resourcetest.StateMachine("machine_1_arn").Prop(p.Arn, "machine_1_arn").Prop(p.Name, "machine_1").Prop(p.Created, now).Build(),
+		"machine_2_arn": resourcetest.StateMachine("machine_2_arn").Prop(p.Arn, "machine_2_arn").Prop(p.Name, "machine_2").Build(),
+		"exec_1_arn": resourcetest.Execution("exec_1_arn").Prop(p.Arn, "exec_1_arn").Prop(p.Name, "exec_1").Prop(p.StateMachine, "machine_1_arn").Prop(p.State, "SUCCEEDED").
+			Prop(p.Launched, now.Add(-time.Hour)).Prop(p.Stopped, now).Build(),
+		"exec_2_arn": resourcetest.Execution("exec_2_arn").Prop(p.Arn, "exec_2_arn").Prop(p.Name, "exec_2").Prop(p.StateMachine, "machine_1_arn").Prop(p.State, "RUNNING").Prop(p.Launched, now).Build(),
 	}
 
 	expectedChildren := map[string][]string{
-		"eu-west-1": {"func_1_arn", "func_2_arn", "func_3_arn"},
+		"eu-west-1":     {"func_1_arn", "func_2_arn", "func_3_arn", "machine_1_arn", "machine_2_arn"},
+		"machine_1_arn": {"exec_1_arn", "exec_2_arn"},
 	}
 	expectedAppliedOn := map[string][]string{}
 
@@ -1026,11 +1673,30 @@ func TestBuildMonitoringGraph(t *testing.T) {
 		},
 	}
 
+	loggroups := []*cloudwatchlogs.LogGroup{
+		{LogGroupName: awssdk.String("/aws/lambda/my_function"), Arn: awssdk.String("arn:aws:logs:eu-west-1:123456789012:log-group:/aws/lambda/my_function:*"), CreationTime: awssdk.Int64(1500000000123), RetentionInDays: awssdk.Int64(30), StoredBytes: awssdk.Int64(2048)},
+		{LogGroupName: awssdk.String("my_logs")},
+	}
+
+	trails := []*cloudtrail.Trail{
+		{TrailARN: awssdk.String("arn:aws:cloudtrail:eu-west-1:123456789012:trail/audit"), Name: awssdk.String("audit"), S3BucketName: awssdk.String("audit_bucket"), HomeRegion: awssdk.String("eu-west-1")},
+	}
+	events := []*cloudtrail.Event{
+		{EventId: awssdk.String("event_1"), EventName: awssdk.String("RunInstances"), EventSource: awssdk.String("ec2.amazonaws.com"), EventTime: awssdk.Time(now), Username: awssdk.String("alice"),
+			CloudTrailEvent: awssdk.String(`{"readOnly":false}`), Resources: []*cloudtrail.Resource{{ResourceName: awssdk.String("inst_1")}}},
+		{EventId: awssdk.String("event_2"), EventName: awssdk.String("DescribeInstances"), EventSource: awssdk.String("ec2.amazonaws.com"), EventTime: awssdk.Time(now), Username: awssdk.String("alice"),
+			CloudTrailEvent: awssdk.String(`{"readOnly":true}`)},
+		{EventId: awssdk.String("event_3"), EventName: awssdk.String("TerminateInstances"), EventSource: awssdk.String("ec2.amazonaws.com"), EventTime: awssdk.Time(now), Username: awssdk.String("bob"),
+			CloudTrailEvent: awssdk.String(`{"readOnly":false,"errorCode":"UnauthorizedOperation"}`), Resources: []*cloudtrail.Resource{{ResourceName: awssdk.String("inst_1")}}},
+	}
+
 	mock := &mockCloudwatch{metrics: metrics, metricalarms: alarms}
+	logsMock := &mockCloudwatchlogs{loggroups: loggroups}
+	trailMock := &mockCloudtrail{trails: trails, events: events}
 
 	service := Monitoring{
-		CloudWatchAPI: mock, region: "eu-west-1",
-		fetcher: fetch.NewFetcher(awsfetch.BuildMonitoringFetchFuncs(awsfetch.NewConfig(mock))),
+		CloudWatchAPI: mock, CloudWatchLogsAPI: logsMock, CloudTrailAPI: trailMock, region: "eu-west-1",
+		fetcher: fetch.NewFetcher(awsfetch.BuildMonitoringFetchFuncs(awsfetch.NewConfig(mock, logsMock, trailMock))),
 	}
 
 	g, err := service.Fetch(context.Background())
@@ -1038,7 +1704,7 @@ func TestBuildMonitoringGraph(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	resources, err := g.Find(cloud.NewQuery("metric", "alarm"))
+	resources, err := g.Find(cloud.NewQuery("metric", "alarm", "loggroup", "trail", "trailevent"))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1073,16 +1739,35 @@ func TestBuildMonitoringGraph(t *testing.T) {
 		"alarm_3": resourcetest.Alarm("alarm_3").Prop(p.Arn, "alarm_3").Prop(p.Name, "my_alarm").Prop(p.ActionsEnabled, true).Prop(p.AlarmActions, []string{"action_arn_1", "action_arn_2", "action_arn_3"}).Prop(p.InsufficientDataActions, []string{"action_arn_1", "action_arn_3"}).
 			Prop(p.OKActions, []string{"action_arn_2"}).Prop(p.Description, "my alarm description").Prop(p.Dimensions, []*graph.KeyValue{{KeyName: "first", Value: "dimension"}, {KeyName: "second", Value: "dimension"}}).Prop(p.MetricName, "metric_2").
 			Prop(p.Namespace, "namespace_2").Prop(p.Updated, now).Prop(p.State, "OK").Build(),
+		"/aws/lambda/my_function": resourcetest.LogGroup("/aws/lambda/my_function").Prop(p.Name, "/aws/lambda/my_function").Prop(p.Arn, "arn:aws:logs:eu-west-1:123456789012:log-group:/aws/lambda/my_function:*").
+			Prop(p.Created, time.Unix(1500000000, 123000000).UTC()).Prop(p.Retention, 30).Prop(p.Size, 2048).Build(),
+		"my_logs": resourcetest.LogGroup("my_logs").Prop(p.Name, "my_logs").Build(),
+		"arn:aws:cloudtrail:eu-west-1:123456789012:trail/audit": resourcetest.Trail("arn:aws:cloudtrail:eu-west-1:123456789012:trail/audit").Prop(p.Arn, "arn:aws:cloudtrail:eu-west-1:123456789012:trail/audit").
+			Prop(p.Name, "audit").Prop(p.Bucket, "audit_bucket").Prop(p.Region, "eu-west-1").Build(),
+		"event_1": resourcetest.TrailEvent("event_1").Prop(p.Name, "RunInstances").Prop(p.Username, "alice").Prop(p.Created, now).Prop(p.Type, "created").Prop(p.Source, "ec2").Build(),
 	}
 
 	expectedChildren := map[string][]string{
-		"eu-west-1": {"awls-4ba90752", "awls-4baa0753", "awls-4bb20753", "awls-4bb30754", "alarm_1", "alarm_2", "alarm_3"},
+		"eu-west-1": {"/aws/lambda/my_function", "arn:aws:cloudtrail:eu-west-1:123456789012:trail/audit", "awls-4ba90752", "awls-4baa0753", "awls-4bb20753", "awls-4bb30754", "alarm_1", "alarm_2", "alarm_3", "my_logs"},
 	}
 	expectedAppliedOn := map[string][]string{
 		"alarm_3": {"awls-4bb30754"},
 	}
 
 	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
+
+	// the resources changed by the events are synced by other services
+	g.(*graph.Graph).AddResource(resourcetest.Instance("inst_1").Build())
+	dependingOn, err := g.ResourceRelations(resourcetest.Instance("inst_1").Build(), rdf.DependingOnRel, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(dependingOn), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := dependingOn[0].Id(), "event_1"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
 }
 
 func TestBuildCdnGraph(t *testing.T) {
@@ -1224,7 +1909,16 @@ func TestBuildCloudFormationGraph(t *testing.T) {
 		},
 	}
 
-	mock := &mockCloudformation{stacks: stacks}
+	stackResources := map[string][]*cloudformation.StackResourceSummary{
+		"id_1": {
+			{LogicalResourceId: awssdk.String("Instance"), PhysicalResourceId: awssdk.String("inst_1"), ResourceType: awssdk.String("AWS::EC2::Instance"), ResourceStatus: awssdk.String("CREATE_COMPLETE")},
+			{LogicalResourceId: awssdk.String("Bucket"), PhysicalResourceId: awssdk.String("my-bucket"), ResourceType: awssdk.String("AWS::S3::Bucket"), ResourceStatus: awssdk.String("CREATE_COMPLETE")},
+			{LogicalResourceId: awssdk.String("Queue"), PhysicalResourceId: awssdk.String("old_queue"), ResourceType: awssdk.String("AWS::SQS::Queue"), ResourceStatus: awssdk.String("DELETE_COMPLETE")},
+			{LogicalResourceId: awssdk.String("Topic"), ResourceType: awssdk.String("AWS::SNS::Topic"), ResourceStatus: awssdk.String("CREATE_IN_PROGRESS")},
+		},
+	}
+
+	mock := &mockCloudformation{stacks: stacks, stackResources: stackResources}
 
 	service := Cloudformation{
 		CloudFormationAPI: mock, region: "eu-west-1",
@@ -1292,6 +1986,31 @@ func TestBuildCloudFormationGraph(t *testing.T) {
 	expectedAppliedOn := map[string][]string{}
 
 	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
+
+	// the stack resources are synced by other services
+	g.(*graph.Graph).AddResource(resourcetest.Instance("inst_1").Build())
+	members, err := g.ResourceRelations(resourcetest.Stack("id_1").Build(), rdf.StackMembersRel, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var memberIds []string
+	for _, m := range members {
+		memberIds = append(memberIds, m.Id())
+	}
+	sort.Strings(memberIds)
+	if got, want := memberIds, []string{"inst_1", "my-bucket"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	stacksOf, err := g.ResourceRelations(resourcetest.Instance("inst_1").Build(), rdf.MemberOfStack, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(stacksOf), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := stacksOf[0].Id(), "id_1"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
 }
 
 func TestBuildEmptyRdfGraphWhenNoData(t *testing.T) {
@@ -1301,8 +2020,8 @@ func TestBuildEmptyRdfGraphWhenNoData(t *testing.T) {
 	mock := &mockIam{}
 
 	access := Access{
-		IAMAPI: mock, region: "eu-west-1",
-		fetcher: fetch.NewFetcher(awsfetch.BuildAccessFetchFuncs(awsfetch.NewConfig(mock))),
+		IAMAPI: mock, KMSAPI: &mockKms{}, OrganizationsAPI: &mockOrganizations{}, region: "eu-west-1",
+		fetcher: fetch.NewFetcher(awsfetch.BuildAccessFetchFuncs(awsfetch.NewConfig(mock, &mockKms{}, &mockOrganizations{}))),
 	}
 
 	g, err := access.Fetch(context.Background())
@@ -1324,14 +2043,18 @@ func TestBuildEmptyRdfGraphWhenNoData(t *testing.T) {
 	infra := Infra{
 		EC2API:         &mockEc2{},
 		ELBV2API:       &mockElbv2{},
+		ELBAPI:         &mockElb{},
 		RDSAPI:         &mockRds{},
 		AutoScalingAPI: &mockAutoscaling{},
 		ECRAPI:         &mockEcr{},
 		ECSAPI:         &mockEcs{},
 		ACMAPI:         &mockAcm{},
+		DynamoDBAPI:    &mockDynamodb{},
+		ElastiCacheAPI: &mockElasticache{},
+		RedshiftAPI:    &mockRedshift{},
 		region:         "eu-west-1",
 		fetcher: fetch.NewFetcher(awsfetch.BuildInfraFetchFuncs(awsfetch.NewConfig(
-			&mockEc2{}, &mockElbv2{}, &mockRds{}, &mockEcr{}, &mockEcs{}, &mockAutoscaling{}, &mockAcm{},
+			&mockEc2{}, &mockElbv2{}, &mockElb{}, &mockRds{}, &mockEcr{}, &mockEcs{}, &mockAutoscaling{}, &mockAcm{}, &mockDynamodb{}, &mockElasticache{}, &mockRedshift{}, &mockBatch{}, &mockDirectconnect{}, &mockEc2transitgateway{},
 		))),
 	}
 
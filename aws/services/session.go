@@ -67,6 +67,7 @@ type sessionResolver struct {
 	enableRequestsFullLogging            bool
 	enableNetworkMonitorRequestsHandlers bool
 	enableCredentialResolvers            bool
+	rateLimits                           map[string]float64
 }
 
 func newSessionResolver() *sessionResolver {
@@ -108,8 +109,13 @@ func (s *sessionResolver) withNetworkMonitor(enableNetworkMonitor bool) *session
 	return s
 }
 
+func (s *sessionResolver) withRateLimits(rates map[string]float64) *sessionResolver {
+	s.rateLimits = rates
+	return s
+}
+
 func (s *sessionResolver) resolve() (*session.Session, error) {
-	session, err := session.NewSessionWithOptions(session.Options{
+	opts := session.Options{
 		Config: awssdk.Config{
 			Region:                        awssdk.String(s.region),
 			HTTPClient:                    s.credentialHTTPClient,
@@ -118,7 +124,33 @@ func (s *sessionResolver) resolve() (*session.Session, error) {
 		SharedConfigState:       session.SharedConfigEnable,
 		AssumeRoleTokenProvider: stscreds.StdinTokenProvider,
 		Profile:                 s.profile,
-	})
+	}
+
+	profile := s.profile
+	if profile == "" {
+		if profile = os.Getenv("AWS_PROFILE"); profile == "" {
+			profile = "default"
+		}
+	}
+	if profiles := loadSharedProfiles(); profiles.needsCustomCredentials(profile) {
+		region := s.region
+		if region == "" {
+			region = profiles.region(profile)
+		}
+		creds, err := profiles.credentials(profile, region)
+		if err != nil {
+			return nil, err
+		}
+		if s.logger != nil {
+			s.logger.ExtraVerbosef("resolving credentials of profile '%s' from AWS CLI config", profile)
+		}
+		opts.Config.Region = awssdk.String(region)
+		opts.Config.Credentials = creds
+		opts.SharedConfigState = session.SharedConfigDisable
+		opts.SharedConfigFiles = []string{}
+	}
+
+	session, err := session.NewSessionWithOptions(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -133,6 +165,10 @@ func (s *sessionResolver) resolve() (*session.Session, error) {
 		}
 	})
 
+	if s.rateLimits != nil {
+		addRateLimitHandlers(session, s.rateLimits, s.logger)
+	}
+
 	if s.enableNetworkMonitorRequestsHandlers {
 		session.Handlers.Send.PushFront(func(r *request.Request) {
 			DefaultNetworkMonitor.addRequest(r)
@@ -0,0 +1,397 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsservices
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/endpointcreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/go-ini/ini"
+)
+
+const (
+	ProcessProviderName = "ProcessProvider"
+	SSOProviderName     = "SSOProvider"
+
+	maxSourceProfileDepth = 10
+)
+
+// sharedProfiles gives access to the profiles of the AWS CLI shared files
+// (~/.aws/credentials and ~/.aws/config), for the features the AWS SDK
+// does not support: credential_process, AWS SSO, credential_source
+// and chains of source_profile
+type sharedProfiles struct {
+	files []*ini.File
+}
+
+func loadSharedProfiles() *sharedProfiles {
+	home := os.Getenv("HOME")
+	if runtime.GOOS == "windows" {
+		home = os.Getenv("USERPROFILE")
+	}
+	credsFile := os.Getenv("AWS_SHARED_CREDENTIALS_FILE")
+	if credsFile == "" {
+		credsFile = filepath.Join(home, ".aws", "credentials")
+	}
+	configFile := os.Getenv("AWS_CONFIG_FILE")
+	if configFile == "" {
+		configFile = filepath.Join(home, ".aws", "config")
+	}
+	return newSharedProfiles(credsFile, configFile)
+}
+
+func newSharedProfiles(filenames ...string) *sharedProfiles {
+	p := &sharedProfiles{}
+	for _, name := range filenames {
+		b, err := ioutil.ReadFile(name)
+		if err != nil {
+			continue
+		}
+		if f, err := ini.Load(b); err == nil {
+			p.files = append(p.files, f)
+		}
+	}
+	return p
+}
+
+// get returns the value of a key of a profile, from the first file defining it
+func (p *sharedProfiles) get(profile, key string) string {
+	return p.getInSection(key, profile, "profile "+profile)
+}
+
+func (p *sharedProfiles) getInSection(key string, sections ...string) string {
+	for _, f := range p.files {
+		for _, name := range sections {
+			if section, err := f.GetSection(name); err == nil && section.HasKey(key) {
+				return strings.TrimSpace(section.Key(key).String())
+			}
+		}
+	}
+	return ""
+}
+
+func (p *sharedProfiles) region(profile string) string {
+	return p.get(profile, "region")
+}
+
+// needsCustomCredentials returns true when the credentials of the profile
+// cannot be resolved by the AWS SDK
+func (p *sharedProfiles) needsCustomCredentials(profile string) bool {
+	for depth := 0; depth < maxSourceProfileDepth; depth++ {
+		if p.get(profile, "credential_process") != "" || p.isSSO(profile) || p.get(profile, "credential_source") != "" {
+			return true
+		}
+		if p.get(profile, "role_arn") == "" {
+			return false
+		}
+		source := p.get(profile, "source_profile")
+		if source == "" || source == profile {
+			return false
+		}
+		if depth > 0 { // chained roles
+			return true
+		}
+		profile = source
+	}
+	return true
+}
+
+func (p *sharedProfiles) isSSO(profile string) bool {
+	return p.get(profile, "sso_start_url") != "" || p.get(profile, "sso_session") != ""
+}
+
+// credentials resolves the credentials of the profile, assuming roles
+// through the source profiles (with the given STS region)
+func (p *sharedProfiles) credentials(profile, region string) (*credentials.Credentials, error) {
+	return p.resolveCredentials(profile, region, make(map[string]bool))
+}
+
+func (p *sharedProfiles) resolveCredentials(profile, region string, visited map[string]bool) (*credentials.Credentials, error) {
+	if visited[profile] {
+		return nil, fmt.Errorf("profile '%s': cycle in source_profile", profile)
+	}
+	visited[profile] = true
+
+	if roleARN := p.get(profile, "role_arn"); roleARN != "" {
+		var sourceCreds *credentials.Credentials
+		var err error
+		switch source, credSource := p.get(profile, "source_profile"), p.get(profile, "credential_source"); {
+		case source == profile:
+			sourceCreds, err = p.baseCredentials(profile)
+		case source != "":
+			sourceCreds, err = p.resolveCredentials(source, region, visited)
+		case credSource != "":
+			sourceCreds, err = credentialsFromSource(credSource, region)
+		default:
+			err = fmt.Errorf("profile '%s': role_arn requires source_profile or credential_source", profile)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return p.assumeRoleCredentials(profile, roleARN, region, sourceCreds)
+	}
+	return p.baseCredentials(profile)
+}
+
+func (p *sharedProfiles) baseCredentials(profile string) (*credentials.Credentials, error) {
+	switch {
+	case p.get(profile, "aws_access_key_id") != "":
+		return credentials.NewStaticCredentials(p.get(profile, "aws_access_key_id"), p.get(profile, "aws_secret_access_key"), p.get(profile, "aws_session_token")), nil
+	case p.get(profile, "credential_process") != "":
+		return credentials.NewCredentials(&processProvider{command: p.get(profile, "credential_process")}), nil
+	case p.isSSO(profile):
+		provider := &ssoProvider{
+			profile:   profile,
+			startURL:  p.get(profile, "sso_start_url"),
+			region:    p.get(profile, "sso_region"),
+			accountID: p.get(profile, "sso_account_id"),
+			roleName:  p.get(profile, "sso_role_name"),
+			cacheDir:  filepath.Join(os.Getenv("HOME"), ".aws", "sso", "cache"),
+			client:    http.DefaultClient,
+		}
+		if name := p.get(profile, "sso_session"); name != "" {
+			provider.session = name
+			provider.startURL = p.getInSection("sso_start_url", "sso-session "+name)
+			provider.region = p.getInSection("sso_region", "sso-session "+name)
+		}
+		if provider.startURL == "" || provider.region == "" || provider.accountID == "" || provider.roleName == "" {
+			return nil, fmt.Errorf("profile '%s': incomplete AWS SSO configuration: expecting sso_start_url, sso_region, sso_account_id and sso_role_name", profile)
+		}
+		return credentials.NewCredentials(provider), nil
+	default:
+		return nil, fmt.Errorf("profile '%s': no credentials found (aws_access_key_id, credential_process or sso_*)", profile)
+	}
+}
+
+func (p *sharedProfiles) assumeRoleCredentials(profile, roleARN, region string, sourceCreds *credentials.Credentials) (*credentials.Credentials, error) {
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            awssdk.Config{Region: awssdk.String(region), Credentials: sourceCreds},
+		SharedConfigState: session.SharedConfigDisable,
+		SharedConfigFiles: []string{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var duration time.Duration
+	if s := p.get(profile, "duration_seconds"); s != "" {
+		secs, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, fmt.Errorf("profile '%s': invalid duration_seconds '%s'", profile, s)
+		}
+		duration = time.Duration(secs) * time.Second
+	}
+	return stscreds.NewCredentials(sess, roleARN, func(opt *stscreds.AssumeRoleProvider) {
+		opt.RoleSessionName = p.get(profile, "role_session_name")
+		if duration > 0 {
+			opt.Duration = duration
+		}
+		if externalID := p.get(profile, "external_id"); externalID != "" {
+			opt.ExternalID = awssdk.String(externalID)
+		}
+		if mfa := p.get(profile, "mfa_serial"); mfa != "" {
+			opt.SerialNumber = awssdk.String(mfa)
+			opt.TokenProvider = stscreds.StdinTokenProvider
+		}
+	}), nil
+}
+
+func credentialsFromSource(source, region string) (*credentials.Credentials, error) {
+	switch source {
+	case "Environment":
+		return credentials.NewEnvCredentials(), nil
+	case "Ec2InstanceMetadata":
+		sess, err := session.NewSessionWithOptions(session.Options{SharedConfigState: session.SharedConfigDisable, SharedConfigFiles: []string{}})
+		if err != nil {
+			return nil, err
+		}
+		return ec2rolecreds.NewCredentialsWithClient(ec2metadata.New(sess)), nil
+	case "EcsContainer":
+		uri := os.Getenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI")
+		if uri == "" {
+			return nil, fmt.Errorf("credential_source EcsContainer: AWS_CONTAINER_CREDENTIALS_RELATIVE_URI is not set")
+		}
+		cfg := defaults.Config().WithRegion(region)
+		return endpointcreds.NewCredentialsClient(*cfg, defaults.Handlers(), "http://169.254.170.2"+uri), nil
+	default:
+		return nil, fmt.Errorf("unsupported credential_source '%s': expecting Environment, Ec2InstanceMetadata or EcsContainer", source)
+	}
+}
+
+// processProvider retrieves credentials from the output of an external command,
+// as configured with credential_process
+type processProvider struct {
+	credentials.Expiry
+	command string
+}
+
+type processCredentials struct {
+	Version         int
+	AccessKeyID     string `json:"AccessKeyId"`
+	SecretAccessKey string
+	SessionToken    string
+	Expiration      *time.Time
+}
+
+func (p *processProvider) Retrieve() (credentials.Value, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd.exe", "/C", p.command)
+	} else {
+		cmd = exec.Command("sh", "-c", p.command)
+	}
+	cmd.Stderr = os.Stderr
+	cmd.Env = os.Environ()
+	out, err := cmd.Output()
+	if err != nil {
+		return credentials.Value{ProviderName: ProcessProviderName}, fmt.Errorf("credential_process '%s': %s", p.command, err)
+	}
+	var creds processCredentials
+	if err = json.Unmarshal(out, &creds); err != nil {
+		return credentials.Value{ProviderName: ProcessProviderName}, fmt.Errorf("credential_process '%s': invalid output: %s", p.command, err)
+	}
+	if creds.Version != 1 {
+		return credentials.Value{ProviderName: ProcessProviderName}, fmt.Errorf("credential_process '%s': unsupported version %d, expecting 1", p.command, creds.Version)
+	}
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return credentials.Value{ProviderName: ProcessProviderName}, fmt.Errorf("credential_process '%s': missing AccessKeyId or SecretAccessKey", p.command)
+	}
+	if creds.Expiration != nil {
+		p.SetExpiration(*creds.Expiration, time.Minute)
+	} else {
+		p.SetExpiration(time.Now().AddDate(100, 0, 0), 0)
+	}
+	return credentials.Value{
+		AccessKeyID:     creds.AccessKeyID,
+		SecretAccessKey: creds.SecretAccessKey,
+		SessionToken:    creds.SessionToken,
+		ProviderName:    ProcessProviderName,
+	}, nil
+}
+
+// ssoProvider retrieves role credentials with the AWS SSO token
+// cached by 'aws sso login'
+type ssoProvider struct {
+	credentials.Expiry
+	profile, session                      string
+	startURL, region, accountID, roleName string
+	cacheDir                              string
+	endpoint                              string
+	client                                *http.Client
+}
+
+type ssoCachedToken struct {
+	AccessToken string    `json:"accessToken"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+type ssoRoleCredentials struct {
+	RoleCredentials struct {
+		AccessKeyID     string `json:"accessKeyId"`
+		SecretAccessKey string `json:"secretAccessKey"`
+		SessionToken    string `json:"sessionToken"`
+		Expiration      int64  `json:"expiration"`
+	} `json:"roleCredentials"`
+}
+
+func (p *ssoProvider) Retrieve() (credentials.Value, error) {
+	empty := credentials.Value{ProviderName: SSOProviderName}
+	token, err := p.cachedToken()
+	if err != nil {
+		return empty, err
+	}
+
+	endpoint := p.endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://portal.sso.%s.amazonaws.com", p.region)
+	}
+	query := url.Values{"account_id": {p.accountID}, "role_name": {p.roleName}}
+	req, err := http.NewRequest("GET", endpoint+"/federation/credentials?"+query.Encode(), nil)
+	if err != nil {
+		return empty, err
+	}
+	req.Header.Set("x-amz-sso_bearer_token", token.AccessToken)
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return empty, fmt.Errorf("AWS SSO: %s", err)
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return empty, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return empty, fmt.Errorf("AWS SSO: getting role credentials of %s in account %s: %s: %s", p.roleName, p.accountID, resp.Status, strings.TrimSpace(string(body)))
+	}
+	var out ssoRoleCredentials
+	if err = json.Unmarshal(body, &out); err != nil {
+		return empty, fmt.Errorf("AWS SSO: invalid role credentials: %s", err)
+	}
+	p.SetExpiration(time.Unix(0, out.RoleCredentials.Expiration*int64(time.Millisecond)), time.Minute)
+	return credentials.Value{
+		AccessKeyID:     out.RoleCredentials.AccessKeyID,
+		SecretAccessKey: out.RoleCredentials.SecretAccessKey,
+		SessionToken:    out.RoleCredentials.SessionToken,
+		ProviderName:    SSOProviderName,
+	}, nil
+}
+
+// cachedToken reads the token of the SSO session, stored by the AWS CLI
+// in a file named after the SHA1 of the session name (or of the start URL)
+func (p *ssoProvider) cachedToken() (*ssoCachedToken, error) {
+	key := p.startURL
+	if p.session != "" {
+		key = p.session
+	}
+	loginHint := fmt.Errorf("no valid AWS SSO session for profile '%s': run 'aws sso login --profile %s'", p.profile, p.profile)
+	b, err := ioutil.ReadFile(filepath.Join(p.cacheDir, fmt.Sprintf("%x.json", sha1.Sum([]byte(key)))))
+	if err != nil {
+		return nil, loginHint
+	}
+	var token ssoCachedToken
+	if err = json.Unmarshal(b, &token); err != nil || token.AccessToken == "" {
+		return nil, loginHint
+	}
+	if token.ExpiresAt.Before(time.Now()) {
+		return nil, loginHint
+	}
+	return &token, nil
+}
+
+// SharedConfigRegion returns the region configured for the profile in the AWS CLI shared files
+func SharedConfigRegion(profile string) (string, bool) {
+	region := loadSharedProfiles().region(profile)
+	return region, region != ""
+}
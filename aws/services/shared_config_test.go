@@ -0,0 +1,222 @@
+package awsservices
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+const testSharedConfig = `
+[default]
+region = eu-west-1
+
+[profile static-role]
+role_arn = arn:aws:iam::123456789012:role/admin
+source_profile = default
+
+[profile chained-role]
+role_arn = arn:aws:iam::210987654321:role/readonly
+source_profile = static-role
+
+[profile process]
+credential_process = echo '{"Version": 1, "AccessKeyId": "AKIDPROCESS", "SecretAccessKey": "secret", "SessionToken": "token"}'
+
+[profile process-role]
+role_arn = arn:aws:iam::123456789012:role/admin
+source_profile = process
+
+[profile sso]
+sso_start_url = https://my-sso.awsapps.com/start
+sso_region = us-east-1
+sso_account_id = 123456789012
+sso_role_name = Admin
+region = us-west-2
+
+[profile sso-session]
+sso_session = corp
+sso_account_id = 123456789012
+sso_role_name = Admin
+
+[sso-session corp]
+sso_start_url = https://corp.awsapps.com/start
+sso_region = eu-central-1
+
+[profile incomplete-sso]
+sso_start_url = https://my-sso.awsapps.com/start
+
+[profile env-role]
+role_arn = arn:aws:iam::123456789012:role/admin
+credential_source = Environment
+
+[profile cycle-a]
+role_arn = arn:aws:iam::123456789012:role/a
+source_profile = cycle-b
+
+[profile cycle-b]
+role_arn = arn:aws:iam::123456789012:role/b
+source_profile = cycle-a
+`
+
+const testSharedCredentials = `
+[default]
+aws_access_key_id = AKIDDEFAULT
+aws_secret_access_key = secret
+`
+
+func TestSharedProfilesCredentials(t *testing.T) {
+	profiles, cleanup := newTestSharedProfiles(t)
+	defer cleanup()
+
+	tcases := []struct {
+		profile      string
+		expCustom    bool
+		expProvider  string
+		expErrPrefix string
+	}{
+		{profile: "default", expCustom: false, expProvider: credentials.StaticProviderName},
+		{profile: "static-role", expCustom: false},
+		{profile: "chained-role", expCustom: true},
+		{profile: "process", expCustom: true, expProvider: ProcessProviderName},
+		{profile: "process-role", expCustom: true},
+		{profile: "sso", expCustom: true},
+		{profile: "sso-session", expCustom: true},
+		{profile: "incomplete-sso", expCustom: true, expErrPrefix: "profile 'incomplete-sso': incomplete AWS SSO configuration"},
+		{profile: "env-role", expCustom: true},
+		{profile: "cycle-a", expCustom: true, expErrPrefix: "profile 'cycle-a': cycle in source_profile"},
+		{profile: "unknown", expCustom: false, expErrPrefix: "profile 'unknown': no credentials found"},
+	}
+	for _, tcase := range tcases {
+		if got, want := profiles.needsCustomCredentials(tcase.profile), tcase.expCustom; got != want {
+			t.Fatalf("%s: got %t, want %t", tcase.profile, got, want)
+		}
+		creds, err := profiles.credentials(tcase.profile, "eu-west-1")
+		if tcase.expErrPrefix != "" {
+			if err == nil || !strings.HasPrefix(err.Error(), tcase.expErrPrefix) {
+				t.Fatalf("%s: got %v, want error starting with %q", tcase.profile, err, tcase.expErrPrefix)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %s", tcase.profile, err)
+		}
+		if tcase.expProvider != "" {
+			val, err := creds.Get()
+			if err != nil {
+				t.Fatalf("%s: %s", tcase.profile, err)
+			}
+			if got, want := val.ProviderName, tcase.expProvider; got != want {
+				t.Fatalf("%s: got %s, want %s", tcase.profile, got, want)
+			}
+		}
+	}
+
+	if got, want := profiles.region("sso"), "us-west-2"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := profiles.region("default"), "eu-west-1"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+func TestProcessProvider(t *testing.T) {
+	expiration := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	provider := &processProvider{command: fmt.Sprintf(`echo '{"Version": 1, "AccessKeyId": "AKID", "SecretAccessKey": "secret", "Expiration": "%s"}'`, expiration)}
+	val, err := provider.Retrieve()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := val.AccessKeyID, "AKID"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if provider.IsExpired() {
+		t.Fatal("expected credentials not expired")
+	}
+
+	for _, cmd := range []string{`echo '{"Version": 2, "AccessKeyId": "AKID", "SecretAccessKey": "secret"}'`, `echo 'not json'`, `exit 1`, `echo '{"Version": 1}'`} {
+		if _, err := (&processProvider{command: cmd}).Retrieve(); err == nil {
+			t.Fatalf("%s: expected error", cmd)
+		}
+	}
+}
+
+func TestSSOProvider(t *testing.T) {
+	cacheDir, err := ioutil.TempDir("", "awless-sso-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(cacheDir)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("x-amz-sso_bearer_token") != "my-token" || r.URL.Path != "/federation/credentials" ||
+			r.URL.Query().Get("account_id") != "123456789012" || r.URL.Query().Get("role_name") != "Admin" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		exp := time.Now().Add(time.Hour).UnixNano() / int64(time.Millisecond)
+		fmt.Fprintf(w, `{"roleCredentials":{"accessKeyId":"AKIDSSO","secretAccessKey":"secret","sessionToken":"session","expiration":%d}}`, exp)
+	}))
+	defer server.Close()
+
+	provider := &ssoProvider{profile: "sso", session: "corp", startURL: "https://corp.awsapps.com/start", region: "eu-central-1",
+		accountID: "123456789012", roleName: "Admin", cacheDir: cacheDir, endpoint: server.URL, client: http.DefaultClient}
+
+	if _, err = provider.Retrieve(); err == nil || !strings.Contains(err.Error(), "aws sso login --profile sso") {
+		t.Fatalf("expected login hint, got %v", err)
+	}
+
+	tokenFile := filepath.Join(cacheDir, fmt.Sprintf("%x.json", sha1.Sum([]byte("corp"))))
+	expired := fmt.Sprintf(`{"accessToken": "my-token", "expiresAt": "%s"}`, time.Now().Add(-time.Hour).UTC().Format(time.RFC3339))
+	if err = ioutil.WriteFile(tokenFile, []byte(expired), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = provider.Retrieve(); err == nil {
+		t.Fatal("expected error with expired token")
+	}
+
+	valid := fmt.Sprintf(`{"accessToken": "my-token", "expiresAt": "%s"}`, time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+	if err = ioutil.WriteFile(tokenFile, []byte(valid), 0600); err != nil {
+		t.Fatal(err)
+	}
+	val, err := provider.Retrieve()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := val.AccessKeyID, "AKIDSSO"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := val.ProviderName, SSOProviderName; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if provider.IsExpired() {
+		t.Fatal("expected credentials not expired")
+	}
+
+	provider.roleName = "Other"
+	if _, err = provider.Retrieve(); err == nil || !strings.Contains(err.Error(), "401") {
+		t.Fatalf("expected unauthorized error, got %v", err)
+	}
+}
+
+func newTestSharedProfiles(t *testing.T) (*sharedProfiles, func()) {
+	dir, err := ioutil.TempDir("", "awless-shared-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	configFile, credsFile := filepath.Join(dir, "config"), filepath.Join(dir, "credentials")
+	if err = ioutil.WriteFile(configFile, []byte(testSharedConfig), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(credsFile, []byte(testSharedCredentials), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return newSharedProfiles(credsFile, configFile), func() { os.RemoveAll(dir) }
+}
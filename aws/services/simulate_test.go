@@ -0,0 +1,68 @@
+package awsservices
+
+import (
+	"reflect"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+type mockSimulateIAM struct {
+	iamiface.IAMAPI
+	simulated *iam.SimulatePrincipalPolicyInput
+}
+
+func (m *mockSimulateIAM) GetRole(in *iam.GetRoleInput) (*iam.GetRoleOutput, error) {
+	return &iam.GetRoleOutput{Role: &iam.Role{Arn: awssdk.String("arn:aws:iam::123456789012:role/ops/" + awssdk.StringValue(in.RoleName))}}, nil
+}
+
+func (m *mockSimulateIAM) SimulatePrincipalPolicyPages(in *iam.SimulatePrincipalPolicyInput, fn func(*iam.SimulatePolicyResponse, bool) bool) error {
+	m.simulated = in
+	fn(&iam.SimulatePolicyResponse{EvaluationResults: []*iam.EvaluationResult{
+		{EvalActionName: awssdk.String("ec2:CreateVpc"), EvalDecision: awssdk.String("allowed")},
+		{EvalActionName: awssdk.String("ec2:CreateSubnet"), EvalDecision: awssdk.String("implicitDeny")},
+	}}, false)
+	fn(&iam.SimulatePolicyResponse{EvaluationResults: []*iam.EvaluationResult{
+		{EvalActionName: awssdk.String("iam:CreateRole"), EvalDecision: awssdk.String("explicitDeny")},
+	}}, true)
+	return nil
+}
+
+func TestSimulateActions(t *testing.T) {
+	actions := []string{"ec2:CreateVpc", "ec2:CreateSubnet", "iam:CreateRole"}
+	tcases := []struct {
+		arn, expPrincipal string
+	}{
+		{arn: "arn:aws:iam::123456789012:user/jdoe", expPrincipal: "arn:aws:iam::123456789012:user/jdoe"},
+		{arn: "arn:aws:sts::123456789012:assumed-role/deployer/awless", expPrincipal: "arn:aws:iam::123456789012:role/ops/deployer"},
+	}
+	for _, tcase := range tcases {
+		mock := &mockSimulateIAM{}
+		access := Access{IAMAPI: mock, STSAPI: &mockSTS{output: &sts.GetCallerIdentityOutput{Arn: awssdk.String(tcase.arn)}}}
+		denied, err := access.SimulateActions(actions)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := denied, map[string]string{"ec2:CreateSubnet": "implicitDeny", "iam:CreateRole": "explicitDeny"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := awssdk.StringValue(mock.simulated.PolicySourceArn), tcase.expPrincipal; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := awssdk.StringValueSlice(mock.simulated.ActionNames), actions; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+
+	access := Access{STSAPI: &mockSTS{output: &sts.GetCallerIdentityOutput{Arn: awssdk.String("arn:aws:iam::123456789012:root")}}}
+	if denied, err := access.SimulateActions(actions); err != nil || len(denied) > 0 {
+		t.Fatalf("got %v, %v", denied, err)
+	}
+	access = Access{STSAPI: &mockSTS{output: &sts.GetCallerIdentityOutput{Arn: awssdk.String("arn:aws:sts::123456789012:federated-user/jdoe")}}}
+	if _, err := access.SimulateActions(actions); err == nil {
+		t.Fatal("expected error got none")
+	}
+}
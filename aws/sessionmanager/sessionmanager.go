@@ -0,0 +1,90 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sessionmanager is a client of the Session Manager calls of the AWS Systems Manager API
+// (version 2014-11-06), limited to the calls and fields used by awless.
+//
+// The SSM client of the vendored aws-sdk-go (1.12.55) predates Session Manager, which the SDK added in 1.15.33.
+// The types mirror service/ssm of aws-sdk-go 1.15.33. Being SSM requests, they share the SSM rate limit.
+// Replace this package with the SDK SSM client when bumping the vendored aws-sdk-go past this version.
+package sessionmanager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/private/protocol/jsonrpc"
+)
+
+const (
+	ServiceName = "ssm"
+	EndpointsID = ServiceName
+)
+
+// SessionManager provides the Session Manager operation methods for making requests to AWS Systems Manager
+type SessionManager struct {
+	*client.Client
+}
+
+// New creates a new instance of the SessionManager client with a session
+func New(p client.ConfigProvider, cfgs ...*aws.Config) *SessionManager {
+	c := p.ClientConfig(EndpointsID, cfgs...)
+	svc := &SessionManager{
+		Client: client.New(
+			*c.Config,
+			metadata.ClientInfo{
+				ServiceName:   ServiceName,
+				SigningName:   c.SigningName,
+				SigningRegion: c.SigningRegion,
+				Endpoint:      c.Endpoint,
+				APIVersion:    "2014-11-06",
+				JSONVersion:   "1.1",
+				TargetPrefix:  "AmazonSSM",
+			},
+			c.Handlers,
+		),
+	}
+	svc.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	svc.Handlers.Build.PushBackNamed(jsonrpc.BuildHandler)
+	svc.Handlers.Unmarshal.PushBackNamed(jsonrpc.UnmarshalHandler)
+	svc.Handlers.UnmarshalMeta.PushBackNamed(jsonrpc.UnmarshalMetaHandler)
+	svc.Handlers.UnmarshalError.PushBackNamed(jsonrpc.UnmarshalErrorHandler)
+	return svc
+}
+
+// StartSession initiates a connection to a target (ex: an instance) for a Session Manager session
+func (c *SessionManager) StartSession(input *StartSessionInput) (*StartSessionOutput, error) {
+	if input == nil {
+		input = &StartSessionInput{}
+	}
+	output := &StartSessionOutput{}
+	op := &request.Operation{Name: "StartSession", HTTPMethod: "POST", HTTPPath: "/"}
+	return output, c.NewRequest(op, input, output).Send()
+}
+
+type StartSessionInput struct {
+	DocumentName *string              `type:"string"`
+	Parameters   map[string][]*string `type:"map"`
+	Target       *string              `min:"1" type:"string" required:"true"`
+}
+
+type StartSessionOutput struct {
+	SessionId  *string `min:"1" type:"string"`
+	StreamUrl  *string `type:"string"`
+	TokenValue *string `type:"string"`
+}
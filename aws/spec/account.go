@@ -0,0 +1,158 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/organizations/organizationsiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateAccount struct {
+	_             string `action:"create" entity:"account" awsAPI:"organizations"`
+	logger        *logger.Logger
+	graph         cloud.GraphAPI
+	api           organizationsiface.OrganizationsAPI
+	Email         *string `awsName:"Email" awsType:"awsstr" templateName:"email"`
+	Name          *string `awsName:"AccountName" awsType:"awsstr" templateName:"name"`
+	Role          *string `awsName:"RoleName" awsType:"awsstr" templateName:"role"`
+	BillingAccess *string `templateName:"billing-access"`
+	Timeout       *string `templateName:"timeout"`
+}
+
+func (cmd *CreateAccount) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("email"), params.Key("name"), params.Opt("billing-access", "role", "timeout")),
+		params.Validators{
+			"billing-access": params.IsInEnumIgnoreCase("allow", "deny"),
+			"timeout":        isCheckTimeout,
+		})
+}
+
+// ManualRun requests the creation of the account and waits for it, AWS creating accounts asynchronously
+func (cmd *CreateAccount) ManualRun(renv env.Running) (interface{}, error) {
+	timeout := 10 * time.Minute
+	if cmd.Timeout != nil {
+		var err error
+		if timeout, err = parseCheckTimeout(StringValue(cmd.Timeout)); err != nil {
+			return nil, err
+		}
+	}
+	input := &organizations.CreateAccountInput{Email: cmd.Email, AccountName: cmd.Name, RoleName: cmd.Role}
+	if cmd.BillingAccess != nil {
+		input.IamUserAccessToBilling = String(strings.ToUpper(StringValue(cmd.BillingAccess)))
+	}
+	start := time.Now()
+	out, err := cmd.api.CreateAccount(input)
+	cmd.logger.ExtraVerbosef("organizations.CreateAccount call took %s", time.Since(start))
+	if err != nil {
+		return nil, err
+	}
+
+	var status *organizations.CreateAccountStatus
+	c := &checker{
+		renv:        renv,
+		description: fmt.Sprintf("account %s", StringValue(cmd.Name)),
+		timeout:     timeout,
+		frequency:   5 * time.Second,
+		fetchFunc: func() (string, error) {
+			output, err := cmd.api.DescribeCreateAccountStatus(&organizations.DescribeCreateAccountStatusInput{CreateAccountRequestId: out.CreateAccountStatus.Id})
+			if err != nil {
+				return "", err
+			}
+			status = output.CreateAccountStatus
+			if state := StringValue(status.State); state == organizations.CreateAccountStateFailed {
+				return "", fmt.Errorf("%s: %s", state, StringValue(status.FailureReason))
+			}
+			return StringValue(status.State), nil
+		},
+		expect: organizations.CreateAccountStateSucceeded,
+		logger: cmd.logger,
+	}
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+func (cmd *CreateAccount) ExtractResult(i interface{}) string {
+	return StringValue(i.(*organizations.CreateAccountStatus).AccountId)
+}
+
+type MoveAccount struct {
+	_           string `action:"move" entity:"account" awsAPI:"organizations" awsCall:"MoveAccount" awsInput:"organizations.MoveAccountInput" awsOutput:"organizations.MoveAccountOutput"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         organizationsiface.OrganizationsAPI
+	Id          *string `awsName:"AccountId" awsType:"awsstr" templateName:"id"`
+	Source      *string `awsName:"SourceParentId" awsType:"awsstr" templateName:"source"`
+	Destination *string `awsName:"DestinationParentId" awsType:"awsstr" templateName:"destination"`
+}
+
+func (cmd *MoveAccount) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("destination"), params.Key("id"), params.Opt("source")))
+}
+
+// BeforeRun looks up the root or organizational unit containing the account when no source is given
+func (cmd *MoveAccount) BeforeRun(renv env.Running) error {
+	if cmd.Source != nil {
+		return nil
+	}
+	parent, err := cmd.currentParent()
+	if err != nil {
+		return err
+	}
+	cmd.Source = String(parent)
+	return nil
+}
+
+func (cmd *MoveAccount) ExtractResult(i interface{}) string {
+	return StringValue(cmd.Id)
+}
+
+// PriorState returns the parent of the account before the move, so that it can be moved back
+func (cmd *MoveAccount) PriorState(renv env.Running, params map[string]interface{}) (map[string]interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, err
+	}
+	parent := StringValue(cmd.Source)
+	if parent == "" {
+		var err error
+		if parent, err = cmd.currentParent(); err != nil {
+			return nil, err
+		}
+	}
+	return map[string]interface{}{"destination": parent}, nil
+}
+
+func (cmd *MoveAccount) currentParent() (string, error) {
+	out, err := cmd.api.ListParents(&organizations.ListParentsInput{ChildId: cmd.Id})
+	if err != nil {
+		return "", err
+	}
+	if len(out.Parents) == 0 {
+		return "", errors.New("cannot find the current parent of the account")
+	}
+	return StringValue(out.Parents[0].Id), nil
+}
@@ -0,0 +1,55 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk/elasticbeanstalkiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateApplication struct {
+	_           string `action:"create" entity:"application" awsAPI:"elasticbeanstalk" awsCall:"CreateApplication" awsInput:"elasticbeanstalk.CreateApplicationInput" awsOutput:"elasticbeanstalk.ApplicationDescriptionMessage"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         elasticbeanstalkiface.ElasticBeanstalkAPI
+	Name        *string `awsName:"ApplicationName" awsType:"awsstr" templateName:"name"`
+	Description *string `awsName:"Description" awsType:"awsstr" templateName:"description"`
+}
+
+func (cmd *CreateApplication) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Opt("description")),
+		params.Validators{"name": params.MaxLengthOf(100)},
+	)
+}
+
+func (cmd *CreateApplication) ExtractResult(i interface{}) string {
+	return StringValue(cmd.Name)
+}
+
+type DeleteApplication struct {
+	_      string `action:"delete" entity:"application" awsAPI:"elasticbeanstalk" awsCall:"DeleteApplication" awsInput:"elasticbeanstalk.DeleteApplicationInput" awsOutput:"elasticbeanstalk.DeleteApplicationOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    elasticbeanstalkiface.ElasticBeanstalkAPI
+	Name   *string `awsName:"ApplicationName" awsType:"awsstr" templateName:"name"`
+	Force  *bool   `awsName:"TerminateEnvByForce" awsType:"awsbool" templateName:"force"`
+}
+
+func (cmd *DeleteApplication) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Opt("force")))
+}
@@ -16,6 +16,10 @@ limitations under the License.
 package awsspec
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/wallix/awless/cloud"
@@ -47,6 +51,9 @@ func (cmd *CreateBucket) ExtractResult(i interface{}) string {
 	return StringValue(cmd.Name)
 }
 
+// UpdateBucket applies to the bucket each of the configurations given: ACL, website, policy,
+// versioning, CORS, lifecycle rules and default encryption. The policy, CORS and lifecycle
+// configurations are the JSON documents of the S3 API, 'none' removing them
 type UpdateBucket struct {
 	_                string `action:"update" entity:"bucket" awsAPI:"s3"`
 	logger           *logger.Logger
@@ -57,19 +64,48 @@ type UpdateBucket struct {
 	PublicWebsite    *bool   `templateName:"public-website"`
 	RedirectHostname *string `templateName:"redirect-hostname"`
 	IndexSuffix      *string `templateName:"index-suffix"`
+	ErrorDocument    *string `templateName:"error-document"`
 	EnforceHttps     *bool   `templateName:"enforce-https"`
+	Policy           *string `templateName:"policy"`
+	Versioning       *string `templateName:"versioning"`
+	Cors             *string `templateName:"cors"`
+	Lifecycle        *string `templateName:"lifecycle"`
+	Encryption       *string `templateName:"encryption"`
+	KmsKey           *string `templateName:"kms-key"`
 }
 
 func (cmd *UpdateBucket) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("name"),
-		params.Opt("acl", "enforce-https", "index-suffix", "public-website", "redirect-hostname"),
-	))
+		params.Opt("acl", "cors", "encryption", "enforce-https", "error-document", "index-suffix", "kms-key", "lifecycle", "policy", "public-website", "redirect-hostname", "versioning"),
+	), params.Validators{
+		"versioning": params.IsInEnumIgnoreCase("enabled", "suspended"),
+		"encryption": params.IsInEnumIgnoreCase(bucketEncryptionAES256, bucketEncryptionKMS, bucketConfigNone),
+		"kms-key": func(i interface{}, others map[string]interface{}) error {
+			if enc, _ := others["encryption"].(string); strings.ToLower(enc) != bucketEncryptionKMS {
+				return fmt.Errorf("only applicable with encryption=%s", bucketEncryptionKMS)
+			}
+			return nil
+		},
+		"policy": func(i interface{}, others map[string]interface{}) error {
+			if s := fmt.Sprint(i); !isBucketConfigNone(s) && !json.Valid([]byte(s)) {
+				return errors.New("invalid policy: expecting a JSON document or 'none'")
+			}
+			return nil
+		},
+		"cors": func(i interface{}, others map[string]interface{}) error {
+			_, err := parseBucketCors(fmt.Sprint(i))
+			return err
+		},
+		"lifecycle": func(i interface{}, others map[string]interface{}) error {
+			_, err := parseBucketLifecycle(fmt.Sprint(i))
+			return err
+		},
+	})
 }
 
 func (cmd *UpdateBucket) ManualRun(renv env.Running) (interface{}, error) {
-	start := time.Now()
-
 	if cmd.Acl != nil { // Update the canned ACL to apply to the bucket
+		start := time.Now()
 		input := &s3.PutBucketAclInput{
 			Bucket: cmd.Name,
 		}
@@ -83,10 +119,10 @@ func (cmd *UpdateBucket) ManualRun(renv env.Running) (interface{}, error) {
 		}
 
 		cmd.logger.ExtraVerbosef("s3.PutBucketAcl call took %s", time.Since(start))
-		return nil, nil
 	}
 
 	if cmd.PublicWebsite != nil { // Set/Unset this bucket as a public website
+		start := time.Now()
 		if BoolValue(cmd.PublicWebsite) {
 			input := &s3.PutBucketWebsiteInput{
 				Bucket:               cmd.Name,
@@ -102,6 +138,9 @@ func (cmd *UpdateBucket) ManualRun(renv env.Running) (interface{}, error) {
 			} else {
 				input.WebsiteConfiguration.IndexDocument = &s3.IndexDocument{Suffix: aws.String("index.html")}
 			}
+			if cmd.ErrorDocument != nil && cmd.RedirectHostname == nil {
+				input.WebsiteConfiguration.ErrorDocument = &s3.ErrorDocument{Key: cmd.ErrorDocument}
+			}
 
 			if _, err := cmd.api.PutBucketWebsite(input); err != nil {
 				return nil, err
@@ -113,9 +152,171 @@ func (cmd *UpdateBucket) ManualRun(renv env.Running) (interface{}, error) {
 		}
 		cmd.logger.ExtraVerbosef("s3.PutBucketWebsite call took %s", time.Since(start))
 	}
+
+	if cmd.Policy != nil {
+		start := time.Now()
+		if isBucketConfigNone(StringValue(cmd.Policy)) {
+			if _, err := cmd.api.DeleteBucketPolicy(&s3.DeleteBucketPolicyInput{Bucket: cmd.Name}); err != nil {
+				return nil, err
+			}
+		} else if _, err := cmd.api.PutBucketPolicy(&s3.PutBucketPolicyInput{Bucket: cmd.Name, Policy: cmd.Policy}); err != nil {
+			return nil, err
+		}
+		cmd.logger.ExtraVerbosef("s3.PutBucketPolicy call took %s", time.Since(start))
+	}
+
+	if cmd.Versioning != nil {
+		start := time.Now()
+		if _, err := cmd.api.PutBucketVersioning(&s3.PutBucketVersioningInput{
+			Bucket:                  cmd.Name,
+			VersioningConfiguration: &s3.VersioningConfiguration{Status: aws.String(strings.Title(strings.ToLower(StringValue(cmd.Versioning))))},
+		}); err != nil {
+			return nil, err
+		}
+		cmd.logger.ExtraVerbosef("s3.PutBucketVersioning call took %s", time.Since(start))
+	}
+
+	if cmd.Cors != nil {
+		start := time.Now()
+		cors, err := parseBucketCors(StringValue(cmd.Cors))
+		if err != nil {
+			return nil, err
+		}
+		if cors == nil {
+			_, err = cmd.api.DeleteBucketCors(&s3.DeleteBucketCorsInput{Bucket: cmd.Name})
+		} else {
+			_, err = cmd.api.PutBucketCors(&s3.PutBucketCorsInput{Bucket: cmd.Name, CORSConfiguration: cors})
+		}
+		if err != nil {
+			return nil, err
+		}
+		cmd.logger.ExtraVerbosef("s3.PutBucketCors call took %s", time.Since(start))
+	}
+
+	if cmd.Lifecycle != nil {
+		start := time.Now()
+		lifecycle, err := parseBucketLifecycle(StringValue(cmd.Lifecycle))
+		if err != nil {
+			return nil, err
+		}
+		if lifecycle == nil {
+			_, err = cmd.api.DeleteBucketLifecycle(&s3.DeleteBucketLifecycleInput{Bucket: cmd.Name})
+		} else {
+			_, err = cmd.api.PutBucketLifecycleConfiguration(&s3.PutBucketLifecycleConfigurationInput{Bucket: cmd.Name, LifecycleConfiguration: lifecycle})
+		}
+		if err != nil {
+			return nil, err
+		}
+		cmd.logger.ExtraVerbosef("s3.PutBucketLifecycleConfiguration call took %s", time.Since(start))
+	}
+
+	if cmd.Encryption != nil {
+		start := time.Now()
+		var err error
+		switch strings.ToLower(StringValue(cmd.Encryption)) {
+		case bucketConfigNone:
+			_, err = cmd.api.DeleteBucketEncryption(&s3.DeleteBucketEncryptionInput{Bucket: cmd.Name})
+		case bucketEncryptionKMS:
+			_, err = cmd.api.PutBucketEncryption(bucketEncryptionInput(cmd.Name, s3.ServerSideEncryptionAwsKms, cmd.KmsKey))
+		default:
+			_, err = cmd.api.PutBucketEncryption(bucketEncryptionInput(cmd.Name, s3.ServerSideEncryptionAes256, nil))
+		}
+		if err != nil {
+			return nil, err
+		}
+		cmd.logger.ExtraVerbosef("s3.PutBucketEncryption call took %s", time.Since(start))
+	}
 	return nil, nil
 }
 
+// IAMActions returns the actions of the configurations updated, the removal of the
+// CORS, lifecycle and encryption ones being allowed by the same actions as their update
+func (cmd *UpdateBucket) IAMActions(params map[string]interface{}) []string {
+	var actions []string
+	if _, ok := params["acl"]; ok {
+		actions = append(actions, "s3:PutBucketAcl")
+	}
+	if v, ok := params["public-website"]; ok {
+		if fmt.Sprint(v) == "false" {
+			actions = append(actions, "s3:DeleteBucketWebsite")
+		} else {
+			actions = append(actions, "s3:PutBucketWebsite")
+		}
+	}
+	if v, ok := params["policy"]; ok {
+		if isBucketConfigNone(fmt.Sprint(v)) {
+			actions = append(actions, "s3:DeleteBucketPolicy")
+		} else {
+			actions = append(actions, "s3:PutBucketPolicy")
+		}
+	}
+	for _, p := range []struct{ param, action string }{
+		{"versioning", "s3:PutBucketVersioning"},
+		{"cors", "s3:PutBucketCORS"},
+		{"lifecycle", "s3:PutLifecycleConfiguration"},
+		{"encryption", "s3:PutEncryptionConfiguration"},
+	} {
+		if _, ok := params[p.param]; ok {
+			actions = append(actions, p.action)
+		}
+	}
+	return actions
+}
+
+const (
+	bucketConfigNone       = "none"
+	bucketEncryptionAES256 = "aes256"
+	bucketEncryptionKMS    = "kms"
+)
+
+func isBucketConfigNone(s string) bool {
+	return strings.ToLower(strings.TrimSpace(s)) == bucketConfigNone
+}
+
+// parseBucketCors parses a CORS configuration (ex: {"CORSRules":[{"AllowedMethods":["GET"],"AllowedOrigins":["*"]}]}),
+// returning nil for 'none'
+func parseBucketCors(s string) (*s3.CORSConfiguration, error) {
+	if isBucketConfigNone(s) {
+		return nil, nil
+	}
+	cors := &s3.CORSConfiguration{}
+	if err := json.Unmarshal([]byte(s), cors); err != nil {
+		return nil, fmt.Errorf("invalid cors: %s", err)
+	}
+	if err := cors.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid cors: %s", err)
+	}
+	return cors, nil
+}
+
+// parseBucketLifecycle parses a lifecycle configuration
+// (ex: {"Rules":[{"ID":"expire-logs","Status":"Enabled","Filter":{"Prefix":"logs/"},"Expiration":{"Days":30}}]}),
+// returning nil for 'none'
+func parseBucketLifecycle(s string) (*s3.BucketLifecycleConfiguration, error) {
+	if isBucketConfigNone(s) {
+		return nil, nil
+	}
+	lifecycle := &s3.BucketLifecycleConfiguration{}
+	if err := json.Unmarshal([]byte(s), lifecycle); err != nil {
+		return nil, fmt.Errorf("invalid lifecycle: %s", err)
+	}
+	if err := lifecycle.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid lifecycle: %s", err)
+	}
+	return lifecycle, nil
+}
+
+func bucketEncryptionInput(bucket *string, algorithm string, kmsKey *string) *s3.PutBucketEncryptionInput {
+	return &s3.PutBucketEncryptionInput{
+		Bucket: bucket,
+		ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+			Rules: []*s3.ServerSideEncryptionRule{
+				{ApplyServerSideEncryptionByDefault: &s3.ServerSideEncryptionByDefault{SSEAlgorithm: aws.String(algorithm), KMSMasterKeyID: kmsKey}},
+			},
+		},
+	}
+}
+
 type DeleteBucket struct {
 	_      string `action:"delete" entity:"bucket" awsAPI:"s3" awsCall:"DeleteBucket" awsInput:"s3.DeleteBucketInput" awsOutput:"s3.DeleteBucketOutput"`
 	logger *logger.Logger
@@ -0,0 +1,153 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateCachecluster struct {
+	_                string `action:"create" entity:"cachecluster" awsAPI:"elasticache" awsCall:"CreateCacheCluster" awsInput:"elasticache.CreateCacheClusterInput" awsOutput:"elasticache.CreateCacheClusterOutput"`
+	logger           *logger.Logger
+	graph            cloud.GraphAPI
+	api              elasticacheiface.ElastiCacheAPI
+	Id               *string   `awsName:"CacheClusterId" awsType:"awsstr" templateName:"id"`
+	Engine           *string   `awsName:"Engine" awsType:"awsstr" templateName:"engine"`
+	Type             *string   `awsName:"CacheNodeType" awsType:"awsstr" templateName:"type"`
+	Count            *int64    `awsName:"NumCacheNodes" awsType:"awsint64" templateName:"count"`
+	SubnetGroup      *string   `awsName:"CacheSubnetGroupName" awsType:"awsstr" templateName:"subnetgroup"`
+	SecurityGroups   []*string `awsName:"SecurityGroupIds" awsType:"awsstringslice" templateName:"securitygroups"`
+	Port             *int64    `awsName:"Port" awsType:"awsint64" templateName:"port"`
+	Version          *string   `awsName:"EngineVersion" awsType:"awsstr" templateName:"version"`
+	AvailabilityZone *string   `awsName:"PreferredAvailabilityZone" awsType:"awsstr" templateName:"availabilityzone"`
+}
+
+func (cmd *CreateCachecluster) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("engine"), params.Key("id"), params.Key("type"),
+			params.Opt(params.Suggested("count"), "availabilityzone", "port", "securitygroups", "subnetgroup", "version"),
+		),
+		params.Validators{
+			"engine": params.IsInEnumIgnoreCase("redis", "memcached"),
+		})
+}
+
+func (cmd *CreateCachecluster) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*elasticache.CreateCacheClusterOutput).CacheCluster.CacheClusterId)
+}
+
+type DeleteCachecluster struct {
+	_      string `action:"delete" entity:"cachecluster" awsAPI:"elasticache" awsCall:"DeleteCacheCluster" awsInput:"elasticache.DeleteCacheClusterInput" awsOutput:"elasticache.DeleteCacheClusterOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    elasticacheiface.ElastiCacheAPI
+	Id     *string `awsName:"CacheClusterId" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *DeleteCachecluster) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
+
+type CheckCachecluster struct {
+	_       string `action:"check" entity:"cachecluster" awsAPI:"elasticache"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     elasticacheiface.ElastiCacheAPI
+	Id      *string `templateName:"id"`
+	State   *string `templateName:"state"`
+	Timeout *int64  `templateName:"timeout"`
+}
+
+func (cmd *CheckCachecluster) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("id"), params.Key("state"), params.Key("timeout")),
+		params.Validators{
+			"state": params.IsInEnumIgnoreCase("available", "creating", "deleting", "incompatible-network",
+				"modifying", "rebooting cache cluster nodes", "restore-failed", "snapshotting", notFoundState),
+		},
+	)
+}
+
+func (cmd *CheckCachecluster) ManualRun(renv env.Running) (interface{}, error) {
+	input := &elasticache.DescribeCacheClustersInput{
+		CacheClusterId: cmd.Id,
+	}
+
+	c := &checker{
+		renv:        renv,
+		description: fmt.Sprintf("cachecluster %s", StringValue(cmd.Id)),
+		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
+		frequency:   5 * time.Second,
+		fetchFunc: func() (string, error) {
+			output, err := cmd.api.DescribeCacheClusters(input)
+			if awserr, ok := err.(awserr.Error); ok && awserr.Code() == elasticache.ErrCodeCacheClusterNotFoundFault {
+				return notFoundState, nil
+			}
+			if err != nil {
+				return "", err
+			}
+			for _, cluster := range output.CacheClusters {
+				if StringValue(cluster.CacheClusterId) == StringValue(cmd.Id) {
+					return StringValue(cluster.CacheClusterStatus), nil
+				}
+			}
+			return notFoundState, nil
+		},
+		expect: StringValue(cmd.State),
+		logger: cmd.logger,
+	}
+	return nil, c.check()
+}
+
+type CreateCachesubnetgroup struct {
+	_           string `action:"create" entity:"cachesubnetgroup" awsAPI:"elasticache" awsCall:"CreateCacheSubnetGroup" awsInput:"elasticache.CreateCacheSubnetGroupInput" awsOutput:"elasticache.CreateCacheSubnetGroupOutput"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         elasticacheiface.ElastiCacheAPI
+	Name        *string   `awsName:"CacheSubnetGroupName" awsType:"awsstr" templateName:"name"`
+	Description *string   `awsName:"CacheSubnetGroupDescription" awsType:"awsstr" templateName:"description"`
+	Subnets     []*string `awsName:"SubnetIds" awsType:"awsstringslice" templateName:"subnets"`
+}
+
+func (cmd *CreateCachesubnetgroup) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("description"), params.Key("name"), params.Key("subnets")))
+}
+
+func (cmd *CreateCachesubnetgroup) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*elasticache.CreateCacheSubnetGroupOutput).CacheSubnetGroup.CacheSubnetGroupName)
+}
+
+type DeleteCachesubnetgroup struct {
+	_      string `action:"delete" entity:"cachesubnetgroup" awsAPI:"elasticache" awsCall:"DeleteCacheSubnetGroup" awsInput:"elasticache.DeleteCacheSubnetGroupInput" awsOutput:"elasticache.DeleteCacheSubnetGroupOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    elasticacheiface.ElastiCacheAPI
+	Name   *string `awsName:"CacheSubnetGroupName" awsType:"awsstr" templateName:"name"`
+}
+
+func (cmd *DeleteCachesubnetgroup) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name")))
+}
@@ -0,0 +1,53 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"github.com/aws/aws-sdk-go/service/glue/glueiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateCatalogdatabase struct {
+	_           string `action:"create" entity:"catalogdatabase" awsAPI:"glue" awsCall:"CreateDatabase" awsInput:"glue.CreateDatabaseInput" awsOutput:"glue.CreateDatabaseOutput"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         glueiface.GlueAPI
+	Name        *string `awsName:"DatabaseInput.Name" awsType:"awsstr" templateName:"name"`
+	Description *string `awsName:"DatabaseInput.Description" awsType:"awsstr" templateName:"description"`
+	Location    *string `awsName:"DatabaseInput.LocationUri" awsType:"awsstr" templateName:"location"`
+}
+
+func (cmd *CreateCatalogdatabase) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Opt("description", "location")))
+}
+
+func (cmd *CreateCatalogdatabase) ExtractResult(i interface{}) string {
+	return StringValue(cmd.Name)
+}
+
+type DeleteCatalogdatabase struct {
+	_      string `action:"delete" entity:"catalogdatabase" awsAPI:"glue" awsCall:"DeleteDatabase" awsInput:"glue.DeleteDatabaseInput" awsOutput:"glue.DeleteDatabaseOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    glueiface.GlueAPI
+	Name   *string `awsName:"Name" awsType:"awsstr" templateName:"name"`
+}
+
+func (cmd *DeleteCatalogdatabase) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name")))
+}
@@ -18,6 +18,7 @@ package awsspec
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/wallix/awless/cloud"
@@ -37,13 +38,31 @@ type CreateCertificate struct {
 	graph             cloud.GraphAPI
 	api               acmiface.ACMAPI
 	Domains           []*string `templateName:"domains"`
+	Validation        *string   `templateName:"validation"`
 	ValidationDomains []*string `templateName:"validation-domains"`
+	Zone              *string   `templateName:"zone"`
 }
 
 func (cmd *CreateCertificate) ParamsSpec() params.Spec {
-	return params.NewSpec(params.AllOf(params.Key("domains"),
-		params.Opt("validation-domains"),
-	))
+	builder := params.SpecBuilder(params.AllOf(params.OnlyOneOf(params.Key("domains"), params.Key("domain")),
+		params.Opt(params.Suggested("validation"), "validation-domains", "zone"),
+	), params.Validators{
+		"validation": params.IsInEnumIgnoreCase("email", "dns"),
+		"validation-domains": func(i interface{}, others map[string]interface{}) error {
+			if isDNSValidation(others["validation"]) {
+				return fmt.Errorf("only applicable to email validation")
+			}
+			return nil
+		},
+		"zone": func(i interface{}, others map[string]interface{}) error {
+			if !isDNSValidation(others["validation"]) {
+				return fmt.Errorf("only applicable to DNS validation (i.e. validation=dns)")
+			}
+			return nil
+		},
+	})
+	builder.AddReducer(domainToDomains, "domain")
+	return builder.Done()
 }
 
 func (cmd *CreateCertificate) ManualRun(renv env.Running) (interface{}, error) {
@@ -63,6 +82,10 @@ func (cmd *CreateCertificate) ManualRun(renv env.Running) (interface{}, error) {
 		}
 	}
 
+	if isDNSValidation(cmd.Validation) {
+		input.ValidationMethod = String(acm.ValidationMethodDns)
+	}
+
 	domainsToValidate := make(map[string]string)
 	// Extra params
 	if len(cmd.ValidationDomains) > 0 {
@@ -92,7 +115,7 @@ func (cmd *CreateCertificate) ManualRun(renv env.Running) (interface{}, error) {
 	}
 	cmd.logger.ExtraVerbosef("acm.RequestCertificate call took %s", time.Since(start))
 
-	if len(domainsToValidate) > 0 {
+	if len(domainsToValidate) > 0 && !isDNSValidation(cmd.Validation) {
 		var helpMsg bytes.Buffer
 		for domain, validationDomain := range domainsToValidate {
 			helpMsg.WriteString(fmt.Sprintf("\n\t-> %s: {admin/administrator/hostmaster/postmaster/webmaster}@%s", domain, validationDomain))
@@ -106,6 +129,101 @@ func (cmd *CreateCertificate) ExtractResult(i interface{}) string {
 	return awssdk.StringValue(i.(*acm.RequestCertificateOutput).CertificateArn)
 }
 
+// AfterRun creates the DNS records validating the domains of the certificate in the given zone,
+// or tells which ones to create when no zone is given
+func (cmd *CreateCertificate) AfterRun(renv env.Running, output interface{}) error {
+	if !isDNSValidation(cmd.Validation) {
+		return nil
+	}
+	records, err := cmd.fetchValidationRecords(renv, output.(*acm.RequestCertificateOutput).CertificateArn)
+	if err != nil {
+		return err
+	}
+	if cmd.Zone == nil {
+		var helpMsg bytes.Buffer
+		for _, record := range records {
+			helpMsg.WriteString(fmt.Sprintf("\n\t-> %s %s %s", StringValue(record.Name), StringValue(record.Type), StringValue(record.Value)))
+		}
+		cmd.logger.Warningf("validate your certificate by creating the DNS records: %s", helpMsg.String())
+		return nil
+	}
+	for _, record := range records {
+		cmd.logger.Infof("creating validation record %s in zone %s", StringValue(record.Name), StringValue(cmd.Zone))
+		// upsert as the record of a domain is the same for all its certificates
+		updateRecord := CommandFactory.Build("updaterecord")().(*UpdateRecord)
+		entries := map[string]interface{}{
+			"zone":   cmd.Zone,
+			"name":   record.Name,
+			"type":   record.Type,
+			"values": record.Value,
+			"ttl":    300,
+		}
+		if err := params.Validate(updateRecord.ParamsSpec().Validators(), entries); err != nil {
+			return err
+		}
+		if _, err := updateRecord.Run(renv, entries); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fetchValidationRecords waits for ACM to provide the DNS records validating the domains of the certificate,
+// which are only available a few seconds after the certificate request
+func (cmd *CreateCertificate) fetchValidationRecords(renv env.Running, arn *string) ([]*acm.ResourceRecord, error) {
+	var records []*acm.ResourceRecord
+	c := &checker{
+		renv:        renv,
+		description: fmt.Sprintf("certificate %s", StringValue(arn)),
+		timeout:     2 * time.Minute,
+		frequency:   5 * time.Second,
+		checkName:   "validation records",
+		fetchFunc: func() (string, error) {
+			output, err := cmd.api.DescribeCertificate(&acm.DescribeCertificateInput{CertificateArn: arn})
+			if err != nil {
+				return "", err
+			}
+			records = nil
+			if output.Certificate == nil || len(output.Certificate.DomainValidationOptions) == 0 {
+				return "pending", nil
+			}
+			names := make(map[string]bool)
+			for _, option := range output.Certificate.DomainValidationOptions {
+				if option.ResourceRecord == nil {
+					return "pending", nil
+				}
+				if name := StringValue(option.ResourceRecord.Name); !names[name] {
+					names[name] = true
+					records = append(records, option.ResourceRecord)
+				}
+			}
+			return "available", nil
+		},
+		expect: "available",
+		logger: cmd.logger,
+	}
+	return records, c.check()
+}
+
+func isDNSValidation(validation interface{}) bool {
+	switch v := validation.(type) {
+	case string:
+		return strings.EqualFold(v, "dns")
+	case *string:
+		return strings.EqualFold(StringValue(v), "dns")
+	default:
+		return false
+	}
+}
+
+func domainToDomains(values map[string]interface{}) (map[string]interface{}, error) {
+	if domain, hasDomain := values["domain"]; hasDomain {
+		return map[string]interface{}{"domains": domain}, nil
+	} else {
+		return nil, nil
+	}
+}
+
 type DeleteCertificate struct {
 	_      string `action:"delete" entity:"certificate" awsAPI:"acm" awsCall:"DeleteCertificate" awsInput:"acm.DeleteCertificateInput" awsOutput:"acm.DeleteCertificateOutput"`
 	logger *logger.Logger
@@ -118,6 +236,52 @@ func (cmd *DeleteCertificate) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("arn")))
 }
 
+type WaitCertificate struct {
+	_       string `action:"wait" entity:"certificate" awsAPI:"acm"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     acmiface.ACMAPI
+	Arn     *string `templateName:"arn"`
+	State   *string `templateName:"state"`
+	Timeout *string `templateName:"timeout"`
+}
+
+func (cmd *WaitCertificate) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("arn"), params.Opt(params.Suggested("state"), "timeout")),
+		params.Validators{
+			"state":   params.IsInEnumIgnoreCase("issued", "pending_validation"),
+			"timeout": isCheckTimeout,
+		})
+}
+
+// ManualRun waits for the certificate to reach the given state (by default issued),
+// its validation taking up to 30 minutes once the DNS records exist
+func (cmd *WaitCertificate) ManualRun(renv env.Running) (interface{}, error) {
+	timeout := 30 * time.Minute
+	if cmd.Timeout != nil {
+		var err error
+		if timeout, err = parseCheckTimeout(StringValue(cmd.Timeout)); err != nil {
+			return nil, err
+		}
+	}
+	state := acm.CertificateStatusIssued
+	if cmd.State != nil {
+		state = StringValue(cmd.State)
+	}
+	checkCertificate := CommandFactory.Build("checkcertificate")().(*CheckCertificate)
+	entries := map[string]interface{}{
+		"arn":     cmd.Arn,
+		"state":   state,
+		"timeout": int64(timeout / time.Second),
+	}
+	if err := params.Validate(checkCertificate.ParamsSpec().Validators(), entries); err != nil {
+		return nil, err
+	}
+	_, err := checkCertificate.Run(renv, entries)
+	return nil, err
+}
+
 type CheckCertificate struct {
 	_       string `action:"check" entity:"certificate" awsAPI:"acm"`
 	logger  *logger.Logger
@@ -142,6 +306,7 @@ func (cmd *CheckCertificate) ManualRun(renv env.Running) (interface{}, error) {
 	}
 
 	c := &checker{
+		renv:        renv,
 		description: fmt.Sprintf("certificate %s", StringValue(cmd.Arn)),
 		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
 		frequency:   5 * time.Second,
@@ -0,0 +1,123 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elb/elbiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+// Health check thresholds of classic load balancers, being the AWS console defaults
+const (
+	classicHealthCheckInterval           = 30
+	classicHealthCheckTimeout            = 5
+	classicHealthCheckHealthyThreshold   = 10
+	classicHealthCheckUnhealthyThreshold = 2
+)
+
+type CreateClassicloadbalancer struct {
+	_                string `action:"create" entity:"classicloadbalancer" awsAPI:"elb" awsCall:"CreateLoadBalancer" awsInput:"elb.CreateLoadBalancerInput" awsOutput:"elb.CreateLoadBalancerOutput"`
+	logger           *logger.Logger
+	graph            cloud.GraphAPI
+	api              elbiface.ELBAPI
+	Name             *string   `awsName:"LoadBalancerName" awsType:"awsstr" templateName:"name"`
+	Subnets          []*string `awsName:"Subnets" awsType:"awsstringslice" templateName:"subnets"`
+	Protocol         *string   `awsName:"Listeners[0]Protocol" awsType:"awsslicestruct" templateName:"protocol"`
+	Port             *int64    `awsName:"Listeners[0]LoadBalancerPort" awsType:"awsslicestructint64" templateName:"port"`
+	InstancePort     *int64    `awsName:"Listeners[0]InstancePort" awsType:"awsslicestructint64" templateName:"instance-port"`
+	InstanceProtocol *string   `awsName:"Listeners[0]InstanceProtocol" awsType:"awsslicestruct" templateName:"instance-protocol"`
+	Certificate      *string   `awsName:"Listeners[0]SSLCertificateId" awsType:"awsslicestruct" templateName:"certificate"`
+	Securitygroups   []*string `awsName:"SecurityGroups" awsType:"awsstringslice" templateName:"securitygroups"`
+	Scheme           *string   `awsName:"Scheme" awsType:"awsstr" templateName:"scheme"`
+	Healthcheck      *string   `templateName:"healthcheck"`
+}
+
+func (cmd *CreateClassicloadbalancer) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("name"), params.Key("subnets"), params.Key("protocol"), params.Key("port"), params.Key("instance-port"),
+			params.Opt("certificate", "healthcheck", "instance-protocol", "scheme", "securitygroups"),
+		),
+		params.Validators{
+			"protocol":          params.IsInEnumIgnoreCase("http", "https", "tcp", "ssl"),
+			"instance-protocol": params.IsInEnumIgnoreCase("http", "https", "tcp", "ssl"),
+			"scheme":            params.IsInEnumIgnoreCase("internet-facing", "internal"),
+		})
+}
+
+func (cmd *CreateClassicloadbalancer) ExtractResult(i interface{}) string {
+	return StringValue(cmd.Name)
+}
+
+// AfterRun configures the health check of the instances, when given as TARGET (ex: HTTP:80/health, TCP:22)
+func (cmd *CreateClassicloadbalancer) AfterRun(renv env.Running, output interface{}) error {
+	if cmd.Healthcheck == nil {
+		return nil
+	}
+	_, err := cmd.api.ConfigureHealthCheck(&elb.ConfigureHealthCheckInput{
+		LoadBalancerName: cmd.Name,
+		HealthCheck: &elb.HealthCheck{
+			Target:             cmd.Healthcheck,
+			Interval:           awssdk.Int64(classicHealthCheckInterval),
+			Timeout:            awssdk.Int64(classicHealthCheckTimeout),
+			HealthyThreshold:   awssdk.Int64(classicHealthCheckHealthyThreshold),
+			UnhealthyThreshold: awssdk.Int64(classicHealthCheckUnhealthyThreshold),
+		},
+	})
+	return err
+}
+
+type DeleteClassicloadbalancer struct {
+	_      string `action:"delete" entity:"classicloadbalancer" awsAPI:"elb" awsCall:"DeleteLoadBalancer" awsInput:"elb.DeleteLoadBalancerInput" awsOutput:"elb.DeleteLoadBalancerOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    elbiface.ELBAPI
+	Name   *string `awsName:"LoadBalancerName" awsType:"awsstr" templateName:"name"`
+}
+
+func (cmd *DeleteClassicloadbalancer) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name")))
+}
+
+type AttachClassicloadbalancer struct {
+	_        string `action:"attach" entity:"classicloadbalancer" awsAPI:"elb" awsCall:"RegisterInstancesWithLoadBalancer" awsInput:"elb.RegisterInstancesWithLoadBalancerInput" awsOutput:"elb.RegisterInstancesWithLoadBalancerOutput"`
+	logger   *logger.Logger
+	graph    cloud.GraphAPI
+	api      elbiface.ELBAPI
+	Name     *string `awsName:"LoadBalancerName" awsType:"awsstr" templateName:"name"`
+	Instance *string `awsName:"Instances[0]InstanceId" awsType:"awsslicestruct" templateName:"instance"`
+}
+
+func (cmd *AttachClassicloadbalancer) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("instance"), params.Key("name")))
+}
+
+type DetachClassicloadbalancer struct {
+	_        string `action:"detach" entity:"classicloadbalancer" awsAPI:"elb" awsCall:"DeregisterInstancesFromLoadBalancer" awsInput:"elb.DeregisterInstancesFromLoadBalancerInput" awsOutput:"elb.DeregisterInstancesFromLoadBalancerOutput"`
+	logger   *logger.Logger
+	graph    cloud.GraphAPI
+	api      elbiface.ELBAPI
+	Name     *string `awsName:"LoadBalancerName" awsType:"awsstr" templateName:"name"`
+	Instance *string `awsName:"Instances[0]InstanceId" awsType:"awsslicestruct" templateName:"instance"`
+}
+
+func (cmd *DetachClassicloadbalancer) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("instance"), params.Key("name")))
+}
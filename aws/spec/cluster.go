@@ -0,0 +1,206 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/redshift/redshiftiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateCluster struct {
+	_                string `action:"create" entity:"cluster" awsAPI:"redshift" awsCall:"CreateCluster" awsInput:"redshift.CreateClusterInput" awsOutput:"redshift.CreateClusterOutput"`
+	logger           *logger.Logger
+	graph            cloud.GraphAPI
+	api              redshiftiface.RedshiftAPI
+	Id               *string   `awsName:"ClusterIdentifier" awsType:"awsstr" templateName:"id"`
+	Type             *string   `awsName:"NodeType" awsType:"awsstr" templateName:"type"`
+	Nodes            *int64    `awsName:"NumberOfNodes" awsType:"awsint64" templateName:"nodes"`
+	ClusterType      *string   `awsName:"ClusterType" awsType:"awsstr"`
+	Username         *string   `awsName:"MasterUsername" awsType:"awsstr" templateName:"username"`
+	Password         *string   `awsName:"MasterUserPassword" awsType:"awsstr" templateName:"password"`
+	Dbname           *string   `awsName:"DBName" awsType:"awsstr" templateName:"dbname"`
+	Subnetgroup      *string   `awsName:"ClusterSubnetGroupName" awsType:"awsstr" templateName:"subnetgroup"`
+	SecurityGroups   []*string `awsName:"VpcSecurityGroupIds" awsType:"awsstringslice" templateName:"securitygroups"`
+	AvailabilityZone *string   `awsName:"AvailabilityZone" awsType:"awsstr" templateName:"availabilityzone"`
+	Port             *int64    `awsName:"Port" awsType:"awsint64" templateName:"port"`
+	Public           *bool     `awsName:"PubliclyAccessible" awsType:"awsbool" templateName:"public"`
+	Encrypted        *bool     `awsName:"Encrypted" awsType:"awsbool" templateName:"encrypted"`
+}
+
+func (cmd *CreateCluster) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("id"), params.Key("password"), params.Key("type"), params.Key("username"),
+			params.Opt(params.Suggested("nodes", "subnetgroup", "securitygroups"), "availabilityzone", "dbname", "encrypted", "port", "public"),
+		),
+		params.Validators{
+			"password": params.MinLengthOf(8),
+		})
+}
+
+// BeforeRun sets the cluster type from the number of nodes: a cluster of one node
+// is a single-node cluster, for which AWS expects no number of nodes
+func (cmd *CreateCluster) BeforeRun(renv env.Running) error {
+	if cmd.Nodes == nil || Int64AsIntValue(cmd.Nodes) == 1 {
+		cmd.ClusterType = String("single-node")
+		cmd.Nodes = nil
+	} else {
+		cmd.ClusterType = String("multi-node")
+	}
+	return nil
+}
+
+func (cmd *CreateCluster) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*redshift.CreateClusterOutput).Cluster.ClusterIdentifier)
+}
+
+type DeleteCluster struct {
+	_            string `action:"delete" entity:"cluster" awsAPI:"redshift" awsCall:"DeleteCluster" awsInput:"redshift.DeleteClusterInput" awsOutput:"redshift.DeleteClusterOutput"`
+	logger       *logger.Logger
+	graph        cloud.GraphAPI
+	api          redshiftiface.RedshiftAPI
+	Id           *string `awsName:"ClusterIdentifier" awsType:"awsstr" templateName:"id"`
+	SkipSnapshot *bool   `awsName:"SkipFinalClusterSnapshot" awsType:"awsbool" templateName:"skip-snapshot"`
+	Snapshot     *string `awsName:"FinalClusterSnapshotIdentifier" awsType:"awsstr" templateName:"snapshot"`
+}
+
+func (cmd *DeleteCluster) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"),
+		params.Opt("skip-snapshot", "snapshot"),
+	))
+}
+
+type ResizeCluster struct {
+	_           string `action:"resize" entity:"cluster" awsAPI:"redshift" awsCall:"ModifyCluster" awsInput:"redshift.ModifyClusterInput" awsOutput:"redshift.ModifyClusterOutput"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         redshiftiface.RedshiftAPI
+	Id          *string `awsName:"ClusterIdentifier" awsType:"awsstr" templateName:"id"`
+	Type        *string `awsName:"NodeType" awsType:"awsstr" templateName:"type"`
+	Nodes       *int64  `awsName:"NumberOfNodes" awsType:"awsint64" templateName:"nodes"`
+	ClusterType *string `awsName:"ClusterType" awsType:"awsstr"`
+}
+
+func (cmd *ResizeCluster) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"),
+		params.AtLeastOneOf(params.Key("nodes"), params.Key("type")),
+	))
+}
+
+// BeforeRun sets the cluster type when the number of nodes changes, as for creation
+func (cmd *ResizeCluster) BeforeRun(renv env.Running) error {
+	if cmd.Nodes == nil {
+		return nil
+	}
+	if Int64AsIntValue(cmd.Nodes) == 1 {
+		cmd.ClusterType = String("single-node")
+		cmd.Nodes = nil
+	} else {
+		cmd.ClusterType = String("multi-node")
+	}
+	return nil
+}
+
+// PriorState returns the node type and number of nodes of the cluster before resizing,
+// to resize it back on revert
+func (cmd *ResizeCluster) PriorState(renv env.Running, params map[string]interface{}) (map[string]interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, err
+	}
+	out, err := cmd.api.DescribeClusters(&redshift.DescribeClustersInput{ClusterIdentifier: cmd.Id})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.Clusters) == 0 {
+		return nil, fmt.Errorf("cluster '%s' not found", StringValue(cmd.Id))
+	}
+	cluster := out.Clusters[0]
+	prior := map[string]interface{}{
+		"nodes": Int64AsIntValue(cluster.NumberOfNodes),
+		"type":  StringValue(cluster.NodeType),
+	}
+	state := make(map[string]interface{})
+	for k := range params {
+		if v, ok := prior[k]; ok {
+			state[k] = v
+		}
+	}
+	return state, nil
+}
+
+func (cmd *ResizeCluster) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*redshift.ModifyClusterOutput).Cluster.ClusterIdentifier)
+}
+
+type CheckCluster struct {
+	_       string `action:"check" entity:"cluster" awsAPI:"redshift"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     redshiftiface.RedshiftAPI
+	Id      *string `templateName:"id"`
+	State   *string `templateName:"state"`
+	Timeout *int64  `templateName:"timeout"`
+}
+
+func (cmd *CheckCluster) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("id"), params.Key("state"), params.Key("timeout")),
+		params.Validators{
+			"state": params.IsInEnumIgnoreCase("available", "creating", "deleting", "final-snapshot", "hardware-failure",
+				"incompatible-hsm", "incompatible-network", "incompatible-parameters", "incompatible-restore",
+				"modifying", "rebooting", "renaming", "resizing", "rotating-keys", "storage-full", "updating-hsm", notFoundState),
+		},
+	)
+}
+
+func (cmd *CheckCluster) ManualRun(renv env.Running) (interface{}, error) {
+	input := &redshift.DescribeClustersInput{
+		ClusterIdentifier: cmd.Id,
+	}
+
+	c := &checker{
+		renv:        renv,
+		description: fmt.Sprintf("cluster %s", StringValue(cmd.Id)),
+		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
+		frequency:   5 * time.Second,
+		fetchFunc: func() (string, error) {
+			output, err := cmd.api.DescribeClusters(input)
+			if awserr, ok := err.(awserr.Error); ok && awserr.Code() == redshift.ErrCodeClusterNotFoundFault {
+				return notFoundState, nil
+			}
+			if err != nil {
+				return "", err
+			}
+			for _, cluster := range output.Clusters {
+				if StringValue(cluster.ClusterIdentifier) == StringValue(cmd.Id) {
+					return StringValue(cluster.ClusterStatus), nil
+				}
+			}
+			return notFoundState, nil
+		},
+		expect: StringValue(cmd.State),
+		logger: cmd.logger,
+	}
+	return nil, c.check()
+}
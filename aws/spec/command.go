@@ -0,0 +1,120 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+const (
+	runShellScriptDocument = "AWS-RunShellScript"
+	defaultCommandTimeout  = 600
+)
+
+type RunCommand struct {
+	_        string `action:"run" entity:"command" awsAPI:"ssm"`
+	logger   *logger.Logger
+	graph    cloud.GraphAPI
+	api      ssmiface.SSMAPI
+	Instance *string `templateName:"instance"`
+	Script   *string `templateName:"script"`
+	Comment  *string `templateName:"comment"`
+	Timeout  *int64  `templateName:"timeout"`
+}
+
+func (cmd *RunCommand) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("instance"), params.Key("script"),
+		params.Opt("comment", "timeout"),
+	))
+}
+
+// ManualRun sends the shell script to the SSM agent of the instance and waits for its execution.
+// The standard output of the script is the result of the command
+func (cmd *RunCommand) ManualRun(renv env.Running) (interface{}, error) {
+	input := &ssm.SendCommandInput{
+		DocumentName: String(runShellScriptDocument),
+		InstanceIds:  []*string{cmd.Instance},
+		Comment:      cmd.Comment,
+		Parameters:   map[string][]*string{"commands": {cmd.Script}},
+	}
+	start := time.Now()
+	out, err := cmd.api.SendCommand(input)
+	if err != nil {
+		return nil, err
+	}
+	cmd.logger.ExtraVerbosef("ssm.SendCommand call took %s", time.Since(start))
+	commandId := out.Command.CommandId
+	renv.Log().Verbosef("command %s sent to instance %s", StringValue(commandId), StringValue(cmd.Instance))
+
+	timeout := defaultCommandTimeout
+	if cmd.Timeout != nil {
+		timeout = Int64AsIntValue(cmd.Timeout)
+	}
+	var invocation *ssm.GetCommandInvocationOutput
+	c := &checker{
+		renv:        renv,
+		description: fmt.Sprintf("command %s on instance %s", StringValue(commandId), StringValue(cmd.Instance)),
+		timeout:     time.Duration(timeout) * time.Second,
+		frequency:   2 * time.Second,
+		fetchFunc: func() (string, error) {
+			invocation, err = cmd.api.GetCommandInvocation(&ssm.GetCommandInvocationInput{CommandId: commandId, InstanceId: cmd.Instance})
+			if awserr, ok := err.(awserr.Error); ok && awserr.Code() == ssm.ErrCodeInvocationDoesNotExist {
+				return ssm.CommandInvocationStatusPending, nil
+			}
+			if err != nil {
+				return "", err
+			}
+			switch status := StringValue(invocation.Status); status {
+			case ssm.CommandInvocationStatusSuccess, ssm.CommandInvocationStatusFailed, ssm.CommandInvocationStatusCancelled, ssm.CommandInvocationStatusTimedOut:
+				return "done", nil
+			default:
+				return status, nil
+			}
+		},
+		expect: "done",
+		logger: cmd.logger,
+	}
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+
+	if stderr := StringValue(invocation.StandardErrorContent); stderr != "" {
+		fmt.Fprint(os.Stderr, stderr)
+	}
+	if status := StringValue(invocation.Status); status != ssm.CommandInvocationStatusSuccess {
+		return nil, fmt.Errorf("command %s on instance %s: %s (exit code %d)", StringValue(commandId), StringValue(cmd.Instance), strings.ToLower(status), Int64AsIntValue(invocation.ResponseCode))
+	}
+	return String(strings.TrimSpace(StringValue(invocation.StandardOutputContent))), nil
+}
+
+func (cmd *RunCommand) ExtractResult(i interface{}) string {
+	return StringValue(i.(*string))
+}
+
+func (cmd *RunCommand) IAMActions(params map[string]interface{}) []string {
+	return []string{"ssm:GetCommandInvocation", "ssm:SendCommand"}
+}
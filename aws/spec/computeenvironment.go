@@ -0,0 +1,127 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/batch/batchiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateComputeenvironment struct {
+	_              string `action:"create" entity:"computeenvironment" awsAPI:"batch" awsCall:"CreateComputeEnvironment" awsInput:"batch.CreateComputeEnvironmentInput" awsOutput:"batch.CreateComputeEnvironmentOutput"`
+	logger         *logger.Logger
+	graph          cloud.GraphAPI
+	api            batchiface.BatchAPI
+	Name           *string   `awsName:"ComputeEnvironmentName" awsType:"awsstr" templateName:"name"`
+	Type           *string   `awsName:"Type" awsType:"awsstr" templateName:"type"`
+	ServiceRole    *string   `awsName:"ServiceRole" awsType:"awsstr" templateName:"service-role"`
+	ResourceType   *string   `awsName:"ComputeResources.Type" awsType:"awsstr" templateName:"resource-type"`
+	MinVcpus       *int64    `awsName:"ComputeResources.MinvCpus" awsType:"awsint64" templateName:"min-vcpus"`
+	MaxVcpus       *int64    `awsName:"ComputeResources.MaxvCpus" awsType:"awsint64" templateName:"max-vcpus"`
+	DesiredVcpus   *int64    `awsName:"ComputeResources.DesiredvCpus" awsType:"awsint64" templateName:"desired-vcpus"`
+	InstanceTypes  []*string `awsName:"ComputeResources.InstanceTypes" awsType:"awsstringslice" templateName:"instance-types"`
+	InstanceRole   *string   `awsName:"ComputeResources.InstanceRole" awsType:"awsstr" templateName:"instance-role"`
+	Subnets        []*string `awsName:"ComputeResources.Subnets" awsType:"awsstringslice" templateName:"subnets"`
+	Securitygroups []*string `awsName:"ComputeResources.SecurityGroupIds" awsType:"awsstringslice" templateName:"securitygroups"`
+	Keypair        *string   `awsName:"ComputeResources.Ec2KeyPair" awsType:"awsstr" templateName:"keypair"`
+	BidPercentage  *int64    `awsName:"ComputeResources.BidPercentage" awsType:"awsint64" templateName:"bid-percentage"`
+	SpotfleetRole  *string   `awsName:"ComputeResources.SpotIamFleetRole" awsType:"awsstr" templateName:"spotfleet-role"`
+}
+
+func (cmd *CreateComputeenvironment) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Key("type"), params.Key("service-role"),
+		params.Opt(params.Suggested("instance-types", "instance-role", "max-vcpus", "subnets", "securitygroups"),
+			"bid-percentage", "desired-vcpus", "keypair", "min-vcpus", "resource-type", "spotfleet-role"),
+	),
+		params.Validators{
+			"type":          params.IsInEnumIgnoreCase("managed", "unmanaged"),
+			"resource-type": params.IsInEnumIgnoreCase("ec2", "spot"),
+		})
+}
+
+// BeforeRun checks the compute resources of managed environments, AWS creating their instances itself
+func (cmd *CreateComputeenvironment) BeforeRun(renv env.Running) error {
+	cmd.Type = String(strings.ToUpper(StringValue(cmd.Type)))
+	if StringValue(cmd.Type) == batch.CETypeUnmanaged {
+		return nil
+	}
+	if cmd.InstanceTypes == nil || cmd.InstanceRole == nil || cmd.MaxVcpus == nil || cmd.Subnets == nil || cmd.Securitygroups == nil {
+		return errors.New("managed compute environment requires instance-types, instance-role, max-vcpus, subnets and securitygroups")
+	}
+	if cmd.ResourceType == nil {
+		cmd.ResourceType = String(batch.CRTypeEc2)
+	}
+	cmd.ResourceType = String(strings.ToUpper(StringValue(cmd.ResourceType)))
+	if cmd.MinVcpus == nil {
+		cmd.MinVcpus = Int64(0)
+	}
+	return nil
+}
+
+func (cmd *CreateComputeenvironment) ExtractResult(i interface{}) string {
+	return StringValue(i.(*batch.CreateComputeEnvironmentOutput).ComputeEnvironmentArn)
+}
+
+type DeleteComputeenvironment struct {
+	_      string `action:"delete" entity:"computeenvironment" awsAPI:"batch" awsCall:"DeleteComputeEnvironment" awsInput:"batch.DeleteComputeEnvironmentInput" awsOutput:"batch.DeleteComputeEnvironmentOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    batchiface.BatchAPI
+	Id     *string `awsName:"ComputeEnvironment" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *DeleteComputeenvironment) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
+
+// BeforeRun disables the compute environment, AWS only deleting disabled environments
+func (cmd *DeleteComputeenvironment) BeforeRun(renv env.Running) error {
+	if _, err := cmd.api.UpdateComputeEnvironment(&batch.UpdateComputeEnvironmentInput{ComputeEnvironment: cmd.Id, State: String(batch.CEStateDisabled)}); err != nil {
+		return err
+	}
+	c := &checker{
+		renv:        renv,
+		description: fmt.Sprintf("computeenvironment %s", StringValue(cmd.Id)),
+		timeout:     5 * time.Minute,
+		frequency:   5 * time.Second,
+		fetchFunc: func() (string, error) {
+			return cmd.status()
+		},
+		expect: batch.CEStatusValid,
+		logger: cmd.logger,
+	}
+	return c.check()
+}
+
+func (cmd *DeleteComputeenvironment) status() (string, error) {
+	output, err := cmd.api.DescribeComputeEnvironments(&batch.DescribeComputeEnvironmentsInput{ComputeEnvironments: []*string{cmd.Id}})
+	if err != nil {
+		return "", err
+	}
+	if len(output.ComputeEnvironments) == 0 {
+		return notFoundState, nil
+	}
+	return StringValue(output.ComputeEnvironments[0].Status), nil
+}
@@ -0,0 +1,90 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+)
+
+type GetConsoleoutput struct {
+	_        string `action:"get" entity:"consoleoutput" awsAPI:"ec2" awsDryRun:"manual"`
+	logger   *logger.Logger
+	graph    cloud.GraphAPI
+	api      ec2iface.EC2API
+	Instance *string `templateName:"instance"`
+	File     *string `templateName:"file"`
+}
+
+func (cmd *GetConsoleoutput) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("instance"), params.Opt("file")))
+}
+
+func (cmd *GetConsoleoutput) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+	_, err := cmd.api.GetConsoleOutput(&ec2.GetConsoleOutputInput{InstanceId: cmd.Instance, DryRun: Bool(true)})
+	return nil, dryRunError(cmd.logger, "get consoleoutput", err)
+}
+
+// ManualRun writes the console output of the instance to the file when given,
+// otherwise alone on stdout so that it can be piped
+func (cmd *GetConsoleoutput) ManualRun(renv env.Running) (interface{}, error) {
+	start := time.Now()
+	out, err := cmd.api.GetConsoleOutput(&ec2.GetConsoleOutputInput{InstanceId: cmd.Instance})
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+	cmd.logger.ExtraVerbosef("ec2.GetConsoleOutput call took %s", time.Since(start))
+	if out.Output == nil {
+		renv.Log().Warningf("no console output available yet for instance %s", StringValue(cmd.Instance))
+		return nil, nil
+	}
+	content, err := base64.StdEncoding.DecodeString(StringValue(out.Output))
+	if err != nil {
+		return nil, fmt.Errorf("decode console output: %s", err)
+	}
+	if file := StringValue(cmd.File); file != "" {
+		if err := ioutil.WriteFile(file, content, 0644); err != nil {
+			return nil, err
+		}
+		renv.Log().Infof("console output of instance %s written to '%s'", StringValue(cmd.Instance), file)
+		return file, nil
+	}
+	fmt.Fprint(os.Stdout, string(content))
+	return nil, nil
+}
+
+func (cmd *GetConsoleoutput) ExtractResult(i interface{}) string {
+	file, _ := i.(string)
+	return file
+}
+
+func (cmd *GetConsoleoutput) IAMActions(params map[string]interface{}) []string {
+	return []string{"ec2:GetConsoleOutput"}
+}
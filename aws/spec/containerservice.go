@@ -0,0 +1,185 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateContainerservice struct {
+	_                         string `action:"create" entity:"containerservice" awsAPI:"ecs"`
+	logger                    *logger.Logger
+	graph                     cloud.GraphAPI
+	api                       ecsiface.ECSAPI
+	Cluster                   *string   `templateName:"cluster"`
+	Name                      *string   `templateName:"name"`
+	ContainerTask             *string   `templateName:"containertask"`
+	DesiredCount              *int64    `templateName:"desired-count"`
+	LaunchType                *string   `templateName:"launch-type"`
+	Subnets                   []*string `templateName:"subnets"`
+	SecurityGroups            []*string `templateName:"securitygroups"`
+	PublicIP                  *bool     `templateName:"public-ip"`
+	Role                      *string   `templateName:"role"`
+	LoadBalancerContainerName *string   `templateName:"loadbalancer.container-name"`
+	LoadBalancerContainerPort *int64    `templateName:"loadbalancer.container-port"`
+	LoadBalancerTargetgroup   *string   `templateName:"loadbalancer.targetgroup"`
+}
+
+func (cmd *CreateContainerservice) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("cluster"), params.Key("containertask"), params.Key("desired-count"), params.Key("name"),
+			params.Opt("launch-type", "loadbalancer.container-name", "loadbalancer.container-port", "loadbalancer.targetgroup", "public-ip", "role", "securitygroups", "subnets"),
+		),
+		params.Validators{
+			"launch-type": isLaunchType,
+		})
+}
+
+func (cmd *CreateContainerservice) ManualRun(renv env.Running) (interface{}, error) {
+	input := &ecs.CreateServiceInput{
+		LaunchType:           ecsLaunchType(cmd.LaunchType),
+		NetworkConfiguration: ecsNetworkConfiguration(cmd.Subnets, cmd.SecurityGroups, cmd.PublicIP),
+	}
+	setters := []setter{
+		{val: cmd.Cluster, fieldPath: "Cluster", fieldType: awsstr},
+		{val: cmd.Name, fieldPath: "ServiceName", fieldType: awsstr},
+		{val: cmd.ContainerTask, fieldPath: "TaskDefinition", fieldType: awsstr},
+		{val: cmd.DesiredCount, fieldPath: "DesiredCount", fieldType: awsint64},
+	}
+	if cmd.Role != nil {
+		setters = append(setters, setter{val: cmd.Role, fieldPath: "Role", fieldType: awsstr})
+	}
+	if cmd.LoadBalancerContainerName != nil {
+		setters = append(setters, setter{val: cmd.LoadBalancerContainerName, fieldPath: "LoadBalancers[0]ContainerName", fieldType: awsslicestruct})
+	}
+	if cmd.LoadBalancerContainerPort != nil {
+		setters = append(setters, setter{val: cmd.LoadBalancerContainerPort, fieldPath: "LoadBalancers[0]ContainerPort", fieldType: awsslicestructint64})
+	}
+	if cmd.LoadBalancerTargetgroup != nil {
+		setters = append(setters, setter{val: cmd.LoadBalancerTargetgroup, fieldPath: "LoadBalancers[0]TargetGroupArn", fieldType: awsslicestruct})
+	}
+
+	call := &awsCall{
+		fnName:  "ecs.CreateService",
+		fn:      cmd.api.CreateService,
+		logger:  cmd.logger,
+		setters: setters,
+	}
+	return call.execute(input)
+}
+
+func (cmd *CreateContainerservice) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ecs.CreateServiceOutput).Service.ServiceArn)
+}
+
+type UpdateContainerservice struct {
+	_             string `action:"update" entity:"containerservice" awsAPI:"ecs" awsCall:"UpdateService" awsInput:"ecs.UpdateServiceInput" awsOutput:"ecs.UpdateServiceOutput"`
+	logger        *logger.Logger
+	graph         cloud.GraphAPI
+	api           ecsiface.ECSAPI
+	Cluster       *string `awsName:"Cluster" awsType:"awsstr" templateName:"cluster"`
+	Name          *string `awsName:"Service" awsType:"awsstr" templateName:"name"`
+	ContainerTask *string `awsName:"TaskDefinition" awsType:"awsstr" templateName:"containertask"`
+	DesiredCount  *int64  `awsName:"DesiredCount" awsType:"awsint64" templateName:"desired-count"`
+}
+
+func (cmd *UpdateContainerservice) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("cluster"), params.Key("name"),
+		params.Opt("containertask", "desired-count"),
+	))
+}
+
+type DeleteContainerservice struct {
+	_       string `action:"delete" entity:"containerservice" awsAPI:"ecs"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     ecsiface.ECSAPI
+	Cluster *string `templateName:"cluster"`
+	Name    *string `templateName:"name"`
+}
+
+func (cmd *DeleteContainerservice) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("cluster"), params.Key("name")))
+}
+
+func (cmd *DeleteContainerservice) ManualRun(renv env.Running) (interface{}, error) {
+	// a service can only be deleted once scaled down to no task
+	scaleDown := &awsCall{
+		fnName: "ecs.UpdateService",
+		fn:     cmd.api.UpdateService,
+		logger: cmd.logger,
+		setters: []setter{
+			{val: cmd.Cluster, fieldPath: "Cluster", fieldType: awsstr},
+			{val: cmd.Name, fieldPath: "Service", fieldType: awsstr},
+		},
+	}
+	if _, err := scaleDown.execute(&ecs.UpdateServiceInput{DesiredCount: awssdk.Int64(0)}); err != nil {
+		return nil, fmt.Errorf("scale down service: %s", err)
+	}
+
+	call := &awsCall{
+		fnName: "ecs.DeleteService",
+		fn:     cmd.api.DeleteService,
+		logger: cmd.logger,
+		setters: []setter{
+			{val: cmd.Cluster, fieldPath: "Cluster", fieldType: awsstr},
+			{val: cmd.Name, fieldPath: "Service", fieldType: awsstr},
+		},
+	}
+	return call.execute(&ecs.DeleteServiceInput{})
+}
+
+func isLaunchType(i interface{}, others map[string]interface{}) error {
+	switch strings.ToLower(fmt.Sprint(i)) {
+	case "ec2", "fargate":
+		return nil
+	default:
+		return fmt.Errorf("expected any of [ec2 fargate] but got %v", i)
+	}
+}
+
+func ecsLaunchType(launchType *string) *string {
+	if launchType == nil {
+		return nil
+	}
+	return awssdk.String(strings.ToUpper(StringValue(launchType)))
+}
+
+// ecsNetworkConfiguration returns the awsvpc network configuration required by the tasks of Fargate,
+// or nil when no subnet is given
+func ecsNetworkConfiguration(subnets, securityGroups []*string, publicIP *bool) *ecs.NetworkConfiguration {
+	if len(subnets) == 0 {
+		return nil
+	}
+	conf := &ecs.AwsVpcConfiguration{Subnets: subnets, SecurityGroups: securityGroups}
+	if publicIP != nil {
+		if BoolValue(publicIP) {
+			conf.AssignPublicIp = awssdk.String(ecs.AssignPublicIpEnabled)
+		} else {
+			conf.AssignPublicIp = awssdk.String(ecs.AssignPublicIpDisabled)
+		}
+	}
+	return &ecs.NetworkConfiguration{AwsvpcConfiguration: conf}
+}
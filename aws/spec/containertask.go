@@ -37,21 +37,26 @@ type StartContainertask struct {
 	logger                    *logger.Logger
 	graph                     cloud.GraphAPI
 	api                       ecsiface.ECSAPI
-	Cluster                   *string `templateName:"cluster"`
-	DesiredCount              *int64  `templateName:"desired-count"`
-	Name                      *string `templateName:"name"`
-	Type                      *string `templateName:"type"`
-	Role                      *string `templateName:"role"`
-	DeploymentName            *string `templateName:"deployment-name"`
-	LoadBalancerContainerName *string `templateName:"loadbalancer.container-name"`
-	LoadBalancerContainerPort *int64  `templateName:"loadbalancer.container-port"`
-	LoadBalancerTargetgroup   *string `templateName:"loadbalancer.targetgroup"`
+	Cluster                   *string   `templateName:"cluster"`
+	DesiredCount              *int64    `templateName:"desired-count"`
+	Name                      *string   `templateName:"name"`
+	Type                      *string   `templateName:"type"`
+	Role                      *string   `templateName:"role"`
+	DeploymentName            *string   `templateName:"deployment-name"`
+	LoadBalancerContainerName *string   `templateName:"loadbalancer.container-name"`
+	LoadBalancerContainerPort *int64    `templateName:"loadbalancer.container-port"`
+	LoadBalancerTargetgroup   *string   `templateName:"loadbalancer.targetgroup"`
+	LaunchType                *string   `templateName:"launch-type"`
+	Subnets                   []*string `templateName:"subnets"`
+	SecurityGroups            []*string `templateName:"securitygroups"`
+	PublicIP                  *bool     `templateName:"public-ip"`
 }
 
 func (cmd *StartContainertask) ParamsSpec() params.Spec {
 	return params.NewSpec(
-		params.AllOf(params.Key("cluster"), params.Key("desired-count"), params.Key("name"), params.Key("type"), params.Opt("deployment-name", "loadbalancer.container-name", "loadbalancer.container-port", "loadbalancer.targetgroup", "role")),
+		params.AllOf(params.Key("cluster"), params.Key("desired-count"), params.Key("name"), params.Key("type"), params.Opt("deployment-name", "launch-type", "loadbalancer.container-name", "loadbalancer.container-port", "loadbalancer.targetgroup", "public-ip", "role", "securitygroups", "subnets")),
 		params.Validators{
+			"launch-type": isLaunchType,
 			"type": func(i interface{}, others map[string]interface{}) error {
 				typ := fmt.Sprint(i)
 				if typ != "task" && typ != "service" {
@@ -95,7 +100,10 @@ func (cmd *StartContainertask) ManualRun(renv env.Running) (interface{}, error)
 			setters: setters,
 		}
 
-		return call.execute(&ecs.CreateServiceInput{})
+		return call.execute(&ecs.CreateServiceInput{
+			LaunchType:           ecsLaunchType(cmd.LaunchType),
+			NetworkConfiguration: ecsNetworkConfiguration(cmd.Subnets, cmd.SecurityGroups, cmd.PublicIP),
+		})
 	case "task":
 		call := &awsCall{
 			fnName: "ecs.RunTask",
@@ -108,7 +116,10 @@ func (cmd *StartContainertask) ManualRun(renv env.Running) (interface{}, error)
 			},
 		}
 
-		output, err := call.execute(&ecs.RunTaskInput{})
+		output, err := call.execute(&ecs.RunTaskInput{
+			LaunchType:           ecsLaunchType(cmd.LaunchType),
+			NetworkConfiguration: ecsNetworkConfiguration(cmd.Subnets, cmd.SecurityGroups, cmd.PublicIP),
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -227,12 +238,18 @@ type AttachContainertask struct {
 	Privileged      *bool     `templateName:"privileged"`
 	Workdir         *string   `templateName:"workdir"`
 	Ports           []*string `templateName:"ports"`
+	LaunchType      *string   `templateName:"launch-type"`
+	Cpu             *string   `templateName:"cpu"`
+	Memory          *string   `templateName:"memory"`
+	ExecutionRole   *string   `templateName:"execution-role"`
 }
 
 func (cmd *AttachContainertask) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("container-name"), params.Key("image"), params.Key("memory-hard-limit"), params.Key("name"),
-		params.Opt("command", "env", "ports", "privileged", "workdir"),
-	))
+		params.Opt("command", "cpu", "env", "execution-role", "launch-type", "memory", "ports", "privileged", "workdir"),
+	), params.Validators{
+		"launch-type": isLaunchType,
+	})
 }
 
 func (cmd *AttachContainertask) ManualRun(renv env.Running) (interface{}, error) {
@@ -255,12 +272,16 @@ func (cmd *AttachContainertask) ManualRun(renv env.Running) (interface{}, error)
 		return nil, err
 	} else {
 		taskDefinitionInput = &ecs.RegisterTaskDefinitionInput{
-			ContainerDefinitions: taskdefOutput.TaskDefinition.ContainerDefinitions,
-			Family:               taskdefOutput.TaskDefinition.Family,
-			NetworkMode:          taskdefOutput.TaskDefinition.NetworkMode,
-			PlacementConstraints: taskdefOutput.TaskDefinition.PlacementConstraints,
-			TaskRoleArn:          taskdefOutput.TaskDefinition.TaskRoleArn,
-			Volumes:              taskdefOutput.TaskDefinition.Volumes,
+			ContainerDefinitions:    taskdefOutput.TaskDefinition.ContainerDefinitions,
+			Cpu:                     taskdefOutput.TaskDefinition.Cpu,
+			ExecutionRoleArn:        taskdefOutput.TaskDefinition.ExecutionRoleArn,
+			Family:                  taskdefOutput.TaskDefinition.Family,
+			Memory:                  taskdefOutput.TaskDefinition.Memory,
+			NetworkMode:             taskdefOutput.TaskDefinition.NetworkMode,
+			PlacementConstraints:    taskdefOutput.TaskDefinition.PlacementConstraints,
+			RequiresCompatibilities: taskdefOutput.TaskDefinition.RequiresCompatibilities,
+			TaskRoleArn:             taskdefOutput.TaskDefinition.TaskRoleArn,
+			Volumes:                 taskdefOutput.TaskDefinition.Volumes,
 		}
 	}
 
@@ -309,6 +330,20 @@ func (cmd *AttachContainertask) ManualRun(renv env.Running) (interface{}, error)
 
 	taskDefinitionInput.ContainerDefinitions = append(taskDefinitionInput.ContainerDefinitions, container)
 
+	if strings.EqualFold(StringValue(cmd.LaunchType), ecs.LaunchTypeFargate) {
+		taskDefinitionInput.RequiresCompatibilities = []*string{aws.String(ecs.CompatibilityFargate)}
+		taskDefinitionInput.NetworkMode = aws.String(ecs.NetworkModeAwsvpc)
+	}
+	if cmd.Cpu != nil {
+		taskDefinitionInput.Cpu = cmd.Cpu
+	}
+	if cmd.Memory != nil {
+		taskDefinitionInput.Memory = cmd.Memory
+	}
+	if cmd.ExecutionRole != nil {
+		taskDefinitionInput.ExecutionRoleArn = cmd.ExecutionRole
+	}
+
 	start := time.Now()
 
 	taskDefOutput, err := cmd.api.RegisterTaskDefinition(taskDefinitionInput)
@@ -362,12 +397,16 @@ func (cmd *DetachContainertask) ManualRun(renv env.Running) (interface{}, error)
 
 	if len(containerDefinitions) > 0 { //At least one container remaining
 		taskDefinitionInput := &ecs.RegisterTaskDefinitionInput{
-			ContainerDefinitions: containerDefinitions,
-			Family:               taskdefOutput.TaskDefinition.Family,
-			NetworkMode:          taskdefOutput.TaskDefinition.NetworkMode,
-			PlacementConstraints: taskdefOutput.TaskDefinition.PlacementConstraints,
-			TaskRoleArn:          taskdefOutput.TaskDefinition.TaskRoleArn,
-			Volumes:              taskdefOutput.TaskDefinition.Volumes,
+			ContainerDefinitions:    containerDefinitions,
+			Cpu:                     taskdefOutput.TaskDefinition.Cpu,
+			ExecutionRoleArn:        taskdefOutput.TaskDefinition.ExecutionRoleArn,
+			Family:                  taskdefOutput.TaskDefinition.Family,
+			Memory:                  taskdefOutput.TaskDefinition.Memory,
+			NetworkMode:             taskdefOutput.TaskDefinition.NetworkMode,
+			PlacementConstraints:    taskdefOutput.TaskDefinition.PlacementConstraints,
+			RequiresCompatibilities: taskdefOutput.TaskDefinition.RequiresCompatibilities,
+			TaskRoleArn:             taskdefOutput.TaskDefinition.TaskRoleArn,
+			Volumes:                 taskdefOutput.TaskDefinition.Volumes,
 		}
 		start := time.Now()
 
@@ -407,7 +446,7 @@ func (cmd *DeleteContainertask) ParamsSpec() params.Spec {
 	))
 }
 
-func (cmd *DeleteContainertask) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteContainertask) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
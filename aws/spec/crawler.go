@@ -0,0 +1,59 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"github.com/aws/aws-sdk-go/service/glue/glueiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateCrawler struct {
+	_           string `action:"create" entity:"crawler" awsAPI:"glue" awsCall:"CreateCrawler" awsInput:"glue.CreateCrawlerInput" awsOutput:"glue.CreateCrawlerOutput"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         glueiface.GlueAPI
+	Name        *string   `awsName:"Name" awsType:"awsstr" templateName:"name"`
+	Role        *string   `awsName:"Role" awsType:"awsstr" templateName:"role"`
+	Database    *string   `awsName:"DatabaseName" awsType:"awsstr" templateName:"database"`
+	Targets     []*string `awsName:"Targets.S3Targets" awsType:"awss3targets" templateName:"targets"`
+	Schedule    *string   `awsName:"Schedule" awsType:"awsstr" templateName:"schedule"`
+	TablePrefix *string   `awsName:"TablePrefix" awsType:"awsstr" templateName:"table-prefix"`
+	Description *string   `awsName:"Description" awsType:"awsstr" templateName:"description"`
+}
+
+func (cmd *CreateCrawler) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Key("role"), params.Key("database"), params.Key("targets"),
+		params.Opt(params.Suggested("schedule"), "description", "table-prefix"),
+	))
+}
+
+func (cmd *CreateCrawler) ExtractResult(i interface{}) string {
+	return StringValue(cmd.Name)
+}
+
+type DeleteCrawler struct {
+	_      string `action:"delete" entity:"crawler" awsAPI:"glue" awsCall:"DeleteCrawler" awsInput:"glue.DeleteCrawlerInput" awsOutput:"glue.DeleteCrawlerOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    glueiface.GlueAPI
+	Name   *string `awsName:"Name" awsType:"awsstr" templateName:"name"`
+}
+
+func (cmd *DeleteCrawler) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name")))
+}
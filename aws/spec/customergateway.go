@@ -0,0 +1,78 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/wallix/awless/logger"
+)
+
+type CreateCustomergateway struct {
+	_        string `action:"create" entity:"customergateway" awsAPI:"ec2" awsCall:"CreateCustomerGateway" awsInput:"ec2.CreateCustomerGatewayInput" awsOutput:"ec2.CreateCustomerGatewayOutput" awsDryRun:""`
+	logger   *logger.Logger
+	graph    cloud.GraphAPI
+	api      ec2iface.EC2API
+	BgpAsn   *int64  `awsName:"BgpAsn" awsType:"awsint64" templateName:"bgp-asn"`
+	PublicIp *string `awsName:"PublicIp" awsType:"awsstr" templateName:"publicip"`
+	Type     *string `awsName:"Type" awsType:"awsstr" templateName:"type"`
+	Name     *string `templateName:"name"`
+}
+
+func (cmd *CreateCustomergateway) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("bgp-asn"), params.Key("publicip"), params.Opt(params.Suggested("name"), "type")),
+		params.Validators{
+			"publicip": params.IsIP,
+			"type":     params.IsInEnumIgnoreCase(ec2.GatewayTypeIpsec1),
+		})
+}
+
+// BeforeRun defaults the gateway type to the only one supported by AWS, i.e. ipsec.1
+func (cmd *CreateCustomergateway) BeforeRun(renv env.Running) error {
+	if cmd.Type == nil {
+		cmd.Type = String(ec2.GatewayTypeIpsec1)
+	}
+	return nil
+}
+
+func (cmd *CreateCustomergateway) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*ec2.CreateCustomerGatewayOutput).CustomerGateway.CustomerGatewayId)
+}
+
+func (cmd *CreateCustomergateway) AfterRun(renv env.Running, output interface{}) error {
+	if cmd.Name == nil {
+		return nil
+	}
+	return createNameTag(awssdk.String(cmd.ExtractResult(output)), cmd.Name, renv)
+}
+
+type DeleteCustomergateway struct {
+	_      string `action:"delete" entity:"customergateway" awsAPI:"ec2" awsCall:"DeleteCustomerGateway" awsInput:"ec2.DeleteCustomerGatewayInput" awsOutput:"ec2.DeleteCustomerGatewayOutput" awsDryRun:""`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2iface.EC2API
+	Id     *string `awsName:"CustomerGatewayId" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *DeleteCustomergateway) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
@@ -177,6 +177,35 @@ func (cmd *CreateDatabase) ExtractResult(i interface{}) string {
 	}
 }
 
+type RestoreDatabase struct {
+	_                string `action:"restore" entity:"database" awsAPI:"rds" awsCall:"RestoreDBInstanceFromDBSnapshot" awsInput:"rds.RestoreDBInstanceFromDBSnapshotInput" awsOutput:"rds.RestoreDBInstanceFromDBSnapshotOutput"`
+	logger           *logger.Logger
+	graph            cloud.GraphAPI
+	api              rdsiface.RDSAPI
+	Snapshot         *string `awsName:"DBSnapshotIdentifier" awsType:"awsstr" templateName:"snapshot"`
+	Name             *string `awsName:"DBInstanceIdentifier" awsType:"awsstr" templateName:"name"`
+	Type             *string `awsName:"DBInstanceClass" awsType:"awsstr" templateName:"type"`
+	Autoupgrade      *bool   `awsName:"AutoMinorVersionUpgrade" awsType:"awsbool" templateName:"autoupgrade"`
+	Availabilityzone *string `awsName:"AvailabilityZone" awsType:"awsstr" templateName:"availabilityzone"`
+	Subnetgroup      *string `awsName:"DBSubnetGroupName" awsType:"awsstr" templateName:"subnetgroup"`
+	Iops             *int64  `awsName:"Iops" awsType:"awsint64" templateName:"iops"`
+	Multiaz          *bool   `awsName:"MultiAZ" awsType:"awsbool" templateName:"multiaz"`
+	Optiongroup      *string `awsName:"OptionGroupName" awsType:"awsstr" templateName:"optiongroup"`
+	Port             *int64  `awsName:"Port" awsType:"awsint64" templateName:"port"`
+	Public           *bool   `awsName:"PubliclyAccessible" awsType:"awsbool" templateName:"public"`
+	Storagetype      *string `awsName:"StorageType" awsType:"awsstr" templateName:"storagetype"`
+}
+
+func (cmd *RestoreDatabase) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Key("snapshot"),
+		params.Opt("autoupgrade", "availabilityzone", "iops", "multiaz", "optiongroup", "port", "public", "storagetype", "subnetgroup", "type"),
+	))
+}
+
+func (cmd *RestoreDatabase) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*rds.RestoreDBInstanceFromDBSnapshotOutput).DBInstance.DBInstanceIdentifier)
+}
+
 type DeleteDatabase struct {
 	_            string `action:"delete" entity:"database" awsAPI:"rds" awsCall:"DeleteDBInstance" awsInput:"rds.DeleteDBInstanceInput" awsOutput:"rds.DeleteDBInstanceOutput"`
 	logger       *logger.Logger
@@ -221,6 +250,7 @@ func (cmd *CheckDatabase) ManualRun(renv env.Running) (interface{}, error) {
 	}
 
 	c := &checker{
+		renv:        renv,
 		description: fmt.Sprintf("database %s", StringValue(cmd.Id)),
 		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
 		frequency:   5 * time.Second,
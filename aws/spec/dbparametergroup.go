@@ -0,0 +1,55 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateDbparametergroup struct {
+	_           string `action:"create" entity:"dbparametergroup" awsAPI:"rds" awsCall:"CreateDBParameterGroup" awsInput:"rds.CreateDBParameterGroupInput" awsOutput:"rds.CreateDBParameterGroupOutput"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         rdsiface.RDSAPI
+	Name        *string `awsName:"DBParameterGroupName" awsType:"awsstr" templateName:"name"`
+	Family      *string `awsName:"DBParameterGroupFamily" awsType:"awsstr" templateName:"family"`
+	Description *string `awsName:"Description" awsType:"awsstr" templateName:"description"`
+}
+
+func (cmd *CreateDbparametergroup) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("description"), params.Key("family"), params.Key("name")))
+}
+
+func (cmd *CreateDbparametergroup) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*rds.CreateDBParameterGroupOutput).DBParameterGroup.DBParameterGroupName)
+}
+
+type DeleteDbparametergroup struct {
+	_      string `action:"delete" entity:"dbparametergroup" awsAPI:"rds" awsCall:"DeleteDBParameterGroup" awsInput:"rds.DeleteDBParameterGroupInput" awsOutput:"rds.DeleteDBParameterGroupOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    rdsiface.RDSAPI
+	Name   *string `awsName:"DBParameterGroupName" awsType:"awsstr" templateName:"name"`
+}
+
+func (cmd *DeleteDbparametergroup) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name")))
+}
@@ -0,0 +1,58 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/params"
+)
+
+// CreateDeployment takes a snapshot of the resources and methods of a REST API,
+// making it callable in the given stage, created if it does not exist
+type CreateDeployment struct {
+	_           string `action:"create" entity:"deployment" awsAPI:"apigateway" awsCall:"CreateDeployment" awsInput:"apigateway.CreateDeploymentInput" awsOutput:"apigateway.Deployment"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         apigatewayiface.APIGatewayAPI
+	Restapi     *string `awsName:"RestApiId" awsType:"awsstr" templateName:"restapi"`
+	Stage       *string `awsName:"StageName" awsType:"awsstr" templateName:"stage"`
+	Description *string `awsName:"Description" awsType:"awsstr" templateName:"description"`
+}
+
+func (cmd *CreateDeployment) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("restapi"), params.Opt(params.Suggested("stage"), "description")))
+}
+
+func (cmd *CreateDeployment) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*apigateway.Deployment).Id)
+}
+
+type DeleteDeployment struct {
+	_       string `action:"delete" entity:"deployment" awsAPI:"apigateway" awsCall:"DeleteDeployment" awsInput:"apigateway.DeleteDeploymentInput" awsOutput:"apigateway.DeleteDeploymentOutput"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     apigatewayiface.APIGatewayAPI
+	Id      *string `awsName:"DeploymentId" awsType:"awsstr" templateName:"id"`
+	Restapi *string `awsName:"RestApiId" awsType:"awsstr" templateName:"restapi"`
+}
+
+func (cmd *DeleteDeployment) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"), params.Key("restapi")))
+}
@@ -17,10 +17,13 @@ package awsspec
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/wallix/awless/aws/config"
 	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/params"
 
@@ -41,6 +44,8 @@ type CreateDistribution struct {
 	graph          cloud.GraphAPI
 	api            cloudfrontiface.CloudFrontAPI
 	OriginDomain   *string   `templateName:"origin-domain"`
+	Origin         *string   `templateName:"origin"`
+	CacheBehaviors []*string `templateName:"cache-behaviors"`
 	Certificate    *string   `templateName:"certificate"`
 	Comment        *string   `templateName:"comment"`
 	DefaultFile    *string   `templateName:"default-file"`
@@ -55,17 +60,26 @@ type CreateDistribution struct {
 }
 
 func (cmd *CreateDistribution) ParamsSpec() params.Spec {
-	return params.NewSpec(params.AllOf(params.Key("origin-domain"),
-		params.Opt("certificate", "comment", "default-file", "domain-aliases", "enable", "forward-cookies", "forward-queries", "https-behaviour", "min-ttl", "origin-path", "price-class"),
+	return params.NewSpec(params.AllOf(params.OnlyOneOf(params.Key("origin-domain"), params.Key("origin")),
+		params.Opt("cache-behaviors", "certificate", "comment", "default-file", "domain-aliases", "enable", "forward-cookies", "forward-queries", "https-behaviour", "min-ttl", "origin-path", "price-class"),
 	))
 }
 
 func (cmd *CreateDistribution) ManualRun(renv env.Running) (interface{}, error) {
 	originId := "orig_1"
+	origin := &cloudfront.Origin{Id: aws.String(originId), DomainName: cmd.OriginDomain}
+	if cmd.Origin != nil {
+		var err error
+		if origin, err = resolveOrigin(cmd.graph, StringValue(cmd.Origin), cloudfrontRegion(cmd.api)); err != nil {
+			return nil, err
+		}
+	} else if isS3OriginDomain(StringValue(cmd.OriginDomain)) {
+		origin.S3OriginConfig = &cloudfront.S3OriginConfig{OriginAccessIdentity: aws.String("")}
+	}
 	input := &cloudfront.CreateDistributionInput{
 		DistributionConfig: &cloudfront.DistributionConfig{
 			CallerReference: aws.String(CallerReferenceFunc()),
-			Comment:         cmd.OriginDomain,
+			Comment:         aws.String(StringValue(origin.DomainName)),
 			DefaultCacheBehavior: &cloudfront.DefaultCacheBehavior{
 				MinTTL: aws.Int64(0),
 				ForwardedValues: &cloudfront.ForwardedValues{
@@ -82,24 +96,28 @@ func (cmd *CreateDistribution) ManualRun(renv env.Running) (interface{}, error)
 			Enabled: aws.Bool(true),
 			Origins: &cloudfront.Origins{
 				Quantity: aws.Int64(1),
-				Items: []*cloudfront.Origin{
-					{Id: aws.String(originId)},
-				},
+				Items:    []*cloudfront.Origin{origin},
 			},
 		},
 	}
 
-	if domain := StringValue(cmd.OriginDomain); strings.HasSuffix(domain, ".s3.amazonaws.com") || (strings.HasSuffix(domain, ".amazonaws.com") && strings.Contains(domain, ".s3-website-")) {
-		input.DistributionConfig.Origins.Items[0].S3OriginConfig = &cloudfront.S3OriginConfig{OriginAccessIdentity: aws.String("")}
+	if cmd.CacheBehaviors != nil {
+		behaviors, err := newCacheBehaviors(cmd.CacheBehaviors, input.DistributionConfig.DefaultCacheBehavior)
+		if err != nil {
+			return nil, err
+		}
+		if cmd.HttpsBehaviour != nil {
+			for _, b := range behaviors.Items {
+				b.ViewerProtocolPolicy = cmd.HttpsBehaviour
+			}
+		}
+		input.DistributionConfig.CacheBehaviors = behaviors
 	}
 
 	call := &awsCall{
 		fnName: "cloudfront.CreateDistribution",
 		fn:     cmd.api.CreateDistribution,
 		logger: cmd.logger,
-		setters: []setter{
-			{val: cmd.OriginDomain, fieldPath: "DistributionConfig.Origins.Items[0].DomainName", fieldType: awsstr},
-		},
 	}
 
 	if cmd.Certificate != nil {
@@ -170,6 +188,7 @@ func (cmd *CheckDistribution) ManualRun(renv env.Running) (interface{}, error) {
 	}
 
 	c := &checker{
+		renv:        renv,
 		description: fmt.Sprintf("distribution %s", StringValue(cmd.Id)),
 		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
 		frequency:   5 * time.Second,
@@ -201,6 +220,8 @@ type UpdateDistribution struct {
 	api            cloudfrontiface.CloudFrontAPI
 	Id             *string   `awsName:"Id" awsType:"awsstr" templateName:"id"`
 	OriginDomain   *string   `templateName:"origin-domain"`
+	Origin         *string   `templateName:"origin"`
+	CacheBehaviors []*string `templateName:"cache-behaviors"`
 	Certificate    *string   `templateName:"certificate"`
 	Comment        *string   `templateName:"comment"`
 	DefaultFile    *string   `templateName:"default-file"`
@@ -216,7 +237,7 @@ type UpdateDistribution struct {
 
 func (cmd *UpdateDistribution) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("id"),
-		params.Opt("certificate", "comment", "default-file", "domain-aliases", "enable", "forward-cookies", "forward-queries", "https-behaviour", "min-ttl", "origin-domain", "origin-path", "price-class"),
+		params.Opt("cache-behaviors", "certificate", "comment", "default-file", "domain-aliases", "enable", "forward-cookies", "forward-queries", "https-behaviour", "min-ttl", "origin", "origin-domain", "origin-path", "price-class"),
 	))
 }
 
@@ -245,7 +266,7 @@ func (cmd *UpdateDistribution) ManualRun(renv env.Running) (interface{}, error)
 			return nil, err
 		}
 	}
-	if cmd.OriginDomain != nil || cmd.OriginPath != nil {
+	if cmd.OriginDomain != nil || cmd.Origin != nil || cmd.OriginPath != nil {
 		if configToUpdate.Origins == nil || len(configToUpdate.Origins.Items) == 0 {
 			configToUpdate.Origins = &cloudfront.Origins{
 				Quantity: aws.Int64(1),
@@ -258,10 +279,18 @@ func (cmd *UpdateDistribution) ManualRun(renv env.Running) (interface{}, error)
 			if err = setFieldWithType(cmd.OriginDomain, input, "DistributionConfig.Origins.Items[0].DomainName", awsstr); err != nil {
 				return nil, err
 			}
-			if domain := aws.StringValue(input.DistributionConfig.Origins.Items[0].DomainName); strings.HasSuffix(domain, ".s3.amazonaws.com") || (strings.HasSuffix(domain, ".amazonaws.com") && strings.Contains(domain, ".s3-website-")) {
+			if isS3OriginDomain(aws.StringValue(input.DistributionConfig.Origins.Items[0].DomainName)) {
 				input.DistributionConfig.Origins.Items[0].S3OriginConfig = &cloudfront.S3OriginConfig{OriginAccessIdentity: aws.String("")}
 			}
 		}
+		if cmd.Origin != nil {
+			origin, err := resolveOrigin(cmd.graph, StringValue(cmd.Origin), cloudfrontRegion(cmd.api))
+			if err != nil {
+				return nil, err
+			}
+			toUpdate := configToUpdate.Origins.Items[0]
+			toUpdate.DomainName, toUpdate.S3OriginConfig, toUpdate.CustomOriginConfig = origin.DomainName, origin.S3OriginConfig, origin.CustomOriginConfig
+		}
 
 		if cmd.OriginPath != nil {
 			if err = setFieldWithType(cmd.OriginPath, input, "DistributionConfig.Origins.Items[0].OriginPath", awsstr); err != nil {
@@ -323,6 +352,11 @@ func (cmd *UpdateDistribution) ManualRun(renv env.Running) (interface{}, error)
 			return nil, err
 		}
 	}
+	if cmd.CacheBehaviors != nil {
+		if configToUpdate.CacheBehaviors, err = newCacheBehaviors(cmd.CacheBehaviors, configToUpdate.DefaultCacheBehavior); err != nil {
+			return nil, err
+		}
+	}
 
 	if aliases := input.DistributionConfig.Aliases; aliases != nil {
 		aliases.Quantity = aws.Int64(int64(len(aliases.Items)))
@@ -407,3 +441,159 @@ func (cmd *DeleteDistribution) ManualRun(renv env.Running) (interface{}, error)
 	cmd.logger.ExtraVerbosef("cloudfront.DeleteDistribution call took %s", time.Since(start))
 	return output, err
 }
+
+type WaitDistribution struct {
+	_       string `action:"wait" entity:"distribution" awsAPI:"cloudfront"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     cloudfrontiface.CloudFrontAPI
+	Id      *string `templateName:"id"`
+	Timeout *string `templateName:"timeout"`
+}
+
+func (cmd *WaitDistribution) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("id"), params.Opt("timeout")),
+		params.Validators{
+			"timeout": isCheckTimeout,
+		})
+}
+
+// ManualRun waits for the changes of the distribution to be deployed to all the edge locations,
+// which usually takes from 15 to 30 minutes
+func (cmd *WaitDistribution) ManualRun(renv env.Running) (interface{}, error) {
+	timeout := 30 * time.Minute
+	if cmd.Timeout != nil {
+		var err error
+		if timeout, err = parseCheckTimeout(StringValue(cmd.Timeout)); err != nil {
+			return nil, err
+		}
+	}
+	checkDistribution := CommandFactory.Build("checkdistribution")().(*CheckDistribution)
+	entries := map[string]interface{}{
+		"id":      cmd.Id,
+		"state":   "Deployed",
+		"timeout": int64(timeout / time.Second),
+	}
+	if err := params.Validate(checkDistribution.ParamsSpec().Validators(), entries); err != nil {
+		return nil, err
+	}
+	_, err := checkDistribution.Run(renv, entries)
+	return nil, err
+}
+
+type CreateInvalidation struct {
+	_            string `action:"create" entity:"invalidation" awsAPI:"cloudfront"`
+	logger       *logger.Logger
+	graph        cloud.GraphAPI
+	api          cloudfrontiface.CloudFrontAPI
+	Distribution *string   `templateName:"distribution"`
+	Path         []*string `templateName:"path"`
+}
+
+func (cmd *CreateInvalidation) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("distribution"), params.Key("path")))
+}
+
+func (cmd *CreateInvalidation) ManualRun(renv env.Running) (interface{}, error) {
+	input := &cloudfront.CreateInvalidationInput{
+		DistributionId: cmd.Distribution,
+		InvalidationBatch: &cloudfront.InvalidationBatch{
+			CallerReference: aws.String(CallerReferenceFunc()),
+			Paths: &cloudfront.Paths{
+				Items:    cmd.Path,
+				Quantity: aws.Int64(int64(len(cmd.Path))),
+			},
+		},
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateInvalidation(input)
+	cmd.logger.ExtraVerbosef("cloudfront.CreateInvalidation call took %s", time.Since(start))
+	return output, err
+}
+
+func (cmd *CreateInvalidation) ExtractResult(i interface{}) string {
+	return StringValue(i.(*cloudfront.CreateInvalidationOutput).Invalidation.Id)
+}
+
+// resolveOrigin returns the origin of a distribution given as a bucket of the region, or as a loadbalancer (name, arn or DNS name)
+// of the local graph. Origins not found in the local graph are taken for buckets, such as the ones created in the same template
+func resolveOrigin(g cloud.GraphAPI, ref, region string) (*cloudfront.Origin, error) {
+	origin := &cloudfront.Origin{Id: aws.String("orig_1")}
+	if g != nil {
+		resources, err := g.Find(cloud.NewQuery(cloud.LoadBalancer, cloud.ClassicLoadBalancer))
+		if err != nil {
+			return nil, err
+		}
+		for _, res := range resources {
+			dns, _ := res.Property(properties.PublicDNS)
+			arn, _ := res.Property(properties.Arn)
+			name, _ := res.Property(properties.Name)
+			if res.Id() != ref && arn != ref && name != ref && dns != ref {
+				continue
+			}
+			if dns == nil {
+				return nil, fmt.Errorf("origin '%s': no DNS name for loadbalancer %s in local graph", ref, res.Id())
+			}
+			origin.DomainName = String(fmt.Sprint(dns))
+			origin.CustomOriginConfig = &cloudfront.CustomOriginConfig{
+				HTTPPort:             aws.Int64(80),
+				HTTPSPort:            aws.Int64(443),
+				OriginProtocolPolicy: aws.String("http-only"),
+			}
+			return origin, nil
+		}
+	}
+	if strings.HasPrefix(ref, "arn:") {
+		return nil, fmt.Errorf("origin '%s': loadbalancer not found in local graph: run `awless sync`", ref)
+	}
+	origin.DomainName = String(ref + ".s3." + awsconfig.DNSSuffixForRegion(region))
+	origin.S3OriginConfig = &cloudfront.S3OriginConfig{OriginAccessIdentity: aws.String("")}
+	return origin, nil
+}
+
+// cloudfrontRegion returns the region of the session, where the buckets given as origin are
+func cloudfrontRegion(api cloudfrontiface.CloudFrontAPI) string {
+	if client, ok := api.(*cloudfront.CloudFront); ok {
+		return StringValue(client.Config.Region)
+	}
+	return ""
+}
+
+// newCacheBehaviors returns the cache behaviors of the PATTERN[:TTL] entries (ex: /images/*:86400), forwarding
+// and serving their objects as the default behavior. TTL is the default time in seconds objects stay in the cache
+func newCacheBehaviors(entries []*string, def *cloudfront.DefaultCacheBehavior) (*cloudfront.CacheBehaviors, error) {
+	behaviors := &cloudfront.CacheBehaviors{Quantity: aws.Int64(int64(len(entries)))}
+	for _, entry := range entries {
+		pattern, ttl := StringValue(entry), ""
+		if i := strings.LastIndex(pattern, ":"); i > -1 {
+			pattern, ttl = pattern[:i], pattern[i+1:]
+		}
+		behavior := &cloudfront.CacheBehavior{
+			PathPattern:          aws.String(pattern),
+			TargetOriginId:       def.TargetOriginId,
+			ForwardedValues:      def.ForwardedValues,
+			TrustedSigners:       def.TrustedSigners,
+			ViewerProtocolPolicy: def.ViewerProtocolPolicy,
+			MinTTL:               aws.Int64(0),
+		}
+		if ttl != "" {
+			seconds, err := strconv.ParseInt(ttl, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("cache behavior '%s': invalid TTL, expecting PATTERN[:SECONDS]", StringValue(entry))
+			}
+			behavior.DefaultTTL = aws.Int64(seconds)
+		}
+		behaviors.Items = append(behaviors.Items, behavior)
+	}
+	return behaviors, nil
+}
+
+func isS3OriginDomain(domain string) bool {
+	for _, suffix := range []string{".amazonaws.com", ".amazonaws.com.cn"} {
+		if strings.HasSuffix(domain, ".s3"+suffix) || (strings.HasSuffix(domain, suffix) && strings.Contains(domain, ".s3-website-")) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,28 @@
+package awsspec
+
+import "testing"
+
+func TestResolveBucketOrigin(t *testing.T) {
+	tcases := []struct {
+		region, expDomain string
+	}{
+		{region: "eu-west-1", expDomain: "my-website.s3.amazonaws.com"},
+		{region: "cn-north-1", expDomain: "my-website.s3.amazonaws.com.cn"},
+		{region: "us-gov-west-1", expDomain: "my-website.s3.amazonaws.com"},
+	}
+	for _, tcase := range tcases {
+		origin, err := resolveOrigin(nil, "my-website", tcase.region)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := StringValue(origin.DomainName), tcase.expDomain; got != want {
+			t.Fatalf("%s: got %s, want %s", tcase.region, got, want)
+		}
+		if origin.S3OriginConfig == nil {
+			t.Fatalf("%s: expected S3 origin config", tcase.region)
+		}
+		if !isS3OriginDomain(StringValue(origin.DomainName)) {
+			t.Fatalf("%s: expected %s to be an S3 origin domain", tcase.region, StringValue(origin.DomainName))
+		}
+	}
+}
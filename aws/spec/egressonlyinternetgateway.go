@@ -0,0 +1,53 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateEgressonlyinternetgateway struct {
+	_      string `action:"create" entity:"egressonlyinternetgateway" awsAPI:"ec2" awsCall:"CreateEgressOnlyInternetGateway" awsInput:"ec2.CreateEgressOnlyInternetGatewayInput" awsOutput:"ec2.CreateEgressOnlyInternetGatewayOutput" awsDryRun:""`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2iface.EC2API
+	Vpc    *string `awsName:"VpcId" awsType:"awsstr" templateName:"vpc"`
+}
+
+func (cmd *CreateEgressonlyinternetgateway) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("vpc")))
+}
+
+func (cmd *CreateEgressonlyinternetgateway) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*ec2.CreateEgressOnlyInternetGatewayOutput).EgressOnlyInternetGateway.EgressOnlyInternetGatewayId)
+}
+
+type DeleteEgressonlyinternetgateway struct {
+	_      string `action:"delete" entity:"egressonlyinternetgateway" awsAPI:"ec2" awsCall:"DeleteEgressOnlyInternetGateway" awsInput:"ec2.DeleteEgressOnlyInternetGatewayInput" awsOutput:"ec2.DeleteEgressOnlyInternetGatewayOutput" awsDryRun:""`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2iface.EC2API
+	Id     *string `awsName:"EgressOnlyInternetGatewayId" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *DeleteEgressonlyinternetgateway) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
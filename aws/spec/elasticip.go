@@ -16,11 +16,15 @@ limitations under the License.
 package awsspec
 
 import (
+	"fmt"
+	"time"
+
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/params"
 )
 
@@ -70,7 +74,10 @@ type AttachElasticip struct {
 
 func (cmd *AttachElasticip) ParamsSpec() params.Spec {
 	return params.NewSpec(
-		params.AllOf(params.Key("id"), params.Opt("allow-reassociation", "instance", "networkinterface", "privateip")),
+		params.AllOf(params.Key("id"),
+			params.AtLeastOneOf(params.Key("instance"), params.Key("networkinterface")),
+			params.Opt("allow-reassociation", "privateip"),
+		),
 		params.Validators{"privateip": params.IsIP},
 	)
 }
@@ -80,13 +87,101 @@ func (cmd *AttachElasticip) ExtractResult(i interface{}) string {
 }
 
 type DetachElasticip struct {
-	_           string `action:"detach" entity:"elasticip" awsAPI:"ec2" awsCall:"DisassociateAddress" awsInput:"ec2.DisassociateAddressInput" awsOutput:"ec2.DisassociateAddressOutput" awsDryRun:""`
+	_           string `action:"detach" entity:"elasticip" awsAPI:"ec2" awsDryRun:"manual"`
 	logger      *logger.Logger
 	graph       cloud.GraphAPI
 	api         ec2iface.EC2API
-	Association *string `awsName:"AssociationId" awsType:"awsstr" templateName:"association"`
+	Id          *string `templateName:"id"`
+	Association *string `templateName:"association"`
 }
 
 func (cmd *DetachElasticip) ParamsSpec() params.Spec {
-	return params.NewSpec(params.AllOf(params.Key("association")))
+	return params.NewSpec(params.OnlyOneOf(params.Key("id"), params.Key("association")))
+}
+
+func (cmd *DetachElasticip) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+	if cmd.Association != nil {
+		_, err := cmd.api.DisassociateAddress(&ec2.DisassociateAddressInput{AssociationId: cmd.Association, DryRun: Bool(true)})
+		return nil, dryRunError(cmd.logger, "detach elasticip", err)
+	}
+	_, err := cmd.api.DescribeAddresses(&ec2.DescribeAddressesInput{AllocationIds: []*string{cmd.Id}, DryRun: Bool(true)})
+	return nil, dryRunError(cmd.logger, "detach elasticip", err)
+}
+
+// ManualRun disassociates the elastic IP, resolving its current association when given by id
+func (cmd *DetachElasticip) ManualRun(renv env.Running) (interface{}, error) {
+	association := cmd.Association
+	if association == nil {
+		addr, err := elasticipAddress(cmd.api, cmd.Id, nil)
+		if err != nil {
+			return nil, err
+		}
+		if addr.AssociationId == nil {
+			return nil, fmt.Errorf("elasticip %s is not attached", StringValue(cmd.Id))
+		}
+		association = addr.AssociationId
+	}
+	start := time.Now()
+	output, err := cmd.api.DisassociateAddress(&ec2.DisassociateAddressInput{AssociationId: association})
+	cmd.logger.ExtraVerbosef("ec2.DisassociateAddress call took %s", time.Since(start))
+	return output, err
+}
+
+func (cmd *DetachElasticip) IAMActions(params map[string]interface{}) []string {
+	if _, ok := params["id"]; ok {
+		return []string{"ec2:DescribeAddresses", "ec2:DisassociateAddress"}
+	}
+	return []string{"ec2:DisassociateAddress"}
+}
+
+// PriorState returns the elastic IP and what it is attached to before the detach,
+// so that it can be attached back: the network interface and private IP when in a VPC,
+// the instance otherwise
+func (cmd *DetachElasticip) PriorState(renv env.Running, params map[string]interface{}) (map[string]interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, err
+	}
+	addr, err := elasticipAddress(cmd.api, cmd.Id, cmd.Association)
+	if err != nil {
+		return nil, err
+	}
+	if addr.AllocationId == nil {
+		return nil, nil
+	}
+	prior := map[string]interface{}{"id": StringValue(addr.AllocationId)}
+	switch {
+	case addr.NetworkInterfaceId != nil:
+		prior["networkinterface"] = StringValue(addr.NetworkInterfaceId)
+		if addr.PrivateIpAddress != nil {
+			prior["privateip"] = StringValue(addr.PrivateIpAddress)
+		}
+	case addr.InstanceId != nil:
+		prior["instance"] = StringValue(addr.InstanceId)
+	default:
+		return nil, nil
+	}
+	return prior, nil
+}
+
+func elasticipAddress(api ec2iface.EC2API, id, association *string) (*ec2.Address, error) {
+	input := &ec2.DescribeAddressesInput{}
+	if id != nil {
+		input.AllocationIds = []*string{id}
+	} else {
+		input.Filters = []*ec2.Filter{{Name: String("association-id"), Values: []*string{association}}}
+	}
+	out, err := api.DescribeAddresses(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(out.Addresses) == 0 {
+		if id != nil {
+			return nil, fmt.Errorf("elasticip %s not found", StringValue(id))
+		}
+		return nil, fmt.Errorf("no elasticip found with association %s", StringValue(association))
+	}
+	return out.Addresses[0], nil
 }
@@ -0,0 +1,102 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk/elasticbeanstalkiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateEnvironment struct {
+	_             string `action:"create" entity:"environment" awsAPI:"elasticbeanstalk" awsCall:"CreateEnvironment" awsInput:"elasticbeanstalk.CreateEnvironmentInput" awsOutput:"elasticbeanstalk.EnvironmentDescription"`
+	logger        *logger.Logger
+	graph         cloud.GraphAPI
+	api           elasticbeanstalkiface.ElasticBeanstalkAPI
+	Application   *string   `awsName:"ApplicationName" awsType:"awsstr" templateName:"application"`
+	Name          *string   `awsName:"EnvironmentName" awsType:"awsstr" templateName:"name"`
+	SolutionStack *string   `awsName:"SolutionStackName" awsType:"awsstr" templateName:"solution-stack"`
+	Version       *string   `awsName:"VersionLabel" awsType:"awsstr" templateName:"version"`
+	Cname         *string   `awsName:"CNAMEPrefix" awsType:"awsstr" templateName:"cname"`
+	Description   *string   `awsName:"Description" awsType:"awsstr" templateName:"description"`
+	Options       []*string `awsName:"OptionSettings" awsType:"awsoptionsettings" templateName:"options"`
+}
+
+func (cmd *CreateEnvironment) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("application"), params.Key("name"), params.Key("solution-stack"),
+		params.Opt(params.Suggested("version"), "cname", "description", "options"),
+	),
+		params.Validators{"name": params.MinLengthOf(4), "cname": params.MinLengthOf(4)},
+	)
+}
+
+func (cmd *CreateEnvironment) ExtractResult(i interface{}) string {
+	return StringValue(i.(*elasticbeanstalk.EnvironmentDescription).EnvironmentId)
+}
+
+type UpdateEnvironment struct {
+	_       string `action:"update" entity:"environment" awsAPI:"elasticbeanstalk" awsCall:"UpdateEnvironment" awsInput:"elasticbeanstalk.UpdateEnvironmentInput" awsOutput:"elasticbeanstalk.EnvironmentDescription"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     elasticbeanstalkiface.ElasticBeanstalkAPI
+	Id      *string   `awsName:"EnvironmentId" awsType:"awsstr" templateName:"id"`
+	Version *string   `awsName:"VersionLabel" awsType:"awsstr" templateName:"version"`
+	Options []*string `awsName:"OptionSettings" awsType:"awsoptionsettings" templateName:"options"`
+}
+
+func (cmd *UpdateEnvironment) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"),
+		params.AtLeastOneOf(params.Key("version"), params.Key("options")),
+	))
+}
+
+func (cmd *UpdateEnvironment) ExtractResult(i interface{}) string {
+	return StringValue(i.(*elasticbeanstalk.EnvironmentDescription).EnvironmentId)
+}
+
+// PriorState returns the version deployed on the environment before the update.
+// Updates of the option settings are not revertible
+func (cmd *UpdateEnvironment) PriorState(renv env.Running, params map[string]interface{}) (map[string]interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, err
+	}
+	if cmd.Options != nil {
+		return nil, nil
+	}
+	out, err := cmd.api.DescribeEnvironments(&elasticbeanstalk.DescribeEnvironmentsInput{EnvironmentIds: []*string{cmd.Id}})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.Environments) == 0 || out.Environments[0].VersionLabel == nil {
+		return nil, nil
+	}
+	return map[string]interface{}{"version": StringValue(out.Environments[0].VersionLabel)}, nil
+}
+
+type TerminateEnvironment struct {
+	_      string `action:"terminate" entity:"environment" awsAPI:"elasticbeanstalk" awsCall:"TerminateEnvironment" awsInput:"elasticbeanstalk.TerminateEnvironmentInput" awsOutput:"elasticbeanstalk.EnvironmentDescription"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    elasticbeanstalkiface.ElasticBeanstalkAPI
+	Id     *string `awsName:"EnvironmentId" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *TerminateEnvironment) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
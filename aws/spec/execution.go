@@ -0,0 +1,57 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/params"
+)
+
+type StartExecution struct {
+	_            string `action:"start" entity:"execution" awsAPI:"sfn" awsCall:"StartExecution" awsInput:"sfn.StartExecutionInput" awsOutput:"sfn.StartExecutionOutput"`
+	logger       *logger.Logger
+	graph        cloud.GraphAPI
+	api          sfniface.SFNAPI
+	Statemachine *string `awsName:"StateMachineArn" awsType:"awsstr" templateName:"statemachine"`
+	Name         *string `awsName:"Name" awsType:"awsstr" templateName:"name"`
+	Input        *string `awsName:"Input" awsType:"awsstr" templateName:"input"`
+}
+
+func (cmd *StartExecution) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("statemachine"), params.Opt(params.Suggested("input"), "name")))
+}
+
+func (cmd *StartExecution) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*sfn.StartExecutionOutput).ExecutionArn)
+}
+
+type StopExecution struct {
+	_      string `action:"stop" entity:"execution" awsAPI:"sfn" awsCall:"StopExecution" awsInput:"sfn.StopExecutionInput" awsOutput:"sfn.StopExecutionOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    sfniface.SFNAPI
+	Id     *string `awsName:"ExecutionArn" awsType:"awsstr" templateName:"id"`
+	Error  *string `awsName:"Error" awsType:"awsstr" templateName:"error"`
+	Cause  *string `awsName:"Cause" awsType:"awsstr" templateName:"cause"`
+}
+
+func (cmd *StopExecution) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"), params.Opt("cause", "error")))
+}
@@ -16,11 +16,18 @@ limitations under the License.
 package awsspec
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/params"
 )
 
@@ -29,24 +36,45 @@ type CreateFunction struct {
 	logger        *logger.Logger
 	graph         cloud.GraphAPI
 	api           lambdaiface.LambdaAPI
-	Name          *string `awsName:"FunctionName" awsType:"awsstr" templateName:"name"`
-	Handler       *string `awsName:"Handler" awsType:"awsstr" templateName:"handler"`
-	Role          *string `awsName:"Role" awsType:"awsstr" templateName:"role"`
-	Runtime       *string `awsName:"Runtime" awsType:"awsstr" templateName:"runtime"`
-	Bucket        *string `awsName:"Code.S3Bucket" awsType:"awsstr" templateName:"bucket"`
-	Object        *string `awsName:"Code.S3Key" awsType:"awsstr" templateName:"object"`
-	Objectversion *string `awsName:"Code.S3ObjectVersion" awsType:"awsstr" templateName:"objectversion"`
-	Zipfile       *string `awsName:"Code.ZipFile" awsType:"awsfiletobyteslice" templateName:"zipfile"`
-	Description   *string `awsName:"Description" awsType:"awsstr" templateName:"description"`
-	Memory        *int64  `awsName:"MemorySize" awsType:"awsint64" templateName:"memory"`
-	Publish       *bool   `awsName:"Publish" awsType:"awsbool" templateName:"publish"`
-	Timeout       *int64  `awsName:"Timeout" awsType:"awsint64" templateName:"timeout"`
+	Name          *string   `awsName:"FunctionName" awsType:"awsstr" templateName:"name"`
+	Handler       *string   `awsName:"Handler" awsType:"awsstr" templateName:"handler"`
+	Role          *string   `awsName:"Role" awsType:"awsstr" templateName:"role"`
+	Runtime       *string   `awsName:"Runtime" awsType:"awsstr" templateName:"runtime"`
+	Bucket        *string   `awsName:"Code.S3Bucket" awsType:"awsstr" templateName:"bucket"`
+	Object        *string   `awsName:"Code.S3Key" awsType:"awsstr" templateName:"object"`
+	Objectversion *string   `awsName:"Code.S3ObjectVersion" awsType:"awsstr" templateName:"objectversion"`
+	Zipfile       *string   `awsName:"Code.ZipFile" awsType:"awsfiletobyteslice" templateName:"zipfile"`
+	Description   *string   `awsName:"Description" awsType:"awsstr" templateName:"description"`
+	Memory        *int64    `awsName:"MemorySize" awsType:"awsint64" templateName:"memory"`
+	Publish       *bool     `awsName:"Publish" awsType:"awsbool" templateName:"publish"`
+	Timeout       *int64    `awsName:"Timeout" awsType:"awsint64" templateName:"timeout"`
+	Environment   []*string `awsName:"Environment.Variables" awsType:"awsstringmap" templateName:"environment"`
 }
 
 func (cmd *CreateFunction) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("handler"), params.Key("name"), params.Key("role"), params.Key("runtime"),
-		params.Opt("bucket", "description", "memory", "object", "objectversion", "publish", "timeout", "zipfile"),
-	))
+		params.Opt("bucket", "description", "environment", "memory", "object", "objectversion", "publish", "timeout", "zipfile"),
+	),
+		params.Validators{
+			"zipfile": func(i interface{}, others map[string]interface{}) error {
+				if _, ok := others["bucket"]; ok {
+					return errors.New("either 'zipfile' or 'bucket' and 'object' can be given as code of the function")
+				}
+				return nil
+			},
+		})
+}
+
+func (cmd *CreateFunction) BeforeRun(renv env.Running) error {
+	if cmd.Zipfile == nil && (cmd.Bucket == nil || cmd.Object == nil) {
+		return errors.New("missing code of the function: expecting 'zipfile' or 'bucket' and 'object'")
+	}
+	arn, err := roleArn(cmd.graph, cmd.Role)
+	if err != nil {
+		return err
+	}
+	cmd.Role = arn
+	return nil
 }
 
 func (cmd *CreateFunction) ExtractResult(i interface{}) string {
@@ -67,3 +95,218 @@ func (cmd *DeleteFunction) ParamsSpec() params.Spec {
 		params.Opt("version"),
 	))
 }
+
+type UpdateFunction struct {
+	_             string `action:"update" entity:"function" awsAPI:"lambda"`
+	logger        *logger.Logger
+	graph         cloud.GraphAPI
+	api           lambdaiface.LambdaAPI
+	Id            *string   `templateName:"id"`
+	Bucket        *string   `templateName:"bucket"`
+	Object        *string   `templateName:"object"`
+	Objectversion *string   `templateName:"objectversion"`
+	Zipfile       *string   `templateName:"zipfile"`
+	Publish       *bool     `templateName:"publish"`
+	Handler       *string   `templateName:"handler"`
+	Role          *string   `templateName:"role"`
+	Runtime       *string   `templateName:"runtime"`
+	Description   *string   `templateName:"description"`
+	Memory        *int64    `templateName:"memory"`
+	Timeout       *int64    `templateName:"timeout"`
+	Environment   []*string `templateName:"environment"`
+}
+
+func (cmd *UpdateFunction) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"),
+		params.AtLeastOneOf(params.Key("zipfile"), params.Key("bucket"), params.Key("handler"), params.Key("role"), params.Key("runtime"),
+			params.Key("description"), params.Key("memory"), params.Key("timeout"), params.Key("environment")),
+		params.Opt("object", "objectversion", "publish"),
+	),
+		params.Validators{
+			"zipfile": func(i interface{}, others map[string]interface{}) error {
+				if _, ok := others["bucket"]; ok {
+					return errors.New("either 'zipfile' or 'bucket' and 'object' can be given as code of the function")
+				}
+				return nil
+			},
+			"bucket": func(i interface{}, others map[string]interface{}) error {
+				if _, ok := others["object"]; !ok {
+					return errors.New("'bucket' requires 'object'")
+				}
+				return nil
+			},
+		})
+}
+
+// ManualRun updates the code of the function, when given, then its configuration
+func (cmd *UpdateFunction) ManualRun(renv env.Running) (interface{}, error) {
+	var arn *string
+	if cmd.hasCode() {
+		input := &lambda.UpdateFunctionCodeInput{
+			FunctionName:    cmd.Id,
+			S3Bucket:        cmd.Bucket,
+			S3Key:           cmd.Object,
+			S3ObjectVersion: cmd.Objectversion,
+			Publish:         cmd.Publish,
+		}
+		if cmd.Zipfile != nil {
+			if err := setFieldWithType(cmd.Zipfile, input, "ZipFile", awsfiletobyteslice); err != nil {
+				return nil, err
+			}
+		}
+		start := time.Now()
+		out, err := cmd.api.UpdateFunctionCode(input)
+		if err != nil {
+			return nil, err
+		}
+		cmd.logger.ExtraVerbosef("lambda.UpdateFunctionCode call took %s", time.Since(start))
+		arn = out.FunctionArn
+	}
+
+	if cmd.hasConfiguration() {
+		input := &lambda.UpdateFunctionConfigurationInput{
+			FunctionName: cmd.Id,
+			Handler:      cmd.Handler,
+			Runtime:      cmd.Runtime,
+			Description:  cmd.Description,
+			MemorySize:   cmd.Memory,
+			Timeout:      cmd.Timeout,
+		}
+		if cmd.Role != nil {
+			role, err := roleArn(cmd.graph, cmd.Role)
+			if err != nil {
+				return nil, err
+			}
+			input.Role = role
+		}
+		if cmd.Environment != nil {
+			if err := setFieldWithType(cmd.Environment, input, "Environment.Variables", awsstringmap); err != nil {
+				return nil, err
+			}
+		}
+		start := time.Now()
+		out, err := cmd.api.UpdateFunctionConfiguration(input)
+		if err != nil {
+			return nil, err
+		}
+		cmd.logger.ExtraVerbosef("lambda.UpdateFunctionConfiguration call took %s", time.Since(start))
+		arn = out.FunctionArn
+	}
+	return arn, nil
+}
+
+func (cmd *UpdateFunction) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*string))
+}
+
+// PriorState returns the configuration of the function being updated, so that the update can be reverted.
+// Updates of the code are not revertible
+func (cmd *UpdateFunction) PriorState(renv env.Running, params map[string]interface{}) (map[string]interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, err
+	}
+	if cmd.hasCode() || cmd.Environment != nil {
+		return nil, nil
+	}
+	conf, err := cmd.api.GetFunctionConfiguration(&lambda.GetFunctionConfigurationInput{FunctionName: cmd.Id})
+	if err != nil {
+		return nil, err
+	}
+	prior := map[string]interface{}{
+		"handler":     StringValue(conf.Handler),
+		"role":        StringValue(conf.Role),
+		"runtime":     StringValue(conf.Runtime),
+		"description": StringValue(conf.Description),
+		"memory":      Int64AsIntValue(conf.MemorySize),
+		"timeout":     Int64AsIntValue(conf.Timeout),
+	}
+	state := make(map[string]interface{})
+	for k := range params {
+		if v, ok := prior[k]; ok {
+			state[k] = v
+		}
+	}
+	return state, nil
+}
+
+func (cmd *UpdateFunction) hasCode() bool {
+	return cmd.Zipfile != nil || cmd.Bucket != nil
+}
+
+func (cmd *UpdateFunction) hasConfiguration() bool {
+	return cmd.Handler != nil || cmd.Role != nil || cmd.Runtime != nil || cmd.Description != nil ||
+		cmd.Memory != nil || cmd.Timeout != nil || cmd.Environment != nil
+}
+
+type InvokeFunction struct {
+	_       string `action:"invoke" entity:"function" awsAPI:"lambda"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     lambdaiface.LambdaAPI
+	Id      *string `templateName:"id"`
+	Payload *string `templateName:"payload"`
+	Async   *bool   `templateName:"async"`
+	Version *string `templateName:"version"`
+}
+
+func (cmd *InvokeFunction) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"),
+		params.Opt("async", "payload", "version"),
+	))
+}
+
+// ManualRun invokes the function, waiting for its response unless async=true.
+// The response payload of the function is the result of the command
+func (cmd *InvokeFunction) ManualRun(renv env.Running) (interface{}, error) {
+	input := &lambda.InvokeInput{
+		FunctionName:   cmd.Id,
+		Qualifier:      cmd.Version,
+		InvocationType: String(lambda.InvocationTypeRequestResponse),
+	}
+	if BoolValue(cmd.Async) {
+		input.InvocationType = String(lambda.InvocationTypeEvent)
+	}
+	if cmd.Payload != nil {
+		input.Payload = []byte(StringValue(cmd.Payload))
+	}
+	start := time.Now()
+	out, err := cmd.api.Invoke(input)
+	if err != nil {
+		return nil, err
+	}
+	cmd.logger.ExtraVerbosef("lambda.Invoke call took %s", time.Since(start))
+	if fnErr := StringValue(out.FunctionError); fnErr != "" {
+		return nil, fmt.Errorf("function %s: %s error: %s", StringValue(cmd.Id), strings.ToLower(fnErr), strings.TrimSpace(string(out.Payload)))
+	}
+	if BoolValue(cmd.Async) {
+		return cmd.Id, nil
+	}
+	return String(strings.TrimSpace(string(out.Payload))), nil
+}
+
+func (cmd *InvokeFunction) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*string))
+}
+
+// roleArn returns the ARN of the role given as ARN, or as id or name of a role of the local graph
+func roleArn(g cloud.GraphAPI, role *string) (*string, error) {
+	ref := StringValue(role)
+	if strings.HasPrefix(ref, "arn:") || g == nil {
+		return role, nil
+	}
+	for _, prop := range []string{properties.ID, properties.Name} {
+		roles, err := g.FindWithProperties(map[string]interface{}{prop: ref})
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range roles {
+			if r.Type() != cloud.Role {
+				continue
+			}
+			if arn, ok := r.Properties()[properties.Arn].(string); ok {
+				return String(arn), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("role '%s' not found in local graph: use its ARN or run `awless sync`", ref)
+}
@@ -18,177 +18,335 @@ limitations under the License.
 package awsspec
 
 var APIPerTemplateDefName = map[string]string{
-	"attachalarm":               "cloudwatch",
-	"attachcontainertask":       "ecs",
-	"attachelasticip":           "ec2",
-	"attachinstance":            "elbv2",
-	"attachinstanceprofile":     "ec2",
-	"attachinternetgateway":     "ec2",
-	"attachlistener":            "elbv2",
-	"attachmfadevice":           "iam",
-	"attachnetworkinterface":    "ec2",
-	"attachpolicy":              "iam",
-	"attachrole":                "iam",
-	"attachroutetable":          "ec2",
-	"attachsecuritygroup":       "ec2",
-	"attachuser":                "iam",
-	"attachvolume":              "ec2",
-	"authenticateregistry":      "ecr",
-	"checkcertificate":          "acm",
-	"checkdatabase":             "rds",
-	"checkdistribution":         "cloudfront",
-	"checkinstance":             "ec2",
-	"checkloadbalancer":         "elbv2",
-	"checknatgateway":           "ec2",
-	"checknetworkinterface":     "ec2",
-	"checkscalinggroup":         "autoscaling",
-	"checksecuritygroup":        "ec2",
-	"checkvolume":               "ec2",
-	"copyimage":                 "ec2",
-	"copysnapshot":              "ec2",
-	"createaccesskey":           "iam",
-	"createalarm":               "cloudwatch",
-	"createappscalingpolicy":    "applicationautoscaling",
-	"createappscalingtarget":    "applicationautoscaling",
-	"createbucket":              "s3",
-	"createcertificate":         "acm",
-	"createcontainercluster":    "ecs",
-	"createdatabase":            "rds",
-	"createdbsubnetgroup":       "rds",
-	"createdistribution":        "cloudfront",
-	"createelasticip":           "ec2",
-	"createfunction":            "lambda",
-	"creategroup":               "iam",
-	"createimage":               "ec2",
-	"createinstance":            "ec2",
-	"createinstanceprofile":     "iam",
-	"createinternetgateway":     "ec2",
-	"createkeypair":             "ec2",
-	"createlaunchconfiguration": "autoscaling",
-	"createlistener":            "elbv2",
-	"createloadbalancer":        "elbv2",
-	"createloginprofile":        "iam",
-	"createmfadevice":           "iam",
-	"createnatgateway":          "ec2",
-	"createnetworkinterface":    "ec2",
-	"createpolicy":              "iam",
-	"createqueue":               "sqs",
-	"createrecord":              "route53",
-	"createrepository":          "ecr",
-	"createrole":                "iam",
-	"createroute":               "ec2",
-	"createroutetable":          "ec2",
-	"creates3object":            "s3",
-	"createscalinggroup":        "autoscaling",
-	"createscalingpolicy":       "autoscaling",
-	"createsecuritygroup":       "ec2",
-	"createsnapshot":            "ec2",
-	"createstack":               "cloudformation",
-	"createsubnet":              "ec2",
-	"createsubscription":        "sns",
-	"createtag":                 "ec2",
-	"createtargetgroup":         "elbv2",
-	"createtopic":               "sns",
-	"createuser":                "iam",
-	"createvolume":              "ec2",
-	"createvpc":                 "ec2",
-	"createzone":                "route53",
-	"deleteaccesskey":           "iam",
-	"deletealarm":               "cloudwatch",
-	"deleteappscalingpolicy":    "applicationautoscaling",
-	"deleteappscalingtarget":    "applicationautoscaling",
-	"deletebucket":              "s3",
-	"deletecertificate":         "acm",
-	"deletecontainercluster":    "ecs",
-	"deletecontainertask":       "ecs",
-	"deletedatabase":            "rds",
-	"deletedbsubnetgroup":       "rds",
-	"deletedistribution":        "cloudfront",
-	"deleteelasticip":           "ec2",
-	"deletefunction":            "lambda",
-	"deletegroup":               "iam",
-	"deleteimage":               "ec2",
-	"deleteinstance":            "ec2",
-	"deleteinstanceprofile":     "iam",
-	"deleteinternetgateway":     "ec2",
-	"deletekeypair":             "ec2",
-	"deletelaunchconfiguration": "autoscaling",
-	"deletelistener":            "elbv2",
-	"deleteloadbalancer":        "elbv2",
-	"deleteloginprofile":        "iam",
-	"deletemfadevice":           "iam",
-	"deletenatgateway":          "ec2",
-	"deletenetworkinterface":    "ec2",
-	"deletepolicy":              "iam",
-	"deletequeue":               "sqs",
-	"deleterecord":              "route53",
-	"deleterepository":          "ecr",
-	"deleterole":                "iam",
-	"deleteroute":               "ec2",
-	"deleteroutetable":          "ec2",
-	"deletes3object":            "s3",
-	"deletescalinggroup":        "autoscaling",
-	"deletescalingpolicy":       "autoscaling",
-	"deletesecuritygroup":       "ec2",
-	"deletesnapshot":            "ec2",
-	"deletestack":               "cloudformation",
-	"deletesubnet":              "ec2",
-	"deletesubscription":        "sns",
-	"deletetag":                 "ec2",
-	"deletetargetgroup":         "elbv2",
-	"deletetopic":               "sns",
-	"deleteuser":                "iam",
-	"deletevolume":              "ec2",
-	"deletevpc":                 "ec2",
-	"deletezone":                "route53",
-	"detachalarm":               "cloudwatch",
-	"detachcontainertask":       "ecs",
-	"detachelasticip":           "ec2",
-	"detachinstance":            "elbv2",
-	"detachinstanceprofile":     "ec2",
-	"detachinternetgateway":     "ec2",
-	"detachmfadevice":           "iam",
-	"detachnetworkinterface":    "ec2",
-	"detachpolicy":              "iam",
-	"detachrole":                "iam",
-	"detachroutetable":          "ec2",
-	"detachsecuritygroup":       "ec2",
-	"detachuser":                "iam",
-	"detachvolume":              "ec2",
-	"importimage":               "ec2",
-	"restartdatabase":           "rds",
-	"restartinstance":           "ec2",
-	"startalarm":                "cloudwatch",
-	"startcontainertask":        "ecs",
-	"startdatabase":             "rds",
-	"startinstance":             "ec2",
-	"stopalarm":                 "cloudwatch",
-	"stopcontainertask":         "ecs",
-	"stopdatabase":              "rds",
-	"stopinstance":              "ec2",
-	"updatebucket":              "s3",
-	"updatecontainertask":       "ecs",
-	"updatedistribution":        "cloudfront",
-	"updateimage":               "ec2",
-	"updateinstance":            "ec2",
-	"updateloginprofile":        "iam",
-	"updatepolicy":              "iam",
-	"updaterecord":              "route53",
-	"updates3object":            "s3",
-	"updatescalinggroup":        "autoscaling",
-	"updatesecuritygroup":       "ec2",
-	"updatestack":               "cloudformation",
-	"updatesubnet":              "ec2",
-	"updatetargetgroup":         "elbv2",
+	"acceptpeeringconnection":         "ec2",
+	"attachalarm":                     "cloudwatch",
+	"attachclassicloadbalancer":       "elb",
+	"attachcontainertask":             "ecs",
+	"attachelasticip":                 "ec2",
+	"attachinstance":                  "elbv2",
+	"attachinstanceprofile":           "ec2",
+	"attachinternetgateway":           "ec2",
+	"attachlistener":                  "elbv2",
+	"attachmfadevice":                 "iam",
+	"attachnetworkacl":                "ec2",
+	"attachnetworkinterface":          "ec2",
+	"attachpolicy":                    "iam",
+	"attachrole":                      "iam",
+	"attachroutetable":                "ec2",
+	"attachsecuritygroup":             "ec2",
+	"attachservicecontrolpolicy":      "organizations",
+	"attachtarget":                    "cloudwatchevents",
+	"attachtransitgatewayroutetable":  "ec2",
+	"attachuser":                      "iam",
+	"attachvolume":                    "ec2",
+	"attachvpngateway":                "ec2",
+	"authenticateregistry":            "ecr",
+	"cancelspotrequest":               "ec2",
+	"checkcachecluster":               "elasticache",
+	"checkcertificate":                "acm",
+	"checkcluster":                    "redshift",
+	"checkdatabase":                   "rds",
+	"checkdistribution":               "cloudfront",
+	"checkhttp":                       "elbv2",
+	"checkinstance":                   "ec2",
+	"checkkubernetescluster":          "eks",
+	"checkloadbalancer":               "elbv2",
+	"checknatgateway":                 "ec2",
+	"checknetworkinterface":           "ec2",
+	"checkscalinggroup":               "autoscaling",
+	"checksecuritygroup":              "ec2",
+	"checktcp":                        "ec2",
+	"checktransitgateway":             "ec2",
+	"checktransitgatewayattachment":   "ec2",
+	"checktransitgatewayroutetable":   "ec2",
+	"checkvolume":                     "ec2",
+	"checkvpnconnection":              "ec2",
+	"copyimage":                       "ec2",
+	"copysnapshot":                    "ec2",
+	"createaccesskey":                 "iam",
+	"createaccount":                   "organizations",
+	"createalarm":                     "cloudwatch",
+	"createalias":                     "kms",
+	"createapplication":               "elasticbeanstalk",
+	"createappscalingpolicy":          "applicationautoscaling",
+	"createappscalingtarget":          "applicationautoscaling",
+	"createbucket":                    "s3",
+	"createcachecluster":              "elasticache",
+	"createcachesubnetgroup":          "elasticache",
+	"createcatalogdatabase":           "glue",
+	"createcertificate":               "acm",
+	"createclassicloadbalancer":       "elb",
+	"createcluster":                   "redshift",
+	"createcomputeenvironment":        "batch",
+	"createcontainercluster":          "ecs",
+	"createcontainerservice":          "ecs",
+	"createcrawler":                   "glue",
+	"createcustomergateway":           "ec2",
+	"createdatabase":                  "rds",
+	"createdbparametergroup":          "rds",
+	"createdbsubnetgroup":             "rds",
+	"createdeployment":                "apigateway",
+	"createdistribution":              "cloudfront",
+	"createegressonlyinternetgateway": "ec2",
+	"createelasticip":                 "ec2",
+	"createenvironment":               "elasticbeanstalk",
+	"createfunction":                  "lambda",
+	"creategrant":                     "kms",
+	"creategroup":                     "iam",
+	"createhost":                      "ec2",
+	"createhostreservation":           "ec2",
+	"createimage":                     "ec2",
+	"createinstance":                  "ec2",
+	"createinstanceprofile":           "iam",
+	"createinternetgateway":           "ec2",
+	"createinvalidation":              "cloudfront",
+	"createjobqueue":                  "batch",
+	"createkey":                       "kms",
+	"createkeypair":                   "ec2",
+	"createkubernetescluster":         "eks",
+	"createlaunchconfiguration":       "autoscaling",
+	"createlistener":                  "elbv2",
+	"createloadbalancer":              "elbv2",
+	"createloggroup":                  "cloudwatchlogs",
+	"createloginprofile":              "iam",
+	"createmethod":                    "apigateway",
+	"createmfadevice":                 "iam",
+	"createnatgateway":                "ec2",
+	"createnetworkacl":                "ec2",
+	"createnetworkaclrule":            "ec2",
+	"createnetworkinterface":          "ec2",
+	"createparameter":                 "ssm",
+	"createpeeringconnection":         "ec2",
+	"createplacementgroup":            "ec2",
+	"createpolicy":                    "iam",
+	"createpolicyversion":             "iam",
+	"createpresignedurl":              "s3",
+	"createqueue":                     "sqs",
+	"createrecord":                    "route53",
+	"createrepository":                "ecr",
+	"createresource":                  "apigateway",
+	"createrestapi":                   "apigateway",
+	"createrole":                      "iam",
+	"createroute":                     "ec2",
+	"createroutetable":                "ec2",
+	"createrule":                      "cloudwatchevents",
+	"creates3object":                  "s3",
+	"createscalinggroup":              "autoscaling",
+	"createscalingpolicy":             "autoscaling",
+	"createsecret":                    "secretsmanager",
+	"createsecuritygroup":             "ec2",
+	"createsnapshot":                  "ec2",
+	"createspotfleet":                 "ec2",
+	"createspotinstance":              "ec2",
+	"createstack":                     "cloudformation",
+	"createstage":                     "apigateway",
+	"createstatemachine":              "sfn",
+	"createsubnet":                    "ec2",
+	"createsubscription":              "sns",
+	"createtable":                     "dynamodb",
+	"createtag":                       "ec2",
+	"createtargetgroup":               "elbv2",
+	"createtopic":                     "sns",
+	"createtrail":                     "cloudtrail",
+	"createtransitgateway":            "ec2",
+	"createtransitgatewayattachment":  "ec2",
+	"createtransitgatewayroutetable":  "ec2",
+	"createuser":                      "iam",
+	"createvolume":                    "ec2",
+	"createvpc":                       "ec2",
+	"createvpcendpoint":               "ec2",
+	"createvpnconnection":             "ec2",
+	"createvpngateway":                "ec2",
+	"createzone":                      "route53",
+	"deleteaccesskey":                 "iam",
+	"deletealarm":                     "cloudwatch",
+	"deletealias":                     "kms",
+	"deleteapplication":               "elasticbeanstalk",
+	"deleteappscalingpolicy":          "applicationautoscaling",
+	"deleteappscalingtarget":          "applicationautoscaling",
+	"deletebucket":                    "s3",
+	"deletecachecluster":              "elasticache",
+	"deletecachesubnetgroup":          "elasticache",
+	"deletecatalogdatabase":           "glue",
+	"deletecertificate":               "acm",
+	"deleteclassicloadbalancer":       "elb",
+	"deletecluster":                   "redshift",
+	"deletecomputeenvironment":        "batch",
+	"deletecontainercluster":          "ecs",
+	"deletecontainerservice":          "ecs",
+	"deletecontainertask":             "ecs",
+	"deletecrawler":                   "glue",
+	"deletecustomergateway":           "ec2",
+	"deletedatabase":                  "rds",
+	"deletedbparametergroup":          "rds",
+	"deletedbsubnetgroup":             "rds",
+	"deletedeployment":                "apigateway",
+	"deletedistribution":              "cloudfront",
+	"deleteegressonlyinternetgateway": "ec2",
+	"deleteelasticip":                 "ec2",
+	"deletefunction":                  "lambda",
+	"deletegrant":                     "kms",
+	"deletegroup":                     "iam",
+	"deletehost":                      "ec2",
+	"deleteimage":                     "ec2",
+	"deleteinstance":                  "ec2",
+	"deleteinstanceprofile":           "iam",
+	"deleteinternetgateway":           "ec2",
+	"deletejobdefinition":             "batch",
+	"deletejobqueue":                  "batch",
+	"deletekey":                       "kms",
+	"deletekeypair":                   "ec2",
+	"deletekubernetescluster":         "eks",
+	"deletelaunchconfiguration":       "autoscaling",
+	"deletelistener":                  "elbv2",
+	"deleteloadbalancer":              "elbv2",
+	"deleteloggroup":                  "cloudwatchlogs",
+	"deleteloginprofile":              "iam",
+	"deletemethod":                    "apigateway",
+	"deletemfadevice":                 "iam",
+	"deletenatgateway":                "ec2",
+	"deletenetworkacl":                "ec2",
+	"deletenetworkaclrule":            "ec2",
+	"deletenetworkinterface":          "ec2",
+	"deleteparameter":                 "ssm",
+	"deletepeeringconnection":         "ec2",
+	"deleteplacementgroup":            "ec2",
+	"deletepolicy":                    "iam",
+	"deletepolicyversion":             "iam",
+	"deletequeue":                     "sqs",
+	"deleterecord":                    "route53",
+	"deleterepository":                "ecr",
+	"deleteresource":                  "apigateway",
+	"deleterestapi":                   "apigateway",
+	"deleterole":                      "iam",
+	"deleteroute":                     "ec2",
+	"deleteroutetable":                "ec2",
+	"deleterule":                      "cloudwatchevents",
+	"deletes3object":                  "s3",
+	"deletescalinggroup":              "autoscaling",
+	"deletescalingpolicy":             "autoscaling",
+	"deletesecret":                    "secretsmanager",
+	"deletesecuritygroup":             "ec2",
+	"deletesnapshot":                  "ec2",
+	"deletestack":                     "cloudformation",
+	"deletestage":                     "apigateway",
+	"deletestatemachine":              "sfn",
+	"deletesubnet":                    "ec2",
+	"deletesubscription":              "sns",
+	"deletetable":                     "dynamodb",
+	"deletetag":                       "ec2",
+	"deletetargetgroup":               "elbv2",
+	"deletetopic":                     "sns",
+	"deletetrail":                     "cloudtrail",
+	"deletetransitgateway":            "ec2",
+	"deletetransitgatewayattachment":  "ec2",
+	"deletetransitgatewayroutetable":  "ec2",
+	"deleteuser":                      "iam",
+	"deletevolume":                    "ec2",
+	"deletevpc":                       "ec2",
+	"deletevpcendpoint":               "ec2",
+	"deletevpnconnection":             "ec2",
+	"deletevpngateway":                "ec2",
+	"deletezone":                      "route53",
+	"detachalarm":                     "cloudwatch",
+	"detachclassicloadbalancer":       "elb",
+	"detachcontainertask":             "ecs",
+	"detachelasticip":                 "ec2",
+	"detachinstance":                  "elbv2",
+	"detachinstanceprofile":           "ec2",
+	"detachinternetgateway":           "ec2",
+	"detachmfadevice":                 "iam",
+	"detachnetworkacl":                "ec2",
+	"detachnetworkinterface":          "ec2",
+	"detachpolicy":                    "iam",
+	"detachrole":                      "iam",
+	"detachroutetable":                "ec2",
+	"detachsecuritygroup":             "ec2",
+	"detachservicecontrolpolicy":      "organizations",
+	"detachtarget":                    "cloudwatchevents",
+	"detachtransitgatewayroutetable":  "ec2",
+	"detachuser":                      "iam",
+	"detachvolume":                    "ec2",
+	"detachvpngateway":                "ec2",
+	"disablekey":                      "kms",
+	"downloads3object":                "s3",
+	"enablekey":                       "kms",
+	"getconsoleoutput":                "ec2",
+	"getscreenshot":                   "ec2",
+	"importimage":                     "ec2",
+	"invokefunction":                  "lambda",
+	"moveaccount":                     "organizations",
+	"rebootinstance":                  "ec2",
+	"registerjobdefinition":           "batch",
+	"resizecluster":                   "redshift",
+	"restartdatabase":                 "rds",
+	"restartinstance":                 "ec2",
+	"restoredatabase":                 "rds",
+	"restorevolume":                   "ec2",
+	"runcommand":                      "ssm",
+	"startalarm":                      "cloudwatch",
+	"startcontainertask":              "ecs",
+	"startdatabase":                   "rds",
+	"startexecution":                  "sfn",
+	"startinstance":                   "ec2",
+	"startquery":                      "athena",
+	"stopalarm":                       "cloudwatch",
+	"stopcontainertask":               "ecs",
+	"stopdatabase":                    "rds",
+	"stopexecution":                   "sfn",
+	"stopinstance":                    "ec2",
+	"submitjob":                       "batch",
+	"terminateenvironment":            "elasticbeanstalk",
+	"updatebucket":                    "s3",
+	"updatecontainerservice":          "ecs",
+	"updatecontainertask":             "ecs",
+	"updatedistribution":              "cloudfront",
+	"updateenvironment":               "elasticbeanstalk",
+	"updatefunction":                  "lambda",
+	"updateimage":                     "ec2",
+	"updateinstance":                  "ec2",
+	"updateloggroup":                  "cloudwatchlogs",
+	"updateloginprofile":              "iam",
+	"updatenetworkaclrule":            "ec2",
+	"updatenetworkinterface":          "ec2",
+	"updateparameter":                 "ssm",
+	"updatepolicy":                    "iam",
+	"updaterecord":                    "route53",
+	"updaterepository":                "ecr",
+	"updates3object":                  "s3",
+	"updatescalinggroup":              "autoscaling",
+	"updatesecret":                    "secretsmanager",
+	"updatesecuritygroup":             "ec2",
+	"updatestack":                     "cloudformation",
+	"updatestatemachine":              "sfn",
+	"updatesubnet":                    "ec2",
+	"updatetable":                     "dynamodb",
+	"updatetargetgroup":               "elbv2",
+	"updatevpc":                       "ec2",
+	"waitcertificate":                 "acm",
+	"waitdistribution":                "cloudfront",
+	"waitkubernetescluster":           "eks",
 }
 
 var AWSTemplatesDefinitions = map[string]Definition{
+	"acceptpeeringconnection": {
+		Action: "accept",
+		Entity: "peeringconnection",
+		Api:    "ec2",
+		Params: new(AcceptPeeringconnection).ParamsSpec().Rule(),
+	},
 	"attachalarm": {
 		Action: "attach",
 		Entity: "alarm",
 		Api:    "cloudwatch",
 		Params: new(AttachAlarm).ParamsSpec().Rule(),
 	},
+	"attachclassicloadbalancer": {
+		Action: "attach",
+		Entity: "classicloadbalancer",
+		Api:    "elb",
+		Params: new(AttachClassicloadbalancer).ParamsSpec().Rule(),
+	},
 	"attachcontainertask": {
 		Action: "attach",
 		Entity: "containertask",
@@ -231,6 +389,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "iam",
 		Params: new(AttachMfadevice).ParamsSpec().Rule(),
 	},
+	"attachnetworkacl": {
+		Action: "attach",
+		Entity: "networkacl",
+		Api:    "ec2",
+		Params: new(AttachNetworkacl).ParamsSpec().Rule(),
+	},
 	"attachnetworkinterface": {
 		Action: "attach",
 		Entity: "networkinterface",
@@ -261,6 +425,24 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(AttachSecuritygroup).ParamsSpec().Rule(),
 	},
+	"attachservicecontrolpolicy": {
+		Action: "attach",
+		Entity: "servicecontrolpolicy",
+		Api:    "organizations",
+		Params: new(AttachServicecontrolpolicy).ParamsSpec().Rule(),
+	},
+	"attachtarget": {
+		Action: "attach",
+		Entity: "target",
+		Api:    "cloudwatchevents",
+		Params: new(AttachTarget).ParamsSpec().Rule(),
+	},
+	"attachtransitgatewayroutetable": {
+		Action: "attach",
+		Entity: "transitgatewayroutetable",
+		Api:    "ec2",
+		Params: new(AttachTransitgatewayroutetable).ParamsSpec().Rule(),
+	},
 	"attachuser": {
 		Action: "attach",
 		Entity: "user",
@@ -273,18 +455,42 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(AttachVolume).ParamsSpec().Rule(),
 	},
+	"attachvpngateway": {
+		Action: "attach",
+		Entity: "vpngateway",
+		Api:    "ec2",
+		Params: new(AttachVpngateway).ParamsSpec().Rule(),
+	},
 	"authenticateregistry": {
 		Action: "authenticate",
 		Entity: "registry",
 		Api:    "ecr",
 		Params: new(AuthenticateRegistry).ParamsSpec().Rule(),
 	},
+	"cancelspotrequest": {
+		Action: "cancel",
+		Entity: "spotrequest",
+		Api:    "ec2",
+		Params: new(CancelSpotrequest).ParamsSpec().Rule(),
+	},
+	"checkcachecluster": {
+		Action: "check",
+		Entity: "cachecluster",
+		Api:    "elasticache",
+		Params: new(CheckCachecluster).ParamsSpec().Rule(),
+	},
 	"checkcertificate": {
 		Action: "check",
 		Entity: "certificate",
 		Api:    "acm",
 		Params: new(CheckCertificate).ParamsSpec().Rule(),
 	},
+	"checkcluster": {
+		Action: "check",
+		Entity: "cluster",
+		Api:    "redshift",
+		Params: new(CheckCluster).ParamsSpec().Rule(),
+	},
 	"checkdatabase": {
 		Action: "check",
 		Entity: "database",
@@ -297,12 +503,24 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "cloudfront",
 		Params: new(CheckDistribution).ParamsSpec().Rule(),
 	},
+	"checkhttp": {
+		Action: "check",
+		Entity: "http",
+		Api:    "elbv2",
+		Params: new(CheckHttp).ParamsSpec().Rule(),
+	},
 	"checkinstance": {
 		Action: "check",
 		Entity: "instance",
 		Api:    "ec2",
 		Params: new(CheckInstance).ParamsSpec().Rule(),
 	},
+	"checkkubernetescluster": {
+		Action: "check",
+		Entity: "kubernetescluster",
+		Api:    "eks",
+		Params: new(CheckKubernetescluster).ParamsSpec().Rule(),
+	},
 	"checkloadbalancer": {
 		Action: "check",
 		Entity: "loadbalancer",
@@ -333,12 +551,42 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(CheckSecuritygroup).ParamsSpec().Rule(),
 	},
+	"checktcp": {
+		Action: "check",
+		Entity: "tcp",
+		Api:    "ec2",
+		Params: new(CheckTcp).ParamsSpec().Rule(),
+	},
+	"checktransitgateway": {
+		Action: "check",
+		Entity: "transitgateway",
+		Api:    "ec2",
+		Params: new(CheckTransitgateway).ParamsSpec().Rule(),
+	},
+	"checktransitgatewayattachment": {
+		Action: "check",
+		Entity: "transitgatewayattachment",
+		Api:    "ec2",
+		Params: new(CheckTransitgatewayattachment).ParamsSpec().Rule(),
+	},
+	"checktransitgatewayroutetable": {
+		Action: "check",
+		Entity: "transitgatewayroutetable",
+		Api:    "ec2",
+		Params: new(CheckTransitgatewayroutetable).ParamsSpec().Rule(),
+	},
 	"checkvolume": {
 		Action: "check",
 		Entity: "volume",
 		Api:    "ec2",
 		Params: new(CheckVolume).ParamsSpec().Rule(),
 	},
+	"checkvpnconnection": {
+		Action: "check",
+		Entity: "vpnconnection",
+		Api:    "ec2",
+		Params: new(CheckVpnconnection).ParamsSpec().Rule(),
+	},
 	"copyimage": {
 		Action: "copy",
 		Entity: "image",
@@ -357,12 +605,30 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "iam",
 		Params: new(CreateAccesskey).ParamsSpec().Rule(),
 	},
+	"createaccount": {
+		Action: "create",
+		Entity: "account",
+		Api:    "organizations",
+		Params: new(CreateAccount).ParamsSpec().Rule(),
+	},
 	"createalarm": {
 		Action: "create",
 		Entity: "alarm",
 		Api:    "cloudwatch",
 		Params: new(CreateAlarm).ParamsSpec().Rule(),
 	},
+	"createalias": {
+		Action: "create",
+		Entity: "alias",
+		Api:    "kms",
+		Params: new(CreateAlias).ParamsSpec().Rule(),
+	},
+	"createapplication": {
+		Action: "create",
+		Entity: "application",
+		Api:    "elasticbeanstalk",
+		Params: new(CreateApplication).ParamsSpec().Rule(),
+	},
 	"createappscalingpolicy": {
 		Action: "create",
 		Entity: "appscalingpolicy",
@@ -381,54 +647,150 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "s3",
 		Params: new(CreateBucket).ParamsSpec().Rule(),
 	},
+	"createcachecluster": {
+		Action: "create",
+		Entity: "cachecluster",
+		Api:    "elasticache",
+		Params: new(CreateCachecluster).ParamsSpec().Rule(),
+	},
+	"createcachesubnetgroup": {
+		Action: "create",
+		Entity: "cachesubnetgroup",
+		Api:    "elasticache",
+		Params: new(CreateCachesubnetgroup).ParamsSpec().Rule(),
+	},
+	"createcatalogdatabase": {
+		Action: "create",
+		Entity: "catalogdatabase",
+		Api:    "glue",
+		Params: new(CreateCatalogdatabase).ParamsSpec().Rule(),
+	},
 	"createcertificate": {
 		Action: "create",
 		Entity: "certificate",
 		Api:    "acm",
 		Params: new(CreateCertificate).ParamsSpec().Rule(),
 	},
+	"createclassicloadbalancer": {
+		Action: "create",
+		Entity: "classicloadbalancer",
+		Api:    "elb",
+		Params: new(CreateClassicloadbalancer).ParamsSpec().Rule(),
+	},
+	"createcluster": {
+		Action: "create",
+		Entity: "cluster",
+		Api:    "redshift",
+		Params: new(CreateCluster).ParamsSpec().Rule(),
+	},
+	"createcomputeenvironment": {
+		Action: "create",
+		Entity: "computeenvironment",
+		Api:    "batch",
+		Params: new(CreateComputeenvironment).ParamsSpec().Rule(),
+	},
 	"createcontainercluster": {
 		Action: "create",
 		Entity: "containercluster",
 		Api:    "ecs",
 		Params: new(CreateContainercluster).ParamsSpec().Rule(),
 	},
+	"createcontainerservice": {
+		Action: "create",
+		Entity: "containerservice",
+		Api:    "ecs",
+		Params: new(CreateContainerservice).ParamsSpec().Rule(),
+	},
+	"createcrawler": {
+		Action: "create",
+		Entity: "crawler",
+		Api:    "glue",
+		Params: new(CreateCrawler).ParamsSpec().Rule(),
+	},
+	"createcustomergateway": {
+		Action: "create",
+		Entity: "customergateway",
+		Api:    "ec2",
+		Params: new(CreateCustomergateway).ParamsSpec().Rule(),
+	},
 	"createdatabase": {
 		Action: "create",
 		Entity: "database",
 		Api:    "rds",
 		Params: new(CreateDatabase).ParamsSpec().Rule(),
 	},
+	"createdbparametergroup": {
+		Action: "create",
+		Entity: "dbparametergroup",
+		Api:    "rds",
+		Params: new(CreateDbparametergroup).ParamsSpec().Rule(),
+	},
 	"createdbsubnetgroup": {
 		Action: "create",
 		Entity: "dbsubnetgroup",
 		Api:    "rds",
 		Params: new(CreateDbsubnetgroup).ParamsSpec().Rule(),
 	},
+	"createdeployment": {
+		Action: "create",
+		Entity: "deployment",
+		Api:    "apigateway",
+		Params: new(CreateDeployment).ParamsSpec().Rule(),
+	},
 	"createdistribution": {
 		Action: "create",
 		Entity: "distribution",
 		Api:    "cloudfront",
 		Params: new(CreateDistribution).ParamsSpec().Rule(),
 	},
+	"createegressonlyinternetgateway": {
+		Action: "create",
+		Entity: "egressonlyinternetgateway",
+		Api:    "ec2",
+		Params: new(CreateEgressonlyinternetgateway).ParamsSpec().Rule(),
+	},
 	"createelasticip": {
 		Action: "create",
 		Entity: "elasticip",
 		Api:    "ec2",
 		Params: new(CreateElasticip).ParamsSpec().Rule(),
 	},
+	"createenvironment": {
+		Action: "create",
+		Entity: "environment",
+		Api:    "elasticbeanstalk",
+		Params: new(CreateEnvironment).ParamsSpec().Rule(),
+	},
 	"createfunction": {
 		Action: "create",
 		Entity: "function",
 		Api:    "lambda",
 		Params: new(CreateFunction).ParamsSpec().Rule(),
 	},
+	"creategrant": {
+		Action: "create",
+		Entity: "grant",
+		Api:    "kms",
+		Params: new(CreateGrant).ParamsSpec().Rule(),
+	},
 	"creategroup": {
 		Action: "create",
 		Entity: "group",
 		Api:    "iam",
 		Params: new(CreateGroup).ParamsSpec().Rule(),
 	},
+	"createhost": {
+		Action: "create",
+		Entity: "host",
+		Api:    "ec2",
+		Params: new(CreateHost).ParamsSpec().Rule(),
+	},
+	"createhostreservation": {
+		Action: "create",
+		Entity: "hostreservation",
+		Api:    "ec2",
+		Params: new(CreateHostreservation).ParamsSpec().Rule(),
+	},
 	"createimage": {
 		Action: "create",
 		Entity: "image",
@@ -453,12 +815,36 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(CreateInternetgateway).ParamsSpec().Rule(),
 	},
+	"createinvalidation": {
+		Action: "create",
+		Entity: "invalidation",
+		Api:    "cloudfront",
+		Params: new(CreateInvalidation).ParamsSpec().Rule(),
+	},
+	"createjobqueue": {
+		Action: "create",
+		Entity: "jobqueue",
+		Api:    "batch",
+		Params: new(CreateJobqueue).ParamsSpec().Rule(),
+	},
+	"createkey": {
+		Action: "create",
+		Entity: "key",
+		Api:    "kms",
+		Params: new(CreateKey).ParamsSpec().Rule(),
+	},
 	"createkeypair": {
 		Action: "create",
 		Entity: "keypair",
 		Api:    "ec2",
 		Params: new(CreateKeypair).ParamsSpec().Rule(),
 	},
+	"createkubernetescluster": {
+		Action: "create",
+		Entity: "kubernetescluster",
+		Api:    "eks",
+		Params: new(CreateKubernetescluster).ParamsSpec().Rule(),
+	},
 	"createlaunchconfiguration": {
 		Action: "create",
 		Entity: "launchconfiguration",
@@ -477,12 +863,24 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "elbv2",
 		Params: new(CreateLoadbalancer).ParamsSpec().Rule(),
 	},
+	"createloggroup": {
+		Action: "create",
+		Entity: "loggroup",
+		Api:    "cloudwatchlogs",
+		Params: new(CreateLoggroup).ParamsSpec().Rule(),
+	},
 	"createloginprofile": {
 		Action: "create",
 		Entity: "loginprofile",
 		Api:    "iam",
 		Params: new(CreateLoginprofile).ParamsSpec().Rule(),
 	},
+	"createmethod": {
+		Action: "create",
+		Entity: "method",
+		Api:    "apigateway",
+		Params: new(CreateMethod).ParamsSpec().Rule(),
+	},
 	"createmfadevice": {
 		Action: "create",
 		Entity: "mfadevice",
@@ -495,18 +893,60 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(CreateNatgateway).ParamsSpec().Rule(),
 	},
+	"createnetworkacl": {
+		Action: "create",
+		Entity: "networkacl",
+		Api:    "ec2",
+		Params: new(CreateNetworkacl).ParamsSpec().Rule(),
+	},
+	"createnetworkaclrule": {
+		Action: "create",
+		Entity: "networkaclrule",
+		Api:    "ec2",
+		Params: new(CreateNetworkaclrule).ParamsSpec().Rule(),
+	},
 	"createnetworkinterface": {
 		Action: "create",
 		Entity: "networkinterface",
 		Api:    "ec2",
 		Params: new(CreateNetworkinterface).ParamsSpec().Rule(),
 	},
+	"createparameter": {
+		Action: "create",
+		Entity: "parameter",
+		Api:    "ssm",
+		Params: new(CreateParameter).ParamsSpec().Rule(),
+	},
+	"createpeeringconnection": {
+		Action: "create",
+		Entity: "peeringconnection",
+		Api:    "ec2",
+		Params: new(CreatePeeringconnection).ParamsSpec().Rule(),
+	},
+	"createplacementgroup": {
+		Action: "create",
+		Entity: "placementgroup",
+		Api:    "ec2",
+		Params: new(CreatePlacementgroup).ParamsSpec().Rule(),
+	},
 	"createpolicy": {
 		Action: "create",
 		Entity: "policy",
 		Api:    "iam",
 		Params: new(CreatePolicy).ParamsSpec().Rule(),
 	},
+	"createpolicyversion": {
+		Action: "create",
+		Entity: "policyversion",
+		Api:    "iam",
+		Params: new(CreatePolicyversion).ParamsSpec().Rule(),
+	},
+	"createpresignedurl": {
+		Action: "create",
+		Entity: "presignedurl",
+		Api:    "s3",
+		Params: new(CreatePresignedurl).ParamsSpec().Rule(),
+	},
 	"createqueue": {
 		Action: "create",
 		Entity: "queue",
@@ -525,6 +965,18 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ecr",
 		Params: new(CreateRepository).ParamsSpec().Rule(),
 	},
+	"createresource": {
+		Action: "create",
+		Entity: "resource",
+		Api:    "apigateway",
+		Params: new(CreateResource).ParamsSpec().Rule(),
+	},
+	"createrestapi": {
+		Action: "create",
+		Entity: "restapi",
+		Api:    "apigateway",
+		Params: new(CreateRestapi).ParamsSpec().Rule(),
+	},
 	"createrole": {
 		Action: "create",
 		Entity: "role",
@@ -543,6 +995,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(CreateRoutetable).ParamsSpec().Rule(),
 	},
+	"createrule": {
+		Action: "create",
+		Entity: "rule",
+		Api:    "cloudwatchevents",
+		Params: new(CreateRule).ParamsSpec().Rule(),
+	},
 	"creates3object": {
 		Action: "create",
 		Entity: "s3object",
@@ -561,6 +1019,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "autoscaling",
 		Params: new(CreateScalingpolicy).ParamsSpec().Rule(),
 	},
+	"createsecret": {
+		Action: "create",
+		Entity: "secret",
+		Api:    "secretsmanager",
+		Params: new(CreateSecret).ParamsSpec().Rule(),
+	},
 	"createsecuritygroup": {
 		Action: "create",
 		Entity: "securitygroup",
@@ -573,12 +1037,36 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(CreateSnapshot).ParamsSpec().Rule(),
 	},
+	"createspotfleet": {
+		Action: "create",
+		Entity: "spotfleet",
+		Api:    "ec2",
+		Params: new(CreateSpotfleet).ParamsSpec().Rule(),
+	},
+	"createspotinstance": {
+		Action: "create",
+		Entity: "spotinstance",
+		Api:    "ec2",
+		Params: new(CreateSpotinstance).ParamsSpec().Rule(),
+	},
 	"createstack": {
 		Action: "create",
 		Entity: "stack",
 		Api:    "cloudformation",
 		Params: new(CreateStack).ParamsSpec().Rule(),
 	},
+	"createstage": {
+		Action: "create",
+		Entity: "stage",
+		Api:    "apigateway",
+		Params: new(CreateStage).ParamsSpec().Rule(),
+	},
+	"createstatemachine": {
+		Action: "create",
+		Entity: "statemachine",
+		Api:    "sfn",
+		Params: new(CreateStatemachine).ParamsSpec().Rule(),
+	},
 	"createsubnet": {
 		Action: "create",
 		Entity: "subnet",
@@ -591,6 +1079,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "sns",
 		Params: new(CreateSubscription).ParamsSpec().Rule(),
 	},
+	"createtable": {
+		Action: "create",
+		Entity: "table",
+		Api:    "dynamodb",
+		Params: new(CreateTable).ParamsSpec().Rule(),
+	},
 	"createtag": {
 		Action: "create",
 		Entity: "tag",
@@ -609,6 +1103,30 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "sns",
 		Params: new(CreateTopic).ParamsSpec().Rule(),
 	},
+	"createtrail": {
+		Action: "create",
+		Entity: "trail",
+		Api:    "cloudtrail",
+		Params: new(CreateTrail).ParamsSpec().Rule(),
+	},
+	"createtransitgateway": {
+		Action: "create",
+		Entity: "transitgateway",
+		Api:    "ec2",
+		Params: new(CreateTransitgateway).ParamsSpec().Rule(),
+	},
+	"createtransitgatewayattachment": {
+		Action: "create",
+		Entity: "transitgatewayattachment",
+		Api:    "ec2",
+		Params: new(CreateTransitgatewayattachment).ParamsSpec().Rule(),
+	},
+	"createtransitgatewayroutetable": {
+		Action: "create",
+		Entity: "transitgatewayroutetable",
+		Api:    "ec2",
+		Params: new(CreateTransitgatewayroutetable).ParamsSpec().Rule(),
+	},
 	"createuser": {
 		Action: "create",
 		Entity: "user",
@@ -627,6 +1145,24 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(CreateVpc).ParamsSpec().Rule(),
 	},
+	"createvpcendpoint": {
+		Action: "create",
+		Entity: "vpcendpoint",
+		Api:    "ec2",
+		Params: new(CreateVpcendpoint).ParamsSpec().Rule(),
+	},
+	"createvpnconnection": {
+		Action: "create",
+		Entity: "vpnconnection",
+		Api:    "ec2",
+		Params: new(CreateVpnconnection).ParamsSpec().Rule(),
+	},
+	"createvpngateway": {
+		Action: "create",
+		Entity: "vpngateway",
+		Api:    "ec2",
+		Params: new(CreateVpngateway).ParamsSpec().Rule(),
+	},
 	"createzone": {
 		Action: "create",
 		Entity: "zone",
@@ -645,6 +1181,18 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "cloudwatch",
 		Params: new(DeleteAlarm).ParamsSpec().Rule(),
 	},
+	"deletealias": {
+		Action: "delete",
+		Entity: "alias",
+		Api:    "kms",
+		Params: new(DeleteAlias).ParamsSpec().Rule(),
+	},
+	"deleteapplication": {
+		Action: "delete",
+		Entity: "application",
+		Api:    "elasticbeanstalk",
+		Params: new(DeleteApplication).ParamsSpec().Rule(),
+	},
 	"deleteappscalingpolicy": {
 		Action: "delete",
 		Entity: "appscalingpolicy",
@@ -663,42 +1211,114 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "s3",
 		Params: new(DeleteBucket).ParamsSpec().Rule(),
 	},
+	"deletecachecluster": {
+		Action: "delete",
+		Entity: "cachecluster",
+		Api:    "elasticache",
+		Params: new(DeleteCachecluster).ParamsSpec().Rule(),
+	},
+	"deletecachesubnetgroup": {
+		Action: "delete",
+		Entity: "cachesubnetgroup",
+		Api:    "elasticache",
+		Params: new(DeleteCachesubnetgroup).ParamsSpec().Rule(),
+	},
+	"deletecatalogdatabase": {
+		Action: "delete",
+		Entity: "catalogdatabase",
+		Api:    "glue",
+		Params: new(DeleteCatalogdatabase).ParamsSpec().Rule(),
+	},
 	"deletecertificate": {
 		Action: "delete",
 		Entity: "certificate",
 		Api:    "acm",
 		Params: new(DeleteCertificate).ParamsSpec().Rule(),
 	},
+	"deleteclassicloadbalancer": {
+		Action: "delete",
+		Entity: "classicloadbalancer",
+		Api:    "elb",
+		Params: new(DeleteClassicloadbalancer).ParamsSpec().Rule(),
+	},
+	"deletecluster": {
+		Action: "delete",
+		Entity: "cluster",
+		Api:    "redshift",
+		Params: new(DeleteCluster).ParamsSpec().Rule(),
+	},
+	"deletecomputeenvironment": {
+		Action: "delete",
+		Entity: "computeenvironment",
+		Api:    "batch",
+		Params: new(DeleteComputeenvironment).ParamsSpec().Rule(),
+	},
 	"deletecontainercluster": {
 		Action: "delete",
 		Entity: "containercluster",
 		Api:    "ecs",
 		Params: new(DeleteContainercluster).ParamsSpec().Rule(),
 	},
+	"deletecontainerservice": {
+		Action: "delete",
+		Entity: "containerservice",
+		Api:    "ecs",
+		Params: new(DeleteContainerservice).ParamsSpec().Rule(),
+	},
 	"deletecontainertask": {
 		Action: "delete",
 		Entity: "containertask",
 		Api:    "ecs",
 		Params: new(DeleteContainertask).ParamsSpec().Rule(),
 	},
+	"deletecrawler": {
+		Action: "delete",
+		Entity: "crawler",
+		Api:    "glue",
+		Params: new(DeleteCrawler).ParamsSpec().Rule(),
+	},
+	"deletecustomergateway": {
+		Action: "delete",
+		Entity: "customergateway",
+		Api:    "ec2",
+		Params: new(DeleteCustomergateway).ParamsSpec().Rule(),
+	},
 	"deletedatabase": {
 		Action: "delete",
 		Entity: "database",
 		Api:    "rds",
 		Params: new(DeleteDatabase).ParamsSpec().Rule(),
 	},
+	"deletedbparametergroup": {
+		Action: "delete",
+		Entity: "dbparametergroup",
+		Api:    "rds",
+		Params: new(DeleteDbparametergroup).ParamsSpec().Rule(),
+	},
 	"deletedbsubnetgroup": {
 		Action: "delete",
 		Entity: "dbsubnetgroup",
 		Api:    "rds",
 		Params: new(DeleteDbsubnetgroup).ParamsSpec().Rule(),
 	},
+	"deletedeployment": {
+		Action: "delete",
+		Entity: "deployment",
+		Api:    "apigateway",
+		Params: new(DeleteDeployment).ParamsSpec().Rule(),
+	},
 	"deletedistribution": {
 		Action: "delete",
 		Entity: "distribution",
 		Api:    "cloudfront",
 		Params: new(DeleteDistribution).ParamsSpec().Rule(),
 	},
+	"deleteegressonlyinternetgateway": {
+		Action: "delete",
+		Entity: "egressonlyinternetgateway",
+		Api:    "ec2",
+		Params: new(DeleteEgressonlyinternetgateway).ParamsSpec().Rule(),
+	},
 	"deleteelasticip": {
 		Action: "delete",
 		Entity: "elasticip",
@@ -711,12 +1331,24 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "lambda",
 		Params: new(DeleteFunction).ParamsSpec().Rule(),
 	},
+	"deletegrant": {
+		Action: "delete",
+		Entity: "grant",
+		Api:    "kms",
+		Params: new(DeleteGrant).ParamsSpec().Rule(),
+	},
 	"deletegroup": {
 		Action: "delete",
 		Entity: "group",
 		Api:    "iam",
 		Params: new(DeleteGroup).ParamsSpec().Rule(),
 	},
+	"deletehost": {
+		Action: "delete",
+		Entity: "host",
+		Api:    "ec2",
+		Params: new(DeleteHost).ParamsSpec().Rule(),
+	},
 	"deleteimage": {
 		Action: "delete",
 		Entity: "image",
@@ -741,12 +1373,36 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(DeleteInternetgateway).ParamsSpec().Rule(),
 	},
+	"deletejobdefinition": {
+		Action: "delete",
+		Entity: "jobdefinition",
+		Api:    "batch",
+		Params: new(DeleteJobdefinition).ParamsSpec().Rule(),
+	},
+	"deletejobqueue": {
+		Action: "delete",
+		Entity: "jobqueue",
+		Api:    "batch",
+		Params: new(DeleteJobqueue).ParamsSpec().Rule(),
+	},
+	"deletekey": {
+		Action: "delete",
+		Entity: "key",
+		Api:    "kms",
+		Params: new(DeleteKey).ParamsSpec().Rule(),
+	},
 	"deletekeypair": {
 		Action: "delete",
 		Entity: "keypair",
 		Api:    "ec2",
 		Params: new(DeleteKeypair).ParamsSpec().Rule(),
 	},
+	"deletekubernetescluster": {
+		Action: "delete",
+		Entity: "kubernetescluster",
+		Api:    "eks",
+		Params: new(DeleteKubernetescluster).ParamsSpec().Rule(),
+	},
 	"deletelaunchconfiguration": {
 		Action: "delete",
 		Entity: "launchconfiguration",
@@ -765,12 +1421,24 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "elbv2",
 		Params: new(DeleteLoadbalancer).ParamsSpec().Rule(),
 	},
+	"deleteloggroup": {
+		Action: "delete",
+		Entity: "loggroup",
+		Api:    "cloudwatchlogs",
+		Params: new(DeleteLoggroup).ParamsSpec().Rule(),
+	},
 	"deleteloginprofile": {
 		Action: "delete",
 		Entity: "loginprofile",
 		Api:    "iam",
 		Params: new(DeleteLoginprofile).ParamsSpec().Rule(),
 	},
+	"deletemethod": {
+		Action: "delete",
+		Entity: "method",
+		Api:    "apigateway",
+		Params: new(DeleteMethod).ParamsSpec().Rule(),
+	},
 	"deletemfadevice": {
 		Action: "delete",
 		Entity: "mfadevice",
@@ -783,18 +1451,54 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(DeleteNatgateway).ParamsSpec().Rule(),
 	},
+	"deletenetworkacl": {
+		Action: "delete",
+		Entity: "networkacl",
+		Api:    "ec2",
+		Params: new(DeleteNetworkacl).ParamsSpec().Rule(),
+	},
+	"deletenetworkaclrule": {
+		Action: "delete",
+		Entity: "networkaclrule",
+		Api:    "ec2",
+		Params: new(DeleteNetworkaclrule).ParamsSpec().Rule(),
+	},
 	"deletenetworkinterface": {
 		Action: "delete",
 		Entity: "networkinterface",
 		Api:    "ec2",
 		Params: new(DeleteNetworkinterface).ParamsSpec().Rule(),
 	},
+	"deleteparameter": {
+		Action: "delete",
+		Entity: "parameter",
+		Api:    "ssm",
+		Params: new(DeleteParameter).ParamsSpec().Rule(),
+	},
+	"deletepeeringconnection": {
+		Action: "delete",
+		Entity: "peeringconnection",
+		Api:    "ec2",
+		Params: new(DeletePeeringconnection).ParamsSpec().Rule(),
+	},
+	"deleteplacementgroup": {
+		Action: "delete",
+		Entity: "placementgroup",
+		Api:    "ec2",
+		Params: new(DeletePlacementgroup).ParamsSpec().Rule(),
+	},
 	"deletepolicy": {
 		Action: "delete",
 		Entity: "policy",
 		Api:    "iam",
 		Params: new(DeletePolicy).ParamsSpec().Rule(),
 	},
+	"deletepolicyversion": {
+		Action: "delete",
+		Entity: "policyversion",
+		Api:    "iam",
+		Params: new(DeletePolicyversion).ParamsSpec().Rule(),
+	},
 	"deletequeue": {
 		Action: "delete",
 		Entity: "queue",
@@ -813,6 +1517,18 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ecr",
 		Params: new(DeleteRepository).ParamsSpec().Rule(),
 	},
+	"deleteresource": {
+		Action: "delete",
+		Entity: "resource",
+		Api:    "apigateway",
+		Params: new(DeleteResource).ParamsSpec().Rule(),
+	},
+	"deleterestapi": {
+		Action: "delete",
+		Entity: "restapi",
+		Api:    "apigateway",
+		Params: new(DeleteRestapi).ParamsSpec().Rule(),
+	},
 	"deleterole": {
 		Action: "delete",
 		Entity: "role",
@@ -831,6 +1547,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(DeleteRoutetable).ParamsSpec().Rule(),
 	},
+	"deleterule": {
+		Action: "delete",
+		Entity: "rule",
+		Api:    "cloudwatchevents",
+		Params: new(DeleteRule).ParamsSpec().Rule(),
+	},
 	"deletes3object": {
 		Action: "delete",
 		Entity: "s3object",
@@ -849,6 +1571,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "autoscaling",
 		Params: new(DeleteScalingpolicy).ParamsSpec().Rule(),
 	},
+	"deletesecret": {
+		Action: "delete",
+		Entity: "secret",
+		Api:    "secretsmanager",
+		Params: new(DeleteSecret).ParamsSpec().Rule(),
+	},
 	"deletesecuritygroup": {
 		Action: "delete",
 		Entity: "securitygroup",
@@ -867,6 +1595,18 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "cloudformation",
 		Params: new(DeleteStack).ParamsSpec().Rule(),
 	},
+	"deletestage": {
+		Action: "delete",
+		Entity: "stage",
+		Api:    "apigateway",
+		Params: new(DeleteStage).ParamsSpec().Rule(),
+	},
+	"deletestatemachine": {
+		Action: "delete",
+		Entity: "statemachine",
+		Api:    "sfn",
+		Params: new(DeleteStatemachine).ParamsSpec().Rule(),
+	},
 	"deletesubnet": {
 		Action: "delete",
 		Entity: "subnet",
@@ -879,6 +1619,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "sns",
 		Params: new(DeleteSubscription).ParamsSpec().Rule(),
 	},
+	"deletetable": {
+		Action: "delete",
+		Entity: "table",
+		Api:    "dynamodb",
+		Params: new(DeleteTable).ParamsSpec().Rule(),
+	},
 	"deletetag": {
 		Action: "delete",
 		Entity: "tag",
@@ -897,6 +1643,30 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "sns",
 		Params: new(DeleteTopic).ParamsSpec().Rule(),
 	},
+	"deletetrail": {
+		Action: "delete",
+		Entity: "trail",
+		Api:    "cloudtrail",
+		Params: new(DeleteTrail).ParamsSpec().Rule(),
+	},
+	"deletetransitgateway": {
+		Action: "delete",
+		Entity: "transitgateway",
+		Api:    "ec2",
+		Params: new(DeleteTransitgateway).ParamsSpec().Rule(),
+	},
+	"deletetransitgatewayattachment": {
+		Action: "delete",
+		Entity: "transitgatewayattachment",
+		Api:    "ec2",
+		Params: new(DeleteTransitgatewayattachment).ParamsSpec().Rule(),
+	},
+	"deletetransitgatewayroutetable": {
+		Action: "delete",
+		Entity: "transitgatewayroutetable",
+		Api:    "ec2",
+		Params: new(DeleteTransitgatewayroutetable).ParamsSpec().Rule(),
+	},
 	"deleteuser": {
 		Action: "delete",
 		Entity: "user",
@@ -915,6 +1685,24 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(DeleteVpc).ParamsSpec().Rule(),
 	},
+	"deletevpcendpoint": {
+		Action: "delete",
+		Entity: "vpcendpoint",
+		Api:    "ec2",
+		Params: new(DeleteVpcendpoint).ParamsSpec().Rule(),
+	},
+	"deletevpnconnection": {
+		Action: "delete",
+		Entity: "vpnconnection",
+		Api:    "ec2",
+		Params: new(DeleteVpnconnection).ParamsSpec().Rule(),
+	},
+	"deletevpngateway": {
+		Action: "delete",
+		Entity: "vpngateway",
+		Api:    "ec2",
+		Params: new(DeleteVpngateway).ParamsSpec().Rule(),
+	},
 	"deletezone": {
 		Action: "delete",
 		Entity: "zone",
@@ -927,6 +1715,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "cloudwatch",
 		Params: new(DetachAlarm).ParamsSpec().Rule(),
 	},
+	"detachclassicloadbalancer": {
+		Action: "detach",
+		Entity: "classicloadbalancer",
+		Api:    "elb",
+		Params: new(DetachClassicloadbalancer).ParamsSpec().Rule(),
+	},
 	"detachcontainertask": {
 		Action: "detach",
 		Entity: "containertask",
@@ -963,6 +1757,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "iam",
 		Params: new(DetachMfadevice).ParamsSpec().Rule(),
 	},
+	"detachnetworkacl": {
+		Action: "detach",
+		Entity: "networkacl",
+		Api:    "ec2",
+		Params: new(DetachNetworkacl).ParamsSpec().Rule(),
+	},
 	"detachnetworkinterface": {
 		Action: "detach",
 		Entity: "networkinterface",
@@ -993,6 +1793,24 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(DetachSecuritygroup).ParamsSpec().Rule(),
 	},
+	"detachservicecontrolpolicy": {
+		Action: "detach",
+		Entity: "servicecontrolpolicy",
+		Api:    "organizations",
+		Params: new(DetachServicecontrolpolicy).ParamsSpec().Rule(),
+	},
+	"detachtarget": {
+		Action: "detach",
+		Entity: "target",
+		Api:    "cloudwatchevents",
+		Params: new(DetachTarget).ParamsSpec().Rule(),
+	},
+	"detachtransitgatewayroutetable": {
+		Action: "detach",
+		Entity: "transitgatewayroutetable",
+		Api:    "ec2",
+		Params: new(DetachTransitgatewayroutetable).ParamsSpec().Rule(),
+	},
 	"detachuser": {
 		Action: "detach",
 		Entity: "user",
@@ -1005,12 +1823,78 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(DetachVolume).ParamsSpec().Rule(),
 	},
+	"detachvpngateway": {
+		Action: "detach",
+		Entity: "vpngateway",
+		Api:    "ec2",
+		Params: new(DetachVpngateway).ParamsSpec().Rule(),
+	},
+	"disablekey": {
+		Action: "disable",
+		Entity: "key",
+		Api:    "kms",
+		Params: new(DisableKey).ParamsSpec().Rule(),
+	},
+	"downloads3object": {
+		Action: "download",
+		Entity: "s3object",
+		Api:    "s3",
+		Params: new(DownloadS3object).ParamsSpec().Rule(),
+	},
+	"enablekey": {
+		Action: "enable",
+		Entity: "key",
+		Api:    "kms",
+		Params: new(EnableKey).ParamsSpec().Rule(),
+	},
+	"getconsoleoutput": {
+		Action: "get",
+		Entity: "consoleoutput",
+		Api:    "ec2",
+		Params: new(GetConsoleoutput).ParamsSpec().Rule(),
+	},
+	"getscreenshot": {
+		Action: "get",
+		Entity: "screenshot",
+		Api:    "ec2",
+		Params: new(GetScreenshot).ParamsSpec().Rule(),
+	},
 	"importimage": {
 		Action: "import",
 		Entity: "image",
 		Api:    "ec2",
 		Params: new(ImportImage).ParamsSpec().Rule(),
 	},
+	"invokefunction": {
+		Action: "invoke",
+		Entity: "function",
+		Api:    "lambda",
+		Params: new(InvokeFunction).ParamsSpec().Rule(),
+	},
+	"moveaccount": {
+		Action: "move",
+		Entity: "account",
+		Api:    "organizations",
+		Params: new(MoveAccount).ParamsSpec().Rule(),
+	},
+	"rebootinstance": {
+		Action: "reboot",
+		Entity: "instance",
+		Api:    "ec2",
+		Params: new(RebootInstance).ParamsSpec().Rule(),
+	},
+	"registerjobdefinition": {
+		Action: "register",
+		Entity: "jobdefinition",
+		Api:    "batch",
+		Params: new(RegisterJobdefinition).ParamsSpec().Rule(),
+	},
+	"resizecluster": {
+		Action: "resize",
+		Entity: "cluster",
+		Api:    "redshift",
+		Params: new(ResizeCluster).ParamsSpec().Rule(),
+	},
 	"restartdatabase": {
 		Action: "restart",
 		Entity: "database",
@@ -1023,6 +1907,24 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(RestartInstance).ParamsSpec().Rule(),
 	},
+	"restoredatabase": {
+		Action: "restore",
+		Entity: "database",
+		Api:    "rds",
+		Params: new(RestoreDatabase).ParamsSpec().Rule(),
+	},
+	"restorevolume": {
+		Action: "restore",
+		Entity: "volume",
+		Api:    "ec2",
+		Params: new(RestoreVolume).ParamsSpec().Rule(),
+	},
+	"runcommand": {
+		Action: "run",
+		Entity: "command",
+		Api:    "ssm",
+		Params: new(RunCommand).ParamsSpec().Rule(),
+	},
 	"startalarm": {
 		Action: "start",
 		Entity: "alarm",
@@ -1041,12 +1943,24 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "rds",
 		Params: new(StartDatabase).ParamsSpec().Rule(),
 	},
+	"startexecution": {
+		Action: "start",
+		Entity: "execution",
+		Api:    "sfn",
+		Params: new(StartExecution).ParamsSpec().Rule(),
+	},
 	"startinstance": {
 		Action: "start",
 		Entity: "instance",
 		Api:    "ec2",
 		Params: new(StartInstance).ParamsSpec().Rule(),
 	},
+	"startquery": {
+		Action: "start",
+		Entity: "query",
+		Api:    "athena",
+		Params: new(StartQuery).ParamsSpec().Rule(),
+	},
 	"stopalarm": {
 		Action: "stop",
 		Entity: "alarm",
@@ -1065,18 +1979,42 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "rds",
 		Params: new(StopDatabase).ParamsSpec().Rule(),
 	},
+	"stopexecution": {
+		Action: "stop",
+		Entity: "execution",
+		Api:    "sfn",
+		Params: new(StopExecution).ParamsSpec().Rule(),
+	},
 	"stopinstance": {
 		Action: "stop",
 		Entity: "instance",
 		Api:    "ec2",
 		Params: new(StopInstance).ParamsSpec().Rule(),
 	},
+	"submitjob": {
+		Action: "submit",
+		Entity: "job",
+		Api:    "batch",
+		Params: new(SubmitJob).ParamsSpec().Rule(),
+	},
+	"terminateenvironment": {
+		Action: "terminate",
+		Entity: "environment",
+		Api:    "elasticbeanstalk",
+		Params: new(TerminateEnvironment).ParamsSpec().Rule(),
+	},
 	"updatebucket": {
 		Action: "update",
 		Entity: "bucket",
 		Api:    "s3",
 		Params: new(UpdateBucket).ParamsSpec().Rule(),
 	},
+	"updatecontainerservice": {
+		Action: "update",
+		Entity: "containerservice",
+		Api:    "ecs",
+		Params: new(UpdateContainerservice).ParamsSpec().Rule(),
+	},
 	"updatecontainertask": {
 		Action: "update",
 		Entity: "containertask",
@@ -1089,6 +2027,18 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "cloudfront",
 		Params: new(UpdateDistribution).ParamsSpec().Rule(),
 	},
+	"updateenvironment": {
+		Action: "update",
+		Entity: "environment",
+		Api:    "elasticbeanstalk",
+		Params: new(UpdateEnvironment).ParamsSpec().Rule(),
+	},
+	"updatefunction": {
+		Action: "update",
+		Entity: "function",
+		Api:    "lambda",
+		Params: new(UpdateFunction).ParamsSpec().Rule(),
+	},
 	"updateimage": {
 		Action: "update",
 		Entity: "image",
@@ -1101,12 +2051,36 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(UpdateInstance).ParamsSpec().Rule(),
 	},
+	"updateloggroup": {
+		Action: "update",
+		Entity: "loggroup",
+		Api:    "cloudwatchlogs",
+		Params: new(UpdateLoggroup).ParamsSpec().Rule(),
+	},
 	"updateloginprofile": {
 		Action: "update",
 		Entity: "loginprofile",
 		Api:    "iam",
 		Params: new(UpdateLoginprofile).ParamsSpec().Rule(),
 	},
+	"updatenetworkaclrule": {
+		Action: "update",
+		Entity: "networkaclrule",
+		Api:    "ec2",
+		Params: new(UpdateNetworkaclrule).ParamsSpec().Rule(),
+	},
+	"updatenetworkinterface": {
+		Action: "update",
+		Entity: "networkinterface",
+		Api:    "ec2",
+		Params: new(UpdateNetworkinterface).ParamsSpec().Rule(),
+	},
+	"updateparameter": {
+		Action: "update",
+		Entity: "parameter",
+		Api:    "ssm",
+		Params: new(UpdateParameter).ParamsSpec().Rule(),
+	},
 	"updatepolicy": {
 		Action: "update",
 		Entity: "policy",
@@ -1119,6 +2093,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "route53",
 		Params: new(UpdateRecord).ParamsSpec().Rule(),
 	},
+	"updaterepository": {
+		Action: "update",
+		Entity: "repository",
+		Api:    "ecr",
+		Params: new(UpdateRepository).ParamsSpec().Rule(),
+	},
 	"updates3object": {
 		Action: "update",
 		Entity: "s3object",
@@ -1131,6 +2111,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "autoscaling",
 		Params: new(UpdateScalinggroup).ParamsSpec().Rule(),
 	},
+	"updatesecret": {
+		Action: "update",
+		Entity: "secret",
+		Api:    "secretsmanager",
+		Params: new(UpdateSecret).ParamsSpec().Rule(),
+	},
 	"updatesecuritygroup": {
 		Action: "update",
 		Entity: "securitygroup",
@@ -1143,31 +2129,83 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "cloudformation",
 		Params: new(UpdateStack).ParamsSpec().Rule(),
 	},
+	"updatestatemachine": {
+		Action: "update",
+		Entity: "statemachine",
+		Api:    "sfn",
+		Params: new(UpdateStatemachine).ParamsSpec().Rule(),
+	},
 	"updatesubnet": {
 		Action: "update",
 		Entity: "subnet",
 		Api:    "ec2",
 		Params: new(UpdateSubnet).ParamsSpec().Rule(),
 	},
+	"updatetable": {
+		Action: "update",
+		Entity: "table",
+		Api:    "dynamodb",
+		Params: new(UpdateTable).ParamsSpec().Rule(),
+	},
 	"updatetargetgroup": {
 		Action: "update",
 		Entity: "targetgroup",
 		Api:    "elbv2",
 		Params: new(UpdateTargetgroup).ParamsSpec().Rule(),
 	},
+	"updatevpc": {
+		Action: "update",
+		Entity: "vpc",
+		Api:    "ec2",
+		Params: new(UpdateVpc).ParamsSpec().Rule(),
+	},
+	"waitcertificate": {
+		Action: "wait",
+		Entity: "certificate",
+		Api:    "acm",
+		Params: new(WaitCertificate).ParamsSpec().Rule(),
+	},
+	"waitdistribution": {
+		Action: "wait",
+		Entity: "distribution",
+		Api:    "cloudfront",
+		Params: new(WaitDistribution).ParamsSpec().Rule(),
+	},
+	"waitkubernetescluster": {
+		Action: "wait",
+		Entity: "kubernetescluster",
+		Api:    "eks",
+		Params: new(WaitKubernetescluster).ParamsSpec().Rule(),
+	},
 }
 
 var DriverSupportedActions = map[string][]string{
-	"attach":       {"alarm", "containertask", "elasticip", "instance", "instanceprofile", "internetgateway", "listener", "mfadevice", "networkinterface", "policy", "role", "routetable", "securitygroup", "user", "volume"},
+	"accept":       {"peeringconnection"},
+	"attach":       {"alarm", "classicloadbalancer", "containertask", "elasticip", "instance", "instanceprofile", "internetgateway", "listener", "mfadevice", "networkacl", "networkinterface", "policy", "role", "routetable", "securitygroup", "servicecontrolpolicy", "target", "transitgatewayroutetable", "user", "volume", "vpngateway"},
 	"authenticate": {"registry"},
-	"check":        {"certificate", "database", "distribution", "instance", "loadbalancer", "natgateway", "networkinterface", "scalinggroup", "securitygroup", "volume"},
+	"cancel":       {"spotrequest"},
+	"check":        {"cachecluster", "certificate", "cluster", "database", "distribution", "http", "instance", "kubernetescluster", "loadbalancer", "natgateway", "networkinterface", "scalinggroup", "securitygroup", "tcp", "transitgateway", "transitgatewayattachment", "transitgatewayroutetable", "volume", "vpnconnection"},
 	"copy":         {"image", "snapshot"},
-	"create":       {"accesskey", "alarm", "appscalingpolicy", "appscalingtarget", "bucket", "certificate", "containercluster", "database", "dbsubnetgroup", "distribution", "elasticip", "function", "group", "image", "instance", "instanceprofile", "internetgateway", "keypair", "launchconfiguration", "listener", "loadbalancer", "loginprofile", "mfadevice", "natgateway", "networkinterface", "policy", "queue", "record", "repository", "role", "route", "routetable", "s3object", "scalinggroup", "scalingpolicy", "securitygroup", "snapshot", "stack", "subnet", "subscription", "tag", "targetgroup", "topic", "user", "volume", "vpc", "zone"},
-	"delete":       {"accesskey", "alarm", "appscalingpolicy", "appscalingtarget", "bucket", "certificate", "containercluster", "containertask", "database", "dbsubnetgroup", "distribution", "elasticip", "function", "group", "image", "instance", "instanceprofile", "internetgateway", "keypair", "launchconfiguration", "listener", "loadbalancer", "loginprofile", "mfadevice", "natgateway", "networkinterface", "policy", "queue", "record", "repository", "role", "route", "routetable", "s3object", "scalinggroup", "scalingpolicy", "securitygroup", "snapshot", "stack", "subnet", "subscription", "tag", "targetgroup", "topic", "user", "volume", "vpc", "zone"},
-	"detach":       {"alarm", "containertask", "elasticip", "instance", "instanceprofile", "internetgateway", "mfadevice", "networkinterface", "policy", "role", "routetable", "securitygroup", "user", "volume"},
+	"create":       {"accesskey", "account", "alarm", "alias", "application", "appscalingpolicy", "appscalingtarget", "bucket", "cachecluster", "cachesubnetgroup", "catalogdatabase", "certificate", "classicloadbalancer", "cluster", "computeenvironment", "containercluster", "containerservice", "crawler", "customergateway", "database", "dbparametergroup", "dbsubnetgroup", "deployment", "distribution", "egressonlyinternetgateway", "elasticip", "environment", "function", "grant", "group", "host", "hostreservation", "image", "instance", "instanceprofile", "internetgateway", "invalidation", "jobqueue", "key", "keypair", "kubernetescluster", "launchconfiguration", "listener", "loadbalancer", "loggroup", "loginprofile", "method", "mfadevice", "natgateway", "networkacl", "networkaclrule", "networkinterface", "parameter", "peeringconnection", "placementgroup", "policy", "policyversion", "presignedurl", "queue", "record", "repository", "resource", "restapi", "role", "route", "routetable", "rule", "s3object", "scalinggroup", "scalingpolicy", "secret", "securitygroup", "snapshot", "spotfleet", "spotinstance", "stack", "stage", "statemachine", "subnet", "subscription", "table", "tag", "targetgroup", "topic", "trail", "transitgateway", "transitgatewayattachment", "transitgatewayroutetable", "user", "volume", "vpc", "vpcendpoint", "vpnconnection", "vpngateway", "zone"},
+	"delete":       {"accesskey", "alarm", "alias", "application", "appscalingpolicy", "appscalingtarget", "bucket", "cachecluster", "cachesubnetgroup", "catalogdatabase", "certificate", "classicloadbalancer", "cluster", "computeenvironment", "containercluster", "containerservice", "containertask", "crawler", "customergateway", "database", "dbparametergroup", "dbsubnetgroup", "deployment", "distribution", "egressonlyinternetgateway", "elasticip", "function", "grant", "group", "host", "image", "instance", "instanceprofile", "internetgateway", "jobdefinition", "jobqueue", "key", "keypair", "kubernetescluster", "launchconfiguration", "listener", "loadbalancer", "loggroup", "loginprofile", "method", "mfadevice", "natgateway", "networkacl", "networkaclrule", "networkinterface", "parameter", "peeringconnection", "placementgroup", "policy", "policyversion", "queue", "record", "repository", "resource", "restapi", "role", "route", "routetable", "rule", "s3object", "scalinggroup", "scalingpolicy", "secret", "securitygroup", "snapshot", "stack", "stage", "statemachine", "subnet", "subscription", "table", "tag", "targetgroup", "topic", "trail", "transitgateway", "transitgatewayattachment", "transitgatewayroutetable", "user", "volume", "vpc", "vpcendpoint", "vpnconnection", "vpngateway", "zone"},
+	"detach":       {"alarm", "classicloadbalancer", "containertask", "elasticip", "instance", "instanceprofile", "internetgateway", "mfadevice", "networkacl", "networkinterface", "policy", "role", "routetable", "securitygroup", "servicecontrolpolicy", "target", "transitgatewayroutetable", "user", "volume", "vpngateway"},
+	"disable":      {"key"},
+	"download":     {"s3object"},
+	"enable":       {"key"},
+	"get":          {"consoleoutput", "screenshot"},
 	"import":       {"image"},
+	"invoke":       {"function"},
+	"move":         {"account"},
+	"reboot":       {"instance"},
+	"register":     {"jobdefinition"},
+	"resize":       {"cluster"},
 	"restart":      {"database", "instance"},
-	"start":        {"alarm", "containertask", "database", "instance"},
-	"stop":         {"alarm", "containertask", "database", "instance"},
-	"update":       {"bucket", "containertask", "distribution", "image", "instance", "loginprofile", "policy", "record", "s3object", "scalinggroup", "securitygroup", "stack", "subnet", "targetgroup"},
+	"restore":      {"database", "volume"},
+	"run":          {"command"},
+	"start":        {"alarm", "containertask", "database", "execution", "instance", "query"},
+	"stop":         {"alarm", "containertask", "database", "execution", "instance"},
+	"submit":       {"job"},
+	"terminate":    {"environment"},
+	"update":       {"bucket", "containerservice", "containertask", "distribution", "environment", "function", "image", "instance", "loggroup", "loginprofile", "networkaclrule", "networkinterface", "parameter", "policy", "record", "repository", "s3object", "scalinggroup", "secret", "securitygroup", "stack", "statemachine", "subnet", "table", "targetgroup", "vpc"},
+	"wait":         {"certificate", "distribution", "kubernetescluster"},
 }
@@ -43,8 +43,12 @@ type AWSFactory struct {
 
 func (f *AWSFactory) Build(key string) func() interface{} {
 	switch key {
+	case "acceptpeeringconnection":
+		return func() interface{} { return NewAcceptPeeringconnection(f.Sess, f.Graph, f.Log) }
 	case "attachalarm":
 		return func() interface{} { return NewAttachAlarm(f.Sess, f.Graph, f.Log) }
+	case "attachclassicloadbalancer":
+		return func() interface{} { return NewAttachClassicloadbalancer(f.Sess, f.Graph, f.Log) }
 	case "attachcontainertask":
 		return func() interface{} { return NewAttachContainertask(f.Sess, f.Graph, f.Log) }
 	case "attachelasticip":
@@ -59,6 +63,8 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewAttachListener(f.Sess, f.Graph, f.Log) }
 	case "attachmfadevice":
 		return func() interface{} { return NewAttachMfadevice(f.Sess, f.Graph, f.Log) }
+	case "attachnetworkacl":
+		return func() interface{} { return NewAttachNetworkacl(f.Sess, f.Graph, f.Log) }
 	case "attachnetworkinterface":
 		return func() interface{} { return NewAttachNetworkinterface(f.Sess, f.Graph, f.Log) }
 	case "attachpolicy":
@@ -69,20 +75,38 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewAttachRoutetable(f.Sess, f.Graph, f.Log) }
 	case "attachsecuritygroup":
 		return func() interface{} { return NewAttachSecuritygroup(f.Sess, f.Graph, f.Log) }
+	case "attachservicecontrolpolicy":
+		return func() interface{} { return NewAttachServicecontrolpolicy(f.Sess, f.Graph, f.Log) }
+	case "attachtarget":
+		return func() interface{} { return NewAttachTarget(f.Sess, f.Graph, f.Log) }
+	case "attachtransitgatewayroutetable":
+		return func() interface{} { return NewAttachTransitgatewayroutetable(f.Sess, f.Graph, f.Log) }
 	case "attachuser":
 		return func() interface{} { return NewAttachUser(f.Sess, f.Graph, f.Log) }
 	case "attachvolume":
 		return func() interface{} { return NewAttachVolume(f.Sess, f.Graph, f.Log) }
+	case "attachvpngateway":
+		return func() interface{} { return NewAttachVpngateway(f.Sess, f.Graph, f.Log) }
 	case "authenticateregistry":
 		return func() interface{} { return NewAuthenticateRegistry(f.Sess, f.Graph, f.Log) }
+	case "cancelspotrequest":
+		return func() interface{} { return NewCancelSpotrequest(f.Sess, f.Graph, f.Log) }
+	case "checkcachecluster":
+		return func() interface{} { return NewCheckCachecluster(f.Sess, f.Graph, f.Log) }
 	case "checkcertificate":
 		return func() interface{} { return NewCheckCertificate(f.Sess, f.Graph, f.Log) }
+	case "checkcluster":
+		return func() interface{} { return NewCheckCluster(f.Sess, f.Graph, f.Log) }
 	case "checkdatabase":
 		return func() interface{} { return NewCheckDatabase(f.Sess, f.Graph, f.Log) }
 	case "checkdistribution":
 		return func() interface{} { return NewCheckDistribution(f.Sess, f.Graph, f.Log) }
+	case "checkhttp":
+		return func() interface{} { return NewCheckHttp(f.Sess, f.Graph, f.Log) }
 	case "checkinstance":
 		return func() interface{} { return NewCheckInstance(f.Sess, f.Graph, f.Log) }
+	case "checkkubernetescluster":
+		return func() interface{} { return NewCheckKubernetescluster(f.Sess, f.Graph, f.Log) }
 	case "checkloadbalancer":
 		return func() interface{} { return NewCheckLoadbalancer(f.Sess, f.Graph, f.Log) }
 	case "checknatgateway":
@@ -93,38 +117,86 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewCheckScalinggroup(f.Sess, f.Graph, f.Log) }
 	case "checksecuritygroup":
 		return func() interface{} { return NewCheckSecuritygroup(f.Sess, f.Graph, f.Log) }
+	case "checktcp":
+		return func() interface{} { return NewCheckTcp(f.Sess, f.Graph, f.Log) }
+	case "checktransitgateway":
+		return func() interface{} { return NewCheckTransitgateway(f.Sess, f.Graph, f.Log) }
+	case "checktransitgatewayattachment":
+		return func() interface{} { return NewCheckTransitgatewayattachment(f.Sess, f.Graph, f.Log) }
+	case "checktransitgatewayroutetable":
+		return func() interface{} { return NewCheckTransitgatewayroutetable(f.Sess, f.Graph, f.Log) }
 	case "checkvolume":
 		return func() interface{} { return NewCheckVolume(f.Sess, f.Graph, f.Log) }
+	case "checkvpnconnection":
+		return func() interface{} { return NewCheckVpnconnection(f.Sess, f.Graph, f.Log) }
 	case "copyimage":
 		return func() interface{} { return NewCopyImage(f.Sess, f.Graph, f.Log) }
 	case "copysnapshot":
 		return func() interface{} { return NewCopySnapshot(f.Sess, f.Graph, f.Log) }
 	case "createaccesskey":
 		return func() interface{} { return NewCreateAccesskey(f.Sess, f.Graph, f.Log) }
+	case "createaccount":
+		return func() interface{} { return NewCreateAccount(f.Sess, f.Graph, f.Log) }
 	case "createalarm":
 		return func() interface{} { return NewCreateAlarm(f.Sess, f.Graph, f.Log) }
+	case "createalias":
+		return func() interface{} { return NewCreateAlias(f.Sess, f.Graph, f.Log) }
+	case "createapplication":
+		return func() interface{} { return NewCreateApplication(f.Sess, f.Graph, f.Log) }
 	case "createappscalingpolicy":
 		return func() interface{} { return NewCreateAppscalingpolicy(f.Sess, f.Graph, f.Log) }
 	case "createappscalingtarget":
 		return func() interface{} { return NewCreateAppscalingtarget(f.Sess, f.Graph, f.Log) }
 	case "createbucket":
 		return func() interface{} { return NewCreateBucket(f.Sess, f.Graph, f.Log) }
+	case "createcachecluster":
+		return func() interface{} { return NewCreateCachecluster(f.Sess, f.Graph, f.Log) }
+	case "createcachesubnetgroup":
+		return func() interface{} { return NewCreateCachesubnetgroup(f.Sess, f.Graph, f.Log) }
+	case "createcatalogdatabase":
+		return func() interface{} { return NewCreateCatalogdatabase(f.Sess, f.Graph, f.Log) }
 	case "createcertificate":
 		return func() interface{} { return NewCreateCertificate(f.Sess, f.Graph, f.Log) }
+	case "createclassicloadbalancer":
+		return func() interface{} { return NewCreateClassicloadbalancer(f.Sess, f.Graph, f.Log) }
+	case "createcluster":
+		return func() interface{} { return NewCreateCluster(f.Sess, f.Graph, f.Log) }
+	case "createcomputeenvironment":
+		return func() interface{} { return NewCreateComputeenvironment(f.Sess, f.Graph, f.Log) }
 	case "createcontainercluster":
 		return func() interface{} { return NewCreateContainercluster(f.Sess, f.Graph, f.Log) }
+	case "createcontainerservice":
+		return func() interface{} { return NewCreateContainerservice(f.Sess, f.Graph, f.Log) }
+	case "createcrawler":
+		return func() interface{} { return NewCreateCrawler(f.Sess, f.Graph, f.Log) }
+	case "createcustomergateway":
+		return func() interface{} { return NewCreateCustomergateway(f.Sess, f.Graph, f.Log) }
 	case "createdatabase":
 		return func() interface{} { return NewCreateDatabase(f.Sess, f.Graph, f.Log) }
+	case "createdbparametergroup":
+		return func() interface{} { return NewCreateDbparametergroup(f.Sess, f.Graph, f.Log) }
 	case "createdbsubnetgroup":
 		return func() interface{} { return NewCreateDbsubnetgroup(f.Sess, f.Graph, f.Log) }
+	case "createdeployment":
+		return func() interface{} { return NewCreateDeployment(f.Sess, f.Graph, f.Log) }
 	case "createdistribution":
 		return func() interface{} { return NewCreateDistribution(f.Sess, f.Graph, f.Log) }
+	case "createegressonlyinternetgateway":
+		return func() interface{} { return NewCreateEgressonlyinternetgateway(f.Sess, f.Graph, f.Log) }
 	case "createelasticip":
 		return func() interface{} { return NewCreateElasticip(f.Sess, f.Graph, f.Log) }
+	case "createenvironment":
+		return func() interface{} { return NewCreateEnvironment(f.Sess, f.Graph, f.Log) }
 	case "createfunction":
 		return func() interface{} { return NewCreateFunction(f.Sess, f.Graph, f.Log) }
+	case "creategrant":
+		return func() interface{} { return NewCreateGrant(f.Sess, f.Graph, f.Log) }
 	case "creategroup":
 		return func() interface{} { return NewCreateGroup(f.Sess, f.Graph, f.Log) }
+	case "createhost":
+		return func() interface{} { return NewCreateHost(f.Sess, f.Graph, f.Log) }
+	case "createhostreservation":
+		return func() interface{} { return NewCreateHostreservation(f.Sess, f.Graph, f.Log) }
 	case "createimage":
 		return func() interface{} { return NewCreateImage(f.Sess, f.Graph, f.Log) }
 	case "createinstance":
@@ -133,94 +205,184 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewCreateInstanceprofile(f.Sess, f.Graph, f.Log) }
 	case "createinternetgateway":
 		return func() interface{} { return NewCreateInternetgateway(f.Sess, f.Graph, f.Log) }
+	case "createinvalidation":
+		return func() interface{} { return NewCreateInvalidation(f.Sess, f.Graph, f.Log) }
+	case "createjobqueue":
+		return func() interface{} { return NewCreateJobqueue(f.Sess, f.Graph, f.Log) }
+	case "createkey":
+		return func() interface{} { return NewCreateKey(f.Sess, f.Graph, f.Log) }
 	case "createkeypair":
 		return func() interface{} { return NewCreateKeypair(f.Sess, f.Graph, f.Log) }
+	case "createkubernetescluster":
+		return func() interface{} { return NewCreateKubernetescluster(f.Sess, f.Graph, f.Log) }
 	case "createlaunchconfiguration":
 		return func() interface{} { return NewCreateLaunchconfiguration(f.Sess, f.Graph, f.Log) }
 	case "createlistener":
 		return func() interface{} { return NewCreateListener(f.Sess, f.Graph, f.Log) }
 	case "createloadbalancer":
 		return func() interface{} { return NewCreateLoadbalancer(f.Sess, f.Graph, f.Log) }
+	case "createloggroup":
+		return func() interface{} { return NewCreateLoggroup(f.Sess, f.Graph, f.Log) }
 	case "createloginprofile":
 		return func() interface{} { return NewCreateLoginprofile(f.Sess, f.Graph, f.Log) }
+	case "createmethod":
+		return func() interface{} { return NewCreateMethod(f.Sess, f.Graph, f.Log) }
 	case "createmfadevice":
 		return func() interface{} { return NewCreateMfadevice(f.Sess, f.Graph, f.Log) }
 	case "createnatgateway":
 		return func() interface{} { return NewCreateNatgateway(f.Sess, f.Graph, f.Log) }
+	case "createnetworkacl":
+		return func() interface{} { return NewCreateNetworkacl(f.Sess, f.Graph, f.Log) }
+	case "createnetworkaclrule":
+		return func() interface{} { return NewCreateNetworkaclrule(f.Sess, f.Graph, f.Log) }
 	case "createnetworkinterface":
 		return func() interface{} { return NewCreateNetworkinterface(f.Sess, f.Graph, f.Log) }
+	case "createparameter":
+		return func() interface{} { return NewCreateParameter(f.Sess, f.Graph, f.Log) }
+	case "createpeeringconnection":
+		return func() interface{} { return NewCreatePeeringconnection(f.Sess, f.Graph, f.Log) }
+	case "createplacementgroup":
+		return func() interface{} { return NewCreatePlacementgroup(f.Sess, f.Graph, f.Log) }
 	case "createpolicy":
 		return func() interface{} { return NewCreatePolicy(f.Sess, f.Graph, f.Log) }
+	case "createpolicyversion":
+		return func() interface{} { return NewCreatePolicyversion(f.Sess, f.Graph, f.Log) }
+	case "createpresignedurl":
+		return func() interface{} { return NewCreatePresignedurl(f.Sess, f.Graph, f.Log) }
 	case "createqueue":
 		return func() interface{} { return NewCreateQueue(f.Sess, f.Graph, f.Log) }
 	case "createrecord":
 		return func() interface{} { return NewCreateRecord(f.Sess, f.Graph, f.Log) }
 	case "createrepository":
 		return func() interface{} { return NewCreateRepository(f.Sess, f.Graph, f.Log) }
+	case "createresource":
+		return func() interface{} { return NewCreateResource(f.Sess, f.Graph, f.Log) }
+	case "createrestapi":
+		return func() interface{} { return NewCreateRestapi(f.Sess, f.Graph, f.Log) }
 	case "createrole":
 		return func() interface{} { return NewCreateRole(f.Sess, f.Graph, f.Log) }
 	case "createroute":
 		return func() interface{} { return NewCreateRoute(f.Sess, f.Graph, f.Log) }
 	case "createroutetable":
 		return func() interface{} { return NewCreateRoutetable(f.Sess, f.Graph, f.Log) }
+	case "createrule":
+		return func() interface{} { return NewCreateRule(f.Sess, f.Graph, f.Log) }
 	case "creates3object":
 		return func() interface{} { return NewCreateS3object(f.Sess, f.Graph, f.Log) }
 	case "createscalinggroup":
 		return func() interface{} { return NewCreateScalinggroup(f.Sess, f.Graph, f.Log) }
 	case "createscalingpolicy":
 		return func() interface{} { return NewCreateScalingpolicy(f.Sess, f.Graph, f.Log) }
+	case "createsecret":
+		return func() interface{} { return NewCreateSecret(f.Sess, f.Graph, f.Log) }
 	case "createsecuritygroup":
 		return func() interface{} { return NewCreateSecuritygroup(f.Sess, f.Graph, f.Log) }
 	case "createsnapshot":
 		return func() interface{} { return NewCreateSnapshot(f.Sess, f.Graph, f.Log) }
+	case "createspotfleet":
+		return func() interface{} { return NewCreateSpotfleet(f.Sess, f.Graph, f.Log) }
+	case "createspotinstance":
+		return func() interface{} { return NewCreateSpotinstance(f.Sess, f.Graph, f.Log) }
 	case "createstack":
 		return func() interface{} { return NewCreateStack(f.Sess, f.Graph, f.Log) }
+	case "createstage":
+		return func() interface{} { return NewCreateStage(f.Sess, f.Graph, f.Log) }
+	case "createstatemachine":
+		return func() interface{} { return NewCreateStatemachine(f.Sess, f.Graph, f.Log) }
 	case "createsubnet":
 		return func() interface{} { return NewCreateSubnet(f.Sess, f.Graph, f.Log) }
 	case "createsubscription":
 		return func() interface{} { return NewCreateSubscription(f.Sess, f.Graph, f.Log) }
+	case "createtable":
+		return func() interface{} { return NewCreateTable(f.Sess, f.Graph, f.Log) }
 	case "createtag":
 		return func() interface{} { return NewCreateTag(f.Sess, f.Graph, f.Log) }
 	case "createtargetgroup":
 		return func() interface{} { return NewCreateTargetgroup(f.Sess, f.Graph, f.Log) }
 	case "createtopic":
 		return func() interface{} { return NewCreateTopic(f.Sess, f.Graph, f.Log) }
+	case "createtrail":
+		return func() interface{} { return NewCreateTrail(f.Sess, f.Graph, f.Log) }
+	case "createtransitgateway":
+		return func() interface{} { return NewCreateTransitgateway(f.Sess, f.Graph, f.Log) }
+	case "createtransitgatewayattachment":
+		return func() interface{} { return NewCreateTransitgatewayattachment(f.Sess, f.Graph, f.Log) }
+	case "createtransitgatewayroutetable":
+		return func() interface{} { return NewCreateTransitgatewayroutetable(f.Sess, f.Graph, f.Log) }
 	case "createuser":
 		return func() interface{} { return NewCreateUser(f.Sess, f.Graph, f.Log) }
 	case "createvolume":
 		return func() interface{} { return NewCreateVolume(f.Sess, f.Graph, f.Log) }
 	case "createvpc":
 		return func() interface{} { return NewCreateVpc(f.Sess, f.Graph, f.Log) }
+	case "createvpcendpoint":
+		return func() interface{} { return NewCreateVpcendpoint(f.Sess, f.Graph, f.Log) }
+	case "createvpnconnection":
+		return func() interface{} { return NewCreateVpnconnection(f.Sess, f.Graph, f.Log) }
+	case "createvpngateway":
+		return func() interface{} { return NewCreateVpngateway(f.Sess, f.Graph, f.Log) }
 	case "createzone":
 		return func() interface{} { return NewCreateZone(f.Sess, f.Graph, f.Log) }
 	case "deleteaccesskey":
 		return func() interface{} { return NewDeleteAccesskey(f.Sess, f.Graph, f.Log) }
 	case "deletealarm":
 		return func() interface{} { return NewDeleteAlarm(f.Sess, f.Graph, f.Log) }
+	case "deletealias":
+		return func() interface{} { return NewDeleteAlias(f.Sess, f.Graph, f.Log) }
+	case "deleteapplication":
+		return func() interface{} { return NewDeleteApplication(f.Sess, f.Graph, f.Log) }
 	case "deleteappscalingpolicy":
 		return func() interface{} { return NewDeleteAppscalingpolicy(f.Sess, f.Graph, f.Log) }
 	case "deleteappscalingtarget":
 		return func() interface{} { return NewDeleteAppscalingtarget(f.Sess, f.Graph, f.Log) }
 	case "deletebucket":
 		return func() interface{} { return NewDeleteBucket(f.Sess, f.Graph, f.Log) }
+	case "deletecachecluster":
+		return func() interface{} { return NewDeleteCachecluster(f.Sess, f.Graph, f.Log) }
+	case "deletecachesubnetgroup":
+		return func() interface{} { return NewDeleteCachesubnetgroup(f.Sess, f.Graph, f.Log) }
+	case "deletecatalogdatabase":
+		return func() interface{} { return NewDeleteCatalogdatabase(f.Sess, f.Graph, f.Log) }
 	case "deletecertificate":
 		return func() interface{} { return NewDeleteCertificate(f.Sess, f.Graph, f.Log) }
+	case "deleteclassicloadbalancer":
+		return func() interface{} { return NewDeleteClassicloadbalancer(f.Sess, f.Graph, f.Log) }
+	case "deletecluster":
+		return func() interface{} { return NewDeleteCluster(f.Sess, f.Graph, f.Log) }
+	case "deletecomputeenvironment":
+		return func() interface{} { return NewDeleteComputeenvironment(f.Sess, f.Graph, f.Log) }
 	case "deletecontainercluster":
 		return func() interface{} { return NewDeleteContainercluster(f.Sess, f.Graph, f.Log) }
+	case "deletecontainerservice":
+		return func() interface{} { return NewDeleteContainerservice(f.Sess, f.Graph, f.Log) }
 	case "deletecontainertask":
 		return func() interface{} { return NewDeleteContainertask(f.Sess, f.Graph, f.Log) }
+	case "deletecrawler":
+		return func() interface{} { return NewDeleteCrawler(f.Sess, f.Graph, f.Log) }
+	case "deletecustomergateway":
+		return func() interface{} { return NewDeleteCustomergateway(f.Sess, f.Graph, f.Log) }
 	case "deletedatabase":
 		return func() interface{} { return NewDeleteDatabase(f.Sess, f.Graph, f.Log) }
+	case "deletedbparametergroup":
+		return func() interface{} { return NewDeleteDbparametergroup(f.Sess, f.Graph, f.Log) }
 	case "deletedbsubnetgroup":
 		return func() interface{} { return NewDeleteDbsubnetgroup(f.Sess, f.Graph, f.Log) }
+	case "deletedeployment":
+		return func() interface{} { return NewDeleteDeployment(f.Sess, f.Graph, f.Log) }
 	case "deletedistribution":
 		return func() interface{} { return NewDeleteDistribution(f.Sess, f.Graph, f.Log) }
+	case "deleteegressonlyinternetgateway":
+		return func() interface{} { return NewDeleteEgressonlyinternetgateway(f.Sess, f.Graph, f.Log) }
 	case "deleteelasticip":
 		return func() interface{} { return NewDeleteElasticip(f.Sess, f.Graph, f.Log) }
 	case "deletefunction":
 		return func() interface{} { return NewDeleteFunction(f.Sess, f.Graph, f.Log) }
+	case "deletegrant":
+		return func() interface{} { return NewDeleteGrant(f.Sess, f.Graph, f.Log) }
 	case "deletegroup":
 		return func() interface{} { return NewDeleteGroup(f.Sess, f.Graph, f.Log) }
+	case "deletehost":
+		return func() interface{} { return NewDeleteHost(f.Sess, f.Graph, f.Log) }
 	case "deleteimage":
 		return func() interface{} { return NewDeleteImage(f.Sess, f.Graph, f.Log) }
 	case "deleteinstance":
@@ -229,68 +391,122 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewDeleteInstanceprofile(f.Sess, f.Graph, f.Log) }
 	case "deleteinternetgateway":
 		return func() interface{} { return NewDeleteInternetgateway(f.Sess, f.Graph, f.Log) }
+	case "deletejobdefinition":
+		return func() interface{} { return NewDeleteJobdefinition(f.Sess, f.Graph, f.Log) }
+	case "deletejobqueue":
+		return func() interface{} { return NewDeleteJobqueue(f.Sess, f.Graph, f.Log) }
+	case "deletekey":
+		return func() interface{} { return NewDeleteKey(f.Sess, f.Graph, f.Log) }
 	case "deletekeypair":
 		return func() interface{} { return NewDeleteKeypair(f.Sess, f.Graph, f.Log) }
+	case "deletekubernetescluster":
+		return func() interface{} { return NewDeleteKubernetescluster(f.Sess, f.Graph, f.Log) }
 	case "deletelaunchconfiguration":
 		return func() interface{} { return NewDeleteLaunchconfiguration(f.Sess, f.Graph, f.Log) }
 	case "deletelistener":
 		return func() interface{} { return NewDeleteListener(f.Sess, f.Graph, f.Log) }
 	case "deleteloadbalancer":
 		return func() interface{} { return NewDeleteLoadbalancer(f.Sess, f.Graph, f.Log) }
+	case "deleteloggroup":
+		return func() interface{} { return NewDeleteLoggroup(f.Sess, f.Graph, f.Log) }
 	case "deleteloginprofile":
 		return func() interface{} { return NewDeleteLoginprofile(f.Sess, f.Graph, f.Log) }
+	case "deletemethod":
+		return func() interface{} { return NewDeleteMethod(f.Sess, f.Graph, f.Log) }
 	case "deletemfadevice":
 		return func() interface{} { return NewDeleteMfadevice(f.Sess, f.Graph, f.Log) }
 	case "deletenatgateway":
 		return func() interface{} { return NewDeleteNatgateway(f.Sess, f.Graph, f.Log) }
+	case "deletenetworkacl":
+		return func() interface{} { return NewDeleteNetworkacl(f.Sess, f.Graph, f.Log) }
+	case "deletenetworkaclrule":
+		return func() interface{} { return NewDeleteNetworkaclrule(f.Sess, f.Graph, f.Log) }
 	case "deletenetworkinterface":
 		return func() interface{} { return NewDeleteNetworkinterface(f.Sess, f.Graph, f.Log) }
+	case "deleteparameter":
+		return func() interface{} { return NewDeleteParameter(f.Sess, f.Graph, f.Log) }
+	case "deletepeeringconnection":
+		return func() interface{} { return NewDeletePeeringconnection(f.Sess, f.Graph, f.Log) }
+	case "deleteplacementgroup":
+		return func() interface{} { return NewDeletePlacementgroup(f.Sess, f.Graph, f.Log) }
 	case "deletepolicy":
 		return func() interface{} { return NewDeletePolicy(f.Sess, f.Graph, f.Log) }
+	case "deletepolicyversion":
+		return func() interface{} { return NewDeletePolicyversion(f.Sess, f.Graph, f.Log) }
 	case "deletequeue":
 		return func() interface{} { return NewDeleteQueue(f.Sess, f.Graph, f.Log) }
 	case "deleterecord":
 		return func() interface{} { return NewDeleteRecord(f.Sess, f.Graph, f.Log) }
 	case "deleterepository":
 		return func() interface{} { return NewDeleteRepository(f.Sess, f.Graph, f.Log) }
+	case "deleteresource":
+		return func() interface{} { return NewDeleteResource(f.Sess, f.Graph, f.Log) }
+	case "deleterestapi":
+		return func() interface{} { return NewDeleteRestapi(f.Sess, f.Graph, f.Log) }
 	case "deleterole":
 		return func() interface{} { return NewDeleteRole(f.Sess, f.Graph, f.Log) }
 	case "deleteroute":
 		return func() interface{} { return NewDeleteRoute(f.Sess, f.Graph, f.Log) }
 	case "deleteroutetable":
 		return func() interface{} { return NewDeleteRoutetable(f.Sess, f.Graph, f.Log) }
+	case "deleterule":
+		return func() interface{} { return NewDeleteRule(f.Sess, f.Graph, f.Log) }
 	case "deletes3object":
 		return func() interface{} { return NewDeleteS3object(f.Sess, f.Graph, f.Log) }
 	case "deletescalinggroup":
 		return func() interface{} { return NewDeleteScalinggroup(f.Sess, f.Graph, f.Log) }
 	case "deletescalingpolicy":
 		return func() interface{} { return NewDeleteScalingpolicy(f.Sess, f.Graph, f.Log) }
+	case "deletesecret":
+		return func() interface{} { return NewDeleteSecret(f.Sess, f.Graph, f.Log) }
 	case "deletesecuritygroup":
 		return func() interface{} { return NewDeleteSecuritygroup(f.Sess, f.Graph, f.Log) }
 	case "deletesnapshot":
 		return func() interface{} { return NewDeleteSnapshot(f.Sess, f.Graph, f.Log) }
 	case "deletestack":
 		return func() interface{} { return NewDeleteStack(f.Sess, f.Graph, f.Log) }
+	case "deletestage":
+		return func() interface{} { return NewDeleteStage(f.Sess, f.Graph, f.Log) }
+	case "deletestatemachine":
+		return func() interface{} { return NewDeleteStatemachine(f.Sess, f.Graph, f.Log) }
 	case "deletesubnet":
 		return func() interface{} { return NewDeleteSubnet(f.Sess, f.Graph, f.Log) }
 	case "deletesubscription":
 		return func() interface{} { return NewDeleteSubscription(f.Sess, f.Graph, f.Log) }
+	case "deletetable":
+		return func() interface{} { return NewDeleteTable(f.Sess, f.Graph, f.Log) }
 	case "deletetag":
 		return func() interface{} { return NewDeleteTag(f.Sess, f.Graph, f.Log) }
 	case "deletetargetgroup":
 		return func() interface{} { return NewDeleteTargetgroup(f.Sess, f.Graph, f.Log) }
 	case "deletetopic":
 		return func() interface{} { return NewDeleteTopic(f.Sess, f.Graph, f.Log) }
+	case "deletetrail":
+		return func() interface{} { return NewDeleteTrail(f.Sess, f.Graph, f.Log) }
+	case "deletetransitgateway":
+		return func() interface{} { return NewDeleteTransitgateway(f.Sess, f.Graph, f.Log) }
+	case "deletetransitgatewayattachment":
+		return func() interface{} { return NewDeleteTransitgatewayattachment(f.Sess, f.Graph, f.Log) }
+	case "deletetransitgatewayroutetable":
+		return func() interface{} { return NewDeleteTransitgatewayroutetable(f.Sess, f.Graph, f.Log) }
 	case "deleteuser":
 		return func() interface{} { return NewDeleteUser(f.Sess, f.Graph, f.Log) }
 	case "deletevolume":
 		return func() interface{} { return NewDeleteVolume(f.Sess, f.Graph, f.Log) }
 	case "deletevpc":
 		return func() interface{} { return NewDeleteVpc(f.Sess, f.Graph, f.Log) }
+	case "deletevpcendpoint":
+		return func() interface{} { return NewDeleteVpcendpoint(f.Sess, f.Graph, f.Log) }
+	case "deletevpnconnection":
+		return func() interface{} { return NewDeleteVpnconnection(f.Sess, f.Graph, f.Log) }
+	case "deletevpngateway":
+		return func() interface{} { return NewDeleteVpngateway(f.Sess, f.Graph, f.Log) }
 	case "deletezone":
 		return func() interface{} { return NewDeleteZone(f.Sess, f.Graph, f.Log) }
 	case "detachalarm":
 		return func() interface{} { return NewDetachAlarm(f.Sess, f.Graph, f.Log) }
+	case "detachclassicloadbalancer":
+		return func() interface{} { return NewDetachClassicloadbalancer(f.Sess, f.Graph, f.Log) }
 	case "detachcontainertask":
 		return func() interface{} { return NewDetachContainertask(f.Sess, f.Graph, f.Log) }
 	case "detachelasticip":
@@ -303,6 +519,8 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewDetachInternetgateway(f.Sess, f.Graph, f.Log) }
 	case "detachmfadevice":
 		return func() interface{} { return NewDetachMfadevice(f.Sess, f.Graph, f.Log) }
+	case "detachnetworkacl":
+		return func() interface{} { return NewDetachNetworkacl(f.Sess, f.Graph, f.Log) }
 	case "detachnetworkinterface":
 		return func() interface{} { return NewDetachNetworkinterface(f.Sess, f.Graph, f.Log) }
 	case "detachpolicy":
@@ -313,66 +531,142 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewDetachRoutetable(f.Sess, f.Graph, f.Log) }
 	case "detachsecuritygroup":
 		return func() interface{} { return NewDetachSecuritygroup(f.Sess, f.Graph, f.Log) }
+	case "detachservicecontrolpolicy":
+		return func() interface{} { return NewDetachServicecontrolpolicy(f.Sess, f.Graph, f.Log) }
+	case "detachtarget":
+		return func() interface{} { return NewDetachTarget(f.Sess, f.Graph, f.Log) }
+	case "detachtransitgatewayroutetable":
+		return func() interface{} { return NewDetachTransitgatewayroutetable(f.Sess, f.Graph, f.Log) }
 	case "detachuser":
 		return func() interface{} { return NewDetachUser(f.Sess, f.Graph, f.Log) }
 	case "detachvolume":
 		return func() interface{} { return NewDetachVolume(f.Sess, f.Graph, f.Log) }
+	case "detachvpngateway":
+		return func() interface{} { return NewDetachVpngateway(f.Sess, f.Graph, f.Log) }
+	case "disablekey":
+		return func() interface{} { return NewDisableKey(f.Sess, f.Graph, f.Log) }
+	case "downloads3object":
+		return func() interface{} { return NewDownloadS3object(f.Sess, f.Graph, f.Log) }
+	case "enablekey":
+		return func() interface{} { return NewEnableKey(f.Sess, f.Graph, f.Log) }
+	case "getconsoleoutput":
+		return func() interface{} { return NewGetConsoleoutput(f.Sess, f.Graph, f.Log) }
+	case "getscreenshot":
+		return func() interface{} { return NewGetScreenshot(f.Sess, f.Graph, f.Log) }
 	case "importimage":
 		return func() interface{} { return NewImportImage(f.Sess, f.Graph, f.Log) }
+	case "invokefunction":
+		return func() interface{} { return NewInvokeFunction(f.Sess, f.Graph, f.Log) }
+	case "moveaccount":
+		return func() interface{} { return NewMoveAccount(f.Sess, f.Graph, f.Log) }
+	case "rebootinstance":
+		return func() interface{} { return NewRebootInstance(f.Sess, f.Graph, f.Log) }
+	case "registerjobdefinition":
+		return func() interface{} { return NewRegisterJobdefinition(f.Sess, f.Graph, f.Log) }
+	case "resizecluster":
+		return func() interface{} { return NewResizeCluster(f.Sess, f.Graph, f.Log) }
 	case "restartdatabase":
 		return func() interface{} { return NewRestartDatabase(f.Sess, f.Graph, f.Log) }
 	case "restartinstance":
 		return func() interface{} { return NewRestartInstance(f.Sess, f.Graph, f.Log) }
+	case "restoredatabase":
+		return func() interface{} { return NewRestoreDatabase(f.Sess, f.Graph, f.Log) }
+	case "restorevolume":
+		return func() interface{} { return NewRestoreVolume(f.Sess, f.Graph, f.Log) }
+	case "runcommand":
+		return func() interface{} { return NewRunCommand(f.Sess, f.Graph, f.Log) }
 	case "startalarm":
 		return func() interface{} { return NewStartAlarm(f.Sess, f.Graph, f.Log) }
 	case "startcontainertask":
 		return func() interface{} { return NewStartContainertask(f.Sess, f.Graph, f.Log) }
 	case "startdatabase":
 		return func() interface{} { return NewStartDatabase(f.Sess, f.Graph, f.Log) }
+	case "startexecution":
+		return func() interface{} { return NewStartExecution(f.Sess, f.Graph, f.Log) }
 	case "startinstance":
 		return func() interface{} { return NewStartInstance(f.Sess, f.Graph, f.Log) }
+	case "startquery":
+		return func() interface{} { return NewStartQuery(f.Sess, f.Graph, f.Log) }
 	case "stopalarm":
 		return func() interface{} { return NewStopAlarm(f.Sess, f.Graph, f.Log) }
 	case "stopcontainertask":
 		return func() interface{} { return NewStopContainertask(f.Sess, f.Graph, f.Log) }
 	case "stopdatabase":
 		return func() interface{} { return NewStopDatabase(f.Sess, f.Graph, f.Log) }
+	case "stopexecution":
+		return func() interface{} { return NewStopExecution(f.Sess, f.Graph, f.Log) }
 	case "stopinstance":
 		return func() interface{} { return NewStopInstance(f.Sess, f.Graph, f.Log) }
+	case "submitjob":
+		return func() interface{} { return NewSubmitJob(f.Sess, f.Graph, f.Log) }
+	case "terminateenvironment":
+		return func() interface{} { return NewTerminateEnvironment(f.Sess, f.Graph, f.Log) }
 	case "updatebucket":
 		return func() interface{} { return NewUpdateBucket(f.Sess, f.Graph, f.Log) }
+	case "updatecontainerservice":
+		return func() interface{} { return NewUpdateContainerservice(f.Sess, f.Graph, f.Log) }
 	case "updatecontainertask":
 		return func() interface{} { return NewUpdateContainertask(f.Sess, f.Graph, f.Log) }
 	case "updatedistribution":
 		return func() interface{} { return NewUpdateDistribution(f.Sess, f.Graph, f.Log) }
+	case "updateenvironment":
+		return func() interface{} { return NewUpdateEnvironment(f.Sess, f.Graph, f.Log) }
+	case "updatefunction":
+		return func() interface{} { return NewUpdateFunction(f.Sess, f.Graph, f.Log) }
 	case "updateimage":
 		return func() interface{} { return NewUpdateImage(f.Sess, f.Graph, f.Log) }
 	case "updateinstance":
 		return func() interface{} { return NewUpdateInstance(f.Sess, f.Graph, f.Log) }
+	case "updateloggroup":
+		return func() interface{} { return NewUpdateLoggroup(f.Sess, f.Graph, f.Log) }
 	case "updateloginprofile":
 		return func() interface{} { return NewUpdateLoginprofile(f.Sess, f.Graph, f.Log) }
+	case "updatenetworkaclrule":
+		return func() interface{} { return NewUpdateNetworkaclrule(f.Sess, f.Graph, f.Log) }
+	case "updatenetworkinterface":
+		return func() interface{} { return NewUpdateNetworkinterface(f.Sess, f.Graph, f.Log) }
+	case "updateparameter":
+		return func() interface{} { return NewUpdateParameter(f.Sess, f.Graph, f.Log) }
 	case "updatepolicy":
 		return func() interface{} { return NewUpdatePolicy(f.Sess, f.Graph, f.Log) }
 	case "updaterecord":
 		return func() interface{} { return NewUpdateRecord(f.Sess, f.Graph, f.Log) }
+	case "updaterepository":
+		return func() interface{} { return NewUpdateRepository(f.Sess, f.Graph, f.Log) }
 	case "updates3object":
 		return func() interface{} { return NewUpdateS3object(f.Sess, f.Graph, f.Log) }
 	case "updatescalinggroup":
 		return func() interface{} { return NewUpdateScalinggroup(f.Sess, f.Graph, f.Log) }
+	case "updatesecret":
+		return func() interface{} { return NewUpdateSecret(f.Sess, f.Graph, f.Log) }
 	case "updatesecuritygroup":
 		return func() interface{} { return NewUpdateSecuritygroup(f.Sess, f.Graph, f.Log) }
 	case "updatestack":
 		return func() interface{} { return NewUpdateStack(f.Sess, f.Graph, f.Log) }
+	case "updatestatemachine":
+		return func() interface{} { return NewUpdateStatemachine(f.Sess, f.Graph, f.Log) }
 	case "updatesubnet":
 		return func() interface{} { return NewUpdateSubnet(f.Sess, f.Graph, f.Log) }
+	case "updatetable":
+		return func() interface{} { return NewUpdateTable(f.Sess, f.Graph, f.Log) }
 	case "updatetargetgroup":
 		return func() interface{} { return NewUpdateTargetgroup(f.Sess, f.Graph, f.Log) }
+	case "updatevpc":
+		return func() interface{} { return NewUpdateVpc(f.Sess, f.Graph, f.Log) }
+	case "waitcertificate":
+		return func() interface{} { return NewWaitCertificate(f.Sess, f.Graph, f.Log) }
+	case "waitdistribution":
+		return func() interface{} { return NewWaitDistribution(f.Sess, f.Graph, f.Log) }
+	case "waitkubernetescluster":
+		return func() interface{} { return NewWaitKubernetescluster(f.Sess, f.Graph, f.Log) }
 	}
 	return nil
 }
 
 var (
+	_ command = &AcceptPeeringconnection{}
 	_ command = &AttachAlarm{}
+	_ command = &AttachClassicloadbalancer{}
 	_ command = &AttachContainertask{}
 	_ command = &AttachElasticip{}
 	_ command = &AttachInstance{}
@@ -380,158 +674,302 @@ var (
 	_ command = &AttachInternetgateway{}
 	_ command = &AttachListener{}
 	_ command = &AttachMfadevice{}
+	_ command = &AttachNetworkacl{}
 	_ command = &AttachNetworkinterface{}
 	_ command = &AttachPolicy{}
 	_ command = &AttachRole{}
 	_ command = &AttachRoutetable{}
 	_ command = &AttachSecuritygroup{}
+	_ command = &AttachServicecontrolpolicy{}
+	_ command = &AttachTarget{}
+	_ command = &AttachTransitgatewayroutetable{}
 	_ command = &AttachUser{}
 	_ command = &AttachVolume{}
+	_ command = &AttachVpngateway{}
 	_ command = &AuthenticateRegistry{}
+	_ command = &CancelSpotrequest{}
+	_ command = &CheckCachecluster{}
 	_ command = &CheckCertificate{}
+	_ command = &CheckCluster{}
 	_ command = &CheckDatabase{}
 	_ command = &CheckDistribution{}
+	_ command = &CheckHttp{}
 	_ command = &CheckInstance{}
+	_ command = &CheckKubernetescluster{}
 	_ command = &CheckLoadbalancer{}
 	_ command = &CheckNatgateway{}
 	_ command = &CheckNetworkinterface{}
 	_ command = &CheckScalinggroup{}
 	_ command = &CheckSecuritygroup{}
+	_ command = &CheckTcp{}
+	_ command = &CheckTransitgateway{}
+	_ command = &CheckTransitgatewayattachment{}
+	_ command = &CheckTransitgatewayroutetable{}
 	_ command = &CheckVolume{}
+	_ command = &CheckVpnconnection{}
 	_ command = &CopyImage{}
 	_ command = &CopySnapshot{}
 	_ command = &CreateAccesskey{}
+	_ command = &CreateAccount{}
 	_ command = &CreateAlarm{}
+	_ command = &CreateAlias{}
+	_ command = &CreateApplication{}
 	_ command = &CreateAppscalingpolicy{}
 	_ command = &CreateAppscalingtarget{}
 	_ command = &CreateBucket{}
+	_ command = &CreateCachecluster{}
+	_ command = &CreateCachesubnetgroup{}
+	_ command = &CreateCatalogdatabase{}
 	_ command = &CreateCertificate{}
+	_ command = &CreateClassicloadbalancer{}
+	_ command = &CreateCluster{}
+	_ command = &CreateComputeenvironment{}
 	_ command = &CreateContainercluster{}
+	_ command = &CreateContainerservice{}
+	_ command = &CreateCrawler{}
+	_ command = &CreateCustomergateway{}
 	_ command = &CreateDatabase{}
+	_ command = &CreateDbparametergroup{}
 	_ command = &CreateDbsubnetgroup{}
+	_ command = &CreateDeployment{}
 	_ command = &CreateDistribution{}
+	_ command = &CreateEgressonlyinternetgateway{}
 	_ command = &CreateElasticip{}
+	_ command = &CreateEnvironment{}
 	_ command = &CreateFunction{}
+	_ command = &CreateGrant{}
 	_ command = &CreateGroup{}
+	_ command = &CreateHost{}
+	_ command = &CreateHostreservation{}
 	_ command = &CreateImage{}
 	_ command = &CreateInstance{}
 	_ command = &CreateInstanceprofile{}
 	_ command = &CreateInternetgateway{}
+	_ command = &CreateInvalidation{}
+	_ command = &CreateJobqueue{}
+	_ command = &CreateKey{}
 	_ command = &CreateKeypair{}
+	_ command = &CreateKubernetescluster{}
 	_ command = &CreateLaunchconfiguration{}
 	_ command = &CreateListener{}
 	_ command = &CreateLoadbalancer{}
+	_ command = &CreateLoggroup{}
 	_ command = &CreateLoginprofile{}
+	_ command = &CreateMethod{}
 	_ command = &CreateMfadevice{}
 	_ command = &CreateNatgateway{}
+	_ command = &CreateNetworkacl{}
+	_ command = &CreateNetworkaclrule{}
 	_ command = &CreateNetworkinterface{}
+	_ command = &CreateParameter{}
+	_ command = &CreatePeeringconnection{}
+	_ command = &CreatePlacementgroup{}
 	_ command = &CreatePolicy{}
+	_ command = &CreatePolicyversion{}
+	_ command = &CreatePresignedurl{}
 	_ command = &CreateQueue{}
 	_ command = &CreateRecord{}
 	_ command = &CreateRepository{}
+	_ command = &CreateResource{}
+	_ command = &CreateRestapi{}
 	_ command = &CreateRole{}
 	_ command = &CreateRoute{}
 	_ command = &CreateRoutetable{}
+	_ command = &CreateRule{}
 	_ command = &CreateS3object{}
 	_ command = &CreateScalinggroup{}
 	_ command = &CreateScalingpolicy{}
+	_ command = &CreateSecret{}
 	_ command = &CreateSecuritygroup{}
 	_ command = &CreateSnapshot{}
+	_ command = &CreateSpotfleet{}
+	_ command = &CreateSpotinstance{}
 	_ command = &CreateStack{}
+	_ command = &CreateStage{}
+	_ command = &CreateStatemachine{}
 	_ command = &CreateSubnet{}
 	_ command = &CreateSubscription{}
+	_ command = &CreateTable{}
 	_ command = &CreateTag{}
 	_ command = &CreateTargetgroup{}
 	_ command = &CreateTopic{}
+	_ command = &CreateTrail{}
+	_ command = &CreateTransitgateway{}
+	_ command = &CreateTransitgatewayattachment{}
+	_ command = &CreateTransitgatewayroutetable{}
 	_ command = &CreateUser{}
 	_ command = &CreateVolume{}
 	_ command = &CreateVpc{}
+	_ command = &CreateVpcendpoint{}
+	_ command = &CreateVpnconnection{}
+	_ command = &CreateVpngateway{}
 	_ command = &CreateZone{}
 	_ command = &DeleteAccesskey{}
 	_ command = &DeleteAlarm{}
+	_ command = &DeleteAlias{}
+	_ command = &DeleteApplication{}
 	_ command = &DeleteAppscalingpolicy{}
 	_ command = &DeleteAppscalingtarget{}
 	_ command = &DeleteBucket{}
+	_ command = &DeleteCachecluster{}
+	_ command = &DeleteCachesubnetgroup{}
+	_ command = &DeleteCatalogdatabase{}
 	_ command = &DeleteCertificate{}
+	_ command = &DeleteClassicloadbalancer{}
+	_ command = &DeleteCluster{}
+	_ command = &DeleteComputeenvironment{}
 	_ command = &DeleteContainercluster{}
+	_ command = &DeleteContainerservice{}
 	_ command = &DeleteContainertask{}
+	_ command = &DeleteCrawler{}
+	_ command = &DeleteCustomergateway{}
 	_ command = &DeleteDatabase{}
+	_ command = &DeleteDbparametergroup{}
 	_ command = &DeleteDbsubnetgroup{}
+	_ command = &DeleteDeployment{}
 	_ command = &DeleteDistribution{}
+	_ command = &DeleteEgressonlyinternetgateway{}
 	_ command = &DeleteElasticip{}
 	_ command = &DeleteFunction{}
+	_ command = &DeleteGrant{}
 	_ command = &DeleteGroup{}
+	_ command = &DeleteHost{}
 	_ command = &DeleteImage{}
 	_ command = &DeleteInstance{}
 	_ command = &DeleteInstanceprofile{}
 	_ command = &DeleteInternetgateway{}
+	_ command = &DeleteJobdefinition{}
+	_ command = &DeleteJobqueue{}
+	_ command = &DeleteKey{}
 	_ command = &DeleteKeypair{}
+	_ command = &DeleteKubernetescluster{}
 	_ command = &DeleteLaunchconfiguration{}
 	_ command = &DeleteListener{}
 	_ command = &DeleteLoadbalancer{}
+	_ command = &DeleteLoggroup{}
 	_ command = &DeleteLoginprofile{}
+	_ command = &DeleteMethod{}
 	_ command = &DeleteMfadevice{}
 	_ command = &DeleteNatgateway{}
+	_ command = &DeleteNetworkacl{}
+	_ command = &DeleteNetworkaclrule{}
 	_ command = &DeleteNetworkinterface{}
+	_ command = &DeleteParameter{}
+	_ command = &DeletePeeringconnection{}
+	_ command = &DeletePlacementgroup{}
 	_ command = &DeletePolicy{}
+	_ command = &DeletePolicyversion{}
 	_ command = &DeleteQueue{}
 	_ command = &DeleteRecord{}
 	_ command = &DeleteRepository{}
+	_ command = &DeleteResource{}
+	_ command = &DeleteRestapi{}
 	_ command = &DeleteRole{}
 	_ command = &DeleteRoute{}
 	_ command = &DeleteRoutetable{}
+	_ command = &DeleteRule{}
 	_ command = &DeleteS3object{}
 	_ command = &DeleteScalinggroup{}
 	_ command = &DeleteScalingpolicy{}
+	_ command = &DeleteSecret{}
 	_ command = &DeleteSecuritygroup{}
 	_ command = &DeleteSnapshot{}
 	_ command = &DeleteStack{}
+	_ command = &DeleteStage{}
+	_ command = &DeleteStatemachine{}
 	_ command = &DeleteSubnet{}
 	_ command = &DeleteSubscription{}
+	_ command = &DeleteTable{}
 	_ command = &DeleteTag{}
 	_ command = &DeleteTargetgroup{}
 	_ command = &DeleteTopic{}
+	_ command = &DeleteTrail{}
+	_ command = &DeleteTransitgateway{}
+	_ command = &DeleteTransitgatewayattachment{}
+	_ command = &DeleteTransitgatewayroutetable{}
 	_ command = &DeleteUser{}
 	_ command = &DeleteVolume{}
 	_ command = &DeleteVpc{}
+	_ command = &DeleteVpcendpoint{}
+	_ command = &DeleteVpnconnection{}
+	_ command = &DeleteVpngateway{}
 	_ command = &DeleteZone{}
 	_ command = &DetachAlarm{}
+	_ command = &DetachClassicloadbalancer{}
 	_ command = &DetachContainertask{}
 	_ command = &DetachElasticip{}
 	_ command = &DetachInstance{}
 	_ command = &DetachInstanceprofile{}
 	_ command = &DetachInternetgateway{}
 	_ command = &DetachMfadevice{}
+	_ command = &DetachNetworkacl{}
 	_ command = &DetachNetworkinterface{}
 	_ command = &DetachPolicy{}
 	_ command = &DetachRole{}
 	_ command = &DetachRoutetable{}
 	_ command = &DetachSecuritygroup{}
+	_ command = &DetachServicecontrolpolicy{}
+	_ command = &DetachTarget{}
+	_ command = &DetachTransitgatewayroutetable{}
 	_ command = &DetachUser{}
 	_ command = &DetachVolume{}
+	_ command = &DetachVpngateway{}
+	_ command = &DisableKey{}
+	_ command = &DownloadS3object{}
+	_ command = &EnableKey{}
+	_ command = &GetConsoleoutput{}
+	_ command = &GetScreenshot{}
 	_ command = &ImportImage{}
+	_ command = &InvokeFunction{}
+	_ command = &MoveAccount{}
+	_ command = &RebootInstance{}
+	_ command = &RegisterJobdefinition{}
+	_ command = &ResizeCluster{}
 	_ command = &RestartDatabase{}
 	_ command = &RestartInstance{}
+	_ command = &RestoreDatabase{}
+	_ command = &RestoreVolume{}
+	_ command = &RunCommand{}
 	_ command = &StartAlarm{}
 	_ command = &StartContainertask{}
 	_ command = &StartDatabase{}
+	_ command = &StartExecution{}
 	_ command = &StartInstance{}
+	_ command = &StartQuery{}
 	_ command = &StopAlarm{}
 	_ command = &StopContainertask{}
 	_ command = &StopDatabase{}
+	_ command = &StopExecution{}
 	_ command = &StopInstance{}
+	_ command = &SubmitJob{}
+	_ command = &TerminateEnvironment{}
 	_ command = &UpdateBucket{}
+	_ command = &UpdateContainerservice{}
 	_ command = &UpdateContainertask{}
 	_ command = &UpdateDistribution{}
+	_ command = &UpdateEnvironment{}
+	_ command = &UpdateFunction{}
 	_ command = &UpdateImage{}
 	_ command = &UpdateInstance{}
+	_ command = &UpdateLoggroup{}
 	_ command = &UpdateLoginprofile{}
+	_ command = &UpdateNetworkaclrule{}
+	_ command = &UpdateNetworkinterface{}
+	_ command = &UpdateParameter{}
 	_ command = &UpdatePolicy{}
 	_ command = &UpdateRecord{}
+	_ command = &UpdateRepository{}
 	_ command = &UpdateS3object{}
 	_ command = &UpdateScalinggroup{}
+	_ command = &UpdateSecret{}
 	_ command = &UpdateSecuritygroup{}
 	_ command = &UpdateStack{}
+	_ command = &UpdateStatemachine{}
 	_ command = &UpdateSubnet{}
+	_ command = &UpdateTable{}
 	_ command = &UpdateTargetgroup{}
+	_ command = &UpdateVpc{}
+	_ command = &WaitCertificate{}
+	_ command = &WaitDistribution{}
+	_ command = &WaitKubernetescluster{}
 )
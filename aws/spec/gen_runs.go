@@ -26,43 +26,174 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acm/acmiface"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling/applicationautoscalingiface"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/aws/aws-sdk-go/service/athena/athenaiface"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/batch/batchiface"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents/cloudwatcheventsiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk/elasticbeanstalkiface"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/aws/aws-sdk-go/service/glue/glueiface"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/organizations/organizationsiface"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/redshift/redshiftiface"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/wallix/awless/aws/ec2transitgateway"
+	"github.com/wallix/awless/aws/ec2transitgateway/ec2transitgatewayiface"
+	"github.com/wallix/awless/aws/eks"
+	"github.com/wallix/awless/aws/eks/eksiface"
+	"github.com/wallix/awless/aws/secretsmanager"
+	"github.com/wallix/awless/aws/secretsmanager/secretsmanageriface"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/template/env"
 )
 
+func NewAcceptPeeringconnection(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AcceptPeeringconnection {
+	cmd := new(AcceptPeeringconnection)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *AcceptPeeringconnection) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *AcceptPeeringconnection) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.AcceptVpcPeeringConnectionInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.AcceptVpcPeeringConnectionInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.AcceptVpcPeeringConnection(input)
+	renv.Log().ExtraVerbosef("ec2.AcceptVpcPeeringConnection call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("accept peeringconnection: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("accept peeringconnection '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("accept peeringconnection done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *AcceptPeeringconnection) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.AcceptVpcPeeringConnectionInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.AcceptVpcPeeringConnectionInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.AcceptVpcPeeringConnection(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.AcceptVpcPeeringConnection call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: accept peeringconnection ok")
+			return fakeDryRunId("peeringconnection"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *AcceptPeeringconnection) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
 func NewAttachAlarm(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AttachAlarm {
 	cmd := new(AttachAlarm)
 	if len(l) > 0 {
@@ -82,13 +213,6 @@ func (cmd *AttachAlarm) SetApi(api cloudwatchiface.CloudWatchAPI) {
 }
 
 func (cmd *AttachAlarm) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *AttachAlarm) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -128,7 +252,7 @@ func (cmd *AttachAlarm) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *AttachAlarm) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *AttachAlarm) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	return fakeDryRunId("alarm"), nil
 }
 
@@ -136,6 +260,78 @@ func (cmd *AttachAlarm) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
+func NewAttachClassicloadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AttachClassicloadbalancer {
+	cmd := new(AttachClassicloadbalancer)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = elb.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *AttachClassicloadbalancer) SetApi(api elbiface.ELBAPI) {
+	cmd.api = api
+}
+
+func (cmd *AttachClassicloadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &elb.RegisterInstancesWithLoadBalancerInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in elb.RegisterInstancesWithLoadBalancerInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.RegisterInstancesWithLoadBalancer(input)
+	renv.Log().ExtraVerbosef("elb.RegisterInstancesWithLoadBalancer call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("attach classicloadbalancer: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("attach classicloadbalancer '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("attach classicloadbalancer done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *AttachClassicloadbalancer) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("classicloadbalancer"), nil
+}
+
+func (cmd *AttachClassicloadbalancer) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
 func NewAttachContainertask(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AttachContainertask {
 	cmd := new(AttachContainertask)
 	if len(l) > 0 {
@@ -155,13 +351,6 @@ func (cmd *AttachContainertask) SetApi(api ecsiface.ECSAPI) {
 }
 
 func (cmd *AttachContainertask) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *AttachContainertask) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -201,7 +390,7 @@ func (cmd *AttachContainertask) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *AttachContainertask) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *AttachContainertask) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	return fakeDryRunId("containertask"), nil
 }
 
@@ -228,13 +417,6 @@ func (cmd *AttachElasticip) SetApi(api ec2iface.EC2API) {
 }
 
 func (cmd *AttachElasticip) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *AttachElasticip) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -280,7 +462,7 @@ func (cmd *AttachElasticip) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *AttachElasticip) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *AttachElasticip) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -328,13 +510,6 @@ func (cmd *AttachInstance) SetApi(api elbv2iface.ELBV2API) {
 }
 
 func (cmd *AttachInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *AttachInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -380,7 +555,7 @@ func (cmd *AttachInstance) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *AttachInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *AttachInstance) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	return fakeDryRunId("instance"), nil
 }
 
@@ -407,13 +582,6 @@ func (cmd *AttachInstanceprofile) SetApi(api ec2iface.EC2API) {
 }
 
 func (cmd *AttachInstanceprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *AttachInstanceprofile) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -476,13 +644,6 @@ func (cmd *AttachInternetgateway) SetApi(api ec2iface.EC2API) {
 }
 
 func (cmd *AttachInternetgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *AttachInternetgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -528,7 +689,7 @@ func (cmd *AttachInternetgateway) run(renv env.Running, params map[string]interf
 	return extracted, nil
 }
 
-func (cmd *AttachInternetgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *AttachInternetgateway) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -576,13 +737,6 @@ func (cmd *AttachListener) SetApi(api elbv2iface.ELBV2API) {
 }
 
 func (cmd *AttachListener) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *AttachListener) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -628,7 +782,7 @@ func (cmd *AttachListener) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *AttachListener) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *AttachListener) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	return fakeDryRunId("listener"), nil
 }
 
@@ -655,13 +809,6 @@ func (cmd *AttachMfadevice) SetApi(api iamiface.IAMAPI) {
 }
 
 func (cmd *AttachMfadevice) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *AttachMfadevice) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -707,7 +854,7 @@ func (cmd *AttachMfadevice) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *AttachMfadevice) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *AttachMfadevice) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	return fakeDryRunId("mfadevice"), nil
 }
 
@@ -715,6 +862,68 @@ func (cmd *AttachMfadevice) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
+func NewAttachNetworkacl(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AttachNetworkacl {
+	cmd := new(AttachNetworkacl)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *AttachNetworkacl) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *AttachNetworkacl) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("attach networkacl: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("attach networkacl '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("attach networkacl done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *AttachNetworkacl) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
 func NewAttachNetworkinterface(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AttachNetworkinterface {
 	cmd := new(AttachNetworkinterface)
 	if len(l) > 0 {
@@ -734,13 +943,6 @@ func (cmd *AttachNetworkinterface) SetApi(api ec2iface.EC2API) {
 }
 
 func (cmd *AttachNetworkinterface) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *AttachNetworkinterface) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -786,7 +988,7 @@ func (cmd *AttachNetworkinterface) run(renv env.Running, params map[string]inter
 	return extracted, nil
 }
 
-func (cmd *AttachNetworkinterface) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *AttachNetworkinterface) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -834,13 +1036,6 @@ func (cmd *AttachPolicy) SetApi(api iamiface.IAMAPI) {
 }
 
 func (cmd *AttachPolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *AttachPolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -880,7 +1075,7 @@ func (cmd *AttachPolicy) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *AttachPolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *AttachPolicy) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	return fakeDryRunId("policy"), nil
 }
 
@@ -907,13 +1102,6 @@ func (cmd *AttachRole) SetApi(api iamiface.IAMAPI) {
 }
 
 func (cmd *AttachRole) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *AttachRole) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -959,7 +1147,7 @@ func (cmd *AttachRole) run(renv env.Running, params map[string]interface{}) (int
 	return extracted, nil
 }
 
-func (cmd *AttachRole) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *AttachRole) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	return fakeDryRunId("role"), nil
 }
 
@@ -986,13 +1174,6 @@ func (cmd *AttachRoutetable) SetApi(api ec2iface.EC2API) {
 }
 
 func (cmd *AttachRoutetable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *AttachRoutetable) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1038,7 +1219,7 @@ func (cmd *AttachRoutetable) run(renv env.Running, params map[string]interface{}
 	return extracted, nil
 }
 
-func (cmd *AttachRoutetable) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *AttachRoutetable) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1086,13 +1267,6 @@ func (cmd *AttachSecuritygroup) SetApi(api ec2iface.EC2API) {
 }
 
 func (cmd *AttachSecuritygroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *AttachSecuritygroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1132,7 +1306,7 @@ func (cmd *AttachSecuritygroup) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *AttachSecuritygroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *AttachSecuritygroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	return fakeDryRunId("securitygroup"), nil
 }
 
@@ -1140,32 +1314,25 @@ func (cmd *AttachSecuritygroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewAttachUser(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AttachUser {
-	cmd := new(AttachUser)
+func NewAttachServicecontrolpolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AttachServicecontrolpolicy {
+	cmd := new(AttachServicecontrolpolicy)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = organizations.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *AttachUser) SetApi(api iamiface.IAMAPI) {
+func (cmd *AttachServicecontrolpolicy) SetApi(api organizationsiface.OrganizationsAPI) {
 	cmd.api = api
 }
 
-func (cmd *AttachUser) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *AttachUser) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *AttachServicecontrolpolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1176,13 +1343,13 @@ func (cmd *AttachUser) run(renv env.Running, params map[string]interface{}) (int
 		}
 	}
 
-	input := &iam.AddUserToGroupInput{}
+	input := &organizations.AttachPolicyInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.AddUserToGroupInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in organizations.AttachPolicyInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.AddUserToGroup(input)
-	renv.Log().ExtraVerbosef("iam.AddUserToGroup call took %s", time.Since(start))
+	output, err := cmd.api.AttachPolicy(input)
+	renv.Log().ExtraVerbosef("organizations.AttachPolicy call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -1192,14 +1359,14 @@ func (cmd *AttachUser) run(renv env.Running, params map[string]interface{}) (int
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("attach user: AWS command returned nil output")
+			renv.Log().Warning("attach servicecontrolpolicy: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("attach user '%s' done", extracted)
+		renv.Log().Verbosef("attach servicecontrolpolicy '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("attach user done")
+		renv.Log().Verbose("attach servicecontrolpolicy done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -1211,40 +1378,33 @@ func (cmd *AttachUser) run(renv env.Running, params map[string]interface{}) (int
 	return extracted, nil
 }
 
-func (cmd *AttachUser) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("user"), nil
+func (cmd *AttachServicecontrolpolicy) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("servicecontrolpolicy"), nil
 }
 
-func (cmd *AttachUser) inject(params map[string]interface{}) error {
+func (cmd *AttachServicecontrolpolicy) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewAttachVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AttachVolume {
-	cmd := new(AttachVolume)
+func NewAttachTarget(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AttachTarget {
+	cmd := new(AttachTarget)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = cloudwatchevents.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *AttachVolume) SetApi(api ec2iface.EC2API) {
+func (cmd *AttachTarget) SetApi(api cloudwatcheventsiface.CloudWatchEventsAPI) {
 	cmd.api = api
 }
 
-func (cmd *AttachVolume) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *AttachVolume) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *AttachTarget) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1255,15 +1415,9 @@ func (cmd *AttachVolume) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &ec2.AttachVolumeInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.AttachVolumeInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.AttachVolume(input)
-	renv.Log().ExtraVerbosef("ec2.AttachVolume call took %s", time.Since(start))
-	if err != nil {
-		return nil, decorateAWSError(err)
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
 	}
 
 	var extracted interface{}
@@ -1271,14 +1425,14 @@ func (cmd *AttachVolume) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("attach volume: AWS command returned nil output")
+			renv.Log().Warning("attach target: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("attach volume '%s' done", extracted)
+		renv.Log().Verbosef("attach target '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("attach volume done")
+		renv.Log().Verbose("attach target done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -1290,61 +1444,33 @@ func (cmd *AttachVolume) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *AttachVolume) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.AttachVolumeInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.AttachVolumeInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.AttachVolume(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.AttachVolume call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: attach volume ok")
-			return fakeDryRunId("volume"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *AttachTarget) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("target"), nil
 }
 
-func (cmd *AttachVolume) inject(params map[string]interface{}) error {
+func (cmd *AttachTarget) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewAuthenticateRegistry(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AuthenticateRegistry {
-	cmd := new(AuthenticateRegistry)
+func NewAttachTransitgatewayroutetable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AttachTransitgatewayroutetable {
+	cmd := new(AttachTransitgatewayroutetable)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ecr.New(sess)
+		cmd.api = ec2transitgateway.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *AuthenticateRegistry) SetApi(api ecriface.ECRAPI) {
+func (cmd *AttachTransitgatewayroutetable) SetApi(api ec2transitgatewayiface.EC2TransitGatewayAPI) {
 	cmd.api = api
 }
 
-func (cmd *AuthenticateRegistry) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *AuthenticateRegistry) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *AttachTransitgatewayroutetable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1355,7 +1481,13 @@ func (cmd *AuthenticateRegistry) run(renv env.Running, params map[string]interfa
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &ec2transitgateway.AssociateTransitGatewayRouteTableInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2transitgateway.AssociateTransitGatewayRouteTableInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.AssociateTransitGatewayRouteTable(input)
+	renv.Log().ExtraVerbosef("ec2transitgateway.AssociateTransitGatewayRouteTable call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -1365,14 +1497,14 @@ func (cmd *AuthenticateRegistry) run(renv env.Running, params map[string]interfa
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("authenticate registry: AWS command returned nil output")
+			renv.Log().Warning("attach transitgatewayroutetable: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("authenticate registry '%s' done", extracted)
+		renv.Log().Verbosef("attach transitgatewayroutetable '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("authenticate registry done")
+		renv.Log().Verbose("attach transitgatewayroutetable done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -1384,40 +1516,54 @@ func (cmd *AuthenticateRegistry) run(renv env.Running, params map[string]interfa
 	return extracted, nil
 }
 
-func (cmd *AuthenticateRegistry) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("registry"), nil
+func (cmd *AttachTransitgatewayroutetable) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2transitgateway.AssociateTransitGatewayRouteTableInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2transitgateway.AssociateTransitGatewayRouteTableInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.AssociateTransitGatewayRouteTable(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2transitgateway.AssociateTransitGatewayRouteTable call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: attach transitgatewayroutetable ok")
+			return fakeDryRunId("transitgatewayroutetable"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *AuthenticateRegistry) inject(params map[string]interface{}) error {
+func (cmd *AttachTransitgatewayroutetable) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckCertificate(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckCertificate {
-	cmd := new(CheckCertificate)
+func NewAttachUser(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AttachUser {
+	cmd := new(AttachUser)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = acm.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckCertificate) SetApi(api acmiface.ACMAPI) {
+func (cmd *AttachUser) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *CheckCertificate) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CheckCertificate) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *AttachUser) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1428,7 +1574,13 @@ func (cmd *CheckCertificate) run(renv env.Running, params map[string]interface{}
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &iam.AddUserToGroupInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in iam.AddUserToGroupInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.AddUserToGroup(input)
+	renv.Log().ExtraVerbosef("iam.AddUserToGroup call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -1438,14 +1590,14 @@ func (cmd *CheckCertificate) run(renv env.Running, params map[string]interface{}
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check certificate: AWS command returned nil output")
+			renv.Log().Warning("attach user: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check certificate '%s' done", extracted)
+		renv.Log().Verbosef("attach user '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check certificate done")
+		renv.Log().Verbose("attach user done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -1457,40 +1609,33 @@ func (cmd *CheckCertificate) run(renv env.Running, params map[string]interface{}
 	return extracted, nil
 }
 
-func (cmd *CheckCertificate) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("certificate"), nil
+func (cmd *AttachUser) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("user"), nil
 }
 
-func (cmd *CheckCertificate) inject(params map[string]interface{}) error {
+func (cmd *AttachUser) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckDatabase {
-	cmd := new(CheckDatabase)
+func NewAttachVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AttachVolume {
+	cmd := new(AttachVolume)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = rds.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckDatabase) SetApi(api rdsiface.RDSAPI) {
+func (cmd *AttachVolume) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CheckDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CheckDatabase) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *AttachVolume) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1501,7 +1646,13 @@ func (cmd *CheckDatabase) run(renv env.Running, params map[string]interface{}) (
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &ec2.AttachVolumeInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.AttachVolumeInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.AttachVolume(input)
+	renv.Log().ExtraVerbosef("ec2.AttachVolume call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -1511,14 +1662,14 @@ func (cmd *CheckDatabase) run(renv env.Running, params map[string]interface{}) (
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check database: AWS command returned nil output")
+			renv.Log().Warning("attach volume: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check database '%s' done", extracted)
+		renv.Log().Verbosef("attach volume '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check database done")
+		renv.Log().Verbose("attach volume done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -1530,40 +1681,54 @@ func (cmd *CheckDatabase) run(renv env.Running, params map[string]interface{}) (
 	return extracted, nil
 }
 
-func (cmd *CheckDatabase) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("database"), nil
+func (cmd *AttachVolume) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.AttachVolumeInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.AttachVolumeInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.AttachVolume(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.AttachVolume call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: attach volume ok")
+			return fakeDryRunId("volume"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *CheckDatabase) inject(params map[string]interface{}) error {
+func (cmd *AttachVolume) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckDistribution(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckDistribution {
-	cmd := new(CheckDistribution)
+func NewAttachVpngateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AttachVpngateway {
+	cmd := new(AttachVpngateway)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudfront.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckDistribution) SetApi(api cloudfrontiface.CloudFrontAPI) {
+func (cmd *AttachVpngateway) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CheckDistribution) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CheckDistribution) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *AttachVpngateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1574,7 +1739,13 @@ func (cmd *CheckDistribution) run(renv env.Running, params map[string]interface{
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &ec2.AttachVpnGatewayInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.AttachVpnGatewayInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.AttachVpnGateway(input)
+	renv.Log().ExtraVerbosef("ec2.AttachVpnGateway call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -1584,14 +1755,14 @@ func (cmd *CheckDistribution) run(renv env.Running, params map[string]interface{
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check distribution: AWS command returned nil output")
+			renv.Log().Warning("attach vpngateway: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check distribution '%s' done", extracted)
+		renv.Log().Verbosef("attach vpngateway '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check distribution done")
+		renv.Log().Verbose("attach vpngateway done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -1603,40 +1774,54 @@ func (cmd *CheckDistribution) run(renv env.Running, params map[string]interface{
 	return extracted, nil
 }
 
-func (cmd *CheckDistribution) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("distribution"), nil
+func (cmd *AttachVpngateway) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.AttachVpnGatewayInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.AttachVpnGatewayInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.AttachVpnGateway(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.AttachVpnGateway call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: attach vpngateway ok")
+			return fakeDryRunId("vpngateway"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *CheckDistribution) inject(params map[string]interface{}) error {
+func (cmd *AttachVpngateway) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckInstance {
-	cmd := new(CheckInstance)
+func NewAuthenticateRegistry(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AuthenticateRegistry {
+	cmd := new(AuthenticateRegistry)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = ecr.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckInstance) SetApi(api ec2iface.EC2API) {
+func (cmd *AuthenticateRegistry) SetApi(api ecriface.ECRAPI) {
 	cmd.api = api
 }
 
-func (cmd *CheckInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CheckInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *AuthenticateRegistry) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1657,14 +1842,14 @@ func (cmd *CheckInstance) run(renv env.Running, params map[string]interface{}) (
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check instance: AWS command returned nil output")
+			renv.Log().Warning("authenticate registry: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check instance '%s' done", extracted)
+		renv.Log().Verbosef("authenticate registry '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check instance done")
+		renv.Log().Verbose("authenticate registry done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -1676,40 +1861,33 @@ func (cmd *CheckInstance) run(renv env.Running, params map[string]interface{}) (
 	return extracted, nil
 }
 
-func (cmd *CheckInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("instance"), nil
+func (cmd *AuthenticateRegistry) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("registry"), nil
 }
 
-func (cmd *CheckInstance) inject(params map[string]interface{}) error {
+func (cmd *AuthenticateRegistry) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckLoadbalancer {
-	cmd := new(CheckLoadbalancer)
+func NewCancelSpotrequest(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CancelSpotrequest {
+	cmd := new(CancelSpotrequest)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elbv2.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckLoadbalancer) SetApi(api elbv2iface.ELBV2API) {
+func (cmd *CancelSpotrequest) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CheckLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CheckLoadbalancer) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CancelSpotrequest) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1730,14 +1908,14 @@ func (cmd *CheckLoadbalancer) run(renv env.Running, params map[string]interface{
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check loadbalancer: AWS command returned nil output")
+			renv.Log().Warning("cancel spotrequest: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check loadbalancer '%s' done", extracted)
+		renv.Log().Verbosef("cancel spotrequest '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check loadbalancer done")
+		renv.Log().Verbose("cancel spotrequest done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -1749,40 +1927,29 @@ func (cmd *CheckLoadbalancer) run(renv env.Running, params map[string]interface{
 	return extracted, nil
 }
 
-func (cmd *CheckLoadbalancer) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("loadbalancer"), nil
-}
-
-func (cmd *CheckLoadbalancer) inject(params map[string]interface{}) error {
+func (cmd *CancelSpotrequest) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckNatgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckNatgateway {
-	cmd := new(CheckNatgateway)
+func NewCheckCachecluster(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckCachecluster {
+	cmd := new(CheckCachecluster)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = elasticache.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckNatgateway) SetApi(api ec2iface.EC2API) {
+func (cmd *CheckCachecluster) SetApi(api elasticacheiface.ElastiCacheAPI) {
 	cmd.api = api
 }
 
-func (cmd *CheckNatgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CheckNatgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckCachecluster) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1803,14 +1970,14 @@ func (cmd *CheckNatgateway) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check natgateway: AWS command returned nil output")
+			renv.Log().Warning("check cachecluster: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check natgateway '%s' done", extracted)
+		renv.Log().Verbosef("check cachecluster '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check natgateway done")
+		renv.Log().Verbose("check cachecluster done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -1822,40 +1989,33 @@ func (cmd *CheckNatgateway) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *CheckNatgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("natgateway"), nil
+func (cmd *CheckCachecluster) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("cachecluster"), nil
 }
 
-func (cmd *CheckNatgateway) inject(params map[string]interface{}) error {
+func (cmd *CheckCachecluster) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckNetworkinterface(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckNetworkinterface {
-	cmd := new(CheckNetworkinterface)
+func NewCheckCertificate(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckCertificate {
+	cmd := new(CheckCertificate)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = acm.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckNetworkinterface) SetApi(api ec2iface.EC2API) {
+func (cmd *CheckCertificate) SetApi(api acmiface.ACMAPI) {
 	cmd.api = api
 }
 
-func (cmd *CheckNetworkinterface) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CheckNetworkinterface) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckCertificate) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1876,14 +2036,14 @@ func (cmd *CheckNetworkinterface) run(renv env.Running, params map[string]interf
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check networkinterface: AWS command returned nil output")
+			renv.Log().Warning("check certificate: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check networkinterface '%s' done", extracted)
+		renv.Log().Verbosef("check certificate '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check networkinterface done")
+		renv.Log().Verbose("check certificate done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -1895,40 +2055,33 @@ func (cmd *CheckNetworkinterface) run(renv env.Running, params map[string]interf
 	return extracted, nil
 }
 
-func (cmd *CheckNetworkinterface) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("networkinterface"), nil
+func (cmd *CheckCertificate) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("certificate"), nil
 }
 
-func (cmd *CheckNetworkinterface) inject(params map[string]interface{}) error {
+func (cmd *CheckCertificate) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckScalinggroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckScalinggroup {
-	cmd := new(CheckScalinggroup)
+func NewCheckCluster(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckCluster {
+	cmd := new(CheckCluster)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = autoscaling.New(sess)
+		cmd.api = redshift.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckScalinggroup) SetApi(api autoscalingiface.AutoScalingAPI) {
+func (cmd *CheckCluster) SetApi(api redshiftiface.RedshiftAPI) {
 	cmd.api = api
 }
 
-func (cmd *CheckScalinggroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CheckScalinggroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckCluster) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1949,14 +2102,14 @@ func (cmd *CheckScalinggroup) run(renv env.Running, params map[string]interface{
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check scalinggroup: AWS command returned nil output")
+			renv.Log().Warning("check cluster: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check scalinggroup '%s' done", extracted)
+		renv.Log().Verbosef("check cluster '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check scalinggroup done")
+		renv.Log().Verbose("check cluster done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -1968,40 +2121,33 @@ func (cmd *CheckScalinggroup) run(renv env.Running, params map[string]interface{
 	return extracted, nil
 }
 
-func (cmd *CheckScalinggroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("scalinggroup"), nil
+func (cmd *CheckCluster) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("cluster"), nil
 }
 
-func (cmd *CheckScalinggroup) inject(params map[string]interface{}) error {
+func (cmd *CheckCluster) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckSecuritygroup {
-	cmd := new(CheckSecuritygroup)
+func NewCheckDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckDatabase {
+	cmd := new(CheckDatabase)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = rds.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckSecuritygroup) SetApi(api ec2iface.EC2API) {
+func (cmd *CheckDatabase) SetApi(api rdsiface.RDSAPI) {
 	cmd.api = api
 }
 
-func (cmd *CheckSecuritygroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CheckSecuritygroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2022,14 +2168,14 @@ func (cmd *CheckSecuritygroup) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check securitygroup: AWS command returned nil output")
+			renv.Log().Warning("check database: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check securitygroup '%s' done", extracted)
+		renv.Log().Verbosef("check database '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check securitygroup done")
+		renv.Log().Verbose("check database done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2041,40 +2187,33 @@ func (cmd *CheckSecuritygroup) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *CheckSecuritygroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("securitygroup"), nil
+func (cmd *CheckDatabase) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("database"), nil
 }
 
-func (cmd *CheckSecuritygroup) inject(params map[string]interface{}) error {
+func (cmd *CheckDatabase) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckVolume {
-	cmd := new(CheckVolume)
+func NewCheckDistribution(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckDistribution {
+	cmd := new(CheckDistribution)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = cloudfront.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckVolume) SetApi(api ec2iface.EC2API) {
+func (cmd *CheckDistribution) SetApi(api cloudfrontiface.CloudFrontAPI) {
 	cmd.api = api
 }
 
-func (cmd *CheckVolume) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CheckVolume) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckDistribution) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2095,14 +2234,14 @@ func (cmd *CheckVolume) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check volume: AWS command returned nil output")
+			renv.Log().Warning("check distribution: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check volume '%s' done", extracted)
+		renv.Log().Verbosef("check distribution '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check volume done")
+		renv.Log().Verbose("check distribution done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2114,40 +2253,33 @@ func (cmd *CheckVolume) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *CheckVolume) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("volume"), nil
+func (cmd *CheckDistribution) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("distribution"), nil
 }
 
-func (cmd *CheckVolume) inject(params map[string]interface{}) error {
+func (cmd *CheckDistribution) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCopyImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CopyImage {
-	cmd := new(CopyImage)
+func NewCheckHttp(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckHttp {
+	cmd := new(CheckHttp)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = elbv2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CopyImage) SetApi(api ec2iface.EC2API) {
+func (cmd *CheckHttp) SetApi(api elbv2iface.ELBV2API) {
 	cmd.api = api
 }
 
-func (cmd *CopyImage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CopyImage) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckHttp) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2158,13 +2290,7 @@ func (cmd *CopyImage) run(renv env.Running, params map[string]interface{}) (inte
 		}
 	}
 
-	input := &ec2.CopyImageInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CopyImageInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CopyImage(input)
-	renv.Log().ExtraVerbosef("ec2.CopyImage call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -2174,14 +2300,14 @@ func (cmd *CopyImage) run(renv env.Running, params map[string]interface{}) (inte
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("copy image: AWS command returned nil output")
+			renv.Log().Warning("check http: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("copy image '%s' done", extracted)
+		renv.Log().Verbosef("check http '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("copy image done")
+		renv.Log().Verbose("check http done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2193,37 +2319,16 @@ func (cmd *CopyImage) run(renv env.Running, params map[string]interface{}) (inte
 	return extracted, nil
 }
 
-func (cmd *CopyImage) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.CopyImageInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CopyImageInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.CopyImage(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CopyImage call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: copy image ok")
-			return fakeDryRunId("image"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CheckHttp) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("http"), nil
 }
 
-func (cmd *CopyImage) inject(params map[string]interface{}) error {
+func (cmd *CheckHttp) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCopySnapshot(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CopySnapshot {
-	cmd := new(CopySnapshot)
+func NewCheckInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckInstance {
+	cmd := new(CheckInstance)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -2236,18 +2341,11 @@ func NewCopySnapshot(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logge
 	return cmd
 }
 
-func (cmd *CopySnapshot) SetApi(api ec2iface.EC2API) {
+func (cmd *CheckInstance) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CopySnapshot) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CopySnapshot) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2258,13 +2356,7 @@ func (cmd *CopySnapshot) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &ec2.CopySnapshotInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CopySnapshotInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CopySnapshot(input)
-	renv.Log().ExtraVerbosef("ec2.CopySnapshot call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -2274,14 +2366,14 @@ func (cmd *CopySnapshot) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("copy snapshot: AWS command returned nil output")
+			renv.Log().Warning("check instance: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("copy snapshot '%s' done", extracted)
+		renv.Log().Verbosef("check instance '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("copy snapshot done")
+		renv.Log().Verbose("check instance done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2293,61 +2385,33 @@ func (cmd *CopySnapshot) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *CopySnapshot) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.CopySnapshotInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CopySnapshotInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.CopySnapshot(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CopySnapshot call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: copy snapshot ok")
-			return fakeDryRunId("snapshot"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CheckInstance) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("instance"), nil
 }
 
-func (cmd *CopySnapshot) inject(params map[string]interface{}) error {
+func (cmd *CheckInstance) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateAccesskey(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateAccesskey {
-	cmd := new(CreateAccesskey)
+func NewCheckKubernetescluster(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckKubernetescluster {
+	cmd := new(CheckKubernetescluster)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = eks.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateAccesskey) SetApi(api iamiface.IAMAPI) {
+func (cmd *CheckKubernetescluster) SetApi(api eksiface.EKSAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateAccesskey) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateAccesskey) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckKubernetescluster) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2358,13 +2422,7 @@ func (cmd *CreateAccesskey) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &iam.CreateAccessKeyInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.CreateAccessKeyInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CreateAccessKey(input)
-	renv.Log().ExtraVerbosef("iam.CreateAccessKey call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -2374,14 +2432,14 @@ func (cmd *CreateAccesskey) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create accesskey: AWS command returned nil output")
+			renv.Log().Warning("check kubernetescluster: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create accesskey '%s' done", extracted)
+		renv.Log().Verbosef("check kubernetescluster '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create accesskey done")
+		renv.Log().Verbose("check kubernetescluster done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2393,40 +2451,33 @@ func (cmd *CreateAccesskey) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *CreateAccesskey) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("accesskey"), nil
+func (cmd *CheckKubernetescluster) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("kubernetescluster"), nil
 }
 
-func (cmd *CreateAccesskey) inject(params map[string]interface{}) error {
+func (cmd *CheckKubernetescluster) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateAlarm(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateAlarm {
-	cmd := new(CreateAlarm)
+func NewCheckLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckLoadbalancer {
+	cmd := new(CheckLoadbalancer)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudwatch.New(sess)
+		cmd.api = elbv2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateAlarm) SetApi(api cloudwatchiface.CloudWatchAPI) {
+func (cmd *CheckLoadbalancer) SetApi(api elbv2iface.ELBV2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateAlarm) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateAlarm) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2437,13 +2488,7 @@ func (cmd *CreateAlarm) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &cloudwatch.PutMetricAlarmInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in cloudwatch.PutMetricAlarmInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.PutMetricAlarm(input)
-	renv.Log().ExtraVerbosef("cloudwatch.PutMetricAlarm call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -2453,14 +2498,14 @@ func (cmd *CreateAlarm) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create alarm: AWS command returned nil output")
+			renv.Log().Warning("check loadbalancer: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create alarm '%s' done", extracted)
+		renv.Log().Verbosef("check loadbalancer '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create alarm done")
+		renv.Log().Verbose("check loadbalancer done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2472,40 +2517,33 @@ func (cmd *CreateAlarm) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *CreateAlarm) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("alarm"), nil
+func (cmd *CheckLoadbalancer) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("loadbalancer"), nil
 }
 
-func (cmd *CreateAlarm) inject(params map[string]interface{}) error {
+func (cmd *CheckLoadbalancer) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateAppscalingpolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateAppscalingpolicy {
-	cmd := new(CreateAppscalingpolicy)
+func NewCheckNatgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckNatgateway {
+	cmd := new(CheckNatgateway)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = applicationautoscaling.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateAppscalingpolicy) SetApi(api applicationautoscalingiface.ApplicationAutoScalingAPI) {
+func (cmd *CheckNatgateway) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateAppscalingpolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateAppscalingpolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckNatgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2516,13 +2554,7 @@ func (cmd *CreateAppscalingpolicy) run(renv env.Running, params map[string]inter
 		}
 	}
 
-	input := &applicationautoscaling.PutScalingPolicyInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in applicationautoscaling.PutScalingPolicyInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.PutScalingPolicy(input)
-	renv.Log().ExtraVerbosef("applicationautoscaling.PutScalingPolicy call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -2532,14 +2564,14 @@ func (cmd *CreateAppscalingpolicy) run(renv env.Running, params map[string]inter
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create appscalingpolicy: AWS command returned nil output")
+			renv.Log().Warning("check natgateway: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create appscalingpolicy '%s' done", extracted)
+		renv.Log().Verbosef("check natgateway '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create appscalingpolicy done")
+		renv.Log().Verbose("check natgateway done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2551,40 +2583,33 @@ func (cmd *CreateAppscalingpolicy) run(renv env.Running, params map[string]inter
 	return extracted, nil
 }
 
-func (cmd *CreateAppscalingpolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("appscalingpolicy"), nil
+func (cmd *CheckNatgateway) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("natgateway"), nil
 }
 
-func (cmd *CreateAppscalingpolicy) inject(params map[string]interface{}) error {
+func (cmd *CheckNatgateway) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateAppscalingtarget(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateAppscalingtarget {
-	cmd := new(CreateAppscalingtarget)
+func NewCheckNetworkinterface(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckNetworkinterface {
+	cmd := new(CheckNetworkinterface)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = applicationautoscaling.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateAppscalingtarget) SetApi(api applicationautoscalingiface.ApplicationAutoScalingAPI) {
+func (cmd *CheckNetworkinterface) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateAppscalingtarget) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateAppscalingtarget) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckNetworkinterface) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2595,13 +2620,7 @@ func (cmd *CreateAppscalingtarget) run(renv env.Running, params map[string]inter
 		}
 	}
 
-	input := &applicationautoscaling.RegisterScalableTargetInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in applicationautoscaling.RegisterScalableTargetInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.RegisterScalableTarget(input)
-	renv.Log().ExtraVerbosef("applicationautoscaling.RegisterScalableTarget call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -2611,14 +2630,14 @@ func (cmd *CreateAppscalingtarget) run(renv env.Running, params map[string]inter
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create appscalingtarget: AWS command returned nil output")
+			renv.Log().Warning("check networkinterface: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create appscalingtarget '%s' done", extracted)
+		renv.Log().Verbosef("check networkinterface '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create appscalingtarget done")
+		renv.Log().Verbose("check networkinterface done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2630,40 +2649,33 @@ func (cmd *CreateAppscalingtarget) run(renv env.Running, params map[string]inter
 	return extracted, nil
 }
 
-func (cmd *CreateAppscalingtarget) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("appscalingtarget"), nil
+func (cmd *CheckNetworkinterface) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("networkinterface"), nil
 }
 
-func (cmd *CreateAppscalingtarget) inject(params map[string]interface{}) error {
+func (cmd *CheckNetworkinterface) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateBucket(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateBucket {
-	cmd := new(CreateBucket)
+func NewCheckScalinggroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckScalinggroup {
+	cmd := new(CheckScalinggroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = s3.New(sess)
+		cmd.api = autoscaling.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateBucket) SetApi(api s3iface.S3API) {
+func (cmd *CheckScalinggroup) SetApi(api autoscalingiface.AutoScalingAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateBucket) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateBucket) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckScalinggroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2674,13 +2686,7 @@ func (cmd *CreateBucket) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &s3.CreateBucketInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in s3.CreateBucketInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CreateBucket(input)
-	renv.Log().ExtraVerbosef("s3.CreateBucket call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -2690,14 +2696,14 @@ func (cmd *CreateBucket) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create bucket: AWS command returned nil output")
+			renv.Log().Warning("check scalinggroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create bucket '%s' done", extracted)
+		renv.Log().Verbosef("check scalinggroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create bucket done")
+		renv.Log().Verbose("check scalinggroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2709,40 +2715,33 @@ func (cmd *CreateBucket) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *CreateBucket) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("bucket"), nil
+func (cmd *CheckScalinggroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("scalinggroup"), nil
 }
 
-func (cmd *CreateBucket) inject(params map[string]interface{}) error {
+func (cmd *CheckScalinggroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateCertificate(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateCertificate {
-	cmd := new(CreateCertificate)
+func NewCheckSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckSecuritygroup {
+	cmd := new(CheckSecuritygroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = acm.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateCertificate) SetApi(api acmiface.ACMAPI) {
+func (cmd *CheckSecuritygroup) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateCertificate) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateCertificate) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckSecuritygroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2763,14 +2762,14 @@ func (cmd *CreateCertificate) run(renv env.Running, params map[string]interface{
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create certificate: AWS command returned nil output")
+			renv.Log().Warning("check securitygroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create certificate '%s' done", extracted)
+		renv.Log().Verbosef("check securitygroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create certificate done")
+		renv.Log().Verbose("check securitygroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2782,40 +2781,33 @@ func (cmd *CreateCertificate) run(renv env.Running, params map[string]interface{
 	return extracted, nil
 }
 
-func (cmd *CreateCertificate) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("certificate"), nil
+func (cmd *CheckSecuritygroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("securitygroup"), nil
 }
 
-func (cmd *CreateCertificate) inject(params map[string]interface{}) error {
+func (cmd *CheckSecuritygroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateContainercluster(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateContainercluster {
-	cmd := new(CreateContainercluster)
+func NewCheckTcp(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckTcp {
+	cmd := new(CheckTcp)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ecs.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateContainercluster) SetApi(api ecsiface.ECSAPI) {
+func (cmd *CheckTcp) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateContainercluster) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateContainercluster) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckTcp) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2826,13 +2818,7 @@ func (cmd *CreateContainercluster) run(renv env.Running, params map[string]inter
 		}
 	}
 
-	input := &ecs.CreateClusterInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ecs.CreateClusterInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CreateCluster(input)
-	renv.Log().ExtraVerbosef("ecs.CreateCluster call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -2842,14 +2828,14 @@ func (cmd *CreateContainercluster) run(renv env.Running, params map[string]inter
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create containercluster: AWS command returned nil output")
+			renv.Log().Warning("check tcp: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create containercluster '%s' done", extracted)
+		renv.Log().Verbosef("check tcp '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create containercluster done")
+		renv.Log().Verbose("check tcp done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2861,40 +2847,33 @@ func (cmd *CreateContainercluster) run(renv env.Running, params map[string]inter
 	return extracted, nil
 }
 
-func (cmd *CreateContainercluster) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("containercluster"), nil
+func (cmd *CheckTcp) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("tcp"), nil
 }
 
-func (cmd *CreateContainercluster) inject(params map[string]interface{}) error {
+func (cmd *CheckTcp) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateDatabase {
-	cmd := new(CreateDatabase)
+func NewCheckTransitgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckTransitgateway {
+	cmd := new(CheckTransitgateway)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = rds.New(sess)
+		cmd.api = ec2transitgateway.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateDatabase) SetApi(api rdsiface.RDSAPI) {
+func (cmd *CheckTransitgateway) SetApi(api ec2transitgatewayiface.EC2TransitGatewayAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateDatabase) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckTransitgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2915,14 +2894,14 @@ func (cmd *CreateDatabase) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create database: AWS command returned nil output")
+			renv.Log().Warning("check transitgateway: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create database '%s' done", extracted)
+		renv.Log().Verbosef("check transitgateway '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create database done")
+		renv.Log().Verbose("check transitgateway done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2934,40 +2913,33 @@ func (cmd *CreateDatabase) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *CreateDatabase) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("database"), nil
+func (cmd *CheckTransitgateway) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("transitgateway"), nil
 }
 
-func (cmd *CreateDatabase) inject(params map[string]interface{}) error {
+func (cmd *CheckTransitgateway) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateDbsubnetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateDbsubnetgroup {
-	cmd := new(CreateDbsubnetgroup)
+func NewCheckTransitgatewayattachment(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckTransitgatewayattachment {
+	cmd := new(CheckTransitgatewayattachment)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = rds.New(sess)
+		cmd.api = ec2transitgateway.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateDbsubnetgroup) SetApi(api rdsiface.RDSAPI) {
+func (cmd *CheckTransitgatewayattachment) SetApi(api ec2transitgatewayiface.EC2TransitGatewayAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateDbsubnetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateDbsubnetgroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckTransitgatewayattachment) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2978,13 +2950,7 @@ func (cmd *CreateDbsubnetgroup) run(renv env.Running, params map[string]interfac
 		}
 	}
 
-	input := &rds.CreateDBSubnetGroupInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in rds.CreateDBSubnetGroupInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CreateDBSubnetGroup(input)
-	renv.Log().ExtraVerbosef("rds.CreateDBSubnetGroup call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -2994,14 +2960,14 @@ func (cmd *CreateDbsubnetgroup) run(renv env.Running, params map[string]interfac
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create dbsubnetgroup: AWS command returned nil output")
+			renv.Log().Warning("check transitgatewayattachment: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create dbsubnetgroup '%s' done", extracted)
+		renv.Log().Verbosef("check transitgatewayattachment '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create dbsubnetgroup done")
+		renv.Log().Verbose("check transitgatewayattachment done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3013,40 +2979,33 @@ func (cmd *CreateDbsubnetgroup) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *CreateDbsubnetgroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("dbsubnetgroup"), nil
+func (cmd *CheckTransitgatewayattachment) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("transitgatewayattachment"), nil
 }
 
-func (cmd *CreateDbsubnetgroup) inject(params map[string]interface{}) error {
+func (cmd *CheckTransitgatewayattachment) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateDistribution(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateDistribution {
-	cmd := new(CreateDistribution)
+func NewCheckTransitgatewayroutetable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckTransitgatewayroutetable {
+	cmd := new(CheckTransitgatewayroutetable)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudfront.New(sess)
+		cmd.api = ec2transitgateway.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateDistribution) SetApi(api cloudfrontiface.CloudFrontAPI) {
+func (cmd *CheckTransitgatewayroutetable) SetApi(api ec2transitgatewayiface.EC2TransitGatewayAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateDistribution) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateDistribution) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckTransitgatewayroutetable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -3067,14 +3026,14 @@ func (cmd *CreateDistribution) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create distribution: AWS command returned nil output")
+			renv.Log().Warning("check transitgatewayroutetable: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create distribution '%s' done", extracted)
+		renv.Log().Verbosef("check transitgatewayroutetable '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create distribution done")
+		renv.Log().Verbose("check transitgatewayroutetable done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3086,16 +3045,16 @@ func (cmd *CreateDistribution) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *CreateDistribution) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("distribution"), nil
+func (cmd *CheckTransitgatewayroutetable) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("transitgatewayroutetable"), nil
 }
 
-func (cmd *CreateDistribution) inject(params map[string]interface{}) error {
+func (cmd *CheckTransitgatewayroutetable) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateElasticip(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateElasticip {
-	cmd := new(CreateElasticip)
+func NewCheckVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckVolume {
+	cmd := new(CheckVolume)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -3108,18 +3067,11 @@ func NewCreateElasticip(sess *session.Session, g cloud.GraphAPI, l ...*logger.Lo
 	return cmd
 }
 
-func (cmd *CreateElasticip) SetApi(api ec2iface.EC2API) {
+func (cmd *CheckVolume) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateElasticip) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateElasticip) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckVolume) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -3130,13 +3082,7 @@ func (cmd *CreateElasticip) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &ec2.AllocateAddressInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.AllocateAddressInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.AllocateAddress(input)
-	renv.Log().ExtraVerbosef("ec2.AllocateAddress call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -3146,14 +3092,14 @@ func (cmd *CreateElasticip) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create elasticip: AWS command returned nil output")
+			renv.Log().Warning("check volume: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create elasticip '%s' done", extracted)
+		renv.Log().Verbosef("check volume '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create elasticip done")
+		renv.Log().Verbose("check volume done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3165,61 +3111,33 @@ func (cmd *CreateElasticip) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *CreateElasticip) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.AllocateAddressInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.AllocateAddressInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.AllocateAddress(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.AllocateAddress call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create elasticip ok")
-			return fakeDryRunId("elasticip"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CheckVolume) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("volume"), nil
 }
 
-func (cmd *CreateElasticip) inject(params map[string]interface{}) error {
+func (cmd *CheckVolume) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateFunction(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateFunction {
-	cmd := new(CreateFunction)
+func NewCheckVpnconnection(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckVpnconnection {
+	cmd := new(CheckVpnconnection)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = lambda.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateFunction) SetApi(api lambdaiface.LambdaAPI) {
+func (cmd *CheckVpnconnection) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateFunction) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateFunction) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckVpnconnection) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -3230,13 +3148,7 @@ func (cmd *CreateFunction) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &lambda.CreateFunctionInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in lambda.CreateFunctionInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CreateFunction(input)
-	renv.Log().ExtraVerbosef("lambda.CreateFunction call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -3246,14 +3158,14 @@ func (cmd *CreateFunction) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create function: AWS command returned nil output")
+			renv.Log().Warning("check vpnconnection: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create function '%s' done", extracted)
+		renv.Log().Verbosef("check vpnconnection '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create function done")
+		renv.Log().Verbose("check vpnconnection done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3265,40 +3177,33 @@ func (cmd *CreateFunction) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *CreateFunction) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("function"), nil
+func (cmd *CheckVpnconnection) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("vpnconnection"), nil
 }
 
-func (cmd *CreateFunction) inject(params map[string]interface{}) error {
+func (cmd *CheckVpnconnection) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateGroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateGroup {
-	cmd := new(CreateGroup)
+func NewCopyImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CopyImage {
+	cmd := new(CopyImage)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateGroup) SetApi(api iamiface.IAMAPI) {
+func (cmd *CopyImage) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateGroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateGroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CopyImage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -3309,13 +3214,7 @@ func (cmd *CreateGroup) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &iam.CreateGroupInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.CreateGroupInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CreateGroup(input)
-	renv.Log().ExtraVerbosef("iam.CreateGroup call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -3325,14 +3224,14 @@ func (cmd *CreateGroup) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create group: AWS command returned nil output")
+			renv.Log().Warning("copy image: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create group '%s' done", extracted)
+		renv.Log().Verbosef("copy image '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create group done")
+		renv.Log().Verbose("copy image done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3344,16 +3243,12 @@ func (cmd *CreateGroup) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *CreateGroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("group"), nil
-}
-
-func (cmd *CreateGroup) inject(params map[string]interface{}) error {
+func (cmd *CopyImage) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateImage {
-	cmd := new(CreateImage)
+func NewCopySnapshot(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CopySnapshot {
+	cmd := new(CopySnapshot)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -3366,18 +3261,11 @@ func NewCreateImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger
 	return cmd
 }
 
-func (cmd *CreateImage) SetApi(api ec2iface.EC2API) {
+func (cmd *CopySnapshot) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateImage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateImage) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CopySnapshot) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -3388,13 +3276,13 @@ func (cmd *CreateImage) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &ec2.CreateImageInput{}
+	input := &ec2.CopySnapshotInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateImageInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CopySnapshotInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateImage(input)
-	renv.Log().ExtraVerbosef("ec2.CreateImage call took %s", time.Since(start))
+	output, err := cmd.api.CopySnapshot(input)
+	renv.Log().ExtraVerbosef("ec2.CopySnapshot call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -3404,14 +3292,14 @@ func (cmd *CreateImage) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create image: AWS command returned nil output")
+			renv.Log().Warning("copy snapshot: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create image '%s' done", extracted)
+		renv.Log().Verbosef("copy snapshot '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create image done")
+		renv.Log().Verbose("copy snapshot done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3423,61 +3311,54 @@ func (cmd *CreateImage) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *CreateImage) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CopySnapshot) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
 
-	input := &ec2.CreateImageInput{}
+	input := &ec2.CopySnapshotInput{}
 	input.SetDryRun(true)
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateImageInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CopySnapshotInput: %s", err)
 	}
 
 	start := time.Now()
-	_, err := cmd.api.CreateImage(input)
+	_, err := cmd.api.CopySnapshot(input)
 	if awsErr, ok := err.(awserr.Error); ok {
 		switch code := awsErr.Code(); {
 		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CreateImage call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create image ok")
-			return fakeDryRunId("image"), nil
+			renv.Log().ExtraVerbosef("dry run: ec2.CopySnapshot call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: copy snapshot ok")
+			return fakeDryRunId("snapshot"), nil
 		}
 	}
 
 	return nil, err
 }
 
-func (cmd *CreateImage) inject(params map[string]interface{}) error {
+func (cmd *CopySnapshot) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateInstance {
-	cmd := new(CreateInstance)
+func NewCreateAccesskey(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateAccesskey {
+	cmd := new(CreateAccesskey)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateInstance) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateAccesskey) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateAccesskey) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -3488,13 +3369,13 @@ func (cmd *CreateInstance) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &ec2.RunInstancesInput{}
+	input := &iam.CreateAccessKeyInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.RunInstancesInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in iam.CreateAccessKeyInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.RunInstances(input)
-	renv.Log().ExtraVerbosef("ec2.RunInstances call took %s", time.Since(start))
+	output, err := cmd.api.CreateAccessKey(input)
+	renv.Log().ExtraVerbosef("iam.CreateAccessKey call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -3504,14 +3385,14 @@ func (cmd *CreateInstance) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create instance: AWS command returned nil output")
+			renv.Log().Warning("create accesskey: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create instance '%s' done", extracted)
+		renv.Log().Verbosef("create accesskey '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create instance done")
+		renv.Log().Verbose("create accesskey done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3523,61 +3404,33 @@ func (cmd *CreateInstance) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *CreateInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.RunInstancesInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.RunInstancesInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.RunInstances(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.RunInstances call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create instance ok")
-			return fakeDryRunId("instance"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CreateAccesskey) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("accesskey"), nil
 }
 
-func (cmd *CreateInstance) inject(params map[string]interface{}) error {
+func (cmd *CreateAccesskey) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateInstanceprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateInstanceprofile {
-	cmd := new(CreateInstanceprofile)
+func NewCreateAccount(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateAccount {
+	cmd := new(CreateAccount)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = organizations.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateInstanceprofile) SetApi(api iamiface.IAMAPI) {
+func (cmd *CreateAccount) SetApi(api organizationsiface.OrganizationsAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateInstanceprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateInstanceprofile) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateAccount) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -3588,13 +3441,7 @@ func (cmd *CreateInstanceprofile) run(renv env.Running, params map[string]interf
 		}
 	}
 
-	input := &iam.CreateInstanceProfileInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.CreateInstanceProfileInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CreateInstanceProfile(input)
-	renv.Log().ExtraVerbosef("iam.CreateInstanceProfile call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -3604,14 +3451,14 @@ func (cmd *CreateInstanceprofile) run(renv env.Running, params map[string]interf
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create instanceprofile: AWS command returned nil output")
+			renv.Log().Warning("create account: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create instanceprofile '%s' done", extracted)
+		renv.Log().Verbosef("create account '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create instanceprofile done")
+		renv.Log().Verbose("create account done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3623,40 +3470,33 @@ func (cmd *CreateInstanceprofile) run(renv env.Running, params map[string]interf
 	return extracted, nil
 }
 
-func (cmd *CreateInstanceprofile) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("instanceprofile"), nil
+func (cmd *CreateAccount) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("account"), nil
 }
 
-func (cmd *CreateInstanceprofile) inject(params map[string]interface{}) error {
+func (cmd *CreateAccount) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateInternetgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateInternetgateway {
-	cmd := new(CreateInternetgateway)
+func NewCreateAlarm(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateAlarm {
+	cmd := new(CreateAlarm)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = cloudwatch.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateInternetgateway) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateAlarm) SetApi(api cloudwatchiface.CloudWatchAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateInternetgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateInternetgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateAlarm) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -3667,13 +3507,13 @@ func (cmd *CreateInternetgateway) run(renv env.Running, params map[string]interf
 		}
 	}
 
-	input := &ec2.CreateInternetGatewayInput{}
+	input := &cloudwatch.PutMetricAlarmInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateInternetGatewayInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in cloudwatch.PutMetricAlarmInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateInternetGateway(input)
-	renv.Log().ExtraVerbosef("ec2.CreateInternetGateway call took %s", time.Since(start))
+	output, err := cmd.api.PutMetricAlarm(input)
+	renv.Log().ExtraVerbosef("cloudwatch.PutMetricAlarm call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -3683,14 +3523,14 @@ func (cmd *CreateInternetgateway) run(renv env.Running, params map[string]interf
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create internetgateway: AWS command returned nil output")
+			renv.Log().Warning("create alarm: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create internetgateway '%s' done", extracted)
+		renv.Log().Verbosef("create alarm '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create internetgateway done")
+		renv.Log().Verbose("create alarm done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3702,61 +3542,33 @@ func (cmd *CreateInternetgateway) run(renv env.Running, params map[string]interf
 	return extracted, nil
 }
 
-func (cmd *CreateInternetgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.CreateInternetGatewayInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateInternetGatewayInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.CreateInternetGateway(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CreateInternetGateway call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create internetgateway ok")
-			return fakeDryRunId("internetgateway"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CreateAlarm) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("alarm"), nil
 }
 
-func (cmd *CreateInternetgateway) inject(params map[string]interface{}) error {
+func (cmd *CreateAlarm) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateKeypair(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateKeypair {
-	cmd := new(CreateKeypair)
+func NewCreateAlias(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateAlias {
+	cmd := new(CreateAlias)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = kms.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateKeypair) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateAlias) SetApi(api kmsiface.KMSAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateKeypair) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateKeypair) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateAlias) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -3767,13 +3579,13 @@ func (cmd *CreateKeypair) run(renv env.Running, params map[string]interface{}) (
 		}
 	}
 
-	input := &ec2.ImportKeyPairInput{}
+	input := &kms.CreateAliasInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.ImportKeyPairInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in kms.CreateAliasInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.ImportKeyPair(input)
-	renv.Log().ExtraVerbosef("ec2.ImportKeyPair call took %s", time.Since(start))
+	output, err := cmd.api.CreateAlias(input)
+	renv.Log().ExtraVerbosef("kms.CreateAlias call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -3783,14 +3595,14 @@ func (cmd *CreateKeypair) run(renv env.Running, params map[string]interface{}) (
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create keypair: AWS command returned nil output")
+			renv.Log().Warning("create alias: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create keypair '%s' done", extracted)
+		renv.Log().Verbosef("create alias '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create keypair done")
+		renv.Log().Verbose("create alias done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3802,40 +3614,33 @@ func (cmd *CreateKeypair) run(renv env.Running, params map[string]interface{}) (
 	return extracted, nil
 }
 
-func (cmd *CreateKeypair) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("keypair"), nil
+func (cmd *CreateAlias) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("alias"), nil
 }
 
-func (cmd *CreateKeypair) inject(params map[string]interface{}) error {
+func (cmd *CreateAlias) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateLaunchconfiguration(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateLaunchconfiguration {
-	cmd := new(CreateLaunchconfiguration)
+func NewCreateApplication(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateApplication {
+	cmd := new(CreateApplication)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = autoscaling.New(sess)
+		cmd.api = elasticbeanstalk.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateLaunchconfiguration) SetApi(api autoscalingiface.AutoScalingAPI) {
+func (cmd *CreateApplication) SetApi(api elasticbeanstalkiface.ElasticBeanstalkAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateLaunchconfiguration) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateLaunchconfiguration) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateApplication) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -3846,13 +3651,13 @@ func (cmd *CreateLaunchconfiguration) run(renv env.Running, params map[string]in
 		}
 	}
 
-	input := &autoscaling.CreateLaunchConfigurationInput{}
+	input := &elasticbeanstalk.CreateApplicationInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in autoscaling.CreateLaunchConfigurationInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in elasticbeanstalk.CreateApplicationInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateLaunchConfiguration(input)
-	renv.Log().ExtraVerbosef("autoscaling.CreateLaunchConfiguration call took %s", time.Since(start))
+	output, err := cmd.api.CreateApplication(input)
+	renv.Log().ExtraVerbosef("elasticbeanstalk.CreateApplication call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -3862,14 +3667,14 @@ func (cmd *CreateLaunchconfiguration) run(renv env.Running, params map[string]in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create launchconfiguration: AWS command returned nil output")
+			renv.Log().Warning("create application: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create launchconfiguration '%s' done", extracted)
+		renv.Log().Verbosef("create application '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create launchconfiguration done")
+		renv.Log().Verbose("create application done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3881,40 +3686,33 @@ func (cmd *CreateLaunchconfiguration) run(renv env.Running, params map[string]in
 	return extracted, nil
 }
 
-func (cmd *CreateLaunchconfiguration) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("launchconfiguration"), nil
+func (cmd *CreateApplication) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("application"), nil
 }
 
-func (cmd *CreateLaunchconfiguration) inject(params map[string]interface{}) error {
+func (cmd *CreateApplication) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateListener(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateListener {
-	cmd := new(CreateListener)
+func NewCreateAppscalingpolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateAppscalingpolicy {
+	cmd := new(CreateAppscalingpolicy)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elbv2.New(sess)
+		cmd.api = applicationautoscaling.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateListener) SetApi(api elbv2iface.ELBV2API) {
+func (cmd *CreateAppscalingpolicy) SetApi(api applicationautoscalingiface.ApplicationAutoScalingAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateListener) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateListener) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateAppscalingpolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -3925,13 +3723,13 @@ func (cmd *CreateListener) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &elbv2.CreateListenerInput{}
+	input := &applicationautoscaling.PutScalingPolicyInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in elbv2.CreateListenerInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in applicationautoscaling.PutScalingPolicyInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateListener(input)
-	renv.Log().ExtraVerbosef("elbv2.CreateListener call took %s", time.Since(start))
+	output, err := cmd.api.PutScalingPolicy(input)
+	renv.Log().ExtraVerbosef("applicationautoscaling.PutScalingPolicy call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -3941,14 +3739,14 @@ func (cmd *CreateListener) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create listener: AWS command returned nil output")
+			renv.Log().Warning("create appscalingpolicy: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create listener '%s' done", extracted)
+		renv.Log().Verbosef("create appscalingpolicy '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create listener done")
+		renv.Log().Verbose("create appscalingpolicy done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3960,40 +3758,33 @@ func (cmd *CreateListener) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *CreateListener) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("listener"), nil
+func (cmd *CreateAppscalingpolicy) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("appscalingpolicy"), nil
 }
 
-func (cmd *CreateListener) inject(params map[string]interface{}) error {
+func (cmd *CreateAppscalingpolicy) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateLoadbalancer {
-	cmd := new(CreateLoadbalancer)
+func NewCreateAppscalingtarget(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateAppscalingtarget {
+	cmd := new(CreateAppscalingtarget)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elbv2.New(sess)
+		cmd.api = applicationautoscaling.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateLoadbalancer) SetApi(api elbv2iface.ELBV2API) {
+func (cmd *CreateAppscalingtarget) SetApi(api applicationautoscalingiface.ApplicationAutoScalingAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateLoadbalancer) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateAppscalingtarget) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4004,13 +3795,13 @@ func (cmd *CreateLoadbalancer) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	input := &elbv2.CreateLoadBalancerInput{}
+	input := &applicationautoscaling.RegisterScalableTargetInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in elbv2.CreateLoadBalancerInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in applicationautoscaling.RegisterScalableTargetInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateLoadBalancer(input)
-	renv.Log().ExtraVerbosef("elbv2.CreateLoadBalancer call took %s", time.Since(start))
+	output, err := cmd.api.RegisterScalableTarget(input)
+	renv.Log().ExtraVerbosef("applicationautoscaling.RegisterScalableTarget call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -4020,14 +3811,14 @@ func (cmd *CreateLoadbalancer) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create loadbalancer: AWS command returned nil output")
+			renv.Log().Warning("create appscalingtarget: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create loadbalancer '%s' done", extracted)
+		renv.Log().Verbosef("create appscalingtarget '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create loadbalancer done")
+		renv.Log().Verbose("create appscalingtarget done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4039,40 +3830,33 @@ func (cmd *CreateLoadbalancer) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *CreateLoadbalancer) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("loadbalancer"), nil
+func (cmd *CreateAppscalingtarget) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("appscalingtarget"), nil
 }
 
-func (cmd *CreateLoadbalancer) inject(params map[string]interface{}) error {
+func (cmd *CreateAppscalingtarget) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateLoginprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateLoginprofile {
-	cmd := new(CreateLoginprofile)
+func NewCreateBucket(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateBucket {
+	cmd := new(CreateBucket)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = s3.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateLoginprofile) SetApi(api iamiface.IAMAPI) {
+func (cmd *CreateBucket) SetApi(api s3iface.S3API) {
 	cmd.api = api
 }
 
-func (cmd *CreateLoginprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateLoginprofile) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateBucket) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4083,13 +3867,13 @@ func (cmd *CreateLoginprofile) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	input := &iam.CreateLoginProfileInput{}
+	input := &s3.CreateBucketInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.CreateLoginProfileInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in s3.CreateBucketInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateLoginProfile(input)
-	renv.Log().ExtraVerbosef("iam.CreateLoginProfile call took %s", time.Since(start))
+	output, err := cmd.api.CreateBucket(input)
+	renv.Log().ExtraVerbosef("s3.CreateBucket call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -4099,14 +3883,14 @@ func (cmd *CreateLoginprofile) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create loginprofile: AWS command returned nil output")
+			renv.Log().Warning("create bucket: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create loginprofile '%s' done", extracted)
+		renv.Log().Verbosef("create bucket '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create loginprofile done")
+		renv.Log().Verbose("create bucket done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4118,40 +3902,33 @@ func (cmd *CreateLoginprofile) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *CreateLoginprofile) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("loginprofile"), nil
+func (cmd *CreateBucket) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("bucket"), nil
 }
 
-func (cmd *CreateLoginprofile) inject(params map[string]interface{}) error {
+func (cmd *CreateBucket) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateMfadevice(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateMfadevice {
-	cmd := new(CreateMfadevice)
+func NewCreateCachecluster(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateCachecluster {
+	cmd := new(CreateCachecluster)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = elasticache.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateMfadevice) SetApi(api iamiface.IAMAPI) {
+func (cmd *CreateCachecluster) SetApi(api elasticacheiface.ElastiCacheAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateMfadevice) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateMfadevice) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateCachecluster) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4162,7 +3939,13 @@ func (cmd *CreateMfadevice) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &elasticache.CreateCacheClusterInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in elasticache.CreateCacheClusterInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateCacheCluster(input)
+	renv.Log().ExtraVerbosef("elasticache.CreateCacheCluster call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -4172,14 +3955,14 @@ func (cmd *CreateMfadevice) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create mfadevice: AWS command returned nil output")
+			renv.Log().Warning("create cachecluster: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create mfadevice '%s' done", extracted)
+		renv.Log().Verbosef("create cachecluster '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create mfadevice done")
+		renv.Log().Verbose("create cachecluster done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4191,40 +3974,33 @@ func (cmd *CreateMfadevice) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *CreateMfadevice) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("mfadevice"), nil
+func (cmd *CreateCachecluster) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("cachecluster"), nil
 }
 
-func (cmd *CreateMfadevice) inject(params map[string]interface{}) error {
+func (cmd *CreateCachecluster) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateNatgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateNatgateway {
-	cmd := new(CreateNatgateway)
+func NewCreateCachesubnetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateCachesubnetgroup {
+	cmd := new(CreateCachesubnetgroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = elasticache.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateNatgateway) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateCachesubnetgroup) SetApi(api elasticacheiface.ElastiCacheAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateNatgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateNatgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateCachesubnetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4235,13 +4011,13 @@ func (cmd *CreateNatgateway) run(renv env.Running, params map[string]interface{}
 		}
 	}
 
-	input := &ec2.CreateNatGatewayInput{}
+	input := &elasticache.CreateCacheSubnetGroupInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateNatGatewayInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in elasticache.CreateCacheSubnetGroupInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateNatGateway(input)
-	renv.Log().ExtraVerbosef("ec2.CreateNatGateway call took %s", time.Since(start))
+	output, err := cmd.api.CreateCacheSubnetGroup(input)
+	renv.Log().ExtraVerbosef("elasticache.CreateCacheSubnetGroup call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -4251,14 +4027,14 @@ func (cmd *CreateNatgateway) run(renv env.Running, params map[string]interface{}
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create natgateway: AWS command returned nil output")
+			renv.Log().Warning("create cachesubnetgroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create natgateway '%s' done", extracted)
+		renv.Log().Verbosef("create cachesubnetgroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create natgateway done")
+		renv.Log().Verbose("create cachesubnetgroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4270,40 +4046,33 @@ func (cmd *CreateNatgateway) run(renv env.Running, params map[string]interface{}
 	return extracted, nil
 }
 
-func (cmd *CreateNatgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("natgateway"), nil
+func (cmd *CreateCachesubnetgroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("cachesubnetgroup"), nil
 }
 
-func (cmd *CreateNatgateway) inject(params map[string]interface{}) error {
+func (cmd *CreateCachesubnetgroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateNetworkinterface(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateNetworkinterface {
-	cmd := new(CreateNetworkinterface)
+func NewCreateCatalogdatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateCatalogdatabase {
+	cmd := new(CreateCatalogdatabase)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = glue.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateNetworkinterface) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateCatalogdatabase) SetApi(api glueiface.GlueAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateNetworkinterface) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateNetworkinterface) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateCatalogdatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4314,13 +4083,13 @@ func (cmd *CreateNetworkinterface) run(renv env.Running, params map[string]inter
 		}
 	}
 
-	input := &ec2.CreateNetworkInterfaceInput{}
+	input := &glue.CreateDatabaseInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateNetworkInterfaceInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in glue.CreateDatabaseInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateNetworkInterface(input)
-	renv.Log().ExtraVerbosef("ec2.CreateNetworkInterface call took %s", time.Since(start))
+	output, err := cmd.api.CreateDatabase(input)
+	renv.Log().ExtraVerbosef("glue.CreateDatabase call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -4330,14 +4099,14 @@ func (cmd *CreateNetworkinterface) run(renv env.Running, params map[string]inter
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create networkinterface: AWS command returned nil output")
+			renv.Log().Warning("create catalogdatabase: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create networkinterface '%s' done", extracted)
+		renv.Log().Verbosef("create catalogdatabase '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create networkinterface done")
+		renv.Log().Verbose("create catalogdatabase done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4349,61 +4118,33 @@ func (cmd *CreateNetworkinterface) run(renv env.Running, params map[string]inter
 	return extracted, nil
 }
 
-func (cmd *CreateNetworkinterface) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.CreateNetworkInterfaceInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateNetworkInterfaceInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.CreateNetworkInterface(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CreateNetworkInterface call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create networkinterface ok")
-			return fakeDryRunId("networkinterface"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CreateCatalogdatabase) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("catalogdatabase"), nil
 }
 
-func (cmd *CreateNetworkinterface) inject(params map[string]interface{}) error {
+func (cmd *CreateCatalogdatabase) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreatePolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreatePolicy {
-	cmd := new(CreatePolicy)
+func NewCreateCertificate(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateCertificate {
+	cmd := new(CreateCertificate)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = acm.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreatePolicy) SetApi(api iamiface.IAMAPI) {
+func (cmd *CreateCertificate) SetApi(api acmiface.ACMAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreatePolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreatePolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateCertificate) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4414,13 +4155,7 @@ func (cmd *CreatePolicy) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &iam.CreatePolicyInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.CreatePolicyInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CreatePolicy(input)
-	renv.Log().ExtraVerbosef("iam.CreatePolicy call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -4430,14 +4165,14 @@ func (cmd *CreatePolicy) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create policy: AWS command returned nil output")
+			renv.Log().Warning("create certificate: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create policy '%s' done", extracted)
+		renv.Log().Verbosef("create certificate '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create policy done")
+		renv.Log().Verbose("create certificate done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4449,40 +4184,33 @@ func (cmd *CreatePolicy) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *CreatePolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("policy"), nil
+func (cmd *CreateCertificate) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("certificate"), nil
 }
 
-func (cmd *CreatePolicy) inject(params map[string]interface{}) error {
+func (cmd *CreateCertificate) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateQueue(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateQueue {
-	cmd := new(CreateQueue)
+func NewCreateClassicloadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateClassicloadbalancer {
+	cmd := new(CreateClassicloadbalancer)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = sqs.New(sess)
+		cmd.api = elb.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateQueue) SetApi(api sqsiface.SQSAPI) {
+func (cmd *CreateClassicloadbalancer) SetApi(api elbiface.ELBAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateQueue) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateQueue) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateClassicloadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4493,13 +4221,13 @@ func (cmd *CreateQueue) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &sqs.CreateQueueInput{}
+	input := &elb.CreateLoadBalancerInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in sqs.CreateQueueInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in elb.CreateLoadBalancerInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateQueue(input)
-	renv.Log().ExtraVerbosef("sqs.CreateQueue call took %s", time.Since(start))
+	output, err := cmd.api.CreateLoadBalancer(input)
+	renv.Log().ExtraVerbosef("elb.CreateLoadBalancer call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -4509,14 +4237,14 @@ func (cmd *CreateQueue) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create queue: AWS command returned nil output")
+			renv.Log().Warning("create classicloadbalancer: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create queue '%s' done", extracted)
+		renv.Log().Verbosef("create classicloadbalancer '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create queue done")
+		renv.Log().Verbose("create classicloadbalancer done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4528,40 +4256,33 @@ func (cmd *CreateQueue) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *CreateQueue) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("queue"), nil
+func (cmd *CreateClassicloadbalancer) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("classicloadbalancer"), nil
 }
 
-func (cmd *CreateQueue) inject(params map[string]interface{}) error {
+func (cmd *CreateClassicloadbalancer) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateRecord(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRecord {
-	cmd := new(CreateRecord)
+func NewCreateCluster(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateCluster {
+	cmd := new(CreateCluster)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = route53.New(sess)
+		cmd.api = redshift.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateRecord) SetApi(api route53iface.Route53API) {
+func (cmd *CreateCluster) SetApi(api redshiftiface.RedshiftAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateRecord) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateRecord) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateCluster) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4572,7 +4293,13 @@ func (cmd *CreateRecord) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &redshift.CreateClusterInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in redshift.CreateClusterInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateCluster(input)
+	renv.Log().ExtraVerbosef("redshift.CreateCluster call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -4582,14 +4309,14 @@ func (cmd *CreateRecord) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create record: AWS command returned nil output")
+			renv.Log().Warning("create cluster: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create record '%s' done", extracted)
+		renv.Log().Verbosef("create cluster '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create record done")
+		renv.Log().Verbose("create cluster done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4601,40 +4328,33 @@ func (cmd *CreateRecord) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *CreateRecord) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("record"), nil
+func (cmd *CreateCluster) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("cluster"), nil
 }
 
-func (cmd *CreateRecord) inject(params map[string]interface{}) error {
+func (cmd *CreateCluster) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateRepository(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRepository {
-	cmd := new(CreateRepository)
+func NewCreateComputeenvironment(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateComputeenvironment {
+	cmd := new(CreateComputeenvironment)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ecr.New(sess)
+		cmd.api = batch.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateRepository) SetApi(api ecriface.ECRAPI) {
+func (cmd *CreateComputeenvironment) SetApi(api batchiface.BatchAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateRepository) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateRepository) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateComputeenvironment) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4645,13 +4365,13 @@ func (cmd *CreateRepository) run(renv env.Running, params map[string]interface{}
 		}
 	}
 
-	input := &ecr.CreateRepositoryInput{}
+	input := &batch.CreateComputeEnvironmentInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ecr.CreateRepositoryInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in batch.CreateComputeEnvironmentInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateRepository(input)
-	renv.Log().ExtraVerbosef("ecr.CreateRepository call took %s", time.Since(start))
+	output, err := cmd.api.CreateComputeEnvironment(input)
+	renv.Log().ExtraVerbosef("batch.CreateComputeEnvironment call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -4661,14 +4381,14 @@ func (cmd *CreateRepository) run(renv env.Running, params map[string]interface{}
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create repository: AWS command returned nil output")
+			renv.Log().Warning("create computeenvironment: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create repository '%s' done", extracted)
+		renv.Log().Verbosef("create computeenvironment '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create repository done")
+		renv.Log().Verbose("create computeenvironment done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4680,40 +4400,33 @@ func (cmd *CreateRepository) run(renv env.Running, params map[string]interface{}
 	return extracted, nil
 }
 
-func (cmd *CreateRepository) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("repository"), nil
+func (cmd *CreateComputeenvironment) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("computeenvironment"), nil
 }
 
-func (cmd *CreateRepository) inject(params map[string]interface{}) error {
+func (cmd *CreateComputeenvironment) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateRole(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRole {
-	cmd := new(CreateRole)
+func NewCreateContainercluster(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateContainercluster {
+	cmd := new(CreateContainercluster)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = ecs.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateRole) SetApi(api iamiface.IAMAPI) {
+func (cmd *CreateContainercluster) SetApi(api ecsiface.ECSAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateRole) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateRole) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateContainercluster) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4724,7 +4437,13 @@ func (cmd *CreateRole) run(renv env.Running, params map[string]interface{}) (int
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &ecs.CreateClusterInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ecs.CreateClusterInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateCluster(input)
+	renv.Log().ExtraVerbosef("ecs.CreateCluster call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -4734,14 +4453,14 @@ func (cmd *CreateRole) run(renv env.Running, params map[string]interface{}) (int
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create role: AWS command returned nil output")
+			renv.Log().Warning("create containercluster: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create role '%s' done", extracted)
+		renv.Log().Verbosef("create containercluster '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create role done")
+		renv.Log().Verbose("create containercluster done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4753,40 +4472,33 @@ func (cmd *CreateRole) run(renv env.Running, params map[string]interface{}) (int
 	return extracted, nil
 }
 
-func (cmd *CreateRole) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("role"), nil
+func (cmd *CreateContainercluster) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("containercluster"), nil
 }
 
-func (cmd *CreateRole) inject(params map[string]interface{}) error {
+func (cmd *CreateContainercluster) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateRoute(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRoute {
-	cmd := new(CreateRoute)
+func NewCreateContainerservice(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateContainerservice {
+	cmd := new(CreateContainerservice)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = ecs.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateRoute) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateContainerservice) SetApi(api ecsiface.ECSAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateRoute) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateRoute) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateContainerservice) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4797,13 +4509,7 @@ func (cmd *CreateRoute) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &ec2.CreateRouteInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateRouteInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CreateRoute(input)
-	renv.Log().ExtraVerbosef("ec2.CreateRoute call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -4813,14 +4519,14 @@ func (cmd *CreateRoute) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create route: AWS command returned nil output")
+			renv.Log().Warning("create containerservice: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create route '%s' done", extracted)
+		renv.Log().Verbosef("create containerservice '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create route done")
+		renv.Log().Verbose("create containerservice done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4832,61 +4538,33 @@ func (cmd *CreateRoute) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *CreateRoute) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.CreateRouteInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateRouteInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.CreateRoute(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CreateRoute call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create route ok")
-			return fakeDryRunId("route"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CreateContainerservice) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("containerservice"), nil
 }
 
-func (cmd *CreateRoute) inject(params map[string]interface{}) error {
+func (cmd *CreateContainerservice) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateRoutetable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRoutetable {
-	cmd := new(CreateRoutetable)
+func NewCreateCrawler(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateCrawler {
+	cmd := new(CreateCrawler)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = glue.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateRoutetable) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateCrawler) SetApi(api glueiface.GlueAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateRoutetable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateRoutetable) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateCrawler) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4897,13 +4575,13 @@ func (cmd *CreateRoutetable) run(renv env.Running, params map[string]interface{}
 		}
 	}
 
-	input := &ec2.CreateRouteTableInput{}
+	input := &glue.CreateCrawlerInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateRouteTableInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in glue.CreateCrawlerInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateRouteTable(input)
-	renv.Log().ExtraVerbosef("ec2.CreateRouteTable call took %s", time.Since(start))
+	output, err := cmd.api.CreateCrawler(input)
+	renv.Log().ExtraVerbosef("glue.CreateCrawler call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -4913,14 +4591,14 @@ func (cmd *CreateRoutetable) run(renv env.Running, params map[string]interface{}
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create routetable: AWS command returned nil output")
+			renv.Log().Warning("create crawler: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create routetable '%s' done", extracted)
+		renv.Log().Verbosef("create crawler '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create routetable done")
+		renv.Log().Verbose("create crawler done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4932,61 +4610,33 @@ func (cmd *CreateRoutetable) run(renv env.Running, params map[string]interface{}
 	return extracted, nil
 }
 
-func (cmd *CreateRoutetable) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
+func (cmd *CreateCrawler) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("crawler"), nil
+}
 
-	input := &ec2.CreateRouteTableInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateRouteTableInput: %s", err)
-	}
+func (cmd *CreateCrawler) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
 
-	start := time.Now()
-	_, err := cmd.api.CreateRouteTable(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CreateRouteTable call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create routetable ok")
-			return fakeDryRunId("routetable"), nil
-		}
-	}
-
-	return nil, err
-}
-
-func (cmd *CreateRoutetable) inject(params map[string]interface{}) error {
-	return structSetter(cmd, params)
-}
-
-func NewCreateS3object(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateS3object {
-	cmd := new(CreateS3object)
+func NewCreateCustomergateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateCustomergateway {
+	cmd := new(CreateCustomergateway)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = s3.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateS3object) SetApi(api s3iface.S3API) {
+func (cmd *CreateCustomergateway) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateS3object) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateS3object) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateCustomergateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4997,7 +4647,13 @@ func (cmd *CreateS3object) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &ec2.CreateCustomerGatewayInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateCustomerGatewayInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateCustomerGateway(input)
+	renv.Log().ExtraVerbosef("ec2.CreateCustomerGateway call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -5007,14 +4663,14 @@ func (cmd *CreateS3object) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create s3object: AWS command returned nil output")
+			renv.Log().Warning("create customergateway: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create s3object '%s' done", extracted)
+		renv.Log().Verbosef("create customergateway '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create s3object done")
+		renv.Log().Verbose("create customergateway done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5026,40 +4682,54 @@ func (cmd *CreateS3object) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *CreateS3object) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("s3object"), nil
+func (cmd *CreateCustomergateway) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreateCustomerGatewayInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateCustomerGatewayInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateCustomerGateway(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateCustomerGateway call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create customergateway ok")
+			return fakeDryRunId("customergateway"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *CreateS3object) inject(params map[string]interface{}) error {
+func (cmd *CreateCustomergateway) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateScalinggroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateScalinggroup {
-	cmd := new(CreateScalinggroup)
+func NewCreateDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateDatabase {
+	cmd := new(CreateDatabase)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = autoscaling.New(sess)
+		cmd.api = rds.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateScalinggroup) SetApi(api autoscalingiface.AutoScalingAPI) {
+func (cmd *CreateDatabase) SetApi(api rdsiface.RDSAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateScalinggroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateScalinggroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5070,13 +4740,7 @@ func (cmd *CreateScalinggroup) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	input := &autoscaling.CreateAutoScalingGroupInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in autoscaling.CreateAutoScalingGroupInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CreateAutoScalingGroup(input)
-	renv.Log().ExtraVerbosef("autoscaling.CreateAutoScalingGroup call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -5086,14 +4750,14 @@ func (cmd *CreateScalinggroup) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create scalinggroup: AWS command returned nil output")
+			renv.Log().Warning("create database: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create scalinggroup '%s' done", extracted)
+		renv.Log().Verbosef("create database '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create scalinggroup done")
+		renv.Log().Verbose("create database done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5105,40 +4769,33 @@ func (cmd *CreateScalinggroup) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *CreateScalinggroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("scalinggroup"), nil
+func (cmd *CreateDatabase) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("database"), nil
 }
 
-func (cmd *CreateScalinggroup) inject(params map[string]interface{}) error {
+func (cmd *CreateDatabase) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateScalingpolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateScalingpolicy {
-	cmd := new(CreateScalingpolicy)
+func NewCreateDbparametergroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateDbparametergroup {
+	cmd := new(CreateDbparametergroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = autoscaling.New(sess)
+		cmd.api = rds.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateScalingpolicy) SetApi(api autoscalingiface.AutoScalingAPI) {
+func (cmd *CreateDbparametergroup) SetApi(api rdsiface.RDSAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateScalingpolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateScalingpolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateDbparametergroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5149,13 +4806,13 @@ func (cmd *CreateScalingpolicy) run(renv env.Running, params map[string]interfac
 		}
 	}
 
-	input := &autoscaling.PutScalingPolicyInput{}
+	input := &rds.CreateDBParameterGroupInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in autoscaling.PutScalingPolicyInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in rds.CreateDBParameterGroupInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.PutScalingPolicy(input)
-	renv.Log().ExtraVerbosef("autoscaling.PutScalingPolicy call took %s", time.Since(start))
+	output, err := cmd.api.CreateDBParameterGroup(input)
+	renv.Log().ExtraVerbosef("rds.CreateDBParameterGroup call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -5165,14 +4822,14 @@ func (cmd *CreateScalingpolicy) run(renv env.Running, params map[string]interfac
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create scalingpolicy: AWS command returned nil output")
+			renv.Log().Warning("create dbparametergroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create scalingpolicy '%s' done", extracted)
+		renv.Log().Verbosef("create dbparametergroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create scalingpolicy done")
+		renv.Log().Verbose("create dbparametergroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5184,40 +4841,33 @@ func (cmd *CreateScalingpolicy) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *CreateScalingpolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("scalingpolicy"), nil
+func (cmd *CreateDbparametergroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("dbparametergroup"), nil
 }
 
-func (cmd *CreateScalingpolicy) inject(params map[string]interface{}) error {
+func (cmd *CreateDbparametergroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateSecuritygroup {
-	cmd := new(CreateSecuritygroup)
+func NewCreateDbsubnetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateDbsubnetgroup {
+	cmd := new(CreateDbsubnetgroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = rds.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateSecuritygroup) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateDbsubnetgroup) SetApi(api rdsiface.RDSAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateSecuritygroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateSecuritygroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateDbsubnetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5228,13 +4878,13 @@ func (cmd *CreateSecuritygroup) run(renv env.Running, params map[string]interfac
 		}
 	}
 
-	input := &ec2.CreateSecurityGroupInput{}
+	input := &rds.CreateDBSubnetGroupInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateSecurityGroupInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in rds.CreateDBSubnetGroupInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateSecurityGroup(input)
-	renv.Log().ExtraVerbosef("ec2.CreateSecurityGroup call took %s", time.Since(start))
+	output, err := cmd.api.CreateDBSubnetGroup(input)
+	renv.Log().ExtraVerbosef("rds.CreateDBSubnetGroup call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -5244,14 +4894,14 @@ func (cmd *CreateSecuritygroup) run(renv env.Running, params map[string]interfac
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create securitygroup: AWS command returned nil output")
+			renv.Log().Warning("create dbsubnetgroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create securitygroup '%s' done", extracted)
+		renv.Log().Verbosef("create dbsubnetgroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create securitygroup done")
+		renv.Log().Verbose("create dbsubnetgroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5263,61 +4913,33 @@ func (cmd *CreateSecuritygroup) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *CreateSecuritygroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.CreateSecurityGroupInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateSecurityGroupInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.CreateSecurityGroup(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CreateSecurityGroup call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create securitygroup ok")
-			return fakeDryRunId("securitygroup"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CreateDbsubnetgroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("dbsubnetgroup"), nil
 }
 
-func (cmd *CreateSecuritygroup) inject(params map[string]interface{}) error {
+func (cmd *CreateDbsubnetgroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateSnapshot(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateSnapshot {
-	cmd := new(CreateSnapshot)
+func NewCreateDeployment(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateDeployment {
+	cmd := new(CreateDeployment)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = apigateway.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateSnapshot) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateDeployment) SetApi(api apigatewayiface.APIGatewayAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateSnapshot) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateSnapshot) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateDeployment) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5328,13 +4950,13 @@ func (cmd *CreateSnapshot) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &ec2.CreateSnapshotInput{}
+	input := &apigateway.CreateDeploymentInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateSnapshotInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in apigateway.CreateDeploymentInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateSnapshot(input)
-	renv.Log().ExtraVerbosef("ec2.CreateSnapshot call took %s", time.Since(start))
+	output, err := cmd.api.CreateDeployment(input)
+	renv.Log().ExtraVerbosef("apigateway.CreateDeployment call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -5344,14 +4966,14 @@ func (cmd *CreateSnapshot) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create snapshot: AWS command returned nil output")
+			renv.Log().Warning("create deployment: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create snapshot '%s' done", extracted)
+		renv.Log().Verbosef("create deployment '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create snapshot done")
+		renv.Log().Verbose("create deployment done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5363,61 +4985,33 @@ func (cmd *CreateSnapshot) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *CreateSnapshot) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.CreateSnapshotInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateSnapshotInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.CreateSnapshot(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CreateSnapshot call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create snapshot ok")
-			return fakeDryRunId("snapshot"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CreateDeployment) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("deployment"), nil
 }
 
-func (cmd *CreateSnapshot) inject(params map[string]interface{}) error {
+func (cmd *CreateDeployment) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateStack(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateStack {
-	cmd := new(CreateStack)
+func NewCreateDistribution(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateDistribution {
+	cmd := new(CreateDistribution)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudformation.New(sess)
+		cmd.api = cloudfront.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateStack) SetApi(api cloudformationiface.CloudFormationAPI) {
+func (cmd *CreateDistribution) SetApi(api cloudfrontiface.CloudFrontAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateStack) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateStack) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateDistribution) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5428,13 +5022,7 @@ func (cmd *CreateStack) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &cloudformation.CreateStackInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in cloudformation.CreateStackInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CreateStack(input)
-	renv.Log().ExtraVerbosef("cloudformation.CreateStack call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -5444,14 +5032,14 @@ func (cmd *CreateStack) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create stack: AWS command returned nil output")
+			renv.Log().Warning("create distribution: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create stack '%s' done", extracted)
+		renv.Log().Verbosef("create distribution '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create stack done")
+		renv.Log().Verbose("create distribution done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5463,16 +5051,16 @@ func (cmd *CreateStack) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *CreateStack) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("stack"), nil
+func (cmd *CreateDistribution) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("distribution"), nil
 }
 
-func (cmd *CreateStack) inject(params map[string]interface{}) error {
+func (cmd *CreateDistribution) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateSubnet(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateSubnet {
-	cmd := new(CreateSubnet)
+func NewCreateEgressonlyinternetgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateEgressonlyinternetgateway {
+	cmd := new(CreateEgressonlyinternetgateway)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -5485,18 +5073,11 @@ func NewCreateSubnet(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logge
 	return cmd
 }
 
-func (cmd *CreateSubnet) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateEgressonlyinternetgateway) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateSubnet) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateSubnet) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateEgressonlyinternetgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5507,13 +5088,13 @@ func (cmd *CreateSubnet) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &ec2.CreateSubnetInput{}
+	input := &ec2.CreateEgressOnlyInternetGatewayInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateSubnetInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CreateEgressOnlyInternetGatewayInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateSubnet(input)
-	renv.Log().ExtraVerbosef("ec2.CreateSubnet call took %s", time.Since(start))
+	output, err := cmd.api.CreateEgressOnlyInternetGateway(input)
+	renv.Log().ExtraVerbosef("ec2.CreateEgressOnlyInternetGateway call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -5523,14 +5104,14 @@ func (cmd *CreateSubnet) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create subnet: AWS command returned nil output")
+			renv.Log().Warning("create egressonlyinternetgateway: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create subnet '%s' done", extracted)
+		renv.Log().Verbosef("create egressonlyinternetgateway '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create subnet done")
+		renv.Log().Verbose("create egressonlyinternetgateway done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5542,61 +5123,54 @@ func (cmd *CreateSubnet) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *CreateSubnet) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateEgressonlyinternetgateway) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
 
-	input := &ec2.CreateSubnetInput{}
+	input := &ec2.CreateEgressOnlyInternetGatewayInput{}
 	input.SetDryRun(true)
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateSubnetInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CreateEgressOnlyInternetGatewayInput: %s", err)
 	}
 
 	start := time.Now()
-	_, err := cmd.api.CreateSubnet(input)
+	_, err := cmd.api.CreateEgressOnlyInternetGateway(input)
 	if awsErr, ok := err.(awserr.Error); ok {
 		switch code := awsErr.Code(); {
 		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CreateSubnet call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create subnet ok")
-			return fakeDryRunId("subnet"), nil
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateEgressOnlyInternetGateway call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create egressonlyinternetgateway ok")
+			return fakeDryRunId("egressonlyinternetgateway"), nil
 		}
 	}
 
 	return nil, err
 }
 
-func (cmd *CreateSubnet) inject(params map[string]interface{}) error {
+func (cmd *CreateEgressonlyinternetgateway) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateSubscription(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateSubscription {
-	cmd := new(CreateSubscription)
+func NewCreateElasticip(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateElasticip {
+	cmd := new(CreateElasticip)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = sns.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateSubscription) SetApi(api snsiface.SNSAPI) {
+func (cmd *CreateElasticip) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateSubscription) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateSubscription) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateElasticip) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5607,13 +5181,13 @@ func (cmd *CreateSubscription) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	input := &sns.SubscribeInput{}
+	input := &ec2.AllocateAddressInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in sns.SubscribeInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.AllocateAddressInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.Subscribe(input)
-	renv.Log().ExtraVerbosef("sns.Subscribe call took %s", time.Since(start))
+	output, err := cmd.api.AllocateAddress(input)
+	renv.Log().ExtraVerbosef("ec2.AllocateAddress call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -5623,14 +5197,14 @@ func (cmd *CreateSubscription) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create subscription: AWS command returned nil output")
+			renv.Log().Warning("create elasticip: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create subscription '%s' done", extracted)
+		renv.Log().Verbosef("create elasticip '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create subscription done")
+		renv.Log().Verbose("create elasticip done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5642,40 +5216,54 @@ func (cmd *CreateSubscription) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *CreateSubscription) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("subscription"), nil
+func (cmd *CreateElasticip) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.AllocateAddressInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.AllocateAddressInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.AllocateAddress(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.AllocateAddress call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create elasticip ok")
+			return fakeDryRunId("elasticip"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *CreateSubscription) inject(params map[string]interface{}) error {
+func (cmd *CreateElasticip) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateTag(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateTag {
-	cmd := new(CreateTag)
+func NewCreateEnvironment(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateEnvironment {
+	cmd := new(CreateEnvironment)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = elasticbeanstalk.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateTag) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateEnvironment) SetApi(api elasticbeanstalkiface.ElasticBeanstalkAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateTag) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateTag) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateEnvironment) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5686,7 +5274,13 @@ func (cmd *CreateTag) run(renv env.Running, params map[string]interface{}) (inte
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &elasticbeanstalk.CreateEnvironmentInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in elasticbeanstalk.CreateEnvironmentInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateEnvironment(input)
+	renv.Log().ExtraVerbosef("elasticbeanstalk.CreateEnvironment call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -5696,14 +5290,14 @@ func (cmd *CreateTag) run(renv env.Running, params map[string]interface{}) (inte
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create tag: AWS command returned nil output")
+			renv.Log().Warning("create environment: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create tag '%s' done", extracted)
+		renv.Log().Verbosef("create environment '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create tag done")
+		renv.Log().Verbose("create environment done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5715,36 +5309,33 @@ func (cmd *CreateTag) run(renv env.Running, params map[string]interface{}) (inte
 	return extracted, nil
 }
 
-func (cmd *CreateTag) inject(params map[string]interface{}) error {
+func (cmd *CreateEnvironment) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("environment"), nil
+}
+
+func (cmd *CreateEnvironment) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateTargetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateTargetgroup {
-	cmd := new(CreateTargetgroup)
+func NewCreateFunction(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateFunction {
+	cmd := new(CreateFunction)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elbv2.New(sess)
+		cmd.api = lambda.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateTargetgroup) SetApi(api elbv2iface.ELBV2API) {
+func (cmd *CreateFunction) SetApi(api lambdaiface.LambdaAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateTargetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateTargetgroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateFunction) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5755,13 +5346,13 @@ func (cmd *CreateTargetgroup) run(renv env.Running, params map[string]interface{
 		}
 	}
 
-	input := &elbv2.CreateTargetGroupInput{}
+	input := &lambda.CreateFunctionInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in elbv2.CreateTargetGroupInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in lambda.CreateFunctionInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateTargetGroup(input)
-	renv.Log().ExtraVerbosef("elbv2.CreateTargetGroup call took %s", time.Since(start))
+	output, err := cmd.api.CreateFunction(input)
+	renv.Log().ExtraVerbosef("lambda.CreateFunction call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -5771,14 +5362,14 @@ func (cmd *CreateTargetgroup) run(renv env.Running, params map[string]interface{
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create targetgroup: AWS command returned nil output")
+			renv.Log().Warning("create function: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create targetgroup '%s' done", extracted)
+		renv.Log().Verbosef("create function '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create targetgroup done")
+		renv.Log().Verbose("create function done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5790,40 +5381,33 @@ func (cmd *CreateTargetgroup) run(renv env.Running, params map[string]interface{
 	return extracted, nil
 }
 
-func (cmd *CreateTargetgroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("targetgroup"), nil
+func (cmd *CreateFunction) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("function"), nil
 }
 
-func (cmd *CreateTargetgroup) inject(params map[string]interface{}) error {
+func (cmd *CreateFunction) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateTopic(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateTopic {
-	cmd := new(CreateTopic)
+func NewCreateGrant(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateGrant {
+	cmd := new(CreateGrant)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = sns.New(sess)
+		cmd.api = kms.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateTopic) SetApi(api snsiface.SNSAPI) {
+func (cmd *CreateGrant) SetApi(api kmsiface.KMSAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateTopic) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateTopic) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateGrant) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5834,13 +5418,13 @@ func (cmd *CreateTopic) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &sns.CreateTopicInput{}
+	input := &kms.CreateGrantInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in sns.CreateTopicInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in kms.CreateGrantInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateTopic(input)
-	renv.Log().ExtraVerbosef("sns.CreateTopic call took %s", time.Since(start))
+	output, err := cmd.api.CreateGrant(input)
+	renv.Log().ExtraVerbosef("kms.CreateGrant call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -5850,14 +5434,14 @@ func (cmd *CreateTopic) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create topic: AWS command returned nil output")
+			renv.Log().Warning("create grant: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create topic '%s' done", extracted)
+		renv.Log().Verbosef("create grant '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create topic done")
+		renv.Log().Verbose("create grant done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5869,16 +5453,16 @@ func (cmd *CreateTopic) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *CreateTopic) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("topic"), nil
+func (cmd *CreateGrant) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("grant"), nil
 }
 
-func (cmd *CreateTopic) inject(params map[string]interface{}) error {
+func (cmd *CreateGrant) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateUser(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateUser {
-	cmd := new(CreateUser)
+func NewCreateGroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateGroup {
+	cmd := new(CreateGroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -5891,18 +5475,11 @@ func NewCreateUser(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger)
 	return cmd
 }
 
-func (cmd *CreateUser) SetApi(api iamiface.IAMAPI) {
+func (cmd *CreateGroup) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateUser) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateUser) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateGroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5913,13 +5490,13 @@ func (cmd *CreateUser) run(renv env.Running, params map[string]interface{}) (int
 		}
 	}
 
-	input := &iam.CreateUserInput{}
+	input := &iam.CreateGroupInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.CreateUserInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in iam.CreateGroupInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateUser(input)
-	renv.Log().ExtraVerbosef("iam.CreateUser call took %s", time.Since(start))
+	output, err := cmd.api.CreateGroup(input)
+	renv.Log().ExtraVerbosef("iam.CreateGroup call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -5929,14 +5506,14 @@ func (cmd *CreateUser) run(renv env.Running, params map[string]interface{}) (int
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create user: AWS command returned nil output")
+			renv.Log().Warning("create group: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create user '%s' done", extracted)
+		renv.Log().Verbosef("create group '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create user done")
+		renv.Log().Verbose("create group done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5948,16 +5525,16 @@ func (cmd *CreateUser) run(renv env.Running, params map[string]interface{}) (int
 	return extracted, nil
 }
 
-func (cmd *CreateUser) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("user"), nil
+func (cmd *CreateGroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("group"), nil
 }
 
-func (cmd *CreateUser) inject(params map[string]interface{}) error {
+func (cmd *CreateGroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateVolume {
-	cmd := new(CreateVolume)
+func NewCreateHost(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateHost {
+	cmd := new(CreateHost)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -5970,18 +5547,11 @@ func NewCreateVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logge
 	return cmd
 }
 
-func (cmd *CreateVolume) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateHost) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateVolume) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateVolume) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateHost) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5992,13 +5562,13 @@ func (cmd *CreateVolume) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &ec2.CreateVolumeInput{}
+	input := &ec2.AllocateHostsInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateVolumeInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.AllocateHostsInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateVolume(input)
-	renv.Log().ExtraVerbosef("ec2.CreateVolume call took %s", time.Since(start))
+	output, err := cmd.api.AllocateHosts(input)
+	renv.Log().ExtraVerbosef("ec2.AllocateHosts call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -6008,14 +5578,14 @@ func (cmd *CreateVolume) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create volume: AWS command returned nil output")
+			renv.Log().Warning("create host: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create volume '%s' done", extracted)
+		renv.Log().Verbosef("create host '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create volume done")
+		renv.Log().Verbose("create host done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6027,37 +5597,16 @@ func (cmd *CreateVolume) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *CreateVolume) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.CreateVolumeInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateVolumeInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.CreateVolume(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CreateVolume call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create volume ok")
-			return fakeDryRunId("volume"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CreateHost) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("host"), nil
 }
 
-func (cmd *CreateVolume) inject(params map[string]interface{}) error {
+func (cmd *CreateHost) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateVpc(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateVpc {
-	cmd := new(CreateVpc)
+func NewCreateHostreservation(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateHostreservation {
+	cmd := new(CreateHostreservation)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -6070,18 +5619,11 @@ func NewCreateVpc(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger)
 	return cmd
 }
 
-func (cmd *CreateVpc) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateHostreservation) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateVpc) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateVpc) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateHostreservation) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6092,13 +5634,13 @@ func (cmd *CreateVpc) run(renv env.Running, params map[string]interface{}) (inte
 		}
 	}
 
-	input := &ec2.CreateVpcInput{}
+	input := &ec2.PurchaseHostReservationInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateVpcInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.PurchaseHostReservationInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateVpc(input)
-	renv.Log().ExtraVerbosef("ec2.CreateVpc call took %s", time.Since(start))
+	output, err := cmd.api.PurchaseHostReservation(input)
+	renv.Log().ExtraVerbosef("ec2.PurchaseHostReservation call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -6108,14 +5650,14 @@ func (cmd *CreateVpc) run(renv env.Running, params map[string]interface{}) (inte
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create vpc: AWS command returned nil output")
+			renv.Log().Warning("create hostreservation: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create vpc '%s' done", extracted)
+		renv.Log().Verbosef("create hostreservation '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create vpc done")
+		renv.Log().Verbose("create hostreservation done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6127,61 +5669,33 @@ func (cmd *CreateVpc) run(renv env.Running, params map[string]interface{}) (inte
 	return extracted, nil
 }
 
-func (cmd *CreateVpc) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.CreateVpcInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateVpcInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.CreateVpc(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CreateVpc call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create vpc ok")
-			return fakeDryRunId("vpc"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CreateHostreservation) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("hostreservation"), nil
 }
 
-func (cmd *CreateVpc) inject(params map[string]interface{}) error {
+func (cmd *CreateHostreservation) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateZone(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateZone {
-	cmd := new(CreateZone)
+func NewCreateImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateImage {
+	cmd := new(CreateImage)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = route53.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateZone) SetApi(api route53iface.Route53API) {
+func (cmd *CreateImage) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateZone) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *CreateZone) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateImage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6192,13 +5706,7 @@ func (cmd *CreateZone) run(renv env.Running, params map[string]interface{}) (int
 		}
 	}
 
-	input := &route53.CreateHostedZoneInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in route53.CreateHostedZoneInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CreateHostedZone(input)
-	renv.Log().ExtraVerbosef("route53.CreateHostedZone call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -6208,14 +5716,14 @@ func (cmd *CreateZone) run(renv env.Running, params map[string]interface{}) (int
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create zone: AWS command returned nil output")
+			renv.Log().Warning("create image: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create zone '%s' done", extracted)
+		renv.Log().Verbosef("create image '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create zone done")
+		renv.Log().Verbose("create image done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6227,40 +5735,29 @@ func (cmd *CreateZone) run(renv env.Running, params map[string]interface{}) (int
 	return extracted, nil
 }
 
-func (cmd *CreateZone) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("zone"), nil
-}
-
-func (cmd *CreateZone) inject(params map[string]interface{}) error {
+func (cmd *CreateImage) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteAccesskey(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteAccesskey {
-	cmd := new(DeleteAccesskey)
+func NewCreateInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateInstance {
+	cmd := new(CreateInstance)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteAccesskey) SetApi(api iamiface.IAMAPI) {
+func (cmd *CreateInstance) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteAccesskey) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteAccesskey) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6271,13 +5768,13 @@ func (cmd *DeleteAccesskey) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &iam.DeleteAccessKeyInput{}
+	input := &ec2.RunInstancesInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.DeleteAccessKeyInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.RunInstancesInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteAccessKey(input)
-	renv.Log().ExtraVerbosef("iam.DeleteAccessKey call took %s", time.Since(start))
+	output, err := cmd.api.RunInstances(input)
+	renv.Log().ExtraVerbosef("ec2.RunInstances call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -6287,14 +5784,14 @@ func (cmd *DeleteAccesskey) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete accesskey: AWS command returned nil output")
+			renv.Log().Warning("create instance: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete accesskey '%s' done", extracted)
+		renv.Log().Verbosef("create instance '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete accesskey done")
+		renv.Log().Verbose("create instance done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6306,40 +5803,54 @@ func (cmd *DeleteAccesskey) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DeleteAccesskey) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("accesskey"), nil
+func (cmd *CreateInstance) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.RunInstancesInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.RunInstancesInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.RunInstances(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.RunInstances call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create instance ok")
+			return fakeDryRunId("instance"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *DeleteAccesskey) inject(params map[string]interface{}) error {
+func (cmd *CreateInstance) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteAlarm(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteAlarm {
-	cmd := new(DeleteAlarm)
+func NewCreateInstanceprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateInstanceprofile {
+	cmd := new(CreateInstanceprofile)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudwatch.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteAlarm) SetApi(api cloudwatchiface.CloudWatchAPI) {
+func (cmd *CreateInstanceprofile) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteAlarm) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteAlarm) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateInstanceprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6350,13 +5861,13 @@ func (cmd *DeleteAlarm) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &cloudwatch.DeleteAlarmsInput{}
+	input := &iam.CreateInstanceProfileInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in cloudwatch.DeleteAlarmsInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in iam.CreateInstanceProfileInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteAlarms(input)
-	renv.Log().ExtraVerbosef("cloudwatch.DeleteAlarms call took %s", time.Since(start))
+	output, err := cmd.api.CreateInstanceProfile(input)
+	renv.Log().ExtraVerbosef("iam.CreateInstanceProfile call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -6366,14 +5877,14 @@ func (cmd *DeleteAlarm) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete alarm: AWS command returned nil output")
+			renv.Log().Warning("create instanceprofile: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete alarm '%s' done", extracted)
+		renv.Log().Verbosef("create instanceprofile '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete alarm done")
+		renv.Log().Verbose("create instanceprofile done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6385,40 +5896,33 @@ func (cmd *DeleteAlarm) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *DeleteAlarm) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("alarm"), nil
+func (cmd *CreateInstanceprofile) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("instanceprofile"), nil
 }
 
-func (cmd *DeleteAlarm) inject(params map[string]interface{}) error {
+func (cmd *CreateInstanceprofile) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteAppscalingpolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteAppscalingpolicy {
-	cmd := new(DeleteAppscalingpolicy)
+func NewCreateInternetgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateInternetgateway {
+	cmd := new(CreateInternetgateway)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = applicationautoscaling.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteAppscalingpolicy) SetApi(api applicationautoscalingiface.ApplicationAutoScalingAPI) {
+func (cmd *CreateInternetgateway) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteAppscalingpolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteAppscalingpolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateInternetgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6429,13 +5933,13 @@ func (cmd *DeleteAppscalingpolicy) run(renv env.Running, params map[string]inter
 		}
 	}
 
-	input := &applicationautoscaling.DeleteScalingPolicyInput{}
+	input := &ec2.CreateInternetGatewayInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in applicationautoscaling.DeleteScalingPolicyInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CreateInternetGatewayInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteScalingPolicy(input)
-	renv.Log().ExtraVerbosef("applicationautoscaling.DeleteScalingPolicy call took %s", time.Since(start))
+	output, err := cmd.api.CreateInternetGateway(input)
+	renv.Log().ExtraVerbosef("ec2.CreateInternetGateway call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -6445,14 +5949,14 @@ func (cmd *DeleteAppscalingpolicy) run(renv env.Running, params map[string]inter
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete appscalingpolicy: AWS command returned nil output")
+			renv.Log().Warning("create internetgateway: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete appscalingpolicy '%s' done", extracted)
+		renv.Log().Verbosef("create internetgateway '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete appscalingpolicy done")
+		renv.Log().Verbose("create internetgateway done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6464,40 +5968,54 @@ func (cmd *DeleteAppscalingpolicy) run(renv env.Running, params map[string]inter
 	return extracted, nil
 }
 
-func (cmd *DeleteAppscalingpolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("appscalingpolicy"), nil
+func (cmd *CreateInternetgateway) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreateInternetGatewayInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateInternetGatewayInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateInternetGateway(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateInternetGateway call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create internetgateway ok")
+			return fakeDryRunId("internetgateway"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *DeleteAppscalingpolicy) inject(params map[string]interface{}) error {
+func (cmd *CreateInternetgateway) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteAppscalingtarget(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteAppscalingtarget {
-	cmd := new(DeleteAppscalingtarget)
+func NewCreateInvalidation(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateInvalidation {
+	cmd := new(CreateInvalidation)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = applicationautoscaling.New(sess)
+		cmd.api = cloudfront.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteAppscalingtarget) SetApi(api applicationautoscalingiface.ApplicationAutoScalingAPI) {
+func (cmd *CreateInvalidation) SetApi(api cloudfrontiface.CloudFrontAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteAppscalingtarget) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteAppscalingtarget) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateInvalidation) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6508,13 +6026,7 @@ func (cmd *DeleteAppscalingtarget) run(renv env.Running, params map[string]inter
 		}
 	}
 
-	input := &applicationautoscaling.DeregisterScalableTargetInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in applicationautoscaling.DeregisterScalableTargetInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.DeregisterScalableTarget(input)
-	renv.Log().ExtraVerbosef("applicationautoscaling.DeregisterScalableTarget call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -6524,14 +6036,14 @@ func (cmd *DeleteAppscalingtarget) run(renv env.Running, params map[string]inter
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete appscalingtarget: AWS command returned nil output")
+			renv.Log().Warning("create invalidation: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete appscalingtarget '%s' done", extracted)
+		renv.Log().Verbosef("create invalidation '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete appscalingtarget done")
+		renv.Log().Verbose("create invalidation done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6543,40 +6055,33 @@ func (cmd *DeleteAppscalingtarget) run(renv env.Running, params map[string]inter
 	return extracted, nil
 }
 
-func (cmd *DeleteAppscalingtarget) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("appscalingtarget"), nil
+func (cmd *CreateInvalidation) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("invalidation"), nil
 }
 
-func (cmd *DeleteAppscalingtarget) inject(params map[string]interface{}) error {
+func (cmd *CreateInvalidation) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteBucket(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteBucket {
-	cmd := new(DeleteBucket)
+func NewCreateJobqueue(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateJobqueue {
+	cmd := new(CreateJobqueue)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = s3.New(sess)
+		cmd.api = batch.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteBucket) SetApi(api s3iface.S3API) {
+func (cmd *CreateJobqueue) SetApi(api batchiface.BatchAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteBucket) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteBucket) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateJobqueue) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6587,13 +6092,13 @@ func (cmd *DeleteBucket) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &s3.DeleteBucketInput{}
+	input := &batch.CreateJobQueueInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in s3.DeleteBucketInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in batch.CreateJobQueueInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteBucket(input)
-	renv.Log().ExtraVerbosef("s3.DeleteBucket call took %s", time.Since(start))
+	output, err := cmd.api.CreateJobQueue(input)
+	renv.Log().ExtraVerbosef("batch.CreateJobQueue call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -6603,14 +6108,14 @@ func (cmd *DeleteBucket) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete bucket: AWS command returned nil output")
+			renv.Log().Warning("create jobqueue: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete bucket '%s' done", extracted)
+		renv.Log().Verbosef("create jobqueue '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete bucket done")
+		renv.Log().Verbose("create jobqueue done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6622,40 +6127,33 @@ func (cmd *DeleteBucket) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *DeleteBucket) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("bucket"), nil
+func (cmd *CreateJobqueue) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("jobqueue"), nil
 }
 
-func (cmd *DeleteBucket) inject(params map[string]interface{}) error {
+func (cmd *CreateJobqueue) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteCertificate(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteCertificate {
-	cmd := new(DeleteCertificate)
+func NewCreateKey(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateKey {
+	cmd := new(CreateKey)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = acm.New(sess)
+		cmd.api = kms.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteCertificate) SetApi(api acmiface.ACMAPI) {
+func (cmd *CreateKey) SetApi(api kmsiface.KMSAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteCertificate) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteCertificate) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateKey) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6666,13 +6164,13 @@ func (cmd *DeleteCertificate) run(renv env.Running, params map[string]interface{
 		}
 	}
 
-	input := &acm.DeleteCertificateInput{}
+	input := &kms.CreateKeyInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in acm.DeleteCertificateInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in kms.CreateKeyInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteCertificate(input)
-	renv.Log().ExtraVerbosef("acm.DeleteCertificate call took %s", time.Since(start))
+	output, err := cmd.api.CreateKey(input)
+	renv.Log().ExtraVerbosef("kms.CreateKey call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -6682,14 +6180,14 @@ func (cmd *DeleteCertificate) run(renv env.Running, params map[string]interface{
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete certificate: AWS command returned nil output")
+			renv.Log().Warning("create key: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete certificate '%s' done", extracted)
+		renv.Log().Verbosef("create key '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete certificate done")
+		renv.Log().Verbose("create key done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6701,40 +6199,33 @@ func (cmd *DeleteCertificate) run(renv env.Running, params map[string]interface{
 	return extracted, nil
 }
 
-func (cmd *DeleteCertificate) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("certificate"), nil
+func (cmd *CreateKey) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("key"), nil
 }
 
-func (cmd *DeleteCertificate) inject(params map[string]interface{}) error {
+func (cmd *CreateKey) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteContainercluster(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteContainercluster {
-	cmd := new(DeleteContainercluster)
+func NewCreateKeypair(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateKeypair {
+	cmd := new(CreateKeypair)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ecs.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteContainercluster) SetApi(api ecsiface.ECSAPI) {
+func (cmd *CreateKeypair) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteContainercluster) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteContainercluster) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateKeypair) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6745,13 +6236,13 @@ func (cmd *DeleteContainercluster) run(renv env.Running, params map[string]inter
 		}
 	}
 
-	input := &ecs.DeleteClusterInput{}
+	input := &ec2.ImportKeyPairInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ecs.DeleteClusterInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.ImportKeyPairInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteCluster(input)
-	renv.Log().ExtraVerbosef("ecs.DeleteCluster call took %s", time.Since(start))
+	output, err := cmd.api.ImportKeyPair(input)
+	renv.Log().ExtraVerbosef("ec2.ImportKeyPair call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -6761,14 +6252,14 @@ func (cmd *DeleteContainercluster) run(renv env.Running, params map[string]inter
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete containercluster: AWS command returned nil output")
+			renv.Log().Warning("create keypair: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete containercluster '%s' done", extracted)
+		renv.Log().Verbosef("create keypair '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete containercluster done")
+		renv.Log().Verbose("create keypair done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6780,40 +6271,33 @@ func (cmd *DeleteContainercluster) run(renv env.Running, params map[string]inter
 	return extracted, nil
 }
 
-func (cmd *DeleteContainercluster) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("containercluster"), nil
+func (cmd *CreateKeypair) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("keypair"), nil
 }
 
-func (cmd *DeleteContainercluster) inject(params map[string]interface{}) error {
+func (cmd *CreateKeypair) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteContainertask(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteContainertask {
-	cmd := new(DeleteContainertask)
+func NewCreateKubernetescluster(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateKubernetescluster {
+	cmd := new(CreateKubernetescluster)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ecs.New(sess)
+		cmd.api = eks.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteContainertask) SetApi(api ecsiface.ECSAPI) {
+func (cmd *CreateKubernetescluster) SetApi(api eksiface.EKSAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteContainertask) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteContainertask) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateKubernetescluster) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6824,7 +6308,13 @@ func (cmd *DeleteContainertask) run(renv env.Running, params map[string]interfac
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &eks.CreateClusterInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in eks.CreateClusterInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateCluster(input)
+	renv.Log().ExtraVerbosef("eks.CreateCluster call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -6834,14 +6324,14 @@ func (cmd *DeleteContainertask) run(renv env.Running, params map[string]interfac
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete containertask: AWS command returned nil output")
+			renv.Log().Warning("create kubernetescluster: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete containertask '%s' done", extracted)
+		renv.Log().Verbosef("create kubernetescluster '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete containertask done")
+		renv.Log().Verbose("create kubernetescluster done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6853,36 +6343,33 @@ func (cmd *DeleteContainertask) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *DeleteContainertask) inject(params map[string]interface{}) error {
+func (cmd *CreateKubernetescluster) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("kubernetescluster"), nil
+}
+
+func (cmd *CreateKubernetescluster) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteDatabase {
-	cmd := new(DeleteDatabase)
+func NewCreateLaunchconfiguration(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateLaunchconfiguration {
+	cmd := new(CreateLaunchconfiguration)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = rds.New(sess)
+		cmd.api = autoscaling.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteDatabase) SetApi(api rdsiface.RDSAPI) {
+func (cmd *CreateLaunchconfiguration) SetApi(api autoscalingiface.AutoScalingAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteDatabase) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateLaunchconfiguration) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6893,13 +6380,13 @@ func (cmd *DeleteDatabase) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &rds.DeleteDBInstanceInput{}
+	input := &autoscaling.CreateLaunchConfigurationInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in rds.DeleteDBInstanceInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in autoscaling.CreateLaunchConfigurationInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteDBInstance(input)
-	renv.Log().ExtraVerbosef("rds.DeleteDBInstance call took %s", time.Since(start))
+	output, err := cmd.api.CreateLaunchConfiguration(input)
+	renv.Log().ExtraVerbosef("autoscaling.CreateLaunchConfiguration call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -6909,14 +6396,14 @@ func (cmd *DeleteDatabase) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete database: AWS command returned nil output")
+			renv.Log().Warning("create launchconfiguration: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete database '%s' done", extracted)
+		renv.Log().Verbosef("create launchconfiguration '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete database done")
+		renv.Log().Verbose("create launchconfiguration done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6928,40 +6415,33 @@ func (cmd *DeleteDatabase) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DeleteDatabase) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("database"), nil
+func (cmd *CreateLaunchconfiguration) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("launchconfiguration"), nil
 }
 
-func (cmd *DeleteDatabase) inject(params map[string]interface{}) error {
+func (cmd *CreateLaunchconfiguration) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteDbsubnetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteDbsubnetgroup {
-	cmd := new(DeleteDbsubnetgroup)
+func NewCreateListener(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateListener {
+	cmd := new(CreateListener)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = rds.New(sess)
+		cmd.api = elbv2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteDbsubnetgroup) SetApi(api rdsiface.RDSAPI) {
+func (cmd *CreateListener) SetApi(api elbv2iface.ELBV2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteDbsubnetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteDbsubnetgroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateListener) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6972,13 +6452,13 @@ func (cmd *DeleteDbsubnetgroup) run(renv env.Running, params map[string]interfac
 		}
 	}
 
-	input := &rds.DeleteDBSubnetGroupInput{}
+	input := &elbv2.CreateListenerInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in rds.DeleteDBSubnetGroupInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in elbv2.CreateListenerInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteDBSubnetGroup(input)
-	renv.Log().ExtraVerbosef("rds.DeleteDBSubnetGroup call took %s", time.Since(start))
+	output, err := cmd.api.CreateListener(input)
+	renv.Log().ExtraVerbosef("elbv2.CreateListener call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -6988,14 +6468,14 @@ func (cmd *DeleteDbsubnetgroup) run(renv env.Running, params map[string]interfac
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete dbsubnetgroup: AWS command returned nil output")
+			renv.Log().Warning("create listener: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete dbsubnetgroup '%s' done", extracted)
+		renv.Log().Verbosef("create listener '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete dbsubnetgroup done")
+		renv.Log().Verbose("create listener done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7007,40 +6487,33 @@ func (cmd *DeleteDbsubnetgroup) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *DeleteDbsubnetgroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("dbsubnetgroup"), nil
+func (cmd *CreateListener) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("listener"), nil
 }
 
-func (cmd *DeleteDbsubnetgroup) inject(params map[string]interface{}) error {
+func (cmd *CreateListener) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteDistribution(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteDistribution {
-	cmd := new(DeleteDistribution)
+func NewCreateLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateLoadbalancer {
+	cmd := new(CreateLoadbalancer)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudfront.New(sess)
+		cmd.api = elbv2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteDistribution) SetApi(api cloudfrontiface.CloudFrontAPI) {
+func (cmd *CreateLoadbalancer) SetApi(api elbv2iface.ELBV2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteDistribution) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteDistribution) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7051,7 +6524,13 @@ func (cmd *DeleteDistribution) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &elbv2.CreateLoadBalancerInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in elbv2.CreateLoadBalancerInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateLoadBalancer(input)
+	renv.Log().ExtraVerbosef("elbv2.CreateLoadBalancer call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -7061,14 +6540,14 @@ func (cmd *DeleteDistribution) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete distribution: AWS command returned nil output")
+			renv.Log().Warning("create loadbalancer: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete distribution '%s' done", extracted)
+		renv.Log().Verbosef("create loadbalancer '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete distribution done")
+		renv.Log().Verbose("create loadbalancer done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7080,40 +6559,33 @@ func (cmd *DeleteDistribution) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *DeleteDistribution) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("distribution"), nil
+func (cmd *CreateLoadbalancer) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("loadbalancer"), nil
 }
 
-func (cmd *DeleteDistribution) inject(params map[string]interface{}) error {
+func (cmd *CreateLoadbalancer) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteElasticip(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteElasticip {
-	cmd := new(DeleteElasticip)
+func NewCreateLoggroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateLoggroup {
+	cmd := new(CreateLoggroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = cloudwatchlogs.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteElasticip) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateLoggroup) SetApi(api cloudwatchlogsiface.CloudWatchLogsAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteElasticip) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteElasticip) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateLoggroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7124,13 +6596,13 @@ func (cmd *DeleteElasticip) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &ec2.ReleaseAddressInput{}
+	input := &cloudwatchlogs.CreateLogGroupInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.ReleaseAddressInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in cloudwatchlogs.CreateLogGroupInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.ReleaseAddress(input)
-	renv.Log().ExtraVerbosef("ec2.ReleaseAddress call took %s", time.Since(start))
+	output, err := cmd.api.CreateLogGroup(input)
+	renv.Log().ExtraVerbosef("cloudwatchlogs.CreateLogGroup call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -7140,14 +6612,14 @@ func (cmd *DeleteElasticip) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete elasticip: AWS command returned nil output")
+			renv.Log().Warning("create loggroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete elasticip '%s' done", extracted)
+		renv.Log().Verbosef("create loggroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete elasticip done")
+		renv.Log().Verbose("create loggroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7159,61 +6631,33 @@ func (cmd *DeleteElasticip) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DeleteElasticip) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.ReleaseAddressInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.ReleaseAddressInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.ReleaseAddress(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.ReleaseAddress call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete elasticip ok")
-			return fakeDryRunId("elasticip"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CreateLoggroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("loggroup"), nil
 }
 
-func (cmd *DeleteElasticip) inject(params map[string]interface{}) error {
+func (cmd *CreateLoggroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteFunction(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteFunction {
-	cmd := new(DeleteFunction)
+func NewCreateLoginprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateLoginprofile {
+	cmd := new(CreateLoginprofile)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = lambda.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteFunction) SetApi(api lambdaiface.LambdaAPI) {
+func (cmd *CreateLoginprofile) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteFunction) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteFunction) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateLoginprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7224,13 +6668,13 @@ func (cmd *DeleteFunction) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &lambda.DeleteFunctionInput{}
+	input := &iam.CreateLoginProfileInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in lambda.DeleteFunctionInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in iam.CreateLoginProfileInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteFunction(input)
-	renv.Log().ExtraVerbosef("lambda.DeleteFunction call took %s", time.Since(start))
+	output, err := cmd.api.CreateLoginProfile(input)
+	renv.Log().ExtraVerbosef("iam.CreateLoginProfile call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -7240,14 +6684,14 @@ func (cmd *DeleteFunction) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete function: AWS command returned nil output")
+			renv.Log().Warning("create loginprofile: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete function '%s' done", extracted)
+		renv.Log().Verbosef("create loginprofile '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete function done")
+		renv.Log().Verbose("create loginprofile done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7259,40 +6703,33 @@ func (cmd *DeleteFunction) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DeleteFunction) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("function"), nil
+func (cmd *CreateLoginprofile) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("loginprofile"), nil
 }
 
-func (cmd *DeleteFunction) inject(params map[string]interface{}) error {
+func (cmd *CreateLoginprofile) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteGroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteGroup {
-	cmd := new(DeleteGroup)
+func NewCreateMethod(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateMethod {
+	cmd := new(CreateMethod)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = apigateway.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteGroup) SetApi(api iamiface.IAMAPI) {
+func (cmd *CreateMethod) SetApi(api apigatewayiface.APIGatewayAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteGroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteGroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateMethod) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7303,13 +6740,13 @@ func (cmd *DeleteGroup) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &iam.DeleteGroupInput{}
+	input := &apigateway.PutMethodInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.DeleteGroupInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in apigateway.PutMethodInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteGroup(input)
-	renv.Log().ExtraVerbosef("iam.DeleteGroup call took %s", time.Since(start))
+	output, err := cmd.api.PutMethod(input)
+	renv.Log().ExtraVerbosef("apigateway.PutMethod call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -7319,14 +6756,14 @@ func (cmd *DeleteGroup) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete group: AWS command returned nil output")
+			renv.Log().Warning("create method: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete group '%s' done", extracted)
+		renv.Log().Verbosef("create method '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete group done")
+		renv.Log().Verbose("create method done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7338,40 +6775,33 @@ func (cmd *DeleteGroup) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *DeleteGroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("group"), nil
+func (cmd *CreateMethod) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("method"), nil
 }
 
-func (cmd *DeleteGroup) inject(params map[string]interface{}) error {
+func (cmd *CreateMethod) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteImage {
-	cmd := new(DeleteImage)
+func NewCreateMfadevice(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateMfadevice {
+	cmd := new(CreateMfadevice)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteImage) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateMfadevice) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteImage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteImage) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateMfadevice) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7392,14 +6822,14 @@ func (cmd *DeleteImage) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete image: AWS command returned nil output")
+			renv.Log().Warning("create mfadevice: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete image '%s' done", extracted)
+		renv.Log().Verbosef("create mfadevice '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete image done")
+		renv.Log().Verbose("create mfadevice done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7411,12 +6841,16 @@ func (cmd *DeleteImage) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *DeleteImage) inject(params map[string]interface{}) error {
+func (cmd *CreateMfadevice) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("mfadevice"), nil
+}
+
+func (cmd *CreateMfadevice) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteInstance {
-	cmd := new(DeleteInstance)
+func NewCreateNatgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateNatgateway {
+	cmd := new(CreateNatgateway)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -7429,18 +6863,11 @@ func NewDeleteInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Log
 	return cmd
 }
 
-func (cmd *DeleteInstance) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateNatgateway) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateNatgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7451,13 +6878,13 @@ func (cmd *DeleteInstance) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &ec2.TerminateInstancesInput{}
+	input := &ec2.CreateNatGatewayInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.TerminateInstancesInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CreateNatGatewayInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.TerminateInstances(input)
-	renv.Log().ExtraVerbosef("ec2.TerminateInstances call took %s", time.Since(start))
+	output, err := cmd.api.CreateNatGateway(input)
+	renv.Log().ExtraVerbosef("ec2.CreateNatGateway call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -7467,14 +6894,14 @@ func (cmd *DeleteInstance) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete instance: AWS command returned nil output")
+			renv.Log().Warning("create natgateway: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete instance '%s' done", extracted)
+		renv.Log().Verbosef("create natgateway '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete instance done")
+		renv.Log().Verbose("create natgateway done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7486,61 +6913,33 @@ func (cmd *DeleteInstance) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DeleteInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.TerminateInstancesInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.TerminateInstancesInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.TerminateInstances(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.TerminateInstances call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete instance ok")
-			return fakeDryRunId("instance"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CreateNatgateway) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("natgateway"), nil
 }
 
-func (cmd *DeleteInstance) inject(params map[string]interface{}) error {
+func (cmd *CreateNatgateway) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteInstanceprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteInstanceprofile {
-	cmd := new(DeleteInstanceprofile)
+func NewCreateNetworkacl(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateNetworkacl {
+	cmd := new(CreateNetworkacl)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteInstanceprofile) SetApi(api iamiface.IAMAPI) {
+func (cmd *CreateNetworkacl) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteInstanceprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteInstanceprofile) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateNetworkacl) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7551,13 +6950,13 @@ func (cmd *DeleteInstanceprofile) run(renv env.Running, params map[string]interf
 		}
 	}
 
-	input := &iam.DeleteInstanceProfileInput{}
+	input := &ec2.CreateNetworkAclInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.DeleteInstanceProfileInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CreateNetworkAclInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteInstanceProfile(input)
-	renv.Log().ExtraVerbosef("iam.DeleteInstanceProfile call took %s", time.Since(start))
+	output, err := cmd.api.CreateNetworkAcl(input)
+	renv.Log().ExtraVerbosef("ec2.CreateNetworkAcl call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -7567,14 +6966,14 @@ func (cmd *DeleteInstanceprofile) run(renv env.Running, params map[string]interf
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete instanceprofile: AWS command returned nil output")
+			renv.Log().Warning("create networkacl: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete instanceprofile '%s' done", extracted)
+		renv.Log().Verbosef("create networkacl '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete instanceprofile done")
+		renv.Log().Verbose("create networkacl done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7586,16 +6985,37 @@ func (cmd *DeleteInstanceprofile) run(renv env.Running, params map[string]interf
 	return extracted, nil
 }
 
-func (cmd *DeleteInstanceprofile) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("instanceprofile"), nil
+func (cmd *CreateNetworkacl) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreateNetworkAclInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateNetworkAclInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateNetworkAcl(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateNetworkAcl call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create networkacl ok")
+			return fakeDryRunId("networkacl"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *DeleteInstanceprofile) inject(params map[string]interface{}) error {
+func (cmd *CreateNetworkacl) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteInternetgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteInternetgateway {
-	cmd := new(DeleteInternetgateway)
+func NewCreateNetworkaclrule(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateNetworkaclrule {
+	cmd := new(CreateNetworkaclrule)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -7608,18 +7028,11 @@ func NewDeleteInternetgateway(sess *session.Session, g cloud.GraphAPI, l ...*log
 	return cmd
 }
 
-func (cmd *DeleteInternetgateway) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateNetworkaclrule) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteInternetgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteInternetgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateNetworkaclrule) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7630,13 +7043,7 @@ func (cmd *DeleteInternetgateway) run(renv env.Running, params map[string]interf
 		}
 	}
 
-	input := &ec2.DeleteInternetGatewayInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteInternetGatewayInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.DeleteInternetGateway(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteInternetGateway call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -7646,14 +7053,14 @@ func (cmd *DeleteInternetgateway) run(renv env.Running, params map[string]interf
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete internetgateway: AWS command returned nil output")
+			renv.Log().Warning("create networkaclrule: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete internetgateway '%s' done", extracted)
+		renv.Log().Verbosef("create networkaclrule '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete internetgateway done")
+		renv.Log().Verbose("create networkaclrule done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7665,37 +7072,12 @@ func (cmd *DeleteInternetgateway) run(renv env.Running, params map[string]interf
 	return extracted, nil
 }
 
-func (cmd *DeleteInternetgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.DeleteInternetGatewayInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteInternetGatewayInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.DeleteInternetGateway(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteInternetGateway call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete internetgateway ok")
-			return fakeDryRunId("internetgateway"), nil
-		}
-	}
-
-	return nil, err
-}
-
-func (cmd *DeleteInternetgateway) inject(params map[string]interface{}) error {
+func (cmd *CreateNetworkaclrule) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteKeypair(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteKeypair {
-	cmd := new(DeleteKeypair)
+func NewCreateNetworkinterface(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateNetworkinterface {
+	cmd := new(CreateNetworkinterface)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -7708,18 +7090,11 @@ func NewDeleteKeypair(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logg
 	return cmd
 }
 
-func (cmd *DeleteKeypair) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateNetworkinterface) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteKeypair) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteKeypair) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateNetworkinterface) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7730,13 +7105,13 @@ func (cmd *DeleteKeypair) run(renv env.Running, params map[string]interface{}) (
 		}
 	}
 
-	input := &ec2.DeleteKeyPairInput{}
+	input := &ec2.CreateNetworkInterfaceInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteKeyPairInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CreateNetworkInterfaceInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteKeyPair(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteKeyPair call took %s", time.Since(start))
+	output, err := cmd.api.CreateNetworkInterface(input)
+	renv.Log().ExtraVerbosef("ec2.CreateNetworkInterface call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -7746,14 +7121,14 @@ func (cmd *DeleteKeypair) run(renv env.Running, params map[string]interface{}) (
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete keypair: AWS command returned nil output")
+			renv.Log().Warning("create networkinterface: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete keypair '%s' done", extracted)
+		renv.Log().Verbosef("create networkinterface '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete keypair done")
+		renv.Log().Verbose("create networkinterface done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7765,61 +7140,54 @@ func (cmd *DeleteKeypair) run(renv env.Running, params map[string]interface{}) (
 	return extracted, nil
 }
 
-func (cmd *DeleteKeypair) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateNetworkinterface) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
 
-	input := &ec2.DeleteKeyPairInput{}
+	input := &ec2.CreateNetworkInterfaceInput{}
 	input.SetDryRun(true)
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteKeyPairInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CreateNetworkInterfaceInput: %s", err)
 	}
 
 	start := time.Now()
-	_, err := cmd.api.DeleteKeyPair(input)
+	_, err := cmd.api.CreateNetworkInterface(input)
 	if awsErr, ok := err.(awserr.Error); ok {
 		switch code := awsErr.Code(); {
 		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteKeyPair call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete keypair ok")
-			return fakeDryRunId("keypair"), nil
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateNetworkInterface call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create networkinterface ok")
+			return fakeDryRunId("networkinterface"), nil
 		}
 	}
 
 	return nil, err
 }
 
-func (cmd *DeleteKeypair) inject(params map[string]interface{}) error {
+func (cmd *CreateNetworkinterface) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteLaunchconfiguration(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteLaunchconfiguration {
-	cmd := new(DeleteLaunchconfiguration)
+func NewCreateParameter(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateParameter {
+	cmd := new(CreateParameter)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = autoscaling.New(sess)
+		cmd.api = ssm.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteLaunchconfiguration) SetApi(api autoscalingiface.AutoScalingAPI) {
+func (cmd *CreateParameter) SetApi(api ssmiface.SSMAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteLaunchconfiguration) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteLaunchconfiguration) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateParameter) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7830,13 +7198,13 @@ func (cmd *DeleteLaunchconfiguration) run(renv env.Running, params map[string]in
 		}
 	}
 
-	input := &autoscaling.DeleteLaunchConfigurationInput{}
+	input := &ssm.PutParameterInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in autoscaling.DeleteLaunchConfigurationInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ssm.PutParameterInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteLaunchConfiguration(input)
-	renv.Log().ExtraVerbosef("autoscaling.DeleteLaunchConfiguration call took %s", time.Since(start))
+	output, err := cmd.api.PutParameter(input)
+	renv.Log().ExtraVerbosef("ssm.PutParameter call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -7846,14 +7214,14 @@ func (cmd *DeleteLaunchconfiguration) run(renv env.Running, params map[string]in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete launchconfiguration: AWS command returned nil output")
+			renv.Log().Warning("create parameter: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete launchconfiguration '%s' done", extracted)
+		renv.Log().Verbosef("create parameter '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete launchconfiguration done")
+		renv.Log().Verbose("create parameter done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7865,40 +7233,33 @@ func (cmd *DeleteLaunchconfiguration) run(renv env.Running, params map[string]in
 	return extracted, nil
 }
 
-func (cmd *DeleteLaunchconfiguration) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("launchconfiguration"), nil
+func (cmd *CreateParameter) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("parameter"), nil
 }
 
-func (cmd *DeleteLaunchconfiguration) inject(params map[string]interface{}) error {
+func (cmd *CreateParameter) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteListener(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteListener {
-	cmd := new(DeleteListener)
+func NewCreatePeeringconnection(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreatePeeringconnection {
+	cmd := new(CreatePeeringconnection)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elbv2.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteListener) SetApi(api elbv2iface.ELBV2API) {
+func (cmd *CreatePeeringconnection) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteListener) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteListener) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreatePeeringconnection) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7909,13 +7270,13 @@ func (cmd *DeleteListener) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &elbv2.DeleteListenerInput{}
+	input := &ec2.CreateVpcPeeringConnectionInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in elbv2.DeleteListenerInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CreateVpcPeeringConnectionInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteListener(input)
-	renv.Log().ExtraVerbosef("elbv2.DeleteListener call took %s", time.Since(start))
+	output, err := cmd.api.CreateVpcPeeringConnection(input)
+	renv.Log().ExtraVerbosef("ec2.CreateVpcPeeringConnection call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -7925,14 +7286,14 @@ func (cmd *DeleteListener) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete listener: AWS command returned nil output")
+			renv.Log().Warning("create peeringconnection: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete listener '%s' done", extracted)
+		renv.Log().Verbosef("create peeringconnection '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete listener done")
+		renv.Log().Verbose("create peeringconnection done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7944,40 +7305,54 @@ func (cmd *DeleteListener) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DeleteListener) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("listener"), nil
+func (cmd *CreatePeeringconnection) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreateVpcPeeringConnectionInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateVpcPeeringConnectionInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateVpcPeeringConnection(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateVpcPeeringConnection call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create peeringconnection ok")
+			return fakeDryRunId("peeringconnection"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *DeleteListener) inject(params map[string]interface{}) error {
+func (cmd *CreatePeeringconnection) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteLoadbalancer {
-	cmd := new(DeleteLoadbalancer)
+func NewCreatePlacementgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreatePlacementgroup {
+	cmd := new(CreatePlacementgroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elbv2.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteLoadbalancer) SetApi(api elbv2iface.ELBV2API) {
+func (cmd *CreatePlacementgroup) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteLoadbalancer) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreatePlacementgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7988,13 +7363,13 @@ func (cmd *DeleteLoadbalancer) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	input := &elbv2.DeleteLoadBalancerInput{}
+	input := &ec2.CreatePlacementGroupInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in elbv2.DeleteLoadBalancerInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CreatePlacementGroupInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteLoadBalancer(input)
-	renv.Log().ExtraVerbosef("elbv2.DeleteLoadBalancer call took %s", time.Since(start))
+	output, err := cmd.api.CreatePlacementGroup(input)
+	renv.Log().ExtraVerbosef("ec2.CreatePlacementGroup call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -8004,14 +7379,14 @@ func (cmd *DeleteLoadbalancer) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete loadbalancer: AWS command returned nil output")
+			renv.Log().Warning("create placementgroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete loadbalancer '%s' done", extracted)
+		renv.Log().Verbosef("create placementgroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete loadbalancer done")
+		renv.Log().Verbose("create placementgroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8023,16 +7398,37 @@ func (cmd *DeleteLoadbalancer) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *DeleteLoadbalancer) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("loadbalancer"), nil
+func (cmd *CreatePlacementgroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreatePlacementGroupInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreatePlacementGroupInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreatePlacementGroup(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreatePlacementGroup call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create placementgroup ok")
+			return fakeDryRunId("placementgroup"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *DeleteLoadbalancer) inject(params map[string]interface{}) error {
+func (cmd *CreatePlacementgroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteLoginprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteLoginprofile {
-	cmd := new(DeleteLoginprofile)
+func NewCreatePolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreatePolicy {
+	cmd := new(CreatePolicy)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -8045,18 +7441,11 @@ func NewDeleteLoginprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger
 	return cmd
 }
 
-func (cmd *DeleteLoginprofile) SetApi(api iamiface.IAMAPI) {
+func (cmd *CreatePolicy) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteLoginprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteLoginprofile) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreatePolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8067,13 +7456,13 @@ func (cmd *DeleteLoginprofile) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	input := &iam.DeleteLoginProfileInput{}
+	input := &iam.CreatePolicyInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.DeleteLoginProfileInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in iam.CreatePolicyInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteLoginProfile(input)
-	renv.Log().ExtraVerbosef("iam.DeleteLoginProfile call took %s", time.Since(start))
+	output, err := cmd.api.CreatePolicy(input)
+	renv.Log().ExtraVerbosef("iam.CreatePolicy call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -8083,14 +7472,14 @@ func (cmd *DeleteLoginprofile) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete loginprofile: AWS command returned nil output")
+			renv.Log().Warning("create policy: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete loginprofile '%s' done", extracted)
+		renv.Log().Verbosef("create policy '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete loginprofile done")
+		renv.Log().Verbose("create policy done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8102,16 +7491,16 @@ func (cmd *DeleteLoginprofile) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *DeleteLoginprofile) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("loginprofile"), nil
+func (cmd *CreatePolicy) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("policy"), nil
 }
 
-func (cmd *DeleteLoginprofile) inject(params map[string]interface{}) error {
+func (cmd *CreatePolicy) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteMfadevice(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteMfadevice {
-	cmd := new(DeleteMfadevice)
+func NewCreatePolicyversion(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreatePolicyversion {
+	cmd := new(CreatePolicyversion)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -8124,18 +7513,11 @@ func NewDeleteMfadevice(sess *session.Session, g cloud.GraphAPI, l ...*logger.Lo
 	return cmd
 }
 
-func (cmd *DeleteMfadevice) SetApi(api iamiface.IAMAPI) {
+func (cmd *CreatePolicyversion) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteMfadevice) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteMfadevice) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreatePolicyversion) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8146,13 +7528,13 @@ func (cmd *DeleteMfadevice) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &iam.DeleteVirtualMFADeviceInput{}
+	input := &iam.CreatePolicyVersionInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.DeleteVirtualMFADeviceInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in iam.CreatePolicyVersionInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteVirtualMFADevice(input)
-	renv.Log().ExtraVerbosef("iam.DeleteVirtualMFADevice call took %s", time.Since(start))
+	output, err := cmd.api.CreatePolicyVersion(input)
+	renv.Log().ExtraVerbosef("iam.CreatePolicyVersion call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -8162,14 +7544,14 @@ func (cmd *DeleteMfadevice) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete mfadevice: AWS command returned nil output")
+			renv.Log().Warning("create policyversion: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete mfadevice '%s' done", extracted)
+		renv.Log().Verbosef("create policyversion '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete mfadevice done")
+		renv.Log().Verbose("create policyversion done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8181,40 +7563,33 @@ func (cmd *DeleteMfadevice) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DeleteMfadevice) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("mfadevice"), nil
+func (cmd *CreatePolicyversion) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("policyversion"), nil
 }
 
-func (cmd *DeleteMfadevice) inject(params map[string]interface{}) error {
+func (cmd *CreatePolicyversion) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteNatgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteNatgateway {
-	cmd := new(DeleteNatgateway)
+func NewCreatePresignedurl(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreatePresignedurl {
+	cmd := new(CreatePresignedurl)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = s3.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteNatgateway) SetApi(api ec2iface.EC2API) {
+func (cmd *CreatePresignedurl) SetApi(api s3iface.S3API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteNatgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteNatgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreatePresignedurl) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8225,13 +7600,7 @@ func (cmd *DeleteNatgateway) run(renv env.Running, params map[string]interface{}
 		}
 	}
 
-	input := &ec2.DeleteNatGatewayInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteNatGatewayInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.DeleteNatGateway(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteNatGateway call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -8241,14 +7610,14 @@ func (cmd *DeleteNatgateway) run(renv env.Running, params map[string]interface{}
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete natgateway: AWS command returned nil output")
+			renv.Log().Warning("create presignedurl: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete natgateway '%s' done", extracted)
+		renv.Log().Verbosef("create presignedurl '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete natgateway done")
+		renv.Log().Verbose("create presignedurl done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8260,40 +7629,33 @@ func (cmd *DeleteNatgateway) run(renv env.Running, params map[string]interface{}
 	return extracted, nil
 }
 
-func (cmd *DeleteNatgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("natgateway"), nil
+func (cmd *CreatePresignedurl) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("presignedurl"), nil
 }
 
-func (cmd *DeleteNatgateway) inject(params map[string]interface{}) error {
+func (cmd *CreatePresignedurl) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteNetworkinterface(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteNetworkinterface {
-	cmd := new(DeleteNetworkinterface)
+func NewCreateQueue(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateQueue {
+	cmd := new(CreateQueue)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = sqs.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteNetworkinterface) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateQueue) SetApi(api sqsiface.SQSAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteNetworkinterface) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteNetworkinterface) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateQueue) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8304,14 +7666,14 @@ func (cmd *DeleteNetworkinterface) run(renv env.Running, params map[string]inter
 		}
 	}
 
-	input := &ec2.DeleteNetworkInterfaceInput{}
+	input := &sqs.CreateQueueInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteNetworkInterfaceInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in sqs.CreateQueueInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteNetworkInterface(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteNetworkInterface call took %s", time.Since(start))
-	if err != nil {
+	output, err := cmd.api.CreateQueue(input)
+	renv.Log().ExtraVerbosef("sqs.CreateQueue call took %s", time.Since(start))
+	if err != nil {
 		return nil, decorateAWSError(err)
 	}
 
@@ -8320,14 +7682,14 @@ func (cmd *DeleteNetworkinterface) run(renv env.Running, params map[string]inter
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete networkinterface: AWS command returned nil output")
+			renv.Log().Warning("create queue: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete networkinterface '%s' done", extracted)
+		renv.Log().Verbosef("create queue '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete networkinterface done")
+		renv.Log().Verbose("create queue done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8339,61 +7701,33 @@ func (cmd *DeleteNetworkinterface) run(renv env.Running, params map[string]inter
 	return extracted, nil
 }
 
-func (cmd *DeleteNetworkinterface) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.DeleteNetworkInterfaceInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteNetworkInterfaceInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.DeleteNetworkInterface(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteNetworkInterface call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete networkinterface ok")
-			return fakeDryRunId("networkinterface"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CreateQueue) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("queue"), nil
 }
 
-func (cmd *DeleteNetworkinterface) inject(params map[string]interface{}) error {
+func (cmd *CreateQueue) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeletePolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeletePolicy {
-	cmd := new(DeletePolicy)
+func NewCreateRecord(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRecord {
+	cmd := new(CreateRecord)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = route53.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeletePolicy) SetApi(api iamiface.IAMAPI) {
+func (cmd *CreateRecord) SetApi(api route53iface.Route53API) {
 	cmd.api = api
 }
 
-func (cmd *DeletePolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeletePolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateRecord) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8404,13 +7738,7 @@ func (cmd *DeletePolicy) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &iam.DeletePolicyInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.DeletePolicyInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.DeletePolicy(input)
-	renv.Log().ExtraVerbosef("iam.DeletePolicy call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -8420,14 +7748,14 @@ func (cmd *DeletePolicy) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete policy: AWS command returned nil output")
+			renv.Log().Warning("create record: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete policy '%s' done", extracted)
+		renv.Log().Verbosef("create record '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete policy done")
+		renv.Log().Verbose("create record done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8439,40 +7767,33 @@ func (cmd *DeletePolicy) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *DeletePolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("policy"), nil
+func (cmd *CreateRecord) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("record"), nil
 }
 
-func (cmd *DeletePolicy) inject(params map[string]interface{}) error {
+func (cmd *CreateRecord) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteQueue(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteQueue {
-	cmd := new(DeleteQueue)
+func NewCreateRepository(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRepository {
+	cmd := new(CreateRepository)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = sqs.New(sess)
+		cmd.api = ecr.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteQueue) SetApi(api sqsiface.SQSAPI) {
+func (cmd *CreateRepository) SetApi(api ecriface.ECRAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteQueue) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteQueue) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateRepository) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8483,13 +7804,13 @@ func (cmd *DeleteQueue) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &sqs.DeleteQueueInput{}
+	input := &ecr.CreateRepositoryInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in sqs.DeleteQueueInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ecr.CreateRepositoryInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteQueue(input)
-	renv.Log().ExtraVerbosef("sqs.DeleteQueue call took %s", time.Since(start))
+	output, err := cmd.api.CreateRepository(input)
+	renv.Log().ExtraVerbosef("ecr.CreateRepository call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -8499,14 +7820,14 @@ func (cmd *DeleteQueue) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete queue: AWS command returned nil output")
+			renv.Log().Warning("create repository: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete queue '%s' done", extracted)
+		renv.Log().Verbosef("create repository '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete queue done")
+		renv.Log().Verbose("create repository done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8518,40 +7839,33 @@ func (cmd *DeleteQueue) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *DeleteQueue) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("queue"), nil
+func (cmd *CreateRepository) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("repository"), nil
 }
 
-func (cmd *DeleteQueue) inject(params map[string]interface{}) error {
+func (cmd *CreateRepository) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteRecord(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRecord {
-	cmd := new(DeleteRecord)
+func NewCreateResource(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateResource {
+	cmd := new(CreateResource)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = route53.New(sess)
+		cmd.api = apigateway.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteRecord) SetApi(api route53iface.Route53API) {
+func (cmd *CreateResource) SetApi(api apigatewayiface.APIGatewayAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteRecord) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteRecord) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateResource) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8562,7 +7876,13 @@ func (cmd *DeleteRecord) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &apigateway.CreateResourceInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in apigateway.CreateResourceInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateResource(input)
+	renv.Log().ExtraVerbosef("apigateway.CreateResource call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -8572,14 +7892,14 @@ func (cmd *DeleteRecord) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete record: AWS command returned nil output")
+			renv.Log().Warning("create resource: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete record '%s' done", extracted)
+		renv.Log().Verbosef("create resource '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete record done")
+		renv.Log().Verbose("create resource done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8591,40 +7911,33 @@ func (cmd *DeleteRecord) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *DeleteRecord) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("record"), nil
+func (cmd *CreateResource) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("resource"), nil
 }
 
-func (cmd *DeleteRecord) inject(params map[string]interface{}) error {
+func (cmd *CreateResource) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteRepository(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRepository {
-	cmd := new(DeleteRepository)
+func NewCreateRestapi(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRestapi {
+	cmd := new(CreateRestapi)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ecr.New(sess)
+		cmd.api = apigateway.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteRepository) SetApi(api ecriface.ECRAPI) {
+func (cmd *CreateRestapi) SetApi(api apigatewayiface.APIGatewayAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteRepository) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteRepository) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateRestapi) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8635,13 +7948,13 @@ func (cmd *DeleteRepository) run(renv env.Running, params map[string]interface{}
 		}
 	}
 
-	input := &ecr.DeleteRepositoryInput{}
+	input := &apigateway.CreateRestApiInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ecr.DeleteRepositoryInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in apigateway.CreateRestApiInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteRepository(input)
-	renv.Log().ExtraVerbosef("ecr.DeleteRepository call took %s", time.Since(start))
+	output, err := cmd.api.CreateRestApi(input)
+	renv.Log().ExtraVerbosef("apigateway.CreateRestApi call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -8651,14 +7964,14 @@ func (cmd *DeleteRepository) run(renv env.Running, params map[string]interface{}
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete repository: AWS command returned nil output")
+			renv.Log().Warning("create restapi: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete repository '%s' done", extracted)
+		renv.Log().Verbosef("create restapi '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete repository done")
+		renv.Log().Verbose("create restapi done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8670,16 +7983,16 @@ func (cmd *DeleteRepository) run(renv env.Running, params map[string]interface{}
 	return extracted, nil
 }
 
-func (cmd *DeleteRepository) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("repository"), nil
+func (cmd *CreateRestapi) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("restapi"), nil
 }
 
-func (cmd *DeleteRepository) inject(params map[string]interface{}) error {
+func (cmd *CreateRestapi) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteRole(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRole {
-	cmd := new(DeleteRole)
+func NewCreateRole(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRole {
+	cmd := new(CreateRole)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -8692,18 +8005,11 @@ func NewDeleteRole(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger)
 	return cmd
 }
 
-func (cmd *DeleteRole) SetApi(api iamiface.IAMAPI) {
+func (cmd *CreateRole) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteRole) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteRole) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateRole) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8724,14 +8030,14 @@ func (cmd *DeleteRole) run(renv env.Running, params map[string]interface{}) (int
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete role: AWS command returned nil output")
+			renv.Log().Warning("create role: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete role '%s' done", extracted)
+		renv.Log().Verbosef("create role '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete role done")
+		renv.Log().Verbose("create role done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8743,16 +8049,16 @@ func (cmd *DeleteRole) run(renv env.Running, params map[string]interface{}) (int
 	return extracted, nil
 }
 
-func (cmd *DeleteRole) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateRole) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	return fakeDryRunId("role"), nil
 }
 
-func (cmd *DeleteRole) inject(params map[string]interface{}) error {
+func (cmd *CreateRole) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteRoute(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRoute {
-	cmd := new(DeleteRoute)
+func NewCreateRoute(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRoute {
+	cmd := new(CreateRoute)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -8765,18 +8071,11 @@ func NewDeleteRoute(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger
 	return cmd
 }
 
-func (cmd *DeleteRoute) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateRoute) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteRoute) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteRoute) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateRoute) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8787,13 +8086,13 @@ func (cmd *DeleteRoute) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &ec2.DeleteRouteInput{}
+	input := &ec2.CreateRouteInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteRouteInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CreateRouteInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteRoute(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteRoute call took %s", time.Since(start))
+	output, err := cmd.api.CreateRoute(input)
+	renv.Log().ExtraVerbosef("ec2.CreateRoute call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -8803,14 +8102,14 @@ func (cmd *DeleteRoute) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete route: AWS command returned nil output")
+			renv.Log().Warning("create route: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete route '%s' done", extracted)
+		renv.Log().Verbosef("create route '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete route done")
+		renv.Log().Verbose("create route done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8822,24 +8121,24 @@ func (cmd *DeleteRoute) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *DeleteRoute) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateRoute) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
 
-	input := &ec2.DeleteRouteInput{}
+	input := &ec2.CreateRouteInput{}
 	input.SetDryRun(true)
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteRouteInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CreateRouteInput: %s", err)
 	}
 
 	start := time.Now()
-	_, err := cmd.api.DeleteRoute(input)
+	_, err := cmd.api.CreateRoute(input)
 	if awsErr, ok := err.(awserr.Error); ok {
 		switch code := awsErr.Code(); {
 		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteRoute call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete route ok")
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateRoute call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create route ok")
 			return fakeDryRunId("route"), nil
 		}
 	}
@@ -8847,12 +8146,12 @@ func (cmd *DeleteRoute) dryRun(renv env.Running, params map[string]interface{})
 	return nil, err
 }
 
-func (cmd *DeleteRoute) inject(params map[string]interface{}) error {
+func (cmd *CreateRoute) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteRoutetable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRoutetable {
-	cmd := new(DeleteRoutetable)
+func NewCreateRoutetable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRoutetable {
+	cmd := new(CreateRoutetable)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -8865,18 +8164,11 @@ func NewDeleteRoutetable(sess *session.Session, g cloud.GraphAPI, l ...*logger.L
 	return cmd
 }
 
-func (cmd *DeleteRoutetable) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateRoutetable) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteRoutetable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteRoutetable) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateRoutetable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8887,13 +8179,13 @@ func (cmd *DeleteRoutetable) run(renv env.Running, params map[string]interface{}
 		}
 	}
 
-	input := &ec2.DeleteRouteTableInput{}
+	input := &ec2.CreateRouteTableInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteRouteTableInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CreateRouteTableInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteRouteTable(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteRouteTable call took %s", time.Since(start))
+	output, err := cmd.api.CreateRouteTable(input)
+	renv.Log().ExtraVerbosef("ec2.CreateRouteTable call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -8903,14 +8195,14 @@ func (cmd *DeleteRoutetable) run(renv env.Running, params map[string]interface{}
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete routetable: AWS command returned nil output")
+			renv.Log().Warning("create routetable: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete routetable '%s' done", extracted)
+		renv.Log().Verbosef("create routetable '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete routetable done")
+		renv.Log().Verbose("create routetable done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8922,24 +8214,24 @@ func (cmd *DeleteRoutetable) run(renv env.Running, params map[string]interface{}
 	return extracted, nil
 }
 
-func (cmd *DeleteRoutetable) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateRoutetable) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
 
-	input := &ec2.DeleteRouteTableInput{}
+	input := &ec2.CreateRouteTableInput{}
 	input.SetDryRun(true)
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteRouteTableInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CreateRouteTableInput: %s", err)
 	}
 
 	start := time.Now()
-	_, err := cmd.api.DeleteRouteTable(input)
+	_, err := cmd.api.CreateRouteTable(input)
 	if awsErr, ok := err.(awserr.Error); ok {
 		switch code := awsErr.Code(); {
 		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteRouteTable call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete routetable ok")
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateRouteTable call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create routetable ok")
 			return fakeDryRunId("routetable"), nil
 		}
 	}
@@ -8947,36 +8239,29 @@ func (cmd *DeleteRoutetable) dryRun(renv env.Running, params map[string]interfac
 	return nil, err
 }
 
-func (cmd *DeleteRoutetable) inject(params map[string]interface{}) error {
+func (cmd *CreateRoutetable) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteS3object(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteS3object {
-	cmd := new(DeleteS3object)
+func NewCreateRule(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRule {
+	cmd := new(CreateRule)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = s3.New(sess)
+		cmd.api = cloudwatchevents.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteS3object) SetApi(api s3iface.S3API) {
+func (cmd *CreateRule) SetApi(api cloudwatcheventsiface.CloudWatchEventsAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteS3object) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteS3object) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateRule) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8987,13 +8272,13 @@ func (cmd *DeleteS3object) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &s3.DeleteObjectInput{}
+	input := &cloudwatchevents.PutRuleInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in s3.DeleteObjectInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in cloudwatchevents.PutRuleInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteObject(input)
-	renv.Log().ExtraVerbosef("s3.DeleteObject call took %s", time.Since(start))
+	output, err := cmd.api.PutRule(input)
+	renv.Log().ExtraVerbosef("cloudwatchevents.PutRule call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -9003,14 +8288,14 @@ func (cmd *DeleteS3object) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete s3object: AWS command returned nil output")
+			renv.Log().Warning("create rule: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete s3object '%s' done", extracted)
+		renv.Log().Verbosef("create rule '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete s3object done")
+		renv.Log().Verbose("create rule done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -9022,40 +8307,33 @@ func (cmd *DeleteS3object) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DeleteS3object) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("s3object"), nil
+func (cmd *CreateRule) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("rule"), nil
 }
 
-func (cmd *DeleteS3object) inject(params map[string]interface{}) error {
+func (cmd *CreateRule) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteScalinggroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteScalinggroup {
-	cmd := new(DeleteScalinggroup)
+func NewCreateS3object(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateS3object {
+	cmd := new(CreateS3object)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = autoscaling.New(sess)
+		cmd.api = s3.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteScalinggroup) SetApi(api autoscalingiface.AutoScalingAPI) {
+func (cmd *CreateS3object) SetApi(api s3iface.S3API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteScalinggroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteScalinggroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateS3object) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9066,13 +8344,7 @@ func (cmd *DeleteScalinggroup) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	input := &autoscaling.DeleteAutoScalingGroupInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in autoscaling.DeleteAutoScalingGroupInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.DeleteAutoScalingGroup(input)
-	renv.Log().ExtraVerbosef("autoscaling.DeleteAutoScalingGroup call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -9082,14 +8354,14 @@ func (cmd *DeleteScalinggroup) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete scalinggroup: AWS command returned nil output")
+			renv.Log().Warning("create s3object: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete scalinggroup '%s' done", extracted)
+		renv.Log().Verbosef("create s3object '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete scalinggroup done")
+		renv.Log().Verbose("create s3object done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -9101,16 +8373,16 @@ func (cmd *DeleteScalinggroup) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *DeleteScalinggroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("scalinggroup"), nil
+func (cmd *CreateS3object) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("s3object"), nil
 }
 
-func (cmd *DeleteScalinggroup) inject(params map[string]interface{}) error {
+func (cmd *CreateS3object) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteScalingpolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteScalingpolicy {
-	cmd := new(DeleteScalingpolicy)
+func NewCreateScalinggroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateScalinggroup {
+	cmd := new(CreateScalinggroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -9123,18 +8395,11 @@ func NewDeleteScalingpolicy(sess *session.Session, g cloud.GraphAPI, l ...*logge
 	return cmd
 }
 
-func (cmd *DeleteScalingpolicy) SetApi(api autoscalingiface.AutoScalingAPI) {
+func (cmd *CreateScalinggroup) SetApi(api autoscalingiface.AutoScalingAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteScalingpolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteScalingpolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateScalinggroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9145,13 +8410,13 @@ func (cmd *DeleteScalingpolicy) run(renv env.Running, params map[string]interfac
 		}
 	}
 
-	input := &autoscaling.DeletePolicyInput{}
+	input := &autoscaling.CreateAutoScalingGroupInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in autoscaling.DeletePolicyInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in autoscaling.CreateAutoScalingGroupInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeletePolicy(input)
-	renv.Log().ExtraVerbosef("autoscaling.DeletePolicy call took %s", time.Since(start))
+	output, err := cmd.api.CreateAutoScalingGroup(input)
+	renv.Log().ExtraVerbosef("autoscaling.CreateAutoScalingGroup call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -9161,14 +8426,14 @@ func (cmd *DeleteScalingpolicy) run(renv env.Running, params map[string]interfac
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete scalingpolicy: AWS command returned nil output")
+			renv.Log().Warning("create scalinggroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete scalingpolicy '%s' done", extracted)
+		renv.Log().Verbosef("create scalinggroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete scalingpolicy done")
+		renv.Log().Verbose("create scalinggroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -9180,40 +8445,33 @@ func (cmd *DeleteScalingpolicy) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *DeleteScalingpolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("scalingpolicy"), nil
+func (cmd *CreateScalinggroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("scalinggroup"), nil
 }
 
-func (cmd *DeleteScalingpolicy) inject(params map[string]interface{}) error {
+func (cmd *CreateScalinggroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteSecuritygroup {
-	cmd := new(DeleteSecuritygroup)
+func NewCreateScalingpolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateScalingpolicy {
+	cmd := new(CreateScalingpolicy)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = autoscaling.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteSecuritygroup) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateScalingpolicy) SetApi(api autoscalingiface.AutoScalingAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteSecuritygroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteSecuritygroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateScalingpolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9224,13 +8482,13 @@ func (cmd *DeleteSecuritygroup) run(renv env.Running, params map[string]interfac
 		}
 	}
 
-	input := &ec2.DeleteSecurityGroupInput{}
+	input := &autoscaling.PutScalingPolicyInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteSecurityGroupInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in autoscaling.PutScalingPolicyInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteSecurityGroup(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteSecurityGroup call took %s", time.Since(start))
+	output, err := cmd.api.PutScalingPolicy(input)
+	renv.Log().ExtraVerbosef("autoscaling.PutScalingPolicy call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -9240,14 +8498,14 @@ func (cmd *DeleteSecuritygroup) run(renv env.Running, params map[string]interfac
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete securitygroup: AWS command returned nil output")
+			renv.Log().Warning("create scalingpolicy: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete securitygroup '%s' done", extracted)
+		renv.Log().Verbosef("create scalingpolicy '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete securitygroup done")
+		renv.Log().Verbose("create scalingpolicy done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -9259,61 +8517,33 @@ func (cmd *DeleteSecuritygroup) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *DeleteSecuritygroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.DeleteSecurityGroupInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteSecurityGroupInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.DeleteSecurityGroup(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteSecurityGroup call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete securitygroup ok")
-			return fakeDryRunId("securitygroup"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CreateScalingpolicy) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("scalingpolicy"), nil
 }
 
-func (cmd *DeleteSecuritygroup) inject(params map[string]interface{}) error {
+func (cmd *CreateScalingpolicy) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteSnapshot(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteSnapshot {
-	cmd := new(DeleteSnapshot)
+func NewCreateSecret(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateSecret {
+	cmd := new(CreateSecret)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = secretsmanager.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteSnapshot) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateSecret) SetApi(api secretsmanageriface.SecretsManagerAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteSnapshot) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteSnapshot) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateSecret) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9324,13 +8554,13 @@ func (cmd *DeleteSnapshot) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &ec2.DeleteSnapshotInput{}
+	input := &secretsmanager.CreateSecretInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteSnapshotInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in secretsmanager.CreateSecretInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteSnapshot(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteSnapshot call took %s", time.Since(start))
+	output, err := cmd.api.CreateSecret(input)
+	renv.Log().ExtraVerbosef("secretsmanager.CreateSecret call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -9340,14 +8570,14 @@ func (cmd *DeleteSnapshot) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete snapshot: AWS command returned nil output")
+			renv.Log().Warning("create secret: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete snapshot '%s' done", extracted)
+		renv.Log().Verbosef("create secret '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete snapshot done")
+		renv.Log().Verbose("create secret done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -9359,61 +8589,33 @@ func (cmd *DeleteSnapshot) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DeleteSnapshot) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.DeleteSnapshotInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteSnapshotInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.DeleteSnapshot(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteSnapshot call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete snapshot ok")
-			return fakeDryRunId("snapshot"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CreateSecret) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("secret"), nil
 }
 
-func (cmd *DeleteSnapshot) inject(params map[string]interface{}) error {
+func (cmd *CreateSecret) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteStack(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteStack {
-	cmd := new(DeleteStack)
+func NewCreateSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateSecuritygroup {
+	cmd := new(CreateSecuritygroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudformation.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteStack) SetApi(api cloudformationiface.CloudFormationAPI) {
+func (cmd *CreateSecuritygroup) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteStack) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteStack) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateSecuritygroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9424,13 +8626,13 @@ func (cmd *DeleteStack) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &cloudformation.DeleteStackInput{}
+	input := &ec2.CreateSecurityGroupInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in cloudformation.DeleteStackInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CreateSecurityGroupInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteStack(input)
-	renv.Log().ExtraVerbosef("cloudformation.DeleteStack call took %s", time.Since(start))
+	output, err := cmd.api.CreateSecurityGroup(input)
+	renv.Log().ExtraVerbosef("ec2.CreateSecurityGroup call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -9440,14 +8642,14 @@ func (cmd *DeleteStack) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete stack: AWS command returned nil output")
+			renv.Log().Warning("create securitygroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete stack '%s' done", extracted)
+		renv.Log().Verbosef("create securitygroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete stack done")
+		renv.Log().Verbose("create securitygroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -9459,16 +8661,37 @@ func (cmd *DeleteStack) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *DeleteStack) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("stack"), nil
+func (cmd *CreateSecuritygroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreateSecurityGroupInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateSecurityGroupInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateSecurityGroup(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateSecurityGroup call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create securitygroup ok")
+			return fakeDryRunId("securitygroup"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *DeleteStack) inject(params map[string]interface{}) error {
+func (cmd *CreateSecuritygroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteSubnet(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteSubnet {
-	cmd := new(DeleteSubnet)
+func NewCreateSnapshot(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateSnapshot {
+	cmd := new(CreateSnapshot)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -9481,18 +8704,11 @@ func NewDeleteSubnet(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logge
 	return cmd
 }
 
-func (cmd *DeleteSubnet) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateSnapshot) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteSubnet) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteSubnet) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateSnapshot) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9503,13 +8719,13 @@ func (cmd *DeleteSubnet) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &ec2.DeleteSubnetInput{}
+	input := &ec2.CreateSnapshotInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteSubnetInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CreateSnapshotInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteSubnet(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteSubnet call took %s", time.Since(start))
+	output, err := cmd.api.CreateSnapshot(input)
+	renv.Log().ExtraVerbosef("ec2.CreateSnapshot call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -9519,14 +8735,14 @@ func (cmd *DeleteSubnet) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete subnet: AWS command returned nil output")
+			renv.Log().Warning("create snapshot: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete subnet '%s' done", extracted)
+		renv.Log().Verbosef("create snapshot '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete subnet done")
+		renv.Log().Verbose("create snapshot done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -9538,61 +8754,54 @@ func (cmd *DeleteSubnet) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *DeleteSubnet) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateSnapshot) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
 
-	input := &ec2.DeleteSubnetInput{}
+	input := &ec2.CreateSnapshotInput{}
 	input.SetDryRun(true)
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteSubnetInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CreateSnapshotInput: %s", err)
 	}
 
 	start := time.Now()
-	_, err := cmd.api.DeleteSubnet(input)
+	_, err := cmd.api.CreateSnapshot(input)
 	if awsErr, ok := err.(awserr.Error); ok {
 		switch code := awsErr.Code(); {
 		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteSubnet call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete subnet ok")
-			return fakeDryRunId("subnet"), nil
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateSnapshot call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create snapshot ok")
+			return fakeDryRunId("snapshot"), nil
 		}
 	}
 
 	return nil, err
 }
 
-func (cmd *DeleteSubnet) inject(params map[string]interface{}) error {
+func (cmd *CreateSnapshot) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteSubscription(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteSubscription {
-	cmd := new(DeleteSubscription)
+func NewCreateSpotfleet(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateSpotfleet {
+	cmd := new(CreateSpotfleet)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = sns.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteSubscription) SetApi(api snsiface.SNSAPI) {
+func (cmd *CreateSpotfleet) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteSubscription) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteSubscription) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateSpotfleet) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9603,13 +8812,7 @@ func (cmd *DeleteSubscription) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	input := &sns.UnsubscribeInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in sns.UnsubscribeInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.Unsubscribe(input)
-	renv.Log().ExtraVerbosef("sns.Unsubscribe call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -9619,14 +8822,14 @@ func (cmd *DeleteSubscription) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete subscription: AWS command returned nil output")
+			renv.Log().Warning("create spotfleet: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete subscription '%s' done", extracted)
+		renv.Log().Verbosef("create spotfleet '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete subscription done")
+		renv.Log().Verbose("create spotfleet done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -9638,16 +8841,12 @@ func (cmd *DeleteSubscription) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *DeleteSubscription) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("subscription"), nil
-}
-
-func (cmd *DeleteSubscription) inject(params map[string]interface{}) error {
+func (cmd *CreateSpotfleet) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteTag(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteTag {
-	cmd := new(DeleteTag)
+func NewCreateSpotinstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateSpotinstance {
+	cmd := new(CreateSpotinstance)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -9660,18 +8859,11 @@ func NewDeleteTag(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger)
 	return cmd
 }
 
-func (cmd *DeleteTag) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateSpotinstance) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteTag) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteTag) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateSpotinstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9692,14 +8884,14 @@ func (cmd *DeleteTag) run(renv env.Running, params map[string]interface{}) (inte
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete tag: AWS command returned nil output")
+			renv.Log().Warning("create spotinstance: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete tag '%s' done", extracted)
+		renv.Log().Verbosef("create spotinstance '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete tag done")
+		renv.Log().Verbose("create spotinstance done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -9711,36 +8903,29 @@ func (cmd *DeleteTag) run(renv env.Running, params map[string]interface{}) (inte
 	return extracted, nil
 }
 
-func (cmd *DeleteTag) inject(params map[string]interface{}) error {
+func (cmd *CreateSpotinstance) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteTargetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteTargetgroup {
-	cmd := new(DeleteTargetgroup)
+func NewCreateStack(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateStack {
+	cmd := new(CreateStack)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elbv2.New(sess)
+		cmd.api = cloudformation.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteTargetgroup) SetApi(api elbv2iface.ELBV2API) {
+func (cmd *CreateStack) SetApi(api cloudformationiface.CloudFormationAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteTargetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteTargetgroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateStack) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9751,13 +8936,13 @@ func (cmd *DeleteTargetgroup) run(renv env.Running, params map[string]interface{
 		}
 	}
 
-	input := &elbv2.DeleteTargetGroupInput{}
+	input := &cloudformation.CreateStackInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in elbv2.DeleteTargetGroupInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in cloudformation.CreateStackInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteTargetGroup(input)
-	renv.Log().ExtraVerbosef("elbv2.DeleteTargetGroup call took %s", time.Since(start))
+	output, err := cmd.api.CreateStack(input)
+	renv.Log().ExtraVerbosef("cloudformation.CreateStack call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -9767,14 +8952,14 @@ func (cmd *DeleteTargetgroup) run(renv env.Running, params map[string]interface{
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete targetgroup: AWS command returned nil output")
+			renv.Log().Warning("create stack: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete targetgroup '%s' done", extracted)
+		renv.Log().Verbosef("create stack '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete targetgroup done")
+		renv.Log().Verbose("create stack done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -9786,40 +8971,33 @@ func (cmd *DeleteTargetgroup) run(renv env.Running, params map[string]interface{
 	return extracted, nil
 }
 
-func (cmd *DeleteTargetgroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("targetgroup"), nil
+func (cmd *CreateStack) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("stack"), nil
 }
 
-func (cmd *DeleteTargetgroup) inject(params map[string]interface{}) error {
+func (cmd *CreateStack) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteTopic(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteTopic {
-	cmd := new(DeleteTopic)
+func NewCreateStage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateStage {
+	cmd := new(CreateStage)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = sns.New(sess)
+		cmd.api = apigateway.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteTopic) SetApi(api snsiface.SNSAPI) {
+func (cmd *CreateStage) SetApi(api apigatewayiface.APIGatewayAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteTopic) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteTopic) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateStage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9830,13 +9008,13 @@ func (cmd *DeleteTopic) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &sns.DeleteTopicInput{}
+	input := &apigateway.CreateStageInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in sns.DeleteTopicInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in apigateway.CreateStageInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteTopic(input)
-	renv.Log().ExtraVerbosef("sns.DeleteTopic call took %s", time.Since(start))
+	output, err := cmd.api.CreateStage(input)
+	renv.Log().ExtraVerbosef("apigateway.CreateStage call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -9846,14 +9024,14 @@ func (cmd *DeleteTopic) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete topic: AWS command returned nil output")
+			renv.Log().Warning("create stage: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete topic '%s' done", extracted)
+		renv.Log().Verbosef("create stage '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete topic done")
+		renv.Log().Verbose("create stage done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -9865,40 +9043,33 @@ func (cmd *DeleteTopic) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *DeleteTopic) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("topic"), nil
+func (cmd *CreateStage) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("stage"), nil
 }
 
-func (cmd *DeleteTopic) inject(params map[string]interface{}) error {
+func (cmd *CreateStage) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteUser(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteUser {
-	cmd := new(DeleteUser)
+func NewCreateStatemachine(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateStatemachine {
+	cmd := new(CreateStatemachine)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = sfn.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteUser) SetApi(api iamiface.IAMAPI) {
+func (cmd *CreateStatemachine) SetApi(api sfniface.SFNAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteUser) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteUser) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateStatemachine) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9909,13 +9080,13 @@ func (cmd *DeleteUser) run(renv env.Running, params map[string]interface{}) (int
 		}
 	}
 
-	input := &iam.DeleteUserInput{}
+	input := &sfn.CreateStateMachineInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.DeleteUserInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in sfn.CreateStateMachineInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteUser(input)
-	renv.Log().ExtraVerbosef("iam.DeleteUser call took %s", time.Since(start))
+	output, err := cmd.api.CreateStateMachine(input)
+	renv.Log().ExtraVerbosef("sfn.CreateStateMachine call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -9925,14 +9096,14 @@ func (cmd *DeleteUser) run(renv env.Running, params map[string]interface{}) (int
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete user: AWS command returned nil output")
+			renv.Log().Warning("create statemachine: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete user '%s' done", extracted)
+		renv.Log().Verbosef("create statemachine '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete user done")
+		renv.Log().Verbose("create statemachine done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -9944,16 +9115,16 @@ func (cmd *DeleteUser) run(renv env.Running, params map[string]interface{}) (int
 	return extracted, nil
 }
 
-func (cmd *DeleteUser) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("user"), nil
+func (cmd *CreateStatemachine) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("statemachine"), nil
 }
 
-func (cmd *DeleteUser) inject(params map[string]interface{}) error {
+func (cmd *CreateStatemachine) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteVolume {
-	cmd := new(DeleteVolume)
+func NewCreateSubnet(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateSubnet {
+	cmd := new(CreateSubnet)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -9966,18 +9137,11 @@ func NewDeleteVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logge
 	return cmd
 }
 
-func (cmd *DeleteVolume) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateSubnet) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteVolume) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteVolume) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateSubnet) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9988,13 +9152,13 @@ func (cmd *DeleteVolume) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &ec2.DeleteVolumeInput{}
+	input := &ec2.CreateSubnetInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteVolumeInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CreateSubnetInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteVolume(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteVolume call took %s", time.Since(start))
+	output, err := cmd.api.CreateSubnet(input)
+	renv.Log().ExtraVerbosef("ec2.CreateSubnet call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -10004,14 +9168,14 @@ func (cmd *DeleteVolume) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete volume: AWS command returned nil output")
+			renv.Log().Warning("create subnet: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete volume '%s' done", extracted)
+		renv.Log().Verbosef("create subnet '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete volume done")
+		renv.Log().Verbose("create subnet done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10023,61 +9187,54 @@ func (cmd *DeleteVolume) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *DeleteVolume) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateSubnet) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
 
-	input := &ec2.DeleteVolumeInput{}
+	input := &ec2.CreateSubnetInput{}
 	input.SetDryRun(true)
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteVolumeInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CreateSubnetInput: %s", err)
 	}
 
 	start := time.Now()
-	_, err := cmd.api.DeleteVolume(input)
+	_, err := cmd.api.CreateSubnet(input)
 	if awsErr, ok := err.(awserr.Error); ok {
 		switch code := awsErr.Code(); {
 		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteVolume call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete volume ok")
-			return fakeDryRunId("volume"), nil
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateSubnet call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create subnet ok")
+			return fakeDryRunId("subnet"), nil
 		}
 	}
 
 	return nil, err
 }
 
-func (cmd *DeleteVolume) inject(params map[string]interface{}) error {
+func (cmd *CreateSubnet) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteVpc(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteVpc {
-	cmd := new(DeleteVpc)
+func NewCreateSubscription(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateSubscription {
+	cmd := new(CreateSubscription)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = sns.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteVpc) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateSubscription) SetApi(api snsiface.SNSAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteVpc) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteVpc) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateSubscription) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10088,13 +9245,13 @@ func (cmd *DeleteVpc) run(renv env.Running, params map[string]interface{}) (inte
 		}
 	}
 
-	input := &ec2.DeleteVpcInput{}
+	input := &sns.SubscribeInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpcInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in sns.SubscribeInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteVpc(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteVpc call took %s", time.Since(start))
+	output, err := cmd.api.Subscribe(input)
+	renv.Log().ExtraVerbosef("sns.Subscribe call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -10104,14 +9261,14 @@ func (cmd *DeleteVpc) run(renv env.Running, params map[string]interface{}) (inte
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete vpc: AWS command returned nil output")
+			renv.Log().Warning("create subscription: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete vpc '%s' done", extracted)
+		renv.Log().Verbosef("create subscription '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete vpc done")
+		renv.Log().Verbose("create subscription done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10123,61 +9280,33 @@ func (cmd *DeleteVpc) run(renv env.Running, params map[string]interface{}) (inte
 	return extracted, nil
 }
 
-func (cmd *DeleteVpc) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.DeleteVpcInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpcInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.DeleteVpc(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteVpc call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete vpc ok")
-			return fakeDryRunId("vpc"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CreateSubscription) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("subscription"), nil
 }
 
-func (cmd *DeleteVpc) inject(params map[string]interface{}) error {
+func (cmd *CreateSubscription) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteZone(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteZone {
-	cmd := new(DeleteZone)
+func NewCreateTable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateTable {
+	cmd := new(CreateTable)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = route53.New(sess)
+		cmd.api = dynamodb.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteZone) SetApi(api route53iface.Route53API) {
+func (cmd *CreateTable) SetApi(api dynamodbiface.DynamoDBAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteZone) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DeleteZone) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateTable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10188,13 +9317,7 @@ func (cmd *DeleteZone) run(renv env.Running, params map[string]interface{}) (int
 		}
 	}
 
-	input := &route53.DeleteHostedZoneInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in route53.DeleteHostedZoneInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.DeleteHostedZone(input)
-	renv.Log().ExtraVerbosef("route53.DeleteHostedZone call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -10204,14 +9327,14 @@ func (cmd *DeleteZone) run(renv env.Running, params map[string]interface{}) (int
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete zone: AWS command returned nil output")
+			renv.Log().Warning("create table: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete zone '%s' done", extracted)
+		renv.Log().Verbosef("create table '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete zone done")
+		renv.Log().Verbose("create table done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10223,40 +9346,33 @@ func (cmd *DeleteZone) run(renv env.Running, params map[string]interface{}) (int
 	return extracted, nil
 }
 
-func (cmd *DeleteZone) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("zone"), nil
+func (cmd *CreateTable) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("table"), nil
 }
 
-func (cmd *DeleteZone) inject(params map[string]interface{}) error {
+func (cmd *CreateTable) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachAlarm(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachAlarm {
-	cmd := new(DetachAlarm)
+func NewCreateTag(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateTag {
+	cmd := new(CreateTag)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudwatch.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DetachAlarm) SetApi(api cloudwatchiface.CloudWatchAPI) {
+func (cmd *CreateTag) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DetachAlarm) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DetachAlarm) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateTag) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10277,14 +9393,14 @@ func (cmd *DetachAlarm) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach alarm: AWS command returned nil output")
+			renv.Log().Warning("create tag: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach alarm '%s' done", extracted)
+		renv.Log().Verbosef("create tag '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach alarm done")
+		renv.Log().Verbose("create tag done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10296,40 +9412,29 @@ func (cmd *DetachAlarm) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *DetachAlarm) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("alarm"), nil
-}
-
-func (cmd *DetachAlarm) inject(params map[string]interface{}) error {
+func (cmd *CreateTag) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachContainertask(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachContainertask {
-	cmd := new(DetachContainertask)
+func NewCreateTargetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateTargetgroup {
+	cmd := new(CreateTargetgroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ecs.New(sess)
+		cmd.api = elbv2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DetachContainertask) SetApi(api ecsiface.ECSAPI) {
+func (cmd *CreateTargetgroup) SetApi(api elbv2iface.ELBV2API) {
 	cmd.api = api
 }
 
-func (cmd *DetachContainertask) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DetachContainertask) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateTargetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10340,7 +9445,13 @@ func (cmd *DetachContainertask) run(renv env.Running, params map[string]interfac
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &elbv2.CreateTargetGroupInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in elbv2.CreateTargetGroupInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateTargetGroup(input)
+	renv.Log().ExtraVerbosef("elbv2.CreateTargetGroup call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -10350,14 +9461,14 @@ func (cmd *DetachContainertask) run(renv env.Running, params map[string]interfac
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach containertask: AWS command returned nil output")
+			renv.Log().Warning("create targetgroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach containertask '%s' done", extracted)
+		renv.Log().Verbosef("create targetgroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach containertask done")
+		renv.Log().Verbose("create targetgroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10369,40 +9480,33 @@ func (cmd *DetachContainertask) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *DetachContainertask) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("containertask"), nil
+func (cmd *CreateTargetgroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("targetgroup"), nil
 }
 
-func (cmd *DetachContainertask) inject(params map[string]interface{}) error {
+func (cmd *CreateTargetgroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachElasticip(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachElasticip {
-	cmd := new(DetachElasticip)
+func NewCreateTopic(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateTopic {
+	cmd := new(CreateTopic)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = sns.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DetachElasticip) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateTopic) SetApi(api snsiface.SNSAPI) {
 	cmd.api = api
 }
 
-func (cmd *DetachElasticip) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DetachElasticip) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateTopic) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10413,13 +9517,13 @@ func (cmd *DetachElasticip) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &ec2.DisassociateAddressInput{}
+	input := &sns.CreateTopicInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DisassociateAddressInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in sns.CreateTopicInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DisassociateAddress(input)
-	renv.Log().ExtraVerbosef("ec2.DisassociateAddress call took %s", time.Since(start))
+	output, err := cmd.api.CreateTopic(input)
+	renv.Log().ExtraVerbosef("sns.CreateTopic call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -10429,14 +9533,14 @@ func (cmd *DetachElasticip) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach elasticip: AWS command returned nil output")
+			renv.Log().Warning("create topic: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach elasticip '%s' done", extracted)
+		renv.Log().Verbosef("create topic '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach elasticip done")
+		renv.Log().Verbose("create topic done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10448,61 +9552,33 @@ func (cmd *DetachElasticip) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DetachElasticip) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.DisassociateAddressInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DisassociateAddressInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.DisassociateAddress(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DisassociateAddress call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: detach elasticip ok")
-			return fakeDryRunId("elasticip"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CreateTopic) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("topic"), nil
 }
 
-func (cmd *DetachElasticip) inject(params map[string]interface{}) error {
+func (cmd *CreateTopic) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachInstance {
-	cmd := new(DetachInstance)
+func NewCreateTrail(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateTrail {
+	cmd := new(CreateTrail)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elbv2.New(sess)
+		cmd.api = cloudtrail.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DetachInstance) SetApi(api elbv2iface.ELBV2API) {
+func (cmd *CreateTrail) SetApi(api cloudtrailiface.CloudTrailAPI) {
 	cmd.api = api
 }
 
-func (cmd *DetachInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DetachInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateTrail) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10513,13 +9589,13 @@ func (cmd *DetachInstance) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &elbv2.DeregisterTargetsInput{}
+	input := &cloudtrail.CreateTrailInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in elbv2.DeregisterTargetsInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in cloudtrail.CreateTrailInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeregisterTargets(input)
-	renv.Log().ExtraVerbosef("elbv2.DeregisterTargets call took %s", time.Since(start))
+	output, err := cmd.api.CreateTrail(input)
+	renv.Log().ExtraVerbosef("cloudtrail.CreateTrail call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -10529,14 +9605,14 @@ func (cmd *DetachInstance) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach instance: AWS command returned nil output")
+			renv.Log().Warning("create trail: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach instance '%s' done", extracted)
+		renv.Log().Verbosef("create trail '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach instance done")
+		renv.Log().Verbose("create trail done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10548,40 +9624,33 @@ func (cmd *DetachInstance) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DetachInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("instance"), nil
+func (cmd *CreateTrail) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("trail"), nil
 }
 
-func (cmd *DetachInstance) inject(params map[string]interface{}) error {
+func (cmd *CreateTrail) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachInstanceprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachInstanceprofile {
-	cmd := new(DetachInstanceprofile)
+func NewCreateTransitgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateTransitgateway {
+	cmd := new(CreateTransitgateway)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = ec2transitgateway.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DetachInstanceprofile) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateTransitgateway) SetApi(api ec2transitgatewayiface.EC2TransitGatewayAPI) {
 	cmd.api = api
 }
 
-func (cmd *DetachInstanceprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DetachInstanceprofile) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateTransitgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10592,7 +9661,13 @@ func (cmd *DetachInstanceprofile) run(renv env.Running, params map[string]interf
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &ec2transitgateway.CreateTransitGatewayInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2transitgateway.CreateTransitGatewayInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateTransitGateway(input)
+	renv.Log().ExtraVerbosef("ec2transitgateway.CreateTransitGateway call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -10602,14 +9677,14 @@ func (cmd *DetachInstanceprofile) run(renv env.Running, params map[string]interf
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach instanceprofile: AWS command returned nil output")
+			renv.Log().Warning("create transitgateway: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach instanceprofile '%s' done", extracted)
+		renv.Log().Verbosef("create transitgateway '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach instanceprofile done")
+		renv.Log().Verbose("create transitgateway done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10621,40 +9696,54 @@ func (cmd *DetachInstanceprofile) run(renv env.Running, params map[string]interf
 	return extracted, nil
 }
 
-func (cmd *DetachInstanceprofile) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("instanceprofile"), nil
+func (cmd *CreateTransitgateway) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2transitgateway.CreateTransitGatewayInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2transitgateway.CreateTransitGatewayInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateTransitGateway(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2transitgateway.CreateTransitGateway call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create transitgateway ok")
+			return fakeDryRunId("transitgateway"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *DetachInstanceprofile) inject(params map[string]interface{}) error {
+func (cmd *CreateTransitgateway) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachInternetgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachInternetgateway {
-	cmd := new(DetachInternetgateway)
+func NewCreateTransitgatewayattachment(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateTransitgatewayattachment {
+	cmd := new(CreateTransitgatewayattachment)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = ec2transitgateway.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DetachInternetgateway) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateTransitgatewayattachment) SetApi(api ec2transitgatewayiface.EC2TransitGatewayAPI) {
 	cmd.api = api
 }
 
-func (cmd *DetachInternetgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DetachInternetgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateTransitgatewayattachment) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10665,13 +9754,13 @@ func (cmd *DetachInternetgateway) run(renv env.Running, params map[string]interf
 		}
 	}
 
-	input := &ec2.DetachInternetGatewayInput{}
+	input := &ec2transitgateway.CreateTransitGatewayVpcAttachmentInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DetachInternetGatewayInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2transitgateway.CreateTransitGatewayVpcAttachmentInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DetachInternetGateway(input)
-	renv.Log().ExtraVerbosef("ec2.DetachInternetGateway call took %s", time.Since(start))
+	output, err := cmd.api.CreateTransitGatewayVpcAttachment(input)
+	renv.Log().ExtraVerbosef("ec2transitgateway.CreateTransitGatewayVpcAttachment call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -10681,14 +9770,14 @@ func (cmd *DetachInternetgateway) run(renv env.Running, params map[string]interf
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach internetgateway: AWS command returned nil output")
+			renv.Log().Warning("create transitgatewayattachment: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach internetgateway '%s' done", extracted)
+		renv.Log().Verbosef("create transitgatewayattachment '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach internetgateway done")
+		renv.Log().Verbose("create transitgatewayattachment done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10700,61 +9789,54 @@ func (cmd *DetachInternetgateway) run(renv env.Running, params map[string]interf
 	return extracted, nil
 }
 
-func (cmd *DetachInternetgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateTransitgatewayattachment) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
 
-	input := &ec2.DetachInternetGatewayInput{}
+	input := &ec2transitgateway.CreateTransitGatewayVpcAttachmentInput{}
 	input.SetDryRun(true)
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DetachInternetGatewayInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2transitgateway.CreateTransitGatewayVpcAttachmentInput: %s", err)
 	}
 
 	start := time.Now()
-	_, err := cmd.api.DetachInternetGateway(input)
+	_, err := cmd.api.CreateTransitGatewayVpcAttachment(input)
 	if awsErr, ok := err.(awserr.Error); ok {
 		switch code := awsErr.Code(); {
 		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DetachInternetGateway call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: detach internetgateway ok")
-			return fakeDryRunId("internetgateway"), nil
+			renv.Log().ExtraVerbosef("dry run: ec2transitgateway.CreateTransitGatewayVpcAttachment call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create transitgatewayattachment ok")
+			return fakeDryRunId("transitgatewayattachment"), nil
 		}
 	}
 
 	return nil, err
 }
 
-func (cmd *DetachInternetgateway) inject(params map[string]interface{}) error {
+func (cmd *CreateTransitgatewayattachment) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachMfadevice(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachMfadevice {
-	cmd := new(DetachMfadevice)
+func NewCreateTransitgatewayroutetable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateTransitgatewayroutetable {
+	cmd := new(CreateTransitgatewayroutetable)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = ec2transitgateway.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DetachMfadevice) SetApi(api iamiface.IAMAPI) {
+func (cmd *CreateTransitgatewayroutetable) SetApi(api ec2transitgatewayiface.EC2TransitGatewayAPI) {
 	cmd.api = api
 }
 
-func (cmd *DetachMfadevice) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DetachMfadevice) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateTransitgatewayroutetable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10765,13 +9847,13 @@ func (cmd *DetachMfadevice) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &iam.DeactivateMFADeviceInput{}
+	input := &ec2transitgateway.CreateTransitGatewayRouteTableInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.DeactivateMFADeviceInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2transitgateway.CreateTransitGatewayRouteTableInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeactivateMFADevice(input)
-	renv.Log().ExtraVerbosef("iam.DeactivateMFADevice call took %s", time.Since(start))
+	output, err := cmd.api.CreateTransitGatewayRouteTable(input)
+	renv.Log().ExtraVerbosef("ec2transitgateway.CreateTransitGatewayRouteTable call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -10781,14 +9863,14 @@ func (cmd *DetachMfadevice) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach mfadevice: AWS command returned nil output")
+			renv.Log().Warning("create transitgatewayroutetable: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach mfadevice '%s' done", extracted)
+		renv.Log().Verbosef("create transitgatewayroutetable '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach mfadevice done")
+		renv.Log().Verbose("create transitgatewayroutetable done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10800,40 +9882,54 @@ func (cmd *DetachMfadevice) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DetachMfadevice) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("mfadevice"), nil
+func (cmd *CreateTransitgatewayroutetable) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2transitgateway.CreateTransitGatewayRouteTableInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2transitgateway.CreateTransitGatewayRouteTableInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateTransitGatewayRouteTable(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2transitgateway.CreateTransitGatewayRouteTable call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create transitgatewayroutetable ok")
+			return fakeDryRunId("transitgatewayroutetable"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *DetachMfadevice) inject(params map[string]interface{}) error {
+func (cmd *CreateTransitgatewayroutetable) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachNetworkinterface(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachNetworkinterface {
-	cmd := new(DetachNetworkinterface)
+func NewCreateUser(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateUser {
+	cmd := new(CreateUser)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DetachNetworkinterface) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateUser) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *DetachNetworkinterface) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DetachNetworkinterface) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateUser) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10844,7 +9940,13 @@ func (cmd *DetachNetworkinterface) run(renv env.Running, params map[string]inter
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &iam.CreateUserInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in iam.CreateUserInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateUser(input)
+	renv.Log().ExtraVerbosef("iam.CreateUser call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -10854,14 +9956,14 @@ func (cmd *DetachNetworkinterface) run(renv env.Running, params map[string]inter
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach networkinterface: AWS command returned nil output")
+			renv.Log().Warning("create user: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach networkinterface '%s' done", extracted)
+		renv.Log().Verbosef("create user '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach networkinterface done")
+		renv.Log().Verbose("create user done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10873,36 +9975,33 @@ func (cmd *DetachNetworkinterface) run(renv env.Running, params map[string]inter
 	return extracted, nil
 }
 
-func (cmd *DetachNetworkinterface) inject(params map[string]interface{}) error {
+func (cmd *CreateUser) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("user"), nil
+}
+
+func (cmd *CreateUser) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachPolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachPolicy {
-	cmd := new(DetachPolicy)
+func NewCreateVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateVolume {
+	cmd := new(CreateVolume)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DetachPolicy) SetApi(api iamiface.IAMAPI) {
+func (cmd *CreateVolume) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DetachPolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DetachPolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateVolume) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10913,7 +10012,13 @@ func (cmd *DetachPolicy) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &ec2.CreateVolumeInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateVolumeInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateVolume(input)
+	renv.Log().ExtraVerbosef("ec2.CreateVolume call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -10923,14 +10028,14 @@ func (cmd *DetachPolicy) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach policy: AWS command returned nil output")
+			renv.Log().Warning("create volume: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach policy '%s' done", extracted)
+		renv.Log().Verbosef("create volume '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach policy done")
+		renv.Log().Verbose("create volume done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10942,40 +10047,54 @@ func (cmd *DetachPolicy) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *DetachPolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("policy"), nil
+func (cmd *CreateVolume) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreateVolumeInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateVolumeInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateVolume(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateVolume call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create volume ok")
+			return fakeDryRunId("volume"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *DetachPolicy) inject(params map[string]interface{}) error {
+func (cmd *CreateVolume) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachRole(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachRole {
-	cmd := new(DetachRole)
+func NewCreateVpc(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateVpc {
+	cmd := new(CreateVpc)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DetachRole) SetApi(api iamiface.IAMAPI) {
+func (cmd *CreateVpc) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DetachRole) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DetachRole) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateVpc) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10986,13 +10105,13 @@ func (cmd *DetachRole) run(renv env.Running, params map[string]interface{}) (int
 		}
 	}
 
-	input := &iam.RemoveRoleFromInstanceProfileInput{}
+	input := &ec2.CreateVpcInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.RemoveRoleFromInstanceProfileInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CreateVpcInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.RemoveRoleFromInstanceProfile(input)
-	renv.Log().ExtraVerbosef("iam.RemoveRoleFromInstanceProfile call took %s", time.Since(start))
+	output, err := cmd.api.CreateVpc(input)
+	renv.Log().ExtraVerbosef("ec2.CreateVpc call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -11002,14 +10121,14 @@ func (cmd *DetachRole) run(renv env.Running, params map[string]interface{}) (int
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach role: AWS command returned nil output")
+			renv.Log().Warning("create vpc: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach role '%s' done", extracted)
+		renv.Log().Verbosef("create vpc '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach role done")
+		renv.Log().Verbose("create vpc done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -11021,18 +10140,39 @@ func (cmd *DetachRole) run(renv env.Running, params map[string]interface{}) (int
 	return extracted, nil
 }
 
-func (cmd *DetachRole) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("role"), nil
-}
+func (cmd *CreateVpc) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
 
-func (cmd *DetachRole) inject(params map[string]interface{}) error {
-	return structSetter(cmd, params)
-}
+	input := &ec2.CreateVpcInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateVpcInput: %s", err)
+	}
 
-func NewDetachRoutetable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachRoutetable {
-	cmd := new(DetachRoutetable)
-	if len(l) > 0 {
-		cmd.logger = l[0]
+	start := time.Now()
+	_, err := cmd.api.CreateVpc(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateVpc call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create vpc ok")
+			return fakeDryRunId("vpc"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *CreateVpc) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCreateVpcendpoint(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateVpcendpoint {
+	cmd := new(CreateVpcendpoint)
+	if len(l) > 0 {
+		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
@@ -11043,18 +10183,104 @@ func NewDetachRoutetable(sess *session.Session, g cloud.GraphAPI, l ...*logger.L
 	return cmd
 }
 
-func (cmd *DetachRoutetable) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateVpcendpoint) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DetachRoutetable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
+func (cmd *CreateVpcendpoint) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.CreateVpcEndpointInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateVpcEndpointInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateVpcEndpoint(input)
+	renv.Log().ExtraVerbosef("ec2.CreateVpcEndpoint call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create vpcendpoint: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create vpcendpoint '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create vpcendpoint done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateVpcendpoint) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreateVpcEndpointInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateVpcEndpointInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateVpcEndpoint(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateVpcEndpoint call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create vpcendpoint ok")
+			return fakeDryRunId("vpcendpoint"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *CreateVpcendpoint) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCreateVpnconnection(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateVpnconnection {
+	cmd := new(CreateVpnconnection)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
 	}
-	return cmd.run(renv, params)
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateVpnconnection) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
 }
 
-func (cmd *DetachRoutetable) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateVpnconnection) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -11065,13 +10291,13 @@ func (cmd *DetachRoutetable) run(renv env.Running, params map[string]interface{}
 		}
 	}
 
-	input := &ec2.DisassociateRouteTableInput{}
+	input := &ec2.CreateVpnConnectionInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DisassociateRouteTableInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CreateVpnConnectionInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DisassociateRouteTable(input)
-	renv.Log().ExtraVerbosef("ec2.DisassociateRouteTable call took %s", time.Since(start))
+	output, err := cmd.api.CreateVpnConnection(input)
+	renv.Log().ExtraVerbosef("ec2.CreateVpnConnection call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -11081,14 +10307,14 @@ func (cmd *DetachRoutetable) run(renv env.Running, params map[string]interface{}
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach routetable: AWS command returned nil output")
+			renv.Log().Warning("create vpnconnection: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach routetable '%s' done", extracted)
+		renv.Log().Verbosef("create vpnconnection '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach routetable done")
+		renv.Log().Verbose("create vpnconnection done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -11100,37 +10326,37 @@ func (cmd *DetachRoutetable) run(renv env.Running, params map[string]interface{}
 	return extracted, nil
 }
 
-func (cmd *DetachRoutetable) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateVpnconnection) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
 
-	input := &ec2.DisassociateRouteTableInput{}
+	input := &ec2.CreateVpnConnectionInput{}
 	input.SetDryRun(true)
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DisassociateRouteTableInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CreateVpnConnectionInput: %s", err)
 	}
 
 	start := time.Now()
-	_, err := cmd.api.DisassociateRouteTable(input)
+	_, err := cmd.api.CreateVpnConnection(input)
 	if awsErr, ok := err.(awserr.Error); ok {
 		switch code := awsErr.Code(); {
 		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DisassociateRouteTable call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: detach routetable ok")
-			return fakeDryRunId("routetable"), nil
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateVpnConnection call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create vpnconnection ok")
+			return fakeDryRunId("vpnconnection"), nil
 		}
 	}
 
 	return nil, err
 }
 
-func (cmd *DetachRoutetable) inject(params map[string]interface{}) error {
+func (cmd *CreateVpnconnection) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachSecuritygroup {
-	cmd := new(DetachSecuritygroup)
+func NewCreateVpngateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateVpngateway {
+	cmd := new(CreateVpngateway)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -11143,18 +10369,104 @@ func NewDetachSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logge
 	return cmd
 }
 
-func (cmd *DetachSecuritygroup) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateVpngateway) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DetachSecuritygroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
+func (cmd *CreateVpngateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.CreateVpnGatewayInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateVpnGatewayInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateVpnGateway(input)
+	renv.Log().ExtraVerbosef("ec2.CreateVpnGateway call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create vpngateway: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create vpngateway '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create vpngateway done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateVpngateway) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreateVpnGatewayInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateVpnGatewayInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateVpnGateway(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateVpnGateway call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create vpngateway ok")
+			return fakeDryRunId("vpngateway"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *CreateVpngateway) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCreateZone(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateZone {
+	cmd := new(CreateZone)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
 	}
-	return cmd.run(renv, params)
+	if sess != nil {
+		cmd.api = route53.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateZone) SetApi(api route53iface.Route53API) {
+	cmd.api = api
 }
 
-func (cmd *DetachSecuritygroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateZone) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -11165,7 +10477,13 @@ func (cmd *DetachSecuritygroup) run(renv env.Running, params map[string]interfac
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &route53.CreateHostedZoneInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in route53.CreateHostedZoneInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateHostedZone(input)
+	renv.Log().ExtraVerbosef("route53.CreateHostedZone call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -11175,14 +10493,14 @@ func (cmd *DetachSecuritygroup) run(renv env.Running, params map[string]interfac
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach securitygroup: AWS command returned nil output")
+			renv.Log().Warning("create zone: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach securitygroup '%s' done", extracted)
+		renv.Log().Verbosef("create zone '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach securitygroup done")
+		renv.Log().Verbose("create zone done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -11194,40 +10512,10707 @@ func (cmd *DetachSecuritygroup) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *DetachSecuritygroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("securitygroup"), nil
+func (cmd *CreateZone) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("zone"), nil
 }
 
-func (cmd *DetachSecuritygroup) inject(params map[string]interface{}) error {
+func (cmd *CreateZone) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachUser(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachUser {
-	cmd := new(DetachUser)
+func NewDeleteAccesskey(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteAccesskey {
+	cmd := new(DeleteAccesskey)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = iam.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteAccesskey) SetApi(api iamiface.IAMAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteAccesskey) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &iam.DeleteAccessKeyInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in iam.DeleteAccessKeyInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteAccessKey(input)
+	renv.Log().ExtraVerbosef("iam.DeleteAccessKey call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete accesskey: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete accesskey '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete accesskey done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteAccesskey) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("accesskey"), nil
+}
+
+func (cmd *DeleteAccesskey) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteAlarm(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteAlarm {
+	cmd := new(DeleteAlarm)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = cloudwatch.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteAlarm) SetApi(api cloudwatchiface.CloudWatchAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteAlarm) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &cloudwatch.DeleteAlarmsInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in cloudwatch.DeleteAlarmsInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteAlarms(input)
+	renv.Log().ExtraVerbosef("cloudwatch.DeleteAlarms call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete alarm: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete alarm '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete alarm done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteAlarm) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("alarm"), nil
+}
+
+func (cmd *DeleteAlarm) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteAlias(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteAlias {
+	cmd := new(DeleteAlias)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = kms.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteAlias) SetApi(api kmsiface.KMSAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteAlias) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &kms.DeleteAliasInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in kms.DeleteAliasInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteAlias(input)
+	renv.Log().ExtraVerbosef("kms.DeleteAlias call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete alias: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete alias '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete alias done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteAlias) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("alias"), nil
+}
+
+func (cmd *DeleteAlias) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteApplication(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteApplication {
+	cmd := new(DeleteApplication)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = elasticbeanstalk.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteApplication) SetApi(api elasticbeanstalkiface.ElasticBeanstalkAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteApplication) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &elasticbeanstalk.DeleteApplicationInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in elasticbeanstalk.DeleteApplicationInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteApplication(input)
+	renv.Log().ExtraVerbosef("elasticbeanstalk.DeleteApplication call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete application: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete application '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete application done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteApplication) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("application"), nil
+}
+
+func (cmd *DeleteApplication) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteAppscalingpolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteAppscalingpolicy {
+	cmd := new(DeleteAppscalingpolicy)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = applicationautoscaling.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteAppscalingpolicy) SetApi(api applicationautoscalingiface.ApplicationAutoScalingAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteAppscalingpolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &applicationautoscaling.DeleteScalingPolicyInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in applicationautoscaling.DeleteScalingPolicyInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteScalingPolicy(input)
+	renv.Log().ExtraVerbosef("applicationautoscaling.DeleteScalingPolicy call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete appscalingpolicy: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete appscalingpolicy '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete appscalingpolicy done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteAppscalingpolicy) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("appscalingpolicy"), nil
+}
+
+func (cmd *DeleteAppscalingpolicy) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteAppscalingtarget(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteAppscalingtarget {
+	cmd := new(DeleteAppscalingtarget)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = applicationautoscaling.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteAppscalingtarget) SetApi(api applicationautoscalingiface.ApplicationAutoScalingAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteAppscalingtarget) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &applicationautoscaling.DeregisterScalableTargetInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in applicationautoscaling.DeregisterScalableTargetInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeregisterScalableTarget(input)
+	renv.Log().ExtraVerbosef("applicationautoscaling.DeregisterScalableTarget call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete appscalingtarget: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete appscalingtarget '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete appscalingtarget done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteAppscalingtarget) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("appscalingtarget"), nil
+}
+
+func (cmd *DeleteAppscalingtarget) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteBucket(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteBucket {
+	cmd := new(DeleteBucket)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = s3.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteBucket) SetApi(api s3iface.S3API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteBucket) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &s3.DeleteBucketInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in s3.DeleteBucketInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteBucket(input)
+	renv.Log().ExtraVerbosef("s3.DeleteBucket call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete bucket: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete bucket '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete bucket done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteBucket) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("bucket"), nil
+}
+
+func (cmd *DeleteBucket) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteCachecluster(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteCachecluster {
+	cmd := new(DeleteCachecluster)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = elasticache.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteCachecluster) SetApi(api elasticacheiface.ElastiCacheAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteCachecluster) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &elasticache.DeleteCacheClusterInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in elasticache.DeleteCacheClusterInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteCacheCluster(input)
+	renv.Log().ExtraVerbosef("elasticache.DeleteCacheCluster call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete cachecluster: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete cachecluster '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete cachecluster done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteCachecluster) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("cachecluster"), nil
+}
+
+func (cmd *DeleteCachecluster) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteCachesubnetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteCachesubnetgroup {
+	cmd := new(DeleteCachesubnetgroup)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = elasticache.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteCachesubnetgroup) SetApi(api elasticacheiface.ElastiCacheAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteCachesubnetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &elasticache.DeleteCacheSubnetGroupInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in elasticache.DeleteCacheSubnetGroupInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteCacheSubnetGroup(input)
+	renv.Log().ExtraVerbosef("elasticache.DeleteCacheSubnetGroup call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete cachesubnetgroup: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete cachesubnetgroup '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete cachesubnetgroup done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteCachesubnetgroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("cachesubnetgroup"), nil
+}
+
+func (cmd *DeleteCachesubnetgroup) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteCatalogdatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteCatalogdatabase {
+	cmd := new(DeleteCatalogdatabase)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = glue.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteCatalogdatabase) SetApi(api glueiface.GlueAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteCatalogdatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &glue.DeleteDatabaseInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in glue.DeleteDatabaseInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteDatabase(input)
+	renv.Log().ExtraVerbosef("glue.DeleteDatabase call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete catalogdatabase: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete catalogdatabase '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete catalogdatabase done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteCatalogdatabase) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("catalogdatabase"), nil
+}
+
+func (cmd *DeleteCatalogdatabase) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteCertificate(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteCertificate {
+	cmd := new(DeleteCertificate)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = acm.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteCertificate) SetApi(api acmiface.ACMAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteCertificate) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &acm.DeleteCertificateInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in acm.DeleteCertificateInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteCertificate(input)
+	renv.Log().ExtraVerbosef("acm.DeleteCertificate call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete certificate: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete certificate '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete certificate done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteCertificate) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("certificate"), nil
+}
+
+func (cmd *DeleteCertificate) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteClassicloadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteClassicloadbalancer {
+	cmd := new(DeleteClassicloadbalancer)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = elb.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteClassicloadbalancer) SetApi(api elbiface.ELBAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteClassicloadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &elb.DeleteLoadBalancerInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in elb.DeleteLoadBalancerInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteLoadBalancer(input)
+	renv.Log().ExtraVerbosef("elb.DeleteLoadBalancer call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete classicloadbalancer: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete classicloadbalancer '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete classicloadbalancer done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteClassicloadbalancer) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("classicloadbalancer"), nil
+}
+
+func (cmd *DeleteClassicloadbalancer) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteCluster(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteCluster {
+	cmd := new(DeleteCluster)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = redshift.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteCluster) SetApi(api redshiftiface.RedshiftAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteCluster) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &redshift.DeleteClusterInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in redshift.DeleteClusterInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteCluster(input)
+	renv.Log().ExtraVerbosef("redshift.DeleteCluster call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete cluster: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete cluster '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete cluster done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteCluster) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("cluster"), nil
+}
+
+func (cmd *DeleteCluster) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteComputeenvironment(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteComputeenvironment {
+	cmd := new(DeleteComputeenvironment)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = batch.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteComputeenvironment) SetApi(api batchiface.BatchAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteComputeenvironment) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &batch.DeleteComputeEnvironmentInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in batch.DeleteComputeEnvironmentInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteComputeEnvironment(input)
+	renv.Log().ExtraVerbosef("batch.DeleteComputeEnvironment call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete computeenvironment: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete computeenvironment '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete computeenvironment done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteComputeenvironment) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("computeenvironment"), nil
+}
+
+func (cmd *DeleteComputeenvironment) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteContainercluster(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteContainercluster {
+	cmd := new(DeleteContainercluster)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ecs.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteContainercluster) SetApi(api ecsiface.ECSAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteContainercluster) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ecs.DeleteClusterInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ecs.DeleteClusterInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteCluster(input)
+	renv.Log().ExtraVerbosef("ecs.DeleteCluster call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete containercluster: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete containercluster '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete containercluster done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteContainercluster) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("containercluster"), nil
+}
+
+func (cmd *DeleteContainercluster) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteContainerservice(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteContainerservice {
+	cmd := new(DeleteContainerservice)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ecs.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteContainerservice) SetApi(api ecsiface.ECSAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteContainerservice) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete containerservice: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete containerservice '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete containerservice done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteContainerservice) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("containerservice"), nil
+}
+
+func (cmd *DeleteContainerservice) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteContainertask(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteContainertask {
+	cmd := new(DeleteContainertask)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ecs.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteContainertask) SetApi(api ecsiface.ECSAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteContainertask) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete containertask: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete containertask '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete containertask done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteContainertask) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteCrawler(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteCrawler {
+	cmd := new(DeleteCrawler)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = glue.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteCrawler) SetApi(api glueiface.GlueAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteCrawler) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &glue.DeleteCrawlerInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in glue.DeleteCrawlerInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteCrawler(input)
+	renv.Log().ExtraVerbosef("glue.DeleteCrawler call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete crawler: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete crawler '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete crawler done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteCrawler) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("crawler"), nil
+}
+
+func (cmd *DeleteCrawler) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteCustomergateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteCustomergateway {
+	cmd := new(DeleteCustomergateway)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteCustomergateway) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteCustomergateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DeleteCustomerGatewayInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteCustomerGatewayInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteCustomerGateway(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteCustomerGateway call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete customergateway: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete customergateway '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete customergateway done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteCustomergateway) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteCustomerGatewayInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteCustomerGatewayInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteCustomerGateway(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteCustomerGateway call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete customergateway ok")
+			return fakeDryRunId("customergateway"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteCustomergateway) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteDatabase {
+	cmd := new(DeleteDatabase)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = rds.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteDatabase) SetApi(api rdsiface.RDSAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &rds.DeleteDBInstanceInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in rds.DeleteDBInstanceInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteDBInstance(input)
+	renv.Log().ExtraVerbosef("rds.DeleteDBInstance call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete database: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete database '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete database done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteDatabase) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("database"), nil
+}
+
+func (cmd *DeleteDatabase) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteDbparametergroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteDbparametergroup {
+	cmd := new(DeleteDbparametergroup)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = rds.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteDbparametergroup) SetApi(api rdsiface.RDSAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteDbparametergroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &rds.DeleteDBParameterGroupInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in rds.DeleteDBParameterGroupInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteDBParameterGroup(input)
+	renv.Log().ExtraVerbosef("rds.DeleteDBParameterGroup call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete dbparametergroup: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete dbparametergroup '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete dbparametergroup done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteDbparametergroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("dbparametergroup"), nil
+}
+
+func (cmd *DeleteDbparametergroup) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteDbsubnetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteDbsubnetgroup {
+	cmd := new(DeleteDbsubnetgroup)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = rds.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteDbsubnetgroup) SetApi(api rdsiface.RDSAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteDbsubnetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &rds.DeleteDBSubnetGroupInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in rds.DeleteDBSubnetGroupInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteDBSubnetGroup(input)
+	renv.Log().ExtraVerbosef("rds.DeleteDBSubnetGroup call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete dbsubnetgroup: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete dbsubnetgroup '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete dbsubnetgroup done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteDbsubnetgroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("dbsubnetgroup"), nil
+}
+
+func (cmd *DeleteDbsubnetgroup) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteDeployment(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteDeployment {
+	cmd := new(DeleteDeployment)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = apigateway.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteDeployment) SetApi(api apigatewayiface.APIGatewayAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteDeployment) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &apigateway.DeleteDeploymentInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in apigateway.DeleteDeploymentInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteDeployment(input)
+	renv.Log().ExtraVerbosef("apigateway.DeleteDeployment call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete deployment: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete deployment '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete deployment done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteDeployment) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("deployment"), nil
+}
+
+func (cmd *DeleteDeployment) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteDistribution(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteDistribution {
+	cmd := new(DeleteDistribution)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = cloudfront.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteDistribution) SetApi(api cloudfrontiface.CloudFrontAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteDistribution) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete distribution: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete distribution '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete distribution done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteDistribution) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("distribution"), nil
+}
+
+func (cmd *DeleteDistribution) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteEgressonlyinternetgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteEgressonlyinternetgateway {
+	cmd := new(DeleteEgressonlyinternetgateway)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteEgressonlyinternetgateway) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteEgressonlyinternetgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DeleteEgressOnlyInternetGatewayInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteEgressOnlyInternetGatewayInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteEgressOnlyInternetGateway(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteEgressOnlyInternetGateway call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete egressonlyinternetgateway: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete egressonlyinternetgateway '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete egressonlyinternetgateway done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteEgressonlyinternetgateway) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteEgressOnlyInternetGatewayInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteEgressOnlyInternetGatewayInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteEgressOnlyInternetGateway(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteEgressOnlyInternetGateway call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete egressonlyinternetgateway ok")
+			return fakeDryRunId("egressonlyinternetgateway"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteEgressonlyinternetgateway) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteElasticip(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteElasticip {
+	cmd := new(DeleteElasticip)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteElasticip) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteElasticip) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.ReleaseAddressInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.ReleaseAddressInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.ReleaseAddress(input)
+	renv.Log().ExtraVerbosef("ec2.ReleaseAddress call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete elasticip: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete elasticip '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete elasticip done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteElasticip) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.ReleaseAddressInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.ReleaseAddressInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.ReleaseAddress(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.ReleaseAddress call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete elasticip ok")
+			return fakeDryRunId("elasticip"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteElasticip) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteFunction(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteFunction {
+	cmd := new(DeleteFunction)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = lambda.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteFunction) SetApi(api lambdaiface.LambdaAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteFunction) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &lambda.DeleteFunctionInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in lambda.DeleteFunctionInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteFunction(input)
+	renv.Log().ExtraVerbosef("lambda.DeleteFunction call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete function: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete function '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete function done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteFunction) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("function"), nil
+}
+
+func (cmd *DeleteFunction) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteGrant(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteGrant {
+	cmd := new(DeleteGrant)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = kms.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteGrant) SetApi(api kmsiface.KMSAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteGrant) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &kms.RevokeGrantInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in kms.RevokeGrantInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.RevokeGrant(input)
+	renv.Log().ExtraVerbosef("kms.RevokeGrant call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete grant: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete grant '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete grant done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteGrant) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("grant"), nil
+}
+
+func (cmd *DeleteGrant) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteGroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteGroup {
+	cmd := new(DeleteGroup)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = iam.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteGroup) SetApi(api iamiface.IAMAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteGroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &iam.DeleteGroupInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in iam.DeleteGroupInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteGroup(input)
+	renv.Log().ExtraVerbosef("iam.DeleteGroup call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete group: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete group '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete group done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteGroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("group"), nil
+}
+
+func (cmd *DeleteGroup) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteHost(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteHost {
+	cmd := new(DeleteHost)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteHost) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteHost) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.ReleaseHostsInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.ReleaseHostsInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.ReleaseHosts(input)
+	renv.Log().ExtraVerbosef("ec2.ReleaseHosts call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete host: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete host '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete host done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteHost) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("host"), nil
+}
+
+func (cmd *DeleteHost) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteImage {
+	cmd := new(DeleteImage)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteImage) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteImage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete image: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete image '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete image done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteImage) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteInstance {
+	cmd := new(DeleteInstance)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteInstance) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.TerminateInstancesInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.TerminateInstancesInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.TerminateInstances(input)
+	renv.Log().ExtraVerbosef("ec2.TerminateInstances call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete instance: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete instance '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete instance done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteInstance) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.TerminateInstancesInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.TerminateInstancesInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.TerminateInstances(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.TerminateInstances call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete instance ok")
+			return fakeDryRunId("instance"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteInstance) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteInstanceprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteInstanceprofile {
+	cmd := new(DeleteInstanceprofile)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = iam.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteInstanceprofile) SetApi(api iamiface.IAMAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteInstanceprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &iam.DeleteInstanceProfileInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in iam.DeleteInstanceProfileInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteInstanceProfile(input)
+	renv.Log().ExtraVerbosef("iam.DeleteInstanceProfile call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete instanceprofile: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete instanceprofile '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete instanceprofile done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteInstanceprofile) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("instanceprofile"), nil
+}
+
+func (cmd *DeleteInstanceprofile) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteInternetgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteInternetgateway {
+	cmd := new(DeleteInternetgateway)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteInternetgateway) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteInternetgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DeleteInternetGatewayInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteInternetGatewayInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteInternetGateway(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteInternetGateway call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete internetgateway: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete internetgateway '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete internetgateway done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteInternetgateway) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteInternetGatewayInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteInternetGatewayInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteInternetGateway(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteInternetGateway call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete internetgateway ok")
+			return fakeDryRunId("internetgateway"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteInternetgateway) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteJobdefinition(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteJobdefinition {
+	cmd := new(DeleteJobdefinition)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = batch.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteJobdefinition) SetApi(api batchiface.BatchAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteJobdefinition) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &batch.DeregisterJobDefinitionInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in batch.DeregisterJobDefinitionInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeregisterJobDefinition(input)
+	renv.Log().ExtraVerbosef("batch.DeregisterJobDefinition call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete jobdefinition: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete jobdefinition '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete jobdefinition done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteJobdefinition) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("jobdefinition"), nil
+}
+
+func (cmd *DeleteJobdefinition) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteJobqueue(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteJobqueue {
+	cmd := new(DeleteJobqueue)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = batch.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteJobqueue) SetApi(api batchiface.BatchAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteJobqueue) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &batch.DeleteJobQueueInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in batch.DeleteJobQueueInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteJobQueue(input)
+	renv.Log().ExtraVerbosef("batch.DeleteJobQueue call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete jobqueue: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete jobqueue '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete jobqueue done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteJobqueue) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("jobqueue"), nil
+}
+
+func (cmd *DeleteJobqueue) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteKey(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteKey {
+	cmd := new(DeleteKey)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = kms.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteKey) SetApi(api kmsiface.KMSAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteKey) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &kms.ScheduleKeyDeletionInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in kms.ScheduleKeyDeletionInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.ScheduleKeyDeletion(input)
+	renv.Log().ExtraVerbosef("kms.ScheduleKeyDeletion call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete key: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete key '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete key done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteKey) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("key"), nil
+}
+
+func (cmd *DeleteKey) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteKeypair(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteKeypair {
+	cmd := new(DeleteKeypair)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteKeypair) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteKeypair) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DeleteKeyPairInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteKeyPairInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteKeyPair(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteKeyPair call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete keypair: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete keypair '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete keypair done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteKeypair) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteKeyPairInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteKeyPairInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteKeyPair(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteKeyPair call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete keypair ok")
+			return fakeDryRunId("keypair"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteKeypair) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteKubernetescluster(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteKubernetescluster {
+	cmd := new(DeleteKubernetescluster)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = eks.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteKubernetescluster) SetApi(api eksiface.EKSAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteKubernetescluster) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &eks.DeleteClusterInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in eks.DeleteClusterInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteCluster(input)
+	renv.Log().ExtraVerbosef("eks.DeleteCluster call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete kubernetescluster: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete kubernetescluster '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete kubernetescluster done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteKubernetescluster) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("kubernetescluster"), nil
+}
+
+func (cmd *DeleteKubernetescluster) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteLaunchconfiguration(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteLaunchconfiguration {
+	cmd := new(DeleteLaunchconfiguration)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = autoscaling.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteLaunchconfiguration) SetApi(api autoscalingiface.AutoScalingAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteLaunchconfiguration) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &autoscaling.DeleteLaunchConfigurationInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in autoscaling.DeleteLaunchConfigurationInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteLaunchConfiguration(input)
+	renv.Log().ExtraVerbosef("autoscaling.DeleteLaunchConfiguration call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete launchconfiguration: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete launchconfiguration '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete launchconfiguration done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteLaunchconfiguration) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("launchconfiguration"), nil
+}
+
+func (cmd *DeleteLaunchconfiguration) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteListener(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteListener {
+	cmd := new(DeleteListener)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = elbv2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteListener) SetApi(api elbv2iface.ELBV2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteListener) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &elbv2.DeleteListenerInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in elbv2.DeleteListenerInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteListener(input)
+	renv.Log().ExtraVerbosef("elbv2.DeleteListener call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete listener: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete listener '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete listener done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteListener) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("listener"), nil
+}
+
+func (cmd *DeleteListener) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteLoadbalancer {
+	cmd := new(DeleteLoadbalancer)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = elbv2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteLoadbalancer) SetApi(api elbv2iface.ELBV2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &elbv2.DeleteLoadBalancerInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in elbv2.DeleteLoadBalancerInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteLoadBalancer(input)
+	renv.Log().ExtraVerbosef("elbv2.DeleteLoadBalancer call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete loadbalancer: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete loadbalancer '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete loadbalancer done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteLoadbalancer) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("loadbalancer"), nil
+}
+
+func (cmd *DeleteLoadbalancer) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteLoggroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteLoggroup {
+	cmd := new(DeleteLoggroup)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = cloudwatchlogs.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteLoggroup) SetApi(api cloudwatchlogsiface.CloudWatchLogsAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteLoggroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &cloudwatchlogs.DeleteLogGroupInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in cloudwatchlogs.DeleteLogGroupInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteLogGroup(input)
+	renv.Log().ExtraVerbosef("cloudwatchlogs.DeleteLogGroup call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete loggroup: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete loggroup '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete loggroup done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteLoggroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("loggroup"), nil
+}
+
+func (cmd *DeleteLoggroup) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteLoginprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteLoginprofile {
+	cmd := new(DeleteLoginprofile)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = iam.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteLoginprofile) SetApi(api iamiface.IAMAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteLoginprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &iam.DeleteLoginProfileInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in iam.DeleteLoginProfileInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteLoginProfile(input)
+	renv.Log().ExtraVerbosef("iam.DeleteLoginProfile call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete loginprofile: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete loginprofile '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete loginprofile done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteLoginprofile) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("loginprofile"), nil
+}
+
+func (cmd *DeleteLoginprofile) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteMethod(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteMethod {
+	cmd := new(DeleteMethod)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = apigateway.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteMethod) SetApi(api apigatewayiface.APIGatewayAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteMethod) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &apigateway.DeleteMethodInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in apigateway.DeleteMethodInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteMethod(input)
+	renv.Log().ExtraVerbosef("apigateway.DeleteMethod call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete method: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete method '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete method done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteMethod) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("method"), nil
+}
+
+func (cmd *DeleteMethod) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteMfadevice(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteMfadevice {
+	cmd := new(DeleteMfadevice)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = iam.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteMfadevice) SetApi(api iamiface.IAMAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteMfadevice) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &iam.DeleteVirtualMFADeviceInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in iam.DeleteVirtualMFADeviceInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteVirtualMFADevice(input)
+	renv.Log().ExtraVerbosef("iam.DeleteVirtualMFADevice call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete mfadevice: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete mfadevice '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete mfadevice done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteMfadevice) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("mfadevice"), nil
+}
+
+func (cmd *DeleteMfadevice) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteNatgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteNatgateway {
+	cmd := new(DeleteNatgateway)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteNatgateway) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteNatgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DeleteNatGatewayInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteNatGatewayInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteNatGateway(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteNatGateway call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete natgateway: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete natgateway '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete natgateway done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteNatgateway) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("natgateway"), nil
+}
+
+func (cmd *DeleteNatgateway) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteNetworkacl(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteNetworkacl {
+	cmd := new(DeleteNetworkacl)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteNetworkacl) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteNetworkacl) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DeleteNetworkAclInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteNetworkAclInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteNetworkAcl(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteNetworkAcl call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete networkacl: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete networkacl '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete networkacl done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteNetworkacl) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteNetworkAclInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteNetworkAclInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteNetworkAcl(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteNetworkAcl call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete networkacl ok")
+			return fakeDryRunId("networkacl"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteNetworkacl) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteNetworkaclrule(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteNetworkaclrule {
+	cmd := new(DeleteNetworkaclrule)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteNetworkaclrule) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteNetworkaclrule) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete networkaclrule: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete networkaclrule '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete networkaclrule done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteNetworkaclrule) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteNetworkinterface(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteNetworkinterface {
+	cmd := new(DeleteNetworkinterface)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteNetworkinterface) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteNetworkinterface) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DeleteNetworkInterfaceInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteNetworkInterfaceInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteNetworkInterface(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteNetworkInterface call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete networkinterface: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete networkinterface '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete networkinterface done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteNetworkinterface) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteNetworkInterfaceInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteNetworkInterfaceInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteNetworkInterface(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteNetworkInterface call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete networkinterface ok")
+			return fakeDryRunId("networkinterface"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteNetworkinterface) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteParameter(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteParameter {
+	cmd := new(DeleteParameter)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ssm.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteParameter) SetApi(api ssmiface.SSMAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteParameter) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ssm.DeleteParameterInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ssm.DeleteParameterInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteParameter(input)
+	renv.Log().ExtraVerbosef("ssm.DeleteParameter call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete parameter: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete parameter '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete parameter done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteParameter) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("parameter"), nil
+}
+
+func (cmd *DeleteParameter) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeletePeeringconnection(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeletePeeringconnection {
+	cmd := new(DeletePeeringconnection)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeletePeeringconnection) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeletePeeringconnection) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DeleteVpcPeeringConnectionInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpcPeeringConnectionInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteVpcPeeringConnection(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteVpcPeeringConnection call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete peeringconnection: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete peeringconnection '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete peeringconnection done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeletePeeringconnection) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteVpcPeeringConnectionInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpcPeeringConnectionInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteVpcPeeringConnection(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteVpcPeeringConnection call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete peeringconnection ok")
+			return fakeDryRunId("peeringconnection"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeletePeeringconnection) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeletePlacementgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeletePlacementgroup {
+	cmd := new(DeletePlacementgroup)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeletePlacementgroup) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeletePlacementgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DeletePlacementGroupInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeletePlacementGroupInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeletePlacementGroup(input)
+	renv.Log().ExtraVerbosef("ec2.DeletePlacementGroup call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete placementgroup: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete placementgroup '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete placementgroup done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeletePlacementgroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeletePlacementGroupInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeletePlacementGroupInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeletePlacementGroup(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeletePlacementGroup call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete placementgroup ok")
+			return fakeDryRunId("placementgroup"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeletePlacementgroup) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeletePolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeletePolicy {
+	cmd := new(DeletePolicy)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = iam.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeletePolicy) SetApi(api iamiface.IAMAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeletePolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &iam.DeletePolicyInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in iam.DeletePolicyInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeletePolicy(input)
+	renv.Log().ExtraVerbosef("iam.DeletePolicy call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete policy: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete policy '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete policy done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeletePolicy) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("policy"), nil
+}
+
+func (cmd *DeletePolicy) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeletePolicyversion(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeletePolicyversion {
+	cmd := new(DeletePolicyversion)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = iam.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeletePolicyversion) SetApi(api iamiface.IAMAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeletePolicyversion) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &iam.DeletePolicyVersionInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in iam.DeletePolicyVersionInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeletePolicyVersion(input)
+	renv.Log().ExtraVerbosef("iam.DeletePolicyVersion call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete policyversion: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete policyversion '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete policyversion done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeletePolicyversion) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("policyversion"), nil
+}
+
+func (cmd *DeletePolicyversion) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteQueue(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteQueue {
+	cmd := new(DeleteQueue)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = sqs.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteQueue) SetApi(api sqsiface.SQSAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteQueue) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &sqs.DeleteQueueInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in sqs.DeleteQueueInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteQueue(input)
+	renv.Log().ExtraVerbosef("sqs.DeleteQueue call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete queue: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete queue '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete queue done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteQueue) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("queue"), nil
+}
+
+func (cmd *DeleteQueue) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteRecord(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRecord {
+	cmd := new(DeleteRecord)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = route53.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteRecord) SetApi(api route53iface.Route53API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteRecord) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete record: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete record '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete record done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteRecord) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("record"), nil
+}
+
+func (cmd *DeleteRecord) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteRepository(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRepository {
+	cmd := new(DeleteRepository)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ecr.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteRepository) SetApi(api ecriface.ECRAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteRepository) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ecr.DeleteRepositoryInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ecr.DeleteRepositoryInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteRepository(input)
+	renv.Log().ExtraVerbosef("ecr.DeleteRepository call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete repository: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete repository '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete repository done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteRepository) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("repository"), nil
+}
+
+func (cmd *DeleteRepository) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteResource(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteResource {
+	cmd := new(DeleteResource)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = apigateway.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteResource) SetApi(api apigatewayiface.APIGatewayAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteResource) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &apigateway.DeleteResourceInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in apigateway.DeleteResourceInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteResource(input)
+	renv.Log().ExtraVerbosef("apigateway.DeleteResource call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete resource: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete resource '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete resource done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteResource) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("resource"), nil
+}
+
+func (cmd *DeleteResource) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteRestapi(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRestapi {
+	cmd := new(DeleteRestapi)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = apigateway.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteRestapi) SetApi(api apigatewayiface.APIGatewayAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteRestapi) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &apigateway.DeleteRestApiInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in apigateway.DeleteRestApiInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteRestApi(input)
+	renv.Log().ExtraVerbosef("apigateway.DeleteRestApi call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete restapi: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete restapi '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete restapi done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteRestapi) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("restapi"), nil
+}
+
+func (cmd *DeleteRestapi) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteRole(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRole {
+	cmd := new(DeleteRole)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = iam.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteRole) SetApi(api iamiface.IAMAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteRole) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete role: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete role '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete role done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteRole) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("role"), nil
+}
+
+func (cmd *DeleteRole) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteRoute(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRoute {
+	cmd := new(DeleteRoute)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteRoute) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteRoute) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DeleteRouteInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteRouteInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteRoute(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteRoute call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete route: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete route '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete route done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteRoute) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteRouteInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteRouteInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteRoute(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteRoute call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete route ok")
+			return fakeDryRunId("route"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteRoute) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteRoutetable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRoutetable {
+	cmd := new(DeleteRoutetable)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteRoutetable) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteRoutetable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DeleteRouteTableInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteRouteTableInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteRouteTable(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteRouteTable call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete routetable: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete routetable '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete routetable done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteRoutetable) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteRouteTableInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteRouteTableInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteRouteTable(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteRouteTable call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete routetable ok")
+			return fakeDryRunId("routetable"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteRoutetable) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteRule(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRule {
+	cmd := new(DeleteRule)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = cloudwatchevents.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteRule) SetApi(api cloudwatcheventsiface.CloudWatchEventsAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteRule) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &cloudwatchevents.DeleteRuleInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in cloudwatchevents.DeleteRuleInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteRule(input)
+	renv.Log().ExtraVerbosef("cloudwatchevents.DeleteRule call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete rule: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete rule '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete rule done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteRule) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("rule"), nil
+}
+
+func (cmd *DeleteRule) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteS3object(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteS3object {
+	cmd := new(DeleteS3object)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = s3.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteS3object) SetApi(api s3iface.S3API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteS3object) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &s3.DeleteObjectInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in s3.DeleteObjectInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteObject(input)
+	renv.Log().ExtraVerbosef("s3.DeleteObject call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete s3object: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete s3object '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete s3object done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteS3object) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("s3object"), nil
+}
+
+func (cmd *DeleteS3object) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteScalinggroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteScalinggroup {
+	cmd := new(DeleteScalinggroup)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = autoscaling.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteScalinggroup) SetApi(api autoscalingiface.AutoScalingAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteScalinggroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &autoscaling.DeleteAutoScalingGroupInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in autoscaling.DeleteAutoScalingGroupInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteAutoScalingGroup(input)
+	renv.Log().ExtraVerbosef("autoscaling.DeleteAutoScalingGroup call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete scalinggroup: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete scalinggroup '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete scalinggroup done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteScalinggroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("scalinggroup"), nil
+}
+
+func (cmd *DeleteScalinggroup) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteScalingpolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteScalingpolicy {
+	cmd := new(DeleteScalingpolicy)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = autoscaling.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteScalingpolicy) SetApi(api autoscalingiface.AutoScalingAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteScalingpolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &autoscaling.DeletePolicyInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in autoscaling.DeletePolicyInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeletePolicy(input)
+	renv.Log().ExtraVerbosef("autoscaling.DeletePolicy call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete scalingpolicy: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete scalingpolicy '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete scalingpolicy done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteScalingpolicy) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("scalingpolicy"), nil
+}
+
+func (cmd *DeleteScalingpolicy) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteSecret(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteSecret {
+	cmd := new(DeleteSecret)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = secretsmanager.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteSecret) SetApi(api secretsmanageriface.SecretsManagerAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteSecret) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &secretsmanager.DeleteSecretInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in secretsmanager.DeleteSecretInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteSecret(input)
+	renv.Log().ExtraVerbosef("secretsmanager.DeleteSecret call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete secret: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete secret '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete secret done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteSecret) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("secret"), nil
+}
+
+func (cmd *DeleteSecret) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteSecuritygroup {
+	cmd := new(DeleteSecuritygroup)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteSecuritygroup) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteSecuritygroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DeleteSecurityGroupInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteSecurityGroupInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteSecurityGroup(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteSecurityGroup call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete securitygroup: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete securitygroup '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete securitygroup done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteSecuritygroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteSecurityGroupInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteSecurityGroupInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteSecurityGroup(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteSecurityGroup call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete securitygroup ok")
+			return fakeDryRunId("securitygroup"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteSecuritygroup) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteSnapshot(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteSnapshot {
+	cmd := new(DeleteSnapshot)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteSnapshot) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteSnapshot) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DeleteSnapshotInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteSnapshotInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteSnapshot(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteSnapshot call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete snapshot: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete snapshot '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete snapshot done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteSnapshot) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteSnapshotInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteSnapshotInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteSnapshot(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteSnapshot call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete snapshot ok")
+			return fakeDryRunId("snapshot"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteSnapshot) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteStack(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteStack {
+	cmd := new(DeleteStack)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = cloudformation.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteStack) SetApi(api cloudformationiface.CloudFormationAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteStack) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &cloudformation.DeleteStackInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in cloudformation.DeleteStackInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteStack(input)
+	renv.Log().ExtraVerbosef("cloudformation.DeleteStack call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete stack: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete stack '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete stack done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteStack) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("stack"), nil
+}
+
+func (cmd *DeleteStack) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteStage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteStage {
+	cmd := new(DeleteStage)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = apigateway.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteStage) SetApi(api apigatewayiface.APIGatewayAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteStage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &apigateway.DeleteStageInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in apigateway.DeleteStageInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteStage(input)
+	renv.Log().ExtraVerbosef("apigateway.DeleteStage call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete stage: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete stage '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete stage done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteStage) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("stage"), nil
+}
+
+func (cmd *DeleteStage) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteStatemachine(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteStatemachine {
+	cmd := new(DeleteStatemachine)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = sfn.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteStatemachine) SetApi(api sfniface.SFNAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteStatemachine) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &sfn.DeleteStateMachineInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in sfn.DeleteStateMachineInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteStateMachine(input)
+	renv.Log().ExtraVerbosef("sfn.DeleteStateMachine call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete statemachine: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete statemachine '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete statemachine done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteStatemachine) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("statemachine"), nil
+}
+
+func (cmd *DeleteStatemachine) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteSubnet(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteSubnet {
+	cmd := new(DeleteSubnet)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteSubnet) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteSubnet) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DeleteSubnetInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteSubnetInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteSubnet(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteSubnet call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete subnet: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete subnet '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete subnet done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteSubnet) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteSubnetInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteSubnetInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteSubnet(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteSubnet call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete subnet ok")
+			return fakeDryRunId("subnet"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteSubnet) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteSubscription(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteSubscription {
+	cmd := new(DeleteSubscription)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = sns.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteSubscription) SetApi(api snsiface.SNSAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteSubscription) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &sns.UnsubscribeInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in sns.UnsubscribeInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.Unsubscribe(input)
+	renv.Log().ExtraVerbosef("sns.Unsubscribe call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete subscription: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete subscription '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete subscription done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteSubscription) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("subscription"), nil
+}
+
+func (cmd *DeleteSubscription) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteTable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteTable {
+	cmd := new(DeleteTable)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = dynamodb.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteTable) SetApi(api dynamodbiface.DynamoDBAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteTable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &dynamodb.DeleteTableInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in dynamodb.DeleteTableInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteTable(input)
+	renv.Log().ExtraVerbosef("dynamodb.DeleteTable call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete table: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete table '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete table done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteTable) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("table"), nil
+}
+
+func (cmd *DeleteTable) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteTag(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteTag {
+	cmd := new(DeleteTag)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteTag) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteTag) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete tag: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete tag '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete tag done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteTag) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteTargetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteTargetgroup {
+	cmd := new(DeleteTargetgroup)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = elbv2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteTargetgroup) SetApi(api elbv2iface.ELBV2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteTargetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &elbv2.DeleteTargetGroupInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in elbv2.DeleteTargetGroupInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteTargetGroup(input)
+	renv.Log().ExtraVerbosef("elbv2.DeleteTargetGroup call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete targetgroup: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete targetgroup '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete targetgroup done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteTargetgroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("targetgroup"), nil
+}
+
+func (cmd *DeleteTargetgroup) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteTopic(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteTopic {
+	cmd := new(DeleteTopic)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = sns.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteTopic) SetApi(api snsiface.SNSAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteTopic) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &sns.DeleteTopicInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in sns.DeleteTopicInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteTopic(input)
+	renv.Log().ExtraVerbosef("sns.DeleteTopic call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete topic: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete topic '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete topic done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteTopic) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("topic"), nil
+}
+
+func (cmd *DeleteTopic) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteTrail(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteTrail {
+	cmd := new(DeleteTrail)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = cloudtrail.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteTrail) SetApi(api cloudtrailiface.CloudTrailAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteTrail) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &cloudtrail.DeleteTrailInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in cloudtrail.DeleteTrailInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteTrail(input)
+	renv.Log().ExtraVerbosef("cloudtrail.DeleteTrail call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete trail: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete trail '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete trail done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteTrail) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("trail"), nil
+}
+
+func (cmd *DeleteTrail) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteTransitgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteTransitgateway {
+	cmd := new(DeleteTransitgateway)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2transitgateway.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteTransitgateway) SetApi(api ec2transitgatewayiface.EC2TransitGatewayAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteTransitgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2transitgateway.DeleteTransitGatewayInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2transitgateway.DeleteTransitGatewayInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteTransitGateway(input)
+	renv.Log().ExtraVerbosef("ec2transitgateway.DeleteTransitGateway call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete transitgateway: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete transitgateway '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete transitgateway done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteTransitgateway) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2transitgateway.DeleteTransitGatewayInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2transitgateway.DeleteTransitGatewayInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteTransitGateway(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2transitgateway.DeleteTransitGateway call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete transitgateway ok")
+			return fakeDryRunId("transitgateway"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteTransitgateway) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteTransitgatewayattachment(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteTransitgatewayattachment {
+	cmd := new(DeleteTransitgatewayattachment)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2transitgateway.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteTransitgatewayattachment) SetApi(api ec2transitgatewayiface.EC2TransitGatewayAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteTransitgatewayattachment) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2transitgateway.DeleteTransitGatewayVpcAttachmentInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2transitgateway.DeleteTransitGatewayVpcAttachmentInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteTransitGatewayVpcAttachment(input)
+	renv.Log().ExtraVerbosef("ec2transitgateway.DeleteTransitGatewayVpcAttachment call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete transitgatewayattachment: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete transitgatewayattachment '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete transitgatewayattachment done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteTransitgatewayattachment) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2transitgateway.DeleteTransitGatewayVpcAttachmentInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2transitgateway.DeleteTransitGatewayVpcAttachmentInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteTransitGatewayVpcAttachment(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2transitgateway.DeleteTransitGatewayVpcAttachment call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete transitgatewayattachment ok")
+			return fakeDryRunId("transitgatewayattachment"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteTransitgatewayattachment) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteTransitgatewayroutetable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteTransitgatewayroutetable {
+	cmd := new(DeleteTransitgatewayroutetable)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2transitgateway.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteTransitgatewayroutetable) SetApi(api ec2transitgatewayiface.EC2TransitGatewayAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteTransitgatewayroutetable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2transitgateway.DeleteTransitGatewayRouteTableInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2transitgateway.DeleteTransitGatewayRouteTableInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteTransitGatewayRouteTable(input)
+	renv.Log().ExtraVerbosef("ec2transitgateway.DeleteTransitGatewayRouteTable call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete transitgatewayroutetable: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete transitgatewayroutetable '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete transitgatewayroutetable done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteTransitgatewayroutetable) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2transitgateway.DeleteTransitGatewayRouteTableInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2transitgateway.DeleteTransitGatewayRouteTableInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteTransitGatewayRouteTable(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2transitgateway.DeleteTransitGatewayRouteTable call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete transitgatewayroutetable ok")
+			return fakeDryRunId("transitgatewayroutetable"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteTransitgatewayroutetable) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteUser(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteUser {
+	cmd := new(DeleteUser)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = iam.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteUser) SetApi(api iamiface.IAMAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteUser) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &iam.DeleteUserInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in iam.DeleteUserInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteUser(input)
+	renv.Log().ExtraVerbosef("iam.DeleteUser call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete user: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete user '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete user done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteUser) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("user"), nil
+}
+
+func (cmd *DeleteUser) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteVolume {
+	cmd := new(DeleteVolume)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteVolume) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteVolume) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DeleteVolumeInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVolumeInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteVolume(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteVolume call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete volume: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete volume '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete volume done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteVolume) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteVolumeInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVolumeInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteVolume(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteVolume call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete volume ok")
+			return fakeDryRunId("volume"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteVolume) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteVpc(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteVpc {
+	cmd := new(DeleteVpc)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteVpc) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteVpc) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DeleteVpcInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpcInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteVpc(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteVpc call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete vpc: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete vpc '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete vpc done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteVpc) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteVpcInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpcInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteVpc(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteVpc call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete vpc ok")
+			return fakeDryRunId("vpc"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteVpc) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteVpcendpoint(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteVpcendpoint {
+	cmd := new(DeleteVpcendpoint)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteVpcendpoint) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteVpcendpoint) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DeleteVpcEndpointsInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpcEndpointsInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteVpcEndpoints(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteVpcEndpoints call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete vpcendpoint: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete vpcendpoint '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete vpcendpoint done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteVpcendpoint) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteVpcEndpointsInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpcEndpointsInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteVpcEndpoints(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteVpcEndpoints call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete vpcendpoint ok")
+			return fakeDryRunId("vpcendpoint"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteVpcendpoint) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteVpnconnection(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteVpnconnection {
+	cmd := new(DeleteVpnconnection)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteVpnconnection) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteVpnconnection) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DeleteVpnConnectionInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpnConnectionInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteVpnConnection(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteVpnConnection call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete vpnconnection: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete vpnconnection '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete vpnconnection done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteVpnconnection) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteVpnConnectionInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpnConnectionInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteVpnConnection(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteVpnConnection call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete vpnconnection ok")
+			return fakeDryRunId("vpnconnection"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteVpnconnection) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteVpngateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteVpngateway {
+	cmd := new(DeleteVpngateway)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteVpngateway) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteVpngateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DeleteVpnGatewayInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpnGatewayInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteVpnGateway(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteVpnGateway call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete vpngateway: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete vpngateway '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete vpngateway done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteVpngateway) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteVpnGatewayInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpnGatewayInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteVpnGateway(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteVpnGateway call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete vpngateway ok")
+			return fakeDryRunId("vpngateway"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteVpngateway) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteZone(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteZone {
+	cmd := new(DeleteZone)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = route53.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteZone) SetApi(api route53iface.Route53API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteZone) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &route53.DeleteHostedZoneInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in route53.DeleteHostedZoneInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteHostedZone(input)
+	renv.Log().ExtraVerbosef("route53.DeleteHostedZone call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete zone: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete zone '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete zone done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteZone) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("zone"), nil
+}
+
+func (cmd *DeleteZone) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDetachAlarm(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachAlarm {
+	cmd := new(DetachAlarm)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = cloudwatch.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DetachAlarm) SetApi(api cloudwatchiface.CloudWatchAPI) {
+	cmd.api = api
+}
+
+func (cmd *DetachAlarm) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("detach alarm: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("detach alarm '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("detach alarm done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DetachAlarm) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("alarm"), nil
+}
+
+func (cmd *DetachAlarm) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDetachClassicloadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachClassicloadbalancer {
+	cmd := new(DetachClassicloadbalancer)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = elb.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DetachClassicloadbalancer) SetApi(api elbiface.ELBAPI) {
+	cmd.api = api
+}
+
+func (cmd *DetachClassicloadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &elb.DeregisterInstancesFromLoadBalancerInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in elb.DeregisterInstancesFromLoadBalancerInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeregisterInstancesFromLoadBalancer(input)
+	renv.Log().ExtraVerbosef("elb.DeregisterInstancesFromLoadBalancer call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("detach classicloadbalancer: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("detach classicloadbalancer '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("detach classicloadbalancer done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DetachClassicloadbalancer) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("classicloadbalancer"), nil
+}
+
+func (cmd *DetachClassicloadbalancer) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDetachContainertask(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachContainertask {
+	cmd := new(DetachContainertask)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ecs.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DetachContainertask) SetApi(api ecsiface.ECSAPI) {
+	cmd.api = api
+}
+
+func (cmd *DetachContainertask) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("detach containertask: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("detach containertask '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("detach containertask done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DetachContainertask) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("containertask"), nil
+}
+
+func (cmd *DetachContainertask) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDetachElasticip(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachElasticip {
+	cmd := new(DetachElasticip)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DetachElasticip) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DetachElasticip) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("detach elasticip: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("detach elasticip '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("detach elasticip done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DetachElasticip) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDetachInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachInstance {
+	cmd := new(DetachInstance)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = elbv2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DetachInstance) SetApi(api elbv2iface.ELBV2API) {
+	cmd.api = api
+}
+
+func (cmd *DetachInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &elbv2.DeregisterTargetsInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in elbv2.DeregisterTargetsInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeregisterTargets(input)
+	renv.Log().ExtraVerbosef("elbv2.DeregisterTargets call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("detach instance: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("detach instance '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("detach instance done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DetachInstance) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("instance"), nil
+}
+
+func (cmd *DetachInstance) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDetachInstanceprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachInstanceprofile {
+	cmd := new(DetachInstanceprofile)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DetachInstanceprofile) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DetachInstanceprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("detach instanceprofile: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("detach instanceprofile '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("detach instanceprofile done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DetachInstanceprofile) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("instanceprofile"), nil
+}
+
+func (cmd *DetachInstanceprofile) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDetachInternetgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachInternetgateway {
+	cmd := new(DetachInternetgateway)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DetachInternetgateway) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DetachInternetgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DetachInternetGatewayInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DetachInternetGatewayInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DetachInternetGateway(input)
+	renv.Log().ExtraVerbosef("ec2.DetachInternetGateway call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("detach internetgateway: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("detach internetgateway '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("detach internetgateway done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DetachInternetgateway) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DetachInternetGatewayInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DetachInternetGatewayInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DetachInternetGateway(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DetachInternetGateway call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: detach internetgateway ok")
+			return fakeDryRunId("internetgateway"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DetachInternetgateway) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDetachMfadevice(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachMfadevice {
+	cmd := new(DetachMfadevice)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = iam.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DetachMfadevice) SetApi(api iamiface.IAMAPI) {
+	cmd.api = api
+}
+
+func (cmd *DetachMfadevice) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &iam.DeactivateMFADeviceInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in iam.DeactivateMFADeviceInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeactivateMFADevice(input)
+	renv.Log().ExtraVerbosef("iam.DeactivateMFADevice call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("detach mfadevice: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("detach mfadevice '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("detach mfadevice done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DetachMfadevice) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("mfadevice"), nil
+}
+
+func (cmd *DetachMfadevice) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDetachNetworkacl(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachNetworkacl {
+	cmd := new(DetachNetworkacl)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DetachNetworkacl) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DetachNetworkacl) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("detach networkacl: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("detach networkacl '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("detach networkacl done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DetachNetworkacl) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDetachNetworkinterface(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachNetworkinterface {
+	cmd := new(DetachNetworkinterface)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DetachNetworkinterface) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DetachNetworkinterface) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("detach networkinterface: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("detach networkinterface '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("detach networkinterface done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DetachNetworkinterface) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDetachPolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachPolicy {
+	cmd := new(DetachPolicy)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = iam.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DetachPolicy) SetApi(api iamiface.IAMAPI) {
+	cmd.api = api
+}
+
+func (cmd *DetachPolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("detach policy: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("detach policy '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("detach policy done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DetachPolicy) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("policy"), nil
+}
+
+func (cmd *DetachPolicy) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDetachRole(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachRole {
+	cmd := new(DetachRole)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = iam.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DetachRole) SetApi(api iamiface.IAMAPI) {
+	cmd.api = api
+}
+
+func (cmd *DetachRole) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &iam.RemoveRoleFromInstanceProfileInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in iam.RemoveRoleFromInstanceProfileInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.RemoveRoleFromInstanceProfile(input)
+	renv.Log().ExtraVerbosef("iam.RemoveRoleFromInstanceProfile call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("detach role: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("detach role '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("detach role done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DetachRole) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("role"), nil
+}
+
+func (cmd *DetachRole) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDetachRoutetable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachRoutetable {
+	cmd := new(DetachRoutetable)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DetachRoutetable) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DetachRoutetable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DisassociateRouteTableInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DisassociateRouteTableInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DisassociateRouteTable(input)
+	renv.Log().ExtraVerbosef("ec2.DisassociateRouteTable call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("detach routetable: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("detach routetable '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("detach routetable done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DetachRoutetable) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DisassociateRouteTableInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DisassociateRouteTableInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DisassociateRouteTable(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DisassociateRouteTable call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: detach routetable ok")
+			return fakeDryRunId("routetable"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DetachRoutetable) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDetachSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachSecuritygroup {
+	cmd := new(DetachSecuritygroup)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DetachSecuritygroup) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DetachSecuritygroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("detach securitygroup: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("detach securitygroup '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("detach securitygroup done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DetachSecuritygroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("securitygroup"), nil
+}
+
+func (cmd *DetachSecuritygroup) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDetachServicecontrolpolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachServicecontrolpolicy {
+	cmd := new(DetachServicecontrolpolicy)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = organizations.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DetachServicecontrolpolicy) SetApi(api organizationsiface.OrganizationsAPI) {
+	cmd.api = api
+}
+
+func (cmd *DetachServicecontrolpolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &organizations.DetachPolicyInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in organizations.DetachPolicyInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DetachPolicy(input)
+	renv.Log().ExtraVerbosef("organizations.DetachPolicy call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("detach servicecontrolpolicy: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("detach servicecontrolpolicy '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("detach servicecontrolpolicy done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DetachServicecontrolpolicy) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("servicecontrolpolicy"), nil
+}
+
+func (cmd *DetachServicecontrolpolicy) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDetachTarget(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachTarget {
+	cmd := new(DetachTarget)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = cloudwatchevents.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DetachTarget) SetApi(api cloudwatcheventsiface.CloudWatchEventsAPI) {
+	cmd.api = api
+}
+
+func (cmd *DetachTarget) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("detach target: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("detach target '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("detach target done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DetachTarget) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("target"), nil
+}
+
+func (cmd *DetachTarget) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDetachTransitgatewayroutetable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachTransitgatewayroutetable {
+	cmd := new(DetachTransitgatewayroutetable)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2transitgateway.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DetachTransitgatewayroutetable) SetApi(api ec2transitgatewayiface.EC2TransitGatewayAPI) {
+	cmd.api = api
+}
+
+func (cmd *DetachTransitgatewayroutetable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2transitgateway.DisassociateTransitGatewayRouteTableInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2transitgateway.DisassociateTransitGatewayRouteTableInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DisassociateTransitGatewayRouteTable(input)
+	renv.Log().ExtraVerbosef("ec2transitgateway.DisassociateTransitGatewayRouteTable call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("detach transitgatewayroutetable: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("detach transitgatewayroutetable '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("detach transitgatewayroutetable done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DetachTransitgatewayroutetable) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2transitgateway.DisassociateTransitGatewayRouteTableInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2transitgateway.DisassociateTransitGatewayRouteTableInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DisassociateTransitGatewayRouteTable(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2transitgateway.DisassociateTransitGatewayRouteTable call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: detach transitgatewayroutetable ok")
+			return fakeDryRunId("transitgatewayroutetable"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DetachTransitgatewayroutetable) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDetachUser(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachUser {
+	cmd := new(DetachUser)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = iam.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DetachUser) SetApi(api iamiface.IAMAPI) {
+	cmd.api = api
+}
+
+func (cmd *DetachUser) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &iam.RemoveUserFromGroupInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in iam.RemoveUserFromGroupInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.RemoveUserFromGroup(input)
+	renv.Log().ExtraVerbosef("iam.RemoveUserFromGroup call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("detach user: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("detach user '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("detach user done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DetachUser) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("user"), nil
+}
+
+func (cmd *DetachUser) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDetachVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachVolume {
+	cmd := new(DetachVolume)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DetachVolume) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DetachVolume) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DetachVolumeInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DetachVolumeInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DetachVolume(input)
+	renv.Log().ExtraVerbosef("ec2.DetachVolume call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("detach volume: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("detach volume '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("detach volume done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DetachVolume) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DetachVolumeInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DetachVolumeInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DetachVolume(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DetachVolume call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: detach volume ok")
+			return fakeDryRunId("volume"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DetachVolume) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDetachVpngateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachVpngateway {
+	cmd := new(DetachVpngateway)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DetachVpngateway) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DetachVpngateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DetachVpnGatewayInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DetachVpnGatewayInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DetachVpnGateway(input)
+	renv.Log().ExtraVerbosef("ec2.DetachVpnGateway call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("detach vpngateway: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("detach vpngateway '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("detach vpngateway done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DetachVpngateway) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DetachVpnGatewayInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DetachVpnGatewayInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DetachVpnGateway(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DetachVpnGateway call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: detach vpngateway ok")
+			return fakeDryRunId("vpngateway"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DetachVpngateway) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDisableKey(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DisableKey {
+	cmd := new(DisableKey)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = kms.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DisableKey) SetApi(api kmsiface.KMSAPI) {
+	cmd.api = api
+}
+
+func (cmd *DisableKey) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &kms.DisableKeyInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in kms.DisableKeyInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DisableKey(input)
+	renv.Log().ExtraVerbosef("kms.DisableKey call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("disable key: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("disable key '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("disable key done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DisableKey) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("key"), nil
+}
+
+func (cmd *DisableKey) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDownloadS3object(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DownloadS3object {
+	cmd := new(DownloadS3object)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = s3.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DownloadS3object) SetApi(api s3iface.S3API) {
+	cmd.api = api
+}
+
+func (cmd *DownloadS3object) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("download s3object: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("download s3object '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("download s3object done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DownloadS3object) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("s3object"), nil
+}
+
+func (cmd *DownloadS3object) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewEnableKey(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *EnableKey {
+	cmd := new(EnableKey)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = kms.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *EnableKey) SetApi(api kmsiface.KMSAPI) {
+	cmd.api = api
+}
+
+func (cmd *EnableKey) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &kms.EnableKeyInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in kms.EnableKeyInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.EnableKey(input)
+	renv.Log().ExtraVerbosef("kms.EnableKey call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("enable key: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("enable key '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("enable key done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *EnableKey) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("key"), nil
+}
+
+func (cmd *EnableKey) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewGetConsoleoutput(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *GetConsoleoutput {
+	cmd := new(GetConsoleoutput)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *GetConsoleoutput) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *GetConsoleoutput) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("get consoleoutput: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("get consoleoutput '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("get consoleoutput done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *GetConsoleoutput) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewGetScreenshot(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *GetScreenshot {
+	cmd := new(GetScreenshot)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *GetScreenshot) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *GetScreenshot) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("get screenshot: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("get screenshot '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("get screenshot done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *GetScreenshot) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewImportImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *ImportImage {
+	cmd := new(ImportImage)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *ImportImage) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *ImportImage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.ImportImageInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.ImportImageInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.ImportImage(input)
+	renv.Log().ExtraVerbosef("ec2.ImportImage call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("import image: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("import image '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("import image done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *ImportImage) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.ImportImageInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.ImportImageInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.ImportImage(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.ImportImage call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: import image ok")
+			return fakeDryRunId("image"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *ImportImage) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewInvokeFunction(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *InvokeFunction {
+	cmd := new(InvokeFunction)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = lambda.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *InvokeFunction) SetApi(api lambdaiface.LambdaAPI) {
+	cmd.api = api
+}
+
+func (cmd *InvokeFunction) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("invoke function: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("invoke function '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("invoke function done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *InvokeFunction) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("function"), nil
+}
+
+func (cmd *InvokeFunction) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewMoveAccount(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *MoveAccount {
+	cmd := new(MoveAccount)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = organizations.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *MoveAccount) SetApi(api organizationsiface.OrganizationsAPI) {
+	cmd.api = api
+}
+
+func (cmd *MoveAccount) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &organizations.MoveAccountInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in organizations.MoveAccountInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.MoveAccount(input)
+	renv.Log().ExtraVerbosef("organizations.MoveAccount call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("move account: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("move account '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("move account done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *MoveAccount) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("account"), nil
+}
+
+func (cmd *MoveAccount) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewRebootInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *RebootInstance {
+	cmd := new(RebootInstance)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *RebootInstance) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *RebootInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.RebootInstancesInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.RebootInstancesInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.RebootInstances(input)
+	renv.Log().ExtraVerbosef("ec2.RebootInstances call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("reboot instance: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("reboot instance '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("reboot instance done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *RebootInstance) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.RebootInstancesInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.RebootInstancesInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.RebootInstances(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.RebootInstances call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: reboot instance ok")
+			return fakeDryRunId("instance"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *RebootInstance) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewRegisterJobdefinition(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *RegisterJobdefinition {
+	cmd := new(RegisterJobdefinition)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = batch.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *RegisterJobdefinition) SetApi(api batchiface.BatchAPI) {
+	cmd.api = api
+}
+
+func (cmd *RegisterJobdefinition) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &batch.RegisterJobDefinitionInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in batch.RegisterJobDefinitionInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.RegisterJobDefinition(input)
+	renv.Log().ExtraVerbosef("batch.RegisterJobDefinition call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("register jobdefinition: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("register jobdefinition '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("register jobdefinition done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *RegisterJobdefinition) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("jobdefinition"), nil
+}
+
+func (cmd *RegisterJobdefinition) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewResizeCluster(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *ResizeCluster {
+	cmd := new(ResizeCluster)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = redshift.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *ResizeCluster) SetApi(api redshiftiface.RedshiftAPI) {
+	cmd.api = api
+}
+
+func (cmd *ResizeCluster) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &redshift.ModifyClusterInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in redshift.ModifyClusterInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.ModifyCluster(input)
+	renv.Log().ExtraVerbosef("redshift.ModifyCluster call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("resize cluster: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("resize cluster '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("resize cluster done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *ResizeCluster) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("cluster"), nil
+}
+
+func (cmd *ResizeCluster) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewRestartDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *RestartDatabase {
+	cmd := new(RestartDatabase)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = rds.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *RestartDatabase) SetApi(api rdsiface.RDSAPI) {
+	cmd.api = api
+}
+
+func (cmd *RestartDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &rds.RebootDBInstanceInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in rds.RebootDBInstanceInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.RebootDBInstance(input)
+	renv.Log().ExtraVerbosef("rds.RebootDBInstance call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("restart database: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("restart database '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("restart database done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *RestartDatabase) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("database"), nil
+}
+
+func (cmd *RestartDatabase) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewRestartInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *RestartInstance {
+	cmd := new(RestartInstance)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *RestartInstance) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *RestartInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.RebootInstancesInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.RebootInstancesInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.RebootInstances(input)
+	renv.Log().ExtraVerbosef("ec2.RebootInstances call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("restart instance: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("restart instance '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("restart instance done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *RestartInstance) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.RebootInstancesInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.RebootInstancesInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.RebootInstances(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.RebootInstances call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: restart instance ok")
+			return fakeDryRunId("instance"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *RestartInstance) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewRestoreDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *RestoreDatabase {
+	cmd := new(RestoreDatabase)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = rds.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *RestoreDatabase) SetApi(api rdsiface.RDSAPI) {
+	cmd.api = api
+}
+
+func (cmd *RestoreDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &rds.RestoreDBInstanceFromDBSnapshotInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in rds.RestoreDBInstanceFromDBSnapshotInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.RestoreDBInstanceFromDBSnapshot(input)
+	renv.Log().ExtraVerbosef("rds.RestoreDBInstanceFromDBSnapshot call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("restore database: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("restore database '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("restore database done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *RestoreDatabase) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("database"), nil
+}
+
+func (cmd *RestoreDatabase) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewRestoreVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *RestoreVolume {
+	cmd := new(RestoreVolume)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *RestoreVolume) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *RestoreVolume) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.CreateVolumeInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateVolumeInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateVolume(input)
+	renv.Log().ExtraVerbosef("ec2.CreateVolume call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("restore volume: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("restore volume '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("restore volume done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *RestoreVolume) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreateVolumeInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateVolumeInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateVolume(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateVolume call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: restore volume ok")
+			return fakeDryRunId("volume"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *RestoreVolume) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewRunCommand(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *RunCommand {
+	cmd := new(RunCommand)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ssm.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *RunCommand) SetApi(api ssmiface.SSMAPI) {
+	cmd.api = api
+}
+
+func (cmd *RunCommand) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("run command: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("run command '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("run command done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *RunCommand) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("command"), nil
+}
+
+func (cmd *RunCommand) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewStartAlarm(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StartAlarm {
+	cmd := new(StartAlarm)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = cloudwatch.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *StartAlarm) SetApi(api cloudwatchiface.CloudWatchAPI) {
+	cmd.api = api
+}
+
+func (cmd *StartAlarm) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &cloudwatch.EnableAlarmActionsInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in cloudwatch.EnableAlarmActionsInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.EnableAlarmActions(input)
+	renv.Log().ExtraVerbosef("cloudwatch.EnableAlarmActions call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("start alarm: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("start alarm '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("start alarm done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *StartAlarm) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("alarm"), nil
+}
+
+func (cmd *StartAlarm) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewStartContainertask(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StartContainertask {
+	cmd := new(StartContainertask)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ecs.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *StartContainertask) SetApi(api ecsiface.ECSAPI) {
+	cmd.api = api
+}
+
+func (cmd *StartContainertask) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("start containertask: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("start containertask '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("start containertask done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *StartContainertask) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("containertask"), nil
+}
+
+func (cmd *StartContainertask) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewStartDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StartDatabase {
+	cmd := new(StartDatabase)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = rds.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *StartDatabase) SetApi(api rdsiface.RDSAPI) {
+	cmd.api = api
+}
+
+func (cmd *StartDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &rds.StartDBInstanceInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in rds.StartDBInstanceInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.StartDBInstance(input)
+	renv.Log().ExtraVerbosef("rds.StartDBInstance call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("start database: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("start database '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("start database done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *StartDatabase) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("database"), nil
+}
+
+func (cmd *StartDatabase) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewStartExecution(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StartExecution {
+	cmd := new(StartExecution)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = sfn.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *StartExecution) SetApi(api sfniface.SFNAPI) {
+	cmd.api = api
+}
+
+func (cmd *StartExecution) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &sfn.StartExecutionInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in sfn.StartExecutionInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.StartExecution(input)
+	renv.Log().ExtraVerbosef("sfn.StartExecution call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("start execution: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("start execution '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("start execution done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *StartExecution) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("execution"), nil
+}
+
+func (cmd *StartExecution) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewStartInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StartInstance {
+	cmd := new(StartInstance)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *StartInstance) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *StartInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.StartInstancesInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.StartInstancesInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.StartInstances(input)
+	renv.Log().ExtraVerbosef("ec2.StartInstances call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("start instance: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("start instance '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("start instance done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *StartInstance) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.StartInstancesInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.StartInstancesInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.StartInstances(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.StartInstances call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: start instance ok")
+			return fakeDryRunId("instance"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *StartInstance) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewStartQuery(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StartQuery {
+	cmd := new(StartQuery)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = athena.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *StartQuery) SetApi(api athenaiface.AthenaAPI) {
+	cmd.api = api
+}
+
+func (cmd *StartQuery) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("start query: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("start query '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("start query done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *StartQuery) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("query"), nil
+}
+
+func (cmd *StartQuery) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewStopAlarm(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StopAlarm {
+	cmd := new(StopAlarm)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = cloudwatch.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *StopAlarm) SetApi(api cloudwatchiface.CloudWatchAPI) {
+	cmd.api = api
+}
+
+func (cmd *StopAlarm) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &cloudwatch.DisableAlarmActionsInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in cloudwatch.DisableAlarmActionsInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DisableAlarmActions(input)
+	renv.Log().ExtraVerbosef("cloudwatch.DisableAlarmActions call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("stop alarm: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("stop alarm '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("stop alarm done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *StopAlarm) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("alarm"), nil
+}
+
+func (cmd *StopAlarm) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewStopContainertask(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StopContainertask {
+	cmd := new(StopContainertask)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ecs.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *StopContainertask) SetApi(api ecsiface.ECSAPI) {
+	cmd.api = api
+}
+
+func (cmd *StopContainertask) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("stop containertask: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("stop containertask '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("stop containertask done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *StopContainertask) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("containertask"), nil
+}
+
+func (cmd *StopContainertask) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewStopDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StopDatabase {
+	cmd := new(StopDatabase)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = rds.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *StopDatabase) SetApi(api rdsiface.RDSAPI) {
+	cmd.api = api
+}
+
+func (cmd *StopDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &rds.StopDBInstanceInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in rds.StopDBInstanceInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.StopDBInstance(input)
+	renv.Log().ExtraVerbosef("rds.StopDBInstance call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("stop database: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("stop database '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("stop database done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *StopDatabase) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("database"), nil
+}
+
+func (cmd *StopDatabase) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewStopExecution(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StopExecution {
+	cmd := new(StopExecution)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = sfn.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *StopExecution) SetApi(api sfniface.SFNAPI) {
+	cmd.api = api
+}
+
+func (cmd *StopExecution) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &sfn.StopExecutionInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in sfn.StopExecutionInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.StopExecution(input)
+	renv.Log().ExtraVerbosef("sfn.StopExecution call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("stop execution: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("stop execution '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("stop execution done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *StopExecution) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("execution"), nil
+}
+
+func (cmd *StopExecution) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewStopInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StopInstance {
+	cmd := new(StopInstance)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *StopInstance) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *StopInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.StopInstancesInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.StopInstancesInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.StopInstances(input)
+	renv.Log().ExtraVerbosef("ec2.StopInstances call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("stop instance: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("stop instance '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("stop instance done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *StopInstance) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.StopInstancesInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.StopInstancesInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.StopInstances(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.StopInstances call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: stop instance ok")
+			return fakeDryRunId("instance"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *StopInstance) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewSubmitJob(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *SubmitJob {
+	cmd := new(SubmitJob)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = batch.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *SubmitJob) SetApi(api batchiface.BatchAPI) {
+	cmd.api = api
+}
+
+func (cmd *SubmitJob) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &batch.SubmitJobInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in batch.SubmitJobInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.SubmitJob(input)
+	renv.Log().ExtraVerbosef("batch.SubmitJob call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("submit job: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("submit job '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("submit job done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *SubmitJob) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("job"), nil
+}
+
+func (cmd *SubmitJob) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewTerminateEnvironment(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *TerminateEnvironment {
+	cmd := new(TerminateEnvironment)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = elasticbeanstalk.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *TerminateEnvironment) SetApi(api elasticbeanstalkiface.ElasticBeanstalkAPI) {
+	cmd.api = api
+}
+
+func (cmd *TerminateEnvironment) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &elasticbeanstalk.TerminateEnvironmentInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in elasticbeanstalk.TerminateEnvironmentInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.TerminateEnvironment(input)
+	renv.Log().ExtraVerbosef("elasticbeanstalk.TerminateEnvironment call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("terminate environment: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("terminate environment '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("terminate environment done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *TerminateEnvironment) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("environment"), nil
+}
+
+func (cmd *TerminateEnvironment) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewUpdateBucket(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateBucket {
+	cmd := new(UpdateBucket)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = s3.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *UpdateBucket) SetApi(api s3iface.S3API) {
+	cmd.api = api
+}
+
+func (cmd *UpdateBucket) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("update bucket: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("update bucket '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("update bucket done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *UpdateBucket) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("bucket"), nil
+}
+
+func (cmd *UpdateBucket) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewUpdateContainerservice(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateContainerservice {
+	cmd := new(UpdateContainerservice)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ecs.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *UpdateContainerservice) SetApi(api ecsiface.ECSAPI) {
+	cmd.api = api
+}
+
+func (cmd *UpdateContainerservice) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ecs.UpdateServiceInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ecs.UpdateServiceInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.UpdateService(input)
+	renv.Log().ExtraVerbosef("ecs.UpdateService call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("update containerservice: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("update containerservice '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("update containerservice done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *UpdateContainerservice) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("containerservice"), nil
+}
+
+func (cmd *UpdateContainerservice) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewUpdateContainertask(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateContainertask {
+	cmd := new(UpdateContainertask)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = ecs.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DetachUser) SetApi(api iamiface.IAMAPI) {
+func (cmd *UpdateContainertask) SetApi(api ecsiface.ECSAPI) {
 	cmd.api = api
 }
 
-func (cmd *DetachUser) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DetachUser) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateContainertask) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -11238,13 +21223,13 @@ func (cmd *DetachUser) run(renv env.Running, params map[string]interface{}) (int
 		}
 	}
 
-	input := &iam.RemoveUserFromGroupInput{}
+	input := &ecs.UpdateServiceInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.RemoveUserFromGroupInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ecs.UpdateServiceInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.RemoveUserFromGroup(input)
-	renv.Log().ExtraVerbosef("iam.RemoveUserFromGroup call took %s", time.Since(start))
+	output, err := cmd.api.UpdateService(input)
+	renv.Log().ExtraVerbosef("ecs.UpdateService call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -11254,14 +21239,14 @@ func (cmd *DetachUser) run(renv env.Running, params map[string]interface{}) (int
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach user: AWS command returned nil output")
+			renv.Log().Warning("update containertask: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach user '%s' done", extracted)
+		renv.Log().Verbosef("update containertask '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach user done")
+		renv.Log().Verbose("update containertask done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -11273,40 +21258,33 @@ func (cmd *DetachUser) run(renv env.Running, params map[string]interface{}) (int
 	return extracted, nil
 }
 
-func (cmd *DetachUser) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("user"), nil
+func (cmd *UpdateContainertask) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("containertask"), nil
 }
 
-func (cmd *DetachUser) inject(params map[string]interface{}) error {
+func (cmd *UpdateContainertask) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachVolume {
-	cmd := new(DetachVolume)
+func NewUpdateDistribution(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateDistribution {
+	cmd := new(UpdateDistribution)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = cloudfront.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DetachVolume) SetApi(api ec2iface.EC2API) {
+func (cmd *UpdateDistribution) SetApi(api cloudfrontiface.CloudFrontAPI) {
 	cmd.api = api
 }
 
-func (cmd *DetachVolume) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *DetachVolume) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateDistribution) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -11317,13 +21295,7 @@ func (cmd *DetachVolume) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &ec2.DetachVolumeInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DetachVolumeInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.DetachVolume(input)
-	renv.Log().ExtraVerbosef("ec2.DetachVolume call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -11333,14 +21305,14 @@ func (cmd *DetachVolume) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach volume: AWS command returned nil output")
+			renv.Log().Warning("update distribution: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach volume '%s' done", extracted)
+		renv.Log().Verbosef("update distribution '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach volume done")
+		renv.Log().Verbose("update distribution done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -11352,61 +21324,33 @@ func (cmd *DetachVolume) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *DetachVolume) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.DetachVolumeInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DetachVolumeInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.DetachVolume(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DetachVolume call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: detach volume ok")
-			return fakeDryRunId("volume"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *UpdateDistribution) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("distribution"), nil
 }
 
-func (cmd *DetachVolume) inject(params map[string]interface{}) error {
+func (cmd *UpdateDistribution) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewImportImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *ImportImage {
-	cmd := new(ImportImage)
+func NewUpdateEnvironment(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateEnvironment {
+	cmd := new(UpdateEnvironment)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = elasticbeanstalk.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *ImportImage) SetApi(api ec2iface.EC2API) {
+func (cmd *UpdateEnvironment) SetApi(api elasticbeanstalkiface.ElasticBeanstalkAPI) {
 	cmd.api = api
 }
 
-func (cmd *ImportImage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *ImportImage) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateEnvironment) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -11417,13 +21361,13 @@ func (cmd *ImportImage) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &ec2.ImportImageInput{}
+	input := &elasticbeanstalk.UpdateEnvironmentInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.ImportImageInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in elasticbeanstalk.UpdateEnvironmentInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.ImportImage(input)
-	renv.Log().ExtraVerbosef("ec2.ImportImage call took %s", time.Since(start))
+	output, err := cmd.api.UpdateEnvironment(input)
+	renv.Log().ExtraVerbosef("elasticbeanstalk.UpdateEnvironment call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -11433,14 +21377,14 @@ func (cmd *ImportImage) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("import image: AWS command returned nil output")
+			renv.Log().Warning("update environment: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("import image '%s' done", extracted)
+		renv.Log().Verbosef("update environment '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("import image done")
+		renv.Log().Verbose("update environment done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -11452,61 +21396,33 @@ func (cmd *ImportImage) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *ImportImage) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.ImportImageInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.ImportImageInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.ImportImage(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.ImportImage call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: import image ok")
-			return fakeDryRunId("image"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *UpdateEnvironment) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("environment"), nil
 }
 
-func (cmd *ImportImage) inject(params map[string]interface{}) error {
+func (cmd *UpdateEnvironment) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewRestartDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *RestartDatabase {
-	cmd := new(RestartDatabase)
+func NewUpdateFunction(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateFunction {
+	cmd := new(UpdateFunction)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = rds.New(sess)
+		cmd.api = lambda.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *RestartDatabase) SetApi(api rdsiface.RDSAPI) {
+func (cmd *UpdateFunction) SetApi(api lambdaiface.LambdaAPI) {
 	cmd.api = api
 }
 
-func (cmd *RestartDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *RestartDatabase) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateFunction) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -11517,13 +21433,7 @@ func (cmd *RestartDatabase) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &rds.RebootDBInstanceInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in rds.RebootDBInstanceInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.RebootDBInstance(input)
-	renv.Log().ExtraVerbosef("rds.RebootDBInstance call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -11533,14 +21443,14 @@ func (cmd *RestartDatabase) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("restart database: AWS command returned nil output")
+			renv.Log().Warning("update function: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("restart database '%s' done", extracted)
+		renv.Log().Verbosef("update function '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("restart database done")
+		renv.Log().Verbose("update function done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -11552,16 +21462,16 @@ func (cmd *RestartDatabase) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *RestartDatabase) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("database"), nil
+func (cmd *UpdateFunction) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("function"), nil
 }
 
-func (cmd *RestartDatabase) inject(params map[string]interface{}) error {
+func (cmd *UpdateFunction) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewRestartInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *RestartInstance {
-	cmd := new(RestartInstance)
+func NewUpdateImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateImage {
+	cmd := new(UpdateImage)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -11574,18 +21484,11 @@ func NewRestartInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Lo
 	return cmd
 }
 
-func (cmd *RestartInstance) SetApi(api ec2iface.EC2API) {
+func (cmd *UpdateImage) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *RestartInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *RestartInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateImage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -11596,13 +21499,7 @@ func (cmd *RestartInstance) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &ec2.RebootInstancesInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.RebootInstancesInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.RebootInstances(input)
-	renv.Log().ExtraVerbosef("ec2.RebootInstances call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -11612,14 +21509,14 @@ func (cmd *RestartInstance) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("restart instance: AWS command returned nil output")
+			renv.Log().Warning("update image: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("restart instance '%s' done", extracted)
+		renv.Log().Verbosef("update image '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("restart instance done")
+		renv.Log().Verbose("update image done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -11631,61 +21528,29 @@ func (cmd *RestartInstance) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *RestartInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.RebootInstancesInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.RebootInstancesInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.RebootInstances(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.RebootInstances call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: restart instance ok")
-			return fakeDryRunId("instance"), nil
-		}
-	}
-
-	return nil, err
-}
-
-func (cmd *RestartInstance) inject(params map[string]interface{}) error {
+func (cmd *UpdateImage) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewStartAlarm(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StartAlarm {
-	cmd := new(StartAlarm)
+func NewUpdateInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateInstance {
+	cmd := new(UpdateInstance)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudwatch.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *StartAlarm) SetApi(api cloudwatchiface.CloudWatchAPI) {
+func (cmd *UpdateInstance) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *StartAlarm) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *StartAlarm) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -11696,13 +21561,7 @@ func (cmd *StartAlarm) run(renv env.Running, params map[string]interface{}) (int
 		}
 	}
 
-	input := &cloudwatch.EnableAlarmActionsInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in cloudwatch.EnableAlarmActionsInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.EnableAlarmActions(input)
-	renv.Log().ExtraVerbosef("cloudwatch.EnableAlarmActions call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -11712,14 +21571,14 @@ func (cmd *StartAlarm) run(renv env.Running, params map[string]interface{}) (int
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("start alarm: AWS command returned nil output")
+			renv.Log().Warning("update instance: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("start alarm '%s' done", extracted)
+		renv.Log().Verbosef("update instance '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("start alarm done")
+		renv.Log().Verbose("update instance done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -11731,40 +21590,29 @@ func (cmd *StartAlarm) run(renv env.Running, params map[string]interface{}) (int
 	return extracted, nil
 }
 
-func (cmd *StartAlarm) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("alarm"), nil
-}
-
-func (cmd *StartAlarm) inject(params map[string]interface{}) error {
+func (cmd *UpdateInstance) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewStartContainertask(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StartContainertask {
-	cmd := new(StartContainertask)
+func NewUpdateLoggroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateLoggroup {
+	cmd := new(UpdateLoggroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ecs.New(sess)
+		cmd.api = cloudwatchlogs.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *StartContainertask) SetApi(api ecsiface.ECSAPI) {
+func (cmd *UpdateLoggroup) SetApi(api cloudwatchlogsiface.CloudWatchLogsAPI) {
 	cmd.api = api
 }
 
-func (cmd *StartContainertask) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *StartContainertask) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateLoggroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -11785,14 +21633,14 @@ func (cmd *StartContainertask) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("start containertask: AWS command returned nil output")
+			renv.Log().Warning("update loggroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("start containertask '%s' done", extracted)
+		renv.Log().Verbosef("update loggroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("start containertask done")
+		renv.Log().Verbose("update loggroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -11804,40 +21652,33 @@ func (cmd *StartContainertask) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *StartContainertask) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("containertask"), nil
+func (cmd *UpdateLoggroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("loggroup"), nil
 }
 
-func (cmd *StartContainertask) inject(params map[string]interface{}) error {
+func (cmd *UpdateLoggroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewStartDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StartDatabase {
-	cmd := new(StartDatabase)
+func NewUpdateLoginprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateLoginprofile {
+	cmd := new(UpdateLoginprofile)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = rds.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *StartDatabase) SetApi(api rdsiface.RDSAPI) {
+func (cmd *UpdateLoginprofile) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *StartDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *StartDatabase) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateLoginprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -11848,13 +21689,13 @@ func (cmd *StartDatabase) run(renv env.Running, params map[string]interface{}) (
 		}
 	}
 
-	input := &rds.StartDBInstanceInput{}
+	input := &iam.UpdateLoginProfileInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in rds.StartDBInstanceInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in iam.UpdateLoginProfileInput: %s", err)
 	}
-	start := time.Now()
-	output, err := cmd.api.StartDBInstance(input)
-	renv.Log().ExtraVerbosef("rds.StartDBInstance call took %s", time.Since(start))
+	start := time.Now()
+	output, err := cmd.api.UpdateLoginProfile(input)
+	renv.Log().ExtraVerbosef("iam.UpdateLoginProfile call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -11864,14 +21705,14 @@ func (cmd *StartDatabase) run(renv env.Running, params map[string]interface{}) (
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("start database: AWS command returned nil output")
+			renv.Log().Warning("update loginprofile: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("start database '%s' done", extracted)
+		renv.Log().Verbosef("update loginprofile '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("start database done")
+		renv.Log().Verbose("update loginprofile done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -11883,16 +21724,16 @@ func (cmd *StartDatabase) run(renv env.Running, params map[string]interface{}) (
 	return extracted, nil
 }
 
-func (cmd *StartDatabase) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("database"), nil
+func (cmd *UpdateLoginprofile) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("loginprofile"), nil
 }
 
-func (cmd *StartDatabase) inject(params map[string]interface{}) error {
+func (cmd *UpdateLoginprofile) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewStartInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StartInstance {
-	cmd := new(StartInstance)
+func NewUpdateNetworkaclrule(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateNetworkaclrule {
+	cmd := new(UpdateNetworkaclrule)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -11905,18 +21746,11 @@ func NewStartInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logg
 	return cmd
 }
 
-func (cmd *StartInstance) SetApi(api ec2iface.EC2API) {
+func (cmd *UpdateNetworkaclrule) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *StartInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *StartInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateNetworkaclrule) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -11927,13 +21761,7 @@ func (cmd *StartInstance) run(renv env.Running, params map[string]interface{}) (
 		}
 	}
 
-	input := &ec2.StartInstancesInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.StartInstancesInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.StartInstances(input)
-	renv.Log().ExtraVerbosef("ec2.StartInstances call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -11943,14 +21771,14 @@ func (cmd *StartInstance) run(renv env.Running, params map[string]interface{}) (
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("start instance: AWS command returned nil output")
+			renv.Log().Warning("update networkaclrule: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("start instance '%s' done", extracted)
+		renv.Log().Verbosef("update networkaclrule '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("start instance done")
+		renv.Log().Verbose("update networkaclrule done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -11962,61 +21790,29 @@ func (cmd *StartInstance) run(renv env.Running, params map[string]interface{}) (
 	return extracted, nil
 }
 
-func (cmd *StartInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.StartInstancesInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.StartInstancesInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.StartInstances(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.StartInstances call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: start instance ok")
-			return fakeDryRunId("instance"), nil
-		}
-	}
-
-	return nil, err
-}
-
-func (cmd *StartInstance) inject(params map[string]interface{}) error {
+func (cmd *UpdateNetworkaclrule) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewStopAlarm(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StopAlarm {
-	cmd := new(StopAlarm)
+func NewUpdateNetworkinterface(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateNetworkinterface {
+	cmd := new(UpdateNetworkinterface)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudwatch.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *StopAlarm) SetApi(api cloudwatchiface.CloudWatchAPI) {
+func (cmd *UpdateNetworkinterface) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *StopAlarm) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *StopAlarm) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateNetworkinterface) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12027,13 +21823,7 @@ func (cmd *StopAlarm) run(renv env.Running, params map[string]interface{}) (inte
 		}
 	}
 
-	input := &cloudwatch.DisableAlarmActionsInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in cloudwatch.DisableAlarmActionsInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.DisableAlarmActions(input)
-	renv.Log().ExtraVerbosef("cloudwatch.DisableAlarmActions call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -12043,14 +21833,14 @@ func (cmd *StopAlarm) run(renv env.Running, params map[string]interface{}) (inte
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("stop alarm: AWS command returned nil output")
+			renv.Log().Warning("update networkinterface: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("stop alarm '%s' done", extracted)
+		renv.Log().Verbosef("update networkinterface '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("stop alarm done")
+		renv.Log().Verbose("update networkinterface done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -12062,40 +21852,29 @@ func (cmd *StopAlarm) run(renv env.Running, params map[string]interface{}) (inte
 	return extracted, nil
 }
 
-func (cmd *StopAlarm) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("alarm"), nil
-}
-
-func (cmd *StopAlarm) inject(params map[string]interface{}) error {
+func (cmd *UpdateNetworkinterface) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewStopContainertask(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StopContainertask {
-	cmd := new(StopContainertask)
+func NewUpdateParameter(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateParameter {
+	cmd := new(UpdateParameter)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ecs.New(sess)
+		cmd.api = ssm.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *StopContainertask) SetApi(api ecsiface.ECSAPI) {
+func (cmd *UpdateParameter) SetApi(api ssmiface.SSMAPI) {
 	cmd.api = api
 }
 
-func (cmd *StopContainertask) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *StopContainertask) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateParameter) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12106,7 +21885,13 @@ func (cmd *StopContainertask) run(renv env.Running, params map[string]interface{
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &ssm.PutParameterInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ssm.PutParameterInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.PutParameter(input)
+	renv.Log().ExtraVerbosef("ssm.PutParameter call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -12116,14 +21901,14 @@ func (cmd *StopContainertask) run(renv env.Running, params map[string]interface{
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("stop containertask: AWS command returned nil output")
+			renv.Log().Warning("update parameter: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("stop containertask '%s' done", extracted)
+		renv.Log().Verbosef("update parameter '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("stop containertask done")
+		renv.Log().Verbose("update parameter done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -12135,40 +21920,33 @@ func (cmd *StopContainertask) run(renv env.Running, params map[string]interface{
 	return extracted, nil
 }
 
-func (cmd *StopContainertask) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("containertask"), nil
+func (cmd *UpdateParameter) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("parameter"), nil
 }
 
-func (cmd *StopContainertask) inject(params map[string]interface{}) error {
+func (cmd *UpdateParameter) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewStopDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StopDatabase {
-	cmd := new(StopDatabase)
+func NewUpdatePolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdatePolicy {
+	cmd := new(UpdatePolicy)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = rds.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *StopDatabase) SetApi(api rdsiface.RDSAPI) {
+func (cmd *UpdatePolicy) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *StopDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *StopDatabase) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdatePolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12179,13 +21957,7 @@ func (cmd *StopDatabase) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &rds.StopDBInstanceInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in rds.StopDBInstanceInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.StopDBInstance(input)
-	renv.Log().ExtraVerbosef("rds.StopDBInstance call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -12195,14 +21967,14 @@ func (cmd *StopDatabase) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("stop database: AWS command returned nil output")
+			renv.Log().Warning("update policy: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("stop database '%s' done", extracted)
+		renv.Log().Verbosef("update policy '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("stop database done")
+		renv.Log().Verbose("update policy done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -12214,40 +21986,33 @@ func (cmd *StopDatabase) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *StopDatabase) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("database"), nil
+func (cmd *UpdatePolicy) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("policy"), nil
 }
 
-func (cmd *StopDatabase) inject(params map[string]interface{}) error {
+func (cmd *UpdatePolicy) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewStopInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StopInstance {
-	cmd := new(StopInstance)
+func NewUpdateRecord(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateRecord {
+	cmd := new(UpdateRecord)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = route53.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *StopInstance) SetApi(api ec2iface.EC2API) {
+func (cmd *UpdateRecord) SetApi(api route53iface.Route53API) {
 	cmd.api = api
 }
 
-func (cmd *StopInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *StopInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateRecord) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12258,13 +22023,7 @@ func (cmd *StopInstance) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &ec2.StopInstancesInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.StopInstancesInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.StopInstances(input)
-	renv.Log().ExtraVerbosef("ec2.StopInstances call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -12274,14 +22033,14 @@ func (cmd *StopInstance) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("stop instance: AWS command returned nil output")
+			renv.Log().Warning("update record: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("stop instance '%s' done", extracted)
+		renv.Log().Verbosef("update record '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("stop instance done")
+		renv.Log().Verbose("update record done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -12293,61 +22052,33 @@ func (cmd *StopInstance) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *StopInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.StopInstancesInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.StopInstancesInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.StopInstances(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.StopInstances call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: stop instance ok")
-			return fakeDryRunId("instance"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *UpdateRecord) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("record"), nil
 }
 
-func (cmd *StopInstance) inject(params map[string]interface{}) error {
+func (cmd *UpdateRecord) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateBucket(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateBucket {
-	cmd := new(UpdateBucket)
+func NewUpdateRepository(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateRepository {
+	cmd := new(UpdateRepository)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = s3.New(sess)
+		cmd.api = ecr.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateBucket) SetApi(api s3iface.S3API) {
+func (cmd *UpdateRepository) SetApi(api ecriface.ECRAPI) {
 	cmd.api = api
 }
 
-func (cmd *UpdateBucket) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *UpdateBucket) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateRepository) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12358,7 +22089,13 @@ func (cmd *UpdateBucket) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &ecr.SetRepositoryPolicyInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ecr.SetRepositoryPolicyInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.SetRepositoryPolicy(input)
+	renv.Log().ExtraVerbosef("ecr.SetRepositoryPolicy call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -12368,14 +22105,14 @@ func (cmd *UpdateBucket) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update bucket: AWS command returned nil output")
+			renv.Log().Warning("update repository: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update bucket '%s' done", extracted)
+		renv.Log().Verbosef("update repository '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update bucket done")
+		renv.Log().Verbose("update repository done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -12387,40 +22124,33 @@ func (cmd *UpdateBucket) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *UpdateBucket) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("bucket"), nil
+func (cmd *UpdateRepository) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("repository"), nil
 }
 
-func (cmd *UpdateBucket) inject(params map[string]interface{}) error {
+func (cmd *UpdateRepository) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateContainertask(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateContainertask {
-	cmd := new(UpdateContainertask)
+func NewUpdateS3object(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateS3object {
+	cmd := new(UpdateS3object)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ecs.New(sess)
+		cmd.api = s3.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateContainertask) SetApi(api ecsiface.ECSAPI) {
+func (cmd *UpdateS3object) SetApi(api s3iface.S3API) {
 	cmd.api = api
 }
 
-func (cmd *UpdateContainertask) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *UpdateContainertask) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateS3object) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12431,13 +22161,13 @@ func (cmd *UpdateContainertask) run(renv env.Running, params map[string]interfac
 		}
 	}
 
-	input := &ecs.UpdateServiceInput{}
+	input := &s3.PutObjectAclInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ecs.UpdateServiceInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in s3.PutObjectAclInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.UpdateService(input)
-	renv.Log().ExtraVerbosef("ecs.UpdateService call took %s", time.Since(start))
+	output, err := cmd.api.PutObjectAcl(input)
+	renv.Log().ExtraVerbosef("s3.PutObjectAcl call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -12447,14 +22177,14 @@ func (cmd *UpdateContainertask) run(renv env.Running, params map[string]interfac
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update containertask: AWS command returned nil output")
+			renv.Log().Warning("update s3object: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update containertask '%s' done", extracted)
+		renv.Log().Verbosef("update s3object '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update containertask done")
+		renv.Log().Verbose("update s3object done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -12466,40 +22196,33 @@ func (cmd *UpdateContainertask) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *UpdateContainertask) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("containertask"), nil
+func (cmd *UpdateS3object) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("s3object"), nil
 }
 
-func (cmd *UpdateContainertask) inject(params map[string]interface{}) error {
+func (cmd *UpdateS3object) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateDistribution(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateDistribution {
-	cmd := new(UpdateDistribution)
+func NewUpdateScalinggroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateScalinggroup {
+	cmd := new(UpdateScalinggroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudfront.New(sess)
+		cmd.api = autoscaling.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateDistribution) SetApi(api cloudfrontiface.CloudFrontAPI) {
+func (cmd *UpdateScalinggroup) SetApi(api autoscalingiface.AutoScalingAPI) {
 	cmd.api = api
 }
 
-func (cmd *UpdateDistribution) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *UpdateDistribution) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateScalinggroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12510,7 +22233,13 @@ func (cmd *UpdateDistribution) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &autoscaling.UpdateAutoScalingGroupInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in autoscaling.UpdateAutoScalingGroupInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.UpdateAutoScalingGroup(input)
+	renv.Log().ExtraVerbosef("autoscaling.UpdateAutoScalingGroup call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -12520,14 +22249,14 @@ func (cmd *UpdateDistribution) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update distribution: AWS command returned nil output")
+			renv.Log().Warning("update scalinggroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update distribution '%s' done", extracted)
+		renv.Log().Verbosef("update scalinggroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update distribution done")
+		renv.Log().Verbose("update scalinggroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -12539,40 +22268,33 @@ func (cmd *UpdateDistribution) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *UpdateDistribution) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("distribution"), nil
+func (cmd *UpdateScalinggroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("scalinggroup"), nil
 }
 
-func (cmd *UpdateDistribution) inject(params map[string]interface{}) error {
+func (cmd *UpdateScalinggroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateImage {
-	cmd := new(UpdateImage)
+func NewUpdateSecret(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateSecret {
+	cmd := new(UpdateSecret)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = secretsmanager.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateImage) SetApi(api ec2iface.EC2API) {
+func (cmd *UpdateSecret) SetApi(api secretsmanageriface.SecretsManagerAPI) {
 	cmd.api = api
 }
 
-func (cmd *UpdateImage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *UpdateImage) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateSecret) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12583,7 +22305,13 @@ func (cmd *UpdateImage) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &secretsmanager.UpdateSecretInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in secretsmanager.UpdateSecretInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.UpdateSecret(input)
+	renv.Log().ExtraVerbosef("secretsmanager.UpdateSecret call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -12593,14 +22321,14 @@ func (cmd *UpdateImage) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update image: AWS command returned nil output")
+			renv.Log().Warning("update secret: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update image '%s' done", extracted)
+		renv.Log().Verbosef("update secret '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update image done")
+		renv.Log().Verbose("update secret done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -12612,12 +22340,16 @@ func (cmd *UpdateImage) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *UpdateImage) inject(params map[string]interface{}) error {
+func (cmd *UpdateSecret) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("secret"), nil
+}
+
+func (cmd *UpdateSecret) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateInstance {
-	cmd := new(UpdateInstance)
+func NewUpdateSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateSecuritygroup {
+	cmd := new(UpdateSecuritygroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -12630,18 +22362,11 @@ func NewUpdateInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Log
 	return cmd
 }
 
-func (cmd *UpdateInstance) SetApi(api ec2iface.EC2API) {
+func (cmd *UpdateSecuritygroup) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *UpdateInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *UpdateInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateSecuritygroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12652,13 +22377,7 @@ func (cmd *UpdateInstance) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &ec2.ModifyInstanceAttributeInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.ModifyInstanceAttributeInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.ModifyInstanceAttribute(input)
-	renv.Log().ExtraVerbosef("ec2.ModifyInstanceAttribute call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -12668,14 +22387,14 @@ func (cmd *UpdateInstance) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update instance: AWS command returned nil output")
+			renv.Log().Warning("update securitygroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update instance '%s' done", extracted)
+		renv.Log().Verbosef("update securitygroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update instance done")
+		renv.Log().Verbose("update securitygroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -12687,61 +22406,29 @@ func (cmd *UpdateInstance) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *UpdateInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.ModifyInstanceAttributeInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.ModifyInstanceAttributeInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.ModifyInstanceAttribute(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.ModifyInstanceAttribute call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: update instance ok")
-			return fakeDryRunId("instance"), nil
-		}
-	}
-
-	return nil, err
-}
-
-func (cmd *UpdateInstance) inject(params map[string]interface{}) error {
+func (cmd *UpdateSecuritygroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateLoginprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateLoginprofile {
-	cmd := new(UpdateLoginprofile)
+func NewUpdateStack(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateStack {
+	cmd := new(UpdateStack)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = cloudformation.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateLoginprofile) SetApi(api iamiface.IAMAPI) {
+func (cmd *UpdateStack) SetApi(api cloudformationiface.CloudFormationAPI) {
 	cmd.api = api
 }
 
-func (cmd *UpdateLoginprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *UpdateLoginprofile) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateStack) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12752,13 +22439,13 @@ func (cmd *UpdateLoginprofile) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	input := &iam.UpdateLoginProfileInput{}
+	input := &cloudformation.UpdateStackInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.UpdateLoginProfileInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in cloudformation.UpdateStackInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.UpdateLoginProfile(input)
-	renv.Log().ExtraVerbosef("iam.UpdateLoginProfile call took %s", time.Since(start))
+	output, err := cmd.api.UpdateStack(input)
+	renv.Log().ExtraVerbosef("cloudformation.UpdateStack call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -12768,14 +22455,14 @@ func (cmd *UpdateLoginprofile) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update loginprofile: AWS command returned nil output")
+			renv.Log().Warning("update stack: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update loginprofile '%s' done", extracted)
+		renv.Log().Verbosef("update stack '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update loginprofile done")
+		renv.Log().Verbose("update stack done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -12787,40 +22474,33 @@ func (cmd *UpdateLoginprofile) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *UpdateLoginprofile) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("loginprofile"), nil
+func (cmd *UpdateStack) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("stack"), nil
 }
 
-func (cmd *UpdateLoginprofile) inject(params map[string]interface{}) error {
+func (cmd *UpdateStack) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdatePolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdatePolicy {
-	cmd := new(UpdatePolicy)
+func NewUpdateStatemachine(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateStatemachine {
+	cmd := new(UpdateStatemachine)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = sfn.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdatePolicy) SetApi(api iamiface.IAMAPI) {
+func (cmd *UpdateStatemachine) SetApi(api sfniface.SFNAPI) {
 	cmd.api = api
 }
 
-func (cmd *UpdatePolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *UpdatePolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateStatemachine) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12831,13 +22511,13 @@ func (cmd *UpdatePolicy) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &iam.CreatePolicyVersionInput{}
+	input := &sfn.UpdateStateMachineInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.CreatePolicyVersionInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in sfn.UpdateStateMachineInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreatePolicyVersion(input)
-	renv.Log().ExtraVerbosef("iam.CreatePolicyVersion call took %s", time.Since(start))
+	output, err := cmd.api.UpdateStateMachine(input)
+	renv.Log().ExtraVerbosef("sfn.UpdateStateMachine call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -12847,14 +22527,14 @@ func (cmd *UpdatePolicy) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update policy: AWS command returned nil output")
+			renv.Log().Warning("update statemachine: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update policy '%s' done", extracted)
+		renv.Log().Verbosef("update statemachine '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update policy done")
+		renv.Log().Verbose("update statemachine done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -12866,40 +22546,33 @@ func (cmd *UpdatePolicy) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *UpdatePolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("policy"), nil
+func (cmd *UpdateStatemachine) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("statemachine"), nil
 }
 
-func (cmd *UpdatePolicy) inject(params map[string]interface{}) error {
+func (cmd *UpdateStatemachine) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateRecord(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateRecord {
-	cmd := new(UpdateRecord)
+func NewUpdateSubnet(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateSubnet {
+	cmd := new(UpdateSubnet)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = route53.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateRecord) SetApi(api route53iface.Route53API) {
+func (cmd *UpdateSubnet) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *UpdateRecord) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *UpdateRecord) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateSubnet) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12920,14 +22593,14 @@ func (cmd *UpdateRecord) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update record: AWS command returned nil output")
+			renv.Log().Warning("update subnet: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update record '%s' done", extracted)
+		renv.Log().Verbosef("update subnet '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update record done")
+		renv.Log().Verbose("update subnet done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -12939,40 +22612,33 @@ func (cmd *UpdateRecord) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *UpdateRecord) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("record"), nil
+func (cmd *UpdateSubnet) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("subnet"), nil
 }
 
-func (cmd *UpdateRecord) inject(params map[string]interface{}) error {
+func (cmd *UpdateSubnet) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateS3object(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateS3object {
-	cmd := new(UpdateS3object)
+func NewUpdateTable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateTable {
+	cmd := new(UpdateTable)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = s3.New(sess)
+		cmd.api = dynamodb.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateS3object) SetApi(api s3iface.S3API) {
+func (cmd *UpdateTable) SetApi(api dynamodbiface.DynamoDBAPI) {
 	cmd.api = api
 }
 
-func (cmd *UpdateS3object) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *UpdateS3object) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateTable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12983,13 +22649,7 @@ func (cmd *UpdateS3object) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &s3.PutObjectAclInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in s3.PutObjectAclInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.PutObjectAcl(input)
-	renv.Log().ExtraVerbosef("s3.PutObjectAcl call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -12999,14 +22659,14 @@ func (cmd *UpdateS3object) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update s3object: AWS command returned nil output")
+			renv.Log().Warning("update table: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update s3object '%s' done", extracted)
+		renv.Log().Verbosef("update table '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update s3object done")
+		renv.Log().Verbose("update table done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -13018,40 +22678,33 @@ func (cmd *UpdateS3object) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *UpdateS3object) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("s3object"), nil
+func (cmd *UpdateTable) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("table"), nil
 }
 
-func (cmd *UpdateS3object) inject(params map[string]interface{}) error {
+func (cmd *UpdateTable) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateScalinggroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateScalinggroup {
-	cmd := new(UpdateScalinggroup)
+func NewUpdateTargetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateTargetgroup {
+	cmd := new(UpdateTargetgroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = autoscaling.New(sess)
+		cmd.api = elbv2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateScalinggroup) SetApi(api autoscalingiface.AutoScalingAPI) {
+func (cmd *UpdateTargetgroup) SetApi(api elbv2iface.ELBV2API) {
 	cmd.api = api
 }
 
-func (cmd *UpdateScalinggroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *UpdateScalinggroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateTargetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -13062,13 +22715,7 @@ func (cmd *UpdateScalinggroup) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	input := &autoscaling.UpdateAutoScalingGroupInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in autoscaling.UpdateAutoScalingGroupInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.UpdateAutoScalingGroup(input)
-	renv.Log().ExtraVerbosef("autoscaling.UpdateAutoScalingGroup call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -13078,14 +22725,14 @@ func (cmd *UpdateScalinggroup) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update scalinggroup: AWS command returned nil output")
+			renv.Log().Warning("update targetgroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update scalinggroup '%s' done", extracted)
+		renv.Log().Verbosef("update targetgroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update scalinggroup done")
+		renv.Log().Verbose("update targetgroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -13097,16 +22744,16 @@ func (cmd *UpdateScalinggroup) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *UpdateScalinggroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("scalinggroup"), nil
+func (cmd *UpdateTargetgroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("targetgroup"), nil
 }
 
-func (cmd *UpdateScalinggroup) inject(params map[string]interface{}) error {
+func (cmd *UpdateTargetgroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateSecuritygroup {
-	cmd := new(UpdateSecuritygroup)
+func NewUpdateVpc(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateVpc {
+	cmd := new(UpdateVpc)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -13119,18 +22766,11 @@ func NewUpdateSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logge
 	return cmd
 }
 
-func (cmd *UpdateSecuritygroup) SetApi(api ec2iface.EC2API) {
+func (cmd *UpdateVpc) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *UpdateSecuritygroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *UpdateSecuritygroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateVpc) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -13151,14 +22791,14 @@ func (cmd *UpdateSecuritygroup) run(renv env.Running, params map[string]interfac
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update securitygroup: AWS command returned nil output")
+			renv.Log().Warning("update vpc: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update securitygroup '%s' done", extracted)
+		renv.Log().Verbosef("update vpc '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update securitygroup done")
+		renv.Log().Verbose("update vpc done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -13170,36 +22810,33 @@ func (cmd *UpdateSecuritygroup) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *UpdateSecuritygroup) inject(params map[string]interface{}) error {
+func (cmd *UpdateVpc) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("vpc"), nil
+}
+
+func (cmd *UpdateVpc) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateStack(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateStack {
-	cmd := new(UpdateStack)
+func NewWaitCertificate(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *WaitCertificate {
+	cmd := new(WaitCertificate)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudformation.New(sess)
+		cmd.api = acm.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateStack) SetApi(api cloudformationiface.CloudFormationAPI) {
+func (cmd *WaitCertificate) SetApi(api acmiface.ACMAPI) {
 	cmd.api = api
 }
 
-func (cmd *UpdateStack) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *UpdateStack) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *WaitCertificate) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -13210,13 +22847,7 @@ func (cmd *UpdateStack) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &cloudformation.UpdateStackInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in cloudformation.UpdateStackInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.UpdateStack(input)
-	renv.Log().ExtraVerbosef("cloudformation.UpdateStack call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -13226,14 +22857,14 @@ func (cmd *UpdateStack) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update stack: AWS command returned nil output")
+			renv.Log().Warning("wait certificate: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update stack '%s' done", extracted)
+		renv.Log().Verbosef("wait certificate '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update stack done")
+		renv.Log().Verbose("wait certificate done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -13245,40 +22876,33 @@ func (cmd *UpdateStack) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *UpdateStack) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("stack"), nil
+func (cmd *WaitCertificate) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("certificate"), nil
 }
 
-func (cmd *UpdateStack) inject(params map[string]interface{}) error {
+func (cmd *WaitCertificate) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateSubnet(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateSubnet {
-	cmd := new(UpdateSubnet)
+func NewWaitDistribution(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *WaitDistribution {
+	cmd := new(WaitDistribution)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = cloudfront.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateSubnet) SetApi(api ec2iface.EC2API) {
+func (cmd *WaitDistribution) SetApi(api cloudfrontiface.CloudFrontAPI) {
 	cmd.api = api
 }
 
-func (cmd *UpdateSubnet) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *UpdateSubnet) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *WaitDistribution) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -13289,13 +22913,7 @@ func (cmd *UpdateSubnet) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &ec2.ModifySubnetAttributeInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.ModifySubnetAttributeInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.ModifySubnetAttribute(input)
-	renv.Log().ExtraVerbosef("ec2.ModifySubnetAttribute call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -13305,14 +22923,14 @@ func (cmd *UpdateSubnet) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update subnet: AWS command returned nil output")
+			renv.Log().Warning("wait distribution: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update subnet '%s' done", extracted)
+		renv.Log().Verbosef("wait distribution '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update subnet done")
+		renv.Log().Verbose("wait distribution done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -13324,40 +22942,33 @@ func (cmd *UpdateSubnet) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *UpdateSubnet) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("subnet"), nil
+func (cmd *WaitDistribution) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("distribution"), nil
 }
 
-func (cmd *UpdateSubnet) inject(params map[string]interface{}) error {
+func (cmd *WaitDistribution) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateTargetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateTargetgroup {
-	cmd := new(UpdateTargetgroup)
+func NewWaitKubernetescluster(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *WaitKubernetescluster {
+	cmd := new(WaitKubernetescluster)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elbv2.New(sess)
+		cmd.api = eks.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateTargetgroup) SetApi(api elbv2iface.ELBV2API) {
+func (cmd *WaitKubernetescluster) SetApi(api eksiface.EKSAPI) {
 	cmd.api = api
 }
 
-func (cmd *UpdateTargetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *UpdateTargetgroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *WaitKubernetescluster) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -13378,14 +22989,14 @@ func (cmd *UpdateTargetgroup) run(renv env.Running, params map[string]interface{
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update targetgroup: AWS command returned nil output")
+			renv.Log().Warning("wait kubernetescluster: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update targetgroup '%s' done", extracted)
+		renv.Log().Verbosef("wait kubernetescluster '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update targetgroup done")
+		renv.Log().Verbose("wait kubernetescluster done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -13397,10 +23008,10 @@ func (cmd *UpdateTargetgroup) run(renv env.Running, params map[string]interface{
 	return extracted, nil
 }
 
-func (cmd *UpdateTargetgroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("targetgroup"), nil
+func (cmd *WaitKubernetescluster) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("kubernetescluster"), nil
 }
 
-func (cmd *UpdateTargetgroup) inject(params map[string]interface{}) error {
+func (cmd *WaitKubernetescluster) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
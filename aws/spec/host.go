@@ -0,0 +1,125 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/wallix/awless/logger"
+)
+
+type CreateHost struct {
+	_                string `action:"create" entity:"host" awsAPI:"ec2" awsCall:"AllocateHosts" awsInput:"ec2.AllocateHostsInput" awsOutput:"ec2.AllocateHostsOutput"`
+	logger           *logger.Logger
+	graph            cloud.GraphAPI
+	api              ec2iface.EC2API
+	Type             *string `awsName:"InstanceType" awsType:"awsstr" templateName:"type"`
+	AvailabilityZone *string `awsName:"AvailabilityZone" awsType:"awsstr" templateName:"availabilityzone"`
+	AutoPlacement    *string `awsName:"AutoPlacement" awsType:"awsstr" templateName:"auto-placement"`
+	Quantity         *int64  `awsName:"Quantity" awsType:"awsint64"`
+}
+
+func (cmd *CreateHost) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("availabilityzone"), params.Key("type"), params.Opt("auto-placement")),
+		params.Validators{
+			"auto-placement": params.IsInEnumIgnoreCase("on", "off"),
+		})
+}
+
+// BeforeRun allocates one dedicated host per command, so that it can be released on revert
+func (cmd *CreateHost) BeforeRun(renv env.Running) error {
+	cmd.Quantity = Int64(1)
+	if cmd.AutoPlacement != nil {
+		cmd.AutoPlacement = String(strings.ToLower(StringValue(cmd.AutoPlacement)))
+	}
+	return nil
+}
+
+func (cmd *CreateHost) ExtractResult(i interface{}) string {
+	out := i.(*ec2.AllocateHostsOutput)
+	if len(out.HostIds) == 0 {
+		return ""
+	}
+	return StringValue(out.HostIds[0])
+}
+
+type DeleteHost struct {
+	_      string `action:"delete" entity:"host" awsAPI:"ec2" awsCall:"ReleaseHosts" awsInput:"ec2.ReleaseHostsInput" awsOutput:"ec2.ReleaseHostsOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2iface.EC2API
+	Id     *string `awsName:"HostIds" awsType:"awsstringslice" templateName:"id"`
+}
+
+func (cmd *DeleteHost) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
+
+// AfterRun reports the host release failure, the API returning the unsuccessful releases without error
+func (cmd *DeleteHost) AfterRun(renv env.Running, output interface{}) error {
+	out, ok := output.(*ec2.ReleaseHostsOutput)
+	if !ok || out == nil {
+		return nil
+	}
+	for _, unsuccessful := range out.Unsuccessful {
+		if unsuccessful.Error != nil {
+			return fmt.Errorf("delete host %s: %s", StringValue(unsuccessful.ResourceId), StringValue(unsuccessful.Error.Message))
+		}
+	}
+	return nil
+}
+
+type CreateHostreservation struct {
+	_          string `action:"create" entity:"hostreservation" awsAPI:"ec2" awsCall:"PurchaseHostReservation" awsInput:"ec2.PurchaseHostReservationInput" awsOutput:"ec2.PurchaseHostReservationOutput"`
+	logger     *logger.Logger
+	graph      cloud.GraphAPI
+	api        ec2iface.EC2API
+	Offering   *string   `awsName:"OfferingId" awsType:"awsstr" templateName:"offering"`
+	Hosts      []*string `awsName:"HostIdSet" awsType:"awsstringslice" templateName:"hosts"`
+	LimitPrice *string   `awsName:"LimitPrice" awsType:"awsstr" templateName:"limit-price"`
+	Currency   *string   `awsName:"CurrencyCode" awsType:"awsstr" templateName:"currency"`
+}
+
+func (cmd *CreateHostreservation) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("hosts"), params.Key("offering"), params.Opt("currency", "limit-price")),
+		params.Validators{
+			"currency": params.IsInEnumIgnoreCase(ec2.CurrencyCodeValuesUsd),
+		})
+}
+
+// BeforeRun sets the currency as expected by the API (i.e. uppercase)
+func (cmd *CreateHostreservation) BeforeRun(renv env.Running) error {
+	if cmd.Currency != nil {
+		cmd.Currency = String(strings.ToUpper(StringValue(cmd.Currency)))
+	}
+	return nil
+}
+
+func (cmd *CreateHostreservation) ExtractResult(i interface{}) string {
+	for _, purchase := range i.(*ec2.PurchaseHostReservationOutput).Purchase {
+		if purchase.HostReservationId != nil {
+			return StringValue(purchase.HostReservationId)
+		}
+	}
+	return ""
+}
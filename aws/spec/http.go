@@ -0,0 +1,113 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+type CheckHttp struct {
+	_            string `action:"check" entity:"http" awsAPI:"elbv2"`
+	logger       *logger.Logger
+	graph        cloud.GraphAPI
+	api          elbv2iface.ELBV2API
+	Url          *string `templateName:"url"`
+	Loadbalancer *string `templateName:"loadbalancer"`
+	Status       *string `templateName:"status"`
+	Timeout      *string `templateName:"timeout"`
+}
+
+func (cmd *CheckHttp) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("url"), params.Key("timeout"), params.Opt("loadbalancer", "status")),
+		params.Validators{
+			"timeout": isCheckTimeout,
+		})
+}
+
+func (cmd *CheckHttp) ManualRun(renv env.Running) (interface{}, error) {
+	timeout, err := parseCheckTimeout(StringValue(cmd.Timeout))
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(StringValue(cmd.Url))
+	if err != nil {
+		return nil, fmt.Errorf("check http: invalid url: %s", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("check http: expecting http or https url, got '%s'", StringValue(cmd.Url))
+	}
+	if lb := StringValue(cmd.Loadbalancer); lb != "" {
+		dnsName, err := cmd.loadbalancerDNSName(lb)
+		if err != nil {
+			return nil, err
+		}
+		if port := u.Port(); port != "" {
+			u.Host = net.JoinHostPort(dnsName, port)
+		} else {
+			u.Host = dnsName
+		}
+	}
+	expect := "200"
+	if cmd.Status != nil {
+		expect = StringValue(cmd.Status)
+	}
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	c := &checker{
+		renv:        renv,
+		description: fmt.Sprintf("http %s", u),
+		timeout:     timeout,
+		frequency:   5 * time.Second,
+		fetchFunc: func() (string, error) {
+			resp, err := client.Get(u.String())
+			if err != nil {
+				return unreachableState, nil
+			}
+			resp.Body.Close()
+			return strconv.Itoa(resp.StatusCode), nil
+		},
+		expect:    expect,
+		logger:    cmd.logger,
+		checkName: "status",
+	}
+	return nil, c.check()
+}
+
+func (cmd *CheckHttp) loadbalancerDNSName(id string) (string, error) {
+	out, err := cmd.api.DescribeLoadBalancers(&elbv2.DescribeLoadBalancersInput{LoadBalancerArns: []*string{&id}})
+	if err != nil {
+		return "", fmt.Errorf("check http: %s", err)
+	}
+	for _, lb := range out.LoadBalancers {
+		if StringValue(lb.LoadBalancerArn) == id {
+			return StringValue(lb.DNSName), nil
+		}
+	}
+	return "", fmt.Errorf("check http: loadbalancer %s not found", id)
+}
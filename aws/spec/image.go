@@ -16,6 +16,7 @@ limitations under the License.
 package awsspec
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -26,43 +27,131 @@ import (
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/wallix/awless/logger"
 )
 
 type CreateImage struct {
-	_           string `action:"create" entity:"image" awsAPI:"ec2" awsCall:"CreateImage" awsInput:"ec2.CreateImageInput" awsOutput:"ec2.CreateImageOutput" awsDryRun:"true"`
-	logger      *logger.Logger
-	graph       cloud.GraphAPI
-	api         ec2iface.EC2API
-	Name        *string `awsName:"Name" awsType:"awsstr" templateName:"name"`
-	Instance    *string `awsName:"InstanceId" awsType:"awsstr" templateName:"instance"`
-	Reboot      *bool   `awsName:"NoReboot" awsType:"awsbool" templateName:"reboot"`
-	Description *string `awsName:"Description" awsType:"awsstr" templateName:"description"`
+	_            string `action:"create" entity:"image" awsAPI:"ec2" awsDryRun:"manual"`
+	logger       *logger.Logger
+	graph        cloud.GraphAPI
+	api          ec2iface.EC2API
+	Name         *string `templateName:"name"`
+	Instance     *string `templateName:"instance"`
+	Snapshot     *string `templateName:"snapshot"`
+	Reboot       *bool   `templateName:"reboot"`
+	Architecture *string `templateName:"architecture"`
+	Description  *string `templateName:"description"`
 }
 
 func (cmd *CreateImage) ParamsSpec() params.Spec {
 	return params.NewSpec(
-		params.AllOf(params.Key("instance"), params.Key("name"), params.Opt("description", "reboot")),
+		params.AllOf(params.Key("name"), params.OnlyOneOf(params.Key("instance"), params.Key("snapshot")),
+			params.Opt("architecture", "description", "reboot"),
+		),
 		params.Validators{
 			"name": params.MinLengthOf(3),
+			"reboot": func(i interface{}, others map[string]interface{}) error {
+				if _, ok := others["snapshot"]; ok {
+					return fmt.Errorf("only applicable to images created from an instance")
+				}
+				return nil
+			},
+			"architecture": func(i interface{}, others map[string]interface{}) error {
+				if _, ok := others["snapshot"]; !ok {
+					return fmt.Errorf("only applicable to images created from a snapshot")
+				}
+				return params.IsInEnumIgnoreCase("i386", "x86_64", "arm64")(i, others)
+			},
 		})
 }
 
-func (cmd *CreateImage) BeforeRun(renv env.Running) error {
-	if reboot := cmd.Reboot; reboot != nil && *reboot {
-		cmd.Reboot = nil
+func (cmd *CreateImage) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	var err error
+	if cmd.Snapshot != nil {
+		input := cmd.registerInput()
+		input.DryRun = Bool(true)
+		_, err = cmd.api.RegisterImage(input)
 	} else {
-		cmd.Reboot = Bool(true) // so that ec2.CreateImageInput.NoReboot = true and therefore by default no reboot from AWS
+		input := cmd.createInput()
+		input.DryRun = Bool(true)
+		_, err = cmd.api.CreateImage(input)
 	}
-	return nil
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			renv.Log().Verbose("dry run: create image ok")
+			return fakeDryRunId("image"), nil
+		}
+	}
+
+	return nil, err
+}
+
+// ManualRun creates the image of an instance or, given an EBS snapshot, registers
+// an HVM image whose root device is a volume restored from the snapshot
+func (cmd *CreateImage) ManualRun(renv env.Running) (interface{}, error) {
+	start := time.Now()
+	if cmd.Snapshot != nil {
+		output, err := cmd.api.RegisterImage(cmd.registerInput())
+		renv.Log().ExtraVerbosef("ec2.RegisterImage call took %s", time.Since(start))
+		return output, err
+	}
+	output, err := cmd.api.CreateImage(cmd.createInput())
+	renv.Log().ExtraVerbosef("ec2.CreateImage call took %s", time.Since(start))
+	return output, err
+}
+
+func (cmd *CreateImage) IAMActions(params map[string]interface{}) []string {
+	if _, ok := params["snapshot"]; ok {
+		return []string{"ec2:RegisterImage"}
+	}
+	return []string{"ec2:CreateImage"}
 }
 
 func (cmd *CreateImage) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*ec2.CreateImageOutput).ImageId)
+	switch out := i.(type) {
+	case *ec2.RegisterImageOutput:
+		return awssdk.StringValue(out.ImageId)
+	case *ec2.CreateImageOutput:
+		return awssdk.StringValue(out.ImageId)
+	}
+	return ""
+}
+
+func (cmd *CreateImage) createInput() *ec2.CreateImageInput {
+	input := &ec2.CreateImageInput{Name: cmd.Name, InstanceId: cmd.Instance, Description: cmd.Description}
+	if !BoolValue(cmd.Reboot) {
+		input.NoReboot = Bool(true) // by default no reboot from AWS
+	}
+	return input
 }
 
+func (cmd *CreateImage) registerInput() *ec2.RegisterImageInput {
+	arch := "x86_64"
+	if cmd.Architecture != nil {
+		arch = strings.ToLower(StringValue(cmd.Architecture))
+	}
+	return &ec2.RegisterImageInput{
+		Name:               cmd.Name,
+		Description:        cmd.Description,
+		Architecture:       String(arch),
+		VirtualizationType: String("hvm"),
+		RootDeviceName:     String(imageRootDevice),
+		BlockDeviceMappings: []*ec2.BlockDeviceMapping{
+			{DeviceName: String(imageRootDevice), Ebs: &ec2.EbsBlockDevice{SnapshotId: cmd.Snapshot, DeleteOnTermination: Bool(true)}},
+		},
+	}
+}
+
+const imageRootDevice = "/dev/xvda"
+
 type UpdateImage struct {
 	_            string `action:"update" entity:"image" awsAPI:"ec2" awsDryRun:"manual"`
 	logger       *logger.Logger
@@ -77,9 +166,18 @@ type UpdateImage struct {
 }
 
 func (cmd *UpdateImage) ParamsSpec() params.Spec {
-	return params.NewSpec(params.AllOf(params.Key("id"),
-		params.Opt("accounts", "description", "groups", "operation", "product-codes"),
-	))
+	builder := params.SpecBuilder(params.AllOf(params.Key("id"),
+		params.Opt("account", "accounts", "description", "groups", "operation", "product-codes"),
+	), params.Validators{"operation": params.IsInEnumIgnoreCase("add", "remove")})
+	builder.AddReducer(accountToAccounts, "account")
+	return builder.Done()
+}
+
+func accountToAccounts(values map[string]interface{}) (map[string]interface{}, error) {
+	if account, hasAccount := values["account"]; hasAccount {
+		return map[string]interface{}{"accounts": account}, nil
+	}
+	return nil, nil
 }
 
 func (cmd *UpdateImage) prepareImageAttributeInput(ctx map[string]interface{}) (*ec2.ModifyImageAttributeInput, error) {
@@ -113,7 +211,7 @@ func (cmd *UpdateImage) ManualRun(renv env.Running) (interface{}, error) {
 	return output, err
 }
 
-func (cmd *UpdateImage) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateImage) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("dry run: cannot set params on command struct: %s", err)
 	}
@@ -141,27 +239,86 @@ func (cmd *UpdateImage) dryRun(renv env.Running, params map[string]interface{})
 }
 
 type CopyImage struct {
-	_            string `action:"copy" entity:"image" awsAPI:"ec2" awsCall:"CopyImage" awsInput:"ec2.CopyImageInput" awsOutput:"ec2.CopyImageOutput" awsDryRun:""`
+	_            string `action:"copy" entity:"image" awsAPI:"ec2" awsDryRun:"manual"`
 	logger       *logger.Logger
 	graph        cloud.GraphAPI
 	api          ec2iface.EC2API
 	Name         *string `awsName:"Name" awsType:"awsstr" templateName:"name"`
 	SourceId     *string `awsName:"SourceImageId" awsType:"awsstr" templateName:"source-id"`
 	SourceRegion *string `awsName:"SourceRegion" awsType:"awsstr" templateName:"source-region"`
+	Region       *string `templateName:"region"`
 	Encrypted    *bool   `awsName:"Encrypted" awsType:"awsbool" templateName:"encrypted"`
+	KmsKey       *string `awsName:"KmsKeyId" awsType:"awsstr" templateName:"kms-key"`
 	Description  *string `awsName:"Description" awsType:"awsstr" templateName:"description"`
 }
 
 func (cmd *CopyImage) ParamsSpec() params.Spec {
-	return params.NewSpec(params.AllOf(params.Key("name"), params.Key("source-id"), params.Key("source-region"),
-		params.Opt("description", "encrypted"),
+	builder := params.SpecBuilder(params.AllOf(params.Key("name"), params.Key("source-id"),
+		params.Opt("description", "encrypted", "kms-key", "region", "source-region"),
 	))
+	builder.AddReducer(encryptWithKMSKey, "encrypted", "kms-key")
+	return builder.Done()
+}
+
+func (cmd *CopyImage) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+	api, input, err := cmd.prepareCopyInput(renv.Context())
+	if err != nil {
+		return nil, err
+	}
+	input.SetDryRun(true)
+
+	_, err = api.CopyImage(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			renv.Log().Verbose("dry run: copy image ok")
+			return fakeDryRunId("image"), nil
+		}
+	}
+
+	return nil, err
+}
+
+// ManualRun copies the image in the region given, the copy being made by a request to the destination region,
+// from the current region by default
+func (cmd *CopyImage) ManualRun(renv env.Running) (interface{}, error) {
+	api, input, err := cmd.prepareCopyInput(renv.Context())
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	output, err := api.CopyImage(input)
+	renv.Log().ExtraVerbosef("ec2.CopyImage call took %s", time.Since(start))
+	return output, err
+}
+
+func (cmd *CopyImage) IAMActions(params map[string]interface{}) []string {
+	return []string{"ec2:CopyImage"}
 }
 
 func (cmd *CopyImage) ExtractResult(i interface{}) string {
 	return awssdk.StringValue(i.(*ec2.CopyImageOutput).ImageId)
 }
 
+func (cmd *CopyImage) prepareCopyInput(ctx map[string]interface{}) (ec2iface.EC2API, *ec2.CopyImageInput, error) {
+	input := &ec2.CopyImageInput{}
+	if err := structInjector(cmd, input, ctx); err != nil {
+		return nil, nil, fmt.Errorf("cannot inject in ec2.CopyImageInput: %s", err)
+	}
+	if input.SourceRegion == nil {
+		region := apiRegion(cmd.api)
+		if region == "" {
+			return nil, nil, errors.New("missing 'source-region': cannot get the current region")
+		}
+		input.SourceRegion = String(region)
+	}
+	api, err := regionalEC2(cmd.api, StringValue(cmd.Region))
+	return api, input, err
+}
+
 type ImportImage struct {
 	_            string `action:"import" entity:"image" awsAPI:"ec2" awsCall:"ImportImage" awsInput:"ec2.ImportImageInput" awsOutput:"ec2.ImportImageOutput" awsDryRun:""`
 	logger       *logger.Logger
@@ -197,18 +354,22 @@ type DeleteImage struct {
 	api             ec2iface.EC2API
 	Id              *string `templateName:"id"`
 	DeleteSnapshots *bool   `templateName:"delete-snapshots"`
+	Region          *string `templateName:"region"`
 }
 
 func (cmd *DeleteImage) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("id"),
-		params.Opt("delete-snapshots"),
+		params.Opt("delete-snapshots", "region"),
 	))
 }
 
-func (cmd *DeleteImage) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteImage) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
+	if err := cmd.useRegion(); err != nil {
+		return nil, err
+	}
 	input := &ec2.DeregisterImageInput{}
 	input.DryRun = Bool(true)
 
@@ -241,6 +402,9 @@ func (cmd *DeleteImage) dryRun(renv env.Running, params map[string]interface{})
 }
 
 func (cmd *DeleteImage) ManualRun(renv env.Running) (interface{}, error) {
+	if err := cmd.useRegion(); err != nil {
+		return nil, err
+	}
 	input := &ec2.DeregisterImageInput{}
 
 	if err := setFieldWithType(cmd.Id, input, "ImageId", awsstr); err != nil {
@@ -265,6 +429,7 @@ func (cmd *DeleteImage) ManualRun(renv env.Running) (interface{}, error) {
 	if BoolValue(cmd.DeleteSnapshots) {
 		for _, snap := range snaps {
 			deleteSnapshot := CommandFactory.Build("deletesnapshot")().(*DeleteSnapshot)
+			deleteSnapshot.SetApi(cmd.api)
 			entries := map[string]interface{}{
 				"id": snap,
 			}
@@ -279,6 +444,16 @@ func (cmd *DeleteImage) ManualRun(renv env.Running) (interface{}, error) {
 	return output, nil
 }
 
+// useRegion deletes the image in the region given, the one of a copy made in another region
+func (cmd *DeleteImage) useRegion() error {
+	api, err := regionalEC2(cmd.api, StringValue(cmd.Region))
+	if err != nil {
+		return err
+	}
+	cmd.api = api
+	return nil
+}
+
 func (cmd *DeleteImage) imageSnapshots(id string) ([]string, error) {
 	var snapshots []string
 	imgs, err := cmd.api.DescribeImages(&ec2.DescribeImagesInput{ImageIds: []*string{String(id)}})
@@ -298,3 +473,24 @@ func (cmd *DeleteImage) imageSnapshots(id string) ([]string, error) {
 	}
 	return snapshots, nil
 }
+
+// regionalEC2 returns a client of the EC2 API in the given region with the configuration
+// and credentials of the given client, returned as is when already in the region
+func regionalEC2(api ec2iface.EC2API, region string) (ec2iface.EC2API, error) {
+	client, ok := api.(*ec2.EC2)
+	if !ok || region == "" || region == apiRegion(api) {
+		return api, nil
+	}
+	sess, err := session.NewSession(client.Config.Copy(&awssdk.Config{Region: String(region)}))
+	if err != nil {
+		return nil, fmt.Errorf("region %s: %s", region, err)
+	}
+	return ec2.New(sess), nil
+}
+
+func apiRegion(api ec2iface.EC2API) string {
+	if client, ok := api.(*ec2.EC2); ok {
+		return StringValue(client.Config.Region)
+	}
+	return ""
+}
@@ -0,0 +1,37 @@
+package awsspec
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestRegionalEC2(t *testing.T) {
+	creds := credentials.NewStaticCredentials("id", "secret", "")
+	api := ec2.New(session.Must(session.NewSession(&awssdk.Config{Region: awssdk.String("us-west-2"), Credentials: creds})))
+
+	same, err := regionalEC2(api, "us-west-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if same != api {
+		t.Fatal("expected the client of the current region to be reused")
+	}
+	if same, _ = regionalEC2(api, ""); same != api {
+		t.Fatal("expected the client to be reused without region")
+	}
+
+	other, err := regionalEC2(api, "eu-west-3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := apiRegion(other), "eu-west-3"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if other.(*ec2.EC2).Config.Credentials != creds {
+		t.Fatal("expected the credentials of the client")
+	}
+}
@@ -17,13 +17,16 @@ package awsspec
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"github.com/wallix/awless/aws/config"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/template/env"
@@ -35,27 +38,42 @@ type CreateInstance struct {
 	logger         *logger.Logger
 	graph          cloud.GraphAPI
 	api            ec2iface.EC2API
-	Image          *string   `awsName:"ImageId" awsType:"awsstr" templateName:"image"`
-	Count          *int64    `awsName:"MaxCount,MinCount" awsType:"awsin64" templateName:"count"`
-	Type           *string   `awsName:"InstanceType" awsType:"awsstr" templateName:"type"`
-	Name           *string   `templateName:"name"`
-	Subnet         *string   `awsName:"SubnetId" awsType:"awsstr" templateName:"subnet"`
-	Keypair        *string   `awsName:"KeyName" awsType:"awsstr" templateName:"keypair"`
-	PrivateIP      *string   `awsName:"PrivateIpAddress" awsType:"awsstr" templateName:"ip"`
-	UserData       *string   `awsName:"UserData" awsType:"awsuserdatatobase64" templateName:"userdata"`
-	SecurityGroups []*string `awsName:"SecurityGroupIds" awsType:"awsstringslice" templateName:"securitygroup"`
-	Lock           *bool     `awsName:"DisableApiTermination" awsType:"awsbool" templateName:"lock"`
-	Role           *string   `awsName:"IamInstanceProfile.Name" awsType:"awsstr" templateName:"role"`
-	DistroQuery    *string   `awsType:"awsstr" templateName:"distro"`
+	Image          *string     `awsName:"ImageId" awsType:"awsstr" templateName:"image"`
+	Count          *int64      `awsName:"MaxCount,MinCount" awsType:"awsin64" templateName:"count"`
+	Type           *string     `awsName:"InstanceType" awsType:"awsstr" templateName:"type"`
+	Name           *string     `templateName:"name"`
+	Subnet         *string     `awsName:"SubnetId" awsType:"awsstr" templateName:"subnet"`
+	Keypair        *string     `awsName:"KeyName" awsType:"awsstr" templateName:"keypair"`
+	PrivateIP      *string     `awsName:"PrivateIpAddress" awsType:"awsstr" templateName:"ip"`
+	UserData       interface{} `awsName:"UserData" awsType:"awsuserdatatobase64" templateName:"userdata"`
+	SecurityGroups []*string   `awsName:"SecurityGroupIds" awsType:"awsstringslice" templateName:"securitygroup"`
+	Lock           *bool       `awsName:"DisableApiTermination" awsType:"awsbool" templateName:"lock"`
+	Role           *string     `awsName:"IamInstanceProfile.Name" awsType:"awsstr" templateName:"role"`
+	RoleArn        *string     `awsName:"IamInstanceProfile.Arn" awsType:"awsstr"`
+	BlockDevices   []*string   `awsName:"BlockDeviceMappings" awsType:"awsblockdevices" templateName:"block-devices"`
+	Tags           []*string   `awsName:"TagSpecifications" awsType:"awsinstancetags" templateName:"tags"`
+	PlacementGroup *string     `awsName:"Placement.GroupName" awsType:"awsstr" templateName:"placementgroup"`
+	Host           *string     `awsName:"Placement.HostId" awsType:"awsstr" templateName:"host"`
+	Tenancy        *string     `awsName:"Placement.Tenancy" awsType:"awsstr" templateName:"tenancy"`
+	DistroQuery    *string     `awsType:"awsstr" templateName:"distro"`
 }
 
 func (cmd *CreateInstance) ParamsSpec() params.Spec {
 	builder := params.SpecBuilder(
 		params.AllOf(params.OnlyOneOf(params.Key("distro"), params.Key("image")),
 			params.Key("count"), params.Key("type"), params.Key("name"), params.Key("subnet"),
-			params.Opt(params.Suggested("keypair", "securitygroup"), "ip", "userdata", "lock", "role"),
+			params.Opt(params.Suggested("keypair", "securitygroup"), "ip", "userdata", "lock", "role", "block-devices", "tags", "placementgroup", "host", "tenancy"),
 		),
-		params.Validators{"ip": params.IsIP},
+		params.Validators{
+			"ip":      params.IsIP,
+			"tenancy": params.IsInEnumIgnoreCase("default", "dedicated", "host"),
+			"block-devices": func(i interface{}, others map[string]interface{}) error {
+				return validateBlockDevices(i)
+			},
+			"type": func(i interface{}, others map[string]interface{}) error {
+				return validateInstanceType(cmd.api, i)
+			},
+		},
 	)
 	builder.AddReducer(cmd.convertDistroToAMI, "distro")
 	return builder.Done()
@@ -87,6 +105,20 @@ func (cmd *CreateInstance) convertDistroToAMI(values map[string]interface{}) (ma
 	return nil, nil
 }
 
+// BeforeRun launches the instance with a host tenancy when placed on a dedicated host,
+// and with the instance profile given by ARN rather than by name when the role is an ARN
+func (cmd *CreateInstance) BeforeRun(renv env.Running) error {
+	if strings.HasPrefix(StringValue(cmd.Role), "arn:") {
+		cmd.RoleArn, cmd.Role = cmd.Role, nil
+	}
+	if cmd.Tenancy != nil {
+		cmd.Tenancy = String(strings.ToLower(StringValue(cmd.Tenancy)))
+	} else if cmd.Host != nil {
+		cmd.Tenancy = String(ec2.TenancyHost)
+	}
+	return nil
+}
+
 func (cmd *CreateInstance) ExtractResult(i interface{}) string {
 	return StringValue(i.(*ec2.Reservation).Instances[0].InstanceId)
 }
@@ -96,17 +128,170 @@ func (cmd *CreateInstance) AfterRun(renv env.Running, output interface{}) error
 }
 
 type UpdateInstance struct {
-	_      string `action:"update" entity:"instance" awsAPI:"ec2" awsCall:"ModifyInstanceAttribute" awsInput:"ec2.ModifyInstanceAttributeInput" awsOutput:"ec2.ModifyInstanceAttributeOutput" awsDryRun:""`
-	logger *logger.Logger
-	graph  cloud.GraphAPI
-	api    ec2iface.EC2API
-	Id     *string `awsName:"InstanceId" awsType:"awsstr" templateName:"id"`
-	Type   *string `awsName:"InstanceType.Value" awsType:"awsstr" templateName:"type"`
-	Lock   *bool   `awsName:"DisableApiTermination" awsType:"awsboolattribute" templateName:"lock"`
+	_       string `action:"update" entity:"instance" awsAPI:"ec2" awsDryRun:"manual"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     ec2iface.EC2API
+	Id      *string `awsName:"InstanceId" awsType:"awsstr" templateName:"id"`
+	Type    *string `awsName:"InstanceType.Value" awsType:"awsstr" templateName:"type"`
+	Lock    *bool   `awsName:"DisableApiTermination" awsType:"awsboolattribute" templateName:"lock"`
+	restart bool
 }
 
 func (cmd *UpdateInstance) ParamsSpec() params.Spec {
-	return params.NewSpec(params.AllOf(params.Key("id"), params.Opt("lock", "type")))
+	return params.NewSpec(params.AllOf(params.Key("id"), params.Opt("lock", "type")),
+		params.Validators{"type": func(i interface{}, others map[string]interface{}) error {
+			return validateInstanceType(cmd.api, i)
+		}},
+	)
+}
+
+func (cmd *UpdateInstance) PriorState(renv env.Running, params map[string]interface{}) (map[string]interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, err
+	}
+	state := make(map[string]interface{})
+	if cmd.Type != nil {
+		out, err := cmd.api.DescribeInstanceAttribute(&ec2.DescribeInstanceAttributeInput{InstanceId: cmd.Id, Attribute: String(ec2.InstanceAttributeNameInstanceType)})
+		if err != nil {
+			return nil, err
+		}
+		if out.InstanceType != nil {
+			state["type"] = StringValue(out.InstanceType.Value)
+		}
+	}
+	if cmd.Lock != nil {
+		out, err := cmd.api.DescribeInstanceAttribute(&ec2.DescribeInstanceAttributeInput{InstanceId: cmd.Id, Attribute: String(ec2.InstanceAttributeNameDisableApiTermination)})
+		if err != nil {
+			return nil, err
+		}
+		if out.DisableApiTermination != nil {
+			state["lock"] = BoolValue(out.DisableApiTermination.Value)
+		}
+	}
+	return state, nil
+}
+
+// ManualRun stops the instance when running to change its type, then starts it back
+// once stopped, whether the change succeeded or not
+func (cmd *UpdateInstance) ManualRun(renv env.Running) (output interface{}, err error) {
+	defer func() {
+		if !cmd.restart {
+			return
+		}
+		if startErr := cmd.startBack(renv, err == nil); startErr != nil {
+			if err == nil {
+				err = startErr
+			} else {
+				renv.Log().Errorf("instance %s left stopped: %s", StringValue(cmd.Id), startErr)
+			}
+		}
+	}()
+	if err = cmd.stopToChangeType(renv); err != nil {
+		return nil, err
+	}
+	input := &ec2.ModifyInstanceAttributeInput{}
+	if err = structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.ModifyInstanceAttributeInput: %s", err)
+	}
+	start := time.Now()
+	if output, err = cmd.api.ModifyInstanceAttribute(input); err != nil {
+		return nil, err
+	}
+	cmd.logger.ExtraVerbosef("ec2.ModifyInstanceAttribute call took %s", time.Since(start))
+	return output, nil
+}
+
+func (cmd *UpdateInstance) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("dry run: cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.ModifyInstanceAttributeInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("dry run: cannot inject in ec2.ModifyInstanceAttributeInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.ModifyInstanceAttribute(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.ModifyInstanceAttribute call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: update instance ok")
+			return fakeDryRunId("instance"), nil
+		}
+	}
+
+	return nil, err
+}
+
+// stopToChangeType stops the instance when running and waits for it to be stopped,
+// its type being only modifiable on a stopped instance
+func (cmd *UpdateInstance) stopToChangeType(renv env.Running) error {
+	if cmd.Type == nil {
+		return nil
+	}
+	state, err := instanceState(cmd.api, cmd.Id)
+	if err != nil {
+		return err
+	}
+	var stopped bool
+	switch state {
+	case ec2.InstanceStateNameStopped:
+		return nil
+	case ec2.InstanceStateNameRunning, ec2.InstanceStateNamePending:
+		if _, err = cmd.api.StopInstances(&ec2.StopInstancesInput{InstanceIds: []*string{cmd.Id}}); err != nil {
+			return fmt.Errorf("stop instance: %s", err)
+		}
+		stopped = true
+		renv.Log().Infof("instance %s stopped to change its type", StringValue(cmd.Id))
+	case ec2.InstanceStateNameStopping:
+	default:
+		return fmt.Errorf("cannot change the type of instance %s in state %s", StringValue(cmd.Id), state)
+	}
+	c := &checker{
+		renv:        renv,
+		description: fmt.Sprintf("instance %s", StringValue(cmd.Id)),
+		timeout:     300 * time.Second,
+		frequency:   5 * time.Second,
+		fetchFunc: func() (string, error) {
+			return instanceState(cmd.api, cmd.Id)
+		},
+		expect: ec2.InstanceStateNameStopped,
+		logger: cmd.logger,
+	}
+	if err = c.check(); err != nil {
+		if stopped {
+			renv.Log().Errorf("instance %s not started back: not confirmed stopped", StringValue(cmd.Id))
+		}
+		return err
+	}
+	// only a stopped instance can be started back
+	cmd.restart = stopped
+	return nil
+}
+
+// startBack starts the instance stopped to change its type, even when the template run was interrupted
+func (cmd *UpdateInstance) startBack(renv env.Running, changed bool) error {
+	input := &ec2.StartInstancesInput{InstanceIds: []*string{cmd.Id}}
+	if _, err := cmd.api.StartInstancesWithContext(awssdk.BackgroundContext(), input, withoutRequestsCancellation); err != nil {
+		return fmt.Errorf("start instance: %s", err)
+	}
+	if changed {
+		renv.Log().Infof("instance %s started with type %s", StringValue(cmd.Id), StringValue(cmd.Type))
+	} else {
+		renv.Log().Infof("instance %s started back with its previous type", StringValue(cmd.Id))
+	}
+	return nil
+}
+
+func (cmd *UpdateInstance) IAMActions(params map[string]interface{}) []string {
+	if _, ok := params["type"]; ok {
+		return []string{"ec2:DescribeInstanceAttribute", "ec2:DescribeInstances", "ec2:ModifyInstanceAttribute", "ec2:StartInstances", "ec2:StopInstances"}
+	}
+	return []string{"ec2:DescribeInstanceAttribute", "ec2:ModifyInstanceAttribute"}
 }
 
 type DeleteInstance struct {
@@ -173,6 +358,20 @@ func (cmd *RestartInstance) ParamsSpec() params.Spec {
 	return builder.Done()
 }
 
+type RebootInstance struct {
+	_      string `action:"reboot" entity:"instance" awsAPI:"ec2" awsCall:"RebootInstances" awsInput:"ec2.RebootInstancesInput" awsOutput:"ec2.RebootInstancesOutput" awsDryRun:""`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2iface.EC2API
+	Id     []*string `awsName:"InstanceIds" awsType:"awsstringslice" templateName:"ids"`
+}
+
+func (cmd *RebootInstance) ParamsSpec() params.Spec {
+	builder := params.SpecBuilder(params.OnlyOneOf(params.Key("ids"), params.Key("id")))
+	builder.AddReducer(idToIds, "id")
+	return builder.Done()
+}
+
 const (
 	notFoundState = "not-found"
 )
@@ -197,36 +396,13 @@ func (cmd *CheckInstance) ParamsSpec() params.Spec {
 }
 
 func (cmd *CheckInstance) ManualRun(renv env.Running) (interface{}, error) {
-	input := &ec2.DescribeInstancesInput{
-		InstanceIds: []*string{cmd.Id},
-	}
-
 	c := &checker{
+		renv:        renv,
 		description: fmt.Sprintf("instance %s", StringValue(cmd.Id)),
 		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
 		frequency:   5 * time.Second,
 		fetchFunc: func() (string, error) {
-			output, err := cmd.api.DescribeInstances(input)
-			if err != nil {
-				if awserr, ok := err.(awserr.Error); ok {
-					if awserr.Code() == "InstanceNotFound" {
-						return notFoundState, nil
-					}
-				} else {
-					return "", err
-				}
-			} else {
-				if res := output.Reservations; len(res) > 0 {
-					if instances := output.Reservations[0].Instances; len(instances) > 0 {
-						for _, inst := range instances {
-							if StringValue(inst.InstanceId) == StringValue(cmd.Id) {
-								return StringValue(inst.State.Name), nil
-							}
-						}
-					}
-				}
-			}
-			return notFoundState, nil
+			return instanceState(cmd.api, cmd.Id)
 		},
 		expect: StringValue(cmd.State),
 		logger: cmd.logger,
@@ -234,6 +410,30 @@ func (cmd *CheckInstance) ManualRun(renv env.Running) (interface{}, error) {
 	return nil, c.check()
 }
 
+func instanceState(api ec2iface.EC2API, id *string) (string, error) {
+	output, err := api.DescribeInstances(&ec2.DescribeInstancesInput{InstanceIds: []*string{id}})
+	if err != nil {
+		if awserr, ok := err.(awserr.Error); ok {
+			if awserr.Code() == "InstanceNotFound" {
+				return notFoundState, nil
+			}
+		} else {
+			return "", err
+		}
+	} else {
+		if res := output.Reservations; len(res) > 0 {
+			if instances := output.Reservations[0].Instances; len(instances) > 0 {
+				for _, inst := range instances {
+					if StringValue(inst.InstanceId) == StringValue(id) {
+						return StringValue(inst.State.Name), nil
+					}
+				}
+			}
+		}
+	}
+	return notFoundState, nil
+}
+
 type AttachInstance struct {
 	_           string `action:"attach" entity:"instance" awsAPI:"elbv2" awsCall:"RegisterTargets" awsInput:"elbv2.RegisterTargetsInput" awsOutput:"elbv2.RegisterTargetsOutput"`
 	logger      *logger.Logger
@@ -270,3 +470,33 @@ func idToIds(values map[string]interface{}) (map[string]interface{}, error) {
 		return nil, nil
 	}
 }
+
+// validateInstanceType checks an instance type against the catalog of the region of the given API client
+func validateInstanceType(api interface{}, i interface{}) error {
+	name, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("expected a string but got %T", i)
+	}
+	var region string
+	switch a := api.(type) {
+	case *ec2.EC2:
+		region = awssdk.StringValue(a.Config.Region)
+	case *autoscaling.AutoScaling:
+		region = awssdk.StringValue(a.Config.Region)
+	}
+	return awsconfig.LoadInstanceTypes(region).Validate(name)
+}
+
+func validateBlockDevices(i interface{}) error {
+	input := &ec2.RunInstancesInput{}
+	if err := setFieldWithType(i, input, "BlockDeviceMappings", awsblockdevices); err != nil {
+		return err
+	}
+	volumeTypes := []string{ec2.VolumeTypeStandard, ec2.VolumeTypeIo1, ec2.VolumeTypeGp2, ec2.VolumeTypeSc1, ec2.VolumeTypeSt1}
+	for _, mapping := range input.BlockDeviceMappings {
+		if t := StringValue(mapping.Ebs.VolumeType); t != "" && !contains(volumeTypes, t) {
+			return fmt.Errorf("invalid volume type '%s' for block device %s, expected one of %s", t, StringValue(mapping.DeviceName), strings.Join(volumeTypes, ", "))
+		}
+	}
+	return nil
+}
@@ -71,7 +71,7 @@ func (cmd *AttachInstanceprofile) ParamsSpec() params.Spec {
 	))
 }
 
-func (cmd *AttachInstanceprofile) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *AttachInstanceprofile) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
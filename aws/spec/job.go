@@ -0,0 +1,46 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/batch/batchiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/params"
+)
+
+type SubmitJob struct {
+	_          string `action:"submit" entity:"job" awsAPI:"batch" awsCall:"SubmitJob" awsInput:"batch.SubmitJobInput" awsOutput:"batch.SubmitJobOutput"`
+	logger     *logger.Logger
+	graph      cloud.GraphAPI
+	api        batchiface.BatchAPI
+	Name       *string   `awsName:"JobName" awsType:"awsstr" templateName:"name"`
+	Queue      *string   `awsName:"JobQueue" awsType:"awsstr" templateName:"queue"`
+	Definition *string   `awsName:"JobDefinition" awsType:"awsstr" templateName:"definition"`
+	Parameters []*string `awsName:"Parameters" awsType:"awsstringmap" templateName:"parameters"`
+	Command    []*string `awsName:"ContainerOverrides.Command" awsType:"awsstringslice" templateName:"command"`
+}
+
+func (cmd *SubmitJob) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Key("queue"), params.Key("definition"),
+		params.Opt("command", "parameters"),
+	))
+}
+
+func (cmd *SubmitJob) ExtractResult(i interface{}) string {
+	return StringValue(i.(*batch.SubmitJobOutput).JobId)
+}
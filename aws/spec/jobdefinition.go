@@ -0,0 +1,69 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/batch/batchiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+type RegisterJobdefinition struct {
+	_          string `action:"register" entity:"jobdefinition" awsAPI:"batch" awsCall:"RegisterJobDefinition" awsInput:"batch.RegisterJobDefinitionInput" awsOutput:"batch.RegisterJobDefinitionOutput"`
+	logger     *logger.Logger
+	graph      cloud.GraphAPI
+	api        batchiface.BatchAPI
+	Name       *string   `awsName:"JobDefinitionName" awsType:"awsstr" templateName:"name"`
+	Image      *string   `awsName:"ContainerProperties.Image" awsType:"awsstr" templateName:"image"`
+	Vcpus      *int64    `awsName:"ContainerProperties.Vcpus" awsType:"awsint64" templateName:"vcpus"`
+	Memory     *int64    `awsName:"ContainerProperties.Memory" awsType:"awsint64" templateName:"memory"`
+	Command    []*string `awsName:"ContainerProperties.Command" awsType:"awsstringslice" templateName:"command"`
+	Role       *string   `awsName:"ContainerProperties.JobRoleArn" awsType:"awsstr" templateName:"role"`
+	Parameters []*string `awsName:"Parameters" awsType:"awsstringmap" templateName:"parameters"`
+	Retries    *int64    `awsName:"RetryStrategy.Attempts" awsType:"awsint64" templateName:"retries"`
+	Type       *string   `awsName:"Type" awsType:"awsstr"`
+}
+
+func (cmd *RegisterJobdefinition) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Key("image"), params.Key("vcpus"), params.Key("memory"),
+		params.Opt(params.Suggested("command"), "parameters", "retries", "role"),
+	))
+}
+
+// BeforeRun registers the definition of a container job, the only type supported by AWS Batch
+func (cmd *RegisterJobdefinition) BeforeRun(renv env.Running) error {
+	cmd.Type = String(batch.JobDefinitionTypeContainer)
+	return nil
+}
+
+func (cmd *RegisterJobdefinition) ExtractResult(i interface{}) string {
+	return StringValue(i.(*batch.RegisterJobDefinitionOutput).JobDefinitionArn)
+}
+
+type DeleteJobdefinition struct {
+	_      string `action:"delete" entity:"jobdefinition" awsAPI:"batch" awsCall:"DeregisterJobDefinition" awsInput:"batch.DeregisterJobDefinitionInput" awsOutput:"batch.DeregisterJobDefinitionOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    batchiface.BatchAPI
+	Id     *string `awsName:"JobDefinition" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *DeleteJobdefinition) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
@@ -0,0 +1,103 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/batch/batchiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateJobqueue struct {
+	_                   string `action:"create" entity:"jobqueue" awsAPI:"batch" awsCall:"CreateJobQueue" awsInput:"batch.CreateJobQueueInput" awsOutput:"batch.CreateJobQueueOutput"`
+	logger              *logger.Logger
+	graph               cloud.GraphAPI
+	api                 batchiface.BatchAPI
+	Name                *string   `awsName:"JobQueueName" awsType:"awsstr" templateName:"name"`
+	Priority            *int64    `awsName:"Priority" awsType:"awsint64" templateName:"priority"`
+	Computeenvironments []*string `awsName:"ComputeEnvironmentOrder" awsType:"awscomputeorder" templateName:"computeenvironments"`
+}
+
+func (cmd *CreateJobqueue) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Key("computeenvironments"),
+		params.Opt(params.Suggested("priority")),
+	))
+}
+
+// BeforeRun gives the lowest priority to the queue when not set, queues with a higher priority being evaluated first
+func (cmd *CreateJobqueue) BeforeRun(renv env.Running) error {
+	if cmd.Priority == nil {
+		cmd.Priority = Int64(1)
+	}
+	return nil
+}
+
+func (cmd *CreateJobqueue) ExtractResult(i interface{}) string {
+	return StringValue(i.(*batch.CreateJobQueueOutput).JobQueueArn)
+}
+
+type DeleteJobqueue struct {
+	_      string `action:"delete" entity:"jobqueue" awsAPI:"batch" awsCall:"DeleteJobQueue" awsInput:"batch.DeleteJobQueueInput" awsOutput:"batch.DeleteJobQueueOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    batchiface.BatchAPI
+	Id     *string `awsName:"JobQueue" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *DeleteJobqueue) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
+
+// BeforeRun disables the job queue, AWS only deleting disabled queues
+func (cmd *DeleteJobqueue) BeforeRun(renv env.Running) error {
+	if _, err := cmd.api.UpdateJobQueue(&batch.UpdateJobQueueInput{JobQueue: cmd.Id, State: String(batch.JQStateDisabled)}); err != nil {
+		return err
+	}
+	return cmd.wait(renv, batch.JQStatusValid)
+}
+
+// AfterRun waits for the job queue to be deleted, its compute environments being deletable only once no queue uses them
+func (cmd *DeleteJobqueue) AfterRun(renv env.Running, output interface{}) error {
+	return cmd.wait(renv, notFoundState)
+}
+
+func (cmd *DeleteJobqueue) wait(renv env.Running, status string) error {
+	c := &checker{
+		renv:        renv,
+		description: fmt.Sprintf("jobqueue %s", StringValue(cmd.Id)),
+		timeout:     5 * time.Minute,
+		frequency:   5 * time.Second,
+		fetchFunc: func() (string, error) {
+			output, err := cmd.api.DescribeJobQueues(&batch.DescribeJobQueuesInput{JobQueues: []*string{cmd.Id}})
+			if err != nil {
+				return "", err
+			}
+			if len(output.JobQueues) == 0 || StringValue(output.JobQueues[0].Status) == batch.JQStatusDeleted {
+				return notFoundState, nil
+			}
+			return StringValue(output.JobQueues[0].Status), nil
+		},
+		expect: status,
+		logger: cmd.logger,
+	}
+	return c.check()
+}
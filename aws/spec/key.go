@@ -0,0 +1,82 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateKey struct {
+	_           string `action:"create" entity:"key" awsAPI:"kms" awsCall:"CreateKey" awsInput:"kms.CreateKeyInput" awsOutput:"kms.CreateKeyOutput"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         kmsiface.KMSAPI
+	Description *string `awsName:"Description" awsType:"awsstr" templateName:"description"`
+	Policy      *string `awsName:"Policy" awsType:"awsstr" templateName:"policy"`
+}
+
+func (cmd *CreateKey) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Opt(params.Suggested("description"), "policy")))
+}
+
+func (cmd *CreateKey) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*kms.CreateKeyOutput).KeyMetadata.KeyId)
+}
+
+// DeleteKey schedules the deletion of the key, AWS deleting keys only after a waiting period
+type DeleteKey struct {
+	_           string `action:"delete" entity:"key" awsAPI:"kms" awsCall:"ScheduleKeyDeletion" awsInput:"kms.ScheduleKeyDeletionInput" awsOutput:"kms.ScheduleKeyDeletionOutput"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         kmsiface.KMSAPI
+	Id          *string `awsName:"KeyId" awsType:"awsstr" templateName:"id"`
+	PendingDays *int64  `awsName:"PendingWindowInDays" awsType:"awsint64" templateName:"pending-days"`
+}
+
+func (cmd *DeleteKey) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"),
+		params.Opt("pending-days"),
+	))
+}
+
+type EnableKey struct {
+	_      string `action:"enable" entity:"key" awsAPI:"kms" awsCall:"EnableKey" awsInput:"kms.EnableKeyInput" awsOutput:"kms.EnableKeyOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    kmsiface.KMSAPI
+	Id     *string `awsName:"KeyId" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *EnableKey) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
+
+type DisableKey struct {
+	_      string `action:"disable" entity:"key" awsAPI:"kms" awsCall:"DisableKey" awsInput:"kms.DisableKeyInput" awsOutput:"kms.DisableKeyOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    kmsiface.KMSAPI
+	Id     *string `awsName:"KeyId" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *DisableKey) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
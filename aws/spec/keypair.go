@@ -16,10 +16,12 @@ limitations under the License.
 package awsspec
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/wallix/awless/cloud"
@@ -30,6 +32,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/wallix/awless/console"
 	"github.com/wallix/awless/logger"
+	"golang.org/x/crypto/ssh"
 )
 
 const keyDirEnv = "__AWLESS_KEYS_DIR"
@@ -41,14 +44,19 @@ type CreateKeypair struct {
 	api               ec2iface.EC2API
 	Name              *string `awsName:"KeyName" awsType:"awsstr" templateName:"name"`
 	Encrypted         *bool   `templateName:"encrypted"`
+	Type              *string `templateName:"type"`
+	Import            *string `templateName:"import"`
 	PublicKeyMaterial []byte  `awsName:"PublicKeyMaterial" awsType:"awsbyteslice"`
 }
 
 func (cmd *CreateKeypair) ParamsSpec() params.Spec {
 	return params.NewSpec(
-		params.AllOf(params.Key("name"), params.Opt("encrypted")),
+		params.AllOf(params.Key("name"), params.Opt("encrypted", "import", "type")),
 		params.Validators{
 			"name": func(i interface{}, others map[string]interface{}) error {
+				if _, ok := others["import"]; ok {
+					return nil
+				}
 				keyDir := os.Getenv(keyDirEnv)
 				if keyDir == "" {
 					return fmt.Errorf("empty env var '%s'", keyDirEnv)
@@ -60,10 +68,20 @@ func (cmd *CreateKeypair) ParamsSpec() params.Spec {
 				}
 				return nil
 			},
+			"type": params.IsInEnumIgnoreCase("rsa", "ed25519"),
 		})
 }
 
+// BeforeRun imports the given public key, or generates locally a key pair whose private key is saved
+// in the awless keys directory and whose public key is imported
 func (cmd *CreateKeypair) BeforeRun(renv env.Running) error {
+	if cmd.Import != nil {
+		if cmd.Type != nil || BoolValue(cmd.Encrypted) {
+			return errors.New("import: 'type' and 'encrypted' only apply to generated keys")
+		}
+		return cmd.importPublicKey()
+	}
+
 	var encryptedMsg string
 	var encrypted bool
 
@@ -77,10 +95,20 @@ func (cmd *CreateKeypair) BeforeRun(renv env.Running) error {
 		return fmt.Errorf("saving private key: file already exists at path: %s", privKeyPath)
 	}
 
-	cmd.logger.Infof("Generating locally%s 4096 RSA at %s", encryptedMsg, privKeyPath)
-	start := time.Now()
-	pub, priv, err := console.GenerateSSHKeyPair(4096, encrypted)
-	cmd.logger.ExtraVerbosef("4096 bits key generation took %s", time.Since(start))
+	var pub, priv []byte
+	var err error
+	if strings.EqualFold(StringValue(cmd.Type), "ed25519") {
+		if encrypted {
+			return errors.New("ed25519 keys cannot be encrypted, use type=rsa")
+		}
+		cmd.logger.Infof("Generating locally ed25519 key at %s", privKeyPath)
+		pub, priv, err = console.GenerateED25519SSHKeyPair()
+	} else {
+		cmd.logger.Infof("Generating locally%s 4096 RSA at %s", encryptedMsg, privKeyPath)
+		start := time.Now()
+		pub, priv, err = console.GenerateSSHKeyPair(4096, encrypted)
+		cmd.logger.ExtraVerbosef("4096 bits key generation took %s", time.Since(start))
+	}
 	if err != nil {
 		return fmt.Errorf("generating key: %s", err)
 	}
@@ -91,6 +119,24 @@ func (cmd *CreateKeypair) BeforeRun(renv env.Running) error {
 	return nil
 }
 
+func (cmd *CreateKeypair) importPublicKey() error {
+	path := StringValue(cmd.Import)
+	if strings.HasPrefix(path, "~/") {
+		path = filepath.Join(os.Getenv("HOME"), path[2:])
+	}
+	pub, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("import: %s", err)
+	}
+	key, _, _, _, err := ssh.ParseAuthorizedKey(pub)
+	if err != nil {
+		return fmt.Errorf("import %s: not a SSH public key: %s", path, err)
+	}
+	cmd.logger.Infof("Importing %s public key %s (%s)", key.Type(), path, ssh.FingerprintSHA256(key))
+	cmd.PublicKeyMaterial = pub
+	return nil
+}
+
 func (cmd *CreateKeypair) ExtractResult(i interface{}) string {
 	return StringValue(i.(*ec2.ImportKeyPairOutput).KeyName)
 }
@@ -0,0 +1,199 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/wallix/awless/aws/eks"
+	"github.com/wallix/awless/aws/eks/eksiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateKubernetescluster struct {
+	_              string `action:"create" entity:"kubernetescluster" awsAPI:"eks" awsCall:"CreateCluster" awsInput:"eks.CreateClusterInput" awsOutput:"eks.CreateClusterOutput"`
+	logger         *logger.Logger
+	graph          cloud.GraphAPI
+	api            eksiface.EKSAPI
+	Name           *string   `awsName:"Name" awsType:"awsstr" templateName:"name"`
+	Role           *string   `awsName:"RoleArn" awsType:"awsstr" templateName:"role"`
+	Subnets        []*string `awsName:"ResourcesVpcConfig.SubnetIds" awsType:"awsstringslice" templateName:"subnets"`
+	SecurityGroups []*string `awsName:"ResourcesVpcConfig.SecurityGroupIds" awsType:"awsstringslice" templateName:"securitygroups"`
+	Version        *string   `awsName:"Version" awsType:"awsstr" templateName:"version"`
+}
+
+func (cmd *CreateKubernetescluster) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Key("role"), params.Key("subnets"),
+		params.Opt(params.Suggested("securitygroups"), "version"),
+	))
+}
+
+func (cmd *CreateKubernetescluster) BeforeRun(renv env.Running) error {
+	arn, err := roleArn(cmd.graph, cmd.Role)
+	if err != nil {
+		return err
+	}
+	cmd.Role = arn
+	return nil
+}
+
+func (cmd *CreateKubernetescluster) ExtractResult(i interface{}) string {
+	return StringValue(i.(*eks.CreateClusterOutput).Cluster.Name)
+}
+
+type DeleteKubernetescluster struct {
+	_      string `action:"delete" entity:"kubernetescluster" awsAPI:"eks" awsCall:"DeleteCluster" awsInput:"eks.DeleteClusterInput" awsOutput:"eks.DeleteClusterOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    eksiface.EKSAPI
+	Name   *string `awsName:"Name" awsType:"awsstr" templateName:"name"`
+}
+
+func (cmd *DeleteKubernetescluster) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name")))
+}
+
+type WaitKubernetescluster struct {
+	_       string `action:"wait" entity:"kubernetescluster" awsAPI:"eks"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     eksiface.EKSAPI
+	Name    *string `templateName:"name"`
+	State   *string `templateName:"state"`
+	Timeout *string `templateName:"timeout"`
+}
+
+func (cmd *WaitKubernetescluster) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("name"), params.Opt(params.Suggested("state"), "timeout")),
+		params.Validators{
+			"state":   params.IsInEnumIgnoreCase("active", "failed"),
+			"timeout": isCheckTimeout,
+		})
+}
+
+// ManualRun waits for the cluster to reach the given state (by default active), its control plane
+// taking 10 to 15 minutes to be created, and returns what kubectl needs to connect to it
+func (cmd *WaitKubernetescluster) ManualRun(renv env.Running) (interface{}, error) {
+	timeout := 30 * time.Minute
+	if cmd.Timeout != nil {
+		var err error
+		if timeout, err = parseCheckTimeout(StringValue(cmd.Timeout)); err != nil {
+			return nil, err
+		}
+	}
+	state := eks.ClusterStatusActive
+	if cmd.State != nil {
+		state = StringValue(cmd.State)
+	}
+	checkCluster := CommandFactory.Build("checkkubernetescluster")().(*CheckKubernetescluster)
+	entries := map[string]interface{}{
+		"name":    cmd.Name,
+		"state":   state,
+		"timeout": int64(timeout / time.Second),
+	}
+	if err := params.Validate(checkCluster.ParamsSpec().Validators(), entries); err != nil {
+		return nil, err
+	}
+	if _, err := checkCluster.Run(renv, entries); err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	output, err := cmd.api.DescribeCluster(&eks.DescribeClusterInput{Name: cmd.Name})
+	cmd.logger.ExtraVerbosef("eks.DescribeCluster call took %s", time.Since(start))
+	if err != nil {
+		return nil, err
+	}
+	return output.Cluster, nil
+}
+
+// kubernetesclusterOutputs are the settings of a cluster in a kubeconfig file
+type kubernetesclusterOutputs struct {
+	Name                     string `json:"name"`
+	Endpoint                 string `json:"endpoint,omitempty"`
+	CertificateAuthorityData string `json:"certificate-authority-data,omitempty"`
+}
+
+// ExtractResult returns as JSON the endpoint and certificate authority of the cluster,
+// known once it is active
+func (cmd *WaitKubernetescluster) ExtractResult(i interface{}) string {
+	cluster := i.(*eks.Cluster)
+	outputs := kubernetesclusterOutputs{
+		Name:     StringValue(cluster.Name),
+		Endpoint: StringValue(cluster.Endpoint),
+	}
+	if cluster.CertificateAuthority != nil {
+		outputs.CertificateAuthorityData = StringValue(cluster.CertificateAuthority.Data)
+	}
+	b, err := json.Marshal(outputs)
+	if err != nil {
+		return outputs.Name
+	}
+	return string(b)
+}
+
+type CheckKubernetescluster struct {
+	_       string `action:"check" entity:"kubernetescluster" awsAPI:"eks"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     eksiface.EKSAPI
+	Name    *string `templateName:"name"`
+	State   *string `templateName:"state"`
+	Timeout *int64  `templateName:"timeout"`
+}
+
+func (cmd *CheckKubernetescluster) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("name"), params.Key("state"), params.Key("timeout")),
+		params.Validators{
+			"state": params.IsInEnumIgnoreCase("active", "creating", "deleting", "failed", notFoundState),
+		})
+}
+
+func (cmd *CheckKubernetescluster) ManualRun(renv env.Running) (interface{}, error) {
+	input := &eks.DescribeClusterInput{
+		Name: cmd.Name,
+	}
+
+	c := &checker{
+		renv:        renv,
+		description: fmt.Sprintf("kubernetescluster %s", StringValue(cmd.Name)),
+		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
+		frequency:   10 * time.Second,
+		fetchFunc: func() (string, error) {
+			output, err := cmd.api.DescribeCluster(input)
+			if awserr, ok := err.(awserr.Error); ok && awserr.Code() == eks.ErrCodeResourceNotFoundException {
+				return notFoundState, nil
+			}
+			if err != nil {
+				return "", err
+			}
+			if output.Cluster == nil {
+				return notFoundState, nil
+			}
+			return StringValue(output.Cluster.Status), nil
+		},
+		expect: StringValue(cmd.State),
+		logger: cmd.logger,
+	}
+	return nil, c.check()
+}
@@ -27,16 +27,16 @@ type CreateLaunchconfiguration struct {
 	logger         *logger.Logger
 	graph          cloud.GraphAPI
 	api            autoscalingiface.AutoScalingAPI
-	Image          *string   `awsName:"ImageId" awsType:"awsstr" templateName:"image"`
-	Type           *string   `awsName:"InstanceType" awsType:"awsstr" templateName:"type"`
-	Name           *string   `awsName:"LaunchConfigurationName" awsType:"awsstr" templateName:"name"`
-	Public         *bool     `awsName:"AssociatePublicIpAddress" awsType:"awsbool" templateName:"public"`
-	Keypair        *string   `awsName:"KeyName" awsType:"awsstr" templateName:"keypair"`
-	Userdata       *string   `awsName:"UserData" awsType:"awsuserdatatobase64" templateName:"userdata"`
-	Securitygroups []*string `awsName:"SecurityGroups" awsType:"awsstringslice" templateName:"securitygroups"`
-	Role           *string   `awsName:"IamInstanceProfile" awsType:"awsstr" templateName:"role"`
-	Spotprice      *string   `awsName:"SpotPrice" awsType:"awsstr" templateName:"spotprice"`
-	DistroQuery    *string   `awsType:"awsstr" templateName:"distro"`
+	Image          *string     `awsName:"ImageId" awsType:"awsstr" templateName:"image"`
+	Type           *string     `awsName:"InstanceType" awsType:"awsstr" templateName:"type"`
+	Name           *string     `awsName:"LaunchConfigurationName" awsType:"awsstr" templateName:"name"`
+	Public         *bool       `awsName:"AssociatePublicIpAddress" awsType:"awsbool" templateName:"public"`
+	Keypair        *string     `awsName:"KeyName" awsType:"awsstr" templateName:"keypair"`
+	Userdata       interface{} `awsName:"UserData" awsType:"awsuserdatatobase64" templateName:"userdata"`
+	Securitygroups []*string   `awsName:"SecurityGroups" awsType:"awsstringslice" templateName:"securitygroups"`
+	Role           *string     `awsName:"IamInstanceProfile" awsType:"awsstr" templateName:"role"`
+	Spotprice      *string     `awsName:"SpotPrice" awsType:"awsstr" templateName:"spotprice"`
+	DistroQuery    *string     `awsType:"awsstr" templateName:"distro"`
 }
 
 func (cmd *CreateLaunchconfiguration) ParamsSpec() params.Spec {
@@ -44,7 +44,9 @@ func (cmd *CreateLaunchconfiguration) ParamsSpec() params.Spec {
 		params.OnlyOneOf(params.Key("distro"), params.Key("image")),
 		params.Key("name"), params.Key("type"),
 		params.Opt("keypair", "public", "role", "securitygroups", "spotprice", "userdata"),
-	))
+	), params.Validators{"type": func(i interface{}, others map[string]interface{}) error {
+		return validateInstanceType(cmd.api, i)
+	}})
 	builder.AddReducer(func(values map[string]interface{}) (map[string]interface{}, error) {
 		fn := CommandFactory.Build("createinstance")().(*CreateInstance).convertDistroToAMI
 		return fn(values)
@@ -90,6 +90,7 @@ func (cmd *CheckLoadbalancer) ManualRun(renv env.Running) (interface{}, error) {
 	}
 
 	c := &checker{
+		renv:        renv,
 		description: fmt.Sprintf("loadbalancer %s", StringValue(cmd.Id)),
 		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
 		frequency:   5 * time.Second,
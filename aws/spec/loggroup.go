@@ -0,0 +1,123 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateLoggroup struct {
+	_         string `action:"create" entity:"loggroup" awsAPI:"cloudwatchlogs" awsCall:"CreateLogGroup" awsInput:"cloudwatchlogs.CreateLogGroupInput" awsOutput:"cloudwatchlogs.CreateLogGroupOutput"`
+	logger    *logger.Logger
+	graph     cloud.GraphAPI
+	api       cloudwatchlogsiface.CloudWatchLogsAPI
+	Name      *string `awsName:"LogGroupName" awsType:"awsstr" templateName:"name"`
+	Key       *string `awsName:"KmsKeyId" awsType:"awsstr" templateName:"key"`
+	Retention *int64  `templateName:"retention"`
+}
+
+func (cmd *CreateLoggroup) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"),
+		params.Opt(params.Suggested("retention"), "key"),
+	))
+}
+
+// AfterRun sets the number of days the log events are kept, log groups being created with no expiration
+func (cmd *CreateLoggroup) AfterRun(renv env.Running, output interface{}) error {
+	if cmd.Retention == nil {
+		return nil
+	}
+	_, err := cmd.api.PutRetentionPolicy(&cloudwatchlogs.PutRetentionPolicyInput{
+		LogGroupName:    cmd.Name,
+		RetentionInDays: cmd.Retention,
+	})
+	return err
+}
+
+func (cmd *CreateLoggroup) ExtractResult(i interface{}) string {
+	return StringValue(cmd.Name)
+}
+
+type UpdateLoggroup struct {
+	_         string `action:"update" entity:"loggroup" awsAPI:"cloudwatchlogs"`
+	logger    *logger.Logger
+	graph     cloud.GraphAPI
+	api       cloudwatchlogsiface.CloudWatchLogsAPI
+	Name      *string `templateName:"name"`
+	Retention *int64  `templateName:"retention"`
+}
+
+func (cmd *UpdateLoggroup) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Key("retention")))
+}
+
+// PriorState returns the retention of the log group before the update, 0 meaning the events never expire
+func (cmd *UpdateLoggroup) PriorState(renv env.Running, params map[string]interface{}) (map[string]interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, err
+	}
+	group, err := cmd.describe()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"retention": Int64AsIntValue(group.RetentionInDays)}, nil
+}
+
+// ManualRun removes the retention policy when the retention is 0, so that log events never expire
+func (cmd *UpdateLoggroup) ManualRun(renv env.Running) (interface{}, error) {
+	start := time.Now()
+	if Int64AsIntValue(cmd.Retention) == 0 {
+		output, err := cmd.api.DeleteRetentionPolicy(&cloudwatchlogs.DeleteRetentionPolicyInput{LogGroupName: cmd.Name})
+		cmd.logger.ExtraVerbosef("cloudwatchlogs.DeleteRetentionPolicy call took %s", time.Since(start))
+		return output, err
+	}
+	output, err := cmd.api.PutRetentionPolicy(&cloudwatchlogs.PutRetentionPolicyInput{LogGroupName: cmd.Name, RetentionInDays: cmd.Retention})
+	cmd.logger.ExtraVerbosef("cloudwatchlogs.PutRetentionPolicy call took %s", time.Since(start))
+	return output, err
+}
+
+func (cmd *UpdateLoggroup) describe() (*cloudwatchlogs.LogGroup, error) {
+	out, err := cmd.api.DescribeLogGroups(&cloudwatchlogs.DescribeLogGroupsInput{LogGroupNamePrefix: cmd.Name})
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range out.LogGroups {
+		if StringValue(group.LogGroupName) == StringValue(cmd.Name) {
+			return group, nil
+		}
+	}
+	return nil, fmt.Errorf("log group '%s' not found", StringValue(cmd.Name))
+}
+
+type DeleteLoggroup struct {
+	_      string `action:"delete" entity:"loggroup" awsAPI:"cloudwatchlogs" awsCall:"DeleteLogGroup" awsInput:"cloudwatchlogs.DeleteLogGroupInput" awsOutput:"cloudwatchlogs.DeleteLogGroupOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    cloudwatchlogsiface.CloudWatchLogsAPI
+	Name   *string `awsName:"LogGroupName" awsType:"awsstr" templateName:"name"`
+}
+
+func (cmd *DeleteLoggroup) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name")))
+}
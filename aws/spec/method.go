@@ -0,0 +1,119 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+// CreateMethod adds an HTTP method to a resource of a REST API, proxying its requests to a Lambda function.
+// The function must allow its invocation by API Gateway (principal apigateway.amazonaws.com)
+type CreateMethod struct {
+	_             string `action:"create" entity:"method" awsAPI:"apigateway" awsCall:"PutMethod" awsInput:"apigateway.PutMethodInput" awsOutput:"apigateway.Method"`
+	logger        *logger.Logger
+	graph         cloud.GraphAPI
+	api           apigatewayiface.APIGatewayAPI
+	Restapi       *string `awsName:"RestApiId" awsType:"awsstr" templateName:"restapi"`
+	Resource      *string `awsName:"ResourceId" awsType:"awsstr" templateName:"resource"`
+	HttpMethod    *string `awsName:"HttpMethod" awsType:"awsstr" templateName:"http-method"`
+	Authorization *string `awsName:"AuthorizationType" awsType:"awsstr" templateName:"authorization"`
+	Function      *string `templateName:"function"`
+}
+
+func (cmd *CreateMethod) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("function"), params.Key("http-method"), params.Key("resource"), params.Key("restapi"),
+		params.Opt("authorization"),
+	),
+		params.Validators{
+			"http-method":   params.IsInEnumIgnoreCase("GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS", "ANY"),
+			"authorization": params.IsInEnumIgnoreCase("NONE", "AWS_IAM", "CUSTOM", "COGNITO_USER_POOLS"),
+			"function": func(i interface{}, others map[string]interface{}) error {
+				if _, err := lambdaIntegrationURI(fmt.Sprint(i)); err != nil {
+					return err
+				}
+				return nil
+			},
+		})
+}
+
+// BeforeRun opens the method to all callers unless an authorization type is given
+func (cmd *CreateMethod) BeforeRun(renv env.Running) error {
+	cmd.HttpMethod = String(strings.ToUpper(StringValue(cmd.HttpMethod)))
+	if cmd.Authorization == nil {
+		cmd.Authorization = String("NONE")
+	}
+	cmd.Authorization = String(strings.ToUpper(StringValue(cmd.Authorization)))
+	return nil
+}
+
+// AfterRun integrates the method with the Lambda function as a proxy,
+// the function receiving the whole request and returning the whole response
+func (cmd *CreateMethod) AfterRun(renv env.Running, output interface{}) error {
+	uri, err := lambdaIntegrationURI(StringValue(cmd.Function))
+	if err != nil {
+		return err
+	}
+	_, err = cmd.api.PutIntegration(&apigateway.PutIntegrationInput{
+		RestApiId:             cmd.Restapi,
+		ResourceId:            cmd.Resource,
+		HttpMethod:            cmd.HttpMethod,
+		Type:                  String(apigateway.IntegrationTypeAwsProxy),
+		IntegrationHttpMethod: String("POST"),
+		Uri:                   String(uri),
+	})
+	return err
+}
+
+func (cmd *CreateMethod) ExtractResult(i interface{}) string {
+	return StringValue(cmd.HttpMethod)
+}
+
+type DeleteMethod struct {
+	_          string `action:"delete" entity:"method" awsAPI:"apigateway" awsCall:"DeleteMethod" awsInput:"apigateway.DeleteMethodInput" awsOutput:"apigateway.DeleteMethodOutput"`
+	logger     *logger.Logger
+	graph      cloud.GraphAPI
+	api        apigatewayiface.APIGatewayAPI
+	Restapi    *string `awsName:"RestApiId" awsType:"awsstr" templateName:"restapi"`
+	Resource   *string `awsName:"ResourceId" awsType:"awsstr" templateName:"resource"`
+	HttpMethod *string `awsName:"HttpMethod" awsType:"awsstr" templateName:"http-method"`
+}
+
+func (cmd *DeleteMethod) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("http-method"), params.Key("resource"), params.Key("restapi")))
+}
+
+func (cmd *DeleteMethod) BeforeRun(renv env.Running) error {
+	cmd.HttpMethod = String(strings.ToUpper(StringValue(cmd.HttpMethod)))
+	return nil
+}
+
+// lambdaIntegrationURI returns the URI API Gateway invokes a Lambda function with,
+// in the region of the function (ex: arn:aws:lambda:eu-west-1:0123456789:function:hello)
+func lambdaIntegrationURI(functionArn string) (string, error) {
+	splits := strings.Split(functionArn, ":")
+	if len(splits) < 7 || splits[0] != "arn" || splits[2] != "lambda" || splits[5] != "function" {
+		return "", fmt.Errorf("invalid function '%s': expecting the ARN of a Lambda function", functionArn)
+	}
+	return fmt.Sprintf("arn:%s:apigateway:%s:lambda:path/2015-03-31/functions/%s/invocations", splits[1], splits[3], functionArn), nil
+}
@@ -40,7 +40,28 @@ type CreateNatgateway struct {
 }
 
 func (cmd *CreateNatgateway) ParamsSpec() params.Spec {
-	return params.NewSpec(params.AllOf(params.Key("elasticip-id"), params.Key("subnet")))
+	return params.NewSpec(params.AllOf(params.Key("subnet"), params.Opt(params.Suggested("elasticip-id"))))
+}
+
+// BeforeRun allocates a new elastic IP for the NAT gateway when none is given
+func (cmd *CreateNatgateway) BeforeRun(renv env.Running) error {
+	if cmd.ElasticipId != nil {
+		return nil
+	}
+	out, err := cmd.api.AllocateAddress(&ec2.AllocateAddressInput{Domain: String(ec2.DomainTypeVpc)})
+	if err != nil {
+		return fmt.Errorf("allocate elastic IP: %s", err)
+	}
+	cmd.ElasticipId = out.AllocationId
+	renv.Log().Infof("elastic IP %s (%s) allocated for the natgateway", StringValue(out.AllocationId), StringValue(out.PublicIp))
+	return nil
+}
+
+func (cmd *CreateNatgateway) IAMActions(params map[string]interface{}) []string {
+	if _, ok := params["elasticip-id"]; ok {
+		return []string{"ec2:CreateNatGateway"}
+	}
+	return []string{"ec2:AllocateAddress", "ec2:CreateNatGateway"}
 }
 
 func (cmd *CreateNatgateway) ExtractResult(i interface{}) string {
@@ -48,15 +69,76 @@ func (cmd *CreateNatgateway) ExtractResult(i interface{}) string {
 }
 
 type DeleteNatgateway struct {
-	_      string `action:"delete" entity:"natgateway" awsAPI:"ec2" awsCall:"DeleteNatGateway" awsInput:"ec2.DeleteNatGatewayInput" awsOutput:"ec2.DeleteNatGatewayOutput"`
-	logger *logger.Logger
-	graph  cloud.GraphAPI
-	api    ec2iface.EC2API
-	Id     *string `awsName:"NatGatewayId" awsType:"awsstr" templateName:"id"`
+	_                string `action:"delete" entity:"natgateway" awsAPI:"ec2" awsCall:"DeleteNatGateway" awsInput:"ec2.DeleteNatGatewayInput" awsOutput:"ec2.DeleteNatGatewayOutput"`
+	logger           *logger.Logger
+	graph            cloud.GraphAPI
+	api              ec2iface.EC2API
+	Id               *string `awsName:"NatGatewayId" awsType:"awsstr" templateName:"id"`
+	ReleaseElasticip *bool   `templateName:"release-elasticip"`
+	allocations      []*string
 }
 
 func (cmd *DeleteNatgateway) ParamsSpec() params.Spec {
-	return params.NewSpec(params.AllOf(params.Key("id")))
+	return params.NewSpec(params.AllOf(params.Key("id"), params.Opt("release-elasticip")))
+}
+
+// BeforeRun retrieves the elastic IPs of the NAT gateway to release them once it is deleted
+func (cmd *DeleteNatgateway) BeforeRun(renv env.Running) error {
+	if !BoolValue(cmd.ReleaseElasticip) {
+		return nil
+	}
+	out, err := cmd.api.DescribeNatGateways(&ec2.DescribeNatGatewaysInput{NatGatewayIds: []*string{cmd.Id}})
+	if err != nil {
+		return err
+	}
+	for _, nat := range out.NatGateways {
+		for _, addr := range nat.NatGatewayAddresses {
+			if addr.AllocationId != nil {
+				cmd.allocations = append(cmd.allocations, addr.AllocationId)
+			}
+		}
+	}
+	return nil
+}
+
+// AfterRun releases the elastic IPs of the NAT gateway when asked to:
+// they can only be released once the NAT gateway is deleted
+func (cmd *DeleteNatgateway) AfterRun(renv env.Running, output interface{}) error {
+	if len(cmd.allocations) == 0 {
+		return nil
+	}
+	c := &checker{
+		renv:        renv,
+		description: fmt.Sprintf("natgateway %s", StringValue(cmd.Id)),
+		timeout:     180 * time.Second,
+		frequency:   5 * time.Second,
+		fetchFunc: func() (string, error) {
+			state, err := natgatewayState(cmd.api, cmd.Id)
+			if state == notFoundState {
+				return ec2.NatGatewayStateDeleted, err
+			}
+			return state, err
+		},
+		expect: ec2.NatGatewayStateDeleted,
+		logger: cmd.logger,
+	}
+	if err := c.check(); err != nil {
+		return err
+	}
+	for _, allocation := range cmd.allocations {
+		if _, err := cmd.api.ReleaseAddress(&ec2.ReleaseAddressInput{AllocationId: allocation}); err != nil {
+			return fmt.Errorf("release elastic IP %s: %s", StringValue(allocation), err)
+		}
+		renv.Log().Verbosef("elastic IP %s of natgateway %s released", StringValue(allocation), StringValue(cmd.Id))
+	}
+	return nil
+}
+
+func (cmd *DeleteNatgateway) IAMActions(params map[string]interface{}) []string {
+	if release, _ := castBool(params["release-elasticip"]); release {
+		return []string{"ec2:DeleteNatGateway", "ec2:DescribeNatGateways", "ec2:ReleaseAddress"}
+	}
+	return []string{"ec2:DeleteNatGateway"}
 }
 
 type CheckNatgateway struct {
@@ -78,35 +160,36 @@ func (cmd *CheckNatgateway) ParamsSpec() params.Spec {
 }
 
 func (cmd *CheckNatgateway) ManualRun(renv env.Running) (interface{}, error) {
-	input := &ec2.DescribeNatGatewaysInput{
-		NatGatewayIds: []*string{cmd.Id},
-	}
-
 	c := &checker{
+		renv:        renv,
 		description: fmt.Sprintf("natgateway %s", StringValue(cmd.Id)),
 		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
 		frequency:   5 * time.Second,
 		fetchFunc: func() (string, error) {
-			output, err := cmd.api.DescribeNatGateways(input)
-			if err != nil {
-				if awserr, ok := err.(awserr.Error); ok {
-					if awserr.Code() == "NatGatewayNotFound" {
-						return notFoundState, nil
-					}
-				} else {
-					return "", err
-				}
-			} else {
-				for _, nat := range output.NatGateways {
-					if StringValue(nat.NatGatewayId) == StringValue(cmd.Id) {
-						return StringValue(nat.State), nil
-					}
-				}
-			}
-			return notFoundState, nil
+			return natgatewayState(cmd.api, cmd.Id)
 		},
 		expect: StringValue(cmd.State),
 		logger: cmd.logger,
 	}
 	return nil, c.check()
 }
+
+func natgatewayState(api ec2iface.EC2API, id *string) (string, error) {
+	output, err := api.DescribeNatGateways(&ec2.DescribeNatGatewaysInput{NatGatewayIds: []*string{id}})
+	if err != nil {
+		if awserr, ok := err.(awserr.Error); ok {
+			if awserr.Code() == "NatGatewayNotFound" {
+				return notFoundState, nil
+			}
+		} else {
+			return "", err
+		}
+	} else {
+		for _, nat := range output.NatGateways {
+			if StringValue(nat.NatGatewayId) == StringValue(id) {
+				return StringValue(nat.State), nil
+			}
+		}
+	}
+	return notFoundState, nil
+}
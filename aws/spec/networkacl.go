@@ -0,0 +1,508 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/wallix/awless/logger"
+)
+
+type CreateNetworkacl struct {
+	_      string `action:"create" entity:"networkacl" awsAPI:"ec2" awsCall:"CreateNetworkAcl" awsInput:"ec2.CreateNetworkAclInput" awsOutput:"ec2.CreateNetworkAclOutput" awsDryRun:""`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2iface.EC2API
+	Vpc    *string `awsName:"VpcId" awsType:"awsstr" templateName:"vpc"`
+	Name   *string `templateName:"name"`
+}
+
+func (cmd *CreateNetworkacl) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("vpc"), params.Opt(params.Suggested("name"))))
+}
+
+func (cmd *CreateNetworkacl) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*ec2.CreateNetworkAclOutput).NetworkAcl.NetworkAclId)
+}
+
+func (cmd *CreateNetworkacl) AfterRun(renv env.Running, output interface{}) error {
+	if cmd.Name == nil {
+		return nil
+	}
+	return createNameTag(awssdk.String(cmd.ExtractResult(output)), cmd.Name, renv)
+}
+
+type DeleteNetworkacl struct {
+	_      string `action:"delete" entity:"networkacl" awsAPI:"ec2" awsCall:"DeleteNetworkAcl" awsInput:"ec2.DeleteNetworkAclInput" awsOutput:"ec2.DeleteNetworkAclOutput" awsDryRun:""`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2iface.EC2API
+	Id     *string `awsName:"NetworkAclId" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *DeleteNetworkacl) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
+
+type AttachNetworkacl struct {
+	_      string `action:"attach" entity:"networkacl" awsAPI:"ec2" awsDryRun:"manual"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2iface.EC2API
+	Id     *string `templateName:"id"`
+	Subnet *string `templateName:"subnet"`
+}
+
+func (cmd *AttachNetworkacl) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"), params.Key("subnet")))
+}
+
+func (cmd *AttachNetworkacl) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+	return nil, dryRunNetworkACL(cmd.api, cmd.logger, "attach networkacl", cmd.Id)
+}
+
+// ManualRun replaces the association of the subnet with its current network ACL,
+// a subnet being always associated with exactly one network ACL
+func (cmd *AttachNetworkacl) ManualRun(renv env.Running) (interface{}, error) {
+	association, _, err := subnetNetworkACL(cmd.api, cmd.Subnet)
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	output, err := cmd.api.ReplaceNetworkAclAssociation(&ec2.ReplaceNetworkAclAssociationInput{AssociationId: association, NetworkAclId: cmd.Id})
+	cmd.logger.ExtraVerbosef("ec2.ReplaceNetworkAclAssociation call took %s", time.Since(start))
+	return output, err
+}
+
+func (cmd *AttachNetworkacl) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*ec2.ReplaceNetworkAclAssociationOutput).NewAssociationId)
+}
+
+func (cmd *AttachNetworkacl) IAMActions(params map[string]interface{}) []string {
+	return []string{"ec2:DescribeNetworkAcls", "ec2:ReplaceNetworkAclAssociation"}
+}
+
+// PriorState returns the network ACL the subnet is associated with before the attach,
+// so that the subnet can be associated back with it
+func (cmd *AttachNetworkacl) PriorState(renv env.Running, params map[string]interface{}) (map[string]interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, err
+	}
+	_, acl, err := subnetNetworkACL(cmd.api, cmd.Subnet)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"id": StringValue(acl.NetworkAclId)}, nil
+}
+
+type DetachNetworkacl struct {
+	_      string `action:"detach" entity:"networkacl" awsAPI:"ec2" awsDryRun:"manual"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2iface.EC2API
+	Subnet *string `templateName:"subnet"`
+}
+
+func (cmd *DetachNetworkacl) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("subnet")))
+}
+
+func (cmd *DetachNetworkacl) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+	return nil, dryRunNetworkACL(cmd.api, cmd.logger, "detach networkacl", nil)
+}
+
+// ManualRun associates the subnet back with the default network ACL of its VPC,
+// a subnet being never left without network ACL
+func (cmd *DetachNetworkacl) ManualRun(renv env.Running) (interface{}, error) {
+	association, current, err := subnetNetworkACL(cmd.api, cmd.Subnet)
+	if err != nil {
+		return nil, err
+	}
+	if BoolValue(current.IsDefault) {
+		return nil, fmt.Errorf("subnet %s is already associated with the default network acl %s", StringValue(cmd.Subnet), StringValue(current.NetworkAclId))
+	}
+	out, err := cmd.api.DescribeNetworkAcls(&ec2.DescribeNetworkAclsInput{Filters: []*ec2.Filter{
+		{Name: String("vpc-id"), Values: []*string{current.VpcId}},
+		{Name: String("default"), Values: []*string{String("true")}},
+	}})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.NetworkAcls) == 0 {
+		return nil, fmt.Errorf("no default network acl found in vpc %s", StringValue(current.VpcId))
+	}
+	start := time.Now()
+	output, err := cmd.api.ReplaceNetworkAclAssociation(&ec2.ReplaceNetworkAclAssociationInput{AssociationId: association, NetworkAclId: out.NetworkAcls[0].NetworkAclId})
+	cmd.logger.ExtraVerbosef("ec2.ReplaceNetworkAclAssociation call took %s", time.Since(start))
+	return output, err
+}
+
+func (cmd *DetachNetworkacl) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*ec2.ReplaceNetworkAclAssociationOutput).NewAssociationId)
+}
+
+func (cmd *DetachNetworkacl) IAMActions(params map[string]interface{}) []string {
+	return []string{"ec2:DescribeNetworkAcls", "ec2:ReplaceNetworkAclAssociation"}
+}
+
+// PriorState returns the network ACL the subnet is associated with before the detach,
+// so that the subnet can be associated back with it
+func (cmd *DetachNetworkacl) PriorState(renv env.Running, params map[string]interface{}) (map[string]interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, err
+	}
+	_, acl, err := subnetNetworkACL(cmd.api, cmd.Subnet)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"id": StringValue(acl.NetworkAclId)}, nil
+}
+
+type CreateNetworkaclrule struct {
+	_          string `action:"create" entity:"networkaclrule" awsAPI:"ec2" awsDryRun:"manual"`
+	logger     *logger.Logger
+	graph      cloud.GraphAPI
+	api        ec2iface.EC2API
+	Networkacl *string `templateName:"networkacl"`
+	Number     *int64  `templateName:"number"`
+	Action     *string `templateName:"action"`
+	Protocol   *string `templateName:"protocol"`
+	CIDR       *string `templateName:"cidr"`
+	Portrange  *string `templateName:"portrange"`
+	Outbound   *bool   `templateName:"outbound"`
+}
+
+func (cmd *CreateNetworkaclrule) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("action"), params.Key("cidr"), params.Key("networkacl"), params.Key("number"), params.Key("protocol"), params.Opt("outbound", "portrange")),
+		networkACLRuleValidators,
+	)
+}
+
+func (cmd *CreateNetworkaclrule) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+	input, err := cmd.input()
+	if err != nil {
+		return nil, err
+	}
+	input.DryRun = Bool(true)
+	_, err = cmd.api.CreateNetworkAclEntry(input)
+	return nil, dryRunError(cmd.logger, "create networkaclrule", err)
+}
+
+func (cmd *CreateNetworkaclrule) ManualRun(renv env.Running) (interface{}, error) {
+	input, err := cmd.input()
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateNetworkAclEntry(input)
+	cmd.logger.ExtraVerbosef("ec2.CreateNetworkAclEntry call took %s", time.Since(start))
+	return output, err
+}
+
+func (cmd *CreateNetworkaclrule) IAMActions(params map[string]interface{}) []string {
+	return []string{"ec2:CreateNetworkAclEntry"}
+}
+
+func (cmd *CreateNetworkaclrule) input() (*ec2.CreateNetworkAclEntryInput, error) {
+	input := &ec2.CreateNetworkAclEntryInput{
+		NetworkAclId: cmd.Networkacl,
+		RuleNumber:   cmd.Number,
+		RuleAction:   String(strings.ToLower(StringValue(cmd.Action))),
+		Egress:       Bool(BoolValue(cmd.Outbound)),
+	}
+	if isIPv6CIDR(StringValue(cmd.CIDR)) {
+		input.Ipv6CidrBlock = cmd.CIDR
+	} else {
+		input.CidrBlock = cmd.CIDR
+	}
+	var err error
+	input.Protocol, input.PortRange, input.IcmpTypeCode, err = networkACLRuleProtocol(StringValue(cmd.Protocol), cmd.Portrange)
+	return input, err
+}
+
+type UpdateNetworkaclrule struct {
+	_          string `action:"update" entity:"networkaclrule" awsAPI:"ec2" awsDryRun:"manual"`
+	logger     *logger.Logger
+	graph      cloud.GraphAPI
+	api        ec2iface.EC2API
+	Networkacl *string `templateName:"networkacl"`
+	Number     *int64  `templateName:"number"`
+	Action     *string `templateName:"action"`
+	Protocol   *string `templateName:"protocol"`
+	CIDR       *string `templateName:"cidr"`
+	Portrange  *string `templateName:"portrange"`
+	Outbound   *bool   `templateName:"outbound"`
+}
+
+func (cmd *UpdateNetworkaclrule) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("action"), params.Key("cidr"), params.Key("networkacl"), params.Key("number"), params.Key("protocol"), params.Opt("outbound", "portrange")),
+		networkACLRuleValidators,
+	)
+}
+
+func (cmd *UpdateNetworkaclrule) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+	input, err := cmd.input()
+	if err != nil {
+		return nil, err
+	}
+	input.DryRun = Bool(true)
+	_, err = cmd.api.ReplaceNetworkAclEntry(input)
+	return nil, dryRunError(cmd.logger, "update networkaclrule", err)
+}
+
+func (cmd *UpdateNetworkaclrule) ManualRun(renv env.Running) (interface{}, error) {
+	input, err := cmd.input()
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	output, err := cmd.api.ReplaceNetworkAclEntry(input)
+	cmd.logger.ExtraVerbosef("ec2.ReplaceNetworkAclEntry call took %s", time.Since(start))
+	return output, err
+}
+
+func (cmd *UpdateNetworkaclrule) IAMActions(params map[string]interface{}) []string {
+	return []string{"ec2:ReplaceNetworkAclEntry"}
+}
+
+// PriorState returns the action, protocol, CIDR and port range of the rule before the update,
+// so that the rule can be replaced back
+func (cmd *UpdateNetworkaclrule) PriorState(renv env.Running, params map[string]interface{}) (map[string]interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, err
+	}
+	out, err := cmd.api.DescribeNetworkAcls(&ec2.DescribeNetworkAclsInput{NetworkAclIds: []*string{cmd.Networkacl}})
+	if err != nil {
+		return nil, err
+	}
+	for _, acl := range out.NetworkAcls {
+		for _, entry := range acl.Entries {
+			if Int64AsIntValue(entry.RuleNumber) != Int64AsIntValue(cmd.Number) || BoolValue(entry.Egress) != BoolValue(cmd.Outbound) {
+				continue
+			}
+			prior := map[string]interface{}{
+				"action":   StringValue(entry.RuleAction),
+				"protocol": networkACLRuleProtocolName(StringValue(entry.Protocol)),
+				"cidr":     StringValue(entry.CidrBlock),
+			}
+			if entry.Ipv6CidrBlock != nil {
+				prior["cidr"] = StringValue(entry.Ipv6CidrBlock)
+			}
+			if r := entry.PortRange; r != nil && isTCPorUDP(prior["protocol"].(string)) {
+				if Int64AsIntValue(r.From) == Int64AsIntValue(r.To) {
+					prior["portrange"] = strconv.FormatInt(awssdk.Int64Value(r.From), 10)
+				} else {
+					prior["portrange"] = fmt.Sprintf("%d-%d", awssdk.Int64Value(r.From), awssdk.Int64Value(r.To))
+				}
+			}
+			return prior, nil
+		}
+	}
+	return nil, fmt.Errorf("no rule %d found in networkacl %s", awssdk.Int64Value(cmd.Number), StringValue(cmd.Networkacl))
+}
+
+func (cmd *UpdateNetworkaclrule) input() (*ec2.ReplaceNetworkAclEntryInput, error) {
+	input := &ec2.ReplaceNetworkAclEntryInput{
+		NetworkAclId: cmd.Networkacl,
+		RuleNumber:   cmd.Number,
+		RuleAction:   String(strings.ToLower(StringValue(cmd.Action))),
+		Egress:       Bool(BoolValue(cmd.Outbound)),
+	}
+	if isIPv6CIDR(StringValue(cmd.CIDR)) {
+		input.Ipv6CidrBlock = cmd.CIDR
+	} else {
+		input.CidrBlock = cmd.CIDR
+	}
+	var err error
+	input.Protocol, input.PortRange, input.IcmpTypeCode, err = networkACLRuleProtocol(StringValue(cmd.Protocol), cmd.Portrange)
+	return input, err
+}
+
+type DeleteNetworkaclrule struct {
+	_          string `action:"delete" entity:"networkaclrule" awsAPI:"ec2" awsDryRun:"manual"`
+	logger     *logger.Logger
+	graph      cloud.GraphAPI
+	api        ec2iface.EC2API
+	Networkacl *string `templateName:"networkacl"`
+	Number     *int64  `templateName:"number"`
+	Outbound   *bool   `templateName:"outbound"`
+}
+
+func (cmd *DeleteNetworkaclrule) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("networkacl"), params.Key("number"), params.Opt("outbound")),
+		params.Validators{"number": validateNetworkACLRuleNumber},
+	)
+}
+
+func (cmd *DeleteNetworkaclrule) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+	input := cmd.input()
+	input.DryRun = Bool(true)
+	_, err := cmd.api.DeleteNetworkAclEntry(input)
+	return nil, dryRunError(cmd.logger, "delete networkaclrule", err)
+}
+
+func (cmd *DeleteNetworkaclrule) ManualRun(renv env.Running) (interface{}, error) {
+	start := time.Now()
+	output, err := cmd.api.DeleteNetworkAclEntry(cmd.input())
+	cmd.logger.ExtraVerbosef("ec2.DeleteNetworkAclEntry call took %s", time.Since(start))
+	return output, err
+}
+
+func (cmd *DeleteNetworkaclrule) IAMActions(params map[string]interface{}) []string {
+	return []string{"ec2:DeleteNetworkAclEntry"}
+}
+
+func (cmd *DeleteNetworkaclrule) input() *ec2.DeleteNetworkAclEntryInput {
+	return &ec2.DeleteNetworkAclEntryInput{NetworkAclId: cmd.Networkacl, RuleNumber: cmd.Number, Egress: Bool(BoolValue(cmd.Outbound))}
+}
+
+var networkACLRuleValidators = params.Validators{
+	"number": validateNetworkACLRuleNumber,
+	"action": params.IsInEnumIgnoreCase("allow", "deny"),
+	"protocol": func(i interface{}, others map[string]interface{}) error {
+		if err := params.IsInEnumIgnoreCase("any", "tcp", "udp", "icmp", "icmpv6")(i, others); err != nil {
+			return err
+		}
+		_, hasPortrange := others["portrange"]
+		if isTCPorUDP(strings.ToLower(fmt.Sprint(i))) && !hasPortrange {
+			return errors.New("'portrange' required when protocol is TCP/UDP")
+		}
+		return nil
+	},
+	"cidr": params.IsCIDR,
+}
+
+// Rule numbers above 32766 are reserved by AWS to the catch-all rules of the network ACLs
+func validateNetworkACLRuleNumber(i interface{}, others map[string]interface{}) error {
+	number, err := castInt64(i)
+	if err != nil {
+		return err
+	}
+	if number < 1 || number > 32766 {
+		return fmt.Errorf("rule number %d out of range [1-32766]", number)
+	}
+	return nil
+}
+
+// networkACLRuleProtocol returns the protocol number and the ports of a network ACL entry,
+// the ICMP rules applying to all the ICMP types and codes
+func networkACLRuleProtocol(protocol string, portrange *string) (*string, *ec2.PortRange, *ec2.IcmpTypeCode, error) {
+	switch strings.ToLower(protocol) {
+	case "any":
+		return String("-1"), nil, nil, nil
+	case "icmp":
+		return String("1"), nil, &ec2.IcmpTypeCode{Type: Int64(-1), Code: Int64(-1)}, nil
+	case "icmpv6":
+		return String("58"), nil, &ec2.IcmpTypeCode{Type: Int64(-1), Code: Int64(-1)}, nil
+	case "tcp", "udp":
+		perm := &ec2.IpPermission{}
+		if err := setIpPermissionPorts(perm, strings.ToLower(protocol), portrange); err != nil {
+			return nil, nil, nil, err
+		}
+		number := "6"
+		if strings.EqualFold(protocol, "udp") {
+			number = "17"
+		}
+		return String(number), &ec2.PortRange{From: perm.FromPort, To: perm.ToPort}, nil, nil
+	default:
+		return nil, nil, nil, fmt.Errorf("invalid protocol '%s', expecting any, tcp, udp, icmp or icmpv6", protocol)
+	}
+}
+
+func networkACLRuleProtocolName(number string) string {
+	switch number {
+	case "-1":
+		return "any"
+	case "6":
+		return "tcp"
+	case "17":
+		return "udp"
+	case "1":
+		return "icmp"
+	case "58":
+		return "icmpv6"
+	default:
+		return number
+	}
+}
+
+// subnetNetworkACL returns the network ACL the subnet is associated with and the id of this association
+func subnetNetworkACL(api ec2iface.EC2API, subnet *string) (*string, *ec2.NetworkAcl, error) {
+	out, err := api.DescribeNetworkAcls(&ec2.DescribeNetworkAclsInput{Filters: []*ec2.Filter{
+		{Name: String("association.subnet-id"), Values: []*string{subnet}},
+	}})
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, acl := range out.NetworkAcls {
+		for _, assoc := range acl.Associations {
+			if StringValue(assoc.SubnetId) == StringValue(subnet) {
+				return assoc.NetworkAclAssociationId, acl, nil
+			}
+		}
+	}
+	return nil, nil, fmt.Errorf("no network acl associated with subnet %s", StringValue(subnet))
+}
+
+func dryRunNetworkACL(api ec2iface.EC2API, l *logger.Logger, description string, id *string) error {
+	input := &ec2.DescribeNetworkAclsInput{DryRun: Bool(true)}
+	if id != nil {
+		input.NetworkAclIds = []*string{id}
+	}
+	_, err := api.DescribeNetworkAcls(input)
+	return dryRunError(l, description, err)
+}
+
+func dryRunError(l *logger.Logger, description string, err error) error {
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			l.Verbosef("dry run: %s ok", description)
+			return nil
+		}
+	}
+	return fmt.Errorf("dry run: %s: %s", description, err)
+}
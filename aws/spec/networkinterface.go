@@ -41,11 +41,13 @@ type CreateNetworkinterface struct {
 	Description    *string   `awsName:"Description" awsType:"awsstr" templateName:"description"`
 	Securitygroups []*string `awsName:"Groups" awsType:"awsstringslice" templateName:"securitygroups"`
 	Privateip      *string   `awsName:"PrivateIpAddress" awsType:"awsstr" templateName:"privateip"`
+	SecondaryCount *int64    `awsName:"SecondaryPrivateIpAddressCount" awsType:"awsint64" templateName:"secondary-count"`
+	SecondaryIps   []*string `templateName:"secondary-privateips"`
 }
 
 func (cmd *CreateNetworkinterface) ParamsSpec() params.Spec {
 	return params.NewSpec(
-		params.AllOf(params.Key("subnet"), params.Opt("description", "privateip", "securitygroups")),
+		params.AllOf(params.Key("subnet"), params.Opt("description", "privateip", "secondary-count", "secondary-privateips", "securitygroups")),
 		params.Validators{"privateip": params.IsIP},
 	)
 }
@@ -54,6 +56,116 @@ func (cmd *CreateNetworkinterface) ExtractResult(i interface{}) string {
 	return awssdk.StringValue(i.(*ec2.CreateNetworkInterfaceOutput).NetworkInterface.NetworkInterfaceId)
 }
 
+// AfterRun assigns the given secondary private IPs to the network interface once created
+func (cmd *CreateNetworkinterface) AfterRun(renv env.Running, output interface{}) error {
+	if len(cmd.SecondaryIps) == 0 {
+		return nil
+	}
+	id := cmd.ExtractResult(output)
+	if _, err := cmd.api.AssignPrivateIpAddresses(&ec2.AssignPrivateIpAddressesInput{NetworkInterfaceId: String(id), PrivateIpAddresses: cmd.SecondaryIps}); err != nil {
+		return fmt.Errorf("assign secondary private ips: %s", err)
+	}
+	renv.Log().Verbosef("secondary private ips %s assigned to networkinterface %s", strings.Join(awssdk.StringValueSlice(cmd.SecondaryIps), ", "), id)
+	return nil
+}
+
+func (cmd *CreateNetworkinterface) IAMActions(params map[string]interface{}) []string {
+	if _, ok := params["secondary-privateips"]; ok {
+		return []string{"ec2:AssignPrivateIpAddresses", "ec2:CreateNetworkInterface"}
+	}
+	return []string{"ec2:CreateNetworkInterface"}
+}
+
+type UpdateNetworkinterface struct {
+	_               string `action:"update" entity:"networkinterface" awsAPI:"ec2" awsDryRun:"manual"`
+	logger          *logger.Logger
+	graph           cloud.GraphAPI
+	api             ec2iface.EC2API
+	Id              *string   `templateName:"id"`
+	Securitygroups  []*string `templateName:"securitygroups"`
+	Description     *string   `templateName:"description"`
+	SourceDestCheck *bool     `templateName:"source-dest-check"`
+}
+
+func (cmd *UpdateNetworkinterface) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"),
+		params.AtLeastOneOf(params.Key("description"), params.Key("securitygroups"), params.Key("source-dest-check")),
+	))
+}
+
+func (cmd *UpdateNetworkinterface) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+	for _, input := range cmd.inputs() {
+		input.DryRun = Bool(true)
+		_, err := cmd.api.ModifyNetworkInterfaceAttribute(input)
+		if err = dryRunError(cmd.logger, "update networkinterface", err); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// ManualRun modifies the attributes of the network interface, the API accepting only one attribute per call
+func (cmd *UpdateNetworkinterface) ManualRun(renv env.Running) (interface{}, error) {
+	for _, input := range cmd.inputs() {
+		start := time.Now()
+		if _, err := cmd.api.ModifyNetworkInterfaceAttribute(input); err != nil {
+			return nil, err
+		}
+		cmd.logger.ExtraVerbosef("ec2.ModifyNetworkInterfaceAttribute call took %s", time.Since(start))
+	}
+	return nil, nil
+}
+
+func (cmd *UpdateNetworkinterface) IAMActions(params map[string]interface{}) []string {
+	return []string{"ec2:DescribeNetworkInterfaces", "ec2:ModifyNetworkInterfaceAttribute"}
+}
+
+// PriorState returns the attributes of the network interface being updated, so that the update can be reverted
+func (cmd *UpdateNetworkinterface) PriorState(renv env.Running, params map[string]interface{}) (map[string]interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, err
+	}
+	out, err := cmd.api.DescribeNetworkInterfaces(&ec2.DescribeNetworkInterfacesInput{NetworkInterfaceIds: []*string{cmd.Id}})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.NetworkInterfaces) == 0 {
+		return nil, fmt.Errorf("networkinterface %s not found", StringValue(cmd.Id))
+	}
+	eni := out.NetworkInterfaces[0]
+	prior := make(map[string]interface{})
+	if cmd.Securitygroups != nil {
+		var groups []interface{}
+		for _, g := range eni.Groups {
+			groups = append(groups, StringValue(g.GroupId))
+		}
+		prior["securitygroups"] = groups
+	}
+	if cmd.Description != nil {
+		prior["description"] = StringValue(eni.Description)
+	}
+	if cmd.SourceDestCheck != nil {
+		prior["source-dest-check"] = BoolValue(eni.SourceDestCheck)
+	}
+	return prior, nil
+}
+
+func (cmd *UpdateNetworkinterface) inputs() (inputs []*ec2.ModifyNetworkInterfaceAttributeInput) {
+	if cmd.Securitygroups != nil {
+		inputs = append(inputs, &ec2.ModifyNetworkInterfaceAttributeInput{NetworkInterfaceId: cmd.Id, Groups: cmd.Securitygroups})
+	}
+	if cmd.Description != nil {
+		inputs = append(inputs, &ec2.ModifyNetworkInterfaceAttributeInput{NetworkInterfaceId: cmd.Id, Description: &ec2.AttributeValue{Value: cmd.Description}})
+	}
+	if cmd.SourceDestCheck != nil {
+		inputs = append(inputs, &ec2.ModifyNetworkInterfaceAttributeInput{NetworkInterfaceId: cmd.Id, SourceDestCheck: &ec2.AttributeBooleanValue{Value: cmd.SourceDestCheck}})
+	}
+	return
+}
+
 type DeleteNetworkinterface struct {
 	_      string `action:"delete" entity:"networkinterface" awsAPI:"ec2" awsCall:"DeleteNetworkInterface" awsInput:"ec2.DeleteNetworkInterfaceInput" awsOutput:"ec2.DeleteNetworkInterfaceOutput" awsDryRun:""`
 	logger *logger.Logger
@@ -103,7 +215,7 @@ func (cmd *DetachNetworkinterface) ParamsSpec() params.Spec {
 	))
 }
 
-func (cmd *DetachNetworkinterface) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachNetworkinterface) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -175,6 +287,33 @@ func (cmd *DetachNetworkinterface) ManualRun(renv env.Running) (interface{}, err
 	return output, err
 }
 
+// PriorState returns the instance and device index the network interface is attached to
+// before the detach, so that it can be attached back
+func (cmd *DetachNetworkinterface) PriorState(renv env.Running, params map[string]interface{}) (map[string]interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, err
+	}
+	input := &ec2.DescribeNetworkInterfacesInput{}
+	if cmd.Attachment != nil {
+		input.Filters = []*ec2.Filter{{Name: String("attachment.attachment-id"), Values: []*string{cmd.Attachment}}}
+	} else {
+		input.NetworkInterfaceIds = []*string{cmd.Id}
+	}
+	out, err := cmd.api.DescribeNetworkInterfaces(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(out.NetworkInterfaces) == 0 || out.NetworkInterfaces[0].Attachment == nil {
+		return nil, nil
+	}
+	eni := out.NetworkInterfaces[0]
+	return map[string]interface{}{
+		"id":           StringValue(eni.NetworkInterfaceId),
+		"instance":     StringValue(eni.Attachment.InstanceId),
+		"device-index": Int64AsIntValue(eni.Attachment.DeviceIndex),
+	}, nil
+}
+
 type CheckNetworkinterface struct {
 	_       string `action:"check" entity:"networkinterface" awsAPI:"ec2"`
 	logger  *logger.Logger
@@ -199,6 +338,7 @@ func (cmd *CheckNetworkinterface) ManualRun(renv env.Running) (interface{}, erro
 	}
 
 	c := &checker{
+		renv:        renv,
 		description: fmt.Sprintf("network interface %s", StringValue(cmd.Id)),
 		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
 		frequency:   5 * time.Second,
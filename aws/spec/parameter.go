@@ -0,0 +1,155 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/driver"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateParameter struct {
+	_           string `action:"create" entity:"parameter" awsAPI:"ssm" awsCall:"PutParameter" awsInput:"ssm.PutParameterInput" awsOutput:"ssm.PutParameterOutput"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         ssmiface.SSMAPI
+	Name        *string `awsName:"Name" awsType:"awsstr" templateName:"name"`
+	Value       *string `awsName:"Value" awsType:"awsstr" templateName:"value"`
+	Description *string `awsName:"Description" awsType:"awsstr" templateName:"description"`
+	Secure      *bool   `templateName:"secure"`
+	Key         *string `awsName:"KeyId" awsType:"awsstr" templateName:"key"`
+	Type        *string `awsName:"Type" awsType:"awsstr"`
+}
+
+func (cmd *CreateParameter) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Key("value"),
+		params.Opt(params.Suggested("secure"), "description", "key"),
+	))
+}
+
+// BeforeRun stores the value as a SecureString, encrypted with KMS, when secure
+func (cmd *CreateParameter) BeforeRun(renv env.Running) error {
+	cmd.Type = String(parameterType(cmd.Secure))
+	return nil
+}
+
+func (cmd *CreateParameter) ExtractResult(i interface{}) string {
+	return StringValue(cmd.Name)
+}
+
+type UpdateParameter struct {
+	_           string `action:"update" entity:"parameter" awsAPI:"ssm" awsCall:"PutParameter" awsInput:"ssm.PutParameterInput" awsOutput:"ssm.PutParameterOutput"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         ssmiface.SSMAPI
+	Name        *string `awsName:"Name" awsType:"awsstr" templateName:"name"`
+	Value       *string `awsName:"Value" awsType:"awsstr" templateName:"value"`
+	Description *string `awsName:"Description" awsType:"awsstr" templateName:"description"`
+	Secure      *bool   `templateName:"secure"`
+	Key         *string `awsName:"KeyId" awsType:"awsstr" templateName:"key"`
+	Type        *string `awsName:"Type" awsType:"awsstr"`
+	Overwrite   *bool   `awsName:"Overwrite" awsType:"awsbool"`
+}
+
+func (cmd *UpdateParameter) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Key("value"),
+		params.Opt("secure", "description", "key"),
+	))
+}
+
+// BeforeRun keeps the type of the parameter when secure is not given, AWS expecting it on each update
+func (cmd *UpdateParameter) BeforeRun(renv env.Running) error {
+	cmd.Overwrite = Bool(true)
+	if cmd.Secure != nil {
+		cmd.Type = String(parameterType(cmd.Secure))
+		return nil
+	}
+	out, err := cmd.api.GetParameter(&ssm.GetParameterInput{Name: cmd.Name})
+	if err != nil {
+		return err
+	}
+	cmd.Type = out.Parameter.Type
+	return nil
+}
+
+type DeleteParameter struct {
+	_      string `action:"delete" entity:"parameter" awsAPI:"ssm" awsCall:"DeleteParameter" awsInput:"ssm.DeleteParameterInput" awsOutput:"ssm.DeleteParameterOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ssmiface.SSMAPI
+	Name   *string `awsName:"Name" awsType:"awsstr" templateName:"name"`
+}
+
+func (cmd *DeleteParameter) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name")))
+}
+
+func parameterType(secure *bool) string {
+	if BoolValue(secure) {
+		return ssm.ParameterTypeSecureString
+	}
+	return ssm.ParameterTypeString
+}
+
+// ResolveSSMParameters is the driver middleware replacing the references to SSM parameters,
+// read with ssm(name) in templates, by their values (decrypted for SecureString) right before
+// the statements run. The values are only given to the drivers: the templates displayed
+// and stored keep the references
+func ResolveSSMParameters(api ssmiface.SSMAPI) driver.Middleware {
+	return func(next driver.Driver) driver.Driver {
+		return driver.Func(func(renv env.Running, values map[string]interface{}) (interface{}, error) {
+			resolved := make(map[string]interface{}, len(values))
+			for k, v := range values {
+				value, err := resolveSSMParameter(api, renv, v)
+				if err != nil {
+					return nil, err
+				}
+				resolved[k] = value
+			}
+			return next.Run(renv, resolved)
+		})
+	}
+}
+
+func resolveSSMParameter(api ssmiface.SSMAPI, renv env.Running, v interface{}) (interface{}, error) {
+	switch vv := v.(type) {
+	case params.SSMParameter:
+		out, err := api.GetParameter(&ssm.GetParameterInput{Name: String(string(vv)), WithDecryption: Bool(true)})
+		if err != nil {
+			return nil, fmt.Errorf("%s: cannot read parameter: %s", vv, decorateAWSError(err))
+		}
+		renv.Log().ExtraVerbosef("resolved parameter %s", string(vv))
+		return StringValue(out.Parameter.Value), nil
+	case []interface{}:
+		var list []interface{}
+		for _, e := range vv {
+			value, err := resolveSSMParameter(api, renv, e)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, value)
+		}
+		return list, nil
+	default:
+		return v, nil
+	}
+}
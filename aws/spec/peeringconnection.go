@@ -0,0 +1,82 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/wallix/awless/logger"
+)
+
+type CreatePeeringconnection struct {
+	_          string `action:"create" entity:"peeringconnection" awsAPI:"ec2" awsCall:"CreateVpcPeeringConnection" awsInput:"ec2.CreateVpcPeeringConnectionInput" awsOutput:"ec2.CreateVpcPeeringConnectionOutput" awsDryRun:""`
+	logger     *logger.Logger
+	graph      cloud.GraphAPI
+	api        ec2iface.EC2API
+	Vpc        *string `awsName:"VpcId" awsType:"awsstr" templateName:"vpc"`
+	PeerVpc    *string `awsName:"PeerVpcId" awsType:"awsstr" templateName:"peer-vpc"`
+	PeerOwner  *string `awsName:"PeerOwnerId" awsType:"awsstr" templateName:"peer-owner"`
+	PeerRegion *string `awsName:"PeerRegion" awsType:"awsstr" templateName:"peer-region"`
+	Name       *string `templateName:"name"`
+}
+
+func (cmd *CreatePeeringconnection) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("peer-vpc"), params.Key("vpc"), params.Opt(params.Suggested("name"), "peer-owner", "peer-region")))
+}
+
+func (cmd *CreatePeeringconnection) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*ec2.CreateVpcPeeringConnectionOutput).VpcPeeringConnection.VpcPeeringConnectionId)
+}
+
+func (cmd *CreatePeeringconnection) AfterRun(renv env.Running, output interface{}) error {
+	if cmd.Name == nil {
+		return nil
+	}
+	return createNameTag(awssdk.String(cmd.ExtractResult(output)), cmd.Name, renv)
+}
+
+type AcceptPeeringconnection struct {
+	_      string `action:"accept" entity:"peeringconnection" awsAPI:"ec2" awsCall:"AcceptVpcPeeringConnection" awsInput:"ec2.AcceptVpcPeeringConnectionInput" awsOutput:"ec2.AcceptVpcPeeringConnectionOutput" awsDryRun:""`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2iface.EC2API
+	Id     *string `awsName:"VpcPeeringConnectionId" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *AcceptPeeringconnection) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
+
+func (cmd *AcceptPeeringconnection) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*ec2.AcceptVpcPeeringConnectionOutput).VpcPeeringConnection.VpcPeeringConnectionId)
+}
+
+type DeletePeeringconnection struct {
+	_      string `action:"delete" entity:"peeringconnection" awsAPI:"ec2" awsCall:"DeleteVpcPeeringConnection" awsInput:"ec2.DeleteVpcPeeringConnectionInput" awsOutput:"ec2.DeleteVpcPeeringConnectionOutput" awsDryRun:""`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2iface.EC2API
+	Id     *string `awsName:"VpcPeeringConnectionId" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *DeletePeeringconnection) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
@@ -0,0 +1,73 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// iamServicePrefixes are the prefixes of the IAM actions of the APIs whose name differs from the one of their service
+var iamServicePrefixes = map[string]string{
+	"applicationautoscaling": "application-autoscaling",
+	"cloudwatchevents":       "events",
+	"cloudwatchlogs":         "logs",
+	"elb":                    "elasticloadbalancing",
+	"elbv2":                  "elasticloadbalancing",
+	"sfn":                    "states",
+}
+
+// IAM actions of the API Gateway management are HTTP verbs on the resources, not its calls
+var unknownIAMActionsAPIs = map[string]struct{}{
+	"apigateway": {},
+}
+
+// iamActioner is implemented by the commands whose IAM actions are not the AWS call of their definition
+// (ex: commands running several calls or calling the API depending on their params)
+type iamActioner interface {
+	IAMActions(params map[string]interface{}) []string
+}
+
+// CommandIAMActions returns the IAM actions (ex: ec2:CreateVpc) a command needs to run with the given params.
+// The bool is false when they are unknown, the command running manual calls
+func CommandIAMActions(cmd interface{}, params map[string]interface{}) ([]string, bool) {
+	if actioner, ok := cmd.(iamActioner); ok {
+		return actioner.IAMActions(params), true
+	}
+	val := reflect.ValueOf(cmd)
+	if val.Kind() != reflect.Ptr || val.Elem().Kind() != reflect.Struct {
+		return nil, false
+	}
+	var api, call string
+	typ := val.Elem().Type()
+	for i := 0; i < typ.NumField(); i++ {
+		if field := typ.Field(i); field.Name == "_" {
+			api, call = field.Tag.Get("awsAPI"), field.Tag.Get("awsCall")
+		}
+	}
+	if _, unknown := unknownIAMActionsAPIs[api]; unknown || api == "" || call == "" {
+		return nil, false
+	}
+	return []string{iamAction(api, call)}, true
+}
+
+func iamAction(api, call string) string {
+	prefix, ok := iamServicePrefixes[api]
+	if !ok {
+		prefix = api
+	}
+	return fmt.Sprintf("%s:%s", prefix, call)
+}
@@ -0,0 +1,34 @@
+package awsspec
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCommandIAMActions(t *testing.T) {
+	tcases := []struct {
+		cmd    interface{}
+		params map[string]interface{}
+		exp    []string
+		known  bool
+	}{
+		{cmd: &CreateVpc{}, exp: []string{"ec2:CreateVpc"}, known: true},
+		{cmd: &CreateListener{}, exp: []string{"elasticloadbalancing:CreateListener"}, known: true},
+		{cmd: &AttachPolicy{}, params: map[string]interface{}{"role": "my-role", "arn": "arn:my:policy"}, exp: []string{"iam:AttachRolePolicy"}, known: true},
+		{cmd: &UpdatePolicy{}, params: map[string]interface{}{"default-version": "v1"}, exp: []string{"iam:ListPolicyVersions", "iam:SetDefaultPolicyVersion"}, known: true},
+		{cmd: &UpdateInstance{}, params: map[string]interface{}{"id": "i-1234", "lock": true}, exp: []string{"ec2:DescribeInstanceAttribute", "ec2:ModifyInstanceAttribute"}, known: true},
+		{cmd: &UpdateInstance{}, params: map[string]interface{}{"id": "i-1234", "type": "t2.micro"}, exp: []string{"ec2:DescribeInstanceAttribute", "ec2:DescribeInstances", "ec2:ModifyInstanceAttribute", "ec2:StartInstances", "ec2:StopInstances"}, known: true},
+		{cmd: &CreateDeployment{}},
+		{cmd: &CreateTag{}},
+		{cmd: "create vpc"},
+	}
+	for i, tcase := range tcases {
+		actions, known := CommandIAMActions(tcase.cmd, tcase.params)
+		if got, want := known, tcase.known; got != want {
+			t.Fatalf("%d: got %t, want %t", i+1, got, want)
+		}
+		if got, want := actions, tcase.exp; !reflect.DeepEqual(got, want) {
+			t.Fatalf("%d: got %v, want %v", i+1, got, want)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"strings"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/wallix/awless/logger"
+)
+
+type CreatePlacementgroup struct {
+	_        string `action:"create" entity:"placementgroup" awsAPI:"ec2" awsCall:"CreatePlacementGroup" awsInput:"ec2.CreatePlacementGroupInput" awsOutput:"ec2.CreatePlacementGroupOutput" awsDryRun:""`
+	logger   *logger.Logger
+	graph    cloud.GraphAPI
+	api      ec2iface.EC2API
+	Name     *string `awsName:"GroupName" awsType:"awsstr" templateName:"name"`
+	Strategy *string `awsName:"Strategy" awsType:"awsstr" templateName:"strategy"`
+}
+
+func (cmd *CreatePlacementgroup) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Key("strategy")),
+		params.Validators{
+			"strategy": params.IsInEnumIgnoreCase("cluster", "spread"),
+		})
+}
+
+// BeforeRun sets the strategy as expected by the API (i.e. lowercase)
+func (cmd *CreatePlacementgroup) BeforeRun(renv env.Running) error {
+	cmd.Strategy = String(strings.ToLower(StringValue(cmd.Strategy)))
+	return nil
+}
+
+func (cmd *CreatePlacementgroup) ExtractResult(i interface{}) string {
+	return StringValue(cmd.Name)
+}
+
+type DeletePlacementgroup struct {
+	_      string `action:"delete" entity:"placementgroup" awsAPI:"ec2" awsCall:"DeletePlacementGroup" awsInput:"ec2.DeletePlacementGroupInput" awsOutput:"ec2.DeletePlacementGroupOutput" awsDryRun:""`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2iface.EC2API
+	Name   *string `awsName:"GroupName" awsType:"awsstr" templateName:"name"`
+}
+
+func (cmd *DeletePlacementgroup) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name")))
+}
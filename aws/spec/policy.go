@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/url"
 	"regexp"
@@ -28,6 +29,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/wallix/awless/aws/config"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/params"
@@ -39,41 +41,34 @@ import (
 )
 
 type CreatePolicy struct {
-	_           string `action:"create" entity:"policy" awsAPI:"iam" awsCall:"CreatePolicy" awsInput:"iam.CreatePolicyInput" awsOutput:"iam.CreatePolicyOutput"`
-	logger      *logger.Logger
-	graph       cloud.GraphAPI
-	api         iamiface.IAMAPI
-	Name        *string   `awsName:"PolicyName" awsType:"awsstr" templateName:"name"`
-	Effect      *string   `templateName:"effect"`
-	Action      []*string `templateName:"action"`
-	Resource    []*string `templateName:"resource"`
-	Description *string   `awsName:"Description" awsType:"awsstr" templateName:"description"`
-	Document    *string   `awsName:"PolicyDocument" awsType:"awsstr"`
-	Conditions  []*string `templateName:"conditions"`
+	_            string `action:"create" entity:"policy" awsAPI:"iam" awsCall:"CreatePolicy" awsInput:"iam.CreatePolicyInput" awsOutput:"iam.CreatePolicyOutput"`
+	logger       *logger.Logger
+	graph        cloud.GraphAPI
+	api          iamiface.IAMAPI
+	Name         *string   `awsName:"PolicyName" awsType:"awsstr" templateName:"name"`
+	Effect       *string   `templateName:"effect"`
+	Action       []*string `templateName:"action"`
+	Resource     []*string `templateName:"resource"`
+	Description  *string   `awsName:"Description" awsType:"awsstr" templateName:"description"`
+	Document     *string   `awsName:"PolicyDocument" awsType:"awsstr" templateName:"document"`
+	DocumentFile *string   `templateName:"document-file"`
+	Conditions   []*string `templateName:"conditions"`
 }
 
 func (cmd *CreatePolicy) ParamsSpec() params.Spec {
-	return params.NewSpec(params.AllOf(params.Key("action"), params.Key("effect"), params.Key("name"), params.Key("resource"),
+	return params.NewSpec(params.AllOf(params.Key("name"),
+		params.OnlyOneOf(params.AllOf(params.Key("action"), params.Key("effect"), params.Key("resource")), params.Key("document"), params.Key("document-file")),
 		params.Opt("conditions", "description"),
-	))
+	), policyDocumentValidators)
 }
 
 func (cmd *CreatePolicy) BeforeRun(renv env.Running) error {
-	stat, err := buildStatementFromParams(cmd.Effect, cmd.Resource, cmd.Action, cmd.Conditions)
+	document, err := policyDocumentFromParams(cmd.Document, cmd.DocumentFile, cmd.Effect, cmd.Resource, cmd.Action, cmd.Conditions)
 	if err != nil {
 		return err
 	}
-	policy := &policyBody{
-		Version:   "2012-10-17",
-		Statement: []*policyStatement{stat},
-	}
-
-	b, err := json.MarshalIndent(policy, "", " ")
-	if err != nil {
-		return fmt.Errorf("cannot marshal policy document: %s", err)
-	}
-	cmd.Document = String(string(b))
-	cmd.logger.ExtraVerbosef("policy document json:\n%s\n", string(b))
+	cmd.Document = String(document)
+	cmd.logger.ExtraVerbosef("policy document json:\n%s\n", document)
 	return nil
 }
 
@@ -81,8 +76,11 @@ func (cmd *CreatePolicy) ExtractResult(i interface{}) string {
 	return StringValue(i.(*iam.CreatePolicyOutput).Policy.Arn)
 }
 
+// UpdatePolicy creates a new default version of the policy, either adding a statement
+// to the current document or replacing it with the given one, or sets back a version as default.
+// No version is created when the new document does not change the statements of the current one
 type UpdatePolicy struct {
-	_              string `action:"update" entity:"policy" awsAPI:"iam" awsCall:"CreatePolicyVersion" awsInput:"iam.CreatePolicyVersionInput" awsOutput:"iam.CreatePolicyVersionOutput"`
+	_              string `action:"update" entity:"policy" awsAPI:"iam"`
 	logger         *logger.Logger
 	graph          cloud.GraphAPI
 	api            iamiface.IAMAPI
@@ -91,81 +89,211 @@ type UpdatePolicy struct {
 	Action         []*string `templateName:"action"`
 	Resource       []*string `templateName:"resource"`
 	Conditions     []*string `templateName:"conditions"`
-	Document       *string   `awsName:"PolicyDocument" awsType:"awsstr"`
-	DefaultVersion *bool     `awsName:"SetAsDefault" awsType:"awsbool"`
+	Document       *string   `templateName:"document"`
+	DocumentFile   *string   `templateName:"document-file"`
+	DefaultVersion *string   `awsName:"VersionId" awsType:"awsstr" templateName:"default-version"`
+	NewDocument    *string   `awsName:"PolicyDocument" awsType:"awsstr"`
 }
 
 func (cmd *UpdatePolicy) ParamsSpec() params.Spec {
-	return params.NewSpec(params.AllOf(params.Key("action"), params.Key("arn"), params.Key("effect"), params.Key("resource"),
+	return params.NewSpec(params.AllOf(params.Key("arn"),
+		params.OnlyOneOf(params.AllOf(params.Key("action"), params.Key("effect"), params.Key("resource")), params.Key("document"), params.Key("document-file"), params.Key("default-version")),
 		params.Opt("conditions"),
-	))
+	), policyDocumentValidators)
 }
 
 func (cmd *UpdatePolicy) BeforeRun(renv env.Running) error {
+	if cmd.DefaultVersion != nil {
+		return nil
+	}
 	document, err := cmd.getPolicyLastVersionDocument(cmd.Arn)
 	if err != nil {
 		return err
 	}
-	var defaultPolicyDocument *struct {
-		Version    string             `json:",omitempty"`
-		ID         string             `json:"Id,omitempty"`
-		Statements []*json.RawMessage `json:"Statement,omitempty"`
-	}
 
-	if err = json.Unmarshal([]byte(document), &defaultPolicyDocument); err != nil {
-		return err
+	var newDocument string
+	if cmd.Document != nil || cmd.DocumentFile != nil {
+		if newDocument, err = policyDocumentFromParams(cmd.Document, cmd.DocumentFile, nil, nil, nil, nil); err != nil {
+			return err
+		}
+	} else {
+		var defaultPolicyDocument *struct {
+			Version    string             `json:",omitempty"`
+			ID         string             `json:"Id,omitempty"`
+			Statements []*json.RawMessage `json:"Statement,omitempty"`
+		}
+
+		if err = json.Unmarshal([]byte(document), &defaultPolicyDocument); err != nil {
+			return err
+		}
+		stat, err := buildStatementFromParams(cmd.Effect, cmd.Resource, cmd.Action, cmd.Conditions)
+		if err != nil {
+			return err
+		}
+
+		var newStatement json.RawMessage
+		if newStatement, err = json.Marshal(stat); err != nil {
+			return err
+		}
+		defaultPolicyDocument.Statements = append(defaultPolicyDocument.Statements, &newStatement)
+
+		b, err := json.MarshalIndent(defaultPolicyDocument, "", " ")
+		if err != nil {
+			return fmt.Errorf("cannot marshal policy document: %s", err)
+		}
+		newDocument = string(b)
 	}
-	stat, err := buildStatementFromParams(cmd.Effect, cmd.Resource, cmd.Action, cmd.Conditions)
+
+	diff, err := diffPolicyDocuments(document, newDocument)
 	if err != nil {
 		return err
 	}
+	if diff.isEmpty() {
+		return nil
+	}
+	for _, line := range diff.lines() {
+		cmd.logger.Verbosef("policy '%s': %s", StringValue(cmd.Arn), line)
+	}
+	cmd.NewDocument = String(newDocument)
+	cmd.logger.ExtraVerbosef("policy document json:\n%s\n", newDocument)
+	return nil
+}
 
-	var newStatement json.RawMessage
-	if newStatement, err = json.Marshal(stat); err != nil {
-		return err
+func (cmd *UpdatePolicy) ManualRun(renv env.Running) (interface{}, error) {
+	start := time.Now()
+	switch {
+	case cmd.DefaultVersion != nil:
+		input := &iam.SetDefaultPolicyVersionInput{}
+		input.PolicyArn = cmd.Arn
+		input.VersionId = cmd.DefaultVersion
+		output, err := cmd.api.SetDefaultPolicyVersion(input)
+		cmd.logger.ExtraVerbosef("iam.SetDefaultPolicyVersion call took %s", time.Since(start))
+		return output, err
+	case cmd.NewDocument == nil:
+		cmd.logger.Infof("policy '%s' already up to date: no new version created", StringValue(cmd.Arn))
+		return nil, nil
+	default:
+		input := &iam.CreatePolicyVersionInput{}
+		input.PolicyArn = cmd.Arn
+		input.PolicyDocument = cmd.NewDocument
+		input.SetAsDefault = aws.Bool(true)
+		output, err := cmd.api.CreatePolicyVersion(input)
+		cmd.logger.ExtraVerbosef("iam.CreatePolicyVersion call took %s", time.Since(start))
+		return output, err
 	}
-	defaultPolicyDocument.Statements = append(defaultPolicyDocument.Statements, &newStatement)
+}
 
-	b, err := json.MarshalIndent(defaultPolicyDocument, "", " ")
+// PriorState returns the default version of the policy before the update, so that it can be set back as default
+func (cmd *UpdatePolicy) PriorState(renv env.Running, params map[string]interface{}) (map[string]interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, err
+	}
+	version, err := policyDefaultVersion(cmd.api, cmd.Arn)
 	if err != nil {
-		return fmt.Errorf("cannot marshal policy document: %s", err)
+		return nil, err
 	}
-	cmd.Document = String(string(b))
-	cmd.DefaultVersion = aws.Bool(true)
-	cmd.logger.ExtraVerbosef("policy document json:\n%s\n", string(b))
-	return nil
+	return map[string]interface{}{"default-version": version}, nil
 }
 
 func (cmd *UpdatePolicy) getPolicyLastVersionDocument(arn *string) (string, error) {
-	listVersionsInput := &iam.ListPolicyVersionsInput{
+	version, err := policyDefaultVersion(cmd.api, arn)
+	if err != nil {
+		return "", err
+	}
+	policyDetailInput := &iam.GetPolicyVersionInput{
+		VersionId: String(version),
 		PolicyArn: arn,
 	}
-	listVersionsOut, err := cmd.api.ListPolicyVersions(listVersionsInput)
+	policyDetailOutput, err := cmd.api.GetPolicyVersion(policyDetailInput)
+	if err != nil {
+		return "", err
+	}
+	document, err := url.QueryUnescape(aws.StringValue(policyDetailOutput.PolicyVersion.Document))
+	if err != nil {
+		return "", fmt.Errorf("decoding policy document: %s", err)
+	}
+	return document, nil
+}
+
+func (cmd *UpdatePolicy) IAMActions(params map[string]interface{}) []string {
+	if _, ok := params["default-version"]; ok {
+		return []string{"iam:ListPolicyVersions", "iam:SetDefaultPolicyVersion"}
+	}
+	return []string{"iam:ListPolicyVersions", "iam:GetPolicyVersion", "iam:CreatePolicyVersion"}
+}
+
+func policyDefaultVersion(api iamiface.IAMAPI, arn *string) (string, error) {
+	listVersionsOut, err := api.ListPolicyVersions(&iam.ListPolicyVersionsInput{PolicyArn: arn})
 	if err != nil {
 		return "", err
 	}
-	var defaultVersion *iam.PolicyVersion
 	for _, version := range listVersionsOut.Versions {
 		if aws.BoolValue(version.IsDefaultVersion) {
-			policyDetailInput := &iam.GetPolicyVersionInput{
-				VersionId: version.VersionId,
-				PolicyArn: arn,
-			}
-			var policyDetailOutput *iam.GetPolicyVersionOutput
-			if policyDetailOutput, err = cmd.api.GetPolicyVersion(policyDetailInput); err != nil {
-				return "", err
-			}
-			defaultVersion = policyDetailOutput.PolicyVersion
+			return aws.StringValue(version.VersionId), nil
 		}
 	}
-	if defaultVersion == nil {
-		return "", fmt.Errorf("update policy: can not find default version for policy with arn '%s'", StringValue(arn))
+	return "", fmt.Errorf("update policy: can not find default version for policy with arn '%s'", StringValue(arn))
+}
+
+type CreatePolicyversion struct {
+	_            string `action:"create" entity:"policyversion" awsAPI:"iam" awsCall:"CreatePolicyVersion" awsInput:"iam.CreatePolicyVersionInput" awsOutput:"iam.CreatePolicyVersionOutput"`
+	logger       *logger.Logger
+	graph        cloud.GraphAPI
+	api          iamiface.IAMAPI
+	Arn          *string `awsName:"PolicyArn" awsType:"awsstr" templateName:"arn"`
+	Document     *string `awsName:"PolicyDocument" awsType:"awsstr" templateName:"document"`
+	DocumentFile *string `templateName:"document-file"`
+	Default      *bool   `awsName:"SetAsDefault" awsType:"awsbool" templateName:"default"`
+}
+
+func (cmd *CreatePolicyversion) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("arn"),
+		params.OnlyOneOf(params.Key("document"), params.Key("document-file")),
+		params.Opt("default"),
+	), policyDocumentValidators)
+}
+
+func (cmd *CreatePolicyversion) BeforeRun(renv env.Running) error {
+	document, err := policyDocumentFromParams(cmd.Document, cmd.DocumentFile, nil, nil, nil, nil)
+	if err != nil {
+		return err
 	}
-	document, err := url.QueryUnescape(aws.StringValue(defaultVersion.Document))
+	cmd.Document = String(document)
+	cmd.logger.ExtraVerbosef("policy document json:\n%s\n", document)
+	return nil
+}
+
+func (cmd *CreatePolicyversion) ExtractResult(i interface{}) string {
+	return StringValue(i.(*iam.CreatePolicyVersionOutput).PolicyVersion.VersionId)
+}
+
+// PriorState returns the default version of the policy when the new version is set as default,
+// so that it can be set back as default before deleting the new version
+func (cmd *CreatePolicyversion) PriorState(renv env.Running, params map[string]interface{}) (map[string]interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, err
+	}
+	if !BoolValue(cmd.Default) {
+		return nil, nil
+	}
+	version, err := policyDefaultVersion(cmd.api, cmd.Arn)
 	if err != nil {
-		return "", fmt.Errorf("decoding policy document: %s", err)
+		return nil, err
 	}
-	return document, nil
+	return map[string]interface{}{"default-version": version}, nil
+}
+
+type DeletePolicyversion struct {
+	_       string `action:"delete" entity:"policyversion" awsAPI:"iam" awsCall:"DeletePolicyVersion" awsInput:"iam.DeletePolicyVersionInput" awsOutput:"iam.DeletePolicyVersionOutput"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     iamiface.IAMAPI
+	Arn     *string `awsName:"PolicyArn" awsType:"awsstr" templateName:"arn"`
+	Version *string `awsName:"VersionId" awsType:"awsstr" templateName:"version"`
+}
+
+func (cmd *DeletePolicyversion) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("arn"), params.Key("version")))
 }
 
 type DeletePolicy struct {
@@ -219,25 +347,36 @@ func (cmd *AttachPolicy) ParamsSpec() params.Spec {
 		params.OnlyOneOf(params.Key("user"), params.Key("role"), params.Key("group")),
 		params.OnlyOneOf(params.Key("arn"), params.AllOf(params.Key("access"), params.Key("service"))),
 	))
-	builder.AddReducer(transformAccessServiceToARN, "access", "service")
+	builder.AddReducer(transformAccessServiceToARN(cmd.api), "access", "service")
 	return builder.Done()
 }
 
-func transformAccessServiceToARN(values map[string]interface{}) (map[string]interface{}, error) {
-	service, hasService := values["service"].(string)
-	access, hasAccess := values["access"].(string)
+// transformAccessServiceToARN resolves the AWS managed policy for a service and an access
+// in the partition of the region of the given API client
+func transformAccessServiceToARN(api iamiface.IAMAPI) func(map[string]interface{}) (map[string]interface{}, error) {
+	return func(values map[string]interface{}) (map[string]interface{}, error) {
+		service, hasService := values["service"].(string)
+		access, hasAccess := values["access"].(string)
 
-	if hasService && hasAccess {
-		pol, err := lookupAWSPolicy(service, access)
-		if err != nil {
-			return values, err
+		if hasService && hasAccess {
+			pol, err := lookupAWSPolicy(service, access, iamPartition(api))
+			if err != nil {
+				return values, err
+			}
+			return map[string]interface{}{"arn": pol.Arn}, nil
+		} else {
+			return nil, nil
 		}
-		return map[string]interface{}{"arn": pol.Arn}, nil
-	} else {
-		return nil, nil
 	}
 }
 
+func iamPartition(api iamiface.IAMAPI) string {
+	if client, ok := api.(*iam.IAM); ok {
+		return awsconfig.PartitionForRegion(StringValue(client.Config.Region))
+	}
+	return awsconfig.StandardPartition
+}
+
 func (cmd *AttachPolicy) ManualRun(renv env.Running) (interface{}, error) {
 	start := time.Now()
 	switch {
@@ -267,6 +406,10 @@ func (cmd *AttachPolicy) ManualRun(renv env.Running) (interface{}, error) {
 	}
 }
 
+func (cmd *AttachPolicy) IAMActions(params map[string]interface{}) []string {
+	return policyAttachmentIAMActions("Attach", params)
+}
+
 type DetachPolicy struct {
 	_      string `action:"detach" entity:"policy" awsAPI:"iam"`
 	logger *logger.Logger
@@ -283,7 +426,7 @@ func (cmd *DetachPolicy) ParamsSpec() params.Spec {
 		params.OnlyOneOf(params.Key("user"), params.Key("role"), params.Key("group")),
 		params.OnlyOneOf(params.Key("arn"), params.AllOf(params.Key("access"), params.Key("service"))),
 	))
-	builder.AddReducer(transformAccessServiceToARN, "access", "service")
+	builder.AddReducer(transformAccessServiceToARN(cmd.api), "access", "service")
 	return builder.Done()
 }
 
@@ -316,6 +459,19 @@ func (cmd *DetachPolicy) ManualRun(renv env.Running) (interface{}, error) {
 	}
 }
 
+func (cmd *DetachPolicy) IAMActions(params map[string]interface{}) []string {
+	return policyAttachmentIAMActions("Detach", params)
+}
+
+func policyAttachmentIAMActions(action string, params map[string]interface{}) []string {
+	for _, principal := range []string{"user", "group", "role"} {
+		if _, ok := params[principal]; ok {
+			return []string{fmt.Sprintf("iam:%s%sPolicy", action, strings.Title(principal))}
+		}
+	}
+	return nil
+}
+
 type policyBody struct {
 	Version   string
 	Statement []*policyStatement
@@ -366,6 +522,142 @@ func buildStatementFromParams(effect *string, resource, action, condition []*str
 	return stat, nil
 }
 
+var policyDocumentValidators = params.Validators{
+	"document": func(i interface{}, others map[string]interface{}) error {
+		_, err := parsePolicyDocument(fmt.Sprint(i))
+		return err
+	},
+	"conditions": func(i interface{}, others map[string]interface{}) error {
+		if _, ok := others["effect"]; !ok {
+			return errors.New("only applicable to a statement given with effect, action and resource")
+		}
+		return nil
+	},
+}
+
+// policyDocumentFromParams returns the policy document given inline or as a file,
+// or else the document made of the statement built from the other params
+func policyDocumentFromParams(document, documentFile, effect *string, resource, action, conditions []*string) (string, error) {
+	switch {
+	case documentFile != nil:
+		b, err := ioutil.ReadFile(StringValue(documentFile))
+		if err != nil {
+			return "", fmt.Errorf("reading policy document: %s", err)
+		}
+		if _, err = parsePolicyDocument(string(b)); err != nil {
+			return "", err
+		}
+		return string(b), nil
+	case document != nil:
+		if _, err := parsePolicyDocument(StringValue(document)); err != nil {
+			return "", err
+		}
+		return StringValue(document), nil
+	}
+	stat, err := buildStatementFromParams(effect, resource, action, conditions)
+	if err != nil {
+		return "", err
+	}
+	policy := &policyBody{
+		Version:   "2012-10-17",
+		Statement: []*policyStatement{stat},
+	}
+	b, err := json.MarshalIndent(policy, "", " ")
+	if err != nil {
+		return "", fmt.Errorf("cannot marshal policy document: %s", err)
+	}
+	return string(b), nil
+}
+
+// parsedPolicyDocument holds the statements of a policy document in a canonical form,
+// so that documents can be compared whatever their indentation or the order of their fields
+type parsedPolicyDocument struct {
+	Version    string
+	Statements []string
+}
+
+func parsePolicyDocument(document string) (*parsedPolicyDocument, error) {
+	var doc struct {
+		Version   string
+		Statement json.RawMessage
+	}
+	if err := json.Unmarshal([]byte(document), &doc); err != nil {
+		return nil, fmt.Errorf("invalid policy document: %s", err)
+	}
+	if len(doc.Statement) == 0 {
+		return nil, errors.New("invalid policy document: missing Statement")
+	}
+	var statements []map[string]interface{}
+	if err := json.Unmarshal(doc.Statement, &statements); err != nil {
+		var single map[string]interface{}
+		if err = json.Unmarshal(doc.Statement, &single); err != nil {
+			return nil, fmt.Errorf("invalid policy document: Statement: %s", err)
+		}
+		statements = append(statements, single)
+	}
+	parsed := &parsedPolicyDocument{Version: doc.Version}
+	for _, stat := range statements {
+		for k, v := range stat {
+			// a single value is equivalent to a list of one element (ex: "Action": "s3:*")
+			if list, ok := v.([]interface{}); ok && len(list) == 1 {
+				stat[k] = list[0]
+			}
+		}
+		b, err := json.Marshal(stat)
+		if err != nil {
+			return nil, err
+		}
+		parsed.Statements = append(parsed.Statements, string(b))
+	}
+	return parsed, nil
+}
+
+type policyDiff struct {
+	fromVersion, toVersion string
+	added, removed         []string
+}
+
+// diffPolicyDocuments returns the changes of the statements and version between two policy documents
+func diffPolicyDocuments(from, to string) (*policyDiff, error) {
+	fromDoc, err := parsePolicyDocument(from)
+	if err != nil {
+		return nil, fmt.Errorf("current %s", err)
+	}
+	toDoc, err := parsePolicyDocument(to)
+	if err != nil {
+		return nil, err
+	}
+	diff := &policyDiff{fromVersion: fromDoc.Version, toVersion: toDoc.Version}
+	for _, stat := range toDoc.Statements {
+		if !contains(fromDoc.Statements, stat) && !contains(diff.added, stat) {
+			diff.added = append(diff.added, stat)
+		}
+	}
+	for _, stat := range fromDoc.Statements {
+		if !contains(toDoc.Statements, stat) && !contains(diff.removed, stat) {
+			diff.removed = append(diff.removed, stat)
+		}
+	}
+	return diff, nil
+}
+
+func (d *policyDiff) isEmpty() bool {
+	return d.fromVersion == d.toVersion && len(d.added) == 0 && len(d.removed) == 0
+}
+
+func (d *policyDiff) lines() (lines []string) {
+	if d.fromVersion != d.toVersion {
+		lines = append(lines, fmt.Sprintf("version %s -> %s", d.fromVersion, d.toVersion))
+	}
+	for _, stat := range d.removed {
+		lines = append(lines, "- "+stat)
+	}
+	for _, stat := range d.added {
+		lines = append(lines, "+ "+stat)
+	}
+	return
+}
+
 type policyConditions []*policyCondition
 
 func (c *policyConditions) MarshalJSON() ([]byte, error) {
@@ -498,7 +790,8 @@ func parseCondition(condition string) (*policyCondition, error) {
 	}
 }
 
-func lookupAWSPolicy(service, access string) (*policy, error) {
+// lookupAWSPolicy returns the AWS managed policy matching the service and the access, with its ARN in the given partition
+func lookupAWSPolicy(service, access, partition string) (*policy, error) {
 	if access != "readonly" && access != "full" {
 		return nil, errors.New("looking up AWS policies: access value can only be 'readonly' or 'full'")
 	}
@@ -508,10 +801,10 @@ func lookupAWSPolicy(service, access string) (*policy, error) {
 		name := strings.ToLower(p.Name)
 		match := fmt.Sprintf("%s%s", strings.ToLower(service), strings.ToLower(access))
 		if strings.Contains(name, match) {
-			return p, nil
+			return &policy{Name: p.Name, Id: p.Id, Arn: p.arnInPartition(partition)}, nil
 		}
 		if strings.Contains(name, strings.ToLower(service)) {
-			suggestions = append(suggestions, fmt.Sprintf("\t\tarn=%s", p.Arn))
+			suggestions = append(suggestions, fmt.Sprintf("\t\tarn=%s", p.arnInPartition(partition)))
 		}
 	}
 
@@ -530,6 +823,15 @@ type policy struct {
 	Arn  string `json:"Arn"`
 }
 
+// arnInPartition returns the ARN of the policy in the given partition, the known AWS policies being
+// listed with their ARN in the standard partition
+func (p *policy) arnInPartition(partition string) string {
+	if partition == "" || partition == awsconfig.StandardPartition {
+		return p.Arn
+	}
+	return strings.Replace(p.Arn, "arn:"+awsconfig.StandardPartition+":", "arn:"+partition+":", 1)
+}
+
 var awsPolicies = []*policy{
 	{
 		Name: "AWSDirectConnectReadOnlyAccess",
@@ -4,6 +4,10 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
 )
 
 func TestBuildPolicyConditions(t *testing.T) {
@@ -53,7 +57,7 @@ func TestBuildPolicyConditions(t *testing.T) {
 }
 
 func TestResolvePolicy(t *testing.T) {
-	p, err := lookupAWSPolicy("ec2", "readonly")
+	p, err := lookupAWSPolicy("ec2", "readonly", "aws")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -61,7 +65,7 @@ func TestResolvePolicy(t *testing.T) {
 		t.Fatalf("got %s, want %s", got, want)
 	}
 
-	p, err = lookupAWSPolicy("lambda", "full")
+	p, err = lookupAWSPolicy("lambda", "full", "aws")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -69,16 +73,48 @@ func TestResolvePolicy(t *testing.T) {
 		t.Fatalf("got %s, want %s", got, want)
 	}
 
-	if _, err = lookupAWSPolicy("lambda", "fully"); err == nil {
+	p, err = lookupAWSPolicy("ec2", "readonly", "aws-cn")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := p.Arn, "arn:aws-cn:iam::aws:policy/AmazonEC2ReadOnlyAccess"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := awsPolicies[0].Arn, "arn:aws:iam::aws:policy/AWSDirectConnectReadOnlyAccess"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	if _, err = lookupAWSPolicy("lambda", "fully", "aws"); err == nil {
 		t.Fatal("expecting error got none")
 	}
-	if _, err = lookupAWSPolicy("lava", "full"); err == nil {
+	if _, err = lookupAWSPolicy("lava", "full", "aws"); err == nil {
 		t.Fatal("expecting error got none")
 	}
 }
 
+func TestTransformAccessServiceToARNInRegionPartition(t *testing.T) {
+	tcases := []struct {
+		region string
+		expArn string
+	}{
+		{"eu-west-1", "arn:aws:iam::aws:policy/AmazonEC2ReadOnlyAccess"},
+		{"cn-north-1", "arn:aws-cn:iam::aws:policy/AmazonEC2ReadOnlyAccess"},
+		{"us-gov-west-1", "arn:aws-us-gov:iam::aws:policy/AmazonEC2ReadOnlyAccess"},
+	}
+	for _, tcase := range tcases {
+		api := iam.New(session.Must(session.NewSession(&awssdk.Config{Region: awssdk.String(tcase.region)})))
+		reduced, err := transformAccessServiceToARN(api)(map[string]interface{}{"service": "ec2", "access": "readonly"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := reduced["arn"], tcase.expArn; got != want {
+			t.Fatalf("%s: got %v, want %s", tcase.region, got, want)
+		}
+	}
+}
+
 func TestResolvePolicyErrorMessageWithSuggestion(t *testing.T) {
-	_, err := lookupAWSPolicy("Administrator", "readonly")
+	_, err := lookupAWSPolicy("Administrator", "readonly", "aws")
 	if err == nil {
 		t.Fatal("expected error got none")
 	}
@@ -94,3 +130,39 @@ func TestResolvePolicyErrorMessageWithSuggestion(t *testing.T) {
 		}
 	}
 }
+
+func TestDiffPolicyDocuments(t *testing.T) {
+	current := `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": ["s3:GetObject"], "Resource": "*"}]}`
+	tcases := []struct {
+		document       string
+		added, removed []string
+		empty          bool
+	}{
+		{document: `{"Statement": {"Resource": ["*"], "Action": "s3:GetObject", "Effect": "Allow"}, "Version": "2012-10-17"}`, empty: true},
+		{document: `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}, {"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`, empty: true},
+		{
+			document: `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": ["s3:GetObject", "s3:PutObject"], "Resource": "*"}]}`,
+			added:    []string{`{"Action":["s3:GetObject","s3:PutObject"],"Effect":"Allow","Resource":"*"}`},
+			removed:  []string{`{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"}`},
+		},
+	}
+	for i, tcase := range tcases {
+		diff, err := diffPolicyDocuments(current, tcase.document)
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if got, want := diff.isEmpty(), tcase.empty; got != want {
+			t.Fatalf("%d: got %t, want %t", i+1, got, want)
+		}
+		if got, want := diff.added, tcase.added; !reflect.DeepEqual(got, want) {
+			t.Fatalf("%d: got %v, want %v", i+1, got, want)
+		}
+		if got, want := diff.removed, tcase.removed; !reflect.DeepEqual(got, want) {
+			t.Fatalf("%d: got %v, want %v", i+1, got, want)
+		}
+	}
+
+	if _, err := diffPolicyDocuments(current, `{"Version": "2012-10-17"}`); err == nil || !strings.Contains(err.Error(), "missing Statement") {
+		t.Fatalf("got %v", err)
+	}
+}
@@ -0,0 +1,108 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+// maxPresignExpiry is the longest validity of the URLs signed with Signature Version 4
+const maxPresignExpiry = 7 * 24 * time.Hour
+
+// CreatePresignedurl signs locally, with the credentials of the session, a URL granting
+// its bearers the download (or upload with method=put) of an object until it expires
+type CreatePresignedurl struct {
+	_      string `action:"create" entity:"presignedurl" awsAPI:"s3"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    s3iface.S3API
+	Bucket *string `templateName:"bucket"`
+	Key    *string `templateName:"key"`
+	Expiry *string `templateName:"expiry"`
+	Method *string `templateName:"method"`
+}
+
+func (cmd *CreatePresignedurl) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("bucket"), params.Key("key"),
+		params.Opt("expiry", "method"),
+	), params.Validators{
+		"expiry": func(i interface{}, others map[string]interface{}) error {
+			_, err := parsePresignExpiry(fmt.Sprint(i))
+			return err
+		},
+		"method": params.IsInEnumIgnoreCase("get", "put"),
+	})
+}
+
+func (cmd *CreatePresignedurl) ManualRun(renv env.Running) (interface{}, error) {
+	expiry := time.Hour
+	if cmd.Expiry != nil {
+		var err error
+		if expiry, err = parsePresignExpiry(StringValue(cmd.Expiry)); err != nil {
+			return nil, err
+		}
+	}
+	var req *request.Request
+	if strings.ToLower(StringValue(cmd.Method)) == "put" {
+		req, _ = cmd.api.PutObjectRequest(&s3.PutObjectInput{Bucket: cmd.Bucket, Key: cmd.Key})
+	} else {
+		req, _ = cmd.api.GetObjectRequest(&s3.GetObjectInput{Bucket: cmd.Bucket, Key: cmd.Key})
+	}
+	url, err := req.Presign(expiry)
+	if err != nil {
+		return nil, err
+	}
+	cmd.logger.ExtraVerbosef("URL of '%s' valid until %s", StringValue(cmd.Key), time.Now().Add(expiry).Format(time.RFC3339))
+	return url, nil
+}
+
+func (cmd *CreatePresignedurl) ExtractResult(i interface{}) string {
+	return i.(string)
+}
+
+// IAMActions returns the actions the bearers of the URL are allowed by the signer to perform
+func (cmd *CreatePresignedurl) IAMActions(params map[string]interface{}) []string {
+	if m, ok := params["method"]; ok && strings.ToLower(fmt.Sprint(m)) == "put" {
+		return []string{"s3:PutObject"}
+	}
+	return []string{"s3:GetObject"}
+}
+
+// parsePresignExpiry parses the validity of a presigned URL given in seconds or as a duration (ex: 3600, 1h)
+func parsePresignExpiry(s string) (time.Duration, error) {
+	d, err := time.ParseDuration(s)
+	if secs, convErr := strconv.Atoi(s); convErr == nil {
+		d, err = time.Duration(secs)*time.Second, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("invalid expiry '%s': expecting seconds or a duration (ex: 3600, 1h)", s)
+	}
+	if d < time.Second || d > maxPresignExpiry {
+		return 0, fmt.Errorf("invalid expiry '%s': expecting between 1s and 7 days", s)
+	}
+	return d, nil
+}
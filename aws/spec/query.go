@@ -0,0 +1,102 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/aws/aws-sdk-go/service/athena/athenaiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+type StartQuery struct {
+	_        string `action:"start" entity:"query" awsAPI:"athena"`
+	logger   *logger.Logger
+	graph    cloud.GraphAPI
+	api      athenaiface.AthenaAPI
+	Query    *string `templateName:"query"`
+	Output   *string `templateName:"output"`
+	Database *string `templateName:"database"`
+	Timeout  *string `templateName:"timeout"`
+}
+
+func (cmd *StartQuery) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("query"), params.Key("output"), params.Opt(params.Suggested("database"), "timeout")),
+		params.Validators{
+			"timeout": isCheckTimeout,
+		})
+}
+
+// ManualRun starts the query and waits for its completion, returning the S3 location of its results
+func (cmd *StartQuery) ManualRun(renv env.Running) (interface{}, error) {
+	timeout := 30 * time.Minute
+	if cmd.Timeout != nil {
+		var err error
+		if timeout, err = parseCheckTimeout(StringValue(cmd.Timeout)); err != nil {
+			return nil, err
+		}
+	}
+	input := &athena.StartQueryExecutionInput{
+		QueryString:         cmd.Query,
+		ResultConfiguration: &athena.ResultConfiguration{OutputLocation: cmd.Output},
+	}
+	if cmd.Database != nil {
+		input.QueryExecutionContext = &athena.QueryExecutionContext{Database: cmd.Database}
+	}
+	start := time.Now()
+	started, err := cmd.api.StartQueryExecution(input)
+	cmd.logger.ExtraVerbosef("athena.StartQueryExecution call took %s", time.Since(start))
+	if err != nil {
+		return nil, err
+	}
+
+	var execution *athena.QueryExecution
+	c := &checker{
+		renv:        renv,
+		description: fmt.Sprintf("query %s", StringValue(started.QueryExecutionId)),
+		timeout:     timeout,
+		frequency:   2 * time.Second,
+		fetchFunc: func() (string, error) {
+			output, err := cmd.api.GetQueryExecution(&athena.GetQueryExecutionInput{QueryExecutionId: started.QueryExecutionId})
+			if err != nil {
+				return "", err
+			}
+			execution = output.QueryExecution
+			switch state := StringValue(execution.Status.State); state {
+			case athena.QueryExecutionStateFailed, athena.QueryExecutionStateCancelled:
+				return "", fmt.Errorf("%s: %s", state, StringValue(execution.Status.StateChangeReason))
+			default:
+				return state, nil
+			}
+		},
+		expect: athena.QueryExecutionStateSucceeded,
+		logger: cmd.logger,
+	}
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+	return execution, nil
+}
+
+func (cmd *StartQuery) ExtractResult(i interface{}) string {
+	return StringValue(i.(*athena.QueryExecution).ResultConfiguration.OutputLocation)
+}
@@ -16,14 +16,25 @@ limitations under the License.
 package awsspec
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/params"
 )
 
+const (
+	fifoQueueSuffix        = ".fifo"
+	defaultMaxReceiveCount = 5
+)
+
 type CreateQueue struct {
 	_                 string `action:"create" entity:"queue" awsAPI:"sqs" awsCall:"CreateQueue" awsInput:"sqs.CreateQueueInput" awsOutput:"sqs.CreateQueueOutput"`
 	logger            *logger.Logger
@@ -37,12 +48,60 @@ type CreateQueue struct {
 	MsgWait           *string `awsName:"Attributes[ReceiveMessageWaitTimeSeconds]" awsType:"awsstringpointermap" templateName:"msg-wait"`
 	RedrivePolicy     *string `awsName:"Attributes[RedrivePolicy]" awsType:"awsstringpointermap" templateName:"redrive-policy"`
 	VisibilityTimeout *string `awsName:"Attributes[VisibilityTimeout]" awsType:"awsstringpointermap" templateName:"visibility-timeout"`
+	Fifo              *string `awsName:"Attributes[FifoQueue]" awsType:"awsstringpointermap" templateName:"fifo"`
+	ContentDedup      *string `awsName:"Attributes[ContentBasedDeduplication]" awsType:"awsstringpointermap" templateName:"content-deduplication"`
+	DeadLetterQueue   *string `templateName:"dead-letter-queue"`
+	MaxReceiveCount   *int64  `templateName:"max-receive-count"`
 }
 
 func (cmd *CreateQueue) ParamsSpec() params.Spec {
-	return params.NewSpec(params.AllOf(params.Key("name"),
-		params.Opt("delay", "max-msg-size", "msg-wait", "policy", "redrive-policy", "retention-period", "visibility-timeout"),
-	))
+	return params.NewSpec(
+		params.AllOf(params.Key("name"),
+			params.Opt("content-deduplication", "dead-letter-queue", "delay", "fifo", "max-msg-size", "max-receive-count", "msg-wait", "policy", "redrive-policy", "retention-period", "visibility-timeout"),
+		),
+		params.Validators{
+			"fifo": func(i interface{}, others map[string]interface{}) error {
+				if err := params.IsInEnumIgnoreCase("true", "false")(i, others); err != nil {
+					return err
+				}
+				if name, ok := others["name"].(string); ok && strings.EqualFold(fmt.Sprint(i), "true") && !strings.HasSuffix(name, fifoQueueSuffix) {
+					return fmt.Errorf("name of FIFO queue '%s' must end with '%s'", name, fifoQueueSuffix)
+				}
+				return nil
+			},
+			"content-deduplication": params.IsInEnumIgnoreCase("true", "false"),
+			"dead-letter-queue": func(i interface{}, others map[string]interface{}) error {
+				if _, ok := others["redrive-policy"]; ok {
+					return errors.New("'dead-letter-queue' cannot be used with 'redrive-policy'")
+				}
+				return nil
+			},
+		})
+}
+
+// BeforeRun sets the redrive policy sending to the dead letter queue, given as URL, ARN or name,
+// the messages received more than max-receive-count times
+func (cmd *CreateQueue) BeforeRun(renv env.Running) error {
+	if cmd.DeadLetterQueue == nil {
+		if cmd.MaxReceiveCount != nil {
+			return errors.New("'max-receive-count' requires 'dead-letter-queue'")
+		}
+		return nil
+	}
+	arn, err := queueArn(cmd.api, StringValue(cmd.DeadLetterQueue))
+	if err != nil {
+		return fmt.Errorf("dead letter queue: %s", err)
+	}
+	maxReceive := int64(defaultMaxReceiveCount)
+	if cmd.MaxReceiveCount != nil {
+		maxReceive = *cmd.MaxReceiveCount
+	}
+	policy, err := json.Marshal(map[string]string{"deadLetterTargetArn": arn, "maxReceiveCount": fmt.Sprint(maxReceive)})
+	if err != nil {
+		return err
+	}
+	cmd.RedrivePolicy = String(string(policy))
+	return nil
 }
 
 func (cmd *CreateQueue) ExtractResult(i interface{}) string {
@@ -60,3 +119,27 @@ type DeleteQueue struct {
 func (cmd *DeleteQueue) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("url")))
 }
+
+// queueArn returns the ARN of a queue given as ARN, URL or name
+func queueArn(api sqsiface.SQSAPI, queue string) (string, error) {
+	if strings.HasPrefix(queue, "arn:") {
+		return queue, nil
+	}
+	url := queue
+	if !strings.Contains(queue, "://") {
+		out, err := api.GetQueueUrl(&sqs.GetQueueUrlInput{QueueName: String(queue)})
+		if err != nil {
+			return "", err
+		}
+		url = awssdk.StringValue(out.QueueUrl)
+	}
+	out, err := api.GetQueueAttributes(&sqs.GetQueueAttributesInput{QueueUrl: String(url), AttributeNames: []*string{String(sqs.QueueAttributeNameQueueArn)}})
+	if err != nil {
+		return "", err
+	}
+	arn := awssdk.StringValue(out.Attributes[sqs.QueueAttributeNameQueueArn])
+	if arn == "" {
+		return "", fmt.Errorf("no ARN for queue %s", queue)
+	}
+	return arn, nil
+}
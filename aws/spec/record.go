@@ -17,6 +17,7 @@ package awsspec
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/wallix/awless/cloud"
@@ -32,20 +33,22 @@ import (
 )
 
 type CreateRecord struct {
-	_       string `action:"create" entity:"record" awsAPI:"route53"`
-	logger  *logger.Logger
-	graph   cloud.GraphAPI
-	api     route53iface.Route53API
-	Zone    *string   `templateName:"zone"`
-	Name    *string   `templateName:"name"`
-	Type    *string   `templateName:"type"`
-	Values  []*string `templateName:"values"`
-	Ttl     *int64    `templateName:"ttl"`
-	Comment *string   `templateName:"comment"`
+	_         string `action:"create" entity:"record" awsAPI:"route53"`
+	logger    *logger.Logger
+	graph     cloud.GraphAPI
+	api       route53iface.Route53API
+	Zone      *string   `templateName:"zone"`
+	Name      *string   `templateName:"name"`
+	Type      *string   `templateName:"type"`
+	Values    []*string `templateName:"values"`
+	Ttl       *int64    `templateName:"ttl"`
+	Alias     *string   `templateName:"alias"`
+	AliasZone *string   `templateName:"alias-zone"`
+	Comment   *string   `templateName:"comment"`
 }
 
 func (cmd *CreateRecord) ParamsSpec() params.Spec {
-	builder := params.SpecBuilder(params.AllOf(params.Key("name"), params.Key("ttl"), params.Key("type"), params.OnlyOneOf(params.Key("values"), params.Key("value")), params.Key("zone"),
+	builder := params.SpecBuilder(params.AllOf(params.Key("name"), params.Key("type"), recordTargetRule(), params.Key("zone"),
 		params.Opt("comment"),
 	))
 	builder.AddReducer(valueToValues, "value")
@@ -53,8 +56,12 @@ func (cmd *CreateRecord) ParamsSpec() params.Spec {
 }
 
 func (cmd *CreateRecord) ManualRun(renv env.Running) (interface{}, error) {
+	alias, err := resolveAliasTarget(cmd.graph, cmd.Alias, cmd.AliasZone)
+	if err != nil {
+		return nil, err
+	}
 	start := time.Now()
-	output, err := changeResourceRecordSets(cmd.api, String("CREATE"), cmd.Zone, cmd.Name, cmd.Type, cmd.Values, cmd.Comment, cmd.Ttl)
+	output, err := changeResourceRecordSets(cmd.api, String("CREATE"), cmd.Zone, cmd.Name, cmd.Type, cmd.Values, cmd.Comment, cmd.Ttl, alias)
 	cmd.logger.ExtraVerbosef("route53.ChangeResourceRecordSets call took %s", time.Since(start))
 	return output, err
 }
@@ -64,26 +71,32 @@ func (cmd *CreateRecord) ExtractResult(i interface{}) string {
 }
 
 type UpdateRecord struct {
-	_      string `action:"update" entity:"record" awsAPI:"route53"`
-	logger *logger.Logger
-	graph  cloud.GraphAPI
-	api    route53iface.Route53API
-	Zone   *string   `templateName:"zone"`
-	Name   *string   `templateName:"name"`
-	Type   *string   `templateName:"type"`
-	Values []*string `templateName:"values"`
-	Ttl    *int64    `templateName:"ttl"`
+	_         string `action:"update" entity:"record" awsAPI:"route53"`
+	logger    *logger.Logger
+	graph     cloud.GraphAPI
+	api       route53iface.Route53API
+	Zone      *string   `templateName:"zone"`
+	Name      *string   `templateName:"name"`
+	Type      *string   `templateName:"type"`
+	Values    []*string `templateName:"values"`
+	Ttl       *int64    `templateName:"ttl"`
+	Alias     *string   `templateName:"alias"`
+	AliasZone *string   `templateName:"alias-zone"`
 }
 
 func (cmd *UpdateRecord) ParamsSpec() params.Spec {
-	builder := params.SpecBuilder(params.AllOf(params.Key("name"), params.Key("ttl"), params.Key("type"), params.OnlyOneOf(params.Key("values"), params.Key("value")), params.Key("zone")))
+	builder := params.SpecBuilder(params.AllOf(params.Key("name"), params.Key("type"), recordTargetRule(), params.Key("zone")))
 	builder.AddReducer(valueToValues, "value")
 	return builder.Done()
 }
 
 func (cmd *UpdateRecord) ManualRun(renv env.Running) (interface{}, error) {
+	alias, err := resolveAliasTarget(cmd.graph, cmd.Alias, cmd.AliasZone)
+	if err != nil {
+		return nil, err
+	}
 	start := time.Now()
-	output, err := changeResourceRecordSets(cmd.api, String("UPSERT"), cmd.Zone, cmd.Name, cmd.Type, cmd.Values, nil, cmd.Ttl)
+	output, err := changeResourceRecordSets(cmd.api, String("UPSERT"), cmd.Zone, cmd.Name, cmd.Type, cmd.Values, nil, cmd.Ttl, alias)
 	cmd.logger.ExtraVerbosef("route53.ChangeResourceRecordSets call took %s", time.Since(start))
 	return output, err
 }
@@ -93,21 +106,23 @@ func (cmd *UpdateRecord) ExtractResult(i interface{}) string {
 }
 
 type DeleteRecord struct {
-	_      string `action:"delete" entity:"record" awsAPI:"route53"`
-	logger *logger.Logger
-	graph  cloud.GraphAPI
-	api    route53iface.Route53API
-	Zone   *string   `templateName:"zone"`
-	Name   *string   `templateName:"name"`
-	Type   *string   `templateName:"type"`
-	Values []*string `templateName:"values"`
-	Ttl    *int64    `templateName:"ttl"`
+	_         string `action:"delete" entity:"record" awsAPI:"route53"`
+	logger    *logger.Logger
+	graph     cloud.GraphAPI
+	api       route53iface.Route53API
+	Zone      *string   `templateName:"zone"`
+	Name      *string   `templateName:"name"`
+	Type      *string   `templateName:"type"`
+	Values    []*string `templateName:"values"`
+	Ttl       *int64    `templateName:"ttl"`
+	Alias     *string   `templateName:"alias"`
+	AliasZone *string   `templateName:"alias-zone"`
 }
 
 func (cmd *DeleteRecord) ParamsSpec() params.Spec {
 	builder := params.SpecBuilder(
 		params.OnlyOneOf(
-			params.AllOf(params.Key("name"), params.Key("ttl"), params.Key("type"), params.OnlyOneOf(params.Key("values"), params.Key("value")), params.Key("zone")),
+			params.AllOf(params.Key("name"), params.Key("type"), recordTargetRule(), params.Key("zone")),
 			params.AllOf(params.Key("id")),
 		),
 	)
@@ -127,14 +142,21 @@ func (cmd *DeleteRecord) ParamsSpec() params.Spec {
 				if name, ok := r.Property(properties.Name); ok {
 					values["name"] = name
 				}
-				if ttl, ok := r.Property(properties.TTL); ok {
-					values["ttl"] = ttl
-				}
 				if t, ok := r.Property(properties.Type); ok {
 					values["type"] = t
 				}
-				if rec, ok := r.Property(properties.Records); ok {
-					values["values"] = rec
+				if alias, ok := r.Property(properties.Alias); ok {
+					values["alias"] = alias
+					if zone, ok := r.Property(properties.AliasZone); ok {
+						values["alias-zone"] = zone
+					}
+				} else {
+					if ttl, ok := r.Property(properties.TTL); ok {
+						values["ttl"] = ttl
+					}
+					if rec, ok := r.Property(properties.Records); ok {
+						values["values"] = rec
+					}
 				}
 				parents, err := cmd.graph.ResourceRelations(r, rdf.ParentOf, false)
 				if err != nil {
@@ -153,8 +175,22 @@ func (cmd *DeleteRecord) ParamsSpec() params.Spec {
 }
 
 func (cmd *DeleteRecord) ManualRun(renv env.Running) (interface{}, error) {
+	alias, err := resolveAliasTarget(cmd.graph, cmd.Alias, cmd.AliasZone)
+	if err != nil {
+		return nil, err
+	}
+	if alias != nil {
+		// a deletion must give the alias target as currently set, health evaluation included
+		current, err := currentAliasTarget(cmd.api, cmd.Zone, cmd.Name, cmd.Type)
+		if err != nil {
+			return nil, err
+		}
+		if current != nil {
+			alias = current
+		}
+	}
 	start := time.Now()
-	output, err := changeResourceRecordSets(cmd.api, String("DELETE"), cmd.Zone, cmd.Name, cmd.Type, cmd.Values, nil, cmd.Ttl)
+	output, err := changeResourceRecordSets(cmd.api, String("DELETE"), cmd.Zone, cmd.Name, cmd.Type, cmd.Values, nil, cmd.Ttl, alias)
 	cmd.logger.ExtraVerbosef("route53.ChangeResourceRecordSets call took %s", time.Since(start))
 	return output, err
 }
@@ -163,7 +199,7 @@ func (cmd *DeleteRecord) ExtractResult(i interface{}) string {
 	return StringValue(i.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo.Id)
 }
 
-func changeResourceRecordSets(api route53iface.Route53API, action, zone, name, recordType *string, values []*string, comment *string, ttl *int64) (*route53.ChangeResourceRecordSetsOutput, error) {
+func changeResourceRecordSets(api route53iface.Route53API, action, zone, name, recordType *string, values []*string, comment *string, ttl *int64, alias *route53.AliasTarget) (*route53.ChangeResourceRecordSetsOutput, error) {
 	input := &route53.ChangeResourceRecordSetsInput{}
 	var err error
 	// Required params
@@ -182,7 +218,9 @@ func changeResourceRecordSets(api route53iface.Route53API, action, zone, name, r
 	if err = setFieldWithType(recordType, change, "ResourceRecordSet.Type", awsstr); err != nil {
 		return nil, err
 	}
-	if err = setFieldWithType(ttl, change, "ResourceRecordSet.TTL", awsint64); err != nil {
+	if alias != nil {
+		change.ResourceRecordSet.AliasTarget = alias
+	} else if err = setFieldWithType(ttl, change, "ResourceRecordSet.TTL", awsint64); err != nil {
 		return nil, err
 	}
 	for _, value := range values {
@@ -210,3 +248,85 @@ func valueToValues(values map[string]interface{}) (map[string]interface{}, error
 		return nil, nil
 	}
 }
+
+func currentAliasTarget(api route53iface.Route53API, zone, name, recordType *string) (*route53.AliasTarget, error) {
+	out, err := api.ListResourceRecordSets(&route53.ListResourceRecordSetsInput{
+		HostedZoneId:    zone,
+		StartRecordName: name,
+		StartRecordType: recordType,
+		MaxItems:        String("1"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, set := range out.ResourceRecordSets {
+		if normalizeAliasDNS(StringValue(set.Name)) == normalizeAliasDNS(StringValue(name)) && StringValue(set.Type) == StringValue(recordType) {
+			return set.AliasTarget, nil
+		}
+	}
+	return nil, nil
+}
+
+// records either have values and a ttl, or are aliases to another AWS resource
+func recordTargetRule() params.Rule {
+	return params.OnlyOneOf(
+		params.AllOf(params.OnlyOneOf(params.Key("values"), params.Key("value")), params.Key("ttl")),
+		params.AllOf(params.Key("alias"), params.Opt("alias-zone")),
+	)
+}
+
+// hosted zone of the CloudFront distributions, as alias target
+const cloudfrontHostedZone = "Z2FDTNDATAQYW2"
+
+// resolveAliasTarget returns the alias target of a record: the DNS name given with its alias zone, a CloudFront domain,
+// or a load balancer (name, arn or DNS name) or CloudFront distribution (id or domain) of the local graph
+func resolveAliasTarget(g cloud.GraphAPI, alias, aliasZone *string) (*route53.AliasTarget, error) {
+	if alias == nil {
+		return nil, nil
+	}
+	name := normalizeAliasDNS(StringValue(alias))
+	target := &route53.AliasTarget{DNSName: alias, EvaluateTargetHealth: Bool(false)}
+	switch {
+	case aliasZone != nil:
+		target.HostedZoneId = aliasZone
+		return target, nil
+	case strings.HasSuffix(name, ".cloudfront.net"):
+		target.HostedZoneId = String(cloudfrontHostedZone)
+		return target, nil
+	}
+
+	if g == nil {
+		return nil, fmt.Errorf("alias '%s': no local graph to resolve it, give its hosted zone with alias-zone", StringValue(alias))
+	}
+	resources, err := g.Find(cloud.NewQuery(cloud.LoadBalancer, cloud.ClassicLoadBalancer, cloud.Distribution))
+	if err != nil {
+		return nil, err
+	}
+	for _, res := range resources {
+		dns, _ := res.Property(properties.PublicDNS)
+		arn, _ := res.Property(properties.Arn)
+		resName, _ := res.Property(properties.Name)
+		if res.Id() != StringValue(alias) && arn != StringValue(alias) && normalizeAliasDNS(fmt.Sprint(dns)) != name &&
+			(res.Type() != cloud.LoadBalancer || resName != StringValue(alias)) {
+			continue
+		}
+		target.DNSName = String(normalizeAliasDNS(fmt.Sprint(dns)))
+		switch res.Type() {
+		case cloud.Distribution:
+			target.HostedZoneId = String(cloudfrontHostedZone)
+		default:
+			zone, ok := res.Property(properties.Zone)
+			if !ok {
+				return nil, fmt.Errorf("alias '%s': no hosted zone for loadbalancer %s in local graph", StringValue(alias), res.Id())
+			}
+			target.HostedZoneId = String(fmt.Sprint(zone))
+			target.EvaluateTargetHealth = Bool(true)
+		}
+		return target, nil
+	}
+	return nil, fmt.Errorf("alias '%s': no loadbalancer or distribution found in local graph (run `awless sync` or give its hosted zone with alias-zone)", StringValue(alias))
+}
+
+func normalizeAliasDNS(name string) string {
+	return strings.TrimPrefix(strings.TrimSuffix(strings.ToLower(name), "."), "dualstack.")
+}
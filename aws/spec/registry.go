@@ -75,10 +75,13 @@ func (cmd *AuthenticateRegistry) ManualRun(renv env.Running) (interface{}, error
 		if len(credentials) != 2 {
 			return nil, fmt.Errorf("invalid authorization token: expect user:password, got %s", decoded)
 		}
-		torun := []string{"docker", "login", "--username", credentials[0], "--password", credentials[1], StringValue(auth.ProxyEndpoint)}
+		endpoint := StringValue(auth.ProxyEndpoint)
+		// the password is given on stdin, to keep it out of process listings and shell history
+		torun := []string{"docker", "login", "--username", credentials[0], "--password-stdin", endpoint}
 
 		if BoolValue(cmd.DisableDockerCmd) {
-			cmd.logger.Infof("Docker authentication command:\n%s", strings.Join(torun, " "))
+			renv.Log().Infof("Docker authentication command, reading the password on stdin:\n%s", strings.Join(torun, " "))
+			renv.Log().Infof("Password of registry %s:\n%s", endpoint, credentials[1])
 		} else {
 			confirm := !(BoolValue(cmd.NoConfirm))
 			if confirm {
@@ -93,6 +96,7 @@ func (cmd *AuthenticateRegistry) ManualRun(renv env.Running) (interface{}, error
 				}
 			}
 			dockerCmd := exec.Command("docker", torun[1:]...)
+			dockerCmd.Stdin = strings.NewReader(credentials[1])
 			out, err := dockerCmd.Output()
 			if err != nil {
 				if e, ok := err.(*exec.ExitError); ok {
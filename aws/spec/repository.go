@@ -55,3 +55,20 @@ func (cmd *DeleteRepository) ParamsSpec() params.Spec {
 		params.Opt("account", "force"),
 	))
 }
+
+type UpdateRepository struct {
+	_          string `action:"update" entity:"repository" awsAPI:"ecr" awsCall:"SetRepositoryPolicy" awsInput:"ecr.SetRepositoryPolicyInput" awsOutput:"ecr.SetRepositoryPolicyOutput"`
+	logger     *logger.Logger
+	graph      cloud.GraphAPI
+	api        ecriface.ECRAPI
+	Name       *string `awsName:"RepositoryName" awsType:"awsstr" templateName:"name"`
+	PolicyFile *string `awsName:"PolicyText" awsType:"awsfiletostring" templateName:"policy-file"`
+	Force      *bool   `awsName:"Force" awsType:"awsbool" templateName:"force"`
+	Account    *string `awsName:"RegistryId" awsType:"awsstr" templateName:"account"`
+}
+
+func (cmd *UpdateRepository) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Key("policy-file"),
+		params.Opt("account", "force"),
+	))
+}
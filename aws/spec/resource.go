@@ -0,0 +1,84 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateResource struct {
+	_       string `action:"create" entity:"resource" awsAPI:"apigateway" awsCall:"CreateResource" awsInput:"apigateway.CreateResourceInput" awsOutput:"apigateway.Resource"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     apigatewayiface.APIGatewayAPI
+	Restapi *string `awsName:"RestApiId" awsType:"awsstr" templateName:"restapi"`
+	Parent  *string `awsName:"ParentId" awsType:"awsstr" templateName:"parent"`
+	Path    *string `awsName:"PathPart" awsType:"awsstr" templateName:"path"`
+}
+
+func (cmd *CreateResource) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("path"), params.Key("restapi"), params.Opt(params.Suggested("parent"))))
+}
+
+// BeforeRun creates the resource under the root resource '/' of the API when no parent is given
+func (cmd *CreateResource) BeforeRun(renv env.Running) error {
+	if cmd.Parent != nil {
+		return nil
+	}
+	input := &apigateway.GetResourcesInput{RestApiId: cmd.Restapi}
+	for {
+		out, err := cmd.api.GetResources(input)
+		if err != nil {
+			return err
+		}
+		for _, res := range out.Items {
+			if awssdk.StringValue(res.Path) == "/" {
+				cmd.Parent = res.Id
+				return nil
+			}
+		}
+		if out.Position == nil {
+			break
+		}
+		input.Position = out.Position
+	}
+	return fmt.Errorf("root resource of rest api '%s' not found", StringValue(cmd.Restapi))
+}
+
+func (cmd *CreateResource) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*apigateway.Resource).Id)
+}
+
+type DeleteResource struct {
+	_       string `action:"delete" entity:"resource" awsAPI:"apigateway" awsCall:"DeleteResource" awsInput:"apigateway.DeleteResourceInput" awsOutput:"apigateway.DeleteResourceOutput"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     apigatewayiface.APIGatewayAPI
+	Id      *string `awsName:"ResourceId" awsType:"awsstr" templateName:"id"`
+	Restapi *string `awsName:"RestApiId" awsType:"awsstr" templateName:"restapi"`
+}
+
+func (cmd *DeleteResource) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"), params.Key("restapi")))
+}
@@ -0,0 +1,54 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateRestapi struct {
+	_           string `action:"create" entity:"restapi" awsAPI:"apigateway" awsCall:"CreateRestApi" awsInput:"apigateway.CreateRestApiInput" awsOutput:"apigateway.RestApi"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         apigatewayiface.APIGatewayAPI
+	Name        *string `awsName:"Name" awsType:"awsstr" templateName:"name"`
+	Description *string `awsName:"Description" awsType:"awsstr" templateName:"description"`
+}
+
+func (cmd *CreateRestapi) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Opt("description")))
+}
+
+func (cmd *CreateRestapi) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*apigateway.RestApi).Id)
+}
+
+type DeleteRestapi struct {
+	_      string `action:"delete" entity:"restapi" awsAPI:"apigateway" awsCall:"DeleteRestApi" awsInput:"apigateway.DeleteRestApiInput" awsOutput:"apigateway.DeleteRestApiOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    apigatewayiface.APIGatewayAPI
+	Id     *string `awsName:"RestApiId" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *DeleteRestapi) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
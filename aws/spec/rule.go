@@ -0,0 +1,71 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents/cloudwatcheventsiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateRule struct {
+	_           string `action:"create" entity:"rule" awsAPI:"cloudwatchevents" awsCall:"PutRule" awsInput:"cloudwatchevents.PutRuleInput" awsOutput:"cloudwatchevents.PutRuleOutput"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         cloudwatcheventsiface.CloudWatchEventsAPI
+	Name        *string `awsName:"Name" awsType:"awsstr" templateName:"name"`
+	Schedule    *string `awsName:"ScheduleExpression" awsType:"awsstr" templateName:"schedule"`
+	Pattern     *string `awsName:"EventPattern" awsType:"awsstr" templateName:"pattern"`
+	Description *string `awsName:"Description" awsType:"awsstr" templateName:"description"`
+	Role        *string `awsName:"RoleArn" awsType:"awsstr" templateName:"role"`
+	Enabled     *bool   `templateName:"enabled"`
+	State       *string `awsName:"State" awsType:"awsstr"`
+}
+
+func (cmd *CreateRule) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"),
+		params.OnlyOneOf(params.Key("schedule"), params.Key("pattern")),
+		params.Opt("description", "enabled", "role"),
+	))
+}
+
+// BeforeRun sets the state of the rule, enabled unless told otherwise
+func (cmd *CreateRule) BeforeRun(renv env.Running) error {
+	if cmd.Enabled != nil && !BoolValue(cmd.Enabled) {
+		cmd.State = String("DISABLED")
+	} else {
+		cmd.State = String("ENABLED")
+	}
+	return nil
+}
+
+func (cmd *CreateRule) ExtractResult(i interface{}) string {
+	return StringValue(cmd.Name)
+}
+
+type DeleteRule struct {
+	_      string `action:"delete" entity:"rule" awsAPI:"cloudwatchevents" awsCall:"DeleteRule" awsInput:"cloudwatchevents.DeleteRuleInput" awsOutput:"cloudwatchevents.DeleteRuleOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    cloudwatcheventsiface.CloudWatchEventsAPI
+	Name   *string `awsName:"Name" awsType:"awsstr" templateName:"name"`
+}
+
+func (cmd *DeleteRule) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name")))
+}
@@ -16,9 +16,16 @@ limitations under the License.
 package awsspec
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"mime"
 	"os"
+	"path"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/template/env"
@@ -27,50 +34,216 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/mitchellh/ioprogress"
 	"github.com/wallix/awless/logger"
+	"golang.org/x/crypto/ssh/terminal"
 )
 
+// CreateS3object uploads a file, or the files of a directory recursively, their keys being their
+// paths relative to the directory. With sync, the files of a directory are only uploaded when
+// missing from the bucket, of a different size or more recent than their object
 type CreateS3object struct {
-	_      string `action:"create" entity:"s3object" awsAPI:"s3"`
-	logger *logger.Logger
-	graph  cloud.GraphAPI
-	api    s3iface.S3API
-	Bucket *string `awsName:"Bucket" awsType:"awsstr" templateName:"bucket"`
-	File   *string `awsName:"Body" awsType:"awsstr" templateName:"file"`
-	Name   *string `awsName:"Key" awsType:"awsstr" templateName:"name"`
-	Acl    *string `awsName:"ACL" awsType:"awsstr" templateName:"acl"`
+	_           string `action:"create" entity:"s3object" awsAPI:"s3"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         s3iface.S3API
+	Bucket      *string `awsName:"Bucket" awsType:"awsstr" templateName:"bucket"`
+	File        *string `awsName:"Body" awsType:"awsstr" templateName:"file"`
+	Name        *string `awsName:"Key" awsType:"awsstr" templateName:"name"`
+	Acl         *string `awsName:"ACL" awsType:"awsstr" templateName:"acl"`
+	Bwlimit     *string `templateName:"bwlimit"`
+	Connections *int64  `templateName:"connections"`
+	Sync        *bool   `templateName:"sync"`
 }
 
 func (cmd *CreateS3object) ParamsSpec() params.Spec {
 	return params.NewSpec(
-		params.AllOf(params.Key("bucket"), params.Key("file"), params.Opt("acl", "name")),
-		params.Validators{"file": params.IsFilepath},
+		params.AllOf(params.Key("bucket"), params.Key("file"), params.Opt("acl", "bwlimit", "connections", "name", "sync")),
+		params.Validators{
+			"file":    isFileOrDirectory,
+			"bwlimit": isBandwidth,
+			"sync": func(i interface{}, others map[string]interface{}) error {
+				if stat, err := os.Stat(fmt.Sprint(others["file"])); err == nil && !stat.IsDir() {
+					return errors.New("only applicable when uploading a directory")
+				}
+				return nil
+			},
+		},
 	)
 }
 
-func (cmd *CreateS3object) ManualRun(env.Running) (interface{}, error) {
-	input := &s3.PutObjectInput{}
+// transferLimits returns the limits set on the statement, the default ones otherwise
+func (cmd *CreateS3object) transferLimits() (TransferLimits, error) {
+	limits := DefaultTransferLimits
+	if cmd.Bwlimit != nil {
+		bandwidth, err := ParseBandwidth(StringValue(cmd.Bwlimit))
+		if err != nil {
+			return limits, err
+		}
+		limits.Bandwidth = bandwidth
+	}
+	if cmd.Connections != nil {
+		limits.Connections = Int64AsIntValue(cmd.Connections)
+	}
+	return limits, nil
+}
 
-	f, err := os.Open(StringValue(cmd.File))
+func (cmd *CreateS3object) ManualRun(env.Running) (interface{}, error) {
+	limits, err := cmd.transferLimits()
 	if err != nil {
 		return nil, err
 	}
-	defer f.Close()
+	if limits.Bandwidth > 0 {
+		cmd.logger.ExtraVerbosef("limiting upload bandwidth to %d bytes/s", limits.Bandwidth)
+	}
 
-	progressR, err := ProgressBarFactory(f)
+	path := StringValue(cmd.File)
+	stat, err := os.Stat(path)
 	if err != nil {
 		return nil, err
 	}
-	input.Body = progressR
+	if stat.IsDir() {
+		return cmd.uploadDirectory(path, limits)
+	}
 
 	var fileName string
 	if n := StringValue(cmd.Name); n != "" {
 		fileName = n
 	} else {
-		_, fileName = filepath.Split(f.Name())
+		_, fileName = filepath.Split(path)
+	}
+	cmd.logger.Infof("uploading '%s'", fileName)
+	if err = cmd.uploadFile(path, fileName, limits); err != nil {
+		return nil, err
+	}
+	return fileName, nil
+}
+
+func (cmd *CreateS3object) ExtractResult(i interface{}) string {
+	return i.(string)
+}
+
+func (cmd *CreateS3object) IAMActions(params map[string]interface{}) []string {
+	if v, ok := params["sync"]; ok && fmt.Sprint(v) == "true" {
+		return []string{"s3:ListBucket", "s3:PutObject"}
+	}
+	return []string{"s3:PutObject"}
+}
+
+// uploadDirectory uploads the files of the directory under the prefix given as name, if any,
+// returning the prefix
+func (cmd *CreateS3object) uploadDirectory(dir string, limits TransferLimits) (string, error) {
+	prefix := StringValue(cmd.Name)
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
 	}
-	input.Key = aws.String(fileName)
+
+	var files []localS3object
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, localS3object{path: path, key: prefix + filepath.ToSlash(rel), size: info.Size(), modified: info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if BoolValue(cmd.Sync) {
+		remote, err := cmd.listObjects(prefix)
+		if err != nil {
+			return "", err
+		}
+		var changed []localS3object
+		for _, f := range files {
+			if obj, ok := remote[f.key]; ok && f.isUpToDate(obj) {
+				cmd.logger.ExtraVerbosef("'%s' up to date in bucket", f.key)
+				continue
+			}
+			changed = append(changed, f)
+		}
+		if skipped := len(files) - len(changed); skipped > 0 {
+			cmd.logger.Infof("%d file(s) of '%s' up to date in bucket %s", skipped, dir, StringValue(cmd.Bucket))
+		}
+		files = changed
+	}
+
+	var total, uploaded int64
+	for _, f := range files {
+		total += f.size
+	}
+	start := time.Now()
+	for i, f := range files {
+		cmd.logger.Infof("uploading '%s' (file %d/%d, %s)", f.key, i+1, len(files), ioprogress.DrawTextFormatBytes(uploaded, total))
+		if err := cmd.uploadFile(f.path, f.key, limits); err != nil {
+			return "", fmt.Errorf("%s: %s", f.key, err)
+		}
+		uploaded += f.size
+	}
+	if len(files) > 0 {
+		cmd.logger.Infof("uploaded %d file(s) (%d bytes) to bucket %s in %s", len(files), total, StringValue(cmd.Bucket), time.Since(start).Round(time.Second))
+	}
+	return prefix, nil
+}
+
+func (cmd *CreateS3object) listObjects(prefix string) (map[string]*s3.Object, error) {
+	objects := make(map[string]*s3.Object)
+	input := &s3.ListObjectsV2Input{Bucket: cmd.Bucket}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	for {
+		out, err := cmd.api.ListObjectsV2(input)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range out.Contents {
+			objects[StringValue(obj.Key)] = obj
+		}
+		if !aws.BoolValue(out.IsTruncated) {
+			return objects, nil
+		}
+		input.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+// uploadFile uploads the file in one request, or in parts sent concurrently when allowed
+// by the limits and larger than the minimum part size
+func (cmd *CreateS3object) uploadFile(path, key string, limits TransferLimits) error {
+	input := &s3.PutObjectInput{}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	finfo, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	multipart := limits.Connections > 1 && finfo.Size() > s3manager.MinUploadPartSize
+
+	progressR, err := ProgressBarFactory(f)
+	if err != nil {
+		return err
+	}
+	if multipart { // parts are read once, to be sent concurrently
+		progressR.readOnce = true
+		input.Body = newThrottledReadSeeker(progressR, limits.Bandwidth, 0)
+	} else { // the SDK reads the body once in memory before the upload
+		input.Body = newThrottledReadSeeker(progressR, limits.Bandwidth, finfo.Size())
+	}
+	input.Key = aws.String(key)
 
 	fileExt := filepath.Ext(f.Name())
 	if mimeType := mime.TypeByExtension(fileExt); mimeType != "" {
@@ -79,26 +252,48 @@ func (cmd *CreateS3object) ManualRun(env.Running) (interface{}, error) {
 	}
 
 	if err = setFieldWithType(cmd.Bucket, input, "Bucket", awsstr); err != nil {
-		return nil, err
+		return err
 	}
 
 	if v := cmd.Acl; v != nil {
 		if err = setFieldWithType(v, input, "ACL", awsstr); err != nil {
-			return nil, err
+			return err
 		}
 	}
 
-	cmd.logger.Infof("uploading '%s'", fileName)
-
-	if _, err = cmd.api.PutObject(input); err != nil {
-		return nil, err
+	if multipart {
+		cmd.logger.ExtraVerbosef("uploading '%s' in parts over %d connections", key, limits.Connections)
+		uploader := s3manager.NewUploaderWithClient(cmd.api, func(u *s3manager.Uploader) {
+			u.Concurrency = limits.Connections
+		})
+		_, err = uploader.Upload(&s3manager.UploadInput{
+			Bucket: input.Bucket, Key: input.Key, ACL: input.ACL, ContentType: input.ContentType, Body: input.Body,
+		})
+		return err
 	}
 
-	return fileName, nil
+	_, err = cmd.api.PutObject(input)
+	return err
 }
 
-func (cmd *CreateS3object) ExtractResult(i interface{}) string {
-	return i.(string)
+type localS3object struct {
+	path, key string
+	size      int64
+	modified  time.Time
+}
+
+// isUpToDate tells if the object, of the same size, was uploaded after the last modification of the file
+func (f localS3object) isUpToDate(obj *s3.Object) bool {
+	return aws.Int64Value(obj.Size) == f.size && !aws.TimeValue(obj.LastModified).Before(f.modified)
+}
+
+func isFileOrDirectory(i interface{}, others map[string]interface{}) error {
+	if _, err := os.Stat(fmt.Sprint(i)); os.IsNotExist(err) {
+		return fmt.Errorf("cannot find file or directory '%s'", i)
+	} else if err != nil {
+		return err
+	}
+	return nil
 }
 
 type UpdateS3object struct {
@@ -131,9 +326,95 @@ func (cmd *DeleteS3object) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("bucket"), params.Key("name")))
 }
 
+// DownloadS3object downloads an object to a local file, by default named as the object
+// in the current directory
+type DownloadS3object struct {
+	_       string `action:"download" entity:"s3object" awsAPI:"s3"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     s3iface.S3API
+	Bucket  *string `templateName:"bucket"`
+	Name    *string `templateName:"name"`
+	File    *string `templateName:"file"`
+	Version *string `templateName:"version"`
+}
+
+func (cmd *DownloadS3object) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("bucket"), params.Key("name"),
+		params.Opt("file", "version"),
+	))
+}
+
+func (cmd *DownloadS3object) ManualRun(env.Running) (interface{}, error) {
+	_, objectName := path.Split(StringValue(cmd.Name))
+	file := StringValue(cmd.File)
+	if file == "" {
+		file = objectName
+	} else if stat, err := os.Stat(file); err == nil && stat.IsDir() {
+		file = filepath.Join(file, objectName)
+	}
+	if file == "" {
+		return nil, fmt.Errorf("cannot name the local file of '%s': missing file param", StringValue(cmd.Name))
+	}
+
+	start := time.Now()
+	out, err := cmd.api.GetObject(&s3.GetObjectInput{Bucket: cmd.Bucket, Key: cmd.Name, VersionId: cmd.Version})
+	if err != nil {
+		return nil, err
+	}
+	defer out.Body.Close()
+	cmd.logger.ExtraVerbosef("s3.GetObject call took %s", time.Since(start))
+
+	f, err := os.Create(file)
+	if err != nil {
+		return nil, err
+	}
+	cmd.logger.Infof("downloading '%s' to '%s'", StringValue(cmd.Name), file)
+	body := io.Reader(out.Body)
+	if size := aws.Int64Value(out.ContentLength); size > 0 {
+		body = &ioprogress.Reader{
+			DrawFunc: ioprogress.DrawTerminalf(progressOutput(), ioprogress.DrawTextFormatBytes),
+			Reader:   out.Body,
+			Size:     size,
+		}
+	}
+	if _, err = io.Copy(f, body); err != nil {
+		f.Close()
+		os.Remove(file)
+		return nil, err
+	}
+	if err = f.Close(); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+func (cmd *DownloadS3object) ExtractResult(i interface{}) string {
+	return i.(string)
+}
+
+func (cmd *DownloadS3object) IAMActions(params map[string]interface{}) []string {
+	if _, ok := params["version"]; ok {
+		return []string{"s3:GetObjectVersion"}
+	}
+	return []string{"s3:GetObject"}
+}
+
+// progressOutput returns where to draw transfer progress bars: stderr when it is a terminal,
+// to keep stdout clean for piped outputs, and nowhere otherwise
+func progressOutput() io.Writer {
+	if terminal.IsTerminal(int(os.Stderr.Fd())) {
+		return os.Stderr
+	}
+	return ioutil.Discard
+}
+
 type ProgressReadSeeker struct {
 	file   *os.File
 	reader *ioprogress.Reader
+	// readOnce is set when the file is read once for its upload, rather than
+	// once in memory and a second time for the HTTP upload
+	readOnce bool
 }
 
 func NewProgressReader(f *os.File) (*ProgressReadSeeker, error) {
@@ -142,7 +423,11 @@ func NewProgressReader(f *os.File) (*ProgressReadSeeker, error) {
 		return nil, err
 	}
 
+	pr := &ProgressReadSeeker{file: f}
 	draw := func(progress, total int64) string {
+		if pr.readOnce {
+			return ioprogress.DrawTextFormatBytes(progress, total)
+		}
 		// &s3.PutObjectInput.Body will be read twice
 		// once in memory and a second time for the HTTP upload
 		// here we only display for the actual HTTP upload
@@ -152,13 +437,13 @@ func NewProgressReader(f *os.File) (*ProgressReadSeeker, error) {
 		return ""
 	}
 
-	reader := &ioprogress.Reader{
-		DrawFunc: ioprogress.DrawTerminalf(os.Stdout, draw),
+	pr.reader = &ioprogress.Reader{
+		DrawFunc: ioprogress.DrawTerminalf(progressOutput(), draw),
 		Reader:   f,
 		Size:     finfo.Size(),
 	}
 
-	return &ProgressReadSeeker{file: f, reader: reader}, nil
+	return pr, nil
 }
 
 func (pr *ProgressReadSeeker) Read(p []byte) (int, error) {
@@ -17,6 +17,7 @@ package awsspec
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/wallix/awless/cloud"
@@ -79,6 +80,44 @@ func (cmd *UpdateScalinggroup) ParamsSpec() params.Spec {
 	))
 }
 
+func (cmd *UpdateScalinggroup) PriorState(renv env.Running, params map[string]interface{}) (map[string]interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, err
+	}
+	out, err := cmd.api.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{AutoScalingGroupNames: []*string{cmd.Name}})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.AutoScalingGroups) == 0 {
+		return nil, fmt.Errorf("scalinggroup '%s' not found", StringValue(cmd.Name))
+	}
+	group := out.AutoScalingGroups[0]
+	prior := map[string]interface{}{
+		"cooldown":                 Int64AsIntValue(group.DefaultCooldown),
+		"desired-capacity":         Int64AsIntValue(group.DesiredCapacity),
+		"healthcheck-grace-period": Int64AsIntValue(group.HealthCheckGracePeriod),
+		"healthcheck-type":         StringValue(group.HealthCheckType),
+		"launchconfiguration":      StringValue(group.LaunchConfigurationName),
+		"max-size":                 Int64AsIntValue(group.MaxSize),
+		"min-size":                 Int64AsIntValue(group.MinSize),
+		"new-instances-protected":  BoolValue(group.NewInstancesProtectedFromScaleIn),
+	}
+	if zones := StringValue(group.VPCZoneIdentifier); zones != "" {
+		var subnets []interface{}
+		for _, s := range strings.Split(zones, ",") {
+			subnets = append(subnets, s)
+		}
+		prior["subnets"] = subnets
+	}
+	state := make(map[string]interface{})
+	for k := range params {
+		if v, ok := prior[k]; ok {
+			state[k] = v
+		}
+	}
+	return state, nil
+}
+
 type DeleteScalinggroup struct {
 	_      string `action:"delete" entity:"scalinggroup" awsAPI:"autoscaling" awsCall:"DeleteAutoScalingGroup" awsInput:"autoscaling.DeleteAutoScalingGroupInput" awsOutput:"autoscaling.DeleteAutoScalingGroupOutput"`
 	logger *logger.Logger
@@ -116,6 +155,7 @@ func (sg *CheckScalinggroup) ManualRun(renv env.Running) (interface{}, error) {
 	sgName := StringValue(sg.Name)
 
 	c := &checker{
+		renv:        renv,
 		description: fmt.Sprintf("scalinggroup '%s'", sgName),
 		timeout:     time.Duration(Int64AsIntValue(sg.Timeout)) * time.Second,
 		frequency:   5 * time.Second,
@@ -0,0 +1,86 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+)
+
+type GetScreenshot struct {
+	_        string `action:"get" entity:"screenshot" awsAPI:"ec2" awsDryRun:"manual"`
+	logger   *logger.Logger
+	graph    cloud.GraphAPI
+	api      ec2iface.EC2API
+	Instance *string `templateName:"instance"`
+	File     *string `templateName:"file"`
+	WakeUp   *bool   `templateName:"wake-up"`
+}
+
+func (cmd *GetScreenshot) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("instance"), params.Opt("file", "wake-up")))
+}
+
+func (cmd *GetScreenshot) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+	_, err := cmd.api.GetConsoleScreenshot(&ec2.GetConsoleScreenshotInput{InstanceId: cmd.Instance, DryRun: Bool(true)})
+	return nil, dryRunError(cmd.logger, "get screenshot", err)
+}
+
+// ManualRun writes the JPG screenshot of the instance console to the file,
+// named after the instance when not given
+func (cmd *GetScreenshot) ManualRun(renv env.Running) (interface{}, error) {
+	start := time.Now()
+	out, err := cmd.api.GetConsoleScreenshot(&ec2.GetConsoleScreenshotInput{InstanceId: cmd.Instance, WakeUp: cmd.WakeUp})
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+	cmd.logger.ExtraVerbosef("ec2.GetConsoleScreenshot call took %s", time.Since(start))
+	content, err := base64.StdEncoding.DecodeString(StringValue(out.ImageData))
+	if err != nil {
+		return nil, fmt.Errorf("decode screenshot: %s", err)
+	}
+	file := StringValue(cmd.File)
+	if file == "" {
+		file = StringValue(cmd.Instance) + ".jpg"
+	}
+	if err := ioutil.WriteFile(file, content, 0644); err != nil {
+		return nil, err
+	}
+	renv.Log().Infof("screenshot of instance %s written to '%s'", StringValue(cmd.Instance), file)
+	return file, nil
+}
+
+func (cmd *GetScreenshot) ExtractResult(i interface{}) string {
+	file, _ := i.(string)
+	return file
+}
+
+func (cmd *GetScreenshot) IAMActions(params map[string]interface{}) []string {
+	return []string{"ec2:GetConsoleScreenshot"}
+}
@@ -0,0 +1,77 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"github.com/wallix/awless/aws/secretsmanager/secretsmanageriface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateSecret struct {
+	_           string `action:"create" entity:"secret" awsAPI:"secretsmanager" awsCall:"CreateSecret" awsInput:"secretsmanager.CreateSecretInput" awsOutput:"secretsmanager.CreateSecretOutput"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         secretsmanageriface.SecretsManagerAPI
+	Name        *string `awsName:"Name" awsType:"awsstr" templateName:"name"`
+	Value       *string `awsName:"SecretString" awsType:"awsstr" templateName:"value"`
+	Description *string `awsName:"Description" awsType:"awsstr" templateName:"description"`
+	Key         *string `awsName:"KmsKeyId" awsType:"awsstr" templateName:"key"`
+}
+
+func (cmd *CreateSecret) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Key("value"),
+		params.Opt("description", "key"),
+	))
+}
+
+func (cmd *CreateSecret) ExtractResult(i interface{}) string {
+	return StringValue(cmd.Name)
+}
+
+type UpdateSecret struct {
+	_           string `action:"update" entity:"secret" awsAPI:"secretsmanager" awsCall:"UpdateSecret" awsInput:"secretsmanager.UpdateSecretInput" awsOutput:"secretsmanager.UpdateSecretOutput"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         secretsmanageriface.SecretsManagerAPI
+	Name        *string `awsName:"SecretId" awsType:"awsstr" templateName:"name"`
+	Value       *string `awsName:"SecretString" awsType:"awsstr" templateName:"value"`
+	Description *string `awsName:"Description" awsType:"awsstr" templateName:"description"`
+	Key         *string `awsName:"KmsKeyId" awsType:"awsstr" templateName:"key"`
+}
+
+func (cmd *UpdateSecret) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"),
+		params.AtLeastOneOf(params.Key("value"), params.Key("description"), params.Key("key")),
+	))
+}
+
+type DeleteSecret struct {
+	_              string `action:"delete" entity:"secret" awsAPI:"secretsmanager" awsCall:"DeleteSecret" awsInput:"secretsmanager.DeleteSecretInput" awsOutput:"secretsmanager.DeleteSecretOutput"`
+	logger         *logger.Logger
+	graph          cloud.GraphAPI
+	api            secretsmanageriface.SecretsManagerAPI
+	Name           *string `awsName:"SecretId" awsType:"awsstr" templateName:"name"`
+	Force          *bool   `awsName:"ForceDeleteWithoutRecovery" awsType:"awsbool" templateName:"force"`
+	RecoveryWindow *int64  `awsName:"RecoveryWindowInDays" awsType:"awsint64" templateName:"recovery-window"`
+}
+
+func (cmd *DeleteSecret) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"),
+		params.Opt("force", "recovery-window"),
+	))
+}
@@ -18,6 +18,7 @@ package awsspec
 import (
 	"errors"
 	"fmt"
+	"net"
 	"strconv"
 	"strings"
 	"time"
@@ -56,21 +57,37 @@ type UpdateSecuritygroup struct {
 	logger        *logger.Logger
 	graph         cloud.GraphAPI
 	api           ec2iface.EC2API
-	Id            *string `templateName:"id"`
-	Protocol      *string `templateName:"protocol"`
-	CIDR          *string `templateName:"cidr"`
-	Securitygroup *string `templateName:"securitygroup"`
-	Inbound       *string `templateName:"inbound"`
-	Outbound      *string `templateName:"outbound"`
-	Portrange     *string `templateName:"portrange"`
+	Id            *string   `templateName:"id"`
+	Protocol      *string   `templateName:"protocol"`
+	CIDR          *string   `templateName:"cidr"`
+	Securitygroup *string   `templateName:"securitygroup"`
+	Source        *string   `templateName:"source"`
+	Inbound       *string   `templateName:"inbound"`
+	Outbound      *string   `templateName:"outbound"`
+	Portrange     *string   `templateName:"portrange"`
+	InboundRules  []*string `templateName:"inbound-rules"`
+	OutboundRules []*string `templateName:"outbound-rules"`
 }
 
 func (cmd *UpdateSecuritygroup) ParamsSpec() params.Spec {
 	return params.NewSpec(
-		params.AllOf(params.Key("id"), params.Key("protocol"), params.OnlyOneOf(params.Key("inbound"), params.Key("outbound")),
-			params.Opt(params.Suggested("cidr", "portrange"), "securitygroup")),
+		params.AllOf(params.Key("id"), params.OnlyOneOf(
+			params.AllOf(params.Key("protocol"), params.OnlyOneOf(params.Key("inbound"), params.Key("outbound")),
+				params.Opt(params.Suggested("cidr", "portrange"), "securitygroup", "source")),
+			params.AtLeastOneOf(params.Key("inbound-rules"), params.Key("outbound-rules")),
+		)),
 		params.Validators{
-			"cidr":     params.IsCIDR,
+			"cidr":           params.IsCIDR,
+			"inbound-rules":  validateSecuritygroupRules,
+			"outbound-rules": validateSecuritygroupRules,
+			"source": func(source interface{}, others map[string]interface{}) error {
+				_, hasCIDR := others["cidr"]
+				_, hasSecgroup := others["securitygroup"]
+				if hasCIDR || hasSecgroup {
+					return errors.New("'source' cannot be used with 'cidr' or 'securitygroup'")
+				}
+				return nil
+			},
 			"inbound":  params.IsInEnumIgnoreCase("authorize", "revoke"),
 			"outbound": params.IsInEnumIgnoreCase("authorize", "revoke"),
 			// Fail fast when protocol is TCP/UDP and port range is missing, instead of waiting
@@ -86,10 +103,13 @@ func (cmd *UpdateSecuritygroup) ParamsSpec() params.Spec {
 		})
 }
 
-func (cmd *UpdateSecuritygroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateSecuritygroup) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
+	if cmd.hasRuleSets() {
+		return nil, cmd.dryRunRuleSets()
+	}
 	ipPerms, err := cmd.buildIpPermissions()
 	if err != nil {
 		return nil, err
@@ -137,6 +157,9 @@ func (cmd *UpdateSecuritygroup) dryRun(renv env.Running, params map[string]inter
 }
 
 func (cmd *UpdateSecuritygroup) ManualRun(renv env.Running) (interface{}, error) {
+	if cmd.hasRuleSets() {
+		return nil, cmd.updateRuleSets(renv)
+	}
 	ipPerms, err := cmd.buildIpPermissions()
 	if err != nil {
 		return nil, err
@@ -192,6 +215,141 @@ func (cmd *UpdateSecuritygroup) ManualRun(renv env.Running) (interface{}, error)
 	return output, err
 }
 
+func (cmd *UpdateSecuritygroup) IAMActions(params map[string]interface{}) []string {
+	var actions []string
+	if _, ok := params["inbound-rules"]; ok {
+		actions = append(actions, "ec2:AuthorizeSecurityGroupIngress", "ec2:RevokeSecurityGroupIngress")
+	}
+	if _, ok := params["outbound-rules"]; ok {
+		actions = append(actions, "ec2:AuthorizeSecurityGroupEgress", "ec2:RevokeSecurityGroupEgress")
+	}
+	if len(actions) > 0 {
+		return append(actions, "ec2:DescribeSecurityGroups")
+	}
+	action := "Authorize"
+	if fmt.Sprint(params["inbound"]) == "revoke" || fmt.Sprint(params["outbound"]) == "revoke" {
+		action = "Revoke"
+	}
+	if _, ok := params["outbound"]; ok {
+		return []string{fmt.Sprintf("ec2:%sSecurityGroupEgress", action)}
+	}
+	return []string{fmt.Sprintf("ec2:%sSecurityGroupIngress", action)}
+}
+
+// PriorState returns the rules of the security group before an update with full rule sets,
+// so that these rules can be set back on revert
+func (cmd *UpdateSecuritygroup) PriorState(renv env.Running, params map[string]interface{}) (map[string]interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, err
+	}
+	if !cmd.hasRuleSets() {
+		return nil, nil
+	}
+	group, err := cmd.describe()
+	if err != nil {
+		return nil, err
+	}
+	prior := make(map[string]interface{})
+	if cmd.InboundRules != nil {
+		prior["inbound-rules"] = securitygroupRules(group.IpPermissions)
+	}
+	if cmd.OutboundRules != nil {
+		prior["outbound-rules"] = securitygroupRules(group.IpPermissionsEgress)
+	}
+	return prior, nil
+}
+
+func (cmd *UpdateSecuritygroup) hasRuleSets() bool {
+	return cmd.InboundRules != nil || cmd.OutboundRules != nil
+}
+
+func (cmd *UpdateSecuritygroup) dryRunRuleSets() error {
+	for _, rules := range [][]*string{cmd.InboundRules, cmd.OutboundRules} {
+		if _, err := parseSecuritygroupRules(awssdk.StringValueSlice(rules)); err != nil {
+			return err
+		}
+	}
+	_, err := cmd.api.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{DryRun: Bool(true), GroupIds: []*string{cmd.Id}})
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			cmd.logger.Verbose("dry run: update securitygroup ok")
+			return nil
+		}
+	}
+	return fmt.Errorf("dry run: update securitygroup: %s", err)
+}
+
+// updateRuleSets authorizes the given rules missing from the security group
+// and revokes its rules that are not given, the rules being authorized first
+// so that the traffic allowed by both the current and given rules is never blocked
+func (cmd *UpdateSecuritygroup) updateRuleSets(renv env.Running) error {
+	group, err := cmd.describe()
+	if err != nil {
+		return err
+	}
+	if cmd.InboundRules != nil {
+		desired, err := parseSecuritygroupRules(awssdk.StringValueSlice(cmd.InboundRules))
+		if err != nil {
+			return err
+		}
+		authorize, revoke := ipPermissionsDelta(group.IpPermissions, desired)
+		renv.Log().Verbosef("securitygroup %s: %d inbound rule(s) to authorize, %d to revoke", StringValue(cmd.Id), len(authorize), len(revoke))
+		if len(authorize) > 0 {
+			start := time.Now()
+			_, err = cmd.api.AuthorizeSecurityGroupIngress(&ec2.AuthorizeSecurityGroupIngressInput{GroupId: cmd.Id, IpPermissions: authorize})
+			cmd.logger.ExtraVerbosef("ec2.AuthorizeSecurityGroupIngress call took %s", time.Since(start))
+			if err != nil {
+				return err
+			}
+		}
+		if len(revoke) > 0 {
+			start := time.Now()
+			_, err = cmd.api.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{GroupId: cmd.Id, IpPermissions: revoke})
+			cmd.logger.ExtraVerbosef("ec2.RevokeSecurityGroupIngress call took %s", time.Since(start))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if cmd.OutboundRules != nil {
+		desired, err := parseSecuritygroupRules(awssdk.StringValueSlice(cmd.OutboundRules))
+		if err != nil {
+			return err
+		}
+		authorize, revoke := ipPermissionsDelta(group.IpPermissionsEgress, desired)
+		renv.Log().Verbosef("securitygroup %s: %d outbound rule(s) to authorize, %d to revoke", StringValue(cmd.Id), len(authorize), len(revoke))
+		if len(authorize) > 0 {
+			start := time.Now()
+			_, err = cmd.api.AuthorizeSecurityGroupEgress(&ec2.AuthorizeSecurityGroupEgressInput{GroupId: cmd.Id, IpPermissions: authorize})
+			cmd.logger.ExtraVerbosef("ec2.AuthorizeSecurityGroupEgress call took %s", time.Since(start))
+			if err != nil {
+				return err
+			}
+		}
+		if len(revoke) > 0 {
+			start := time.Now()
+			_, err = cmd.api.RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{GroupId: cmd.Id, IpPermissions: revoke})
+			cmd.logger.ExtraVerbosef("ec2.RevokeSecurityGroupEgress call took %s", time.Since(start))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (cmd *UpdateSecuritygroup) describe() (*ec2.SecurityGroup, error) {
+	out, err := cmd.api.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{GroupIds: []*string{cmd.Id}})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.SecurityGroups) == 0 {
+		return nil, fmt.Errorf("securitygroup %s not found", StringValue(cmd.Id))
+	}
+	return out.SecurityGroups[0], nil
+}
+
 type DeleteSecuritygroup struct {
 	_      string `action:"delete" entity:"securitygroup" awsAPI:"ec2" awsCall:"DeleteSecurityGroup" awsInput:"ec2.DeleteSecurityGroupInput" awsOutput:"ec2.DeleteSecurityGroupOutput" awsDryRun:""`
 	logger *logger.Logger
@@ -230,6 +388,7 @@ func (cmd *CheckSecuritygroup) ManualRun(renv env.Running) (interface{}, error)
 	}
 
 	c := &checker{
+		renv:        renv,
 		description: fmt.Sprintf("securitygroup %s", StringValue(cmd.Id)),
 		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
 		frequency:   5 * time.Second,
@@ -322,24 +481,51 @@ func (cmd *DetachSecuritygroup) ManualRun(renv env.Running) (interface{}, error)
 
 func (cmd *UpdateSecuritygroup) buildIpPermissions() ([]*ec2.IpPermission, error) {
 	ipPerm := &ec2.IpPermission{}
-	if cidr := cmd.CIDR; cidr != nil {
+	if cidr := cmd.CIDR; cidr != nil && isIPv6CIDR(StringValue(cidr)) {
+		ipPerm.Ipv6Ranges = []*ec2.Ipv6Range{{CidrIpv6: cidr}}
+	} else if cidr != nil {
 		ipPerm.IpRanges = []*ec2.IpRange{{CidrIp: cidr}}
 	} else if secgroup := cmd.Securitygroup; secgroup != nil {
 		ipPerm.UserIdGroupPairs = []*ec2.UserIdGroupPair{{GroupId: secgroup}}
+	} else if source := cmd.Source; source != nil {
+		if err := setIpPermissionSource(ipPerm, StringValue(source)); err != nil {
+			return nil, err
+		}
 	} else {
-		return nil, errors.New("missing either 'cidr' or 'securitygroup' parameter")
+		return nil, errors.New("missing either 'cidr', 'securitygroup' or 'source' parameter")
+	}
+
+	if err := setIpPermissionPorts(ipPerm, StringValue(cmd.Protocol), cmd.Portrange); err != nil {
+		return nil, err
 	}
+	return []*ec2.IpPermission{ipPerm}, nil
+}
 
-	p := StringValue(cmd.Protocol)
+// setIpPermissionSource sets the source of the permission: either a CIDR or a security
+// group id, the sg:STACK/NAME references being resolved to ids when compiling the template
+func setIpPermissionSource(ipPerm *ec2.IpPermission, source string) error {
+	if _, _, err := net.ParseCIDR(source); err == nil && isIPv6CIDR(source) {
+		ipPerm.Ipv6Ranges = []*ec2.Ipv6Range{{CidrIpv6: String(source)}}
+	} else if err == nil {
+		ipPerm.IpRanges = []*ec2.IpRange{{CidrIp: String(source)}}
+	} else if strings.HasPrefix(source, "sg-") {
+		ipPerm.UserIdGroupPairs = []*ec2.UserIdGroupPair{{GroupId: String(source)}}
+	} else {
+		return fmt.Errorf("invalid source '%s': expecting a CIDR, a security group id or a sg:STACK/NAME reference", source)
+	}
+	return nil
+}
+
+func setIpPermissionPorts(ipPerm *ec2.IpPermission, p string, pRange *string) error {
 	if strings.Contains("any", p) {
 		ipPerm.FromPort = Int64(-1)
 		ipPerm.ToPort = Int64(-1)
 		ipPerm.IpProtocol = String("-1")
-		return []*ec2.IpPermission{ipPerm}, nil
+		return nil
 	}
 	ipPerm.IpProtocol = String(p)
 
-	if pRange := cmd.Portrange; pRange != nil {
+	if pRange != nil {
 		ports := *pRange
 		switch {
 		case strings.Contains(ports, "any"):
@@ -353,25 +539,189 @@ func (cmd *UpdateSecuritygroup) buildIpPermissions() ([]*ec2.IpPermission, error
 		case strings.Contains(ports, "-"):
 			from, err := strconv.ParseInt(strings.SplitN(ports, "-", 2)[0], 10, 64)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			to, err := strconv.ParseInt(strings.SplitN(ports, "-", 2)[1], 10, 64)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			ipPerm.FromPort = Int64(from)
 			ipPerm.ToPort = Int64(to)
 		default:
 			port, err := strconv.ParseInt(ports, 10, 64)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			ipPerm.FromPort = Int64(port)
 			ipPerm.ToPort = Int64(port)
 		}
 	}
+	return nil
+}
 
-	return []*ec2.IpPermission{ipPerm}, nil
+const noSecuritygroupRules = "none"
+
+// parseSecuritygroupRules parses rules given as PROTOCOL:PORTRANGE:SOURCE (ex: tcp:22:10.0.0.0/8, any:any:sg-1234),
+// the single rule 'none' meaning no rule at all
+func parseSecuritygroupRules(rules []string) ([]*ec2.IpPermission, error) {
+	if len(rules) == 1 && rules[0] == noSecuritygroupRules {
+		return []*ec2.IpPermission{}, nil
+	}
+	var ipPerms []*ec2.IpPermission
+	for _, rule := range rules {
+		splits := strings.SplitN(rule, ":", 3)
+		if len(splits) != 3 {
+			return nil, fmt.Errorf("invalid rule '%s', expected 'protocol:portrange:source'", rule)
+		}
+		protocol, ports, source := splits[0], splits[1], splits[2]
+		if isTCPorUDP(protocol) && ports == "" {
+			return nil, fmt.Errorf("invalid rule '%s': missing portrange when protocol is TCP/UDP", rule)
+		}
+		ipPerm := &ec2.IpPermission{}
+		if err := setIpPermissionSource(ipPerm, source); err != nil {
+			return nil, fmt.Errorf("invalid rule '%s': %s", rule, err)
+		}
+		var pRange *string
+		if ports != "" {
+			pRange = String(ports)
+		}
+		if err := setIpPermissionPorts(ipPerm, protocol, pRange); err != nil {
+			return nil, fmt.Errorf("invalid rule '%s': %s", rule, err)
+		}
+		if ipPerm.FromPort == nil && ipPerm.ToPort == nil {
+			ipPerm.FromPort, ipPerm.ToPort = Int64(-1), Int64(-1)
+		}
+		ipPerms = append(ipPerms, ipPerm)
+	}
+	return ipPerms, nil
+}
+
+func validateSecuritygroupRules(i interface{}, others map[string]interface{}) error {
+	for _, p := range []string{"cidr", "inbound", "outbound", "portrange", "protocol", "securitygroup", "source"} {
+		if _, ok := others[p]; ok {
+			return fmt.Errorf("'%s' cannot be used with 'inbound-rules' or 'outbound-rules'", p)
+		}
+	}
+	_, err := parseSecuritygroupRules(castStringSlice(i))
+	return err
+}
+
+// securitygroupRules returns the rules of the permissions as PROTOCOL:PORTRANGE:SOURCE,
+// the prefix lists sources being ignored
+func securitygroupRules(ipPerms []*ec2.IpPermission) []interface{} {
+	var rules []interface{}
+	for _, perm := range flattenIpPermissions(ipPerms) {
+		protocol, ports := normalizeIpProtocol(StringValue(perm.IpProtocol)), "any"
+		from, to := Int64AsIntValue(perm.FromPort), Int64AsIntValue(perm.ToPort)
+		switch {
+		case protocol == "-1":
+			protocol = "any"
+		case from == -1 && to == -1:
+		case from == to:
+			ports = strconv.Itoa(from)
+		default:
+			ports = fmt.Sprintf("%d-%d", from, to)
+		}
+		rules = append(rules, fmt.Sprintf("%s:%s:%s", protocol, ports, ipPermissionSource(perm)))
+	}
+	if len(rules) == 0 {
+		return []interface{}{noSecuritygroupRules}
+	}
+	return rules
+}
+
+// ipPermissionsDelta returns the permissions to authorize and to revoke to go from
+// the current permissions to the desired ones, with a single source each
+func ipPermissionsDelta(current, desired []*ec2.IpPermission) (authorize, revoke []*ec2.IpPermission) {
+	currentKeys := make(map[string]bool)
+	for _, perm := range flattenIpPermissions(current) {
+		currentKeys[ipPermissionKey(perm)] = true
+	}
+	desiredKeys := make(map[string]bool)
+	for _, perm := range flattenIpPermissions(desired) {
+		key := ipPermissionKey(perm)
+		if !currentKeys[key] && !desiredKeys[key] {
+			authorize = append(authorize, perm)
+		}
+		desiredKeys[key] = true
+	}
+	for _, perm := range flattenIpPermissions(current) {
+		if !desiredKeys[ipPermissionKey(perm)] {
+			revoke = append(revoke, perm)
+		}
+	}
+	return
+}
+
+// flattenIpPermissions splits the permissions into permissions with a single source,
+// the prefix lists sources being ignored
+func flattenIpPermissions(ipPerms []*ec2.IpPermission) (flat []*ec2.IpPermission) {
+	for _, perm := range ipPerms {
+		single := func() *ec2.IpPermission {
+			return &ec2.IpPermission{IpProtocol: perm.IpProtocol, FromPort: perm.FromPort, ToPort: perm.ToPort}
+		}
+		for _, r := range perm.IpRanges {
+			p := single()
+			p.IpRanges = []*ec2.IpRange{r}
+			flat = append(flat, p)
+		}
+		for _, r := range perm.Ipv6Ranges {
+			p := single()
+			p.Ipv6Ranges = []*ec2.Ipv6Range{r}
+			flat = append(flat, p)
+		}
+		for _, pair := range perm.UserIdGroupPairs {
+			p := single()
+			p.UserIdGroupPairs = []*ec2.UserIdGroupPair{pair}
+			flat = append(flat, p)
+		}
+	}
+	return
+}
+
+func ipPermissionKey(perm *ec2.IpPermission) string {
+	protocol := normalizeIpProtocol(StringValue(perm.IpProtocol))
+	if protocol == "-1" {
+		return fmt.Sprintf("%s::%s", protocol, ipPermissionSource(perm))
+	}
+	from, to := Int64AsIntValue(perm.FromPort), Int64AsIntValue(perm.ToPort)
+	if !isTCPorUDP(protocol) && perm.FromPort == nil && perm.ToPort == nil {
+		// AWS returns -1 ports for the protocols without ports (ex: icmp)
+		from, to = -1, -1
+	}
+	return fmt.Sprintf("%s:%d-%d:%s", protocol, from, to, ipPermissionSource(perm))
+}
+
+func ipPermissionSource(perm *ec2.IpPermission) string {
+	switch {
+	case len(perm.IpRanges) > 0:
+		return StringValue(perm.IpRanges[0].CidrIp)
+	case len(perm.Ipv6Ranges) > 0:
+		return StringValue(perm.Ipv6Ranges[0].CidrIpv6)
+	case len(perm.UserIdGroupPairs) > 0:
+		return StringValue(perm.UserIdGroupPairs[0].GroupId)
+	}
+	return ""
+}
+
+// normalizeIpProtocol returns the name of the protocols that AWS names in the rules (ex: 6 is tcp)
+func normalizeIpProtocol(p string) string {
+	switch p = strings.ToLower(p); p {
+	case "6":
+		return "tcp"
+	case "17":
+		return "udp"
+	case "1":
+		return "icmp"
+	case "58":
+		return "icmpv6"
+	}
+	return p
+}
+
+func isIPv6CIDR(cidr string) bool {
+	ip, _, err := net.ParseCIDR(cidr)
+	return err == nil && ip.To4() == nil
 }
 
 func isTCPorUDP(p string) bool {
@@ -87,6 +87,21 @@ func TestBuildIpPermissionsFromParams(t *testing.T) {
 				},
 			},
 		},
+		{
+			params: map[string]interface{}{
+				"protocol":  "tcp",
+				"cidr":      "::/0",
+				"portrange": 443,
+			},
+			expected: []*ec2.IpPermission{
+				{
+					IpProtocol: aws.String("tcp"),
+					Ipv6Ranges: []*ec2.Ipv6Range{{CidrIpv6: aws.String("::/0")}},
+					FromPort:   aws.Int64(int64(443)),
+					ToPort:     aws.Int64(int64(443)),
+				},
+			},
+		},
 		{
 			params: map[string]interface{}{
 				"protocol":      "icmp",
@@ -132,3 +147,78 @@ func TestBuildIpPermissionsFromParams(t *testing.T) {
 		}
 	}
 }
+
+func TestSecuritygroupRulesDelta(t *testing.T) {
+	current := []*ec2.IpPermission{
+		{
+			IpProtocol: aws.String("tcp"),
+			IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("10.0.0.0/8")}, {CidrIp: aws.String("0.0.0.0/0")}},
+			FromPort:   aws.Int64(22),
+			ToPort:     aws.Int64(22),
+		},
+		{
+			IpProtocol:       aws.String("-1"),
+			UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-12345"), UserId: aws.String("123456789012")}},
+		},
+		{
+			IpProtocol:    aws.String("tcp"),
+			PrefixListIds: []*ec2.PrefixListId{{PrefixListId: aws.String("pl-12345")}},
+			FromPort:      aws.Int64(443),
+			ToPort:        aws.Int64(443),
+		},
+	}
+	if got, want := securitygroupRules(current), []interface{}{"tcp:22:10.0.0.0/8", "tcp:22:0.0.0.0/0", "any:any:sg-12345"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := securitygroupRules(nil), []interface{}{"none"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	desired, err := parseSecuritygroupRules([]string{"tcp:22:10.0.0.0/8", "any:any:sg-12345", "tcp:443:::/0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	authorize, revoke := ipPermissionsDelta(current, desired)
+	expAuthorize := []*ec2.IpPermission{
+		{IpProtocol: aws.String("tcp"), Ipv6Ranges: []*ec2.Ipv6Range{{CidrIpv6: aws.String("::/0")}}, FromPort: aws.Int64(443), ToPort: aws.Int64(443)},
+	}
+	if got, want := authorize, expAuthorize; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+	expRevoke := []*ec2.IpPermission{
+		{IpProtocol: aws.String("tcp"), IpRanges: []*ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}}, FromPort: aws.Int64(22), ToPort: aws.Int64(22)},
+	}
+	if got, want := revoke, expRevoke; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+
+	authorize, revoke = ipPermissionsDelta(current, []*ec2.IpPermission{})
+	if len(authorize) != 0 || len(revoke) != 3 {
+		t.Fatalf("got %d to authorize and %d to revoke, want 0 and 3", len(authorize), len(revoke))
+	}
+
+	icmp, err := parseSecuritygroupRules([]string{"icmp::10.0.0.0/8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expIcmp := []*ec2.IpPermission{
+		{IpProtocol: aws.String("icmp"), IpRanges: []*ec2.IpRange{{CidrIp: aws.String("10.0.0.0/8")}}, FromPort: aws.Int64(-1), ToPort: aws.Int64(-1)},
+	}
+	if got, want := icmp, expIcmp; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+	authorize, revoke = ipPermissionsDelta([]*ec2.IpPermission{
+		{IpProtocol: aws.String("1"), IpRanges: []*ec2.IpRange{{CidrIp: aws.String("10.0.0.0/8")}}, FromPort: aws.Int64(-1), ToPort: aws.Int64(-1)},
+	}, []*ec2.IpPermission{
+		{IpProtocol: aws.String("icmp"), IpRanges: []*ec2.IpRange{{CidrIp: aws.String("10.0.0.0/8")}}},
+	})
+	if len(authorize) != 0 || len(revoke) != 0 {
+		t.Fatalf("icmp: got %d to authorize and %d to revoke, want none", len(authorize), len(revoke))
+	}
+
+	for _, invalid := range []string{"tcp:22", "tcp::10.0.0.0/8", "tcp:22:my-group", "udp:a-b:0.0.0.0/0"} {
+		if _, err := parseSecuritygroupRules([]string{invalid}); err == nil {
+			t.Fatalf("%s: expected error", invalid)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"github.com/aws/aws-sdk-go/service/organizations/organizationsiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/params"
+)
+
+// AttachServicecontrolpolicy attaches a service control policy to the root of the organization,
+// an organizational unit or an account
+type AttachServicecontrolpolicy struct {
+	_      string `action:"attach" entity:"servicecontrolpolicy" awsAPI:"organizations" awsCall:"AttachPolicy" awsInput:"organizations.AttachPolicyInput" awsOutput:"organizations.AttachPolicyOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    organizationsiface.OrganizationsAPI
+	Id     *string `awsName:"PolicyId" awsType:"awsstr" templateName:"id"`
+	Target *string `awsName:"TargetId" awsType:"awsstr" templateName:"target"`
+}
+
+func (cmd *AttachServicecontrolpolicy) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"), params.Key("target")))
+}
+
+type DetachServicecontrolpolicy struct {
+	_      string `action:"detach" entity:"servicecontrolpolicy" awsAPI:"organizations" awsCall:"DetachPolicy" awsInput:"organizations.DetachPolicyInput" awsOutput:"organizations.DetachPolicyOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    organizationsiface.OrganizationsAPI
+	Id     *string `awsName:"PolicyId" awsType:"awsstr" templateName:"id"`
+	Target *string `awsName:"TargetId" awsType:"awsstr" templateName:"target"`
+}
+
+func (cmd *DetachServicecontrolpolicy) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"), params.Key("target")))
+}
@@ -29,17 +29,21 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/glue"
 
 	"bytes"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/batch"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/params"
 )
 
 const (
@@ -53,6 +57,7 @@ const (
 	awsint64slice       = "awsint64slice"
 	awsstringslice      = "awsstringslice"
 	awsstringpointermap = "awsstringpointermap"
+	awsstringmap        = "awsstringmap"
 	awsslicestruct      = "awsslicestruct"
 	awsslicestructint64 = "awsslicestructint64"
 	awsuserdatatobase64 = "awsuserdatatobase64"
@@ -60,6 +65,9 @@ const (
 	awsfiletostring     = "awsfiletostring"
 	awsdimensionslice   = "awsdimensionslice"
 	awsparameterslice   = "awsparameterslice"
+	awsoptionsettings   = "awsoptionsettings"
+	awscomputeorder     = "awscomputeorder"
+	awss3targets        = "awss3targets"
 	awsecskeyvalue      = "awsecskeyvalue"
 	awsportmappings     = "awsportmappings"
 	awssubnetmappings   = "awssubnetmappings"
@@ -68,6 +76,8 @@ const (
 	aws6digitsstring    = "aws6digitsstring"
 	awsbyteslice        = "awsbyteslice"
 	awstagslice         = "awstagslice"
+	awsblockdevices     = "awsblockdevices"
+	awsinstancetags     = "awsinstancetags"
 )
 
 var (
@@ -154,6 +164,17 @@ func setFieldWithType(v, i interface{}, fieldPath string, destType string, inter
 			keyvalues = append(keyvalues, &ecs.KeyValuePair{Name: aws.String(splits[0]), Value: aws.String(splits[1])})
 		}
 		v = keyvalues
+	case awsstringmap:
+		sl := castStringSlice(v)
+		m := make(map[string]*string)
+		for _, s := range sl {
+			splits := strings.SplitN(s, ":", 2)
+			if len(splits) != 2 {
+				return fmt.Errorf("invalid map entry '%s', expected 'key:value'", s)
+			}
+			m[splits[0]] = aws.String(splits[1])
+		}
+		v = m
 	case awsparameterslice:
 		sl := castStringSlice(v)
 		var parameters []*cloudformation.Parameter
@@ -165,6 +186,32 @@ func setFieldWithType(v, i interface{}, fieldPath string, destType string, inter
 			parameters = append(parameters, &cloudformation.Parameter{ParameterKey: aws.String(splits[0]), ParameterValue: aws.String(splits[1])})
 		}
 		v = parameters
+	case awsoptionsettings:
+		sl := castStringSlice(v)
+		var settings []*elasticbeanstalk.ConfigurationOptionSetting
+		for _, s := range sl {
+			splits := strings.SplitN(s, "=", 2)
+			sep := strings.LastIndex(splits[0], ":")
+			if len(splits) != 2 || sep < 1 {
+				return fmt.Errorf("invalid option setting '%s', expected 'namespace:option=value'", s)
+			}
+			settings = append(settings, &elasticbeanstalk.ConfigurationOptionSetting{Namespace: aws.String(splits[0][:sep]), OptionName: aws.String(splits[0][sep+1:]), Value: aws.String(splits[1])})
+		}
+		v = settings
+	case awscomputeorder:
+		sl := castStringSlice(v)
+		var order []*batch.ComputeEnvironmentOrder
+		for i, s := range sl {
+			order = append(order, &batch.ComputeEnvironmentOrder{ComputeEnvironment: aws.String(s), Order: aws.Int64(int64(i + 1))})
+		}
+		v = order
+	case awss3targets:
+		sl := castStringSlice(v)
+		var targets []*glue.S3Target
+		for _, s := range sl {
+			targets = append(targets, &glue.S3Target{Path: aws.String(s)})
+		}
+		v = targets
 	case awssubnetmappings:
 		sl := castStringSlice(v)
 		var subnetMappings []*elbv2.SubnetMapping
@@ -359,6 +406,46 @@ func setFieldWithType(v, i interface{}, fieldPath string, destType string, inter
 		}
 
 		v = tags
+	case awsblockdevices:
+		sl := castStringSlice(v)
+		var mappings []*ec2.BlockDeviceMapping
+		for _, s := range sl {
+			splits := strings.Split(s, ":")
+			if len(splits) < 2 || len(splits) > 4 {
+				return fmt.Errorf("invalid block device '%s', expected 'device:size[:type[:iops]]'", s)
+			}
+			size, err := strconv.ParseInt(splits[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid size in block device '%s': %s", s, err)
+			}
+			ebs := &ec2.EbsBlockDevice{VolumeSize: aws.Int64(size), DeleteOnTermination: aws.Bool(true)}
+			if len(splits) > 2 {
+				ebs.VolumeType = aws.String(splits[2])
+			}
+			if len(splits) > 3 {
+				iops, err := strconv.ParseInt(splits[3], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid iops in block device '%s': %s", s, err)
+				}
+				ebs.Iops = aws.Int64(iops)
+			}
+			mappings = append(mappings, &ec2.BlockDeviceMapping{DeviceName: aws.String(splits[0]), Ebs: ebs})
+		}
+		v = mappings
+	case awsinstancetags:
+		sl := castStringSlice(v)
+		var tags []*ec2.Tag
+		for _, s := range sl {
+			splits := strings.SplitN(s, ":", 2)
+			if len(splits) != 2 {
+				return fmt.Errorf("invalid tag '%s', expected 'key:value'", s)
+			}
+			tags = append(tags, &ec2.Tag{Key: aws.String(splits[0]), Value: aws.String(splits[1])})
+		}
+		v = []*ec2.TagSpecification{
+			{ResourceType: aws.String(ec2.ResourceTypeInstance), Tags: tags},
+			{ResourceType: aws.String(ec2.ResourceTypeVolume), Tags: tags},
+		}
 	}
 	awsutil.SetValueAtPath(i, fieldPath, v)
 	return nil
@@ -527,7 +614,9 @@ func userDataContentAsBase64(v interface{}, tplData interface{}) (string, error)
 	var readErr error
 	var content []byte
 
-	if strings.HasPrefix(strings.TrimSpace(userdata), "#") { // userdata are bash content or yml cloud script content (https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/user-data.html#user-data-shell-scripts)
+	if fileContent, ok := v.(params.FileContent); ok { // userdata content read from a file with file(path)
+		content = []byte(fileContent)
+	} else if strings.HasPrefix(strings.TrimSpace(userdata), "#") { // userdata are bash content or yml cloud script content (https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/user-data.html#user-data-shell-scripts)
 		r := strings.NewReplacer("\\a", "\a", "\\b", "\b", "\\f", "\f", "\\n", "\n", "\\t", "\t", "\\r", "\r", "\\v", "\v")
 		content = []byte(r.Replace(userdata))
 	} else if strings.HasPrefix(userdata, "http") {
@@ -26,14 +26,18 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/glue"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/batch"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/wallix/awless/template/params"
 )
 
 func TestGoTemplatingInUserdata(t *testing.T) {
@@ -62,6 +66,27 @@ func TestGoTemplatingInUserdata(t *testing.T) {
 	}
 }
 
+func TestUserdataContent(t *testing.T) {
+	tcases := []struct {
+		content params.FileContent
+		expText string
+	}{
+		{content: "#!/bin/bash\nprintf \"hello {{ .name }}\\n\"\n", expText: "#!/bin/bash\nprintf \"hello johndoe\\n\"\n"},
+		{content: "echo {{ .name }} > /tmp/hello", expText: "echo johndoe > /tmp/hello"},
+	}
+	for i, tcase := range tcases {
+		awsparams := &ec2.RunInstancesInput{}
+
+		err := setFieldWithType(tcase.content, awsparams, "UserData", awsuserdatatobase64, map[string]string{"name": "johndoe"})
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if got, want := awssdk.StringValue(awsparams.UserData), base64.StdEncoding.EncodeToString([]byte(tcase.expText)); got != want {
+			t.Fatalf("%d: got %s, want %s", i+1, got, want)
+		}
+	}
+}
+
 func TestSetFieldWithTypeAWSFile(t *testing.T) {
 	text := []byte("file content")
 	f, err := ioutil.TempFile("", "")
@@ -168,12 +193,18 @@ func TestSetFieldWithMultiType(t *testing.T) {
 		MapAttribute      map[string]*string
 		EmptyMapAttribute map[string]*string
 		ParameterList     []*cloudformation.Parameter
+		OptionSettings    []*elasticbeanstalk.ConfigurationOptionSetting
+		ComputeOrder      []*batch.ComputeEnvironmentOrder
+		S3Targets         []*glue.S3Target
+		StringMapStruct   *struct{ Variables map[string]*string }
 		PortMappings      []*ecs.PortMapping
 		SubnetMappings    []*elbv2.SubnetMapping
 		StepAdjustments   []*applicationautoscaling.StepAdjustment
 		CSVString         *string
 		SixDigitsString   *string
 		ByteSlice         []byte
+		BlockDevices      []*ec2.BlockDeviceMapping
+		TagSpecifications []*ec2.TagSpecification
 	}{Field: "initial", MapAttribute: map[string]*string{"test": awssdk.String("1234")}}
 
 	err := setFieldWithType("expected", &any, "Field", awsstr)
@@ -497,6 +528,48 @@ func TestSetFieldWithMultiType(t *testing.T) {
 	if got, want := *any.ParameterList[1].ParameterValue, "value1:with:"; got != want {
 		t.Fatalf("got %s, want %s", got, want)
 	}
+	err = setFieldWithType([]string{"aws:autoscaling:asg:MinSize=1", "aws:elasticbeanstalk:application:environment:URL=http://a=b"}, &any, "OptionSettings", awsoptionsettings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expSettings := []*elasticbeanstalk.ConfigurationOptionSetting{
+		{Namespace: awssdk.String("aws:autoscaling:asg"), OptionName: awssdk.String("MinSize"), Value: awssdk.String("1")},
+		{Namespace: awssdk.String("aws:elasticbeanstalk:application:environment"), OptionName: awssdk.String("URL"), Value: awssdk.String("http://a=b")},
+	}
+	if got, want := any.OptionSettings, expSettings; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if err = setFieldWithType([]string{"MinSize=1"}, &any, "OptionSettings", awsoptionsettings); err == nil {
+		t.Fatal("expected error for option setting without namespace")
+	}
+	err = setFieldWithType([]string{"first-env", "arn:aws:batch:us-east-1:0123456789:compute-environment/spot-env"}, &any, "ComputeOrder", awscomputeorder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expOrder := []*batch.ComputeEnvironmentOrder{
+		{ComputeEnvironment: awssdk.String("first-env"), Order: awssdk.Int64(1)},
+		{ComputeEnvironment: awssdk.String("arn:aws:batch:us-east-1:0123456789:compute-environment/spot-env"), Order: awssdk.Int64(2)},
+	}
+	if got, want := any.ComputeOrder, expOrder; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	err = setFieldWithType("s3://my-bucket/logs/", &any, "S3Targets", awss3targets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := any.S3Targets, []*glue.S3Target{{Path: awssdk.String("s3://my-bucket/logs/")}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	err = setFieldWithType([]string{"key:value", "key1:value1:with:"}, &any, "StringMapStruct.Variables", awsstringmap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := any.StringMapStruct.Variables, map[string]*string{"key": awssdk.String("value"), "key1": awssdk.String("value1:with:")}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if err = setFieldWithType([]string{"novalue"}, &any, "StringMapStruct.Variables", awsstringmap); err == nil {
+		t.Fatal("expected error for entry without value")
+	}
 	err = setFieldWithType([]string{"key:value", "key1:value1:with:"}, &any, "KeyValueSliceField", awsecskeyvalue)
 	if err != nil {
 		t.Fatal(err)
@@ -637,6 +710,29 @@ func TestSetFieldWithMultiType(t *testing.T) {
 	if got, want := any.ByteSlice, []byte("hello"); !reflect.DeepEqual(got, want) {
 		t.Fatalf("got %s, want %s", got, want)
 	}
+	err = setFieldWithType([]interface{}{"/dev/xvda:30", "/dev/sdb:500:io1:5000"}, &any, "BlockDevices", awsblockdevices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expDevices := []*ec2.BlockDeviceMapping{
+		{DeviceName: awssdk.String("/dev/xvda"), Ebs: &ec2.EbsBlockDevice{VolumeSize: awssdk.Int64(30), DeleteOnTermination: awssdk.Bool(true)}},
+		{DeviceName: awssdk.String("/dev/sdb"), Ebs: &ec2.EbsBlockDevice{VolumeSize: awssdk.Int64(500), VolumeType: awssdk.String("io1"), Iops: awssdk.Int64(5000), DeleteOnTermination: awssdk.Bool(true)}},
+	}
+	if got, want := any.BlockDevices, expDevices; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if err = setFieldWithType("/dev/sdb", &any, "BlockDevices", awsblockdevices); err == nil {
+		t.Fatal("expected error got none")
+	}
+	err = setFieldWithType([]interface{}{"env:prod", "url:http://my.site"}, &any, "TagSpecifications", awsinstancetags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expTags := []*ec2.Tag{{Key: awssdk.String("env"), Value: awssdk.String("prod")}, {Key: awssdk.String("url"), Value: awssdk.String("http://my.site")}}
+	expSpecs := []*ec2.TagSpecification{{ResourceType: awssdk.String("instance"), Tags: expTags}, {ResourceType: awssdk.String("volume"), Tags: expTags}}
+	if got, want := any.TagSpecifications, expSpecs; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
 }
 
 type TestStruct struct {
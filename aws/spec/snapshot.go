@@ -63,13 +63,16 @@ type CopySnapshot struct {
 	SourceId     *string `awsName:"SourceSnapshotId" awsType:"awsstr" templateName:"source-id"`
 	SourceRegion *string `awsName:"SourceRegion" awsType:"awsstr" templateName:"source-region"`
 	Encrypted    *bool   `awsName:"Encrypted" awsType:"awsbool" templateName:"encrypted"`
+	KmsKey       *string `awsName:"KmsKeyId" awsType:"awsstr" templateName:"kms-key"`
 	Description  *string `awsName:"Description" awsType:"awsstr" templateName:"description"`
 }
 
 func (cmd *CopySnapshot) ParamsSpec() params.Spec {
-	return params.NewSpec(params.AllOf(params.Key("source-id"), params.Key("source-region"),
-		params.Opt("description", "encrypted"),
+	builder := params.SpecBuilder(params.AllOf(params.Key("source-id"), params.Key("source-region"),
+		params.Opt("description", "encrypted", "kms-key"),
 	))
+	builder.AddReducer(encryptWithKMSKey, "encrypted", "kms-key")
+	return builder.Done()
 }
 
 func (cmd *CopySnapshot) ExtractResult(i interface{}) string {
@@ -1,14 +1,18 @@
 package awsspec
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/rand"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/params"
 
@@ -70,10 +74,30 @@ func fakeDryRunId(entity string) string {
 		return fmt.Sprintf("sg-%d", suffix)
 	case cloud.InternetGateway:
 		return fmt.Sprintf("igw-%d", suffix)
+	case cloud.EgressOnlyInternetGateway:
+		return fmt.Sprintf("eigw-%d", suffix)
 	case cloud.NatGateway:
 		return fmt.Sprintf("nat-%d", suffix)
+	case cloud.PeeringConnection:
+		return fmt.Sprintf("pcx-%d", suffix)
+	case cloud.VpcEndpoint:
+		return fmt.Sprintf("vpce-%d", suffix)
+	case cloud.CustomerGateway:
+		return fmt.Sprintf("cgw-%d", suffix)
+	case cloud.VpnGateway:
+		return fmt.Sprintf("vgw-%d", suffix)
+	case cloud.VpnConnection:
+		return fmt.Sprintf("vpn-%d", suffix)
 	case cloud.RouteTable:
 		return fmt.Sprintf("rtb-%d", suffix)
+	case cloud.NetworkACL:
+		return fmt.Sprintf("acl-%d", suffix)
+	case cloud.Host:
+		return fmt.Sprintf("h-%d", suffix)
+	case cloud.SpotRequest:
+		return fmt.Sprintf("sir-%d", suffix)
+	case cloud.SpotFleet:
+		return fmt.Sprintf("sfr-%d", suffix)
 	default:
 		return fmt.Sprintf("dryrunid-%d", suffix)
 	}
@@ -125,6 +149,7 @@ type checker struct {
 	expect      string
 	logger      *logger.Logger
 	checkName   string
+	renv        env.Running
 }
 
 func (c *checker) check() error {
@@ -135,10 +160,13 @@ func (c *checker) check() error {
 	}
 	defer timer.Stop()
 	defer c.logger.Println()
-	for {
+	ctx := env.Ctx(c.renv)
+	for attempt := 1; ; attempt++ {
 		select {
 		case <-timer.C:
 			return fmt.Errorf("timeout of %s expired", c.timeout)
+		case <-ctx.Done():
+			return fmt.Errorf("check %s: %s", c.description, ctx.Err())
 		default:
 		}
 		got, err := c.fetchFunc()
@@ -151,8 +179,59 @@ func (c *checker) check() error {
 		}
 		elapsed := time.Since(now)
 		c.logger.InteractiveInfof("%s %s '%s', expect '%s', timeout in %s (retry in %s)", c.description, c.checkName, got, c.expect, color.New(color.FgGreen).Sprint(c.timeout-elapsed.Round(time.Second)), c.frequency)
-		time.Sleep(c.frequency)
+		env.NotifyRetry(c.renv, attempt, fmt.Errorf("%s %s '%s', expect '%s'", c.description, c.checkName, got, c.expect))
+		select {
+		case <-time.After(c.frequency):
+		case <-ctx.Done():
+			return fmt.Errorf("check %s: %s", c.description, ctx.Err())
+		}
+	}
+}
+
+const (
+	unreachableState = "unreachable"
+	openState        = "open"
+)
+
+// parseCheckTimeout accepts a number of seconds (ex: 300) or a duration (ex: 5m)
+func parseCheckTimeout(s string) (time.Duration, error) {
+	if secs, err := strconv.Atoi(s); err == nil {
+		return time.Duration(secs) * time.Second, nil
 	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timeout '%s': expecting seconds or a duration (ex: 300, 5m)", s)
+	}
+	return d, nil
+}
+
+func isCheckTimeout(i interface{}, others map[string]interface{}) error {
+	_, err := parseCheckTimeout(fmt.Sprint(i))
+	return err
+}
+
+// CancelRequestsWithContext makes the AWS requests of the clients later created
+// from sess abort when ctx is cancelled, so that interrupting a template run
+// does not wait for its in-flight driver calls. The context replaces the one
+// given for a previous run with the same session
+func CancelRequestsWithContext(sess *session.Session, ctx context.Context) {
+	handler := request.NamedHandler{
+		Name: cancelRequestsHandlerName,
+		Fn: func(r *request.Request) {
+			r.SetContext(ctx)
+		},
+	}
+	if !sess.Handlers.Build.SwapNamed(handler) {
+		sess.Handlers.Build.PushFrontNamed(handler)
+	}
+}
+
+const cancelRequestsHandlerName = "awless.CancelRequestsWithContext"
+
+// withoutRequestsCancellation lets a request complete even when the template run was interrupted,
+// for the calls restoring resources left in a transient state by the interrupted command
+func withoutRequestsCancellation(r *request.Request) {
+	r.Handlers.Build.RemoveByName(cancelRequestsHandlerName)
 }
 
 type enumValidator struct {
@@ -1,8 +1,14 @@
 package awsspec
 
 import (
+	"context"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 )
 
 func checkErrs(t *testing.T, errs []error, length int, expected ...string) {
@@ -54,3 +60,47 @@ func TestEnumValidator(t *testing.T) {
 		}
 	}
 }
+
+func TestParseCheckTimeout(t *testing.T) {
+	tcases := []struct {
+		in     string
+		exp    time.Duration
+		expErr bool
+	}{
+		{in: "180", exp: 3 * time.Minute},
+		{in: "5m", exp: 5 * time.Minute},
+		{in: "1m30s", exp: 90 * time.Second},
+		{in: "soon", expErr: true},
+	}
+	for _, tcase := range tcases {
+		got, err := parseCheckTimeout(tcase.in)
+		if tcase.expErr {
+			if err == nil {
+				t.Fatalf("%s: expected error", tcase.in)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %s", tcase.in, err)
+		}
+		if got != tcase.exp {
+			t.Fatalf("%s: got %s, want %s", tcase.in, got, tcase.exp)
+		}
+	}
+}
+
+func TestCancelRequestsWithContext(t *testing.T) {
+	sess := session.Must(session.NewSession())
+	first, cancelFirst := context.WithCancel(context.Background())
+	defer cancelFirst()
+	CancelRequestsWithContext(sess, first)
+	second, cancelSecond := context.WithCancel(context.Background())
+	defer cancelSecond()
+	CancelRequestsWithContext(sess, second)
+
+	req := request.New(*sess.Config, metadata.ClientInfo{}, sess.Handlers.Copy(), nil, &request.Operation{Name: "DescribeInstances"}, nil, nil)
+	sess.Handlers.Build.Run(req)
+	if got, want := req.Context(), second; got != want {
+		t.Fatalf("got context %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,166 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/wallix/awless/logger"
+)
+
+type CreateSpotfleet struct {
+	_              string `action:"create" entity:"spotfleet" awsAPI:"ec2" awsDryRun:"manual"`
+	logger         *logger.Logger
+	graph          cloud.GraphAPI
+	api            ec2iface.EC2API
+	FleetRole      *string     `templateName:"fleet-role"`
+	Capacity       *int64      `templateName:"capacity"`
+	Image          *string     `templateName:"image"`
+	Types          []*string   `templateName:"types"`
+	Subnets        []*string   `templateName:"subnets"`
+	Price          *string     `templateName:"price"`
+	Strategy       *string     `templateName:"strategy"`
+	Keypair        *string     `templateName:"keypair"`
+	SecurityGroups []*string   `templateName:"securitygroups"`
+	UserData       interface{} `templateName:"userdata"`
+	Role           *string     `templateName:"role"`
+	Interruption   *string     `templateName:"interruption"`
+	Persistent     *bool       `templateName:"persistent"`
+}
+
+func (cmd *CreateSpotfleet) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("capacity"), params.Key("fleet-role"), params.Key("image"), params.Key("types"),
+			params.Opt("interruption", params.Suggested("keypair", "securitygroups", "subnets"), "persistent", "price", "role", "strategy", "userdata"),
+		),
+		params.Validators{
+			"types": func(i interface{}, others map[string]interface{}) error {
+				for _, t := range castStringSlice(i) {
+					if err := validateInstanceType(cmd.api, t); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			"strategy":     params.IsInEnumIgnoreCase("lowestprice", "diversified"),
+			"interruption": validateSpotInterruption,
+		})
+}
+
+func (cmd *CreateSpotfleet) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input, err := cmd.prepareInput(renv.Context())
+	if err != nil {
+		return nil, err
+	}
+	input.SetDryRun(true)
+
+	_, err = cmd.api.RequestSpotFleet(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			renv.Log().Verbose("dry run: create spotfleet ok")
+			return fakeDryRunId(cloud.SpotFleet), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *CreateSpotfleet) ManualRun(renv env.Running) (interface{}, error) {
+	input, err := cmd.prepareInput(renv.Context())
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	output, err := cmd.api.RequestSpotFleet(input)
+	renv.Log().ExtraVerbosef("ec2.RequestSpotFleet call took %s", time.Since(start))
+	return output, err
+}
+
+func (cmd *CreateSpotfleet) IAMActions(params map[string]interface{}) []string {
+	return []string{"ec2:RequestSpotFleet"}
+}
+
+func (cmd *CreateSpotfleet) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*ec2.RequestSpotFleetOutput).SpotFleetRequestId)
+}
+
+// prepareInput builds the fleet request with one launch specification per instance type,
+// each spanning the given subnets: the fleet then picks its instances among all
+// the pools of instance type and availability zone
+func (cmd *CreateSpotfleet) prepareInput(ctx map[string]interface{}) (*ec2.RequestSpotFleetInput, error) {
+	config := &ec2.SpotFleetRequestConfigData{
+		IamFleetRole:   cmd.FleetRole,
+		TargetCapacity: cmd.Capacity,
+		SpotPrice:      cmd.Price,
+	}
+	if BoolValue(cmd.Persistent) {
+		config.SetType(ec2.FleetTypeMaintain)
+	}
+	if cmd.Interruption != nil {
+		config.SetInstanceInterruptionBehavior(strings.ToLower(StringValue(cmd.Interruption)))
+	}
+	switch strings.ToLower(StringValue(cmd.Strategy)) {
+	case "lowestprice":
+		config.SetAllocationStrategy(ec2.AllocationStrategyLowestPrice)
+	case "diversified":
+		config.SetAllocationStrategy(ec2.AllocationStrategyDiversified)
+	}
+
+	var userdata *string
+	if cmd.UserData != nil {
+		content, err := userDataContentAsBase64(cmd.UserData, ctx)
+		if err != nil {
+			return nil, fmt.Errorf("userdata: %s", err)
+		}
+		userdata = String(content)
+	}
+	var groups []*ec2.GroupIdentifier
+	for _, group := range cmd.SecurityGroups {
+		groups = append(groups, &ec2.GroupIdentifier{GroupId: group})
+	}
+	for _, typ := range cmd.Types {
+		spec := &ec2.SpotFleetLaunchSpecification{
+			ImageId:        cmd.Image,
+			InstanceType:   typ,
+			KeyName:        cmd.Keypair,
+			SecurityGroups: groups,
+			UserData:       userdata,
+		}
+		if len(cmd.Subnets) > 0 {
+			spec.SetSubnetId(strings.Join(awssdk.StringValueSlice(cmd.Subnets), ","))
+		}
+		if cmd.Role != nil {
+			spec.SetIamInstanceProfile(&ec2.IamInstanceProfileSpecification{Name: cmd.Role})
+		}
+		config.LaunchSpecifications = append(config.LaunchSpecifications, spec)
+	}
+	return &ec2.RequestSpotFleetInput{SpotFleetRequestConfig: config}, nil
+}
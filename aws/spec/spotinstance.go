@@ -0,0 +1,145 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/wallix/awless/logger"
+)
+
+type CreateSpotinstance struct {
+	_              string `action:"create" entity:"spotinstance" awsAPI:"ec2" awsDryRun:"manual"`
+	logger         *logger.Logger
+	graph          cloud.GraphAPI
+	api            ec2iface.EC2API
+	Image          *string     `awsName:"LaunchSpecification.ImageId" awsType:"awsstr" templateName:"image"`
+	Type           *string     `awsName:"LaunchSpecification.InstanceType" awsType:"awsstr" templateName:"type"`
+	Count          *int64      `awsName:"InstanceCount" awsType:"awsint64" templateName:"count"`
+	Price          *string     `awsName:"SpotPrice" awsType:"awsstr" templateName:"price"`
+	Name           *string     `templateName:"name"`
+	Subnet         *string     `awsName:"LaunchSpecification.SubnetId" awsType:"awsstr" templateName:"subnet"`
+	Keypair        *string     `awsName:"LaunchSpecification.KeyName" awsType:"awsstr" templateName:"keypair"`
+	SecurityGroups []*string   `awsName:"LaunchSpecification.SecurityGroupIds" awsType:"awsstringslice" templateName:"securitygroup"`
+	UserData       interface{} `awsName:"LaunchSpecification.UserData" awsType:"awsuserdatatobase64" templateName:"userdata"`
+	Role           *string     `awsName:"LaunchSpecification.IamInstanceProfile.Name" awsType:"awsstr" templateName:"role"`
+	Interruption   *string     `templateName:"interruption"`
+	Persistent     *bool       `templateName:"persistent"`
+}
+
+func (cmd *CreateSpotinstance) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("image"), params.Key("type"), params.Key("subnet"),
+			params.Opt("count", "interruption", params.Suggested("keypair", "securitygroup"), "name", "persistent", "price", "role", "userdata"),
+		),
+		params.Validators{
+			"type": func(i interface{}, others map[string]interface{}) error {
+				return validateInstanceType(cmd.api, i)
+			},
+			"interruption": validateSpotInterruption,
+		})
+}
+
+// validateSpotInterruption checks the interruption behavior of the instances of a spot request:
+// only the instances of persistent requests can be stopped or hibernated
+func validateSpotInterruption(i interface{}, others map[string]interface{}) error {
+	if err := params.IsInEnumIgnoreCase("terminate", "stop", "hibernate")(i, others); err != nil {
+		return err
+	}
+	if behavior := strings.ToLower(fmt.Sprint(i)); behavior != ec2.InstanceInterruptionBehaviorTerminate {
+		if persistent, _ := castBool(others["persistent"]); !persistent {
+			return fmt.Errorf("'%s' only applicable with 'persistent=true'", behavior)
+		}
+	}
+	return nil
+}
+
+func (cmd *CreateSpotinstance) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input, err := cmd.prepareInput(renv.Context())
+	if err != nil {
+		return nil, err
+	}
+	input.SetDryRun(true)
+
+	_, err = cmd.api.RequestSpotInstances(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().Verbose("dry run: create spotinstance ok")
+			return fakeDryRunId(cloud.SpotRequest), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *CreateSpotinstance) ManualRun(renv env.Running) (interface{}, error) {
+	input, err := cmd.prepareInput(renv.Context())
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	output, err := cmd.api.RequestSpotInstances(input)
+	renv.Log().ExtraVerbosef("ec2.RequestSpotInstances call took %s", time.Since(start))
+	return output, err
+}
+
+func (cmd *CreateSpotinstance) IAMActions(params map[string]interface{}) []string {
+	actions := []string{"ec2:RequestSpotInstances"}
+	if _, ok := params["name"]; ok {
+		actions = append(actions, "ec2:CreateTags")
+	}
+	return actions
+}
+
+func (cmd *CreateSpotinstance) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*ec2.RequestSpotInstancesOutput).SpotInstanceRequests[0].SpotInstanceRequestId)
+}
+
+func (cmd *CreateSpotinstance) AfterRun(renv env.Running, output interface{}) error {
+	if cmd.Name == nil {
+		return nil
+	}
+	return createNameTag(String(cmd.ExtractResult(output)), cmd.Name, renv)
+}
+
+func (cmd *CreateSpotinstance) prepareInput(ctx map[string]interface{}) (*ec2.RequestSpotInstancesInput, error) {
+	input := &ec2.RequestSpotInstancesInput{}
+	if err := structInjector(cmd, input, ctx); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.RequestSpotInstancesInput: %s", err)
+	}
+	if BoolValue(cmd.Persistent) {
+		input.SetType(ec2.SpotInstanceTypePersistent)
+	}
+	if cmd.Interruption != nil {
+		input.SetInstanceInterruptionBehavior(strings.ToLower(StringValue(cmd.Interruption)))
+	}
+	return input, nil
+}
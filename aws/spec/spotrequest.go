@@ -0,0 +1,126 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/wallix/awless/logger"
+)
+
+type CancelSpotrequest struct {
+	_                  string `action:"cancel" entity:"spotrequest" awsAPI:"ec2" awsDryRun:"manual"`
+	logger             *logger.Logger
+	graph              cloud.GraphAPI
+	api                ec2iface.EC2API
+	Id                 *string `templateName:"id"`
+	TerminateInstances *bool   `templateName:"terminate-instances"`
+}
+
+func (cmd *CancelSpotrequest) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"), params.Opt("terminate-instances")))
+}
+
+func (cmd *CancelSpotrequest) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	var err error
+	if cmd.isFleet() {
+		_, err = cmd.api.CancelSpotFleetRequests(&ec2.CancelSpotFleetRequestsInput{DryRun: Bool(true), SpotFleetRequestIds: []*string{cmd.Id}, TerminateInstances: Bool(BoolValue(cmd.TerminateInstances))})
+	} else {
+		_, err = cmd.api.CancelSpotInstanceRequests(&ec2.CancelSpotInstanceRequestsInput{DryRun: Bool(true), SpotInstanceRequestIds: []*string{cmd.Id}})
+	}
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			renv.Log().Verbose("dry run: cancel spotrequest ok")
+			return StringValue(cmd.Id), nil
+		}
+	}
+
+	return nil, err
+}
+
+// ManualRun cancels a spot fleet request (id starting with 'sfr-') or a spot instance request.
+// As cancelling a spot instance request leaves its instance running, the instance is terminated
+// afterwards when asked to
+func (cmd *CancelSpotrequest) ManualRun(renv env.Running) (interface{}, error) {
+	start := time.Now()
+	if cmd.isFleet() {
+		output, err := cmd.api.CancelSpotFleetRequests(&ec2.CancelSpotFleetRequestsInput{SpotFleetRequestIds: []*string{cmd.Id}, TerminateInstances: Bool(BoolValue(cmd.TerminateInstances))})
+		renv.Log().ExtraVerbosef("ec2.CancelSpotFleetRequests call took %s", time.Since(start))
+		if err != nil {
+			return nil, err
+		}
+		for _, unsuccessful := range output.UnsuccessfulFleetRequests {
+			if unsuccessful.Error != nil {
+				return nil, fmt.Errorf("cancel spot fleet request %s: %s", StringValue(unsuccessful.SpotFleetRequestId), StringValue(unsuccessful.Error.Message))
+			}
+		}
+		return output, nil
+	}
+
+	var instances []*string
+	if BoolValue(cmd.TerminateInstances) {
+		out, err := cmd.api.DescribeSpotInstanceRequests(&ec2.DescribeSpotInstanceRequestsInput{SpotInstanceRequestIds: []*string{cmd.Id}})
+		if err != nil {
+			return nil, err
+		}
+		for _, req := range out.SpotInstanceRequests {
+			if req.InstanceId != nil {
+				instances = append(instances, req.InstanceId)
+			}
+		}
+	}
+	output, err := cmd.api.CancelSpotInstanceRequests(&ec2.CancelSpotInstanceRequestsInput{SpotInstanceRequestIds: []*string{cmd.Id}})
+	renv.Log().ExtraVerbosef("ec2.CancelSpotInstanceRequests call took %s", time.Since(start))
+	if err != nil {
+		return nil, err
+	}
+	if len(instances) > 0 {
+		if _, err := cmd.api.TerminateInstances(&ec2.TerminateInstancesInput{InstanceIds: instances}); err != nil {
+			return nil, fmt.Errorf("terminate instances of spot request %s: %s", StringValue(cmd.Id), err)
+		}
+		renv.Log().Verbosef("instances %s of spot request %s terminated", strings.Join(awssdk.StringValueSlice(instances), ", "), StringValue(cmd.Id))
+	}
+	return output, nil
+}
+
+func (cmd *CancelSpotrequest) IAMActions(params map[string]interface{}) []string {
+	if strings.HasPrefix(fmt.Sprint(params["id"]), "sfr-") {
+		return []string{"ec2:CancelSpotFleetRequests"}
+	}
+	if terminate, _ := castBool(params["terminate-instances"]); terminate {
+		return []string{"ec2:CancelSpotInstanceRequests", "ec2:DescribeSpotInstanceRequests", "ec2:TerminateInstances"}
+	}
+	return []string{"ec2:CancelSpotInstanceRequests"}
+}
+
+func (cmd *CancelSpotrequest) isFleet() bool {
+	return strings.HasPrefix(StringValue(cmd.Id), "sfr-")
+}
@@ -40,6 +40,7 @@ type CreateStack struct {
 	api             cloudformationiface.CloudFormationAPI
 	Name            *string   `awsName:"StackName" awsType:"awsstr" templateName:"name"`
 	TemplateFile    *string   `awsName:"TemplateBody" awsType:"awsfiletostring" templateName:"template-file"`
+	TemplateURL     *string   `awsName:"TemplateURL" awsType:"awsstr" templateName:"template-url"`
 	Capabilities    []*string `awsName:"Capabilities" awsType:"awsstringslice" templateName:"capabilities"`
 	DisableRollback *bool     `awsName:"DisableRollback" awsType:"awsbool" templateName:"disable-rollback"`
 	Notifications   []*string `awsName:"NotificationARNs" awsType:"awsstringslice" templateName:"notifications"`
@@ -56,7 +57,7 @@ type CreateStack struct {
 
 func (cmd *CreateStack) ParamsSpec() params.Spec {
 	return params.NewSpec(
-		params.AllOf(params.Key("name"), params.Key("template-file"), params.Opt("capabilities", "disable-rollback", "notifications", "on-failure", "parameters", "policy-file", "resource-types", "role", "stack-file", "tags", "timeout")),
+		params.AllOf(params.Key("name"), params.OnlyOneOf(params.Key("template-file"), params.Key("template-url")), params.Opt("capabilities", "disable-rollback", "notifications", "on-failure", "parameters", "policy-file", "resource-types", "role", "stack-file", "tags", "timeout")),
 		params.Validators{"template-file": params.IsFilepath},
 	)
 }
@@ -88,6 +89,7 @@ type UpdateStack struct {
 	PolicyFile          *string   `awsName:"StackPolicyBody" awsType:"awsfiletostring" templateName:"policy-file"`
 	PolicyUpdateFile    *string   `awsName:"StackPolicyDuringUpdateBody" awsType:"awsfiletostring" templateName:"policy-update-file"`
 	TemplateFile        *string   `awsName:"TemplateBody" awsType:"awsfiletostring" templateName:"template-file"`
+	TemplateURL         *string   `awsName:"TemplateURL" awsType:"awsstr" templateName:"template-url"`
 	UsePreviousTemplate *bool     `awsName:"UsePreviousTemplate" awsType:"awsbool" templateName:"use-previous-template"`
 	Tags                []*string `awsName:"Tags" awsType:"awstagslice" templateName:"tags"`
 	PolicyBody          *string   `awsName:"StackPolicyBody" awsType:"awsstr"`
@@ -96,7 +98,7 @@ type UpdateStack struct {
 
 func (cmd *UpdateStack) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("name"),
-		params.Opt("capabilities", "notifications", "parameters", "policy-file", "policy-update-file", "resource-types", "role", "stack-file", "tags", "template-file", "use-previous-template"),
+		params.Opt("capabilities", "notifications", "parameters", "policy-file", "policy-update-file", "resource-types", "role", "stack-file", "tags", "template-file", "template-url", "use-previous-template"),
 	))
 }
 
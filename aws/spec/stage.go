@@ -0,0 +1,55 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateStage struct {
+	_           string `action:"create" entity:"stage" awsAPI:"apigateway" awsCall:"CreateStage" awsInput:"apigateway.CreateStageInput" awsOutput:"apigateway.Stage"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         apigatewayiface.APIGatewayAPI
+	Name        *string `awsName:"StageName" awsType:"awsstr" templateName:"name"`
+	Restapi     *string `awsName:"RestApiId" awsType:"awsstr" templateName:"restapi"`
+	Deployment  *string `awsName:"DeploymentId" awsType:"awsstr" templateName:"deployment"`
+	Description *string `awsName:"Description" awsType:"awsstr" templateName:"description"`
+}
+
+func (cmd *CreateStage) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("deployment"), params.Key("name"), params.Key("restapi"), params.Opt("description")))
+}
+
+func (cmd *CreateStage) ExtractResult(i interface{}) string {
+	return StringValue(cmd.Name)
+}
+
+type DeleteStage struct {
+	_       string `action:"delete" entity:"stage" awsAPI:"apigateway" awsCall:"DeleteStage" awsInput:"apigateway.DeleteStageInput" awsOutput:"apigateway.DeleteStageOutput"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     apigatewayiface.APIGatewayAPI
+	Name    *string `awsName:"StageName" awsType:"awsstr" templateName:"name"`
+	Restapi *string `awsName:"RestApiId" awsType:"awsstr" templateName:"restapi"`
+}
+
+func (cmd *DeleteStage) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Key("restapi")))
+}
@@ -16,6 +16,9 @@ limitations under the License.
 package awsspec
 
 import (
+	"errors"
+	"fmt"
+
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
@@ -31,6 +34,7 @@ type CreateSubnet struct {
 	graph            cloud.GraphAPI
 	api              ec2iface.EC2API
 	CIDR             *string `awsName:"CidrBlock" awsType:"awsstr" templateName:"cidr"`
+	IPv6CIDR         *string `awsName:"Ipv6CidrBlock" awsType:"awsstr" templateName:"ipv6"`
 	VPC              *string `awsName:"VpcId" awsType:"awsstr" templateName:"vpc"`
 	AvailabilityZone *string `awsName:"AvailabilityZone" awsType:"awsstr" templateName:"availabilityzone"`
 	Public           *bool   `awsType:"awsboolattribute" templateName:"public"`
@@ -39,8 +43,8 @@ type CreateSubnet struct {
 
 func (cmd *CreateSubnet) ParamsSpec() params.Spec {
 	return params.NewSpec(
-		params.AllOf(params.Key("cidr"), params.Key("vpc"), params.Opt(params.Suggested("name"), "availabilityzone", "public")),
-		params.Validators{"cidr": params.IsCIDR})
+		params.AllOf(params.Key("cidr"), params.Key("vpc"), params.Opt(params.Suggested("name"), "availabilityzone", "ipv6", "public")),
+		params.Validators{"cidr": params.IsCIDR, "ipv6": params.IsCIDR})
 }
 
 func (cmd *CreateSubnet) ExtractResult(i interface{}) string {
@@ -66,16 +70,87 @@ func (cmd *CreateSubnet) AfterRun(renv env.Running, output interface{}) error {
 }
 
 type UpdateSubnet struct {
-	_      string `action:"update" entity:"subnet" awsAPI:"ec2" awsCall:"ModifySubnetAttribute" awsInput:"ec2.ModifySubnetAttributeInput" awsOutput:"ec2.ModifySubnetAttributeOutput"`
-	logger *logger.Logger
-	graph  cloud.GraphAPI
-	api    ec2iface.EC2API
-	Id     *string `awsName:"SubnetId" awsType:"awsstr" templateName:"id"`
-	Public *bool   `awsName:"MapPublicIpOnLaunch" awsType:"awsboolattribute" templateName:"public"`
+	_          string `action:"update" entity:"subnet" awsAPI:"ec2"`
+	logger     *logger.Logger
+	graph      cloud.GraphAPI
+	api        ec2iface.EC2API
+	Id         *string `templateName:"id"`
+	Public     *bool   `templateName:"public"`
+	IPv6CIDR   *string `templateName:"ipv6"`
+	AssignIPv6 *bool   `templateName:"assign-ipv6"`
 }
 
 func (cmd *UpdateSubnet) ParamsSpec() params.Spec {
-	return params.NewSpec(params.AllOf(params.Key("id"), params.Opt("public")))
+	return params.NewSpec(
+		params.AllOf(params.Key("id"), params.Opt("assign-ipv6", "ipv6", "public")),
+		params.Validators{"ipv6": params.IsCIDR})
+}
+
+func (cmd *UpdateSubnet) PriorState(renv env.Running, params map[string]interface{}) (map[string]interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, err
+	}
+	if cmd.IPv6CIDR != nil {
+		return nil, errors.New("association of an IPv6 CIDR block cannot be reverted with an update")
+	}
+	out, err := cmd.api.DescribeSubnets(&ec2.DescribeSubnetsInput{SubnetIds: []*string{cmd.Id}})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.Subnets) == 0 {
+		return nil, fmt.Errorf("subnet %s not found", StringValue(cmd.Id))
+	}
+	state := make(map[string]interface{})
+	if cmd.Public != nil {
+		state["public"] = BoolValue(out.Subnets[0].MapPublicIpOnLaunch)
+	}
+	if cmd.AssignIPv6 != nil {
+		state["assign-ipv6"] = BoolValue(out.Subnets[0].AssignIpv6AddressOnCreation)
+	}
+	return state, nil
+}
+
+func (cmd *UpdateSubnet) ManualRun(renv env.Running) (output interface{}, err error) {
+	if cmd.IPv6CIDR != nil {
+		call := &awsCall{
+			fnName: "ec2.AssociateSubnetCidrBlock",
+			fn:     cmd.api.AssociateSubnetCidrBlock,
+			logger: cmd.logger,
+			setters: []setter{
+				{val: cmd.Id, fieldPath: "SubnetId", fieldType: awsstr},
+				{val: cmd.IPv6CIDR, fieldPath: "Ipv6CidrBlock", fieldType: awsstr},
+			},
+		}
+		if output, err = call.execute(&ec2.AssociateSubnetCidrBlockInput{}); err != nil {
+			return nil, err
+		}
+	}
+	// ModifySubnetAttribute only accepts one attribute per call
+	attributes := []struct {
+		val       *bool
+		fieldPath string
+	}{
+		{cmd.Public, "MapPublicIpOnLaunch"},
+		{cmd.AssignIPv6, "AssignIpv6AddressOnCreation"},
+	}
+	for _, attr := range attributes {
+		if attr.val == nil {
+			continue
+		}
+		call := &awsCall{
+			fnName: "ec2.ModifySubnetAttribute",
+			fn:     cmd.api.ModifySubnetAttribute,
+			logger: cmd.logger,
+			setters: []setter{
+				{val: cmd.Id, fieldPath: "SubnetId", fieldType: awsstr},
+				{val: attr.val, fieldPath: attr.fieldPath, fieldType: awsboolattribute},
+			},
+		}
+		if output, err = call.execute(&ec2.ModifySubnetAttributeInput{}); err != nil {
+			return nil, err
+		}
+	}
+	return output, nil
 }
 
 type DeleteSubnet struct {
@@ -16,11 +16,14 @@ limitations under the License.
 package awsspec
 
 import (
+	"strings"
+
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/params"
 )
 
@@ -34,12 +37,28 @@ type CreateSubscription struct {
 	Protocol *string `awsName:"Protocol" awsType:"awsstr" templateName:"protocol"`
 }
 
+// subscriptionPendingConfirmation is the subscription ARN returned while the endpoint
+// (email, http, ...) has not confirmed the subscription
+const subscriptionPendingConfirmation = "pending confirmation"
+
 func (cmd *CreateSubscription) ParamsSpec() params.Spec {
-	return params.NewSpec(params.AllOf(params.Key("endpoint"), params.Key("protocol"), params.Key("topic")))
+	return params.NewSpec(params.AllOf(params.Key("endpoint"), params.Key("protocol"), params.Key("topic")),
+		params.Validators{
+			"protocol": params.IsInEnumIgnoreCase("http", "https", "email", "email-json", "sms", "sqs", "application", "lambda"),
+		})
+}
+
+func (cmd *CreateSubscription) BeforeRun(renv env.Running) error {
+	cmd.Protocol = String(strings.ToLower(StringValue(cmd.Protocol)))
+	return nil
 }
 
 func (cmd *CreateSubscription) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*sns.SubscribeOutput).SubscriptionArn)
+	arn := awssdk.StringValue(i.(*sns.SubscribeOutput).SubscriptionArn)
+	if arn == subscriptionPendingConfirmation {
+		cmd.logger.Warningf("subscription of '%s' to topic '%s' is pending confirmation of the endpoint", StringValue(cmd.Endpoint), StringValue(cmd.Topic))
+	}
+	return arn
 }
 
 type DeleteSubscription struct {
@@ -0,0 +1,192 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+const defaultTableCapacity = 5
+
+var isTableKeyType = params.IsInEnumIgnoreCase("string", "number", "binary")
+
+type CreateTable struct {
+	_             string `action:"create" entity:"table" awsAPI:"dynamodb"`
+	logger        *logger.Logger
+	graph         cloud.GraphAPI
+	api           dynamodbiface.DynamoDBAPI
+	Name          *string `templateName:"name"`
+	HashKey       *string `templateName:"hash-key"`
+	HashKeyType   *string `templateName:"hash-key-type"`
+	RangeKey      *string `templateName:"range-key"`
+	RangeKeyType  *string `templateName:"range-key-type"`
+	ReadCapacity  *int64  `templateName:"read-capacity"`
+	WriteCapacity *int64  `templateName:"write-capacity"`
+}
+
+func (cmd *CreateTable) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("name"), params.Key("hash-key"),
+			params.Opt(params.Suggested("hash-key-type"), "range-key", "range-key-type", "read-capacity", "write-capacity"),
+		),
+		params.Validators{
+			"hash-key-type":  isTableKeyType,
+			"range-key-type": isTableKeyType,
+		})
+}
+
+func (cmd *CreateTable) ManualRun(renv env.Running) (interface{}, error) {
+	input := &dynamodb.CreateTableInput{
+		TableName: cmd.Name,
+		ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+			ReadCapacityUnits:  capacityOrDefault(cmd.ReadCapacity),
+			WriteCapacityUnits: capacityOrDefault(cmd.WriteCapacity),
+		},
+	}
+	addKey := func(name, typ *string, keyType string) {
+		input.KeySchema = append(input.KeySchema, &dynamodb.KeySchemaElement{AttributeName: name, KeyType: String(keyType)})
+		input.AttributeDefinitions = append(input.AttributeDefinitions, &dynamodb.AttributeDefinition{AttributeName: name, AttributeType: String(tableAttributeType(typ))})
+	}
+	addKey(cmd.HashKey, cmd.HashKeyType, dynamodb.KeyTypeHash)
+	if cmd.RangeKey != nil {
+		addKey(cmd.RangeKey, cmd.RangeKeyType, dynamodb.KeyTypeRange)
+	}
+
+	start := time.Now()
+	output, err := cmd.api.CreateTable(input)
+	if err != nil {
+		return nil, err
+	}
+	cmd.logger.ExtraVerbosef("dynamodb.CreateTable call took %s", time.Since(start))
+	return output, nil
+}
+
+func (cmd *CreateTable) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*dynamodb.CreateTableOutput).TableDescription.TableName)
+}
+
+type UpdateTable struct {
+	_             string `action:"update" entity:"table" awsAPI:"dynamodb"`
+	logger        *logger.Logger
+	graph         cloud.GraphAPI
+	api           dynamodbiface.DynamoDBAPI
+	Name          *string `templateName:"name"`
+	ReadCapacity  *int64  `templateName:"read-capacity"`
+	WriteCapacity *int64  `templateName:"write-capacity"`
+	TTLAttribute  *string `templateName:"ttl-attribute"`
+	TTLEnabled    *bool   `templateName:"ttl-enabled"`
+}
+
+func (cmd *UpdateTable) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("name"),
+			params.AtLeastOneOf(params.Key("read-capacity"), params.Key("write-capacity"), params.Key("ttl-attribute")),
+			params.Opt("ttl-enabled"),
+		),
+		params.Validators{
+			"ttl-enabled": func(i interface{}, others map[string]interface{}) error {
+				if _, ok := others["ttl-attribute"]; !ok {
+					return errors.New("'ttl-enabled' requires 'ttl-attribute'")
+				}
+				return nil
+			},
+		})
+}
+
+// ManualRun updates the provisioned throughput of the table, keeping the capacity not given,
+// then enables the TTL on the given attribute (or disables it with ttl-enabled=false)
+func (cmd *UpdateTable) ManualRun(renv env.Running) (interface{}, error) {
+	if cmd.ReadCapacity != nil || cmd.WriteCapacity != nil {
+		throughput := &dynamodb.ProvisionedThroughput{ReadCapacityUnits: cmd.ReadCapacity, WriteCapacityUnits: cmd.WriteCapacity}
+		if cmd.ReadCapacity == nil || cmd.WriteCapacity == nil {
+			out, err := cmd.api.DescribeTable(&dynamodb.DescribeTableInput{TableName: cmd.Name})
+			if err != nil {
+				return nil, err
+			}
+			if current := out.Table.ProvisionedThroughput; current != nil {
+				if throughput.ReadCapacityUnits == nil {
+					throughput.ReadCapacityUnits = current.ReadCapacityUnits
+				}
+				if throughput.WriteCapacityUnits == nil {
+					throughput.WriteCapacityUnits = current.WriteCapacityUnits
+				}
+			}
+		}
+		start := time.Now()
+		if _, err := cmd.api.UpdateTable(&dynamodb.UpdateTableInput{TableName: cmd.Name, ProvisionedThroughput: throughput}); err != nil {
+			return nil, err
+		}
+		cmd.logger.ExtraVerbosef("dynamodb.UpdateTable call took %s", time.Since(start))
+	}
+
+	if cmd.TTLAttribute != nil {
+		enabled := cmd.TTLEnabled == nil || BoolValue(cmd.TTLEnabled)
+		start := time.Now()
+		if _, err := cmd.api.UpdateTimeToLive(&dynamodb.UpdateTimeToLiveInput{
+			TableName:               cmd.Name,
+			TimeToLiveSpecification: &dynamodb.TimeToLiveSpecification{AttributeName: cmd.TTLAttribute, Enabled: Bool(enabled)},
+		}); err != nil {
+			return nil, err
+		}
+		cmd.logger.ExtraVerbosef("dynamodb.UpdateTimeToLive call took %s", time.Since(start))
+	}
+	return cmd.Name, nil
+}
+
+func (cmd *UpdateTable) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*string))
+}
+
+type DeleteTable struct {
+	_      string `action:"delete" entity:"table" awsAPI:"dynamodb" awsCall:"DeleteTable" awsInput:"dynamodb.DeleteTableInput" awsOutput:"dynamodb.DeleteTableOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    dynamodbiface.DynamoDBAPI
+	Name   *string `awsName:"TableName" awsType:"awsstr" templateName:"name"`
+}
+
+func (cmd *DeleteTable) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name")))
+}
+
+func capacityOrDefault(capacity *int64) *int64 {
+	if capacity == nil {
+		return Int64(defaultTableCapacity)
+	}
+	return capacity
+}
+
+// tableAttributeType returns the DynamoDB type (S, N or B) of a key type, string by default
+func tableAttributeType(typ *string) string {
+	switch strings.ToLower(awssdk.StringValue(typ)) {
+	case "number":
+		return dynamodb.ScalarAttributeTypeN
+	case "binary":
+		return dynamodb.ScalarAttributeTypeB
+	default:
+		return dynamodb.ScalarAttributeTypeS
+	}
+}
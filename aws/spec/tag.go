@@ -46,7 +46,7 @@ func (cmd *CreateTag) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("key"), params.Key("resource"), params.Key("value")))
 }
 
-func (cmd *CreateTag) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateTag) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("dry run: cannot set params on command struct: %s", err)
 	}
@@ -105,7 +105,7 @@ func (cmd *DeleteTag) ParamsSpec() params.Spec {
 	))
 }
 
-func (cmd *DeleteTag) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteTag) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -0,0 +1,120 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents/cloudwatcheventsiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+var invalidTargetIDChars = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+
+type AttachTarget struct {
+	_        string `action:"attach" entity:"target" awsAPI:"cloudwatchevents"`
+	logger   *logger.Logger
+	graph    cloud.GraphAPI
+	api      cloudwatcheventsiface.CloudWatchEventsAPI
+	Rule     *string `templateName:"rule"`
+	Id       *string `templateName:"id"`
+	Function *string `templateName:"function"`
+	Queue    *string `templateName:"queue"`
+	Topic    *string `templateName:"topic"`
+	Input    *string `templateName:"input"`
+}
+
+func (cmd *AttachTarget) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("rule"),
+		params.OnlyOneOf(params.Key("function"), params.Key("queue"), params.Key("topic")),
+		params.Opt("id", "input"),
+	))
+}
+
+// ManualRun adds the Lambda function, SQS queue or SNS topic to the targets of the rule,
+// identified by the name ending its ARN unless an ID is given
+func (cmd *AttachTarget) ManualRun(renv env.Running) (interface{}, error) {
+	arn := StringValue(cmd.Function)
+	if cmd.Queue != nil {
+		arn = StringValue(cmd.Queue)
+	} else if cmd.Topic != nil {
+		arn = StringValue(cmd.Topic)
+	}
+	if cmd.Id == nil {
+		cmd.Id = String(targetIDFromArn(arn))
+	}
+
+	out, err := cmd.api.PutTargets(&cloudwatchevents.PutTargetsInput{
+		Rule: cmd.Rule,
+		Targets: []*cloudwatchevents.Target{
+			{Id: cmd.Id, Arn: String(arn), Input: cmd.Input},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.FailedEntries) > 0 {
+		return nil, fmt.Errorf("attach target %s: %s", StringValue(cmd.Id), StringValue(out.FailedEntries[0].ErrorMessage))
+	}
+	return out, nil
+}
+
+func (cmd *AttachTarget) ExtractResult(i interface{}) string {
+	return StringValue(cmd.Id)
+}
+
+type DetachTarget struct {
+	_      string `action:"detach" entity:"target" awsAPI:"cloudwatchevents"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    cloudwatcheventsiface.CloudWatchEventsAPI
+	Rule   *string `templateName:"rule"`
+	Id     *string `templateName:"id"`
+}
+
+func (cmd *DetachTarget) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"), params.Key("rule")))
+}
+
+func (cmd *DetachTarget) ManualRun(renv env.Running) (interface{}, error) {
+	out, err := cmd.api.RemoveTargets(&cloudwatchevents.RemoveTargetsInput{
+		Rule: cmd.Rule,
+		Ids:  []*string{cmd.Id},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.FailedEntries) > 0 {
+		return nil, fmt.Errorf("detach target %s: %s", StringValue(cmd.Id), StringValue(out.FailedEntries[0].ErrorMessage))
+	}
+	return out, nil
+}
+
+// targetIDFromArn returns the name of the resource ending the ARN (ex: the function name
+// of arn:aws:lambda:eu-west-1:0123456789:function:backup) as a valid target ID
+func targetIDFromArn(arn string) string {
+	id := invalidTargetIDChars.ReplaceAllString(arn[strings.LastIndex(arn, ":")+1:], "-")
+	if len(id) > 64 {
+		id = id[:64]
+	}
+	return id
+}
@@ -0,0 +1,97 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+type CheckTcp struct {
+	_        string `action:"check" entity:"tcp" awsAPI:"ec2"`
+	logger   *logger.Logger
+	graph    cloud.GraphAPI
+	api      ec2iface.EC2API
+	Host     *string `templateName:"host"`
+	Instance *string `templateName:"instance"`
+	Port     *int64  `templateName:"port"`
+	Timeout  *string `templateName:"timeout"`
+}
+
+func (cmd *CheckTcp) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.OnlyOneOf(params.Key("host"), params.Key("instance")), params.Key("port"), params.Key("timeout")),
+		params.Validators{
+			"timeout": isCheckTimeout,
+		})
+}
+
+func (cmd *CheckTcp) ManualRun(renv env.Running) (interface{}, error) {
+	timeout, err := parseCheckTimeout(StringValue(cmd.Timeout))
+	if err != nil {
+		return nil, err
+	}
+	host := StringValue(cmd.Host)
+	if id := StringValue(cmd.Instance); id != "" {
+		if host, err = cmd.instancePrivateIP(id); err != nil {
+			return nil, err
+		}
+	}
+	addr := net.JoinHostPort(host, strconv.Itoa(Int64AsIntValue(cmd.Port)))
+
+	c := &checker{
+		renv:        renv,
+		description: fmt.Sprintf("tcp %s", addr),
+		timeout:     timeout,
+		frequency:   5 * time.Second,
+		fetchFunc: func() (string, error) {
+			conn, err := net.DialTimeout("tcp", addr, 5*time.Second)
+			if err != nil {
+				return unreachableState, nil
+			}
+			conn.Close()
+			return openState, nil
+		},
+		expect:    openState,
+		logger:    cmd.logger,
+		checkName: "port",
+	}
+	return nil, c.check()
+}
+
+func (cmd *CheckTcp) instancePrivateIP(id string) (string, error) {
+	out, err := cmd.api.DescribeInstances(&ec2.DescribeInstancesInput{InstanceIds: []*string{&id}})
+	if err != nil {
+		return "", fmt.Errorf("check tcp: %s", err)
+	}
+	for _, res := range out.Reservations {
+		for _, inst := range res.Instances {
+			if StringValue(inst.InstanceId) == id && StringValue(inst.PrivateIpAddress) != "" {
+				return StringValue(inst.PrivateIpAddress), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("check tcp: no private IP found for instance %s", id)
+}
@@ -0,0 +1,66 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateTrail struct {
+	_           string `action:"create" entity:"trail" awsAPI:"cloudtrail" awsCall:"CreateTrail" awsInput:"cloudtrail.CreateTrailInput" awsOutput:"cloudtrail.CreateTrailOutput"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         cloudtrailiface.CloudTrailAPI
+	Name        *string `awsName:"Name" awsType:"awsstr" templateName:"name"`
+	Bucket      *string `awsName:"S3BucketName" awsType:"awsstr" templateName:"bucket"`
+	Prefix      *string `awsName:"S3KeyPrefix" awsType:"awsstr" templateName:"prefix"`
+	Multiregion *bool   `awsName:"IsMultiRegionTrail" awsType:"awsbool" templateName:"multiregion"`
+	Key         *string `awsName:"KmsKeyId" awsType:"awsstr" templateName:"key"`
+}
+
+func (cmd *CreateTrail) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("bucket"), params.Key("name"),
+		params.Opt(params.Suggested("multiregion"), "key", "prefix"),
+	))
+}
+
+// AfterRun starts the logging of the trail, trails being created with logging turned off
+func (cmd *CreateTrail) AfterRun(renv env.Running, output interface{}) error {
+	_, err := cmd.api.StartLogging(&cloudtrail.StartLoggingInput{Name: output.(*cloudtrail.CreateTrailOutput).TrailARN})
+	return err
+}
+
+func (cmd *CreateTrail) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*cloudtrail.CreateTrailOutput).TrailARN)
+}
+
+type DeleteTrail struct {
+	_      string `action:"delete" entity:"trail" awsAPI:"cloudtrail" awsCall:"DeleteTrail" awsInput:"cloudtrail.DeleteTrailInput" awsOutput:"cloudtrail.DeleteTrailOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    cloudtrailiface.CloudTrailAPI
+	Id     *string `awsName:"Name" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *DeleteTrail) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
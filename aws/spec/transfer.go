@@ -0,0 +1,135 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TransferLimits controls the storage transfers, so that large ones do not saturate the network
+type TransferLimits struct {
+	// Bandwidth is the max number of bytes per second transferred, 0 is unlimited
+	Bandwidth int64
+	// Connections is the number of parts of a file transferred concurrently, 0 or 1 to transfer
+	// files in one request
+	Connections int
+}
+
+// DefaultTransferLimits applies to the transfers whose statements do not set bwlimit or connections
+var DefaultTransferLimits TransferLimits
+
+var bandwidthRegex = regexp.MustCompile(`^(\d+(?:\.\d+)?)\s*([kmg]?)(?:i?b)?(?:/s)?$`)
+
+// ParseBandwidth parses a bandwidth as a number of bytes per second with an optional
+// K, M or G unit (ex: 5MB/s, 500K, 1.5M/s, 1024)
+func ParseBandwidth(s string) (int64, error) {
+	matches := bandwidthRegex.FindStringSubmatch(strings.ToLower(strings.TrimSpace(s)))
+	if matches == nil {
+		return 0, fmt.Errorf("invalid bandwidth '%s', expecting bytes per second with an optional unit (ex: 5MB/s, 500KB/s)", s)
+	}
+	value, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid bandwidth '%s': %s", s, err)
+	}
+	switch matches[2] {
+	case "k":
+		value *= 1024
+	case "m":
+		value *= 1024 * 1024
+	case "g":
+		value *= 1024 * 1024 * 1024
+	}
+	return int64(value), nil
+}
+
+func isBandwidth(i interface{}, others map[string]interface{}) error {
+	_, err := ParseBandwidth(fmt.Sprint(i))
+	return err
+}
+
+// transferLimiter spaces out the reads of a transfer to stay under its bandwidth
+type transferLimiter struct {
+	mu        sync.Mutex
+	bandwidth int64
+	next      time.Time
+	now       func() time.Time
+	sleep     func(time.Duration)
+}
+
+func newTransferLimiter(bandwidth int64) *transferLimiter {
+	return &transferLimiter{bandwidth: bandwidth, now: time.Now, sleep: time.Sleep}
+}
+
+// chunk is the max size of a read, so that the transfer is smooth rather than by bursts
+func (l *transferLimiter) chunk() int {
+	if c := l.bandwidth / 10; c > 0 {
+		return int(c)
+	}
+	return 1
+}
+
+// wait blocks until n more bytes can be transferred
+func (l *transferLimiter) wait(n int) {
+	l.mu.Lock()
+	now := l.now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	wait := l.next.Sub(now)
+	l.next = l.next.Add(time.Duration(float64(n) / float64(l.bandwidth) * float64(time.Second)))
+	l.mu.Unlock()
+	if wait > 0 {
+		l.sleep(wait)
+	}
+}
+
+// throttledReadSeeker limits the bandwidth of the reads past its first unthrottled bytes.
+// Those allow the SDK to read the body once in memory (ex: to sign the request) before
+// the actual upload
+type throttledReadSeeker struct {
+	io.ReadSeeker
+	limiter     *transferLimiter
+	unthrottled int64
+	read        int64
+}
+
+func newThrottledReadSeeker(r io.ReadSeeker, bandwidth, unthrottled int64) io.ReadSeeker {
+	if bandwidth <= 0 {
+		return r
+	}
+	return &throttledReadSeeker{ReadSeeker: r, limiter: newTransferLimiter(bandwidth), unthrottled: unthrottled}
+}
+
+func (r *throttledReadSeeker) Read(p []byte) (int, error) {
+	if r.read >= r.unthrottled && len(p) > r.limiter.chunk() {
+		p = p[:r.limiter.chunk()]
+	}
+	n, err := r.ReadSeeker.Read(p)
+	if throttled := r.read + int64(n) - r.unthrottled; throttled > 0 {
+		if throttled > int64(n) {
+			throttled = int64(n)
+		}
+		r.limiter.wait(int(throttled))
+	}
+	r.read += int64(n)
+	return n, err
+}
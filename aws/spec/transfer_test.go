@@ -0,0 +1,80 @@
+package awsspec
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func TestParseBandwidth(t *testing.T) {
+	tcases := []struct {
+		in  string
+		exp int64
+	}{
+		{in: "1024", exp: 1024},
+		{in: "500K", exp: 500 * 1024},
+		{in: "500KB/s", exp: 500 * 1024},
+		{in: "5MB/s", exp: 5 * 1024 * 1024},
+		{in: "5mb/s", exp: 5 * 1024 * 1024},
+		{in: "1.5M", exp: 1024 * 1024 * 3 / 2},
+		{in: "2GiB/s", exp: 2 * 1024 * 1024 * 1024},
+	}
+	for _, tcase := range tcases {
+		got, err := ParseBandwidth(tcase.in)
+		if err != nil {
+			t.Fatalf("%s: %s", tcase.in, err)
+		}
+		if got != tcase.exp {
+			t.Fatalf("%s: got %d, want %d", tcase.in, got, tcase.exp)
+		}
+	}
+	for _, in := range []string{"", "fast", "5TB/s", "-5M", "5 MB/h"} {
+		if _, err := ParseBandwidth(in); err == nil {
+			t.Fatalf("%s: expected error", in)
+		}
+	}
+}
+
+func TestThrottledReadSeeker(t *testing.T) {
+	content := bytes.Repeat([]byte("a"), 1000)
+	if r := newThrottledReadSeeker(bytes.NewReader(content), 0, 0); r == nil {
+		t.Fatal("expected reader")
+	} else if _, ok := r.(*throttledReadSeeker); ok {
+		t.Fatal("expected reader not to be throttled without bandwidth")
+	}
+
+	r := newThrottledReadSeeker(bytes.NewReader(content), 100, 1000).(*throttledReadSeeker)
+	var slept time.Duration
+	clock := time.Now()
+	r.limiter.now = func() time.Time { return clock }
+	r.limiter.sleep = func(d time.Duration) {
+		slept += d
+		clock = clock.Add(d)
+	}
+
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, content) {
+		t.Fatal("unexpected content")
+	}
+	if slept != 0 {
+		t.Fatalf("expected first read not throttled, slept %s", slept)
+	}
+
+	if _, err = r.Seek(0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if b, err = ioutil.ReadAll(r); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, content) {
+		t.Fatal("unexpected content")
+	}
+	// 1000 bytes at 100 bytes/s, the first 10 bytes chunk being sent without wait
+	if slept < 9*time.Second || slept > 10*time.Second {
+		t.Fatalf("expected about 10s throttling, got %s", slept)
+	}
+}
@@ -0,0 +1,328 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/wallix/awless/aws/ec2transitgateway"
+	"github.com/wallix/awless/aws/ec2transitgateway/ec2transitgatewayiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+var isEnableOrDisable = params.IsInEnumIgnoreCase("enable", "disable")
+
+type CreateTransitgateway struct {
+	_                  string `action:"create" entity:"transitgateway" awsAPI:"ec2" awsCall:"CreateTransitGateway" awsInput:"ec2transitgateway.CreateTransitGatewayInput" awsOutput:"ec2transitgateway.CreateTransitGatewayOutput" awsDryRun:""`
+	logger             *logger.Logger
+	graph              cloud.GraphAPI
+	api                ec2transitgatewayiface.EC2TransitGatewayAPI
+	Description        *string `awsName:"Description" awsType:"awsstr" templateName:"description"`
+	AmazonAsn          *int64  `awsName:"Options.AmazonSideAsn" awsType:"awsint64" templateName:"amazon-asn"`
+	DnsSupport         *string `awsName:"Options.DnsSupport" awsType:"awsstr" templateName:"dns-support"`
+	VpnEcmpSupport     *string `awsName:"Options.VpnEcmpSupport" awsType:"awsstr" templateName:"vpn-ecmp-support"`
+	DefaultAssociation *string `awsName:"Options.DefaultRouteTableAssociation" awsType:"awsstr" templateName:"default-association"`
+	DefaultPropagation *string `awsName:"Options.DefaultRouteTablePropagation" awsType:"awsstr" templateName:"default-propagation"`
+	AutoAccept         *string `awsName:"Options.AutoAcceptSharedAttachments" awsType:"awsstr" templateName:"auto-accept"`
+	Name               *string `templateName:"name"`
+}
+
+func (cmd *CreateTransitgateway) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Opt(params.Suggested("name"), "amazon-asn", "auto-accept", "default-association", "default-propagation", "description", "dns-support", "vpn-ecmp-support")),
+		params.Validators{
+			"auto-accept":         isEnableOrDisable,
+			"default-association": isEnableOrDisable,
+			"default-propagation": isEnableOrDisable,
+			"dns-support":         isEnableOrDisable,
+			"vpn-ecmp-support":    isEnableOrDisable,
+		})
+}
+
+func (cmd *CreateTransitgateway) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*ec2transitgateway.CreateTransitGatewayOutput).TransitGateway.TransitGatewayId)
+}
+
+func (cmd *CreateTransitgateway) AfterRun(renv env.Running, output interface{}) error {
+	if cmd.Name == nil {
+		return nil
+	}
+	return createNameTag(awssdk.String(cmd.ExtractResult(output)), cmd.Name, renv)
+}
+
+type DeleteTransitgateway struct {
+	_      string `action:"delete" entity:"transitgateway" awsAPI:"ec2" awsCall:"DeleteTransitGateway" awsInput:"ec2transitgateway.DeleteTransitGatewayInput" awsOutput:"ec2transitgateway.DeleteTransitGatewayOutput" awsDryRun:""`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2transitgatewayiface.EC2TransitGatewayAPI
+	Id     *string `awsName:"TransitGatewayId" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *DeleteTransitgateway) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
+
+type CheckTransitgateway struct {
+	_       string `action:"check" entity:"transitgateway" awsAPI:"ec2"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     ec2transitgatewayiface.EC2TransitGatewayAPI
+	Id      *string `templateName:"id"`
+	State   *string `templateName:"state"`
+	Timeout *int64  `templateName:"timeout"`
+}
+
+func (cmd *CheckTransitgateway) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("id"), params.Key("state"), params.Key("timeout")),
+		params.Validators{
+			"state": params.IsInEnumIgnoreCase("pending", "available", "modifying", "deleting", "deleted", notFoundState),
+		})
+}
+
+func (cmd *CheckTransitgateway) ManualRun(renv env.Running) (interface{}, error) {
+	c := &checker{
+		renv:        renv,
+		description: fmt.Sprintf("transitgateway %s", StringValue(cmd.Id)),
+		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
+		frequency:   5 * time.Second,
+		fetchFunc: func() (string, error) {
+			output, err := cmd.api.DescribeTransitGateways(&ec2transitgateway.DescribeTransitGatewaysInput{TransitGatewayIds: []*string{cmd.Id}})
+			if err != nil {
+				return transitgatewayNotFoundState(err)
+			}
+			for _, tgw := range output.TransitGateways {
+				if StringValue(tgw.TransitGatewayId) == StringValue(cmd.Id) {
+					return StringValue(tgw.State), nil
+				}
+			}
+			return notFoundState, nil
+		},
+		expect: StringValue(cmd.State),
+		logger: cmd.logger,
+	}
+	return nil, c.check()
+}
+
+type CreateTransitgatewayattachment struct {
+	_              string `action:"create" entity:"transitgatewayattachment" awsAPI:"ec2" awsCall:"CreateTransitGatewayVpcAttachment" awsInput:"ec2transitgateway.CreateTransitGatewayVpcAttachmentInput" awsOutput:"ec2transitgateway.CreateTransitGatewayVpcAttachmentOutput" awsDryRun:""`
+	logger         *logger.Logger
+	graph          cloud.GraphAPI
+	api            ec2transitgatewayiface.EC2TransitGatewayAPI
+	Transitgateway *string   `awsName:"TransitGatewayId" awsType:"awsstr" templateName:"transitgateway"`
+	Vpc            *string   `awsName:"VpcId" awsType:"awsstr" templateName:"vpc"`
+	Subnets        []*string `awsName:"SubnetIds" awsType:"awsstringslice" templateName:"subnets"`
+	DnsSupport     *string   `awsName:"Options.DnsSupport" awsType:"awsstr" templateName:"dns-support"`
+	Ipv6Support    *string   `awsName:"Options.Ipv6Support" awsType:"awsstr" templateName:"ipv6-support"`
+	Name           *string   `templateName:"name"`
+}
+
+func (cmd *CreateTransitgatewayattachment) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("transitgateway"), params.Key("vpc"), params.Key("subnets"),
+			params.Opt(params.Suggested("name"), "dns-support", "ipv6-support"),
+		),
+		params.Validators{
+			"dns-support":  isEnableOrDisable,
+			"ipv6-support": isEnableOrDisable,
+		})
+}
+
+func (cmd *CreateTransitgatewayattachment) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*ec2transitgateway.CreateTransitGatewayVpcAttachmentOutput).TransitGatewayVpcAttachment.TransitGatewayAttachmentId)
+}
+
+func (cmd *CreateTransitgatewayattachment) AfterRun(renv env.Running, output interface{}) error {
+	if cmd.Name == nil {
+		return nil
+	}
+	return createNameTag(awssdk.String(cmd.ExtractResult(output)), cmd.Name, renv)
+}
+
+type DeleteTransitgatewayattachment struct {
+	_      string `action:"delete" entity:"transitgatewayattachment" awsAPI:"ec2" awsCall:"DeleteTransitGatewayVpcAttachment" awsInput:"ec2transitgateway.DeleteTransitGatewayVpcAttachmentInput" awsOutput:"ec2transitgateway.DeleteTransitGatewayVpcAttachmentOutput" awsDryRun:""`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2transitgatewayiface.EC2TransitGatewayAPI
+	Id     *string `awsName:"TransitGatewayAttachmentId" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *DeleteTransitgatewayattachment) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
+
+type CheckTransitgatewayattachment struct {
+	_       string `action:"check" entity:"transitgatewayattachment" awsAPI:"ec2"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     ec2transitgatewayiface.EC2TransitGatewayAPI
+	Id      *string `templateName:"id"`
+	State   *string `templateName:"state"`
+	Timeout *int64  `templateName:"timeout"`
+}
+
+func (cmd *CheckTransitgatewayattachment) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("id"), params.Key("state"), params.Key("timeout")),
+		params.Validators{
+			"state": params.IsInEnumIgnoreCase("pendingAcceptance", "rollingBack", "pending", "available", "modifying", "deleting", "deleted",
+				"failed", "rejected", "rejecting", "failing", notFoundState),
+		})
+}
+
+func (cmd *CheckTransitgatewayattachment) ManualRun(renv env.Running) (interface{}, error) {
+	c := &checker{
+		renv:        renv,
+		description: fmt.Sprintf("transitgatewayattachment %s", StringValue(cmd.Id)),
+		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
+		frequency:   5 * time.Second,
+		fetchFunc: func() (string, error) {
+			output, err := cmd.api.DescribeTransitGatewayAttachments(&ec2transitgateway.DescribeTransitGatewayAttachmentsInput{TransitGatewayAttachmentIds: []*string{cmd.Id}})
+			if err != nil {
+				return transitgatewayNotFoundState(err)
+			}
+			for _, attachment := range output.TransitGatewayAttachments {
+				if StringValue(attachment.TransitGatewayAttachmentId) == StringValue(cmd.Id) {
+					return StringValue(attachment.State), nil
+				}
+			}
+			return notFoundState, nil
+		},
+		expect: StringValue(cmd.State),
+		logger: cmd.logger,
+	}
+	return nil, c.check()
+}
+
+type CreateTransitgatewayroutetable struct {
+	_              string `action:"create" entity:"transitgatewayroutetable" awsAPI:"ec2" awsCall:"CreateTransitGatewayRouteTable" awsInput:"ec2transitgateway.CreateTransitGatewayRouteTableInput" awsOutput:"ec2transitgateway.CreateTransitGatewayRouteTableOutput" awsDryRun:""`
+	logger         *logger.Logger
+	graph          cloud.GraphAPI
+	api            ec2transitgatewayiface.EC2TransitGatewayAPI
+	Transitgateway *string `awsName:"TransitGatewayId" awsType:"awsstr" templateName:"transitgateway"`
+	Name           *string `templateName:"name"`
+}
+
+func (cmd *CreateTransitgatewayroutetable) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("transitgateway"), params.Opt(params.Suggested("name"))))
+}
+
+func (cmd *CreateTransitgatewayroutetable) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*ec2transitgateway.CreateTransitGatewayRouteTableOutput).TransitGatewayRouteTable.TransitGatewayRouteTableId)
+}
+
+func (cmd *CreateTransitgatewayroutetable) AfterRun(renv env.Running, output interface{}) error {
+	if cmd.Name == nil {
+		return nil
+	}
+	return createNameTag(awssdk.String(cmd.ExtractResult(output)), cmd.Name, renv)
+}
+
+type DeleteTransitgatewayroutetable struct {
+	_      string `action:"delete" entity:"transitgatewayroutetable" awsAPI:"ec2" awsCall:"DeleteTransitGatewayRouteTable" awsInput:"ec2transitgateway.DeleteTransitGatewayRouteTableInput" awsOutput:"ec2transitgateway.DeleteTransitGatewayRouteTableOutput" awsDryRun:""`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2transitgatewayiface.EC2TransitGatewayAPI
+	Id     *string `awsName:"TransitGatewayRouteTableId" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *DeleteTransitgatewayroutetable) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
+
+type CheckTransitgatewayroutetable struct {
+	_       string `action:"check" entity:"transitgatewayroutetable" awsAPI:"ec2"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     ec2transitgatewayiface.EC2TransitGatewayAPI
+	Id      *string `templateName:"id"`
+	State   *string `templateName:"state"`
+	Timeout *int64  `templateName:"timeout"`
+}
+
+func (cmd *CheckTransitgatewayroutetable) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("id"), params.Key("state"), params.Key("timeout")),
+		params.Validators{
+			"state": params.IsInEnumIgnoreCase("pending", "available", "deleting", "deleted", notFoundState),
+		})
+}
+
+func (cmd *CheckTransitgatewayroutetable) ManualRun(renv env.Running) (interface{}, error) {
+	c := &checker{
+		renv:        renv,
+		description: fmt.Sprintf("transitgatewayroutetable %s", StringValue(cmd.Id)),
+		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
+		frequency:   5 * time.Second,
+		fetchFunc: func() (string, error) {
+			output, err := cmd.api.DescribeTransitGatewayRouteTables(&ec2transitgateway.DescribeTransitGatewayRouteTablesInput{TransitGatewayRouteTableIds: []*string{cmd.Id}})
+			if err != nil {
+				return transitgatewayNotFoundState(err)
+			}
+			for _, table := range output.TransitGatewayRouteTables {
+				if StringValue(table.TransitGatewayRouteTableId) == StringValue(cmd.Id) {
+					return StringValue(table.State), nil
+				}
+			}
+			return notFoundState, nil
+		},
+		expect: StringValue(cmd.State),
+		logger: cmd.logger,
+	}
+	return nil, c.check()
+}
+
+type AttachTransitgatewayroutetable struct {
+	_          string `action:"attach" entity:"transitgatewayroutetable" awsAPI:"ec2" awsCall:"AssociateTransitGatewayRouteTable" awsInput:"ec2transitgateway.AssociateTransitGatewayRouteTableInput" awsOutput:"ec2transitgateway.AssociateTransitGatewayRouteTableOutput" awsDryRun:""`
+	logger     *logger.Logger
+	graph      cloud.GraphAPI
+	api        ec2transitgatewayiface.EC2TransitGatewayAPI
+	Id         *string `awsName:"TransitGatewayRouteTableId" awsType:"awsstr" templateName:"id"`
+	Attachment *string `awsName:"TransitGatewayAttachmentId" awsType:"awsstr" templateName:"attachment"`
+}
+
+func (cmd *AttachTransitgatewayroutetable) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"), params.Key("attachment")))
+}
+
+type DetachTransitgatewayroutetable struct {
+	_          string `action:"detach" entity:"transitgatewayroutetable" awsAPI:"ec2" awsCall:"DisassociateTransitGatewayRouteTable" awsInput:"ec2transitgateway.DisassociateTransitGatewayRouteTableInput" awsOutput:"ec2transitgateway.DisassociateTransitGatewayRouteTableOutput" awsDryRun:""`
+	logger     *logger.Logger
+	graph      cloud.GraphAPI
+	api        ec2transitgatewayiface.EC2TransitGatewayAPI
+	Id         *string `awsName:"TransitGatewayRouteTableId" awsType:"awsstr" templateName:"id"`
+	Attachment *string `awsName:"TransitGatewayAttachmentId" awsType:"awsstr" templateName:"attachment"`
+}
+
+func (cmd *DetachTransitgatewayroutetable) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"), params.Key("attachment")))
+}
+
+// transitgatewayNotFoundState maps the InvalidTransitGatewayID.NotFound like errors,
+// returned when describing unknown ids, to the not-found state
+func transitgatewayNotFoundState(err error) (string, error) {
+	if awserr, ok := err.(awserr.Error); ok && strings.HasSuffix(awserr.Code(), notFound) {
+		return notFoundState, nil
+	}
+	return "", err
+}
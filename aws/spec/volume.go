@@ -36,16 +36,50 @@ type CreateVolume struct {
 	api              ec2iface.EC2API
 	Availabilityzone *string `awsName:"AvailabilityZone" awsType:"awsstr" templateName:"availabilityzone"`
 	Size             *int64  `awsName:"Size" awsType:"awsint64" templateName:"size"`
+	Type             *string `awsName:"VolumeType" awsType:"awsstr" templateName:"type"`
+	Iops             *int64  `awsName:"Iops" awsType:"awsint64" templateName:"iops"`
+	Encrypted        *bool   `awsName:"Encrypted" awsType:"awsbool" templateName:"encrypted"`
+	KmsKey           *string `awsName:"KmsKeyId" awsType:"awsstr" templateName:"kms-key"`
 }
 
 func (cmd *CreateVolume) ParamsSpec() params.Spec {
-	return params.NewSpec(params.AllOf(params.Key("availabilityzone"), params.Key("size")))
+	builder := params.SpecBuilder(params.AllOf(params.Key("availabilityzone"), params.Key("size"),
+		params.Opt("encrypted", "iops", "kms-key", "type"),
+	), params.Validators{"type": validVolumeType})
+	builder.AddReducer(encryptWithKMSKey, "encrypted", "kms-key")
+	return builder.Done()
 }
 
 func (cmd *CreateVolume) ExtractResult(i interface{}) string {
 	return awssdk.StringValue(i.(*ec2.Volume).VolumeId)
 }
 
+type RestoreVolume struct {
+	_                string `action:"restore" entity:"volume" awsAPI:"ec2" awsCall:"CreateVolume" awsInput:"ec2.CreateVolumeInput" awsOutput:"ec2.Volume" awsDryRun:""`
+	logger           *logger.Logger
+	graph            cloud.GraphAPI
+	api              ec2iface.EC2API
+	Snapshot         *string `awsName:"SnapshotId" awsType:"awsstr" templateName:"snapshot"`
+	Availabilityzone *string `awsName:"AvailabilityZone" awsType:"awsstr" templateName:"availabilityzone"`
+	Size             *int64  `awsName:"Size" awsType:"awsint64" templateName:"size"`
+	Type             *string `awsName:"VolumeType" awsType:"awsstr" templateName:"type"`
+	Iops             *int64  `awsName:"Iops" awsType:"awsint64" templateName:"iops"`
+	Encrypted        *bool   `awsName:"Encrypted" awsType:"awsbool" templateName:"encrypted"`
+	KmsKey           *string `awsName:"KmsKeyId" awsType:"awsstr" templateName:"kms-key"`
+}
+
+func (cmd *RestoreVolume) ParamsSpec() params.Spec {
+	builder := params.SpecBuilder(params.AllOf(params.Key("availabilityzone"), params.Key("snapshot"),
+		params.Opt("encrypted", "iops", "kms-key", "size", "type"),
+	), params.Validators{"type": validVolumeType})
+	builder.AddReducer(encryptWithKMSKey, "encrypted", "kms-key")
+	return builder.Done()
+}
+
+func (cmd *RestoreVolume) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*ec2.Volume).VolumeId)
+}
+
 type CheckVolume struct {
 	_       string `action:"check" entity:"volume" awsAPI:"ec2"`
 	logger  *logger.Logger
@@ -69,6 +103,7 @@ func (cmd *CheckVolume) ManualRun(renv env.Running) (interface{}, error) {
 	input := &ec2.DescribeVolumesInput{VolumeIds: []*string{cmd.Id}}
 
 	c := &checker{
+		renv:        renv,
 		description: fmt.Sprintf("volume %s", StringValue(cmd.Id)),
 		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
 		frequency:   5 * time.Second,
@@ -146,3 +181,13 @@ func (cmd *DetachVolume) ParamsSpec() params.Spec {
 func (cmd *DetachVolume) ExtractResult(i interface{}) string {
 	return awssdk.StringValue(i.(*ec2.VolumeAttachment).VolumeId)
 }
+
+var validVolumeType = params.IsInEnumIgnoreCase("gp2", "io1", "st1", "sc1", "standard")
+
+// encryptWithKMSKey encrypts the volumes and snapshots given a KMS key, AWS rejecting the key otherwise
+func encryptWithKMSKey(values map[string]interface{}) (map[string]interface{}, error) {
+	if _, hasKey := values["kms-key"]; hasKey {
+		values["encrypted"] = true
+	}
+	return values, nil
+}
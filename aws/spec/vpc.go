@@ -32,12 +32,13 @@ type CreateVpc struct {
 	graph  cloud.GraphAPI
 	api    ec2iface.EC2API
 	CIDR   *string `awsName:"CidrBlock" awsType:"awsstr" templateName:"cidr"`
+	IPv6   *bool   `awsName:"AmazonProvidedIpv6CidrBlock" awsType:"awsbool" templateName:"ipv6"`
 	Name   *string `awsName:"Name" templateName:"name"`
 }
 
 func (cmd *CreateVpc) ParamsSpec() params.Spec {
 	return params.NewSpec(
-		params.AllOf(params.Key("cidr"), params.Opt(params.Suggested("name"))),
+		params.AllOf(params.Key("cidr"), params.Opt(params.Suggested("name"), "ipv6")),
 		params.Validators{"cidr": params.IsCIDR})
 }
 
@@ -49,6 +50,33 @@ func (cmd *CreateVpc) AfterRun(renv env.Running, output interface{}) error {
 	return createNameTag(awssdk.String(cmd.ExtractResult(output)), cmd.Name, renv)
 }
 
+type UpdateVpc struct {
+	_      string `action:"update" entity:"vpc" awsAPI:"ec2"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2iface.EC2API
+	Id     *string `templateName:"id"`
+	IPv6   *string `templateName:"ipv6"`
+}
+
+func (cmd *UpdateVpc) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("id"), params.Key("ipv6")),
+		params.Validators{"ipv6": params.IsInEnumIgnoreCase("auto")})
+}
+
+func (cmd *UpdateVpc) ManualRun(renv env.Running) (interface{}, error) {
+	call := &awsCall{
+		fnName: "ec2.AssociateVpcCidrBlock",
+		fn:     cmd.api.AssociateVpcCidrBlock,
+		logger: cmd.logger,
+		setters: []setter{
+			{val: cmd.Id, fieldPath: "VpcId", fieldType: awsstr},
+		},
+	}
+	return call.execute(&ec2.AssociateVpcCidrBlockInput{AmazonProvidedIpv6CidrBlock: Bool(true)})
+}
+
 type DeleteVpc struct {
 	_      string `action:"delete" entity:"vpc" awsAPI:"ec2" awsCall:"DeleteVpc" awsInput:"ec2.DeleteVpcInput" awsOutput:"ec2.DeleteVpcOutput" awsDryRun:""`
 	logger *logger.Logger
@@ -0,0 +1,113 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/wallix/awless/logger"
+)
+
+type CreateVpcendpoint struct {
+	_              string `action:"create" entity:"vpcendpoint" awsAPI:"ec2" awsCall:"CreateVpcEndpoint" awsInput:"ec2.CreateVpcEndpointInput" awsOutput:"ec2.CreateVpcEndpointOutput" awsDryRun:""`
+	logger         *logger.Logger
+	graph          cloud.GraphAPI
+	api            ec2iface.EC2API
+	Vpc            *string   `awsName:"VpcId" awsType:"awsstr" templateName:"vpc"`
+	Service        *string   `awsName:"ServiceName" awsType:"awsstr" templateName:"service"`
+	Type           *string   `awsName:"VpcEndpointType" awsType:"awsstr" templateName:"type"`
+	RouteTables    []*string `awsName:"RouteTableIds" awsType:"awsstringslice" templateName:"routetables"`
+	Subnets        []*string `awsName:"SubnetIds" awsType:"awsstringslice" templateName:"subnets"`
+	SecurityGroups []*string `awsName:"SecurityGroupIds" awsType:"awsstringslice" templateName:"securitygroups"`
+	PrivateDNS     *bool     `awsName:"PrivateDnsEnabled" awsType:"awsbool" templateName:"private-dns"`
+	Policy         *string   `awsName:"PolicyDocument" awsType:"awsstr" templateName:"policy"`
+}
+
+func (cmd *CreateVpcendpoint) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("service"), params.Key("vpc"),
+			params.Opt("policy", "private-dns", "routetables", "securitygroups", "subnets", "type"),
+		),
+		params.Validators{
+			"type": func(i interface{}, others map[string]interface{}) error {
+				if err := params.IsInEnumIgnoreCase("gateway", "interface")(i, others); err != nil {
+					return err
+				}
+				if strings.EqualFold(fmt.Sprint(i), ec2.VpcEndpointTypeInterface) {
+					if _, ok := others["routetables"]; ok {
+						return errors.New("'routetables' only applicable to gateway endpoints")
+					}
+					return nil
+				}
+				for _, p := range []string{"private-dns", "securitygroups", "subnets"} {
+					if _, ok := others[p]; ok {
+						return fmt.Errorf("'%s' only applicable to interface endpoints", p)
+					}
+				}
+				return nil
+			},
+		})
+}
+
+// BeforeRun sets the endpoint type as expected by the API (i.e. Gateway or Interface)
+func (cmd *CreateVpcendpoint) BeforeRun(renv env.Running) error {
+	switch strings.ToLower(StringValue(cmd.Type)) {
+	case "gateway":
+		cmd.Type = String(ec2.VpcEndpointTypeGateway)
+	case "interface":
+		cmd.Type = String(ec2.VpcEndpointTypeInterface)
+	}
+	return nil
+}
+
+func (cmd *CreateVpcendpoint) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*ec2.CreateVpcEndpointOutput).VpcEndpoint.VpcEndpointId)
+}
+
+type DeleteVpcendpoint struct {
+	_      string `action:"delete" entity:"vpcendpoint" awsAPI:"ec2" awsCall:"DeleteVpcEndpoints" awsInput:"ec2.DeleteVpcEndpointsInput" awsOutput:"ec2.DeleteVpcEndpointsOutput" awsDryRun:""`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2iface.EC2API
+	Id     *string `awsName:"VpcEndpointIds" awsType:"awsstringslice" templateName:"id"`
+}
+
+func (cmd *DeleteVpcendpoint) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
+
+// AfterRun reports the endpoint deletion failure, the API returning the unsuccessful deletions without error
+func (cmd *DeleteVpcendpoint) AfterRun(renv env.Running, output interface{}) error {
+	out, ok := output.(*ec2.DeleteVpcEndpointsOutput)
+	if !ok || out == nil {
+		return nil
+	}
+	for _, unsuccessful := range out.Unsuccessful {
+		if unsuccessful.Error != nil {
+			return fmt.Errorf("delete vpcendpoint %s: %s", StringValue(unsuccessful.ResourceId), StringValue(unsuccessful.Error.Message))
+		}
+	}
+	return nil
+}
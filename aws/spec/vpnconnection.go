@@ -0,0 +1,163 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/wallix/awless/logger"
+)
+
+type CreateVpnconnection struct {
+	_                string `action:"create" entity:"vpnconnection" awsAPI:"ec2" awsCall:"CreateVpnConnection" awsInput:"ec2.CreateVpnConnectionInput" awsOutput:"ec2.CreateVpnConnectionOutput" awsDryRun:""`
+	logger           *logger.Logger
+	graph            cloud.GraphAPI
+	api              ec2iface.EC2API
+	Customergateway  *string `awsName:"CustomerGatewayId" awsType:"awsstr" templateName:"customergateway"`
+	Vpngateway       *string `awsName:"VpnGatewayId" awsType:"awsstr" templateName:"vpngateway"`
+	Type             *string `awsName:"Type" awsType:"awsstr" templateName:"type"`
+	StaticRoutesOnly *bool   `awsName:"Options.StaticRoutesOnly" awsType:"awsbool" templateName:"static-routes-only"`
+	ConfigFile       *string `templateName:"config-file"`
+	Name             *string `templateName:"name"`
+}
+
+func (cmd *CreateVpnconnection) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("customergateway"), params.Key("vpngateway"),
+			params.Opt(params.Suggested("name"), "config-file", "static-routes-only", "type"),
+		),
+		params.Validators{
+			"type": params.IsInEnumIgnoreCase(ec2.GatewayTypeIpsec1),
+			"config-file": func(i interface{}, others map[string]interface{}) error {
+				if _, err := os.Stat(fmt.Sprint(i)); err == nil {
+					return fmt.Errorf("file already exists at path: %v", i)
+				}
+				return nil
+			},
+		})
+}
+
+// BeforeRun defaults the connection type to the only one supported by AWS, i.e. ipsec.1
+func (cmd *CreateVpnconnection) BeforeRun(renv env.Running) error {
+	if cmd.Type == nil {
+		cmd.Type = String(ec2.GatewayTypeIpsec1)
+	}
+	return nil
+}
+
+func (cmd *CreateVpnconnection) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*ec2.CreateVpnConnectionOutput).VpnConnection.VpnConnectionId)
+}
+
+// AfterRun names the connection and saves the configuration of the customer gateway
+// (tunnels, pre-shared keys, ...) that AWS returns only while the connection is alive
+func (cmd *CreateVpnconnection) AfterRun(renv env.Running, output interface{}) error {
+	if cmd.Name != nil {
+		if err := createNameTag(awssdk.String(cmd.ExtractResult(output)), cmd.Name, renv); err != nil {
+			return err
+		}
+	}
+	if cmd.ConfigFile == nil {
+		return nil
+	}
+	config := StringValue(output.(*ec2.CreateVpnConnectionOutput).VpnConnection.CustomerGatewayConfiguration)
+	if err := ioutil.WriteFile(StringValue(cmd.ConfigFile), []byte(config), 0600); err != nil {
+		return fmt.Errorf("saving customer gateway configuration: %s", err)
+	}
+	renv.Log().Infof("customer gateway configuration of vpnconnection %s saved at %s", cmd.ExtractResult(output), StringValue(cmd.ConfigFile))
+	return nil
+}
+
+type DeleteVpnconnection struct {
+	_      string `action:"delete" entity:"vpnconnection" awsAPI:"ec2" awsCall:"DeleteVpnConnection" awsInput:"ec2.DeleteVpnConnectionInput" awsOutput:"ec2.DeleteVpnConnectionOutput" awsDryRun:""`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2iface.EC2API
+	Id     *string `awsName:"VpnConnectionId" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *DeleteVpnconnection) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
+
+type CheckVpnconnection struct {
+	_       string `action:"check" entity:"vpnconnection" awsAPI:"ec2"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     ec2iface.EC2API
+	Id      *string `templateName:"id"`
+	State   *string `templateName:"state"`
+	Timeout *int64  `templateName:"timeout"`
+}
+
+func (cmd *CheckVpnconnection) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("id"), params.Key("state"), params.Key("timeout")),
+		params.Validators{
+			"state": params.IsInEnumIgnoreCase("pending", "available", "deleting", "deleted", notFoundState),
+		})
+}
+
+func (cmd *CheckVpnconnection) ManualRun(renv env.Running) (interface{}, error) {
+	c := &checker{
+		renv:        renv,
+		description: fmt.Sprintf("vpnconnection %s", StringValue(cmd.Id)),
+		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
+		frequency:   5 * time.Second,
+		fetchFunc: func() (string, error) {
+			state, err := vpnconnectionState(cmd.api, cmd.Id)
+			// deleted connections are only listed for a while
+			if state == notFoundState && StringValue(cmd.State) == ec2.VpnStateDeleted {
+				return ec2.VpnStateDeleted, err
+			}
+			return state, err
+		},
+		expect: StringValue(cmd.State),
+		logger: cmd.logger,
+	}
+	return nil, c.check()
+}
+
+func vpnconnectionState(api ec2iface.EC2API, id *string) (string, error) {
+	output, err := api.DescribeVpnConnections(&ec2.DescribeVpnConnectionsInput{VpnConnectionIds: []*string{id}})
+	if err != nil {
+		if awserr, ok := err.(awserr.Error); ok {
+			if awserr.Code() == "InvalidVpnConnectionID.NotFound" {
+				return notFoundState, nil
+			}
+		} else {
+			return "", err
+		}
+	} else {
+		for _, conn := range output.VpnConnections {
+			if StringValue(conn.VpnConnectionId) == StringValue(id) {
+				return StringValue(conn.State), nil
+			}
+		}
+	}
+	return notFoundState, nil
+}
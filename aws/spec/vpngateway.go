@@ -0,0 +1,103 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/wallix/awless/logger"
+)
+
+type CreateVpngateway struct {
+	_                string `action:"create" entity:"vpngateway" awsAPI:"ec2" awsCall:"CreateVpnGateway" awsInput:"ec2.CreateVpnGatewayInput" awsOutput:"ec2.CreateVpnGatewayOutput" awsDryRun:""`
+	logger           *logger.Logger
+	graph            cloud.GraphAPI
+	api              ec2iface.EC2API
+	Type             *string `awsName:"Type" awsType:"awsstr" templateName:"type"`
+	AmazonAsn        *int64  `awsName:"AmazonSideAsn" awsType:"awsint64" templateName:"amazon-asn"`
+	Availabilityzone *string `awsName:"AvailabilityZone" awsType:"awsstr" templateName:"availabilityzone"`
+	Name             *string `templateName:"name"`
+}
+
+func (cmd *CreateVpngateway) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Opt(params.Suggested("name"), "amazon-asn", "availabilityzone", "type")),
+		params.Validators{
+			"type": params.IsInEnumIgnoreCase(ec2.GatewayTypeIpsec1),
+		})
+}
+
+// BeforeRun defaults the gateway type to the only one supported by AWS, i.e. ipsec.1
+func (cmd *CreateVpngateway) BeforeRun(renv env.Running) error {
+	if cmd.Type == nil {
+		cmd.Type = String(ec2.GatewayTypeIpsec1)
+	}
+	return nil
+}
+
+func (cmd *CreateVpngateway) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*ec2.CreateVpnGatewayOutput).VpnGateway.VpnGatewayId)
+}
+
+func (cmd *CreateVpngateway) AfterRun(renv env.Running, output interface{}) error {
+	if cmd.Name == nil {
+		return nil
+	}
+	return createNameTag(awssdk.String(cmd.ExtractResult(output)), cmd.Name, renv)
+}
+
+type DeleteVpngateway struct {
+	_      string `action:"delete" entity:"vpngateway" awsAPI:"ec2" awsCall:"DeleteVpnGateway" awsInput:"ec2.DeleteVpnGatewayInput" awsOutput:"ec2.DeleteVpnGatewayOutput" awsDryRun:""`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2iface.EC2API
+	Id     *string `awsName:"VpnGatewayId" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *DeleteVpngateway) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
+
+type AttachVpngateway struct {
+	_      string `action:"attach" entity:"vpngateway" awsAPI:"ec2" awsCall:"AttachVpnGateway" awsInput:"ec2.AttachVpnGatewayInput" awsOutput:"ec2.AttachVpnGatewayOutput" awsDryRun:""`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2iface.EC2API
+	Id     *string `awsName:"VpnGatewayId" awsType:"awsstr" templateName:"id"`
+	Vpc    *string `awsName:"VpcId" awsType:"awsstr" templateName:"vpc"`
+}
+
+func (cmd *AttachVpngateway) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"), params.Key("vpc")))
+}
+
+type DetachVpngateway struct {
+	_      string `action:"detach" entity:"vpngateway" awsAPI:"ec2" awsCall:"DetachVpnGateway" awsInput:"ec2.DetachVpnGatewayInput" awsOutput:"ec2.DetachVpnGatewayOutput" awsDryRun:""`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2iface.EC2API
+	Id     *string `awsName:"VpnGatewayId" awsType:"awsstr" templateName:"id"`
+	Vpc    *string `awsName:"VpcId" awsType:"awsstr" templateName:"vpc"`
+}
+
+func (cmd *DetachVpngateway) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"), params.Key("vpc")))
+}
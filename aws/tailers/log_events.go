@@ -0,0 +1,131 @@
+package awstailers
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/wallix/awless/aws/services"
+)
+
+type logEventsTailer struct {
+	group            string
+	filter           string
+	streams          []string
+	since            time.Duration
+	follow           bool
+	pollingFrequency time.Duration
+	nbEvents         int
+	lastEventTime    int64
+	lastEventIDs     map[string]bool
+}
+
+func NewLogEventsTailer(group, filter string, streams []string, since time.Duration, nbEvents int, follow bool, frequency time.Duration) *logEventsTailer {
+	return &logEventsTailer{group: group, filter: filter, streams: streams, since: since, nbEvents: nbEvents, follow: follow, pollingFrequency: frequency}
+}
+
+func (t *logEventsTailer) Name() string {
+	return "loggroup"
+}
+
+func (t *logEventsTailer) Tail(w io.Writer) error {
+	monitoring, ok := awsservices.MonitoringService.(*awsservices.Monitoring)
+	if !ok {
+		return fmt.Errorf("invalid cloud service, expected awsservices.Monitoring, got %T", awsservices.MonitoringService)
+	}
+	if t.follow && t.pollingFrequency < 5*time.Second {
+		return fmt.Errorf("invalid polling frequency: %s", t.pollingFrequency)
+	}
+
+	t.lastEventTime = toMillis(time.Now().Add(-t.since))
+	if err := t.displayEvents(monitoring.CloudWatchLogsAPI, w, t.nbEvents); err != nil {
+		return err
+	}
+	if !t.follow {
+		return nil
+	}
+
+	ticker := time.NewTicker(t.pollingFrequency)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := t.displayEvents(monitoring.CloudWatchLogsAPI, w, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// displayEvents prints the events of the log group since the last displayed one,
+// only the last max ones when max is positive
+func (t *logEventsTailer) displayEvents(api cloudwatchlogsiface.CloudWatchLogsAPI, w io.Writer, max int) error {
+	input := &cloudwatchlogs.FilterLogEventsInput{
+		LogGroupName: awssdk.String(t.group),
+		StartTime:    awssdk.Int64(t.lastEventTime),
+		Interleaved:  awssdk.Bool(true),
+	}
+	if t.filter != "" {
+		input.FilterPattern = awssdk.String(t.filter)
+	}
+	if len(t.streams) > 0 {
+		input.LogStreamNames = awssdk.StringSlice(t.streams)
+	}
+
+	var logEvents []*cloudwatchlogs.FilteredLogEvent
+	err := api.FilterLogEventsPages(input, func(page *cloudwatchlogs.FilterLogEventsOutput, lastPage bool) bool {
+		for _, logEvent := range page.Events {
+			// events at the time of the last displayed one have already been fetched, but not necessarily all of them
+			if t.lastEventIDs[awssdk.StringValue(logEvent.EventId)] {
+				continue
+			}
+			logEvents = append(logEvents, logEvent)
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(logEvents, func(i, j int) bool {
+		return awssdk.Int64Value(logEvents[i].Timestamp) < awssdk.Int64Value(logEvents[j].Timestamp)
+	})
+
+	for _, logEvent := range logEvents {
+		stamp := awssdk.Int64Value(logEvent.Timestamp)
+		if stamp > t.lastEventTime || t.lastEventIDs == nil {
+			t.lastEventTime = stamp
+			t.lastEventIDs = make(map[string]bool)
+		}
+		t.lastEventIDs[awssdk.StringValue(logEvent.EventId)] = true
+	}
+
+	if max > 0 && len(logEvents) > max {
+		logEvents = logEvents[len(logEvents)-max:]
+	}
+	for _, logEvent := range logEvents {
+		if err := newEventFromLogEvent(logEvent).print(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newEventFromLogEvent(e *cloudwatchlogs.FilteredLogEvent) *event {
+	return &event{
+		id:      awssdk.StringValue(e.EventId),
+		stamp:   fromMillis(awssdk.Int64Value(e.Timestamp)),
+		message: strings.TrimRight(awssdk.StringValue(e.Message), "\n"),
+		element: awssdk.StringValue(e.LogStreamName),
+	}
+}
+
+func toMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+func fromMillis(ms int64) time.Time {
+	return time.Unix(0, ms*int64(time.Millisecond)).UTC()
+}
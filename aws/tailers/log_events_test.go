@@ -0,0 +1,77 @@
+package awstailers
+
+import (
+	"bytes"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+)
+
+type logEventsMock struct {
+	cloudwatchlogsiface.CloudWatchLogsAPI
+	events []*cloudwatchlogs.FilteredLogEvent
+	inputs []*cloudwatchlogs.FilterLogEventsInput
+}
+
+func (m *logEventsMock) FilterLogEventsPages(input *cloudwatchlogs.FilterLogEventsInput, fn func(*cloudwatchlogs.FilterLogEventsOutput, bool) bool) error {
+	m.inputs = append(m.inputs, input)
+	var out []*cloudwatchlogs.FilteredLogEvent
+	for _, e := range m.events {
+		if awssdk.Int64Value(e.Timestamp) >= awssdk.Int64Value(input.StartTime) {
+			out = append(out, e)
+		}
+	}
+	fn(&cloudwatchlogs.FilterLogEventsOutput{Events: out}, true)
+	return nil
+}
+
+func TestDisplayLogEvents(t *testing.T) {
+	newEvent := func(id string, stamp int64, msg string) *cloudwatchlogs.FilteredLogEvent {
+		return &cloudwatchlogs.FilteredLogEvent{EventId: awssdk.String(id), Timestamp: awssdk.Int64(stamp), LogStreamName: awssdk.String("web-1"), Message: awssdk.String(msg + "\n")}
+	}
+	mock := &logEventsMock{events: []*cloudwatchlogs.FilteredLogEvent{
+		newEvent("1", 1500000000000, "starting"),
+		newEvent("2", 1500000001000, "GET /"),
+		newEvent("3", 1500000002000, "GET /index.html"),
+	}}
+	tailer := NewLogEventsTailer("my-logs", "GET", []string{"web-1"}, 0, 2, true, 0)
+	tailer.lastEventTime = 1500000000000
+
+	var buf bytes.Buffer
+	if err := tailer.displayEvents(mock, &buf, 2); err != nil {
+		t.Fatal(err)
+	}
+	expect := "2017-07-14 02:40:01 +0000 UTC: web-1\n\tGET /\n2017-07-14 02:40:02 +0000 UTC: web-1\n\tGET /index.html\n"
+	if got, want := buf.String(), expect; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+	if got, want := awssdk.StringValue(mock.inputs[0].FilterPattern), "GET"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := awssdk.StringValueSlice(mock.inputs[0].LogStreamNames), []string{"web-1"}; len(got) != 1 || got[0] != want[0] {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	mock.events = append(mock.events, newEvent("4", 1500000002000, "GET /favicon.ico"), newEvent("5", 1500000003000, "GET /css/main.css"))
+	buf.Reset()
+	if err := tailer.displayEvents(mock, &buf, 0); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := awssdk.Int64Value(mock.inputs[1].StartTime), int64(1500000002000); got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	expect = "2017-07-14 02:40:02 +0000 UTC: web-1\n\tGET /favicon.ico\n2017-07-14 02:40:03 +0000 UTC: web-1\n\tGET /css/main.css\n"
+	if got, want := buf.String(), expect; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+
+	buf.Reset()
+	if err := tailer.displayEvents(mock, &buf, 0); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != "" {
+		t.Fatalf("got %s, want no new event", got)
+	}
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awstrail
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+)
+
+const (
+	Created  = "created"
+	Modified = "modified"
+	Deleted  = "deleted"
+)
+
+// Event is a CloudTrail management event, with the resources it acted on
+type Event struct {
+	ID        string           `json:"id"`
+	Time      time.Time        `json:"time"`
+	Name      string           `json:"name"`
+	Source    string           `json:"source"`
+	Action    string           `json:"action"`
+	Principal string           `json:"principal"`
+	SourceIP  string           `json:"sourceIP,omitempty"`
+	ErrorCode string           `json:"error,omitempty"`
+	ReadOnly  bool             `json:"readOnly"`
+	Resources []*EventResource `json:"resources,omitempty"`
+}
+
+// EventResource is a resource referenced by an event. Type and Name are
+// filled from the graph when the resource is found in it.
+type EventResource struct {
+	ID      string `json:"id"`
+	AWSType string `json:"awsType,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Exists  bool   `json:"exists"`
+}
+
+// Filter restricts the events looked up. Empty fields are ignored.
+type Filter struct {
+	ResourceID string
+	Principal  string
+	Since      time.Time
+	Max        int
+}
+
+// Lookup returns the most recent events matching the filter.
+// CloudTrail only supports one lookup attribute, so the principal is
+// filtered locally when a resource ID is also given.
+func Lookup(api cloudtrailiface.CloudTrailAPI, f Filter) ([]*Event, error) {
+	input := &cloudtrail.LookupEventsInput{}
+	if !f.Since.IsZero() {
+		input.StartTime = aws.Time(f.Since)
+	}
+	switch {
+	case f.ResourceID != "":
+		input.LookupAttributes = []*cloudtrail.LookupAttribute{{AttributeKey: aws.String(cloudtrail.LookupAttributeKeyResourceName), AttributeValue: aws.String(f.ResourceID)}}
+	case f.Principal != "":
+		input.LookupAttributes = []*cloudtrail.LookupAttribute{{AttributeKey: aws.String(cloudtrail.LookupAttributeKeyUsername), AttributeValue: aws.String(f.Principal)}}
+	}
+
+	var events []*Event
+	var parseErr error
+	err := api.LookupEventsPages(input, func(out *cloudtrail.LookupEventsOutput, lastPage bool) bool {
+		for _, e := range out.Events {
+			event, err := NewEvent(e)
+			if err != nil {
+				parseErr = err
+				return false
+			}
+			if f.Principal != "" && !event.hasPrincipal(f.Principal) {
+				continue
+			}
+			events = append(events, event)
+			if f.Max > 0 && len(events) >= f.Max {
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return events, err
+	}
+	return events, parseErr
+}
+
+// Correlate completes the resources of the events with their type and name from the graph.
+// Resources not in the graph are reported as not existing anymore.
+func Correlate(events []*Event, g cloud.GraphAPI) error {
+	for _, e := range events {
+		for _, r := range e.Resources {
+			found, err := g.FindWithProperties(map[string]interface{}{properties.ID: r.ID})
+			if err != nil {
+				return err
+			}
+			if len(found) == 0 {
+				continue
+			}
+			r.Exists = true
+			r.Type = found[0].Type()
+			r.Name, _ = found[0].Properties()[properties.Name].(string)
+		}
+	}
+	return nil
+}
+
+type trailRecord struct {
+	SourceIPAddress string `json:"sourceIPAddress"`
+	ErrorCode       string `json:"errorCode"`
+	ReadOnly        bool   `json:"readOnly"`
+	UserIdentity    struct {
+		ARN string `json:"arn"`
+	} `json:"userIdentity"`
+}
+
+// NewEvent builds an event from a CloudTrail event, parsing its raw record
+func NewEvent(e *cloudtrail.Event) (*Event, error) {
+	event := &Event{
+		ID:        aws.StringValue(e.EventId),
+		Time:      aws.TimeValue(e.EventTime),
+		Name:      aws.StringValue(e.EventName),
+		Source:    strings.TrimSuffix(aws.StringValue(e.EventSource), ".amazonaws.com"),
+		Principal: aws.StringValue(e.Username),
+	}
+	event.Action = actionOfEvent(event.Name)
+	if raw := aws.StringValue(e.CloudTrailEvent); raw != "" {
+		var record trailRecord
+		if err := json.Unmarshal([]byte(raw), &record); err != nil {
+			return nil, err
+		}
+		event.SourceIP = record.SourceIPAddress
+		event.ErrorCode = record.ErrorCode
+		event.ReadOnly = record.ReadOnly
+		if event.Principal == "" {
+			event.Principal = record.UserIdentity.ARN
+		}
+	}
+	for _, r := range e.Resources {
+		event.Resources = append(event.Resources, &EventResource{ID: aws.StringValue(r.ResourceName), AWSType: aws.StringValue(r.ResourceType)})
+	}
+	return event, nil
+}
+
+// IsChange reports whether the event successfully created, modified or deleted resources
+func (e *Event) IsChange() bool {
+	return !e.ReadOnly && e.ErrorCode == ""
+}
+
+func (e *Event) hasPrincipal(principal string) bool {
+	return strings.EqualFold(e.Principal, principal)
+}
+
+var (
+	creationPrefixes = []string{"Create", "Run", "Allocate", "Import", "Register", "Put", "Copy", "Request", "Add"}
+	deletionPrefixes = []string{"Delete", "Terminate", "Release", "Deregister", "Remove", "Disable"}
+)
+
+func actionOfEvent(name string) string {
+	for _, prefix := range creationPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return Created
+		}
+	}
+	for _, prefix := range deletionPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return Deleted
+		}
+	}
+	return Modified
+}
@@ -0,0 +1,162 @@
+package awstrail
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestLookupEvents(t *testing.T) {
+	now := time.Now().UTC()
+	api := &mockCloudTrail{pages: [][]*cloudtrail.Event{
+		{
+			trailEvent("1", "RunInstances", "alice", now, `{"sourceIPAddress":"1.2.3.4"}`, "i-1234"),
+			trailEvent("2", "TerminateInstances", "bob", now, `{"sourceIPAddress":"5.6.7.8","errorCode":"UnauthorizedOperation"}`, "i-1234"),
+		},
+		{
+			trailEvent("3", "ModifyInstanceAttribute", "Alice", now, `{"sourceIPAddress":"1.2.3.4"}`, "i-1234"),
+			trailEvent("4", "DeleteSubnet", "", now, `{"userIdentity":{"arn":"arn:aws:sts::0123:assumed-role/admin"}}`, "sub-1234"),
+		},
+	}}
+
+	events, err := Lookup(api, Filter{ResourceID: "i-1234", Principal: "alice", Since: now.Add(-time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(api.inputs), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	input := api.inputs[0]
+	if got, want := aws.StringValue(input.LookupAttributes[0].AttributeKey), cloudtrail.LookupAttributeKeyResourceName; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := aws.TimeValue(input.StartTime), now.Add(-time.Hour); !got.Equal(want) {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	var ids []string
+	for _, e := range events {
+		ids = append(ids, e.ID)
+	}
+	if got, want := ids, []string{"1", "3"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := events[0].Action, Created; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := events[1].Action, Modified; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := events[0].SourceIP, "1.2.3.4"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := events[0].Source, "ec2"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	api.inputs = nil
+	events, err = Lookup(api, Filter{Max: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(events), 3; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if api.inputs[0].LookupAttributes != nil || api.inputs[0].StartTime != nil {
+		t.Fatalf("unexpected lookup input %v", api.inputs[0])
+	}
+	if got, want := events[1].ErrorCode, "UnauthorizedOperation"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := events[1].Action, Deleted; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	api.inputs = nil
+	events, err = Lookup(api, Filter{Principal: "arn:aws:sts::0123:assumed-role/admin"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := aws.StringValue(api.inputs[0].LookupAttributes[0].AttributeKey), cloudtrail.LookupAttributeKeyUsername; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := len(events), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := events[0].ID, "4"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+func TestEventIsChange(t *testing.T) {
+	now := time.Now().UTC()
+	tcases := []struct {
+		record string
+		exp    bool
+	}{
+		{record: `{"readOnly":false}`, exp: true},
+		{record: `{"readOnly":true}`, exp: false},
+		{record: `{"readOnly":false,"errorCode":"UnauthorizedOperation"}`, exp: false},
+	}
+	for i, tcase := range tcases {
+		event, err := NewEvent(trailEvent("1", "RunInstances", "alice", now, tcase.record, "i-1234"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := event.IsChange(), tcase.exp; got != want {
+			t.Fatalf("%d: got %t, want %t", i+1, got, want)
+		}
+	}
+}
+
+func TestCorrelateEvents(t *testing.T) {
+	g := graph.NewGraph()
+	g.AddResource(resourcetest.Instance("i-1234").Prop("Name", "web").Build())
+
+	events := []*Event{
+		{ID: "1", Resources: []*EventResource{{ID: "i-1234"}, {ID: "i-5678"}}},
+	}
+	if err := Correlate(events, g); err != nil {
+		t.Fatal(err)
+	}
+	exp := []*EventResource{{ID: "i-1234", Type: "instance", Name: "web", Exists: true}, {ID: "i-5678"}}
+	if got, want := events[0].Resources, exp; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got[0], want[0])
+	}
+}
+
+func trailEvent(id, name, user string, at time.Time, record string, resourceID string) *cloudtrail.Event {
+	e := &cloudtrail.Event{
+		EventId:         aws.String(id),
+		EventName:       aws.String(name),
+		EventSource:     aws.String("ec2.amazonaws.com"),
+		EventTime:       aws.Time(at),
+		CloudTrailEvent: aws.String(record),
+		Resources:       []*cloudtrail.Resource{{ResourceName: aws.String(resourceID)}},
+	}
+	if user != "" {
+		e.Username = aws.String(user)
+	}
+	return e
+}
+
+type mockCloudTrail struct {
+	cloudtrailiface.CloudTrailAPI
+	pages  [][]*cloudtrail.Event
+	inputs []*cloudtrail.LookupEventsInput
+}
+
+func (m *mockCloudTrail) LookupEventsPages(input *cloudtrail.LookupEventsInput, fn func(*cloudtrail.LookupEventsOutput, bool) bool) error {
+	m.inputs = append(m.inputs, input)
+	for i, page := range m.pages {
+		if !fn(&cloudtrail.LookupEventsOutput{Events: page}, i == len(m.pages)-1) {
+			break
+		}
+	}
+	return nil
+}
@@ -29,38 +29,72 @@ var ErrFetchAccessDenied = errors.New("access denied to cloud resource")
 const (
 	Region string = "region"
 	//infra
-	Vpc              string = "vpc"
-	Subnet           string = "subnet"
-	Image            string = "image"
-	ImportImageTask  string = "importimagetask"
-	SecurityGroup    string = "securitygroup"
-	AvailabilityZone string = "availabilityzone"
-	Keypair          string = "keypair"
-	Volume           string = "volume"
-	Instance         string = "instance"
-	InstanceProfile  string = "instanceprofile"
-	InternetGateway  string = "internetgateway"
-	NatGateway       string = "natgateway"
-	RouteTable       string = "routetable"
-	ElasticIP        string = "elasticip"
-	Snapshot         string = "snapshot"
-	NetworkInterface string = "networkinterface"
-	Certificate      string = "certificate"
+	Vpc                       string = "vpc"
+	Subnet                    string = "subnet"
+	Image                     string = "image"
+	ImportImageTask           string = "importimagetask"
+	SecurityGroup             string = "securitygroup"
+	AvailabilityZone          string = "availabilityzone"
+	Keypair                   string = "keypair"
+	Volume                    string = "volume"
+	Instance                  string = "instance"
+	InstanceProfile           string = "instanceprofile"
+	InternetGateway           string = "internetgateway"
+	EgressOnlyInternetGateway string = "egressonlyinternetgateway"
+	NatGateway                string = "natgateway"
+	PeeringConnection         string = "peeringconnection"
+	VpcEndpoint               string = "vpcendpoint"
+	CustomerGateway           string = "customergateway"
+	VpnGateway                string = "vpngateway"
+	VpnConnection             string = "vpnconnection"
+	TransitGateway            string = "transitgateway"
+	TransitGatewayAttachment  string = "transitgatewayattachment"
+	TransitGatewayRouteTable  string = "transitgatewayroutetable"
+	RouteTable                string = "routetable"
+	NetworkACL                string = "networkacl"
+	PlacementGroup            string = "placementgroup"
+	Host                      string = "host"
+	ElasticIP                 string = "elasticip"
+	Snapshot                  string = "snapshot"
+	NetworkInterface          string = "networkinterface"
+	SpotRequest               string = "spotrequest"
+	SpotFleet                 string = "spotfleet"
+	Reservation               string = "reservation"
+	Certificate               string = "certificate"
 	//loadbalancer
-	LoadBalancer string = "loadbalancer"
-	TargetGroup  string = "targetgroup"
-	Listener     string = "listener"
+	LoadBalancer        string = "loadbalancer"
+	TargetGroup         string = "targetgroup"
+	Listener            string = "listener"
+	ClassicLoadBalancer string = "classicloadbalancer"
 	//database
-	Database      string = "database"
-	DbSubnetGroup string = "dbsubnetgroup"
+	Database         string = "database"
+	DbSubnetGroup    string = "dbsubnetgroup"
+	DbParameterGroup string = "dbparametergroup"
+	//nosql
+	Table string = "table"
+	//cache
+	CacheCluster     string = "cachecluster"
+	CacheSubnetGroup string = "cachesubnetgroup"
+	//datawarehouse
+	Cluster string = "cluster"
+	//batch
+	ComputeEnvironment string = "computeenvironment"
+	JobQueue           string = "jobqueue"
+	Job                string = "job"
+	//directconnect
+	DxConnection     string = "dxconnection"
+	VirtualInterface string = "virtualinterface"
 	//access
-	User         string = "user"
-	Role         string = "role"
-	Group        string = "group"
-	Policy       string = "policy"
-	AccessKey    string = "accesskey"
-	LoginProfile string = "loginprofile"
-	MFADevice    string = "mfadevice"
+	User               string = "user"
+	Role               string = "role"
+	Group              string = "group"
+	Policy             string = "policy"
+	AccessKey          string = "accesskey"
+	LoginProfile       string = "loginprofile"
+	MFADevice          string = "mfadevice"
+	Key                string = "key"
+	Account            string = "account"
+	OrganizationalUnit string = "organizationalunit"
 	//storage
 	Bucket   string = "bucket"
 	S3Object string = "s3object"
@@ -74,14 +108,19 @@ const (
 	Zone   string = "zone"
 	Record string = "record"
 	//lambda
-	Function string = "function"
+	Function     string = "function"
+	StateMachine string = "statemachine"
+	Execution    string = "execution"
 	//autoscaling
 	LaunchConfiguration string = "launchconfiguration"
 	ScalingGroup        string = "scalinggroup"
 	ScalingPolicy       string = "scalingpolicy"
 	//monitoring
-	Metric string = "metric"
-	Alarm  string = "alarm"
+	Metric     string = "metric"
+	Alarm      string = "alarm"
+	LogGroup   string = "loggroup"
+	Trail      string = "trail"
+	TrailEvent string = "trailevent"
 	//cdn
 	Distribution string = "distribution"
 	//cloudformation
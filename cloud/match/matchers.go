@@ -62,11 +62,21 @@ type propertyMatcher struct {
 	value         interface{}
 	matchOnString bool
 	ignoreCase    bool
+	ignoreKeyCase bool
 	contains      bool
+	hasPrefix     bool
 }
 
 func (m propertyMatcher) Match(r cloud.Resource) bool {
 	v, found := r.Property(m.name)
+	if !found && m.ignoreKeyCase {
+		for name, prop := range r.Properties() {
+			if strings.EqualFold(name, m.name) {
+				v, found = prop, true
+				break
+			}
+		}
+	}
 	if !found {
 		return false
 	}
@@ -83,10 +93,13 @@ func (m propertyMatcher) Match(r cloud.Resource) bool {
 			expectVal = strings.ToLower(expect)
 		}
 	}
-	if m.contains {
+	if m.contains || m.hasPrefix {
 		vv, vIsStr := v.(string)
 		expect, expectIsStr := expectVal.(string)
 		if vIsStr && expectIsStr {
+			if m.hasPrefix {
+				return strings.HasPrefix(vv, expect)
+			}
 			return strings.Contains(vv, expect)
 		}
 	}
@@ -107,11 +120,21 @@ func (p propertyMatcher) IgnoreCase() propertyMatcher {
 	return p
 }
 
+func (p propertyMatcher) IgnoreKeyCase() propertyMatcher {
+	p.ignoreKeyCase = true
+	return p
+}
+
 func (p propertyMatcher) Contains() propertyMatcher {
 	p.contains = true
 	return p
 }
 
+func (p propertyMatcher) HasPrefix() propertyMatcher {
+	p.hasPrefix = true
+	return p
+}
+
 type tagMatcher struct {
 	key, value string
 }
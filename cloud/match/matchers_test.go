@@ -16,6 +16,7 @@ limitations under the License.
 package match
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/wallix/awless/cloud"
@@ -39,6 +40,8 @@ func TestMatchers(t *testing.T) {
 		{match: Property("Prop", "WithCase").IgnoreCase(), resource: resourcetest.Instance("i1").Prop("Prop", "WITHCASE").Build(), expect: true},
 		{match: Property("Prop", "42").IgnoreCase().MatchString(), resource: resourcetest.Instance("i1").Prop("Prop", 42).Build(), expect: true},
 		{match: Property("Prop", "inside").Contains(), resource: resourcetest.Instance("i1").Prop("Prop", "Match inside the content").Build(), expect: true},
+		{match: Property("Prop", "logs/").HasPrefix(), resource: resourcetest.Instance("i1").Prop("Prop", "logs/2017/access.log").Build(), expect: true},
+		{match: Property("Prop", "logs/").HasPrefix(), resource: resourcetest.Instance("i1").Prop("Prop", "archives/logs/access.log").Build(), expect: false},
 		{match: Tag("Key", "Val"), resource: resourcetest.Instance("i1").Prop("Tags", []string{"Key=Val"}).Build(), expect: true},
 		{match: Tag("Key", "Notthis"), resource: resourcetest.Instance("i1").Prop("Tags", []string{"Key=Val"}).Build(), expect: false},
 		{match: TagKey("Key"), resource: resourcetest.Instance("i1").Prop("Tags", []string{"Key=Val"}).Build(), expect: true},
@@ -52,3 +55,37 @@ func TestMatchers(t *testing.T) {
 		}
 	}
 }
+
+func TestParseQuery(t *testing.T) {
+	subnet := resourcetest.Subnet("sub_1").Prop("Public", false).Prop("CIDR", "10.0.1.0/24").Prop("Tags", []string{"Env=prod", "Team=web"}).Build()
+	tcases := []struct {
+		query  string
+		expect bool
+		expErr string
+	}{
+		{query: "tag:Env=prod", expect: true},
+		{query: "tag:Env=prod, public:false", expect: true},
+		{query: "tag:Team,cidr:10.0.1.0/24", expect: true},
+		{query: " Public : FALSE ", expect: true},
+		{query: "tag:Env=dev, public:false", expect: false},
+		{query: "tag:env=prod", expect: false},
+		{query: "state:available", expect: false},
+		{query: "public", expErr: "invalid query term 'public'"},
+		{query: " , ", expErr: "empty query"},
+	}
+	for i, tcase := range tcases {
+		matcher, err := ParseQuery(tcase.query)
+		if tcase.expErr != "" {
+			if err == nil || !strings.HasPrefix(err.Error(), tcase.expErr) {
+				t.Fatalf("%d: got %v, want %s", i+1, err, tcase.expErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if got, want := matcher.Match(subnet), tcase.expect; got != want {
+			t.Fatalf("%d: got %t, want %t", i+1, got, want)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package match
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wallix/awless/cloud"
+)
+
+// ParseQuery builds a matcher from a comma separated list of terms, all of which must match.
+// A term is either 'tag:Key=Value', 'tag:Key' or 'property:value' (case insensitive).
+// Ex: "tag:Env=prod, public:false"
+func ParseQuery(query string) (cloud.Matcher, error) {
+	var matchers []cloud.Matcher
+	for _, term := range strings.Split(query, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+		splits := strings.SplitN(term, ":", 2)
+		if len(splits) != 2 || strings.TrimSpace(splits[0]) == "" {
+			return nil, fmt.Errorf("invalid query term '%s': expecting 'key:value'", term)
+		}
+		key, value := strings.TrimSpace(splits[0]), strings.TrimSpace(splits[1])
+		if strings.EqualFold(key, "tag") {
+			if tag := strings.SplitN(value, "=", 2); len(tag) == 2 {
+				matchers = append(matchers, Tag(tag[0], tag[1]))
+			} else {
+				matchers = append(matchers, TagKey(value))
+			}
+			continue
+		}
+		matchers = append(matchers, Property(key, value).MatchString().IgnoreCase().IgnoreKeyCase())
+	}
+	if len(matchers) == 0 {
+		return nil, fmt.Errorf("empty query")
+	}
+	return And(matchers...), nil
+}
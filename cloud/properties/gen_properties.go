@@ -31,10 +31,12 @@ const (
 	AlarmActions                      = "AlarmActions"
 	AlarmNames                        = "AlarmNames"
 	Alias                             = "Alias"
+	AliasZone                         = "AliasZone"
 	Aliases                           = "Aliases"
 	ApproximateMessageCount           = "ApproximateMessageCount"
 	Architecture                      = "Architecture"
 	Arn                               = "Arn"
+	ASN                               = "ASN"
 	Association                       = "Association"
 	Associations                      = "Associations"
 	Attachable                        = "Attachable"
@@ -42,11 +44,14 @@ const (
 	AttachedAt                        = "AttachedAt"
 	Attachment                        = "Attachment"
 	Attributes                        = "Attributes"
+	AutoPlacement                     = "AutoPlacement"
 	AutoUpgrade                       = "AutoUpgrade"
 	AvailabilityZone                  = "AvailabilityZone"
 	AvailabilityZones                 = "AvailabilityZones"
 	BackupRetentionPeriod             = "BackupRetentionPeriod"
+	Bandwidth                         = "Bandwidth"
 	Bucket                            = "Bucket"
+	CacheSubnetGroup                  = "CacheSubnetGroup"
 	CallerReference                   = "CallerReference"
 	Capabilities                      = "Capabilities"
 	Certificate                       = "Certificate"
@@ -67,6 +72,7 @@ const (
 	Cluster                           = "Cluster"
 	Comment                           = "Comment"
 	Config                            = "Config"
+	Connection                        = "Connection"
 	ContainerInstance                 = "ContainerInstance"
 	ContainersImages                  = "ContainersImages"
 	ContainerTask                     = "ContainerTask"
@@ -75,8 +81,10 @@ const (
 	CopyTagsToSnapshot                = "CopyTagsToSnapshot"
 	Country                           = "Country"
 	Created                           = "Created"
+	CustomerGateway                   = "CustomerGateway"
 	DBSecurityGroups                  = "DBSecurityGroups"
 	DBSubnetGroup                     = "DBSubnetGroup"
+	DeadLetterQueue                   = "DeadLetterQueue"
 	Default                           = "Default"
 	DefaultCooldown                   = "DefaultCooldown"
 	Delay                             = "Delay"
@@ -84,54 +92,71 @@ const (
 	Deployments                       = "Deployments"
 	Description                       = "Description"
 	DesiredCapacity                   = "DesiredCapacity"
+	DesiredCount                      = "DesiredCount"
+	Devices                           = "Devices"
 	Dimensions                        = "Dimensions"
 	DisableRollback                   = "DisableRollback"
 	DockerVersion                     = "DockerVersion"
 	Document                          = "Document"
+	Domain                            = "Domain"
+	Email                             = "Email"
 	Enabled                           = "Enabled"
 	Encrypted                         = "Encrypted"
 	Endpoint                          = "Endpoint"
 	Engine                            = "Engine"
 	EngineVersion                     = "EngineVersion"
 	ExitCode                          = "ExitCode"
+	Expires                           = "Expires"
 	Failover                          = "Failover"
+	Family                            = "Family"
+	Fifo                              = "Fifo"
 	Fingerprint                       = "Fingerprint"
 	GlobalID                          = "GlobalID"
 	GranteeType                       = "GranteeType"
 	Grants                            = "Grants"
 	Handler                           = "Handler"
 	Hash                              = "Hash"
+	HashKey                           = "HashKey"
 	HealthCheck                       = "HealthCheck"
 	HealthCheckGracePeriod            = "HealthCheckGracePeriod"
 	HealthCheckType                   = "HealthCheckType"
 	HealthyThresholdCount             = "HealthyThresholdCount"
 	Host                              = "Host"
+	HostReservation                   = "HostReservation"
 	HTTPVersion                       = "HTTPVersion"
 	Hypervisor                        = "Hypervisor"
 	ID                                = "ID"
 	Image                             = "Image"
+	InboundACLRules                   = "InboundACLRules"
 	InboundRules                      = "InboundRules"
 	InlinePolicies                    = "InlinePolicies"
 	Instance                          = "Instance"
+	InstanceCount                     = "InstanceCount"
 	InstanceOwner                     = "InstanceOwner"
 	Instances                         = "Instances"
 	InsufficientDataActions           = "InsufficientDataActions"
 	IOPS                              = "IOPS"
 	IPType                            = "IPType"
+	ItemCount                         = "ItemCount"
 	IPv6Addresses                     = "IPv6Addresses"
+	IPv6CIDRs                         = "IPv6CIDRs"
 	IPv6Enabled                       = "IPv6Enabled"
+	Joined                            = "Joined"
 	Key                               = "Key"
 	KeyName                           = "KeyName"
 	KeyPair                           = "KeyPair"
 	LatestRestorableTime              = "LatestRestorableTime"
 	LaunchConfigurationName           = "LaunchConfigurationName"
+	LaunchType                        = "LaunchType"
 	Launched                          = "Launched"
 	License                           = "License"
 	Lifecycle                         = "Lifecycle"
+	Listeners                         = "Listeners"
 	LoadBalancer                      = "LoadBalancer"
 	Location                          = "Location"
 	MACAddress                        = "MACAddress"
 	Main                              = "Main"
+	MaxReceiveCount                   = "MaxReceiveCount"
 	MaxSize                           = "MaxSize"
 	Memory                            = "Memory"
 	Messages                          = "Messages"
@@ -143,20 +168,28 @@ const (
 	MultiAZ                           = "MultiAZ"
 	Name                              = "Name"
 	Namespace                         = "Namespace"
+	NetworkInterface                  = "NetworkInterface"
 	NetworkInterfaces                 = "NetworkInterfaces"
 	NewInstancesProtected             = "NewInstancesProtected"
+	NodeCount                         = "NodeCount"
 	Notifications                     = "Notifications"
 	OKActions                         = "OKActions"
+	OfferingClass                     = "OfferingClass"
+	OfferingType                      = "OfferingType"
 	OptionGroups                      = "OptionGroups"
 	Origins                           = "Origins"
+	OutboundACLRules                  = "OutboundACLRules"
 	OutboundRules                     = "OutboundRules"
 	Outputs                           = "Outputs"
 	Owner                             = "Owner"
 	ParameterGroups                   = "ParameterGroups"
 	Parameters                        = "Parameters"
+	Parent                            = "Parent"
 	PasswordLastUsed                  = "PasswordLastUsed"
 	Path                              = "Path"
 	PathPrefix                        = "PathPrefix"
+	PeerOwner                         = "PeerOwner"
+	PeerVpc                           = "PeerVpc"
 	PendingTasksCount                 = "PendingTasksCount"
 	PlacementGroup                    = "PlacementGroup"
 	Port                              = "Port"
@@ -164,6 +197,7 @@ const (
 	PreferredBackupDate               = "PreferredBackupDate"
 	PreferredMaintenanceDate          = "PreferredMaintenanceDate"
 	PriceClass                        = "PriceClass"
+	Principals                        = "Principals"
 	Private                           = "Private"
 	PrivateDNS                        = "PrivateDNS"
 	PrivateIP                         = "PrivateIP"
@@ -173,34 +207,44 @@ const (
 	Public                            = "Public"
 	PublicDNS                         = "PublicDNS"
 	PublicIP                          = "PublicIP"
+	RangeKey                          = "RangeKey"
+	ReadCapacity                      = "ReadCapacity"
 	RecordCount                       = "RecordCount"
 	Records                           = "Records"
 	Region                            = "Region"
 	RegisteredContainerInstancesCount = "RegisteredContainerInstancesCount"
 	ReplicaOf                         = "ReplicaOf"
+	Retention                         = "Retention"
 	Role                              = "Role"
 	Roles                             = "Roles"
 	RootDevice                        = "RootDevice"
 	RootDeviceType                    = "RootDeviceType"
 	Routes                            = "Routes"
+	RouteTable                        = "RouteTable"
+	RouteTables                       = "RouteTables"
 	RunningTasksCount                 = "RunningTasksCount"
 	Runtime                           = "Runtime"
 	ScalingAdjustment                 = "ScalingAdjustment"
 	ScalingGroupName                  = "ScalingGroupName"
 	Scheme                            = "Scheme"
+	Scope                             = "Scope"
 	SecondaryAvailabilityZone         = "SecondaryAvailabilityZone"
+	SecondaryPrivateIPs               = "SecondaryPrivateIPs"
 	SecurityGroups                    = "SecurityGroups"
+	Service                           = "Service"
 	Set                               = "Set"
 	Size                              = "Size"
 	Source                            = "Source"
 	SpotInstanceRequestId             = "SpotInstanceRequestId"
 	SpotPrice                         = "SpotPrice"
 	SSLSupportMethod                  = "SSLSupportMethod"
+	StateMachine                      = "StateMachine"
 	State                             = "State"
 	StateMessage                      = "StateMessage"
 	Stopped                           = "Stopped"
 	Storage                           = "Storage"
 	StorageType                       = "StorageType"
+	Strategy                          = "Strategy"
 	Subnet                            = "Subnet"
 	Subnets                           = "Subnets"
 	Tags                              = "Tags"
@@ -210,6 +254,7 @@ const (
 	TLSVersionRequired                = "TLSVersionRequired"
 	Topic                             = "Topic"
 	TrafficPolicyInstance             = "TrafficPolicyInstance"
+	TransitGateway                    = "TransitGateway"
 	TrustPolicy                       = "TrustPolicy"
 	TTL                               = "TTL"
 	Type                              = "Type"
@@ -218,13 +263,18 @@ const (
 	URI                               = "URI"
 	UserData                          = "UserData"
 	Username                          = "Username"
+	Utilization                       = "Utilization"
 	Value                             = "Value"
 	Version                           = "Version"
 	Virtualization                    = "Virtualization"
+	VisibilityTimeout                 = "VisibilityTimeout"
+	VLAN                              = "VLAN"
 	Volume                            = "Volume"
 	Vpc                               = "Vpc"
 	Vpcs                              = "Vpcs"
+	VpnGateway                        = "VpnGateway"
 	WebACL                            = "WebACL"
 	Weight                            = "Weight"
+	WriteCapacity                     = "WriteCapacity"
 	Zone                              = "Zone"
 )
@@ -33,10 +33,12 @@ const (
 	AlarmActions                      = "cloud:alarmActions"
 	AlarmNames                        = "cloud:alarmNames"
 	Alias                             = "cloud:alias"
+	AliasZone                         = "cloud:aliasZone"
 	Aliases                           = "cloud:aliases"
 	ApproximateMessageCount           = "cloud:approximateMessageCount"
 	Architecture                      = "cloud:architecture"
 	Arn                               = "cloud:arn"
+	ASN                               = "net:asn"
 	Association                       = "cloud:association"
 	Associations                      = "cloud:associations"
 	Attachable                        = "cloud:attachable"
@@ -44,11 +46,14 @@ const (
 	AttachedAt                        = "cloud:attachedAt"
 	Attachment                        = "cloud:attachment"
 	Attributes                        = "cloud:attributes"
+	AutoPlacement                     = "cloud:autoPlacement"
 	AutoUpgrade                       = "cloud:autoUpgrade"
 	AvailabilityZone                  = "cloud:availabilityZone"
 	AvailabilityZones                 = "cloud:availabilityZones"
 	BackupRetentionPeriod             = "cloud:backupRetentionPeriod"
+	Bandwidth                         = "cloud:bandwidth"
 	Bucket                            = "cloud:bucketName"
+	CacheSubnetGroup                  = "cloud:cacheSubnetGroup"
 	CallerReference                   = "cloud:callerReference"
 	Capabilities                      = "cloud:capabilities"
 	Certificate                       = "cloud:certificate"
@@ -69,6 +74,7 @@ const (
 	Cluster                           = "cloud:cluster"
 	Comment                           = "rdfs:comment"
 	Config                            = "cloud:config"
+	Connection                        = "cloud:connection"
 	ContainerInstance                 = "cloud:containerInstance"
 	ContainersImages                  = "cloud:containersImages"
 	ContainerTask                     = "cloud:containerTask"
@@ -77,8 +83,10 @@ const (
 	CopyTagsToSnapshot                = "cloud:copyTagsToSnapshot"
 	Country                           = "cloud:country"
 	Created                           = "cloud:created"
+	CustomerGateway                   = "cloud:customerGateway"
 	DBSecurityGroups                  = "cloud:dbSecurityGroups"
 	DBSubnetGroup                     = "cloud:dbSubnetGroup"
+	DeadLetterQueue                   = "cloud:deadLetterQueue"
 	Default                           = "cloud:default"
 	DefaultCooldown                   = "cloud:defaultCooldown"
 	Delay                             = "cloud:delaySeconds"
@@ -86,54 +94,71 @@ const (
 	Deployments                       = "cloud:deployments"
 	Description                       = "cloud:description"
 	DesiredCapacity                   = "cloud:desiredCapacity"
+	DesiredCount                      = "cloud:desiredCount"
+	Devices                           = "cloud:devices"
 	Dimensions                        = "cloud:dimensions"
 	DisableRollback                   = "cloud:disableRollback"
 	DockerVersion                     = "cloud:dockerVersion"
 	Document                          = "cloud:document"
+	Domain                            = "cloud:domain"
+	Email                             = "cloud:email"
 	Enabled                           = "cloud:enabled"
 	Encrypted                         = "cloud:encrypted"
 	Endpoint                          = "cloud:endpoint"
 	Engine                            = "cloud:engine"
 	EngineVersion                     = "cloud:engineVersion"
 	ExitCode                          = "cloud:exitCode"
+	Expires                           = "cloud:expires"
 	Failover                          = "cloud:failover"
+	Family                            = "cloud:family"
+	Fifo                              = "cloud:fifo"
 	Fingerprint                       = "cloud:fingerprint"
 	GlobalID                          = "cloud:globalID"
 	GranteeType                       = "cloud:granteeType"
 	Grants                            = "cloud:grants"
 	Handler                           = "cloud:handler"
 	Hash                              = "cloud:hash"
+	HashKey                           = "cloud:hashKey"
 	HealthCheck                       = "cloud:healthCheck"
 	HealthCheckGracePeriod            = "cloud:healthCheckGracePeriod"
 	HealthCheckType                   = "cloud:healthCheckType"
 	HealthyThresholdCount             = "cloud:healthyThresholdCount"
 	Host                              = "cloud:host"
+	HostReservation                   = "cloud:hostReservation"
 	HTTPVersion                       = "cloud:httpVersion"
 	Hypervisor                        = "cloud:hypervisor"
 	ID                                = "cloud:id"
 	Image                             = "cloud:image"
+	InboundACLRules                   = "net:inboundACLRules"
 	InboundRules                      = "net:inboundRules"
 	InlinePolicies                    = "cloud:inlinePolicies"
 	Instance                          = "cloud:instance"
+	InstanceCount                     = "cloud:instanceCount"
 	InstanceOwner                     = "cloud:instanceOwner"
 	Instances                         = "cloud:instances"
 	InsufficientDataActions           = "cloud:insufficientDataActions"
 	IOPS                              = "cloud:iops"
 	IPType                            = "net:ipType"
+	ItemCount                         = "cloud:itemCount"
 	IPv6Addresses                     = "cloud:ipv6Addresses"
+	IPv6CIDRs                         = "net:ipv6Cidrs"
 	IPv6Enabled                       = "cloud:ipv6Enabled"
+	Joined                            = "cloud:joined"
 	Key                               = "cloud:key"
 	KeyName                           = "cloud:keyName"
 	KeyPair                           = "cloud:keyPair"
 	LatestRestorableTime              = "cloud:latestRestorableTime"
 	LaunchConfigurationName           = "cloud:launchConfigurationName"
+	LaunchType                        = "cloud:launchType"
 	Launched                          = "cloud:launched"
 	License                           = "cloud:license"
 	Lifecycle                         = "cloud:lifecycle"
+	Listeners                         = "cloud:listeners"
 	LoadBalancer                      = "cloud:loadBalancer"
 	Location                          = "cloud:location"
 	MACAddress                        = "cloud:macAddress"
 	Main                              = "cloud:main"
+	MaxReceiveCount                   = "cloud:maxReceiveCount"
 	MaxSize                           = "cloud:maxSize"
 	Memory                            = "cloud:memory"
 	Messages                          = "cloud:messages"
@@ -145,20 +170,28 @@ const (
 	MultiAZ                           = "cloud:multiAZ"
 	Name                              = "cloud:name"
 	Namespace                         = "cloud:namemespace"
+	NetworkInterface                  = "cloud:networkInterface"
 	NetworkInterfaces                 = "cloud:networkInterfaces"
 	NewInstancesProtected             = "cloud:newInstancesProtected"
+	NodeCount                         = "cloud:nodeCount"
 	Notifications                     = "cloud:notifications"
 	OKActions                         = "cloud:okActions"
+	OfferingClass                     = "cloud:offeringClass"
+	OfferingType                      = "cloud:offeringType"
 	OptionGroups                      = "cloud:optionGroups"
 	Origins                           = "cloud:origins"
+	OutboundACLRules                  = "net:outboundACLRules"
 	OutboundRules                     = "net:outboundRules"
 	Outputs                           = "cloud:outputs"
 	Owner                             = "cloud:owner"
 	ParameterGroups                   = "cloud:parameterGroups"
 	Parameters                        = "cloud:parameters"
+	Parent                            = "cloud:parent"
 	PasswordLastUsed                  = "cloud:passwordLastUsed"
 	Path                              = "cloud:path"
 	PathPrefix                        = "cloud:pathPrefix"
+	PeerOwner                         = "cloud:peerOwner"
+	PeerVpc                           = "cloud:peerVpc"
 	PendingTasksCount                 = "cloud:pendingTasksCount"
 	PlacementGroup                    = "cloud:placementGroup"
 	Port                              = "net:port"
@@ -166,6 +199,7 @@ const (
 	PreferredBackupDate               = "cloud:preferredBackupDate"
 	PreferredMaintenanceDate          = "cloud:preferredMaintenanceDate"
 	PriceClass                        = "cloud:priceClass"
+	Principals                        = "cloud:principals"
 	Private                           = "cloud:private"
 	PrivateDNS                        = "cloud:privateDNS"
 	PrivateIP                         = "net:privateIP"
@@ -175,34 +209,44 @@ const (
 	Public                            = "cloud:public"
 	PublicDNS                         = "cloud:publicDNS"
 	PublicIP                          = "net:publicIP"
+	RangeKey                          = "cloud:rangeKey"
+	ReadCapacity                      = "cloud:readCapacity"
 	RecordCount                       = "cloud:records"
 	Records                           = "cloud:recordCount"
 	Region                            = "cloud:region"
 	RegisteredContainerInstancesCount = "cloud:registeredContainerInstancesCount"
 	ReplicaOf                         = "cloud:replicaOf"
+	Retention                         = "cloud:retention"
 	Role                              = "cloud:role"
 	Roles                             = "cloud:roles"
 	RootDevice                        = "cloud:rootDevice"
 	RootDeviceType                    = "cloud:rootDeviceType"
 	Routes                            = "net:routes"
+	RouteTable                        = "cloud:routeTable"
+	RouteTables                       = "cloud:routeTables"
 	RunningTasksCount                 = "cloud:runningTasksCount"
 	Runtime                           = "cloud:runtime"
 	ScalingAdjustment                 = "cloud:scalingAdjustment"
 	ScalingGroupName                  = "cloud:scalingGroupName"
 	Scheme                            = "net:scheme"
+	Scope                             = "cloud:scope"
 	SecondaryAvailabilityZone         = "cloud:secondaryAvailabilityZone"
+	SecondaryPrivateIPs               = "cloud:secondaryPrivateIPs"
 	SecurityGroups                    = "cloud:securityGroups"
+	Service                           = "cloud:service"
 	Set                               = "cloud:set"
 	Size                              = "cloud:size"
 	Source                            = "cloud:source"
 	SpotInstanceRequestId             = "cloud:spotInstanceRequestId"
 	SpotPrice                         = "cloud:spotPrice"
 	SSLSupportMethod                  = "cloud:sslSupportMethod"
+	StateMachine                      = "cloud:stateMachine"
 	State                             = "cloud:state"
 	StateMessage                      = "cloud:stateMessage"
 	Stopped                           = "cloud:stopped"
 	Storage                           = "cloud:storage"
 	StorageType                       = "cloud:storageType"
+	Strategy                          = "cloud:strategy"
 	Subnet                            = "cloud:subnet"
 	Subnets                           = "cloud:subnets"
 	Tags                              = "cloud:tags"
@@ -212,6 +256,7 @@ const (
 	TLSVersionRequired                = "cloud:tlsVersionRequired"
 	Topic                             = "cloud:topic"
 	TrafficPolicyInstance             = "cloud:trafficPolicyInstance"
+	TransitGateway                    = "cloud:transitGateway"
 	TrustPolicy                       = "cloud:trustPolicy"
 	TTL                               = "cloud:ttl"
 	Type                              = "cloud:type"
@@ -220,14 +265,19 @@ const (
 	URI                               = "cloud:uri"
 	UserData                          = "cloud:userData"
 	Username                          = "cloud:username"
+	Utilization                       = "cloud:utilization"
 	Value                             = "cloud:value"
 	Version                           = "cloud:version"
 	Virtualization                    = "cloud:virtualization"
+	VisibilityTimeout                 = "cloud:visibilityTimeout"
+	VLAN                              = "net:vlan"
 	Volume                            = "cloud:volume"
 	Vpc                               = "cloud:vpc"
 	Vpcs                              = "cloud:vpcs"
+	VpnGateway                        = "cloud:vpnGateway"
 	WebACL                            = "cloud:webACL"
 	Weight                            = "cloud:weight"
+	WriteCapacity                     = "cloud:writeCapacity"
 	Zone                              = "cloud:zone"
 )
 
@@ -245,10 +295,12 @@ var Labels = map[string]string{
 	properties.AlarmActions:                      AlarmActions,
 	properties.AlarmNames:                        AlarmNames,
 	properties.Alias:                             Alias,
+	properties.AliasZone:                         AliasZone,
 	properties.Aliases:                           Aliases,
 	properties.ApproximateMessageCount:           ApproximateMessageCount,
 	properties.Architecture:                      Architecture,
 	properties.Arn:                               Arn,
+	properties.ASN:                               ASN,
 	properties.Association:                       Association,
 	properties.Associations:                      Associations,
 	properties.Attachable:                        Attachable,
@@ -256,11 +308,14 @@ var Labels = map[string]string{
 	properties.AttachedAt:                        AttachedAt,
 	properties.Attachment:                        Attachment,
 	properties.Attributes:                        Attributes,
+	properties.AutoPlacement:                     AutoPlacement,
 	properties.AutoUpgrade:                       AutoUpgrade,
 	properties.AvailabilityZone:                  AvailabilityZone,
 	properties.AvailabilityZones:                 AvailabilityZones,
 	properties.BackupRetentionPeriod:             BackupRetentionPeriod,
+	properties.Bandwidth:                         Bandwidth,
 	properties.Bucket:                            Bucket,
+	properties.CacheSubnetGroup:                  CacheSubnetGroup,
 	properties.CallerReference:                   CallerReference,
 	properties.Capabilities:                      Capabilities,
 	properties.Certificate:                       Certificate,
@@ -281,6 +336,7 @@ var Labels = map[string]string{
 	properties.Cluster:                           Cluster,
 	properties.Comment:                           Comment,
 	properties.Config:                            Config,
+	properties.Connection:                        Connection,
 	properties.ContainerInstance:                 ContainerInstance,
 	properties.ContainersImages:                  ContainersImages,
 	properties.ContainerTask:                     ContainerTask,
@@ -289,8 +345,10 @@ var Labels = map[string]string{
 	properties.CopyTagsToSnapshot:                CopyTagsToSnapshot,
 	properties.Country:                           Country,
 	properties.Created:                           Created,
+	properties.CustomerGateway:                   CustomerGateway,
 	properties.DBSecurityGroups:                  DBSecurityGroups,
 	properties.DBSubnetGroup:                     DBSubnetGroup,
+	properties.DeadLetterQueue:                   DeadLetterQueue,
 	properties.Default:                           Default,
 	properties.DefaultCooldown:                   DefaultCooldown,
 	properties.Delay:                             Delay,
@@ -298,54 +356,71 @@ var Labels = map[string]string{
 	properties.Deployments:                       Deployments,
 	properties.Description:                       Description,
 	properties.DesiredCapacity:                   DesiredCapacity,
+	properties.DesiredCount:                      DesiredCount,
+	properties.Devices:                           Devices,
 	properties.Dimensions:                        Dimensions,
 	properties.DisableRollback:                   DisableRollback,
 	properties.DockerVersion:                     DockerVersion,
 	properties.Document:                          Document,
+	properties.Domain:                            Domain,
+	properties.Email:                             Email,
 	properties.Enabled:                           Enabled,
 	properties.Encrypted:                         Encrypted,
 	properties.Endpoint:                          Endpoint,
 	properties.Engine:                            Engine,
 	properties.EngineVersion:                     EngineVersion,
 	properties.ExitCode:                          ExitCode,
+	properties.Expires:                           Expires,
 	properties.Failover:                          Failover,
+	properties.Family:                            Family,
+	properties.Fifo:                              Fifo,
 	properties.Fingerprint:                       Fingerprint,
 	properties.GlobalID:                          GlobalID,
 	properties.GranteeType:                       GranteeType,
 	properties.Grants:                            Grants,
 	properties.Handler:                           Handler,
 	properties.Hash:                              Hash,
+	properties.HashKey:                           HashKey,
 	properties.HealthCheck:                       HealthCheck,
 	properties.HealthCheckGracePeriod:            HealthCheckGracePeriod,
 	properties.HealthCheckType:                   HealthCheckType,
 	properties.HealthyThresholdCount:             HealthyThresholdCount,
 	properties.Host:                              Host,
+	properties.HostReservation:                   HostReservation,
 	properties.HTTPVersion:                       HTTPVersion,
 	properties.Hypervisor:                        Hypervisor,
 	properties.ID:                                ID,
 	properties.Image:                             Image,
+	properties.InboundACLRules:                   InboundACLRules,
 	properties.InboundRules:                      InboundRules,
 	properties.InlinePolicies:                    InlinePolicies,
 	properties.Instance:                          Instance,
+	properties.InstanceCount:                     InstanceCount,
 	properties.InstanceOwner:                     InstanceOwner,
 	properties.Instances:                         Instances,
 	properties.InsufficientDataActions:           InsufficientDataActions,
 	properties.IOPS:                              IOPS,
 	properties.IPType:                            IPType,
+	properties.ItemCount:                         ItemCount,
 	properties.IPv6Addresses:                     IPv6Addresses,
+	properties.IPv6CIDRs:                         IPv6CIDRs,
 	properties.IPv6Enabled:                       IPv6Enabled,
+	properties.Joined:                            Joined,
 	properties.Key:                               Key,
 	properties.KeyName:                           KeyName,
 	properties.KeyPair:                           KeyPair,
 	properties.LatestRestorableTime:              LatestRestorableTime,
 	properties.LaunchConfigurationName:           LaunchConfigurationName,
+	properties.LaunchType:                        LaunchType,
 	properties.Launched:                          Launched,
 	properties.License:                           License,
 	properties.Lifecycle:                         Lifecycle,
+	properties.Listeners:                         Listeners,
 	properties.LoadBalancer:                      LoadBalancer,
 	properties.Location:                          Location,
 	properties.MACAddress:                        MACAddress,
 	properties.Main:                              Main,
+	properties.MaxReceiveCount:                   MaxReceiveCount,
 	properties.MaxSize:                           MaxSize,
 	properties.Memory:                            Memory,
 	properties.Messages:                          Messages,
@@ -357,20 +432,28 @@ var Labels = map[string]string{
 	properties.MultiAZ:                           MultiAZ,
 	properties.Name:                              Name,
 	properties.Namespace:                         Namespace,
+	properties.NetworkInterface:                  NetworkInterface,
 	properties.NetworkInterfaces:                 NetworkInterfaces,
 	properties.NewInstancesProtected:             NewInstancesProtected,
+	properties.NodeCount:                         NodeCount,
 	properties.Notifications:                     Notifications,
 	properties.OKActions:                         OKActions,
+	properties.OfferingClass:                     OfferingClass,
+	properties.OfferingType:                      OfferingType,
 	properties.OptionGroups:                      OptionGroups,
 	properties.Origins:                           Origins,
+	properties.OutboundACLRules:                  OutboundACLRules,
 	properties.OutboundRules:                     OutboundRules,
 	properties.Outputs:                           Outputs,
 	properties.Owner:                             Owner,
 	properties.ParameterGroups:                   ParameterGroups,
 	properties.Parameters:                        Parameters,
+	properties.Parent:                            Parent,
 	properties.PasswordLastUsed:                  PasswordLastUsed,
 	properties.Path:                              Path,
 	properties.PathPrefix:                        PathPrefix,
+	properties.PeerOwner:                         PeerOwner,
+	properties.PeerVpc:                           PeerVpc,
 	properties.PendingTasksCount:                 PendingTasksCount,
 	properties.PlacementGroup:                    PlacementGroup,
 	properties.Port:                              Port,
@@ -378,6 +461,7 @@ var Labels = map[string]string{
 	properties.PreferredBackupDate:               PreferredBackupDate,
 	properties.PreferredMaintenanceDate:          PreferredMaintenanceDate,
 	properties.PriceClass:                        PriceClass,
+	properties.Principals:                        Principals,
 	properties.Private:                           Private,
 	properties.PrivateDNS:                        PrivateDNS,
 	properties.PrivateIP:                         PrivateIP,
@@ -387,34 +471,44 @@ var Labels = map[string]string{
 	properties.Public:                            Public,
 	properties.PublicDNS:                         PublicDNS,
 	properties.PublicIP:                          PublicIP,
+	properties.RangeKey:                          RangeKey,
+	properties.ReadCapacity:                      ReadCapacity,
 	properties.RecordCount:                       RecordCount,
 	properties.Records:                           Records,
 	properties.Region:                            Region,
 	properties.RegisteredContainerInstancesCount: RegisteredContainerInstancesCount,
 	properties.ReplicaOf:                         ReplicaOf,
+	properties.Retention:                         Retention,
 	properties.Role:                              Role,
 	properties.Roles:                             Roles,
 	properties.RootDevice:                        RootDevice,
 	properties.RootDeviceType:                    RootDeviceType,
 	properties.Routes:                            Routes,
+	properties.RouteTable:                        RouteTable,
+	properties.RouteTables:                       RouteTables,
 	properties.RunningTasksCount:                 RunningTasksCount,
 	properties.Runtime:                           Runtime,
 	properties.ScalingAdjustment:                 ScalingAdjustment,
 	properties.ScalingGroupName:                  ScalingGroupName,
 	properties.Scheme:                            Scheme,
+	properties.Scope:                             Scope,
 	properties.SecondaryAvailabilityZone:         SecondaryAvailabilityZone,
+	properties.SecondaryPrivateIPs:               SecondaryPrivateIPs,
 	properties.SecurityGroups:                    SecurityGroups,
+	properties.Service:                           Service,
 	properties.Set:                               Set,
 	properties.Size:                              Size,
 	properties.Source:                            Source,
 	properties.SpotInstanceRequestId:             SpotInstanceRequestId,
 	properties.SpotPrice:                         SpotPrice,
 	properties.SSLSupportMethod:                  SSLSupportMethod,
+	properties.StateMachine:                      StateMachine,
 	properties.State:                             State,
 	properties.StateMessage:                      StateMessage,
 	properties.Stopped:                           Stopped,
 	properties.Storage:                           Storage,
 	properties.StorageType:                       StorageType,
+	properties.Strategy:                          Strategy,
 	properties.Subnet:                            Subnet,
 	properties.Subnets:                           Subnets,
 	properties.Tags:                              Tags,
@@ -424,6 +518,7 @@ var Labels = map[string]string{
 	properties.TLSVersionRequired:                TLSVersionRequired,
 	properties.Topic:                             Topic,
 	properties.TrafficPolicyInstance:             TrafficPolicyInstance,
+	properties.TransitGateway:                    TransitGateway,
 	properties.TrustPolicy:                       TrustPolicy,
 	properties.TTL:                               TTL,
 	properties.Type:                              Type,
@@ -432,14 +527,19 @@ var Labels = map[string]string{
 	properties.URI:                               URI,
 	properties.UserData:                          UserData,
 	properties.Username:                          Username,
+	properties.Utilization:                       Utilization,
 	properties.Value:                             Value,
 	properties.Version:                           Version,
 	properties.Virtualization:                    Virtualization,
+	properties.VisibilityTimeout:                 VisibilityTimeout,
+	properties.VLAN:                              VLAN,
 	properties.Volume:                            Volume,
 	properties.Vpc:                               Vpc,
 	properties.Vpcs:                              Vpcs,
+	properties.VpnGateway:                        VpnGateway,
 	properties.WebACL:                            WebACL,
 	properties.Weight:                            Weight,
+	properties.WriteCapacity:                     WriteCapacity,
 	properties.Zone:                              Zone,
 }
 
@@ -457,10 +557,12 @@ var Properties = RDFProperties{
 	AlarmActions:            {ID: AlarmActions, RdfType: "rdf:Property", RdfsLabel: "AlarmActions", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
 	AlarmNames:              {ID: AlarmNames, RdfType: "rdf:Property", RdfsLabel: "AlarmNames", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
 	Alias:                   {ID: Alias, RdfType: "rdf:Property", RdfsLabel: "Alias", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	AliasZone:               {ID: AliasZone, RdfType: "rdf:Property", RdfsLabel: "AliasZone", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Aliases:                 {ID: Aliases, RdfType: "rdf:Property", RdfsLabel: "Aliases", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
 	ApproximateMessageCount: {ID: ApproximateMessageCount, RdfType: "rdf:Property", RdfsLabel: "ApproximateMessageCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Architecture:            {ID: Architecture, RdfType: "rdf:Property", RdfsLabel: "Architecture", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Arn:                     {ID: Arn, RdfType: "rdf:Property", RdfsLabel: "Arn", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	ASN:                     {ID: ASN, RdfType: "rdf:Property", RdfsLabel: "ASN", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Association:             {ID: Association, RdfType: "rdf:Property", RdfsLabel: "Association", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Associations:            {ID: Associations, RdfType: "rdf:Property", RdfsLabel: "Associations", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:KeyValue"},
 	Attachable:              {ID: Attachable, RdfType: "rdf:Property", RdfsLabel: "Attachable", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
@@ -468,11 +570,14 @@ var Properties = RDFProperties{
 	AttachedAt:              {ID: AttachedAt, RdfType: "rdf:Property", RdfsLabel: "AttachedAt", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
 	Attachment:              {ID: Attachment, RdfType: "rdf:Property", RdfsLabel: "Attachment", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Attributes:              {ID: Attributes, RdfType: "rdf:Property", RdfsLabel: "Attributes", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:KeyValue"},
+	AutoPlacement:           {ID: AutoPlacement, RdfType: "rdf:Property", RdfsLabel: "AutoPlacement", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	AutoUpgrade:             {ID: AutoUpgrade, RdfType: "rdf:Property", RdfsLabel: "AutoUpgrade", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
 	AvailabilityZone:        {ID: AvailabilityZone, RdfType: "rdf:Property", RdfsLabel: "AvailabilityZone", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 	AvailabilityZones:       {ID: AvailabilityZones, RdfType: "rdf:Property", RdfsLabel: "AvailabilityZones", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
 	BackupRetentionPeriod:   {ID: BackupRetentionPeriod, RdfType: "rdf:Property", RdfsLabel: "BackupRetentionPeriod", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
+	Bandwidth:               {ID: Bandwidth, RdfType: "rdf:Property", RdfsLabel: "Bandwidth", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Bucket:                  {ID: Bucket, RdfType: "rdf:Property", RdfsLabel: "Bucket", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
+	CacheSubnetGroup:        {ID: CacheSubnetGroup, RdfType: "rdf:Property", RdfsLabel: "CacheSubnetGroup", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	CallerReference:         {ID: CallerReference, RdfType: "rdf:Property", RdfsLabel: "CallerReference", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Capabilities:            {ID: Capabilities, RdfType: "rdf:Property", RdfsLabel: "Capabilities", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
 	Certificate:             {ID: Certificate, RdfType: "rdf:Property", RdfsLabel: "Certificate", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
@@ -493,6 +598,7 @@ var Properties = RDFProperties{
 	Cluster:                 {ID: Cluster, RdfType: "rdf:Property", RdfsLabel: "Cluster", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Comment:                 {ID: Comment, RdfType: "rdf:Property", RdfsLabel: "Comment", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Config:                  {ID: Config, RdfType: "rdf:Property", RdfsLabel: "Config", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Connection:              {ID: Connection, RdfType: "rdf:Property", RdfsLabel: "Connection", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 	ContainerInstance:       {ID: ContainerInstance, RdfType: "rdf:Property", RdfsLabel: "ContainerInstance", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 	ContainersImages:        {ID: ContainersImages, RdfType: "rdf:Property", RdfsLabel: "ContainersImages", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:KeyValue"},
 	ContainerTask:           {ID: ContainerTask, RdfType: "rdf:Property", RdfsLabel: "ContainerTask", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
@@ -501,8 +607,10 @@ var Properties = RDFProperties{
 	CopyTagsToSnapshot:      {ID: CopyTagsToSnapshot, RdfType: "rdf:Property", RdfsLabel: "CopyTagsToSnapshot", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Country:                 {ID: Country, RdfType: "rdf:Property", RdfsLabel: "Country", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Created:                 {ID: Created, RdfType: "rdf:Property", RdfsLabel: "Created", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
+	CustomerGateway:         {ID: CustomerGateway, RdfType: "rdf:Property", RdfsLabel: "CustomerGateway", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 	DBSecurityGroups:        {ID: DBSecurityGroups, RdfType: "rdf:Property", RdfsLabel: "DBSecurityGroups", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
 	DBSubnetGroup:           {ID: DBSubnetGroup, RdfType: "rdf:Property", RdfsLabel: "DBSubnetGroup", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	DeadLetterQueue:         {ID: DeadLetterQueue, RdfType: "rdf:Property", RdfsLabel: "DeadLetterQueue", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Default:                 {ID: Default, RdfType: "rdf:Property", RdfsLabel: "Default", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
 	DefaultCooldown:         {ID: DefaultCooldown, RdfType: "rdf:Property", RdfsLabel: "DefaultCooldown", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Delay:                   {ID: Delay, RdfType: "rdf:Property", RdfsLabel: "Delay", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
@@ -510,54 +618,71 @@ var Properties = RDFProperties{
 	Deployments:             {ID: Deployments, RdfType: "rdf:Property", RdfsLabel: "Deployments", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:KeyValue"},
 	Description:             {ID: Description, RdfType: "rdf:Property", RdfsLabel: "Description", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	DesiredCapacity:         {ID: DesiredCapacity, RdfType: "rdf:Property", RdfsLabel: "DesiredCapacity", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	DesiredCount:            {ID: DesiredCount, RdfType: "rdf:Property", RdfsLabel: "DesiredCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	Devices:                 {ID: Devices, RdfType: "rdf:Property", RdfsLabel: "Devices", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
 	Dimensions:              {ID: Dimensions, RdfType: "rdf:Property", RdfsLabel: "Dimensions", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:KeyValue"},
 	DisableRollback:         {ID: DisableRollback, RdfType: "rdf:Property", RdfsLabel: "DisableRollback", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
 	DockerVersion:           {ID: DockerVersion, RdfType: "rdf:Property", RdfsLabel: "DockerVersion", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Document:                {ID: Document, RdfType: "rdf:Property", RdfsLabel: "Document", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Domain:                  {ID: Domain, RdfType: "rdf:Property", RdfsLabel: "Domain", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Email:                   {ID: Email, RdfType: "rdf:Property", RdfsLabel: "Email", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Enabled:                 {ID: Enabled, RdfType: "rdf:Property", RdfsLabel: "Enabled", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
 	Encrypted:               {ID: Encrypted, RdfType: "rdf:Property", RdfsLabel: "Encrypted", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
 	Endpoint:                {ID: Endpoint, RdfType: "rdf:Property", RdfsLabel: "Endpoint", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Engine:                  {ID: Engine, RdfType: "rdf:Property", RdfsLabel: "Engine", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	EngineVersion:           {ID: EngineVersion, RdfType: "rdf:Property", RdfsLabel: "EngineVersion", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	ExitCode:                {ID: ExitCode, RdfType: "rdf:Property", RdfsLabel: "ExitCode", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	Expires:                 {ID: Expires, RdfType: "rdf:Property", RdfsLabel: "Expires", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
 	Failover:                {ID: Failover, RdfType: "rdf:Property", RdfsLabel: "Failover", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Family:                  {ID: Family, RdfType: "rdf:Property", RdfsLabel: "Family", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Fifo:                    {ID: Fifo, RdfType: "rdf:Property", RdfsLabel: "Fifo", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
 	Fingerprint:             {ID: Fingerprint, RdfType: "rdf:Property", RdfsLabel: "Fingerprint", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	GlobalID:                {ID: GlobalID, RdfType: "rdf:Property", RdfsLabel: "GlobalID", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	GranteeType:             {ID: GranteeType, RdfType: "rdf:Property", RdfsLabel: "GranteeType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Grants:                  {ID: Grants, RdfType: "rdf:Property", RdfsLabel: "Grants", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:Grant"},
 	Handler:                 {ID: Handler, RdfType: "rdf:Property", RdfsLabel: "Handler", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Hash:                    {ID: Hash, RdfType: "rdf:Property", RdfsLabel: "Hash", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	HashKey:                 {ID: HashKey, RdfType: "rdf:Property", RdfsLabel: "HashKey", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	HealthCheck:             {ID: HealthCheck, RdfType: "rdf:Property", RdfsLabel: "HealthCheck", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	HealthCheckGracePeriod:  {ID: HealthCheckGracePeriod, RdfType: "rdf:Property", RdfsLabel: "HealthCheckGracePeriod", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	HealthCheckType:         {ID: HealthCheckType, RdfType: "rdf:Property", RdfsLabel: "HealthCheckType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	HealthyThresholdCount:   {ID: HealthyThresholdCount, RdfType: "rdf:Property", RdfsLabel: "HealthyThresholdCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Host:                    {ID: Host, RdfType: "rdf:Property", RdfsLabel: "Host", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	HostReservation:         {ID: HostReservation, RdfType: "rdf:Property", RdfsLabel: "HostReservation", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	HTTPVersion:             {ID: HTTPVersion, RdfType: "rdf:Property", RdfsLabel: "HTTPVersion", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Hypervisor:              {ID: Hypervisor, RdfType: "rdf:Property", RdfsLabel: "Hypervisor", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	ID:                      {ID: ID, RdfType: "rdf:Property", RdfsLabel: "ID", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Image:                   {ID: Image, RdfType: "rdf:Property", RdfsLabel: "Image", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	InboundACLRules:         {ID: InboundACLRules, RdfType: "rdf:Property", RdfsLabel: "InboundACLRules", RdfsDefinedBy: "rdfs:list", RdfsDataType: "net-owl:NetworkACLRule"},
 	InboundRules:            {ID: InboundRules, RdfType: "rdf:Property", RdfsLabel: "InboundRules", RdfsDefinedBy: "rdfs:list", RdfsDataType: "net-owl:FirewallRule"},
 	InlinePolicies:          {ID: InlinePolicies, RdfType: "rdf:Property", RdfsLabel: "InlinePolicies", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
 	Instance:                {ID: Instance, RdfType: "rdf:Property", RdfsLabel: "Instance", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
+	InstanceCount:           {ID: InstanceCount, RdfType: "rdf:Property", RdfsLabel: "InstanceCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	InstanceOwner:           {ID: InstanceOwner, RdfType: "rdf:Property", RdfsLabel: "InstanceOwner", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Instances:               {ID: Instances, RdfType: "rdf:Property", RdfsLabel: "Instances", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
 	InsufficientDataActions: {ID: InsufficientDataActions, RdfType: "rdf:Property", RdfsLabel: "InsufficientDataActions", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
 	IOPS:                     {ID: IOPS, RdfType: "rdf:Property", RdfsLabel: "IOPS", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	IPType:                   {ID: IPType, RdfType: "rdf:Property", RdfsLabel: "IPType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	ItemCount:                {ID: ItemCount, RdfType: "rdf:Property", RdfsLabel: "ItemCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	IPv6Addresses:            {ID: IPv6Addresses, RdfType: "rdf:Property", RdfsLabel: "IPv6Addresses", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
+	IPv6CIDRs:                {ID: IPv6CIDRs, RdfType: "rdf:Property", RdfsLabel: "IPv6CIDRs", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
 	IPv6Enabled:              {ID: IPv6Enabled, RdfType: "rdf:Property", RdfsLabel: "IPv6Enabled", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
+	Joined:                   {ID: Joined, RdfType: "rdf:Property", RdfsLabel: "Joined", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
 	Key:                      {ID: Key, RdfType: "rdf:Property", RdfsLabel: "Key", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	KeyName:                  {ID: KeyName, RdfType: "rdf:Property", RdfsLabel: "KeyName", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	KeyPair:                  {ID: KeyPair, RdfType: "rdf:Property", RdfsLabel: "KeyPair", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 	LatestRestorableTime:     {ID: LatestRestorableTime, RdfType: "rdf:Property", RdfsLabel: "LatestRestorableTime", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
 	LaunchConfigurationName:  {ID: LaunchConfigurationName, RdfType: "rdf:Property", RdfsLabel: "LaunchConfigurationName", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	LaunchType:               {ID: LaunchType, RdfType: "rdf:Property", RdfsLabel: "LaunchType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Launched:                 {ID: Launched, RdfType: "rdf:Property", RdfsLabel: "Launched", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
 	License:                  {ID: License, RdfType: "rdf:Property", RdfsLabel: "License", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Lifecycle:                {ID: Lifecycle, RdfType: "rdf:Property", RdfsLabel: "Lifecycle", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Listeners:                {ID: Listeners, RdfType: "rdf:Property", RdfsLabel: "Listeners", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
 	LoadBalancer:             {ID: LoadBalancer, RdfType: "rdf:Property", RdfsLabel: "LoadBalancer", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 	Location:                 {ID: Location, RdfType: "rdf:Property", RdfsLabel: "Location", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 	MACAddress:               {ID: MACAddress, RdfType: "rdf:Property", RdfsLabel: "MACAddress", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Main:                     {ID: Main, RdfType: "rdf:Property", RdfsLabel: "Main", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
+	MaxReceiveCount:          {ID: MaxReceiveCount, RdfType: "rdf:Property", RdfsLabel: "MaxReceiveCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	MaxSize:                  {ID: MaxSize, RdfType: "rdf:Property", RdfsLabel: "MaxSize", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Memory:                   {ID: Memory, RdfType: "rdf:Property", RdfsLabel: "Memory", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Messages:                 {ID: Messages, RdfType: "rdf:Property", RdfsLabel: "Messages", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
@@ -569,20 +694,28 @@ var Properties = RDFProperties{
 	MultiAZ:                  {ID: MultiAZ, RdfType: "rdf:Property", RdfsLabel: "MultiAZ", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Name:                     {ID: Name, RdfType: "rdf:Property", RdfsLabel: "Name", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Namespace:                {ID: Namespace, RdfType: "rdf:Property", RdfsLabel: "Namespace", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	NetworkInterface:         {ID: NetworkInterface, RdfType: "rdf:Property", RdfsLabel: "NetworkInterface", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 	NetworkInterfaces:        {ID: NetworkInterfaces, RdfType: "rdf:Property", RdfsLabel: "NetworkInterfaces", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
 	NewInstancesProtected:    {ID: NewInstancesProtected, RdfType: "rdf:Property", RdfsLabel: "NewInstancesProtected", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
+	NodeCount:                {ID: NodeCount, RdfType: "rdf:Property", RdfsLabel: "NodeCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Notifications:            {ID: Notifications, RdfType: "rdf:Property", RdfsLabel: "Notifications", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
 	OKActions:                {ID: OKActions, RdfType: "rdf:Property", RdfsLabel: "OKActions", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
+	OfferingClass:            {ID: OfferingClass, RdfType: "rdf:Property", RdfsLabel: "OfferingClass", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	OfferingType:             {ID: OfferingType, RdfType: "rdf:Property", RdfsLabel: "OfferingType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	OptionGroups:             {ID: OptionGroups, RdfType: "rdf:Property", RdfsLabel: "OptionGroups", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
 	Origins:                  {ID: Origins, RdfType: "rdf:Property", RdfsLabel: "Origins", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:DistributionOrigin"},
+	OutboundACLRules:         {ID: OutboundACLRules, RdfType: "rdf:Property", RdfsLabel: "OutboundACLRules", RdfsDefinedBy: "rdfs:list", RdfsDataType: "net-owl:NetworkACLRule"},
 	OutboundRules:            {ID: OutboundRules, RdfType: "rdf:Property", RdfsLabel: "OutboundRules", RdfsDefinedBy: "rdfs:list", RdfsDataType: "net-owl:FirewallRule"},
 	Outputs:                  {ID: Outputs, RdfType: "rdf:Property", RdfsLabel: "Outputs", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:KeyValue"},
 	Owner:                    {ID: Owner, RdfType: "rdf:Property", RdfsLabel: "Owner", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	ParameterGroups:          {ID: ParameterGroups, RdfType: "rdf:Property", RdfsLabel: "ParameterGroups", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
 	Parameters:               {ID: Parameters, RdfType: "rdf:Property", RdfsLabel: "Parameters", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:KeyValue"},
+	Parent:                   {ID: Parent, RdfType: "rdf:Property", RdfsLabel: "Parent", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	PasswordLastUsed:         {ID: PasswordLastUsed, RdfType: "rdf:Property", RdfsLabel: "PasswordLastUsed", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
 	Path:                     {ID: Path, RdfType: "rdf:Property", RdfsLabel: "Path", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	PathPrefix:               {ID: PathPrefix, RdfType: "rdf:Property", RdfsLabel: "PathPrefix", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	PeerOwner:                {ID: PeerOwner, RdfType: "rdf:Property", RdfsLabel: "PeerOwner", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	PeerVpc:                  {ID: PeerVpc, RdfType: "rdf:Property", RdfsLabel: "PeerVpc", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 	PendingTasksCount:        {ID: PendingTasksCount, RdfType: "rdf:Property", RdfsLabel: "PendingTasksCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	PlacementGroup:           {ID: PlacementGroup, RdfType: "rdf:Property", RdfsLabel: "PlacementGroup", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Port:                     {ID: Port, RdfType: "rdf:Property", RdfsLabel: "Port", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
@@ -590,6 +723,7 @@ var Properties = RDFProperties{
 	PreferredBackupDate:      {ID: PreferredBackupDate, RdfType: "rdf:Property", RdfsLabel: "PreferredBackupDate", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	PreferredMaintenanceDate: {ID: PreferredMaintenanceDate, RdfType: "rdf:Property", RdfsLabel: "PreferredMaintenanceDate", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	PriceClass:               {ID: PriceClass, RdfType: "rdf:Property", RdfsLabel: "PriceClass", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Principals:                        {ID: Principals, RdfType: "rdf:Property", RdfsLabel: "Principals", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
 	Private:                  {ID: Private, RdfType: "rdf:Property", RdfsLabel: "Private", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	PrivateDNS:               {ID: PrivateDNS, RdfType: "rdf:Property", RdfsLabel: "PrivateDNS", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	PrivateIP:                {ID: PrivateIP, RdfType: "rdf:Property", RdfsLabel: "PrivateIP", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
@@ -599,34 +733,44 @@ var Properties = RDFProperties{
 	Public:                   {ID: Public, RdfType: "rdf:Property", RdfsLabel: "Public", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
 	PublicDNS:                {ID: PublicDNS, RdfType: "rdf:Property", RdfsLabel: "PublicDNS", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	PublicIP:                 {ID: PublicIP, RdfType: "rdf:Property", RdfsLabel: "PublicIP", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	RangeKey:                 {ID: RangeKey, RdfType: "rdf:Property", RdfsLabel: "RangeKey", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	ReadCapacity:             {ID: ReadCapacity, RdfType: "rdf:Property", RdfsLabel: "ReadCapacity", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	RecordCount:              {ID: RecordCount, RdfType: "rdf:Property", RdfsLabel: "RecordCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Records:                  {ID: Records, RdfType: "rdf:Property", RdfsLabel: "Records", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
 	Region:                   {ID: Region, RdfType: "rdf:Property", RdfsLabel: "Region", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	RegisteredContainerInstancesCount: {ID: RegisteredContainerInstancesCount, RdfType: "rdf:Property", RdfsLabel: "RegisteredContainerInstancesCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	ReplicaOf:                         {ID: ReplicaOf, RdfType: "rdf:Property", RdfsLabel: "ReplicaOf", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Retention:                         {ID: Retention, RdfType: "rdf:Property", RdfsLabel: "Retention", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Role:                              {ID: Role, RdfType: "rdf:Property", RdfsLabel: "Role", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 	Roles:                             {ID: Roles, RdfType: "rdf:Property", RdfsLabel: "Roles", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
 	RootDevice:                        {ID: RootDevice, RdfType: "rdf:Property", RdfsLabel: "RootDevice", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	RootDeviceType:                    {ID: RootDeviceType, RdfType: "rdf:Property", RdfsLabel: "RootDeviceType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Routes:                            {ID: Routes, RdfType: "rdf:Property", RdfsLabel: "Routes", RdfsDefinedBy: "rdfs:list", RdfsDataType: "net-owl:Route"},
+	RouteTable:                        {ID: RouteTable, RdfType: "rdf:Property", RdfsLabel: "RouteTable", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
+	RouteTables:                       {ID: RouteTables, RdfType: "rdf:Property", RdfsLabel: "RouteTables", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
 	RunningTasksCount:                 {ID: RunningTasksCount, RdfType: "rdf:Property", RdfsLabel: "RunningTasksCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Runtime:                           {ID: Runtime, RdfType: "rdf:Property", RdfsLabel: "Runtime", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	ScalingAdjustment:                 {ID: ScalingAdjustment, RdfType: "rdf:Property", RdfsLabel: "ScalingAdjustment", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	ScalingGroupName:                  {ID: ScalingGroupName, RdfType: "rdf:Property", RdfsLabel: "ScalingGroupName", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Scheme:                            {ID: Scheme, RdfType: "rdf:Property", RdfsLabel: "Scheme", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Scope:                             {ID: Scope, RdfType: "rdf:Property", RdfsLabel: "Scope", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	SecondaryAvailabilityZone: {ID: SecondaryAvailabilityZone, RdfType: "rdf:Property", RdfsLabel: "SecondaryAvailabilityZone", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	SecondaryPrivateIPs:       {ID: SecondaryPrivateIPs, RdfType: "rdf:Property", RdfsLabel: "SecondaryPrivateIPs", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
 	SecurityGroups:            {ID: SecurityGroups, RdfType: "rdf:Property", RdfsLabel: "SecurityGroups", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
+	Service:                   {ID: Service, RdfType: "rdf:Property", RdfsLabel: "Service", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Set:                       {ID: Set, RdfType: "rdf:Property", RdfsLabel: "Set", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Size:                      {ID: Size, RdfType: "rdf:Property", RdfsLabel: "Size", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Source:                    {ID: Source, RdfType: "rdf:Property", RdfsLabel: "Source", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	SpotInstanceRequestId:     {ID: SpotInstanceRequestId, RdfType: "rdf:Property", RdfsLabel: "SpotInstanceRequestId", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	SpotPrice:                 {ID: SpotPrice, RdfType: "rdf:Property", RdfsLabel: "SpotPrice", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	SSLSupportMethod:          {ID: SSLSupportMethod, RdfType: "rdf:Property", RdfsLabel: "SSLSupportMethod", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	StateMachine:              {ID: StateMachine, RdfType: "rdf:Property", RdfsLabel: "StateMachine", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 	State:                     {ID: State, RdfType: "rdf:Property", RdfsLabel: "State", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	StateMessage:              {ID: StateMessage, RdfType: "rdf:Property", RdfsLabel: "StateMessage", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Stopped:                   {ID: Stopped, RdfType: "rdf:Property", RdfsLabel: "Stopped", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
 	Storage:                   {ID: Storage, RdfType: "rdf:Property", RdfsLabel: "Storage", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	StorageType:               {ID: StorageType, RdfType: "rdf:Property", RdfsLabel: "StorageType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Strategy:                  {ID: Strategy, RdfType: "rdf:Property", RdfsLabel: "Strategy", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Subnet:                    {ID: Subnet, RdfType: "rdf:Property", RdfsLabel: "Subnet", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 	Subnets:                   {ID: Subnets, RdfType: "rdf:Property", RdfsLabel: "Subnets", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
 	Tags:                      {ID: Tags, RdfType: "rdf:Property", RdfsLabel: "Tags", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
@@ -636,6 +780,7 @@ var Properties = RDFProperties{
 	TLSVersionRequired:        {ID: TLSVersionRequired, RdfType: "rdf:Property", RdfsLabel: "TLSVersionRequired", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Topic:                     {ID: Topic, RdfType: "rdf:Property", RdfsLabel: "Topic", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 	TrafficPolicyInstance: {ID: TrafficPolicyInstance, RdfType: "rdf:Property", RdfsLabel: "TrafficPolicyInstance", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	TransitGateway:        {ID: TransitGateway, RdfType: "rdf:Property", RdfsLabel: "TransitGateway", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 	TrustPolicy:           {ID: TrustPolicy, RdfType: "rdf:Property", RdfsLabel: "TrustPolicy", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	TTL:                   {ID: TTL, RdfType: "rdf:Property", RdfsLabel: "TTL", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Type:                  {ID: Type, RdfType: "rdf:Property", RdfsLabel: "Type", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
@@ -644,13 +789,18 @@ var Properties = RDFProperties{
 	URI:                     {ID: URI, RdfType: "rdf:Property", RdfsLabel: "URI", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	UserData:                {ID: UserData, RdfType: "rdf:Property", RdfsLabel: "UserData", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Username:                {ID: Username, RdfType: "rdf:Property", RdfsLabel: "Username", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Utilization:             {ID: Utilization, RdfType: "rdf:Property", RdfsLabel: "Utilization", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Value:                   {ID: Value, RdfType: "rdf:Property", RdfsLabel: "Value", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Version:                 {ID: Version, RdfType: "rdf:Property", RdfsLabel: "Version", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Virtualization:          {ID: Virtualization, RdfType: "rdf:Property", RdfsLabel: "Virtualization", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	VisibilityTimeout:       {ID: VisibilityTimeout, RdfType: "rdf:Property", RdfsLabel: "VisibilityTimeout", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	VLAN:                    {ID: VLAN, RdfType: "rdf:Property", RdfsLabel: "VLAN", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Volume:                  {ID: Volume, RdfType: "rdf:Property", RdfsLabel: "Volume", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 	Vpc:                     {ID: Vpc, RdfType: "rdf:Property", RdfsLabel: "Vpc", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 	Vpcs:                    {ID: Vpcs, RdfType: "rdf:Property", RdfsLabel: "Vpcs", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
+	VpnGateway:              {ID: VpnGateway, RdfType: "rdf:Property", RdfsLabel: "VpnGateway", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 	WebACL:                  {ID: WebACL, RdfType: "rdf:Property", RdfsLabel: "WebACL", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Weight:                  {ID: Weight, RdfType: "rdf:Property", RdfsLabel: "Weight", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	WriteCapacity:           {ID: WriteCapacity, RdfType: "rdf:Property", RdfsLabel: "WriteCapacity", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Zone:                    {ID: Zone, RdfType: "rdf:Property", RdfsLabel: "Zone", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 }
@@ -41,6 +41,7 @@ var (
 
 	NetFirewallRule    = fmt.Sprintf("%s:FirewallRule", NetowlNS)
 	NetRoute           = fmt.Sprintf("%s:Route", NetowlNS)
+	NetNetworkACLRule  = fmt.Sprintf("%s:NetworkACLRule", NetowlNS)
 	CloudGrantee       = fmt.Sprintf("%s:Grantee", CloudOwlNS)
 	KeyValue           = fmt.Sprintf("%s:KeyValue", CloudOwlNS)
 	DistributionOrigin = fmt.Sprintf("%s:DistributionOrigin", CloudOwlNS)
@@ -50,14 +51,18 @@ var (
 
 	NetRouteTargets          = fmt.Sprintf("%s:routeTargets", NetNS)
 	NetDestinationPrefixList = fmt.Sprintf("%s:routeDestinationPrefixList", NetNS)
+	NetRuleNumber            = fmt.Sprintf("%s:ruleNumber", NetNS)
+	NetRuleAction            = fmt.Sprintf("%s:ruleAction", NetNS)
 )
 
 // Relations
 var (
-	ParentOf       = fmt.Sprintf("%s:parentOf", CloudRelNS)
-	ChildrenOfRel  = "childrenOf"
-	ApplyOn        = fmt.Sprintf("%s:applyOn", CloudRelNS)
-	DependingOnRel = "dependingOn"
+	ParentOf        = fmt.Sprintf("%s:parentOf", CloudRelNS)
+	ChildrenOfRel   = "childrenOf"
+	ApplyOn         = fmt.Sprintf("%s:applyOn", CloudRelNS)
+	DependingOnRel  = "dependingOn"
+	MemberOfStack   = fmt.Sprintf("%s:memberOfStack", CloudRelNS)
+	StackMembersRel = "stackMembers"
 )
 
 type rdfProp struct {
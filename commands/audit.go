@@ -0,0 +1,221 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/sync"
+)
+
+const (
+	backupOK      = "ok"
+	backupStale   = "stale"
+	backupMissing = "missing"
+)
+
+var (
+	auditBackupsTagFlag    string
+	auditBackupsMaxAgeFlag time.Duration
+	auditBackupsFormatFlag string
+)
+
+func init() {
+	RootCmd.AddCommand(auditCmd)
+	auditCmd.AddCommand(auditBackupsCmd)
+
+	auditBackupsCmd.Flags().StringVar(&auditBackupsTagFlag, "tag", "Backup=critical", "Tag (KEY=VALUE, or KEY for any value) of the volumes whose backups are audited")
+	auditBackupsCmd.Flags().DurationVar(&auditBackupsMaxAgeFlag, "max-age", 24*time.Hour, "Max age of the latest backup of a resource")
+	auditBackupsCmd.Flags().StringVar(&auditBackupsFormatFlag, "format", "table", "Output format: table or json")
+}
+
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Audit the locally synced resources of all regions",
+}
+
+var auditBackupsCmd = &cobra.Command{
+	Use:   "backups",
+	Short: "Check that the critical volumes and the databases have a recent backup, failing otherwise",
+	Long: `Check that the critical volumes and the databases of all locally synced regions have a recent backup.
+
+A volume is critical when tagged with --tag, and backed up by its latest completed snapshot. The tags of databases
+not being synced, all databases are audited, backed up by their automated backups (latest restorable time).
+
+The command fails when a resource has no backup or an older one than --max-age, so that it can run in a scheduled job.
+Restore a backup with 'awless restore volume' or 'awless restore database'.`,
+	Example:           "  awless audit backups\n  awless audit backups --tag Env=prod --max-age 12h\n  awless restore volume snapshot=snap-12345 availabilityzone=eu-west-1a",
+	PersistentPreRun:  applyHooks(initAwlessEnvHook, initLoggerHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		graphs, err := sync.LoadLocalGraphsPerRegion(config.GetAWSProfile())
+		exitOn(err)
+		if len(graphs) == 0 {
+			exitOn(fmt.Errorf("no local resources for profile '%s': run `awless sync` first", config.GetAWSProfile()))
+		}
+
+		audit, err := auditBackups(graphs, auditBackupsTagFlag, auditBackupsMaxAgeFlag, time.Now())
+		exitOn(err)
+		exitOn(audit.print(os.Stdout, auditBackupsFormatFlag))
+
+		if failed := audit.failed(); failed > 0 {
+			exitOn(fmt.Errorf("%d resources without backup of less than %s", failed, auditBackupsMaxAgeFlag))
+		}
+	},
+}
+
+type backupsAudit struct {
+	Tag       string                `json:"tag"`
+	MaxAge    string                `json:"maxAge"`
+	Resources []*backupAuditedEntry `json:"resources"`
+}
+
+type backupAuditedEntry struct {
+	Region string `json:"region"`
+	Type   string `json:"type"`
+	ID     string `json:"id"`
+	Name   string `json:"name,omitempty"`
+	// Backup is the snapshot of a volume, empty for the automated backups of databases
+	Backup     string     `json:"backup,omitempty"`
+	LastBackup *time.Time `json:"lastBackup,omitempty"`
+	Status     string     `json:"status"`
+}
+
+// auditBackups lists the volumes tagged with tag and the databases of the graphs, with the date
+// of their latest backup and whether it is older than maxAge at now
+func auditBackups(graphs map[string]cloud.GraphAPI, tag string, maxAge time.Duration, now time.Time) (*backupsAudit, error) {
+	tagKey, tagValue, anyValue := tag, "", true
+	if kv := strings.SplitN(tag, "=", 2); len(kv) == 2 {
+		tagKey, tagValue, anyValue = kv[0], kv[1], false
+	}
+	if tagKey == "" {
+		return nil, fmt.Errorf("invalid tag '%s': expecting KEY=VALUE or KEY", tag)
+	}
+
+	audit := &backupsAudit{Tag: tag, MaxAge: maxAge.String()}
+	var regions []string
+	for region := range graphs {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	for _, region := range regions {
+		resources, err := graphs[region].Find(cloud.NewQuery(cloud.Volume, cloud.Snapshot, cloud.Database))
+		if err != nil {
+			return nil, err
+		}
+		sort.Sort(byTypeAndString{resources})
+
+		latestSnapshots := make(map[string]cloud.Resource)
+		for _, res := range resources {
+			if res.Type() != cloud.Snapshot || stringProp(res, properties.State) != "completed" {
+				continue
+			}
+			vol := stringProp(res, properties.Volume)
+			if latest, ok := latestSnapshots[vol]; !ok || timeProp(res, properties.Created).After(timeProp(latest, properties.Created)) {
+				latestSnapshots[vol] = res
+			}
+		}
+
+		for _, res := range resources {
+			entry := &backupAuditedEntry{Region: region, Type: res.Type(), ID: res.Id(), Name: stringProp(res, properties.Name)}
+			switch res.Type() {
+			case cloud.Volume:
+				if v := dimensionValue(res, region, tagDimensionPref+tagKey); v == "" || (!anyValue && v != tagValue) {
+					continue
+				}
+				if snap, ok := latestSnapshots[res.Id()]; ok {
+					entry.Backup = snap.Id()
+					entry.LastBackup = timePointer(timeProp(snap, properties.Created))
+				}
+			case cloud.Database:
+				entry.LastBackup = timePointer(timeProp(res, properties.LatestRestorableTime))
+			default:
+				continue
+			}
+			switch {
+			case entry.LastBackup == nil:
+				entry.Status = backupMissing
+			case now.Sub(*entry.LastBackup) > maxAge:
+				entry.Status = backupStale
+			default:
+				entry.Status = backupOK
+			}
+			audit.Resources = append(audit.Resources, entry)
+		}
+	}
+	return audit, nil
+}
+
+func (a *backupsAudit) failed() (count int) {
+	for _, res := range a.Resources {
+		if res.Status != backupOK {
+			count++
+		}
+	}
+	return
+}
+
+func (a *backupsAudit) print(w io.Writer, format string) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(a)
+	case "table", "":
+		if len(a.Resources) == 0 {
+			fmt.Fprintf(w, "No volume tagged %s nor database found.\n", a.Tag)
+			return nil
+		}
+		t := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(t, "TYPE\tREGION\tID\tNAME\tBACKUP\tLAST BACKUP\tSTATUS")
+		for _, res := range a.Resources {
+			var last string
+			if res.LastBackup != nil {
+				last = res.LastBackup.UTC().Format("2006-01-02 15:04")
+			}
+			fmt.Fprintf(t, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", res.Type, res.Region, res.ID, orDash(res.Name), orDash(res.Backup), orDash(last), strings.ToUpper(res.Status))
+		}
+		return t.Flush()
+	default:
+		return fmt.Errorf("unsupported format '%s' for backups audit: expected table or json", format)
+	}
+}
+
+func timeProp(res cloud.Resource, key string) time.Time {
+	t, _ := res.Properties()[key].(time.Time)
+	return t
+}
+
+func timePointer(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
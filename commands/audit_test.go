@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	p "github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestAuditBackups(t *testing.T) {
+	now := time.Date(2018, 1, 10, 12, 0, 0, 0, time.UTC)
+	eu := graph.NewGraph()
+	eu.AddResource(resourcetest.Volume("vol-1").Prop(p.Name, "data").Prop(p.Tags, []string{"Backup=critical"}).Build())
+	eu.AddResource(resourcetest.Volume("vol-2").Prop(p.Tags, []string{"Backup=critical"}).Build())
+	eu.AddResource(resourcetest.Volume("vol-3").Prop(p.Tags, []string{"Backup=none"}).Build())
+	eu.AddResource(resourcetest.Volume("vol-4").Build())
+	eu.AddResource(resourcetest.Snapshot("snap-1").Prop(p.Volume, "vol-1").Prop(p.State, "completed").Prop(p.Created, now.Add(-30*time.Hour)).Build())
+	eu.AddResource(resourcetest.Snapshot("snap-2").Prop(p.Volume, "vol-1").Prop(p.State, "completed").Prop(p.Created, now.Add(-2*time.Hour)).Build())
+	eu.AddResource(resourcetest.Snapshot("snap-3").Prop(p.Volume, "vol-1").Prop(p.State, "pending").Prop(p.Created, now.Add(-1*time.Hour)).Build())
+	eu.AddResource(resourcetest.Snapshot("snap-4").Prop(p.Volume, "vol-3").Prop(p.State, "completed").Prop(p.Created, now.Add(-1*time.Hour)).Build())
+	us := graph.NewGraph()
+	us.AddResource(resourcetest.Database("db-1").Prop(p.LatestRestorableTime, now.Add(-48*time.Hour)).Build())
+	us.AddResource(resourcetest.Database("db-2").Prop(p.Name, "orders").Prop(p.LatestRestorableTime, now.Add(-5*time.Minute)).Build())
+	us.AddResource(resourcetest.Database("db-3").Build())
+	graphs := map[string]cloud.GraphAPI{"eu-west-1": eu, "us-east-1": us}
+
+	audit, err := auditBackups(graphs, "Backup=critical", 24*time.Hour, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buff bytes.Buffer
+	if err = audit.print(&buff, "table"); err != nil {
+		t.Fatal(err)
+	}
+	expected := `TYPE      REGION     ID     NAME    BACKUP  LAST BACKUP       STATUS
+volume    eu-west-1  vol-1  data    snap-2  2018-01-10 10:00  OK
+volume    eu-west-1  vol-2  -       -       -                 MISSING
+database  us-east-1  db-2   orders  -       2018-01-10 11:55  OK
+database  us-east-1  db-1   -       -       2018-01-08 12:00  STALE
+database  us-east-1  db-3   -       -       -                 MISSING
+`
+	if got, want := buff.String(), expected; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+	if got, want := audit.failed(), 3; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	audit, err = auditBackups(graphs, "Backup", 24*time.Hour, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, res := range audit.Resources {
+		ids = append(ids, res.ID)
+	}
+	if got, want := len(ids), 6; got != want {
+		t.Fatalf("got %d (%v), want %d", got, ids, want)
+	}
+
+	if _, err = auditBackups(graphs, "=critical", 24*time.Hour, now); err == nil {
+		t.Fatal("expected error for tag without key")
+	}
+}
@@ -0,0 +1,312 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/database"
+	awlesssync "github.com/wallix/awless/sync"
+)
+
+const (
+	diagnosisOK      = "ok"
+	diagnosisWarning = "warning"
+	diagnosisError   = "error"
+	diagnosisSkipped = "skipped"
+
+	minOpenFilesLimit = 1024
+	// AWS rejects requests signed more than 15 minutes away from its time
+	maxClockSkew     = 15 * time.Minute
+	warningClockSkew = 1 * time.Minute
+	dialTimeout      = 5 * time.Second
+)
+
+var doctorEnvErr error
+
+func init() {
+	RootCmd.AddCommand(doctorCmd)
+}
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Diagnose your awless environment (runtime, local store, config, credentials, clock, network) and suggest fixes",
+	Long:  "Diagnose your awless environment and suggest fixes: runtime limits, local store integrity, config validity, AWS credentials, clock skew and network reachability of AWS endpoints. Use --local to skip the checks contacting AWS",
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		// a broken environment is diagnosed rather than exited on
+		doctorEnvErr = initAwlessEnvHook(cmd, args)
+		applyHooks(initLoggerHook)(cmd, args)
+	},
+
+	Run: func(cmd *cobra.Command, args []string) {
+		profile, region := config.GetAWSProfile(), config.GetAWSRegion()
+
+		diagnoses := []*diagnosis{diagnoseRuntime(), diagnoseStore(doctorEnvErr), diagnoseConfig(), diagnoseLocalInventory(profile, region)}
+		if localGlobalFlag {
+			for _, name := range []string{"credentials", "clock", "network"} {
+				diagnoses = append(diagnoses, &diagnosis{name: name, status: diagnosisSkipped, detail: "`--local` flag prevents contacting AWS"})
+			}
+		} else {
+			diagnoses = append(diagnoses, diagnoseCredentials(profile, region), diagnoseClock(region), diagnoseNetwork(region))
+		}
+
+		printDiagnoses(os.Stdout, diagnoses)
+
+		var failed int
+		for _, d := range diagnoses {
+			if d.status == diagnosisError {
+				failed++
+			}
+		}
+		if failed > 0 {
+			exitOn(fmt.Errorf("%d check(s) failed", failed))
+		}
+	},
+}
+
+type diagnosis struct {
+	name, status, detail string
+	fixes                []string
+}
+
+func (d *diagnosis) fail(status, detail string, fixes ...string) *diagnosis {
+	d.status, d.detail, d.fixes = status, detail, fixes
+	return d
+}
+
+func printDiagnoses(w io.Writer, diagnoses []*diagnosis) {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for _, d := range diagnoses {
+		var status string
+		switch d.status {
+		case diagnosisOK:
+			status = renderGreenFn(d.status)
+		case diagnosisWarning:
+			status = renderYellowFn(d.status)
+		case diagnosisError:
+			status = renderRedFn(d.status)
+		default:
+			status = d.status
+		}
+		// chained AWS errors span several lines
+		fmt.Fprintf(tw, "[%s]\t%s\t%s\n", status, d.name, strings.Replace(d.detail, "\n", " ", -1))
+		for _, fix := range d.fixes {
+			fmt.Fprintf(tw, "\t\t-> %s\n", fix)
+		}
+	}
+	tw.Flush()
+}
+
+func diagnoseRuntime() *diagnosis {
+	d := &diagnosis{name: "runtime", status: diagnosisOK}
+	d.detail = fmt.Sprintf("awless %s, %s %s/%s, %d CPU(s)", config.Version, runtime.Version(), runtime.GOOS, runtime.GOARCH, runtime.NumCPU())
+	if limit, ok := openFilesLimit(); ok {
+		d.detail += fmt.Sprintf(", open files limit %d", limit)
+		if limit < minOpenFilesLimit {
+			return d.fail(diagnosisWarning, d.detail,
+				fmt.Sprintf("sync and concurrent templates open many connections: raise the limit to at least %d (ex: `ulimit -n 4096`)", minOpenFilesLimit))
+		}
+	}
+	return d
+}
+
+func diagnoseStore(envErr error) *diagnosis {
+	d := &diagnosis{name: "store", status: diagnosisOK, detail: config.DBPath}
+	if info, err := os.Stat(config.AwlessHome); err != nil || !info.IsDir() {
+		return d.fail(diagnosisError, fmt.Sprintf("cannot access awless home %s: %v", config.AwlessHome, err),
+			fmt.Sprintf("make sure %s is a directory you own (it is created on first run)", config.AwlessHome))
+	}
+	if envErr != nil {
+		return d.fail(diagnosisError, envErr.Error(),
+			"if another awless process is running, wait for it to finish or stop it",
+			fmt.Sprintf("otherwise check permissions of %s", config.DBPath))
+	}
+	err := database.Execute(func(db *database.DB) error {
+		return db.Check()
+	})
+	if err != nil {
+		return d.fail(diagnosisError, err.Error(),
+			fmt.Sprintf("move %s aside and run awless again to recreate it (your config will be reset)", config.DBPath))
+	}
+	return d
+}
+
+func diagnoseConfig() *diagnosis {
+	d := &diagnosis{name: "config", status: diagnosisOK, detail: "valid"}
+	errs := config.Validate()
+	if len(errs) == 0 {
+		return d
+	}
+	var details []string
+	for _, err := range errs {
+		details = append(details, err.Error())
+	}
+	return d.fail(diagnosisError, strings.Join(details, "; "),
+		"fix values with `awless config set KEY VALUE` or remove them with `awless config unset KEY`", "list current values with `awless config list`")
+}
+
+func diagnoseLocalInventory(profile, region string) *diagnosis {
+	d := &diagnosis{name: "inventory", status: diagnosisOK, detail: fmt.Sprintf("local resources of profile '%s' in '%s' loaded", profile, region)}
+	if _, err := awlesssync.LoadLocalGraphs(profile, region); err != nil {
+		return d.fail(diagnosisWarning, fmt.Sprintf("cannot load local resources: %s", err), "run `awless sync` to refresh them")
+	}
+	return d
+}
+
+func diagnoseCredentials(profile, region string) *diagnosis {
+	d := &diagnosis{name: "credentials", status: diagnosisOK}
+	health, err := awsservices.CheckCredentials(profile, region)
+	if err != nil {
+		code := "error"
+		if awsErr, ok := err.(awserr.Error); ok {
+			code = awsErr.Code()
+		}
+		detail := fmt.Sprintf("profile '%s': %s", profile, err)
+		switch code {
+		case "NoCredentialProviders", "SharedCredsLoad":
+			return d.fail(diagnosisError, detail,
+				"configure credentials with `aws configure`, set AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY, or run any awless command to be prompted",
+				"or select an existing profile with `-p PROFILE` or `awless config set aws.profile PROFILE`")
+		case "ExpiredToken", "ExpiredTokenException", "RequestExpired":
+			return d.fail(diagnosisError, detail, "your temporary credentials expired: renew them (ex: new session token, SSO login)")
+		case "InvalidClientTokenId", "UnrecognizedClientException", "AccessDenied":
+			return d.fail(diagnosisError, detail, "the access key is unknown or disabled: check it in the IAM console and update it with `aws configure`")
+		case "SignatureDoesNotMatch", "RequestTimeTooSkewed", "InvalidSignatureException":
+			return d.fail(diagnosisError, detail, "the secret key is wrong or your clock is off: see the clock check below")
+		default:
+			return d.fail(diagnosisError, detail, "run with -e to see the credential chain and requests in extra verbose mode")
+		}
+	}
+
+	d.detail = fmt.Sprintf("profile '%s' resolved through %s as %s", profile, health.Provider, health.Identity.Arn)
+	if health.Identity.IsRoot() {
+		return d.fail(diagnosisWarning, d.detail, "best practices suggest using an IAM user or role rather than root credentials")
+	}
+	if os.Getenv("AWS_ACCESS_KEY_ID") != "" && profile != "" && profile != "default" {
+		return d.fail(diagnosisWarning, d.detail, fmt.Sprintf("AWS_ACCESS_KEY_ID is set and takes precedence over profile '%s': unset it to use the profile", profile))
+	}
+	return d
+}
+
+func diagnoseClock(region string) *diagnosis {
+	d := &diagnosis{name: "clock", status: diagnosisOK}
+	hosts, err := awsservices.RequiredEndpoints(region)
+	if err != nil {
+		return d.fail(diagnosisError, err.Error(), "set a valid region with `awless config set aws.region`")
+	}
+	sent := time.Now()
+	resp, err := (&http.Client{Timeout: dialTimeout}).Head(fmt.Sprintf("https://%s", hosts[0]))
+	if err != nil {
+		return d.fail(diagnosisWarning, fmt.Sprintf("cannot get AWS time: %s", err), "see the network check below")
+	}
+	resp.Body.Close()
+	skew, err := clockSkew(resp.Header.Get("Date"), sent, time.Now())
+	if err != nil {
+		return d.fail(diagnosisWarning, err.Error())
+	}
+	d.detail = fmt.Sprintf("local time is %s off AWS time", skew)
+	fix := "synchronize your clock with NTP (ex: `sudo ntpdate pool.ntp.org`, or enable automatic time in your system settings)"
+	switch {
+	case skew >= maxClockSkew:
+		return d.fail(diagnosisError, d.detail+": AWS rejects the request signatures", fix)
+	case skew >= warningClockSkew:
+		return d.fail(diagnosisWarning, d.detail, fix)
+	}
+	return d
+}
+
+// clockSkew compares the Date header of a response with the local time
+// halfway between sending the request and receiving its response
+func clockSkew(serverDate string, sent, received time.Time) (time.Duration, error) {
+	if serverDate == "" {
+		return 0, errors.New("cannot get AWS time: no Date header in response")
+	}
+	server, err := http.ParseTime(serverDate)
+	if err != nil {
+		return 0, fmt.Errorf("cannot get AWS time: %s", err)
+	}
+	local := sent.Add(received.Sub(sent) / 2)
+	skew := local.Sub(server)
+	if skew < 0 {
+		skew = -skew
+	}
+	// the Date header has a 1 second precision
+	return skew.Truncate(time.Second), nil
+}
+
+func diagnoseNetwork(region string) *diagnosis {
+	d := &diagnosis{name: "network", status: diagnosisOK}
+	hosts, err := awsservices.RequiredEndpoints(region)
+	if err != nil {
+		return d.fail(diagnosisError, err.Error(), "set a valid region with `awless config set aws.region`")
+	}
+
+	unreachable := make([]string, len(hosts))
+	var wg sync.WaitGroup
+	for i, host := range hosts {
+		wg.Add(1)
+		go func(i int, host string) {
+			defer wg.Done()
+			conn, err := net.DialTimeout("tcp", host, dialTimeout)
+			if err != nil {
+				unreachable[i] = fmt.Sprintf("%s (%s)", host, err)
+				return
+			}
+			conn.Close()
+		}(i, host)
+	}
+	wg.Wait()
+
+	var failures []string
+	for _, u := range unreachable {
+		if u != "" {
+			failures = append(failures, u)
+		}
+	}
+	d.detail = fmt.Sprintf("%d/%d AWS endpoints reachable", len(hosts)-len(failures), len(hosts))
+	if proxy := httpsProxy(); proxy != "" {
+		d.detail += fmt.Sprintf(" (proxy %s)", proxy)
+	}
+	if len(failures) > 0 {
+		return d.fail(diagnosisError, fmt.Sprintf("%s: unreachable %s", d.detail, strings.Join(failures, ", ")),
+			"allow outbound HTTPS (port 443) to these hosts in your firewall, or set HTTPS_PROXY if you are behind a proxy")
+	}
+	return d
+}
+
+func httpsProxy() string {
+	for _, name := range []string{"HTTPS_PROXY", "https_proxy"} {
+		if v := os.Getenv(name); v != "" {
+			return v
+		}
+	}
+	return ""
+}
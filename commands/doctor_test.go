@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/fatih/color"
+)
+
+func TestClockSkew(t *testing.T) {
+	sent := time.Date(2017, 10, 2, 15, 4, 5, 0, time.UTC)
+	tcases := []struct {
+		date     string
+		received time.Time
+		expect   time.Duration
+	}{
+		{date: "Mon, 02 Oct 2017 15:04:05 GMT", received: sent.Add(200 * time.Millisecond), expect: 0},
+		{date: "Mon, 02 Oct 2017 15:24:05 GMT", received: sent.Add(2 * time.Second), expect: 19*time.Minute + 59*time.Second},
+		{date: "Mon, 02 Oct 2017 15:02:05 GMT", received: sent, expect: 2 * time.Minute},
+	}
+	for i, tcase := range tcases {
+		skew, err := clockSkew(tcase.date, sent, tcase.received)
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if got, want := skew, tcase.expect; got != want {
+			t.Fatalf("%d: got %s, want %s", i+1, got, want)
+		}
+	}
+	if _, err := clockSkew("", sent, sent); err == nil {
+		t.Fatal("expected error")
+	}
+	if _, err := clockSkew("yesterday", sent, sent); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestPrintDiagnoses(t *testing.T) {
+	defer func(noColor bool) { color.NoColor = noColor }(color.NoColor)
+	color.NoColor = true
+
+	var buff bytes.Buffer
+	printDiagnoses(&buff, []*diagnosis{
+		{name: "runtime", status: diagnosisOK, detail: "go1.9"},
+		(&diagnosis{name: "clock"}).fail(diagnosisError, "20m0s off", "synchronize your clock"),
+	})
+	expected := `[ok]     runtime  go1.9
+[error]  clock    20m0s off
+                  -> synchronize your clock
+`
+	if got, want := buff.String(), expected; got != want {
+		t.Fatalf("got\n%q\nwant\n%q", got, want)
+	}
+}
@@ -26,6 +26,6 @@ import (
 func exitOn(err error) {
 	if err != nil {
 		fmt.Fprintln(os.Stderr, color.RedString("[error]  "), err)
-		os.Exit(1)
+		exit(1)
 	}
 }
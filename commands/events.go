@@ -0,0 +1,114 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/spec"
+	"github.com/wallix/awless/aws/trail"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync"
+)
+
+var (
+	eventsResourceFlag  string
+	eventsPrincipalFlag string
+	eventsSinceFlag     time.Duration
+	eventsMaxFlag       int
+)
+
+func init() {
+	listCmd.AddCommand(listEventsCmd)
+
+	listEventsCmd.Flags().StringVar(&eventsResourceFlag, "resource", "", "Only events acting on the resource with this id or name")
+	listEventsCmd.Flags().StringVar(&eventsPrincipalFlag, "principal", "", "Only events performed by this principal (IAM user name or ARN)")
+	listEventsCmd.Flags().DurationVar(&eventsSinceFlag, "since", 24*time.Hour, "Only events that happened since this duration. Ex: 2h, 30m")
+	listEventsCmd.Flags().IntVar(&eventsMaxFlag, "max", 50, "Maximum number of events to fetch (0 for no limit)")
+}
+
+var listEventsCmd = &cobra.Command{
+	Use:     "events",
+	Short:   "[infra] List recent CloudTrail events (who created, modified or deleted what, when and from where), correlated with the locally synced resources",
+	Example: "  awless list events --resource i-0c2bea0ff5ef6ad39\n  awless list events --principal john --since 2h\n  awless list events --format json",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		factory, ok := awsspec.CommandFactory.(*awsspec.AWSFactory)
+		if !ok || factory.Sess == nil {
+			exitOn(fmt.Errorf("no AWS session to fetch CloudTrail events"))
+		}
+		events, err := awstrail.Lookup(cloudtrail.New(factory.Sess), awstrail.Filter{
+			ResourceID: eventsResourceFlag,
+			Principal:  eventsPrincipalFlag,
+			Since:      time.Now().Add(-eventsSinceFlag),
+			Max:        eventsMaxFlag,
+		})
+		exitOn(err)
+
+		if g, err := sync.LoadLocalGraphs(config.GetAWSProfile(), config.GetAWSRegion()); err != nil {
+			logger.Verbosef("cannot correlate events with local resources: %s", err)
+		} else {
+			exitOn(awstrail.Correlate(events, g))
+		}
+		exitOn(printEvents(os.Stdout, events, listingFormat))
+	},
+}
+
+func printEvents(w io.Writer, events []*awstrail.Event, format string) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(events)
+	case "table", "":
+		t := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		if !noHeadersFlag {
+			fmt.Fprintln(t, "TIME\tPRINCIPAL\tACTION\tEVENT\tRESOURCES\tSOURCE IP\tERROR")
+		}
+		for _, e := range events {
+			var resources []string
+			for _, r := range e.Resources {
+				resources = append(resources, formatEventResource(r))
+			}
+			fmt.Fprintf(t, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", e.Time.Local().Format(time.Stamp), e.Principal, e.Action,
+				e.Source+":"+e.Name, strings.Join(resources, ", "), e.SourceIP, e.ErrorCode)
+		}
+		return t.Flush()
+	default:
+		return fmt.Errorf("unsupported format '%s' for events: expected table or json", format)
+	}
+}
+
+func formatEventResource(r *awstrail.EventResource) string {
+	switch {
+	case !r.Exists:
+		return r.ID
+	case r.Name != "":
+		return fmt.Sprintf("%s[%s %s]", r.ID, r.Type, r.Name)
+	default:
+		return fmt.Sprintf("%s[%s]", r.ID, r.Type)
+	}
+}
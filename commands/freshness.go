@@ -0,0 +1,98 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync"
+)
+
+// freshenLocalData displays the age of the local data of the given services (all when none)
+// in the current region. When the autosync.maxage config is set, the services synced
+// longer ago are synced first, unless working offline (--local, --no-auto-sync, --no-sync)
+func freshenLocalData(serviceNames ...string) {
+	profile, region := config.GetAWSProfile(), config.GetAWSRegion()
+	times := sync.LastSyncTimes(profile, region)
+	if len(serviceNames) == 0 {
+		for name := range cloud.ServiceRegistry {
+			serviceNames = append(serviceNames, name)
+		}
+	}
+	if len(serviceNames) == 0 { // cloud services not initialized
+		for name := range times {
+			serviceNames = append(serviceNames, name)
+		}
+	}
+	sort.Strings(serviceNames)
+
+	maxAge := config.GetAutosyncMaxAge()
+	offline := localGlobalFlag || noAutoSyncGlobalFlag || noSyncGlobalFlag || !config.GetAutosync()
+	if maxAge > 0 && !offline {
+		var services []cloud.Service
+		for _, name := range staleServices(times, serviceNames, maxAge, time.Now()) {
+			if srv, ok := cloud.ServiceRegistry[name]; ok && !srv.IsSyncDisabled() {
+				services = append(services, srv)
+			}
+		}
+		if len(services) > 0 {
+			logger.Infof("Syncing %s, synced more than %s ago (disable with --no-auto-sync global flag)", joinSentence(cloud.Services(services).Names()), maxAge)
+			syncer := sync.DefaultSyncer
+			if syncer == nil {
+				syncer = sync.NewSyncer(logger.DefaultLogger)
+			}
+			if _, err := syncer.Sync(services...); err != nil {
+				logger.Verbose(err)
+			}
+			times = sync.LastSyncTimes(profile, region)
+		}
+	}
+
+	if report := freshnessReport(times, serviceNames); report != "" {
+		logger.Info(report)
+	} else {
+		logger.Infof("no local data synced for region '%s' and profile '%s' (run `awless sync`)", region, profile)
+	}
+}
+
+// staleServices returns the services, among the given ones, never synced or synced longer than maxAge ago
+func staleServices(times map[string]time.Time, serviceNames []string, maxAge time.Duration, now time.Time) (stale []string) {
+	for _, name := range serviceNames {
+		if synced, ok := times[name]; !ok || now.Sub(synced) > maxAge {
+			stale = append(stale, name)
+		}
+	}
+	return
+}
+
+// freshnessReport describes how long ago the services were synced (ex: infra synced 3 hours ago),
+// grouping the services synced at the same time
+func freshnessReport(times map[string]time.Time, serviceNames []string) string {
+	var ages []string
+	servicesPerAge := make(map[string][]string)
+	for _, name := range serviceNames {
+		synced, ok := times[name]
+		if !ok {
+			continue
+		}
+		age := console.HumanizeTime(synced)
+		if _, ok := servicesPerAge[age]; !ok {
+			ages = append(ages, age)
+		}
+		servicesPerAge[age] = append(servicesPerAge[age], name)
+	}
+	var parts []string
+	for _, age := range ages {
+		if age == "now" {
+			parts = append(parts, fmt.Sprintf("%s synced just now", joinSentence(servicesPerAge[age])))
+		} else {
+			parts = append(parts, fmt.Sprintf("%s synced %s ago", joinSentence(servicesPerAge[age]), age))
+		}
+	}
+	return strings.Join(parts, "; ")
+}
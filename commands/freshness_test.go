@@ -0,0 +1,31 @@
+package commands
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestStaleServicesAndFreshnessReport(t *testing.T) {
+	now := time.Now()
+	times := map[string]time.Time{
+		"infra":   now.Add(-3*time.Hour - 30*time.Minute),
+		"access":  now.Add(-3*time.Hour - 20*time.Minute),
+		"storage": now.Add(-10*time.Minute - 30*time.Second),
+	}
+	names := []string{"access", "dns", "infra", "storage"}
+
+	if got, want := staleServices(times, names, time.Hour, now), []string{"access", "dns", "infra"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := staleServices(times, []string{"infra", "storage"}, 4*time.Hour, now), []string(nil); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	if got, want := freshnessReport(times, names), "access and infra synced 3 hours ago; storage synced 10 mins ago"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if got, want := freshnessReport(times, []string{"dns"}), ""; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/graph"
+)
+
+var (
+	graphExportFormatFlag     string
+	graphExportAllRegionsFlag bool
+)
+
+func init() {
+	RootCmd.AddCommand(graphCmd)
+	graphCmd.AddCommand(graphExportCmd)
+
+	graphExportCmd.Flags().StringVar(&graphExportFormatFlag, "format", graph.DotFormat, fmt.Sprintf("Export format: %s", strings.Join(graph.ExportFormats, ", ")))
+	graphExportCmd.Flags().BoolVar(&graphExportAllRegionsFlag, "all-regions", false, "Export the synced resources of all regions of the current profile")
+}
+
+var graphCmd = &cobra.Command{
+	Use:               "graph",
+	Short:             "Operations on the graph of the locally synced resources",
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook),
+}
+
+var graphExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the graph of the locally synced resources to visualize it (Graphviz DOT, GraphML) or load it in a graph database (Neo4j Cypher, GraphSON)",
+	Long: `Export the graph of the locally synced resources.
+
+Resources are exported as typed nodes carrying their properties. Parent relations (PARENT_OF),
+applies-on relations (APPLIES_ON), stack memberships (MEMBER_OF_STACK) and references between resources
+(ex: VPC, SECURITY_GROUPS) become labeled edges.
+
+  - dot: Graphviz digraph, to render with dot, neato, ...
+  - graphml: GraphML document, to open in Gephi, yEd or Cytoscape
+  - cypher: MERGE statements, to pipe into cypher-shell (Neo4j)
+  - graphson: GraphSON adjacency list, one vertex per line, to read with TinkerPop's GraphSONReader`,
+	Example: `  awless graph export | dot -Tsvg > infra.svg
+  awless graph export --format graphml --all-regions > infra.graphml
+  awless graph export --format cypher | cypher-shell -u neo4j -p secret`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		exportLocalGraph(graphExportFormatFlag, graphExportAllRegionsFlag)
+	},
+}
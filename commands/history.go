@@ -17,6 +17,7 @@ limitations under the License.
 package commands
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 
@@ -31,19 +32,20 @@ import (
 )
 
 var (
-	showProperties bool
+	showProperties    bool
+	historyFormatFlag string
 )
 
 func init() {
 	RootCmd.AddCommand(historyCmd)
 
 	historyCmd.Flags().BoolVar(&showProperties, "properties", false, "Full diff with resources properties")
+	historyCmd.Flags().StringVar(&historyFormatFlag, "format", "", "Output format: json (added, removed and changed resources with key-level changes of JSON properties)")
 }
 
 var historyCmd = &cobra.Command{
 	Use:               "history",
-	Hidden:            true,
-	Short:             "(in progress) Show a infra resource history & changes using your locally sync snapshots",
+	Short:             "Search and rerun your past awless invocations (see subcommands), or show the infra resources changes between your locally synced snapshots",
 	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
 	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
 
@@ -70,8 +72,13 @@ var historyCmd = &cobra.Command{
 			diffs = append(diffs, d)
 		}
 
+		if historyFormatFlag == "json" {
+			return printRevisionDiffsJSON(diffs, root)
+		}
+
 		for _, diff := range diffs {
 			displayRevisionDiff(diff, awsservices.InfraService.Name(), root, verboseGlobalFlag)
+			displayRevisionDiff(diff, awsservices.AccessService.Name(), root, verboseGlobalFlag)
 		}
 
 		return nil
@@ -85,8 +92,11 @@ func displayRevisionDiff(diff *sync.Diff, cloudService string, root *graph.Resou
 	}
 
 	var graphdiff *graph.Diff
-	if cloudService == awsservices.InfraService.Name() {
+	switch cloudService {
+	case awsservices.InfraService.Name():
 		graphdiff = diff.InfraDiff
+	case awsservices.AccessService.Name():
+		graphdiff = diff.AccessDiff
 	}
 
 	if showProperties {
@@ -123,3 +133,28 @@ func displayRevisionDiff(diff *sync.Diff, cloudService string, root *graph.Resou
 		}
 	}
 }
+
+type revisionDiffReport struct {
+	From   string              `json:"from"`
+	To     string              `json:"to"`
+	Infra  *console.DiffReport `json:"infra"`
+	Access *console.DiffReport `json:"access"`
+}
+
+func printRevisionDiffsJSON(diffs []*sync.Diff, root *graph.Resource) error {
+	reports := []*revisionDiffReport{}
+	for _, diff := range diffs {
+		report := &revisionDiffReport{From: diff.From.Id, To: diff.To.Id}
+		var err error
+		if report.Infra, err = console.BuildDiffReport(diff.InfraDiff, root); err != nil {
+			return err
+		}
+		if report.Access, err = console.BuildDiffReport(diff.AccessDiff, root); err != nil {
+			return err
+		}
+		reports = append(reports, report)
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", " ")
+	return enc.Encode(reports)
+}
@@ -22,14 +22,17 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/spf13/cobra"
 	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/aws/spec"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/config"
 	"github.com/wallix/awless/database"
 	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/sync"
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/driver"
+	"github.com/wallix/awless/template/plugin"
 )
 
 func applyHooks(funcs ...func(*cobra.Command, []string) error) func(*cobra.Command, []string) {
@@ -58,6 +61,11 @@ func applyRegionAndProfilePrecedence() error {
 			return err
 		}
 		profileOverridenThrough = "command flag"
+	} else if envProfile := os.Getenv("AWS_PROFILE"); envProfile != "" {
+		if err := config.SetVolatile(config.ProfileConfigKey, envProfile); err != nil {
+			return err
+		}
+		profileOverridenThrough = "AWS_PROFILE variable"
 	} else if envProfile := os.Getenv("AWS_DEFAULT_PROFILE"); envProfile != "" {
 		if err := config.SetVolatile(config.ProfileConfigKey, envProfile); err != nil {
 			return err
@@ -67,17 +75,13 @@ func applyRegionAndProfilePrecedence() error {
 
 	profile := config.GetAWSProfile()
 
-	if region, embedded, err := hasEmbeddedRegionInSharedConfigForProfile(profile); err == nil {
-		if embedded {
-			if e := config.SetVolatile(config.RegionConfigKey, region); e != nil {
-				return e
-			}
-			regionOverridenThrough = fmt.Sprintf("profile '%s' (see AWS config files $HOME/.aws/{credentials,config})", profile)
-		} else {
-			regionOverridenThrough = ""
+	if region, embedded := awsservices.SharedConfigRegion(profile); embedded {
+		if e := config.SetVolatile(config.RegionConfigKey, region); e != nil {
+			return e
 		}
+		regionOverridenThrough = fmt.Sprintf("profile '%s' (see AWS config files $HOME/.aws/{credentials,config})", profile)
 	} else {
-		return err
+		regionOverridenThrough = ""
 	}
 
 	if awsRegionGlobalFlag != "" {
@@ -153,6 +157,48 @@ func initSyncerHook(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var pluginRegistry = &plugin.Registry{}
+
+// driverMux routes the template commands: namespaced entities (ex: ocean.droplet)
+// to their sub driver, the others to AWS then to the plugins
+var driverMux = driver.NewMux(func(tokens ...string) interface{} {
+	newCommandFunc := awsspec.CommandFactory.Build(strings.Join(tokens, ""))
+	if newCommandFunc == nil {
+		return pluginRegistry.Lookup(tokens...)
+	}
+	return newCommandFunc()
+})
+
+// registerDriver makes the entities of the namespace (ex: ocean.droplet) parsable
+// in templates and run by the given sub driver
+func registerDriver(namespace string, lookup driver.LookupFunc) error {
+	if err := driverMux.Register(namespace, lookup); err != nil {
+		return err
+	}
+	template.RegisterNamespace(namespace)
+	return nil
+}
+
+// initPluginsHook loads the external drivers of the plugins directory,
+// making their entities available in templates
+func initPluginsHook(cmd *cobra.Command, args []string) error {
+	reg, err := plugin.Load(config.PluginsDir)
+	if err != nil {
+		logger.Warningf("%s", err)
+	}
+	for _, p := range reg.Plugins() {
+		logger.ExtraVerbosef("loaded plugin %s (%d commands) from %s", p.Name, len(p.Commands), p.Path)
+		if err := registerDriver(p.Name, p.Lookup); err != nil {
+			logger.Verbosef("plugin %s: %s", p.Name, err)
+		}
+	}
+	for _, entity := range reg.Entities() {
+		template.RegisterEntity(entity)
+	}
+	pluginRegistry = reg
+	return nil
+}
+
 func initLoggerHook(cmd *cobra.Command, args []string) error {
 	var flag int
 	if verboseGlobalFlag {
@@ -242,15 +288,6 @@ func migrationActionsAndExtraMessages(current string) {
 	}
 }
 
-func hasEmbeddedRegionInSharedConfigForProfile(profile string) (string, bool, error) {
-	s, err := session.NewSessionWithOptions(session.Options{
-		SharedConfigState: session.SharedConfigEnable,
-		Profile:           profile,
-	})
-	region := *s.Config.Region
-	return region, len(region) > 0, err
-}
-
 func isNotAwlessFormerDefaultAMI(s string) bool {
 	amis := []string{"ami-c58c1dd3", "ami-4191b524", "ami-7a85a01a", "ami-4836a428", "ami-0bd66a6f", "ami-d3c0c4b5", "ami-b6daced2", "ami-b968bad6", "ami-fc5ae39f", "ami-762a2315", "ami-923d12f5", "ami-9d15c7f3", "ami-52c7b43d", "ami-2bccae47"}
 	for _, e := range amis {
@@ -63,6 +63,8 @@ var inspectCmd = &cobra.Command{
 			if _, err := sync.DefaultSyncer.Sync(services...); err != nil {
 				logger.Verbose(err)
 			}
+		} else {
+			freshenLocalData()
 		}
 
 		g, err := sync.LoadLocalGraphs(config.GetAWSProfile(), config.GetAWSRegion())
@@ -0,0 +1,119 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/config"
+	"github.com/wallix/awless/aws/spec"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/logger"
+)
+
+const instanceTypesRefreshPeriod = 7 * 24 * time.Hour
+
+var (
+	instanceTypesMinCPUFlag    int
+	instanceTypesMinMemoryFlag float64
+	instanceTypesMaxPriceFlag  float64
+	instanceTypesFamilyFlag    []string
+)
+
+func init() {
+	listCmd.AddCommand(listInstanceTypesCmd)
+
+	listInstanceTypesCmd.Flags().IntVar(&instanceTypesMinCPUFlag, "min-cpu", 0, "Minimum number of vCPUs")
+	listInstanceTypesCmd.Flags().Float64Var(&instanceTypesMinMemoryFlag, "min-memory", 0, "Minimum memory in GiB")
+	listInstanceTypesCmd.Flags().Float64Var(&instanceTypesMaxPriceFlag, "max-price", 0, "Maximum on-demand price in USD per hour")
+	listInstanceTypesCmd.Flags().StringSliceVar(&instanceTypesFamilyFlag, "family", []string{}, "Filter on instance type families. Ex: --family t2,m5")
+}
+
+var listInstanceTypesCmd = &cobra.Command{
+	Use:     "instance-types",
+	Short:   "[infra] List EC2 instance types available in the region, with their specs and on-demand prices",
+	Example: "  awless list instance-types --min-cpu 4 --max-price 0.2\n  awless list instance-types --family t2,m5 --format json",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		catalog := awsconfig.LoadInstanceTypes(config.GetAWSRegion())
+		if catalog.IsEmbedded() {
+			logger.Verbosef("no instance types catalog synced for region %s, using the one embedded in awless (prices of us-east-1)", config.GetAWSRegion())
+		}
+		types := catalog.Filter(awsconfig.InstanceTypeFilter{
+			MinCPU:    instanceTypesMinCPUFlag,
+			MinMemory: instanceTypesMinMemoryFlag,
+			MaxPrice:  instanceTypesMaxPriceFlag,
+			Families:  instanceTypesFamilyFlag,
+		})
+		exitOn(printInstanceTypes(os.Stdout, types, listingFormat))
+	},
+}
+
+func printInstanceTypes(w io.Writer, types []awsconfig.InstanceType, format string) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(types)
+	case "table", "":
+		t := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		if !noHeadersFlag {
+			fmt.Fprintln(t, "TYPE\tVCPU\tMEMORY (GIB)\tNETWORK\tPRICE ($/HOUR)")
+		}
+		for _, it := range types {
+			price := "-"
+			if it.Price > 0 {
+				price = fmt.Sprint(it.Price)
+			}
+			fmt.Fprintf(t, "%s\t%d\t%g\t%s\t%s\n", it.Name, it.VCPU, it.MemoryGiB, it.Network, price)
+		}
+		return t.Flush()
+	default:
+		return fmt.Errorf("unsupported format '%s' for instance types: expected table or json", format)
+	}
+}
+
+// refreshInstanceTypesCatalog fetches the instance types of the region from the AWS Price List API
+// when the stored catalog is older than the refresh period
+func refreshInstanceTypesCatalog(region string) error {
+	if catalog := awsconfig.LoadInstanceTypes(region); !catalog.IsEmbedded() && time.Since(catalog.Refreshed) < instanceTypesRefreshPeriod {
+		return nil
+	}
+	factory, ok := awsspec.CommandFactory.(*awsspec.AWSFactory)
+	if !ok || factory.Sess == nil {
+		return fmt.Errorf("no AWS session to refresh instance types")
+	}
+	// the Price List API is only exposed in us-east-1
+	api := pricing.New(factory.Sess, awssdk.NewConfig().WithRegion("us-east-1"))
+	catalog, err := awsconfig.FetchInstanceTypes(api, region)
+	if err != nil {
+		return err
+	}
+	if err = awsconfig.SaveInstanceTypes(catalog); err != nil {
+		return err
+	}
+	logger.Verbosef("refreshed catalog of %d instance types for region %s", len(catalog.Types), region)
+	return nil
+}
@@ -0,0 +1,243 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/database"
+	"github.com/wallix/awless/logger"
+)
+
+var (
+	historyLimitFlag int
+	invocationStart  = time.Now()
+)
+
+func init() {
+	historyCmd.AddCommand(historySearchCmd)
+	historyCmd.AddCommand(historyRerunCmd)
+
+	historySearchCmd.Flags().IntVar(&historyLimitFlag, "limit", 50, "Max number of invocations shown, the latest ones. 0 to show all")
+}
+
+var historySearchCmd = &cobra.Command{
+	Use:               "search [TERM...]",
+	Short:             "Search the history of your awless invocations for the ones containing all the terms (case insensitive), the oldest first",
+	Example:           "  awless history search\n  awless history search ssh\n  awless history search create instance --limit 10",
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		var invs []*database.Invocation
+		exitOn(database.Execute(func(db *database.DB) (err error) {
+			invs, err = db.ListInvocations()
+			return
+		}))
+		found := searchInvocations(invs, args, historyLimitFlag)
+		if len(found) == 0 {
+			logger.Info("No invocation found in history")
+			return
+		}
+		printInvocations(os.Stdout, found)
+	},
+}
+
+var historyRerunCmd = &cobra.Command{
+	Use:               "rerun ID",
+	Short:             "Run again an invocation of the history, with the profile and region it was run with unless overridden with --aws-profile and --aws-region",
+	Example:           "  awless history rerun 42\n  awless history rerun 42 -r us-east-1",
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("invocation ID required (see `awless history search`)")
+		}
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid invocation ID '%s': expecting a number", args[0])
+		}
+		var inv *database.Invocation
+		exitOn(database.Execute(func(db *database.DB) (err error) {
+			inv, err = db.GetInvocation(id)
+			return
+		}))
+
+		rerunArgs := rerunInvocationArgs(inv, awsProfileGlobalFlag, awsRegionGlobalFlag)
+		logger.Infof("Rerunning: awless %s", invocationLine(rerunArgs))
+		exe, err := os.Executable()
+		exitOn(err)
+		rerun := exec.Command(exe, rerunArgs...)
+		rerun.Stdin, rerun.Stdout, rerun.Stderr = os.Stdin, os.Stdout, os.Stderr
+		if err = rerun.Run(); err != nil {
+			if exitErr, ok := err.(*exec.ExitError); ok {
+				if status, ok := exitErr.Sys().(syscall.WaitStatus); ok {
+					exit(status.ExitStatus())
+				}
+				exit(1)
+			}
+			return err
+		}
+		return nil
+	},
+}
+
+// exit records the invocation in the history then exits with the code
+func exit(code int) {
+	recordInvocation(code)
+	os.Exit(code)
+}
+
+// Execute runs the awless command line, recording it in the history of invocations
+func Execute() {
+	if err := RootCmd.Execute(); err != nil {
+		exit(1)
+	}
+	exit(0)
+}
+
+// recordInvocation adds the command line to the history, unless it is a history command
+// or awless has not been installed yet
+func recordInvocation(code int) {
+	args := os.Args[1:]
+	if cmd, _, err := RootCmd.Find(args); err == nil && strings.HasPrefix(cmd.CommandPath(), "awless history") {
+		return
+	}
+	if _, err := os.Stat(config.DBPath); err != nil {
+		return
+	}
+	inv := &database.Invocation{
+		Args:     redactInvocationArgs(args),
+		Profile:  config.GetAWSProfile(),
+		Region:   config.GetAWSRegion(),
+		Date:     invocationStart.UTC(),
+		Duration: time.Since(invocationStart),
+		ExitCode: code,
+	}
+	if err := database.Execute(func(db *database.DB) error {
+		return db.AddInvocation(inv)
+	}); err != nil {
+		logger.ExtraVerbosef("cannot record invocation in history: %s", err)
+	}
+}
+
+// redactedKeys are the last dotted segment of the params and fillers whose values are redacted
+// (ex: password=, database.password=, *.token=)
+var redactedKeys = map[string]bool{"password": true, "secret": true, "token": true}
+
+// redactInvocationArgs returns the arguments with the values of passwords, secrets, tokens and secure parameters
+// replaced by holes (ex: password={password}), so that they are prompted for again on rerun
+func redactInvocationArgs(args []string) []string {
+	secure := false
+	for _, arg := range args {
+		secure = secure || strings.EqualFold(arg, "secure=true") || strings.EqualFold(arg, "secret")
+	}
+	redacted := make([]string, len(args))
+	for i, arg := range args {
+		redacted[i] = arg
+		splits := strings.SplitN(arg, "=", 2)
+		if len(splits) != 2 || strings.HasPrefix(splits[1], "{") {
+			continue
+		}
+		key := strings.ToLower(splits[0])
+		if redactedKeys[key[strings.LastIndex(key, ".")+1:]] || (secure && key == "value") {
+			redacted[i] = fmt.Sprintf("%s={%s}", splits[0], strings.TrimPrefix(key, "*."))
+		}
+	}
+	return redacted
+}
+
+// searchInvocations returns the latest invocations, at most limit when positive,
+// whose command line contains all the terms
+func searchInvocations(invs []*database.Invocation, terms []string, limit int) []*database.Invocation {
+	var found []*database.Invocation
+	for _, inv := range invs {
+		line := strings.ToLower(invocationLine(inv.Args))
+		matches := true
+		for _, term := range terms {
+			matches = matches && strings.Contains(line, strings.ToLower(term))
+		}
+		if matches {
+			found = append(found, inv)
+		}
+	}
+	if limit > 0 && len(found) > limit {
+		found = found[len(found)-limit:]
+	}
+	return found
+}
+
+// rerunInvocationArgs returns the arguments of the invocation, run with its profile and region
+// unless others are given
+func rerunInvocationArgs(inv *database.Invocation, profile, region string) []string {
+	args := append([]string{}, inv.Args...)
+	if profile != "" {
+		args = append(args, "--aws-profile", profile)
+	} else if inv.Profile != "" && !hasFlag(inv.Args, "--aws-profile", "-p") {
+		args = append(args, "--aws-profile", inv.Profile)
+	}
+	if region != "" {
+		args = append(args, "--aws-region", region)
+	} else if inv.Region != "" && !hasFlag(inv.Args, "--aws-region", "-r") {
+		args = append(args, "--aws-region", inv.Region)
+	}
+	return args
+}
+
+func hasFlag(args []string, names ...string) bool {
+	for _, arg := range args {
+		for _, name := range names {
+			if arg == name || strings.HasPrefix(arg, name+"=") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// invocationLine returns the arguments as typed in a shell
+func invocationLine(args []string) string {
+	var quoted []string
+	for _, arg := range args {
+		if arg == "" || strings.ContainsAny(arg, " \t\n'\"$\\") {
+			arg = "'" + strings.Replace(arg, "'", `'\''`, -1) + "'"
+		}
+		quoted = append(quoted, arg)
+	}
+	return strings.Join(quoted, " ")
+}
+
+func printInvocations(w io.Writer, invs []*database.Invocation) {
+	tab := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tab, "ID\tDATE\tDURATION\tEXIT\tPROFILE\tREGION\tCOMMAND")
+	for _, inv := range invs {
+		fmt.Fprintf(tab, "%d\t%s\t%s\t%d\t%s\t%s\tawless %s\n", inv.ID, inv.Date.Local().Format(time.Stamp), inv.Duration.Round(time.Millisecond), inv.ExitCode, inv.Profile, inv.Region, invocationLine(inv.Args))
+	}
+	tab.Flush()
+}
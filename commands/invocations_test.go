@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/database"
+)
+
+func TestInvocations(t *testing.T) {
+	invs := []*database.Invocation{
+		{ID: 1, Args: []string{"ssh", "i-1234", "--private"}, Profile: "default", Region: "eu-west-1", Date: time.Date(2018, 3, 1, 12, 30, 0, 0, time.Local), Duration: 1500 * time.Millisecond},
+		{ID: 2, Args: []string{"ls", "instances", "--filter", "name=web server"}, Profile: "prod", Region: "us-east-1", Date: time.Date(2018, 3, 1, 12, 31, 0, 0, time.Local), Duration: time.Second, ExitCode: 1},
+		{ID: 3, Args: []string{"ssh", "-r", "us-west-2", "web"}, Profile: "default", Region: "us-west-2", Date: time.Date(2018, 3, 1, 12, 32, 0, 0, time.Local)},
+	}
+
+	var ids []int
+	for _, inv := range searchInvocations(invs, []string{"SSH"}, 0) {
+		ids = append(ids, inv.ID)
+	}
+	if got, want := ids, []int{1, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := searchInvocations(invs, []string{"ssh", "private"}, 0), invs[:1]; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := searchInvocations(invs, nil, 2), invs[1:]; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	if got, want := rerunInvocationArgs(invs[0], "", ""), []string{"ssh", "i-1234", "--private", "--aws-profile", "default", "--aws-region", "eu-west-1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if got, want := rerunInvocationArgs(invs[2], "", ""), []string{"ssh", "-r", "us-west-2", "web", "--aws-profile", "default"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if got, want := rerunInvocationArgs(invs[2], "", "eu-west-3"), []string{"ssh", "-r", "us-west-2", "web", "--aws-profile", "default", "--aws-region", "eu-west-3"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	if got, want := redactInvocationArgs([]string{"create", "database", "engine=mysql", "password=s3cr3t!", "username=admin"}), []string{"create", "database", "engine=mysql", "password={password}", "username=admin"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if got, want := redactInvocationArgs([]string{"create", "parameter", "name=/db/pass", "value=MyPassw0rd", "secure=true"}), []string{"create", "parameter", "name=/db/pass", "value={value}", "secure=true"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if got, want := redactInvocationArgs([]string{"create", "parameter", "name=/app/env", "value=prod"}), []string{"create", "parameter", "name=/app/env", "value=prod"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if got, want := redactInvocationArgs([]string{"update", "secret", "name=db-password", "value=MyPassw0rd"}), []string{"update", "secret", "name=db-password", "value={value}"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if got, want := redactInvocationArgs([]string{"run", "db.tpl", "database.password=s3cr3t!", "database.name=app", "*.password=s3cr3t!"}), []string{"run", "db.tpl", "database.password={database.password}", "database.name=app", "*.password={password}"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if got, want := redactInvocationArgs([]string{"run", "app.tpl", "app.secret=abc", "github.token=ghp_123", "api.Token=xyz", "token.name=ci"}), []string{"run", "app.tpl", "app.secret={app.secret}", "github.token={github.token}", "api.Token={api.token}", "token.name=ci"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	var buff bytes.Buffer
+	printInvocations(&buff, invs[:2])
+	exp := `ID  DATE             DURATION  EXIT  PROFILE  REGION     COMMAND
+1   Mar  1 12:30:00  1.5s      0     default  eu-west-1  awless ssh i-1234 --private
+2   Mar  1 12:31:00  1s        1     prod     us-east-1  awless ls instances --filter 'name=web server'
+`
+	if got, want := buff.String(), exp; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+}
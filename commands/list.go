@@ -43,6 +43,7 @@ var (
 	noHeadersFlag              bool
 	sortBy                     []string
 	reverseFlag                bool
+	listAttachmentsFlag        bool
 )
 
 func init() {
@@ -65,7 +66,7 @@ func init() {
 		}
 	}
 
-	listCmd.PersistentFlags().StringVar(&listingFormat, "format", "table", "Output format: table, csv, tsv, json (default to table)")
+	listCmd.PersistentFlags().StringVar(&listingFormat, "format", "table", fmt.Sprintf("Output format: %s (default to table)", strings.Join(console.Renderers(), ", ")))
 	listCmd.PersistentFlags().StringSliceVar(&listingFiltersFlag, "filter", []string{}, "Filter resources given key/values fields (case insensitive). Ex: --filter type=t2.micro")
 	listCmd.PersistentFlags().StringSliceVar(&listingTagFiltersFlag, "tag", []string{}, "Filter EC2 resources given tags (case sensitive!). Ex: --tag Env=Production")
 	listCmd.PersistentFlags().StringSliceVar(&listingTagKeyFiltersFlag, "tag-key", []string{}, "Filter EC2 resources given a tag key only (case sensitive!). Ex: --tag-key Env")
@@ -75,12 +76,13 @@ func init() {
 	listCmd.PersistentFlags().BoolVar(&noHeadersFlag, "no-headers", false, "Do not display headers")
 	listCmd.PersistentFlags().BoolVar(&reverseFlag, "reverse", false, "Use in conjunction with --sort to reverse sort")
 	listCmd.PersistentFlags().StringSliceVar(&sortBy, "sort", []string{"Id"}, "Sort tables by column(s) name(s)")
+	listCmd.PersistentFlags().BoolVar(&listAttachmentsFlag, "attachments", false, "Join the attached resources from the local data: instance and device of volumes, instance and subnet of networkinterfaces, target groups and load balancers of instances")
 }
 
 var listCmd = &cobra.Command{
 	Use:               "list",
 	Aliases:           []string{"ls"},
-	Example:           "  awless list instances --sort uptime\n  awless list users --format csv\n  awless list volumes --filter state=use --filter type=gp2\n  awless list volumes --tag-value Purchased\n  awless list vpcs --tag-key Dept --tag-key Internal\n  awless list instances --tag Env=Production,Dept=Marketing\n  awless list instances --filter state=running,type=micro\n  awless list s3objects --filter bucket=pdf-bucket ",
+	Example:           "  awless list instances --sort uptime\n  awless list users --format csv\n  awless list volumes --filter state=use --filter type=gp2\n  awless list volumes --tag-value Purchased\n  awless list vpcs --tag-key Dept --tag-key Internal\n  awless list instances --tag Env=Production,Dept=Marketing\n  awless list instances --filter state=running,type=micro\n  awless list s3objects --filter bucket=pdf-bucket\n  awless list s3objects --filter bucket=pdf-bucket --filter prefix=2017/\n  awless list volumes --attachments",
 	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, firstInstallDoneHook),
 	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
 	Short:             "List resources: sorting, filtering via tag/properties, output formatting, etc...",
@@ -100,17 +102,24 @@ var listSpecificResourceCmd = func(resType string) *cobra.Command {
 				logger.Errorf("invalid parameter%s '%s'", plural, strings.Join(args, " "))
 				if strings.Contains(args[0], "=") {
 					if !promptConfirmDefaultYes("Did you mean `awless list %s --filter %s`? ", cloud.PluralizeResource(resType), strings.Join(args, " ")) {
-						os.Exit(1)
+						exit(1)
 					}
 					listingFiltersFlag = append(listingFiltersFlag, args...)
 				} else {
-					os.Exit(1)
+					exit(1)
 				}
 			}
 			var g cloud.GraphAPI
 
-			if localGlobalFlag {
+			if listAttachmentsFlag {
+				if _, ok := console.AttachmentsColumnDefinitions[resType]; !ok {
+					exitOn(fmt.Errorf("no attachments to list for %s", cloud.PluralizeResource(resType)))
+				}
+			}
+
+			if localGlobalFlag || listAttachmentsFlag {
 				if srvName, ok := awsservices.ServicePerResourceType[resType]; ok {
+					freshenLocalData(srvName)
 					g = sync.LoadLocalGraphForService(srvName, config.GetAWSProfile(), config.GetAWSRegion())
 				} else {
 					exitOn(fmt.Errorf("cannot find service for resource type %s", resType))
@@ -135,6 +144,7 @@ var listAllResourceInServiceCmd = func(srvName string) *cobra.Command {
 		Hidden: true,
 
 		Run: func(cmd *cobra.Command, args []string) {
+			freshenLocalData(srvName)
 			g := sync.LoadLocalGraphForService(srvName, config.GetAWSProfile(), config.GetAWSRegion())
 			displayer, err := console.BuildOptions(
 				console.WithFormat(listingFormat),
@@ -151,6 +161,7 @@ func printResources(g cloud.GraphAPI, resType string) {
 	displayer, err := console.BuildOptions(
 		console.WithRdfType(resType),
 		console.WithColumns(listingColumnsFlag),
+		console.WithAttachments(listAttachmentsFlag),
 		console.WithFilters(listingFiltersFlag),
 		console.WithTagFilters(listingTagFiltersFlag),
 		console.WithTagKeyFilters(listingTagKeyFiltersFlag),
@@ -0,0 +1,421 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"os/signal"
+	"sort"
+	"strings"
+	stdsync "sync"
+	"sync/atomic"
+	"text/tabwriter"
+	"time"
+
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template"
+	yaml "gopkg.in/yaml.v2"
+)
+
+var (
+	runMatrixFlag         string
+	runMatrixParallelFlag int
+	noPromptFlag          bool
+)
+
+func init() {
+	runCmd.Flags().StringVar(&runMatrixFlag, "matrix", "", "Run the template on each target (profile, region and params) of a YAML matrix file")
+	runCmd.Flags().IntVar(&runMatrixParallelFlag, "matrix-parallel", 1, "Max number of matrix targets run concurrently. 1 to run them sequentially")
+	runCmd.Flags().BoolVar(&noPromptFlag, "no-prompt", false, "Fail on missing params instead of prompting for them")
+	runCmd.Flags().MarkHidden("no-prompt")
+}
+
+// runMatrix is the content of a matrix file. Targets are all the combinations
+// of its profiles, regions and params sets, followed by its explicit targets:
+//
+//	profiles: [dev, prod]
+//	regions: [eu-west-1, us-east-1]
+//	params:
+//	  - {env: staging, cidr: 10.0.0.0/16}
+//	  - {env: live, cidr: 10.1.0.0/16}
+//	targets:
+//	  - {profile: prod, region: ap-southeast-1, params: {env: live}}
+//
+// Without profiles (resp. regions), the current profile (resp. region) is used
+type runMatrix struct {
+	Profiles []string                 `yaml:"profiles"`
+	Regions  []string                 `yaml:"regions"`
+	Params   []map[string]interface{} `yaml:"params"`
+	Targets  []*matrixTarget          `yaml:"targets"`
+}
+
+type matrixTarget struct {
+	Profile string                 `yaml:"profile" json:"profile"`
+	Region  string                 `yaml:"region" json:"region"`
+	Params  map[string]interface{} `yaml:"params,omitempty" json:"params,omitempty"`
+}
+
+func (t *matrixTarget) String() string {
+	return t.Profile + "/" + t.Region
+}
+
+// paramsArgs returns the params of the target as param=value arguments of `awless run`
+func (t *matrixTarget) paramsArgs() []string {
+	var args []string
+	for k, v := range t.Params {
+		args = append(args, k+"="+matrixParamValue(v))
+	}
+	sort.Strings(args)
+	return args
+}
+
+func matrixParamValue(v interface{}) string {
+	switch vv := v.(type) {
+	case string:
+		return template.QuoteParamValue(vv)
+	case []interface{}:
+		var elems []string
+		for _, e := range vv {
+			elems = append(elems, matrixParamValue(e))
+		}
+		return "[" + strings.Join(elems, ",") + "]"
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func loadRunMatrix(path string) (*runMatrix, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	m := &runMatrix{}
+	if err = yaml.Unmarshal(b, m); err != nil {
+		return nil, fmt.Errorf("invalid matrix file %s: %s", path, err)
+	}
+	return m, nil
+}
+
+// targets expands the matrix into the list of targets to run
+func (m *runMatrix) targets(defaultProfile, defaultRegion string) ([]*matrixTarget, error) {
+	var targets []*matrixTarget
+	if len(m.Profiles) > 0 || len(m.Regions) > 0 || len(m.Params) > 0 || len(m.Targets) == 0 {
+		profiles, regions, paramSets := m.Profiles, m.Regions, m.Params
+		if len(profiles) == 0 {
+			profiles = []string{defaultProfile}
+		}
+		if len(regions) == 0 {
+			regions = []string{defaultRegion}
+		}
+		if len(paramSets) == 0 {
+			paramSets = []map[string]interface{}{nil}
+		}
+		for _, profile := range profiles {
+			for _, region := range regions {
+				for _, params := range paramSets {
+					targets = append(targets, &matrixTarget{Profile: profile, Region: region, Params: params})
+				}
+			}
+		}
+	}
+	for _, t := range m.Targets {
+		target := &matrixTarget{Profile: t.Profile, Region: t.Region, Params: t.Params}
+		if target.Profile == "" {
+			target.Profile = defaultProfile
+		}
+		if target.Region == "" {
+			target.Region = defaultRegion
+		}
+		targets = append(targets, target)
+	}
+	for _, t := range targets {
+		if t.Region == "" {
+			return targets, fmt.Errorf("matrix target with profile '%s' has no region", t.Profile)
+		}
+	}
+	return targets, nil
+}
+
+type matrixTargetResult struct {
+	matrixTarget `yaml:",inline"`
+	Status       string              `json:"status" yaml:"status"`
+	Error        string              `json:"error,omitempty" yaml:"error,omitempty"`
+	Result       *template.RunResult `json:"result,omitempty" yaml:"result,omitempty"`
+}
+
+// matrixReport aggregates the results of a template run on all the targets of a matrix
+type matrixReport struct {
+	Status  string                `json:"status" yaml:"status"`
+	Targets []*matrixTargetResult `json:"targets" yaml:"targets"`
+}
+
+// runMatrixTargets runs the targets, at most parallel ones at a time, until interrupted.
+// Results are in the order of the targets
+func runMatrixTargets(targets []*matrixTarget, parallel int, interrupted func() bool, run func(*matrixTarget) *matrixTargetResult) *matrixReport {
+	if parallel < 1 {
+		parallel = 1
+	}
+	report := &matrixReport{Status: template.SuccessStatus, Targets: make([]*matrixTargetResult, len(targets))}
+
+	sem := make(chan struct{}, parallel)
+	var wg stdsync.WaitGroup
+	for i, target := range targets {
+		sem <- struct{}{}
+		if interrupted() {
+			<-sem
+			report.Targets[i] = &matrixTargetResult{matrixTarget: *target, Status: template.SkippedStatus, Error: "matrix run interrupted"}
+			continue
+		}
+		wg.Add(1)
+		go func(i int, target *matrixTarget) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			report.Targets[i] = run(target)
+		}(i, target)
+	}
+	wg.Wait()
+
+	for _, res := range report.Targets {
+		switch {
+		case res.Status == template.SuccessStatus:
+		case res.Status == template.FailureStatus || report.Status == template.SuccessStatus:
+			report.Status = res.Status
+		}
+	}
+	return report
+}
+
+func (r *matrixReport) print(w io.Writer) {
+	tab := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tab, "PROFILE\tREGION\tPARAMS\tSTATUS\tCOMMANDS\tDURATION\tID / ERROR")
+	for _, res := range r.Targets {
+		commands, duration, detail := "-", "-", res.Error
+		if res.Result != nil {
+			var ok int
+			for _, st := range res.Result.Statements {
+				if st.Status == template.SuccessStatus {
+					ok++
+				}
+			}
+			commands = fmt.Sprintf("%d/%d", ok, len(res.Result.Statements))
+			duration = (time.Duration(res.Result.DurationMs) * time.Millisecond).String()
+			detail = res.Result.ID
+			if len(res.Result.Errors) > 0 {
+				detail = res.Result.Errors[0]
+			}
+		}
+		params := strings.Join(res.paramsArgs(), " ")
+		if params == "" {
+			params = "-"
+		}
+		fmt.Fprintf(tab, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", res.Profile, res.Region, params, res.Status, commands, duration, strings.Replace(detail, "\n", " ", -1))
+	}
+	tab.Flush()
+}
+
+func (r *matrixReport) marshal(format string) ([]byte, error) {
+	if err := template.ValidateResultFormat(format); err != nil {
+		return nil, err
+	}
+	if strings.ToLower(format) == template.YAMLResultFormat {
+		return yaml.Marshal(r)
+	}
+	return json.MarshalIndent(r, "", "  ")
+}
+
+// runTemplateMatrix runs the template of the given `awless run` args on all the targets
+// of the matrix file, each target running in its own awless process
+func runTemplateMatrix(args []string, content []byte) error {
+	if isSchedulingMode() {
+		return errors.New("--run-in and --revert-in are not supported with --matrix")
+	}
+	if runOutputFormatFlag != "" {
+		if err := template.ValidateResultFormat(runOutputFormatFlag); err != nil {
+			return err
+		}
+	}
+	matrix, err := loadRunMatrix(runMatrixFlag)
+	if err != nil {
+		return err
+	}
+	targets, err := matrix.targets(config.GetAWSProfile(), config.GetAWSRegion())
+	if err != nil {
+		return err
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	if !forceGlobalFlag {
+		if noTerminalForPrompts {
+			return errors.New("cannot confirm: template read from stdin without terminal, use --force")
+		}
+		fmt.Fprintf(os.Stderr, "%s\n\nTargets:\n", renderGreenFn(string(bytes.TrimSpace(removeComments(content)))))
+		for _, t := range targets {
+			fmt.Fprintf(os.Stderr, "  %s %s\n", t, strings.Join(t.paramsArgs(), " "))
+		}
+		fmt.Fprintf(os.Stderr, "\nConfirm run on %d targets? [y/N] ", len(targets))
+		var yesorno string
+		if _, err := fmt.Scanln(&yesorno); err != nil && err.Error() != "unexpected newline" {
+			return err
+		}
+		if strings.TrimSpace(strings.ToLower(yesorno)) != "y" {
+			exit(1)
+		}
+	}
+
+	var interrupts int32
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt)
+	defer signal.Stop(sigs)
+	go func() {
+		for range sigs { // the running targets receive the interrupt too
+			if atomic.AddInt32(&interrupts, 1) == 1 {
+				logger.Warning("interrupting matrix run: waiting for the running targets, remaining ones are skipped")
+			}
+		}
+	}()
+
+	var stderrMu stdsync.Mutex
+	report := runMatrixTargets(targets, runMatrixParallelFlag, func() bool { return atomic.LoadInt32(&interrupts) > 0 }, func(t *matrixTarget) *matrixTargetResult {
+		logger.Infof("running template on %s", t)
+		return execMatrixTarget(exe, matrixTargetArgs(args, content, t), args[0] == stdinTemplatePath, content, t, &stderrMu)
+	})
+
+	if runOutputFormatFlag != "" {
+		b, err := report.marshal(runOutputFormatFlag)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(os.Stdout, strings.TrimSpace(string(b)))
+	} else {
+		fmt.Fprintln(os.Stderr)
+		report.print(os.Stdout)
+	}
+	if report.Status != template.SuccessStatus {
+		exit(1)
+	}
+	return nil
+}
+
+// matrixTargetArgs returns the `awless run` arguments running the template on the target.
+// The content is pinned by its checksum so that all targets run the same template
+func matrixTargetArgs(args []string, content []byte, t *matrixTarget) []string {
+	runArgs := []string{"run", args[0], "--sha256", templateChecksum(content), "--format", template.JSONResultFormat,
+		"--force", "--no-prompt", "--aws-profile", t.Profile, "--aws-region", t.Region, "--parallel", fmt.Sprint(parallelismFlag)}
+	if runLogMessage != "" {
+		runArgs = append(runArgs, "--message", runLogMessage)
+	}
+	if runStackFlag != "" {
+		runArgs = append(runArgs, "--stack", runStackFlag)
+	}
+	if runTTLFlag > 0 {
+		runArgs = append(runArgs, "--ttl", runTTLFlag.String())
+	}
+	if verboseGlobalFlag {
+		runArgs = append(runArgs, "--verbose")
+	}
+	if extraVerboseGlobalFlag {
+		runArgs = append(runArgs, "--extra-verbose")
+	}
+	if noSyncGlobalFlag {
+		runArgs = append(runArgs, "--no-sync")
+	}
+	// params of the target come last to take precedence over the ones given to all targets
+	runArgs = append(runArgs, args[1:]...)
+	return append(runArgs, t.paramsArgs()...)
+}
+
+func execMatrixTarget(exe string, args []string, fromStdin bool, content []byte, t *matrixTarget, stderrMu *stdsync.Mutex) *matrixTargetResult {
+	res := &matrixTargetResult{matrixTarget: *t, Status: template.FailureStatus}
+
+	var stdout bytes.Buffer
+	stderr := &prefixedLinesWriter{prefix: fmt.Sprintf("[%s] ", t), w: os.Stderr, mu: stderrMu}
+	cmd := exec.Command(exe, args...)
+	if fromStdin {
+		cmd.Stdin = bytes.NewReader(content)
+	}
+	cmd.Stdout, cmd.Stderr = &stdout, stderr
+	runErr := cmd.Run()
+	stderr.flush()
+
+	if out := bytes.TrimSpace(stdout.Bytes()); len(out) > 0 {
+		result := &template.RunResult{}
+		if err := json.Unmarshal(out, result); err != nil {
+			res.Error = fmt.Sprintf("invalid run result: %s", err)
+			return res
+		}
+		res.Result, res.Status = result, result.Status
+		return res
+	}
+	switch {
+	case stderr.last != "":
+		res.Error = stderr.last
+	case runErr != nil:
+		res.Error = runErr.Error()
+	default:
+		res.Error = "no run result"
+	}
+	return res
+}
+
+// prefixedLinesWriter writes complete lines with a prefix, keeping the last one written
+type prefixedLinesWriter struct {
+	prefix string
+	w      io.Writer
+	mu     *stdsync.Mutex
+	buf    bytes.Buffer
+	last   string
+}
+
+func (p *prefixedLinesWriter) Write(b []byte) (int, error) {
+	p.buf.Write(b)
+	for {
+		i := bytes.IndexByte(p.buf.Bytes(), '\n')
+		if i < 0 {
+			return len(b), nil
+		}
+		p.writeLine(string(p.buf.Next(i + 1)))
+	}
+}
+
+func (p *prefixedLinesWriter) flush() {
+	if p.buf.Len() > 0 {
+		p.writeLine(p.buf.String() + "\n")
+		p.buf.Reset()
+	}
+}
+
+func (p *prefixedLinesWriter) writeLine(line string) {
+	if trimmed := strings.TrimSpace(line); trimmed != "" {
+		p.last = trimmed
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	fmt.Fprint(p.w, p.prefix+line)
+}
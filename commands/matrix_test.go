@@ -0,0 +1,144 @@
+package commands
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	stdsync "sync"
+	"testing"
+
+	"github.com/wallix/awless/template"
+)
+
+func TestRunMatrixTargets(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awlessmatrix")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "matrix.yaml")
+	content := `
+profiles: [dev, prod]
+regions: [eu-west-1, us-east-1]
+params:
+  - {name: web server, count: 2}
+  - {name: db, subnets: [sub-1, sub-2]}
+targets:
+  - {region: ap-southeast-1}
+`
+	if err = ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	matrix, err := loadRunMatrix(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	targets, err := matrix.targets("default", "eu-west-3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, target := range targets {
+		got = append(got, target.String()+" "+strings.Join(target.paramsArgs(), " "))
+	}
+	expected := []string{
+		"dev/eu-west-1 count=2 name='web server'",
+		"dev/eu-west-1 name=db subnets=[sub-1,sub-2]",
+		"dev/us-east-1 count=2 name='web server'",
+		"dev/us-east-1 name=db subnets=[sub-1,sub-2]",
+		"prod/eu-west-1 count=2 name='web server'",
+		"prod/eu-west-1 name=db subnets=[sub-1,sub-2]",
+		"prod/us-east-1 count=2 name='web server'",
+		"prod/us-east-1 name=db subnets=[sub-1,sub-2]",
+		"default/ap-southeast-1 ",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("got\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(expected, "\n"))
+	}
+	for _, args := range targets[1].paramsArgs() {
+		if _, err = template.ParseParams(args); err != nil {
+			t.Fatalf("%s: %s", args, err)
+		}
+	}
+
+	onlyTargets := &runMatrix{Targets: []*matrixTarget{{Profile: "prod"}}}
+	if _, err = onlyTargets.targets("default", ""); err == nil {
+		t.Fatal("expected error on target without region")
+	}
+	if targets, _ = (&runMatrix{}).targets("default", "eu-west-1"); len(targets) != 1 || targets[0].String() != "default/eu-west-1" {
+		t.Fatalf("unexpected targets %v", targets)
+	}
+}
+
+func TestRunMatrixTargetsReport(t *testing.T) {
+	targets := []*matrixTarget{{Profile: "dev", Region: "eu-west-1"}, {Profile: "dev", Region: "us-east-1"}, {Profile: "prod", Region: "eu-west-1"}}
+	run := func(target *matrixTarget) *matrixTargetResult {
+		if target.Region == "us-east-1" {
+			return &matrixTargetResult{matrixTarget: *target, Status: template.FailureStatus, Error: "dry run failed"}
+		}
+		return &matrixTargetResult{matrixTarget: *target, Status: template.SuccessStatus, Result: &template.RunResult{
+			ID: "01BTT1AA3N36VCKSNKAKN4WX2N", Status: template.SuccessStatus, DurationMs: 1500,
+			Statements: []*template.StatementResult{{Status: template.SuccessStatus}},
+		}}
+	}
+	report := runMatrixTargets(targets, 2, func() bool { return false }, run)
+	if got, want := report.Status, template.FailureStatus; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	var buff bytes.Buffer
+	report.print(&buff)
+	expected := []string{
+		"PROFILE  REGION     PARAMS  STATUS   COMMANDS  DURATION  ID / ERROR",
+		"dev      eu-west-1  -       success  1/1       1.5s      01BTT1AA3N36VCKSNKAKN4WX2N",
+		"dev      us-east-1  -       failure  -         -         dry run failed",
+		"prod     eu-west-1  -       success  1/1       1.5s      01BTT1AA3N36VCKSNKAKN4WX2N",
+	}
+	if got, want := buff.String(), strings.Join(expected, "\n")+"\n"; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+	b, err := report.marshal("json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"region": "us-east-1"`) || !strings.Contains(string(b), `"error": "dry run failed"`) {
+		t.Fatalf("unexpected json:\n%s", b)
+	}
+	if b, err = report.marshal("yaml"); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), "- profile: dev\n  region: us-east-1\n") {
+		t.Fatalf("unexpected yaml:\n%s", b)
+	}
+
+	var runs int
+	report = runMatrixTargets(targets, 1, func() bool { return runs > 0 }, func(target *matrixTarget) *matrixTargetResult {
+		runs++
+		return run(target)
+	})
+	if got, want := report.Status, template.SkippedStatus; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := runs, 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
+
+func TestPrefixedLinesWriter(t *testing.T) {
+	var buff bytes.Buffer
+	w := &prefixedLinesWriter{prefix: "[dev/eu-west-1] ", w: &buff, mu: &stdsync.Mutex{}}
+	w.Write([]byte("Dry running"))
+	w.Write([]byte(" template ...\n[error] missing"))
+	w.Write([]byte(" value\n\n"))
+	w.Write([]byte("exit"))
+	w.flush()
+	expected := "[dev/eu-west-1] Dry running template ...\n[dev/eu-west-1] [error] missing value\n[dev/eu-west-1] \n[dev/eu-west-1] exit\n"
+	if got, want := buff.String(), expected; got != want {
+		t.Fatalf("got\n%q\nwant\n%q", got, want)
+	}
+	if got, want := w.last, "exit"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
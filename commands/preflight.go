@@ -0,0 +1,83 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/aws/spec"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template"
+)
+
+var preflightFlag bool
+
+// preflightPermissions checks with the given policy simulation that the identity of the session
+// can perform the AWS actions of all the statements of the template, reporting the missing ones
+func preflightPermissions(tpl *template.Template, simulate func(actions []string) (map[string]string, error)) error {
+	var actions []string
+	statements := make(map[string][]string)
+	seen := make(map[string]struct{})
+	for _, cmd := range tpl.CommandNodesIterator() {
+		statement := fmt.Sprintf("%s %s", cmd.Action, cmd.Entity)
+		if cmd.IsModifier(template.AccountModifier) {
+			logger.Verbosef("preflight: '%s' runs in another account, permissions not checked", statement)
+			continue
+		}
+		needed, known := awsspec.CommandIAMActions(cmd.Command, cmd.ToDriverParams())
+		if !known {
+			logger.Verbosef("preflight: unknown permissions of '%s', not checked", statement)
+			continue
+		}
+		for _, action := range needed {
+			if _, done := statements[action]; !done {
+				actions = append(actions, action)
+			}
+			if _, ok := seen[action+" "+statement]; !ok {
+				seen[action+" "+statement] = struct{}{}
+				statements[action] = append(statements[action], statement)
+			}
+		}
+	}
+	if len(actions) == 0 {
+		return nil
+	}
+
+	denied, err := simulate(actions)
+	if err != nil {
+		return fmt.Errorf("preflight: %s", err)
+	}
+	if len(denied) == 0 {
+		logger.Verbosef("preflight: allowed to perform %s", strings.Join(actions, ", "))
+		return nil
+	}
+	var missing []string
+	for action := range denied {
+		missing = append(missing, action)
+	}
+	sort.Strings(missing)
+	var buf bytes.Buffer
+	buf.WriteString("preflight: missing permissions:")
+	for _, action := range missing {
+		buf.WriteString(fmt.Sprintf("\n\t%s (%s) for %s", action, denied[action], strings.Join(statements[action], ", ")))
+	}
+	return errors.New(buf.String())
+}
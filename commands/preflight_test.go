@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/aws/spec"
+	"github.com/wallix/awless/template"
+)
+
+func TestPreflightPermissions(t *testing.T) {
+	cenv := template.NewEnv().WithLookupCommandFunc(func(tokens ...string) interface{} {
+		return awsspec.MockAWSSessionFactory.Build(strings.Join(tokens, ""))()
+	}).Build()
+	tpl, _, err := template.Compile(template.MustParse(`vpc = create vpc cidr=10.0.0.0/16
+create subnet cidr=10.0.0.0/24 vpc=$vpc
+create subnet cidr=10.0.1.0/24 vpc=$vpc
+attach policy arn=arn:my:policy role=my-role
+create tag resource=$vpc key=Env value=prod`), cenv, template.TestCompileMode)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var simulated []string
+	err = preflightPermissions(tpl, func(actions []string) (map[string]string, error) {
+		simulated = actions
+		return map[string]string{"iam:AttachRolePolicy": "explicitDeny", "ec2:CreateSubnet": "implicitDeny"}, nil
+	})
+	if got, want := simulated, []string{"ec2:CreateVpc", "ec2:CreateSubnet", "iam:AttachRolePolicy"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	exp := "preflight: missing permissions:\n\tec2:CreateSubnet (implicitDeny) for create subnet\n\tiam:AttachRolePolicy (explicitDeny) for attach policy"
+	if err == nil || err.Error() != exp {
+		t.Fatalf("got %v, want %s", err, exp)
+	}
+
+	allowed := func(actions []string) (map[string]string, error) { return nil, nil }
+	if err = preflightPermissions(tpl, allowed); err != nil {
+		t.Fatal(err)
+	}
+	failing := func(actions []string) (map[string]string, error) { return nil, errors.New("access denied") }
+	if err = preflightPermissions(tpl, failing); err == nil || err.Error() != "preflight: access denied" {
+		t.Fatalf("got %v", err)
+	}
+}
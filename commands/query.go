@@ -0,0 +1,113 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync"
+)
+
+var (
+	queryFormatFlag  string
+	queryPathsFlag   bool
+	queryOnlyIDsFlag bool
+)
+
+func init() {
+	RootCmd.AddCommand(queryCmd)
+
+	queryCmd.Flags().StringVar(&queryFormatFlag, "format", "table", fmt.Sprintf("Output format of the resources: %s (default to table)", strings.Join(console.Renderers(), ", ")))
+	queryCmd.Flags().BoolVar(&queryPathsFlag, "paths", false, "Print the paths leading to the resources, one per line")
+	queryCmd.Flags().BoolVar(&queryOnlyIDsFlag, "ids", false, "Print only the ids of the resources")
+}
+
+var queryCmd = &cobra.Command{
+	Use:   "query QUERY",
+	Short: "Query the resources of the local synced model by their properties and relations",
+	Long: `Query the resources of the local synced model (see 'awless sync') by their properties and relations.
+
+A query is a list of resource types separated by '->', each followed by an optional condition between brackets.
+Consecutive resources are related as parent/child (at any depth), one applying on the other or stack members.
+Conditions combine terms with 'and', 'or', 'not' and parentheses. The terms are 'key=value', 'key!=value',
+'key~value' (contains) or 'key' (set and not false), the key being a property, 'id' or 'tag.Key'.`,
+	Example: `  awless query "instance[state=running and tag.Env=prod] -> subnet -> vpc"
+  awless query "vpc[name=main] -> instance[state!=running]" --ids
+  awless query "securitygroup[name~web] -> instance" --paths
+  awless query "subnet[not public] -> instance[tag.Role=web or tag.Role=api]" --format json`,
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return errors.New("expecting one query (ex: awless query \"instance[state=running] -> vpc\")")
+		}
+
+		g, err := sync.LoadLocalGraphs(config.GetAWSProfile(), config.GetAWSRegion())
+		exitOn(err)
+
+		result, err := g.(*graph.Graph).Query(args[0])
+		exitOn(err)
+
+		if len(result.Resources) == 0 {
+			logger.Infof("no resource matching the query in region '%s' (run `awless sync` if resources are recent)", config.GetAWSRegion())
+			return nil
+		}
+
+		if queryPathsFlag {
+			printQueryPaths(os.Stdout, result.Paths)
+			return nil
+		}
+
+		if queryOnlyIDsFlag {
+			for _, r := range result.Resources {
+				fmt.Println(r.Id())
+			}
+			return nil
+		}
+
+		found := graph.NewGraph()
+		exitOn(found.AddResource(result.Resources...))
+		displayer, err := console.BuildOptions(
+			console.WithRdfType(result.Resources[0].Type()),
+			console.WithFormat(queryFormatFlag),
+			console.WithMaxWidth(console.GetTerminalWidth()),
+		).SetSource(found).Build()
+		exitOn(err)
+		exitOn(displayer.Print(os.Stdout))
+		return nil
+	},
+}
+
+func printQueryPaths(w io.Writer, paths [][]*graph.Resource) {
+	for _, path := range paths {
+		var hops []string
+		for _, r := range path {
+			hops = append(hops, fmt.Sprintf("%s %s", r.Type(), instanceLabel(r)))
+		}
+		fmt.Fprintln(w, strings.Join(hops, " -> "))
+	}
+}
@@ -0,0 +1,22 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestPrintQueryPaths(t *testing.T) {
+	vpc := resourcetest.VPC("vpc-1").Prop("Name", "main").Build()
+	sub := resourcetest.Subnet("subnet-1").Build()
+	inst := resourcetest.Instance("i-1").Prop("Name", "web").Build()
+
+	var buff bytes.Buffer
+	printQueryPaths(&buff, [][]*graph.Resource{{inst, sub, vpc}, {inst}})
+	exp := "instance web (i-1) -> subnet subnet-1 -> vpc main (vpc-1)\ninstance web (i-1)\n"
+	if got, want := buff.String(), exp; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+}
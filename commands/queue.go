@@ -0,0 +1,261 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	stdsync "sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/database"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/queue"
+	"github.com/wallix/awless/template"
+)
+
+// interval at which a worker looks for queued runs it can start
+const queuePollInterval = 2 * time.Second
+
+var (
+	runQueueFlag         bool
+	queueConcurrencyFlag string
+	queuePriorityFlag    string
+	queueWatchFlag       bool
+)
+
+func init() {
+	runCmd.Flags().BoolVar(&runQueueFlag, "queue", false, "Add the template to the execution queue instead of running it (see `awless queue`)")
+
+	RootCmd.AddCommand(queueCmd)
+	queueCmd.AddCommand(queueListCmd)
+	queueCmd.AddCommand(queueCancelCmd)
+	queueCmd.AddCommand(queueWorkCmd)
+
+	queueWorkCmd.Flags().StringVar(&queueConcurrencyFlag, "concurrency", "", "Max runs of a class running at the same time, as comma separated CLASS=N (ex: destructive=1,normal=4)")
+	queueWorkCmd.Flags().StringVar(&queuePriorityFlag, "priority", "", "Priority of the runs of a class, the highest first, as comma separated CLASS=N (ex: destructive=4)")
+	queueWorkCmd.Flags().BoolVar(&queueWatchFlag, "watch", false, "Keep waiting for new runs once the queue is empty")
+}
+
+var queueCmd = &cobra.Command{
+	Use:   "queue",
+	Short: "Manage the queue of template runs waiting for execution (add runs with `awless run --queue`)",
+	Long: fmt.Sprintf(`Manage the queue of template runs waiting for execution, persisted locally so that queued runs survive a restart.
+
+Runs are classified from the actions of their template: %s (only checks), %s, or %s (deletes, detaches, stops or restarts).
+A worker (%s) starts the oldest run of the class of highest priority among the classes below their concurrency limit.
+Defaults: %s.`, queue.ReadOnly, queue.Normal, queue.Destructive, "`awless queue work`", defaultLimitsString()),
+	Example:           "  awless run teardown.aws --queue\n  awless queue list\n  awless queue work --concurrency destructive=1,normal=4\n  awless queue cancel 01C2R1JXGFP5TQN0H4VAZ0MNRY",
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
+}
+
+var queueListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the queued, running and interrupted runs, the oldest first",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		var runs []*queue.Run
+		exitOn(database.Execute(func(db *database.DB) (err error) {
+			runs, err = db.ListQueuedRuns()
+			return
+		}))
+		if len(runs) == 0 {
+			fmt.Fprintln(os.Stderr, "no queued runs")
+			return
+		}
+		exitOn(printQueuedRuns(os.Stdout, runs))
+	},
+}
+
+var queueCancelCmd = &cobra.Command{
+	Use:   "cancel ID [ID...]",
+	Short: "Remove from the queue runs not running yet, or interrupted ones",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 {
+			exitOn(errors.New("missing ID of the run to cancel (see `awless queue list`)"))
+		}
+		exitOn(database.Execute(func(db *database.DB) error {
+			for _, id := range args {
+				if err := db.CancelQueuedRun(id); err != nil {
+					return err
+				}
+				logger.Infof("run %s cancelled", id)
+			}
+			return nil
+		}))
+	},
+}
+
+var queueWorkCmd = &cobra.Command{
+	Use:   "work",
+	Short: "Run the queued runs, according to the concurrency limits and priorities of their classes, until the queue is empty",
+	Long: `Run the queued runs, according to the concurrency limits and priorities of their classes, until the queue is empty (or forever with --watch).
+
+Runs are run without confirmation in their profile and region, each as an 'awless run', and removed from the queue once done (see their result with 'awless log').
+Runs left running by a stopped worker are marked interrupted and are not run again: cancel them once checked with 'awless log'.
+Run a single worker per machine.`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		limits, err := queue.ParseLimits(queueConcurrencyFlag, queuePriorityFlag)
+		exitOn(err)
+		exitOn(workQueue(limits, queueWatchFlag))
+	},
+}
+
+func enqueueTemplate(content []byte, paramsArgs []string) error {
+	switch {
+	case runMatrixFlag != "":
+		return errors.New("--queue is not supported with --matrix")
+	case isSchedulingMode():
+		return errors.New("--queue is not supported with --run-in and --revert-in")
+	case runStackFlag != "" || runTTLFlag > 0:
+		return errors.New("--queue is not supported with --stack and --ttl")
+	}
+	run, err := queue.NewRun(string(removeComments(content)), paramsArgs, config.GetAWSProfile(), config.GetAWSRegion())
+	if err != nil {
+		return err
+	}
+	if err = database.Execute(func(db *database.DB) error {
+		return db.AddQueuedRun(run)
+	}); err != nil {
+		return err
+	}
+	logger.Infof("%s run %s queued: run the queue with `awless queue work`", run.Class, run.ID)
+	return nil
+}
+
+// workQueue starts the queued runs allowed by the limits, until none remains or
+// it is interrupted, in which case the running ones are waited for
+func workQueue(limits queue.Limits, watch bool) error {
+	var interrupted []*queue.Run
+	if err := database.Execute(func(db *database.DB) (err error) {
+		interrupted, err = db.InterruptRunningRuns()
+		return
+	}); err != nil {
+		return err
+	}
+	for _, run := range interrupted {
+		logger.Warningf("run %s was interrupted while running: not running it again (see `awless log` then `awless queue cancel %s`)", run.ID, run.ID)
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt)
+	defer signal.Stop(sigs)
+
+	var stderrMu stdsync.Mutex
+	done := make(chan *queue.Run)
+	var running int
+	var stopping bool
+	for {
+		for !stopping {
+			var next *queue.Run
+			if err := database.Execute(func(db *database.DB) (err error) {
+				next, err = db.StartNextQueuedRun(limits)
+				return
+			}); err != nil {
+				return err
+			}
+			if next == nil {
+				break
+			}
+			running++
+			logger.Infof("starting %s run %s on %s/%s", next.Class, next.ID, next.Profile, next.Region)
+			go func(run *queue.Run) {
+				res := execMatrixTarget(exe, queuedRunArgs(run), true, []byte(run.Template), &matrixTarget{Profile: run.Profile, Region: run.Region}, &stderrMu)
+				if res.Status == template.SuccessStatus {
+					logger.Infof("run %s done", run.ID)
+				} else {
+					logger.Errorf("run %s %s: %s", run.ID, res.Status, res.Error)
+				}
+				done <- run
+			}(next)
+		}
+
+		if running == 0 && (stopping || !watch) {
+			return nil
+		}
+
+		select {
+		case run := <-done:
+			running--
+			if err := database.Execute(func(db *database.DB) error {
+				return db.DeleteQueuedRun(run.ID)
+			}); err != nil {
+				logger.Errorf("cannot remove run %s from queue: %s", run.ID, err)
+			}
+		case <-sigs: // the running runs receive the interrupt too
+			if !stopping {
+				logger.Warning("interrupting queue worker: waiting for the running runs, queued ones stay queued")
+			}
+			stopping = true
+		case <-time.After(queuePollInterval):
+		}
+	}
+}
+
+// queuedRunArgs returns the `awless run` arguments running the template, read from stdin, of a queued run
+func queuedRunArgs(run *queue.Run) []string {
+	runArgs := []string{"run", stdinTemplatePath, "--format", template.JSONResultFormat, "--force", "--no-prompt",
+		"--aws-profile", run.Profile, "--aws-region", run.Region, "--message", fmt.Sprintf("Run queued %s", run.ID)}
+	if verboseGlobalFlag {
+		runArgs = append(runArgs, "--verbose")
+	}
+	if extraVerboseGlobalFlag {
+		runArgs = append(runArgs, "--extra-verbose")
+	}
+	return append(runArgs, run.Args...)
+}
+
+func printQueuedRuns(w io.Writer, runs []*queue.Run) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tCLASS\tSTATUS\tPROFILE\tREGION\tQUEUED\tTEMPLATE")
+	for _, run := range runs {
+		lines := strings.Split(strings.TrimSpace(run.Template), "\n")
+		text := lines[0]
+		if len(lines) > 1 {
+			text = fmt.Sprintf("%s (+%d lines)", text, len(lines)-1)
+		}
+		if len(run.Args) > 0 {
+			text = fmt.Sprintf("%s [%s]", text, strings.Join(run.Args, " "))
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", run.ID, run.Class, run.Status, run.Profile, run.Region, run.Queued.Local().Format(time.Stamp), text)
+	}
+	return tw.Flush()
+}
+
+func defaultLimitsString() string {
+	var limits []string
+	for _, class := range queue.Classes {
+		l := queue.DefaultLimits()[class]
+		limits = append(limits, fmt.Sprintf("%s concurrency %d and priority %d", class, l.Concurrency, l.Priority))
+	}
+	return strings.Join(limits, ", ")
+}
@@ -0,0 +1,35 @@
+package commands
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/queue"
+)
+
+func TestQueuedRuns(t *testing.T) {
+	run := &queue.Run{
+		ID: "01C2R1JXGFP5TQN0H4VAZ0MNRY", Class: queue.Destructive, Status: queue.QueuedStatus,
+		Template: "delete subnet id={subnet}\ndelete vpc id={vpc}\n", Args: []string{"subnet=subnet-1", "vpc=vpc-1"},
+		Profile: "prod", Region: "eu-west-1", Queued: time.Date(2018, 3, 1, 12, 30, 0, 0, time.Local),
+	}
+
+	expected := []string{"run", "-", "--format", "json", "--force", "--no-prompt", "--aws-profile", "prod", "--aws-region", "eu-west-1",
+		"--message", "Run queued 01C2R1JXGFP5TQN0H4VAZ0MNRY", "subnet=subnet-1", "vpc=vpc-1"}
+	if got, want := queuedRunArgs(run), expected; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	var buff bytes.Buffer
+	if err := printQueuedRuns(&buff, []*queue.Run{run}); err != nil {
+		t.Fatal(err)
+	}
+	exp := `ID                          CLASS        STATUS  PROFILE  REGION     QUEUED           TEMPLATE
+01C2R1JXGFP5TQN0H4VAZ0MNRY  destructive  queued  prod     eu-west-1  Mar  1 12:30:00  delete subnet id={subnet} (+1 lines) [subnet=subnet-1 vpc=vpc-1]
+`
+	if got, want := buff.String(), exp; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/sync"
+)
+
+var (
+	repoExportFormatFlag     string
+	repoExportAllRegionsFlag bool
+)
+
+func init() {
+	RootCmd.AddCommand(repoCmd)
+	repoCmd.AddCommand(repoExportCmd)
+
+	repoExportCmd.Flags().StringVar(&repoExportFormatFlag, "format", graph.CypherFormat, fmt.Sprintf("Export format: %s", strings.Join(graph.ExportFormats, ", ")))
+	repoExportCmd.Flags().BoolVar(&repoExportAllRegionsFlag, "all-regions", false, "Export the synced resources of all regions of the current profile")
+}
+
+var repoCmd = &cobra.Command{
+	Use:               "repo",
+	Short:             "Operations on the local repository of synced resources",
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook),
+}
+
+var repoExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the locally synced resources as an import script for property graph databases (Neo4j Cypher, TinkerPop Gremlin GraphSON)",
+	Long: `Export the locally synced resources as an import script for property graph databases.
+
+Resources are exported as nodes carrying their properties. Parent relations (PARENT_OF),
+applies-on relations (APPLIES_ON) and references between resources (ex: VPC, SECURITY_GROUPS) become typed edges.
+
+  - cypher: MERGE statements, to pipe into cypher-shell
+  - graphson: GraphSON adjacency list, one vertex per line, to read with TinkerPop's GraphSONReader
+
+Use 'awless graph export' to export it as well to visualization tools (Graphviz DOT, GraphML).`,
+	Example: "  awless repo export --format cypher | cypher-shell -u neo4j -p secret\n  awless repo export --format graphson --all-regions > inventory.json",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		exportLocalGraph(repoExportFormatFlag, repoExportAllRegionsFlag)
+	},
+}
+
+// exportLocalGraph writes on stdout the locally synced resources of the current region,
+// or of all regions, in the given export format
+func exportLocalGraph(format string, allRegions bool) {
+	var g cloud.GraphAPI
+	var err error
+	freshenLocalData()
+	if allRegions {
+		g, err = sync.LoadAllLocalGraphs(config.GetAWSProfile())
+	} else {
+		g, err = sync.LoadLocalGraphs(config.GetAWSProfile(), config.GetAWSRegion())
+	}
+	exitOn(err)
+	gph, ok := g.(*graph.Graph)
+	if !ok {
+		exitOn(fmt.Errorf("cannot export graph of type %T", g))
+	}
+	exitOn(gph.Export(os.Stdout, format))
+}
@@ -0,0 +1,275 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/sync"
+	"github.com/wallix/awless/template"
+)
+
+// resource types of the showback, the EC2 ones taggable with `create tag`
+var showbackResourceTypes = []string{cloud.Instance, cloud.Volume}
+
+var invalidHoleCharsReg = regexp.MustCompile(`[^a-zA-Z0-9-_.]`)
+
+var (
+	showbackByFlag       string
+	showbackFormatFlag   string
+	showbackTemplateFlag string
+)
+
+func init() {
+	RootCmd.AddCommand(reportCmd)
+	reportCmd.AddCommand(showbackCmd)
+
+	showbackCmd.Flags().StringVar(&showbackByFlag, "by", "", "Tag whose values the spend is reported per (ex: tag:Team)")
+	showbackCmd.Flags().StringVar(&showbackFormatFlag, "format", "table", "Output format: table or json")
+	showbackCmd.Flags().StringVar(&showbackTemplateFlag, "template", "", "Write to this file the template tagging the untagged resources of the current region")
+}
+
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Produce reports from the locally synced resources of all regions",
+}
+
+var showbackCmd = &cobra.Command{
+	Use:   "showback",
+	Short: "Report the estimated monthly spend per value of a tag, with the untagged remainder, and propose a template tagging the untagged resources",
+	Long: `Report the estimated monthly spend of instances and volumes of all locally synced regions per value of a tag, with the untagged remainder.
+
+The spend is the on-demand cost of the running instances, from the instance types catalog. With --template, the untagged resources
+of the current region get a 'create tag' statement: volumes attached to a tagged instance reuse the tag value of the instance,
+the other values are left as holes to fill when running the template.`,
+	Example:           "  awless report showback --by tag:Team\n  awless report showback --by tag:Team --template tag-teams.aws\n  awless run tag-teams.aws",
+	PersistentPreRun:  applyHooks(initAwlessEnvHook, initLoggerHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		if !strings.HasPrefix(showbackByFlag, tagDimensionPref) || len(showbackByFlag) == len(tagDimensionPref) {
+			exitOn(fmt.Errorf("invalid --by '%s': expecting a tag key (ex: tag:Team)", showbackByFlag))
+		}
+		graphs, err := sync.LoadLocalGraphsPerRegion(config.GetAWSProfile())
+		exitOn(err)
+		if len(graphs) == 0 {
+			exitOn(fmt.Errorf("no local resources for profile '%s': run `awless sync` first", config.GetAWSProfile()))
+		}
+
+		report, err := showback(graphs, strings.TrimPrefix(showbackByFlag, tagDimensionPref), catalogInstancePrice)
+		exitOn(err)
+		exitOn(report.print(os.Stdout, showbackFormatFlag))
+
+		if len(report.Untagged) == 0 || showbackFormatFlag == "json" {
+			return
+		}
+		if showbackTemplateFlag == "" {
+			fmt.Fprintf(os.Stderr, "\n%d untagged resources: use --template FILE to write a template tagging them\n", len(report.Untagged))
+			return
+		}
+		tpl, err := report.taggingTemplate(config.GetAWSRegion())
+		exitOn(err)
+		exitOn(ioutil.WriteFile(showbackTemplateFlag, []byte(tpl.String()+"\n"), 0644))
+		fmt.Fprintf(os.Stderr, "\ntagging template of the untagged resources of %s written to %s (run it with `awless run %s`)\n", config.GetAWSRegion(), showbackTemplateFlag, showbackTemplateFlag)
+		if others := report.untaggedRegions(config.GetAWSRegion()); len(others) > 0 {
+			fmt.Fprintf(os.Stderr, "untagged resources also in %s: rerun with --aws-region for their template\n", strings.Join(others, ", "))
+		}
+	},
+}
+
+type showbackReport struct {
+	TagKey   string              `json:"tag"`
+	Groups   []*showbackGroup    `json:"groups"`
+	Untagged []*showbackResource `json:"untagged,omitempty"`
+}
+
+type showbackGroup struct {
+	Value       string  `json:"value"`
+	Resources   int     `json:"resources"`
+	MonthlyCost float64 `json:"monthlyCost"`
+}
+
+type showbackResource struct {
+	Region      string  `json:"region"`
+	Type        string  `json:"type"`
+	ID          string  `json:"id"`
+	Name        string  `json:"name,omitempty"`
+	MonthlyCost float64 `json:"monthlyCost"`
+	// tag value of the instance a volume is attached to, if any
+	suggested string
+}
+
+// showback groups the instances and volumes of the graphs per value of the tag, the untagged
+// ones, if any, in a last group of empty value. Groups are sorted by decreasing cost
+func showback(graphs map[string]cloud.GraphAPI, tagKey string, prices instancePricer) (*showbackReport, error) {
+	report := &showbackReport{TagKey: tagKey}
+	var regions []string
+	for region := range graphs {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	groups := make(map[string]*showbackGroup)
+	for _, region := range regions {
+		resources, err := graphs[region].Find(cloud.NewQuery(showbackResourceTypes...))
+		if err != nil {
+			return nil, err
+		}
+		instanceTags := make(map[string]string)
+		for _, res := range resources {
+			if res.Type() == cloud.Instance {
+				instanceTags[res.Id()] = dimensionValue(res, region, tagDimensionPref+tagKey)
+			}
+		}
+		sort.Sort(byTypeAndString{resources})
+		for _, res := range resources {
+			var cost float64
+			if res.Type() == cloud.Instance && fmt.Sprint(res.Properties()[properties.State]) == "running" {
+				if price, ok := prices(region, fmt.Sprint(res.Properties()[properties.Type])); ok {
+					cost = price * hoursPerMonth
+				}
+			}
+			value := dimensionValue(res, region, tagDimensionPref+tagKey)
+			g, ok := groups[value]
+			if !ok {
+				g = &showbackGroup{Value: value}
+				groups[value] = g
+				report.Groups = append(report.Groups, g)
+			}
+			g.Resources++
+			g.MonthlyCost += cost
+
+			if value == "" {
+				untagged := &showbackResource{Region: region, Type: res.Type(), ID: res.Id(), Name: stringProp(res, properties.Name), MonthlyCost: cost}
+				if res.Type() == cloud.Volume {
+					attached, _ := res.Properties()[properties.Instances].([]string)
+					for _, inst := range attached {
+						if v := instanceTags[inst]; v != "" {
+							untagged.suggested = v
+							break
+						}
+					}
+				}
+				report.Untagged = append(report.Untagged, untagged)
+			}
+		}
+	}
+
+	sort.Slice(report.Groups, func(i, j int) bool {
+		a, b := report.Groups[i], report.Groups[j]
+		if (a.Value == "") != (b.Value == "") {
+			return b.Value == ""
+		}
+		if a.MonthlyCost != b.MonthlyCost {
+			return a.MonthlyCost > b.MonthlyCost
+		}
+		return a.Value < b.Value
+	})
+	return report, nil
+}
+
+func (r *showbackReport) print(w io.Writer, format string) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(r)
+	case "table", "":
+		t := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(t, "%s\tRESOURCES\tCOST ($/MONTH)\tSHARE\n", strings.ToUpper(tagDimensionPref+r.TagKey))
+		var total float64
+		var count int
+		for _, g := range r.Groups {
+			total += g.MonthlyCost
+			count += g.Resources
+		}
+		for _, g := range r.Groups {
+			value := g.Value
+			if value == "" {
+				value = "(untagged)"
+			}
+			fmt.Fprintf(t, "%s\t%d\t%.2f\t%s\n", value, g.Resources, g.MonthlyCost, share(g.MonthlyCost, total))
+		}
+		fmt.Fprintf(t, "TOTAL\t%d\t%.2f\t%s\n", count, total, share(total, total))
+		if len(r.Untagged) > 0 {
+			if err := t.Flush(); err != nil {
+				return err
+			}
+			t = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(t)
+			fmt.Fprintln(t, "UNTAGGED\tREGION\tID\tNAME\tCOST ($/MONTH)")
+			for _, res := range r.Untagged {
+				fmt.Fprintf(t, "%s\t%s\t%s\t%s\t%.2f\n", res.Type, res.Region, res.ID, orDash(res.Name), res.MonthlyCost)
+			}
+		}
+		return t.Flush()
+	default:
+		return fmt.Errorf("unsupported format '%s' for showback: expected table or json", format)
+	}
+}
+
+func share(cost, total float64) string {
+	if total == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", cost*100/total)
+}
+
+// taggingTemplate returns the template tagging the untagged resources of the region. The value
+// of a volume attached to a tagged instance is the one of the instance, others are holes
+func (r *showbackReport) taggingTemplate(region string) (*template.Template, error) {
+	var lines []string
+	holePrefix := strings.ToLower(invalidHoleCharsReg.ReplaceAllString(r.TagKey, "-"))
+	for _, res := range r.Untagged {
+		if res.Region != region {
+			continue
+		}
+		value := fmt.Sprintf("{%s.%s}", holePrefix, res.ID)
+		if res.suggested != "" {
+			value = template.QuoteParamValue(res.suggested)
+		}
+		lines = append(lines, fmt.Sprintf("create tag resource=%s key=%s value=%s", res.ID, template.QuoteParamValue(r.TagKey), value))
+	}
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("no untagged resources in %s", region)
+	}
+	return template.Parse(strings.Join(lines, "\n"))
+}
+
+// untaggedRegions returns the regions, other than the given one, having untagged resources
+func (r *showbackReport) untaggedRegions(except string) (regions []string) {
+	seen := map[string]bool{except: true}
+	for _, res := range r.Untagged {
+		if !seen[res.Region] {
+			seen[res.Region] = true
+			regions = append(regions, res.Region)
+		}
+	}
+	return
+}
@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wallix/awless/cloud"
+	p "github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestShowback(t *testing.T) {
+	eu := graph.NewGraph()
+	eu.AddResource(resourcetest.Instance("i-1").Prop(p.Type, "m5.large").Prop(p.State, "running").Prop(p.Tags, []string{"Team=data"}).Build())
+	eu.AddResource(resourcetest.Instance("i-2").Prop(p.Type, "t2.micro").Prop(p.State, "running").Prop(p.Tags, []string{"Team=web", "Env=prod"}).Build())
+	eu.AddResource(resourcetest.Instance("i-3").Prop(p.Name, "legacy").Prop(p.Type, "t2.micro").Prop(p.State, "running").Build())
+	eu.AddResource(resourcetest.Instance("i-4").Prop(p.Type, "m5.large").Prop(p.State, "stopped").Build())
+	eu.AddResource(resourcetest.Volume("vol-1").Prop(p.Instances, []string{"i-1"}).Build())
+	eu.AddResource(resourcetest.Volume("vol-2").Prop(p.Tags, []string{"Team=web"}).Build())
+	eu.AddResource(resourcetest.Subnet("sub-1").Build())
+	us := graph.NewGraph()
+	us.AddResource(resourcetest.Volume("vol-3").Build())
+	graphs := map[string]cloud.GraphAPI{"eu-west-1": eu, "us-east-1": us}
+
+	prices := func(region, instanceType string) (float64, bool) {
+		return map[string]float64{"t2.micro": 0.01, "m5.large": 0.1}[instanceType], true
+	}
+
+	report, err := showback(graphs, "Team", prices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buff bytes.Buffer
+	if err = report.print(&buff, "table"); err != nil {
+		t.Fatal(err)
+	}
+	expected := `TAG:TEAM    RESOURCES  COST ($/MONTH)  SHARE
+data        1          73.00           83.3%
+web         2          7.30            8.3%
+(untagged)  4          7.30            8.3%
+TOTAL       7          87.60           100.0%
+
+UNTAGGED  REGION     ID     NAME    COST ($/MONTH)
+instance  eu-west-1  i-3    legacy  7.30
+instance  eu-west-1  i-4    -       0.00
+volume    eu-west-1  vol-1  -       0.00
+volume    us-east-1  vol-3  -       0.00
+`
+	if got, want := buff.String(), expected; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+
+	tpl, err := report.taggingTemplate("eu-west-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedTpl := "create tag key=Team resource=i-3 value={team.i-3}\n" +
+		"create tag key=Team resource=i-4 value={team.i-4}\n" +
+		"create tag key=Team resource=vol-1 value=data"
+	if got, want := tpl.String(), expectedTpl; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+	if _, err = report.taggingTemplate("eu-central-1"); err == nil {
+		t.Fatal("expected error")
+	}
+	if got, want := report.untaggedRegions("eu-west-1"), []string{"us-east-1"}; len(got) != 1 || got[0] != want[0] {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/sync"
+)
+
+// exit code of `awless resolve` when several resources match
+const resolveAmbiguousExitCode = 2
+
+// properties printed per --format of `awless resolve`, the first one set being used
+var resolveFormats = map[string][]string{
+	"id":   {properties.ID},
+	"arn":  {properties.Arn},
+	"name": {properties.Name},
+	"ip":   {properties.PublicIP, properties.PrivateIP},
+}
+
+var (
+	resolveTypeFlag   string
+	resolveFormatFlag string
+)
+
+func init() {
+	RootCmd.AddCommand(resolveCmd)
+
+	resolveCmd.Flags().StringVarP(&resolveTypeFlag, "type", "t", "", "Only resolve resources of this type (ex: instance, subnet, ...)")
+	resolveCmd.Flags().StringVarP(&resolveFormatFlag, "format", "f", "id", "Output format: id, arn, name or ip (public, else private)")
+}
+
+var resolveCmd = &cobra.Command{
+	Use:   "resolve NAME",
+	Short: "Print the id (or arn, ip) of the resource matching NAME in the local graph, failing when none or several match",
+	Long: `Print the id (or arn, ip) of the resource matching NAME in the local graph of the current region, without syncing.
+
+Matching rules are tried in order, the first one matching resources wins:
+  1. exact: id, name or arn equal to NAME
+  2. prefix: name starting with NAME
+  3. tag: a tag value equal to NAME, or the tag KEY=VALUE when NAME is of this form
+
+Exits with status 1 when nothing matches and with status 2 when several resources match, listing them on stderr.`,
+	Example: `  awless resolve web-prod-1 --type instance
+  awless resolve web-prod --type instance --format ip
+  awless resolve Env=staging -t vpc
+  ssh ubuntu@$(awless resolve web-prod-1 -f ip)`,
+	PersistentPreRun:  applyHooks(initAwlessEnvHook, initLoggerHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 1 {
+			exitOn(fmt.Errorf("expecting one NAME to resolve"))
+		}
+		props, ok := resolveFormats[resolveFormatFlag]
+		if !ok {
+			exitOn(fmt.Errorf("invalid format '%s': expecting id, arn, name or ip", resolveFormatFlag))
+		}
+		var types []string
+		if resolveTypeFlag != "" {
+			resType := cloud.SingularizeResource(strings.ToLower(resolveTypeFlag))
+			for _, t := range awsservices.ResourceTypes {
+				if t == resType {
+					types = append(types, resType)
+				}
+			}
+			if len(types) == 0 {
+				exitOn(fmt.Errorf("invalid resource type '%s'", resolveTypeFlag))
+			}
+		}
+
+		freshenLocalData()
+		g, err := sync.LoadLocalGraphs(config.GetAWSProfile(), config.GetAWSRegion())
+		exitOn(err)
+
+		resources, rule, err := resolveByName(g, args[0], types)
+		exitOn(err)
+
+		switch len(resources) {
+		case 0:
+			exitOn(fmt.Errorf("no resource matching '%s' in region '%s' for profile '%s' (run `awless sync` if it is recent)", args[0], config.GetAWSRegion(), config.GetAWSProfile()))
+		case 1:
+			value, err := resolvedValue(resources[0], props)
+			exitOn(err)
+			fmt.Println(value)
+		default:
+			fmt.Fprintf(os.Stderr, "%d resources matching '%s' (%s match):\n", len(resources), args[0], rule)
+			for _, res := range resources {
+				fmt.Fprintf(os.Stderr, "\t%s\t%s\t%s\n", res.Type(), res.Id(), stringProp(res, properties.Name))
+			}
+			exit(resolveAmbiguousExitCode)
+		}
+	},
+}
+
+// resolveByName returns the resources, of the given types or of any type, matching the name
+// with the first matching rule among exact, prefix and tag, sorted by type
+func resolveByName(g cloud.GraphAPI, name string, types []string) ([]cloud.Resource, string, error) {
+	if len(types) == 0 {
+		types = awsservices.ResourceTypes
+	}
+	all, err := g.Find(cloud.NewQuery(types...))
+	if err != nil {
+		return nil, "", err
+	}
+
+	rules := []struct {
+		name  string
+		match func(cloud.Resource) bool
+	}{
+		{"exact", func(r cloud.Resource) bool {
+			return r.Id() == name || stringProp(r, properties.Name) == name || stringProp(r, properties.Arn) == name
+		}},
+		{"prefix", func(r cloud.Resource) bool {
+			n := stringProp(r, properties.Name)
+			return n != "" && strings.HasPrefix(n, name)
+		}},
+		{"tag", func(r cloud.Resource) bool {
+			tags, _ := r.Properties()[properties.Tags].([]string)
+			for _, tag := range tags {
+				if tag == name {
+					return true
+				}
+				if kv := strings.SplitN(tag, "=", 2); len(kv) == 2 && kv[1] == name {
+					return true
+				}
+			}
+			return false
+		}},
+	}
+
+	for _, rule := range rules {
+		var matched []cloud.Resource
+		for _, r := range all {
+			if rule.match(r) {
+				matched = append(matched, r)
+			}
+		}
+		if len(matched) > 0 {
+			sort.Sort(byTypeAndString{matched})
+			return matched, rule.name, nil
+		}
+	}
+	return nil, "", nil
+}
+
+func resolvedValue(res cloud.Resource, props []string) (string, error) {
+	for _, p := range props {
+		if v := stringProp(res, p); v != "" {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("%s %s has no %s", res.Type(), res.Id(), strings.ToLower(strings.Join(props, " or ")))
+}
+
+func stringProp(res cloud.Resource, key string) string {
+	if key == properties.ID {
+		return res.Id()
+	}
+	s, _ := res.Properties()[key].(string)
+	return s
+}
@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/wallix/awless/cloud"
+	p "github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestResolveByName(t *testing.T) {
+	g := graph.NewGraph()
+	g.AddResource(resourcetest.Instance("i-1").Prop(p.Name, "web-prod-1").Prop(p.PublicIP, "1.2.3.4").Prop(p.Tags, []string{"Env=prod"}).Build())
+	g.AddResource(resourcetest.Instance("i-2").Prop(p.Name, "web-prod-2").Prop(p.PrivateIP, "10.0.0.2").Prop(p.Tags, []string{"Env=prod"}).Build())
+	g.AddResource(resourcetest.Instance("i-3").Prop(p.Name, "web-prod").Prop(p.Tags, []string{"Env=staging"}).Build())
+	g.AddResource(resourcetest.Subnet("sub-1").Prop(p.Name, "web-prod-1").Build())
+	g.AddResource(resourcetest.VPC("vpc-1").Prop(p.Arn, "arn:aws:ec2:vpc/vpc-1").Prop(p.Tags, []string{"Env=staging"}).Build())
+
+	tcases := []struct {
+		name    string
+		types   []string
+		expIds  []string
+		expRule string
+	}{
+		{name: "i-2", expIds: []string{"i-2"}, expRule: "exact"},
+		{name: "arn:aws:ec2:vpc/vpc-1", expIds: []string{"vpc-1"}, expRule: "exact"},
+		{name: "web-prod-1", expIds: []string{"i-1", "sub-1"}, expRule: "exact"},
+		{name: "web-prod-1", types: []string{cloud.Instance}, expIds: []string{"i-1"}, expRule: "exact"},
+		{name: "web-prod", types: []string{cloud.Instance}, expIds: []string{"i-3"}, expRule: "exact"},
+		{name: "web-prod-", types: []string{cloud.Instance}, expIds: []string{"i-1", "i-2"}, expRule: "prefix"},
+		{name: "staging", expIds: []string{"i-3", "vpc-1"}, expRule: "tag"},
+		{name: "Env=staging", types: []string{cloud.Vpc}, expIds: []string{"vpc-1"}, expRule: "tag"},
+		{name: "unknown"},
+	}
+	for _, tcase := range tcases {
+		resources, rule, err := resolveByName(g, tcase.name, tcase.types)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, r := range resources {
+			ids = append(ids, r.Id())
+		}
+		if got, want := ids, tcase.expIds; !reflect.DeepEqual(got, want) {
+			t.Fatalf("%s %v: got %v, want %v", tcase.name, tcase.types, got, want)
+		}
+		if got, want := rule, tcase.expRule; got != want {
+			t.Fatalf("%s %v: rule: got %s, want %s", tcase.name, tcase.types, got, want)
+		}
+	}
+
+	t.Run("format", func(t *testing.T) {
+		instances, _, err := resolveByName(g, "web-prod-", []string{cloud.Instance})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := resolvedValue(instances[0], resolveFormats["ip"]); err != nil || got != "1.2.3.4" {
+			t.Fatalf("got %s (%v), want public ip", got, err)
+		}
+		if got, err := resolvedValue(instances[1], resolveFormats["ip"]); err != nil || got != "10.0.0.2" {
+			t.Fatalf("got %s (%v), want private ip", got, err)
+		}
+		if _, err := resolvedValue(instances[0], resolveFormats["arn"]); err == nil {
+			t.Fatal("expected error for missing arn")
+		}
+	})
+}
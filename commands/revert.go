@@ -19,24 +19,37 @@ package commands
 import (
 	"errors"
 	"fmt"
-
-	"os"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/aws/spec"
+	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/config"
 	"github.com/wallix/awless/database"
 	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync"
 	"github.com/wallix/awless/template"
 )
 
+var (
+	revertOnlyEntitiesFlag []string
+	revertRangeFlag        string
+	revertDryRunFlag       bool
+)
+
 func init() {
 	RootCmd.AddCommand(revertCmd)
+	revertCmd.Flags().StringSliceVar(&revertOnlyEntitiesFlag, "only", nil, "Revert only the commands on the given entities (ex: --only instance,subnet)")
+	revertCmd.Flags().StringVar(&revertRangeFlag, "range", "", "Revert only the commands in the given range of positions, starting at 1 (ex: --range 2:5, --range 3:)")
+	revertCmd.Flags().BoolVar(&revertDryRunFlag, "dry-run", false, "Show the plan of the revert and dry run it without executing it")
+	revertCmd.Flags().IntVar(&parallelismFlag, "parallel", defaultParallelism, "Max number of independent deletes run concurrently. 1 to run sequentially")
 }
 
 var revertCmd = &cobra.Command{
 	Use:               "revert REVERTID",
 	Short:             "Revert a template execution given a revert ID (see `awless log` to list revert ids)",
-	Example:           "  awless revert 01BA7RV6ES86PZYCM3H28WM6KZ",
+	Example:           "  awless revert 01BA7RV6ES86PZYCM3H28WM6KZ\n  awless revert 01BA7RV6ES86PZYCM3H28WM6KZ --only instance,subnet\n  awless revert 01BA7RV6ES86PZYCM3H28WM6KZ --range 2:4\n  awless revert 01BA7RV6ES86PZYCM3H28WM6KZ --dry-run",
 	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
 	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
 
@@ -56,15 +69,37 @@ var revertCmd = &cobra.Command{
 		if loc := loaded.Locale; loc != "" && loc != config.GetAWSRegion() {
 			logger.Errorf("This template was originally run in region %s", loc)
 			logger.Infof("Revert with `awless revert %s -r %s -p %s`", revertID, loc, loaded.Profile)
-			os.Exit(1)
+			exit(1)
 		}
 
 		if prof := loaded.Profile; prof != config.GetAWSProfile() {
 			logger.Warningf("This template was originally run with profile %s", prof)
 		}
 
-		reverted, err := loaded.Template.Revert()
+		var reverted *template.Template
+		var err error
+		if len(revertOnlyEntitiesFlag) > 0 || revertRangeFlag != "" {
+			sel := &template.RevertSelection{Entities: revertOnlyEntitiesFlag}
+			sel.From, sel.To, err = template.ParseRevertRange(revertRangeFlag)
+			exitOn(err)
+			reverted, err = loaded.Template.PartialRevert(sel)
+		} else {
+			reverted, err = loaded.Template.Revert()
+		}
+		exitOn(err)
+
+		plan, err := planRevert(reverted, loaded.Date())
 		exitOn(err)
+		if plan.HasNoOp() {
+			logger.Infof("Revert plan (no-op commands will be skipped):\n\n%s\n", plan)
+		} else {
+			logger.Infof("Revert plan:\n\n%s\n", plan)
+		}
+		if plan.IsEmpty() {
+			logger.Info("Nothing to revert: all targeted resources no longer exist")
+			return nil
+		}
+		reverted = plan.Template(reverted.ID)
 
 		tplExec := &template.TemplateExecution{
 			Template: reverted,
@@ -74,8 +109,44 @@ var revertCmd = &cobra.Command{
 		}
 		tplExec.SetMessage(fmt.Sprintf("Revert %s: %s", loaded.ID, loaded.Message))
 
-		exitOn(NewRunnerRequiredParamsOnly(tplExec.Template, tplExec.Message, tplExec.Path).Run())
+		runner := NewRunnerRequiredParamsOnly(tplExec.Template, tplExec.Message, tplExec.Path)
+		if revertDryRunFlag {
+			runner.BeforeRun = func(tplExec *template.TemplateExecution) (bool, error) {
+				fmt.Printf("%s\n\n", renderGreenFn(tplExec.Template))
+				logger.Info("Dry run successful: nothing has been reverted (remove --dry-run to revert)")
+				return false, nil
+			}
+		}
+		exitOn(runTemplate(runner))
 
 		return nil
 	},
 }
+
+// planRevert syncs the services of the revert template then plans it, the commands
+// being flagged as no-op only with local data synced after the reverted execution
+func planRevert(reverted *template.Template, executedAt time.Time) (*template.Plan, error) {
+	if !localGlobalFlag {
+		services := awsservices.GetCloudServicesForAPIs(reverted.UniqueDefinitions(awsspec.APIPerTemplateDefName)...)
+		if _, err := sync.DefaultSyncer.Sync(services...); err != nil {
+			logger.Verbosef("cannot sync before planning revert: %s", err)
+		}
+	}
+	profile, region := config.GetAWSProfile(), config.GetAWSRegion()
+	return template.PlanRevert(reverted, revertGraphLookup(executedAt, sync.LastSyncTimes(profile, region), func(service string) cloud.GraphAPI {
+		return sync.LoadLocalGraphForService(service, profile, region)
+	}))
+}
+
+// revertGraphLookup returns the local graph of the service of an entity only when it was synced
+// after the execution: otherwise (never synced, sync disabled, stale data) the resources created
+// by the execution could be missing from it
+func revertGraphLookup(executedAt time.Time, lastSyncs map[string]time.Time, load func(service string) cloud.GraphAPI) func(string) (cloud.GraphAPI, bool) {
+	return func(entity string) (cloud.GraphAPI, bool) {
+		service := awsservices.ServicePerResourceType[entity]
+		if synced, ok := lastSyncs[service]; !ok || !synced.After(executedAt) {
+			return nil, false
+		}
+		return load(service), true
+	}
+}
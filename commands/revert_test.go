@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+	"github.com/wallix/awless/template"
+)
+
+func TestPlanRevertOnlyWithFreshLocalData(t *testing.T) {
+	executedAt := time.Now().Add(-time.Hour)
+	g := graph.NewGraph()
+	g.AddResource(resourcetest.Instance("inst_1").Build())
+	load := func(service string) cloud.GraphAPI { return g }
+	tpl := template.MustParse("delete instance id=inst_1\ndelete instance id=inst_2\ndelete s3object name=obj_2 bucket=my-bucket")
+
+	tcases := []struct {
+		lastSyncs map[string]time.Time
+		expNoOps  []bool
+	}{
+		{lastSyncs: map[string]time.Time{"infra": time.Now()}, expNoOps: []bool{false, true, false}},
+		{lastSyncs: map[string]time.Time{"infra": executedAt.Add(-time.Minute), "storage": time.Now()}, expNoOps: []bool{false, false, true}},
+		{lastSyncs: map[string]time.Time{}, expNoOps: []bool{false, false, false}},
+	}
+	for i, tcase := range tcases {
+		plan, err := template.PlanRevert(tpl, revertGraphLookup(executedAt, tcase.lastSyncs, load))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for j, noop := range tcase.expNoOps {
+			if got, want := plan.Steps[j].NoOp, noop; got != want {
+				t.Fatalf("%d: step %d: got %t, want %t", i+1, j+1, got, want)
+			}
+		}
+	}
+}
@@ -27,6 +27,7 @@ var (
 	silentGlobalFlag       bool
 	localGlobalFlag        bool
 	noSyncGlobalFlag       bool
+	noAutoSyncGlobalFlag   bool
 	forceGlobalFlag        bool
 	versionGlobalFlag      bool
 	awsRegionGlobalFlag    string
@@ -48,6 +49,7 @@ func init() {
 	RootCmd.PersistentFlags().BoolVarP(&localGlobalFlag, "local", "l", false, "Work offline only using locally synced resources")
 	RootCmd.PersistentFlags().BoolVarP(&forceGlobalFlag, "force", "f", false, "Force the command and bypass confirmation prompts")
 	RootCmd.PersistentFlags().BoolVar(&noSyncGlobalFlag, "no-sync", false, "Do not run any sync on command")
+	RootCmd.PersistentFlags().BoolVar(&noAutoSyncGlobalFlag, "no-auto-sync", false, "Read the local data as is, never syncing it when older than the autosync.maxage config")
 	RootCmd.PersistentFlags().StringVarP(&awsRegionGlobalFlag, "aws-region", "r", "", "Override AWS region temporarily for the current command")
 	RootCmd.PersistentFlags().SetAnnotation("aws-region", cobra.BashCompCustom, []string{"__awless_region_list"})
 	RootCmd.PersistentFlags().StringVarP(&awsProfileGlobalFlag, "aws-profile", "p", "", "Override AWS profile temporarily for the current command")
@@ -191,7 +193,7 @@ __custom_func() {
 __awless_region_list()
 {
     cur="${COMP_WORDS[COMP_CWORD]#*=}"
-    regions="us-east-1 us-east-2 us-west-1 us-west-2 ca-central-1 eu-west-1 eu-central-1 eu-west-2 eu-west-3 ap-northeast-1 ap-northeast-2 ap-southeast-1 ap-southeast-2 ap-south-1 sa-east-1"
+    regions="us-east-1 us-east-2 us-west-1 us-west-2 ca-central-1 eu-west-1 eu-central-1 eu-west-2 eu-west-3 ap-northeast-1 ap-northeast-2 ap-southeast-1 ap-southeast-2 ap-south-1 sa-east-1 cn-north-1 cn-northwest-1 us-gov-west-1"
     COMPREPLY=( $(compgen -W "${regions}" -- ${cur}) )
 }
 
@@ -205,7 +207,7 @@ __awless_profile_list()
 __awless_profile_region_list()
 {
     cur="${COMP_WORDS[COMP_CWORD]#*=}"
-		regions="us-east-1 us-east-2 us-west-1 us-west-2 ca-central-1 eu-west-1 eu-central-1 eu-west-2 eu-west-3 ap-northeast-1 ap-northeast-2 ap-southeast-1 ap-southeast-2 ap-south-1 sa-east-1"
+		regions="us-east-1 us-east-2 us-west-1 us-west-2 ca-central-1 eu-west-1 eu-central-1 eu-west-2 eu-west-3 ap-northeast-1 ap-northeast-2 ap-southeast-1 ap-southeast-2 ap-south-1 sa-east-1 cn-north-1 cn-northwest-1 us-gov-west-1"
     profiles="$((egrep '^\[ *[a-zA-Z0-9_-]+ *\]$' ~/.aws/credentials 2>/dev/null; grep '\[profile' ~/.aws/config 2>/dev/null | sed 's|\[profile ||g') | tr -d '[]' | sort | uniq)"
     COMPREPLY=( $(compgen -W "${profiles} ${regions}" -- ${cur}) )
 }
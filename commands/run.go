@@ -19,6 +19,7 @@ package commands
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -37,6 +38,7 @@ import (
 
 	"github.com/chzyer/readline"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/wallix/awless-scheduler/client"
 	"github.com/wallix/awless/aws/doc"
 	"github.com/wallix/awless/aws/services"
@@ -49,6 +51,7 @@ import (
 	"github.com/wallix/awless/sync"
 	"github.com/wallix/awless/template"
 	"github.com/wallix/awless/template/params"
+	"github.com/wallix/awless/template/registry"
 )
 
 var (
@@ -58,38 +61,70 @@ var (
 	listRemoteTemplatesFlag bool
 	noSuggestedParamsFlag   bool
 	allSuggestedParamsFlag  bool
+	parallelismFlag         int
+	templateSHA256Flag      string
+	runOutputFormatFlag     string
+	bwlimitFlag             string
+	transferConnectionsFlag int
 )
 
+const defaultParallelism = 10
+
 func init() {
 	RootCmd.AddCommand(runCmd)
 	runCmd.Flags().BoolVar(&listRemoteTemplatesFlag, "list", false, "List templates available at https://github.com/wallix/awless-templates")
 	runCmd.Flags().StringVar(&scheduleRunInFlag, "run-in", "", "Postpone the execution of this template")
 	runCmd.Flags().StringVar(&scheduleRevertInFlag, "revert-in", "", "Schedule the revertion of this template")
 	runCmd.Flags().StringVarP(&runLogMessage, "message", "m", "", "Add a message for this template execution to be persisted in your logs")
+	runCmd.Flags().StringVar(&templateSHA256Flag, "sha256", "", "Only run the template if its content has this SHA256 checksum (hex), to pin remote templates")
+	runCmd.Flags().StringVar(&runOutputFormatFlag, "format", "", fmt.Sprintf("Print the result of the execution in a machine-readable format (%s), human logs going to stderr", strings.Join(template.ResultFormats, ", ")))
+	runCmd.Flags().IntVar(&parallelismFlag, "parallel", defaultParallelism, "Max number of independent deletes run concurrently (ex: in teardown templates). 1 to run sequentially")
+	runCmd.Flags().StringVar(&bwlimitFlag, "bwlimit", "", "Max bandwidth of the storage transfers, overridden by the bwlimit param of statements (ex: 5MB/s)")
+	runCmd.Flags().IntVar(&transferConnectionsFlag, "transfer-connections", 0, "Number of parts of a file transferred concurrently by storage transfers, overridden by the connections param of statements")
+	runCmd.Flags().BoolVar(&preflightFlag, "preflight", false, "Check with an IAM policy simulation that the current credentials can perform the AWS actions of all the statements before running them")
 
 	var actions []string
 	for a := range awsspec.DriverSupportedActions {
 		actions = append(actions, a)
 	}
+	actions = append(actions, "ensure")
 	sort.Strings(actions)
 
 	for _, action := range actions {
-		entities := awsspec.DriverSupportedActions[action]
+		entities := awsspec.DriverSupportedActions[template.DefinitionAction(action)]
 		sort.Strings(entities)
 		cmd := createDriverCommands(action, entities)
-		cmd.PersistentFlags().StringVar(&scheduleRunInFlag, "run-in", "", "Postpone the execution of this command")
-		cmd.PersistentFlags().StringVar(&scheduleRevertInFlag, "revert-in", "", "Schedule the revertion of this command")
+		if action == "run" {
+			// the run action shares its name with the command running templates:
+			// its entities (ex: awless run command ...) are subcommands of the latter
+			for _, entityCmd := range cmd.Commands() {
+				cmd.RemoveCommand(entityCmd)
+				addDriverCommandFlags(entityCmd.Flags())
+				runCmd.AddCommand(entityCmd)
+			}
+			continue
+		}
+		addDriverCommandFlags(cmd.PersistentFlags())
 		RootCmd.AddCommand(cmd)
 	}
 }
 
+func addDriverCommandFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&scheduleRunInFlag, "run-in", "", "Postpone the execution of this command")
+	flags.StringVar(&scheduleRevertInFlag, "revert-in", "", "Schedule the revertion of this command")
+	flags.StringVar(&runOutputFormatFlag, "format", "", fmt.Sprintf("Print the result of the execution in a machine-readable format (%s), human logs going to stderr", strings.Join(template.ResultFormats, ", ")))
+	flags.StringVar(&bwlimitFlag, "bwlimit", "", "Max bandwidth of the storage transfers (ex: 5MB/s)")
+	flags.IntVar(&transferConnectionsFlag, "transfer-connections", 0, "Number of parts of a file transferred concurrently by storage transfers")
+	flags.BoolVar(&preflightFlag, "preflight", false, "Check with an IAM policy simulation that the current credentials can perform the AWS actions of the command before running it")
+}
+
 const maxMsgLen = 140
 
 var runCmd = &cobra.Command{
 	Use:               "run PATH",
-	Short:             "Run a template given a filepath or URL",
-	Example:           "  awless run ~/templates/my-infra.aws\n  awless run https://raw.githubusercontent.com/wallix/awless-templates/master/create_vpc.aws\n  awless run repo:create_vpc",
-	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
+	Short:             "Run a template given a filepath, URL (http(s)://, s3://, git+), registry name (see awless template list) or '-' for stdin",
+	Example:           "  awless run ~/templates/my-infra.aws\n  awless run https://raw.githubusercontent.com/wallix/awless-templates/master/create_vpc.aws\n  awless run repo:create_vpc\n  awless run aws/create_instance@v2\n  awless run s3://my-bucket/templates/vpc.aws --sha256 9f86d0...\n  awless run git+https://github.com/me/infra.git//templates/vpc.aws?ref=v1.2\n  cat vpc.aws | awless run - cidr=10.0.0.0/16\n  awless run baseline.aws --matrix targets.yaml --matrix-parallel 4\n  awless run test-env.aws --stack test-env --ttl 8h\n  awless run test-env.aws --stack test-env --diff --prune",
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initPluginsHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
 	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
 
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -98,7 +133,7 @@ var runCmd = &cobra.Command{
 			return nil
 		}
 		if len(args) < 1 {
-			return errors.New("missing PATH arg (filepath or url)")
+			return errors.New("missing PATH arg (filepath, url or '-' for stdin)")
 		}
 
 		if len(runLogMessage) > maxMsgLen {
@@ -107,6 +142,15 @@ var runCmd = &cobra.Command{
 
 		content, fullPath, err := getTemplateText(args[0])
 		exitOn(err)
+		if noPromptFlag {
+			noTerminalForPrompts = true
+		}
+
+		if templateSHA256Flag != "" {
+			exitOn(verifyTemplateChecksum(args[0], content, templateSHA256Flag))
+		} else if isRemoteTemplatePath(args[0]) {
+			logger.Verbosef("Remote template sha256: %s (pin it with --sha256)", templateChecksum(content))
+		}
 
 		logger.Verbosef("Loaded template text:\n\n%s\n", removeComments(content))
 
@@ -116,6 +160,25 @@ var runCmd = &cobra.Command{
 		extraParams, err := template.ParseParams(strings.Join(args[1:], " "))
 		exitOn(err)
 
+		if runQueueFlag {
+			exitOn(enqueueTemplate(content, args[1:]))
+			return nil
+		}
+
+		if (runStackFlag != "" || runTTLFlag > 0) && isSchedulingMode() {
+			exitOn(errors.New("--stack and --ttl are not supported with --run-in and --revert-in"))
+		}
+		if (runStackDiffFlag || runStackPruneFlag) && runStackFlag == "" {
+			exitOn(errors.New("--diff and --prune require --stack"))
+		}
+
+		if runMatrixFlag != "" {
+			exitOn(runTemplateMatrix(args, content))
+			return nil
+		}
+
+		exitOn(checkRunStack(runStackFlag))
+
 		tplExec := &template.TemplateExecution{
 			Template: templ,
 			Path:     fullPath,
@@ -125,7 +188,12 @@ var runCmd = &cobra.Command{
 			Source:   templ.String(),
 		}
 
-		exitOn(NewRunnerRequiredParamsOnly(tplExec.Template, tplExec.Message, tplExec.Path, config.Defaults, extraParams).Run())
+		runner := NewRunnerRequiredParamsOnly(tplExec.Template, tplExec.Message, tplExec.Path, config.Defaults, extraParams)
+		if runStackFlag != "" || runTTLFlag > 0 {
+			recordInStack(runner, runStackFlag, runTTLFlag, false)
+		}
+		exitOn(convergeInStack(runner, runStackFlag, runStackDiffFlag, runStackPruneFlag))
+		exitOn(runTemplate(runner))
 
 		return nil
 	},
@@ -163,8 +231,12 @@ func missingHolesStdinFunc() func(string, []string, bool) string {
 			autocomplete = typedParamCompletionFunc(allGraphsOnce.mustLoad(), typedParam.ResourceType, typedParam.PropertyName)
 		}
 
+		var suggestions []resourceSuggestion
 		if len(enums) > 0 {
 			autocomplete = enumCompletionFunc(enums)
+		} else {
+			suggestions = holeResourceSuggestions(allGraphsOnce.mustLoad(), paramPaths, typedParam)
+			printResourceSuggestions(suggestions)
 		}
 
 		var promptSuffix string
@@ -178,11 +250,32 @@ func missingHolesStdinFunc() func(string, []string, bool) string {
 			}
 			logger.Error(err)
 		}
+		if selected, ok := selectSuggestion(response, suggestions); ok {
+			response = selected
+		}
 		count++
 		return
 	}
 }
 
+const maxPrintedSuggestions = 20
+
+func printResourceSuggestions(suggestions []resourceSuggestion) {
+	if len(suggestions) == 0 {
+		return
+	}
+	fmt.Fprintln(os.Stderr, "Existing resources (enter a number to select one):")
+	w := tabwriter.NewWriter(os.Stderr, 0, 0, 2, ' ', 0)
+	for i, s := range suggestions {
+		if i == maxPrintedSuggestions {
+			fmt.Fprintf(w, "  ...\t%d more (Tab for completion)\n", len(suggestions)-maxPrintedSuggestions)
+			break
+		}
+		fmt.Fprintf(w, "  %d)\t%s\t%s\t%s\n", i+1, s.ID, s.Name, s.CIDR)
+	}
+	w.Flush()
+}
+
 func askHole(hole, promptSuffix string, autocomplete readline.AutoCompleter) (string, error) {
 	l, err := readline.NewEx(&readline.Config{
 		Prompt:          renderCyanBoldFn(hole+"?") + renderYellowFn(promptSuffix) + " ",
@@ -199,7 +292,7 @@ func askHole(hole, promptSuffix string, autocomplete readline.AutoCompleter) (st
 		line, err := l.Readline()
 		if err == readline.ErrInterrupt {
 			if len(line) == 0 {
-				os.Exit(0)
+				exit(0)
 			} else {
 				continue
 			}
@@ -252,7 +345,7 @@ func createDriverCommands(action string, entities []string) *cobra.Command {
 				return invalidEntityErr
 			}
 
-			templDef, ok := awsspec.AWSLookupDefinitions(fmt.Sprintf("%s%s", action, resources[0].Type()))
+			templDef, ok := lookupDriverDefinition(action, resources[0].Type())
 			if !ok {
 				return invalidEntityErr
 			}
@@ -266,13 +359,13 @@ func createDriverCommands(action string, entities []string) *cobra.Command {
 				Source:   templ.String(),
 			}
 
-			exitOn(NewRunner(tplExec.Template, tplExec.Message, tplExec.Path).Run())
+			exitOn(runTemplate(NewRunner(tplExec.Template, tplExec.Message, tplExec.Path)))
 			return nil
 		},
 	}
 
 	for _, entity := range entities {
-		templDef, ok := awsspec.AWSLookupDefinitions(fmt.Sprintf("%s%s", action, entity))
+		templDef, ok := lookupDriverDefinition(action, entity)
 		if !ok {
 			exitOn(errors.New("command unsupported on inline mode"))
 		}
@@ -297,12 +390,12 @@ func createDriverCommands(action string, entities []string) *cobra.Command {
 					Source:   templ.String(),
 				}
 
-				exitOn(NewRunner(tplExec.Template, tplExec.Message, tplExec.Path, config.Defaults).Run())
+				exitOn(runTemplate(NewRunner(tplExec.Template, tplExec.Message, tplExec.Path, config.Defaults)))
 				return nil
 			}
 		}
 		var apiStr string
-		if api, ok := awsspec.APIPerTemplateDefName[templDef.Action+templDef.Entity]; ok {
+		if api, ok := awsspec.APIPerTemplateDefName[template.DefinitionAction(action)+templDef.Entity]; ok {
 			apiStr = fmt.Sprint(strings.ToUpper(api) + " ")
 		}
 
@@ -311,14 +404,14 @@ func createDriverCommands(action string, entities []string) *cobra.Command {
 		tab := tabwriter.NewWriter(&paramsStr, 0, 0, 3, '.', 0)
 		for _, p := range allParams {
 			fmt.Fprintf(tab, "  %s\t", p)
-			if d, ok := awsdoc.TemplateParamsDocWithEnums(templDef.Action, templDef.Entity, p); ok {
+			if d, ok := awsdoc.TemplateParamsDocWithEnums(template.DefinitionAction(action), templDef.Entity, p); ok {
 				fmt.Fprintf(tab, " %s", d)
 			}
 			fmt.Fprintln(tab)
 		}
 		for _, p := range optParams {
 			fmt.Fprintf(tab, "  [%s]\t", p)
-			if d, ok := awsdoc.TemplateParamsDocWithEnums(templDef.Action, templDef.Entity, p); ok {
+			if d, ok := awsdoc.TemplateParamsDocWithEnums(template.DefinitionAction(action), templDef.Entity, p); ok {
 				fmt.Fprintf(tab, " %s", d)
 			}
 			fmt.Fprintln(tab)
@@ -329,11 +422,15 @@ func createDriverCommands(action string, entities []string) *cobra.Command {
 		for _, param := range append(allParams, optParams...) {
 			validArgs = append(validArgs, param+"=")
 		}
+		shortDesc := fmt.Sprintf("%s a %s%s", strings.Title(action), apiStr, templDef.Entity)
+		if action == "ensure" {
+			shortDesc = fmt.Sprintf("Create a %s%s unless an existing one matches the given params", apiStr, templDef.Entity)
+		}
 		currentCmd := &cobra.Command{
 			Use:               fmt.Sprintf("%s [param=value ...]", templDef.Entity),
 			PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
 			PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
-			Short:             awsdoc.AwlessCommandDefinitionsDoc(action, templDef.Entity, fmt.Sprintf("%s a %s%s", strings.Title(action), apiStr, templDef.Entity)),
+			Short:             awsdoc.AwlessCommandDefinitionsDoc(action, templDef.Entity, shortDesc),
 			Long:              fmt.Sprintf("Params:\n%s\nParams patterns:\n  %s\n\nSee also:\n%s", paramsStr.String(), templDef.Params, availableActionsForEntity(templDef.Entity)),
 			Example:           awsdoc.AwlessExamplesDoc(action, templDef.Entity),
 			RunE:              run(templDef),
@@ -351,6 +448,14 @@ func createDriverCommands(action string, entities []string) *cobra.Command {
 	return actionCmd
 }
 
+// lookupDriverDefinition returns the definition of a one-liner command, an ensure
+// command having the definition of the create command of its entity
+func lookupDriverDefinition(action, entity string) (awsspec.Definition, bool) {
+	def, ok := awsspec.AWSLookupDefinitions(template.DefinitionAction(action) + entity)
+	def.Action = action
+	return def, ok
+}
+
 func runSyncFor(tplExec *template.TemplateExecution) {
 	if !config.GetAutosync() {
 		return
@@ -381,25 +486,13 @@ func resolveAliasFunc(paramPath, alias string) string {
 		logger.Errorf("resolve alias: invalid param path: %s", paramPath)
 		return ""
 	}
-	entity, key := splits[1], splits[2]
-	var typedParam *awsdoc.ParamType
-	if tparam, has := awsdoc.ParamTypeDoc[paramPath]; has {
-		typedParam = tparam
-	}
+	resType, typedParam := aliasResourceType(paramPath)
 
 	gph, err := sync.LoadLocalGraphs(config.GetAWSProfile(), config.GetAWSRegion())
 	if err != nil {
 		fmt.Printf("resolve alias '%s': cannot load local graphs for region %s: %s\n", alias, config.GetAWSRegion(), err)
 		return ""
 	}
-	resType := key
-	if typedParam != nil {
-		resType = typedParam.ResourceType
-	} else {
-		if strings.Contains(key, "id") {
-			resType = entity
-		}
-	}
 
 	resources, err := gph.Find(cloud.NewQuery(resType).Match(match.And(match.Property("Name", alias))))
 	if err != nil {
@@ -430,6 +523,122 @@ func resolveAliasFunc(paramPath, alias string) string {
 	return matchingResource.Id()
 }
 
+// resolveAliasQueryFunc resolves an alias query (ex: @{tag:Env=prod, public:false})
+// to the only locally synced resource matching it
+func resolveAliasQueryFunc(paramPath, query string) (string, error) {
+	if len(strings.Split(paramPath, ".")) != 3 {
+		return "", fmt.Errorf("invalid param path: %s", paramPath)
+	}
+	matcher, err := match.ParseQuery(query)
+	if err != nil {
+		return "", err
+	}
+	resType, typedParam := aliasResourceType(paramPath)
+
+	gph, err := sync.LoadLocalGraphs(config.GetAWSProfile(), config.GetAWSRegion())
+	if err != nil {
+		return "", fmt.Errorf("cannot load local graphs for region %s: %s", config.GetAWSRegion(), err)
+	}
+	resources, err := gph.Find(cloud.NewQuery(resType).Match(matcher))
+	if err != nil {
+		return "", err
+	}
+	switch len(resources) {
+	case 0:
+		return "", fmt.Errorf("no %s matches in locally synced data", resType)
+	case 1:
+		if typedParam != nil {
+			if prop, ok := resources[0].Properties()[typedParam.PropertyName].(string); ok {
+				return prop, nil
+			}
+		}
+		return resources[0].Id(), nil
+	default:
+		var ids []string
+		for _, res := range resources {
+			ids = append(ids, res.Id())
+		}
+		sort.Strings(ids)
+		return "", fmt.Errorf("ambiguous query: %d %s match (%s)", len(resources), cloud.PluralizeResource(resType), strings.Join(ids, ", "))
+	}
+}
+
+// aliasResourceType returns the type of the resources an alias of the given param path
+// (ex: create.instance.subnet) refers to, along with the doc of the param when typed
+func aliasResourceType(paramPath string) (string, *awsdoc.ParamType) {
+	splits := strings.Split(paramPath, ".")
+	entity, key := splits[1], splits[2]
+	if typedParam, has := awsdoc.ParamTypeDoc[paramPath]; has {
+		return typedParam.ResourceType, typedParam
+	}
+	if strings.Contains(key, "id") {
+		return entity, nil
+	}
+	return key, nil
+}
+
+// resolveAvailabilityZonesFunc returns the available zones of the current region,
+// from the locally synced infra or, when none is found, from AWS
+func resolveAvailabilityZonesFunc() ([]string, error) {
+	region := config.GetAWSRegion()
+	g := sync.LoadLocalGraphForService(awsservices.InfraService.Name(), config.GetAWSProfile(), region)
+	zones, err := availableZonesInGraph(g, region)
+	if err != nil || len(zones) > 0 {
+		return zones, err
+	}
+	if g, err = awsservices.InfraService.FetchByType(context.WithValue(context.Background(), "force", true), cloud.AvailabilityZone); err != nil {
+		return nil, err
+	}
+	return availableZonesInGraph(g, region)
+}
+
+func availableZonesInGraph(g cloud.GraphAPI, region string) ([]string, error) {
+	resources, err := g.Find(cloud.NewQuery(cloud.AvailabilityZone))
+	if err != nil {
+		return nil, err
+	}
+	var zones []string
+	for _, res := range resources {
+		if state, ok := res.Properties()[properties.State].(string); ok && state != "available" {
+			continue
+		}
+		if r, ok := res.Properties()[properties.Region].(string); ok && r != region {
+			continue
+		}
+		zones = append(zones, res.Id())
+	}
+	sort.Strings(zones)
+	return zones, nil
+}
+
+// fetchGraphForResourceType fetches from AWS the current resources of a type,
+// falling back on the locally synced ones when fetching fails
+func fetchGraphForResourceType(resourceType string) (cloud.GraphAPI, bool) {
+	srvName, ok := awsservices.ServicePerResourceType[resourceType]
+	if !ok {
+		return nil, false
+	}
+	srv, ok := cloud.ServiceRegistry[srvName]
+	if !ok {
+		return nil, false
+	}
+	g, err := srv.FetchByType(context.WithValue(context.Background(), "force", true), resourceType)
+	if err != nil {
+		logger.Warningf("cannot fetch %s, using locally synced data: %s", cloud.PluralizeResource(resourceType), err)
+		return sync.LoadLocalGraphForService(srvName, config.GetAWSProfile(), config.GetAWSRegion()), true
+	}
+	return g, true
+}
+
+// fetchResourcesOfType fetches the resources of a type from the cloud, as `awless list` does
+func fetchResourcesOfType(resourceType string) (cloud.GraphAPI, error) {
+	srv, err := cloud.GetServiceForType(resourceType)
+	if err != nil {
+		return nil, err
+	}
+	return srv.FetchByType(context.WithValue(context.Background(), "force", true), resourceType)
+}
+
 func availableActionsForEntity(entity string) string {
 	var out []string
 	for actionentity, _ := range awsspec.APIPerTemplateDefName {
@@ -472,9 +681,22 @@ func getTemplateText(path string) (content []byte, expanded string, err error) {
 
 	expanded = path
 
-	if strings.HasPrefix(path, "http") {
+	if path == stdinTemplatePath {
+		content, err = readStdinTemplate()
+	} else if strings.HasPrefix(path, "s3://") {
+		logger.ExtraVerbosef("fetching S3 template at '%s'", path)
+		content, err = readS3Template(path)
+	} else if strings.HasPrefix(path, "git+") {
+		logger.ExtraVerbosef("fetching git template at '%s'", path)
+		content, err = readGitTemplate(path)
+	} else if strings.HasPrefix(path, "http") {
 		logger.ExtraVerbosef("fetching remote template at '%s'", path)
 		content, err = readHttpContent(path)
+	} else if ref, isRef := registryRef(path); isRef {
+		var resolved registry.Ref
+		content, resolved, err = templateRegistry().Resolve(ref)
+		expanded = resolved.String()
+		logger.ExtraVerbosef("loaded template %s from registry", expanded)
 	} else {
 		f, ferr := os.Open(path)
 		if ferr != nil {
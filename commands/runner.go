@@ -1,10 +1,15 @@
 package commands
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
 	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/wallix/awless/aws/services"
 	"github.com/wallix/awless/aws/spec"
 	"github.com/wallix/awless/cloud"
@@ -33,7 +38,31 @@ func NewRunner(tpl *template.Template, msg, tplPath string, fillers ...map[strin
 	runner.TemplatePath = tplPath
 	runner.Fillers = fillers
 	runner.AliasFunc = resolveAliasFunc
+	runner.AliasQueryFunc = resolveAliasQueryFunc
+	runner.AvailabilityZonesFunc = resolveAvailabilityZonesFunc
+	runner.StackRefFunc = resolveStackRefFunc
+	runner.LookupGraph = fetchGraphForResourceType
+	runner.FetchGraph = fetchResourcesOfType
 	runner.MissingHolesFunc = missingHolesStdinFunc()
+	if noTerminalForPrompts {
+		runner.MissingHolesFunc = missingHolesNonInteractiveFunc()
+	}
+	runner.Parallelism = parallelismFlag
+	if bwlimitFlag != "" {
+		bandwidth, err := awsspec.ParseBandwidth(bwlimitFlag)
+		exitOn(err)
+		awsspec.DefaultTransferLimits.Bandwidth = bandwidth
+	}
+	awsspec.DefaultTransferLimits.Connections = transferConnectionsFlag
+	runner.Observer = logRunObserver{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runner.Context = ctx
+	if f, ok := awsspec.CommandFactory.(*awsspec.AWSFactory); ok {
+		awsspec.CancelRequestsWithContext(f.Sess, ctx)
+		runner.Middlewares = append(runner.Middlewares, awsspec.ResolveSSMParameters(ssm.New(f.Sess)))
+	}
+	stopInterrupts := func() {}
 	if allSuggestedParamsFlag {
 		runner.ParamsSuggested = env.ALL_PARAMS
 	}
@@ -49,24 +78,38 @@ func NewRunner(tpl *template.Template, msg, tplPath string, fillers ...map[strin
 		&template.ParamIsSetValidator{Action: "create", Entity: "instance", Param: "keypair", WarningMessage: "This instance has no access keypair. You might not be able to connect to it. Use `awless create instance keypair=my-keypair ...`"},
 	}
 
-	runner.CmdLookuper = func(tokens ...string) interface{} {
-		newCommandFunc := awsspec.CommandFactory.Build(strings.Join(tokens, ""))
-		if newCommandFunc == nil {
-			return nil
+	runner.CmdLookuper = driverMux.Lookup
+	runner.AccountCmdLookuper = awsservices.CommandInAccount
+	if preflightFlag {
+		runner.Preflight = func(tpl *template.Template) error {
+			return preflightPermissions(tpl, awsservices.AccessService.(*awsservices.Access).SimulateActions)
 		}
-		return newCommandFunc()
+	}
+
+	// with a machine-readable output, stdout only receives the execution result
+	var out io.Writer = os.Stdout
+	if runOutputFormatFlag != "" {
+		out = os.Stderr
 	}
 
 	runner.BeforeRun = func(tplExec *template.TemplateExecution) (bool, error) {
+		if runOutputFormatFlag != "" {
+			if err := template.ValidateResultFormat(runOutputFormatFlag); err != nil {
+				return false, err
+			}
+		}
 		var yesorno string
 		if forceGlobalFlag {
 			yesorno = "y"
+		} else if noTerminalForPrompts {
+			return false, fmt.Errorf("cannot confirm: template read from stdin without terminal, use --force")
 		} else {
-			fmt.Printf("%s\n\n", renderGreenFn(tplExec.Template))
+			fmt.Fprintf(out, "%s\n\n", renderGreenFn(tplExec.Template))
+			printParamsChangedSinceLastRun(out, tplExec.Template)
 			if isSchedulingMode() {
-				fmt.Printf("Confirm scheduling (region: %s)? [y/N] ", config.GetAWSRegion())
+				fmt.Fprintf(out, "Confirm scheduling (region: %s)? [y/N] ", config.GetAWSRegion())
 			} else {
-				fmt.Printf("Confirm (region: %s)? [y/N] ", config.GetAWSRegion())
+				fmt.Fprintf(out, "Confirm (region: %s)? [y/N] ", config.GetAWSRegion())
 			}
 			if _, err := fmt.Scanln(&yesorno); err != nil && err.Error() != "unexpected newline" {
 				return false, err
@@ -84,13 +127,15 @@ func NewRunner(tpl *template.Template, msg, tplPath string, fillers ...map[strin
 			if isSchedulingMode() {
 				return false, scheduleTemplate(tplExec.Template, scheduleRunInFlag, scheduleRevertInFlag)
 			}
+			stopInterrupts = cancelOnInterrupt(cancel)
 			return true, nil
 		}
-		os.Exit(1)
+		exit(1)
 		return false, nil
 	}
 
 	runner.AfterRun = func(tplExec *template.TemplateExecution) error {
+		stopInterrupts()
 		if tplExec.Message == "" {
 			if tplExec.IsOneLiner() {
 				tplExec.SetMessage(fmt.Sprintf("Run %s", tplExec.Template))
@@ -111,14 +156,124 @@ func NewRunner(tpl *template.Template, msg, tplPath string, fillers ...map[strin
 		}
 
 		if template.IsRevertible(tplExec.Template) {
-			fmt.Println()
+			fmt.Fprintln(out)
 			logger.Infof("Revert this template with `awless revert %s`", tplExec.Template.ID)
 		}
 
 		runSyncFor(tplExec)
 
+		if runOutputFormatFlag != "" {
+			b, err := tplExec.Result().Marshal(runOutputFormatFlag)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(os.Stdout, strings.TrimSpace(string(b)))
+		}
+
 		return nil
 	}
 
 	return runner
 }
+
+// runTemplate executes the template of the runner, exiting when some of its commands failed
+func runTemplate(runner *template.Runner) error {
+	tplExec, err := runner.Execute()
+	if err != nil {
+		return err
+	}
+	if tplExec.Stats().KOCount > 0 {
+		exit(1)
+	}
+	return nil
+}
+
+// printParamsChangedSinceLastRun shows, when the template was already run with other params,
+// the params changed since its last run (ex: instance.type: t2.micro → m4.large)
+func printParamsChangedSinceLastRun(w io.Writer, tpl *template.Template) {
+	last, err := lastRunOf(tpl)
+	if err != nil {
+		logger.ExtraVerbosef("cannot load previous runs of template: %s", err)
+		return
+	}
+	if last == nil {
+		return
+	}
+	changes, err := tpl.DiffParams(last.Template)
+	if err != nil || len(changes) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "Params changed since the last run of this template (%s, %s):\n", last.ID, last.Date().Format(time.Stamp))
+	for _, c := range changes {
+		fmt.Fprintf(w, "\t%s\n", renderYellowFn(c))
+	}
+	fmt.Fprintln(w)
+}
+
+// lastRunOf returns the most recent execution, stack teardowns excluded, of a template
+// with the same hash, or nil if it has never been run
+func lastRunOf(tpl *template.Template) (*template.TemplateExecution, error) {
+	hash := tpl.Hash()
+	var last *template.TemplateExecution
+	err := database.Execute(func(db *database.DB) error {
+		loaded, err := db.ListTemplates()
+		if err != nil {
+			return err
+		}
+		for _, lt := range loaded {
+			if lt.Err != nil || lt.TplExec.Teardown || len(lt.TplExec.CommandNodesIterator()) == 0 {
+				continue
+			}
+			if lt.TplExec.Hash() == hash && (last == nil || lt.TplExec.ID > last.ID) {
+				last = lt.TplExec
+			}
+		}
+		return nil
+	})
+	return last, err
+}
+
+// cancelOnInterrupt cancels the running template on Ctrl-C so that its remaining
+// statements are skipped, and exits on a second Ctrl-C
+func cancelOnInterrupt(cancel context.CancelFunc) (stop func()) {
+	sigs := make(chan os.Signal, 1)
+	done := make(chan struct{})
+	signal.Notify(sigs, os.Interrupt)
+	go func() {
+		select {
+		case <-sigs:
+			logger.Warning("interrupting run: waiting for the running statements, remaining ones are skipped (Ctrl-C again to exit now)")
+			cancel()
+		case <-done:
+			return
+		}
+		select {
+		case <-sigs:
+			exit(1)
+		case <-done:
+		}
+	}()
+	return func() {
+		signal.Stop(sigs)
+		close(done)
+	}
+}
+
+// logRunObserver reports the progress of a running template in extra verbose mode
+type logRunObserver struct{}
+
+func (logRunObserver) OnStatementStart(st env.Statement) {
+	if st.Origin != "" {
+		logger.ExtraVerbosef("[%d/%d] running %s (from %s)", st.Index, st.Total, st.Line, st.Origin)
+		return
+	}
+	logger.ExtraVerbosef("[%d/%d] running %s", st.Index, st.Total, st.Line)
+}
+
+func (logRunObserver) OnStatementDone(st env.Statement) {
+	logger.ExtraVerbosef("[%d/%d] %s %s done in %s", st.Index, st.Total, st.Action, st.Entity, st.Duration.Round(time.Millisecond))
+}
+
+func (logRunObserver) OnRetry(st env.Statement, attempt int, reason error) {
+	logger.ExtraVerbosef("[%d/%d] %s %s: attempt %d: %s", st.Index, st.Total, st.Action, st.Entity, attempt, reason)
+}
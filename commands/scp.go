@@ -0,0 +1,123 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var scpRecursiveFlag bool
+
+func init() {
+	RootCmd.AddCommand(scpCmd)
+	scpCmd.Flags().BoolVarP(&scpRecursiveFlag, "recursive", "R", false, "Recursively copy entire directories (-r is the --aws-region flag)")
+	scpCmd.Flags().StringVarP(&keyPathFlag, "identity", "i", "", "Set path or name toward the identity (key file) to use to connect through SSH")
+	scpCmd.Flags().IntVar(&sshPortFlag, "port", 22, "Set SSH target port")
+	scpCmd.Flags().IntVar(&sshTroughPortFlag, "through-port", 22, "Set SSH proxy port")
+	scpCmd.Flags().StringVar(&proxyInstanceThroughFlag, "through", "", "Name of instance to proxy through to connect to a destination host, or 'auto' to use a public instance of the destination VPC")
+	scpCmd.Flags().BoolVar(&instanceConnectFlag, "instance-connect", false, "Push an ephemeral key with EC2 Instance Connect to connect (default for instances without key pair)")
+	scpCmd.Flags().BoolVar(&printSSHCLIFlag, "print-cli", false, "Print the CLI one-liner to copy the files with scp. (/usr/bin/scp ... user@ip:path)")
+	scpCmd.Flags().BoolVar(&privateIPFlag, "private", false, "Use private ip to connect to host")
+	scpCmd.Flags().BoolVar(&disableStrictHostKeyCheckingFlag, "disable-strict-host-keychecking", false, "Disable the remote host key check from ~/.ssh/known_hosts or ~/.awless/known_hosts file")
+}
+
+var scpCmd = &cobra.Command{
+	Use:   "scp SOURCE... DESTINATION",
+	Short: "Copy files to or from an instance given an id or alias, with the local scp client",
+	Long:  "Copy files to or from an instance given an id or alias, with the local scp client. The remote paths are given as [USER@]INSTANCE:PATH and all connection details are derived from the instance, as with `awless ssh`.",
+	Example: `  awless scp ./app.tar.gz redis-prod:/tmp/                # upload a file to an instance given its name
+  awless scp ubuntu@i-8d43b21b:/var/log/syslog .           # download a file, forcing the user
+  awless scp -R ./site redis-prod:/var/www/                # upload a directory
+  awless scp -R db-private:/backups ./ --through auto      # download a directory through the bastion of the VPC
+  awless scp app.conf db-private:/etc/app/ --through my-bastion -i keyname
+  awless scp app.conf redis-prod:/tmp/ --print-cli         # print out the scp command line`,
+
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 2 {
+			return errors.New("expecting one or more SOURCE and a DESTINATION")
+		}
+
+		userhost, err := scpRemoteInstance(args)
+		exitOn(err)
+
+		client, _ := dialInstance(userhost)
+
+		var paths []string
+		for _, arg := range args {
+			if _, path, ok := splitSCPRemotePath(arg); ok {
+				arg = client.RemotePath(path)
+			}
+			paths = append(paths, arg)
+		}
+
+		if printSSHCLIFlag {
+			fmt.Println(client.CopyString(scpRecursiveFlag, paths...))
+			return nil
+		}
+
+		exitOn(client.Copy(scpRecursiveFlag, paths...))
+		return nil
+	},
+}
+
+// scpRemoteInstance returns the [USER@]INSTANCE of the remote paths of the copy:
+// either the destination or the sources are on a single instance
+func scpRemoteInstance(args []string) (string, error) {
+	sources, destination := args[:len(args)-1], args[len(args)-1]
+	var remoteSources []string
+	for _, src := range sources {
+		if userhost, _, ok := splitSCPRemotePath(src); ok {
+			remoteSources = append(remoteSources, userhost)
+		}
+	}
+
+	if userhost, _, ok := splitSCPRemotePath(destination); ok {
+		if len(remoteSources) > 0 {
+			return "", errors.New("copies between remote paths are not supported")
+		}
+		return userhost, nil
+	}
+	if len(remoteSources) == 0 {
+		return "", errors.New("no remote path: expecting [USER@]INSTANCE:PATH as sources or destination")
+	}
+	if len(remoteSources) != len(sources) {
+		return "", errors.New("cannot mix local and remote sources")
+	}
+	for _, userhost := range remoteSources[1:] {
+		if userhost != remoteSources[0] {
+			return "", fmt.Errorf("copies from several instances are not supported (%s and %s)", remoteSources[0], userhost)
+		}
+	}
+	return remoteSources[0], nil
+}
+
+// splitSCPRemotePath splits a [USER@]INSTANCE:PATH argument, a local path having
+// no colon or a slash before its first colon (ex: ./file:1)
+func splitSCPRemotePath(arg string) (string, string, bool) {
+	i := strings.Index(arg, ":")
+	if i <= 0 || strings.Contains(arg[:i], "/") {
+		return "", "", false
+	}
+	return arg[:i], arg[i+1:], true
+}
@@ -0,0 +1,36 @@
+package commands
+
+import "testing"
+
+func TestScpRemoteInstance(t *testing.T) {
+	tcases := []struct {
+		args   []string
+		exp    string
+		expErr bool
+	}{
+		{args: []string{"./app.tar.gz", "redis-prod:/tmp/"}, exp: "redis-prod"},
+		{args: []string{"a.txt", "b.txt", "ubuntu@i-1234:"}, exp: "ubuntu@i-1234"},
+		{args: []string{"ubuntu@i-1234:/var/log/syslog", "."}, exp: "ubuntu@i-1234"},
+		{args: []string{"web:/etc/hosts", "web:/etc/hostname", "./conf/"}, exp: "web"},
+		{args: []string{"./dir:1/file", "web:/tmp"}, exp: "web"},
+		{args: []string{"a.txt", "b.txt"}, expErr: true},
+		{args: []string{"web:/tmp/a", "db:/tmp/"}, expErr: true},
+		{args: []string{"web:/tmp/a", "db:/tmp/b", "."}, expErr: true},
+		{args: []string{"web:/tmp/a", "./local", "."}, expErr: true},
+	}
+	for _, tcase := range tcases {
+		got, err := scpRemoteInstance(tcase.args)
+		if tcase.expErr {
+			if err == nil {
+				t.Fatalf("%v: expected error, got %s", tcase.args, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%v: %s", tcase.args, err)
+		}
+		if got != tcase.exp {
+			t.Fatalf("%v: got %s, want %s", tcase.args, got, tcase.exp)
+		}
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"os"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
@@ -32,6 +33,7 @@ import (
 	"github.com/wallix/awless/cloud/rdf"
 	"github.com/wallix/awless/config"
 	"github.com/wallix/awless/console"
+	"github.com/wallix/awless/graph"
 	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/sync"
 )
@@ -70,9 +72,11 @@ var showCmd = &cobra.Command{
 		if _, err := awsconfig.ParseRegion(ref); err == nil && ref != config.GetAWSRegion() {
 			logger.Errorf("Cannot show region '%s' as you are in region '%s'", ref, config.GetAWSRegion())
 			logger.Infof("Use `awless show %s -r %s`", ref, ref)
-			os.Exit(1)
+			exit(1)
 		}
 
+		freshenLocalData()
+
 		var resource cloud.Resource
 		var gph cloud.GraphAPI
 
@@ -206,16 +210,113 @@ func showResource(resource cloud.Resource, gph cloud.GraphAPI) {
 	appliedOn, err := gph.ResourceRelations(resource, rdf.ApplyOn, false)
 	exitOn(err)
 	printResourceList(renderCyanBoldFn("Applied on"), appliedOn)
+	if resource.Type() == cloud.Subnet {
+		printNetworkACLRules(appliedOn)
+	}
 
 	dependingOn, err := gph.ResourceRelations(resource, rdf.DependingOnRel, false)
 	exitOn(err)
-	printResourceList(renderCyanBoldFn("Depending on"), dependingOn)
+	var events []cloud.Resource
+	var others []cloud.Resource
+	for _, r := range dependingOn {
+		if r.Type() == cloud.TrailEvent {
+			events = append(events, r)
+		} else {
+			others = append(others, r)
+		}
+	}
+	printResourceList(renderCyanBoldFn("Depending on"), others)
+	printTrailEvents(renderCyanBoldFn("Activity"), events)
+	if resource.Type() == cloud.Instance {
+		warnIfNotReserved(resource, gph)
+	}
+
+	stacks, err := gph.ResourceRelations(resource, rdf.MemberOfStack, false)
+	exitOn(err)
+	printResourceList(renderCyanBoldFn("Member of stack"), stacks)
+
+	stackMembers, err := gph.ResourceRelations(resource, rdf.StackMembersRel, false)
+	exitOn(err)
+	printResourceList(renderCyanBoldFn("Stack resources"), stackMembers)
 
 	siblings, err := gph.ResourceSiblings(resource)
 	exitOn(err)
 	printResourceList(renderCyanBoldFn("Siblings"), siblings, "display all with flag --siblings")
 }
 
+// warnIfNotReserved warns when a running on-demand instance is not covered by any of the synced reservations
+func warnIfNotReserved(instance cloud.Resource, gph cloud.GraphAPI) {
+	if sync, ok := config.Get("aws.infra.reservation.sync"); ok && sync == false {
+		return
+	}
+	if notReserved, err := isNotReserved(instance, gph); err != nil || !notReserved {
+		return
+	}
+	fmt.Println()
+	logger.Warningf("instance %s is not covered by any reservation (see `awless list reservations`)", instance.Id())
+}
+
+// isNotReserved returns whether a running on-demand instance is not covered by a reservation,
+// never when no reservation has been synced. Reservations apply on the instances they cover
+func isNotReserved(instance cloud.Resource, gph cloud.GraphAPI) (bool, error) {
+	if state, _ := instance.Property(properties.State); state != "running" {
+		return false, nil
+	}
+	if lifecycle, ok := instance.Property(properties.Lifecycle); ok && lifecycle != "" {
+		return false, nil
+	}
+	reservations, err := gph.Find(cloud.NewQuery(cloud.Reservation))
+	if err != nil || len(reservations) == 0 {
+		return false, err
+	}
+	dependingOn, err := gph.ResourceRelations(instance, rdf.DependingOnRel, false)
+	if err != nil {
+		return false, err
+	}
+	for _, r := range dependingOn {
+		if r.Type() == cloud.Reservation {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// printNetworkACLRules displays the rules of the network ACL associated with a subnet, in their evaluation order
+func printNetworkACLRules(appliedOn []cloud.Resource) {
+	for _, acl := range appliedOn {
+		if acl.Type() != cloud.NetworkACL {
+			continue
+		}
+		for _, direction := range []struct{ title, prop string }{{"Inbound", properties.InboundACLRules}, {"Outbound", properties.OutboundACLRules}} {
+			rules, _ := acl.Properties()[direction.prop].([]*graph.NetworkACLRule)
+			if len(rules) == 0 {
+				continue
+			}
+			graph.NetworkACLRules(rules).Sort()
+			fmt.Printf("\n%s:\n", renderCyanBoldFn(fmt.Sprintf("%s rules (%s)", direction.title, printResourceRef(acl))))
+			for _, r := range rules {
+				action := renderGreenFn(fmt.Sprintf("%-5s", r.Action))
+				if r.Action == "deny" {
+					action = renderRedFn(fmt.Sprintf("%-5s", r.Action))
+				}
+				var ipRange string
+				if r.IPRange != nil {
+					ipRange = r.IPRange.String()
+				}
+				ports := r.Protocol
+				if !r.PortRange.Any && r.Protocol != "any" {
+					if r.PortRange.FromPort == r.PortRange.ToPort {
+						ports = fmt.Sprintf("%s:%d", r.Protocol, r.PortRange.FromPort)
+					} else {
+						ports = fmt.Sprintf("%s:%d-%d", r.Protocol, r.PortRange.FromPort, r.PortRange.ToPort)
+					}
+				}
+				fmt.Printf("\t%-5d  %s  %-15s  %s\n", r.Number, action, ports, ipRange)
+			}
+		}
+	}
+}
+
 func runFullSync() {
 	if !config.GetAutosync() {
 		logger.Info("autosync disabled")
@@ -253,7 +354,7 @@ func findResourceInLocalGraphs(ref string) (cloud.Resource, cloud.GraphAPI) {
 			logger.Infof(buf.String())
 		}
 
-		os.Exit(0)
+		exit(0)
 	}
 
 	return nil, nil
@@ -336,6 +437,24 @@ func printResourceList(title string, list []cloud.Resource, shortenListMsg ...st
 	}
 }
 
+// printTrailEvents displays the synced CloudTrail events of a resource, most recent first
+func printTrailEvents(title string, events []cloud.Resource) {
+	if len(events) == 0 {
+		return
+	}
+	sort.Slice(events, func(i, j int) bool {
+		ti, _ := events[i].Properties()[properties.Created].(time.Time)
+		tj, _ := events[j].Properties()[properties.Created].(time.Time)
+		return ti.After(tj)
+	})
+	fmt.Printf("\n%s:\n", title)
+	for _, e := range events {
+		props := e.Properties()
+		created, _ := props[properties.Created].(time.Time)
+		fmt.Printf("\t%s  %s %s (%s:%s)\n", created.Local().Format("2006-01-02 15:04:05"), props[properties.Username], props[properties.Type], props[properties.Source], props[properties.Name])
+	}
+}
+
 func printResourceRef(r cloud.Resource, idRenderFunc ...func(a ...interface{}) string) string {
 	render := fmt.Sprint
 	if len(idRenderFunc) > 0 {
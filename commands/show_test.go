@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestIsNotReserved(t *testing.T) {
+	covered := resourcetest.Instance("inst_1").Prop(properties.State, "running").Build()
+	uncovered := resourcetest.Instance("inst_2").Prop(properties.State, "running").Build()
+	stopped := resourcetest.Instance("inst_3").Prop(properties.State, "stopped").Build()
+	spot := resourcetest.Instance("inst_4").Prop(properties.State, "running").Prop(properties.Lifecycle, "spot").Build()
+	reservation := resourcetest.Reservation("ri_1").Build()
+
+	g := graph.NewGraph()
+	g.AddResource(covered, uncovered, stopped, spot, reservation)
+	if err := g.AddAppliesOnRelation(reservation, covered); err != nil {
+		t.Fatal(err)
+	}
+
+	tcases := []struct {
+		instance *graph.Resource
+		exp      bool
+	}{
+		{instance: covered, exp: false},
+		{instance: uncovered, exp: true},
+		{instance: stopped, exp: false},
+		{instance: spot, exp: false},
+	}
+	for i, tcase := range tcases {
+		notReserved, err := isNotReserved(tcase.instance, g)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := notReserved, tcase.exp; got != want {
+			t.Fatalf("%d: got %t, want %t", i+1, got, want)
+		}
+	}
+
+	noReservations := graph.NewGraph()
+	noReservations.AddResource(uncovered)
+	if notReserved, err := isNotReserved(uncovered, noReservations); err != nil || notReserved {
+		t.Fatalf("got %t (%v), want no warning when no reservation is synced", notReserved, err)
+	}
+}
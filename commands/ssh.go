@@ -18,17 +18,29 @@ package commands
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"os"
+	"os/exec"
+	"os/signal"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/config"
+	"github.com/wallix/awless/aws/ec2instanceconnect"
 	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/aws/sessionmanager"
+	"github.com/wallix/awless/aws/spec"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/cloud/match"
 	"github.com/wallix/awless/cloud/properties"
@@ -37,6 +49,7 @@ import (
 	"github.com/wallix/awless/graph"
 	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/ssh"
+	awlesssync "github.com/wallix/awless/sync"
 )
 
 var keyPathFlag, proxyInstanceThroughFlag string
@@ -45,17 +58,34 @@ var printSSHConfigFlag bool
 var printSSHCLIFlag bool
 var privateIPFlag bool
 var disableStrictHostKeyCheckingFlag bool
+var ssmSessionFlag bool
+var forwardAgentFlag bool
+var localForwardsFlag []string
+var dynamicForwardFlag string
+var instanceConnectFlag bool
+
+// autoBastion is the --through value resolving the bastion from the instances of the destination VPC
+const autoBastion = "auto"
+
+// instanceConnectKeyFile is the file, in the awless keys directory, of the last ephemeral key
+// pushed with EC2 Instance Connect (the instances accept it for 60 seconds)
+const instanceConnectKeyFile = "ec2-instance-connect.pem"
 
 func init() {
 	RootCmd.AddCommand(sshCmd)
 	sshCmd.Flags().StringVarP(&keyPathFlag, "identity", "i", "", "Set path or name toward the identity (key file) to use to connect through SSH")
 	sshCmd.Flags().IntVar(&sshPortFlag, "port", 22, "Set SSH target port")
 	sshCmd.Flags().IntVar(&sshTroughPortFlag, "through-port", 22, "Set SSH proxy port")
-	sshCmd.Flags().StringVar(&proxyInstanceThroughFlag, "through", "", "Name of instance to proxy through to connect to a destination host")
+	sshCmd.Flags().StringVar(&proxyInstanceThroughFlag, "through", "", "Name of instance to proxy through to connect to a destination host, or 'auto' to use a public instance of the destination VPC")
+	sshCmd.Flags().BoolVarP(&forwardAgentFlag, "forward-agent", "A", false, "Forward the SSH agent to the destination host (ex: to connect further from a bastion)")
+	sshCmd.Flags().StringArrayVarP(&localForwardsFlag, "local-forward", "L", nil, "Forward a local port to a remote host and port through the instance: [bind_address:]port:host:hostport, the remote host being possibly a database, cachecluster or instance reference (ex: 5432:@mydb)")
+	sshCmd.Flags().StringVarP(&dynamicForwardFlag, "dynamic-forward", "D", "", "Open a local SOCKS proxy on the given [bind_address:]port tunneling through the instance")
 	sshCmd.Flags().BoolVar(&printSSHConfigFlag, "print-config", false, "Print SSH configuration for ~/.ssh/config file.")
 	sshCmd.Flags().BoolVar(&printSSHCLIFlag, "print-cli", false, "Print the CLI one-liner to connect with SSH. (/usr/bin/ssh user@ip -i ...)")
 	sshCmd.Flags().BoolVar(&privateIPFlag, "private", false, "Use private ip to connect to host")
 	sshCmd.Flags().BoolVar(&disableStrictHostKeyCheckingFlag, "disable-strict-host-keychecking", false, "Disable the remote host key check from ~/.ssh/known_hosts or ~/.awless/known_hosts file")
+	sshCmd.Flags().BoolVar(&instanceConnectFlag, "instance-connect", false, "Push an ephemeral key with EC2 Instance Connect to connect (default for instances without key pair)")
+	sshCmd.Flags().BoolVar(&ssmSessionFlag, "ssm", false, "Open a shell session through AWS Systems Manager Session Manager (no public IP, open port or key needed; requires the session-manager-plugin)")
 }
 
 var defaultAMIUsers = []string{"ec2-user", "ubuntu", "centos", "bitnami", "admin", "root"}
@@ -72,10 +102,23 @@ var sshCmd = &cobra.Command{
 
   awless ssh redis-prod -i keyname            # using AWS keyname (look into ~/.ssh/keyname.pem & ~/.awless/keys/keyname.pem)
   awless ssh redis-prod -i ~/path/toward/key  # specifying a full key path
+  awless ssh redis-prod --instance-connect    # push an ephemeral key with EC2 Instance Connect (default when the instance has no key pair)
 
   awless ssh db-private --through my-bastion  # connect to a private inst through a public one
+  awless ssh db-private --through auto        # connect through the bastion (public instance) of the VPC of db-private
+  awless ssh my-bastion -A                    # forward your SSH agent to connect further from the instance
+
+  awless ssh my-bastion -L 5432:db.internal:5432  # forward local port 5432 to db.internal:5432 through the instance
+  awless ssh my-bastion -L @mydb                  # forward the port of the database mydb to its endpoint (host and port from the local graph)
+  awless ssh my-bastion -L 16379:@my-redis        # forward local port 16379 to the endpoint of the cachecluster my-redis
+  awless ssh my-bastion -D 1080                   # open a SOCKS proxy on local port 1080
   awless ssh db-private --private             # connect using the private IP (when you have a VPN, tunnel, etc ...)
 
+  awless ssh --ssm i-8d43b21b                 # open a session through SSM Session Manager (no public IP or key needed)
+
+  awless ssh --on tag:Role=web -- 'uptime'                     # run a command on all the running instances tagged Role=web
+  awless ssh --on tag:Env=prod,type:t2.micro --parallel 5 -- df -h  # run on at most 5 instances at a time
+
   awless ssh redis-prod --print-cli           # print out the full terminal command to connect to instance
   awless ssh redis-prod --print-config        # print out the full SSH config (i.e: ~/.ssh/config) to connect to instance
   
@@ -87,104 +130,457 @@ var sshCmd = &cobra.Command{
 	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
 
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if sshOnFlag != "" {
+			return runOnInstances(sshOnFlag, args)
+		}
 		if len(args) != 1 {
 			return fmt.Errorf("instance required")
 		}
 
-		var err error
-		var connectionCtx *instanceConnectionContext
-
-		if proxyInstanceThroughFlag != "" {
-			connectionCtx, err = initInstanceConnectionContext(proxyInstanceThroughFlag, keyPathFlag)
-		} else {
-			connectionCtx, err = initInstanceConnectionContext(args[0], keyPathFlag)
+		if ssmSessionFlag {
+			if proxyInstanceThroughFlag != "" || privateIPFlag {
+				return errors.New("--ssm cannot be used with --through or --private")
+			}
+			if len(localForwardsFlag) > 0 || dynamicForwardFlag != "" {
+				return errors.New("--ssm cannot be used with port forwarding")
+			}
+			connectionCtx, err := initInstanceConnectionContext(args[0], "")
+			exitOn(err)
+			if printSSHCLIFlag {
+				fmt.Printf("aws ssm start-session --target %s --region %s\n", connectionCtx.instance.Id(), config.GetAWSRegion())
+				return nil
+			}
+			exitOn(startSSMSession(connectionCtx))
+			return nil
 		}
-		exitOn(err)
 
-		firsHopClient, err := ssh.InitClient(connectionCtx.keypath, config.KeysDir, filepath.Join(os.Getenv("HOME"), ".ssh"))
+		localForwards, err := resolveLocalForwards(localForwardsFlag)
 		exitOn(err)
 
-		if err != nil && strings.Contains(err.Error(), "cannot find SSH key") && keyPathFlag == "" {
-			logger.Info("you may want to specify a key filepath with `-i /path/to/key.pem`")
+		targetClient, connectionCtx := dialInstance(args[0])
+		targetClient.ForwardAgent = forwardAgentFlag
+		targetClient.LocalForwards = localForwards
+		targetClient.DynamicForward = dynamicForwardFlag
+
+		if printSSHConfigFlag {
+			host := connectionCtx.instanceName
+			if proxyInstanceThroughFlag != "" {
+				host = args[0]
+			}
+			fmt.Println(targetClient.SSHConfigString(host))
+			return nil
 		}
+
+		if printSSHCLIFlag {
+			fmt.Println(targetClient.ConnectString())
+			return nil
+		}
+
+		exitOn(targetClient.Connect())
+		return nil
+	},
+}
+
+// startSSMSession opens a shell session on the instance through SSM Session Manager.
+// The StartSession call is made by awless, the session itself being handed over
+// to the session-manager-plugin, as done by the AWS CLI
+func startSSMSession(ctx *instanceConnectionContext) error {
+	if st := ctx.state; st != "running" {
+		return fmt.Errorf("instance %s is '%s': cannot open a session on a non running instance", ctx.instance.Id(), st)
+	}
+	plugin, err := exec.LookPath("session-manager-plugin")
+	if err != nil {
+		logger.Info("install it from https://docs.aws.amazon.com/systems-manager/latest/userguide/session-manager-working-with-install-plugin.html")
+		return errors.New("session-manager-plugin not found in PATH")
+	}
+	factory, ok := awsspec.CommandFactory.(*awsspec.AWSFactory)
+	if !ok || factory.Sess == nil {
+		return fmt.Errorf("no AWS session to connect to instance %s", ctx.instance.Id())
+	}
+
+	api := sessionmanager.New(factory.Sess)
+	output, err := api.StartSession(&sessionmanager.StartSessionInput{Target: awssdk.String(ctx.instance.Id())})
+	if err != nil {
+		return fmt.Errorf("start session on instance %s: %s", ctx.instance.Id(), err)
+	}
+	logger.Verbosef("SSM session %s started on instance %s", awssdk.StringValue(output.SessionId), ctx.instance.Id())
+
+	session, err := json.Marshal(map[string]string{
+		"SessionId":  awssdk.StringValue(output.SessionId),
+		"StreamUrl":  awssdk.StringValue(output.StreamUrl),
+		"TokenValue": awssdk.StringValue(output.TokenValue),
+	})
+	if err != nil {
+		return err
+	}
+	params, err := json.Marshal(map[string]string{"Target": ctx.instance.Id()})
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command(plugin, string(session), awssdk.StringValue(factory.Sess.Config.Region), "StartSession", config.GetAWSProfile(), string(params), api.Endpoint)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	// interrupts (ex: Ctrl+C) are for the remote shell, the plugin forwarding them
+	signal.Ignore(os.Interrupt)
+	defer signal.Reset(os.Interrupt)
+	return cmd.Run()
+}
+
+// imageOSUsers are the default users of the images, by the distribution found in their name
+var imageOSUsers = []struct{ distro, user string }{
+	{"bitnami", "bitnami"},
+	{"ubuntu", "ubuntu"},
+	{"debian", "admin"},
+	{"centos", "centos"},
+	{"amzn", "ec2-user"},
+	{"rhel", "ec2-user"},
+	{"suse", "ec2-user"},
+	{"sles", "ec2-user"},
+}
+
+// imageOSUser returns the default user of an image given its name, or an empty string when unknown
+func imageOSUser(imageName string) string {
+	name := strings.ToLower(imageName)
+	for _, u := range imageOSUsers {
+		if strings.Contains(name, u.distro) {
+			return u.user
+		}
+	}
+	return ""
+}
+
+// resolveInstanceOSUser returns the default user of an instance from the name of its image
+func resolveInstanceOSUser(api ec2iface.EC2API, instance cloud.Resource) (string, error) {
+	imageId, _ := instance.Properties()[properties.Image].(string)
+	if imageId == "" {
+		return "", fmt.Errorf("no image for instance %s", instance.Id())
+	}
+	out, err := api.DescribeImages(&ec2.DescribeImagesInput{ImageIds: []*string{awssdk.String(imageId)}})
+	if err != nil {
+		return "", err
+	}
+	if len(out.Images) == 0 {
+		return "", fmt.Errorf("image %s of instance %s not found", imageId, instance.Id())
+	}
+	name := awssdk.StringValue(out.Images[0].Name)
+	if user := imageOSUser(name); user != "" {
+		return user, nil
+	}
+	return "", fmt.Errorf("unknown user for image %s (%s) of instance %s", imageId, name, instance.Id())
+}
+
+// pushInstanceConnectKey generates an ephemeral key and pushes it with EC2 Instance Connect on the instances,
+// for their user, resolved from their image when not given, returning the path of its private key
+func pushInstanceConnectKey(ctxs ...*instanceConnectionContext) (string, error) {
+	factory, ok := awsspec.CommandFactory.(*awsspec.AWSFactory)
+	if !ok || factory.Sess == nil {
+		return "", errors.New("no AWS session to push key with EC2 Instance Connect")
+	}
+	for _, ctx := range ctxs {
+		if ctx == nil || ctx.user != "" {
+			continue
+		}
+		user, err := resolveInstanceOSUser(ec2.New(factory.Sess), ctx.instance)
+		if err != nil {
+			return "", fmt.Errorf("cannot resolve the user to push key with EC2 Instance Connect (give it with USER@INSTANCE): %s", err)
+		}
+		logger.Verbosef("user '%s' resolved from the image of instance %s", user, ctx.instance.Id())
+		ctx.user = user
+	}
+
+	pub, priv, err := console.GenerateED25519SSHKeyPair()
+	if err != nil {
+		return "", err
+	}
+	keyPath := filepath.Join(config.KeysDir, instanceConnectKeyFile)
+	os.Remove(keyPath)
+	if err = ioutil.WriteFile(keyPath, priv, 0400); err != nil {
+		return "", fmt.Errorf("saving ephemeral key: %s", err)
+	}
+
+	region := awssdk.StringValue(factory.Sess.Config.Region)
+	endpoint := fmt.Sprintf("https://%s.%s.%s", ec2instanceconnect.EndpointsID, region, awsconfig.DNSSuffixForRegion(region))
+	api := ec2instanceconnect.New(factory.Sess, &awssdk.Config{Endpoint: awssdk.String(endpoint)})
+	for _, ctx := range ctxs {
+		if ctx == nil {
+			continue
+		}
+		zone, _ := ctx.instance.Properties()[properties.AvailabilityZone].(string)
+		_, err = api.SendSSHPublicKey(&ec2instanceconnect.SendSSHPublicKeyInput{
+			AvailabilityZone: awssdk.String(zone),
+			InstanceId:       awssdk.String(ctx.instance.Id()),
+			InstanceOSUser:   awssdk.String(ctx.user),
+			SSHPublicKey:     awssdk.String(string(pub)),
+		})
+		if err != nil {
+			return "", fmt.Errorf("push key with EC2 Instance Connect on instance %s: %s", ctx.instance.Id(), err)
+		}
+		logger.Verbosef("ephemeral key pushed with EC2 Instance Connect on instance %s for %s", ctx.instance.Id(), ctx.user)
+	}
+	return keyPath, nil
+}
+
+// dialInstance resolves the instance (by name, id or IP), its user and key, and connects to it
+// through SSH, through the bastion when --through is given
+func dialInstance(userhost string) (*ssh.Client, *instanceConnectionContext) {
+	var err error
+	var connectionCtx, destInstanceCtx *instanceConnectionContext
+
+	if proxyInstanceThroughFlag != "" {
+		destInstanceCtx, err = initInstanceConnectionContext(userhost, keyPathFlag)
 		exitOn(err)
+		through := proxyInstanceThroughFlag
+		if through == autoBastion {
+			bastion, err := resolveBastion(destInstanceCtx.resourcesGraph, destInstanceCtx.instance)
+			exitOn(err)
+			logger.Infof("using instance %s as bastion to connect to %s", instanceLabel(bastion), destInstanceCtx.instance.Id())
+			through = bastion.Id()
+		}
+		connectionCtx, err = initInstanceConnectionContext(through, keyPathFlag)
+	} else {
+		connectionCtx, err = initInstanceConnectionContext(userhost, keyPathFlag)
+	}
+	exitOn(err)
+
+	targetClient, err := dialConnectionContexts(connectionCtx, destInstanceCtx)
+	exitOn(err)
 
-		firsHopClient.SetLogger(logger.DefaultLogger)
-		firsHopClient.SetStrictHostKeyChecking(!disableStrictHostKeyCheckingFlag)
-		firsHopClient.InteractiveTerminalFunc = console.InteractiveTerminal
-		if proxyInstanceThroughFlag != "" {
-			firsHopClient.Port = sshTroughPortFlag
+	return targetClient, connectionCtx
+}
+
+// dialConnectionContexts connects through SSH to the first hop instance, and from it
+// to the destination instance when given
+func dialConnectionContexts(connectionCtx, destInstanceCtx *instanceConnectionContext) (*ssh.Client, error) {
+	if instanceConnectFlag || (keyPathFlag == "" && (connectionCtx.keypath == "" || (destInstanceCtx != nil && destInstanceCtx.keypath == ""))) {
+		keypath, err := pushInstanceConnectKey(connectionCtx, destInstanceCtx)
+		if err != nil && instanceConnectFlag {
+			return nil, err
+		}
+		if err != nil {
+			logger.Warningf("no key pair on instance, %s", err)
 		} else {
-			firsHopClient.Port = sshPortFlag
+			connectionCtx.keypath = keypath
 		}
+	}
 
-		if privateIPFlag {
-			if priv := connectionCtx.privip; priv != "" {
-				firsHopClient.IP = connectionCtx.privip
-			} else {
-				exitOn(fmt.Errorf(
-					"no private IP resolved for instance %s (state '%s')",
-					connectionCtx.instance.Id(), connectionCtx.state,
-				))
-			}
+	firsHopClient, err := ssh.InitClient(connectionCtx.keypath, config.KeysDir, filepath.Join(os.Getenv("HOME"), ".ssh"))
+	if err != nil && strings.Contains(err.Error(), "cannot find SSH key") && keyPathFlag == "" {
+		logger.Info("you may want to specify a key filepath with `-i /path/to/key.pem`")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	firsHopClient.SetLogger(logger.DefaultLogger)
+	firsHopClient.SetStrictHostKeyChecking(!disableStrictHostKeyCheckingFlag)
+	firsHopClient.InteractiveTerminalFunc = console.InteractiveTerminal
+	if proxyInstanceThroughFlag != "" {
+		firsHopClient.Port = sshTroughPortFlag
+	} else {
+		firsHopClient.Port = sshPortFlag
+	}
+
+	if privateIPFlag {
+		if priv := connectionCtx.privip; priv != "" {
+			firsHopClient.IP = connectionCtx.privip
 		} else {
-			if pub := connectionCtx.ip; pub != "" {
-				firsHopClient.IP = connectionCtx.ip
-			} else {
-				logger.Infof("`--private` flag can be used to connect through instance's private IP '%s'", connectionCtx.privip)
-				exitOn(fmt.Errorf("no public IP resolved for instance %s (state '%s')", connectionCtx.instance.Id(), connectionCtx.state))
-			}
+			return nil, fmt.Errorf(
+				"no private IP resolved for instance %s (state '%s')",
+				connectionCtx.instance.Id(), connectionCtx.state,
+			)
 		}
-
-		if connectionCtx.user != "" {
-			err = firsHopClient.DialWithUsers(connectionCtx.user)
+	} else {
+		if pub := connectionCtx.ip; pub != "" {
+			firsHopClient.IP = connectionCtx.ip
 		} else {
-			err = firsHopClient.DialWithUsers(defaultAMIUsers...)
+			logger.Infof("`--private` flag can be used to connect through instance's private IP '%s'", connectionCtx.privip)
+			logger.Info("`--through auto` flag can be used to connect through a public instance of the same VPC")
+			logger.Info("`--ssm` flag can be used to open a session through SSM Session Manager when the instance runs the SSM agent")
+			return nil, fmt.Errorf("no public IP resolved for instance %s (state '%s')", connectionCtx.instance.Id(), connectionCtx.state)
 		}
+	}
 
-		if isConnectionRefusedErr(err) {
-			logger.Warning("cannot connect to this instance, maybe the system is still booting?")
-			exitOn(err)
-			return nil
+	if connectionCtx.user != "" {
+		err = firsHopClient.DialWithUsers(connectionCtx.user)
+	} else {
+		err = firsHopClient.DialWithUsers(defaultAMIUsers...)
+	}
+
+	if isConnectionRefusedErr(err) {
+		logger.Warning("cannot connect to this instance, maybe the system is still booting?")
+		return nil, err
+	}
+
+	if err != nil {
+		if e := connectionCtx.checkInstanceAccessible(); e != nil {
+			logger.Error(e.Error())
 		}
+		return nil, err
+	}
+
+	targetClient := firsHopClient
 
+	if destInstanceCtx != nil {
+		if destInstanceCtx.user != "" {
+			targetClient, err = firsHopClient.NewClientWithProxy(destInstanceCtx.privip, sshPortFlag, destInstanceCtx.user)
+		} else {
+			targetClient, err = firsHopClient.NewClientWithProxy(destInstanceCtx.privip, sshPortFlag, defaultAMIUsers...)
+		}
 		if err != nil {
-			if e := connectionCtx.checkInstanceAccessible(); e != nil {
-				logger.Error(e.Error())
-			}
-			exitOn(err)
+			return nil, err
 		}
+	}
 
-		targetClient := firsHopClient
+	return targetClient, nil
+}
 
-		if proxyInstanceThroughFlag != "" {
-			destInstanceCtx, err := initInstanceConnectionContext(args[0], keyPathFlag)
-			exitOn(err)
-			if destInstanceCtx.user != "" {
-				targetClient, err = firsHopClient.NewClientWithProxy(destInstanceCtx.privip, sshPortFlag, destInstanceCtx.user)
-			} else {
-				targetClient, err = firsHopClient.NewClientWithProxy(destInstanceCtx.privip, sshPortFlag, defaultAMIUsers...)
+// resolveLocalForwards resolves the resource references (ex: @mydb) of the local forwards
+// from the local graph, loaded only when one of them has a reference
+func resolveLocalForwards(forwards []string) ([]string, error) {
+	var g cloud.GraphAPI
+	var resolved []string
+	for _, forward := range forwards {
+		if strings.Contains(forward, "@") && g == nil {
+			var err error
+			if g, err = awlesssync.LoadLocalGraphs(config.GetAWSProfile(), config.GetAWSRegion()); err != nil {
+				return nil, err
 			}
-			exitOn(err)
 		}
+		r, err := resolveLocalForward(g, forward)
+		if err != nil {
+			return nil, err
+		}
+		if r != forward {
+			logger.Verbosef("local forward %s resolved to %s", forward, r)
+		}
+		resolved = append(resolved, r)
+	}
+	return resolved, nil
+}
 
-		if printSSHConfigFlag {
-			host := connectionCtx.instanceName
-			if proxyInstanceThroughFlag != "" {
-				host = args[0]
-			}
-			fmt.Println(targetClient.SSHConfigString(host))
-			return nil
+// resolveLocalForward replaces the resource reference of a local forward with its endpoint host,
+// the local and remote ports defaulting to the port of the resource
+// (ex: @mydb, 15432:@mydb or 15432:@mydb:5432 for [bind_address:]port:host:hostport)
+func resolveLocalForward(g cloud.GraphAPI, forward string) (string, error) {
+	parts := strings.Split(forward, ":")
+	refIndex := -1
+	for i, part := range parts {
+		if strings.HasPrefix(part, "@") {
+			refIndex = i
+		}
+	}
+	if refIndex < 0 {
+		if len(parts) < 3 {
+			return "", fmt.Errorf("invalid local forward '%s': expecting [bind_address:]port:host:hostport", forward)
 		}
+		return forward, nil
+	}
 
-		if printSSHCLIFlag {
-			fmt.Println(targetClient.ConnectString())
-			return nil
+	ref := parts[refIndex][1:]
+	resources, _, err := resolveByName(g, ref, []string{cloud.Database, cloud.CacheCluster, cloud.Instance})
+	if err != nil {
+		return "", err
+	}
+	switch len(resources) {
+	case 0:
+		return "", fmt.Errorf("local forward '%s': no database, cachecluster or instance matching '%s' (run `awless sync` if it is recent)", forward, ref)
+	case 1:
+	default:
+		var ids []string
+		for _, r := range resources {
+			ids = append(ids, fmt.Sprintf("%s %s", r.Type(), r.Id()))
 		}
+		return "", fmt.Errorf("local forward '%s': several resources matching '%s': %s", forward, ref, strings.Join(ids, ", "))
+	}
 
-		exitOn(targetClient.Connect())
-		return nil
-	},
+	res := resources[0]
+	var host string
+	switch res.Type() {
+	case cloud.Database:
+		host = stringProp(res, properties.PublicDNS)
+	case cloud.CacheCluster:
+		host = stringProp(res, properties.Endpoint)
+	case cloud.Instance:
+		host = stringProp(res, properties.PrivateIP)
+	}
+	if host == "" {
+		return "", fmt.Errorf("local forward '%s': no endpoint for %s %s", forward, res.Type(), res.Id())
+	}
+	var port string
+	if p, ok := res.Properties()[properties.Port]; ok && p != nil {
+		port = fmt.Sprint(p)
+	}
+
+	local, remote := parts[:refIndex], parts[refIndex+1:]
+	if len(remote) == 0 {
+		if port == "" {
+			return "", fmt.Errorf("local forward '%s': no port for %s %s, expecting %s:hostport", forward, res.Type(), res.Id(), parts[refIndex])
+		}
+		remote = []string{port}
+	}
+	if len(local) == 0 {
+		local = remote
+	}
+	if len(remote) != 1 || len(local) > 2 {
+		return "", fmt.Errorf("invalid local forward '%s': expecting [bind_address:]port:host:hostport", forward)
+	}
+	return fmt.Sprintf("%s:%s:%s", strings.Join(local, ":"), host, remote[0]), nil
+}
+
+// resolveBastion returns the running instance with a public IP in the VPC of the destination instance,
+// the ones named or tagged as bastion being preferred when there are several of them
+func resolveBastion(g cloud.GraphAPI, destination cloud.Resource) (cloud.Resource, error) {
+	vpc, _ := destination.Properties()[properties.Vpc].(string)
+	if vpc == "" {
+		return nil, fmt.Errorf("cannot resolve bastion: no VPC for instance %s", destination.Id())
+	}
+	instances, err := g.Find(cloud.NewQuery(cloud.Instance).Match(match.And(match.Property(properties.Vpc, vpc), match.Property(properties.State, "running"))))
+	if err != nil {
+		return nil, err
+	}
+	var publics, bastions []cloud.Resource
+	for _, inst := range instances {
+		if ip, _ := inst.Properties()[properties.PublicIP].(string); ip == "" || inst.Id() == destination.Id() {
+			continue
+		}
+		publics = append(publics, inst)
+		if strings.Contains(strings.ToLower(instanceLabel(inst)), "bastion") || hasBastionTag(inst) {
+			bastions = append(bastions, inst)
+		}
+	}
+	if len(bastions) > 0 {
+		publics = bastions
+	}
+	switch len(publics) {
+	case 0:
+		return nil, fmt.Errorf("cannot resolve bastion: no running instance with a public IP in VPC %s, use `--through` with an instance name or id", vpc)
+	case 1:
+		return publics[0], nil
+	default:
+		var names []string
+		for _, inst := range publics {
+			names = append(names, instanceLabel(inst))
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("cannot resolve bastion: several candidates in VPC %s (%s), use `--through` with one of them", vpc, strings.Join(names, ", "))
+	}
+}
+
+func hasBastionTag(r cloud.Resource) bool {
+	tags, _ := r.Properties()[properties.Tags].([]string)
+	for _, t := range tags {
+		if strings.Contains(strings.ToLower(t), "bastion") {
+			return true
+		}
+	}
+	return false
+}
+
+func instanceLabel(r cloud.Resource) string {
+	if name, _ := r.Properties()[properties.Name].(string); name != "" {
+		return fmt.Sprintf("%s (%s)", name, r.Id())
+	}
+	return r.Id()
 }
 
 func isConnectionRefusedErr(err error) bool {
@@ -252,20 +648,32 @@ func initInstanceConnectionContext(userhost, keypath string) (*instanceConnectio
 		}
 	}
 
-	ctx.privip, _ = ctx.instance.Properties()[properties.PrivateIP].(string)
-	ctx.ip, _ = ctx.instance.Properties()[properties.PublicIP].(string)
-	ctx.state, _ = ctx.instance.Properties()[properties.State].(string)
+	ctx.setInstance(ctx.instance, keypath)
+
+	return ctx, nil
+}
+
+// instanceContext returns the connection context of an instance of the already fetched graph
+func (ctx *instanceConnectionContext) instanceContext(instance cloud.Resource, keypath string) *instanceConnectionContext {
+	instCtx := &instanceConnectionContext{instanceName: instance.Id(), myip: ctx.myip, resourcesGraph: ctx.resourcesGraph}
+	instCtx.setInstance(instance, keypath)
+	return instCtx
+}
+
+func (ctx *instanceConnectionContext) setInstance(instance cloud.Resource, keypath string) {
+	ctx.instance = instance
+	ctx.privip, _ = instance.Properties()[properties.PrivateIP].(string)
+	ctx.ip, _ = instance.Properties()[properties.PublicIP].(string)
+	ctx.state, _ = instance.Properties()[properties.State].(string)
 
 	if keypath != "" {
 		ctx.keypath = keypath
 	} else {
-		keypair, ok := ctx.instance.Properties()[properties.KeyPair].(string)
+		keypair, ok := instance.Properties()[properties.KeyPair].(string)
 		if ok {
 			ctx.keypath = fmt.Sprint(keypair)
 		}
 	}
-
-	return ctx, nil
 }
 
 func (ctx *instanceConnectionContext) fetchConnectionInfo() {
@@ -0,0 +1,202 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/fatih/color"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/match"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/ssh"
+)
+
+var sshOnFlag string
+var sshParallelFlag int
+
+func init() {
+	sshCmd.Flags().StringVar(&sshOnFlag, "on", "", "Run the command given after '--' on all the instances matching the query (ex: tag:Role=web,tag:Env=prod)")
+	sshCmd.Flags().IntVar(&sshParallelFlag, "parallel", 10, "Maximum number of instances running the command at the same time with --on")
+}
+
+type hostResult struct {
+	host           string
+	stdout, stderr []byte
+	exitStatus     int
+	err            error
+}
+
+func (r *hostResult) failed() bool {
+	return r.err != nil || r.exitStatus != 0
+}
+
+// runOnInstances runs the command concurrently through SSH on the running instances matching the query,
+// and prints the output and exit status of each of them
+func runOnInstances(query string, args []string) error {
+	if len(args) == 0 {
+		return errors.New("command required after '--' (ex: awless ssh --on tag:Role=web -- uptime)")
+	}
+	if ssmSessionFlag || len(localForwardsFlag) > 0 || dynamicForwardFlag != "" || printSSHConfigFlag || printSSHCLIFlag {
+		return errors.New("--on cannot be used with --ssm, port forwarding, --print-config or --print-cli")
+	}
+	matcher, err := match.ParseQuery(query)
+	if err != nil {
+		return err
+	}
+
+	graphCtx := &instanceConnectionContext{}
+	graphCtx.fetchConnectionInfo()
+
+	running, others, err := selectInstances(graphCtx.resourcesGraph, matcher)
+	exitOn(err)
+	for _, inst := range others {
+		logger.Warningf("skipping %s: instance is '%s'", instanceLabel(inst), stringProp(inst, properties.State))
+	}
+	if len(running) == 0 {
+		return fmt.Errorf("no running instance matching '%s'", query)
+	}
+
+	command := strings.Join(args, " ")
+	logger.Infof("running `%s` on %d instance(s)", command, len(running))
+
+	results := make([]*hostResult, len(running))
+	clients := make([]*ssh.Client, len(running))
+	// dialing is sequential as unknown host keys are confirmed interactively
+	for i, inst := range running {
+		results[i] = &hostResult{host: instanceLabel(inst), exitStatus: -1}
+		clients[i], results[i].err = dialResolvedInstance(graphCtx, inst)
+	}
+
+	parallel := sshParallelFlag
+	if parallel < 1 {
+		parallel = 1
+	}
+	limiter := make(chan struct{}, parallel)
+	var wg sync.WaitGroup
+	for i, client := range clients {
+		if client == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(client *ssh.Client, res *hostResult) {
+			defer wg.Done()
+			limiter <- struct{}{}
+			defer func() { <-limiter }()
+			defer client.CloseAll()
+			res.stdout, res.stderr, res.exitStatus, res.err = client.Run(command)
+		}(client, results[i])
+	}
+	wg.Wait()
+
+	if failed := printHostResults(os.Stdout, results); failed > 0 {
+		exitOn(fmt.Errorf("command failed on %d of %d instance(s)", failed, len(results)))
+	}
+	return nil
+}
+
+// selectInstances returns the running instances matching, and the other ones, sorted by name
+func selectInstances(g cloud.GraphAPI, matcher cloud.Matcher) (running, others []cloud.Resource, err error) {
+	instances, err := g.Find(cloud.NewQuery(cloud.Instance).Match(matcher))
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Slice(instances, func(i, j int) bool { return instanceLabel(instances[i]) < instanceLabel(instances[j]) })
+	for _, inst := range instances {
+		if stringProp(inst, properties.State) == "running" {
+			running = append(running, inst)
+		} else {
+			others = append(others, inst)
+		}
+	}
+	return
+}
+
+// dialResolvedInstance connects through SSH to an instance of the fetched graph,
+// through its bastion when --through is given
+func dialResolvedInstance(graphCtx *instanceConnectionContext, instance cloud.Resource) (*ssh.Client, error) {
+	instCtx := graphCtx.instanceContext(instance, keyPathFlag)
+	if proxyInstanceThroughFlag == "" {
+		return dialConnectionContexts(instCtx, nil)
+	}
+	var bastion cloud.Resource
+	var err error
+	if proxyInstanceThroughFlag == autoBastion {
+		bastion, err = resolveBastion(graphCtx.resourcesGraph, instance)
+	} else {
+		bastion, err = findInstanceByNameOrId(graphCtx.resourcesGraph, proxyInstanceThroughFlag)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return dialConnectionContexts(graphCtx.instanceContext(bastion, keyPathFlag), instCtx)
+}
+
+func findInstanceByNameOrId(g cloud.GraphAPI, ref string) (cloud.Resource, error) {
+	instances, err := g.Find(cloud.NewQuery(cloud.Instance).Match(match.Or(match.Property(properties.Name, ref), match.Property(properties.PublicIP, ref), match.Property(properties.PrivateIP, ref))))
+	if err != nil {
+		return nil, err
+	}
+	switch len(instances) {
+	case 0:
+		return findResource(g, ref, cloud.Instance)
+	case 1:
+		return instances[0], nil
+	default:
+		return nil, fmt.Errorf("several instances matching '%s', use its id", ref)
+	}
+}
+
+// printHostResults prints the output of each host followed by a summary, returning the number of failures
+func printHostResults(w io.Writer, results []*hostResult) int {
+	var failures []string
+	for _, res := range results {
+		status := fmt.Sprintf("exit %d", res.exitStatus)
+		if res.err != nil {
+			status = fmt.Sprintf("error: %s", res.err)
+		}
+		if res.failed() {
+			failures = append(failures, res.host)
+			fmt.Fprintf(w, "%s %s: %s\n", color.New(color.FgRed).Sprint("==>"), res.host, status)
+		} else {
+			fmt.Fprintf(w, "%s %s: %s\n", color.New(color.FgGreen).Sprint("==>"), res.host, status)
+		}
+		for _, out := range [][]byte{res.stdout, res.stderr} {
+			if len(out) == 0 {
+				continue
+			}
+			w.Write(out)
+			if !bytes.HasSuffix(out, []byte("\n")) {
+				fmt.Fprintln(w)
+			}
+		}
+	}
+	fmt.Fprintf(w, "\n%d succeeded, %d failed", len(results)-len(failures), len(failures))
+	if len(failures) > 0 {
+		fmt.Fprintf(w, ": %s", strings.Join(failures, ", "))
+	}
+	fmt.Fprintln(w)
+	return len(failures)
+}
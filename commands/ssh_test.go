@@ -0,0 +1,288 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/fatih/color"
+	awsconv "github.com/wallix/awless/aws/conv"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/match"
+	p "github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestResolveBastion(t *testing.T) {
+	instance := func(id, vpc, state, publicIP string, props ...interface{}) *graph.Resource {
+		b := resourcetest.Instance(id).Prop(p.Vpc, vpc).Prop(p.State, state).Prop(p.PublicIP, publicIP)
+		for i := 0; i+1 < len(props); i += 2 {
+			b = b.Prop(props[i].(string), props[i+1])
+		}
+		return b.Build()
+	}
+
+	tcases := []struct {
+		name      string
+		instances []*graph.Resource
+		expId     string
+		expErr    bool
+	}{
+		{
+			name: "single public instance of the vpc",
+			instances: []*graph.Resource{
+				instance("i-dest", "vpc-1", "running", ""),
+				instance("i-1", "vpc-1", "running", "1.2.3.4"),
+				instance("i-2", "vpc-2", "running", "2.3.4.5"),
+				instance("i-3", "vpc-1", "stopped", "3.4.5.6"),
+				instance("i-4", "vpc-1", "running", ""),
+			},
+			expId: "i-1",
+		},
+		{
+			name: "bastion preferred by name",
+			instances: []*graph.Resource{
+				instance("i-dest", "vpc-1", "running", ""),
+				instance("i-1", "vpc-1", "running", "1.2.3.4", p.Name, "web"),
+				instance("i-2", "vpc-1", "running", "2.3.4.5", p.Name, "prod-Bastion"),
+			},
+			expId: "i-2",
+		},
+		{
+			name: "bastion preferred by tag",
+			instances: []*graph.Resource{
+				instance("i-dest", "vpc-1", "running", ""),
+				instance("i-1", "vpc-1", "running", "1.2.3.4"),
+				instance("i-2", "vpc-1", "running", "2.3.4.5", p.Tags, []string{"Role=bastion"}),
+			},
+			expId: "i-2",
+		},
+		{
+			name: "several candidates",
+			instances: []*graph.Resource{
+				instance("i-dest", "vpc-1", "running", ""),
+				instance("i-1", "vpc-1", "running", "1.2.3.4"),
+				instance("i-2", "vpc-1", "running", "2.3.4.5"),
+			},
+			expErr: true,
+		},
+		{
+			name: "destination only public instance",
+			instances: []*graph.Resource{
+				instance("i-dest", "vpc-1", "running", "1.2.3.4"),
+			},
+			expErr: true,
+		},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			g := graph.NewGraph()
+			for _, inst := range tcase.instances {
+				g.AddResource(inst)
+			}
+			bastion, err := resolveBastion(g, tcase.instances[0])
+			if tcase.expErr {
+				if err == nil {
+					t.Fatalf("expected error, got %s", bastion.Id())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := bastion.Id(), tcase.expId; got != want {
+				t.Fatalf("got %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestResolveLocalForward(t *testing.T) {
+	g := graph.NewGraph()
+	// as returned by AWS: RDS often gives a 0 DbInstancePort, Redis clusters have no configuration endpoint
+	mydb, err := awsconv.NewResource(&rds.DBInstance{
+		DBInstanceIdentifier: aws.String("mydb"),
+		DbInstancePort:       aws.Int64(0),
+		Endpoint:             &rds.Endpoint{Address: aws.String("mydb.abc.eu-west-1.rds.amazonaws.com"), Port: aws.Int64(5432)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	redis, err := awsconv.NewResource(&elasticache.CacheCluster{
+		CacheClusterId: aws.String("my-redis"),
+		Engine:         aws.String("redis"),
+		CacheNodes: []*elasticache.CacheNode{
+			{CacheNodeId: aws.String("0001"), Endpoint: &elasticache.Endpoint{Address: aws.String("my-redis.abc.0001.euw1.cache.amazonaws.com"), Port: aws.Int64(6379)}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.AddResource(mydb, redis)
+	g.AddResource(resourcetest.Instance("i-1").Prop(p.Name, "backend").Prop(p.PrivateIP, "10.0.1.5").Build())
+	g.AddResource(resourcetest.Database("olddb").Prop(p.Port, int64(3306)).Build())
+
+	tcases := []struct {
+		forward string
+		exp     string
+		expErr  bool
+	}{
+		{forward: "5432:db.internal:5432", exp: "5432:db.internal:5432"},
+		{forward: "@mydb", exp: "5432:mydb.abc.eu-west-1.rds.amazonaws.com:5432"},
+		{forward: "15432:@mydb", exp: "15432:mydb.abc.eu-west-1.rds.amazonaws.com:5432"},
+		{forward: "127.0.0.1:15432:@mydb", exp: "127.0.0.1:15432:mydb.abc.eu-west-1.rds.amazonaws.com:5432"},
+		{forward: "16379:@my-redis:6380", exp: "16379:my-redis.abc.0001.euw1.cache.amazonaws.com:6380"},
+		{forward: "16379:@my-redis", exp: "16379:my-redis.abc.0001.euw1.cache.amazonaws.com:6379"},
+		{forward: "@my-redis", exp: "6379:my-redis.abc.0001.euw1.cache.amazonaws.com:6379"},
+		{forward: "8080:@backend:80", exp: "8080:10.0.1.5:80"},
+		{forward: "@backend:8080", exp: "8080:10.0.1.5:8080"},
+		{forward: "@backend", expErr: true},
+		{forward: "@olddb", expErr: true},
+		{forward: "@unknown", expErr: true},
+		{forward: "5432:db.internal", expErr: true},
+	}
+	for _, tcase := range tcases {
+		got, err := resolveLocalForward(g, tcase.forward)
+		if tcase.expErr {
+			if err == nil {
+				t.Fatalf("%s: expected error, got %s", tcase.forward, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %s", tcase.forward, err)
+		}
+		if got != tcase.exp {
+			t.Fatalf("%s: got %s, want %s", tcase.forward, got, tcase.exp)
+		}
+	}
+}
+
+type imagesMock struct {
+	ec2iface.EC2API
+	images map[string]string
+}
+
+func (m *imagesMock) DescribeImages(input *ec2.DescribeImagesInput) (*ec2.DescribeImagesOutput, error) {
+	out := &ec2.DescribeImagesOutput{}
+	for _, id := range input.ImageIds {
+		if name, ok := m.images[aws.StringValue(id)]; ok {
+			out.Images = append(out.Images, &ec2.Image{ImageId: id, Name: aws.String(name)})
+		}
+	}
+	return out, nil
+}
+
+func TestResolveInstanceOSUser(t *testing.T) {
+	api := &imagesMock{images: map[string]string{
+		"ami-amzn":    "amzn2-ami-hvm-2.0.20190612-x86_64-gp2",
+		"ami-ubuntu":  "ubuntu/images/hvm-ssd/ubuntu-bionic-18.04-amd64-server-20190627.1",
+		"ami-debian":  "debian-stretch-hvm-x86_64-gp2-2019-06-19-26089",
+		"ami-bitnami": "bitnami-wordpress-5.2.2-0-linux-ubuntu-16.04-x86_64-hvm-ebs",
+		"ami-custom":  "my-golden-image",
+	}}
+	tcases := []struct {
+		image, expUser string
+		expErr         bool
+	}{
+		{image: "ami-amzn", expUser: "ec2-user"},
+		{image: "ami-ubuntu", expUser: "ubuntu"},
+		{image: "ami-debian", expUser: "admin"},
+		{image: "ami-bitnami", expUser: "bitnami"},
+		{image: "ami-custom", expErr: true},
+		{image: "ami-deregistered", expErr: true},
+		{image: "", expErr: true},
+	}
+	for _, tcase := range tcases {
+		user, err := resolveInstanceOSUser(api, resourcetest.Instance("i-1").Prop(p.Image, tcase.image).Build())
+		if tcase.expErr {
+			if err == nil {
+				t.Fatalf("%s: expected error, got user %s", tcase.image, user)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %s", tcase.image, err)
+		}
+		if got, want := user, tcase.expUser; got != want {
+			t.Fatalf("%s: got %s, want %s", tcase.image, got, want)
+		}
+	}
+}
+
+func TestSelectInstances(t *testing.T) {
+	ids := func(resources []cloud.Resource) (res []string) {
+		for _, r := range resources {
+			res = append(res, r.Id())
+		}
+		return
+	}
+	g := graph.NewGraph()
+	g.AddResource(resourcetest.Instance("i-1").Prop(p.Name, "web-2").Prop(p.State, "running").Prop(p.Tags, []string{"Role=web"}).Build())
+	g.AddResource(resourcetest.Instance("i-2").Prop(p.Name, "web-1").Prop(p.State, "running").Prop(p.Tags, []string{"Role=web", "Env=prod"}).Build())
+	g.AddResource(resourcetest.Instance("i-3").Prop(p.Name, "web-3").Prop(p.State, "stopped").Prop(p.Tags, []string{"Role=web"}).Build())
+	g.AddResource(resourcetest.Instance("i-4").Prop(p.Name, "db").Prop(p.State, "running").Prop(p.Tags, []string{"Role=db"}).Build())
+
+	tcases := []struct {
+		query                 string
+		expRunning, expOthers []string
+	}{
+		{query: "tag:Role=web", expRunning: []string{"i-2", "i-1"}, expOthers: []string{"i-3"}},
+		{query: "tag:Role=web,tag:Env=prod", expRunning: []string{"i-2"}},
+		{query: "tag:Env", expRunning: []string{"i-2"}},
+		{query: "name:db", expRunning: []string{"i-4"}},
+		{query: "tag:Role=cache"},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.query, func(t *testing.T) {
+			matcher, err := match.ParseQuery(tcase.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			running, others, err := selectInstances(g, matcher)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := ids(running), tcase.expRunning; !reflect.DeepEqual(got, want) {
+				t.Fatalf("running: got %q, want %q", got, want)
+			}
+			if got, want := ids(others), tcase.expOthers; !reflect.DeepEqual(got, want) {
+				t.Fatalf("others: got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestPrintHostResults(t *testing.T) {
+	defer func(noColor bool) { color.NoColor = noColor }(color.NoColor)
+	color.NoColor = true
+
+	var buff bytes.Buffer
+	failed := printHostResults(&buff, []*hostResult{
+		{host: "web-1 (i-1)", stdout: []byte(" 10:00:00 up 3 days\n")},
+		{host: "web-2 (i-2)", stdout: []byte("partial"), stderr: []byte("uptime: not found\n"), exitStatus: 127},
+		{host: "web-3 (i-3)", exitStatus: -1, err: errors.New("no public IP resolved for instance i-3")},
+	})
+	if got, want := failed, 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	exp := `==> web-1 (i-1): exit 0
+ 10:00:00 up 3 days
+==> web-2 (i-2): exit 127
+partial
+uptime: not found
+==> web-3 (i-3): error: no public IP resolved for instance i-3
+
+1 succeeded, 2 failed: web-2 (i-2), web-3 (i-3)
+`
+	if got, want := buff.String(), exp; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,626 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/oklog/ulid"
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/aws/spec"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
+	"github.com/wallix/awless/database"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync"
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/params"
+)
+
+var (
+	runStackFlag      string
+	runTTLFlag        time.Duration
+	runStackDiffFlag  bool
+	runStackPruneFlag bool
+	stackDryRunFlag   bool
+	validStackNameReg = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+)
+
+func init() {
+	RootCmd.AddCommand(stackCmd)
+	stackCmd.AddCommand(stackListCmd)
+	stackCmd.AddCommand(stackTeardownCmd)
+	stackCmd.AddCommand(stackReapCmd)
+	stackCmd.AddCommand(stackImportCmd)
+
+	runCmd.Flags().StringVar(&runStackFlag, "stack", "", "Record the execution in the given stack, whose resources are torn down together (see `awless stack`)")
+	runCmd.Flags().BoolVar(&runStackDiffFlag, "diff", false, "With --stack, only show the statements converging the stack to the template (creates of the missing resources, updates of the changed ones), without running them")
+	runCmd.Flags().BoolVar(&runStackPruneFlag, "prune", false, "With --stack, also delete the resources of the stack the template does not create anymore")
+	runCmd.Flags().DurationVar(&runTTLFlag, "ttl", 0, "Tear down the stack of the execution after the given duration (ex: 8h), with `awless stack reap`. Without --stack, the execution is its own stack")
+	stackTeardownCmd.Flags().BoolVar(&stackDryRunFlag, "dry-run", false, "Show the plan of the teardown and dry run it without executing it")
+	stackTeardownCmd.Flags().IntVar(&parallelismFlag, "parallel", defaultParallelism, "Max number of independent deletes run concurrently. 1 to run sequentially")
+	stackReapCmd.Flags().BoolVar(&stackDryRunFlag, "dry-run", false, "Show the plans of the teardowns and dry run them without executing them")
+	stackReapCmd.Flags().IntVar(&parallelismFlag, "parallel", defaultParallelism, "Max number of independent deletes run concurrently. 1 to run sequentially")
+}
+
+var stackCmd = &cobra.Command{
+	Use:   "stack",
+	Short: "List, import into, tear down and reap the stacks of resources created with `awless run --stack NAME` or `--ttl DURATION`",
+}
+
+var stackListCmd = &cobra.Command{
+	Use:               "list",
+	Short:             "List the live stacks with their expiration",
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		stacks, err := loadStacks()
+		exitOn(err)
+		printStacks(os.Stdout, stacks, time.Now())
+	},
+}
+
+var stackTeardownCmd = &cobra.Command{
+	Use:               "teardown NAME",
+	Short:             "Tear down a stack, reverting all its executions",
+	Example:           "  awless stack teardown test-env\n  awless stack teardown test-env --dry-run",
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("stack NAME required (see `awless stack list`)")
+		}
+		stacks, err := loadStacks()
+		exitOn(err)
+		for _, stack := range stacks {
+			if stack.Name != args[0] {
+				continue
+			}
+			if err = checkStackLocation(stack); err != nil {
+				logger.Error(err)
+				logger.Infof("Tear it down with `awless stack teardown %s -r %s -p %s`", stack.Name, stack.Locale(), stack.Profile())
+				exit(1)
+			}
+			tplExec, err := teardownStack(stack)
+			exitOn(err)
+			if tplExec != nil && tplExec.Stats().KOCount > 0 {
+				exit(1)
+			}
+			return nil
+		}
+		return fmt.Errorf("no live stack '%s' (see `awless stack list`)", args[0])
+	},
+}
+
+var stackReapCmd = &cobra.Command{
+	Use:               "reap",
+	Short:             "Tear down the expired stacks of the current profile and region. Run it periodically (ex: cron) to reap ephemeral environments",
+	Example:           "  awless stack reap\n  awless stack reap --dry-run\n  awless stack reap --force  # unattended",
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		stacks, err := loadStacks()
+		exitOn(err)
+
+		var expired, failed int
+		for _, stack := range stacks {
+			if !stack.IsExpired(time.Now()) {
+				continue
+			}
+			expired++
+			if err := checkStackLocation(stack); err != nil {
+				logger.Infof("%s: reap it with `awless stack reap -r %s -p %s`", err, stack.Locale(), stack.Profile())
+				continue
+			}
+			logger.Infof("Stack %s expired %s ago: tearing it down", stack.Name, console.HumanizeTime(stack.ExpiresAt()))
+			tplExec, err := teardownStack(stack)
+			if err != nil {
+				logger.Errorf("teardown stack %s: %s", stack.Name, err)
+				failed++
+			} else if tplExec != nil && tplExec.Stats().KOCount > 0 {
+				failed++
+			}
+		}
+		if expired == 0 {
+			logger.Info("No expired stack")
+		}
+		if failed > 0 {
+			exitOn(fmt.Errorf("%d expired stack(s) could not be torn down", failed))
+		}
+	},
+}
+
+var stackImportCmd = &cobra.Command{
+	Use:               "import NAME REF [REF...]",
+	Short:             "Import existing resources (ids, @names or ARNs of locally synced resources) into a stack, to tear them down with it",
+	Long:              "Import existing resources into a stack: EC2 resources are tagged with " + stackTagKey + "=NAME, and the resources are recorded in the stack as if created by awless, with their current properties, so that the stack teardown deletes them",
+	Example:           "  awless stack import test-env i-0abc1234 vol-1234abcd @my-subnet",
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 2 {
+			return errors.New("stack NAME and resources REF required")
+		}
+		name := args[0]
+		exitOn(checkRunStack(name))
+
+		g, err := sync.LoadLocalGraphs(config.GetAWSProfile(), config.GetAWSRegion())
+		exitOn(err)
+		var resources []cloud.Resource
+		for _, ref := range args[1:] {
+			_, found, _ := resolveResourceFromRef(g, ref)
+			switch len(found) {
+			case 0:
+				exitOn(fmt.Errorf("resource '%s' not found in locally synced data (run `awless sync`)", ref))
+			case 1:
+				resources = append(resources, found[0])
+			default:
+				exitOn(fmt.Errorf("ambiguous resource '%s': %d resources match", ref, len(found)))
+			}
+		}
+
+		imported, err := importTemplate(resources)
+		exitOn(err)
+
+		if !forceGlobalFlag {
+			fmt.Printf("%s\n\n", renderGreenFn(imported))
+			fmt.Printf("Import %d resources into stack %s (region: %s)? [y/N] ", len(resources), name, config.GetAWSRegion())
+			var yesorno string
+			if _, err := fmt.Scanln(&yesorno); err != nil && err.Error() != "unexpected newline" {
+				return err
+			}
+			if strings.TrimSpace(strings.ToLower(yesorno)) != "y" {
+				exit(1)
+			}
+		}
+
+		if tagging := tagTemplate(resources, name); tagging != nil {
+			runner := NewRunner(tagging, fmt.Sprintf("Tag resources imported into stack %s", name), "")
+			runner.BeforeRun = func(*template.TemplateExecution) (bool, error) { return true, nil }
+			tplExec, err := runner.Execute()
+			exitOn(err)
+			if tplExec.Stats().KOCount > 0 {
+				exitOn(fmt.Errorf("cannot tag all the resources: none imported into stack %s", name))
+			}
+		}
+
+		tplExec := &template.TemplateExecution{
+			Template: imported,
+			Locale:   config.GetAWSRegion(),
+			Profile:  config.GetAWSProfile(),
+			Source:   imported.String(),
+			Stack:    name,
+		}
+		tplExec.SetMessage(fmt.Sprintf("Import %d resources into stack %s", len(resources), name))
+		if me, err := awsservices.AccessService.(*awsservices.Access).GetIdentity(); err == nil {
+			tplExec.Author = me.ResourcePath
+		}
+		exitOn(database.Execute(func(db *database.DB) error {
+			return db.AddTemplate(tplExec)
+		}))
+		logger.Infof("Imported %d resources into stack %s: tear it down with `awless stack teardown %s`", len(resources), name, name)
+		return nil
+	},
+}
+
+const stackTagKey = "awless:stack"
+
+var ec2ResourceID = regexp.MustCompile("^[a-z]+-[0-9a-f]+$")
+
+// tagTemplate returns the template tagging the EC2 resources with their stack, nil if none
+func tagTemplate(resources []cloud.Resource, stack string) *template.Template {
+	var lines []string
+	for _, res := range resources {
+		if awsservices.ServicePerResourceType[res.Type()] != awsservices.InfraService.Name() || !ec2ResourceID.MatchString(res.Id()) {
+			logger.Verbosef("%s %s not tagged: tags only supported on EC2 resources", res.Type(), res.Id())
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("create tag resource=%s key=%s value=%s", res.Id(), template.QuoteParamValue(stackTagKey), stack))
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	return template.MustParse(strings.Join(lines, "\n"))
+}
+
+// importTemplate returns the template creating the resources with their current properties,
+// each command having the id of its resource as result. The resources are ordered so that
+// the ones referenced by others (ex: a subnet by an instance) are created first
+func importTemplate(resources []cloud.Resource) (*template.Template, error) {
+	ordered := orderByReferences(resources)
+	var lines []string
+	for _, res := range ordered {
+		def, ok := awsspec.AWSLookupDefinitions("create" + res.Type())
+		if !ok {
+			return nil, fmt.Errorf("cannot import %s %s: awless cannot create resources of type %s", res.Type(), res.Id(), res.Type())
+		}
+		required, optionals, _ := params.List(def.Params)
+		line := fmt.Sprintf("create %s", res.Type())
+		for _, param := range append(required, optionals...) {
+			if value, ok := importParamValue(res, param); ok {
+				line += fmt.Sprintf(" %s=%s", param, value)
+			}
+		}
+		lines = append(lines, line)
+	}
+	tpl, err := template.Parse(strings.Join(lines, "\n"))
+	if err != nil {
+		return nil, err
+	}
+	for i, cmd := range tpl.CommandNodesIterator() {
+		cmd.CmdResult = ordered[i].Id()
+	}
+	tpl.ID = ulid.MustNew(ulid.Timestamp(time.Now()), rand.Reader).String()
+	return tpl, nil
+}
+
+// importParamValue returns the value of a create param from the property of the same name
+// (ex: availabilityzone from AvailabilityZone), if any and representable in a template
+func importParamValue(res cloud.Resource, param string) (string, bool) {
+	for key, v := range res.Properties() {
+		if strings.ToLower(key) != strings.Replace(param, "-", "", -1) {
+			continue
+		}
+		var value string
+		switch vv := v.(type) {
+		case string:
+			if vv == "" {
+				return "", false
+			}
+			value = template.QuoteParamValue(vv)
+		case int, int64, float64, bool:
+			value = fmt.Sprint(vv)
+		case []string:
+			if len(vv) == 0 {
+				return "", false
+			}
+			var quoted []string
+			for _, s := range vv {
+				quoted = append(quoted, template.QuoteParamValue(s))
+			}
+			value = "[" + strings.Join(quoted, ",") + "]"
+		default:
+			return "", false
+		}
+		if _, err := template.ParseParams(param + "=" + value); err != nil {
+			return "", false
+		}
+		return value, true
+	}
+	return "", false
+}
+
+// orderByReferences sorts the resources, keeping their order when possible,
+// so that the ones referenced by the properties of others come first
+func orderByReferences(resources []cloud.Resource) []cloud.Resource {
+	refs := func(res cloud.Resource, id string) bool {
+		for _, v := range res.Properties() {
+			switch vv := v.(type) {
+			case string:
+				if vv == id {
+					return true
+				}
+			case []string:
+				for _, s := range vv {
+					if s == id {
+						return true
+					}
+				}
+			}
+		}
+		return false
+	}
+	var ordered []cloud.Resource
+	placed := make(map[cloud.Resource]bool)
+	for len(ordered) < len(resources) {
+		var next cloud.Resource
+		for _, res := range resources {
+			if placed[res] {
+				continue
+			}
+			ready := true
+			for _, other := range resources {
+				if other != res && !placed[other] && refs(res, other.Id()) {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				next = res
+				break
+			}
+		}
+		if next == nil { // circular references
+			for _, res := range resources {
+				if !placed[res] {
+					next = res
+					break
+				}
+			}
+		}
+		placed[next] = true
+		ordered = append(ordered, next)
+	}
+	return ordered
+}
+
+func loadStacks() ([]*template.Stack, error) {
+	var execs []*template.TemplateExecution
+	err := database.Execute(func(db *database.DB) error {
+		loaded, err := db.ListTemplates()
+		if err != nil {
+			return err
+		}
+		for _, l := range loaded {
+			if l.Err != nil {
+				logger.Verbosef("ignoring invalid template %s: %s", l.Key, l.Err)
+				continue
+			}
+			execs = append(execs, l.TplExec)
+		}
+		return nil
+	})
+	return template.Stacks(execs), err
+}
+
+// resolveStackRefFunc resolves the security group named NAME of the stack STACK, referenced
+// in templates as sg:STACK/NAME, to its id
+func resolveStackRefFunc(stack, name string) (string, error) {
+	stacks, err := loadStacks()
+	if err != nil {
+		return "", err
+	}
+	g, err := sync.LoadLocalGraphs(config.GetAWSProfile(), config.GetAWSRegion())
+	if err != nil {
+		logger.Verbosef("stack references resolved without local graph: %s", err)
+		g = nil
+	}
+	return stackSecurityGroup(stacks, g, stack, name)
+}
+
+// stackSecurityGroup returns the id of the security group recorded as created with the name
+// in the stack or else, from the graph, of the security group with the name either
+// tagged with the stack or created in it
+func stackSecurityGroup(stacks []*template.Stack, g cloud.GraphAPI, stack, name string) (string, error) {
+	created := make(map[string]bool)
+	for _, s := range stacks {
+		if s.Name != stack {
+			continue
+		}
+		if id, ok := s.Output(cloud.SecurityGroup, name); ok {
+			return id, nil
+		}
+		for _, exec := range s.Executions {
+			for _, cmd := range exec.CommandNodesIterator() {
+				if id, ok := cmd.CmdResult.(string); ok && cmd.CmdErr == nil {
+					created[id] = true
+				}
+			}
+		}
+	}
+
+	if g != nil {
+		sgs, err := g.Find(cloud.NewQuery(cloud.SecurityGroup))
+		if err != nil {
+			return "", err
+		}
+		stackTag := fmt.Sprintf("%s=%s", stackTagKey, stack)
+		var ids []string
+		for _, sg := range sgs {
+			if stringProp(sg, properties.Name) != name {
+				continue
+			}
+			inStack := created[sg.Id()]
+			tags, _ := sg.Properties()[properties.Tags].([]string)
+			for _, tag := range tags {
+				inStack = inStack || tag == stackTag
+			}
+			if inStack {
+				ids = append(ids, sg.Id())
+			}
+		}
+		switch len(ids) {
+		case 0:
+		case 1:
+			return ids[0], nil
+		default:
+			sort.Strings(ids)
+			return "", fmt.Errorf("several securitygroups named '%s' in stack '%s': %s", name, stack, strings.Join(ids, ", "))
+		}
+	}
+	return "", fmt.Errorf("no securitygroup named '%s' in stack '%s'", name, stack)
+}
+
+// checkStackLocation fails when the stack does not live in the current region and profile
+func checkStackLocation(stack *template.Stack) error {
+	if stack.Locale() != config.GetAWSRegion() || stack.Profile() != config.GetAWSProfile() {
+		return fmt.Errorf("stack %s lives in region %s with profile %s", stack.Name, stack.Locale(), stack.Profile())
+	}
+	return nil
+}
+
+// checkRunStack validates the stack a template is about to be run in
+func checkRunStack(name string) error {
+	if name == "" {
+		return nil
+	}
+	if !validStackNameReg.MatchString(name) {
+		return fmt.Errorf("invalid stack name '%s': expecting letters, digits, '.', '_' or '-'", name)
+	}
+	stacks, err := loadStacks()
+	if err != nil {
+		return err
+	}
+	for _, stack := range stacks {
+		if stack.Name == name {
+			return checkStackLocation(stack)
+		}
+	}
+	return nil
+}
+
+// recordInStack records the execution of the runner in the stack when saved.
+// Without name, the stack of the execution is named after its ID
+func recordInStack(runner *template.Runner, name string, ttl time.Duration, teardown bool) {
+	afterRun := runner.AfterRun
+	runner.AfterRun = func(tplExec *template.TemplateExecution) error {
+		tplExec.Stack = name
+		if tplExec.Stack == "" {
+			tplExec.Stack = tplExec.ID
+		}
+		if ttl > 0 {
+			tplExec.ExpiresAt = time.Now().Add(ttl)
+			logger.Infof("Stack %s expires in %s: tear it down with `awless stack reap` or `awless stack teardown %s`", tplExec.Stack, ttl, tplExec.Stack)
+		}
+		tplExec.Teardown = teardown
+		return afterRun(tplExec)
+	}
+}
+
+// convergeInStack makes the runner run, in place of its template, the statements converging
+// the live stack with the name to the template. Nothing is done for a stack not yet created,
+// unless only its diff is requested
+func convergeInStack(runner *template.Runner, name string, diff, prune bool) error {
+	if name == "" {
+		return nil
+	}
+	stacks, err := loadStacks()
+	if err != nil {
+		return err
+	}
+	stack := &template.Stack{Name: name}
+	for _, s := range stacks {
+		if s.Name == name {
+			stack = s
+		}
+	}
+	if len(stack.Executions) == 0 && !diff {
+		return nil
+	}
+
+	beforeRun := runner.BeforeRun
+	runner.BeforeRun = func(tplExec *template.TemplateExecution) (bool, error) {
+		conv, err := stack.Converge(tplExec.Template, runner.CmdLookuper, prune)
+		if err != nil {
+			return false, err
+		}
+		for _, warning := range conv.Warnings {
+			logger.Warning(warning)
+		}
+		if conv.IsEmpty() {
+			logger.Infof("Stack %s is up to date with the template (%s)", name, conv)
+			return false, nil
+		}
+		if diff {
+			fmt.Printf("%s\n\n", renderGreenFn(conv.Template))
+			logger.Infof("Stack %s: %s (remove --diff to converge it)", name, conv)
+			return false, nil
+		}
+		logger.Infof("Converging stack %s: %s", name, conv)
+		converging := *runner
+		converging.Template, converging.BeforeRun = conv.Template, beforeRun
+		convExec, err := converging.Execute()
+		if err != nil {
+			return false, err
+		}
+		if convExec.Stats().KOCount > 0 {
+			exit(1)
+		}
+		return false, nil
+	}
+	return nil
+}
+
+// teardownStack runs the teardown template of the stack, skipping the reverts of resources
+// that no longer exist. The execution returned is nil when nothing had to be torn down
+func teardownStack(stack *template.Stack) (*template.TemplateExecution, error) {
+	teardown, err := stack.Teardown()
+	if err != nil {
+		return nil, err
+	}
+	plan, err := planRevert(teardown, stack.Executions[len(stack.Executions)-1].Date())
+	if err != nil {
+		return nil, err
+	}
+	if plan.HasNoOp() {
+		logger.Infof("Teardown plan of stack %s (no-op commands will be skipped):\n\n%s\n", stack.Name, plan)
+	} else {
+		logger.Infof("Teardown plan of stack %s:\n\n%s\n", stack.Name, plan)
+	}
+
+	runner := NewRunnerRequiredParamsOnly(teardown, fmt.Sprintf("Teardown stack %s", stack.Name), "")
+	if plan.IsEmpty() {
+		logger.Infof("Nothing to tear down in stack %s: all its resources no longer exist", stack.Name)
+		if stackDryRunFlag {
+			return nil, nil
+		}
+		// the stack is recorded as torn down with an empty execution
+		tplExec := &template.TemplateExecution{Template: plan.Template(teardown.ID), Locale: stack.Locale(), Profile: stack.Profile()}
+		tplExec.SetMessage(fmt.Sprintf("Teardown stack %s", stack.Name))
+		tplExec.Stack, tplExec.Teardown = stack.Name, true
+		return tplExec, database.Execute(func(db *database.DB) error {
+			return db.AddTemplate(tplExec)
+		})
+	}
+	runner.Template = plan.Template(teardown.ID)
+	if stackDryRunFlag {
+		runner.BeforeRun = func(tplExec *template.TemplateExecution) (bool, error) {
+			fmt.Printf("%s\n\n", renderGreenFn(tplExec.Template))
+			logger.Infof("Dry run successful: stack %s has not been torn down (remove --dry-run to tear it down)", stack.Name)
+			return false, nil
+		}
+	}
+	recordInStack(runner, stack.Name, 0, true)
+	return runner.Execute()
+}
+
+func printStacks(w io.Writer, stacks []*template.Stack, now time.Time) {
+	tab := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tab, "NAME\tREGION\tPROFILE\tRUNS\tEXPIRES")
+	for _, stack := range stacks {
+		fmt.Fprintf(tab, "%s\t%s\t%s\t%d\t%s\n", stack.Name, stack.Locale(), stack.Profile(), len(stack.Executions), stackExpiry(stack, now))
+	}
+	tab.Flush()
+}
+
+func stackExpiry(stack *template.Stack, now time.Time) string {
+	expires := stack.ExpiresAt()
+	switch {
+	case expires.IsZero():
+		return "never"
+	case stack.IsExpired(now):
+		return fmt.Sprintf("expired (%s)", expires.UTC().Format(time.RFC3339))
+	default:
+		return fmt.Sprintf("in %s (%s)", expires.Sub(now).Round(time.Minute), expires.UTC().Format(time.RFC3339))
+	}
+}
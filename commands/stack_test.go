@@ -0,0 +1,130 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+	"github.com/wallix/awless/template"
+)
+
+func TestPrintStacks(t *testing.T) {
+	now := time.Date(2017, 10, 1, 12, 0, 0, 0, time.UTC)
+	newExec := func(id, stack string, expires time.Time) *template.TemplateExecution {
+		tpl := template.MustParse("create vpc cidr=10.0.0.0/16")
+		tpl.ID = id
+		return &template.TemplateExecution{Template: tpl, Stack: stack, Locale: "eu-west-1", Profile: "dev", ExpiresAt: expires}
+	}
+	stacks := template.Stacks([]*template.TemplateExecution{
+		newExec("01BTT1AA3N36VCKSNKAKN4WX2A", "test", now.Add(-time.Hour)),
+		newExec("01BTT1AA3N36VCKSNKAKN4WX2B", "demo", now.Add(90*time.Minute+20*time.Second)),
+		newExec("01BTT1AA3N36VCKSNKAKN4WX2C", "demo", time.Time{}),
+		newExec("01BTT1AA3N36VCKSNKAKN4WX2D", "prod", time.Time{}),
+	})
+
+	var buff bytes.Buffer
+	printStacks(&buff, stacks, now)
+	expected := "NAME  REGION     PROFILE  RUNS  EXPIRES\n" +
+		"demo  eu-west-1  dev      2     in 1h30m0s (2017-10-01T13:30:20Z)\n" +
+		"prod  eu-west-1  dev      1     never\n" +
+		"test  eu-west-1  dev      1     expired (2017-10-01T11:00:00Z)\n"
+	if got, want := buff.String(), expected; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestImportTemplate(t *testing.T) {
+	resources := []cloud.Resource{
+		resourcetest.Instance("i-0abc").Prop(properties.Name, "web server").Prop(properties.Type, "t2.micro").Prop(properties.Subnet, "subnet-1").Prop(properties.State, "running").Build(),
+		resourcetest.Subnet("subnet-1").Prop(properties.CIDR, "10.0.1.0/24").Prop(properties.Vpc, "vpc-1").Prop(properties.AvailabilityZone, "eu-west-1a").Build(),
+		resourcetest.VPC("vpc-1").Prop(properties.CIDR, "10.0.0.0/16").Build(),
+	}
+	tpl, err := importTemplate(resources)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "create vpc cidr=10.0.0.0/16\n" +
+		"create subnet availabilityzone=eu-west-1a cidr=10.0.1.0/24 vpc=vpc-1\n" +
+		"create instance name='web server' subnet=subnet-1 type=t2.micro"
+	if got, want := tpl.String(), expected; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+	if tpl.ID == "" {
+		t.Fatal("expected template ID")
+	}
+
+	stack := template.Stacks([]*template.TemplateExecution{{Template: tpl, Stack: "imported"}})[0]
+	teardown, err := stack.Teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = "delete instance id=i-0abc\n" +
+		"check instance id=i-0abc state=terminated timeout=180\n" +
+		"delete subnet id=subnet-1\n" +
+		"delete vpc id=vpc-1"
+	if got, want := teardown.String(), expected; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+
+	if _, err = importTemplate([]cloud.Resource{resourcetest.Region("eu-west-1").Build()}); err == nil {
+		t.Fatal("expected error for resource type not creatable")
+	}
+}
+
+func TestStackSecurityGroup(t *testing.T) {
+	newExec := func(id, stack, text string, results ...string) *template.TemplateExecution {
+		tpl := template.MustParse(text)
+		tpl.ID = id
+		for i, cmd := range tpl.CommandNodesIterator() {
+			if i < len(results) {
+				cmd.CmdResult = results[i]
+			}
+		}
+		return &template.TemplateExecution{Template: tpl, Stack: stack}
+	}
+	stacks := template.Stacks([]*template.TemplateExecution{
+		newExec("01BTT1AA3N36VCKSNKAKN4WX2A", "front", "create securitygroup vpc=vpc-1 name=web description=web", "sg-1"),
+		newExec("01BTT1AA3N36VCKSNKAKN4WX2B", "front", "create instance subnet=sub-1 image=ami-1 type=t2.micro count=1 name=lb", "i-1"),
+		newExec("01BTT1AA3N36VCKSNKAKN4WX2C", "back", "create instance subnet=sub-1 image=ami-1 type=t2.micro count=1 name=db", "sg-3"),
+	})
+
+	g := graph.NewGraph()
+	g.AddResource(resourcetest.SecurityGroup("sg-1").Prop(properties.Name, "web").Build())
+	g.AddResource(resourcetest.SecurityGroup("sg-2").Prop(properties.Name, "db").Prop(properties.Tags, []string{"awless:stack=imported"}).Build())
+	g.AddResource(resourcetest.SecurityGroup("sg-3").Prop(properties.Name, "db").Build())
+	g.AddResource(resourcetest.SecurityGroup("sg-4").Prop(properties.Name, "db").Prop(properties.Tags, []string{"awless:stack=back"}).Build())
+	g.AddResource(resourcetest.SecurityGroup("sg-5").Prop(properties.Name, "lb").Build())
+
+	tcases := []struct {
+		graph       cloud.GraphAPI
+		stack, name string
+		exp         string
+		expErr      string
+	}{
+		{stack: "front", name: "web", exp: "sg-1"},
+		{graph: g, stack: "front", name: "web", exp: "sg-1"},
+		{graph: g, stack: "imported", name: "db", exp: "sg-2"},
+		{graph: g, stack: "back", name: "db", expErr: "several securitygroups named 'db' in stack 'back': sg-3, sg-4"},
+		{graph: g, stack: "front", name: "lb", expErr: "no securitygroup named 'lb' in stack 'front'"},
+		{stack: "imported", name: "db", expErr: "no securitygroup named 'db' in stack 'imported'"},
+	}
+	for i, tcase := range tcases {
+		got, err := stackSecurityGroup(stacks, tcase.graph, tcase.stack, tcase.name)
+		if tcase.expErr != "" {
+			if err == nil || err.Error() != tcase.expErr {
+				t.Fatalf("%d: got error %v, want %s", i+1, err, tcase.expErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if got != tcase.exp {
+			t.Fatalf("%d: got %s, want %s", i+1, got, tcase.exp)
+		}
+	}
+}
@@ -0,0 +1,273 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/config"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/sync"
+)
+
+const (
+	regionDimension  = "region"
+	typeDimension    = "type"
+	tagDimensionPref = "tag:"
+
+	// hours per month used to estimate monthly costs from hourly prices
+	hoursPerMonth = 730
+)
+
+// resource types summarized, in column order
+var summaryResourceTypes = []string{cloud.Instance, cloud.Volume, cloud.Bucket}
+
+var (
+	summaryGroupByFlag []string
+	summaryCostFlag    bool
+	summaryFormatFlag  string
+)
+
+func init() {
+	RootCmd.AddCommand(summaryCmd)
+
+	summaryCmd.Flags().StringSliceVar(&summaryGroupByFlag, "group-by", []string{regionDimension, typeDimension}, "Group resources by: region, type (one count column per resource type), tag:KEY or any property (ex: State, Type, AvailabilityZone)")
+	summaryCmd.Flags().BoolVar(&summaryCostFlag, "cost", false, "Estimate the monthly on-demand cost (USD) of running instances")
+	summaryCmd.Flags().StringVar(&summaryFormatFlag, "format", "table", "Output format: table or json")
+}
+
+var summaryCmd = &cobra.Command{
+	Use:               "summary",
+	Short:             "Count instances, volumes and buckets of all locally synced regions, grouped by region, type, tags or properties",
+	Example:           "  awless summary\n  awless summary --group-by region,type,tag:Env --cost\n  awless summary --group-by tag:Team,State --format json",
+	PersistentPreRun:  applyHooks(initAwlessEnvHook, initLoggerHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		graphs, err := sync.LoadLocalGraphsPerRegion(config.GetAWSProfile())
+		exitOn(err)
+		if len(graphs) == 0 {
+			exitOn(fmt.Errorf("no local resources for profile '%s': run `awless sync` first", config.GetAWSProfile()))
+		}
+
+		var prices instancePricer
+		if summaryCostFlag {
+			prices = catalogInstancePrice
+		}
+		summary, err := summarize(graphs, summaryGroupByFlag, prices)
+		exitOn(err)
+		exitOn(summary.print(os.Stdout, summaryFormatFlag))
+	},
+}
+
+// instancePricer returns the hourly on-demand price of an instance type in a region
+type instancePricer func(region, instanceType string) (float64, bool)
+
+func catalogInstancePrice(region, instanceType string) (float64, bool) {
+	t, ok := awsconfig.LoadInstanceTypes(region).Lookup(instanceType)
+	if !ok || t.Price <= 0 {
+		return 0, false
+	}
+	return t.Price, true
+}
+
+type inventorySummary struct {
+	Dimensions []string        `json:"dimensions"`
+	Types      []string        `json:"types,omitempty"`
+	Groups     []*summaryGroup `json:"groups"`
+	withCost   bool
+}
+
+type summaryGroup struct {
+	Keys        map[string]string `json:"group"`
+	Counts      map[string]int    `json:"counts,omitempty"`
+	Total       int               `json:"total"`
+	MonthlyCost *float64          `json:"monthlyCost,omitempty"`
+}
+
+// summarize counts the resources of the graphs of each region in groups. The type dimension
+// is pivoted: it gives a count per resource type in each group instead of a group per type
+func summarize(graphs map[string]cloud.GraphAPI, groupBy []string, prices instancePricer) (*inventorySummary, error) {
+	summary := &inventorySummary{withCost: prices != nil}
+	for _, dim := range groupBy {
+		switch dim = strings.TrimSpace(dim); {
+		case dim == "":
+		case dim == typeDimension:
+			summary.Types = summaryResourceTypes
+		case strings.HasPrefix(dim, tagDimensionPref) && len(dim) == len(tagDimensionPref):
+			return nil, fmt.Errorf("invalid group '%s': expecting a tag key (ex: tag:Env)", dim)
+		default:
+			summary.Dimensions = append(summary.Dimensions, dim)
+		}
+	}
+
+	var regions []string
+	for region := range graphs {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	groups := make(map[string]*summaryGroup)
+	for _, region := range regions {
+		resources, err := graphs[region].Find(cloud.NewQuery(summaryResourceTypes...))
+		if err != nil {
+			return nil, err
+		}
+		for _, res := range resources {
+			keys := make(map[string]string)
+			var id []string
+			for _, dim := range summary.Dimensions {
+				keys[dim] = dimensionValue(res, region, dim)
+				id = append(id, keys[dim])
+			}
+			groupID := strings.Join(id, "\x00")
+			g, ok := groups[groupID]
+			if !ok {
+				g = &summaryGroup{Keys: keys}
+				if len(summary.Types) > 0 {
+					g.Counts = make(map[string]int)
+				}
+				if summary.withCost {
+					g.MonthlyCost = new(float64)
+				}
+				groups[groupID] = g
+				summary.Groups = append(summary.Groups, g)
+			}
+			g.Total++
+			if g.Counts != nil {
+				g.Counts[res.Type()]++
+			}
+			if summary.withCost && res.Type() == cloud.Instance && fmt.Sprint(res.Properties()[properties.State]) == "running" {
+				if price, ok := prices(region, fmt.Sprint(res.Properties()[properties.Type])); ok {
+					*g.MonthlyCost += price * hoursPerMonth
+				}
+			}
+		}
+	}
+
+	sort.Slice(summary.Groups, func(i, j int) bool {
+		for _, dim := range summary.Dimensions {
+			if a, b := summary.Groups[i].Keys[dim], summary.Groups[j].Keys[dim]; a != b {
+				return a < b
+			}
+		}
+		return false
+	})
+	return summary, nil
+}
+
+func dimensionValue(res cloud.Resource, region, dim string) string {
+	switch {
+	case dim == regionDimension:
+		return region
+	case strings.HasPrefix(dim, tagDimensionPref):
+		key := strings.TrimPrefix(dim, tagDimensionPref)
+		tags, _ := res.Properties()[properties.Tags].([]string)
+		for _, tag := range tags {
+			if kv := strings.SplitN(tag, "=", 2); len(kv) == 2 && kv[0] == key {
+				return kv[1]
+			}
+		}
+		return ""
+	default:
+		for k, v := range res.Properties() {
+			if strings.EqualFold(k, dim) {
+				return fmt.Sprint(v)
+			}
+		}
+		return ""
+	}
+}
+
+func (s *inventorySummary) print(w io.Writer, format string) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(s)
+	case "table", "":
+		t := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		var headers []string
+		for _, dim := range s.Dimensions {
+			headers = append(headers, strings.ToUpper(dim))
+		}
+		for _, typ := range s.Types {
+			headers = append(headers, strings.ToUpper(cloud.PluralizeResource(typ)))
+		}
+		headers = append(headers, "TOTAL")
+		if s.withCost {
+			headers = append(headers, "COST ($/MONTH)")
+		}
+		fmt.Fprintln(t, strings.Join(headers, "\t"))
+
+		totals := &summaryGroup{Counts: make(map[string]int), MonthlyCost: new(float64)}
+		for _, g := range s.Groups {
+			var cells []string
+			for _, dim := range s.Dimensions {
+				cells = append(cells, orDash(g.Keys[dim]))
+			}
+			cells = append(cells, g.cells(s.Types, s.withCost)...)
+			fmt.Fprintln(t, strings.Join(cells, "\t"))
+
+			totals.Total += g.Total
+			for typ, c := range g.Counts {
+				totals.Counts[typ] += c
+			}
+			if g.MonthlyCost != nil {
+				*totals.MonthlyCost += *g.MonthlyCost
+			}
+		}
+		if len(s.Dimensions) > 0 && len(s.Groups) > 1 {
+			cells := []string{"TOTAL"}
+			for i := 1; i < len(s.Dimensions); i++ {
+				cells = append(cells, "")
+			}
+			cells = append(cells, totals.cells(s.Types, s.withCost)...)
+			fmt.Fprintln(t, strings.Join(cells, "\t"))
+		}
+		return t.Flush()
+	default:
+		return fmt.Errorf("unsupported format '%s' for summary: expected table or json", format)
+	}
+}
+
+func (g *summaryGroup) cells(types []string, withCost bool) (cells []string) {
+	for _, typ := range types {
+		cells = append(cells, fmt.Sprint(g.Counts[typ]))
+	}
+	cells = append(cells, fmt.Sprint(g.Total))
+	if withCost {
+		cells = append(cells, fmt.Sprintf("%.2f", *g.MonthlyCost))
+	}
+	return
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
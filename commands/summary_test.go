@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/wallix/awless/cloud"
+	p "github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestSummarize(t *testing.T) {
+	eu := graph.NewGraph()
+	eu.AddResource(resourcetest.Instance("i-1").Prop(p.Type, "t2.micro").Prop(p.State, "running").Prop(p.Tags, []string{"Env=prod"}).Build())
+	eu.AddResource(resourcetest.Instance("i-2").Prop(p.Type, "t2.micro").Prop(p.State, "stopped").Prop(p.Tags, []string{"Env=prod"}).Build())
+	eu.AddResource(resourcetest.Volume("vol-1").Prop(p.Tags, []string{"Env=dev"}).Build())
+	eu.AddResource(resourcetest.Subnet("sub-1").Build())
+	us := graph.NewGraph()
+	us.AddResource(resourcetest.Instance("i-3").Prop(p.Type, "m5.large").Prop(p.State, "running").Prop(p.Tags, []string{"Env=prod", "Team=data"}).Build())
+	us.AddResource(resourcetest.Bucket("my-bucket").Build())
+	graphs := map[string]cloud.GraphAPI{"eu-west-1": eu, "us-east-1": us}
+
+	prices := func(region, instanceType string) (float64, bool) {
+		return map[string]float64{"t2.micro": 0.01, "m5.large": 0.1}[instanceType], true
+	}
+
+	t.Run("pivot on type", func(t *testing.T) {
+		summary, err := summarize(graphs, []string{"region", "type", "tag:Env"}, prices)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buff bytes.Buffer
+		if err = summary.print(&buff, "table"); err != nil {
+			t.Fatal(err)
+		}
+		expected := `REGION     TAG:ENV  INSTANCES  VOLUMES  BUCKETS  TOTAL  COST ($/MONTH)
+eu-west-1  dev      0          1        0        1      0.00
+eu-west-1  prod     2          0        0        2      7.30
+us-east-1  -        0          0        1        1      0.00
+us-east-1  prod     1          0        0        1      73.00
+TOTAL               3          1        1        5      80.30
+`
+		if got, want := buff.String(), expected; got != want {
+			t.Fatalf("got\n%s\nwant\n%s", got, want)
+		}
+	})
+
+	t.Run("json by property", func(t *testing.T) {
+		summary, err := summarize(graphs, []string{"state"}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buff bytes.Buffer
+		if err = summary.print(&buff, "json"); err != nil {
+			t.Fatal(err)
+		}
+		var decoded struct {
+			Groups []*summaryGroup `json:"groups"`
+		}
+		if err = json.Unmarshal(buff.Bytes(), &decoded); err != nil {
+			t.Fatal(err)
+		}
+		expected := []*summaryGroup{
+			{Keys: map[string]string{"state": ""}, Total: 2},
+			{Keys: map[string]string{"state": "running"}, Total: 2},
+			{Keys: map[string]string{"state": "stopped"}, Total: 1},
+		}
+		if got, want := decoded.Groups, expected; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %s", buff.String())
+		}
+	})
+
+	if _, err := summarize(graphs, []string{"tag:"}, nil); err == nil {
+		t.Fatal("expected error")
+	}
+}
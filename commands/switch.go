@@ -23,6 +23,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/wallix/awless/aws/config"
 	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/logger"
 )
 
 func init() {
@@ -50,6 +51,9 @@ var switchCmd = &cobra.Command{
 		}
 		for _, arg := range args {
 			if awsconfig.IsValidRegion(arg) {
+				if from, to := config.GetAWSPartition(), awsconfig.PartitionForRegion(arg); from != to {
+					logger.Warningf("switching from partition '%s' to '%s': credentials are not shared across partitions, you might need to switch profile too", from, to)
+				}
 				exitOn(config.Set(config.RegionConfigKey, arg))
 				continue
 			}
@@ -93,6 +93,11 @@ var syncCmd = &cobra.Command{
 		for k, g := range graphs {
 			displaySyncStats(k, g)
 		}
+		if displayAllServices || *servicesToSyncFlags[awsservices.InfraService.Name()] {
+			if err := refreshInstanceTypesCatalog(config.GetAWSRegion()); err != nil {
+				logger.Warningf("cannot refresh instance types catalog: %s", err)
+			}
+		}
 		logger.Infof("sync took %s", time.Since(start))
 
 		return nil
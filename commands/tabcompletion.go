@@ -3,12 +3,15 @@ package commands
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"unicode"
 
 	"github.com/chzyer/readline"
+	"github.com/wallix/awless/aws/doc"
 	"github.com/wallix/awless/aws/services"
 	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/graph"
 	"github.com/wallix/awless/template"
 )
@@ -121,6 +124,72 @@ func holeAutoCompletion(g cloud.GraphAPI, paramPaths []string) readline.AutoComp
 	return &prefixCompleter{callback: completeFunc, splitChar: ","}
 }
 
+// resourceSuggestion is an existing resource offered as the value of a hole
+type resourceSuggestion struct {
+	Value, ID, Name, CIDR string
+}
+
+// holeResourceSuggestions returns the existing resources that can fill a hole
+// referencing resources (ex: instance.subnet), sorted by name then id
+func holeResourceSuggestions(g cloud.GraphAPI, paramPaths []string, typedParam *awsdoc.ParamType) (suggestions []resourceSuggestion) {
+	var types []string
+	var propName string
+	if typedParam != nil {
+		types, propName = []string{typedParam.ResourceType}, typedParam.PropertyName
+	} else {
+		for _, paramPath := range paramPaths {
+			splits := strings.Split(paramPath, ".")
+			if len(splits) != 3 {
+				continue
+			}
+			if entityTypes, entityProp := guessEntityTypeFromHoleQuestion(splits[1] + "." + splits[2]); len(entityTypes) > 0 && (entityProp == "" || keyCorrespondsToProperty(entityProp, "id")) {
+				types = append(types, entityTypes...)
+			}
+		}
+	}
+	if len(types) == 0 {
+		return
+	}
+	resources, err := g.Find(cloud.NewQuery(types...))
+	if err != nil {
+		return
+	}
+	seen := make(map[string]bool)
+	for _, res := range resources {
+		suggestion := resourceSuggestion{Value: res.Id(), ID: res.Id()}
+		if propName != "" {
+			val, ok := res.Properties()[propName].(string)
+			if !ok || val == "" {
+				continue
+			}
+			suggestion.Value = val
+		}
+		if seen[suggestion.Value] {
+			continue
+		}
+		seen[suggestion.Value] = true
+		suggestion.Name, _ = res.Properties()[properties.Name].(string)
+		suggestion.CIDR, _ = res.Properties()[properties.CIDR].(string)
+		suggestions = append(suggestions, suggestion)
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].Name == suggestions[j].Name {
+			return suggestions[i].ID < suggestions[j].ID
+		}
+		return suggestions[i].Name < suggestions[j].Name
+	})
+	return
+}
+
+// selectSuggestion returns the value of the suggestion numbered by the response, if any
+func selectSuggestion(response string, suggestions []resourceSuggestion) (string, bool) {
+	n, err := strconv.Atoi(strings.TrimSpace(response))
+	if err != nil || n < 1 || n > len(suggestions) {
+		return "", false
+	}
+	return suggestions[n-1].Value, true
+}
+
 type prefixCompleter struct {
 	callback  readline.DynamicCompleteFunc
 	splitChar string
@@ -200,6 +269,16 @@ func guessEntityTypeFromHoleQuestion(hole string) (resolved []string, prop strin
 		return
 	}
 
+	// dotted resource types (ex: route.table for routetable)
+	if joined := strings.Join(tokens, ""); len(tokens) > 1 {
+		for _, r := range resourcesTypesWithPlural {
+			if joined == r {
+				resolved = []string{cloud.SingularizeResource(r)}
+				return
+			}
+		}
+	}
+
 	var types []string
 	for _, t := range tokens {
 		for _, r := range resourcesTypesWithPlural {
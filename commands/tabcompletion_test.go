@@ -6,6 +6,7 @@ import (
 
 	"sort"
 
+	"github.com/wallix/awless/aws/doc"
 	p "github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/graph"
 	"github.com/wallix/awless/graph/resourcetest"
@@ -199,7 +200,7 @@ func TestGuessEntityType(t *testing.T) {
 		{hole: "any"},
 		{hole: "inst"},
 		{hole: "gateway"},
-		{hole: "gateway.", types: []string{"internetgateway", "natgateway"}},
+		{hole: "gateway.", types: []string{"internetgateway", "natgateway", "customergateway", "vpngateway", "transitgateway", "transitgatewayattachment", "transitgatewayroutetable"}},
 		{hole: "instance", types: []string{"instance"}},
 		{hole: "instance.ip", types: []string{"instance"}, prop: "ip"},
 		{hole: "securitygroup.id", types: []string{"securitygroup"}, prop: "id"},
@@ -209,14 +210,14 @@ func TestGuessEntityType(t *testing.T) {
 		{hole: "subnet.cidr", types: []string{"subnet"}, prop: "cidr"},
 		{hole: "subnet.cidr.any", types: []string{"subnet"}},
 		{hole: "vpc.instance", types: []string{"instance"}},
-		{hole: "route.gateway", types: []string{"internetgateway", "natgateway"}},
+		{hole: "route.gateway", types: []string{"internetgateway", "natgateway", "customergateway", "vpngateway", "transitgateway", "transitgatewayattachment", "transitgatewayroutetable"}},
 		{hole: "route.table", types: []string{"routetable"}},
 
 		{hole: "zone.1", types: []string{"zone"}, prop: "1"},
 		{hole: "availabilityzone.1", types: []string{"availabilityzone"}, prop: "1"},
 
-		{hole: "gateway.1", types: []string{"internetgateway", "natgateway"}},
-		{hole: "gateway.in", types: []string{"internetgateway", "natgateway"}},
+		{hole: "gateway.1", types: []string{"internetgateway", "natgateway", "customergateway", "vpngateway", "transitgateway", "transitgatewayattachment", "transitgatewayroutetable"}},
+		{hole: "gateway.in", types: []string{"internetgateway", "natgateway", "customergateway", "vpngateway", "transitgateway", "transitgatewayattachment", "transitgatewayroutetable"}},
 		{hole: "gateway.inst", types: []string{"instance", "containerinstance", "instanceprofile"}},
 
 		{hole: "gateway.inst.any", types: []string{"instance", "containerinstance", "instanceprofile"}},
@@ -250,3 +251,50 @@ func toRune(arr ...string) [][]rune {
 
 	return out
 }
+
+func TestHoleResourceSuggestions(t *testing.T) {
+	g := graph.NewGraph()
+	g.AddResource(resourcetest.Subnet("s-2").Prop(p.Name, "public").Prop(p.CIDR, "10.0.1.0/24").Build())
+	g.AddResource(resourcetest.Subnet("s-1").Prop(p.Name, "private").Prop(p.CIDR, "10.0.2.0/24").Build())
+	g.AddResource(resourcetest.Subnet("s-3").Build())
+	g.AddResource(resourcetest.Instance("i-1").Prop(p.Name, "web").Prop(p.Type, "t2.micro").Build())
+	g.AddResource(resourcetest.KeyPair("kp-1").Prop(p.Name, "my-key").Build())
+
+	suggestions := holeResourceSuggestions(g, []string{"create.instance.subnet"}, nil)
+	exp := []resourceSuggestion{
+		{Value: "s-3", ID: "s-3"},
+		{Value: "s-1", ID: "s-1", Name: "private", CIDR: "10.0.2.0/24"},
+		{Value: "s-2", ID: "s-2", Name: "public", CIDR: "10.0.1.0/24"},
+	}
+	if got, want := suggestions, exp; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+	if got, want := holeResourceSuggestions(g, []string{"create.instance.type"}, nil), []resourceSuggestion(nil); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+	typed := &awsdoc.ParamType{ResourceType: "keypair", PropertyName: p.Name}
+	if got, want := holeResourceSuggestions(g, []string{"create.instance.keypair"}, typed), []resourceSuggestion{{Value: "my-key", ID: "kp-1", Name: "my-key"}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+
+	tcases := []struct {
+		response string
+		exp      string
+		selected bool
+	}{
+		{response: "2", exp: "s-1", selected: true},
+		{response: " 3 ", exp: "s-2", selected: true},
+		{response: "0"},
+		{response: "4"},
+		{response: "@private"},
+	}
+	for i, tcase := range tcases {
+		val, ok := selectSuggestion(tcase.response, suggestions)
+		if got, want := ok, tcase.selected; got != want {
+			t.Fatalf("%d: got %t, want %t", i+1, got, want)
+		}
+		if got, want := val, tcase.exp; got != want {
+			t.Fatalf("%d: got %s, want %s", i+1, got, want)
+		}
+	}
+}
@@ -31,6 +31,9 @@ var tailNumberEventsFlag int
 var stackEventsFilters []string
 var stackEventsTailTimeout time.Duration
 var cancelStackUpdateAfterTimeout bool
+var logEventsFilterFlag string
+var logEventsStreamsFlag []string
+var logEventsSinceFlag time.Duration
 
 func init() {
 	RootCmd.AddCommand(tailCmd)
@@ -54,6 +57,12 @@ func init() {
 	stackEventsCmd.PersistentFlags().DurationVar(&stackEventsTailTimeout, "timeout", time.Duration(1*time.Hour), "Time to wait for stack update to complete, use with 'follow' flag")
 
 	tailCmd.AddCommand(stackEventsCmd)
+
+	logEventsCmd.PersistentFlags().StringVar(&logEventsFilterFlag, "filter", "", "Only display the log events matching this CloudWatch Logs filter pattern (ex: ERROR, '{ $.status = 500 }')")
+	logEventsCmd.PersistentFlags().StringSliceVar(&logEventsStreamsFlag, "stream", nil, "Only display the log events of these log streams")
+	logEventsCmd.PersistentFlags().DurationVar(&logEventsSinceFlag, "since", time.Hour, "Display the last log events of this period")
+
+	tailCmd.AddCommand(logEventsCmd)
 }
 
 var tailCmd = &cobra.Command{
@@ -85,3 +94,17 @@ var stackEventsCmd = &cobra.Command{
 		exitOn(awstailers.NewCloudformationEventsTailer(args[0], tailNumberEventsFlag, tailEnableFollowFlag, tailFollowFrequencyFlag, stackEventsFilters, stackEventsTailTimeout, cancelStackUpdateAfterTimeout).Tail(os.Stdout))
 	},
 }
+
+var logEventsCmd = &cobra.Command{
+	Use:     "loggroup NAME",
+	Short:   "Watch the log events of a log group",
+	Example: "  awless tail loggroup /aws/lambda/my-function --follow\n  awless tail loggroup my-app/access-logs --filter ERROR --stream web-1,web-2 --since 24h -n 50",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 1 {
+			exitOn(fmt.Errorf("expecting log group name"))
+		}
+
+		exitOn(awstailers.NewLogEventsTailer(args[0], logEventsFilterFlag, logEventsStreamsFlag, logEventsSinceFlag, tailNumberEventsFlag, tailEnableFollowFlag, tailFollowFrequencyFlag).Tail(os.Stdout))
+	},
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/spec"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync"
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/registry"
+)
+
+var templateListFormat string
+
+func init() {
+	RootCmd.AddCommand(templateCmd)
+	templateCmd.AddCommand(explainTemplateCmd)
+	templateCmd.AddCommand(listTemplatesCmd)
+	templateCmd.AddCommand(addTemplateCmd)
+	templateCmd.AddCommand(policyTemplateCmd)
+
+	listTemplatesCmd.Flags().StringVar(&templateListFormat, "format", "table", "Output format: table or json")
+}
+
+var templateCmd = &cobra.Command{
+	Use:               "template",
+	Short:             "Explain templates and manage the registry of named templates",
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook),
+}
+
+var explainTemplateCmd = &cobra.Command{
+	Use:     "explain PATH",
+	Short:   "Describe each statement of a template in plain language, with its references and the parameters still to be provided",
+	Example: "  awless template explain ~/templates/my-infra.aws\n  awless template explain repo:create_vpc",
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("missing PATH arg (filepath or url)")
+		}
+		content, _, err := getTemplateText(args[0])
+		exitOn(err)
+
+		templ, err := template.Parse(string(content))
+		exitOn(err)
+
+		var aliasFunc func(paramPath, alias string) string
+		if _, err := sync.LoadLocalGraphs(config.GetAWSProfile(), config.GetAWSRegion()); err != nil {
+			logger.Verbosef("aliases will not be resolved: %s", err)
+		} else {
+			aliasFunc = resolveAliasFunc
+		}
+
+		sentences, holes := templ.Explain(aliasFunc)
+		for _, s := range sentences {
+			fmt.Println(s)
+		}
+		fmt.Println()
+		if len(holes) > 0 {
+			fmt.Printf("Parameters to be provided (prompted or given as 'key=value' to 'awless run'): %s\n", strings.Join(holes, ", "))
+		} else {
+			fmt.Println("No parameters to be provided")
+		}
+		return nil
+	},
+}
+
+var policyTemplateCmd = &cobra.Command{
+	Use:     "policy PATH",
+	Short:   "Print the least-privilege IAM policy (JSON) allowing to run a template, from the AWS calls of its statements",
+	Example: "  awless template policy ~/templates/my-infra.aws\n  awless template policy repo:create_vpc > policy.json",
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("missing PATH arg (filepath or url)")
+		}
+		content, _, err := getTemplateText(args[0])
+		exitOn(err)
+
+		templ, err := template.Parse(string(content))
+		exitOn(err)
+
+		factory := &awsspec.AWSFactory{Log: logger.DiscardLogger}
+		cenv := template.NewEnv().WithLookupCommandFunc(func(tokens ...string) interface{} {
+			if newCommandFunc := factory.Build(strings.Join(tokens, "")); newCommandFunc != nil {
+				return newCommandFunc()
+			}
+			return nil
+		}).WithAccountLookupCommandFunc(func(account, role, key string) (interface{}, error) {
+			return nil, errors.New("statements not run when generating the policy of a template")
+		}).Build()
+		templ, _, err = template.Compile(templ, cenv, template.StatementsCompileMode)
+		exitOn(err)
+
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(templatePolicy(templ, config.GetAWSRegion()))
+	},
+}
+
+var listTemplatesCmd = &cobra.Command{
+	Use:     "list [SEARCH]",
+	Short:   "List (or search) the named templates of the local and remote registries, with their versions, descriptions and holes",
+	Example: "  awless template list\n  awless template list vpc\n  awless run aws/create_instance@v2",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		var entries []*registry.Entry
+		var err error
+		if len(args) > 0 {
+			entries, err = templateRegistry().Search(strings.Join(args, " "))
+		} else {
+			entries, err = templateRegistry().List()
+		}
+		exitOn(err)
+		exitOn(printTemplateEntries(os.Stdout, entries, templateListFormat))
+	},
+}
+
+var addTemplateCmd = &cobra.Command{
+	Use:     "add NAME@VERSION PATH",
+	Short:   "Store a template file in the local registry under a name and version, to be run with 'awless run NAME@VERSION'",
+	Example: "  awless template add aws/create_instance@v2 ./create_instance.aws",
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 2 {
+			return errors.New("expecting NAME@VERSION and PATH args")
+		}
+		ref, err := registry.ParseRef(args[0])
+		exitOn(err)
+		content, err := ioutil.ReadFile(args[1])
+		exitOn(err)
+		_, err = template.Parse(string(content))
+		exitOn(err)
+		exitOn(registry.DirSource(config.TemplatesDir).Add(ref, content))
+		logger.Infof("template %s stored in local registry %s", ref, config.TemplatesDir)
+		return nil
+	},
+}
+
+func printTemplateEntries(w io.Writer, entries []*registry.Entry, format string) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(entries)
+	case "table", "":
+		t := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(t, "NAME\tVERSIONS\tDESCRIPTION\tHOLES")
+		for _, e := range entries {
+			fmt.Fprintf(t, "%s\t%s\t%s\t%s\n", e.Name, strings.Join(e.Versions, ","), e.Description, strings.Join(e.Holes, ","))
+		}
+		return t.Flush()
+	default:
+		return fmt.Errorf("unsupported format '%s' for templates: expected table or json", format)
+	}
+}
+
+// templateRegistry returns the registry of named templates: the local one,
+// then the remote one configured, if any
+func templateRegistry() *registry.Registry {
+	sources := []registry.Source{registry.DirSource(config.TemplatesDir)}
+	if location := config.GetTemplateRegistry(); location != "" {
+		remote, err := registry.NewRemoteSource(location, filepath.Join(os.Getenv("__AWLESS_CACHE"), "templates"))
+		if err != nil {
+			logger.Warning(err)
+		} else {
+			sources = append(sources, remote)
+		}
+	}
+	return registry.New(sources...)
+}
+
+// registryRef returns the registry reference of a template, when the path
+// is not an existing file
+func registryRef(path string) (registry.Ref, bool) {
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		return registry.Ref{}, false
+	}
+	ref, err := registry.ParseRef(path)
+	return ref, err == nil
+}
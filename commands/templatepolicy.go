@@ -0,0 +1,102 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/aws/spec"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template"
+)
+
+type iamPolicyDocument struct {
+	Version   string
+	Statement []iamPolicyStatement
+}
+
+type iamPolicyStatement struct {
+	Effect   string
+	Action   []string
+	Resource interface{}
+}
+
+// templatePolicy returns the least-privilege IAM policy allowing to run the statements of
+// a template compiled in StatementsCompileMode: the AWS actions of their commands, and
+// the assuming of the roles of the statements running in other accounts.
+// Resources being only known at run time, the actions are allowed on all of them
+func templatePolicy(tpl *template.Template, region string) *iamPolicyDocument {
+	var actions, roles []string
+	seenActions, seenRoles := make(map[string]struct{}), make(map[string]struct{})
+	for _, cmd := range tpl.CommandNodesIterator() {
+		statement := fmt.Sprintf("%s %s", cmd.Action, cmd.Entity)
+		if cmd.IsModifier(template.AccountModifier) {
+			role := "*"
+			params := cmd.ToDriverParams()
+			account, _ := params[template.AccountModifier].(string)
+			roleName, _ := params[template.RoleModifier].(string)
+			if account != "" && (roleName != "" || !cmd.IsModifier(template.RoleModifier)) {
+				if arn, err := awsservices.AccountRoleARN(account, roleName, region); err == nil {
+					role = arn
+				}
+			}
+			if role == "*" {
+				logger.Verbosef("role assumed by '%s' unknown before running the template: allowing to assume any role", statement)
+			}
+			if _, ok := seenRoles[role]; !ok {
+				seenRoles[role] = struct{}{}
+				roles = append(roles, role)
+			}
+			logger.Verbosef("'%s' runs in another account, with the permissions of the role assumed there", statement)
+			continue
+		}
+		params := cmd.ToDriverParams()
+		for _, k := range cmd.Keys() {
+			if _, ok := params[k]; !ok {
+				params[k] = nil
+			}
+		}
+		needed, known := awsspec.CommandIAMActions(cmd.Command, params)
+		if !known {
+			logger.Warningf("unknown permissions of '%s', not in the policy", statement)
+			continue
+		}
+		for _, action := range needed {
+			if _, ok := seenActions[action]; !ok {
+				seenActions[action] = struct{}{}
+				actions = append(actions, action)
+			}
+		}
+	}
+
+	doc := &iamPolicyDocument{Version: "2012-10-17"}
+	if len(actions) > 0 {
+		sort.Strings(actions)
+		doc.Statement = append(doc.Statement, iamPolicyStatement{Effect: "Allow", Action: actions, Resource: "*"})
+	}
+	if len(roles) > 0 {
+		var resource interface{} = roles
+		if _, anyRole := seenRoles["*"]; anyRole {
+			resource = "*"
+		} else {
+			sort.Strings(roles)
+		}
+		doc.Statement = append(doc.Statement, iamPolicyStatement{Effect: "Allow", Action: []string{"sts:AssumeRole"}, Resource: resource})
+	}
+	return doc
+}
@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/aws/spec"
+	"github.com/wallix/awless/template"
+)
+
+func TestTemplatePolicy(t *testing.T) {
+	cenv := template.NewEnv().WithLookupCommandFunc(func(tokens ...string) interface{} {
+		return awsspec.MockAWSSessionFactory.Build(strings.Join(tokens, ""))()
+	}).WithAccountLookupCommandFunc(func(account, role, key string) (interface{}, error) {
+		return nil, nil
+	}).Build()
+	compile := func(text string) *template.Template {
+		tpl, _, err := template.Compile(template.MustParse(text), cenv, template.StatementsCompileMode)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tpl
+	}
+
+	policy := templatePolicy(compile(`vpc = create vpc cidr={vpc.cidr}
+create subnet cidr=10.0.0.0/24 vpc=$vpc
+ensure subnet cidr=10.0.1.0/24 vpc=$vpc
+attach policy arn=arn:my:policy role={role.name}
+delete instance ids=@web
+create bucket name=logs account=123456789012
+create bucket name=backups account=arn:aws:iam::210987654321:role/deployer
+delete bucket name=logs account=123456789012 role=admin`), "us-west-1")
+	exp := &iamPolicyDocument{Version: "2012-10-17", Statement: []iamPolicyStatement{
+		{Effect: "Allow", Action: []string{"ec2:CreateSubnet", "ec2:CreateVpc", "ec2:TerminateInstances", "iam:AttachRolePolicy"}, Resource: "*"},
+		{Effect: "Allow", Action: []string{"sts:AssumeRole"}, Resource: []string{
+			"arn:aws:iam::123456789012:role/OrganizationAccountAccessRole",
+			"arn:aws:iam::123456789012:role/admin",
+			"arn:aws:iam::210987654321:role/deployer",
+		}},
+	}}
+	if !reflect.DeepEqual(policy, exp) {
+		t.Fatalf("got %#v, want %#v", policy, exp)
+	}
+
+	policy = templatePolicy(compile(`create bucket name=logs account={prod.account}
+create bucket name=logs account=123456789012`), "cn-north-1")
+	exp = &iamPolicyDocument{Version: "2012-10-17", Statement: []iamPolicyStatement{
+		{Effect: "Allow", Action: []string{"sts:AssumeRole"}, Resource: "*"},
+	}}
+	if !reflect.DeepEqual(policy, exp) {
+		t.Fatalf("got %#v, want %#v", policy, exp)
+	}
+}
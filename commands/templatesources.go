@@ -0,0 +1,159 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/chzyer/readline"
+	"github.com/wallix/awless/aws/spec"
+)
+
+const stdinTemplatePath = "-"
+
+// readStdinTemplate reads the template from stdin, then switches the prompts
+// (missing holes, confirmation) to the terminal when there is one
+func readStdinTemplate() (content []byte, err error) {
+	if content, err = ioutil.ReadAll(os.Stdin); err != nil {
+		return content, err
+	}
+	tty, err := os.Open("/dev/tty")
+	if err != nil {
+		noTerminalForPrompts = true
+		return content, nil
+	}
+	os.Stdin = tty
+	readline.Stdin = tty
+	return content, nil
+}
+
+// readS3Template reads a template stored at 's3://bucket/key'
+func readS3Template(path string) ([]byte, error) {
+	bucketAndKey := strings.SplitN(strings.TrimPrefix(path, "s3://"), "/", 2)
+	if len(bucketAndKey) != 2 || bucketAndKey[0] == "" || bucketAndKey[1] == "" {
+		return nil, fmt.Errorf("invalid S3 template '%s': expecting s3://bucket/key", path)
+	}
+	factory, ok := awsspec.CommandFactory.(*awsspec.AWSFactory)
+	if !ok || factory.Sess == nil {
+		return nil, fmt.Errorf("no AWS session to fetch template %s", path)
+	}
+	out, err := s3.New(factory.Sess).GetObject(&s3.GetObjectInput{Bucket: awssdk.String(bucketAndKey[0]), Key: awssdk.String(bucketAndKey[1])})
+	if err != nil {
+		return nil, fmt.Errorf("fetching template %s: %s", path, err)
+	}
+	defer out.Body.Close()
+	return ioutil.ReadAll(out.Body)
+}
+
+// parseGitTemplatePath splits 'git+URL//path/in/repo.aws[?ref=REF]'
+// into the repository URL, the file path and the optional git reference
+func parseGitTemplatePath(path string) (repo, file, ref string, err error) {
+	rest := strings.TrimPrefix(path, "git+")
+	if i := strings.LastIndex(rest, "?ref="); i > -1 {
+		rest, ref = rest[:i], rest[i+len("?ref="):]
+	}
+	var start int
+	if i := strings.Index(rest, "://"); i > -1 {
+		start = i + len("://")
+	}
+	j := strings.Index(rest[start:], "//")
+	if j < 1 || start+j+2 == len(rest) {
+		return "", "", "", fmt.Errorf("invalid git template '%s': expecting git+URL//path/to/template.aws[?ref=REF]", path)
+	}
+	return rest[:start+j], rest[start+j+2:], ref, nil
+}
+
+// readGitTemplate reads a template from a git repository, cloned in a temporary directory
+func readGitTemplate(path string) ([]byte, error) {
+	repo, file, ref, err := parseGitTemplatePath(path)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := ioutil.TempDir("", "awless-git-template")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	clone := []string{"clone", "--quiet", "--depth", "1"}
+	if ref != "" {
+		clone = append(clone, "--branch", ref)
+	}
+	if err = runGit(append(clone, repo, dir)...); err != nil && ref != "" {
+		// ref is not a branch nor a tag: fully clone to checkout a commit
+		os.RemoveAll(dir)
+		if err = runGit("clone", "--quiet", repo, dir); err == nil {
+			err = runGit("-C", dir, "checkout", "--quiet", ref)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(file)))
+}
+
+func runGit(args ...string) error {
+	if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("git %s: %s: %s", args[0], err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+func isRemoteTemplatePath(path string) bool {
+	for _, prefix := range []string{"http://", "https://", "s3://", "git+", "repo:"} {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func templateChecksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// verifyTemplateChecksum fails when the template content does not have the pinned SHA256
+func verifyTemplateChecksum(path string, content []byte, expected string) error {
+	if actual := templateChecksum(content); !strings.EqualFold(actual, strings.TrimSpace(expected)) {
+		return fmt.Errorf("checksum mismatch for template %s: expected sha256 %s, got %s. Refusing to run possibly tampered content", path, expected, actual)
+	}
+	return nil
+}
+
+// noTerminalForPrompts is set when the template is read from stdin
+// and no terminal is available to prompt the user
+var noTerminalForPrompts bool
+
+func missingHolesNonInteractiveFunc() func(string, []string, bool) string {
+	return func(hole string, paramPaths []string, optional bool) string {
+		if optional {
+			return ""
+		}
+		exitOn(fmt.Errorf("missing value for '%s': template read from stdin without terminal, provide it as argument: awless run - %s=...", hole, hole))
+		return ""
+	}
+}
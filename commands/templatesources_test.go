@@ -0,0 +1,99 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseGitTemplatePath(t *testing.T) {
+	tcases := []struct {
+		in                       string
+		expRepo, expFile, expRef string
+		expErr                   bool
+	}{
+		{in: "git+https://github.com/me/infra.git//templates/vpc.aws", expRepo: "https://github.com/me/infra.git", expFile: "templates/vpc.aws"},
+		{in: "git+https://github.com/me/infra.git//vpc.aws?ref=v1.2", expRepo: "https://github.com/me/infra.git", expFile: "vpc.aws", expRef: "v1.2"},
+		{in: "git+ssh://git@github.com/me/infra.git//vpc.aws?ref=4e1243b", expRepo: "ssh://git@github.com/me/infra.git", expFile: "vpc.aws", expRef: "4e1243b"},
+		{in: "git+git@github.com:me/infra.git//a/b.aws", expRepo: "git@github.com:me/infra.git", expFile: "a/b.aws"},
+		{in: "git+https://github.com/me/infra.git", expErr: true},
+		{in: "git+https://github.com/me/infra.git//", expErr: true},
+	}
+	for i, tcase := range tcases {
+		repo, file, ref, err := parseGitTemplatePath(tcase.in)
+		if tcase.expErr {
+			if err == nil {
+				t.Fatalf("%d: expected error", i+1)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if repo != tcase.expRepo || file != tcase.expFile || ref != tcase.expRef {
+			t.Fatalf("%d: got %s %s %s, want %s %s %s", i+1, repo, file, ref, tcase.expRepo, tcase.expFile, tcase.expRef)
+		}
+	}
+}
+
+func TestReadGitTemplate(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	repo, err := ioutil.TempDir("", "awless-git-repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(repo)
+
+	git := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-C", repo, "-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %s: %s", args, err, out)
+		}
+	}
+	git("init", "--quiet")
+	os.MkdirAll(filepath.Join(repo, "templates"), 0700)
+	ioutil.WriteFile(filepath.Join(repo, "templates", "vpc.aws"), []byte("create vpc cidr=10.0.0.0/16"), 0600)
+	git("add", "-A")
+	git("commit", "--quiet", "-m", "v1")
+	git("tag", "v1")
+	ioutil.WriteFile(filepath.Join(repo, "templates", "vpc.aws"), []byte("create vpc cidr=10.1.0.0/16"), 0600)
+	git("commit", "--quiet", "-am", "v2")
+
+	tcases := []struct {
+		path, exp string
+	}{
+		{path: "git+file://" + repo + "//templates/vpc.aws", exp: "create vpc cidr=10.1.0.0/16"},
+		{path: "git+file://" + repo + "//templates/vpc.aws?ref=v1", exp: "create vpc cidr=10.0.0.0/16"},
+		{path: "git+file://" + repo + "//templates/vpc.aws?ref=HEAD~1", exp: "create vpc cidr=10.0.0.0/16"},
+	}
+	for i, tcase := range tcases {
+		content, err := readGitTemplate(tcase.path)
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if got, want := string(content), tcase.exp; got != want {
+			t.Fatalf("%d: got %s, want %s", i+1, got, want)
+		}
+	}
+	if _, err := readGitTemplate("git+file://" + repo + "//templates/unknown.aws"); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestVerifyTemplateChecksum(t *testing.T) {
+	content := []byte("create vpc cidr=10.0.0.0/16")
+	sum := templateChecksum(content)
+	if err := verifyTemplateChecksum("vpc.aws", content, sum); err != nil {
+		t.Fatal(err)
+	}
+	if err := verifyTemplateChecksum("vpc.aws", []byte("create vpc cidr=0.0.0.0/0"), sum); err == nil {
+		t.Fatal("expected checksum error")
+	}
+	if err := verifyTemplateChecksum("vpc.aws", content, "invalid"); err == nil {
+		t.Fatal("expected checksum error")
+	}
+}
@@ -26,7 +26,9 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/config"
 	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/config"
 	"github.com/wallix/awless/logger"
 )
 
@@ -70,6 +72,10 @@ var whoamiCmd = &cobra.Command{
 			logger.Warning("awless official templates might help https://github.com/wallix/awless-templates\n")
 		}
 
+		if region := config.GetAWSRegion(); me.Partition != "" && me.Partition != awsconfig.PartitionForRegion(region) {
+			logger.Warningf("Your credentials belong to partition '%s' but current region '%s' is in partition '%s'", me.Partition, region, awsconfig.PartitionForRegion(region))
+		}
+
 		switch {
 		case onlyMyAccountFlag:
 			fmt.Println(me.Account)
@@ -90,10 +96,12 @@ var whoamiCmd = &cobra.Command{
 
 		if !me.IsUserType() {
 			fmt.Printf("ResourceType: %s, Resource: %s, Id: %s, Account: %s\n", me.ResourceType, me.Resource, me.UserId, me.Account)
+			fmt.Printf("Console: %s\n", awsconfig.ConsoleURL(config.GetAWSRegion()))
 			return
 		}
 
 		fmt.Printf("Username: %s, Id: %s, Account: %s\n", me.Resource, me.UserId, me.Account)
+		fmt.Printf("Console: %s\n", awsconfig.ConsoleURL(config.GetAWSRegion()))
 
 		policies, err := awsservices.AccessService.(*awsservices.Access).GetUserPolicies(me.Resource)
 		if err != nil {
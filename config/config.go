@@ -9,6 +9,7 @@ import (
 	"text/tabwriter"
 
 	"github.com/wallix/awless/aws/config"
+	"github.com/wallix/awless/aws/services"
 	"github.com/wallix/awless/aws/spec"
 	"github.com/wallix/awless/database"
 )
@@ -24,8 +25,10 @@ const (
 
 	//Config
 	autosyncConfigKey              = "autosync"
+	autosyncMaxAgeConfigKey        = "autosync.maxage"
 	checkUpgradeFrequencyConfigKey = "upgrade.checkfrequency"
 	schedulerURL                   = "scheduler.url"
+	templateRegistryConfigKey      = "template.registry"
 	RegionConfigKey                = "aws.region"
 	ProfileConfigKey               = "aws.profile"
 
@@ -34,23 +37,27 @@ const (
 )
 
 var configDefinitions = map[string]*Definition{
-	autosyncConfigKey:              {help: "Automatically synchronize your cloud locally", defaultValue: "true", parseParamFn: parseBool},
-	RegionConfigKey:                {help: "AWS region", parseParamFn: awsconfig.ParseRegion, stdinParamProviderFn: awsconfig.StdinRegionSelector, onUpdateFns: []onUpdateFunc{runSyncWithUpdatedRegion}},
-	ProfileConfigKey:               {help: "AWS profile", defaultValue: "default"},
-	"aws.infra.sync":               {help: "Enable/disable sync of infra services (EC2, RDS, etc.) (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
-	"aws.access.sync":              {help: "Enable/disable sync of IAM service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
-	"aws.storage.sync":             {help: "Enable/disable sync of S3 service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
-	"aws.storage.s3object.sync":    {help: "Enable/disable sync of S3/s3object (when empty: true)", defaultValue: "false", parseParamFn: parseBool},
-	"aws.dns.sync":                 {help: "Enable/disable sync of DNS service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
-	"aws.dns.record.sync":          {help: "Enable/disable sync of DNS/record (when empty: true)", defaultValue: "false", parseParamFn: parseBool},
-	"aws.notification.sync":        {help: "Enable/disable sync of SNS service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
-	"aws.monitoring.sync":          {help: "Enable/disable sync of CloudWatch service (when empty: true)", defaultValue: "false", parseParamFn: parseBool},
-	"aws.lambda.sync":              {help: "Enable/disable sync of Lambda service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
-	"aws.messaging.sync":           {help: "Enable/disable sync of SQS/SNS service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
-	"aws.cdn.sync":                 {help: "Enable/disable sync of CloudFront service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
-	"aws.cloudformation.sync":      {help: "Enable/disable sync of CloudFormation service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
-	checkUpgradeFrequencyConfigKey: {help: "Upgrade check frequency (hours); a negative value disables check", defaultValue: "8", parseParamFn: parseInt},
-	schedulerURL:                   {help: "URL used by awless CLI to interact with pre-installed https://github.com/wallix/awless-scheduler", defaultValue: "http://localhost:8082"},
+	autosyncConfigKey:                {help: "Automatically synchronize your cloud locally", defaultValue: "true", parseParamFn: parseBool},
+	autosyncMaxAgeConfigKey:          {help: "Before reading the local data, sync the services synced longer ago than this number of minutes (when 0: disabled)", defaultValue: "0", parseParamFn: parseInt},
+	RegionConfigKey:                  {help: "AWS region", parseParamFn: awsconfig.ParseRegion, stdinParamProviderFn: awsconfig.StdinRegionSelector, onUpdateFns: []onUpdateFunc{runSyncWithUpdatedRegion}},
+	ProfileConfigKey:                 {help: "AWS profile", defaultValue: "default"},
+	"aws.infra.sync":                 {help: "Enable/disable sync of infra services (EC2, RDS, etc.) (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.access.sync":                {help: "Enable/disable sync of IAM service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.storage.sync":               {help: "Enable/disable sync of S3 service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.storage.s3object.sync":      {help: "Enable/disable sync of S3/s3object (when empty: true)", defaultValue: "false", parseParamFn: parseBool},
+	"aws.dns.sync":                   {help: "Enable/disable sync of DNS service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.dns.record.sync":            {help: "Enable/disable sync of DNS/record (when empty: true)", defaultValue: "false", parseParamFn: parseBool},
+	"aws.notification.sync":          {help: "Enable/disable sync of SNS service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.monitoring.sync":            {help: "Enable/disable sync of CloudWatch service (when empty: true)", defaultValue: "false", parseParamFn: parseBool},
+	"aws.monitoring.trailevent.sync": {help: "Enable/disable sync of the recent CloudTrail events changing resources (when empty: true)", defaultValue: "false", parseParamFn: parseBool},
+	"aws.lambda.sync":                {help: "Enable/disable sync of Lambda service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.messaging.sync":             {help: "Enable/disable sync of SQS/SNS service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.cdn.sync":                   {help: "Enable/disable sync of CloudFront service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.cloudformation.sync":        {help: "Enable/disable sync of CloudFormation service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.ratelimit.default":          {help: "Max requests per second sent to each AWS service, overridden per service with aws.ratelimit.<service> (ex: aws.ratelimit.ec2); 0 is unlimited. Throttled requests are retried at a lower rate", defaultValue: "0", parseParamFn: parseRate},
+	checkUpgradeFrequencyConfigKey:   {help: "Upgrade check frequency (hours); a negative value disables check", defaultValue: "8", parseParamFn: parseInt},
+	schedulerURL:                     {help: "URL used by awless CLI to interact with pre-installed https://github.com/wallix/awless-scheduler", defaultValue: "http://localhost:8082"},
+	templateRegistryConfigKey:        {help: "Remote registry of named templates, looked up after the local one: http(s)://, s3://bucket/prefix or git+ URL (when empty: local only)"},
 }
 
 var defaultsDefinitions = map[string]*Definition{
@@ -185,6 +192,45 @@ func Get(key string) (interface{}, bool) {
 	return v, ok
 }
 
+// Validate reports the loaded config and defaults values that awless would refuse to set,
+// the deprecated keys still in use, the missing required keys and a region outside
+// the partition of the region of the AWS profile
+func Validate() (errs []error) {
+	validate := func(values map[string]interface{}, definitions map[string]*Definition) {
+		var keys []string
+		for k := range values {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if newKey, ok := deprecated[k]; ok {
+				errs = append(errs, fmt.Errorf("%s: deprecated key, replaced by %s", k, newKey))
+				continue
+			}
+			parseFn := func(string) (interface{}, error) { return nil, nil }
+			if def, ok := definitions[k]; ok && def.parseParamFn != nil {
+				parseFn = def.parseParamFn
+			} else if strings.HasPrefix(k, awsservices.RateLimitConfigPrefix) {
+				parseFn = parseRate
+			}
+			if _, err := parseFn(fmt.Sprint(values[k])); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %s", k, err))
+			}
+		}
+	}
+	validate(Config, configDefinitions)
+	validate(Defaults, defaultsDefinitions)
+
+	if v, ok := Config[RegionConfigKey]; !ok || fmt.Sprint(v) == "" {
+		errs = append(errs, fmt.Errorf("%s: missing required key", RegionConfigKey))
+	} else if region := fmt.Sprint(v); awsconfig.IsValidRegion(region) {
+		if err := awsconfig.ValidateRegionForProfile(region, GetAWSProfile()); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %s", RegionConfigKey, err))
+		}
+	}
+	return
+}
+
 func SetVolatile(key, value string) error {
 	_, _, _, err := setVolatile(key, value)
 	return err
@@ -218,6 +264,14 @@ func parseInt(a string) (interface{}, error) {
 	return i, nil
 }
 
+func parseRate(a string) (interface{}, error) {
+	f, err := strconv.ParseFloat(a, 64)
+	if err != nil || f < 0 {
+		return f, fmt.Errorf("invalid value, expected a positive number of requests per second, got '%s'", a)
+	}
+	return f, nil
+}
+
 func defaultParser(value string) (interface{}, error) {
 	if num, err := strconv.Atoi(value); err == nil {
 		return num, nil
@@ -364,7 +418,7 @@ func runSyncWithUpdatedRegion(i interface{}) {
 		return
 	}
 
-	if !awsconfig.IsValidRegion(fmt.Sprint(i)) {
+	if awsconfig.ValidateRegionForProfile(fmt.Sprint(i), GetAWSProfile()) != nil {
 		return
 	}
 
@@ -171,3 +171,38 @@ func TestDefaults(t *testing.T) {
 		}
 	})
 }
+
+func TestValidate(t *testing.T) {
+	defer func(confDefs, defDefs map[string]*Definition, conf, defs map[string]interface{}) {
+		configDefinitions, defaultsDefinitions, Config, Defaults = confDefs, defDefs, conf, defs
+	}(configDefinitions, defaultsDefinitions, Config, Defaults)
+
+	configDefinitions = map[string]*Definition{
+		"aws.region":     {help: "AWS region"},
+		"aws.infra.sync": {parseParamFn: parseBool},
+	}
+	defaultsDefinitions = map[string]*Definition{
+		"instance.count": {parseParamFn: parseInt},
+	}
+	Config = map[string]interface{}{"aws.infra.sync": "maybe", "aws.ratelimit.ec2": "-1", "aws.ratelimit.iam": 2.5, "region": "eu-west-1"}
+	Defaults = map[string]interface{}{"instance.count": 2, "instance.type": "t2.micro"}
+
+	var errs []string
+	for _, err := range Validate() {
+		errs = append(errs, err.Error())
+	}
+	expected := []string{
+		"aws.infra.sync: invalid value, expected a boolean, got 'maybe'",
+		"aws.ratelimit.ec2: invalid value, expected a positive number of requests per second, got '-1'",
+		"region: deprecated key, replaced by aws.region",
+		"aws.region: missing required key",
+	}
+	if got, want := errs, expected; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+
+	Config = map[string]interface{}{"aws.region": "eu-west-1", "aws.infra.sync": true}
+	if errs := Validate(); len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+}
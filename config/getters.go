@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"github.com/wallix/awless/aws/config"
 )
 
 func GetAWSRegion() string {
@@ -16,6 +18,10 @@ func GetAWSRegion() string {
 	return ""
 }
 
+func GetAWSPartition() string {
+	return awsconfig.PartitionForRegion(GetAWSRegion())
+}
+
 const defaultAWSSessionProfile = "default"
 
 func GetAWSProfile() string {
@@ -38,6 +44,15 @@ func GetAutosync() bool {
 	return true
 }
 
+// GetAutosyncMaxAge returns the age above which the local data of a service is synced
+// before being read, 0 when disabled
+func GetAutosyncMaxAge() time.Duration {
+	if minutes, ok := Config[autosyncMaxAgeConfigKey].(int); ok && minutes > 0 {
+		return time.Duration(minutes) * time.Minute
+	}
+	return 0
+}
+
 func GetSchedulerURL() string {
 	if u, ok := Config[schedulerURL].(string); ok {
 		return u
@@ -45,6 +60,13 @@ func GetSchedulerURL() string {
 	return ""
 }
 
+func GetTemplateRegistry() string {
+	if u, ok := Config[templateRegistryConfigKey].(string); ok {
+		return u
+	}
+	return ""
+}
+
 func GetConfigWithPrefix(prefix string) map[string]interface{} {
 	conf := make(map[string]interface{})
 	for k, v := range Config {
@@ -32,6 +32,8 @@ var (
 	DBPath             = filepath.Join(AwlessHome, database.Filename)
 	Dir                = filepath.Join(AwlessHome, "aws")
 	KeysDir            = filepath.Join(AwlessHome, "keys")
+	TemplatesDir       = filepath.Join(AwlessHome, "templates")
+	PluginsDir         = filepath.Join(AwlessHome, "plugins")
 	AwlessFirstInstall bool
 )
 
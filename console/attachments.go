@@ -0,0 +1,149 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package console
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/match"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/cloud/rdf"
+)
+
+// AttachmentsColumnDefinitions are the columns added to a listing with --attachments,
+// joining the resources related in the graph to the listed ones
+var AttachmentsColumnDefinitions = map[string][]ColumnDefinition{
+	cloud.Volume: {
+		JoinColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "InstanceName"}, Join: joinNames(cloud.Instance, properties.Instances)},
+		SliceColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Devices}},
+	},
+	cloud.NetworkInterface: {
+		JoinColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "InstanceName"}, Join: joinNames(cloud.Instance, properties.Instance)},
+		JoinColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "SubnetName"}, Join: joinNames(cloud.Subnet, properties.Subnet)},
+		JoinColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "SubnetCIDR"}, Join: joinProperty(cloud.Subnet, properties.Subnet, properties.CIDR)},
+	},
+	cloud.Instance: {
+		JoinColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "TargetGroups"}, Join: joinInstanceTargetGroups},
+		JoinColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "LoadBalancers"}, Join: joinInstanceLoadBalancers},
+	},
+}
+
+// JoinColumnDefinition displays values computed from the resources related in the graph to the displayed one
+type JoinColumnDefinition struct {
+	StringColumnDefinition
+	Join func(g cloud.GraphAPI, res cloud.Resource) interface{}
+}
+
+// joinProperty displays the given property of the resources of type typ whose ids are in the idProp property
+func joinProperty(typ, idProp, prop string) func(cloud.GraphAPI, cloud.Resource) interface{} {
+	return func(g cloud.GraphAPI, res cloud.Resource) interface{} {
+		var values []string
+		for _, id := range propertyIds(res, idProp) {
+			related, err := g.FindOne(cloud.NewQuery(typ).Match(match.Property(properties.ID, id)))
+			if err != nil || related == nil {
+				continue
+			}
+			if v, ok := related.Property(prop); ok {
+				values = append(values, fmt.Sprint(v))
+			}
+		}
+		return joinValues(values)
+	}
+}
+
+func joinNames(typ, idProp string) func(cloud.GraphAPI, cloud.Resource) interface{} {
+	return joinProperty(typ, idProp, properties.Name)
+}
+
+func joinInstanceTargetGroups(g cloud.GraphAPI, res cloud.Resource) interface{} {
+	return joinValues(relatedNames(targetGroupsOf(g, res)))
+}
+
+// joinInstanceLoadBalancers displays the load balancers forwarding to the instance: through
+// its target groups, or directly for classic load balancers
+func joinInstanceLoadBalancers(g cloud.GraphAPI, res cloud.Resource) interface{} {
+	var balancers []cloud.Resource
+	for _, tg := range targetGroupsOf(g, res) {
+		balancers = append(balancers, relatedOfType(g, tg, cloud.LoadBalancer)...)
+	}
+	balancers = append(balancers, relatedOfType(g, res, cloud.ClassicLoadBalancer)...)
+	return joinValues(relatedNames(balancers))
+}
+
+func targetGroupsOf(g cloud.GraphAPI, res cloud.Resource) []cloud.Resource {
+	return relatedOfType(g, res, cloud.TargetGroup)
+}
+
+// relatedOfType returns the resources of the given type applying on res
+func relatedOfType(g cloud.GraphAPI, res cloud.Resource, typ string) (related []cloud.Resource) {
+	all, err := g.ResourceRelations(res, rdf.DependingOnRel, false)
+	if err != nil {
+		return
+	}
+	for _, r := range all {
+		if r.Type() == typ {
+			related = append(related, r)
+		}
+	}
+	return
+}
+
+func relatedNames(resources []cloud.Resource) (names []string) {
+	for _, r := range resources {
+		if name, ok := r.Property(properties.Name); ok && fmt.Sprint(name) != "" {
+			names = append(names, fmt.Sprint(name))
+		} else {
+			names = append(names, r.Id())
+		}
+	}
+	return
+}
+
+func propertyIds(res cloud.Resource, prop string) []string {
+	v, ok := res.Property(prop)
+	if !ok {
+		return nil
+	}
+	switch vv := v.(type) {
+	case []string:
+		return vv
+	case string:
+		if vv != "" {
+			return []string{vv}
+		}
+	}
+	return nil
+}
+
+func joinValues(values []string) interface{} {
+	if len(values) == 0 {
+		return nil
+	}
+	unique := make(map[string]struct{})
+	var sorted []string
+	for _, v := range values {
+		if _, done := unique[v]; !done {
+			unique[v] = struct{}{}
+			sorted = append(sorted, v)
+		}
+	}
+	sort.Strings(sorted)
+	return strings.Join(sorted, " ")
+}
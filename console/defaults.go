@@ -23,54 +23,88 @@ import (
 )
 
 var ColumnsInListing = map[string][]string{
-	cloud.Instance:            {properties.ID, properties.AvailabilityZone, properties.Name, properties.State, properties.Type, properties.PublicIP, properties.PrivateIP, properties.Launched, properties.KeyPair},
-	cloud.Vpc:                 {properties.ID, properties.Name, properties.Default, properties.State, properties.CIDR},
-	cloud.Subnet:              {properties.ID, properties.Name, properties.CIDR, properties.AvailabilityZone, properties.Default, properties.Vpc, properties.Public, properties.State},
-	cloud.SecurityGroup:       {properties.ID, properties.Vpc, properties.InboundRules, properties.OutboundRules, properties.Name, properties.Description},
-	cloud.InternetGateway:     {properties.ID, properties.Name, properties.Vpcs},
-	cloud.NatGateway:          {properties.ID, properties.State, properties.Vpc, properties.Subnet, properties.Created},
-	cloud.RouteTable:          {properties.ID, properties.Name, properties.Vpc, properties.Default, properties.Routes, properties.Associations},
-	cloud.Keypair:             {properties.ID, properties.Fingerprint},
-	cloud.Image:               {properties.ID, properties.Name, properties.State, properties.Location, properties.Public, properties.Type, properties.Created, properties.Architecture, properties.Hypervisor, properties.Virtualization},
-	cloud.ImportImageTask:     {properties.ID, properties.Description, properties.Image, properties.Progress, properties.State, properties.StateMessage},
-	cloud.Volume:              {properties.ID, properties.Name, properties.Type, properties.State, properties.Size, properties.Encrypted, properties.Created, properties.AvailabilityZone, properties.Instances},
-	cloud.AvailabilityZone:    {properties.Name, properties.State, properties.Region, properties.Messages},
-	cloud.ElasticIP:           {properties.ID, properties.PublicIP, properties.PrivateIP, properties.Association},
-	cloud.Snapshot:            {properties.ID, properties.Volume, properties.Encrypted, properties.Owner, properties.State, properties.Progress, properties.Created, properties.Size},
-	cloud.NetworkInterface:    {properties.ID, properties.Vpc, properties.Subnet, properties.State, properties.Instance, properties.PrivateIP, properties.PublicIP, properties.Description},
-	cloud.LoadBalancer:        {properties.Name, properties.Vpc, properties.State, properties.PublicDNS, properties.Created, properties.Scheme},
-	cloud.TargetGroup:         {properties.Name, properties.Vpc, properties.CheckHTTPCode, properties.Port, properties.Protocol, properties.CheckInterval, properties.CheckPath, properties.CheckPort, properties.CheckProtocol},
-	cloud.Listener:            {properties.ID, properties.Protocol, properties.Port, properties.LoadBalancer, properties.TargetGroups, properties.AlarmActions},
-	cloud.Database:            {properties.ID, properties.Name, properties.AvailabilityZone, properties.Class, properties.State, properties.Storage, properties.Port, properties.Username, properties.Public, properties.ReplicaOf, properties.Engine, properties.EngineVersion, properties.Created},
-	cloud.DbSubnetGroup:       {properties.ID, properties.State, properties.Vpc, properties.Subnets, properties.Description},
-	cloud.LaunchConfiguration: {properties.Name, properties.Type, properties.Created, properties.KeyPair},
-	cloud.ScalingGroup:        {properties.Name, properties.LaunchConfigurationName, properties.DesiredCapacity, properties.State, properties.Created, properties.NewInstancesProtected},
-	cloud.ScalingPolicy:       {properties.Name, properties.Type, properties.ScalingGroupName, properties.AlarmNames, properties.AdjustmentType, properties.ScalingAdjustment},
-	cloud.Repository:          {properties.Name, properties.URI, properties.Created, properties.Account, properties.Arn},
-	cloud.ContainerCluster:    {properties.Name, properties.State, properties.ActiveServicesCount, properties.PendingTasksCount, properties.RegisteredContainerInstancesCount, properties.RunningTasksCount},
-	cloud.ContainerTask:       {properties.Name, properties.Version, properties.State, properties.ContainersImages, properties.Deployments},
-	cloud.Container:           {properties.Name, properties.DeploymentName, properties.State, properties.Created, properties.Launched, properties.Stopped, properties.Cluster, properties.ContainerTask},
-	cloud.ContainerInstance:   {properties.ID, properties.Instance, properties.Cluster, properties.State, properties.RunningTasksCount, properties.PendingTasksCount, properties.Created, properties.AgentConnected},
-	cloud.Certificate:         {properties.Arn, properties.Name},
-	cloud.User:                {properties.ID, properties.Name, properties.PasswordLastUsed, properties.Created},
-	cloud.Role:                {properties.ID, properties.Name, properties.Created},
-	cloud.InstanceProfile:     {properties.ID, properties.Name, properties.Path, properties.Created},
-	cloud.Policy:              {properties.ID, properties.Name, properties.Type, properties.Created, properties.Updated, properties.Attached},
-	cloud.Group:               {properties.ID, properties.Name, properties.Created},
-	cloud.AccessKey:           {properties.ID, properties.State, properties.Username, properties.Created},
-	cloud.MFADevice:           {properties.ID, properties.AttachedAt},
-	cloud.Bucket:              {properties.ID, properties.Grants, properties.Created},
-	cloud.S3Object:            {properties.ID, properties.Bucket, properties.Modified, properties.Owner, properties.Size, properties.Class},
-	cloud.Subscription:        {properties.Arn, properties.Topic, properties.Endpoint, properties.Protocol, properties.Owner},
-	cloud.Topic:               {properties.ID},
-	cloud.Queue:               {properties.ID, properties.ApproximateMessageCount, properties.Created, properties.Modified, properties.Delay},
-	cloud.Zone:                {properties.ID, properties.Name, properties.Comment, properties.Private, properties.RecordCount, properties.CallerReference},
-	cloud.Record:              {properties.ID, properties.Type, properties.Name, properties.Records, properties.Zone, properties.Alias, properties.TTL},
-	cloud.Function:            {properties.Name, properties.Size, properties.Memory, properties.Runtime, properties.Version, properties.Modified, properties.Description},
-	cloud.Metric:              {properties.ID, properties.Name, properties.Namespace, properties.Dimensions},
-	cloud.Alarm:               {properties.Name, properties.Namespace, properties.MetricName, properties.Description, properties.State, properties.Updated, properties.Dimensions},
-	cloud.Distribution:        {properties.ID, properties.PublicDNS, properties.Enabled, properties.State, properties.Modified, properties.Aliases, properties.SSLSupportMethod, properties.Origins},
-	cloud.Stack:               {properties.ID, properties.Name, properties.State, properties.Created, properties.Modified},
+	cloud.Instance:                 {properties.ID, properties.AvailabilityZone, properties.Name, properties.State, properties.Type, properties.PublicIP, properties.PrivateIP, properties.Launched, properties.KeyPair},
+	cloud.Vpc:                      {properties.ID, properties.Name, properties.Default, properties.State, properties.CIDR, properties.IPv6CIDRs},
+	cloud.Subnet:                   {properties.ID, properties.Name, properties.CIDR, properties.IPv6CIDRs, properties.AvailabilityZone, properties.Default, properties.Vpc, properties.Public, properties.State},
+	cloud.SecurityGroup:            {properties.ID, properties.Vpc, properties.InboundRules, properties.OutboundRules, properties.Name, properties.Description},
+	cloud.InternetGateway:          {properties.ID, properties.Name, properties.Vpcs},
+	cloud.NatGateway:               {properties.ID, properties.State, properties.Vpc, properties.Subnet, properties.Created},
+	cloud.PeeringConnection:        {properties.ID, properties.Name, properties.State, properties.Vpc, properties.PeerVpc, properties.PeerOwner},
+	cloud.VpcEndpoint:              {properties.ID, properties.Type, properties.Service, properties.State, properties.Vpc, properties.Created},
+	cloud.CustomerGateway:          {properties.ID, properties.Name, properties.State, properties.PublicIP, properties.ASN, properties.Type},
+	cloud.VpnGateway:               {properties.ID, properties.Name, properties.State, properties.Vpcs, properties.ASN, properties.Type},
+	cloud.VpnConnection:            {properties.ID, properties.Name, properties.State, properties.CustomerGateway, properties.VpnGateway, properties.Type},
+	cloud.RouteTable:               {properties.ID, properties.Name, properties.Vpc, properties.Default, properties.Routes, properties.Associations},
+	cloud.NetworkACL:               {properties.ID, properties.Name, properties.Vpc, properties.Default, properties.InboundACLRules, properties.OutboundACLRules, properties.Associations},
+	cloud.PlacementGroup:           {properties.Name, properties.Strategy, properties.State},
+	cloud.Host:                     {properties.ID, properties.Type, properties.AvailabilityZone, properties.State, properties.AutoPlacement, properties.Instances, properties.HostReservation},
+	cloud.Keypair:                  {properties.ID, properties.Fingerprint},
+	cloud.Image:                    {properties.ID, properties.Name, properties.State, properties.Location, properties.Public, properties.Type, properties.Created, properties.Architecture, properties.Hypervisor, properties.Virtualization},
+	cloud.ImportImageTask:          {properties.ID, properties.Description, properties.Image, properties.Progress, properties.State, properties.StateMessage},
+	cloud.Volume:                   {properties.ID, properties.Name, properties.Type, properties.State, properties.Size, properties.Encrypted, properties.Created, properties.AvailabilityZone, properties.Instances},
+	cloud.AvailabilityZone:         {properties.Name, properties.State, properties.Region, properties.Messages},
+	cloud.ElasticIP:                {properties.ID, properties.PublicIP, properties.PrivateIP, properties.Instance, properties.Association},
+	cloud.Snapshot:                 {properties.ID, properties.Volume, properties.Encrypted, properties.Owner, properties.State, properties.Progress, properties.Created, properties.Size},
+	cloud.NetworkInterface:         {properties.ID, properties.Vpc, properties.Subnet, properties.State, properties.Instance, properties.PrivateIP, properties.PublicIP, properties.Description},
+	cloud.SpotRequest:              {properties.ID, properties.Name, properties.State, properties.StateMessage, properties.Type, properties.SpotPrice, properties.Instance, properties.AvailabilityZone, properties.Created},
+	cloud.SpotFleet:                {properties.ID, properties.State, properties.StateMessage, properties.Type, properties.DesiredCapacity, properties.SpotPrice, properties.Created},
+	cloud.Reservation:              {properties.ID, properties.Name, properties.Type, properties.Scope, properties.AvailabilityZone, properties.InstanceCount, properties.Utilization, properties.State, properties.OfferingType, properties.Expires},
+	cloud.LoadBalancer:             {properties.Name, properties.Vpc, properties.State, properties.PublicDNS, properties.Created, properties.Scheme},
+	cloud.TargetGroup:              {properties.Name, properties.Vpc, properties.CheckHTTPCode, properties.Port, properties.Protocol, properties.CheckInterval, properties.CheckPath, properties.CheckPort, properties.CheckProtocol},
+	cloud.ClassicLoadBalancer:      {properties.Name, properties.Vpc, properties.PublicDNS, properties.Listeners, properties.Instances, properties.HealthCheck, properties.Created, properties.Scheme},
+	cloud.Listener:                 {properties.ID, properties.Protocol, properties.Port, properties.LoadBalancer, properties.TargetGroups, properties.AlarmActions},
+	cloud.Database:                 {properties.ID, properties.Name, properties.AvailabilityZone, properties.Class, properties.State, properties.Storage, properties.Port, properties.Username, properties.Public, properties.ReplicaOf, properties.Engine, properties.EngineVersion, properties.Created},
+	cloud.DbSubnetGroup:            {properties.ID, properties.State, properties.Vpc, properties.Subnets, properties.Description},
+	cloud.DbParameterGroup:         {properties.Name, properties.Family, properties.Description},
+	cloud.Table:                    {properties.Name, properties.State, properties.ItemCount, properties.Size, properties.ReadCapacity, properties.WriteCapacity, properties.HashKey, properties.RangeKey, properties.Created},
+	cloud.CacheCluster:             {properties.Name, properties.State, properties.Engine, properties.EngineVersion, properties.Class, properties.NodeCount, properties.AvailabilityZone, properties.CacheSubnetGroup, properties.Endpoint, properties.Created},
+	cloud.CacheSubnetGroup:         {properties.Name, properties.Vpc, properties.Subnets, properties.Description},
+	cloud.Cluster:                  {properties.Name, properties.State, properties.Class, properties.NodeCount, properties.Vpc, properties.AvailabilityZone, properties.Endpoint, properties.Port, properties.Created},
+	cloud.ComputeEnvironment:       {properties.Name, properties.Type, properties.State, properties.StateMessage, properties.Role},
+	cloud.JobQueue:                 {properties.Name, properties.State, properties.StateMessage},
+	cloud.Job:                      {properties.ID, properties.Name, properties.State, properties.StateMessage, properties.ExitCode, properties.Created, properties.Launched, properties.Stopped},
+	cloud.DxConnection:             {properties.ID, properties.Name, properties.State, properties.Bandwidth, properties.Location, properties.VLAN, properties.Owner},
+	cloud.VirtualInterface:         {properties.ID, properties.Name, properties.Type, properties.State, properties.Connection, properties.VpnGateway, properties.ASN, properties.VLAN},
+	cloud.TransitGateway:           {properties.ID, properties.Name, properties.State, properties.ASN, properties.Description, properties.Owner, properties.Created},
+	cloud.TransitGatewayAttachment: {properties.ID, properties.Name, properties.State, properties.TransitGateway, properties.Type, properties.RouteTable, properties.Created},
+	cloud.TransitGatewayRouteTable: {properties.ID, properties.Name, properties.State, properties.TransitGateway, properties.Default, properties.Created},
+	cloud.LaunchConfiguration:      {properties.Name, properties.Type, properties.Created, properties.KeyPair},
+	cloud.ScalingGroup:             {properties.Name, properties.LaunchConfigurationName, properties.DesiredCapacity, properties.State, properties.Created, properties.NewInstancesProtected},
+	cloud.ScalingPolicy:            {properties.Name, properties.Type, properties.ScalingGroupName, properties.AlarmNames, properties.AdjustmentType, properties.ScalingAdjustment},
+	cloud.Repository:               {properties.Name, properties.URI, properties.Created, properties.Account, properties.Arn},
+	cloud.ContainerCluster:         {properties.Name, properties.State, properties.ActiveServicesCount, properties.PendingTasksCount, properties.RegisteredContainerInstancesCount, properties.RunningTasksCount},
+	cloud.ContainerService:         {properties.Name, properties.Cluster, properties.ContainerTask, properties.State, properties.DesiredCount, properties.RunningTasksCount, properties.PendingTasksCount, properties.LaunchType, properties.Created},
+	cloud.ContainerTask:            {properties.Name, properties.Version, properties.State, properties.ContainersImages, properties.Deployments},
+	cloud.Container:                {properties.Name, properties.DeploymentName, properties.State, properties.Created, properties.Launched, properties.Stopped, properties.Cluster, properties.ContainerTask},
+	cloud.ContainerInstance:        {properties.ID, properties.Instance, properties.Cluster, properties.State, properties.RunningTasksCount, properties.PendingTasksCount, properties.Created, properties.AgentConnected},
+	cloud.Certificate:              {properties.Arn, properties.Name},
+	cloud.User:                     {properties.ID, properties.Name, properties.PasswordLastUsed, properties.Created},
+	cloud.Role:                     {properties.ID, properties.Name, properties.Created},
+	cloud.InstanceProfile:          {properties.ID, properties.Name, properties.Path, properties.Created},
+	cloud.Policy:                   {properties.ID, properties.Name, properties.Type, properties.Created, properties.Updated, properties.Attached},
+	cloud.Group:                    {properties.ID, properties.Name, properties.Created},
+	cloud.AccessKey:                {properties.ID, properties.State, properties.Username, properties.Created},
+	cloud.MFADevice:                {properties.ID, properties.AttachedAt},
+	cloud.Key:                      {properties.ID, properties.Name, properties.State, properties.Description, properties.Principals, properties.Created},
+	cloud.Account:                  {properties.ID, properties.Name, properties.Email, properties.State, properties.Parent, properties.Joined},
+	cloud.OrganizationalUnit:       {properties.ID, properties.Name, properties.Parent},
+	cloud.Bucket:                   {properties.ID, properties.Grants, properties.Created},
+	cloud.S3Object:                 {properties.ID, properties.Bucket, properties.Modified, properties.Owner, properties.Size, properties.Class},
+	cloud.Subscription:             {properties.Arn, properties.Topic, properties.Endpoint, properties.Protocol, properties.Owner},
+	cloud.Topic:                    {properties.ID},
+	cloud.Queue:                    {properties.ID, properties.ApproximateMessageCount, properties.Created, properties.Modified, properties.Delay},
+	cloud.Zone:                     {properties.ID, properties.Name, properties.Comment, properties.Private, properties.RecordCount, properties.CallerReference},
+	cloud.Record:                   {properties.ID, properties.Type, properties.Name, properties.Records, properties.Zone, properties.Alias, properties.TTL},
+	cloud.Function:                 {properties.Name, properties.Size, properties.Memory, properties.Runtime, properties.Version, properties.Modified, properties.Description},
+	cloud.StateMachine:             {properties.Name, properties.Arn, properties.Created},
+	cloud.Execution:                {properties.Name, properties.StateMachine, properties.State, properties.Launched, properties.Stopped},
+	cloud.Metric:                   {properties.ID, properties.Name, properties.Namespace, properties.Dimensions},
+	cloud.Alarm:                    {properties.Name, properties.Namespace, properties.MetricName, properties.Description, properties.State, properties.Updated, properties.Dimensions},
+	cloud.LogGroup:                 {properties.Name, properties.Retention, properties.Size, properties.Created},
+	cloud.Trail:                    {properties.Name, properties.Bucket, properties.Region, properties.Key},
+	cloud.TrailEvent:               {properties.Created, properties.Username, properties.Type, properties.Source, properties.Name},
+	cloud.Distribution:             {properties.ID, properties.PublicDNS, properties.Enabled, properties.State, properties.Modified, properties.Aliases, properties.SSLSupportMethod, properties.Origins},
+	cloud.Stack:                    {properties.ID, properties.Name, properties.State, properties.Created, properties.Modified},
 }
 
 var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
@@ -98,11 +132,13 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		},
 		StringColumnDefinition{Prop: properties.State},
 		StringColumnDefinition{Prop: properties.CIDR},
+		SliceColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.IPv6CIDRs, Friendly: "IPv6 CIDRs"}},
 	},
 	cloud.Subnet: {
 		StringColumnDefinition{Prop: properties.ID},
 		StringColumnDefinition{Prop: properties.Name},
 		StringColumnDefinition{Prop: properties.CIDR},
+		SliceColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.IPv6CIDRs, Friendly: "IPv6 CIDRs"}},
 		StringColumnDefinition{Prop: properties.AvailabilityZone, Friendly: "Zone"},
 		ColoredValueColumnDefinition{
 			StringColumnDefinition: StringColumnDefinition{Prop: properties.Default, Friendly: "Default"},
@@ -136,6 +172,52 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.Subnet},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created, Friendly: "Created"}},
 	},
+	cloud.PeeringConnection: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.State},
+		StringColumnDefinition{Prop: properties.Vpc},
+		StringColumnDefinition{Prop: properties.PeerVpc, Friendly: "Peer Vpc"},
+		StringColumnDefinition{Prop: properties.PeerOwner, Friendly: "Peer Owner"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Expires}},
+	},
+	cloud.VpcEndpoint: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Type},
+		StringColumnDefinition{Prop: properties.Service},
+		StringColumnDefinition{Prop: properties.State},
+		StringColumnDefinition{Prop: properties.Vpc},
+		SliceColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.RouteTables}},
+		SliceColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Subnets}},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created, Friendly: "Created"}},
+	},
+	cloud.CustomerGateway: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.State},
+		StringColumnDefinition{Prop: properties.PublicIP, Friendly: "Public IP"},
+		StringColumnDefinition{Prop: properties.ASN, Friendly: "BGP ASN"},
+		StringColumnDefinition{Prop: properties.Type},
+	},
+	cloud.VpnGateway: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.State},
+		SliceColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Vpcs}},
+		StringColumnDefinition{Prop: properties.ASN, Friendly: "Amazon ASN"},
+		StringColumnDefinition{Prop: properties.Type},
+	},
+	cloud.VpnConnection: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"available": color.FgGreen, "pending": color.FgYellow, "deleting": color.FgRed},
+		},
+		StringColumnDefinition{Prop: properties.CustomerGateway, Friendly: "Customer Gateway"},
+		StringColumnDefinition{Prop: properties.VpnGateway, Friendly: "VPN Gateway"},
+		StringColumnDefinition{Prop: properties.Type},
+	},
 	cloud.RouteTable: {
 		StringColumnDefinition{Prop: properties.ID},
 		StringColumnDefinition{Prop: properties.Name},
@@ -147,6 +229,36 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		RoutesColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Routes}},
 		KeyValuesColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Associations}},
 	},
+	cloud.NetworkACL: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Vpc},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.Default},
+			ColoredValues:          map[string]color.Attribute{"true": color.FgGreen},
+		},
+		NetworkACLRulesColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.InboundACLRules, Friendly: "Inbound"}},
+		NetworkACLRulesColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.OutboundACLRules, Friendly: "Outbound"}},
+		KeyValuesColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Associations}},
+	},
+	cloud.PlacementGroup: {
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Strategy},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"available": color.FgGreen}},
+	},
+	cloud.Host: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Type},
+		StringColumnDefinition{Prop: properties.AvailabilityZone, Friendly: "Zone"},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"available": color.FgGreen, "under-assessment": color.FgYellow}},
+		StringColumnDefinition{Prop: properties.AutoPlacement},
+		SliceColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Instances}},
+		StringColumnDefinition{Prop: properties.HostReservation, Friendly: "Reservation"},
+	},
 	cloud.Keypair: {
 		StringColumnDefinition{Prop: properties.ID},
 		StringColumnDefinition{Prop: properties.Fingerprint},
@@ -192,7 +304,10 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.ID},
 		StringColumnDefinition{Prop: properties.PublicIP},
 		StringColumnDefinition{Prop: properties.PrivateIP},
+		StringColumnDefinition{Prop: properties.Instance},
+		StringColumnDefinition{Prop: properties.NetworkInterface},
 		StringColumnDefinition{Prop: properties.Association},
+		StringColumnDefinition{Prop: properties.Domain},
 	},
 	cloud.Snapshot: {
 		StringColumnDefinition{Prop: properties.ID},
@@ -212,8 +327,45 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.Instance},
 		StringColumnDefinition{Prop: properties.PrivateIP},
 		StringColumnDefinition{Prop: properties.PublicIP},
+		SliceColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.SecondaryPrivateIPs}},
+		SliceColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.SecurityGroups}},
 		StringColumnDefinition{Prop: properties.Description},
 	},
+	cloud.SpotRequest: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.State},
+		StringColumnDefinition{Prop: properties.StateMessage, Friendly: "Status"},
+		StringColumnDefinition{Prop: properties.Type},
+		StringColumnDefinition{Prop: properties.SpotPrice, Friendly: "MaxPrice"},
+		StringColumnDefinition{Prop: properties.Instance},
+		StringColumnDefinition{Prop: properties.AvailabilityZone, Friendly: "Zone"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
+	cloud.SpotFleet: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.State},
+		StringColumnDefinition{Prop: properties.StateMessage, Friendly: "Activity"},
+		StringColumnDefinition{Prop: properties.Type},
+		StringColumnDefinition{Prop: properties.DesiredCapacity, Friendly: "Capacity"},
+		StringColumnDefinition{Prop: properties.SpotPrice, Friendly: "MaxPrice"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
+	cloud.Reservation: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Type},
+		StringColumnDefinition{Prop: properties.Scope},
+		StringColumnDefinition{Prop: properties.AvailabilityZone, Friendly: "Zone"},
+		StringColumnDefinition{Prop: properties.InstanceCount, Friendly: "Count"},
+		StringColumnDefinition{Prop: properties.Utilization, Friendly: "Utilization(%)"},
+		StringColumnDefinition{Prop: properties.State},
+		StringColumnDefinition{Prop: properties.OfferingClass, Friendly: "Class"},
+		StringColumnDefinition{Prop: properties.OfferingType, Friendly: "Payment"},
+		StringColumnDefinition{Prop: properties.Description, Friendly: "Platform"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Launched, Friendly: "Start"}},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Expires}},
+	},
 	// Loadbalancer
 	cloud.LoadBalancer: {
 		StringColumnDefinition{Prop: properties.Name},
@@ -234,6 +386,16 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.CheckPort, Friendly: "HCPort"},
 		StringColumnDefinition{Prop: properties.CheckProtocol, Friendly: "HCProtocol"},
 	},
+	cloud.ClassicLoadBalancer: {
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Vpc},
+		StringColumnDefinition{Prop: properties.PublicDNS},
+		StringColumnDefinition{Prop: properties.Listeners},
+		StringColumnDefinition{Prop: properties.Instances},
+		StringColumnDefinition{Prop: properties.HealthCheck},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created, Friendly: "Created"}},
+		StringColumnDefinition{Prop: properties.Scheme},
+	},
 	cloud.Listener: {
 		StringColumnDefinition{Prop: properties.ID},
 		StringColumnDefinition{Prop: properties.Port},
@@ -270,6 +432,158 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.Subnets},
 		StringColumnDefinition{Prop: properties.Description},
 	},
+	cloud.DbParameterGroup: {
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Family},
+		StringColumnDefinition{Prop: properties.Description},
+	},
+	//NoSQL
+	cloud.Table: {
+		StringColumnDefinition{Prop: properties.Name},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"ACTIVE": color.FgGreen, "DELETING": color.FgRed},
+		},
+		StringColumnDefinition{Prop: properties.ItemCount, Friendly: "Items"},
+		StorageColumnDefinition{Unit: b, StringColumnDefinition: StringColumnDefinition{Prop: properties.Size}},
+		StringColumnDefinition{Prop: properties.ReadCapacity, Friendly: "Read"},
+		StringColumnDefinition{Prop: properties.WriteCapacity, Friendly: "Write"},
+		StringColumnDefinition{Prop: properties.HashKey},
+		StringColumnDefinition{Prop: properties.RangeKey},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
+	//Cache
+	cloud.CacheCluster: {
+		StringColumnDefinition{Prop: properties.Name},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"available": color.FgGreen, "deleting": color.FgRed, "incompatible-network": color.FgRed, "restore-failed": color.FgRed},
+		},
+		StringColumnDefinition{Prop: properties.Engine},
+		StringColumnDefinition{Prop: properties.EngineVersion, Friendly: "Version"},
+		StringColumnDefinition{Prop: properties.Class},
+		StringColumnDefinition{Prop: properties.NodeCount, Friendly: "Nodes"},
+		StringColumnDefinition{Prop: properties.AvailabilityZone, Friendly: "Zone"},
+		StringColumnDefinition{Prop: properties.CacheSubnetGroup, Friendly: "SubnetGroup"},
+		StringColumnDefinition{Prop: properties.Endpoint},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
+	cloud.CacheSubnetGroup: {
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Vpc},
+		SliceColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Subnets}},
+		StringColumnDefinition{Prop: properties.Description},
+	},
+	//Datawarehouse
+	cloud.Cluster: {
+		StringColumnDefinition{Prop: properties.Name},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"available": color.FgGreen, "deleting": color.FgRed, "hardware-failure": color.FgRed, "incompatible-network": color.FgRed, "storage-full": color.FgRed},
+		},
+		StringColumnDefinition{Prop: properties.Class},
+		StringColumnDefinition{Prop: properties.NodeCount, Friendly: "Nodes"},
+		StringColumnDefinition{Prop: properties.Vpc},
+		StringColumnDefinition{Prop: properties.AvailabilityZone, Friendly: "Zone"},
+		StringColumnDefinition{Prop: properties.Endpoint},
+		StringColumnDefinition{Prop: properties.Port},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
+	//Batch
+	cloud.ComputeEnvironment: {
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Type},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"ENABLED": color.FgGreen, "DISABLED": color.FgRed},
+		},
+		StringColumnDefinition{Prop: properties.StateMessage, Friendly: "Status"},
+		StringColumnDefinition{Prop: properties.Role},
+	},
+	cloud.JobQueue: {
+		StringColumnDefinition{Prop: properties.Name},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"ENABLED": color.FgGreen, "DISABLED": color.FgRed},
+		},
+		StringColumnDefinition{Prop: properties.StateMessage, Friendly: "Status"},
+	},
+	cloud.DxConnection: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"available": color.FgGreen, "down": color.FgRed},
+		},
+		StringColumnDefinition{Prop: properties.Bandwidth},
+		StringColumnDefinition{Prop: properties.Location},
+		StringColumnDefinition{Prop: properties.VLAN, Friendly: "VLAN"},
+		StringColumnDefinition{Prop: properties.Owner},
+	},
+	cloud.VirtualInterface: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Type},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"available": color.FgGreen, "down": color.FgRed},
+		},
+		StringColumnDefinition{Prop: properties.Connection},
+		StringColumnDefinition{Prop: properties.VpnGateway, Friendly: "VPN Gateway"},
+		StringColumnDefinition{Prop: properties.ASN},
+		StringColumnDefinition{Prop: properties.VLAN, Friendly: "VLAN"},
+	},
+	cloud.TransitGateway: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"available": color.FgGreen, "pending": color.FgYellow, "modifying": color.FgYellow, "deleting": color.FgRed},
+		},
+		StringColumnDefinition{Prop: properties.ASN, Friendly: "Amazon ASN"},
+		StringColumnDefinition{Prop: properties.Description},
+		StringColumnDefinition{Prop: properties.Owner},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
+	cloud.TransitGatewayAttachment: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"available": color.FgGreen, "pending": color.FgYellow, "modifying": color.FgYellow, "deleting": color.FgRed, "failed": color.FgRed},
+		},
+		StringColumnDefinition{Prop: properties.TransitGateway, Friendly: "Transit Gateway"},
+		StringColumnDefinition{Prop: properties.Type},
+		StringColumnDefinition{Prop: properties.RouteTable, Friendly: "Route Table"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
+	cloud.TransitGatewayRouteTable: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"available": color.FgGreen, "pending": color.FgYellow, "deleting": color.FgRed},
+		},
+		StringColumnDefinition{Prop: properties.TransitGateway, Friendly: "Transit Gateway"},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.Default},
+			ColoredValues:          map[string]color.Attribute{"true": color.FgGreen},
+		},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
+	cloud.Job: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"SUCCEEDED": color.FgGreen, "RUNNING": color.FgYellow, "FAILED": color.FgRed},
+		},
+		StringColumnDefinition{Prop: properties.StateMessage, Friendly: "Reason"},
+		StringColumnDefinition{Prop: properties.ExitCode},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Launched, Friendly: "Started"}},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Stopped}},
+	},
 	//Autoscaling
 	cloud.LaunchConfiguration: {
 		StringColumnDefinition{Prop: properties.Name},
@@ -309,6 +623,17 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.RegisteredContainerInstancesCount, Friendly: "RegisteredContainerInstances"},
 		StringColumnDefinition{Prop: properties.RunningTasksCount, Friendly: "RunningTasks"},
 	},
+	cloud.ContainerService: {
+		StringColumnDefinition{Prop: properties.Name},
+		ARNLastValueColumnDefinition{Separator: "/", StringColumnDefinition: StringColumnDefinition{Prop: properties.Cluster}},
+		ARNLastValueColumnDefinition{Separator: "/", StringColumnDefinition: StringColumnDefinition{Prop: properties.ContainerTask}},
+		StringColumnDefinition{Prop: properties.State},
+		StringColumnDefinition{Prop: properties.DesiredCount, Friendly: "Desired"},
+		StringColumnDefinition{Prop: properties.RunningTasksCount, Friendly: "RunningTasks"},
+		StringColumnDefinition{Prop: properties.PendingTasksCount, Friendly: "PendingTasks"},
+		StringColumnDefinition{Prop: properties.LaunchType},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
 	cloud.ContainerTask: {
 		StringColumnDefinition{Prop: properties.Name},
 		StringColumnDefinition{Prop: properties.Version},
@@ -385,6 +710,28 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.ID},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.AttachedAt}},
 	},
+	cloud.Key: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.State},
+		StringColumnDefinition{Prop: properties.Description},
+		SliceColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Aliases}},
+		SliceColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Principals}},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
+	cloud.Account: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Email},
+		StringColumnDefinition{Prop: properties.State},
+		StringColumnDefinition{Prop: properties.Parent},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Joined}},
+	},
+	cloud.OrganizationalUnit: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Parent},
+	},
 	// S3
 	cloud.Bucket: {
 		StringColumnDefinition{Prop: properties.ID},
@@ -414,6 +761,8 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 	cloud.Queue: {
 		StringColumnDefinition{Prop: properties.ID, Friendly: "URL"},
 		StringColumnDefinition{Prop: properties.ApproximateMessageCount, Friendly: "~NbMsg"},
+		StringColumnDefinition{Prop: properties.Fifo},
+		StringColumnDefinition{Prop: properties.DeadLetterQueue, Friendly: "DLQ"},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Modified, Friendly: "LastModif"}},
 		StringColumnDefinition{Prop: properties.Delay, Friendly: "Delay(s)"},
@@ -446,6 +795,18 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Modified}},
 		StringColumnDefinition{Prop: properties.Description},
 	},
+	cloud.StateMachine: {
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Arn},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
+	cloud.Execution: {
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.StateMachine},
+		StringColumnDefinition{Prop: properties.State},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Launched}},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Stopped}},
+	},
 	//Monitoring
 	cloud.Metric: {
 		StringColumnDefinition{Prop: properties.ID},
@@ -462,6 +823,25 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Updated}},
 		KeyValuesColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Dimensions}},
 	},
+	cloud.LogGroup: {
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Retention, Friendly: "Retention (days)"},
+		StorageColumnDefinition{Unit: b, StringColumnDefinition: StringColumnDefinition{Prop: properties.Size}},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
+	cloud.Trail: {
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Bucket},
+		StringColumnDefinition{Prop: properties.Region},
+		StringColumnDefinition{Prop: properties.Key},
+	},
+	cloud.TrailEvent: {
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created, Friendly: "Time"}},
+		StringColumnDefinition{Prop: properties.Username, Friendly: "Principal"},
+		StringColumnDefinition{Prop: properties.Type, Friendly: "Action"},
+		StringColumnDefinition{Prop: properties.Source},
+		StringColumnDefinition{Prop: properties.Name, Friendly: "Event"},
+	},
 	//CDN
 	cloud.Distribution: {
 		StringColumnDefinition{Prop: properties.ID},
@@ -0,0 +1,162 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package console
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/graph"
+)
+
+const (
+	PropertyAdded   = "added"
+	PropertyRemoved = "removed"
+	PropertyChanged = "changed"
+)
+
+// DiffReport is the machine-readable form of a diff between two graphs
+type DiffReport struct {
+	Added   []*DiffResource   `json:"added"`
+	Removed []*DiffResource   `json:"removed"`
+	Changed []*PropertyChange `json:"changed"`
+}
+
+type DiffResource struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// PropertyChange is a change of a property of a resource present in both graphs.
+// JSON-valued properties (policy documents, ...) are diffed structurally in JSONChanges
+type PropertyChange struct {
+	DiffResource
+	Property    string              `json:"property"`
+	Kind        string              `json:"kind"`
+	From        interface{}         `json:"from,omitempty"`
+	To          interface{}         `json:"to,omitempty"`
+	JSONChanges []*graph.JSONChange `json:"jsonChanges,omitempty"`
+}
+
+func BuildDiffReport(diff *graph.Diff, root cloud.Resource) (*DiffReport, error) {
+	report := &DiffReport{Added: []*DiffResource{}, Removed: []*DiffResource{}, Changed: []*PropertyChange{}}
+
+	fromCommons := make(map[string]cloud.Resource)
+	toCommons := make(map[string]cloud.Resource)
+	each := func(res *graph.Resource, distance int) error {
+		if meta, _ := res.Meta("diff"); meta == "extra" {
+			report.Removed = append(report.Removed, newDiffResource(res))
+		} else {
+			fromCommons[res.Id()] = res
+		}
+		return nil
+	}
+	if err := diff.FromGraph().Accept(&graph.ChildrenVisitor{From: root.(*graph.Resource), Each: each}); err != nil {
+		return report, err
+	}
+	each = func(res *graph.Resource, distance int) error {
+		if meta, _ := res.Meta("diff"); meta == "extra" {
+			report.Added = append(report.Added, newDiffResource(res))
+		} else {
+			toCommons[res.Id()] = res
+		}
+		return nil
+	}
+	if err := diff.ToGraph().Accept(&graph.ChildrenVisitor{From: root.(*graph.Resource), Each: each}); err != nil {
+		return report, err
+	}
+
+	for _, common := range fromCommons {
+		rem, ok := toCommons[common.Id()]
+		if !ok {
+			continue
+		}
+		added := graph.Subtract(rem.Properties(), common.Properties())
+		deleted := graph.Subtract(common.Properties(), rem.Properties())
+		for k, to := range added {
+			change := &PropertyChange{DiffResource: *newDiffResource(common), Property: k, Kind: PropertyAdded, To: to}
+			if from, ok := deleted[k]; ok {
+				change.Kind, change.From = PropertyChanged, from
+				change.JSONChanges, _ = graph.DiffJSON(from, to)
+			}
+			report.Changed = append(report.Changed, change)
+		}
+		for k, from := range deleted {
+			if _, ok := added[k]; !ok {
+				report.Changed = append(report.Changed, &PropertyChange{DiffResource: *newDiffResource(common), Property: k, Kind: PropertyRemoved, From: from})
+			}
+		}
+	}
+
+	sortDiffResources(report.Added)
+	sortDiffResources(report.Removed)
+	sort.Slice(report.Changed, func(i, j int) bool {
+		a, b := report.Changed[i], report.Changed[j]
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.ID != b.ID {
+			return a.ID < b.ID
+		}
+		return a.Property < b.Property
+	})
+
+	return report, nil
+}
+
+func newDiffResource(res cloud.Resource) *DiffResource {
+	return &DiffResource{Type: res.Type(), ID: res.Id(), Name: nameOrID(res)}
+}
+
+func sortDiffResources(all []*DiffResource) {
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].Type != all[j].Type {
+			return all[i].Type < all[j].Type
+		}
+		return all[i].ID < all[j].ID
+	})
+}
+
+type diffJSONDisplayer struct {
+	*fromDiffDisplayer
+}
+
+func (d *diffJSONDisplayer) Print(w io.Writer) error {
+	report, err := BuildDiffReport(d.diff, d.root)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", " ")
+	return enc.Encode(report)
+}
+
+// jsonChangeValue renders a structural change of a JSON-valued property
+func jsonChangeValue(c *graph.JSONChange) string {
+	switch c.Kind {
+	case graph.JSONAdded:
+		return "+ " + graph.CompactJSON(c.To)
+	case graph.JSONRemoved:
+		return "- " + graph.CompactJSON(c.From)
+	default:
+		return fmt.Sprintf("~ %s -> %s", graph.CompactJSON(c.From), graph.CompactJSON(c.To))
+	}
+}
@@ -0,0 +1,70 @@
+package console
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestDiffJSONProperties(t *testing.T) {
+	root := graph.InitResource("region", "eu-west-1")
+	from, to := graph.NewGraph(), graph.NewGraph()
+	from.AddResource(resourcetest.Region("eu-west-1").Build(),
+		resourcetest.Policy("pol_1").Prop(properties.Name, "s3-access").Prop(properties.Document, `{"Statement":[{"Action":"s3:GetObject","Effect":"Allow"}]}`).Build(),
+		resourcetest.Policy("pol_2").Prop(properties.Name, "old").Build(),
+	)
+	to.AddResource(resourcetest.Region("eu-west-1").Build(),
+		resourcetest.Policy("pol_1").Prop(properties.Name, "s3-access").Prop(properties.Document, `{"Statement":[{"Action":"s3:*","Effect":"Allow","Resource":"*"}]}`).Build(),
+		resourcetest.Policy("pol_2").Prop(properties.Name, "new").Build(),
+	)
+	resourcetest.AddParents(from, "eu-west-1 -> pol_1", "eu-west-1 -> pol_2")
+	resourcetest.AddParents(to, "eu-west-1 -> pol_1", "eu-west-1 -> pol_2")
+	diff, err := graph.DefaultDiffer.Run(root.Id(), from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	displayer, _ := BuildOptions(WithFormat("table"), WithRootNode(root)).SetSource(diff).Build()
+	var w bytes.Buffer
+	if err = displayer.Print(&w); err != nil {
+		t.Fatal(err)
+	}
+	out := strings.Replace(w.String(), "\u00a0", " ", -1)
+	for _, expected := range []string{
+		"| policy | old       | Name                           | + new                      |",
+		"|        | s3-access | Document: Statement[0].Action  | ~ \"s3:GetObject\" -> \"s3:*\" |",
+		"| policy | s3-access | Document:                      | + \"*\"                      |",
+	} {
+		if !strings.Contains(out, expected) {
+			t.Fatalf("expected\n%s\nin\n%s", expected, out)
+		}
+	}
+
+	displayer, _ = BuildOptions(WithFormat("json"), WithRootNode(root)).SetSource(diff).Build()
+	w.Reset()
+	if err = displayer.Print(&w); err != nil {
+		t.Fatal(err)
+	}
+	var report DiffReport
+	if err = json.Unmarshal(w.Bytes(), &report); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(report.Changed), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	doc := report.Changed[0]
+	if doc.Property != "Document" || doc.Kind != PropertyChanged || doc.ID != "pol_1" || len(doc.JSONChanges) != 2 {
+		t.Fatalf("unexpected change %#v", doc)
+	}
+	if got, want := doc.JSONChanges[0].Path, "Statement[0].Action"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := report.Changed[1].From, "old"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
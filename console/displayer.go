@@ -17,7 +17,6 @@ limitations under the License.
 package console
 
 import (
-	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -31,6 +30,7 @@ import (
 	"github.com/olekukonko/tablewriter"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/cloud/match"
+	"github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/graph"
 )
 
@@ -80,6 +80,8 @@ func (b *Builder) buildQuery() (cloud.Query, error) {
 
 			if key != "" {
 				matchers = append(matchers, match.Property(key, val).IgnoreCase().MatchString().Contains())
+			} else if name == "Prefix" { // ex: keys of storage objects, the prefix being also applied when fetching them
+				matchers = append(matchers, match.Property(properties.ID, val).MatchString().HasPrefix())
 			} else {
 				var allowed []string
 				for _, h := range b.columnDefinitions {
@@ -142,6 +144,7 @@ func (b *Builder) Build() (Displayer, error) {
 		}
 
 		filteredGraph := b.dataSource.(cloud.GraphAPI)
+		base.source = filteredGraph
 		q, err := b.buildQuery()
 		if err != nil {
 			return nil, err
@@ -150,33 +153,19 @@ func (b *Builder) Build() (Displayer, error) {
 			return nil, err
 		}
 
-		switch b.format {
-		case "csv":
-			dis := &csvDisplayer{base}
-			dis.setGraph(filteredGraph)
-			return dis, nil
-		case "tsv":
-			dis := &tsvDisplayer{base}
-			dis.setGraph(filteredGraph)
-			return dis, nil
-		case "json":
-			dis := &jsonDisplayer{base}
-			dis.setGraph(filteredGraph)
-			return dis, nil
-		case "porcelain":
+		if b.format == "porcelain" {
 			dis := &porcelainDisplayer{base}
 			dis.setGraph(filteredGraph)
 			return dis, nil
-		case "table":
-			dis := &tableDisplayer{base}
-			dis.setGraph(filteredGraph)
-			return dis, nil
-		default:
+		}
+		renderer, ok := getRenderer(b.format)
+		if !ok {
 			fmt.Fprintf(os.Stderr, "unknown format '%s', display as 'table'\n", b.format)
-			dis := &tableDisplayer{base}
-			dis.setGraph(filteredGraph)
-			return dis, nil
+			renderer, _ = getRenderer("table")
 		}
+		dis := &listingDisplayer{fromGraphDisplayer: base, renderer: renderer}
+		dis.setGraph(filteredGraph)
+		return dis, nil
 	case cloud.Resource:
 		dis := &tableResourceDisplayer{columnDefinitions: b.columnDefinitions, maxwidth: b.maxwidth}
 		dis.SetResource(b.dataSource.(cloud.Resource))
@@ -192,6 +181,10 @@ func (b *Builder) Build() (Displayer, error) {
 			dis := &diffTableDisplayer{&base}
 			dis.SetDiff(b.dataSource.(*graph.Diff))
 			return dis, nil
+		case "json":
+			dis := &diffJSONDisplayer{&base}
+			dis.SetDiff(b.dataSource.(*graph.Diff))
+			return dis, nil
 		default:
 			fmt.Fprintf(os.Stderr, "unknown format '%s', display as 'tree'\n", b.format)
 			dis := &diffTreeDisplayer{&base}
@@ -253,6 +246,22 @@ func WithColumns(properties []string) optsFn {
 	}
 }
 
+// WithAttachments adds the AttachmentsColumnDefinitions of the type to the columns, to join in
+// the listing the resources attached to the listed ones (ex: instance and device of volumes)
+func WithAttachments(attachments bool) optsFn {
+	return func(b *Builder) *Builder {
+		if !attachments {
+			return b
+		}
+		columns := b.columnDefinitions
+		if len(columns) == 0 {
+			columns = DefaultsColumnDefinitions[b.rdfType]
+		}
+		b.columnDefinitions = append(append([]ColumnDefinition{}, columns...), AttachmentsColumnDefinitions[b.rdfType]...)
+		return b
+	}
+}
+
 func WithColumnDefinitions(definitions []ColumnDefinition) optsFn {
 	return func(b *Builder) *Builder {
 		b.columnDefinitions = definitions
@@ -355,6 +364,7 @@ type table [][]interface{}
 type fromGraphDisplayer struct {
 	sorter
 	g                 cloud.GraphAPI
+	source            cloud.GraphAPI
 	rdfType           string
 	columnDefinitions []ColumnDefinition
 	maxwidth          int
@@ -365,230 +375,61 @@ func (d *fromGraphDisplayer) setGraph(g cloud.GraphAPI) {
 	d.g = g
 }
 
-type csvDisplayer struct {
-	fromGraphDisplayer
-}
-
-func (d *csvDisplayer) Print(w io.Writer) error {
-	resources, err := d.g.Find(cloud.NewQuery(d.rdfType))
-	if err != nil {
-		return err
-	}
-
-	if len(d.columnDefinitions) == 0 {
-		return nil
-	}
-
-	values := make(table, len(resources))
-	for i, res := range resources {
-		if v := values[i]; v == nil {
-			values[i] = make([]interface{}, len(d.columnDefinitions))
-		}
-		for j, h := range d.columnDefinitions {
-			values[i][j] = res.Properties()[h.propKey()]
+// columnValue returns the value of the column for the resource, joined from the unfiltered
+// source graph for JoinColumnDefinitions
+func (d *fromGraphDisplayer) columnValue(res cloud.Resource, h ColumnDefinition) interface{} {
+	if join, ok := h.(JoinColumnDefinition); ok {
+		if d.source == nil {
+			return nil
 		}
+		return join.Join(d.source, res)
 	}
-
-	d.sorter.sort(values)
-
-	var buff bytes.Buffer
-
-	var head []string
-	for _, h := range d.columnDefinitions {
-		head = append(head, h.title())
-	}
-
-	if !d.noHeaders {
-		buff.WriteString(strings.Join(head, ",") + "\n")
-	}
-
-	for i := range values {
-		var props []string
-		for j, h := range d.columnDefinitions {
-			props = append(props, h.format(values[i][j]))
-		}
-		buff.WriteString(strings.Join(props, ",") + "\n")
-	}
-
-	_, err = w.Write(buff.Bytes())
-	return err
+	return res.Properties()[h.propKey()]
 }
 
-type tsvDisplayer struct {
+// listingDisplayer displays the resources of a type with the renderer of the requested format
+type listingDisplayer struct {
 	fromGraphDisplayer
+	renderer Renderer
 }
 
-func (d *tsvDisplayer) Print(w io.Writer) error {
-	color.NoColor = true // as default tabwriter does not play nice with the color library
-
-	resources, err := d.g.Find(cloud.NewQuery(d.rdfType))
+func (d *listingDisplayer) Print(w io.Writer) error {
+	listing, err := d.listing()
 	if err != nil {
 		return err
 	}
-
-	if len(d.columnDefinitions) == 0 {
-		return nil
-	}
-
-	values := make(table, len(resources))
-	for i, res := range resources {
-		if v := values[i]; v == nil {
-			values[i] = make([]interface{}, len(d.columnDefinitions))
-		}
-		for j, h := range d.columnDefinitions {
-			values[i][j] = res.Properties()[h.propKey()]
-		}
-	}
-
-	d.sorter.sort(values)
-
-	var head []string
-	for _, h := range d.columnDefinitions {
-		head = append(head, h.title())
-	}
-
-	if !d.noHeaders {
-		fmt.Fprintln(w, strings.Join(head, "\t"))
-	}
-
-	for i := range values {
-		var props []string
-		for j, h := range d.columnDefinitions {
-			props = append(props, h.format(values[i][j]))
-		}
-		fmt.Fprintln(w, strings.Join(props, "\t"))
-	}
-
-	return nil
+	return d.renderer.Render(w, listing)
 }
 
-type jsonDisplayer struct {
-	fromGraphDisplayer
-}
-
-func (d *jsonDisplayer) Print(w io.Writer) error {
-	resources, err := d.g.Find(cloud.NewQuery(d.rdfType))
-	if err != nil {
-		return err
-	}
-
-	sort.Slice(resources, func(i, j int) bool { return resources[i].Id() < resources[j].Id() })
-
-	var props []map[string]interface{}
-	for _, res := range resources {
-		props = append(props, res.Properties())
-	}
-
-	enc := json.NewEncoder(w)
-	enc.SetIndent("", " ")
-
-	return enc.Encode(props)
-}
-
-type tableDisplayer struct {
-	fromGraphDisplayer
-}
-
-func (d *tableDisplayer) Print(w io.Writer) error {
+func (d *fromGraphDisplayer) listing() (*Listing, error) {
 	resources, err := d.g.Find(cloud.NewQuery(d.rdfType))
 	if err != nil {
-		return err
-	}
-	if len(resources) == 0 {
-		w.Write([]byte("No results found.\n"))
-		return nil
-	}
-	if len(d.columnDefinitions) == 0 {
-		w.Write([]byte("No columns to display.\n"))
-		return nil
+		return nil, err
 	}
 
+	columnsCount := len(d.columnDefinitions)
 	values := make(table, len(resources))
 	for i, res := range resources {
-		if v := values[i]; v == nil {
-			values[i] = make([]interface{}, len(d.columnDefinitions))
-		}
-		for j, h := range d.columnDefinitions {
-			values[i][j] = res.Properties()[h.propKey()]
-		}
-	}
-
-	d.sorter.sort(values)
-	markColumnAsc := -1
-	if len(d.sorter.columns()) > 0 {
-		markColumnAsc = d.sorter.columns()[0]
-	}
-
-	columnsToDisplay := d.columnDefinitions
-	maxWidthNoWraping := 1
-	if d.maxwidth != 0 {
-		columnsToDisplay = []ColumnDefinition{}
-		currentWidth := 1 // first border
+		// the resource is kept as last value, so that it is sorted along with its row
+		values[i] = make([]interface{}, columnsCount+1)
 		for j, h := range d.columnDefinitions {
-			var symbol string
-			if markColumnAsc == j {
-				symbol = d.sorter.symbol()
-			}
-			colW := colWidth(j, values, h, symbol) + 3 // +3 (tables margin + border)
-			if currentWidth+colW > d.maxwidth {
-				break
-			}
-			currentWidth += colW
-			maxWidthNoWraping += colWidthNoWraping(j, values, h, symbol) + 3
-			columnsToDisplay = append(columnsToDisplay, h)
-		}
-	}
-
-	table := tablewriter.NewWriter(w)
-	table.SetBorders(tablewriter.Border{Left: true, Top: false, Right: true, Bottom: false})
-	table.SetCenterSeparator("|")
-	table.SetAlignment(tablewriter.ALIGN_LEFT)
-	table.SetColWidth(tableColWidth)
-	if !d.noHeaders {
-		var displayHeaders []string
-		for i, h := range columnsToDisplay {
-			var symbol string
-			if markColumnAsc == i {
-				symbol = d.sorter.symbol()
-			}
-			displayHeaders = append(displayHeaders, h.title(symbol))
+			values[i][j] = d.columnValue(res, h)
 		}
-		table.SetHeader(displayHeaders)
+		values[i][columnsCount] = res
 	}
 
-	var enableWraping bool
-	if d.maxwidth <= maxWidthNoWraping {
-		enableWraping = true
+	if columnsCount > 0 {
+		d.sorter.sort(values)
 	}
 
-	wraper := autoWraper{maxWidth: autowrapMaxSize, wrappingChar: " "}
-	for i := range values {
-		var props []string
-		for j, h := range columnsToDisplay {
-			val := h.format(values[i][j])
-			if enableWraping {
-				props = append(props, wraper.Wrap(val))
-			} else {
-				props = append(props, val)
-			}
-
-		}
-		table.Append(props)
+	listing := &Listing{Type: d.rdfType, Columns: d.columnDefinitions, SortColumn: -1, SortSymbol: d.sorter.symbol(), MaxWidth: d.maxwidth, NoHeaders: d.noHeaders}
+	if len(d.sorter.columns()) > 0 {
+		listing.SortColumn = d.sorter.columns()[0]
 	}
-
-	table.Render()
-	if len(columnsToDisplay) < len(d.columnDefinitions) {
-		var hiddenColumns []string
-		for i := len(columnsToDisplay); i < len(d.columnDefinitions); i++ {
-			hiddenColumns = append(hiddenColumns, "'"+d.columnDefinitions[i].title()+"'")
-		}
-		if len(hiddenColumns) == 1 {
-			fmt.Fprint(w, color.New(color.FgRed).SprintfFunc()("Column truncated to fit terminal: %s\n", hiddenColumns[0]))
-		} else {
-			fmt.Fprint(w, color.New(color.FgRed).SprintfFunc()("Columns truncated to fit terminal: %s\n", strings.Join(hiddenColumns, ", ")))
-		}
+	for _, row := range values {
+		listing.Rows = append(listing.Rows, ListingRow{Resource: row[columnsCount].(cloud.Resource), Values: row[:columnsCount]})
 	}
-	return nil
+	return listing, nil
 }
 
 type porcelainDisplayer struct {
@@ -745,61 +586,40 @@ type diffTableDisplayer struct {
 }
 
 func (d *diffTableDisplayer) Print(w io.Writer) error {
-	var values table
-
-	fromCommons := make(map[string]cloud.Resource)
-	toCommons := make(map[string]cloud.Resource)
-	each := func(res *graph.Resource, distance int) error {
-		diff, _ := res.Meta("diff")
-		switch diff {
-		case "extra":
-			values = append(values, []interface{}{
-				res.Type(), color.New(color.FgRed).SprintFunc()("- " + nameOrID(res)), "", "",
-			})
-		default:
-			fromCommons[res.Id()] = res
-		}
-		return nil
-	}
-	err := d.diff.FromGraph().Accept(&graph.ChildrenVisitor{From: d.root.(*graph.Resource), Each: each})
-	if err != nil {
-		return err
-	}
-
-	each = func(res *graph.Resource, distance int) error {
-		meta, _ := res.Meta("diff")
-		switch meta {
-		case "extra":
-			values = append(values, []interface{}{
-				res.Type(), color.New(color.FgGreen).SprintFunc()("+ " + nameOrID(res)), "", "",
-			})
-		default:
-			toCommons[res.Id()] = res
-		}
-		return nil
-	}
-	err = d.diff.ToGraph().Accept(&graph.ChildrenVisitor{From: d.root.(*graph.Resource), Each: each})
+	report, err := BuildDiffReport(d.diff, d.root)
 	if err != nil {
 		return err
 	}
 
-	for _, common := range fromCommons {
-		resType := common.Type()
-		naming := nameOrID(common)
-
-		if rem, ok := toCommons[common.Id()]; ok {
-			added := graph.Subtract(rem.Properties(), common.Properties())
-			for k, v := range added {
-				values = append(values, []interface{}{
-					resType, naming, k, color.New(color.FgGreen).SprintFunc()("+ " + fmt.Sprint(v)),
-				})
+	green, red, yellow := color.New(color.FgGreen).SprintFunc(), color.New(color.FgRed).SprintFunc(), color.New(color.FgYellow).SprintFunc()
+	var values table
+	for _, res := range report.Removed {
+		values = append(values, []interface{}{res.Type, red("- " + res.Name), "", ""})
+	}
+	for _, res := range report.Added {
+		values = append(values, []interface{}{res.Type, green("+ " + res.Name), "", ""})
+	}
+	for _, c := range report.Changed {
+		switch {
+		case len(c.JSONChanges) > 0:
+			for _, jc := range c.JSONChanges {
+				val := jsonChangeValue(jc)
+				switch jc.Kind {
+				case graph.JSONAdded:
+					val = green(val)
+				case graph.JSONRemoved:
+					val = red(val)
+				default:
+					val = yellow(val)
+				}
+				values = append(values, []interface{}{c.Type, c.Name, c.Property + ": " + jc.Path, val})
 			}
-
-			deleted := graph.Subtract(common.Properties(), rem.Properties())
-			for k, v := range deleted {
-				values = append(values, []interface{}{
-					resType, naming, k, color.New(color.FgRed).SprintFunc()("- " + fmt.Sprint(v)),
-				})
+		case c.Kind == PropertyRemoved:
+			values = append(values, []interface{}{c.Type, c.Name, c.Property, red("- " + fmt.Sprint(c.From))})
+		default:
+			values = append(values, []interface{}{c.Type, c.Name, c.Property, green("+ " + fmt.Sprint(c.To))})
+			if c.Kind == PropertyChanged {
+				values = append(values, []interface{}{c.Type, c.Name, c.Property, red("- " + fmt.Sprint(c.From))})
 			}
 		}
 	}
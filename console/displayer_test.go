@@ -18,6 +18,10 @@ package console
 
 import (
 	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -655,6 +659,19 @@ func TestFilter(t *testing.T) {
 		}
 		compareJSON(t, w.String(), expected)
 	})
+	t.Run("Filter prefix", func(t *testing.T) {
+		var w bytes.Buffer
+		displayer, _ := BuildOptions(
+			WithRdfType("subnet"),
+			WithFormat("json"),
+			WithFilters([]string{"prefix=sub_3"}),
+		).SetSource(g).Build()
+		expected := `[{"ID":"sub_3","Public":false,"Name":"my_subnet","Vpc":"vpc_1"}]`
+		if err := displayer.Print(&w); err != nil {
+			t.Fatal(err)
+		}
+		compareJSON(t, w.String(), expected)
+	})
 }
 
 func TestCompareInterface(t *testing.T) {
@@ -900,3 +917,111 @@ func TestNoHeadersDisplay(t *testing.T) {
 		t.Fatalf("got \n%s\n\nwant\n\n%s\n", got, want)
 	}
 }
+
+func TestAttachmentsDisplay(t *testing.T) {
+	g := createInfraGraph()
+	vol1 := resourcetest.Volume("vol_1").Prop(p.Instances, []string{"inst_1"}).Prop(p.Devices, []string{"/dev/sdf"}).Build()
+	vol2 := resourcetest.Volume("vol_2").Build()
+	eni1 := resourcetest.NetworkInterface("eni_1").Prop(p.Instance, "inst_2").Prop(p.Subnet, "sub_1").Build()
+	tg1 := resourcetest.TargetGroup("tg_1").Prop(p.Name, "web-tg").Build()
+	lb1 := resourcetest.LoadBalancer("lb_1").Prop(p.Name, "web-lb").Build()
+	classic1 := resourcetest.ClassicLoadBalancer("classic_1").Prop(p.Name, "legacy").Build()
+	g.AddResource(vol1, vol2, eni1, tg1, lb1, classic1,
+		resourcetest.Subnet("sub_1").Prop(p.Name, "my_subnet").Prop(p.CIDR, "10.0.1.0/24").Build(),
+	)
+	inst1, _ := g.GetResource("instance", "inst_1")
+	inst3, _ := g.GetResource("instance", "inst_3")
+	g.AddAppliesOnRelation(tg1, inst1)
+	g.AddAppliesOnRelation(lb1, tg1)
+	g.AddAppliesOnRelation(classic1, inst1)
+	g.AddAppliesOnRelation(classic1, inst3)
+
+	tcases := []struct {
+		rdfType  string
+		columns  []string
+		expected string
+	}{
+		{
+			rdfType: "volume",
+			columns: []string{"ID", "Instances"},
+			expected: "ID,Instances,InstanceName,Devices\n" +
+				"vol_1,[inst_1],redis,/dev/sdf\n" +
+				"vol_2,,,\n",
+		},
+		{
+			rdfType: "networkinterface",
+			columns: []string{"ID", "Instance", "Subnet"},
+			expected: "ID,Instance,Subnet,InstanceName,SubnetName,SubnetCIDR\n" +
+				"eni_1,inst_2,sub_1,django,my_subnet,10.0.1.0/24\n",
+		},
+		{
+			rdfType: "instance",
+			columns: []string{"ID", "Name"},
+			expected: "ID,Name,TargetGroups,LoadBalancers\n" +
+				"inst_1,redis,web-tg,legacy web-lb\n" +
+				"inst_2,django,,\n" +
+				"inst_3,apache,,legacy\n",
+		},
+	}
+
+	for _, tcase := range tcases {
+		displayer, _ := BuildOptions(
+			WithRdfType(tcase.rdfType),
+			WithColumns(tcase.columns),
+			WithAttachments(true),
+			WithFormat("csv"),
+		).SetSource(g).Build()
+
+		var w bytes.Buffer
+		if err := displayer.Print(&w); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := w.String(), tcase.expected; got != want {
+			t.Fatalf("%s: got \n%q\n\nwant\n\n%q\n", tcase.rdfType, got, want)
+		}
+	}
+}
+
+func TestRegisteredRendererDisplay(t *testing.T) {
+	g := createInfraGraph()
+
+	markdown := RendererFunc(func(w io.Writer, l *Listing) error {
+		fmt.Fprintf(w, "| %s |\n", strings.Join(l.Titles(), " | "))
+		for i, row := range l.Rows {
+			var values []string
+			for j := range l.Columns {
+				values = append(values, l.Format(i, j))
+			}
+			fmt.Fprintf(w, "| %s | (%s)\n", strings.Join(values, " | "), row.Resource.Id())
+		}
+		return nil
+	})
+	if err := RegisterRenderer("markdown", markdown); err != nil {
+		t.Fatal(err)
+	}
+	if err := RegisterRenderer("csv", markdown); err == nil {
+		t.Fatal("expected error when registering an existing format")
+	}
+	if got, want := Renderers(), []string{"csv", "json", "markdown", "table", "tsv"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	displayer, _ := BuildOptions(
+		WithRdfType("instance"),
+		WithColumns([]string{"ID", "Name"}),
+		WithSortBy("Name"),
+		WithFormat("markdown"),
+	).SetSource(g).Build()
+
+	var w bytes.Buffer
+	if err := displayer.Print(&w); err != nil {
+		t.Fatal(err)
+	}
+	expected := "| ID | Name |\n" +
+		"| inst_3 | apache | (inst_3)\n" +
+		"| inst_2 | django | (inst_2)\n" +
+		"| inst_1 | redis | (inst_1)\n"
+	if got, want := w.String(), expected; got != want {
+		t.Fatalf("got \n%q\n\nwant\n\n%q\n", got, want)
+	}
+}
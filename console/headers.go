@@ -246,6 +246,41 @@ func (h FirewallRulesColumnDefinition) format(i interface{}) string {
 	return w.String()
 }
 
+type NetworkACLRulesColumnDefinition struct {
+	StringColumnDefinition
+}
+
+func (h NetworkACLRulesColumnDefinition) format(i interface{}) string {
+	if i == nil {
+		return ""
+	}
+	ii, ok := i.([]*graph.NetworkACLRule)
+	if !ok {
+		return "invalid rules"
+	}
+	var w bytes.Buffer
+
+	for _, r := range ii {
+		w.WriteString(fmt.Sprintf("%d:%s[", r.Number, r.Action))
+		if r.IPRange != nil {
+			w.WriteString(r.IPRange.String())
+		}
+		w.WriteString("](")
+
+		switch {
+		case r.Protocol == "any", r.PortRange.Any:
+			w.WriteString(r.Protocol)
+		case r.PortRange.FromPort == r.PortRange.ToPort:
+			w.WriteString(fmt.Sprintf("%s:%d", r.Protocol, r.PortRange.FromPort))
+		default:
+			w.WriteString(fmt.Sprintf("%s:%d-%d", r.Protocol, r.PortRange.FromPort, r.PortRange.ToPort))
+		}
+
+		w.WriteString(") ")
+	}
+	return w.String()
+}
+
 type RoutesColumnDefinition struct {
 	StringColumnDefinition
 }
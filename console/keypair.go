@@ -21,11 +21,13 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
+	"encoding/binary"
 	"encoding/pem"
 	"fmt"
 	"os"
 	"syscall"
 
+	"golang.org/x/crypto/ed25519"
 	"golang.org/x/crypto/ssh"
 	"golang.org/x/crypto/ssh/terminal"
 )
@@ -85,3 +87,39 @@ var GenerateSSHKeyPair = func(size int, encryptKey bool) ([]byte, []byte, error)
 
 	return ssh.MarshalAuthorizedKey(sshPub), privPem, nil
 }
+
+// GenerateED25519SSHKeyPair generates an ed25519 key pair, the private key being
+// in the unencrypted OpenSSH format (the only one OpenSSH reads for ed25519 keys)
+var GenerateED25519SSHKeyPair = func() ([]byte, []byte, error) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	sshPub, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var check [4]byte
+	if _, err = rand.Read(check[:]); err != nil {
+		return nil, nil, err
+	}
+	checkint := binary.BigEndian.Uint32(check[:])
+	privBlock := ssh.Marshal(struct {
+		Check1, Check2 uint32
+		Keytype        string
+		Pub, Priv      []byte
+		Comment        string
+	}{checkint, checkint, ssh.KeyAlgoED25519, pub, priv, ""})
+	for i := 1; len(privBlock)%8 != 0; i++ {
+		privBlock = append(privBlock, byte(i))
+	}
+
+	key := append([]byte("openssh-key-v1\x00"), ssh.Marshal(struct {
+		CipherName, KdfName, KdfOpts string
+		NumKeys                      uint32
+		PubKey, PrivKeyBlock         []byte
+	}{"none", "none", "", 1, sshPub.Marshal(), privBlock})...)
+
+	return ssh.MarshalAuthorizedKey(sshPub), pem.EncodeToMemory(&pem.Block{Type: "OPENSSH PRIVATE KEY", Bytes: key}), nil
+}
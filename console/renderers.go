@@ -0,0 +1,285 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package console
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/wallix/awless/cloud"
+)
+
+// Listing is a listing of resources of a same type, ready to be rendered in an output format
+type Listing struct {
+	Type    string
+	Columns []ColumnDefinition
+	// Rows are sorted as requested by the user
+	Rows []ListingRow
+	// SortColumn is the index of the main sorting column, or -1 when not sorted by a column
+	SortColumn int
+	SortSymbol string
+	// MaxWidth is the width of the terminal the listing is rendered in, 0 when unknown
+	MaxWidth  int
+	NoHeaders bool
+}
+
+// ListingRow holds a listed resource with the values of the columns of the listing
+type ListingRow struct {
+	Resource cloud.Resource
+	Values   []interface{}
+}
+
+// Titles returns the titles of the columns of the listing
+func (l *Listing) Titles() (titles []string) {
+	for _, c := range l.Columns {
+		titles = append(titles, c.title())
+	}
+	return
+}
+
+// Keys returns the property keys of the columns of the listing
+func (l *Listing) Keys() (keys []string) {
+	for _, c := range l.Columns {
+		keys = append(keys, c.propKey())
+	}
+	return
+}
+
+// Format returns the value of the column j of the row i as displayed in a listing
+func (l *Listing) Format(i, j int) string {
+	return l.Columns[j].format(l.Rows[i].Values[j])
+}
+
+func (l *Listing) values() table {
+	values := make(table, len(l.Rows))
+	for i, row := range l.Rows {
+		values[i] = row.Values
+	}
+	return values
+}
+
+// Renderer writes listings in an output format, selected with the --format flag
+type Renderer interface {
+	Render(io.Writer, *Listing) error
+}
+
+// RendererFunc is a function usable as a Renderer
+type RendererFunc func(io.Writer, *Listing) error
+
+func (fn RendererFunc) Render(w io.Writer, l *Listing) error {
+	return fn(w, l)
+}
+
+var (
+	renderersMu sync.RWMutex
+	renderers   = map[string]Renderer{
+		"table": RendererFunc(renderTable),
+		"csv":   RendererFunc(renderCSV),
+		"tsv":   RendererFunc(renderTSV),
+		"json":  RendererFunc(renderJSON),
+	}
+)
+
+// RegisterRenderer adds an output format for the listings, so that embedders or plugins provide
+// their own formats (ex: HTML fragment, Prometheus textfile) without modifying awless
+func RegisterRenderer(format string, r Renderer) error {
+	renderersMu.Lock()
+	defer renderersMu.Unlock()
+	format = strings.ToLower(format)
+	if format == "" || r == nil {
+		return fmt.Errorf("register renderer: empty format or renderer")
+	}
+	if format == "porcelain" {
+		return fmt.Errorf("register renderer: format '%s' is reserved", format)
+	}
+	if _, ok := renderers[format]; ok {
+		return fmt.Errorf("register renderer: format '%s' already registered", format)
+	}
+	renderers[format] = r
+	return nil
+}
+
+// Renderers returns the sorted output formats of the listings
+func Renderers() (formats []string) {
+	renderersMu.RLock()
+	defer renderersMu.RUnlock()
+	for f := range renderers {
+		formats = append(formats, f)
+	}
+	sort.Strings(formats)
+	return
+}
+
+func getRenderer(format string) (Renderer, bool) {
+	renderersMu.RLock()
+	defer renderersMu.RUnlock()
+	r, ok := renderers[strings.ToLower(format)]
+	return r, ok
+}
+
+func renderCSV(w io.Writer, l *Listing) error {
+	if len(l.Columns) == 0 {
+		return nil
+	}
+
+	var buff bytes.Buffer
+	if !l.NoHeaders {
+		buff.WriteString(strings.Join(l.Titles(), ",") + "\n")
+	}
+	for i := range l.Rows {
+		var props []string
+		for j := range l.Columns {
+			props = append(props, l.Format(i, j))
+		}
+		buff.WriteString(strings.Join(props, ",") + "\n")
+	}
+
+	_, err := w.Write(buff.Bytes())
+	return err
+}
+
+func renderTSV(w io.Writer, l *Listing) error {
+	color.NoColor = true // as default tabwriter does not play nice with the color library
+
+	if len(l.Columns) == 0 {
+		return nil
+	}
+
+	if !l.NoHeaders {
+		fmt.Fprintln(w, strings.Join(l.Titles(), "\t"))
+	}
+	for i := range l.Rows {
+		var props []string
+		for j := range l.Columns {
+			props = append(props, l.Format(i, j))
+		}
+		fmt.Fprintln(w, strings.Join(props, "\t"))
+	}
+
+	return nil
+}
+
+func renderJSON(w io.Writer, l *Listing) error {
+	rows := append([]ListingRow{}, l.Rows...)
+	sort.Slice(rows, func(i, j int) bool { return rows[i].Resource.Id() < rows[j].Resource.Id() })
+
+	var props []map[string]interface{}
+	for _, row := range rows {
+		resProps := row.Resource.Properties()
+		for j, h := range l.Columns {
+			if _, ok := h.(JoinColumnDefinition); ok && row.Values[j] != nil {
+				resProps[h.propKey()] = row.Values[j]
+			}
+		}
+		props = append(props, resProps)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", " ")
+
+	return enc.Encode(props)
+}
+
+func renderTable(w io.Writer, l *Listing) error {
+	if len(l.Rows) == 0 {
+		w.Write([]byte("No results found.\n"))
+		return nil
+	}
+	if len(l.Columns) == 0 {
+		w.Write([]byte("No columns to display.\n"))
+		return nil
+	}
+
+	values := l.values()
+	columnsToDisplay := l.Columns
+	maxWidthNoWraping := 1
+	if l.MaxWidth != 0 {
+		columnsToDisplay = []ColumnDefinition{}
+		currentWidth := 1 // first border
+		for j, h := range l.Columns {
+			var symbol string
+			if l.SortColumn == j {
+				symbol = l.SortSymbol
+			}
+			colW := colWidth(j, values, h, symbol) + 3 // +3 (tables margin + border)
+			if currentWidth+colW > l.MaxWidth {
+				break
+			}
+			currentWidth += colW
+			maxWidthNoWraping += colWidthNoWraping(j, values, h, symbol) + 3
+			columnsToDisplay = append(columnsToDisplay, h)
+		}
+	}
+
+	table := tablewriter.NewWriter(w)
+	table.SetBorders(tablewriter.Border{Left: true, Top: false, Right: true, Bottom: false})
+	table.SetCenterSeparator("|")
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetColWidth(tableColWidth)
+	if !l.NoHeaders {
+		var displayHeaders []string
+		for i, h := range columnsToDisplay {
+			var symbol string
+			if l.SortColumn == i {
+				symbol = l.SortSymbol
+			}
+			displayHeaders = append(displayHeaders, h.title(symbol))
+		}
+		table.SetHeader(displayHeaders)
+	}
+
+	var enableWraping bool
+	if l.MaxWidth <= maxWidthNoWraping {
+		enableWraping = true
+	}
+
+	wraper := autoWraper{maxWidth: autowrapMaxSize, wrappingChar: " "}
+	for i := range values {
+		var props []string
+		for j := range columnsToDisplay {
+			val := l.Format(i, j)
+			if enableWraping {
+				props = append(props, wraper.Wrap(val))
+			} else {
+				props = append(props, val)
+			}
+
+		}
+		table.Append(props)
+	}
+
+	table.Render()
+	if len(columnsToDisplay) < len(l.Columns) {
+		var hiddenColumns []string
+		for i := len(columnsToDisplay); i < len(l.Columns); i++ {
+			hiddenColumns = append(hiddenColumns, "'"+l.Columns[i].title()+"'")
+		}
+		if len(hiddenColumns) == 1 {
+			fmt.Fprint(w, color.New(color.FgRed).SprintfFunc()("Column truncated to fit terminal: %s\n", hiddenColumns[0]))
+		} else {
+			fmt.Fprint(w, color.New(color.FgRed).SprintfFunc()("Columns truncated to fit terminal: %s\n", strings.Join(hiddenColumns, ", ")))
+		}
+	}
+	return nil
+}
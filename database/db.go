@@ -143,6 +143,19 @@ func (db *DB) SetIntValue(key string, value int) error {
 	return db.SetStringValue(key, strconv.Itoa(value))
 }
 
+// Check verifies the consistency of the database pages and returns the first corruption found
+func (db *DB) Check() error {
+	return db.bolt.View(func(tx *bolt.Tx) error {
+		var first error
+		for err := range tx.Check() { // drain all errors for the check to complete
+			if first == nil {
+				first = fmt.Errorf("database corrupted: %s", err)
+			}
+		}
+		return first
+	})
+}
+
 // Close the database
 func (db *DB) Close() {
 	if db.bolt != nil {
@@ -89,3 +89,15 @@ func TestGetSetDatabaseValues(t *testing.T) {
 		t.Fatalf("got %s, want %s", got, want)
 	}
 }
+
+func TestCheckDatabase(t *testing.T) {
+	db, close := newTestDb()
+	defer close()
+
+	if err := db.SetStringValue("mykey", "myvalue"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Check(); err != nil {
+		t.Fatal(err)
+	}
+}
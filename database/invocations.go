@@ -0,0 +1,114 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/boltdb/bolt"
+)
+
+const INVOCATIONS_BUCKET = "invocations"
+
+// MaxInvocations is the number of invocations kept in the history, the oldest being dropped
+const MaxInvocations = 5000
+
+// Invocation is a command line of awless, recorded in the history when it exits
+type Invocation struct {
+	ID       int           `json:"id"`
+	Args     []string      `json:"args"`
+	Profile  string        `json:"profile,omitempty"`
+	Region   string        `json:"region,omitempty"`
+	Date     time.Time     `json:"date"`
+	Duration time.Duration `json:"duration"`
+	ExitCode int           `json:"exitCode"`
+}
+
+// AddInvocation records the invocation in the history, setting its ID
+func (db *DB) AddInvocation(inv *Invocation) error {
+	return db.bolt.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists([]byte(INVOCATIONS_BUCKET))
+		if err != nil {
+			return fmt.Errorf("create bucket %s: %s", INVOCATIONS_BUCKET, err)
+		}
+		seq, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+		inv.ID = int(seq)
+		content, err := json.Marshal(inv)
+		if err != nil {
+			return err
+		}
+		if err = bucket.Put(invocationKey(inv.ID), content); err != nil {
+			return err
+		}
+		c := bucket.Cursor()
+		for k, _ := c.First(); k != nil && binary.BigEndian.Uint64(k)+MaxInvocations <= seq; k, _ = c.First() {
+			if err := c.Delete(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ListInvocations returns the invocations of the history, the oldest first
+func (db *DB) ListInvocations() ([]*Invocation, error) {
+	var invs []*Invocation
+	err := db.bolt.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(INVOCATIONS_BUCKET))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			inv := &Invocation{}
+			if err := json.Unmarshal(v, inv); err != nil {
+				return fmt.Errorf("invocation %d: %s", binary.BigEndian.Uint64(k), err)
+			}
+			invs = append(invs, inv)
+			return nil
+		})
+	})
+	return invs, err
+}
+
+func (db *DB) GetInvocation(id int) (*Invocation, error) {
+	inv := &Invocation{}
+	err := db.bolt.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(INVOCATIONS_BUCKET))
+		if b == nil {
+			return fmt.Errorf("no invocation with id %d in history", id)
+		}
+		content := b.Get(invocationKey(id))
+		if content == nil {
+			return fmt.Errorf("no invocation with id %d in history", id)
+		}
+		return json.Unmarshal(content, inv)
+	})
+	return inv, err
+}
+
+// invocationKey orders the invocations by ID in the bucket
+func invocationKey(id int) []byte {
+	k := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, uint64(id))
+	return k
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestInvocations(t *testing.T) {
+	db, close := newTestDb()
+	defer close()
+
+	if invs, err := db.ListInvocations(); err != nil || len(invs) != 0 {
+		t.Fatalf("got %v, %v, want no invocation", invs, err)
+	}
+
+	date := time.Date(2017, 10, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < MaxInvocations+2; i++ {
+		inv := &Invocation{Args: []string{"ssh", "i-1"}, Profile: "default", Region: "eu-west-1", Date: date, Duration: time.Second}
+		if err := db.AddInvocation(inv); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := inv.ID, i+1; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	}
+
+	invs, err := db.ListInvocations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(invs), MaxInvocations; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := invs[0].ID, 3; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	inv, err := db.GetInvocation(MaxInvocations + 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := inv, (&Invocation{ID: MaxInvocations + 2, Args: []string{"ssh", "i-1"}, Profile: "default", Region: "eu-west-1", Date: date, Duration: time.Second}); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+	if _, err = db.GetInvocation(1); err == nil {
+		t.Fatal("expected error for invocation dropped from history")
+	}
+}
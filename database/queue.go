@@ -0,0 +1,150 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/wallix/awless/queue"
+)
+
+const QUEUE_BUCKET = "queue"
+
+func (db *DB) AddQueuedRun(run *queue.Run) error {
+	return db.bolt.Update(func(tx *bolt.Tx) error {
+		if run.ID == "" {
+			return errors.New("cannot persist queued run with empty ID")
+		}
+		bucket, err := tx.CreateBucketIfNotExists([]byte(QUEUE_BUCKET))
+		if err != nil {
+			return fmt.Errorf("create bucket %s: %s", QUEUE_BUCKET, err)
+		}
+		return putQueuedRun(bucket, run)
+	})
+}
+
+// ListQueuedRuns returns the queued and running runs, the oldest first
+func (db *DB) ListQueuedRuns() ([]*queue.Run, error) {
+	var runs []*queue.Run
+	err := db.bolt.View(func(tx *bolt.Tx) (err error) {
+		runs, err = queuedRuns(tx.Bucket([]byte(QUEUE_BUCKET)))
+		return
+	})
+	return runs, err
+}
+
+// StartNextQueuedRun marks as running and returns the next run to start given the limits, if any
+func (db *DB) StartNextQueuedRun(limits queue.Limits) (*queue.Run, error) {
+	var next *queue.Run
+	err := db.bolt.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(QUEUE_BUCKET))
+		runs, err := queuedRuns(b)
+		if err != nil {
+			return err
+		}
+		if next = queue.Next(runs, limits); next == nil {
+			return nil
+		}
+		next.Status = queue.RunningStatus
+		next.Started = time.Now().UTC()
+		return putQueuedRun(b, next)
+	})
+	return next, err
+}
+
+// InterruptRunningRuns marks as interrupted the runs left running by a stopped worker
+func (db *DB) InterruptRunningRuns() ([]*queue.Run, error) {
+	var interrupted []*queue.Run
+	err := db.bolt.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(QUEUE_BUCKET))
+		runs, err := queuedRuns(b)
+		if err != nil {
+			return err
+		}
+		for _, run := range runs {
+			if run.Status == queue.RunningStatus {
+				run.Status = queue.InterruptedStatus
+				if err := putQueuedRun(b, run); err != nil {
+					return err
+				}
+				interrupted = append(interrupted, run)
+			}
+		}
+		return nil
+	})
+	return interrupted, err
+}
+
+// CancelQueuedRun removes from the queue a run not running
+func (db *DB) CancelQueuedRun(id string) error {
+	return db.bolt.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(QUEUE_BUCKET))
+		if b == nil {
+			return fmt.Errorf("no queued run with id '%s'", id)
+		}
+		content := b.Get([]byte(id))
+		if content == nil {
+			return fmt.Errorf("no queued run with id '%s'", id)
+		}
+		run := &queue.Run{}
+		if err := json.Unmarshal(content, run); err != nil {
+			return err
+		}
+		if run.Status == queue.RunningStatus {
+			return fmt.Errorf("cannot cancel run '%s': already running", id)
+		}
+		return b.Delete([]byte(id))
+	})
+}
+
+func (db *DB) DeleteQueuedRun(id string) error {
+	return db.bolt.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(QUEUE_BUCKET))
+		if b == nil {
+			return nil
+		}
+		return b.Delete([]byte(id))
+	})
+}
+
+func queuedRuns(b *bolt.Bucket) ([]*queue.Run, error) {
+	var runs []*queue.Run
+	if b == nil {
+		return runs, nil
+	}
+	err := b.ForEach(func(k, v []byte) error {
+		run := &queue.Run{}
+		if err := json.Unmarshal(v, run); err != nil {
+			return fmt.Errorf("queued run %s: %s", k, err)
+		}
+		runs = append(runs, run)
+		return nil
+	})
+	return runs, err
+}
+
+func putQueuedRun(b *bolt.Bucket, run *queue.Run) error {
+	content, err := json.Marshal(run)
+	if err != nil {
+		return err
+	}
+	return b.Put([]byte(run.ID), content)
+}
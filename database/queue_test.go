@@ -0,0 +1,76 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/wallix/awless/queue"
+)
+
+func TestQueuedRuns(t *testing.T) {
+	db, close := newTestDb()
+	defer close()
+
+	destroy, err := queue.NewRun("delete vpc id=vpc-1", nil, "default", "eu-west-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	create, err := queue.NewRun("create vpc cidr=10.0.0.0/16", nil, "default", "eu-west-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, run := range []*queue.Run{destroy, create} {
+		if err = db.AddQueuedRun(run); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	next, err := db.StartNextQueuedRun(queue.DefaultLimits())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := next.ID, create.ID; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if err = db.CancelQueuedRun(create.ID); err == nil {
+		t.Fatal("expected error when cancelling a running run")
+	}
+
+	interrupted, err := db.InterruptRunningRuns()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(interrupted), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	runs, err := db.ListQueuedRuns()
+	if err != nil {
+		t.Fatal(err)
+	}
+	statuses := make(map[string]string)
+	for _, run := range runs {
+		statuses[run.ID] = run.Status
+	}
+	if got, want := statuses[create.ID], queue.InterruptedStatus; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := statuses[destroy.ID], queue.QueuedStatus; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	if err = db.CancelQueuedRun(create.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err = db.DeleteQueuedRun(destroy.ID); err != nil {
+		t.Fatal(err)
+	}
+	if runs, err = db.ListQueuedRuns(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(runs), 0; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if next, err = db.StartNextQueuedRun(queue.DefaultLimits()); err != nil || next != nil {
+		t.Fatalf("got %v, %v, want no run to start", next, err)
+	}
+}
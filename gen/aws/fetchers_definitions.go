@@ -28,12 +28,42 @@ func ApiToInterface(api string) string {
 		return "AutoScalingAPI"
 	case "cloudwatch":
 		return "CloudWatchAPI"
+	case "cloudwatchlogs":
+		return "CloudWatchLogsAPI"
+	case "cloudwatchevents":
+		return "CloudWatchEventsAPI"
 	case "cloudfront":
 		return "CloudFrontAPI"
+	case "cloudtrail":
+		return "CloudTrailAPI"
+	case "batch":
+		return "BatchAPI"
+	case "directconnect":
+		return "DirectConnectAPI"
+	case "ec2transitgateway":
+		return "EC2TransitGatewayAPI"
+	case "glue":
+		return "GlueAPI"
+	case "athena":
+		return "AthenaAPI"
+	case "apigateway":
+		return "APIGatewayAPI"
 	case "applicationautoscaling":
 		return "ApplicationAutoScalingAPI"
 	case "cloudformation":
 		return "CloudFormationAPI"
+	case "dynamodb":
+		return "DynamoDBAPI"
+	case "elasticache":
+		return "ElastiCacheAPI"
+	case "elasticbeanstalk":
+		return "ElasticBeanstalkAPI"
+	case "redshift":
+		return "RedshiftAPI"
+	case "organizations":
+		return "OrganizationsAPI"
+	case "secretsmanager":
+		return "SecretsManagerAPI"
 	case "route53", "lambda":
 		return strings.Title(api) + "API"
 	default:
@@ -62,7 +92,7 @@ type fetcher struct {
 var FetchersDefs = []fetchersDef{
 	{
 		Name: "infra",
-		Api:  []string{"ec2", "elbv2", "rds", "autoscaling", "ecr", "ecs", "applicationautoscaling", "acm"},
+		Api:  []string{"ec2", "elbv2", "elb", "rds", "autoscaling", "ecr", "ecs", "applicationautoscaling", "acm", "dynamodb", "elasticache", "redshift", "batch", "directconnect", "ec2transitgateway"},
 		Fetchers: []fetcher{
 			{Api: "ec2", ResourceType: cloud.Instance, AWSType: "ec2.Instance", ApiMethod: "DescribeInstancesPages", Input: "ec2.DescribeInstancesInput{}", Output: "ec2.DescribeInstancesOutput", OutputsExtractor: "Instances", OutputsContainers: "Reservations", Multipage: true, NextPageMarker: "NextToken"},
 			{Api: "ec2", ResourceType: cloud.Subnet, AWSType: "ec2.Subnet", ApiMethod: "DescribeSubnets", Input: "ec2.DescribeSubnetsInput{}", Output: "ec2.DescribeSubnetsOutput", OutputsExtractor: "Subnets"},
@@ -72,33 +102,59 @@ var FetchersDefs = []fetchersDef{
 			{Api: "ec2", ResourceType: cloud.Volume, AWSType: "ec2.Volume", ApiMethod: "DescribeVolumesPages", Input: "ec2.DescribeVolumesInput{}", Output: "ec2.DescribeVolumesOutput", OutputsExtractor: "Volumes", Multipage: true, NextPageMarker: "NextToken"},
 			{Api: "ec2", ResourceType: cloud.InternetGateway, AWSType: "ec2.InternetGateway", ApiMethod: "DescribeInternetGateways", Input: "ec2.DescribeInternetGatewaysInput{}", Output: "ec2.DescribeInternetGatewaysOutput", OutputsExtractor: "InternetGateways"},
 			{Api: "ec2", ResourceType: cloud.NatGateway, AWSType: "ec2.NatGateway", ApiMethod: "DescribeNatGateways", Input: "ec2.DescribeNatGatewaysInput{}", Output: "ec2.DescribeNatGatewaysOutput", OutputsExtractor: "NatGateways"},
+			{Api: "ec2", ResourceType: cloud.PeeringConnection, AWSType: "ec2.VpcPeeringConnection", ApiMethod: "DescribeVpcPeeringConnections", Input: "ec2.DescribeVpcPeeringConnectionsInput{}", Output: "ec2.DescribeVpcPeeringConnectionsOutput", OutputsExtractor: "VpcPeeringConnections"},
+			{Api: "ec2", ResourceType: cloud.VpcEndpoint, AWSType: "ec2.VpcEndpoint", ApiMethod: "DescribeVpcEndpoints", Input: "ec2.DescribeVpcEndpointsInput{}", Output: "ec2.DescribeVpcEndpointsOutput", OutputsExtractor: "VpcEndpoints"},
+			{Api: "ec2", ResourceType: cloud.CustomerGateway, AWSType: "ec2.CustomerGateway", ApiMethod: "DescribeCustomerGateways", Input: "ec2.DescribeCustomerGatewaysInput{}", Output: "ec2.DescribeCustomerGatewaysOutput", OutputsExtractor: "CustomerGateways"},
+			{Api: "ec2", ResourceType: cloud.VpnGateway, AWSType: "ec2.VpnGateway", ApiMethod: "DescribeVpnGateways", Input: "ec2.DescribeVpnGatewaysInput{}", Output: "ec2.DescribeVpnGatewaysOutput", OutputsExtractor: "VpnGateways"},
+			{Api: "ec2", ResourceType: cloud.VpnConnection, AWSType: "ec2.VpnConnection", ApiMethod: "DescribeVpnConnections", Input: "ec2.DescribeVpnConnectionsInput{}", Output: "ec2.DescribeVpnConnectionsOutput", OutputsExtractor: "VpnConnections"},
 			{Api: "ec2", ResourceType: cloud.RouteTable, AWSType: "ec2.RouteTable", ApiMethod: "DescribeRouteTables", Input: "ec2.DescribeRouteTablesInput{}", Output: "ec2.DescribeRouteTablesOutput", OutputsExtractor: "RouteTables"},
+			{Api: "ec2", ResourceType: cloud.NetworkACL, AWSType: "ec2.NetworkAcl", ApiMethod: "DescribeNetworkAcls", Input: "ec2.DescribeNetworkAclsInput{}", Output: "ec2.DescribeNetworkAclsOutput", OutputsExtractor: "NetworkAcls"},
+			{Api: "ec2", ResourceType: cloud.PlacementGroup, AWSType: "ec2.PlacementGroup", ApiMethod: "DescribePlacementGroups", Input: "ec2.DescribePlacementGroupsInput{}", Output: "ec2.DescribePlacementGroupsOutput", OutputsExtractor: "PlacementGroups"},
+			{Api: "ec2", ResourceType: cloud.Host, AWSType: "ec2.Host", ApiMethod: "DescribeHosts", Input: "ec2.DescribeHostsInput{}", Output: "ec2.DescribeHostsOutput", OutputsExtractor: "Hosts"},
 			{Api: "ec2", ResourceType: cloud.AvailabilityZone, AWSType: "ec2.AvailabilityZone", ApiMethod: "DescribeAvailabilityZones", Input: "ec2.DescribeAvailabilityZonesInput{}", Output: "ec2.DescribeAvailabilityZonesOutput", OutputsExtractor: "AvailabilityZones"},
 			{Api: "ec2", ResourceType: cloud.Image, AWSType: "ec2.Image", ApiMethod: "DescribeImages", Input: "ec2.DescribeImagesInput{Owners: []*string{awssdk.String(\"self\")}}", Output: "ec2.DescribeImagesOutput", OutputsExtractor: "Images"},
 			{Api: "ec2", ResourceType: cloud.ImportImageTask, AWSType: "ec2.ImportImageTask", ApiMethod: "DescribeImportImageTasks", Input: "ec2.DescribeImportImageTasksInput{}", Output: "ec2.DescribeImportImageTasksOutput", OutputsExtractor: "ImportImageTasks"},
 			{Api: "ec2", ResourceType: cloud.ElasticIP, AWSType: "ec2.Address", ApiMethod: "DescribeAddresses", Input: "ec2.DescribeAddressesInput{}", Output: "ec2.DescribeAddressesOutput", OutputsExtractor: "Addresses"},
 			{Api: "ec2", ResourceType: cloud.Snapshot, AWSType: "ec2.Snapshot", ApiMethod: "DescribeSnapshotsPages", Input: "ec2.DescribeSnapshotsInput{OwnerIds:[]*string{awssdk.String(\"self\")}}", Output: "ec2.DescribeSnapshotsOutput", OutputsExtractor: "Snapshots", Multipage: true, NextPageMarker: "NextToken"},
 			{Api: "ec2", ResourceType: cloud.NetworkInterface, AWSType: "ec2.NetworkInterface", ApiMethod: "DescribeNetworkInterfaces", Input: "ec2.DescribeNetworkInterfacesInput{}", Output: "ec2.DescribeNetworkInterfacesOutput", OutputsExtractor: "NetworkInterfaces"},
+			{Api: "ec2", ResourceType: cloud.SpotRequest, AWSType: "ec2.SpotInstanceRequest", ApiMethod: "DescribeSpotInstanceRequests", Input: "ec2.DescribeSpotInstanceRequestsInput{}", Output: "ec2.DescribeSpotInstanceRequestsOutput", OutputsExtractor: "SpotInstanceRequests"},
+			{Api: "ec2", ResourceType: cloud.SpotFleet, AWSType: "ec2.SpotFleetRequestConfig", ApiMethod: "DescribeSpotFleetRequestsPages", Input: "ec2.DescribeSpotFleetRequestsInput{}", Output: "ec2.DescribeSpotFleetRequestsOutput", OutputsExtractor: "SpotFleetRequestConfigs", Multipage: true, NextPageMarker: "NextToken"},
+			{Api: "ec2", ResourceType: cloud.Reservation, AWSType: "ec2.ReservedInstances", ManualFetcher: true},
 			{Api: "elbv2", ResourceType: cloud.LoadBalancer, AWSType: "elbv2.LoadBalancer", ApiMethod: "DescribeLoadBalancersPages", Input: "elbv2.DescribeLoadBalancersInput{}", Output: "elbv2.DescribeLoadBalancersOutput", OutputsExtractor: "LoadBalancers", Multipage: true, NextPageMarker: "NextMarker"},
 			{Api: "elbv2", ResourceType: cloud.TargetGroup, AWSType: "elbv2.TargetGroup", ApiMethod: "DescribeTargetGroups", Input: "elbv2.DescribeTargetGroupsInput{}", Output: "elbv2.DescribeTargetGroupsOutput", OutputsExtractor: "TargetGroups"},
 			{Api: "elbv2", ResourceType: cloud.Listener, AWSType: "elbv2.Listener", ManualFetcher: true},
+			{Api: "elb", ResourceType: cloud.ClassicLoadBalancer, AWSType: "elb.LoadBalancerDescription", ApiMethod: "DescribeLoadBalancersPages", Input: "elb.DescribeLoadBalancersInput{}", Output: "elb.DescribeLoadBalancersOutput", OutputsExtractor: "LoadBalancerDescriptions", Multipage: true, NextPageMarker: "NextMarker"},
 			{Api: "rds", ResourceType: cloud.Database, AWSType: "rds.DBInstance", ApiMethod: "DescribeDBInstancesPages", Input: "rds.DescribeDBInstancesInput{}", Output: "rds.DescribeDBInstancesOutput", OutputsExtractor: "DBInstances", Multipage: true, NextPageMarker: "Marker"},
 			{Api: "rds", ResourceType: cloud.DbSubnetGroup, AWSType: "rds.DBSubnetGroup", ApiMethod: "DescribeDBSubnetGroupsPages", Input: "rds.DescribeDBSubnetGroupsInput{}", Output: "rds.DescribeDBSubnetGroupsOutput", OutputsExtractor: "DBSubnetGroups", Multipage: true, NextPageMarker: "Marker"},
+			{Api: "rds", ResourceType: cloud.DbParameterGroup, AWSType: "rds.DBParameterGroup", ApiMethod: "DescribeDBParameterGroupsPages", Input: "rds.DescribeDBParameterGroupsInput{}", Output: "rds.DescribeDBParameterGroupsOutput", OutputsExtractor: "DBParameterGroups", Multipage: true, NextPageMarker: "Marker"},
 			{Api: "autoscaling", ResourceType: cloud.LaunchConfiguration, AWSType: "autoscaling.LaunchConfiguration", ApiMethod: "DescribeLaunchConfigurationsPages", Input: "autoscaling.DescribeLaunchConfigurationsInput{}", Output: "autoscaling.DescribeLaunchConfigurationsOutput", OutputsExtractor: "LaunchConfigurations", Multipage: true, NextPageMarker: "NextToken"},
 			{Api: "autoscaling", ResourceType: cloud.ScalingGroup, AWSType: "autoscaling.Group", ApiMethod: "DescribeAutoScalingGroupsPages", Input: "autoscaling.DescribeAutoScalingGroupsInput{}", Output: "autoscaling.DescribeAutoScalingGroupsOutput", OutputsExtractor: "AutoScalingGroups", Multipage: true, NextPageMarker: "NextToken"},
 			{Api: "autoscaling", ResourceType: cloud.ScalingPolicy, AWSType: "autoscaling.ScalingPolicy", ApiMethod: "DescribePoliciesPages", Input: "autoscaling.DescribePoliciesInput{}", Output: "autoscaling.DescribePoliciesOutput", OutputsExtractor: "ScalingPolicies", Multipage: true, NextPageMarker: "NextToken"},
 			{Api: "ecr", ResourceType: cloud.Repository, AWSType: "ecr.Repository", ApiMethod: "DescribeRepositoriesPages", Input: "ecr.DescribeRepositoriesInput{}", Output: "ecr.DescribeRepositoriesOutput", OutputsExtractor: "Repositories", Multipage: true, NextPageMarker: "NextToken"},
 			{Api: "ecs", ResourceType: cloud.ContainerCluster, AWSType: "ecs.Cluster", ManualFetcher: true},
+			{Api: "ecs", ResourceType: cloud.ContainerService, AWSType: "ecs.Service", ManualFetcher: true},
 			{Api: "ecs", ResourceType: cloud.ContainerTask, AWSType: "ecs.TaskDefinition", ManualFetcher: true},
 			{Api: "ecs", ResourceType: cloud.Container, AWSType: "ecs.Container", ManualFetcher: true},
 			{Api: "ecs", ResourceType: cloud.ContainerInstance, AWSType: "ecs.ContainerInstance", ManualFetcher: true},
 			{Api: "acm", ResourceType: cloud.Certificate, AWSType: "acm.CertificateSummary", ApiMethod: "ListCertificatesPages", Input: "acm.ListCertificatesInput{}", Output: "acm.ListCertificatesOutput", OutputsExtractor: "CertificateSummaryList", Multipage: true, NextPageMarker: "NextToken"},
+			{Api: "dynamodb", ResourceType: cloud.Table, AWSType: "dynamodb.TableDescription", ManualFetcher: true},
+			{Api: "elasticache", ResourceType: cloud.CacheCluster, AWSType: "elasticache.CacheCluster", ApiMethod: "DescribeCacheClustersPages", Input: "elasticache.DescribeCacheClustersInput{ShowCacheNodeInfo: awssdk.Bool(true)}", Output: "elasticache.DescribeCacheClustersOutput", OutputsExtractor: "CacheClusters", Multipage: true, NextPageMarker: "Marker"},
+			{Api: "elasticache", ResourceType: cloud.CacheSubnetGroup, AWSType: "elasticache.CacheSubnetGroup", ApiMethod: "DescribeCacheSubnetGroupsPages", Input: "elasticache.DescribeCacheSubnetGroupsInput{}", Output: "elasticache.DescribeCacheSubnetGroupsOutput", OutputsExtractor: "CacheSubnetGroups", Multipage: true, NextPageMarker: "Marker"},
+			{Api: "redshift", ResourceType: cloud.Cluster, AWSType: "redshift.Cluster", ApiMethod: "DescribeClustersPages", Input: "redshift.DescribeClustersInput{}", Output: "redshift.DescribeClustersOutput", OutputsExtractor: "Clusters", Multipage: true, NextPageMarker: "Marker"},
+			{Api: "batch", ResourceType: cloud.ComputeEnvironment, AWSType: "batch.ComputeEnvironmentDetail", ApiMethod: "DescribeComputeEnvironments", Input: "batch.DescribeComputeEnvironmentsInput{}", Output: "batch.DescribeComputeEnvironmentsOutput", OutputsExtractor: "ComputeEnvironments"},
+			{Api: "batch", ResourceType: cloud.JobQueue, AWSType: "batch.JobQueueDetail", ApiMethod: "DescribeJobQueues", Input: "batch.DescribeJobQueuesInput{}", Output: "batch.DescribeJobQueuesOutput", OutputsExtractor: "JobQueues"},
+			{Api: "batch", ResourceType: cloud.Job, AWSType: "batch.JobDetail", ManualFetcher: true},
+			{Api: "directconnect", ResourceType: cloud.DxConnection, AWSType: "directconnect.Connection", ApiMethod: "DescribeConnections", Input: "directconnect.DescribeConnectionsInput{}", Output: "directconnect.Connections", OutputsExtractor: "Connections"},
+			{Api: "directconnect", ResourceType: cloud.VirtualInterface, AWSType: "directconnect.VirtualInterface", ApiMethod: "DescribeVirtualInterfaces", Input: "directconnect.DescribeVirtualInterfacesInput{}", Output: "directconnect.DescribeVirtualInterfacesOutput", OutputsExtractor: "VirtualInterfaces"},
+			{Api: "ec2transitgateway", ResourceType: cloud.TransitGateway, AWSType: "ec2transitgateway.TransitGateway", ManualFetcher: true},
+			{Api: "ec2transitgateway", ResourceType: cloud.TransitGatewayAttachment, AWSType: "ec2transitgateway.TransitGatewayAttachment", ManualFetcher: true},
+			{Api: "ec2transitgateway", ResourceType: cloud.TransitGatewayRouteTable, AWSType: "ec2transitgateway.TransitGatewayRouteTable", ManualFetcher: true},
 		},
 	},
 	{
 		Name:   "access",
 		Global: true,
-		Api:    []string{"iam", "sts"},
+		Api:    []string{"iam", "sts", "kms", "organizations"},
 		Fetchers: []fetcher{
 			{Api: "iam", ResourceType: cloud.User, AWSType: "iam.UserDetail", ManualFetcher: true},
 			{Api: "iam", ResourceType: cloud.Group, AWSType: "iam.GroupDetail", ManualFetcher: true},
@@ -107,6 +163,9 @@ var FetchersDefs = []fetchersDef{
 			{Api: "iam", ResourceType: cloud.AccessKey, AWSType: "iam.AccessKeyMetadata", ManualFetcher: true},
 			{Api: "iam", ResourceType: cloud.InstanceProfile, AWSType: "iam.InstanceProfile", ApiMethod: "ListInstanceProfilesPages", Input: "iam.ListInstanceProfilesInput{}", Output: "iam.ListInstanceProfilesOutput", OutputsExtractor: "InstanceProfiles", Multipage: true, NextPageMarker: "Marker"},
 			{Api: "iam", ResourceType: cloud.MFADevice, AWSType: "iam.VirtualMFADevice", ApiMethod: "ListVirtualMFADevicesPages", Input: "iam.ListVirtualMFADevicesInput{}", Output: "iam.ListVirtualMFADevicesOutput", OutputsExtractor: "VirtualMFADevices", Multipage: true, NextPageMarker: "Marker"},
+			{Api: "kms", ResourceType: cloud.Key, AWSType: "kms.KeyMetadata", ManualFetcher: true},
+			{Api: "organizations", ResourceType: cloud.Account, AWSType: "organizations.Account", ManualFetcher: true},
+			{Api: "organizations", ResourceType: cloud.OrganizationalUnit, AWSType: "organizations.OrganizationalUnit", ManualFetcher: true},
 		},
 	},
 	{
@@ -138,17 +197,22 @@ var FetchersDefs = []fetchersDef{
 
 	{
 		Name: "lambda",
-		Api:  []string{"lambda"},
+		Api:  []string{"lambda", "sfn"},
 		Fetchers: []fetcher{
 			{Api: "lambda", ResourceType: cloud.Function, AWSType: "lambda.FunctionConfiguration", ApiMethod: "ListFunctionsPages", Input: "lambda.ListFunctionsInput{}", Output: "lambda.ListFunctionsOutput", OutputsExtractor: "Functions", Multipage: true, NextPageMarker: "NextMarker"},
+			{Api: "sfn", ResourceType: cloud.StateMachine, AWSType: "sfn.StateMachineListItem", ApiMethod: "ListStateMachinesPages", Input: "sfn.ListStateMachinesInput{}", Output: "sfn.ListStateMachinesOutput", OutputsExtractor: "StateMachines", Multipage: true, NextPageMarker: "NextToken"},
+			{Api: "sfn", ResourceType: cloud.Execution, AWSType: "sfn.ExecutionListItem", ManualFetcher: true},
 		},
 	},
 	{
 		Name: "monitoring",
-		Api:  []string{"cloudwatch"},
+		Api:  []string{"cloudwatch", "cloudwatchlogs", "cloudtrail"},
 		Fetchers: []fetcher{
 			{Api: "cloudwatch", ResourceType: cloud.Metric, AWSType: "cloudwatch.Metric", ApiMethod: "ListMetricsPages", Input: "cloudwatch.ListMetricsInput{}", Output: "cloudwatch.ListMetricsOutput", OutputsExtractor: "Metrics", Multipage: true, NextPageMarker: "NextToken"},
 			{Api: "cloudwatch", ResourceType: cloud.Alarm, AWSType: "cloudwatch.MetricAlarm", ApiMethod: "DescribeAlarmsPages", Input: "cloudwatch.DescribeAlarmsInput{}", Output: "cloudwatch.DescribeAlarmsOutput", OutputsExtractor: "MetricAlarms", Multipage: true, NextPageMarker: "NextToken"},
+			{Api: "cloudwatchlogs", ResourceType: cloud.LogGroup, AWSType: "cloudwatchlogs.LogGroup", ApiMethod: "DescribeLogGroupsPages", Input: "cloudwatchlogs.DescribeLogGroupsInput{}", Output: "cloudwatchlogs.DescribeLogGroupsOutput", OutputsExtractor: "LogGroups", Multipage: true, NextPageMarker: "NextToken"},
+			{Api: "cloudtrail", ResourceType: cloud.Trail, AWSType: "cloudtrail.Trail", ApiMethod: "DescribeTrails", Input: "cloudtrail.DescribeTrailsInput{}", Output: "cloudtrail.DescribeTrailsOutput", OutputsExtractor: "TrailList"},
+			{Api: "cloudtrail", ResourceType: cloud.TrailEvent, AWSType: "cloudtrail.Event", ManualFetcher: true},
 		},
 	},
 	{
@@ -163,7 +227,7 @@ var FetchersDefs = []fetchersDef{
 		Name: "cloudformation", //deployment ?
 		Api:  []string{"cloudformation"},
 		Fetchers: []fetcher{
-			{Api: "cloudformation", ResourceType: cloud.Stack, AWSType: "cloudformation.Stack", ApiMethod: "DescribeStacksPages", Input: "cloudformation.DescribeStacksInput{}", Output: "cloudformation.DescribeStacksOutput", OutputsExtractor: "Stacks", Multipage: true, NextPageMarker: "NextToken"},
+			{Api: "cloudformation", ResourceType: cloud.Stack, AWSType: "cloudformation.Stack", ManualFetcher: true},
 		},
 	},
 }
@@ -275,13 +275,6 @@ func (cmd *{{ $cmdName }}) SetApi(api {{$tag.API}}iface.{{ ApiToInterface $tag.A
 }
 
 func (cmd *{{ $cmdName }}) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if renv.IsDryRun() {
-		return cmd.dryRun(renv, params)
-	}
-	return cmd.run(renv, params)
-}
-
-func (cmd *{{ $cmdName }}) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -337,7 +330,7 @@ func (cmd *{{ $cmdName }}) run(renv env.Running, params map[string]interface{})
 
 {{ if $tag.HasDryRun }}
 	{{ if $tag.GenDryRun }}
-	func (cmd *{{ $cmdName }}) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	func (cmd *{{ $cmdName }}) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 		if err := cmd.inject(params); err != nil {
 			return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 		}
@@ -363,7 +356,7 @@ func (cmd *{{ $cmdName }}) run(renv env.Running, params map[string]interface{})
 	}
 	{{- end }}
 {{- else }}
-func (cmd *{{ $cmdName }}) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *{{ $cmdName }}) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	return fakeDryRunId("{{ $tag.Entity }}"), nil
 }
 {{- end }}
@@ -42,13 +42,24 @@ var mocksDefs = []*mockDef{
 			{FuncType: "list", AWSType: "ec2.Volume", ApiMethod: "DescribeVolumesPages", Input: "ec2.DescribeVolumesInput", Output: "ec2.DescribeVolumesOutput", OutputsExtractor: "Volumes", Multipage: true, NextPageMarker: "NextToken"},
 			{FuncType: "list", AWSType: "ec2.InternetGateway", ApiMethod: "DescribeInternetGateways", Input: "ec2.DescribeInternetGatewaysInput", Output: "ec2.DescribeInternetGatewaysOutput", OutputsExtractor: "InternetGateways"},
 			{FuncType: "list", AWSType: "ec2.NatGateway", ApiMethod: "DescribeNatGateways", Input: "ec2.DescribeNatGatewaysInput", Output: "ec2.DescribeNatGatewaysOutput", OutputsExtractor: "NatGateways"},
+			{FuncType: "list", AWSType: "ec2.VpcPeeringConnection", ApiMethod: "DescribeVpcPeeringConnections", Input: "ec2.DescribeVpcPeeringConnectionsInput", Output: "ec2.DescribeVpcPeeringConnectionsOutput", OutputsExtractor: "VpcPeeringConnections"},
+			{FuncType: "list", AWSType: "ec2.VpcEndpoint", ApiMethod: "DescribeVpcEndpoints", Input: "ec2.DescribeVpcEndpointsInput", Output: "ec2.DescribeVpcEndpointsOutput", OutputsExtractor: "VpcEndpoints"},
+			{FuncType: "list", AWSType: "ec2.CustomerGateway", ApiMethod: "DescribeCustomerGateways", Input: "ec2.DescribeCustomerGatewaysInput", Output: "ec2.DescribeCustomerGatewaysOutput", OutputsExtractor: "CustomerGateways"},
+			{FuncType: "list", AWSType: "ec2.VpnGateway", ApiMethod: "DescribeVpnGateways", Input: "ec2.DescribeVpnGatewaysInput", Output: "ec2.DescribeVpnGatewaysOutput", OutputsExtractor: "VpnGateways"},
+			{FuncType: "list", AWSType: "ec2.VpnConnection", ApiMethod: "DescribeVpnConnections", Input: "ec2.DescribeVpnConnectionsInput", Output: "ec2.DescribeVpnConnectionsOutput", OutputsExtractor: "VpnConnections"},
 			{FuncType: "list", AWSType: "ec2.RouteTable", ApiMethod: "DescribeRouteTables", Input: "ec2.DescribeRouteTablesInput", Output: "ec2.DescribeRouteTablesOutput", OutputsExtractor: "RouteTables"},
+			{FuncType: "list", AWSType: "ec2.NetworkAcl", ApiMethod: "DescribeNetworkAcls", Input: "ec2.DescribeNetworkAclsInput", Output: "ec2.DescribeNetworkAclsOutput", OutputsExtractor: "NetworkAcls"},
+			{FuncType: "list", AWSType: "ec2.PlacementGroup", ApiMethod: "DescribePlacementGroups", Input: "ec2.DescribePlacementGroupsInput", Output: "ec2.DescribePlacementGroupsOutput", OutputsExtractor: "PlacementGroups"},
+			{FuncType: "list", AWSType: "ec2.Host", ApiMethod: "DescribeHosts", Input: "ec2.DescribeHostsInput", Output: "ec2.DescribeHostsOutput", OutputsExtractor: "Hosts"},
 			{FuncType: "list", AWSType: "ec2.AvailabilityZone", ApiMethod: "DescribeAvailabilityZones", Input: "ec2.DescribeAvailabilityZonesInput", Output: "ec2.DescribeAvailabilityZonesOutput", OutputsExtractor: "AvailabilityZones"},
 			{FuncType: "list", AWSType: "ec2.Image", ApiMethod: "DescribeImages", Input: "ec2.DescribeImagesInput", Output: "ec2.DescribeImagesOutput", OutputsExtractor: "Images"},
 			{FuncType: "list", AWSType: "ec2.ImportImageTask", ApiMethod: "DescribeImportImageTasks", Input: "ec2.DescribeImportImageTasksInput", Output: "ec2.DescribeImportImageTasksOutput", OutputsExtractor: "ImportImageTasks"},
 			{FuncType: "list", AWSType: "ec2.Address", ApiMethod: "DescribeAddresses", Input: "ec2.DescribeAddressesInput", Output: "ec2.DescribeAddressesOutput", OutputsExtractor: "Addresses"},
 			{FuncType: "list", AWSType: "ec2.Snapshot", ApiMethod: "DescribeSnapshotsPages", Input: "ec2.DescribeSnapshotsInput", Output: "ec2.DescribeSnapshotsOutput", OutputsExtractor: "Snapshots", Multipage: true, NextPageMarker: "NextToken"},
 			{FuncType: "list", AWSType: "ec2.NetworkInterface", ApiMethod: "DescribeNetworkInterfaces", Input: "ec2.DescribeNetworkInterfacesInput", Output: "ec2.DescribeNetworkInterfacesOutput", OutputsExtractor: "NetworkInterfaces"},
+			{FuncType: "list", AWSType: "ec2.SpotInstanceRequest", ApiMethod: "DescribeSpotInstanceRequests", Input: "ec2.DescribeSpotInstanceRequestsInput", Output: "ec2.DescribeSpotInstanceRequestsOutput", OutputsExtractor: "SpotInstanceRequests"},
+			{FuncType: "list", AWSType: "ec2.SpotFleetRequestConfig", ApiMethod: "DescribeSpotFleetRequestsPages", Input: "ec2.DescribeSpotFleetRequestsInput", Output: "ec2.DescribeSpotFleetRequestsOutput", OutputsExtractor: "SpotFleetRequestConfigs", Multipage: true, NextPageMarker: "NextToken"},
+			{FuncType: "list", AWSType: "ec2.ReservedInstances", Manual: true},
 		},
 	},
 	{
@@ -60,11 +71,18 @@ var mocksDefs = []*mockDef{
 			{FuncType: "list", AWSType: "elbv2.TargetHealthDescription", Manual: true, MockFieldType: "mapslice"},
 		},
 	},
+	{
+		Api: "elb",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "elb.LoadBalancerDescription", ApiMethod: "DescribeLoadBalancersPages", Input: "elb.DescribeLoadBalancersInput", Output: "elb.DescribeLoadBalancersOutput", OutputsExtractor: "LoadBalancerDescriptions", Multipage: true, NextPageMarker: "NextMarker"},
+		},
+	},
 	{
 		Api: "rds",
 		Funcs: []*mockFuncDef{
 			{FuncType: "list", AWSType: "rds.DBInstance", ApiMethod: "DescribeDBInstancesPages", Input: "rds.DescribeDBInstancesInput", Output: "rds.DescribeDBInstancesOutput", OutputsExtractor: "DBInstances", Multipage: true, NextPageMarker: "Marker"},
 			{FuncType: "list", AWSType: "rds.DBSubnetGroup", ApiMethod: "DescribeDBSubnetGroupsPages", Input: "rds.DescribeDBSubnetGroupsInput", Output: "rds.DescribeDBSubnetGroupsOutput", OutputsExtractor: "DBSubnetGroups", Multipage: true, NextPageMarker: "Marker"},
+			{FuncType: "list", AWSType: "rds.DBParameterGroup", ApiMethod: "DescribeDBParameterGroupsPages", Input: "rds.DescribeDBParameterGroupsInput", Output: "rds.DescribeDBParameterGroupsOutput", OutputsExtractor: "DBParameterGroups", Multipage: true, NextPageMarker: "Marker"},
 		},
 	},
 	{
@@ -81,6 +99,48 @@ var mocksDefs = []*mockDef{
 			{FuncType: "list", AWSType: "acm.CertificateSummary", ApiMethod: "ListCertificatesPages", Input: "acm.ListCertificatesInput", Output: "acm.ListCertificatesOutput", OutputsExtractor: "CertificateSummaryList", Multipage: true, NextPageMarker: "NextToken"},
 		},
 	},
+	{
+		Api: "dynamodb",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "dynamodb.TableDescription", Manual: true},
+		},
+	},
+	{
+		Api: "elasticache",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "elasticache.CacheCluster", ApiMethod: "DescribeCacheClustersPages", Input: "elasticache.DescribeCacheClustersInput", Output: "elasticache.DescribeCacheClustersOutput", OutputsExtractor: "CacheClusters", Multipage: true, NextPageMarker: "Marker"},
+			{FuncType: "list", AWSType: "elasticache.CacheSubnetGroup", ApiMethod: "DescribeCacheSubnetGroupsPages", Input: "elasticache.DescribeCacheSubnetGroupsInput", Output: "elasticache.DescribeCacheSubnetGroupsOutput", OutputsExtractor: "CacheSubnetGroups", Multipage: true, NextPageMarker: "Marker"},
+		},
+	},
+	{
+		Api: "redshift",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "redshift.Cluster", ApiMethod: "DescribeClustersPages", Input: "redshift.DescribeClustersInput", Output: "redshift.DescribeClustersOutput", OutputsExtractor: "Clusters", Multipage: true, NextPageMarker: "Marker"},
+		},
+	},
+	{
+		Api: "batch",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "batch.ComputeEnvironmentDetail", ApiMethod: "DescribeComputeEnvironments", Input: "batch.DescribeComputeEnvironmentsInput", Output: "batch.DescribeComputeEnvironmentsOutput", OutputsExtractor: "ComputeEnvironments"},
+			{FuncType: "list", AWSType: "batch.JobQueueDetail", ApiMethod: "DescribeJobQueues", Input: "batch.DescribeJobQueuesInput", Output: "batch.DescribeJobQueuesOutput", OutputsExtractor: "JobQueues"},
+			{FuncType: "list", AWSType: "batch.JobDetail", Manual: true},
+		},
+	},
+	{
+		Api: "directconnect",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "directconnect.Connection", ApiMethod: "DescribeConnections", Input: "directconnect.DescribeConnectionsInput", Output: "directconnect.Connections", OutputsExtractor: "Connections"},
+			{FuncType: "list", AWSType: "directconnect.VirtualInterface", ApiMethod: "DescribeVirtualInterfaces", Input: "directconnect.DescribeVirtualInterfacesInput", Output: "directconnect.DescribeVirtualInterfacesOutput", OutputsExtractor: "VirtualInterfaces"},
+		},
+	},
+	{
+		Api: "ec2transitgateway",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "ec2transitgateway.TransitGateway", Manual: true},
+			{FuncType: "list", AWSType: "ec2transitgateway.TransitGatewayAttachment", Manual: true},
+			{FuncType: "list", AWSType: "ec2transitgateway.TransitGatewayRouteTable", Manual: true},
+		},
+	},
 	{
 		Api: "iam",
 		Funcs: []*mockFuncDef{
@@ -95,6 +155,23 @@ var mocksDefs = []*mockDef{
 			{FuncType: "list", AWSType: "iam.VirtualMFADevice", ApiMethod: "ListVirtualMFADevicesPages", Input: "iam.ListVirtualMFADevicesInput", Output: "iam.ListVirtualMFADevicesOutput", OutputsExtractor: "VirtualMFADevices", Multipage: true, NextPageMarker: "Marker"},
 		},
 	},
+	{
+		Api: "kms",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", MockField: "keys", AWSType: "kms.KeyMetadata", Manual: true},
+			{FuncType: "list", MockField: "aliases", AWSType: "kms.AliasListEntry", Manual: true},
+			{FuncType: "list", MockFieldType: "mapslice", MockField: "grants", AWSType: "kms.GrantListEntry", Manual: true},
+			{FuncType: "list", MockFieldType: "map", MockField: "keyPolicies", AWSType: "string", Manual: true},
+		},
+	},
+	{
+		Api: "organizations",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", MockField: "roots", AWSType: "organizations.Root", Manual: true},
+			{FuncType: "list", MockFieldType: "mapslice", MockField: "accounts", AWSType: "organizations.Account", Manual: true},
+			{FuncType: "list", MockFieldType: "mapslice", MockField: "units", AWSType: "organizations.OrganizationalUnit", Manual: true},
+		},
+	},
 	{
 		Api: "s3",
 		Funcs: []*mockFuncDef{
@@ -130,6 +207,13 @@ var mocksDefs = []*mockDef{
 			{FuncType: "list", AWSType: "lambda.FunctionConfiguration", ApiMethod: "ListFunctionsPages", Input: "lambda.ListFunctionsInput", Output: "lambda.ListFunctionsOutput", OutputsExtractor: "Functions", Multipage: true, NextPageMarker: "NextMarker"},
 		},
 	},
+	{
+		Api: "sfn",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "sfn.StateMachineListItem", ApiMethod: "ListStateMachinesPages", Input: "sfn.ListStateMachinesInput", Output: "sfn.ListStateMachinesOutput", OutputsExtractor: "StateMachines", Multipage: true, NextPageMarker: "NextToken"},
+			{FuncType: "list", MockFieldType: "mapslice", AWSType: "sfn.ExecutionListItem", Manual: true},
+		},
+	},
 	{
 		Api: "cloudwatch",
 		Funcs: []*mockFuncDef{
@@ -137,6 +221,19 @@ var mocksDefs = []*mockDef{
 			{FuncType: "list", AWSType: "cloudwatch.MetricAlarm", ApiMethod: "DescribeAlarmsPages", Input: "cloudwatch.DescribeAlarmsInput", Output: "cloudwatch.DescribeAlarmsOutput", OutputsExtractor: "MetricAlarms", Multipage: true, NextPageMarker: "NextToken"},
 		},
 	},
+	{
+		Api: "cloudwatchlogs",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "cloudwatchlogs.LogGroup", ApiMethod: "DescribeLogGroupsPages", Input: "cloudwatchlogs.DescribeLogGroupsInput", Output: "cloudwatchlogs.DescribeLogGroupsOutput", OutputsExtractor: "LogGroups", Multipage: true, NextPageMarker: "NextToken"},
+		},
+	},
+	{
+		Api: "cloudtrail",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "cloudtrail.Trail", ApiMethod: "DescribeTrails", Input: "cloudtrail.DescribeTrailsInput", Output: "cloudtrail.DescribeTrailsOutput", OutputsExtractor: "TrailList"},
+			{FuncType: "list", AWSType: "cloudtrail.Event", Manual: true},
+		},
+	},
 	{
 		Api: "cloudfront",
 		Funcs: []*mockFuncDef{
@@ -147,6 +244,7 @@ var mocksDefs = []*mockDef{
 		Api: "cloudformation",
 		Funcs: []*mockFuncDef{
 			{FuncType: "list", AWSType: "cloudformation.Stack", ApiMethod: "DescribeStacksPages", Input: "cloudformation.DescribeStacksInput", Output: "cloudformation.DescribeStacksOutput", OutputsExtractor: "Stacks", Multipage: true, NextPageMarker: "NextToken"},
+			{FuncType: "list", MockFieldType: "mapslice", MockField: "stackResources", AWSType: "cloudformation.StackResourceSummary", Manual: true},
 		},
 	},
 	{
@@ -160,6 +258,7 @@ var mocksDefs = []*mockDef{
 		Funcs: []*mockFuncDef{
 			{FuncType: "list", AWSType: "ecs.Cluster", Manual: true},
 			{FuncType: "list", MockField: "clusterNames", AWSType: "string", ApiMethod: "ListClustersPages", Input: "ecs.ListClustersInput", Output: "ecs.ListClustersOutput", OutputsExtractor: "ClusterArns", Multipage: true, NextPageMarker: "NextToken"},
+			{FuncType: "list", MockFieldType: "mapslice", AWSType: "ecs.Service", Manual: true},
 			{FuncType: "list", AWSType: "ecs.TaskDefinition", Manual: true},
 			{FuncType: "list", MockField: "taskdefinitionNames", AWSType: "string", ApiMethod: "ListTaskDefinitionsPages", Input: "ecs.ListTaskDefinitionsInput", Output: "ecs.ListTaskDefinitionsOutput", OutputsExtractor: "TaskDefinitionArns", Multipage: true, NextPageMarker: "NextToken"},
 			{FuncType: "list", MockFieldType: "mapslice", AWSType: "ecs.Task", Manual: true},
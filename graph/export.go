@@ -0,0 +1,413 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/cloud/rdf"
+)
+
+const (
+	CypherFormat   = "cypher"
+	DotFormat      = "dot"
+	GraphMLFormat  = "graphml"
+	GraphSONFormat = "graphson"
+
+	ParentOfEdge      = "PARENT_OF"
+	AppliesOnEdge     = "APPLIES_ON"
+	MemberOfStackEdge = "MEMBER_OF_STACK"
+)
+
+var ExportFormats = []string{CypherFormat, DotFormat, GraphMLFormat, GraphSONFormat}
+
+// Export writes the graph as an import script for a property graph database
+// or as a graph file for visualization tools: resources become nodes with their properties,
+// and parent, applies-on and property references (ex: a subnet's Vpc) become typed edges
+func (g *Graph) Export(w io.Writer, format string) error {
+	nodes, edges, err := g.propertyGraph()
+	if err != nil {
+		return err
+	}
+	switch format {
+	case CypherFormat:
+		return exportCypher(w, nodes, edges)
+	case DotFormat:
+		return exportDOT(w, nodes, edges)
+	case GraphMLFormat:
+		return exportGraphML(w, nodes, edges)
+	case GraphSONFormat:
+		return exportGraphSON(w, nodes, edges)
+	default:
+		return fmt.Errorf("unknown export format '%s', expecting one of %s", format, strings.Join(ExportFormats, ", "))
+	}
+}
+
+type exportEdge struct {
+	from, label, to string
+}
+
+func (g *Graph) propertyGraph() ([]*Resource, []exportEdge, error) {
+	snap := g.store.Snapshot()
+
+	var nodes []*Resource
+	exists := make(map[string]bool)
+	for _, t := range snap.WithPredicate(rdf.ID) {
+		id := t.Subject()
+		if exists[id] {
+			continue
+		}
+		typ, err := resolveResourceType(snap, id)
+		if err != nil {
+			continue
+		}
+		res := InitResource(typ, id)
+		if err = res.unmarshalFullRdf(snap); err != nil {
+			return nodes, nil, err
+		}
+		exists[id] = true
+		nodes = append(nodes, res)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].Type() != nodes[j].Type() {
+			return nodes[i].Type() < nodes[j].Type()
+		}
+		return nodes[i].Id() < nodes[j].Id()
+	})
+
+	var edges []exportEdge
+	addEdge := func(from, label, to string) {
+		if from != to && exists[from] && exists[to] {
+			edges = append(edges, exportEdge{from: from, label: label, to: to})
+		}
+	}
+	for pred, label := range map[string]string{rdf.ParentOf: ParentOfEdge, rdf.ApplyOn: AppliesOnEdge, rdf.MemberOfStack: MemberOfStackEdge} {
+		for _, t := range snap.WithPredicate(pred) {
+			if to, ok := t.Object().Resource(); ok {
+				addEdge(t.Subject(), label, to)
+			}
+		}
+	}
+	for _, res := range nodes {
+		for key, value := range res.Properties() {
+			prop, ok := rdf.Properties[rdf.Labels[key]]
+			if !ok {
+				continue
+			}
+			switch {
+			case prop.RdfsDefinedBy == rdf.RdfsClass:
+				addEdge(res.Id(), edgeLabel(key), fmt.Sprint(value))
+			case prop.RdfsDefinedBy == rdf.RdfsList && prop.RdfsDataType == rdf.RdfsClass:
+				if list, ok := value.([]string); ok {
+					for _, to := range list {
+						addEdge(res.Id(), edgeLabel(key), to)
+					}
+				}
+			}
+		}
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].from != edges[j].from {
+			return edges[i].from < edges[j].from
+		}
+		if edges[i].label != edges[j].label {
+			return edges[i].label < edges[j].label
+		}
+		return edges[i].to < edges[j].to
+	})
+
+	return nodes, edges, nil
+}
+
+func exportCypher(w io.Writer, nodes []*Resource, edges []exportEdge) error {
+	if _, err := fmt.Fprintf(w, "// awless inventory: %d resources, %d relations\n", len(nodes), len(edges)); err != nil {
+		return err
+	}
+	for _, res := range nodes {
+		var props []string
+		for _, key := range sortedPropertyKeys(res) {
+			props = append(props, fmt.Sprintf("`%s`: %s", key, cypherValue(exportValue(res.Properties()[key]))))
+		}
+		if _, err := fmt.Fprintf(w, "MERGE (n:Resource:%s {ID: %s}) SET n += {%s};\n", nodeLabel(res.Type()), cypherValue(res.Id()), strings.Join(props, ", ")); err != nil {
+			return err
+		}
+	}
+	for _, e := range edges {
+		if _, err := fmt.Fprintf(w, "MATCH (a:Resource {ID: %s}), (b:Resource {ID: %s}) MERGE (a)-[:%s]->(b);\n", cypherValue(e.from), cypherValue(e.to), e.label); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportDOT writes a Graphviz digraph, nodes being labeled with their type and name
+func exportDOT(w io.Writer, nodes []*Resource, edges []exportEdge) error {
+	if _, err := fmt.Fprintln(w, "digraph awless {\n\tnode [shape=box];"); err != nil {
+		return err
+	}
+	for _, res := range nodes {
+		label := res.Type() + "\n" + res.Id()
+		if name, _ := res.Properties()[properties.Name].(string); name != "" {
+			label = fmt.Sprintf("%s\n%s (%s)", res.Type(), name, res.Id())
+		}
+		if _, err := fmt.Fprintf(w, "\t%s [label=%s, type=%s];\n", dotID(res.Id()), dotID(label), dotID(res.Type())); err != nil {
+			return err
+		}
+	}
+	for _, e := range edges {
+		if _, err := fmt.Fprintf(w, "\t%s -> %s [label=%s];\n", dotID(e.from), dotID(e.to), dotID(e.label)); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintln(w, "}")
+	return err
+}
+
+func dotID(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
+
+type graphml struct {
+	XMLName xml.Name          `xml:"graphml"`
+	XMLNS   string            `xml:"xmlns,attr"`
+	Keys    []graphmlKey      `xml:"key"`
+	Graph   graphmlGraphNodes `xml:"graph"`
+}
+
+type graphmlKey struct {
+	ID       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+type graphmlGraphNodes struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphmlNode `xml:"node"`
+	Edges       []graphmlEdge `xml:"edge"`
+}
+
+type graphmlNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphmlData `xml:"data"`
+}
+
+type graphmlEdge struct {
+	ID     string        `xml:"id,attr"`
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphmlData `xml:"data"`
+}
+
+type graphmlData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// exportGraphML writes a GraphML document (read by Gephi, yEd, Cytoscape, ...), the properties
+// being node attributes typed from their values, lists being comma separated strings
+func exportGraphML(w io.Writer, nodes []*Resource, edges []exportEdge) error {
+	doc := graphml{
+		XMLNS: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []graphmlKey{
+			{ID: "type", For: "node", AttrName: "type", AttrType: "string"},
+			{ID: "label", For: "edge", AttrName: "label", AttrType: "string"},
+		},
+		Graph: graphmlGraphNodes{ID: "awless", EdgeDefault: "directed"},
+	}
+
+	attrTypes := make(map[string]string)
+	for _, res := range nodes {
+		node := graphmlNode{ID: res.Id(), Data: []graphmlData{{Key: "type", Value: res.Type()}}}
+		for _, key := range sortedPropertyKeys(res) {
+			value := exportValue(res.Properties()[key])
+			typ := graphmlAttrType(value)
+			if known, ok := attrTypes[key]; ok && known != typ {
+				typ = "string"
+			}
+			attrTypes[key] = typ
+			if list, ok := value.([]string); ok {
+				value = strings.Join(list, ",")
+			}
+			node.Data = append(node.Data, graphmlData{Key: "p_" + key, Value: fmt.Sprint(value)})
+		}
+		doc.Graph.Nodes = append(doc.Graph.Nodes, node)
+	}
+	var keys []string
+	for key := range attrTypes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		doc.Keys = append(doc.Keys, graphmlKey{ID: "p_" + key, For: "node", AttrName: key, AttrType: attrTypes[key]})
+	}
+	for _, e := range edges {
+		doc.Graph.Edges = append(doc.Graph.Edges, graphmlEdge{
+			ID:     fmt.Sprintf("%s-%s->%s", e.from, e.label, e.to),
+			Source: e.from,
+			Target: e.to,
+			Data:   []graphmlData{{Key: "label", Value: e.label}},
+		})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+func graphmlAttrType(i interface{}) string {
+	switch i.(type) {
+	case bool:
+		return "boolean"
+	case int, int64:
+		return "long"
+	case float64:
+		return "double"
+	default:
+		return "string"
+	}
+}
+
+type graphsonVertex struct {
+	ID         string                        `json:"id"`
+	Label      string                        `json:"label"`
+	OutE       map[string][]graphsonEdge     `json:"outE,omitempty"`
+	InE        map[string][]graphsonEdge     `json:"inE,omitempty"`
+	Properties map[string][]graphsonProperty `json:"properties"`
+}
+
+type graphsonEdge struct {
+	ID   string `json:"id"`
+	InV  string `json:"inV,omitempty"`
+	OutV string `json:"outV,omitempty"`
+}
+
+type graphsonProperty struct {
+	ID    string      `json:"id"`
+	Value interface{} `json:"value"`
+}
+
+// exportGraphSON writes the GraphSON adjacency list format (one vertex per line)
+// read by TinkerPop's GraphSONReader
+func exportGraphSON(w io.Writer, nodes []*Resource, edges []exportEdge) error {
+	vertices := make(map[string]*graphsonVertex)
+	for _, res := range nodes {
+		v := &graphsonVertex{ID: res.Id(), Label: res.Type(), OutE: make(map[string][]graphsonEdge), InE: make(map[string][]graphsonEdge), Properties: make(map[string][]graphsonProperty)}
+		for _, key := range sortedPropertyKeys(res) {
+			v.Properties[key] = []graphsonProperty{{ID: res.Id() + "/" + key, Value: exportValue(res.Properties()[key])}}
+		}
+		vertices[res.Id()] = v
+	}
+	for _, e := range edges {
+		id := fmt.Sprintf("%s-%s->%s", e.from, e.label, e.to)
+		vertices[e.from].OutE[e.label] = append(vertices[e.from].OutE[e.label], graphsonEdge{ID: id, InV: e.to})
+		vertices[e.to].InE[e.label] = append(vertices[e.to].InE[e.label], graphsonEdge{ID: id, OutV: e.from})
+	}
+
+	enc := json.NewEncoder(w)
+	for _, res := range nodes {
+		if err := enc.Encode(vertices[res.Id()]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortedPropertyKeys(res *Resource) (keys []string) {
+	for k, v := range res.Properties() {
+		if v != nil {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return
+}
+
+// exportValue converts a property to a value storable in property graph databases:
+// a string, a boolean, a number or a sorted list of strings
+func exportValue(i interface{}) interface{} {
+	switch v := i.(type) {
+	case string, bool, int, int64, float64:
+		return v
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	case []string:
+		list := append([]string{}, v...)
+		sort.Strings(list)
+		return list
+	}
+	if val := reflect.ValueOf(i); val.Kind() == reflect.Slice {
+		list := make([]string, val.Len())
+		for j := 0; j < val.Len(); j++ {
+			list[j] = fmt.Sprint(val.Index(j).Interface())
+		}
+		sort.Strings(list)
+		return list
+	}
+	return fmt.Sprint(i)
+}
+
+func cypherValue(i interface{}) string {
+	switch v := i.(type) {
+	case string:
+		return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`).Replace(v) + `"`
+	case []string:
+		quoted := make([]string, len(v))
+		for j, s := range v {
+			quoted[j] = cypherValue(s)
+		}
+		return "[" + strings.Join(quoted, ", ") + "]"
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func nodeLabel(typ string) string {
+	a := []rune(typ)
+	a[0] = unicode.ToUpper(a[0])
+	return string(a)
+}
+
+// edgeLabel turns a property name into an edge label (ex: SecurityGroups -> SECURITY_GROUPS)
+func edgeLabel(prop string) string {
+	runes := []rune(prop)
+	var out []rune
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			out = append(out, '_')
+		}
+		out = append(out, unicode.ToUpper(r))
+	}
+	return string(out)
+}
@@ -0,0 +1,143 @@
+package graph
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/cloud/properties"
+)
+
+func TestExportGraph(t *testing.T) {
+	g := NewGraph()
+	vpc := InitResource("vpc", "vpc_1")
+	vpc.SetProperty(properties.Name, `my "main" vpc`)
+	vpc.SetProperty(properties.Default, true)
+	sub := InitResource("subnet", "sub_1")
+	sub.SetProperty(properties.Vpc, "vpc_1")
+	sg := InitResource("securitygroup", "sg_1")
+	sg.SetProperty(properties.Vpc, "vpc_1")
+	inst := InitResource("instance", "inst_1")
+	inst.SetProperty(properties.SecurityGroups, []string{"sg_1", "sg_unknown"})
+	inst.SetProperty(properties.Launched, time.Date(2017, 3, 10, 12, 0, 0, 0, time.UTC))
+	inst.SetProperty(properties.Tags, []string{"env=prod"})
+	g.AddResource(vpc, sub, sg, inst)
+	g.AddParentRelation(vpc, sub)
+	g.AddParentRelation(sub, inst)
+	g.AddAppliesOnRelation(sg, inst)
+
+	var buff bytes.Buffer
+	if err := g.Export(&buff, CypherFormat); err != nil {
+		t.Fatal(err)
+	}
+	expected := `// awless inventory: 4 resources, 6 relations
+MERGE (n:Resource:Instance {ID: "inst_1"}) SET n += {` + "`ID`" + `: "inst_1", ` + "`Launched`" + `: "2017-03-10T12:00:00Z", ` + "`SecurityGroups`" + `: ["sg_1", "sg_unknown"], ` + "`Tags`" + `: ["env=prod"]};
+MERGE (n:Resource:Securitygroup {ID: "sg_1"}) SET n += {` + "`ID`" + `: "sg_1", ` + "`Vpc`" + `: "vpc_1"};
+MERGE (n:Resource:Subnet {ID: "sub_1"}) SET n += {` + "`ID`" + `: "sub_1", ` + "`Vpc`" + `: "vpc_1"};
+MERGE (n:Resource:Vpc {ID: "vpc_1"}) SET n += {` + "`Default`" + `: true, ` + "`ID`" + `: "vpc_1", ` + "`Name`" + `: "my \"main\" vpc"};
+MATCH (a:Resource {ID: "inst_1"}), (b:Resource {ID: "sg_1"}) MERGE (a)-[:SECURITY_GROUPS]->(b);
+MATCH (a:Resource {ID: "sg_1"}), (b:Resource {ID: "inst_1"}) MERGE (a)-[:APPLIES_ON]->(b);
+MATCH (a:Resource {ID: "sg_1"}), (b:Resource {ID: "vpc_1"}) MERGE (a)-[:VPC]->(b);
+MATCH (a:Resource {ID: "sub_1"}), (b:Resource {ID: "inst_1"}) MERGE (a)-[:PARENT_OF]->(b);
+MATCH (a:Resource {ID: "sub_1"}), (b:Resource {ID: "vpc_1"}) MERGE (a)-[:VPC]->(b);
+MATCH (a:Resource {ID: "vpc_1"}), (b:Resource {ID: "sub_1"}) MERGE (a)-[:PARENT_OF]->(b);
+`
+	if got, want := buff.String(), expected; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+
+	buff.Reset()
+	if err := g.Export(&buff, GraphSONFormat); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buff.String()), "\n")
+	if got, want := len(lines), 4; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	var vertex graphsonVertex
+	if err := json.Unmarshal([]byte(lines[3]), &vertex); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := vertex.Label, "vpc"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := vertex.OutE[ParentOfEdge][0].InV, "sub_1"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := len(vertex.InE["VPC"]), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := vertex.Properties["Name"][0].Value, `my "main" vpc`; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	buff.Reset()
+	if err := g.Export(&buff, DotFormat); err != nil {
+		t.Fatal(err)
+	}
+	expected = `digraph awless {
+	node [shape=box];
+	"inst_1" [label="instance\ninst_1", type="instance"];
+	"sg_1" [label="securitygroup\nsg_1", type="securitygroup"];
+	"sub_1" [label="subnet\nsub_1", type="subnet"];
+	"vpc_1" [label="vpc\nmy \"main\" vpc (vpc_1)", type="vpc"];
+	"inst_1" -> "sg_1" [label="SECURITY_GROUPS"];
+	"sg_1" -> "inst_1" [label="APPLIES_ON"];
+	"sg_1" -> "vpc_1" [label="VPC"];
+	"sub_1" -> "inst_1" [label="PARENT_OF"];
+	"sub_1" -> "vpc_1" [label="VPC"];
+	"vpc_1" -> "sub_1" [label="PARENT_OF"];
+}
+`
+	if got, want := buff.String(), expected; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+
+	buff.Reset()
+	if err := g.Export(&buff, GraphMLFormat); err != nil {
+		t.Fatal(err)
+	}
+	var doc graphml
+	if err := xml.Unmarshal(buff.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(doc.Graph.Nodes), 4; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := len(doc.Graph.Edges), 6; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := doc.Graph.Nodes[3].Data, []graphmlData{{Key: "type", Value: "vpc"}, {Key: "p_Default", Value: "true"}, {Key: "p_ID", Value: "vpc_1"}, {Key: "p_Name", Value: `my "main" vpc`}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+	if got, want := doc.Graph.Nodes[0].Data[3], (graphmlData{Key: "p_SecurityGroups", Value: "sg_1,sg_unknown"}); got != want {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+	keyTypes := make(map[string]string)
+	for _, k := range doc.Keys {
+		keyTypes[k.ID] = k.AttrType
+	}
+	if got, want := keyTypes, map[string]string{"type": "string", "label": "string", "p_Default": "boolean", "p_ID": "string", "p_Launched": "string", "p_Name": "string", "p_SecurityGroups": "string", "p_Tags": "string", "p_Vpc": "string"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+	if got, want := doc.Graph.Edges[0], (graphmlEdge{ID: "inst_1-SECURITY_GROUPS->sg_1", Source: "inst_1", Target: "sg_1", Data: []graphmlData{{Key: "label", Value: "SECURITY_GROUPS"}}}); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+
+	if err := g.Export(&buff, "gexf"); err == nil {
+		t.Fatal("expected error for unknown format")
+	}
+}
+
+func TestEdgeLabel(t *testing.T) {
+	tcases := map[string]string{"Vpc": "VPC", "SecurityGroups": "SECURITY_GROUPS", "DBSecurityGroups": "DB_SECURITY_GROUPS", "ARN": "ARN"}
+	for in, exp := range tcases {
+		if got, want := edgeLabel(in), exp; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	}
+}
@@ -70,6 +70,12 @@ func (g *Graph) AddResource(resources ...*Resource) error {
 						return err
 					}
 				}
+			case rdf.StackMembersRel:
+				for _, attached := range attachedRes {
+					if err := g.AddMemberOfStackRelation(attached, res); err != nil {
+						return err
+					}
+				}
 			}
 		}
 
@@ -90,6 +96,10 @@ func (g *Graph) AddAppliesOnRelation(parent, child *Resource) error {
 	return g.addRelation(parent, child, rdf.ApplyOn)
 }
 
+func (g *Graph) AddMemberOfStackRelation(member, stack *Resource) error {
+	return g.addRelation(member, stack, rdf.MemberOfStack)
+}
+
 func (g *Graph) GetResource(t string, id string) (*Resource, error) {
 	resource := InitResource(t, id)
 	snap := g.store.Snapshot()
@@ -260,6 +270,16 @@ func (g *Graph) ResourceRelations(from cloud.Resource, relation string, recursiv
 		err = g.Accept(&ParentsVisitor{From: from.(*Resource), IncludeFrom: false, Relation: rdf.ApplyOn, Each: collectFunc})
 	case rdf.ApplyOn:
 		err = g.Accept(&ChildrenVisitor{From: from.(*Resource), IncludeFrom: false, Relation: rdf.ApplyOn, Each: collectFunc})
+	case rdf.MemberOfStack:
+		err = g.Accept(&ChildrenVisitor{From: from.(*Resource), IncludeFrom: false, Relation: rdf.MemberOfStack, Each: collectFunc})
+	case rdf.StackMembersRel:
+		var members []*Resource
+		if members, err = g.ListStackMembers(from.(*Resource)); err != nil {
+			return
+		}
+		for _, m := range members {
+			collect = append(collect, m)
+		}
 	default:
 		err = g.Accept(&ParentsVisitor{From: from.(*Resource), IncludeFrom: false, Relation: relation, Each: collectFunc})
 	}
@@ -277,6 +297,8 @@ func (g *Graph) VisitRelations(from cloud.Resource, relation string, includeFrom
 		err = g.Accept(&ParentsVisitor{From: from.(*Resource), IncludeFrom: includeFrom, Relation: rdf.ApplyOn, Each: eachFunc})
 	case rdf.ApplyOn:
 		err = g.Accept(&ChildrenVisitor{From: from.(*Resource), IncludeFrom: includeFrom, Relation: rdf.ApplyOn, Each: eachFunc})
+	case rdf.MemberOfStack:
+		err = g.Accept(&ChildrenVisitor{From: from.(*Resource), IncludeFrom: includeFrom, Relation: rdf.MemberOfStack, Each: eachFunc})
 	default:
 		err = g.Accept(&ParentsVisitor{From: from.(*Resource), IncludeFrom: includeFrom, Relation: relation, Each: eachFunc})
 	}
@@ -323,6 +345,31 @@ func (g *Graph) ListResourcesDependingOn(start *Resource) ([]*Resource, error) {
 	return resources, nil
 }
 
+// ListStackMembers returns the resources deployed by a stack. Members whose type
+// has not been synced (ex: service sync disabled) are returned as not found resources
+func (g *Graph) ListStackMembers(stack *Resource) ([]*Resource, error) {
+	var resources []*Resource
+
+	snap := g.store.Snapshot()
+	for _, tri := range snap.WithPredObj(rdf.MemberOfStack, tstore.Resource(stack.Id())) {
+		id := tri.Subject()
+		rT, err := resolveResourceType(snap, id)
+		if err != nil {
+			if err == errTypeNotFound {
+				resources = append(resources, NotFoundResource(id))
+				continue
+			}
+			return resources, err
+		}
+		res, err := g.GetResource(rT, id)
+		if err != nil {
+			return resources, err
+		}
+		resources = append(resources, res)
+	}
+	return resources, nil
+}
+
 func (g *Graph) ListResourcesAppliedOn(start *Resource) ([]*Resource, error) {
 	var resources []*Resource
 
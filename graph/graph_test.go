@@ -107,6 +107,51 @@ func TestAddGraphRelation(t *testing.T) {
 			t.Fatalf("got\n%q\nwant\n%q\n", got, want)
 		}
 	})
+
+	t.Run("Add stack members", func(t *testing.T) {
+		g := NewGraph()
+		inst := InitResource("instance", "inst_1")
+		stack := InitResource("stack", "stack_1")
+		stack.AddRelation(rdf.StackMembersRel, inst)
+		stack.AddRelation(rdf.StackMembersRel, InitResource("bucket", "unsynced_bucket"))
+		g.AddResource(inst, stack)
+
+		expTriples := tstore.Triples([]tstore.Triple{
+			tstore.SubjPred("inst_1", "rdf:type").Resource("cloud-owl:Instance"),
+			tstore.SubjPred("inst_1", "cloud:id").StringLiteral("inst_1"),
+			tstore.SubjPred("stack_1", "rdf:type").Resource("cloud-owl:Stack"),
+			tstore.SubjPred("stack_1", "cloud:id").StringLiteral("stack_1"),
+			tstore.SubjPred("inst_1", "cloud-rel:memberOfStack").Resource("stack_1"),
+			tstore.SubjPred("unsynced_bucket", "cloud-rel:memberOfStack").Resource("stack_1"),
+		})
+		if got, want := tstore.Triples(g.store.Snapshot().Triples()), expTriples; !got.Equal(want) {
+			t.Fatalf("got\n%q\nwant\n%q\n", got, want)
+		}
+
+		stacks, err := g.ResourceRelations(inst, rdf.MemberOfStack, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(stacks), 1; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		if got, want := stacks[0].Id(), "stack_1"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+
+		members, err := g.ResourceRelations(stack, rdf.StackMembersRel, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, m := range members {
+			ids = append(ids, m.Type()+":"+m.Id())
+		}
+		sort.Strings(ids)
+		if got, want := ids, []string{"instance:inst_1", notFoundResourceType + ":unsynced_bucket"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
 }
 
 func TestFind(t *testing.T) {
@@ -0,0 +1,152 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const (
+	JSONAdded   = "added"
+	JSONRemoved = "removed"
+	JSONChanged = "changed"
+)
+
+// JSONChange is a key-level change between two JSON documents.
+// Path locates the changed value (ex: Statement[0].Action)
+type JSONChange struct {
+	Path string      `json:"path"`
+	Kind string      `json:"kind"`
+	From interface{} `json:"from,omitempty"`
+	To   interface{} `json:"to,omitempty"`
+}
+
+// DiffJSON structurally compares two JSON-valued properties (IAM policy documents,
+// bucket policies, ...), given as is, URL encoded or base64 encoded.
+// It returns false when one of the values is not a JSON object or array
+func DiffJSON(from, to interface{}) ([]*JSONChange, bool) {
+	fromDoc, ok := parseJSONProperty(from)
+	if !ok {
+		return nil, false
+	}
+	toDoc, ok := parseJSONProperty(to)
+	if !ok {
+		return nil, false
+	}
+	var changes []*JSONChange
+	diffJSONValues("", fromDoc, toDoc, &changes)
+	return changes, true
+}
+
+func parseJSONProperty(i interface{}) (interface{}, bool) {
+	s, ok := i.(string)
+	if !ok {
+		return nil, false
+	}
+	candidates := []string{s}
+	if unescaped, err := url.QueryUnescape(s); err == nil && unescaped != s {
+		candidates = append(candidates, unescaped)
+	}
+	if decoded, err := base64.StdEncoding.DecodeString(s); err == nil {
+		candidates = append(candidates, string(decoded))
+	}
+	for _, c := range candidates {
+		c = strings.TrimSpace(c)
+		if !strings.HasPrefix(c, "{") && !strings.HasPrefix(c, "[") {
+			continue
+		}
+		var doc interface{}
+		if err := json.Unmarshal([]byte(c), &doc); err == nil {
+			return doc, true
+		}
+	}
+	return nil, false
+}
+
+func diffJSONValues(path string, from, to interface{}, changes *[]*JSONChange) {
+	switch f := from.(type) {
+	case map[string]interface{}:
+		if t, ok := to.(map[string]interface{}); ok {
+			keys := make(map[string]bool)
+			for k := range f {
+				keys[k] = true
+			}
+			for k := range t {
+				keys[k] = true
+			}
+			var sorted []string
+			for k := range keys {
+				sorted = append(sorted, k)
+			}
+			sort.Strings(sorted)
+			for _, k := range sorted {
+				fv, inFrom := f[k]
+				tv, inTo := t[k]
+				subpath := joinJSONPath(path, k)
+				switch {
+				case !inFrom:
+					*changes = append(*changes, &JSONChange{Path: subpath, Kind: JSONAdded, To: tv})
+				case !inTo:
+					*changes = append(*changes, &JSONChange{Path: subpath, Kind: JSONRemoved, From: fv})
+				default:
+					diffJSONValues(subpath, fv, tv, changes)
+				}
+			}
+			return
+		}
+	case []interface{}:
+		if t, ok := to.([]interface{}); ok {
+			for i := 0; i < len(f) || i < len(t); i++ {
+				subpath := fmt.Sprintf("%s[%d]", path, i)
+				switch {
+				case i >= len(f):
+					*changes = append(*changes, &JSONChange{Path: subpath, Kind: JSONAdded, To: t[i]})
+				case i >= len(t):
+					*changes = append(*changes, &JSONChange{Path: subpath, Kind: JSONRemoved, From: f[i]})
+				default:
+					diffJSONValues(subpath, f[i], t[i], changes)
+				}
+			}
+			return
+		}
+	}
+	if !reflect.DeepEqual(from, to) {
+		*changes = append(*changes, &JSONChange{Path: path, Kind: JSONChanged, From: from, To: to})
+	}
+}
+
+func joinJSONPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// CompactJSON renders a JSON value on one line
+func CompactJSON(i interface{}) string {
+	b, err := json.Marshal(i)
+	if err != nil {
+		return fmt.Sprint(i)
+	}
+	return string(b)
+}
@@ -0,0 +1,52 @@
+package graph
+
+import (
+	"encoding/base64"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestDiffJSON(t *testing.T) {
+	from := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":"*"}]}`
+	to := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"arn:aws:s3:::bucket/*"},{"Effect":"Deny","Action":"iam:*"}]}`
+	expected := []*JSONChange{
+		{Path: "Statement[0].Action[1]", Kind: JSONAdded, To: "s3:PutObject"},
+		{Path: "Statement[0].Resource", Kind: JSONChanged, From: "*", To: "arn:aws:s3:::bucket/*"},
+		{Path: "Statement[1]", Kind: JSONAdded, To: map[string]interface{}{"Effect": "Deny", "Action": "iam:*"}},
+	}
+
+	changes, ok := DiffJSON(from, to)
+	if !ok {
+		t.Fatal("expected JSON values")
+	}
+	if got, want := changes, expected; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %s, want %s", CompactJSON(got), CompactJSON(want))
+	}
+
+	changes, ok = DiffJSON(url.QueryEscape(from), base64.StdEncoding.EncodeToString([]byte(to)))
+	if !ok {
+		t.Fatal("expected encoded JSON values")
+	}
+	if got, want := changes, expected; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %s, want %s", CompactJSON(got), CompactJSON(want))
+	}
+
+	changes, ok = DiffJSON(`{"a":1,"b":{"c":true}}`, `{"b":{}}`)
+	if !ok {
+		t.Fatal("expected JSON values")
+	}
+	expected = []*JSONChange{
+		{Path: "a", Kind: JSONRemoved, From: float64(1)},
+		{Path: "b.c", Kind: JSONRemoved, From: true},
+	}
+	if got, want := changes, expected; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %s, want %s", CompactJSON(got), CompactJSON(want))
+	}
+
+	for _, tcase := range [][2]interface{}{{"t2.micro", "t2.large"}, {`{"a":1}`, "plain"}, {"[not json", "[]"}, {1, 2}} {
+		if _, ok := DiffJSON(tcase[0], tcase[1]); ok {
+			t.Fatalf("%v: expected non JSON values", tcase)
+		}
+	}
+}
@@ -0,0 +1,422 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/wallix/awless/cloud/rdf"
+)
+
+// QueryResult holds the resources matched by the last step of a query
+// and the paths leading to them from the resources of the first step
+type QueryResult struct {
+	Resources []*Resource
+	Paths     [][]*Resource
+}
+
+// Query runs a path query over the graph. A query is a list of steps separated by '->',
+// each step being a resource type optionally followed by a condition between brackets:
+//
+//	instance[state=running and tag.Env=prod] -> subnet -> vpc
+//
+// Consecutive steps are resources related by parent/child (at any depth), applies-on or stack membership relations.
+// Conditions combine with 'and', 'or', 'not' and parentheses terms of the forms 'key=value', 'key!=value',
+// 'key~value' (contains) or 'key' (set and not false, empty or zero), the key being a property (case insensitive),
+// 'id' or 'tag.Key'. Values are compared case insensitively and can be quoted.
+func (g *Graph) Query(query string) (*QueryResult, error) {
+	steps, err := parsePathQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	starts, err := g.GetAllResources(steps[0].resourceType)
+	if err != nil {
+		return nil, err
+	}
+	sortResources(starts)
+
+	result := &QueryResult{}
+	found := make(map[string]bool)
+	var walk func(path []*Resource, next []queryStep) error
+	walk = func(path []*Resource, next []queryStep) error {
+		if len(next) == 0 {
+			result.Paths = append(result.Paths, append([]*Resource(nil), path...))
+			if last := path[len(path)-1]; !found[last.Id()] {
+				found[last.Id()] = true
+				result.Resources = append(result.Resources, last)
+			}
+			return nil
+		}
+		related, err := g.relatedResources(path[len(path)-1], next[0].resourceType)
+		if err != nil {
+			return err
+		}
+		for _, r := range related {
+			if next[0].match(r) {
+				if err := walk(append(path, r), next[1:]); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	for _, r := range starts {
+		if steps[0].match(r) {
+			if err := walk([]*Resource{r}, steps[1:]); err != nil {
+				return result, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// relatedResources returns the resources of the given type related to the resource
+// by parent/child, applies-on or stack membership relations, in both directions
+func (g *Graph) relatedResources(res *Resource, resourceType string) ([]*Resource, error) {
+	var related []*Resource
+	seen := make(map[string]bool)
+	collect := func(r *Resource, depth int) error {
+		if r.Type() == resourceType && !seen[r.Id()] {
+			seen[r.Id()] = true
+			related = append(related, r)
+		}
+		return nil
+	}
+	for _, rel := range []string{rdf.ParentOf, rdf.ApplyOn, rdf.MemberOfStack} {
+		if err := g.Accept(&ParentsVisitor{From: res, Relation: rel, Each: collect}); err != nil {
+			return nil, err
+		}
+		if err := g.Accept(&ChildrenVisitor{From: res, Relation: rel, Each: collect}); err != nil {
+			return nil, err
+		}
+	}
+	sortResources(related)
+	return related, nil
+}
+
+func sortResources(resources []*Resource) {
+	sort.Slice(resources, func(i, j int) bool { return resources[i].Id() < resources[j].Id() })
+}
+
+type queryStep struct {
+	resourceType string
+	cond         queryCond
+}
+
+func (s queryStep) match(r *Resource) bool {
+	return s.cond == nil || s.cond.match(r)
+}
+
+type queryCond interface {
+	match(*Resource) bool
+}
+
+type andCond []queryCond
+
+func (c andCond) match(r *Resource) bool {
+	for _, sub := range c {
+		if !sub.match(r) {
+			return false
+		}
+	}
+	return true
+}
+
+type orCond []queryCond
+
+func (c orCond) match(r *Resource) bool {
+	for _, sub := range c {
+		if sub.match(r) {
+			return true
+		}
+	}
+	return false
+}
+
+type notCond struct {
+	cond queryCond
+}
+
+func (c notCond) match(r *Resource) bool {
+	return !c.cond.match(r)
+}
+
+type termCond struct {
+	key, op, value string
+}
+
+func (c termCond) match(r *Resource) bool {
+	values, ok := c.values(r)
+	switch c.op {
+	case "":
+		for _, v := range values {
+			if v != "" && v != "false" && v != "0" {
+				return true
+			}
+		}
+		return false
+	case "!=":
+		return !ok || !c.matchValues(values)
+	default:
+		return ok && c.matchValues(values)
+	}
+}
+
+func (c termCond) matchValues(values []string) bool {
+	for _, v := range values {
+		if c.op == "~" && strings.Contains(strings.ToLower(v), strings.ToLower(c.value)) {
+			return true
+		}
+		if c.op != "~" && strings.EqualFold(v, c.value) {
+			return true
+		}
+	}
+	return false
+}
+
+// values returns the string values of the key for the resource: the elements of list properties
+// being the values of the key, and the value of the tag for a 'tag.Key' key
+func (c termCond) values(r *Resource) ([]string, bool) {
+	if strings.EqualFold(c.key, "id") {
+		return []string{r.Id()}, true
+	}
+	if len(c.key) > 4 && strings.EqualFold(c.key[:4], "tag.") {
+		tags, _ := r.Properties()["Tags"].([]string)
+		for _, t := range tags {
+			if kv := strings.SplitN(t, "=", 2); kv[0] == c.key[4:] {
+				if len(kv) == 1 {
+					return []string{""}, true
+				}
+				return []string{kv[1]}, true
+			}
+		}
+		return nil, false
+	}
+	for k, v := range r.Properties() {
+		if !strings.EqualFold(k, c.key) || v == nil {
+			continue
+		}
+		if list, ok := v.([]string); ok {
+			return list, true
+		}
+		return []string{fmt.Sprint(v)}, true
+	}
+	return nil, false
+}
+
+func parsePathQuery(query string) ([]queryStep, error) {
+	tokens, err := lexQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	p := &queryParser{tokens: tokens}
+	var steps []queryStep
+	for {
+		step, err := p.parseStep()
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, step)
+		if p.peek().kind == eofToken {
+			return steps, nil
+		}
+		if p.next().kind != arrowToken {
+			return nil, fmt.Errorf("query: expecting '->' after step %s", step.resourceType)
+		}
+	}
+}
+
+type tokenKind int
+
+const (
+	eofToken tokenKind = iota
+	wordToken
+	arrowToken
+	opToken
+	punctToken
+)
+
+type queryToken struct {
+	kind  tokenKind
+	value string
+}
+
+func lexQuery(query string) ([]queryToken, error) {
+	var tokens []queryToken
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		c := runes[i]
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '-' && i+1 < len(runes) && runes[i+1] == '>':
+			tokens = append(tokens, queryToken{arrowToken, "->"})
+			i += 2
+		case c == '!' && i+1 < len(runes) && runes[i+1] == '=':
+			tokens = append(tokens, queryToken{opToken, "!="})
+			i += 2
+		case c == '=' || c == '~':
+			tokens = append(tokens, queryToken{opToken, string(c)})
+			i++
+		case strings.ContainsRune("[]()", c):
+			tokens = append(tokens, queryToken{punctToken, string(c)})
+			i++
+		case c == '\'' || c == '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != c {
+				end++
+			}
+			if end == len(runes) {
+				return nil, fmt.Errorf("query: unterminated quoted value %s", string(runes[i:]))
+			}
+			tokens = append(tokens, queryToken{wordToken, string(runes[i+1 : end])})
+			i = end + 1
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune("[]()=~!'\"", runes[i]) &&
+				!(runes[i] == '-' && i+1 < len(runes) && runes[i+1] == '>') {
+				i++
+			}
+			if i == start {
+				return nil, fmt.Errorf("query: unexpected character '%c'", c)
+			}
+			tokens = append(tokens, queryToken{wordToken, string(runes[start:i])})
+		}
+	}
+	return tokens, nil
+}
+
+type queryParser struct {
+	tokens []queryToken
+	pos    int
+}
+
+func (p *queryParser) peek() queryToken {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return queryToken{kind: eofToken}
+}
+
+func (p *queryParser) next() queryToken {
+	tok := p.peek()
+	if p.pos < len(p.tokens) {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *queryParser) parseStep() (queryStep, error) {
+	var step queryStep
+	tok := p.next()
+	if tok.kind != wordToken {
+		return step, errors.New("query: expecting a resource type")
+	}
+	step.resourceType = strings.ToLower(tok.value)
+	if p.peek() != (queryToken{punctToken, "["}) {
+		return step, nil
+	}
+	p.next()
+	cond, err := p.parseOr()
+	if err != nil {
+		return step, err
+	}
+	if p.next() != (queryToken{punctToken, "]"}) {
+		return step, fmt.Errorf("query: expecting ']' to close the condition of %s", step.resourceType)
+	}
+	step.cond = cond
+	return step, nil
+}
+
+func (p *queryParser) parseOr() (queryCond, error) {
+	var conds orCond
+	for {
+		cond, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, cond)
+		if tok := p.peek(); tok.kind != wordToken || !strings.EqualFold(tok.value, "or") {
+			break
+		}
+		p.next()
+	}
+	if len(conds) == 1 {
+		return conds[0], nil
+	}
+	return conds, nil
+}
+
+func (p *queryParser) parseAnd() (queryCond, error) {
+	var conds andCond
+	for {
+		cond, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, cond)
+		if tok := p.peek(); tok.kind != wordToken || !strings.EqualFold(tok.value, "and") {
+			break
+		}
+		p.next()
+	}
+	if len(conds) == 1 {
+		return conds[0], nil
+	}
+	return conds, nil
+}
+
+func (p *queryParser) parseUnary() (queryCond, error) {
+	tok := p.next()
+	switch {
+	case tok.kind == wordToken && strings.EqualFold(tok.value, "not"):
+		cond, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notCond{cond}, nil
+	case tok == queryToken{punctToken, "("}:
+		cond, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != (queryToken{punctToken, ")"}) {
+			return nil, errors.New("query: expecting ')'")
+		}
+		return cond, nil
+	case tok.kind == wordToken:
+		term := termCond{key: tok.value}
+		if p.peek().kind != opToken {
+			return term, nil
+		}
+		term.op = p.next().value
+		value := p.next()
+		if value.kind != wordToken {
+			return nil, fmt.Errorf("query: expecting a value after '%s%s'", term.key, term.op)
+		}
+		term.value = value.value
+		return term, nil
+	default:
+		return nil, errors.New("query: expecting a condition")
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestQuery(t *testing.T) {
+	g := graph.NewGraph()
+	vpc1 := resourcetest.VPC("vpc_1").Prop("Name", "main").Build()
+	vpc2 := resourcetest.VPC("vpc_2").Build()
+	sub1 := resourcetest.Subnet("sub_1").Prop("Public", true).Build()
+	sub2 := resourcetest.Subnet("sub_2").Prop("Public", false).Build()
+	sub3 := resourcetest.Subnet("sub_3").Build()
+	inst1 := resourcetest.Instance("inst_1").Prop("Name", "web-1").Prop("State", "running").Prop("Tags", []string{"Env=prod", "Role=web"}).Build()
+	inst2 := resourcetest.Instance("inst_2").Prop("Name", "web-2").Prop("State", "stopped").Prop("Tags", []string{"Env=prod", "Role=web"}).Build()
+	inst3 := resourcetest.Instance("inst_3").Prop("Name", "db").Prop("State", "running").Prop("Tags", []string{"Env=dev"}).Build()
+	sg1 := resourcetest.SecurityGroup("sg_1").Prop("Name", "web access").Build()
+	g.AddResource(vpc1, vpc2, sub1, sub2, sub3, inst1, inst2, inst3, sg1)
+	g.AddParentRelation(vpc1, sub1)
+	g.AddParentRelation(vpc1, sub2)
+	g.AddParentRelation(vpc2, sub3)
+	g.AddParentRelation(sub1, inst1)
+	g.AddParentRelation(sub2, inst2)
+	g.AddParentRelation(sub3, inst3)
+	g.AddAppliesOnRelation(sg1, inst1)
+	g.AddAppliesOnRelation(sg1, inst2)
+
+	tcases := []struct {
+		query    string
+		expIds   []string
+		expPaths []string
+	}{
+		{query: "instance", expIds: []string{"inst_1", "inst_2", "inst_3"}, expPaths: []string{"inst_1", "inst_2", "inst_3"}},
+		{query: "instance[state=running and tag.Env=prod] -> subnet -> vpc", expIds: []string{"vpc_1"}, expPaths: []string{"inst_1 sub_1 vpc_1"}},
+		{query: "instance[tag.Role=web]->vpc", expIds: []string{"vpc_1"}, expPaths: []string{"inst_1 vpc_1", "inst_2 vpc_1"}},
+		{query: "vpc[name=MAIN] -> instance[state != running]", expIds: []string{"inst_2"}, expPaths: []string{"vpc_1 inst_2"}},
+		{query: "instance[tag.Env=dev or not (state=running)]", expIds: []string{"inst_2", "inst_3"}, expPaths: []string{"inst_2", "inst_3"}},
+		{query: "subnet[public] -> instance", expIds: []string{"inst_1"}, expPaths: []string{"sub_1 inst_1"}},
+		{query: "subnet[not public]", expIds: []string{"sub_2", "sub_3"}, expPaths: []string{"sub_2", "sub_3"}},
+		{query: `securitygroup[name='web access'] -> instance -> subnet`, expIds: []string{"sub_1", "sub_2"}, expPaths: []string{"sg_1 inst_1 sub_1", "sg_1 inst_2 sub_2"}},
+		{query: "instance[name~web and tag.Role] -> securitygroup", expIds: []string{"sg_1"}, expPaths: []string{"inst_1 sg_1", "inst_2 sg_1"}},
+		{query: "instance[id=inst_3] -> securitygroup"},
+		{query: "instance[tag.Env!=prod]", expIds: []string{"inst_3"}, expPaths: []string{"inst_3"}},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.query, func(t *testing.T) {
+			res, err := g.Query(tcase.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids, paths []string
+			for _, r := range res.Resources {
+				ids = append(ids, r.Id())
+			}
+			for _, path := range res.Paths {
+				var hops []string
+				for _, r := range path {
+					hops = append(hops, r.Id())
+				}
+				paths = append(paths, strings.Join(hops, " "))
+			}
+			if got, want := ids, tcase.expIds; !reflect.DeepEqual(got, want) {
+				t.Fatalf("resources: got %q, want %q", got, want)
+			}
+			if got, want := paths, tcase.expPaths; !reflect.DeepEqual(got, want) {
+				t.Fatalf("paths: got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestQueryErrors(t *testing.T) {
+	g := graph.NewGraph()
+	for _, query := range []string{
+		"",
+		"instance ->",
+		"instance[state=running",
+		"instance[state=]",
+		"instance[(state=running]",
+		"instance[name='web]",
+		"instance subnet",
+		"[state=running]",
+	} {
+		if _, err := g.Query(query); err == nil {
+			t.Fatalf("%q: expected error", query)
+		}
+	}
+}
@@ -47,6 +47,17 @@ func getPropertyValue(gph tstore.RDFGraph, propObj tstore.Object, prop string) (
 			return nil, err
 		}
 		return route, nil
+	case definedBy == rdf.RdfsList && dataType == rdf.NetNetworkACLRule:
+		id, ok := propObj.Resource()
+		if !ok {
+			return nil, fmt.Errorf("get property '%s': object not resource identifier", prop)
+		}
+		rule := &NetworkACLRule{}
+		err := rule.unmarshalFromTriples(gph, id)
+		if err != nil {
+			return nil, err
+		}
+		return rule, nil
 	case definedBy == rdf.RdfsList && dataType == rdf.Grant:
 		id, ok := propObj.Resource()
 		if !ok {
@@ -227,6 +227,16 @@ func (res *Resource) marshalFullRDF() ([]tstore.Triple, error) {
 					triples = append(triples, tstore.SubjPred(res.id, propId).Resource(routeId))
 					triples = append(triples, r.marshalToTriples(routeId)...)
 				}
+			case rdf.NetNetworkACLRule:
+				list, ok := value.([]*NetworkACLRule)
+				if !ok {
+					return triples, fmt.Errorf("resource %s: marshalling property '%s': expected a network acl rule slice, got a %T", res, key, value)
+				}
+				for _, r := range list {
+					ruleId := randomRdfId()
+					triples = append(triples, tstore.SubjPred(res.id, propId).Resource(ruleId))
+					triples = append(triples, r.marshalToTriples(ruleId)...)
+				}
 			case rdf.Grant:
 				list, ok := value.([]*Grant)
 				if !ok {
@@ -327,6 +337,13 @@ func (res *Resource) unmarshalFullRdf(gph tstore.RDFGraph) error {
 				}
 				list = append(list, propVal.(*Route))
 				res.properties[propKey] = list
+			case rdf.NetNetworkACLRule:
+				list, ok := res.properties[propKey].([]*NetworkACLRule)
+				if !ok {
+					list = []*NetworkACLRule{}
+				}
+				list = append(list, propVal.(*NetworkACLRule))
+				res.properties[propKey] = list
 			case rdf.Grant:
 				list, ok := res.properties[propKey].([]*Grant)
 				if !ok {
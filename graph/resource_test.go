@@ -274,6 +274,37 @@ func TestMarshalUnmarshalRouteTables(t *testing.T) {
 	}
 }
 
+func TestMarshalUnmarshalNetworkACLRules(t *testing.T) {
+	_, anywhere, _ := net.ParseCIDR("0.0.0.0/0")
+	_, subnetcidr, _ := net.ParseCIDR("10.192.24.0/24")
+	_, ipv6cidr, _ := net.ParseCIDR("2001:db8::/32")
+	r := testResource("acl1", "networkacl").prop(properties.ID, "acl1").prop(
+		"InboundACLRules", []*NetworkACLRule{
+			{Number: 100, Action: "allow", Protocol: "tcp", PortRange: PortRange{FromPort: 22, ToPort: 22}, IPRange: subnetcidr},
+			{Number: 200, Action: "deny", Protocol: "icmpv6", PortRange: PortRange{Any: true}, IPRange: ipv6cidr},
+			{Number: 32767, Action: "deny", Protocol: "any", PortRange: PortRange{Any: true}, IPRange: anywhere},
+		}).prop(
+		"OutboundACLRules", []*NetworkACLRule{
+			{Number: 100, Action: "allow", Protocol: "udp", PortRange: PortRange{FromPort: 1024, ToPort: 65535}, IPRange: anywhere},
+		}).build()
+	g := NewGraph()
+	triples, err := r.marshalFullRDF()
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.store.Add(triples...)
+	rawRes := InitResource(r.Type(), r.Id())
+	err = rawRes.unmarshalFullRdf(g.store.Snapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	NetworkACLRules(rawRes.Properties()["InboundACLRules"].([]*NetworkACLRule)).Sort()
+
+	if got, want := rawRes, r; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got\n%#v\nwant\n%#v\n", got, want)
+	}
+}
+
 func TestMarshalUnmarshalGrants(t *testing.T) {
 	r := testResource("bck1", "bucket").prop(properties.ID, "bck1").prop(
 		"Grants", []*Grant{
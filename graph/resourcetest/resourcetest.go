@@ -50,14 +50,54 @@ func NatGw(id string) *rBuilder {
 	return new("natgateway", id)
 }
 
+func ElasticIP(id string) *rBuilder {
+	return new("elasticip", id)
+}
+
+func PeeringConnection(id string) *rBuilder {
+	return new("peeringconnection", id)
+}
+
+func VpcEndpoint(id string) *rBuilder {
+	return new("vpcendpoint", id)
+}
+
+func CustomerGateway(id string) *rBuilder {
+	return new("customergateway", id)
+}
+
+func VpnGateway(id string) *rBuilder {
+	return new("vpngateway", id)
+}
+
+func VpnConnection(id string) *rBuilder {
+	return new("vpnconnection", id)
+}
+
 func RouteTable(id string) *rBuilder {
 	return new("routetable", id)
 }
 
+func NetworkACL(id string) *rBuilder {
+	return new("networkacl", id)
+}
+
+func PlacementGroup(id string) *rBuilder {
+	return new("placementgroup", id)
+}
+
+func Host(id string) *rBuilder {
+	return new("host", id)
+}
+
 func LoadBalancer(id string) *rBuilder {
 	return new("loadbalancer", id)
 }
 
+func ClassicLoadBalancer(id string) *rBuilder {
+	return new("classicloadbalancer", id)
+}
+
 func AvailabilityZone(id string) *rBuilder {
 	return new("availabilityzone", id)
 }
@@ -86,14 +126,98 @@ func MfaDevice(id string) *rBuilder {
 	return new("mfadevice", id)
 }
 
+func Key(id string) *rBuilder {
+	return new("key", id)
+}
+
+func Account(id string) *rBuilder {
+	return new("account", id)
+}
+
+func OrganizationalUnit(id string) *rBuilder {
+	return new("organizationalunit", id)
+}
+
 func Listener(id string) *rBuilder {
 	return new("listener", id)
 }
 
+func Database(id string) *rBuilder {
+	return new("database", id)
+}
+
+func DbSubnetGroup(id string) *rBuilder {
+	return new("dbsubnetgroup", id)
+}
+
+func DbParameterGroup(id string) *rBuilder {
+	return new("dbparametergroup", id)
+}
+
+func Table(id string) *rBuilder {
+	return new("table", id)
+}
+
+func CacheCluster(id string) *rBuilder {
+	return new("cachecluster", id)
+}
+
+func CacheSubnetGroup(id string) *rBuilder {
+	return new("cachesubnetgroup", id)
+}
+
+func Cluster(id string) *rBuilder {
+	return new("cluster", id)
+}
+
+func ComputeEnvironment(id string) *rBuilder {
+	return new("computeenvironment", id)
+}
+
+func Reservation(id string) *rBuilder {
+	return new("reservation", id)
+}
+
+func JobQueue(id string) *rBuilder {
+	return new("jobqueue", id)
+}
+
+func Job(id string) *rBuilder {
+	return new("job", id)
+}
+
+func DxConnection(id string) *rBuilder {
+	return new("dxconnection", id)
+}
+
+func VirtualInterface(id string) *rBuilder {
+	return new("virtualinterface", id)
+}
+
+func TransitGateway(id string) *rBuilder {
+	return new("transitgateway", id)
+}
+
+func TransitGatewayAttachment(id string) *rBuilder {
+	return new("transitgatewayattachment", id)
+}
+
+func TransitGatewayRouteTable(id string) *rBuilder {
+	return new("transitgatewayroutetable", id)
+}
+
 func Bucket(id string) *rBuilder {
 	return new("bucket", id)
 }
 
+func Volume(id string) *rBuilder {
+	return new("volume", id)
+}
+
+func Snapshot(id string) *rBuilder {
+	return new("snapshot", id)
+}
+
 func Zone(id string) *rBuilder {
 	return new("zone", id)
 }
@@ -126,10 +250,30 @@ func Function(id string) *rBuilder {
 	return new("function", id)
 }
 
+func StateMachine(id string) *rBuilder {
+	return new("statemachine", id)
+}
+
+func Execution(id string) *rBuilder {
+	return new("execution", id)
+}
+
 func Alarm(id string) *rBuilder {
 	return new("alarm", id)
 }
 
+func LogGroup(id string) *rBuilder {
+	return new("loggroup", id)
+}
+
+func Trail(id string) *rBuilder {
+	return new("trail", id)
+}
+
+func TrailEvent(id string) *rBuilder {
+	return new("trailevent", id)
+}
+
 func Metric(id string) *rBuilder {
 	return new("metric", id)
 }
@@ -154,6 +298,10 @@ func ContainerCluster(id string) *rBuilder {
 	return new("containercluster", id)
 }
 
+func ContainerService(id string) *rBuilder {
+	return new("containerservice", id)
+}
+
 func ContainerTask(id string) *rBuilder {
 	return new("containertask", id)
 }
@@ -112,6 +112,74 @@ func (r *FirewallRule) unmarshalFromTriples(g tstore.RDFGraph, id string) error
 	return nil
 }
 
+type NetworkACLRules []*NetworkACLRule
+
+func (rules NetworkACLRules) Sort() {
+	sort.Slice(rules, func(i int, j int) bool {
+		return rules[i].Number < rules[j].Number
+	})
+}
+
+// NetworkACLRule is a numbered rule of a network ACL. The rules of an ACL are evaluated
+// in increasing number order, the first one matching allowing or denying the traffic.
+type NetworkACLRule struct {
+	Number    int64      `predicate:"net:ruleNumber"`
+	Action    string     `predicate:"net:ruleAction"` // allow or deny
+	Protocol  string     `predicate:"net:protocol"`
+	PortRange PortRange  `predicate:"net:portRange"`
+	IPRange   *net.IPNet `predicate:"net:cidr"` // IPv4 or IPv6 range
+}
+
+func (r *NetworkACLRule) String() string {
+	return fmt.Sprintf("Number:%d; Action:%s; PortRange:%+v; Protocol:%s; IPRange:%+v", r.Number, r.Action, r.PortRange, r.Protocol, r.IPRange)
+}
+
+func (r *NetworkACLRule) marshalToTriples(id string) []tstore.Triple {
+	var triples []tstore.Triple
+	triples = append(triples, tstore.SubjPred(id, rdf.RdfType).Resource(rdf.NetNetworkACLRule))
+	triples = append(triples, tstore.TriplesFromStruct(id, r)...)
+	return triples
+}
+
+func (r *NetworkACLRule) unmarshalFromTriples(g tstore.RDFGraph, id string) error {
+	numberTs := g.WithSubjPred(id, rdf.NetRuleNumber)
+	if ln := len(numberTs); ln != 1 {
+		return fmt.Errorf("unmarshal network acl rule: number: expected unique, got %d", ln)
+	}
+	number, err := tstore.ParseInteger(numberTs[0].Object())
+	if err != nil {
+		return fmt.Errorf("unmarshal network acl rule: number: %s", err)
+	}
+	r.Number = int64(number)
+
+	if r.Action, err = extractUniqueLiteralTextFromTriples(g.WithSubjPred(id, rdf.NetRuleAction)); err != nil {
+		return fmt.Errorf("unmarshal network acl rule: action: %s", err)
+	}
+	if r.Protocol, err = extractUniqueLiteralTextFromTriples(g.WithSubjPred(id, rdf.Protocol)); err != nil {
+		return fmt.Errorf("unmarshal network acl rule: protocol: %s", err)
+	}
+
+	ports, err := extractUniqueLiteralTextFromTriples(g.WithSubjPred(id, rdf.PortRange))
+	if err != nil {
+		return fmt.Errorf("unmarshal network acl rule: port range: %s", err)
+	}
+	if r.PortRange, err = ParsePortRange(ports); err != nil {
+		return fmt.Errorf("unmarshal network acl rule: %s", err)
+	}
+
+	cidrTs := g.WithSubjPred(id, rdf.CIDR)
+	if len(cidrTs) > 0 {
+		cidr, err := extractUniqueLiteralTextFromTriples(cidrTs)
+		if err != nil {
+			return fmt.Errorf("unmarshal network acl rule: cidr: %s", err)
+		}
+		if _, r.IPRange, err = net.ParseCIDR(cidr); err != nil {
+			return fmt.Errorf("unmarshal network acl rule: cidr: %s", err)
+		}
+	}
+	return nil
+}
+
 type PortRange struct {
 	FromPort, ToPort int64
 	Any              bool
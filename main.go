@@ -19,5 +19,5 @@ package main
 import "github.com/wallix/awless/commands"
 
 func main() {
-	commands.RootCmd.Execute()
+	commands.Execute()
 }
@@ -0,0 +1,182 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package queue classifies the template runs waiting for execution and
+// picks the next one to start according to per-class concurrency limits and priorities
+package queue
+
+import (
+	"crypto/rand"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid"
+	"github.com/wallix/awless/template"
+)
+
+// Classes of runs
+const (
+	ReadOnly    = "read-only"
+	Normal      = "normal"
+	Destructive = "destructive"
+)
+
+// Classes lists the classes of runs
+var Classes = []string{ReadOnly, Normal, Destructive}
+
+// Statuses of queued runs. A run found running when a worker starts has been
+// interrupted (ex: restart) and is not started again, to avoid running twice its statements
+const (
+	QueuedStatus      = "queued"
+	RunningStatus     = "running"
+	InterruptedStatus = "interrupted"
+)
+
+var (
+	readOnlyActions    = map[string]bool{"check": true}
+	destructiveActions = map[string]bool{"delete": true, "detach": true, "stop": true, "restart": true, "reboot": true, "terminate": true}
+)
+
+// Classify returns the class of a template: destructive when one of its commands
+// deletes, detaches, stops, restarts or terminates, read-only when it only checks, normal otherwise
+func Classify(tpl *template.Template) string {
+	class := ReadOnly
+	for _, cmd := range tpl.CommandNodesIterator() {
+		if destructiveActions[cmd.Action] {
+			return Destructive
+		}
+		if !readOnlyActions[cmd.Action] {
+			class = Normal
+		}
+	}
+	return class
+}
+
+// Run is a template run waiting in the queue, or running
+type Run struct {
+	ID       string    `json:"id"`
+	Class    string    `json:"class"`
+	Template string    `json:"template"`
+	Args     []string  `json:"args,omitempty"`
+	Profile  string    `json:"profile"`
+	Region   string    `json:"region"`
+	Status   string    `json:"status"`
+	Queued   time.Time `json:"queued"`
+	Started  time.Time `json:"started,omitempty"`
+}
+
+// NewRun returns a queued run of the template text with its params args (ex: name=web)
+func NewRun(text string, args []string, profile, region string) (*Run, error) {
+	tpl, err := template.Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	return &Run{
+		ID:       ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+		Class:    Classify(tpl),
+		Template: text,
+		Args:     args,
+		Profile:  profile,
+		Region:   region,
+		Status:   QueuedStatus,
+		Queued:   now,
+	}, nil
+}
+
+// Limit of a class: max number of runs of the class running at the same time,
+// and priority of its runs over the ones of other classes (the highest first)
+type Limit struct {
+	Concurrency int `json:"concurrency"`
+	Priority    int `json:"priority"`
+}
+
+// Limits per class
+type Limits map[string]Limit
+
+// DefaultLimits favours the fast and harmless read-only runs and runs destructive ones one at a time
+func DefaultLimits() Limits {
+	return Limits{
+		ReadOnly:    {Concurrency: 4, Priority: 3},
+		Normal:      {Concurrency: 2, Priority: 2},
+		Destructive: {Concurrency: 1, Priority: 1},
+	}
+}
+
+// ParseLimits overrides the default limits with comma separated CLASS=N concurrencies
+// and priorities (ex: "destructive=2,normal=4")
+func ParseLimits(concurrencies, priorities string) (Limits, error) {
+	limits := DefaultLimits()
+	set := func(spec, kind string, apply func(*Limit, int)) error {
+		for _, kv := range strings.Split(spec, ",") {
+			if strings.TrimSpace(kv) == "" {
+				continue
+			}
+			splits := strings.SplitN(kv, "=", 2)
+			class := strings.TrimSpace(splits[0])
+			limit, ok := limits[class]
+			if !ok || len(splits) != 2 {
+				return fmt.Errorf("invalid %s '%s': expecting CLASS=N with class in %s", kind, kv, strings.Join(Classes, ", "))
+			}
+			n, err := strconv.Atoi(strings.TrimSpace(splits[1]))
+			if err != nil || n < 0 {
+				return fmt.Errorf("invalid %s '%s': expecting a positive number", kind, kv)
+			}
+			apply(&limit, n)
+			limits[class] = limit
+		}
+		return nil
+	}
+	if err := set(concurrencies, "concurrency", func(l *Limit, n int) { l.Concurrency = n }); err != nil {
+		return nil, err
+	}
+	if err := set(priorities, "priority", func(l *Limit, n int) { l.Priority = n }); err != nil {
+		return nil, err
+	}
+	return limits, nil
+}
+
+// Next returns the queued run to start, or nil: the oldest run of the class of highest
+// priority among the ones having less running runs than their concurrency limit
+func Next(runs []*Run, limits Limits) *Run {
+	running := make(map[string]int)
+	for _, r := range runs {
+		if r.Status == RunningStatus {
+			running[r.Class]++
+		}
+	}
+
+	var candidates []*Run
+	for _, r := range runs {
+		if r.Status == QueuedStatus && running[r.Class] < limits[r.Class].Concurrency {
+			candidates = append(candidates, r)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if pa, pb := limits[a.Class].Priority, limits[b.Class].Priority; pa != pb {
+			return pa > pb
+		}
+		return a.ID < b.ID
+	})
+	return candidates[0]
+}
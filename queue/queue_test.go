@@ -0,0 +1,108 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/wallix/awless/template"
+)
+
+func TestClassify(t *testing.T) {
+	tcases := []struct {
+		text, class string
+	}{
+		{"check instance id=i-1 state=running timeout=180", ReadOnly},
+		{"create vpc cidr=10.0.0.0/16\ncheck instance id=i-1 state=running timeout=180", Normal},
+		{"update securitygroup id=sg-1 inbound=authorize cidr=0.0.0.0/0 portrange=443", Normal},
+		{"create subnet cidr=10.0.0.0/24 vpc=vpc-1\ndelete instance ids=i-1", Destructive},
+		{"stop instance ids=i-1", Destructive},
+		{"terminate environment id=e-1", Destructive},
+	}
+	for i, tc := range tcases {
+		if got, want := Classify(template.MustParse(tc.text)), tc.class; got != want {
+			t.Fatalf("%d: got %s, want %s", i+1, got, want)
+		}
+	}
+}
+
+func TestParseLimits(t *testing.T) {
+	limits, err := ParseLimits("destructive=2, normal=3", "destructive=5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := limits[Destructive], (Limit{Concurrency: 2, Priority: 5}); got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := limits[Normal], (Limit{Concurrency: 3, Priority: 2}); got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := limits[ReadOnly], DefaultLimits()[ReadOnly]; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	for _, spec := range []string{"unknown=1", "normal", "normal=-1", "normal=many"} {
+		if _, err := ParseLimits(spec, ""); err == nil {
+			t.Fatalf("expected error for '%s'", spec)
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	limits := Limits{
+		ReadOnly:    {Concurrency: 1, Priority: 1},
+		Normal:      {Concurrency: 2, Priority: 2},
+		Destructive: {Concurrency: 1, Priority: 3},
+	}
+	runs := []*Run{
+		{ID: "01", Class: Normal, Status: QueuedStatus},
+		{ID: "02", Class: ReadOnly, Status: QueuedStatus},
+		{ID: "03", Class: Destructive, Status: InterruptedStatus},
+		{ID: "04", Class: Destructive, Status: QueuedStatus},
+		{ID: "05", Class: Destructive, Status: QueuedStatus},
+		{ID: "06", Class: Normal, Status: QueuedStatus},
+	}
+
+	var started []string
+	for next := Next(runs, limits); next != nil; next = Next(runs, limits) {
+		next.Status = RunningStatus
+		started = append(started, next.ID)
+	}
+	if got, want := started, []string{"04", "01", "06", "02"}; !equal(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	runs[3].Status = InterruptedStatus
+	if got := Next(runs, limits); got == nil || got.ID != "05" {
+		t.Fatalf("got %v, want run 05", got)
+	}
+}
+
+func TestNewRun(t *testing.T) {
+	run, err := NewRun("delete instance ids={instances}", []string{"instances=i-1"}, "prod", "eu-west-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if run.ID == "" || run.Queued.IsZero() {
+		t.Fatalf("expected id and queued time, got %#v", run)
+	}
+	if got, want := run.Class, Destructive; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := run.Status, QueuedStatus; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if _, err = NewRun("create instance name=", nil, "prod", "eu-west-1"); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -31,6 +31,9 @@ type Client struct {
 	Proxy                   *Client
 	HostKeyCallback         gossh.HostKeyCallback
 	StrictHostKeyChecking   bool
+	ForwardAgent            bool
+	LocalForwards           []string
+	DynamicForward          string
 	InteractiveTerminalFunc func(*gossh.Client) error
 	logger                  *logger.Logger
 }
@@ -130,6 +133,9 @@ func (c *Client) NewClientWithProxy(destinationHost string, destinationPort int,
 			Port:    destinationPort,
 			InteractiveTerminalFunc: func(*gossh.Client) error { return nil },
 			StrictHostKeyChecking:   c.StrictHostKeyChecking,
+			ForwardAgent:            c.ForwardAgent,
+			LocalForwards:           c.LocalForwards,
+			DynamicForward:          c.DynamicForward,
 			logger:                  logger.DiscardLogger,
 		}, nil
 	}
@@ -149,7 +155,30 @@ func (c *Client) CloseAll() error {
 	return nil
 }
 
+// Run executes the command on the remote host, returning its standard output and error
+// and its exit status. The error is only set when the command could not run to completion
+func (c *Client) Run(command string) (stdout, stderr []byte, exitStatus int, err error) {
+	session, err := c.NewSession()
+	if err != nil {
+		return nil, nil, -1, err
+	}
+	defer session.Close()
+
+	var outBuf, errBuf bytes.Buffer
+	session.Stdout, session.Stderr = &outBuf, &errBuf
+	if err = session.Run(command); err != nil {
+		if exitErr, ok := err.(*gossh.ExitError); ok {
+			return outBuf.Bytes(), errBuf.Bytes(), exitErr.ExitStatus(), nil
+		}
+		return outBuf.Bytes(), errBuf.Bytes(), -1, err
+	}
+	return outBuf.Bytes(), errBuf.Bytes(), 0, nil
+}
+
 func (c *Client) Connect() (err error) {
+	if c.Proxy != nil {
+		c.logger.Infof("SSH hops: %s", c.HopChain())
+	}
 	args, installed := c.localExec()
 	if installed {
 		c.logger.Infof("Login as '%s' on '%s'; client '%s'", c.User, c.IP, args[0])
@@ -164,6 +193,12 @@ func (c *Client) Connect() (err error) {
 	}
 
 	c.logger.Infof("No SSH. Fallback on builtin client. Login as '%s' on '%s'", c.User, c.IP)
+	if c.ForwardAgent {
+		c.logger.Warning("agent forwarding is not supported by the builtin client")
+	}
+	if len(c.LocalForwards) > 0 || c.DynamicForward != "" {
+		c.logger.Warning("port forwarding is not supported by the builtin client")
+	}
 	return c.InteractiveTerminalFunc(c.Client)
 }
 
@@ -180,18 +215,30 @@ func (c *Client) SSHConfigString(hostname string) string {
 	if c.Port != 22 {
 		extraOpts["Port"] = strconv.Itoa(c.Port)
 	}
+	if c.ForwardAgent {
+		extraOpts["ForwardAgent"] = "yes"
+	}
+	if c.DynamicForward != "" {
+		extraOpts["DynamicForward"] = c.DynamicForward
+	}
 	if c.Proxy != nil {
-		var keyArg string
-		if k := c.Proxy.Keypath; len(k) > 0 {
-			keyArg = fmt.Sprintf("-i %s", k)
+		extraOpts["ProxyCommand"] = c.proxyCommand()
+	}
+
+	var localForwards []string
+	for _, forward := range c.LocalForwards {
+		// in SSH config, the remote host and port are separated by a space (ex: LocalForward 5432 db.internal:5432)
+		if parts := strings.Split(forward, ":"); len(parts) > 2 {
+			forward = strings.Join(parts[:len(parts)-2], ":") + " " + strings.Join(parts[len(parts)-2:], ":")
 		}
-		extraOpts["ProxyCommand"] = fmt.Sprintf("ssh %s %s@%s -p %d -W %%h:%%p", keyArg, c.Proxy.User, c.Proxy.IP, c.Proxy.Port)
+		localForwards = append(localForwards, forward)
 	}
 
 	params := struct {
 		IP, User, Name string
 		Extra          map[string]string
-	}{c.IP, c.User, hostname, extraOpts}
+		LocalForwards  []string
+	}{c.IP, c.User, hostname, extraOpts, localForwards}
 
 	template.Must(template.New("ssh_config").Parse(`
 Host {{ .Name }}
@@ -200,11 +247,26 @@ Host {{ .Name }}
 {{- range $key, $value := .Extra }}
   {{ $key }} {{ $value -}}
 {{ end -}}
+{{- range .LocalForwards }}
+  LocalForward {{ . -}}
+{{ end -}}
 `)).Execute(&buf, params)
 
 	return buf.String()
 }
 
+// HopChain returns the successive hosts the connection goes through (ex: ec2-user@34.215.29.221 -> ec2-user@172.31.77.151)
+func (c *Client) HopChain() string {
+	hop := fmt.Sprintf("%s@%s", c.User, c.IP)
+	if c.Port != 22 {
+		hop = fmt.Sprintf("%s:%d", hop, c.Port)
+	}
+	if c.Proxy != nil {
+		return fmt.Sprintf("%s -> %s", c.Proxy.HopChain(), hop)
+	}
+	return hop
+}
+
 func (c *Client) ConnectString() string {
 	args, _ := c.localExec()
 	return strings.Join(args, " ")
@@ -227,20 +289,94 @@ func (c *Client) localExec() ([]string, bool) {
 	if !c.StrictHostKeyChecking {
 		args = append(args, "-o", "StrictHostKeychecking=no")
 	}
+	if c.ForwardAgent {
+		args = append(args, "-A")
+	}
+	for _, forward := range c.LocalForwards {
+		args = append(args, "-L", forward)
+	}
+	if c.DynamicForward != "" {
+		args = append(args, "-D", c.DynamicForward)
+	}
 
 	args = append(args, fmt.Sprintf("%s@%s", c.User, c.IP))
 
 	if c.Proxy != nil {
-		var keyArg string
-		if k := c.Proxy.Keypath; len(k) > 0 {
-			keyArg = fmt.Sprintf("-i %s", k)
-		}
-		args = append(args, "-o", fmt.Sprintf("ProxyCommand='ssh %s %s@%s -p %d -W %%h:%%p'", keyArg, c.Proxy.User, c.Proxy.IP, c.Proxy.Port))
+		args = append(args, "-o", fmt.Sprintf("ProxyCommand='%s'", c.proxyCommand()))
 	}
 
 	return args, exists
 }
 
+func (c *Client) proxyCommand() string {
+	var keyArg string
+	if k := c.Proxy.Keypath; len(k) > 0 {
+		keyArg = fmt.Sprintf("-i %s", k)
+	}
+	return fmt.Sprintf("ssh %s %s@%s -p %d -W %%h:%%p", keyArg, c.Proxy.User, c.Proxy.IP, c.Proxy.Port)
+}
+
+// RemotePath returns the scp notation of a path on the host of the client (ex: ec2-user@1.2.3.4:/tmp/)
+func (c *Client) RemotePath(path string) string {
+	return fmt.Sprintf("%s@%s:%s", c.User, c.IP, path)
+}
+
+// Copy copies the files, from or to the host given with RemotePath, with the local scp client
+func (c *Client) Copy(recursive bool, paths ...string) error {
+	args, installed := c.scpExec(recursive, false, paths...)
+	if !installed {
+		return errors.New("no scp client found, it is required to copy files")
+	}
+	if c.Proxy != nil {
+		c.logger.Infof("SSH hops: %s", c.HopChain())
+	}
+	c.logger.Infof("Copying as '%s' on '%s'; client '%s'", c.User, c.IP, args[0])
+	c.logger.ExtraVerbosef("running locally %s", args)
+	if err := c.CloseAll(); err != nil {
+		c.logger.Warning("could not close properly SSH awless client before delegating")
+	}
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	return cmd.Run()
+}
+
+// CopyString returns the scp command line copying the files
+func (c *Client) CopyString(recursive bool, paths ...string) string {
+	args, _ := c.scpExec(recursive, true, paths...)
+	return strings.Join(args, " ")
+}
+
+func (c *Client) scpExec(recursive, quoteProxyCommand bool, paths ...string) ([]string, bool) {
+	exists := true
+	bin, err := exec.LookPath("scp")
+	if err != nil {
+		exists = false
+		bin = "scp"
+	}
+	args := []string{bin}
+	if recursive {
+		args = append(args, "-r")
+	}
+	if len(c.Keypath) > 0 {
+		args = append(args, "-i", c.Keypath)
+	}
+	if c.Port != 22 {
+		args = append(args, "-P", strconv.Itoa(c.Port))
+	}
+	if !c.StrictHostKeyChecking {
+		args = append(args, "-o", "StrictHostKeychecking=no")
+	}
+	if c.Proxy != nil {
+		if quoteProxyCommand {
+			args = append(args, "-o", fmt.Sprintf("ProxyCommand='%s'", c.proxyCommand()))
+		} else {
+			args = append(args, "-o", fmt.Sprintf("ProxyCommand=%s", c.proxyCommand()))
+		}
+	}
+
+	return append(args, paths...), exists
+}
+
 func DecryptSSHKey(key []byte, password []byte) (gossh.Signer, error) {
 	block, _ := pem.Decode(key)
 	pem, err := x509.DecryptPEMBlock(block, password)
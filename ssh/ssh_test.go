@@ -182,6 +182,23 @@ To get rid of this message, update '%[1]s:2'`, knowHostsFile)},
 	}
 }
 
+func TestHopChain(t *testing.T) {
+	bastion := &Client{Port: 22, IP: "1.2.3.4", User: "ec2-user"}
+	tcases := []struct {
+		client *Client
+		exp    string
+	}{
+		{bastion, "ec2-user@1.2.3.4"},
+		{&Client{Port: 2222, IP: "10.0.0.5", User: "ubuntu", Proxy: bastion}, "ec2-user@1.2.3.4 -> ubuntu@10.0.0.5:2222"},
+		{&Client{Port: 22, IP: "10.0.1.8", User: "admin", Proxy: &Client{Port: 22, IP: "10.0.0.5", User: "ubuntu", Proxy: bastion}}, "ec2-user@1.2.3.4 -> ubuntu@10.0.0.5 -> admin@10.0.1.8"},
+	}
+	for i, tcase := range tcases {
+		if got, want := tcase.client.HopChain(), tcase.exp; got != want {
+			t.Fatalf("case %d: got '%s', want '%s'", i+1, got, want)
+		}
+	}
+}
+
 func TestCLIAndConfig(t *testing.T) {
 	tcases := []struct {
 		client      *Client
@@ -207,6 +224,21 @@ func TestCLIAndConfig(t *testing.T) {
 			"/usr/bin/ssh -o StrictHostKeychecking=no ec2-user@1.2.3.4",
 			"\nHost TestHost\n  Hostname 1.2.3.4\n  User ec2-user\n  StrictHostKeychecking no",
 		},
+		{
+			&Client{Port: 22, IP: "1.2.3.4", User: "ec2-user", StrictHostKeyChecking: true, ForwardAgent: true},
+			"/usr/bin/ssh -A ec2-user@1.2.3.4",
+			"\nHost TestHost\n  Hostname 1.2.3.4\n  User ec2-user\n  ForwardAgent yes",
+		},
+		{
+			&Client{Port: 22, IP: "10.0.0.5", User: "ubuntu", StrictHostKeyChecking: true, ForwardAgent: true, Proxy: &Client{Port: 2222, IP: "1.2.3.4", User: "ec2-user", Keypath: "/path/to/key"}},
+			"/usr/bin/ssh -A ubuntu@10.0.0.5 -o ProxyCommand='ssh -i /path/to/key ec2-user@1.2.3.4 -p 2222 -W %h:%p'",
+			"\nHost TestHost\n  Hostname 10.0.0.5\n  User ubuntu\n  ForwardAgent yes\n  ProxyCommand ssh -i /path/to/key ec2-user@1.2.3.4 -p 2222 -W %h:%p",
+		},
+		{
+			&Client{Port: 22, IP: "1.2.3.4", User: "ec2-user", StrictHostKeyChecking: true, LocalForwards: []string{"5432:mydb.eu-west-1.rds.amazonaws.com:5432", "127.0.0.1:6379:redis.internal:6379"}, DynamicForward: "1080"},
+			"/usr/bin/ssh -L 5432:mydb.eu-west-1.rds.amazonaws.com:5432 -L 127.0.0.1:6379:redis.internal:6379 -D 1080 ec2-user@1.2.3.4",
+			"\nHost TestHost\n  Hostname 1.2.3.4\n  User ec2-user\n  DynamicForward 1080\n  LocalForward 5432 mydb.eu-west-1.rds.amazonaws.com:5432\n  LocalForward 127.0.0.1:6379 redis.internal:6379",
+		},
 	}
 
 	var got string
@@ -223,3 +255,32 @@ func TestCLIAndConfig(t *testing.T) {
 		}
 	}
 }
+
+func TestCopyString(t *testing.T) {
+	tcases := []struct {
+		client    *Client
+		recursive bool
+		exp       string
+	}{
+		{
+			&Client{Port: 22, IP: "1.2.3.4", User: "ec2-user", StrictHostKeyChecking: true},
+			false,
+			"/usr/bin/scp ./app.tar.gz ec2-user@1.2.3.4:/tmp/",
+		},
+		{
+			&Client{Port: 2222, IP: "1.2.3.4", User: "ec2-user", StrictHostKeyChecking: false, Keypath: "/path/to/key"},
+			true,
+			"/usr/bin/scp -r -i /path/to/key -P 2222 -o StrictHostKeychecking=no ./app.tar.gz ec2-user@1.2.3.4:/tmp/",
+		},
+		{
+			&Client{Port: 22, IP: "1.2.3.4", User: "ec2-user", StrictHostKeyChecking: true, Proxy: &Client{Port: 22, IP: "5.6.7.8", User: "ubuntu", Keypath: "/path/to/key"}},
+			false,
+			"/usr/bin/scp -o ProxyCommand='ssh -i /path/to/key ubuntu@5.6.7.8 -p 22 -W %h:%p' ./app.tar.gz ec2-user@1.2.3.4:/tmp/",
+		},
+	}
+	for i, tcase := range tcases {
+		if got, want := tcase.client.CopyString(tcase.recursive, "./app.tar.gz", tcase.client.RemotePath("/tmp/")), tcase.exp; got != want {
+			t.Fatalf("case %d: got '%s', want '%s'", i+1, got, want)
+		}
+	}
+}
@@ -198,6 +198,23 @@ func LoadLocalGraphForService(serviceName, profile, region string) cloud.GraphAP
 	return g
 }
 
+// LastSyncTimes returns, per service name, when the local data of the services was
+// last synced for the profile and region, global services (access, dns, cdn) included
+func LastSyncTimes(profile, region string) map[string]time.Time {
+	times := make(map[string]time.Time)
+	for _, dir := range []string{"global", region} {
+		files, _ := filepath.Glob(filepath.Join(repo.BaseDir(), profile, dir, fmt.Sprintf("*%s", fileExt)))
+		for _, f := range files {
+			info, err := os.Stat(f)
+			if err != nil {
+				continue
+			}
+			times[strings.TrimSuffix(filepath.Base(f), fileExt)] = info.ModTime()
+		}
+	}
+	return times
+}
+
 func LoadLocalGraphs(profile, region string) (cloud.GraphAPI, error) {
 	var files []string
 	globalFiles, _ := filepath.Glob(filepath.Join(repo.BaseDir(), profile, "global", fmt.Sprintf("*%s", fileExt)))
@@ -221,6 +238,39 @@ func LoadLocalGraphs(profile, region string) (cloud.GraphAPI, error) {
 	return g, err
 }
 
+// LoadLocalGraphsPerRegion loads the local resources of each region synced for the profile,
+// global resources (IAM, DNS, ...) excluded
+func LoadLocalGraphsPerRegion(profile string) (map[string]cloud.GraphAPI, error) {
+	graphs := make(map[string]cloud.GraphAPI)
+	dirs, _ := filepath.Glob(filepath.Join(repo.BaseDir(), profile, "*"))
+	for _, dir := range dirs {
+		region := filepath.Base(dir)
+		if region == "global" {
+			continue
+		}
+		files, _ := filepath.Glob(filepath.Join(dir, fmt.Sprintf("*%s", fileExt)))
+		if len(files) == 0 {
+			continue
+		}
+
+		g := graph.NewGraph()
+		var readers []io.Reader
+		for _, f := range files {
+			reader, err := os.Open(f)
+			if err != nil {
+				return graphs, fmt.Errorf("loading '%s': %s", f, err)
+			}
+			defer reader.Close()
+			readers = append(readers, reader)
+		}
+		if err := g.UnmarshalFromReaders(readers...); err != nil {
+			return graphs, fmt.Errorf("loading region %s: %s", region, err)
+		}
+		graphs[region] = g
+	}
+	return graphs, nil
+}
+
 func LoadAllLocalGraphs(profile string) (cloud.GraphAPI, error) {
 	path := filepath.Join(repo.BaseDir(), profile, "*", fmt.Sprintf("*%s", fileExt))
 	files, _ := filepath.Glob(path)
@@ -123,6 +123,39 @@ func (*ec2Mock) DescribeSecurityGroups(input *ec2.DescribeSecurityGroupsInput) (
 	return &ec2.DescribeSecurityGroupsOutput{SecurityGroups: sgroups}, nil
 }
 
+func (*ec2Mock) DescribeSpotInstanceRequests(input *ec2.DescribeSpotInstanceRequestsInput) (*ec2.DescribeSpotInstanceRequestsOutput, error) {
+	return &ec2.DescribeSpotInstanceRequestsOutput{SpotInstanceRequests: []*ec2.SpotInstanceRequest{}}, nil
+}
+
+func (*ec2Mock) DescribeSpotFleetRequestsPages(input *ec2.DescribeSpotFleetRequestsInput, fn func(p *ec2.DescribeSpotFleetRequestsOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&ec2.DescribeSpotFleetRequestsOutput{}, true)
+	return nil
+}
+
+func (*ec2Mock) DescribeVpcPeeringConnections(input *ec2.DescribeVpcPeeringConnectionsInput) (*ec2.DescribeVpcPeeringConnectionsOutput, error) {
+	return &ec2.DescribeVpcPeeringConnectionsOutput{VpcPeeringConnections: []*ec2.VpcPeeringConnection{}}, nil
+}
+
+func (*ec2Mock) DescribeVpcEndpoints(input *ec2.DescribeVpcEndpointsInput) (*ec2.DescribeVpcEndpointsOutput, error) {
+	return &ec2.DescribeVpcEndpointsOutput{VpcEndpoints: []*ec2.VpcEndpoint{}}, nil
+}
+
+func (*ec2Mock) DescribeCustomerGateways(input *ec2.DescribeCustomerGatewaysInput) (*ec2.DescribeCustomerGatewaysOutput, error) {
+	return &ec2.DescribeCustomerGatewaysOutput{CustomerGateways: []*ec2.CustomerGateway{}}, nil
+}
+
+func (*ec2Mock) DescribeVpnGateways(input *ec2.DescribeVpnGatewaysInput) (*ec2.DescribeVpnGatewaysOutput, error) {
+	return &ec2.DescribeVpnGatewaysOutput{VpnGateways: []*ec2.VpnGateway{}}, nil
+}
+
+func (*ec2Mock) DescribeVpnConnections(input *ec2.DescribeVpnConnectionsInput) (*ec2.DescribeVpnConnectionsOutput, error) {
+	return &ec2.DescribeVpnConnectionsOutput{VpnConnections: []*ec2.VpnConnection{}}, nil
+}
+
+func (*ec2Mock) DescribeReservedInstances(input *ec2.DescribeReservedInstancesInput) (*ec2.DescribeReservedInstancesOutput, error) {
+	return &ec2.DescribeReservedInstancesOutput{ReservedInstances: []*ec2.ReservedInstances{}}, nil
+}
+
 func (*ec2Mock) DescribeImportImageTasks(input *ec2.DescribeImportImageTasksInput) (*ec2.DescribeImportImageTasksOutput, error) {
 	return &ec2.DescribeImportImageTasksOutput{ImportImageTasks: []*ec2.ImportImageTask{}}, nil
 }
@@ -160,6 +193,18 @@ func (*ec2Mock) DescribeRouteTables(input *ec2.DescribeRouteTablesInput) (*ec2.D
 	return &ec2.DescribeRouteTablesOutput{RouteTables: rTables}, nil
 }
 
+func (*ec2Mock) DescribeNetworkAcls(input *ec2.DescribeNetworkAclsInput) (*ec2.DescribeNetworkAclsOutput, error) {
+	return &ec2.DescribeNetworkAclsOutput{NetworkAcls: []*ec2.NetworkAcl{}}, nil
+}
+
+func (*ec2Mock) DescribePlacementGroups(input *ec2.DescribePlacementGroupsInput) (*ec2.DescribePlacementGroupsOutput, error) {
+	return &ec2.DescribePlacementGroupsOutput{PlacementGroups: []*ec2.PlacementGroup{}}, nil
+}
+
+func (*ec2Mock) DescribeHosts(input *ec2.DescribeHostsInput) (*ec2.DescribeHostsOutput, error) {
+	return &ec2.DescribeHostsOutput{Hosts: []*ec2.Host{}}, nil
+}
+
 func (*ec2Mock) DescribeAvailabilityZones(input *ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error) {
 	zones := []*ec2.AvailabilityZone{
 		{ZoneName: awssdk.String("us-west-1a"), State: awssdk.String("available"), RegionName: awssdk.String("us-west-1"), Messages: []*ec2.AvailabilityZoneMessage{{Message: awssdk.String("msg 1")}, {Message: awssdk.String("msg 2")}}},
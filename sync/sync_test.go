@@ -10,8 +10,10 @@ import (
 	"io/ioutil"
 
 	"path/filepath"
+	"time"
 
 	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
 )
 
 func TestSyncTripleFiles(t *testing.T) {
@@ -79,3 +81,72 @@ func (s *mockService) IsSyncDisabled() bool                          { return fa
 func (s *mockService) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
 	return nil, nil
 }
+
+func TestLoadLocalGraphsPerRegion(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "awlessunittest_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	os.Setenv("__AWLESS_HOME", tmpDir)
+
+	var services []cloud.Service
+	for _, region := range []string{"paris", "bali", "global"} {
+		g := graph.NewGraph()
+		g.AddResource(resourcetest.Instance("inst_" + region).Build())
+		services = append(services, &mockService{g: g, name: "infra_" + region, region: region, profile: "admin"})
+	}
+	if _, err = NewSyncer().Sync(services...); err != nil {
+		t.Fatal(err)
+	}
+
+	graphs, err := LoadLocalGraphsPerRegion("admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(graphs), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	for _, region := range []string{"paris", "bali"} {
+		res, err := graphs[region].Find(cloud.NewQuery("instance"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res) != 1 || res[0].Id() != "inst_"+region {
+			t.Fatalf("%s: unexpected resources %v", region, res)
+		}
+	}
+}
+
+func TestLastSyncTimes(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "awlessunittest_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	os.Setenv("__AWLESS_HOME", tmpDir)
+
+	if got := LastSyncTimes("admin", "paris"); len(got) != 0 {
+		t.Fatalf("expected no sync time, got %v", got)
+	}
+
+	before := time.Now().Add(-time.Second)
+	services := []cloud.Service{
+		&mockService{g: graph.NewGraph(), name: "infra", region: "paris", profile: "admin"},
+		&mockService{g: graph.NewGraph(), name: "access", region: "global", profile: "admin"},
+		&mockService{g: graph.NewGraph(), name: "storage", region: "bali", profile: "admin"},
+	}
+	if _, err = NewSyncer().Sync(services...); err != nil {
+		t.Fatal(err)
+	}
+
+	times := LastSyncTimes("admin", "paris")
+	if got, want := len(times), 2; got != want {
+		t.Fatalf("got %d, want %d: %v", got, want, times)
+	}
+	for _, name := range []string{"infra", "access"} {
+		if synced, ok := times[name]; !ok || synced.Before(before) {
+			t.Fatalf("%s: unexpected sync time %v", name, synced)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package template
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/template/driver"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/internal/ast"
+	"github.com/wallix/awless/template/params"
+)
+
+const (
+	// AccountModifier runs a statement in another account than the one of the session
+	// (ex: account=123456789012), given as an account id or as the ARN of the role to assume in it
+	AccountModifier = "account"
+	// RoleModifier is the role assumed in the account of the statement (ex: account=123456789012 role=deployer).
+	// Commands having a role param of their own take the role to assume as an ARN in the account modifier
+	RoleModifier = "role"
+)
+
+// accountModifiersPass marks the account and role params of the statements not expecting
+// them as modifiers, their commands being looked up in the given account when run
+func accountModifiersPass(tpl *Template, cenv env.Compiling) (*Template, env.Compiling, error) {
+	for _, node := range tpl.CommandNodesIterator() {
+		modifiers := accountModifiers(node)
+		if len(modifiers) == 0 {
+			continue
+		}
+		var lookup func(account, role, key string) (interface{}, error)
+		if l, ok := cenv.(interface {
+			AccountLookupCommandFunc() func(account, role, key string) (interface{}, error)
+		}); ok {
+			lookup = l.AccountLookupCommandFunc()
+		}
+		if lookup == nil {
+			return tpl, cenv, cmdErr(node, "running statements in another account is not supported")
+		}
+		// account ids, made of digits, are parsed as integers dropping their leading zeros
+		if n, ok := node.ParamNodes[AccountModifier].(ast.InterfaceNode); ok {
+			if id, isInt := n.Value().(int); isInt {
+				node.ParamNodes[AccountModifier] = fmt.Sprintf("%012d", id)
+			}
+		}
+		node.Modifiers = modifiers
+		node.Command = &accountCommand{
+			Command:   node.Command,
+			key:       fmt.Sprintf("%s%s", DefinitionAction(node.Action), node.Entity),
+			modifiers: modifiers,
+			lookup:    lookup,
+		}
+		node.Driver = wrapDriver(node.Command, cenv)
+	}
+	return tpl, cenv, nil
+}
+
+// accountModifiers returns the params of the node modifying the account it runs in
+func accountModifiers(node *ast.CommandNode) []string {
+	if !hasParam(node, AccountModifier) || isCommandParam(node, AccountModifier) {
+		return nil
+	}
+	modifiers := []string{AccountModifier}
+	if hasParam(node, RoleModifier) && !isCommandParam(node, RoleModifier) {
+		modifiers = append(modifiers, RoleModifier)
+	}
+	return modifiers
+}
+
+func hasParam(node *ast.CommandNode, key string) bool {
+	_, inParams := node.ParamNodes[key]
+	_, inRefs := node.Refs[key]
+	return inParams || inRefs
+}
+
+func isCommandParam(node *ast.CommandNode, key string) bool {
+	required, optionals, _ := params.List(node.ParamsSpec().Rule())
+	return contains(required, key) || contains(optionals, key)
+}
+
+// modifiersSuffix returns the modifiers of the command as params to append to a statement
+func modifiersSuffix(cmd *ast.CommandNode) string {
+	var all []string
+	for _, k := range cmd.Modifiers {
+		v, ok := cmd.ParamNodes[k]
+		if !ok {
+			continue
+		}
+		// account ids are quoted to be parsed back as strings, keeping their leading zeros
+		if s, isStr := v.(string); isStr && isDigits(s) {
+			all = append(all, fmt.Sprintf("%s='%s'", k, s))
+		} else {
+			all = append(all, fmt.Sprintf("%s=%s", k, printItem(v)))
+		}
+	}
+	sort.Strings(all)
+	if len(all) == 0 {
+		return ""
+	}
+	return " " + strings.Join(all, " ")
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// withoutModifiers returns a copy of the command without its modifiers
+func withoutModifiers(cmd *ast.CommandNode) *ast.CommandNode {
+	if len(cmd.Modifiers) == 0 {
+		return cmd
+	}
+	c := *cmd
+	c.ParamNodes = make(map[string]interface{})
+	for k, v := range cmd.ParamNodes {
+		if !cmd.IsModifier(k) {
+			c.ParamNodes[k] = v
+		}
+	}
+	c.Modifiers = nil
+	return &c
+}
+
+// accountCommand runs a command in the account given by the modifiers of its statement.
+// The embedded command, looked up for the account of the session, gives the params spec
+type accountCommand struct {
+	ast.Command
+	key       string
+	modifiers []string
+	lookup    func(account, role, key string) (interface{}, error)
+}
+
+func (c *accountCommand) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	cmd, cmdParams, err := c.inAccount(params)
+	if err != nil {
+		return nil, err
+	}
+	return cmd.Run(renv, cmdParams)
+}
+
+func (c *accountCommand) DryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	cmd, cmdParams, err := c.inAccount(params)
+	if err != nil {
+		return nil, err
+	}
+	return driver.DryRun(cmd).Run(renv, cmdParams)
+}
+
+func (c *accountCommand) PriorState(renv env.Running, params map[string]interface{}) (map[string]interface{}, error) {
+	cmd, cmdParams, err := c.inAccount(params)
+	if err != nil {
+		return nil, err
+	}
+	if capturer, ok := cmd.(PriorStateCapturer); ok {
+		return capturer.PriorState(renv, cmdParams)
+	}
+	return nil, nil
+}
+
+// inAccount looks up the command in the account of the statement and returns it
+// with the params of the statement minus the modifiers
+func (c *accountCommand) inAccount(params map[string]interface{}) (ast.Command, map[string]interface{}, error) {
+	cmdParams := make(map[string]interface{})
+	for k, v := range params {
+		if !contains(c.modifiers, k) {
+			cmdParams[k] = v
+		}
+	}
+	account, ok := params[AccountModifier].(string)
+	if !ok || account == "" {
+		return nil, nil, fmt.Errorf("account: expecting an account id or a role ARN, got '%v'", params[AccountModifier])
+	}
+	var role string
+	if contains(c.modifiers, RoleModifier) {
+		role = fmt.Sprint(params[RoleModifier])
+	}
+	i, err := c.lookup(account, role, c.key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("account %s: %s", account, err)
+	}
+	cmd, ok := i.(ast.Command)
+	if !ok || cmd == nil {
+		return nil, nil, fmt.Errorf("account %s: cannot run '%s' in another account", account, c.key)
+	}
+	return cmd, cmdParams, nil
+}
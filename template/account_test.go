@@ -0,0 +1,137 @@
+package template
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+func TestAccountModifiers(t *testing.T) {
+	specs := map[string]params.Spec{
+		"createaccount":    params.NewSpec(params.AllOf(params.Key("email"), params.Key("name"))),
+		"createinstance":   params.NewSpec(params.AllOf(params.Key("name"), params.Opt("role"))),
+		"createbucket":     params.NewSpec(params.AllOf(params.Key("name"))),
+		"createrepository": params.NewSpec(params.AllOf(params.Key("name"), params.Opt("account"))),
+	}
+	var ran []string
+	var lookups []string
+	cenv := NewEnv().WithLookupCommandFunc(func(tokens ...string) interface{} {
+		return &mockAccountCommand{key: tokens[0], spec: specs[tokens[0]], ran: &ran}
+	}).WithAccountLookupCommandFunc(func(account, role, key string) (interface{}, error) {
+		lookups = append(lookups, fmt.Sprintf("%s %s/%s", key, account, role))
+		return &mockAccountCommand{key: key, spec: specs[key], account: account + "/" + role, ran: &ran}, nil
+	}).Build()
+
+	tpl := MustParse(`acc = create account email=ops@example.com name=prod
+create instance name=web role=web-profile account=$acc
+create bucket name=logs account=012345678901 role=deployer
+create repository name=app account=111111111111`)
+	compiled, cenv, err := newMultiPass(injectCommandsInNodesPass, accountModifiersPass, processAndValidateParamsPass, resolveParamsAndExtractRefsPass).compile(tpl, cenv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	executed, err := compiled.Run(NewRunEnv(cenv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, cmd := range executed.CommandNodesIterator() {
+		if cmd.Err() != nil {
+			t.Fatal(cmd.Err())
+		}
+	}
+
+	exp := []string{
+		"createaccount: email=ops@example.com name=prod",
+		"createinstance in 222222222222/: name=web role=web-profile",
+		"createbucket in 012345678901/deployer: name=logs",
+		"createrepository: account=111111111111 name=app",
+	}
+	if got, want := ran, exp; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	// the prior state is captured in the account of the statement
+	if got, want := lookups, []string{"createinstance 222222222222/", "createinstance 222222222222/", "createbucket 012345678901/deployer", "createbucket 012345678901/deployer"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	tplExec := &TemplateExecution{Template: executed}
+	b, err := tplExec.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded := &TemplateExecution{}
+	if err = loaded.UnmarshalJSON(b); err != nil {
+		t.Fatal(err)
+	}
+	for _, revertible := range []*Template{executed, loaded.Template} {
+		reverted, err := revertible.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+		exp := "delete repository name=app\ndelete bucket account='012345678901' name=logs-id role=deployer\ndelete instance account='222222222222' id=web-id\ncheck instance account='222222222222' id=web-id state=terminated timeout=180"
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got\n%s\nwant\n%s", got, want)
+		}
+	}
+}
+
+func TestAccountModifiersErrors(t *testing.T) {
+	lookupCommand := func(tokens ...string) interface{} {
+		return &mockAccountCommand{key: tokens[0], spec: params.NewSpec(params.AllOf(params.Key("name")))}
+	}
+
+	_, _, err := Compile(MustParse("create bucket name=logs account=012345678901"), NewEnv().WithLookupCommandFunc(lookupCommand).Build(), TestCompileMode)
+	if err == nil || !strings.Contains(err.Error(), "running statements in another account is not supported") {
+		t.Fatalf("got %v", err)
+	}
+
+	cenv := NewEnv().WithLookupCommandFunc(lookupCommand).WithAccountLookupCommandFunc(func(account, role, key string) (interface{}, error) {
+		return nil, errors.New("access denied")
+	}).Build()
+	compiled, cenv, err := newMultiPass(injectCommandsInNodesPass, accountModifiersPass, resolveParamsAndExtractRefsPass).compile(MustParse("create bucket name=logs account=012345678901"), cenv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	executed, err := compiled.Run(NewRunEnv(cenv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := executed.CommandNodesIterator()[0].Err().Error(), "account 012345678901: access denied"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+type mockAccountCommand struct {
+	key, account string
+	spec         params.Spec
+	ran          *[]string
+}
+
+func (c *mockAccountCommand) ParamsSpec() params.Spec { return c.spec }
+
+func (c *mockAccountCommand) Run(renv env.Running, p map[string]interface{}) (interface{}, error) {
+	var all []string
+	for k, v := range p {
+		all = append(all, fmt.Sprintf("%s=%v", k, v))
+	}
+	sort.Strings(all)
+	where := c.key
+	if c.account != "" {
+		where = fmt.Sprintf("%s in %s", c.key, c.account)
+	}
+	*c.ran = append(*c.ran, fmt.Sprintf("%s: %s", where, strings.Join(all, " ")))
+	if c.key == "createaccount" {
+		return "222222222222", nil
+	}
+	return fmt.Sprint(p["name"], "-id"), nil
+}
+
+func (c *mockAccountCommand) PriorState(env.Running, map[string]interface{}) (map[string]interface{}, error) {
+	return nil, nil
+}
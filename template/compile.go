@@ -3,8 +3,13 @@ package template
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"regexp"
 	"sort"
+	"strconv"
+	"strings"
 
+	"github.com/wallix/awless/template/driver"
 	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/internal/ast"
 	"github.com/wallix/awless/template/params"
@@ -16,32 +21,45 @@ var (
 	TestCompileMode = []compileFunc{
 		injectCommandsInNodesPass,
 		failOnDeclarationWithNoResultPass,
+		accountModifiersPass,
 		processAndValidateParamsPass,
 		checkInvalidReferenceDeclarationsPass,
 		resolveHolesPass,
 		resolveMissingHolesPass,
 		removeOptionalHolesPass,
+		resolveFuncsPass,
 		resolveAliasPass,
 		inlineVariableValuePass,
+		resolveStackRefsPass,
 		resolveParamsAndExtractRefsPass,
 	}
 
 	NewRunnerCompileMode = []compileFunc{
 		injectCommandsInNodesPass,
 		failOnDeclarationWithNoResultPass,
+		accountModifiersPass,
 		processAndValidateParamsPass,
 		checkInvalidReferenceDeclarationsPass,
 		resolveHolesPass,
 		resolveMissingHolesPass,
 		removeOptionalHolesPass,
+		resolveFuncsPass,
 		resolveAliasPass,
 		inlineVariableValuePass,
+		resolveStackRefsPass,
 		failOnUnresolvedHolesPass,
 		failOnUnresolvedAliasPass,
 		resolveParamsAndExtractRefsPass,
 		convertParamsPass,
 		validateCommandsPass,
 	}
+
+	// StatementsCompileMode only injects the commands in the nodes, leaving their params unresolved,
+	// to tell what a template runs before its holes are filled
+	StatementsCompileMode = []compileFunc{
+		injectCommandsInNodesPass,
+		accountModifiersPass,
+	}
 )
 
 func Compile(tpl *Template, cenv env.Compiling, mode ...Mode) (*Template, env.Compiling, error) {
@@ -85,7 +103,7 @@ func injectCommandsInNodesPass(tpl *Template, cenv env.Compiling) (*Template, en
 	}
 
 	for _, node := range tpl.CommandNodesIterator() {
-		key := fmt.Sprintf("%s%s", node.Action, node.Entity)
+		key := fmt.Sprintf("%s%s", DefinitionAction(node.Action), node.Entity)
 		cmd, ok := cenv.LookupCommandFunc()(key).(ast.Command)
 		if !ok {
 			return tpl, cenv, fmt.Errorf("%s: casting: %v is not a command", key, cmd)
@@ -94,10 +112,23 @@ func injectCommandsInNodesPass(tpl *Template, cenv env.Compiling) (*Template, en
 			return tpl, cenv, fmt.Errorf("command for '%s' is nil", key)
 		}
 		node.Command = cmd
+		node.Driver = wrapDriver(cmd, cenv)
 	}
 	return tpl, cenv, nil
 }
 
+// wrapDriver chains the command with the middlewares of the env, if any,
+// the dry run middleware being the innermost one
+func wrapDriver(cmd ast.Command, cenv env.Compiling) driver.Driver {
+	var mws []driver.Middleware
+	if m, ok := cenv.(interface {
+		Middlewares() []driver.Middleware
+	}); ok {
+		mws = append(mws, m.Middlewares()...)
+	}
+	return driver.Chain(cmd, append(mws, driver.DryRun)...)
+}
+
 func failOnDeclarationWithNoResultPass(tpl *Template, cenv env.Compiling) (*Template, env.Compiling, error) {
 	failOnDeclarationWithNoResult := func(node *ast.DeclarationNode) error {
 		cmdNode, ok := node.Expr.(*ast.CommandNode)
@@ -328,12 +359,26 @@ func removeOptionalHolesPass(tpl *Template, cenv env.Compiling) (*Template, env.
 
 func resolveAliasPass(tpl *Template, cenv env.Compiling) (*Template, env.Compiling, error) {
 	var emptyResolv []string
+	var queryErrs []string
 	resolvAliasFunc := func(action, entity string, key string) func(string) (string, bool) {
 		return func(alias string) (string, bool) {
+			normalized := fmt.Sprintf("%s.%s.%s", DefinitionAction(action), entity, key)
+			if query, isQuery := aliasQuery(alias); isQuery {
+				if cenv.AliasQueryFunc() == nil {
+					queryErrs = append(queryErrs, fmt.Sprintf("cannot resolve alias \"@%s\": no query resolver", alias))
+					return "", false
+				}
+				actual, err := cenv.AliasQueryFunc()(normalized, query)
+				if err != nil {
+					queryErrs = append(queryErrs, fmt.Sprintf("cannot resolve alias \"@%s\" for %s: %s", alias, key, err))
+					return "", false
+				}
+				cenv.Log().ExtraVerbosef("alias: resolved query '%s' to '%s' for key %s", query, actual, key)
+				return actual, true
+			}
 			if cenv.AliasFunc() == nil {
 				return "", false
 			}
-			normalized := fmt.Sprintf("%s.%s.%s", action, entity, key)
 			actual := cenv.AliasFunc()(normalized, alias)
 			if actual == "" {
 				emptyResolv = append(emptyResolv, alias)
@@ -347,6 +392,10 @@ func resolveAliasPass(tpl *Template, cenv env.Compiling) (*Template, env.Compili
 
 	ast.ProcessAliases(tpl.AST, resolvAliasFunc)
 
+	if len(queryErrs) > 0 {
+		return tpl, cenv, errors.New(strings.Join(queryErrs, "\n"))
+	}
+
 	switch len(emptyResolv) {
 	case 0:
 		break
@@ -360,6 +409,127 @@ func resolveAliasPass(tpl *Template, cenv env.Compiling) (*Template, env.Compili
 	return tpl, cenv, nil
 }
 
+// aliasQuery returns the query of an alias of the form {tag:Env=prod, public:false}
+func aliasQuery(alias string) (string, bool) {
+	if strings.HasPrefix(alias, "{") && strings.HasSuffix(alias, "}") {
+		return alias[1 : len(alias)-1], true
+	}
+	return "", false
+}
+
+// resolveFuncsPass resolves the template functions:
+// azs() to the list of available zones of the target region, az(n) to the n-th of them,
+// ssm(name) to a reference to a SSM parameter, read by the drivers at run time so that secrets are never displayed nor stored,
+// file(path) to the content of a local file (ex: a JSON document)
+func resolveFuncsPass(tpl *Template, cenv env.Compiling) (*Template, env.Compiling, error) {
+	var zones []string
+	availabilityZones := func() ([]string, error) {
+		if zones != nil {
+			return zones, nil
+		}
+		if cenv.AvailabilityZonesFunc() == nil {
+			return nil, errors.New("no availability zones resolver")
+		}
+		resolved, err := cenv.AvailabilityZonesFunc()()
+		if err != nil {
+			return nil, fmt.Errorf("cannot resolve availability zones: %s", err)
+		}
+		if len(resolved) == 0 {
+			return nil, errors.New("no available availability zone in region")
+		}
+		zones = resolved
+		cenv.Log().ExtraVerbosef("func: resolved availability zones %v", zones)
+		return zones, nil
+	}
+
+	resolvFunc := func(node ast.FuncNode) (interface{}, error) {
+		args := node.Args()
+		switch node.Name() {
+		case "azs":
+			if len(args) > 0 {
+				return nil, fmt.Errorf("%s: azs() expects no argument", node)
+			}
+			all, err := availabilityZones()
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", node, err)
+			}
+			var arr []interface{}
+			for _, zone := range all {
+				arr = append(arr, zone)
+			}
+			return ast.NewListNode(arr), nil
+		case "az":
+			if len(args) != 1 {
+				return nil, fmt.Errorf("%s: az() expects the index of the zone (ex: az(0))", node)
+			}
+			index, err := strconv.Atoi(args[0])
+			if err != nil {
+				return nil, fmt.Errorf("%s: invalid index: %s", node, err)
+			}
+			all, err := availabilityZones()
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", node, err)
+			}
+			if index >= len(all) {
+				return nil, fmt.Errorf("%s: index out of range, only %d availability zones in region: %v", node, len(all), all)
+			}
+			return all[index], nil
+		case "ssm":
+			if len(args) != 1 {
+				return nil, fmt.Errorf("%s: ssm() expects the name of the parameter (ex: ssm(/my-app/db-password))", node)
+			}
+			return params.SSMParameter(args[0]), nil
+		case "file":
+			if len(args) != 1 {
+				return nil, fmt.Errorf("%s: file() expects the path of the file (ex: file(./definition.json))", node)
+			}
+			content, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", node, err)
+			}
+			cenv.Log().ExtraVerbosef("func: read %d bytes from file %s", len(content), args[0])
+			return params.FileContent(content), nil
+		default:
+			return nil, fmt.Errorf("unknown function '%s'", node.Name())
+		}
+	}
+
+	return tpl, cenv, ast.ProcessFuncs(tpl.AST, resolvFunc)
+}
+
+var stackRefRegex = regexp.MustCompile(`^sg:([^/]+)/(.+)$`)
+
+// resolveStackRefsPass resolves the params referencing a security group of another
+// stack (ex: source=sg:other-stack/web) to the id of this security group
+func resolveStackRefsPass(tpl *Template, cenv env.Compiling) (*Template, env.Compiling, error) {
+	for _, node := range tpl.CommandNodesIterator() {
+		for key, param := range node.ParamNodes {
+			var value interface{} = param
+			if n, ok := param.(ast.InterfaceNode); ok {
+				value = n.Value()
+			}
+			str, ok := value.(string)
+			if !ok {
+				continue
+			}
+			matches := stackRefRegex.FindStringSubmatch(str)
+			if matches == nil {
+				continue
+			}
+			if cenv.StackRefFunc() == nil {
+				return tpl, cenv, cmdErr(node, "cannot resolve '%s' for %s: no stack resolver", str, key)
+			}
+			id, err := cenv.StackRefFunc()(matches[1], matches[2])
+			if err != nil {
+				return tpl, cenv, cmdErr(node, "cannot resolve '%s' for %s: %s", str, key, err)
+			}
+			cenv.Log().ExtraVerbosef("stack: resolved '%s' to '%s' for key %s", str, id, key)
+			node.ParamNodes[key] = id
+		}
+	}
+	return tpl, cenv, nil
+}
+
 func failOnUnresolvedHolesPass(tpl *Template, cenv env.Compiling) (*Template, env.Compiling, error) {
 	uniqueUnresolved := make(map[string]struct{})
 	for _, hole := range ast.CollectHoles(tpl.AST) {
@@ -403,6 +573,9 @@ func cmdErr(cmd *ast.CommandNode, i interface{}, a ...interface{}) error {
 	var prefix string
 	if cmd != nil {
 		prefix = fmt.Sprintf("%s %s: ", cmd.Action, cmd.Entity)
+		if cmd.Origin.IsTraceable() {
+			prefix = fmt.Sprintf("%s: %s", cmd.Origin, prefix)
+		}
 	}
 	var msg string
 	switch ii := i.(type) {
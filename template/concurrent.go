@@ -0,0 +1,118 @@
+package template
+
+import (
+	"sync"
+
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// independentDeletes detects, at the start of the given statements, a run of deletes
+// of the same entity (along with their checks) acting on distinct resources,
+// as found in teardown templates. It returns the positions of the commands grouped
+// per resource, in order of appearance, and the number of statements of the run.
+func independentDeletes(stmts []*ast.Statement) (groups [][]int, count int) {
+	var entity string
+	var hasDelete bool
+	groupIndex := make(map[string]int)
+	for i, sts := range stmts {
+		n, ok := sts.Node.(*ast.CommandNode)
+		if !ok || (n.Action != "delete" && n.Action != "check") {
+			break
+		}
+		if entity == "" {
+			entity = n.Entity
+		}
+		key, ok := resourceKey(n)
+		if n.Entity != entity || !ok {
+			break
+		}
+		if n.Action == "delete" {
+			hasDelete = true
+		}
+		k, exists := groupIndex[key]
+		if !exists {
+			k = len(groups)
+			groupIndex[key] = k
+			groups = append(groups, nil)
+		}
+		groups[k] = append(groups[k], i)
+		count++
+	}
+	if !hasDelete {
+		return nil, 0
+	}
+	return groups, count
+}
+
+func resourceKey(n *ast.CommandNode) (string, bool) {
+	for _, param := range []string{"id", "name"} {
+		if _, isRef := n.Refs[param]; isRef {
+			return "", false
+		}
+		if v, ok := n.ParamNodes[param].(string); ok {
+			return param + "=" + v, true
+		}
+	}
+	return "", false
+}
+
+// processStatementsConcurrently runs the commands of each group sequentially until one fails,
+// with at most renv.Parallelism() groups running at a time. It returns the processed statements,
+// in template order, and whether the template execution must stop. No group is started once
+// one failed. Once the run is cancelled, the remaining commands are not started and reported
+// as skipped. The first statement is at index first of a template of total statements.
+func processStatementsConcurrently(renv env.Running, stmts []*ast.Statement, groups [][]int, vars map[string]interface{}, first, total int) (processed []*ast.Statement, stop bool) {
+	clones := make([]*ast.Statement, len(stmts))
+	for j, sts := range stmts {
+		clones[j] = sts.Clone()
+		clones[j].Node.(*ast.CommandNode).ProcessRefs(vars)
+	}
+
+	ran := make([]bool, len(stmts))
+	sem := make(chan struct{}, renv.Parallelism())
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, group := range groups {
+		sem <- struct{}{}
+		mu.Lock()
+		failed := stop
+		mu.Unlock()
+		if failed {
+			<-sem
+			break
+		}
+		wg.Add(1)
+		go func(group []int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			for _, j := range group {
+				if renv.Ctx().Err() != nil {
+					mu.Lock()
+					stop = true
+					mu.Unlock()
+					return
+				}
+				ran[j] = true
+				if processCmdNode(renv, clones[j].Node.(*ast.CommandNode), first+j, total) {
+					mu.Lock()
+					stop = true
+					mu.Unlock()
+					return
+				}
+			}
+		}(group)
+	}
+	wg.Wait()
+
+	for j, clone := range clones {
+		if ran[j] {
+			processed = append(processed, clone)
+		} else if err := renv.Ctx().Err(); err != nil {
+			processed = append(processed, skipStatement(clone, err))
+		}
+	}
+	return
+}
@@ -0,0 +1,160 @@
+package template
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+func TestIndependentDeletes(t *testing.T) {
+	tcases := []struct {
+		tpl       string
+		expGroups [][]int
+		expCount  int
+	}{
+		{tpl: "delete snapshot id=snap-1\ndelete snapshot id=snap-2\ndelete snapshot id=snap-3", expGroups: [][]int{{0}, {1}, {2}}, expCount: 3},
+		{tpl: "delete instance id=i-1\ncheck instance id=i-1 state=terminated timeout=180\ndelete instance id=i-2\ncheck instance id=i-2 state=terminated timeout=180\ndelete subnet id=sub-1",
+			expGroups: [][]int{{0, 1}, {2, 3}}, expCount: 4},
+		{tpl: "check securitygroup id=sg-1 state=unused timeout=300\ndelete securitygroup id=sg-1\ncheck securitygroup id=sg-2 state=unused timeout=300\ndelete securitygroup id=sg-2",
+			expGroups: [][]int{{0, 1}, {2, 3}}, expCount: 4},
+		{tpl: "delete scalinggroup name=asg-1\ndelete scalinggroup name=asg-2", expGroups: [][]int{{0}, {1}}, expCount: 2},
+		{tpl: "delete snapshot id=snap-1\nsnap = create snapshot volume=vol-1\ndelete snapshot id=snap-2", expGroups: [][]int{{0}}, expCount: 1},
+		{tpl: "delete snapshot id=$snap\ndelete snapshot id=snap-2"},
+		{tpl: "delete instance ids=[i-1,i-2]\ndelete instance id=i-3"},
+		{tpl: "check instance id=i-1 state=running timeout=180\ncheck instance id=i-2 state=running timeout=180"},
+		{tpl: "create vpc cidr=10.0.0.0/16\ndelete vpc id=vpc-1"},
+	}
+	for i, tcase := range tcases {
+		compiled, _, err := resolveParamsAndExtractRefsPass(MustParse(tcase.tpl), NewEnv().Build())
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		groups, count := independentDeletes(compiled.Statements)
+		if got, want := groups, tcase.expGroups; !reflect.DeepEqual(got, want) {
+			t.Fatalf("%d: got %v, want %v", i+1, got, want)
+		}
+		if got, want := count, tcase.expCount; got != want {
+			t.Fatalf("%d: got %d, want %d", i+1, got, want)
+		}
+	}
+}
+
+func TestRunIndependentDeletesConcurrently(t *testing.T) {
+	tcases := []struct {
+		tpl            string
+		parallelism    int
+		failing        string
+		failFast       bool
+		expConcurrency int
+		expRan         []string
+	}{
+		{
+			tpl:            "delete snapshot id=snap-1\ndelete snapshot id=snap-2\ndelete snapshot id=snap-3\ndelete snapshot id=snap-4\ndelete volume id=vol-1",
+			parallelism:    2,
+			expConcurrency: 2,
+			expRan:         []string{"snapshot snap-1", "snapshot snap-2", "snapshot snap-3", "snapshot snap-4", "volume vol-1"},
+		},
+		{
+			tpl:            "delete snapshot id=snap-1\ndelete snapshot id=snap-2\ndelete snapshot id=snap-3",
+			parallelism:    1,
+			expConcurrency: 1,
+			expRan:         []string{"snapshot snap-1", "snapshot snap-2", "snapshot snap-3"},
+		},
+		{
+			tpl:            "delete instance id=i-1\ncheck instance id=i-1 state=terminated timeout=180\ndelete instance id=i-2\ncheck instance id=i-2 state=terminated timeout=180\ndelete subnet id=sub-1",
+			parallelism:    10,
+			failing:        "i-2",
+			expConcurrency: 2,
+			expRan:         []string{"instance i-1", "instance i-1", "instance i-2"},
+		},
+		{
+			tpl:            "delete snapshot id=snap-1\ndelete snapshot id=snap-2\ndelete snapshot id=snap-3\ndelete snapshot id=snap-4",
+			parallelism:    2,
+			failing:        "snap-1",
+			failFast:       true,
+			expConcurrency: 2,
+			expRan:         []string{"snapshot snap-1", "snapshot snap-2"},
+		},
+	}
+
+	for i, tcase := range tcases {
+		recorder := &concurrencyRecorder{failing: tcase.failing, failFast: tcase.failFast}
+		cenv := NewEnv().WithLookupCommandFunc(func(tokens ...string) interface{} {
+			entity := strings.TrimPrefix(strings.TrimPrefix(tokens[0], "delete"), "check")
+			return &mockRecordedCommand{entity: entity, recorder: recorder}
+		}).WithParallelism(tcase.parallelism).Build()
+
+		pass := newMultiPass(injectCommandsInNodesPass, resolveParamsAndExtractRefsPass)
+		compiled, cenv, err := pass.compile(MustParse(tcase.tpl), cenv)
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		ran, err := compiled.Run(NewRunEnv(cenv))
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if got, want := recorder.max, tcase.expConcurrency; got != want {
+			t.Fatalf("%d: max concurrency: got %d, want %d", i+1, got, want)
+		}
+		var ranCmds []string
+		for _, cmd := range ran.CommandNodesIterator() {
+			ranCmds = append(ranCmds, cmd.Entity+" "+cmd.ParamNodes["id"].(string))
+		}
+		if got, want := ranCmds, tcase.expRan; !reflect.DeepEqual(got, want) {
+			t.Fatalf("%d: got %v, want %v", i+1, got, want)
+		}
+		if got, want := len(recorder.ran), len(tcase.expRan); got != want {
+			t.Fatalf("%d: ran %d commands, want %d", i+1, got, want)
+		}
+		if tcase.failing == "" {
+			if got, want := recorder.ran[len(recorder.ran)-1], tcase.expRan[len(tcase.expRan)-1]; got != want {
+				t.Fatalf("%d: dependent delete should run last: got %s, want %s", i+1, got, want)
+			}
+		}
+	}
+}
+
+type concurrencyRecorder struct {
+	mu       sync.Mutex
+	current  int
+	max      int
+	ran      []string
+	failing  string
+	failFast bool
+}
+
+type mockRecordedCommand struct {
+	entity   string
+	recorder *concurrencyRecorder
+}
+
+func (c *mockRecordedCommand) ParamsSpec() params.Spec { return params.NewSpec(nil) }
+func (c *mockRecordedCommand) Run(renv env.Running, p map[string]interface{}) (interface{}, error) {
+	r := c.recorder
+	id := p["id"].(string)
+	r.mu.Lock()
+	r.current++
+	if r.current > r.max {
+		r.max = r.current
+	}
+	r.ran = append(r.ran, strings.Join([]string{c.entity, id}, " "))
+	r.mu.Unlock()
+
+	if id != r.failing || !r.failFast {
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	r.mu.Lock()
+	r.current--
+	r.mu.Unlock()
+	if id == r.failing {
+		return nil, errors.New("cannot delete")
+	}
+	return id, nil
+}
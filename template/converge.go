@@ -0,0 +1,278 @@
+package template
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/template/internal/ast"
+	"github.com/wallix/awless/template/params"
+)
+
+// Convergence is the result of the comparison of a compiled template with the live resources
+// of a stack: the incremental statements to run for the stack to reach the state the template declares
+type Convergence struct {
+	// Template creates the missing resources, updates the changed ones then, when pruned,
+	// deletes the ones the template does not declare anymore. It is to be compiled before run
+	Template                             *Template
+	Unchanged, Created, Updated, Deleted int
+	// Warnings lists the changes that cannot be made in place
+	Warnings []string
+}
+
+func (c *Convergence) IsEmpty() bool {
+	return len(c.Template.Statements) == 0
+}
+
+func (c *Convergence) String() string {
+	return fmt.Sprintf("%d to create, %d to update, %d to delete, %d unchanged", c.Created, c.Updated, c.Deleted, c.Unchanged)
+}
+
+// stackResource is a resource created by a successful command of a stack
+type stackResource struct {
+	cmd     *ast.CommandNode
+	params  map[string]string
+	result  string
+	matched bool
+}
+
+// Converge compares the compiled template with what the live executions of the stack created and ran.
+// A create command of the template matches the resource created in the stack with the same entity
+// and name param or, without name, with the same params: unchanged resources are not created again,
+// the references to them being replaced by their ids, and the params changed on named resources
+// are updated in place when the update command of their entity (found with lookup) supports them.
+// Other commands are skipped when they already succeeded in the stack with the same params.
+// With prune, the resources of the stack matched by no create command are deleted
+func (s *Stack) Converge(tpl *Template, lookup func(...string) interface{}, prune bool) (*Convergence, error) {
+	conv := &Convergence{}
+	var resources []*stackResource
+	done := make(map[string]bool)
+	for _, exec := range s.Executions {
+		if exec.Template == nil {
+			continue
+		}
+		for _, cmd := range exec.CommandNodesIterator() {
+			if cmd.CmdErr != nil {
+				continue
+			}
+			recorded := recordedParams(cmd)
+			done[commandLine(cmd.Action, cmd.Entity, recorded)] = true
+			switch cmd.Action {
+			case "create":
+				if result, ok := cmd.CmdResult.(string); ok && result != "" {
+					resources = append(resources, &stackResource{cmd: cmd, params: recorded, result: result})
+				}
+			case "delete":
+				for _, res := range resources {
+					if res.cmd.Entity == cmd.Entity && (recorded["id"] == res.result || recorded["name"] == res.result) {
+						res.matched = true // deleted: neither matchable nor to prune
+					}
+				}
+			}
+		}
+	}
+
+	vars := make(map[string]string)
+	var lines []string
+	for _, sts := range tpl.Statements {
+		var ident string
+		var cmd *ast.CommandNode
+		switch n := sts.Node.(type) {
+		case *ast.CommandNode:
+			cmd = n
+		case *ast.DeclarationNode:
+			c, ok := n.Expr.(*ast.CommandNode)
+			if !ok {
+				return nil, fmt.Errorf("converge: unexpected declaration of %T", n.Expr)
+			}
+			ident, cmd = n.Ident, c
+		default:
+			return nil, fmt.Errorf("converge: unexpected node %T", sts.Node)
+		}
+
+		current, resolved := convergedParams(cmd, vars)
+		if cmd.Action == "create" && resolved {
+			if res := matchStackResource(resources, cmd.Entity, current); res != nil {
+				res.matched = true
+				vars[ident] = res.result
+				update, warnings := updateLine(res, current, lookup)
+				conv.Warnings = append(conv.Warnings, warnings...)
+				if update != "" {
+					lines = append(lines, update)
+					conv.Updated++
+				} else {
+					conv.Unchanged++
+				}
+				continue
+			}
+		}
+		if cmd.Action != "create" && resolved && done[commandLine(cmd.Action, cmd.Entity, current)] {
+			continue
+		}
+
+		line := commandLine(cmd.Action, cmd.Entity, current)
+		if ident != "" {
+			line = fmt.Sprintf("%s = %s", ident, line)
+		}
+		lines = append(lines, line)
+		if cmd.Action == "create" {
+			conv.Created++
+		}
+	}
+
+	if prune {
+		removed := &Template{AST: &ast.AST{}}
+		for _, res := range resources {
+			if !res.matched {
+				removed.Statements = append(removed.Statements, &ast.Statement{Node: res.cmd})
+				conv.Deleted++
+			}
+		}
+		if IsRevertible(removed) {
+			deletes, err := removed.Revert()
+			if err != nil {
+				return nil, fmt.Errorf("stack %s: %s", s.Name, err)
+			}
+			for _, cmd := range deletes.CommandNodesIterator() {
+				lines = append(lines, cmd.String())
+			}
+		}
+	}
+
+	conv.Template = &Template{ID: tpl.ID, AST: &ast.AST{}}
+	if len(lines) > 0 {
+		converged, err := Parse(strings.Join(lines, "\n"))
+		if err != nil {
+			return nil, fmt.Errorf("converge: %s", err)
+		}
+		converged.ID = tpl.ID
+		conv.Template = converged
+	}
+	return conv, nil
+}
+
+func matchStackResource(resources []*stackResource, entity string, current map[string]string) *stackResource {
+	name, named := current["name"]
+	for _, res := range resources {
+		if res.matched || res.cmd.Entity != entity {
+			continue
+		}
+		if named && res.params["name"] == name {
+			return res
+		}
+		if !named && commandLine("", "", res.params) == commandLine("", "", current) {
+			return res
+		}
+	}
+	return nil
+}
+
+// updateLine returns the update command of the params of the resource changed in the template,
+// with warnings for the ones that cannot be updated in place
+func updateLine(res *stackResource, current map[string]string, lookup func(...string) interface{}) (string, []string) {
+	var changed []string
+	for k, v := range current {
+		if res.params[k] != v {
+			changed = append(changed, k)
+		}
+	}
+	if len(changed) == 0 {
+		return "", nil
+	}
+	sort.Strings(changed)
+
+	var updatable []string
+	if cmd, ok := lookup(fmt.Sprintf("update%s", res.cmd.Entity)).(ast.Command); ok && cmd != nil && cmd.ParamsSpec() != nil && cmd.ParamsSpec().Rule() != nil {
+		required, optionals, _ := params.List(cmd.ParamsSpec().Rule())
+		updatable = append(required, optionals...)
+	}
+	var identifier string
+	switch {
+	case contains(updatable, "id"):
+		identifier = "id"
+	case contains(updatable, "name"):
+		identifier = "name"
+	}
+
+	update := map[string]string{identifier: quoteParamIfNeeded(res.result)}
+	var warnings []string
+	for _, k := range changed {
+		if identifier == "" || k == identifier || !contains(updatable, k) {
+			warnings = append(warnings, fmt.Sprintf("%s %s: %s cannot be updated in place (%s → %s)", res.cmd.Entity, res.result, k, res.params[k], current[k]))
+			continue
+		}
+		update[k] = current[k]
+	}
+	if len(update) == 1 {
+		return "", warnings
+	}
+	return commandLine("update", res.cmd.Entity, update), warnings
+}
+
+// recordedParams returns the printed params of a command run in a stack,
+// the references of its params having been replaced by their values when run
+func recordedParams(cmd *ast.CommandNode) map[string]string {
+	out := make(map[string]string)
+	for k, v := range cmd.ParamNodes {
+		out[k], _ = printParam(v, nil)
+	}
+	return out
+}
+
+// convergedParams returns the printed params of a compiled command, its references being
+// replaced by the ids of the stack resources they point to. It is not resolved when some
+// reference points to a resource that does not exist yet
+func convergedParams(cmd *ast.CommandNode, vars map[string]string) (map[string]string, bool) {
+	out := make(map[string]string)
+	resolved := true
+	for _, nodes := range []map[string]interface{}{cmd.ParamNodes, cmd.Refs} {
+		for k, v := range nodes {
+			printed, ok := printParam(v, vars)
+			out[k] = printed
+			resolved = resolved && ok
+		}
+	}
+	return out, resolved
+}
+
+// printParam prints the value of a param, its references being replaced by their value in vars.
+// It returns false when some reference has no value
+func printParam(v interface{}, vars map[string]string) (string, bool) {
+	switch vv := v.(type) {
+	case ast.RefNode:
+		if id, ok := vars[vv.Ref()]; ok && id != "" {
+			return quoteParamIfNeeded(id), true
+		}
+		return vv.String(), false
+	case ast.InterfaceNode:
+		return printParam(vv.Value(), vars)
+	case ast.ListNode:
+		return printParam(vv.Elems(), vars)
+	case []interface{}:
+		resolved := true
+		var elems []string
+		for _, e := range vv {
+			printed, ok := printParam(e, vars)
+			elems = append(elems, printed)
+			resolved = resolved && ok
+		}
+		return "[" + strings.Join(elems, ",") + "]", resolved
+	default:
+		return printItem(vv), true
+	}
+}
+
+func commandLine(action, entity string, params map[string]string) string {
+	var keys []string
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var buff bytes.Buffer
+	fmt.Fprintf(&buff, "%s %s", action, entity)
+	for _, k := range keys {
+		fmt.Fprintf(&buff, " %s=%s", k, params[k])
+	}
+	return buff.String()
+}
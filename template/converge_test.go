@@ -0,0 +1,106 @@
+package template
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+type mockUpdateCommand struct {
+	opts []interface{}
+}
+
+func (c *mockUpdateCommand) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"), params.Opt(c.opts...)))
+}
+func (c *mockUpdateCommand) Run(env.Running, map[string]interface{}) (interface{}, error) {
+	return nil, nil
+}
+
+func TestStackConverge(t *testing.T) {
+	newExec := func(id, text string, results ...string) *TemplateExecution {
+		tpl := MustParse(text)
+		tpl.ID = id
+		for i, cmd := range tpl.CommandNodesIterator() {
+			if i < len(results) {
+				cmd.CmdResult = results[i]
+			}
+		}
+		return &TemplateExecution{Template: tpl, Stack: "test", Locale: "eu-west-1", Profile: "dev"}
+	}
+	stack := &Stack{Name: "test", Executions: []*TemplateExecution{
+		newExec("01BTT1AA3N36VCKSNKAKN4WX2A", "create vpc cidr=10.0.0.0/16\ncreate subnet cidr=10.0.0.0/24 vpc=vpc-1\ncreate instance name=web subnet=sub-1 type=t2.micro\ncreate keypair name=mykey\nattach policy arn=arn:readonly user=jdoe",
+			"vpc-1", "sub-1", "i-1", "mykey"),
+	}}
+	lookup := func(tokens ...string) interface{} {
+		if tokens[0] == "updateinstance" {
+			return &mockUpdateCommand{opts: []interface{}{"type"}}
+		}
+		return nil
+	}
+
+	tcases := []struct {
+		template          string
+		prune             bool
+		expect            string
+		expectConvergence string
+		expectWarnings    []string
+	}{
+		{
+			template:          "vpc = create vpc cidr=10.0.0.0/16\nsub = create subnet cidr=10.0.0.0/24 vpc=$vpc\ncreate instance name=web subnet=$sub type=t2.micro\ncreate keypair name=mykey\nattach policy arn=arn:readonly user=jdoe",
+			expect:            "",
+			expectConvergence: "0 to create, 0 to update, 0 to delete, 4 unchanged",
+		},
+		{
+			template:          "vpc = create vpc cidr=10.0.0.0/16\nsub = create subnet cidr=10.0.0.0/24 vpc=$vpc\nsub2 = create subnet cidr=10.0.1.0/24 vpc=$vpc\ncreate instance name=web subnet=$sub type=t2.small\ncreate instance name=db subnet=$sub2 type=t2.micro\ncreate keypair name=mykey\nattach policy arn=arn:readonly user=jdoe\nattach policy arn=arn:admin user=jdoe",
+			expect:            "sub2 = create subnet cidr=10.0.1.0/24 vpc=vpc-1\nupdate instance id=i-1 type=t2.small\ncreate instance name=db subnet=$sub2 type=t2.micro\nattach policy arn=arn:admin user=jdoe",
+			expectConvergence: "2 to create, 1 to update, 0 to delete, 3 unchanged",
+		},
+		{
+			template:          "vpc = create vpc cidr=10.0.0.0/16\nsub = create subnet cidr=10.0.0.0/24 vpc=$vpc\ncreate instance name=web subnet=$sub type=t2.micro image=ami-2",
+			prune:             true,
+			expect:            "delete keypair name=mykey",
+			expectConvergence: "0 to create, 0 to update, 1 to delete, 3 unchanged",
+			expectWarnings:    []string{"instance i-1: image cannot be updated in place ( → ami-2)"},
+		},
+		{
+			template:          "vpc = create vpc cidr=10.1.0.0/16\ncreate subnet cidr=10.1.0.0/24 vpc=$vpc",
+			prune:             true,
+			expect:            "vpc = create vpc cidr=10.1.0.0/16\ncreate subnet cidr=10.1.0.0/24 vpc=$vpc\ndelete keypair name=mykey\ndelete instance id=i-1\ncheck instance id=i-1 state=terminated timeout=180\ndelete subnet id=sub-1\ndelete vpc id=vpc-1",
+			expectConvergence: "2 to create, 0 to update, 4 to delete, 0 unchanged",
+		},
+	}
+	for i, tcase := range tcases {
+		conv, err := stack.Converge(MustParse(tcase.template), lookup, tcase.prune)
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if got, want := conv.Template.String(), tcase.expect; got != want {
+			t.Fatalf("%d: got\n%s\nwant\n%s", i+1, got, want)
+		}
+		if got, want := conv.String(), tcase.expectConvergence; got != want {
+			t.Fatalf("%d: got %s, want %s", i+1, got, want)
+		}
+		if got, want := conv.IsEmpty(), tcase.expect == ""; got != want {
+			t.Fatalf("%d: got %t, want %t", i+1, got, want)
+		}
+		if got, want := conv.Warnings, tcase.expectWarnings; !reflect.DeepEqual(got, want) {
+			t.Fatalf("%d: got %q, want %q", i+1, got, want)
+		}
+	}
+
+	deleted := newExec("01BTT1AA3N36VCKSNKAKN4WX2B", "delete keypair name=mykey")
+	stack.Executions = append(stack.Executions, deleted)
+	conv, err := stack.Converge(MustParse("create vpc cidr=10.0.0.0/16"), lookup, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := conv.String(), "0 to create, 0 to update, 2 to delete, 1 unchanged"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := conv.Template.String(), "delete instance id=i-1\ncheck instance id=i-1 state=terminated timeout=180\ndelete subnet id=sub-1"; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+}
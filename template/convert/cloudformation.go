@@ -0,0 +1,229 @@
+// Package convert translates awless templates to other infrastructure as code formats
+package convert
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/internal/ast"
+	"gopkg.in/yaml.v2"
+)
+
+const cloudFormationVersion = "2010-09-09"
+
+// CloudFormationTemplate is a CloudFormation template, marshallable to JSON or YAML
+type CloudFormationTemplate struct {
+	AWSTemplateFormatVersion string                              `json:"AWSTemplateFormatVersion" yaml:"AWSTemplateFormatVersion"`
+	Description              string                              `json:"Description,omitempty" yaml:"Description,omitempty"`
+	Parameters               map[string]*CloudFormationParameter `json:"Parameters,omitempty" yaml:"Parameters,omitempty"`
+	Resources                map[string]*CloudFormationResource  `json:"Resources" yaml:"Resources"`
+}
+
+type CloudFormationParameter struct {
+	Type        string `json:"Type" yaml:"Type"`
+	Description string `json:"Description,omitempty" yaml:"Description,omitempty"`
+}
+
+type CloudFormationResource struct {
+	Type       string                 `json:"Type" yaml:"Type"`
+	Properties map[string]interface{} `json:"Properties,omitempty" yaml:"Properties,omitempty"`
+}
+
+func (t *CloudFormationTemplate) JSON() ([]byte, error) {
+	return json.MarshalIndent(t, "", "  ")
+}
+
+func (t *CloudFormationTemplate) YAML() ([]byte, error) {
+	return yaml.Marshal(t)
+}
+
+// ToCloudFormation converts a parsed awless template to a CloudFormation template.
+// Each command creating a resource becomes a logical resource, named after the variable
+// it is assigned to. References to those variables become Ref or Fn::GetAtt intrinsic functions,
+// holes and aliases become template parameters. Only a subset of the commands can be
+// converted: an error is returned for the first command (or param) that cannot.
+func ToCloudFormation(tpl *template.Template) (*CloudFormationTemplate, error) {
+	c := &cfConverter{
+		out: &CloudFormationTemplate{
+			AWSTemplateFormatVersion: cloudFormationVersion,
+			Description:              "Converted from awless template",
+			Parameters:               make(map[string]*CloudFormationParameter),
+			Resources:                make(map[string]*CloudFormationResource),
+		},
+		declared:  make(map[string]*declared),
+		logicalID: make(map[string]bool),
+	}
+
+	// declared commands are named first for the logical ids generated for the others not to shadow them
+	for _, st := range tpl.Statements {
+		if decl, ok := st.Node.(*ast.DeclarationNode); ok {
+			if cmd, isCmd := decl.Expr.(*ast.CommandNode); isCmd {
+				def, err := definitionOf(cmd)
+				if err != nil {
+					return nil, err
+				}
+				c.declared[decl.Ident] = &declared{logicalID: c.newLogicalID(toLogicalID(decl.Ident)), def: def}
+			}
+		}
+	}
+
+	for _, st := range tpl.Statements {
+		switch n := st.Node.(type) {
+		case *ast.DeclarationNode:
+			switch expr := n.Expr.(type) {
+			case *ast.CommandNode:
+				res, err := c.convert(expr)
+				if err != nil {
+					return nil, err
+				}
+				c.out.Resources[c.declared[n.Ident].logicalID] = res
+			case *ast.RightExpressionNode:
+				c.declared[n.Ident] = &declared{value: expr.Node()}
+			}
+		case *ast.CommandNode:
+			res, err := c.convert(n)
+			if err != nil {
+				return nil, err
+			}
+			c.out.Resources[c.newLogicalID(res.Type[strings.LastIndex(res.Type, ":")+1:])] = res
+		}
+	}
+
+	return c.out, nil
+}
+
+type declared struct {
+	logicalID string
+	def       *cfDefinition
+	value     interface{}
+}
+
+type cfConverter struct {
+	out       *CloudFormationTemplate
+	declared  map[string]*declared
+	logicalID map[string]bool
+}
+
+func (c *cfConverter) convert(cmd *ast.CommandNode) (*CloudFormationResource, error) {
+	def, err := definitionOf(cmd)
+	if err != nil {
+		return nil, err
+	}
+	params := make(map[string]interface{})
+	for k, node := range cmd.ParamNodes {
+		v, err := c.value(node)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %s: %s", cmd.Action, cmd.Entity, k, err)
+		}
+		params[k] = v
+	}
+	for k, node := range cmd.Refs {
+		v, err := c.value(node)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %s: %s", cmd.Action, cmd.Entity, k, err)
+		}
+		params[k] = v
+	}
+
+	res, err := def.build(params)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %s", cmd.Action, cmd.Entity, err)
+	}
+	return res, nil
+}
+
+func (c *cfConverter) value(node interface{}) (interface{}, error) {
+	switch n := node.(type) {
+	case ast.InterfaceNode:
+		return n.Value(), nil
+	case ast.HoleNode:
+		return c.parameter(n.Hole(), fmt.Sprintf("Value of {%s}", n.Hole())), nil
+	case ast.AliasNode:
+		return c.parameter(n.Alias(), fmt.Sprintf("Existing resource referenced as @%s", n.Alias())), nil
+	case ast.RefNode:
+		decl, ok := c.declared[n.Ref()]
+		if !ok {
+			return nil, fmt.Errorf("undefined reference $%s", n.Ref())
+		}
+		if decl.def == nil {
+			return c.value(decl.value)
+		}
+		if decl.def.resultAttr != "" {
+			return map[string]interface{}{"Fn::GetAtt": []string{decl.logicalID, decl.def.resultAttr}}, nil
+		}
+		return map[string]interface{}{"Ref": decl.logicalID}, nil
+	case ast.ListNode:
+		var list []interface{}
+		for _, e := range n.Elems() {
+			v, err := c.value(e)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, v)
+		}
+		return list, nil
+	case ast.ConcatenationNode:
+		var parts []interface{}
+		for _, e := range n.Elems() {
+			v, err := c.value(e)
+			if err != nil {
+				return nil, err
+			}
+			parts = append(parts, v)
+		}
+		return map[string]interface{}{"Fn::Join": []interface{}{"", parts}}, nil
+	case ast.FuncNode:
+		return nil, fmt.Errorf("function %s() cannot be converted", n.Name())
+	default:
+		return n, nil
+	}
+}
+
+func (c *cfConverter) parameter(key, description string) interface{} {
+	name := toLogicalID(key)
+	if _, ok := c.out.Parameters[name]; !ok {
+		c.out.Parameters[name] = &CloudFormationParameter{Type: "String", Description: description}
+	}
+	return map[string]interface{}{"Ref": name}
+}
+
+func (c *cfConverter) newLogicalID(prefix string) string {
+	id := prefix
+	for i := 1; c.logicalID[id]; i++ {
+		id = fmt.Sprintf("%s%d", prefix, i)
+	}
+	c.logicalID[id] = true
+	return id
+}
+
+// toLogicalID turns an awless identifier (ex: my-vpc, vpc.cidr) into an alphanumeric CloudFormation one (ex: MyVpc, VpcCidr)
+func toLogicalID(s string) string {
+	var id []rune
+	upper := true
+	for _, r := range s {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			upper = true
+		case upper:
+			id = append(id, unicode.ToUpper(r))
+			upper = false
+		default:
+			id = append(id, r)
+		}
+	}
+	return string(id)
+}
+
+var errNotLiteral = errors.New("expecting a literal value")
+
+func literal(v interface{}) (string, error) {
+	switch v.(type) {
+	case map[string]interface{}, []interface{}:
+		return "", errNotLiteral
+	}
+	return strings.TrimSpace(fmt.Sprint(v)), nil
+}
@@ -0,0 +1,141 @@
+package convert
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/template"
+	"gopkg.in/yaml.v2"
+)
+
+func TestToCloudFormation(t *testing.T) {
+	tpl := template.MustParse(`prefix = {env.name}
+vpc = create vpc cidr=10.0.0.0/16 name=$prefix
+my-subnet = create subnet vpc=$vpc cidr=10.0.1.0/24 availabilityzone=eu-west-1a public=true
+gateway = create internetgateway
+attach internetgateway id=$gateway vpc=$vpc
+table = create routetable vpc=$vpc
+attach routetable id=$table subnet=$my-subnet
+create route table=$table cidr=0.0.0.0/0 gateway=$gateway
+sg = create securitygroup vpc=$vpc description=web name={env.name}-web
+update securitygroup id=$sg inbound=authorize protocol=tcp cidr=0.0.0.0/0 portrange=80-81
+update securitygroup id=$sg outbound=authorize protocol=any cidr=0.0.0.0/0
+ip = create elasticip domain=vpc
+create natgateway elasticip-id=$ip subnet=$my-subnet
+create instance image=ami-123 type=t2.micro subnet=$my-subnet securitygroup=$sg keypair=@mykey count=1 name=web`)
+
+	cf, err := ToCloudFormation(tpl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := `{
+  "AWSTemplateFormatVersion": "2010-09-09",
+  "Description": "Converted from awless template",
+  "Parameters": {
+    "EnvName": {"Type": "String", "Description": "Value of {env.name}"},
+    "Mykey": {"Type": "String", "Description": "Existing resource referenced as @mykey"}
+  },
+  "Resources": {
+    "Vpc": {"Type": "AWS::EC2::VPC", "Properties": {"CidrBlock": "10.0.0.0/16", "Tags": [{"Key": "Name", "Value": {"Ref": "EnvName"}}]}},
+    "MySubnet": {"Type": "AWS::EC2::Subnet", "Properties": {"VpcId": {"Ref": "Vpc"}, "CidrBlock": "10.0.1.0/24", "AvailabilityZone": "eu-west-1a", "MapPublicIpOnLaunch": "true"}},
+    "Gateway": {"Type": "AWS::EC2::InternetGateway"},
+    "VPCGatewayAttachment": {"Type": "AWS::EC2::VPCGatewayAttachment", "Properties": {"InternetGatewayId": {"Ref": "Gateway"}, "VpcId": {"Ref": "Vpc"}}},
+    "Table": {"Type": "AWS::EC2::RouteTable", "Properties": {"VpcId": {"Ref": "Vpc"}}},
+    "SubnetRouteTableAssociation": {"Type": "AWS::EC2::SubnetRouteTableAssociation", "Properties": {"RouteTableId": {"Ref": "Table"}, "SubnetId": {"Ref": "MySubnet"}}},
+    "Route": {"Type": "AWS::EC2::Route", "Properties": {"RouteTableId": {"Ref": "Table"}, "DestinationCidrBlock": "0.0.0.0/0", "GatewayId": {"Ref": "Gateway"}}},
+    "Sg": {"Type": "AWS::EC2::SecurityGroup", "Properties": {"VpcId": {"Ref": "Vpc"}, "GroupDescription": "web", "GroupName": {"Fn::Join": ["", [{"Ref": "EnvName"}, "-web"]]}}},
+    "SecurityGroupIngress": {"Type": "AWS::EC2::SecurityGroupIngress", "Properties": {"GroupId": {"Fn::GetAtt": ["Sg", "GroupId"]}, "IpProtocol": "tcp", "CidrIp": "0.0.0.0/0", "FromPort": 80, "ToPort": 81}},
+    "SecurityGroupEgress": {"Type": "AWS::EC2::SecurityGroupEgress", "Properties": {"GroupId": {"Fn::GetAtt": ["Sg", "GroupId"]}, "IpProtocol": "-1", "CidrIp": "0.0.0.0/0", "FromPort": -1, "ToPort": -1}},
+    "Ip": {"Type": "AWS::EC2::EIP", "Properties": {"Domain": "vpc"}},
+    "NatGateway": {"Type": "AWS::EC2::NatGateway", "Properties": {"AllocationId": {"Fn::GetAtt": ["Ip", "AllocationId"]}, "SubnetId": {"Ref": "MySubnet"}}},
+    "Instance": {"Type": "AWS::EC2::Instance", "Properties": {"ImageId": "ami-123", "InstanceType": "t2.micro", "SubnetId": {"Ref": "MySubnet"}, "KeyName": {"Ref": "Mykey"},
+      "SecurityGroupIds": [{"Fn::GetAtt": ["Sg", "GroupId"]}], "Tags": [{"Key": "Name", "Value": "web"}]}}
+  }
+}`
+	b, err := cf.JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got, want interface{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(exp), &want); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got\n%s\nwant\n%s", b, exp)
+	}
+
+	b, err = cf.YAML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromYAML map[string]interface{}
+	if err := yaml.Unmarshal(b, &fromYAML); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(fromYAML["Resources"].(map[interface{}]interface{})), 13; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if !strings.Contains(string(b), "Fn::GetAtt:\n        - Ip\n        - AllocationId") {
+		t.Fatalf("missing GetAtt in\n%s", b)
+	}
+}
+
+func TestToCloudFormationLogicalIDs(t *testing.T) {
+	cf, err := ToCloudFormation(template.MustParse(`create bucket name=logs
+create bucket name=assets
+bucket = create bucket name=data`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for id, res := range cf.Resources {
+		ids = append(ids, id+"="+res.Properties["BucketName"].(string))
+	}
+	exp := []string{"Bucket=data", "Bucket1=logs", "Bucket2=assets"}
+	if got, want := len(ids), len(exp); got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	for _, e := range exp {
+		if !strings.Contains(strings.Join(ids, " "), e) {
+			t.Fatalf("got %v, want %v", ids, exp)
+		}
+	}
+}
+
+func TestToCloudFormationErrors(t *testing.T) {
+	tcases := []struct {
+		tpl    string
+		expErr string
+	}{
+		{tpl: "delete instance id=i-123", expErr: "delete instance: cannot be converted to CloudFormation"},
+		{tpl: "create vpc cidr=10.0.0.0/16 ipv6=true", expErr: "create vpc: param 'ipv6' cannot be converted"},
+		{tpl: "create instance image=ami-123 type=t2.micro subnet=sub-1 count=3", expErr: "create instance: count: one resource is declared per instance in CloudFormation, got 3"},
+		{tpl: "update securitygroup id=sg-1 inbound=revoke protocol=tcp cidr=0.0.0.0/0 portrange=22", expErr: "update securitygroup: only rules being authorized can be converted, got revoke"},
+		{tpl: "create subnet vpc=$vpc cidr=10.0.0.0/24", expErr: "create subnet: vpc: undefined reference $vpc"},
+	}
+
+	for _, tcase := range tcases {
+		_, err := ToCloudFormation(template.MustParse(tcase.tpl))
+		if err == nil {
+			t.Fatalf("%s: expected error", tcase.tpl)
+		}
+		if got, want := err.Error(), tcase.expErr; got != want {
+			t.Fatalf("%s: got %s, want %s", tcase.tpl, got, want)
+		}
+	}
+}
+
+func TestToLogicalID(t *testing.T) {
+	tcases := map[string]string{"vpc": "Vpc", "my-subnet": "MySubnet", "vpc.cidr": "VpcCidr", "web_sg2": "WebSg2", "myVpc": "MyVpc"}
+	for in, exp := range tcases {
+		if got, want := toLogicalID(in), exp; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package convert
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+type cfProperty struct {
+	name string
+	list bool
+}
+
+func prop(name string) cfProperty     { return cfProperty{name: name} }
+func listProp(name string) cfProperty { return cfProperty{name: name, list: true} }
+
+type cfDefinition struct {
+	cfnType string
+	// awless params mapped as is to CloudFormation properties
+	props map[string]cfProperty
+	// the name param becomes a Name tag
+	nameTag bool
+	// attribute giving the awless command result, the resource Ref when empty
+	resultAttr string
+	// custom builds the properties from the params not mapped with props, returning the CloudFormation type when it depends on them
+	custom func(params, props map[string]interface{}) (string, error)
+}
+
+func (d *cfDefinition) build(params map[string]interface{}) (*CloudFormationResource, error) {
+	res := &CloudFormationResource{Type: d.cfnType, Properties: make(map[string]interface{})}
+
+	var keys []string
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	others := make(map[string]interface{})
+	for _, k := range keys {
+		v := params[k]
+		if p, ok := d.props[k]; ok {
+			if _, isList := v.([]interface{}); p.list && !isList {
+				v = []interface{}{v}
+			}
+			res.Properties[p.name] = v
+			continue
+		}
+		if k == "name" && d.nameTag {
+			res.Properties["Tags"] = []interface{}{map[string]interface{}{"Key": "Name", "Value": v}}
+			continue
+		}
+		if d.custom == nil {
+			return res, fmt.Errorf("param '%s' cannot be converted", k)
+		}
+		others[k] = v
+	}
+
+	if d.custom != nil {
+		typ, err := d.custom(others, res.Properties)
+		if err != nil {
+			return res, err
+		}
+		if typ != "" {
+			res.Type = typ
+		}
+	}
+
+	return res, nil
+}
+
+func definitionOf(cmd *ast.CommandNode) (*cfDefinition, error) {
+	def, ok := cfDefinitions[cmd.Action+cmd.Entity]
+	if !ok {
+		return nil, fmt.Errorf("%s %s: cannot be converted to CloudFormation", cmd.Action, cmd.Entity)
+	}
+	return def, nil
+}
+
+var cfDefinitions = map[string]*cfDefinition{
+	"createvpc": {
+		cfnType: "AWS::EC2::VPC",
+		props:   map[string]cfProperty{"cidr": prop("CidrBlock")},
+		nameTag: true,
+	},
+	"createsubnet": {
+		cfnType: "AWS::EC2::Subnet",
+		props:   map[string]cfProperty{"cidr": prop("CidrBlock"), "vpc": prop("VpcId"), "availabilityzone": prop("AvailabilityZone"), "public": prop("MapPublicIpOnLaunch")},
+		nameTag: true,
+	},
+	"createinternetgateway": {
+		cfnType: "AWS::EC2::InternetGateway",
+	},
+	"attachinternetgateway": {
+		cfnType: "AWS::EC2::VPCGatewayAttachment",
+		props:   map[string]cfProperty{"id": prop("InternetGatewayId"), "vpc": prop("VpcId")},
+	},
+	"createroutetable": {
+		cfnType: "AWS::EC2::RouteTable",
+		props:   map[string]cfProperty{"vpc": prop("VpcId")},
+	},
+	"attachroutetable": {
+		cfnType: "AWS::EC2::SubnetRouteTableAssociation",
+		props:   map[string]cfProperty{"id": prop("RouteTableId"), "subnet": prop("SubnetId")},
+	},
+	"createroute": {
+		cfnType: "AWS::EC2::Route",
+		props:   map[string]cfProperty{"table": prop("RouteTableId"), "cidr": prop("DestinationCidrBlock"), "gateway": prop("GatewayId")},
+	},
+	"createelasticip": {
+		cfnType:    "AWS::EC2::EIP",
+		props:      map[string]cfProperty{"domain": prop("Domain")},
+		resultAttr: "AllocationId",
+	},
+	"createnatgateway": {
+		cfnType: "AWS::EC2::NatGateway",
+		props:   map[string]cfProperty{"elasticip-id": prop("AllocationId"), "subnet": prop("SubnetId")},
+	},
+	"createsecuritygroup": {
+		cfnType:    "AWS::EC2::SecurityGroup",
+		props:      map[string]cfProperty{"name": prop("GroupName"), "description": prop("GroupDescription"), "vpc": prop("VpcId")},
+		resultAttr: "GroupId",
+	},
+	"updatesecuritygroup": {
+		cfnType: "AWS::EC2::SecurityGroupIngress",
+		props:   map[string]cfProperty{"id": prop("GroupId")},
+		custom:  securityGroupRule,
+	},
+	"createinstance": {
+		cfnType: "AWS::EC2::Instance",
+		props: map[string]cfProperty{"image": prop("ImageId"), "type": prop("InstanceType"), "subnet": prop("SubnetId"), "keypair": prop("KeyName"),
+			"ip": prop("PrivateIpAddress"), "securitygroup": listProp("SecurityGroupIds"), "lock": prop("DisableApiTermination"), "role": prop("IamInstanceProfile")},
+		nameTag: true,
+		custom: func(params, props map[string]interface{}) (string, error) {
+			for k, v := range params {
+				if k != "count" {
+					return "", fmt.Errorf("param '%s' cannot be converted", k)
+				}
+				if count, err := literal(v); err != nil || count != "1" {
+					return "", fmt.Errorf("count: one resource is declared per instance in CloudFormation, got %v", v)
+				}
+			}
+			return "", nil
+		},
+	},
+	"createvolume": {
+		cfnType: "AWS::EC2::Volume",
+		props:   map[string]cfProperty{"availabilityzone": prop("AvailabilityZone"), "size": prop("Size")},
+	},
+	"attachvolume": {
+		cfnType: "AWS::EC2::VolumeAttachment",
+		props:   map[string]cfProperty{"id": prop("VolumeId"), "instance": prop("InstanceId"), "device": prop("Device")},
+	},
+	"createbucket": {
+		cfnType: "AWS::S3::Bucket",
+		props:   map[string]cfProperty{"name": prop("BucketName")},
+	},
+	"createqueue": {
+		cfnType: "AWS::SQS::Queue",
+		props: map[string]cfProperty{"name": prop("QueueName"), "delay": prop("DelaySeconds"), "max-msg-size": prop("MaximumMessageSize"),
+			"retention-period": prop("MessageRetentionPeriod"), "msg-wait": prop("ReceiveMessageWaitTimeSeconds"), "visibility-timeout": prop("VisibilityTimeout"),
+			"fifo": prop("FifoQueue"), "content-deduplication": prop("ContentBasedDeduplication")},
+	},
+	"createtopic": {
+		cfnType: "AWS::SNS::Topic",
+		props:   map[string]cfProperty{"name": prop("TopicName")},
+	},
+	"createsubscription": {
+		cfnType: "AWS::SNS::Subscription",
+		props:   map[string]cfProperty{"topic": prop("TopicArn"), "endpoint": prop("Endpoint"), "protocol": prop("Protocol")},
+	},
+	"createloadbalancer": {
+		cfnType: "AWS::ElasticLoadBalancingV2::LoadBalancer",
+		props: map[string]cfProperty{"name": prop("Name"), "subnets": listProp("Subnets"), "securitygroups": listProp("SecurityGroups"),
+			"scheme": prop("Scheme"), "iptype": prop("IpAddressType"), "type": prop("Type")},
+	},
+	"createtargetgroup": {
+		cfnType: "AWS::ElasticLoadBalancingV2::TargetGroup",
+		props: map[string]cfProperty{"name": prop("Name"), "port": prop("Port"), "protocol": prop("Protocol"), "vpc": prop("VpcId"),
+			"healthcheckinterval": prop("HealthCheckIntervalSeconds"), "healthcheckpath": prop("HealthCheckPath"), "healthcheckport": prop("HealthCheckPort"),
+			"healthcheckprotocol": prop("HealthCheckProtocol"), "healthchecktimeout": prop("HealthCheckTimeoutSeconds"),
+			"healthythreshold": prop("HealthyThresholdCount"), "unhealthythreshold": prop("UnhealthyThresholdCount")},
+	},
+	"createlistener": {
+		cfnType: "AWS::ElasticLoadBalancingV2::Listener",
+		props:   map[string]cfProperty{"loadbalancer": prop("LoadBalancerArn"), "port": prop("Port"), "protocol": prop("Protocol"), "sslpolicy": prop("SslPolicy")},
+		custom: func(params, props map[string]interface{}) (string, error) {
+			action := map[string]interface{}{"Type": "forward"}
+			for k, v := range params {
+				switch k {
+				case "actiontype":
+					action["Type"] = v
+				case "targetgroup":
+					action["TargetGroupArn"] = v
+				case "certificate":
+					props["Certificates"] = []interface{}{map[string]interface{}{"CertificateArn": v}}
+				default:
+					return "", fmt.Errorf("param '%s' cannot be converted", k)
+				}
+			}
+			props["DefaultActions"] = []interface{}{action}
+			return "", nil
+		},
+	},
+}
+
+// securityGroupRule converts the params of an update securitygroup command to
+// a standalone ingress or egress rule, applying the same port range rules as the command
+func securityGroupRule(params, props map[string]interface{}) (string, error) {
+	typ, peerProp, way := "AWS::EC2::SecurityGroupIngress", "SourceSecurityGroupId", params["inbound"]
+	if v, ok := params["outbound"]; ok {
+		typ, peerProp, way = "AWS::EC2::SecurityGroupEgress", "DestinationSecurityGroupId", v
+	}
+	if s, err := literal(way); err != nil || s != "authorize" {
+		return "", fmt.Errorf("only rules being authorized can be converted, got %v", way)
+	}
+
+	for k, v := range params {
+		switch k {
+		case "inbound", "outbound", "protocol", "portrange":
+		case "cidr":
+			if s, err := literal(v); err == nil && strings.Contains(s, ":") {
+				props["CidrIpv6"] = v
+			} else {
+				props["CidrIp"] = v
+			}
+		case "securitygroup":
+			props[peerProp] = v
+		default:
+			return "", fmt.Errorf("param '%s' cannot be converted", k)
+		}
+	}
+
+	protocol, err := literal(params["protocol"])
+	if err != nil {
+		return "", fmt.Errorf("protocol: %s", err)
+	}
+	if strings.Contains("any", protocol) {
+		props["IpProtocol"], props["FromPort"], props["ToPort"] = "-1", -1, -1
+		return typ, nil
+	}
+	props["IpProtocol"] = protocol
+
+	if _, ok := params["portrange"]; !ok {
+		return typ, nil
+	}
+	ports, err := literal(params["portrange"])
+	if err != nil {
+		return "", fmt.Errorf("portrange: %s", err)
+	}
+	switch {
+	case strings.Contains(ports, "any"):
+		if protocol == "tcp" || protocol == "udp" {
+			props["FromPort"], props["ToPort"] = 0, 65535
+		} else {
+			props["FromPort"], props["ToPort"] = -1, -1
+		}
+	case strings.Contains(ports, "-"):
+		bounds := strings.SplitN(ports, "-", 2)
+		from, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			return "", fmt.Errorf("portrange: %s", err)
+		}
+		to, err := strconv.Atoi(bounds[1])
+		if err != nil {
+			return "", fmt.Errorf("portrange: %s", err)
+		}
+		props["FromPort"], props["ToPort"] = from, to
+	default:
+		port, err := strconv.Atoi(ports)
+		if err != nil {
+			return "", fmt.Errorf("portrange: %s", err)
+		}
+		props["FromPort"], props["ToPort"] = port, port
+	}
+	return typ, nil
+}
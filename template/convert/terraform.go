@@ -0,0 +1,474 @@
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/wallix/awless/template"
+)
+
+// TerraformImport is the content of a Terraform state translated to awless
+type TerraformImport struct {
+	// statements creating again the supported resources, in dependency order
+	Statements []string
+	// cloud ids of all the resources of the state by Terraform address (ex: aws_vpc.main),
+	// to reference the existing resources from awless templates
+	IDs map[string]string
+	// addresses of the resources without awless equivalent
+	Skipped []string
+}
+
+// Template parses the imported statements as an awless template
+func (t *TerraformImport) Template() (*template.Template, error) {
+	return template.Parse(strings.Join(t.Statements, "\n"))
+}
+
+// FromTerraformState reads a Terraform state file (.tfstate, format version 3 or 4)
+// and converts the AWS resources it manages to awless statements. Each resource is assigned
+// to a variable named after its Terraform name and type (ex: aws_vpc.main becomes main_vpc),
+// the attributes holding the id of a previously converted resource becoming references to it.
+// The ids of the other resources are kept as is, still pointing to the existing resources.
+func FromTerraformState(r io.Reader) (*TerraformImport, error) {
+	var state struct {
+		Version int `json:"version"`
+		// format version 3 (Terraform < 0.12)
+		Modules []struct {
+			Path      []string `json:"path"`
+			Resources map[string]struct {
+				Type    string `json:"type"`
+				Primary struct {
+					ID         string            `json:"id"`
+					Attributes map[string]string `json:"attributes"`
+				} `json:"primary"`
+			} `json:"resources"`
+		} `json:"modules"`
+		// format version 4
+		Resources []struct {
+			Module    string `json:"module"`
+			Mode      string `json:"mode"`
+			Type      string `json:"type"`
+			Name      string `json:"name"`
+			Instances []struct {
+				IndexKey   interface{}            `json:"index_key"`
+				Attributes map[string]interface{} `json:"attributes"`
+			} `json:"instances"`
+		} `json:"resources"`
+	}
+	if err := json.NewDecoder(r).Decode(&state); err != nil {
+		return nil, fmt.Errorf("terraform state: %s", err)
+	}
+
+	var resources []*tfResource
+	switch state.Version {
+	case 3:
+		for _, mod := range state.Modules {
+			var prefix string
+			for _, p := range mod.Path[1:] {
+				prefix += "module." + p + "."
+			}
+			for key, res := range mod.Resources {
+				if strings.HasPrefix(key, "data.") {
+					continue
+				}
+				// keys are TYPE.NAME, suffixed by the index for resources with a count
+				parts := strings.SplitN(key, ".", 3)
+				if len(parts) < 2 {
+					return nil, fmt.Errorf("terraform state: invalid resource key '%s'", key)
+				}
+				name := parts[1]
+				if len(parts) == 3 {
+					name += "_" + parts[2]
+				}
+				resources = append(resources, &tfResource{
+					address: prefix + key,
+					typ:     res.Type,
+					name:    name,
+					id:      res.Primary.ID,
+					attrs:   unflatten(res.Primary.Attributes),
+				})
+			}
+		}
+	case 4:
+		for _, res := range state.Resources {
+			if res.Mode != "managed" {
+				continue
+			}
+			prefix := res.Module
+			if prefix != "" {
+				prefix += "."
+			}
+			for _, inst := range res.Instances {
+				address, name := fmt.Sprintf("%s%s.%s", prefix, res.Type, res.Name), res.Name
+				if inst.IndexKey != nil {
+					address += fmt.Sprintf("[%v]", inst.IndexKey)
+					name += fmt.Sprintf("_%v", inst.IndexKey)
+				}
+				resources = append(resources, &tfResource{
+					address: address,
+					typ:     res.Type,
+					name:    name,
+					id:      attrString(inst.Attributes, "id"),
+					attrs:   inst.Attributes,
+				})
+			}
+		}
+	default:
+		return nil, fmt.Errorf("terraform state: unsupported format version %d", state.Version)
+	}
+
+	return newTfConverter().convert(resources), nil
+}
+
+type tfResource struct {
+	address, typ, name, id string
+	attrs                  map[string]interface{}
+	// awless variable the resource is assigned to
+	variable string
+}
+
+type tfConverter struct {
+	out *TerraformImport
+	// variables of the resources already converted, by cloud id
+	vars     map[string]string
+	varNames map[string]bool
+}
+
+func newTfConverter() *tfConverter {
+	return &tfConverter{
+		out:      &TerraformImport{IDs: make(map[string]string)},
+		vars:     make(map[string]string),
+		varNames: make(map[string]bool),
+	}
+}
+
+func (c *tfConverter) convert(resources []*tfResource) *TerraformImport {
+	order := make(map[string]int)
+	for i, typ := range tfTypesOrder {
+		order[typ] = i
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		if order[resources[i].typ] != order[resources[j].typ] {
+			return order[resources[i].typ] < order[resources[j].typ]
+		}
+		return resources[i].address < resources[j].address
+	})
+
+	for _, res := range resources {
+		c.out.IDs[res.address] = res.id
+	}
+	for _, res := range resources {
+		conv, ok := tfConverters[res.typ]
+		if !ok {
+			c.out.Skipped = append(c.out.Skipped, res.address)
+			continue
+		}
+		res.variable = c.newVar(res)
+		statements := conv(c, res)
+		statements[0] = res.variable + " = " + statements[0]
+		c.out.Statements = append(c.out.Statements, statements...)
+		if res.id != "" {
+			c.vars[res.id] = res.variable
+		}
+	}
+	sort.Strings(c.out.Skipped)
+	return c.out
+}
+
+var invalidIdentifierChars = regexp.MustCompile("[^a-zA-Z0-9-_.]")
+
+func (c *tfConverter) newVar(res *tfResource) string {
+	prefix := invalidIdentifierChars.ReplaceAllString(res.name+"_"+tfEntities[res.typ], "_")
+	name := prefix
+	for i := 2; c.varNames[name]; i++ {
+		name = fmt.Sprintf("%s%d", prefix, i)
+	}
+	c.varNames[name] = true
+	return name
+}
+
+// command builds an awless statement from params given as key/value pairs, skipping empty values
+func (c *tfConverter) command(action, entity string, params ...string) string {
+	buff := []string{action, entity}
+	for i := 0; i+1 < len(params); i += 2 {
+		if params[i+1] != "" {
+			buff = append(buff, params[i]+"="+params[i+1])
+		}
+	}
+	return strings.Join(buff, " ")
+}
+
+// value renders an attribute as a param value, referencing the converted resources by their variable
+func (c *tfConverter) value(attrs map[string]interface{}, key string) string {
+	if list, ok := attrs[key].([]interface{}); ok {
+		var elems []string
+		for _, e := range list {
+			if s := c.scalar(e); s != "" {
+				elems = append(elems, s)
+			}
+		}
+		if len(elems) == 0 {
+			return ""
+		}
+		return "[" + strings.Join(elems, ",") + "]"
+	}
+	return c.scalar(attrs[key])
+}
+
+func (c *tfConverter) scalar(v interface{}) string {
+	s := toString(v)
+	if s == "" {
+		return ""
+	}
+	if variable, ok := c.vars[s]; ok {
+		return "$" + variable
+	}
+	return template.QuoteParamValue(s)
+}
+
+func attrString(attrs map[string]interface{}, key string) string {
+	return toString(attrs[key])
+}
+
+func toString(v interface{}) string {
+	switch vv := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return vv
+	case float64:
+		return strconv.FormatFloat(vv, 'f', -1, 64)
+	default:
+		return fmt.Sprint(vv)
+	}
+}
+
+func nameTag(attrs map[string]interface{}) string {
+	tags, _ := attrs["tags"].(map[string]interface{})
+	if name := toString(tags["Name"]); name != "" {
+		return template.QuoteParamValue(name)
+	}
+	return ""
+}
+
+// unflatten nests the attributes of the format version 3 (ex: tags.Name, ingress.#, ingress.1234.from_port)
+// as they are in the format version 4, lists being counted with a # key and maps with a % key
+func unflatten(flat map[string]string) map[string]interface{} {
+	root := make(map[string]interface{})
+	lists := make(map[string]bool)
+	for k, v := range flat {
+		parts := strings.Split(k, ".")
+		last := parts[len(parts)-1]
+		switch last {
+		case "#":
+			lists[strings.Join(parts[:len(parts)-1], ".")] = true
+			continue
+		case "%":
+			continue
+		}
+		m := root
+		for _, p := range parts[:len(parts)-1] {
+			sub, ok := m[p].(map[string]interface{})
+			if !ok {
+				sub = make(map[string]interface{})
+				m[p] = sub
+			}
+			m = sub
+		}
+		m[last] = v
+	}
+	return nestLists(root, "", lists).(map[string]interface{})
+}
+
+func nestLists(m map[string]interface{}, path string, lists map[string]bool) interface{} {
+	var keys []string
+	for k, v := range m {
+		keys = append(keys, k)
+		if sub, ok := v.(map[string]interface{}); ok {
+			subPath := k
+			if path != "" {
+				subPath = path + "." + k
+			}
+			m[k] = nestLists(sub, subPath, lists)
+		}
+	}
+	if !lists[path] {
+		return m
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		ki, erri := strconv.Atoi(keys[i])
+		kj, errj := strconv.Atoi(keys[j])
+		if erri == nil && errj == nil {
+			return ki < kj
+		}
+		return keys[i] < keys[j]
+	})
+	var list []interface{}
+	for _, k := range keys {
+		list = append(list, m[k])
+	}
+	return list
+}
+
+// resources converted first to be referenced by the others
+var tfTypesOrder = []string{
+	"aws_vpc", "aws_internet_gateway", "aws_subnet", "aws_route_table", "aws_route_table_association",
+	"aws_security_group", "aws_security_group_rule", "aws_eip", "aws_nat_gateway", "aws_route",
+	"aws_ebs_volume", "aws_instance", "aws_volume_attachment", "aws_lb", "aws_alb", "aws_lb_target_group", "aws_alb_target_group",
+	"aws_s3_bucket", "aws_sqs_queue", "aws_sns_topic",
+}
+
+var tfEntities = map[string]string{
+	"aws_vpc": "vpc", "aws_internet_gateway": "internetgateway", "aws_subnet": "subnet", "aws_route_table": "routetable",
+	"aws_route_table_association": "routetable_association", "aws_security_group": "securitygroup", "aws_security_group_rule": "securitygroup_rule",
+	"aws_eip": "elasticip", "aws_nat_gateway": "natgateway", "aws_route": "route", "aws_ebs_volume": "volume", "aws_instance": "instance",
+	"aws_volume_attachment": "volume_attachment", "aws_lb": "loadbalancer", "aws_alb": "loadbalancer", "aws_lb_target_group": "targetgroup",
+	"aws_alb_target_group": "targetgroup", "aws_s3_bucket": "bucket", "aws_sqs_queue": "queue", "aws_sns_topic": "topic",
+}
+
+// tfConverters return the statements converting a resource, the first one being the main command
+var tfConverters = map[string]func(c *tfConverter, r *tfResource) []string{
+	"aws_vpc": func(c *tfConverter, r *tfResource) []string {
+		return []string{c.command("create", "vpc", "cidr", c.value(r.attrs, "cidr_block"), "name", nameTag(r.attrs))}
+	},
+	"aws_internet_gateway": func(c *tfConverter, r *tfResource) []string {
+		statements := []string{c.command("create", "internetgateway")}
+		if vpc := c.value(r.attrs, "vpc_id"); vpc != "" {
+			statements = append(statements, c.command("attach", "internetgateway", "id", "$"+r.variable, "vpc", vpc))
+		}
+		return statements
+	},
+	"aws_subnet": func(c *tfConverter, r *tfResource) []string {
+		var public string
+		if attrString(r.attrs, "map_public_ip_on_launch") == "true" {
+			public = "true"
+		}
+		return []string{c.command("create", "subnet", "cidr", c.value(r.attrs, "cidr_block"), "vpc", c.value(r.attrs, "vpc_id"),
+			"availabilityzone", c.value(r.attrs, "availability_zone"), "public", public, "name", nameTag(r.attrs))}
+	},
+	"aws_route_table": func(c *tfConverter, r *tfResource) []string {
+		return []string{c.command("create", "routetable", "vpc", c.value(r.attrs, "vpc_id"))}
+	},
+	"aws_route_table_association": func(c *tfConverter, r *tfResource) []string {
+		return []string{c.command("attach", "routetable", "id", c.value(r.attrs, "route_table_id"), "subnet", c.value(r.attrs, "subnet_id"))}
+	},
+	"aws_route": func(c *tfConverter, r *tfResource) []string {
+		gateway := c.value(r.attrs, "gateway_id")
+		if gateway == "" {
+			gateway = c.value(r.attrs, "nat_gateway_id")
+		}
+		return []string{c.command("create", "route", "table", c.value(r.attrs, "route_table_id"), "cidr", c.value(r.attrs, "destination_cidr_block"), "gateway", gateway)}
+	},
+	"aws_security_group": func(c *tfConverter, r *tfResource) []string {
+		statements := []string{c.command("create", "securitygroup", "name", c.value(r.attrs, "name"), "description", c.value(r.attrs, "description"), "vpc", c.value(r.attrs, "vpc_id"))}
+		for _, way := range []string{"ingress", "egress"} {
+			rules, _ := r.attrs[way].([]interface{})
+			for _, rule := range rules {
+				if attrs, ok := rule.(map[string]interface{}); ok {
+					statements = append(statements, c.securityGroupRules("$"+r.variable, way, attrs)...)
+				}
+			}
+		}
+		return statements
+	},
+	"aws_security_group_rule": func(c *tfConverter, r *tfResource) []string {
+		statements := c.securityGroupRules(c.value(r.attrs, "security_group_id"), attrString(r.attrs, "type"), r.attrs)
+		if len(statements) == 0 {
+			return []string{c.command("update", "securitygroup", "id", c.value(r.attrs, "security_group_id"))}
+		}
+		return statements
+	},
+	"aws_eip": func(c *tfConverter, r *tfResource) []string {
+		var domain string
+		if attrString(r.attrs, "vpc") == "true" || attrString(r.attrs, "domain") == "vpc" {
+			domain = "vpc"
+		}
+		return []string{c.command("create", "elasticip", "domain", domain)}
+	},
+	"aws_nat_gateway": func(c *tfConverter, r *tfResource) []string {
+		return []string{c.command("create", "natgateway", "elasticip-id", c.value(r.attrs, "allocation_id"), "subnet", c.value(r.attrs, "subnet_id"))}
+	},
+	"aws_ebs_volume": func(c *tfConverter, r *tfResource) []string {
+		return []string{c.command("create", "volume", "availabilityzone", c.value(r.attrs, "availability_zone"), "size", c.value(r.attrs, "size"))}
+	},
+	"aws_instance": func(c *tfConverter, r *tfResource) []string {
+		return []string{c.command("create", "instance", "image", c.value(r.attrs, "ami"), "type", c.value(r.attrs, "instance_type"),
+			"subnet", c.value(r.attrs, "subnet_id"), "keypair", c.value(r.attrs, "key_name"), "securitygroup", c.value(r.attrs, "vpc_security_group_ids"),
+			"ip", c.value(r.attrs, "private_ip"), "role", c.value(r.attrs, "iam_instance_profile"), "name", nameTag(r.attrs))}
+	},
+	"aws_volume_attachment": func(c *tfConverter, r *tfResource) []string {
+		return []string{c.command("attach", "volume", "id", c.value(r.attrs, "volume_id"), "instance", c.value(r.attrs, "instance_id"), "device", c.value(r.attrs, "device_name"))}
+	},
+	"aws_lb":               loadBalancerStatements,
+	"aws_alb":              loadBalancerStatements,
+	"aws_lb_target_group":  targetGroupStatements,
+	"aws_alb_target_group": targetGroupStatements,
+	"aws_s3_bucket": func(c *tfConverter, r *tfResource) []string {
+		return []string{c.command("create", "bucket", "name", c.value(r.attrs, "bucket"))}
+	},
+	"aws_sqs_queue": func(c *tfConverter, r *tfResource) []string {
+		return []string{c.command("create", "queue", "name", c.value(r.attrs, "name"))}
+	},
+	"aws_sns_topic": func(c *tfConverter, r *tfResource) []string {
+		return []string{c.command("create", "topic", "name", c.value(r.attrs, "name"))}
+	},
+}
+
+func loadBalancerStatements(c *tfConverter, r *tfResource) []string {
+	scheme := "internet-facing"
+	if attrString(r.attrs, "internal") == "true" {
+		scheme = "internal"
+	}
+	return []string{c.command("create", "loadbalancer", "name", c.value(r.attrs, "name"), "subnets", c.value(r.attrs, "subnets"),
+		"securitygroups", c.value(r.attrs, "security_groups"), "scheme", scheme, "type", c.value(r.attrs, "load_balancer_type"))}
+}
+
+func targetGroupStatements(c *tfConverter, r *tfResource) []string {
+	return []string{c.command("create", "targetgroup", "name", c.value(r.attrs, "name"), "port", c.value(r.attrs, "port"),
+		"protocol", c.value(r.attrs, "protocol"), "vpc", c.value(r.attrs, "vpc_id"))}
+}
+
+// securityGroupRules converts an ingress or egress rule to update securitygroup statements,
+// one per CIDR block or source security group
+func (c *tfConverter) securityGroupRules(securitygroup, way string, attrs map[string]interface{}) (statements []string) {
+	param := "inbound"
+	if way == "egress" {
+		param = "outbound"
+	}
+	protocol := attrString(attrs, "protocol")
+	if protocol == "-1" {
+		protocol = "any"
+	}
+	var portrange string
+	if protocol != "any" {
+		from, to := attrString(attrs, "from_port"), attrString(attrs, "to_port")
+		portrange = from
+		if from != to {
+			portrange = from + "-" + to
+		}
+	}
+
+	var sources []string
+	if cidrs, ok := attrs["cidr_blocks"].([]interface{}); ok {
+		for _, cidr := range cidrs {
+			sources = append(sources, "cidr", c.scalar(cidr))
+		}
+	}
+	if sg := attrString(attrs, "source_security_group_id"); sg != "" {
+		sources = append(sources, "securitygroup", c.scalar(sg))
+	}
+	if groups, ok := attrs["security_groups"].([]interface{}); ok {
+		for _, sg := range groups {
+			sources = append(sources, "securitygroup", c.scalar(sg))
+		}
+	}
+	for i := 0; i+1 < len(sources); i += 2 {
+		statements = append(statements, c.command("update", "securitygroup", "id", securitygroup, param, "authorize",
+			"protocol", protocol, sources[i], sources[i+1], "portrange", portrange))
+	}
+	return
+}
@@ -0,0 +1,157 @@
+package convert
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFromTerraformStateV4(t *testing.T) {
+	state := `{
+  "version": 4,
+  "terraform_version": "0.12.24",
+  "resources": [
+    {"mode": "data", "type": "aws_ami", "name": "ubuntu", "instances": [{"attributes": {"id": "ami-123"}}]},
+    {"mode": "managed", "type": "aws_instance", "name": "web", "instances": [
+      {"index_key": 0, "attributes": {"id": "i-1", "ami": "ami-123", "instance_type": "t2.micro", "subnet_id": "subnet-1", "key_name": "mykey",
+        "vpc_security_group_ids": ["sg-1"], "tags": {"Name": "web server"}}}
+    ]},
+    {"mode": "managed", "type": "aws_subnet", "name": "public", "instances": [
+      {"attributes": {"id": "subnet-1", "vpc_id": "vpc-1", "cidr_block": "10.0.1.0/24", "availability_zone": "eu-west-1a", "map_public_ip_on_launch": true}}
+    ]},
+    {"mode": "managed", "type": "aws_vpc", "name": "main", "instances": [
+      {"attributes": {"id": "vpc-1", "cidr_block": "10.0.0.0/16", "tags": {"Name": "main"}}}
+    ]},
+    {"mode": "managed", "type": "aws_internet_gateway", "name": "gw", "instances": [
+      {"attributes": {"id": "igw-1", "vpc_id": "vpc-1"}}
+    ]},
+    {"mode": "managed", "type": "aws_security_group", "name": "web", "instances": [
+      {"attributes": {"id": "sg-1", "name": "web", "description": "web access", "vpc_id": "vpc-1",
+        "ingress": [{"from_port": 80, "to_port": 81, "protocol": "tcp", "cidr_blocks": ["0.0.0.0/0"], "security_groups": []}],
+        "egress": [{"from_port": 0, "to_port": 0, "protocol": "-1", "cidr_blocks": ["0.0.0.0/0"]}]}}
+    ]},
+    {"mode": "managed", "type": "aws_key_pair", "name": "deployer", "instances": [{"attributes": {"id": "mykey"}}]},
+    {"mode": "managed", "module": "module.storage", "type": "aws_s3_bucket", "name": "logs", "instances": [{"attributes": {"id": "my-logs", "bucket": "my-logs"}}]}
+  ]
+}`
+	imported, err := FromTerraformState(strings.NewReader(state))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := []string{
+		"main_vpc = create vpc cidr=10.0.0.0/16 name=main",
+		"gw_internetgateway = create internetgateway",
+		"attach internetgateway id=$gw_internetgateway vpc=$main_vpc",
+		"public_subnet = create subnet cidr=10.0.1.0/24 vpc=$main_vpc availabilityzone=eu-west-1a public=true",
+		"web_securitygroup = create securitygroup name=web description='web access' vpc=$main_vpc",
+		"update securitygroup id=$web_securitygroup inbound=authorize protocol=tcp cidr=0.0.0.0/0 portrange=80-81",
+		"update securitygroup id=$web_securitygroup outbound=authorize protocol=any cidr=0.0.0.0/0",
+		"web_0_instance = create instance image=ami-123 type=t2.micro subnet=$public_subnet keypair=mykey securitygroup=[$web_securitygroup] name='web server'",
+		"logs_bucket = create bucket name=my-logs",
+	}
+	if got, want := imported.Statements, exp; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got\n%s\n\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if got, want := imported.Skipped, []string{"aws_key_pair.deployer"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := imported.IDs["aws_instance.web[0]"], "i-1"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := imported.IDs["module.storage.aws_s3_bucket.logs"], "my-logs"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if _, ok := imported.IDs["data.aws_ami.ubuntu"]; ok {
+		t.Fatal("data sources should not be imported")
+	}
+
+	tpl, err := imported.Template()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(tpl.CommandNodesIterator()), len(exp); got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
+
+func TestFromTerraformStateV3(t *testing.T) {
+	state := `{
+  "version": 3,
+  "modules": [{
+    "path": ["root"],
+    "resources": {
+      "aws_vpc.main": {"type": "aws_vpc", "primary": {"id": "vpc-1", "attributes": {"id": "vpc-1", "cidr_block": "10.0.0.0/16", "tags.%": "1", "tags.Name": "main"}}},
+      "aws_security_group_rule.ssh": {"type": "aws_security_group_rule", "primary": {"id": "sgrule-1", "attributes": {
+        "type": "ingress", "security_group_id": "sg-existing", "from_port": "22", "to_port": "22", "protocol": "tcp",
+        "cidr_blocks.#": "2", "cidr_blocks.0": "10.0.0.0/8", "cidr_blocks.1": "192.168.0.0/16"}}},
+      "aws_instance.web.1": {"type": "aws_instance", "primary": {"id": "i-2", "attributes": {"ami": "ami-123", "instance_type": "t2.micro",
+        "subnet_id": "subnet-existing", "vpc_security_group_ids.#": "2", "vpc_security_group_ids.1234": "sg-1", "vpc_security_group_ids.5678": "sg-2"}}},
+      "data.aws_ami.ubuntu": {"type": "aws_ami", "primary": {"id": "ami-123"}}
+    }
+  }, {
+    "path": ["root", "queues"],
+    "resources": {
+      "aws_sqs_queue.jobs": {"type": "aws_sqs_queue", "primary": {"id": "https://sqs.eu-west-1.amazonaws.com/123/jobs", "attributes": {"name": "jobs"}}}
+    }
+  }]
+}`
+	imported, err := FromTerraformState(strings.NewReader(state))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := []string{
+		"main_vpc = create vpc cidr=10.0.0.0/16 name=main",
+		"ssh_securitygroup_rule = update securitygroup id=sg-existing inbound=authorize protocol=tcp cidr=10.0.0.0/8 portrange=22",
+		"update securitygroup id=sg-existing inbound=authorize protocol=tcp cidr=192.168.0.0/16 portrange=22",
+		"web_1_instance = create instance image=ami-123 type=t2.micro subnet=subnet-existing securitygroup=[sg-1,sg-2]",
+		"jobs_queue = create queue name=jobs",
+	}
+	if got, want := imported.Statements, exp; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got\n%s\n\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if got, want := imported.IDs["module.queues.aws_sqs_queue.jobs"], "https://sqs.eu-west-1.amazonaws.com/123/jobs"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := len(imported.IDs), 4; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
+
+func TestFromTerraformStateErrors(t *testing.T) {
+	tcases := []struct {
+		state  string
+		expErr string
+	}{
+		{state: `{"version": 2}`, expErr: "terraform state: unsupported format version 2"},
+		{state: `resource "aws_vpc" "main" {}`, expErr: "terraform state: invalid character 'r' looking for beginning of value"},
+	}
+	for _, tcase := range tcases {
+		_, err := FromTerraformState(strings.NewReader(tcase.state))
+		if err == nil {
+			t.Fatalf("%s: expected error", tcase.state)
+		}
+		if got, want := err.Error(), tcase.expErr; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	}
+}
+
+func TestUnflatten(t *testing.T) {
+	flat := map[string]string{
+		"id": "sg-1", "tags.%": "1", "tags.Name": "web",
+		"ingress.#": "1", "ingress.2541437006.from_port": "22", "ingress.2541437006.cidr_blocks.#": "1", "ingress.2541437006.cidr_blocks.0": "0.0.0.0/0",
+		"egress.#": "0",
+	}
+	exp := map[string]interface{}{
+		"id":   "sg-1",
+		"tags": map[string]interface{}{"Name": "web"},
+		"ingress": []interface{}{
+			map[string]interface{}{"from_port": "22", "cidr_blocks": []interface{}{"0.0.0.0/0"}},
+		},
+	}
+	if got, want := unflatten(flat), exp; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import "github.com/wallix/awless/template/env"
+
+// Driver runs a template statement, given the params of its action on an entity,
+// and returns its result (usually the id of the resource)
+type Driver interface {
+	Run(env.Running, map[string]interface{}) (interface{}, error)
+}
+
+// Func adapts a function to the Driver interface
+type Func func(env.Running, map[string]interface{}) (interface{}, error)
+
+func (f Func) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return f(renv, params)
+}
+
+// Middleware wraps a driver to act before, after or instead of it
+// (logging, metrics, dry run, rate limiting, auditing, ...)
+type Middleware func(next Driver) Driver
+
+// Chain wraps d with the middlewares in order: the first one is the outermost,
+// seeing the statements first and their results last
+func Chain(d Driver, mws ...Middleware) Driver {
+	for i := len(mws) - 1; i >= 0; i-- {
+		d = mws[i](d)
+	}
+	return d
+}
+
+// DryRunner is implemented by drivers able to check a statement without running it
+type DryRunner interface {
+	DryRun(env.Running, map[string]interface{}) (interface{}, error)
+}
+
+// DryRun is the middleware running the DryRun of drivers when the env is in dry run mode.
+// It has to directly wrap the driver; the ones not implementing DryRunner are run as is
+func DryRun(next Driver) Driver {
+	dryRunner, ok := next.(DryRunner)
+	if !ok {
+		return next
+	}
+	return Func(func(renv env.Running, params map[string]interface{}) (interface{}, error) {
+		if renv.IsDryRun() {
+			return dryRunner.DryRun(renv, params)
+		}
+		return next.Run(renv, params)
+	})
+}
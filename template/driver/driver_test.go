@@ -0,0 +1,83 @@
+package driver
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/wallix/awless/template/env"
+)
+
+func TestChain(t *testing.T) {
+	var calls []string
+	record := func(name string) Middleware {
+		return func(next Driver) Driver {
+			return Func(func(renv env.Running, params map[string]interface{}) (interface{}, error) {
+				calls = append(calls, "before "+name)
+				res, err := next.Run(renv, params)
+				calls = append(calls, "after "+name)
+				return res, err
+			})
+		}
+	}
+	d := Func(func(renv env.Running, params map[string]interface{}) (interface{}, error) {
+		calls = append(calls, "run")
+		return params["id"], nil
+	})
+
+	res, err := Chain(d, record("log"), record("audit")).Run(nil, map[string]interface{}{"id": "i-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := res, "i-1"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := calls, []string{"before log", "before audit", "run", "after audit", "after log"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	calls = nil
+	if _, err = Chain(d).Run(nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := calls, []string{"run"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	d := DryRun(&dryRunnable{})
+	res, err := d.Run(&dryRunEnv{dryRun: true}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := res, "dry run"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if res, _ = d.Run(&dryRunEnv{}, nil); res != "run" {
+		t.Fatalf("got %v, want run", res)
+	}
+
+	notDryRunnable := Func(func(renv env.Running, params map[string]interface{}) (interface{}, error) {
+		return "run", nil
+	})
+	if res, _ = DryRun(notDryRunnable).Run(&dryRunEnv{dryRun: true}, nil); res != "run" {
+		t.Fatalf("got %v, want run", res)
+	}
+}
+
+type dryRunEnv struct {
+	env.Running
+	dryRun bool
+}
+
+func (e *dryRunEnv) IsDryRun() bool { return e.dryRun }
+
+type dryRunnable struct{}
+
+func (d *dryRunnable) Run(env.Running, map[string]interface{}) (interface{}, error) {
+	return "run", nil
+}
+
+func (d *dryRunnable) DryRun(env.Running, map[string]interface{}) (interface{}, error) {
+	return "dry run", nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var validNamespace = regexp.MustCompile("^[a-z0-9]+$")
+
+// LookupFunc returns the command of a definition key, made of an action
+// followed by an entity (ex: "createinstance"), or nil when unsupported
+type LookupFunc func(tokens ...string) interface{}
+
+// Mux is a composite lookup routing the namespaced entities (ex: gcp.instance,
+// dns.record) to the lookup registered for their namespace, which receives
+// the key without namespace (ex: "createinstance"). Entities without
+// namespace go to the default lookup
+type Mux struct {
+	def LookupFunc
+
+	mu         sync.RWMutex
+	namespaces map[string]LookupFunc
+}
+
+func NewMux(def LookupFunc) *Mux {
+	return &Mux{def: def, namespaces: make(map[string]LookupFunc)}
+}
+
+// Register routes the entities of the namespace to the lookup of a sub driver
+func (m *Mux) Register(namespace string, lookup LookupFunc) error {
+	if !validNamespace.MatchString(namespace) {
+		return fmt.Errorf("invalid driver namespace '%s': expecting lowercase letters or digits", namespace)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.namespaces[namespace]; ok {
+		return fmt.Errorf("driver namespace '%s' already registered", namespace)
+	}
+	m.namespaces[namespace] = lookup
+	return nil
+}
+
+// Namespaces returns the registered namespaces, sorted
+func (m *Mux) Namespaces() (namespaces []string) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for ns := range m.namespaces {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+	return
+}
+
+// Lookup implements LookupFunc. The namespace of a key is the longest registered one
+// preceding the dot of the entity (ex: gcp in "creategcp.instance")
+func (m *Mux) Lookup(tokens ...string) interface{} {
+	key := strings.Join(tokens, "")
+	dot := strings.Index(key, ".")
+	if dot < 0 {
+		if m.def == nil {
+			return nil
+		}
+		return m.def(key)
+	}
+	head, entity := key[:dot], key[dot+1:]
+
+	m.mu.RLock()
+	var namespace string
+	var lookup LookupFunc
+	for ns, l := range m.namespaces {
+		if strings.HasSuffix(head, ns) && len(ns) > len(namespace) {
+			namespace, lookup = ns, l
+		}
+	}
+	m.mu.RUnlock()
+
+	if lookup == nil {
+		return nil
+	}
+	return lookup(strings.TrimSuffix(head, namespace) + entity)
+}
@@ -0,0 +1,56 @@
+package driver
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMux(t *testing.T) {
+	recordLookup := func(name string) LookupFunc {
+		return func(tokens ...string) interface{} {
+			key := strings.Join(tokens, "")
+			if strings.HasSuffix(key, "unsupported") {
+				return nil
+			}
+			return name + ":" + key
+		}
+	}
+	mux := NewMux(recordLookup("aws"))
+	if err := mux.Register("gcp", recordLookup("gcp")); err != nil {
+		t.Fatal(err)
+	}
+	if err := mux.Register("cp", recordLookup("cp")); err != nil {
+		t.Fatal(err)
+	}
+	if err := mux.Register("gcp", recordLookup("other")); err == nil {
+		t.Fatal("expected error on namespace already registered")
+	}
+	if err := mux.Register("my-dns", recordLookup("dns")); err == nil {
+		t.Fatal("expected error on invalid namespace")
+	}
+	if got, want := mux.Namespaces(), []string{"cp", "gcp"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	tcases := []struct {
+		tokens []string
+		exp    interface{}
+	}{
+		{tokens: []string{"createinstance"}, exp: "aws:createinstance"},
+		{tokens: []string{"create", "instance"}, exp: "aws:createinstance"},
+		{tokens: []string{"creategcp.instance"}, exp: "gcp:createinstance"},
+		{tokens: []string{"delete", "cp.bucket"}, exp: "cp:deletebucket"},
+		{tokens: []string{"createdns.record"}, exp: nil},
+		{tokens: []string{"creategcp.unsupported"}, exp: nil},
+	}
+	for _, tcase := range tcases {
+		if got, want := mux.Lookup(tcase.tokens...), tcase.exp; got != want {
+			t.Fatalf("%v: got %v, want %v", tcase.tokens, got, want)
+		}
+	}
+
+	if got := NewMux(nil).Lookup("createinstance"); got != nil {
+		t.Fatalf("got %v, want nil", got)
+	}
+}
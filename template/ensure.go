@@ -0,0 +1,117 @@
+package template
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// DefinitionAction returns the action of the command definition implementing an action:
+// an ensure command is run, when no matching resource exists, by the create command of its entity
+func DefinitionAction(action string) string {
+	if action == string(ast.Ensure) {
+		return string(ast.Create)
+	}
+	return action
+}
+
+// findEnsuredResource returns the id of the existing resource matching the params of an ensure command,
+// or an empty string when none exists. The resources are fetched from the cloud, a stale local graph
+// leading to duplicates or to the id of a deleted resource.
+// A param identifies a resource when resources of its type have a property of the same name
+// (ex: name -> Name, cidr -> CIDR); a resource matches when all identifying params have equal values.
+func findEnsuredResource(renv env.Running, cmd *ast.CommandNode) (string, error) {
+	g, err := renv.FetchGraph(cmd.Entity)
+	if err != nil {
+		return "", fmt.Errorf("cannot check existing %s: %s", cloud.PluralizeResource(cmd.Entity), err)
+	}
+	resources, err := g.Find(cloud.NewQuery(cmd.Entity))
+	if err != nil || len(resources) == 0 {
+		return "", err
+	}
+
+	params := cmd.ToDriverParams()
+	identifying := make(map[string]string)
+	for key := range params {
+		for _, res := range resources {
+			if prop, ok := propertyForParam(res, key); ok {
+				identifying[key] = prop
+				break
+			}
+		}
+	}
+	if len(identifying) == 0 {
+		return "", fmt.Errorf("no param identifies an existing %s among: %s", cmd.Entity, strings.Join(cmd.Keys(), ", "))
+	}
+
+	var matching []cloud.Resource
+	for _, res := range resources {
+		if resourceMatchesParams(res, params, identifying) {
+			matching = append(matching, res)
+		}
+	}
+
+	switch len(matching) {
+	case 0:
+		return "", nil
+	case 1:
+		return matching[0].Id(), nil
+	default:
+		var ids []string
+		for _, res := range matching {
+			ids = append(ids, res.Id())
+		}
+		sort.Strings(ids)
+		return "", fmt.Errorf("%d existing %s match (%s): use more specific params", len(matching), cloud.PluralizeResource(cmd.Entity), strings.Join(ids, ", "))
+	}
+}
+
+func propertyForParam(res cloud.Resource, key string) (string, bool) {
+	normalized := strings.Replace(key, "-", "", -1)
+	for prop := range res.Properties() {
+		if strings.EqualFold(prop, normalized) {
+			return prop, true
+		}
+	}
+	return "", false
+}
+
+func resourceMatchesParams(res cloud.Resource, params map[string]interface{}, identifying map[string]string) bool {
+	for key, prop := range identifying {
+		actual, ok := res.Properties()[prop]
+		if !ok || !valuesEqual(params[key], actual) {
+			return false
+		}
+	}
+	return true
+}
+
+func valuesEqual(param, prop interface{}) bool {
+	switch pp := prop.(type) {
+	case []string:
+		var values []string
+		switch v := param.(type) {
+		case []interface{}:
+			for _, e := range v {
+				values = append(values, fmt.Sprint(e))
+			}
+		default:
+			values = append(values, fmt.Sprint(v))
+		}
+		if len(values) != len(pp) {
+			return false
+		}
+		for _, v := range values {
+			if !contains(pp, v) {
+				return false
+			}
+		}
+		return true
+	default:
+		return fmt.Sprint(param) == fmt.Sprint(prop)
+	}
+}
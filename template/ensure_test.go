@@ -0,0 +1,100 @@
+package template
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+func TestEnsure(t *testing.T) {
+	g := graph.NewGraph()
+	g.AddResource(
+		resourcetest.VPC("vpc_1").Prop("Name", "main").Prop("CIDR", "10.0.0.0/16").Build(),
+		resourcetest.VPC("vpc_2").Prop("Name", "other").Prop("CIDR", "10.0.0.0/16").Build(),
+	)
+
+	tcases := []struct {
+		tpl        string
+		graph      cloud.GraphAPI
+		fetchErr   error
+		expResult  string
+		expAction  string
+		expCreated bool
+		expErr     string
+	}{
+		{tpl: "ensure vpc cidr=10.0.0.0/16 name=main", graph: g, expResult: "vpc_1", expAction: "ensure"},
+		{tpl: "ensure vpc name=other", graph: g, expResult: "vpc_2", expAction: "ensure"},
+		{tpl: "ensure vpc cidr=10.1.0.0/16 name=main", graph: g, expResult: "vpc_new", expAction: "create", expCreated: true},
+		{tpl: "ensure vpc cidr=10.0.0.0/16 name=main", graph: graph.NewGraph(), expResult: "vpc_new", expAction: "create", expCreated: true},
+		{tpl: "ensure vpc cidr=10.0.0.0/16", graph: g, expErr: "2 existing vpcs match (vpc_1, vpc_2)"},
+		{tpl: "ensure vpc ipv6=true", graph: g, expErr: "no param identifies an existing vpc"},
+		{tpl: "ensure vpc name=main", graph: g, fetchErr: errors.New("RequestExpired"), expErr: "cannot check existing vpcs: RequestExpired"},
+	}
+
+	for i, tcase := range tcases {
+		create := &mockCreateCommand{result: "vpc_new"}
+		cenv := NewEnv().WithLookupCommandFunc(func(tokens ...string) interface{} {
+			if key := strings.Join(tokens, ""); key != "createvpc" {
+				t.Fatalf("%d: unexpected command lookup %s", i+1, key)
+			}
+			return create
+		}).WithLookupGraphFunc(func(string) (cloud.GraphAPI, bool) {
+			t.Fatalf("%d: unexpected lookup of the local graph", i+1)
+			return nil, false
+		}).WithFetchGraphFunc(func(string) (cloud.GraphAPI, error) {
+			return tcase.graph, tcase.fetchErr
+		}).Build()
+
+		pass := newMultiPass(injectCommandsInNodesPass, resolveParamsAndExtractRefsPass)
+		compiled, cenv, err := pass.compile(MustParse(tcase.tpl), cenv)
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		ran, err := compiled.Run(NewRunEnv(cenv))
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		cmd := ran.CommandNodesIterator()[0]
+		if tcase.expErr != "" {
+			if cmd.CmdErr == nil {
+				t.Fatalf("%d: expected error, got nil", i+1)
+			}
+			if got, want := cmd.CmdErr.Error(), tcase.expErr; !strings.Contains(got, want) {
+				t.Fatalf("%d: got %s, want %s", i+1, got, want)
+			}
+			continue
+		}
+		if cmd.CmdErr != nil {
+			t.Fatalf("%d: %s", i+1, cmd.CmdErr)
+		}
+		if got, want := cmd.CmdResult, tcase.expResult; got != want {
+			t.Fatalf("%d: got %v, want %s", i+1, got, want)
+		}
+		if got, want := cmd.Action, tcase.expAction; got != want {
+			t.Fatalf("%d: got %s, want %s", i+1, got, want)
+		}
+		if got, want := create.calls > 0, tcase.expCreated; got != want {
+			t.Fatalf("%d: created: got %t, want %t", i+1, got, want)
+		}
+		if got, want := isRevertible(cmd), tcase.expCreated; got != want {
+			t.Fatalf("%d: revertible: got %t, want %t", i+1, got, want)
+		}
+	}
+}
+
+type mockCreateCommand struct {
+	result string
+	calls  int
+}
+
+func (c *mockCreateCommand) ParamsSpec() params.Spec { return params.NewSpec(nil) }
+func (c *mockCreateCommand) Run(env.Running, map[string]interface{}) (interface{}, error) {
+	c.calls++
+	return c.result, nil
+}
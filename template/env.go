@@ -1,9 +1,13 @@
 package template
 
 import (
+	"context"
+	"fmt"
 	"sync"
 
+	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/driver"
 	"github.com/wallix/awless/template/env"
 )
 
@@ -13,14 +17,26 @@ var (
 )
 
 type runEnv struct {
-	log    *logger.Logger
-	dryRun bool
-	ctx    map[string]interface{}
+	log         *logger.Logger
+	dryRun      bool
+	ctx         map[string]interface{}
+	lookupGraph func(string) (cloud.GraphAPI, bool)
+	fetchGraph  func(string) (cloud.GraphAPI, error)
+	parallelism int
+	observer    env.Observer
 }
 
 func NewRunEnv(cenv env.Compiling, context ...map[string]interface{}) env.Running {
 	renv := new(runEnv)
 	renv.log = cenv.Log()
+	renv.lookupGraph = cenv.LookupGraphFunc()
+	if f, ok := cenv.(interface {
+		FetchGraphFunc() func(string) (cloud.GraphAPI, error)
+	}); ok {
+		renv.fetchGraph = f.FetchGraphFunc()
+	}
+	renv.parallelism = cenv.Parallelism()
+	renv.observer = cenv.Observer()
 	renv.ctx = make(map[string]interface{})
 	for _, m := range context {
 		for k, v := range m {
@@ -43,6 +59,63 @@ func (e *runEnv) SetDryRun(b bool) {
 	e.dryRun = b
 }
 
+func (e *runEnv) LookupGraph(resourceType string) (cloud.GraphAPI, bool) {
+	if e.lookupGraph == nil {
+		return nil, false
+	}
+	return e.lookupGraph(resourceType)
+}
+
+func (e *runEnv) FetchGraph(resourceType string) (cloud.GraphAPI, error) {
+	if e.fetchGraph == nil {
+		return nil, fmt.Errorf("cannot fetch %s: no cloud access", cloud.PluralizeResource(resourceType))
+	}
+	return e.fetchGraph(resourceType)
+}
+
+// Parallelism returns the max number of independent commands run concurrently
+func (e *runEnv) Parallelism() int {
+	if e.parallelism < 1 {
+		return 1
+	}
+	return e.parallelism
+}
+
+func (e *runEnv) Observer() env.Observer {
+	if e.observer == nil {
+		return env.NopObserver{}
+	}
+	return e.observer
+}
+
+func (e *runEnv) Ctx() context.Context {
+	return context.Background()
+}
+
+// contextEnv is the running env of a template run with a context
+type contextEnv struct {
+	env.Running
+	ctx context.Context
+}
+
+func (e *contextEnv) Ctx() context.Context {
+	return e.ctx
+}
+
+func (e *runEnv) Statement() env.Statement {
+	return env.Statement{}
+}
+
+// statementEnv is the running env given to the driver of a statement
+type statementEnv struct {
+	env.Running
+	statement env.Statement
+}
+
+func (e *statementEnv) Statement() env.Statement {
+	return e.statement
+}
+
 func (e *runEnv) Context() (out map[string]interface{}) {
 	out = make(map[string]interface{})
 	for k, v := range e.ctx {
@@ -58,8 +131,17 @@ func (e *runEnv) Log() *logger.Logger {
 type compileEnv struct {
 	*dataMap
 	lookupCommandFunc func(...string) interface{}
+	accountLookupFunc func(account, role, key string) (interface{}, error)
+	middlewares       []driver.Middleware
 	aliasFunc         func(paramPath, alias string) string
+	aliasQueryFunc    func(paramPath, query string) (string, error)
 	missingHolesFunc  func(string, []string, bool) string
+	azsFunc           func() ([]string, error)
+	stackRefFunc      func(stack, name string) (string, error)
+	lookupGraphFunc   func(string) (cloud.GraphAPI, bool)
+	fetchGraphFunc    func(string) (cloud.GraphAPI, error)
+	parallelism       int
+	observer          env.Observer
 	log               *logger.Logger
 	paramsSuggested   int
 }
@@ -68,14 +150,56 @@ func (e *compileEnv) LookupCommandFunc() func(...string) interface{} {
 	return e.lookupCommandFunc
 }
 
+// Middlewares returns the middlewares wrapping the looked up commands
+func (e *compileEnv) Middlewares() []driver.Middleware {
+	return e.middlewares
+}
+
+// AccountLookupCommandFunc returns the lookup of the commands run in other accounts
+func (e *compileEnv) AccountLookupCommandFunc() func(account, role, key string) (interface{}, error) {
+	return e.accountLookupFunc
+}
+
 func (e *compileEnv) AliasFunc() func(paramPath, alias string) string {
 	return e.aliasFunc
 }
 
+func (e *compileEnv) AliasQueryFunc() func(paramPath, query string) (string, error) {
+	return e.aliasQueryFunc
+}
+
 func (e *compileEnv) MissingHolesFunc() func(string, []string, bool) string {
 	return e.missingHolesFunc
 }
 
+func (e *compileEnv) AvailabilityZonesFunc() func() ([]string, error) {
+	return e.azsFunc
+}
+
+func (e *compileEnv) StackRefFunc() func(stack, name string) (string, error) {
+	return e.stackRefFunc
+}
+
+func (e *compileEnv) LookupGraphFunc() func(string) (cloud.GraphAPI, bool) {
+	return e.lookupGraphFunc
+}
+
+// FetchGraphFunc returns the fetch of the resources of a type from the cloud
+func (e *compileEnv) FetchGraphFunc() func(string) (cloud.GraphAPI, error) {
+	return e.fetchGraphFunc
+}
+
+func (e *compileEnv) Parallelism() int {
+	return e.parallelism
+}
+
+func (e *compileEnv) Observer() env.Observer {
+	if e.observer == nil {
+		return env.NopObserver{}
+	}
+	return e.observer
+}
+
 func (e *compileEnv) ParamsMode() int {
 	return e.paramsSuggested
 }
@@ -135,21 +259,72 @@ func (b *envBuilder) WithAliasFunc(fn func(paramPath, alias string) string) *env
 	return b
 }
 
+func (b *envBuilder) WithAliasQueryFunc(fn func(paramPath, query string) (string, error)) *envBuilder {
+	b.E.aliasQueryFunc = fn
+	return b
+}
+
 func (b *envBuilder) WithMissingHolesFunc(fn func(string, []string, bool) string) *envBuilder {
 	b.E.missingHolesFunc = fn
 	return b
 }
 
+func (b *envBuilder) WithAvailabilityZonesFunc(fn func() ([]string, error)) *envBuilder {
+	b.E.azsFunc = fn
+	return b
+}
+
+// WithStackRefFunc resolves the security groups referenced as sg:STACK/NAME to their ids
+func (b *envBuilder) WithStackRefFunc(fn func(stack, name string) (string, error)) *envBuilder {
+	b.E.stackRefFunc = fn
+	return b
+}
+
+func (b *envBuilder) WithLookupGraphFunc(fn func(string) (cloud.GraphAPI, bool)) *envBuilder {
+	b.E.lookupGraphFunc = fn
+	return b
+}
+
+// WithFetchGraphFunc fetches the resources of a type from the cloud, for ensure commands
+// to check the existing resources before creating them
+func (b *envBuilder) WithFetchGraphFunc(fn func(string) (cloud.GraphAPI, error)) *envBuilder {
+	b.E.fetchGraphFunc = fn
+	return b
+}
+
 func (b *envBuilder) WithLookupCommandFunc(fn func(...string) interface{}) *envBuilder {
 	b.E.lookupCommandFunc = fn
 	return b
 }
 
+// WithAccountLookupCommandFunc builds the commands of the statements run in another account
+// (ex: account=123456789012), authenticated with the role given by the statement (optional)
+func (b *envBuilder) WithAccountLookupCommandFunc(fn func(account, role, key string) (interface{}, error)) *envBuilder {
+	b.E.accountLookupFunc = fn
+	return b
+}
+
+// WithMiddlewares wraps the looked up commands with the middlewares, the first one being the outermost
+func (b *envBuilder) WithMiddlewares(mws ...driver.Middleware) *envBuilder {
+	b.E.middlewares = append(b.E.middlewares, mws...)
+	return b
+}
+
 func (b *envBuilder) WithLog(l *logger.Logger) *envBuilder {
 	b.E.log = l
 	return b
 }
 
+func (b *envBuilder) WithParallelism(n int) *envBuilder {
+	b.E.parallelism = n
+	return b
+}
+
+func (b *envBuilder) WithObserver(o env.Observer) *envBuilder {
+	b.E.observer = o
+	return b
+}
+
 func (b *envBuilder) WithParamsMode(paramsSuggested int) *envBuilder {
 	b.E.paramsSuggested = paramsSuggested
 	return b
@@ -1,6 +1,10 @@
 package env
 
 import (
+	"context"
+	"time"
+
+	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
 )
 
@@ -25,14 +29,75 @@ type Running interface {
 	Context() map[string]interface{}
 	IsDryRun() bool
 	SetDryRun(b bool)
+	LookupGraph(resourceType string) (cloud.GraphAPI, bool)
+	// FetchGraph fetches the resources of a type from the cloud, bypassing the locally synced graph
+	FetchGraph(resourceType string) (cloud.GraphAPI, error)
+	Parallelism() int
+	Observer() Observer
+	// Ctx returns the context of the run, cancelled to interrupt it.
+	// Drivers pass it down to their long running calls
+	Ctx() context.Context
+	// Statement returns the statement being run, when called from a driver
+	Statement() Statement
 }
 
 type Compiling interface {
 	log
 	LookupCommandFunc() func(...string) interface{}
 	AliasFunc() func(paramPath, alias string) string
+	AliasQueryFunc() func(paramPath, query string) (string, error)
 	MissingHolesFunc() func(string, []string, bool) string
+	AvailabilityZonesFunc() func() ([]string, error)
+	StackRefFunc() func(stack, name string) (string, error)
+	LookupGraphFunc() func(resourceType string) (cloud.GraphAPI, bool)
+	Parallelism() int
+	Observer() Observer
 	ParamsMode() int
 	Push(int, ...map[string]interface{})
 	Get(int) map[string]interface{}
 }
+
+// Statement describes to observers a statement of a running template
+type Statement struct {
+	// Index of the statement in the template (starting at 1) and number of statements
+	Index, Total   int
+	Action, Entity string
+	Line           string
+	// where the statement comes from (ex: vpc.aws:12), when known
+	Origin string
+
+	// Set once the statement is done
+	Result   interface{}
+	Err      error
+	Duration time.Duration
+}
+
+// Observer is notified of the progress of a template run, so that CLIs and
+// embedders can render progress bars, spinners or streaming logs.
+// Statements of independent deletes being run concurrently, implementations must be safe for concurrent use
+type Observer interface {
+	OnStatementStart(Statement)
+	OnStatementDone(Statement)
+	OnRetry(st Statement, attempt int, reason error)
+}
+
+type NopObserver struct{}
+
+func (NopObserver) OnStatementStart(Statement)    {}
+func (NopObserver) OnStatementDone(Statement)     {}
+func (NopObserver) OnRetry(Statement, int, error) {}
+
+// NotifyRetry lets drivers report that the running statement is being retried
+func NotifyRetry(renv Running, attempt int, reason error) {
+	if renv != nil {
+		renv.Observer().OnRetry(renv.Statement(), attempt, reason)
+	}
+}
+
+// Ctx returns the context of the run, or a background context when renv is nil
+func Ctx(renv Running) context.Context {
+	if renv == nil {
+		return context.Background()
+	}
+	return renv.Ctx()
+}
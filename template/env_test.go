@@ -0,0 +1,167 @@
+package template
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/wallix/awless/template/driver"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+func TestRunNotifiesObserver(t *testing.T) {
+	tpl := "delete snapshot id=snap-1\ndelete snapshot id=snap-2\ndelete volume id=failing"
+	for _, parallelism := range []int{1, 2} {
+		observer := &recordingObserver{}
+		cenv := NewEnv().WithLookupCommandFunc(func(tokens ...string) interface{} {
+			return &mockRetryingCommand{}
+		}).WithParallelism(parallelism).WithObserver(observer).Build()
+
+		pass := newMultiPass(injectCommandsInNodesPass, resolveParamsAndExtractRefsPass)
+		compiled, cenv, err := pass.compile(MustParse(tpl), cenv)
+		if err != nil {
+			t.Fatal(err)
+		}
+		renv := NewRunEnv(cenv)
+		if _, err = compiled.DryRun(renv); err != nil {
+			t.Fatal(err)
+		}
+		if got := len(observer.events); got != 0 {
+			t.Fatalf("parallelism %d: expected no events on dry run, got %v", parallelism, observer.events)
+		}
+		if _, err = compiled.Run(renv); err != nil {
+			t.Fatal(err)
+		}
+		sort.Strings(observer.events)
+		expected := []string{
+			"done 1/3 delete snapshot id=snap-1: snap-1 <nil>",
+			"done 2/3 delete snapshot id=snap-2: snap-2 <nil>",
+			"done 3/3 delete volume id=failing: <nil> cannot delete",
+			"retry 1/3 delete snapshot id=snap-1: 1 not yet",
+			"retry 2/3 delete snapshot id=snap-2: 1 not yet",
+			"retry 3/3 delete volume id=failing: 1 not yet",
+			"start 1/3 delete snapshot id=snap-1",
+			"start 2/3 delete snapshot id=snap-2",
+			"start 3/3 delete volume id=failing",
+		}
+		if got, want := observer.events, expected; !reflect.DeepEqual(got, want) {
+			t.Fatalf("parallelism %d: got\n%s\nwant\n%s", parallelism, strings.Join(got, "\n"), strings.Join(want, "\n"))
+		}
+	}
+}
+
+func TestRunErrorsAndResultsReferenceOrigin(t *testing.T) {
+	tpl := MustParse("# cleanup\ndelete snapshot id=snap-1\n\nsnap = delete volume id=failing")
+	tpl.setOriginFile("cleanup.aws")
+	observer := &recordingObserver{}
+	cenv := NewEnv().WithLookupCommandFunc(func(tokens ...string) interface{} {
+		return &mockRetryingCommand{}
+	}).WithObserver(observer).Build()
+
+	pass := newMultiPass(injectCommandsInNodesPass, resolveParamsAndExtractRefsPass)
+	compiled, cenv, err := pass.compile(tpl, cenv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ran, err := compiled.Run(NewRunEnv(cenv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds := ran.CommandNodesIterator()
+	if got, want := cmds[1].CmdErr.Error(), "cleanup.aws:4: cannot delete"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := observer.events[len(observer.events)-1], "done 2/2 delete volume id=failing: <nil> cleanup.aws:4: cannot delete"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	res := (&TemplateExecution{Template: ran}).Result()
+	if got, want := res.Statements[0].Origin, "cleanup.aws:2"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := res.Statements[1].Origin, "cleanup.aws:4"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+type recordingObserver struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (o *recordingObserver) record(format string, a ...interface{}) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.events = append(o.events, fmt.Sprintf(format, a...))
+}
+
+func (o *recordingObserver) OnStatementStart(st env.Statement) {
+	o.record("start %d/%d %s", st.Index, st.Total, st.Line)
+}
+
+func (o *recordingObserver) OnStatementDone(st env.Statement) {
+	o.record("done %d/%d %s: %v %v", st.Index, st.Total, st.Line, st.Result, st.Err)
+}
+
+func (o *recordingObserver) OnRetry(st env.Statement, attempt int, reason error) {
+	o.record("retry %d/%d %s: %d %s", st.Index, st.Total, st.Line, attempt, reason)
+}
+
+type mockRetryingCommand struct{}
+
+func (c *mockRetryingCommand) ParamsSpec() params.Spec { return params.NewSpec(nil) }
+func (c *mockRetryingCommand) Run(renv env.Running, p map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return nil, nil
+	}
+	env.NotifyRetry(renv, 1, errors.New("not yet"))
+	if id := p["id"]; id != "failing" {
+		return id, nil
+	}
+	return nil, errors.New("cannot delete")
+}
+
+func TestRunThroughMiddlewares(t *testing.T) {
+	var calls []string
+	audit := func(next driver.Driver) driver.Driver {
+		return driver.Func(func(renv env.Running, params map[string]interface{}) (interface{}, error) {
+			res, err := next.Run(renv, params)
+			calls = append(calls, fmt.Sprintf("dryrun=%t %v", renv.IsDryRun(), res))
+			return res, err
+		})
+	}
+	cenv := NewEnv().WithLookupCommandFunc(func(tokens ...string) interface{} {
+		return &mockDryRunnableCommand{}
+	}).WithMiddlewares(audit).Build()
+
+	pass := newMultiPass(injectCommandsInNodesPass, resolveParamsAndExtractRefsPass)
+	compiled, cenv, err := pass.compile(MustParse("delete snapshot id=snap-1"), cenv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	renv := NewRunEnv(cenv)
+	if _, err = compiled.DryRun(renv); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = compiled.Run(renv); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := calls, []string{"dryrun=true dry-snap-1", "dryrun=false snap-1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+type mockDryRunnableCommand struct{}
+
+func (c *mockDryRunnableCommand) ParamsSpec() params.Spec { return params.NewSpec(nil) }
+func (c *mockDryRunnableCommand) Run(renv env.Running, p map[string]interface{}) (interface{}, error) {
+	return p["id"], nil
+}
+func (c *mockDryRunnableCommand) DryRun(renv env.Running, p map[string]interface{}) (interface{}, error) {
+	return fmt.Sprintf("dry-%s", p["id"]), nil
+}
@@ -0,0 +1,162 @@
+package template
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+var explainedActions = map[string]string{
+	"accept": "Accepts", "attach": "Attaches", "authenticate": "Authenticates", "cancel": "Cancels", "check": "Checks that", "copy": "Copies",
+	"create": "Creates", "delete": "Deletes", "detach": "Detaches", "disable": "Disables", "download": "Downloads", "enable": "Enables", "ensure": "Ensures", "get": "Gets",
+	"import": "Imports", "invoke": "Invokes", "move": "Moves", "reboot": "Reboots", "register": "Registers", "resize": "Resizes", "restart": "Restarts", "restore": "Restores", "run": "Runs", "start": "Starts", "stop": "Stops", "submit": "Submits", "terminate": "Terminates", "update": "Updates",
+	"wait": "Waits for",
+}
+
+// Explain describes each statement of the parsed template as a sentence, with references
+// to previous statements spelled out. It also returns the holes still to be filled, sorted.
+// When aliasFunc is not nil, it is used to show the resources aliases resolve to.
+func (s *Template) Explain(aliasFunc func(paramPath, alias string) string) (sentences []string, holes []string) {
+	e := &explainer{declaredAt: make(map[string]int), declaredBy: make(map[string]*ast.CommandNode), holes: make(map[string]bool), aliasFunc: aliasFunc}
+	for i, sts := range s.Statements {
+		var sentence string
+		switch n := sts.Node.(type) {
+		case *ast.CommandNode:
+			sentence = e.command(n)
+		case *ast.DeclarationNode:
+			switch expr := n.Expr.(type) {
+			case *ast.CommandNode:
+				sentence = fmt.Sprintf("%s, referenced as $%s", e.command(expr), n.Ident)
+				e.declaredBy[n.Ident] = expr
+			default:
+				sentence = fmt.Sprintf("Sets $%s to %s", n.Ident, e.value("", "", expressionValue(expr)))
+			}
+			e.declaredAt[n.Ident] = i + 1
+		default:
+			sentence = fmt.Sprint(sts.Node)
+		}
+		sentences = append(sentences, fmt.Sprintf("%d. %s", i+1, sentence))
+	}
+	for h := range e.holes {
+		holes = append(holes, h)
+	}
+	sort.Strings(holes)
+	return
+}
+
+type explainer struct {
+	declaredAt map[string]int
+	declaredBy map[string]*ast.CommandNode
+	holes      map[string]bool
+	aliasFunc  func(paramPath, alias string) string
+}
+
+func (e *explainer) command(n *ast.CommandNode) string {
+	verb, ok := explainedActions[n.Action]
+	if !ok {
+		verb = strings.Title(n.Action) + "s"
+	}
+	var subject string
+	switch n.Action {
+	case "create", "copy", "import":
+		subject = withArticle(n.Entity)
+	default:
+		subject = "the " + n.Entity
+	}
+
+	var keys []string
+	for k := range n.ParamNodes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var with, in []string
+	for _, k := range keys {
+		v := e.value(fmt.Sprintf("%s.%s.%s", n.Action, n.Entity, k), k, n.ParamNodes[k])
+		if ref, isRef := n.ParamNodes[k].(ast.RefNode); isRef && e.declaredBy[ref.Ref()] != nil && e.declaredBy[ref.Ref()].Entity == k {
+			in = append(in, v)
+			continue
+		}
+		with = append(with, fmt.Sprintf("%s %s", k, v))
+	}
+
+	sentence := verb + " " + subject
+	if len(in) > 0 {
+		sentence += " in " + strings.Join(in, " and ")
+	}
+	if len(with) > 0 {
+		sentence += " with " + joinWithAnd(with)
+	}
+	return sentence
+}
+
+func (e *explainer) value(paramPath, key string, node interface{}) string {
+	switch n := node.(type) {
+	case ast.HoleNode:
+		e.holes[n.Hole()] = true
+		if n.IsOptional() {
+			return fmt.Sprintf("%s (optional, to be provided)", n)
+		}
+		return fmt.Sprintf("%s (to be provided)", n)
+	case ast.RefNode:
+		step, declared := e.declaredAt[n.Ref()]
+		if !declared {
+			return fmt.Sprintf("%s (undefined)", n)
+		}
+		if cmd := e.declaredBy[n.Ref()]; cmd != nil {
+			if cmd.Action == "create" {
+				return fmt.Sprintf("the %s created at step %d (%s)", cmd.Entity, step, n)
+			}
+			return fmt.Sprintf("the result of step %d (%s)", step, n)
+		}
+		return fmt.Sprintf("the value set at step %d (%s)", step, n)
+	case ast.AliasNode:
+		if e.aliasFunc != nil && paramPath != "" {
+			if resolved := e.aliasFunc(paramPath, n.Alias()); resolved != "" {
+				return fmt.Sprintf("%s (resolved to %s)", n, resolved)
+			}
+			return fmt.Sprintf("%s (unresolved)", n)
+		}
+		return n.String()
+	case ast.ListNode:
+		var elems []string
+		for _, el := range n.Elems() {
+			elems = append(elems, e.value(paramPath, key, el))
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	case ast.ConcatenationNode:
+		for _, el := range n.Elems() {
+			if h, ok := el.(ast.HoleNode); ok {
+				e.holes[h.Hole()] = true
+			}
+		}
+		return n.String()
+	case nil:
+		return "nothing"
+	default:
+		return fmt.Sprint(n)
+	}
+}
+
+func expressionValue(expr ast.ExpressionNode) interface{} {
+	if right, ok := expr.(*ast.RightExpressionNode); ok {
+		return right.Node()
+	}
+	return expr
+}
+
+func withArticle(s string) string {
+	if s != "" && strings.ContainsRune("aeiou", rune(s[0])) {
+		return "an " + s
+	}
+	return "a " + s
+}
+
+func joinWithAnd(elems []string) string {
+	if len(elems) < 2 {
+		return strings.Join(elems, "")
+	}
+	return strings.Join(elems[:len(elems)-1], ", ") + " and " + elems[len(elems)-1]
+}
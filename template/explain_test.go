@@ -0,0 +1,40 @@
+package template
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExplainTemplate(t *testing.T) {
+	tpl := MustParse(`vpc = create vpc cidr=10.0.0.0/16 name={vpc.name}
+subnet = create subnet cidr=10.0.1.0/24 vpc=$vpc zone=@eu-west-1a
+name = {instance.name}
+create instance subnet=$subnet name=$name keypair=@mykey securitygroup=[$sg, sg-1234]
+delete instance id=i-1234 ips={ips.missing}
+attach internetgateway id=igw-1234 vpc=$vpc`)
+
+	aliases := map[string]string{"create.instance.keypair.mykey": "mykey"}
+	sentences, holes := tpl.Explain(func(paramPath, alias string) string {
+		return aliases[paramPath+"."+alias]
+	})
+
+	exp := []string{
+		"1. Creates a vpc with cidr 10.0.0.0/16 and name {vpc.name} (to be provided), referenced as $vpc",
+		"2. Creates a subnet in the vpc created at step 1 ($vpc) with cidr 10.0.1.0/24 and zone @eu-west-1a (unresolved), referenced as $subnet",
+		"3. Sets $name to {instance.name} (to be provided)",
+		"4. Creates an instance in the subnet created at step 2 ($subnet) with keypair @mykey (resolved to mykey), name the value set at step 3 ($name) and securitygroup [$sg (undefined), sg-1234]",
+		"5. Deletes the instance with id i-1234 and ips {ips.missing} (to be provided)",
+		"6. Attaches the internetgateway in the vpc created at step 1 ($vpc) with id igw-1234",
+	}
+	if got, want := sentences, exp; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got\n%q\nwant\n%q", got, want)
+	}
+	if got, want := holes, []string{"instance.name", "ips.missing", "vpc.name"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	sentences, _ = MustParse("start instance id=@web").Explain(nil)
+	if got, want := sentences, []string{"1. Starts the instance with id @web"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
@@ -9,20 +9,42 @@ const (
 	Create Action = "create"
 	Delete Action = "delete"
 	Update Action = "update"
+	Ensure Action = "ensure"
 
 	Check Action = "check"
 
 	Start   Action = "start"
 	Restart Action = "restart"
+	Reboot  Action = "reboot"
 	Stop    Action = "stop"
 
+	Terminate Action = "terminate"
+
+	Enable  Action = "enable"
+	Disable Action = "disable"
+
 	Attach Action = "attach"
 	Detach Action = "detach"
 
-	Copy Action = "copy"
+	Accept Action = "accept"
+
+	Copy     Action = "copy"
+	Move     Action = "move"
+	Resize   Action = "resize"
+	Download Action = "download"
+	Get      Action = "get"
 
 	Import       Action = "import"
 	Authenticate Action = "authenticate"
+	Restore      Action = "restore"
+
+	Invoke Action = "invoke"
+	Run    Action = "run"
+	Wait   Action = "wait"
+
+	Register Action = "register"
+	Submit   Action = "submit"
+	Cancel   Action = "cancel"
 )
 
 var actions = map[Action]struct{}{
@@ -30,15 +52,32 @@ var actions = map[Action]struct{}{
 	Create:       {},
 	Delete:       {},
 	Update:       {},
+	Ensure:       {},
 	Check:        {},
 	Start:        {},
 	Restart:      {},
+	Reboot:       {},
 	Stop:         {},
+	Terminate:    {},
+	Enable:       {},
+	Disable:      {},
 	Attach:       {},
 	Detach:       {},
+	Accept:       {},
 	Copy:         {},
+	Move:         {},
+	Resize:       {},
+	Download:     {},
+	Get:          {},
 	Import:       {},
 	Authenticate: {},
+	Restore:      {},
+	Invoke:       {},
+	Run:          {},
+	Wait:         {},
+	Register:     {},
+	Submit:       {},
+	Cancel:       {},
 }
 
 func IsInvalidAction(s string) bool {
@@ -61,16 +61,30 @@ type Command interface {
 func (c *CommandNode) Result() interface{} { return c.CmdResult }
 func (c *CommandNode) Err() error          { return c.CmdErr }
 
+// Keys returns the keys of the params of the command, excluding its modifiers
 func (c *CommandNode) Keys() (keys []string) {
 	for k := range c.ParamNodes {
-		keys = append(keys, k)
+		if !c.IsModifier(k) {
+			keys = append(keys, k)
+		}
 	}
 	for k := range c.Refs {
-		keys = append(keys, k)
+		if !c.IsModifier(k) {
+			keys = append(keys, k)
+		}
 	}
 	return
 }
 
+func (c *CommandNode) IsModifier(key string) bool {
+	for _, m := range c.Modifiers {
+		if m == key {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *CommandNode) String() string {
 	var all []string
 
@@ -78,6 +92,8 @@ func (c *CommandNode) String() string {
 		switch vv := v.(type) {
 		case string:
 			all = append(all, fmt.Sprintf("%s=%v", k, quoteStringIfNeeded(vv)))
+		case params.FileContent:
+			all = append(all, fmt.Sprintf("%s=%v", k, quoteStringIfNeeded(string(vv))))
 		case []interface{}:
 			var a []string
 			for _, e := range vv {
@@ -113,9 +129,12 @@ func (c *CommandNode) String() string {
 func (c *CommandNode) clone() Node {
 	cmd := &CommandNode{
 		Command: c.Command,
+		Driver:  c.Driver,
 		Action:  c.Action, Entity: c.Entity,
+		Origin:     c.Origin,
 		ParamNodes: make(map[string]interface{}),
 		Refs:       make(map[string]interface{}),
+		Modifiers:  c.Modifiers,
 	}
 
 	for k, v := range c.ParamNodes {
@@ -161,7 +180,7 @@ func (c *CommandNode) ToDriverParams() map[string]interface{} {
 		switch node := v.(type) {
 		case InterfaceNode:
 			params[k] = node.i
-		case RefNode, HoleNode, AliasNode:
+		case RefNode, HoleNode, AliasNode, FuncNode:
 		default:
 			params[k] = node
 		}
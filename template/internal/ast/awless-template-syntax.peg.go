@@ -54,6 +54,7 @@ const (
 	ruleWhitespace
 	ruleEndOfLine
 	ruleEndOfFile
+	ruleFuncValue
 	ruleAction0
 	ruleAction1
 	rulePegText
@@ -80,6 +81,7 @@ const (
 	ruleAction22
 	ruleAction23
 	ruleAction24
+	ruleAction25
 )
 
 var rul3s = [...]string{
@@ -124,6 +126,7 @@ var rul3s = [...]string{
 	"Whitespace",
 	"EndOfLine",
 	"EndOfFile",
+	"FuncValue",
 	"Action0",
 	"Action1",
 	"PegText",
@@ -150,6 +153,7 @@ var rul3s = [...]string{
 	"Action22",
 	"Action23",
 	"Action24",
+	"Action25",
 }
 
 type token32 struct {
@@ -266,7 +270,7 @@ type Peg struct {
 
 	Buffer string
 	buffer []rune
-	rules  [67]func() bool
+	rules  [69]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -408,6 +412,8 @@ func (p *Peg) Execute() {
 			p.addFirstValueInConcatenation()
 		case ruleAction24:
 			p.lastValueInConcatenation()
+		case ruleAction25:
+			p.addParamFuncValue(text)
 
 		}
 	}
@@ -835,7 +841,7 @@ func (p *Peg) Init() {
 		nil,
 		/* 2 Action <- <[a-z]+> */
 		nil,
-		/* 3 Entity <- <([a-z] / [0-9])+> */
+		/* 3 Entity <- <(([a-z] / [0-9])+ ('.' ([a-z] / [0-9])+)?)> */
 		nil,
 		/* 4 Declaration <- <(<Identifier> Action2 Equal (CmdExpr / ValueExpr))> */
 		nil,
@@ -916,6 +922,32 @@ func (p *Peg) Init() {
 						l77:
 							position, tokenIndex = position77, tokenIndex77
 						}
+						{
+							position901, tokenIndex901 := position, tokenIndex
+							if buffer[position] != rune('.') {
+								goto l901
+							}
+							position++
+							if c := buffer[position]; (c < rune('a') || c > rune('z')) && (c < rune('0') || c > rune('9')) {
+								goto l901
+							}
+							position++
+						l903:
+							{
+								position904, tokenIndex904 := position, tokenIndex
+								if c := buffer[position]; (c < rune('a') || c > rune('z')) && (c < rune('0') || c > rune('9')) {
+									goto l904
+								}
+								position++
+								goto l903
+							l904:
+								position, tokenIndex = position904, tokenIndex904
+							}
+							goto l902
+						l901:
+							position, tokenIndex = position901, tokenIndex901
+						l902:
+						}
 						add(ruleEntity, position75)
 					}
 					add(rulePegText, position74)
@@ -1237,7 +1269,7 @@ func (p *Peg) Init() {
 		nil,
 		/* 12 ListWithoutSquareBrackets <- <(Action9 (WhiteSpacing Value WhiteSpacing) (',' WhiteSpacing Value WhiteSpacing)+ Action10)> */
 		nil,
-		/* 13 NoRefValue <- <(ConcatenationValue / HoleWithSuffixValue / HoleValue / HolesStringValue / (AliasValue Action11) / (DoubleQuote CustomTypedValue DoubleQuote) / (SingleQuote CustomTypedValue SingleQuote) / CustomTypedValue / QuotedStringValue / UnquotedParamValue)> */
+		/* 13 NoRefValue <- <(ConcatenationValue / HoleWithSuffixValue / HoleValue / HolesStringValue / (AliasValue Action11) / (DoubleQuote CustomTypedValue DoubleQuote) / (SingleQuote CustomTypedValue SingleQuote) / CustomTypedValue / QuotedStringValue / FuncValue / UnquotedParamValue)> */
 		nil,
 		/* 14 Value <- <((RefValue Action12) / NoRefValue)> */
 		func() bool {
@@ -1577,12 +1609,51 @@ func (p *Peg) Init() {
 								l185:
 									position, tokenIndex = position182, tokenIndex182
 									if buffer[position] != rune('@') {
-										goto l180
+										goto l274
 									}
 									position++
 									if !_rules[ruleSingleQuotedValue]() {
+										goto l274
+									}
+									goto l182
+								l274:
+									position, tokenIndex = position182, tokenIndex182
+									if buffer[position] != rune('@') {
 										goto l180
 									}
+									position++
+									{
+										position275 := position
+										if buffer[position] != rune('{') {
+											goto l180
+										}
+										position++
+									l276:
+										{
+											position277, tokenIndex277 := position, tokenIndex
+											{
+												position278, tokenIndex278 := position, tokenIndex
+												if buffer[position] != rune('}') {
+													goto l278
+												}
+												position++
+												goto l277
+											l278:
+												position, tokenIndex = position278, tokenIndex278
+											}
+											if !matchDot() {
+												goto l277
+											}
+											goto l276
+										l277:
+											position, tokenIndex = position277, tokenIndex277
+										}
+										if buffer[position] != rune('}') {
+											goto l180
+										}
+										position++
+										add(rulePegText, position275)
+									}
 								}
 							l182:
 								add(ruleAliasValue, position181)
@@ -1628,6 +1699,97 @@ func (p *Peg) Init() {
 							}
 							goto l130
 						l190:
+							position, tokenIndex = position130, tokenIndex130
+							{
+								position268 := position
+								{
+									position269 := position
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										goto l267
+									}
+									position++
+								l270:
+									{
+										position271, tokenIndex271 := position, tokenIndex
+										if c := buffer[position]; c < rune('a') || c > rune('z') {
+											goto l271
+										}
+										position++
+										goto l270
+									l271:
+										position, tokenIndex = position271, tokenIndex271
+									}
+									if buffer[position] != rune('(') {
+										goto l267
+									}
+									position++
+								l272:
+									{
+										position273, tokenIndex273 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '/':
+												if buffer[position] != rune('/') {
+													goto l273
+												}
+												position++
+												break
+											case '.':
+												if buffer[position] != rune('.') {
+													goto l273
+												}
+												position++
+												break
+											case '_':
+												if buffer[position] != rune('_') {
+													goto l273
+												}
+												position++
+												break
+											case '-':
+												if buffer[position] != rune('-') {
+													goto l273
+												}
+												position++
+												break
+											case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+												if c := buffer[position]; c < rune('0') || c > rune('9') {
+													goto l273
+												}
+												position++
+												break
+											case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+												if c := buffer[position]; c < rune('A') || c > rune('Z') {
+													goto l273
+												}
+												position++
+												break
+											default:
+												if c := buffer[position]; c < rune('a') || c > rune('z') {
+													goto l273
+												}
+												position++
+												break
+											}
+										}
+
+										goto l272
+									l273:
+										position, tokenIndex = position273, tokenIndex273
+									}
+									if buffer[position] != rune(')') {
+										goto l267
+									}
+									position++
+									add(rulePegText, position269)
+								}
+								{
+									add(ruleAction25, position)
+								}
+								add(ruleFuncValue, position268)
+							}
+							goto l130
+						l267:
 							position, tokenIndex = position130, tokenIndex130
 							if !_rules[ruleUnquotedParamValue]() {
 								goto l122
@@ -2057,7 +2219,7 @@ func (p *Peg) Init() {
 		nil,
 		/* 24 RefValue <- <('$' <Identifier>)> */
 		nil,
-		/* 25 AliasValue <- <(('@' <UnquotedParam>) / ('@' DoubleQuotedValue) / ('@' SingleQuotedValue))> */
+		/* 25 AliasValue <- <(('@' <UnquotedParam>) / ('@' DoubleQuotedValue) / ('@' SingleQuotedValue) / ('@' <('{' (!'}' .)* '}')>))> */
 		nil,
 		/* 26 HoleValue <- <(Hole Action20)> */
 		func() bool {
@@ -2289,56 +2451,60 @@ func (p *Peg) Init() {
 		},
 		/* 39 EndOfFile <- <!.> */
 		nil,
-		/* 41 Action0 <- <{ p.NewStatement() }> */
+		/* 40 FuncValue <- <(<([a-z]+ '(' ((&('/') '/') | (&('.') '.') | (&('_') '_') | (&('-') '-') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))* ')')> Action25)> */
+		nil,
+		/* 42 Action0 <- <{ p.NewStatement() }> */
+		nil,
+		/* 43 Action1 <- <{ p.StatementDone() }> */
 		nil,
-		/* 42 Action1 <- <{ p.StatementDone() }> */
 		nil,
+		/* 45 Action2 <- <{ p.addDeclarationIdentifier(text) }> */
 		nil,
-		/* 44 Action2 <- <{ p.addDeclarationIdentifier(text) }> */
+		/* 46 Action3 <- <{ p.addValue() }> */
 		nil,
-		/* 45 Action3 <- <{ p.addValue() }> */
+		/* 47 Action4 <- <{ p.addAction(text) }> */
 		nil,
-		/* 46 Action4 <- <{ p.addAction(text) }> */
+		/* 48 Action5 <- <{ p.addEntity(text) }> */
 		nil,
-		/* 47 Action5 <- <{ p.addEntity(text) }> */
+		/* 49 Action6 <- <{ p.addParamKey(text) }> */
 		nil,
-		/* 48 Action6 <- <{ p.addParamKey(text) }> */
+		/* 50 Action7 <- <{  p.addFirstValueInList() }> */
 		nil,
-		/* 49 Action7 <- <{  p.addFirstValueInList() }> */
+		/* 51 Action8 <- <{  p.lastValueInList() }> */
 		nil,
-		/* 50 Action8 <- <{  p.lastValueInList() }> */
+		/* 52 Action9 <- <{  p.addFirstValueInList() }> */
 		nil,
-		/* 51 Action9 <- <{  p.addFirstValueInList() }> */
+		/* 53 Action10 <- <{  p.lastValueInList() }> */
 		nil,
-		/* 52 Action10 <- <{  p.lastValueInList() }> */
+		/* 54 Action11 <- <{  p.addAliasParam(text) }> */
 		nil,
-		/* 53 Action11 <- <{  p.addAliasParam(text) }> */
+		/* 55 Action12 <- <{  p.addParamRefValue(text) }> */
 		nil,
-		/* 54 Action12 <- <{  p.addParamRefValue(text) }> */
+		/* 56 Action13 <- <{ p.addParamValue(text) }> */
 		nil,
-		/* 55 Action13 <- <{ p.addParamValue(text) }> */
+		/* 57 Action14 <- <{ p.addParamValue(text) }> */
 		nil,
-		/* 56 Action14 <- <{ p.addParamValue(text) }> */
+		/* 58 Action15 <- <{ p.addFirstValueInConcatenation() }> */
 		nil,
-		/* 57 Action15 <- <{ p.addFirstValueInConcatenation() }> */
+		/* 59 Action16 <- <{  p.lastValueInConcatenation() }> */
 		nil,
-		/* 58 Action16 <- <{  p.lastValueInConcatenation() }> */
+		/* 60 Action17 <- <{ p.addFirstValueInConcatenation() }> */
 		nil,
-		/* 59 Action17 <- <{ p.addFirstValueInConcatenation() }> */
+		/* 61 Action18 <- <{  p.lastValueInConcatenation() }> */
 		nil,
-		/* 60 Action18 <- <{  p.lastValueInConcatenation() }> */
+		/* 62 Action19 <- <{ p.addStringValue(text) }> */
 		nil,
-		/* 61 Action19 <- <{ p.addStringValue(text) }> */
+		/* 63 Action20 <- <{  p.addParamHoleValue(text) }> */
 		nil,
-		/* 62 Action20 <- <{  p.addParamHoleValue(text) }> */
+		/* 64 Action21 <- <{ p.addFirstValueInConcatenation() }> */
 		nil,
-		/* 63 Action21 <- <{ p.addFirstValueInConcatenation() }> */
+		/* 65 Action22 <- <{  p.lastValueInConcatenation() }> */
 		nil,
-		/* 64 Action22 <- <{  p.lastValueInConcatenation() }> */
+		/* 66 Action23 <- <{ p.addFirstValueInConcatenation() }> */
 		nil,
-		/* 65 Action23 <- <{ p.addFirstValueInConcatenation() }> */
+		/* 67 Action24 <- <{  p.lastValueInConcatenation() }> */
 		nil,
-		/* 66 Action24 <- <{  p.lastValueInConcatenation() }> */
+		/* 68 Action25 <- <{ p.addParamFuncValue(text) }> */
 		nil,
 	}
 	p.rules = _rules
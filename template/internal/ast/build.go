@@ -3,6 +3,7 @@ package ast
 import (
 	"fmt"
 	"strconv"
+	"strings"
 )
 
 type statementBuilder struct {
@@ -172,6 +173,15 @@ func (a *AST) addAliasParam(text string) {
 	a.stmtBuilder.addParamValue(AliasNode{key: text})
 }
 
+func (a *AST) addParamFuncValue(text string) {
+	open := strings.Index(text, "(")
+	node := FuncNode{name: text[:open]}
+	if arg := text[open+1 : len(text)-1]; arg != "" {
+		node.args = []string{arg}
+	}
+	a.stmtBuilder.addParamValue(node)
+}
+
 type listValueBuilder struct {
 	elements []interface{}
 }
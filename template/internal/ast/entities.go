@@ -1,64 +1,153 @@
 package ast
 
+import (
+	"strings"
+	"sync"
+)
+
 type Entity string
 
+var entitiesMu sync.RWMutex
+
 var entities = map[Entity]struct{}{
 	"none": {},
 
-	"accesskey":           {},
-	"alarm":               {},
-	"appscalingtarget":    {},
-	"appscalingpolicy":    {},
-	"scalinggroup":        {},
-	"bucket":              {},
-	"certificate":         {},
-	"container":           {},
-	"containercluster":    {},
-	"containerservice":    {},
-	"containertask":       {},
-	"database":            {},
-	"distribution":        {},
-	"dbsubnetgroup":       {},
-	"elasticip":           {},
-	"function":            {},
-	"group":               {},
-	"instance":            {},
-	"image":               {},
-	"internetgateway":     {},
-	"mfadevice":           {},
-	"natgateway":          {},
-	"networkinterface":    {},
-	"instanceprofile":     {},
-	"keypair":             {},
-	"launchconfiguration": {},
-	"listener":            {},
-	"loadbalancer":        {},
-	"loginprofile":        {},
-	"policy":              {},
-	"queue":               {},
-	"record":              {},
-	"registry":            {},
-	"repository":          {},
-	"role":                {},
-	"route":               {},
-	"routetable":          {},
-	"s3object":            {},
-	"scalingpolicy":       {},
-	"securitygroup":       {},
-	"snapshot":            {},
-	"stack":               {},
-	"subnet":              {},
-	"subscription":        {},
-	"tag":                 {},
-	"targetgroup":         {},
-	"topic":               {},
-	"user":                {},
-	"volume":              {},
-	"vpc":                 {},
-	"zone":                {},
+	"accesskey":                 {},
+	"account":                   {},
+	"alarm":                     {},
+	"alias":                     {},
+	"application":               {},
+	"appscalingtarget":          {},
+	"appscalingpolicy":          {},
+	"scalinggroup":              {},
+	"bucket":                    {},
+	"cachecluster":              {},
+	"cachesubnetgroup":          {},
+	"catalogdatabase":           {},
+	"certificate":               {},
+	"classicloadbalancer":       {},
+	"command":                   {},
+	"computeenvironment":        {},
+	"cluster":                   {},
+	"container":                 {},
+	"containercluster":          {},
+	"containerservice":          {},
+	"containertask":             {},
+	"crawler":                   {},
+	"customergateway":           {},
+	"database":                  {},
+	"deployment":                {},
+	"distribution":              {},
+	"dbparametergroup":          {},
+	"consoleoutput":             {},
+	"dbsubnetgroup":             {},
+	"egressonlyinternetgateway": {},
+	"elasticip":                 {},
+	"environment":               {},
+	"execution":                 {},
+	"function":                  {},
+	"grant":                     {},
+	"group":                     {},
+	"host":                      {},
+	"hostreservation":           {},
+	"http":                      {},
+	"instance":                  {},
+	"image":                     {},
+	"internetgateway":           {},
+	"invalidation":              {},
+	"job":                       {},
+	"jobdefinition":             {},
+	"jobqueue":                  {},
+	"method":                    {},
+	"mfadevice":                 {},
+	"natgateway":                {},
+	"networkacl":                {},
+	"networkaclrule":            {},
+	"networkinterface":          {},
+	"instanceprofile":           {},
+	"key":                       {},
+	"keypair":                   {},
+	"kubernetescluster":         {},
+	"launchconfiguration":       {},
+	"listener":                  {},
+	"loadbalancer":              {},
+	"loginprofile":              {},
+	"loggroup":                  {},
+	"parameter":                 {},
+	"peeringconnection":         {},
+	"placementgroup":            {},
+	"policy":                    {},
+	"policyversion":             {},
+	"presignedurl":              {},
+	"queue":                     {},
+	"query":                     {},
+	"record":                    {},
+	"registry":                  {},
+	"repository":                {},
+	"resource":                  {},
+	"restapi":                   {},
+	"role":                      {},
+	"screenshot":                {},
+	"route":                     {},
+	"routetable":                {},
+	"rule":                      {},
+	"s3object":                  {},
+	"scalingpolicy":             {},
+	"secret":                    {},
+	"securitygroup":             {},
+	"servicecontrolpolicy":      {},
+	"snapshot":                  {},
+	"spotfleet":                 {},
+	"spotinstance":              {},
+	"spotrequest":               {},
+	"stack":                     {},
+	"stage":                     {},
+	"statemachine":              {},
+	"subnet":                    {},
+	"subscription":              {},
+	"table":                     {},
+	"tag":                       {},
+	"target":                    {},
+	"targetgroup":               {},
+	"tcp":                       {},
+	"topic":                     {},
+	"trail":                     {},
+	"transitgateway":            {},
+	"transitgatewayattachment":  {},
+	"transitgatewayroutetable":  {},
+	"user":                      {},
+	"volume":                    {},
+	"vpcendpoint":               {},
+	"vpc":                       {},
+	"vpnconnection":             {},
+	"vpngateway":                {},
+	"zone":                      {},
 }
 
+// namespaces of the entities of external drivers (ex: gcp for gcp.instance)
+var namespaces = make(map[string]struct{})
+
 func IsInvalidEntity(s string) bool {
+	entitiesMu.RLock()
+	defer entitiesMu.RUnlock()
+	if i := strings.Index(s, "."); i > -1 {
+		_, ok := namespaces[s[:i]]
+		return !ok
+	}
 	_, ok := entities[Entity(s)]
 	return !ok
 }
+
+// RegisterNamespace makes valid in templates all the entities prefixed by the namespace (ex: gcp.instance)
+func RegisterNamespace(ns string) {
+	entitiesMu.Lock()
+	defer entitiesMu.Unlock()
+	namespaces[ns] = struct{}{}
+}
+
+// RegisterEntity makes an entity provided by an external driver valid in templates
+func RegisterEntity(s string) {
+	entitiesMu.Lock()
+	defer entitiesMu.Unlock()
+	entities[Entity(s)] = struct{}{}
+}
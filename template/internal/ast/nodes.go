@@ -1,9 +1,14 @@
 package ast
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/wallix/awless/template/driver"
+	"github.com/wallix/awless/template/params"
 )
 
 var (
@@ -15,6 +20,7 @@ var (
 	_ Node = (*ConcatenationNode)(nil)
 	_ Node = (*ListNode)(nil)
 	_ Node = (*InterfaceNode)(nil)
+	_ Node = (*FuncNode)(nil)
 )
 
 type RightExpressionNode struct {
@@ -29,7 +35,7 @@ func (n *RightExpressionNode) Result() interface{} {
 	switch v := n.i.(type) {
 	case InterfaceNode:
 		return v.i
-	case RefNode, AliasNode, HoleNode:
+	case RefNode, AliasNode, HoleNode, FuncNode:
 		return nil
 	case ListNode:
 		var arr []interface{}
@@ -37,7 +43,7 @@ func (n *RightExpressionNode) Result() interface{} {
 			switch ev := e.(type) {
 			case InterfaceNode:
 				arr = append(arr, ev.i)
-			case RefNode, AliasNode, HoleNode:
+			case RefNode, AliasNode, HoleNode, FuncNode:
 				return nil
 			default:
 				arr = append(arr, ev)
@@ -77,12 +83,73 @@ type CompiledCommand struct {
 
 type CommandNode struct {
 	Command
+	// the command wrapped by the driver middlewares, run in place of the command when set
+	Driver    driver.Driver
 	CmdResult interface{}
 	CmdErr    error
+	// values, before running, of the params an update command modifies
+	CmdPriorState map[string]interface{}
+	// time spent running the command against the cloud
+	CmdDuration time.Duration
+
+	// where the command comes from in the human-authored templates (optional)
+	Origin *Origin
 
 	Action, Entity string
 	ParamNodes     map[string]interface{}
 	Refs           map[string]interface{}
+	// keys of the params telling where to run the command rather than being
+	// given to it (ex: account, role)
+	Modifiers []string
+}
+
+// Origin locates a command in the template it was written in, and lists the expansions,
+// the outermost first, that generated it from there (ex: stack teardown, revert)
+type Origin struct {
+	File       string   `json:"file,omitempty"`
+	Line       int      `json:"line,omitempty"`
+	Expansions []string `json:"expansions,omitempty"`
+}
+
+// Expand returns a copy of the origin with the given expansions appended to its path.
+// It can be called on a nil origin
+func (o *Origin) Expand(expansions ...string) *Origin {
+	expanded := &Origin{}
+	if o != nil {
+		expanded.File, expanded.Line = o.File, o.Line
+		expanded.Expansions = append(expanded.Expansions, o.Expansions...)
+	}
+	expanded.Expansions = append(expanded.Expansions, expansions...)
+	return expanded
+}
+
+// IsTraceable returns true when the origin tells more than the command itself,
+// being a file or generated by expansions
+func (o *Origin) IsTraceable() bool {
+	return o != nil && (o.File != "" || len(o.Expansions) > 0)
+}
+
+// String returns the origin such as "vpc.aws:12 (stack test-env > revert of 01BX5ZZKBKACTAV9WEVGEMMVRZ)"
+func (o *Origin) String() string {
+	if o == nil {
+		return ""
+	}
+	var buff bytes.Buffer
+	switch o.File {
+	case "":
+		buff.WriteString("template")
+	case "-":
+		buff.WriteString("stdin")
+	default:
+		buff.WriteString(o.File)
+	}
+	if o.Line > 0 {
+		fmt.Fprintf(&buff, ":%d", o.Line)
+	}
+	if len(o.Expansions) > 0 {
+		fmt.Fprintf(&buff, " (%s)", strings.Join(o.Expansions, " > "))
+	}
+	return buff.String()
 }
 
 type RefNode struct {
@@ -154,6 +221,31 @@ func (n HoleNode) clone() Node {
 	return n
 }
 
+type FuncNode struct {
+	name string
+	args []string
+}
+
+func NewFuncNode(name string, args ...string) FuncNode {
+	return FuncNode{name: name, args: args}
+}
+
+func (n FuncNode) Name() string {
+	return n.name
+}
+
+func (n FuncNode) Args() []string {
+	return n.args
+}
+
+func (n FuncNode) String() string {
+	return n.name + "(" + strings.Join(n.args, ",") + ")"
+}
+
+func (n FuncNode) clone() Node {
+	return n
+}
+
 type ListNode struct {
 	arr []interface{}
 }
@@ -186,6 +278,10 @@ func NewConcatenationNode(arr []interface{}) ConcatenationNode {
 	return ConcatenationNode{arr: arr}
 }
 
+func (n ConcatenationNode) Elems() []interface{} {
+	return n.arr
+}
+
 func (n ConcatenationNode) Concat() string {
 	var arr []string
 	for _, e := range n.arr {
@@ -249,6 +345,8 @@ func (n InterfaceNode) String() string {
 		return "[" + strings.Join(v, ",") + "]"
 	case string:
 		return quoteStringIfNeeded(v)
+	case params.FileContent:
+		return quoteStringIfNeeded(string(v))
 	default:
 		return fmt.Sprint(v)
 	}
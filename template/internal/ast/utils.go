@@ -185,10 +185,41 @@ func ProcessAliases(tree Node, aliasFunc func(action, entity string, key string)
 	return
 }
 
+func ProcessFuncs(tree Node, funcFn func(node FuncNode) (interface{}, error)) error {
+	var errs []string
+	v := newVisitor()
+	v.onFuncs = func(parent interface{}, node FuncNode) {
+		resolv, err := funcFn(node)
+		if err != nil {
+			errs = append(errs, err.Error())
+			return
+		}
+		switch p := parent.(type) {
+		case ListNode:
+			if _, isList := resolv.(ListNode); isList {
+				errs = append(errs, fmt.Sprintf("%s: cannot use a list in a list", node))
+				return
+			}
+			p.arr[v.listIndex] = resolv
+		case *CommandNode:
+			p.ParamNodes[v.key] = resolv
+		case *RightExpressionNode:
+			p.i = resolv
+		}
+	}
+	v.visit(tree)
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
 type visitor struct {
 	onRefs    func(parent interface{}, n RefNode)
 	onAliases func(parent interface{}, n AliasNode)
 	onHoles   func(parent interface{}, n HoleNode)
+	onFuncs   func(parent interface{}, n FuncNode)
 
 	parent                     Node
 	declaredVariables          []string
@@ -201,6 +232,7 @@ func newVisitor() *visitor {
 		onRefs:    func(interface{}, RefNode) {},
 		onAliases: func(interface{}, AliasNode) {},
 		onHoles:   func(interface{}, HoleNode) {},
+		onFuncs:   func(interface{}, FuncNode) {},
 	}
 }
 
@@ -217,6 +249,9 @@ func (v *visitor) visit(tree Node) {
 	case RefNode:
 		v.onRefs(v.parent, t)
 		return
+	case FuncNode:
+		v.onFuncs(v.parent, t)
+		return
 	}
 
 	switch t := tree.(type) {
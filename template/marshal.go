@@ -18,6 +18,14 @@ type TemplateExecution struct {
 	Author, Source, Locale string
 	Profile, Path, Message string
 	Fillers                map[string]interface{}
+	// Stack groups the executions whose resources are torn down together
+	Stack string
+	// ExpiresAt is when the stack of the execution is to be torn down (optional)
+	ExpiresAt time.Time
+	// Teardown is set on the executions tearing down their stack
+	Teardown bool
+	// time spent running the template, not persisted
+	Duration time.Duration
 }
 
 // Date extract the date from the ulid template identifier
@@ -58,6 +66,12 @@ func (t *TemplateExecution) MarshalJSON() ([]byte, error) {
 	out.Message = t.Message
 	out.Path = t.Path
 	out.Fillers = t.Fillers
+	out.Stack = t.Stack
+	if !t.ExpiresAt.IsZero() {
+		expires := t.ExpiresAt.UTC()
+		out.ExpiresAt = &expires
+	}
+	out.Teardown = t.Teardown
 	if out.Fillers == nil {
 		out.Fillers = make(map[string]interface{}, 0) // friendlier for json, avoiding "fillers": null,
 	}
@@ -74,6 +88,11 @@ func (t *TemplateExecution) MarshalJSON() ([]byte, error) {
 				newCmd.Results = append(newCmd.Results, s)
 			}
 		}
+		newCmd.PriorState = cmd.CmdPriorState
+		newCmd.Modifiers = cmd.Modifiers
+		if cmd.Origin.IsTraceable() {
+			newCmd.Origin = cmd.Origin
+		}
 		out.Commands = append(out.Commands, newCmd)
 	}
 
@@ -102,6 +121,12 @@ func (t *TemplateExecution) UnmarshalJSON(b []byte) error {
 	t.Path = v.Path
 	t.Author = v.Author
 	t.Fillers = v.Fillers
+	t.Stack = v.Stack
+	t.ExpiresAt = time.Time{}
+	if v.ExpiresAt != nil {
+		t.ExpiresAt = *v.ExpiresAt
+	}
+	t.Teardown = v.Teardown
 
 	tpl := &Template{ID: v.ID, AST: &ast.AST{
 		Statements: make([]*ast.Statement, 0),
@@ -122,6 +147,9 @@ func (t *TemplateExecution) UnmarshalJSON(b []byte) error {
 			if len(c.Errors) > 0 {
 				n.CmdErr = errors.New(c.Errors[0])
 			}
+			n.CmdPriorState = c.PriorState
+			n.Modifiers = c.Modifiers
+			n.Origin = c.Origin
 			tpl.Statements = append(tpl.Statements, &ast.Statement{Node: n})
 		}
 	}
@@ -133,8 +161,11 @@ func (t *TemplateExecution) UnmarshalJSON(b []byte) error {
 
 type TemplateExecutionStats struct {
 	KOCount, OKCount, CmdCount int
-	ActionEntityCount          map[string]int
-	Oneliner                   string
+	// SkippedCount is the number of commands, counted in KOCount, not run
+	// because the run was cancelled
+	SkippedCount      int
+	ActionEntityCount map[string]int
+	Oneliner          string
 }
 
 func (te *TemplateExecutionStats) AllKO() bool {
@@ -149,6 +180,9 @@ func (t *TemplateExecution) Stats() *TemplateExecutionStats {
 		actionentity = fmt.Sprintf("%s %s", cmd.Action, cmd.Entity)
 		if cmd.Err() != nil {
 			stats.KOCount++
+			if IsSkipped(cmd.CmdErr) {
+				stats.SkippedCount++
+			}
 		} else {
 			stats.OKCount++
 		}
@@ -164,19 +198,25 @@ func (t *TemplateExecution) Stats() *TemplateExecutionStats {
 }
 
 type toJSON struct {
-	ID       string                 `json:"id"`
-	Author   string                 `json:"author,omitempty"`
-	Source   string                 `json:"source"`
-	Locale   string                 `json:"locale"`
-	Profile  string                 `json:"profile,omitempty"`
-	Message  string                 `json:"message,omitempty"`
-	Path     string                 `json:"path,omitempty"`
-	Fillers  map[string]interface{} `json:"fillers"`
-	Commands []command              `json:"commands"`
+	ID        string                 `json:"id"`
+	Author    string                 `json:"author,omitempty"`
+	Source    string                 `json:"source"`
+	Locale    string                 `json:"locale"`
+	Profile   string                 `json:"profile,omitempty"`
+	Message   string                 `json:"message,omitempty"`
+	Path      string                 `json:"path,omitempty"`
+	Fillers   map[string]interface{} `json:"fillers"`
+	Stack     string                 `json:"stack,omitempty"`
+	ExpiresAt *time.Time             `json:"expiresAt,omitempty"`
+	Teardown  bool                   `json:"teardown,omitempty"`
+	Commands  []command              `json:"commands"`
 }
 
 type command struct {
-	Line    string   `json:"line"`
-	Errors  []string `json:"errors,omitempty"`
-	Results []string `json:"results,omitempty"`
+	Line       string                 `json:"line"`
+	Errors     []string               `json:"errors,omitempty"`
+	Results    []string               `json:"results,omitempty"`
+	PriorState map[string]interface{} `json:"prior,omitempty"`
+	Modifiers  []string               `json:"modifiers,omitempty"`
+	Origin     *ast.Origin            `json:"origin,omitempty"`
 }
@@ -321,3 +321,36 @@ func identJSON(content []byte) string {
 	}
 	return string(ident)
 }
+
+func TestTemplateExecutionOriginMarshaling(t *testing.T) {
+	tpl := MustParse("create vpc cidr=10.0.0.0/16\ncreate subnet cidr=10.0.0.0/24")
+	tpl.ID = "01BTT1AA3N36VCKSNKAKN4WX2A"
+	tpl.setOriginFile("vpc.aws")
+	for i, cmd := range tpl.CommandNodesIterator() {
+		cmd.CmdResult = fmt.Sprintf("res-%d", i+1)
+	}
+	exec := &TemplateExecution{Template: tpl}
+
+	b, err := json.Marshal(exec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"origin":{"file":"vpc.aws","line":2}`) {
+		t.Fatalf("expected origin in %s", b)
+	}
+	loaded := &TemplateExecution{}
+	if err = json.Unmarshal(b, loaded); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := loaded.CommandNodesIterator()[1].Origin.String(), "vpc.aws:2"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	reverted, err := loaded.Revert()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := reverted.CommandNodesIterator()[0].Origin.String(), "vpc.aws:2 (revert of 01BTT1AA3N36VCKSNKAKN4WX2A)"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
@@ -59,3 +59,15 @@ func (s *spec) Validators() Validators {
 func (s *spec) Reducers() []Reducer {
 	return s.reds
 }
+
+// FileContent is the content of a file read with the file() template function. It tells the
+// params accepting either a path, a URL or a content (ex: userdata) that the value is a content.
+type FileContent string
+
+// SSMParameter is the name of a SSM parameter read with the ssm() template function. The drivers
+// resolve it at run time so that its value (ex: a SecureString) is never displayed nor stored.
+type SSMParameter string
+
+func (p SSMParameter) String() string {
+	return "ssm(" + string(p) + ")"
+}
@@ -14,6 +14,9 @@ func Validate(all Validators, paramValues map[string]interface{}) error {
 	var hasErr bool
 	for key, vFn := range all {
 		if val, ok := paramValues[key]; ok {
+			if _, isParameter := val.(SSMParameter); isParameter { // only known at run time
+				continue
+			}
 			if err := vFn(val, paramValues); err != nil {
 				hasErr = true
 				msg.WriteString(fmt.Sprintf("\n\t\t- param '%s': %s", key, err))
@@ -27,4 +27,8 @@ func TestValidation(t *testing.T) {
 	if got, want := msg, "param 'two': expected min length of 2"; !strings.Contains(got, want) {
 		t.Fatalf("expected '%s' to contains: %s", got, want)
 	}
+
+	if err = params.Validate(vals, map[string]interface{}{"two": params.SSMParameter("/my-app/password")}); err != nil {
+		t.Fatalf("expected no validation of values read at run time, got %s", err)
+	}
 }
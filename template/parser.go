@@ -27,6 +27,12 @@ import (
 	"github.com/wallix/awless/template/internal/ast"
 )
 
+// RegisterEntity makes an entity provided by an external driver (ex: a plugin) parsable in templates.
+// It has to be called before parsing templates using the entity
+func RegisterEntity(name string) {
+	ast.RegisterEntity(name)
+}
+
 func Parse(text string) (tmpl *Template, err error) {
 	defer func() { // as peg lib does not allow errors in Execute, we use panic to build the AST
 		if rerr := recover(); rerr != nil {
@@ -55,10 +61,48 @@ func Parse(text string) (tmpl *Template, err error) {
 	p.Execute()
 
 	tmpl.AST = p.AST
+	tmpl.setOriginLines(text)
 
 	return
 }
 
+// setOriginLines sets the line of their statement in the text as origin of the commands,
+// one statement being written per non blank and non comment line
+func (t *Template) setOriginLines(text string) {
+	var lines []int
+	for i, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "//") {
+			continue
+		}
+		lines = append(lines, i+1)
+	}
+	if len(lines) != len(t.Statements) {
+		return
+	}
+	for i, st := range t.Statements {
+		if cmd, ok := extractExpressionNode(st).(*ast.CommandNode); ok {
+			cmd.Origin = &ast.Origin{Line: lines[i]}
+		}
+	}
+}
+
+// setOriginFile sets the file the template was read from in the origin of the commands
+// not coming from another file already
+func (t *Template) setOriginFile(path string) {
+	if path == "" {
+		return
+	}
+	for _, cmd := range t.CommandNodesIterator() {
+		if cmd.Origin != nil && cmd.Origin.File != "" {
+			continue
+		}
+		origin := cmd.Origin.Expand()
+		origin.File = path
+		cmd.Origin = origin
+	}
+}
+
 func MustParse(text string) *Template {
 	t, err := Parse(text)
 	if err != nil {
@@ -67,6 +111,20 @@ func MustParse(text string) *Template {
 	return t
 }
 
+// RegisterNamespace makes the entities prefixed by the namespace of an external driver
+// (ex: gcp.instance) parsable in templates. It has to be called before parsing templates using them
+func RegisterNamespace(name string) {
+	ast.RegisterNamespace(name)
+}
+
+// QuoteParamValue quotes a string value, when needed, to be given as param=value to ParseParams
+func QuoteParamValue(s string) string {
+	if ast.SimpleStringValue.MatchString(s) {
+		return s
+	}
+	return ast.Quote(s)
+}
+
 func ParseParams(text string) (map[string]interface{}, error) {
 	node, err := parseParamsAsCommandNode(text)
 	if err != nil {
@@ -74,6 +74,11 @@ func TestParseVariousTemplatesCorrectly(t *testing.T) {
 		{"support concatenation with '+' of quoted string and holes", "instance = create instance name='prefix-'+{instance.name}+{instance.version}+'-suffix'", ""},
 		{"support concatenation with '+' of quoted string and holes", "instance = create instance name='pre${}fix-' + {instance.name}+'middle-' +{instance.version}+ '-suffix'", "instance = create instance name='pre${}fix-'+{instance.name}+'middle-'+{instance.version}+'-suffix'"},
 		{"support concatenation with '+' of quoted string and holes with a hole as prefix", "instance = create instance name={instance.name}+'midl${}fix-'+'midle2${}fix-'+{instance.version}+'-suffix'", ""},
+
+		{"support functions", "create subnet availabilityzone=az(1) cidr=10.0.0.0/24", ""},
+		{"support functions without argument", "zones = azs()", ""},
+		{"support functions in list", "create loadbalancer subnets=[az(0),az(2)]", ""},
+		{"support functions with path argument", "create database password=ssm(/my-app/db_password.v2)", ""},
 	}
 
 	for _, tcase := range tcases {
@@ -212,6 +217,20 @@ func TestParseSingleQuotedString(t *testing.T) {
 	}
 }
 
+func TestParseSetsOriginLines(t *testing.T) {
+	tpl := MustParse("# network\n\ncreate vpc cidr=10.0.0.0/16\n  // subnet\nsub = create subnet cidr=10.0.0.0/24\nname = web\n\ncreate instance name=$name subnet=$sub\n")
+	var lines []int
+	for _, cmd := range tpl.CommandNodesIterator() {
+		lines = append(lines, cmd.Origin.Line)
+		if cmd.Origin.IsTraceable() {
+			t.Fatalf("expected origin without file to be untraceable, got %s", cmd.Origin)
+		}
+	}
+	if got, want := lines, []int{3, 5, 8}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
 func TestParsingInvalidActionAndEntities(t *testing.T) {
 	_, err := Parse(`creat instance`)
 	if err == nil || !strings.Contains(err.Error(), "action 'creat'") {
@@ -224,6 +243,28 @@ func TestParsingInvalidActionAndEntities(t *testing.T) {
 	}
 }
 
+func TestParseNamespacedEntities(t *testing.T) {
+	if _, err := Parse("create testns.instance name=web"); err == nil || !strings.Contains(err.Error(), "unknown entity 'testns.instance'") {
+		t.Fatalf("expected unknown entity error, got %v", err)
+	}
+	RegisterNamespace("testns")
+	tpl, err := Parse("inst = create testns.instance name=web\ndelete testns.instance id=$inst")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := tpl.String(), "inst = create testns.instance name=web\ndelete testns.instance id=$inst"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := tpl.CommandNodesIterator()[0].Entity, "testns.instance"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	for _, invalid := range []string{"create testns. name=web", "create testns.instance.sub name=web", "create .instance name=web"} {
+		if _, err = Parse(invalid); err == nil {
+			t.Fatalf("%s: expected error", invalid)
+		}
+	}
+}
+
 func TestParsingEmptyTemplate(t *testing.T) {
 	_, err := Parse(``)
 	if err == nil || err.Error() != "empty template" {
@@ -277,6 +318,7 @@ func TestParamsOnlyParsing(t *testing.T) {
 		exp   map[string]interface{}
 	}{
 		{input: "type=t2.micro subnet=@my-subnet count=4", exp: map[string]interface{}{"type": "t2.micro", "subnet": ast.NewAliasNode("my-subnet"), "count": 4}},
+		{input: "subnet=@{tag:Env=prod} count=4", exp: map[string]interface{}{"subnet": ast.NewAliasNode("{tag:Env=prod}"), "count": 4}},
 		{input: "subnet=[sub-1234,sub-2345]", exp: map[string]interface{}{"subnet": ast.NewListNode([]interface{}{"sub-1234", "sub-2345"})}},
 	}
 	for i, tcase := range tcases {
@@ -342,6 +384,18 @@ func TestTemplateParsing(t *testing.T) {
 					return assertAliases(tpl.Statements[0].Node, map[string]string{"id": "my f$!=€&g vm name"})
 				},
 			},
+			{
+				input: "create instance subnet=@{tag:Env=prod, public:false} securitygroup=[@{name:web},@db-sg] name=web",
+				verifyFn: func(tpl *Template) error {
+					if err := isCommandNode(tpl.Statements[0].Node); err != nil {
+						t.Fatal(err)
+					}
+					if got, want := tpl.String(), "create instance name=web securitygroup=[@{name:web},@db-sg] subnet=@{tag:Env=prod, public:false}"; got != want {
+						t.Fatalf("got %s, want %s", got, want)
+					}
+					return assertAliases(tpl.Statements[0].Node, map[string]string{"subnet": "{tag:Env=prod, public:false}"})
+				},
+			},
 			{
 				input: "vpc_1 = create vpc",
 				verifyFn: func(tpl *Template) error {
@@ -2,6 +2,10 @@ package template
 
 import (
 	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"strings"
@@ -276,6 +280,16 @@ func TestResolveMissingSuggestedPass(t *testing.T) {
 			return "arole"
 		case "create.instance.userdata":
 			return "/path/to/my/file"
+		case "create.instance.block-devices":
+			return "/dev/xvda:30"
+		case "create.instance.tags":
+			return "env:prod"
+		case "create.instance.placementgroup":
+			return "my-cluster"
+		case "create.instance.host":
+			return "h-1234"
+		case "create.instance.tenancy":
+			return "host"
 		default:
 			t.Fatalf("unexepected optional parameter %s: %v", in, paramPaths)
 			return ""
@@ -320,10 +334,10 @@ func TestResolveMissingSuggestedPass(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if got, want := count, 5; got != want {
+	if got, want := count, 10; got != want {
 		t.Fatalf("got %d, want %d", got, want)
 	}
-	if got, want := compiled.String(), "create instance count=1 image=ami-1a17137a ip=1.2.3.4 keypair=mykeypair lock=true name=my-instance role=arole securitygroup=@my-sec-group subnet=sub-1234 type=t2.nano userdata=/path/to/my/file"; got != want {
+	if got, want := compiled.String(), "create instance block-devices=/dev/xvda:30 count=1 host=h-1234 image=ami-1a17137a ip=1.2.3.4 keypair=mykeypair lock=true name=my-instance placementgroup=my-cluster role=arole securitygroup=@my-sec-group subnet=sub-1234 tags=env:prod tenancy=host type=t2.nano userdata=/path/to/my/file"; got != want {
 		t.Fatalf("got \n%s, want \n%s", got, want)
 	}
 }
@@ -351,6 +365,197 @@ func TestResolveAliasPass(t *testing.T) {
 	assertCmdParams(t, tpl, map[string]interface{}{"subnet": "sub-12345", "ami": "ami-12345", "count": 3})
 }
 
+func TestResolveAliasQueryPass(t *testing.T) {
+	cenv := NewEnv().WithAliasQueryFunc(func(paramPath, query string) (string, error) {
+		switch query {
+		case "tag:Env=prod, public:false":
+			if paramPath != "create.instance.subnet" {
+				t.Fatalf("unexpected param path %s", paramPath)
+			}
+			return "sub-12345", nil
+		case "name:web":
+			return "sg-12345", nil
+		default:
+			return "", errors.New("ambiguous query: 2 subnets match (sub-1, sub-2)")
+		}
+	}).WithAliasFunc(func(p, v string) string { return "sg-67890" }).Build()
+
+	tcases := []struct {
+		tpl      string
+		expTpl   string
+		expError string
+	}{
+		{tpl: "create instance subnet=@{tag:Env=prod, public:false}", expTpl: "create instance subnet=sub-12345"},
+		{tpl: "create instance securitygroup=[@{name:web},@db-sg]", expTpl: "create instance securitygroup=[sg-12345,sg-67890]"},
+		{tpl: "create instance subnet=@{tag:Env=dev}", expError: "cannot resolve alias \"@{tag:Env=dev}\" for subnet: ambiguous query: 2 subnets match (sub-1, sub-2)"},
+	}
+	for i, tcase := range tcases {
+		tpl, _, err := resolveAliasPass(MustParse(tcase.tpl), cenv)
+		if tcase.expError != "" {
+			if err == nil {
+				t.Fatalf("%d: expected error got none", i+1)
+			}
+			if got, want := err.Error(), tcase.expError; got != want {
+				t.Fatalf("%d: got %s, want %s", i+1, got, want)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if got, want := tpl.String(), tcase.expTpl; got != want {
+			t.Fatalf("%d: got %s, want %s", i+1, got, want)
+		}
+	}
+}
+
+func TestResolveFuncsPass(t *testing.T) {
+	var calls int
+	cenv := NewEnv().WithAvailabilityZonesFunc(func() ([]string, error) {
+		calls++
+		return []string{"us-west-2a", "us-west-2b", "us-west-2c"}, nil
+	}).Build()
+
+	tcases := []struct {
+		tpl      string
+		expTpl   string
+		expError string
+	}{
+		{tpl: "create subnet availabilityzone=az(1)", expTpl: "create subnet availabilityzone=us-west-2b"},
+		{tpl: "create loadbalancer zones=azs()", expTpl: "create loadbalancer zones=[us-west-2a,us-west-2b,us-west-2c]"},
+		{tpl: "create loadbalancer zones=[az(0),az(2)]", expTpl: "create loadbalancer zones=[us-west-2a,us-west-2c]"},
+		{tpl: "zones = azs()\ncreate subnet availabilityzone=az(0)", expTpl: "zones = [us-west-2a,us-west-2b,us-west-2c]\ncreate subnet availabilityzone=us-west-2a"},
+		{tpl: "create subnet availabilityzone=az(3)", expError: "az(3): index out of range, only 3 availability zones"},
+		{tpl: "create subnet availabilityzone=az()", expError: "az() expects the index of the zone"},
+		{tpl: "create loadbalancer zones=azs(1)", expError: "azs() expects no argument"},
+		{tpl: "create loadbalancer zones=[azs(),az(1)]", expError: "azs(): cannot use a list in a list"},
+		{tpl: "create subnet availabilityzone=zone(1)", expError: "unknown function 'zone'"},
+	}
+
+	for i, tcase := range tcases {
+		calls = 0
+		tpl, _, err := resolveFuncsPass(MustParse(tcase.tpl), cenv)
+		if tcase.expError != "" {
+			if err == nil {
+				t.Fatalf("%d: expected error, got nil", i+1)
+			}
+			if got, want := err.Error(), tcase.expError; !strings.Contains(got, want) {
+				t.Fatalf("%d: got %s, want %s", i+1, got, want)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if got, want := tpl.String(), tcase.expTpl; got != want {
+			t.Fatalf("%d: got\n%s\nwant\n%s", i+1, got, want)
+		}
+		if got, want := calls, 1; got != want {
+			t.Fatalf("%d: got %d, want %d", i+1, got, want)
+		}
+	}
+
+	t.Run("no resolver", func(t *testing.T) {
+		_, _, err := resolveFuncsPass(MustParse("create subnet availabilityzone=az(0)"), NewEnv().Build())
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("ssm parameters", func(t *testing.T) {
+		tpl, _, err := resolveFuncsPass(MustParse("create database id=my-db password=ssm(/my-app/db-password)"), NewEnv().Build())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := tpl.CommandNodesIterator()[0].ParamNodes["password"], params.SSMParameter("/my-app/db-password"); got != want {
+			t.Fatalf("got %v, want %s", got, want)
+		}
+		if got, want := tpl.String(), "create database id=my-db password=ssm(/my-app/db-password)"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		reparsed, _, err := resolveFuncsPass(MustParse(tpl.String()), NewEnv().Build())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := reparsed.CommandNodesIterator()[0].ParamNodes["password"], params.SSMParameter("/my-app/db-password"); got != want {
+			t.Fatalf("got %v, want %s", got, want)
+		}
+		if _, _, err = resolveFuncsPass(MustParse("create database password=ssm()"), NewEnv().Build()); err == nil || !strings.Contains(err.Error(), "ssm() expects the name of the parameter") {
+			t.Fatalf("got %v, want missing name error", err)
+		}
+	})
+
+	t.Run("file", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "awless")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		definition := `{"StartAt": "Hello", "States": {"Hello": {"Type": "Pass", "End": true}}}`
+		path := filepath.Join(dir, "definition.json")
+		if err = ioutil.WriteFile(path, []byte(definition), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		tpl, _, err := resolveFuncsPass(MustParse(fmt.Sprintf("create statemachine name=hello definition=file(%s)", path)), NewEnv().Build())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := tpl.CommandNodesIterator()[0].ParamNodes["definition"], params.FileContent(definition); got != want {
+			t.Fatalf("got %v, want %s", got, want)
+		}
+		if got, want := tpl.String(), "create statemachine definition='"+definition+"' name=hello"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if _, _, err = resolveFuncsPass(MustParse(fmt.Sprintf("create statemachine definition=file(%s)", filepath.Join(dir, "unknown.json"))), NewEnv().Build()); err == nil || !strings.Contains(err.Error(), "no such file") {
+			t.Fatalf("got %v, want no such file error", err)
+		}
+	})
+}
+
+func TestResolveStackRefsPass(t *testing.T) {
+	cenv := NewEnv().WithStackRefFunc(func(stack, name string) (string, error) {
+		if stack == "front" && name == "web" {
+			return "sg-1234", nil
+		}
+		return "", fmt.Errorf("no securitygroup '%s' in stack '%s'", name, stack)
+	}).Build()
+
+	tcases := []struct {
+		tpl, expTpl, expError string
+	}{
+		{tpl: "update securitygroup id=sg-1 inbound=authorize protocol=tcp portrange=80 source=sg:front/web", expTpl: "update securitygroup id=sg-1 inbound=authorize portrange=80 protocol=tcp source=sg-1234"},
+		{tpl: "update securitygroup id=sg-1 inbound=authorize protocol=tcp portrange=80 securitygroup='sg:front/web'", expTpl: "update securitygroup id=sg-1 inbound=authorize portrange=80 protocol=tcp securitygroup=sg-1234"},
+		{tpl: "update securitygroup id=sg-1 inbound=authorize protocol=tcp portrange=80 source=10.0.0.0/24", expTpl: "update securitygroup id=sg-1 inbound=authorize portrange=80 protocol=tcp source=10.0.0.0/24"},
+		{tpl: "update securitygroup id=sg-1 inbound=authorize protocol=tcp portrange=80 source=sg:back/db", expError: "no securitygroup 'db' in stack 'back'"},
+	}
+	for i, tcase := range tcases {
+		tpl, _, err := resolveStackRefsPass(MustParse(tcase.tpl), cenv)
+		if tcase.expError != "" {
+			if err == nil {
+				t.Fatalf("%d: expected error, got nil", i+1)
+			}
+			if got, want := err.Error(), tcase.expError; !strings.Contains(got, want) {
+				t.Fatalf("%d: got %s, want %s", i+1, got, want)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if got, want := tpl.String(), tcase.expTpl; got != want {
+			t.Fatalf("%d: got\n%s\nwant\n%s", i+1, got, want)
+		}
+	}
+
+	t.Run("no resolver", func(t *testing.T) {
+		_, _, err := resolveStackRefsPass(MustParse("update securitygroup id=sg-1 source=sg:front/web"), NewEnv().Build())
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
+
 func TestResolveHolesPass(t *testing.T) {
 	tpl := MustParse("create instance count={instance.count} type={instance.type}")
 
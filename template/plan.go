@@ -0,0 +1,125 @@
+package template
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// PlanStep is a command of a plan, flagged as no-op
+// when the resource it targets no longer exists
+type PlanStep struct {
+	Command *ast.CommandNode
+	NoOp    bool
+	Reason  string
+}
+
+type Plan struct {
+	Steps []*PlanStep
+}
+
+// PlanRevert builds the plan of a revert template, flagging as no-op the commands
+// targeting (by id or name) a resource that cannot be found anymore in the graph of its type.
+// Commands whose graph the lookup does not return are never flagged
+func PlanRevert(tpl *Template, lookup LookupGraphFunc) (*Plan, error) {
+	plan := &Plan{}
+	for _, cmd := range tpl.CommandNodesIterator() {
+		step := &PlanStep{Command: cmd}
+		plan.Steps = append(plan.Steps, step)
+
+		target, ok := revertedResourceRef(cmd)
+		if !ok {
+			continue
+		}
+		g, ok := lookup(cmd.Entity)
+		if !ok || g == nil {
+			continue
+		}
+		exists, err := resourceExists(g, cmd.Entity, target)
+		if err != nil {
+			return plan, err
+		}
+		if !exists {
+			step.NoOp = true
+			step.Reason = fmt.Sprintf("%s '%s' no longer exists", cmd.Entity, target)
+		}
+	}
+	return plan, nil
+}
+
+// Template returns a template containing only the commands that are not no-op
+func (p *Plan) Template(id string) *Template {
+	tpl := &Template{ID: id, AST: &ast.AST{}}
+	for _, step := range p.Steps {
+		if !step.NoOp {
+			tpl.Statements = append(tpl.Statements, &ast.Statement{Node: step.Command})
+		}
+	}
+	return tpl
+}
+
+func (p *Plan) HasNoOp() bool {
+	for _, step := range p.Steps {
+		if step.NoOp {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Plan) IsEmpty() bool {
+	for _, step := range p.Steps {
+		if !step.NoOp {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Plan) String() string {
+	var buff bytes.Buffer
+	for i, step := range p.Steps {
+		if i > 0 {
+			buff.WriteByte('\n')
+		}
+		if step.NoOp {
+			buff.WriteString(fmt.Sprintf("[no-op] %s (%s)", step.Command, step.Reason))
+		} else {
+			buff.WriteString(step.Command.String())
+		}
+	}
+	return buff.String()
+}
+
+var revertedActionsWithTarget = []string{"delete", "terminate", "detach", "check", "start", "stop", "enable", "disable"}
+
+func revertedResourceRef(cmd *ast.CommandNode) (string, bool) {
+	if !contains(revertedActionsWithTarget, cmd.Action) {
+		return "", false
+	}
+	params := cmd.ToDriverParams()
+	for _, key := range []string{"id", "name"} {
+		if v, ok := params[key].(string); ok && v != "" {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+func resourceExists(g cloud.GraphAPI, entity, ref string) (bool, error) {
+	resources, err := g.Find(cloud.NewQuery(entity))
+	if err != nil {
+		return false, err
+	}
+	for _, r := range resources {
+		if r.Id() == ref {
+			return true, nil
+		}
+		if name, ok := r.Properties()["Name"].(string); ok && name == ref {
+			return true, nil
+		}
+	}
+	return false, nil
+}
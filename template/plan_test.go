@@ -0,0 +1,70 @@
+package template_test
+
+import (
+	"testing"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+	"github.com/wallix/awless/template"
+)
+
+func TestPlanRevert(t *testing.T) {
+	g := graph.NewGraph()
+	g.AddResource(
+		resourcetest.Instance("inst_1").Build(),
+		resourcetest.Subnet("sub_1").Prop("Name", "my-subnet").Build(),
+	)
+	lookup := func(key string) (cloud.GraphAPI, bool) { return g, true }
+
+	t.Run("flag missing resources as no-op", func(t *testing.T) {
+		tpl := template.MustParse(`delete instance id=inst_1
+delete instance id=inst_2
+delete subnet id=my-subnet
+create tag resource=inst_1 key=Env value=prod`)
+
+		plan, err := template.PlanRevert(tpl, lookup)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(plan.Steps), 4; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		for i, noop := range []bool{false, true, false, false} {
+			if got, want := plan.Steps[i].NoOp, noop; got != want {
+				t.Fatalf("step %d: got %t, want %t", i+1, got, want)
+			}
+		}
+		if !plan.HasNoOp() {
+			t.Fatal("expected plan to have no-op")
+		}
+		if plan.IsEmpty() {
+			t.Fatal("expected plan not to be empty")
+		}
+		exp := `delete instance id=inst_1
+[no-op] delete instance id=inst_2 (instance 'inst_2' no longer exists)
+delete subnet id=my-subnet
+create tag key=Env resource=inst_1 value=prod`
+		if got, want := plan.String(), exp; got != want {
+			t.Fatalf("got\n%s\n\nwant\n%s", got, want)
+		}
+		exp = `delete instance id=inst_1
+delete subnet id=my-subnet
+create tag key=Env resource=inst_1 value=prod`
+		if got, want := plan.Template("revert_id").String(), exp; got != want {
+			t.Fatalf("got\n%s\n\nwant\n%s", got, want)
+		}
+	})
+
+	t.Run("empty plan", func(t *testing.T) {
+		tpl := template.MustParse("delete instance id=inst_2\ndelete subnet id=sub_2")
+
+		plan, err := template.PlanRevert(tpl, lookup)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !plan.IsEmpty() {
+			t.Fatalf("expected empty plan, got\n%s", plan)
+		}
+	})
+}
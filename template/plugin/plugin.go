@@ -0,0 +1,289 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package plugin loads external drivers, shipped as executables, so that templates
+// can act on entities of other providers without recompiling awless.
+//
+// A plugin is an executable named awless-driver-NAME in the plugins directory.
+// For each call, awless runs it with one JSON Request on its standard input
+// and reads one JSON Response from its standard output:
+//
+//	{"version": 1, "method": "describe"}
+//	-> {"commands": [{"action": "create", "entity": "droplet", "required": ["name"], "optional": ["size"], "dryRun": true}]}
+//
+//	{"version": 1, "method": "run", "action": "create", "entity": "droplet", "params": {"name": "web"}}
+//	-> {"result": "droplet-1234"} or {"error": "quota exceeded"}
+//
+// The "dryrun" method, with the same request as "run", is only called for commands describing dryRun.
+// Messages written by the plugin on its standard error are displayed in verbose mode
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/internal/ast"
+	"github.com/wallix/awless/template/params"
+)
+
+const (
+	// Prefix of the executables loaded as plugins
+	Prefix = "awless-driver-"
+	// ProtocolVersion is sent with each request for plugins to check compatibility
+	ProtocolVersion = 1
+
+	DescribeMethod = "describe"
+	RunMethod      = "run"
+	DryRunMethod   = "dryrun"
+
+	describeTimeout = 10 * time.Second
+)
+
+type Request struct {
+	Version int                    `json:"version"`
+	Method  string                 `json:"method"`
+	Action  string                 `json:"action,omitempty"`
+	Entity  string                 `json:"entity,omitempty"`
+	Params  map[string]interface{} `json:"params,omitempty"`
+}
+
+type Response struct {
+	Commands []*CommandSpec `json:"commands,omitempty"`
+	Result   interface{}    `json:"result,omitempty"`
+	Error    string         `json:"error,omitempty"`
+}
+
+// CommandSpec describes an action on an entity implemented by a plugin
+type CommandSpec struct {
+	Action   string   `json:"action"`
+	Entity   string   `json:"entity"`
+	Required []string `json:"required,omitempty"`
+	Optional []string `json:"optional,omitempty"`
+	DryRun   bool     `json:"dryRun,omitempty"`
+}
+
+type Plugin struct {
+	Name, Path string
+	Commands   []*CommandSpec
+
+	commands map[string]*Command
+}
+
+// Registry routes the lookups of template commands to the plugins implementing them
+type Registry struct {
+	plugins  []*Plugin
+	commands map[string]*Command
+}
+
+// Load describes the plugins found in dir. Plugins failing to describe themselves
+// or conflicting with already loaded ones are reported in the error, the others being loaded
+func Load(dir string) (*Registry, error) {
+	reg := &Registry{commands: make(map[string]*Command)}
+	files, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return reg, nil
+	}
+	if err != nil {
+		return reg, fmt.Errorf("loading plugins: %s", err)
+	}
+
+	var errs []string
+	for _, f := range files {
+		name, ok := pluginName(f)
+		if !ok {
+			continue
+		}
+		p := &Plugin{Name: name, Path: filepath.Join(dir, f.Name())}
+		if err := reg.add(p); err != nil {
+			errs = append(errs, fmt.Sprintf("plugin %s: %s", name, err))
+		}
+	}
+	if len(errs) > 0 {
+		return reg, errors.New(strings.Join(errs, "; "))
+	}
+	return reg, nil
+}
+
+func pluginName(f os.FileInfo) (string, bool) {
+	if f.IsDir() || !strings.HasPrefix(f.Name(), Prefix) {
+		return "", false
+	}
+	name := strings.TrimPrefix(f.Name(), Prefix)
+	if runtime.GOOS == "windows" {
+		if !strings.HasSuffix(name, ".exe") {
+			return "", false
+		}
+		name = strings.TrimSuffix(name, ".exe")
+	} else if f.Mode()&0111 == 0 {
+		return "", false
+	}
+	return name, name != ""
+}
+
+func (r *Registry) add(p *Plugin) error {
+	ctx, cancel := context.WithTimeout(context.Background(), describeTimeout)
+	defer cancel()
+	resp, err := p.call(ctx, &Request{Method: DescribeMethod}, nil)
+	if err != nil {
+		return err
+	}
+	for _, spec := range resp.Commands {
+		if spec.Action == "" || spec.Entity == "" {
+			return fmt.Errorf("invalid command %#v: missing action or entity", spec)
+		}
+		if ast.IsInvalidAction(spec.Action) {
+			return fmt.Errorf("invalid command %s %s: unknown action '%s'", spec.Action, spec.Entity, spec.Action)
+		}
+		if existing, ok := r.commands[spec.Action+spec.Entity]; ok {
+			return fmt.Errorf("%s %s already provided by plugin %s", spec.Action, spec.Entity, existing.plugin.Name)
+		}
+	}
+	p.Commands = resp.Commands
+	p.commands = make(map[string]*Command)
+	for _, spec := range p.Commands {
+		cmd := &Command{plugin: p, spec: spec}
+		p.commands[spec.Action+spec.Entity] = cmd
+		r.commands[spec.Action+spec.Entity] = cmd
+	}
+	r.plugins = append(r.plugins, p)
+	return nil
+}
+
+func (r *Registry) Plugins() []*Plugin {
+	return r.plugins
+}
+
+// Entities returns the entities provided by the plugins, sorted
+func (r *Registry) Entities() (entities []string) {
+	uniq := make(map[string]bool)
+	for _, cmd := range r.commands {
+		if !uniq[cmd.spec.Entity] {
+			uniq[cmd.spec.Entity] = true
+			entities = append(entities, cmd.spec.Entity)
+		}
+	}
+	sort.Strings(entities)
+	return
+}
+
+// Lookup returns the command of a plugin for the tokens of a command definition
+// (ex: "create", "droplet"), or nil
+func (r *Registry) Lookup(tokens ...string) interface{} {
+	if cmd, ok := r.commands[strings.Join(tokens, "")]; ok {
+		return cmd
+	}
+	return nil
+}
+
+// Lookup returns the command of the plugin for the tokens of a command definition, or nil.
+// It routes the entities namespaced with the name of the plugin (ex: ocean.droplet)
+func (p *Plugin) Lookup(tokens ...string) interface{} {
+	if cmd, ok := p.commands[strings.Join(tokens, "")]; ok {
+		return cmd
+	}
+	return nil
+}
+
+func (p *Plugin) call(ctx context.Context, req *Request, renv env.Running) (*Response, error) {
+	req.Version = ProtocolVersion
+	in, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling request: %s", err)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.Path)
+	cmd.Stdin = bytes.NewReader(in)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+
+	runErr := cmd.Run()
+	if renv != nil && stderr.Len() > 0 {
+		renv.Log().Verbosef("plugin %s: %s", p.Name, strings.TrimSpace(stderr.String()))
+	}
+
+	resp := new(Response)
+	if err := json.Unmarshal(stdout.Bytes(), resp); err != nil {
+		if runErr != nil {
+			return nil, fmt.Errorf("%s: %s", runErr, strings.TrimSpace(stderr.String()))
+		}
+		return nil, fmt.Errorf("invalid response: %s", err)
+	}
+	if resp.Error != "" {
+		return resp, errors.New(resp.Error)
+	}
+	if runErr != nil {
+		return resp, fmt.Errorf("%s: %s", runErr, strings.TrimSpace(stderr.String()))
+	}
+	return resp, nil
+}
+
+// Command is a template command run by a plugin
+type Command struct {
+	plugin *Plugin
+	spec   *CommandSpec
+}
+
+func (c *Command) ParamsSpec() params.Spec {
+	var rules []params.Rule
+	for _, k := range c.spec.Required {
+		rules = append(rules, params.Key(k))
+	}
+	if len(c.spec.Optional) > 0 {
+		var opts []interface{}
+		for _, k := range c.spec.Optional {
+			opts = append(opts, k)
+		}
+		rules = append(rules, params.Opt(opts...))
+	}
+	return params.NewSpec(params.AllOf(rules...))
+}
+
+func (c *Command) Run(renv env.Running, p map[string]interface{}) (interface{}, error) {
+	return c.call(renv, RunMethod, p)
+}
+
+// DryRun asks the plugin to check the command when it supports it,
+// otherwise it returns a fake result
+func (c *Command) DryRun(renv env.Running, p map[string]interface{}) (interface{}, error) {
+	if !c.spec.DryRun {
+		return fmt.Sprintf("%s-dry-run", c.spec.Entity), nil
+	}
+	return c.call(renv, DryRunMethod, p)
+}
+
+// ExtractResult allows to assign the result of the command to a template variable
+func (c *Command) ExtractResult(i interface{}) string {
+	return fmt.Sprint(i)
+}
+
+func (c *Command) call(renv env.Running, method string, p map[string]interface{}) (interface{}, error) {
+	resp, err := c.plugin.call(env.Ctx(renv), &Request{Method: method, Action: c.spec.Action, Entity: c.spec.Entity, Params: p}, renv)
+	if err != nil {
+		return nil, fmt.Errorf("plugin %s: %s", c.plugin.Name, err)
+	}
+	return resp.Result, nil
+}
@@ -0,0 +1,140 @@
+package plugin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/driver"
+	"github.com/wallix/awless/template/params"
+)
+
+const dropletPlugin = `#!/bin/sh
+req=$(cat)
+case "$req" in
+  *'"method":"describe"'*)
+    echo '{"commands": [{"action": "create", "entity": "droplet", "required": ["name"], "optional": ["size"], "dryRun": true}, {"action": "delete", "entity": "droplet", "required": ["id"]}]}' ;;
+  *'"method":"dryrun"'*'"name":"invalid"'*)
+    echo '{"error": "invalid droplet name"}' ;;
+  *'"method":"dryrun"'*)
+    echo '{"result": "droplet-dry"}' ;;
+  *'"method":"run"'*'"action":"create"'*)
+    echo "creating droplet" >&2
+    echo '{"result": "droplet-1"}' ;;
+  *'"method":"run"'*'"id":"droplet-1"'*)
+    echo '{}' ;;
+  *)
+    echo "unexpected request $req" >&2
+    exit 1 ;;
+esac
+`
+
+func TestLoadAndRunPlugins(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell script plugin")
+	}
+	dir, err := ioutil.TempDir("", "awlessplugins")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err = ioutil.WriteFile(filepath.Join(dir, Prefix+"ocean"), []byte(dropletPlugin), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(dir, Prefix+"broken"), []byte("#!/bin/sh\necho oops"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(dir, Prefix+"notexecutable"), []byte("#!/bin/sh\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	reg, err := Load(dir)
+	if err == nil || !strings.Contains(err.Error(), "plugin broken: invalid response") {
+		t.Fatalf("expected error on broken plugin, got %v", err)
+	}
+	if got, want := len(reg.Plugins()), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := reg.Entities(), []string{"droplet"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if reg.Lookup("create", "instance") != nil {
+		t.Fatal("expected no command")
+	}
+
+	for _, entity := range reg.Entities() {
+		template.RegisterEntity(entity)
+	}
+	tpl, err := template.Parse("d = create droplet name=web\ndelete droplet id=$d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cenv := template.NewEnv().WithLookupCommandFunc(reg.Lookup).Build()
+	compiled, cenv, err := template.Compile(tpl, cenv, template.NewRunnerCompileMode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	renv := template.NewRunEnv(cenv)
+	if _, err = compiled.DryRun(renv); err != nil {
+		t.Fatal(err)
+	}
+	ran, err := compiled.Run(renv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var results []interface{}
+	for _, cmd := range ran.CommandNodesIterator() {
+		if cmd.CmdErr != nil {
+			t.Fatal(cmd.CmdErr)
+		}
+		results = append(results, cmd.CmdResult)
+	}
+	if got, want := results, []interface{}{"droplet-1", nil}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	mux := driver.NewMux(reg.Lookup)
+	if err = mux.Register("ocean", reg.Plugins()[0].Lookup); err != nil {
+		t.Fatal(err)
+	}
+	template.RegisterNamespace("ocean")
+	compiled, cenv, err = template.Compile(template.MustParse("create ocean.droplet name=db"), template.NewEnv().WithLookupCommandFunc(mux.Lookup).Build(), template.NewRunnerCompileMode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ran, err = compiled.Run(template.NewRunEnv(cenv)); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := ran.CommandNodesIterator()[0].CmdResult, "droplet-1"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	_, err = reg.Lookup("create", "droplet").(*Command).DryRun(renv, map[string]interface{}{"name": "invalid"})
+	if err == nil || err.Error() != "plugin ocean: invalid droplet name" {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	rule := reg.Lookup("create", "droplet").(*Command).ParamsSpec().Rule()
+	if got, want := rule.Missing([]string{"size"}), []string{"name"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if err = params.Run(rule, []string{"name", "size", "unknown"}); err == nil {
+		t.Fatal("expected error on unexpected param")
+	}
+}
+
+func TestLoadMissingDir(t *testing.T) {
+	reg, err := Load(filepath.Join(os.TempDir(), "awless-no-such-plugins-dir"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reg.Plugins()) != 0 {
+		t.Fatal("expected no plugins")
+	}
+}
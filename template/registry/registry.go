@@ -0,0 +1,228 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package registry stores named and versioned templates, such as 'aws/create_instance@v2',
+// in a local directory and optional remote sources.
+package registry
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/wallix/awless/template"
+)
+
+const FileExt = ".aws"
+
+var (
+	nameRegex    = regexp.MustCompile(`^[a-zA-Z0-9_\-]+(/[a-zA-Z0-9_\-]+)*$`)
+	versionRegex = regexp.MustCompile(`^[a-zA-Z0-9_.\-]+$`)
+)
+
+// Ref references a template of a registry by name and, optionally, version
+type Ref struct {
+	Name, Version string
+}
+
+// ParseRef parses 'name@version' or 'name' (i.e. latest version)
+func ParseRef(s string) (Ref, error) {
+	var ref Ref
+	ref.Name = s
+	if i := strings.LastIndex(s, "@"); i > -1 {
+		ref.Name, ref.Version = s[:i], s[i+1:]
+		if !versionRegex.MatchString(ref.Version) {
+			return ref, fmt.Errorf("invalid template version '%s'", ref.Version)
+		}
+	}
+	if !nameRegex.MatchString(ref.Name) {
+		return ref, fmt.Errorf("invalid template name '%s': expecting slash separated words (ex: aws/create_instance)", ref.Name)
+	}
+	return ref, nil
+}
+
+func (r Ref) String() string {
+	if r.Version == "" {
+		return r.Name
+	}
+	return r.Name + "@" + r.Version
+}
+
+// Source is a storage of versioned templates
+type Source interface {
+	// List returns the versioned references of all templates of the source
+	List() ([]Ref, error)
+	Read(Ref) ([]byte, error)
+}
+
+// Entry describes the versions of a named template, from its latest version
+type Entry struct {
+	Name        string   `json:"name"`
+	Versions    []string `json:"versions"`
+	Description string   `json:"description,omitempty"`
+	Holes       []string `json:"holes,omitempty"`
+}
+
+func (e *Entry) Latest() string {
+	return e.Versions[len(e.Versions)-1]
+}
+
+// Registry looks up templates in its sources by order of priority
+type Registry struct {
+	sources []Source
+}
+
+func New(sources ...Source) *Registry {
+	return &Registry{sources: sources}
+}
+
+// Resolve returns the content of the referenced template, with the version resolved
+// to the latest one when not given
+func (r *Registry) Resolve(ref Ref) ([]byte, Ref, error) {
+	for _, src := range r.sources {
+		refs, err := src.List()
+		if err != nil {
+			return nil, ref, err
+		}
+		versions := versionsOf(refs, ref.Name)
+		if len(versions) == 0 {
+			continue
+		}
+		resolved := ref
+		if resolved.Version == "" {
+			resolved.Version = versions[len(versions)-1]
+		} else if !contains(versions, resolved.Version) {
+			return nil, ref, fmt.Errorf("template %s: unknown version '%s' (available: %s)", ref.Name, ref.Version, strings.Join(versions, ", "))
+		}
+		content, err := src.Read(resolved)
+		return content, resolved, err
+	}
+	return nil, ref, fmt.Errorf("template %s not found in registry", ref)
+}
+
+// List returns the templates available, sorted by name. Templates of a source
+// shadow the ones of the same name in sources of lower priority.
+func (r *Registry) List() ([]*Entry, error) {
+	var entries []*Entry
+	seen := make(map[string]bool)
+	for _, src := range r.sources {
+		refs, err := src.List()
+		if err != nil {
+			return entries, err
+		}
+		var names []string
+		for _, ref := range refs {
+			if !seen[ref.Name] && !contains(names, ref.Name) {
+				names = append(names, ref.Name)
+			}
+		}
+		for _, name := range names {
+			seen[name] = true
+			entry := &Entry{Name: name, Versions: versionsOf(refs, name)}
+			content, err := src.Read(Ref{Name: name, Version: entry.Latest()})
+			if err != nil {
+				return entries, err
+			}
+			entry.Description = Description(content)
+			if tpl, err := template.Parse(string(content)); err == nil {
+				_, entry.Holes = tpl.Explain(nil)
+			}
+			entries = append(entries, entry)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+	return entries, nil
+}
+
+// Search returns the templates whose name or description contains the given term, ignoring case
+func (r *Registry) Search(term string) ([]*Entry, error) {
+	entries, err := r.List()
+	if err != nil {
+		return nil, err
+	}
+	var found []*Entry
+	term = strings.ToLower(term)
+	for _, e := range entries {
+		if strings.Contains(strings.ToLower(e.Name), term) || strings.Contains(strings.ToLower(e.Description), term) {
+			found = append(found, e)
+		}
+	}
+	return found, nil
+}
+
+// Description returns the text of the leading comments of a template
+func Description(content []byte) string {
+	var lines []string
+	scn := bufio.NewScanner(bytes.NewReader(content))
+	for scn.Scan() {
+		line := strings.TrimSpace(scn.Text())
+		if line == "" {
+			if len(lines) > 0 {
+				break
+			}
+			continue
+		}
+		if !strings.HasPrefix(line, "#") {
+			break
+		}
+		if comment := strings.TrimSpace(strings.TrimLeft(line, "#")); comment != "" && !strings.HasPrefix(comment, "awless") {
+			lines = append(lines, comment)
+		}
+	}
+	return strings.Join(lines, " ")
+}
+
+func versionsOf(refs []Ref, name string) (versions []string) {
+	for _, ref := range refs {
+		if ref.Name == name {
+			versions = append(versions, ref.Version)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool { return compareVersions(versions[i], versions[j]) < 0 })
+	return
+}
+
+// compareVersions compares versions such as 'v2' or '1.10.2' on their numeric parts
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(strings.TrimPrefix(a, "v"), "."), strings.Split(strings.TrimPrefix(b, "v"), ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		ai, aerr := strconv.Atoi(as[i])
+		bi, berr := strconv.Atoi(bs[i])
+		switch {
+		case aerr == nil && berr == nil && ai != bi:
+			if ai < bi {
+				return -1
+			}
+			return 1
+		case (aerr != nil || berr != nil) && as[i] != bs[i]:
+			return strings.Compare(as[i], bs[i])
+		}
+	}
+	return len(as) - len(bs)
+}
+
+func contains(arr []string, s string) bool {
+	for _, e := range arr {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,188 @@
+package registry
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestParseRef(t *testing.T) {
+	tcases := []struct {
+		in     string
+		exp    Ref
+		expErr bool
+	}{
+		{in: "aws/create_instance@v2", exp: Ref{Name: "aws/create_instance", Version: "v2"}},
+		{in: "aws/create_instance", exp: Ref{Name: "aws/create_instance"}},
+		{in: "vpc@1.2.0", exp: Ref{Name: "vpc", Version: "1.2.0"}},
+		{in: "aws/create_instance@", expErr: true},
+		{in: "../create_instance@v1", expErr: true},
+		{in: "/tmp/infra.aws", expErr: true},
+		{in: "aws//vpc", expErr: true},
+	}
+	for i, tcase := range tcases {
+		ref, err := ParseRef(tcase.in)
+		if tcase.expErr {
+			if err == nil {
+				t.Fatalf("%d: expected error, got %v", i+1, ref)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if got, want := ref, tcase.exp; got != want {
+			t.Fatalf("%d: got %v, want %v", i+1, got, want)
+		}
+		if got, want := ref.String(), tcase.in; got != want {
+			t.Fatalf("%d: got %s, want %s", i+1, got, want)
+		}
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tcases := []struct {
+		a, b string
+		exp  int
+	}{
+		{"v1", "v2", -1},
+		{"v10", "v2", 1},
+		{"1.2.0", "1.10.0", -1},
+		{"1.2", "1.2.1", -1},
+		{"v2", "v2", 0},
+		{"beta", "alpha", 1},
+	}
+	for i, tcase := range tcases {
+		got := compareVersions(tcase.a, tcase.b)
+		if (got < 0) != (tcase.exp < 0) || (got > 0) != (tcase.exp > 0) {
+			t.Fatalf("%d: got %d, want %d", i+1, got, tcase.exp)
+		}
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awless-registry")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	local := DirSource(dir)
+	local.Add(Ref{Name: "aws/create_instance", Version: "v1"}, []byte("create instance name={instance.name}"))
+	local.Add(Ref{Name: "aws/create_instance", Version: "v10"}, []byte("# Create an instance\n# in a subnet\n\ncreate instance name={instance.name} subnet={instance.subnet}"))
+	local.Add(Ref{Name: "aws/create_instance", Version: "v2"}, []byte("create instance name={instance.name}"))
+	if err := local.Add(Ref{Name: "aws/create_instance", Version: "v2"}, []byte("")); err == nil {
+		t.Fatal("expected error when adding existing version")
+	}
+	if err := local.Add(Ref{Name: "aws/create_instance"}, []byte("")); err == nil {
+		t.Fatal("expected error when adding without version")
+	}
+
+	remote := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/index.json":
+			w.Write([]byte(`["aws/create_vpc@v1", "aws/create_instance@v3"]`))
+		case "/aws/create_vpc/v1.aws":
+			w.Write([]byte("# Create a VPC\ncreate vpc cidr={vpc.cidr}"))
+		case "/aws/create_instance/v3.aws":
+			w.Write([]byte("create instance"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer remote.Close()
+
+	reg := New(local, HTTPSource(remote.URL))
+
+	content, ref, err := reg.Resolve(Ref{Name: "aws/create_instance"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := ref.Version, "v10"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := Description(content), "Create an instance in a subnet"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if content, _, err = reg.Resolve(Ref{Name: "aws/create_instance", Version: "v1"}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(content), "create instance name={instance.name}"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if _, _, err = reg.Resolve(Ref{Name: "aws/create_instance", Version: "v3"}); err == nil {
+		t.Fatal("expected error: local versions shadow remote ones")
+	}
+	if content, _, err = reg.Resolve(Ref{Name: "aws/create_vpc", Version: "v1"}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(content), "# Create a VPC\ncreate vpc cidr={vpc.cidr}"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if _, _, err = reg.Resolve(Ref{Name: "aws/unknown"}); err == nil {
+		t.Fatal("expected error")
+	}
+
+	entries, err := reg.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []*Entry{
+		{Name: "aws/create_instance", Versions: []string{"v1", "v2", "v10"}, Description: "Create an instance in a subnet", Holes: []string{"instance.name", "instance.subnet"}},
+		{Name: "aws/create_vpc", Versions: []string{"v1"}, Description: "Create a VPC", Holes: []string{"vpc.cidr"}},
+	}
+	if got, want := entries, exp; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got[0], want[0])
+	}
+
+	if entries, err = reg.Search("vpc"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(entries), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if entries, err = reg.Search("SUBNET"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := entries[0].Name, "aws/create_instance"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+func TestNewRemoteSource(t *testing.T) {
+	tcases := []struct {
+		location string
+		exp      Source
+		expErr   bool
+	}{
+		{location: "https://example.com/templates", exp: HTTPSource("https://example.com/templates")},
+		{location: "s3://my-bucket/templates/", exp: HTTPSource("https://my-bucket.s3.amazonaws.com/templates")},
+		{location: "s3://my-bucket", exp: HTTPSource("https://my-bucket.s3.amazonaws.com")},
+		{location: "ftp://example.com", expErr: true},
+	}
+	for i, tcase := range tcases {
+		src, err := NewRemoteSource(tcase.location, "/tmp")
+		if tcase.expErr {
+			if err == nil {
+				t.Fatalf("%d: expected error", i+1)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if got, want := src, tcase.exp; got != want {
+			t.Fatalf("%d: got %v, want %v", i+1, got, want)
+		}
+	}
+	src, err := NewRemoteSource("git+https://github.com/me/templates.git", "/cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := src.(*gitSource).url, "https://github.com/me/templates.git"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// DirSource stores templates as '<dir>/<name>/<version>.aws'
+type DirSource string
+
+func (d DirSource) List() ([]Ref, error) {
+	var refs []Ref
+	err := filepath.Walk(string(d), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && path == string(d) {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if info.IsDir() || filepath.Ext(path) != FileExt {
+			return nil
+		}
+		rel, err := filepath.Rel(string(d), path)
+		if err != nil {
+			return err
+		}
+		name, version := filepath.Split(strings.TrimSuffix(rel, FileExt))
+		ref := Ref{Name: filepath.ToSlash(strings.TrimSuffix(name, string(filepath.Separator))), Version: version}
+		if nameRegex.MatchString(ref.Name) && versionRegex.MatchString(ref.Version) {
+			refs = append(refs, ref)
+		}
+		return nil
+	})
+	return refs, err
+}
+
+func (d DirSource) Read(ref Ref) ([]byte, error) {
+	return ioutil.ReadFile(d.path(ref))
+}
+
+// Add stores a new version of a template, failing if it already exists
+func (d DirSource) Add(ref Ref, content []byte) error {
+	if ref.Version == "" {
+		return fmt.Errorf("template %s: missing version", ref)
+	}
+	path := d.path(ref)
+	if _, err := os.Stat(path); err == nil {
+		return fmt.Errorf("template %s already exists in %s", ref, path)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, content, 0600)
+}
+
+func (d DirSource) path(ref Ref) string {
+	return filepath.Join(string(d), filepath.FromSlash(ref.Name), ref.Version+FileExt)
+}
+
+// HTTPSource serves templates as '<url>/<name>/<version>.aws', listed in
+// '<url>/index.json' as an array of 'name@version' strings
+type HTTPSource string
+
+func (h HTTPSource) List() ([]Ref, error) {
+	content, err := httpGet(strings.TrimSuffix(string(h), "/") + "/index.json")
+	if err != nil {
+		return nil, err
+	}
+	var index []string
+	if err = json.Unmarshal(content, &index); err != nil {
+		return nil, fmt.Errorf("invalid registry index at %s: %s", h, err)
+	}
+	var refs []Ref
+	for _, s := range index {
+		ref, err := ParseRef(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid registry index at %s: %s", h, err)
+		}
+		if ref.Version == "" {
+			return nil, fmt.Errorf("invalid registry index at %s: missing version for %s", h, ref.Name)
+		}
+		refs = append(refs, ref)
+	}
+	return refs, nil
+}
+
+func (h HTTPSource) Read(ref Ref) ([]byte, error) {
+	return httpGet(fmt.Sprintf("%s/%s/%s%s", strings.TrimSuffix(string(h), "/"), ref.Name, ref.Version, FileExt))
+}
+
+func httpGet(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("'%s' when fetching '%s'", resp.Status, url)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// gitSource clones (or updates) a git repository in a cache dir
+// and reads it as a DirSource
+type gitSource struct {
+	url, dir string
+	once     sync.Once
+	err      error
+}
+
+func (g *gitSource) List() ([]Ref, error) {
+	if err := g.fetch(); err != nil {
+		return nil, err
+	}
+	return DirSource(g.dir).List()
+}
+
+func (g *gitSource) Read(ref Ref) ([]byte, error) {
+	if err := g.fetch(); err != nil {
+		return nil, err
+	}
+	return DirSource(g.dir).Read(ref)
+}
+
+func (g *gitSource) fetch() error {
+	g.once.Do(func() {
+		var cmd *exec.Cmd
+		if _, err := os.Stat(filepath.Join(g.dir, ".git")); err == nil {
+			cmd = exec.Command("git", "-C", g.dir, "pull", "--ff-only", "--quiet")
+		} else {
+			cmd = exec.Command("git", "clone", "--depth", "1", "--quiet", g.url, g.dir)
+		}
+		if out, err := cmd.CombinedOutput(); err != nil {
+			g.err = fmt.Errorf("fetching template registry %s: %s: %s", g.url, err, strings.TrimSpace(string(out)))
+		}
+	})
+	return g.err
+}
+
+// NewRemoteSource returns the source for the given location:
+//   - 'https://...' or 'http://...': served over HTTP (see HTTPSource)
+//   - 's3://bucket/prefix': a public S3 bucket, served over HTTPS
+//   - 'git+https://...', 'git+ssh://...' or 'git@...': a git repository, cloned in cacheDir
+func NewRemoteSource(location, cacheDir string) (Source, error) {
+	switch {
+	case strings.HasPrefix(location, "http://"), strings.HasPrefix(location, "https://"):
+		return HTTPSource(location), nil
+	case strings.HasPrefix(location, "s3://"):
+		bucketAndPrefix := strings.SplitN(strings.TrimPrefix(location, "s3://"), "/", 2)
+		url := fmt.Sprintf("https://%s.s3.amazonaws.com", bucketAndPrefix[0])
+		if len(bucketAndPrefix) > 1 && bucketAndPrefix[1] != "" {
+			url += "/" + strings.Trim(bucketAndPrefix[1], "/")
+		}
+		return HTTPSource(url), nil
+	case strings.HasPrefix(location, "git+"), strings.HasPrefix(location, "git@"):
+		url := strings.TrimPrefix(location, "git+")
+		return &gitSource{url: url, dir: filepath.Join(cacheDir, fmt.Sprintf("%x", sha1.Sum([]byte(url))))}, nil
+	default:
+		return nil, fmt.Errorf("unsupported template registry '%s': expecting http(s)://, s3:// or git+ URL", location)
+	}
+}
@@ -0,0 +1,101 @@
+package template
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// Hash identifies the shape of the template: its commands with their action, entity
+// and param keys, in order. Param values are left out so that runs of a same template
+// with different params (ex: another instance type) share their hash
+func (s *Template) Hash() string {
+	h := sha256.New()
+	for _, cmd := range s.CommandNodesIterator() {
+		keys := make(map[string]struct{})
+		for _, k := range cmd.Keys() {
+			keys[k] = struct{}{}
+		}
+		var sorted []string
+		for k := range keys {
+			sorted = append(sorted, k)
+		}
+		sort.Strings(sorted)
+		fmt.Fprintf(h, "%s %s %s\n", cmd.Action, cmd.Entity, strings.Join(sorted, ","))
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+// ParamChange is a param whose value differs between two runs of a same template
+type ParamChange struct {
+	// Index is the position, starting at 1, of the command in the template
+	Index                   int
+	Entity, Param           string
+	Previous, Current       string
+	previousSet, currentSet bool
+}
+
+func (c ParamChange) String() string {
+	previous, current := c.Previous, c.Current
+	if !c.previousSet {
+		previous = "(none)"
+	}
+	if !c.currentSet {
+		current = "(none)"
+	}
+	return fmt.Sprintf("%s.%s: %s → %s", c.Entity, c.Param, previous, current)
+}
+
+// DiffParams lists, command by command, the params of the template whose values differ
+// from the ones of a previous run of a template with the same hash. Params referencing
+// other statements are skipped, their values being only known once run
+func (s *Template) DiffParams(previous *Template) ([]ParamChange, error) {
+	if s.Hash() != previous.Hash() {
+		return nil, fmt.Errorf("diff params: templates do not have the same commands")
+	}
+	var changes []ParamChange
+	previousCmds := previous.CommandNodesIterator()
+	for i, cmd := range s.CommandNodesIterator() {
+		changes = append(changes, diffCommandParams(i+1, previousCmds[i], cmd)...)
+	}
+	return changes, nil
+}
+
+func diffCommandParams(index int, previous, current *ast.CommandNode) (changes []ParamChange) {
+	keys := make(map[string]struct{})
+	for k := range previous.ParamNodes {
+		keys[k] = struct{}{}
+	}
+	for k := range current.ParamNodes {
+		keys[k] = struct{}{}
+	}
+	var sorted []string
+	for k := range keys {
+		_, previousRef := previous.Refs[k]
+		_, currentRef := current.Refs[k]
+		if !previousRef && !currentRef {
+			sorted = append(sorted, k)
+		}
+	}
+	sort.Strings(sorted)
+
+	for _, k := range sorted {
+		change := ParamChange{Index: index, Entity: current.Entity, Param: k}
+		var before, after interface{}
+		before, change.previousSet = previous.ParamNodes[k]
+		after, change.currentSet = current.ParamNodes[k]
+		if change.previousSet {
+			change.Previous = printItem(before)
+		}
+		if change.currentSet {
+			change.Current = printItem(after)
+		}
+		if change.previousSet != change.currentSet || change.Previous != change.Current {
+			changes = append(changes, change)
+		}
+	}
+	return
+}
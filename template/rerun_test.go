@@ -0,0 +1,83 @@
+package template
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHashAndDiffParamsOfRerunTemplates(t *testing.T) {
+	previous := MustParse(`subnet = create subnet cidr=10.0.1.0/24 vpc=vpc-1234
+create instance subnet=$subnet type=t2.micro name=web securitygroup=[sg-1,sg-2]`)
+	current := MustParse(`subnet = create subnet vpc=vpc-1234 cidr=10.0.2.0/24
+create instance name=web type=m4.large securitygroup=[sg-1,sg-3] subnet=$subnet`)
+
+	if previous.Hash() != current.Hash() {
+		t.Fatal("expected same hash for templates with the same commands and param keys")
+	}
+	for _, other := range []string{
+		"create subnet cidr=10.0.1.0/24 vpc=vpc-1234",
+		"create subnet cidr=10.0.1.0/24 vpc=vpc-1234\ncreate instance subnet=sub-1 type=t2.micro name=web",
+		"create subnet cidr=10.0.1.0/24 vpc=vpc-1234\ncreate instance subnet=sub-1 type=t2.micro name=web securitygroup=sg-1 keypair=mykey",
+	} {
+		if MustParse(other).Hash() == previous.Hash() {
+			t.Fatalf("expected different hash for %q", other)
+		}
+	}
+
+	changes, err := current.DiffParams(previous)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, c := range changes {
+		got = append(got, c.String())
+	}
+	exp := []string{
+		"subnet.cidr: 10.0.1.0/24 → 10.0.2.0/24",
+		"instance.securitygroup: [sg-1,sg-2] → [sg-1,sg-3]",
+		"instance.type: t2.micro → m4.large",
+	}
+	if !reflect.DeepEqual(got, exp) {
+		t.Fatalf("got %q, want %q", got, exp)
+	}
+	if got, want := changes[2].Index, 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	changes, err = previous.DiffParams(previous)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 0 {
+		t.Fatalf("expected no change, got %v", changes)
+	}
+
+	if _, err = current.DiffParams(MustParse("create subnet cidr=10.0.1.0/24 vpc=vpc-1234")); err == nil {
+		t.Fatal("expected error for templates with different hashes")
+	}
+}
+
+func TestDiffParamsAgainstExecutionJournal(t *testing.T) {
+	previous := MustParse("create instance subnet=sub-1 type=t2.micro name=web")
+	previous.ID = "01BX5ZZKBKACTAV9WEVGEMMVRZ"
+	b, err := (&TemplateExecution{Template: previous}).MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored := &TemplateExecution{}
+	if err = stored.UnmarshalJSON(b); err != nil {
+		t.Fatal(err)
+	}
+
+	current := MustParse("create instance subnet=sub-1 type=t2.small name=web")
+	if current.Hash() != stored.Hash() {
+		t.Fatal("expected same hash as the stored execution")
+	}
+	changes, err := current.DiffParams(stored.Template)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].String() != "instance.type: t2.micro → t2.small" {
+		t.Fatalf("got %v", changes)
+	}
+}
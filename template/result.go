@@ -0,0 +1,158 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid"
+	"github.com/wallix/awless/template/internal/ast"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const (
+	JSONResultFormat = "json"
+	YAMLResultFormat = "yaml"
+
+	SuccessStatus     = "success"
+	FailureStatus     = "failure"
+	SkippedStatus     = "skipped"
+	InterruptedStatus = "interrupted"
+)
+
+var ResultFormats = []string{JSONResultFormat, YAMLResultFormat}
+
+// RunResult is the machine-readable report of a template execution,
+// to be consumed by CI pipelines instead of the human log lines
+type RunResult struct {
+	ID         string             `json:"id" yaml:"id"`
+	RevertID   string             `json:"revertId,omitempty" yaml:"revertId,omitempty"`
+	Status     string             `json:"status" yaml:"status"`
+	Date       time.Time          `json:"date" yaml:"date"`
+	DurationMs int64              `json:"durationMs" yaml:"durationMs"`
+	Path       string             `json:"path,omitempty" yaml:"path,omitempty"`
+	Message    string             `json:"message,omitempty" yaml:"message,omitempty"`
+	Author     string             `json:"author,omitempty" yaml:"author,omitempty"`
+	Profile    string             `json:"profile,omitempty" yaml:"profile,omitempty"`
+	Region     string             `json:"region" yaml:"region"`
+	Statements []*StatementResult `json:"statements" yaml:"statements"`
+	Errors     []string           `json:"errors,omitempty" yaml:"errors,omitempty"`
+}
+
+type StatementResult struct {
+	Line       string `json:"line" yaml:"line"`
+	Action     string `json:"action" yaml:"action"`
+	Entity     string `json:"entity" yaml:"entity"`
+	Variable   string `json:"variable,omitempty" yaml:"variable,omitempty"`
+	Origin     string `json:"origin,omitempty" yaml:"origin,omitempty"`
+	Status     string `json:"status" yaml:"status"`
+	Result     string `json:"result,omitempty" yaml:"result,omitempty"`
+	Error      string `json:"error,omitempty" yaml:"error,omitempty"`
+	DurationMs int64  `json:"durationMs" yaml:"durationMs"`
+}
+
+// Result builds the report of the executed template
+func (t *TemplateExecution) Result() *RunResult {
+	res := &RunResult{
+		ID:         t.ID,
+		Status:     SuccessStatus,
+		DurationMs: durationMs(t.Duration),
+		Path:       t.Path,
+		Message:    t.Message,
+		Author:     t.Author,
+		Profile:    t.Profile,
+		Region:     t.Locale,
+		Statements: []*StatementResult{},
+	}
+	if parsed, err := ulid.Parse(t.ID); err == nil {
+		res.Date = time.Unix(0, int64(parsed.Time())*int64(time.Millisecond)).UTC()
+	}
+
+	for _, st := range t.Statements {
+		var cmd *ast.CommandNode
+		var variable string
+		switch n := st.Node.(type) {
+		case *ast.CommandNode:
+			cmd = n
+		case *ast.DeclarationNode:
+			if c, ok := n.Expr.(*ast.CommandNode); ok {
+				cmd, variable = c, n.Ident
+			}
+		}
+		if cmd == nil {
+			continue
+		}
+		stRes := &StatementResult{
+			Line:       cmd.String(),
+			Action:     cmd.Action,
+			Entity:     cmd.Entity,
+			Variable:   variable,
+			Origin:     originOf(cmd),
+			Status:     SuccessStatus,
+			DurationMs: durationMs(cmd.CmdDuration),
+		}
+		if cmd.CmdResult != nil {
+			stRes.Result = fmt.Sprint(cmd.CmdResult)
+		}
+		switch {
+		case IsSkipped(cmd.CmdErr):
+			stRes.Status = SkippedStatus
+			stRes.Error = cmd.CmdErr.Error()
+			res.Status = InterruptedStatus
+		case cmd.CmdErr != nil:
+			stRes.Status = FailureStatus
+			stRes.Error = cmd.CmdErr.Error()
+			if res.Status != InterruptedStatus {
+				res.Status = FailureStatus
+			}
+			res.Errors = append(res.Errors, cmd.CmdErr.Error())
+		}
+		res.Statements = append(res.Statements, stRes)
+	}
+
+	if IsRevertible(t.Template) {
+		res.RevertID = t.ID
+	}
+	return res
+}
+
+// Marshal the report in one of the ResultFormats
+func (r *RunResult) Marshal(format string) ([]byte, error) {
+	if err := ValidateResultFormat(format); err != nil {
+		return nil, err
+	}
+	if strings.ToLower(format) == YAMLResultFormat {
+		return yaml.Marshal(r)
+	}
+	return json.MarshalIndent(r, "", "  ")
+}
+
+func ValidateResultFormat(format string) error {
+	for _, f := range ResultFormats {
+		if strings.ToLower(format) == f {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown result format '%s', expecting one of %s", format, strings.Join(ResultFormats, ", "))
+}
+
+func durationMs(d time.Duration) int64 {
+	return int64(d / time.Millisecond)
+}
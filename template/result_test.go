@@ -0,0 +1,73 @@
+package template
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTemplateExecutionResult(t *testing.T) {
+	tpl := MustParse("vpc = create vpc cidr=10.0.0.0/16\ncreate subnet cidr=10.0.0.0/24 vpc=$vpc\nstart instance id=i-1")
+	tpl.ID = "01BTT1AA3N36VCKSNKAKN4WX2N"
+	cmds := tpl.CommandNodesIterator()
+	cmds[0].CmdResult, cmds[0].CmdDuration = "vpc-1", 1500*time.Millisecond
+	cmds[1].CmdResult = "subnet-1"
+	cmds[2].CmdErr = errors.New("instance not found")
+
+	tplExec := &TemplateExecution{Template: tpl, Locale: "eu-west-1", Path: "infra.aws", Duration: 2 * time.Second}
+	res := tplExec.Result()
+	if got, want := res.Status, FailureStatus; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := res.Date.Year(), 2017; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := res.DurationMs, int64(2000); got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := len(res.Statements), 3; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	vpcRes := res.Statements[0]
+	if vpcRes.Variable != "vpc" || vpcRes.Result != "vpc-1" || vpcRes.Status != SuccessStatus || vpcRes.DurationMs != 1500 || vpcRes.Action != "create" || vpcRes.Entity != "vpc" {
+		t.Fatalf("unexpected statement result %#v", vpcRes)
+	}
+	if got, want := res.Statements[2].Error, "instance not found"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := strings.Join(res.Errors, ","), "instance not found"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	b, err := res.Marshal("json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromJSON RunResult
+	if err = json.Unmarshal(b, &fromJSON); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fromJSON.Statements[1].Result, "subnet-1"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if b, err = res.Marshal("yaml"); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), "status: failure") || !strings.Contains(string(b), "variable: vpc") {
+		t.Fatalf("unexpected yaml:\n%s", b)
+	}
+	if _, err = res.Marshal("xml"); err == nil {
+		t.Fatal("expected error")
+	}
+
+	cmds[2].CmdErr = nil
+	res = tplExec.Result()
+	if got, want := res.Status, SuccessStatus; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := res.RevertID, tpl.ID; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
@@ -1,28 +1,57 @@
 package template
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/wallix/awless/template/internal/ast"
 )
 
 func (te *Template) Revert() (*Template, error) {
+	expansion := "revert"
+	if te.ID != "" {
+		expansion = fmt.Sprintf("revert of %s", te.ID)
+	}
+	return te.revert(func(cmd *ast.CommandNode) *ast.Origin {
+		return cmd.Origin.Expand(expansion)
+	})
+}
+
+// revert returns the revert template, the origin of each revert command being the one
+// returned by originFn for the reverted command
+func (te *Template) revert(originFn func(*ast.CommandNode) *ast.Origin) (*Template, error) {
 	var lines []string
+	var origins []*ast.Origin
 	cmdsReverseIterator := te.CommandNodesReverseIterator()
 	for i, cmd := range cmdsReverseIterator {
 		notLastCommand := (i != len(cmdsReverseIterator)-1)
+		// the revert statements run in the account of the reverted one
+		first, modifiers := len(lines), modifiersSuffix(cmd)
+		cmd = withoutModifiers(cmd)
 		if isRevertible(cmd) {
 			var revertAction string
 			var params []string
+			revertEntity := cmd.Entity
 
 			switch cmd.Action {
-			case "create", "copy":
+			case "create", "copy", "restore", "register":
 				revertAction = "delete"
+				switch cmd.Entity {
+				case "environment":
+					revertAction = "terminate"
+				case "spotinstance", "spotfleet":
+					revertAction, revertEntity = "cancel", "spotrequest"
+				}
 			case "start":
 				revertAction = "stop"
 			case "stop":
 				revertAction = "start"
+			case "enable":
+				revertAction = "disable"
+			case "disable":
+				revertAction = "enable"
 			case "detach":
 				revertAction = "attach"
 			case "attach":
@@ -31,6 +60,10 @@ func (te *Template) Revert() (*Template, error) {
 				revertAction = "create"
 			case "update":
 				revertAction = "update"
+			case "resize":
+				revertAction = "resize"
+			case "move":
+				revertAction = "move"
 			}
 
 			switch cmd.Action {
@@ -53,15 +86,31 @@ func (te *Template) Revert() (*Template, error) {
 				case "mfadevice":
 					params = append(params, fmt.Sprintf("id=%s", printItem(cmd.ParamNodes["id"])))
 					params = append(params, fmt.Sprintf("user=%s", printItem(cmd.ParamNodes["user"])))
+				case "target":
+					params = append(params, fmt.Sprintf("id=%s", quoteParamIfNeeded(cmd.CmdResult)))
+					params = append(params, fmt.Sprintf("rule=%s", printItem(cmd.ParamNodes["rule"])))
+				case "networkacl":
+					revertAction = "attach"
+					params = append(params, fmt.Sprintf("id=%s", printItem(cmd.CmdPriorState["id"])))
+					params = append(params, fmt.Sprintf("subnet=%s", printItem(cmd.ParamNodes["subnet"])))
 				default:
 					for k, v := range cmd.ParamNodes {
 						params = append(params, fmt.Sprintf("%s=%v", k, v))
 					}
 				}
-			case "start", "stop", "detach":
+			case "start", "stop", "enable", "disable", "detach":
 				switch {
 				case cmd.Entity == "routetable":
 					params = append(params, fmt.Sprintf("association=%s", quoteParamIfNeeded(cmd.CmdResult)))
+				case cmd.Entity == "execution":
+					params = append(params, fmt.Sprintf("id=%s", quoteParamIfNeeded(cmd.CmdResult)))
+				case (cmd.Entity == "elasticip" || cmd.Entity == "networkinterface") && cmd.Action == "detach":
+					for k, v := range cmd.CmdPriorState {
+						params = append(params, fmt.Sprintf("%s=%s", k, printItem(v)))
+					}
+				case cmd.Entity == "networkacl" && cmd.Action == "detach":
+					params = append(params, fmt.Sprintf("id=%s", printItem(cmd.CmdPriorState["id"])))
+					params = append(params, fmt.Sprintf("subnet=%s", printItem(cmd.ParamNodes["subnet"])))
 				case cmd.Entity == "volume" && cmd.Action == "detach":
 					for k, v := range cmd.ParamNodes {
 						if k == "force" {
@@ -105,14 +154,36 @@ func (te *Template) Revert() (*Template, error) {
 						}
 						params = append(params, fmt.Sprintf("%s=%v", k, printItem(v)))
 					}
-				case "database":
+				case "networkaclrule":
+					for _, k := range []string{"networkacl", "number", "outbound"} {
+						if v, ok := cmd.ParamNodes[k]; ok {
+							params = append(params, fmt.Sprintf("%s=%v", k, printItem(v)))
+						}
+					}
+				case "database", "cluster":
 					params = append(params, fmt.Sprintf("id=%s", quoteParamIfNeeded(cmd.CmdResult)))
 					params = append(params, "skip-snapshot=true")
 				case "certificate":
 					params = append(params, fmt.Sprintf("arn=%s", quoteParamIfNeeded(cmd.CmdResult)))
+				case "spotinstance", "spotfleet":
+					params = append(params, fmt.Sprintf("id=%s", quoteParamIfNeeded(cmd.CmdResult)))
+					params = append(params, "terminate-instances=true")
+				case "image":
+					params = append(params, fmt.Sprintf("id=%s", quoteParamIfNeeded(cmd.CmdResult)))
+					if _, fromInstance := cmd.ParamNodes["instance"]; fromInstance {
+						params = append(params, "delete-snapshots=true")
+					}
+				case "natgateway":
+					params = append(params, fmt.Sprintf("id=%s", quoteParamIfNeeded(cmd.CmdResult)))
+					if _, withElasticIP := cmd.ParamNodes["elasticip-id"]; !withElasticIP {
+						params = append(params, "release-elasticip=true")
+					}
 				case "policy":
 					params = append(params, fmt.Sprintf("arn=%s", quoteParamIfNeeded(cmd.CmdResult)))
 					params = append(params, "all-versions=true")
+				case "policyversion":
+					params = append(params, fmt.Sprintf("arn=%s", printItem(cmd.ParamNodes["arn"])))
+					params = append(params, fmt.Sprintf("version=%s", quoteParamIfNeeded(cmd.CmdResult)))
 				case "queue":
 					params = append(params, fmt.Sprintf("url=%s", quoteParamIfNeeded(cmd.CmdResult)))
 				case "s3object":
@@ -123,6 +194,19 @@ func (te *Template) Revert() (*Template, error) {
 				case "accesskey":
 					params = append(params, fmt.Sprintf("id=%s", quoteParamIfNeeded(cmd.CmdResult)))
 					params = append(params, fmt.Sprintf("user=%s", printItem(cmd.ParamNodes["user"])))
+				case "grant":
+					params = append(params, fmt.Sprintf("id=%s", quoteParamIfNeeded(cmd.CmdResult)))
+					params = append(params, fmt.Sprintf("key=%s", printItem(cmd.ParamNodes["key"])))
+				case "resource", "deployment":
+					params = append(params, fmt.Sprintf("id=%s", quoteParamIfNeeded(cmd.CmdResult)))
+					params = append(params, fmt.Sprintf("restapi=%s", printItem(cmd.ParamNodes["restapi"])))
+				case "method":
+					params = append(params, fmt.Sprintf("http-method=%s", quoteParamIfNeeded(cmd.CmdResult)))
+					params = append(params, fmt.Sprintf("resource=%s", printItem(cmd.ParamNodes["resource"])))
+					params = append(params, fmt.Sprintf("restapi=%s", printItem(cmd.ParamNodes["restapi"])))
+				case "stage":
+					params = append(params, fmt.Sprintf("name=%s", quoteParamIfNeeded(cmd.CmdResult)))
+					params = append(params, fmt.Sprintf("restapi=%s", printItem(cmd.ParamNodes["restapi"])))
 				case "appscalingtarget":
 					params = append(params, fmt.Sprintf("dimension=%s", printItem(cmd.ParamNodes["dimension"])))
 					params = append(params, fmt.Sprintf("resource=%s", printItem(cmd.ParamNodes["resource"])))
@@ -134,7 +218,10 @@ func (te *Template) Revert() (*Template, error) {
 					params = append(params, fmt.Sprintf("service-namespace=%s", printItem(cmd.ParamNodes["service-namespace"])))
 				case "loginprofile":
 					params = append(params, fmt.Sprintf("username=%s", printItem(cmd.ParamNodes["username"])))
-				case "bucket", "launchconfiguration", "scalinggroup", "alarm", "dbsubnetgroup", "keypair":
+				case "containerservice":
+					params = append(params, fmt.Sprintf("cluster=%s", printItem(cmd.ParamNodes["cluster"])))
+					params = append(params, fmt.Sprintf("name=%s", printItem(cmd.ParamNodes["name"])))
+				case "bucket", "launchconfiguration", "scalinggroup", "alarm", "dbsubnetgroup", "dbparametergroup", "keypair", "table", "classicloadbalancer", "cachesubnetgroup", "loggroup", "rule", "alias", "parameter", "secret", "application", "catalogdatabase", "crawler", "placementgroup", "kubernetescluster":
 					params = append(params, fmt.Sprintf("name=%s", quoteParamIfNeeded(cmd.CmdResult)))
 					if cmd.Entity == "scalinggroup" {
 						params = append(params, "force=true")
@@ -151,11 +238,21 @@ func (te *Template) Revert() (*Template, error) {
 				case "instanceprofile":
 					params = append(params, fmt.Sprintf("name=%s", printItem(cmd.ParamNodes["name"])))
 				}
+			case "register":
+				params = append(params, fmt.Sprintf("id=%s", quoteParamIfNeeded(cmd.CmdResult)))
+			case "restore":
+				params = append(params, fmt.Sprintf("id=%s", quoteParamIfNeeded(cmd.CmdResult)))
+				if cmd.Entity == "database" {
+					params = append(params, "skip-snapshot=true")
+				}
 			case "copy":
 				switch cmd.Entity {
 				case "image":
 					params = append(params, fmt.Sprintf("id=%s", quoteParamIfNeeded(cmd.CmdResult)))
 					params = append(params, "delete-snapshots=true")
+					if region, ok := cmd.ParamNodes["region"]; ok {
+						params = append(params, fmt.Sprintf("region=%s", printItem(region)))
+					}
 				default:
 					params = append(params, fmt.Sprintf("id=%s", quoteParamIfNeeded(cmd.CmdResult)))
 				}
@@ -171,9 +268,46 @@ func (te *Template) Revert() (*Template, error) {
 							}
 							continue
 						}
+						if prior, ok := cmd.CmdPriorState[k]; ok {
+							v = prior
+						}
 						params = append(params, fmt.Sprintf("%s=%v", k, printItem(v)))
 					}
+				case "policy":
+					params = append(params, fmt.Sprintf("arn=%s", printItem(cmd.ParamNodes["arn"])))
+					params = append(params, fmt.Sprintf("default-version=%s", printItem(cmd.CmdPriorState["default-version"])))
+				case "networkaclrule":
+					for k, v := range cmd.ParamNodes {
+						if k == "portrange" {
+							continue
+						}
+						if prior, ok := cmd.CmdPriorState[k]; ok {
+							v = prior
+						}
+						params = append(params, fmt.Sprintf("%s=%v", k, printItem(v)))
+					}
+					if ports, ok := cmd.CmdPriorState["portrange"]; ok {
+						params = append(params, fmt.Sprintf("portrange=%v", printItem(ports)))
+					}
+				default:
+					for k, v := range cmd.ParamNodes {
+						if prior, ok := cmd.CmdPriorState[k]; ok {
+							v = prior
+						}
+						params = append(params, fmt.Sprintf("%s=%v", k, printItem(v)))
+					}
+				}
+			case "resize":
+				for k, v := range cmd.ParamNodes {
+					if prior, ok := cmd.CmdPriorState[k]; ok {
+						v = prior
+					}
+					params = append(params, fmt.Sprintf("%s=%v", k, printItem(v)))
 				}
+			case "move":
+				params = append(params, fmt.Sprintf("id=%s", printItem(cmd.ParamNodes["id"])))
+				params = append(params, fmt.Sprintf("source=%s", printItem(cmd.ParamNodes["destination"])))
+				params = append(params, fmt.Sprintf("destination=%s", printItem(cmd.CmdPriorState["destination"])))
 			}
 
 			// Prechecks
@@ -184,6 +318,21 @@ func (te *Template) Revert() (*Template, error) {
 				lines = append(lines, fmt.Sprintf("update scalinggroup name=%s max-size=0 min-size=0", quoteParamIfNeeded(cmd.CmdResult)))
 				lines = append(lines, fmt.Sprintf("check scalinggroup count=0 name=%s timeout=600", quoteParamIfNeeded(cmd.CmdResult)))
 			}
+			if cmd.Action == "create" && cmd.Entity == "deployment" && cmd.ParamNodes["stage"] != nil {
+				lines = append(lines, fmt.Sprintf("delete stage name=%s restapi=%s", printItem(cmd.ParamNodes["stage"]), printItem(cmd.ParamNodes["restapi"])))
+			}
+			if cmd.Action == "create" && cmd.Entity == "policyversion" && cmd.CmdPriorState["default-version"] != nil {
+				lines = append(lines, fmt.Sprintf("update policy arn=%s default-version=%s", printItem(cmd.ParamNodes["arn"]), printItem(cmd.CmdPriorState["default-version"])))
+			}
+			if cmd.Action == "create" && cmd.Entity == "kubernetescluster" {
+				lines = append(lines, fmt.Sprintf("check kubernetescluster name=%s state=active timeout=1800", quoteParamIfNeeded(cmd.CmdResult)))
+			}
+			if cmd.Action == "create" && (cmd.Entity == "transitgateway" || cmd.Entity == "transitgatewayattachment" || cmd.Entity == "transitgatewayroutetable") {
+				lines = append(lines, fmt.Sprintf("check %s id=%s state=available timeout=600", cmd.Entity, quoteParamIfNeeded(cmd.CmdResult)))
+			}
+			if cmd.Action == "resize" && cmd.Entity == "cluster" {
+				lines = append(lines, fmt.Sprintf("check cluster id=%s state=available timeout=3600", printItem(cmd.ParamNodes["id"])))
+			}
 			if cmd.Action == "start" && cmd.Entity == "instance" {
 				switch vv := cmd.ParamNodes["ids"].(type) {
 				case string:
@@ -212,7 +361,7 @@ func (te *Template) Revert() (*Template, error) {
 				lines = append(lines, fmt.Sprintf("update containertask cluster=%s deployment-name=%s desired-count=0", printItem(cmd.ParamNodes["cluster"]), printItem(cmd.ParamNodes["deployment-name"])))
 			}
 
-			lines = append(lines, fmt.Sprintf("%s %s %s", revertAction, cmd.Entity, strings.Join(params, " ")))
+			lines = append(lines, fmt.Sprintf("%s %s %s", revertAction, revertEntity, strings.Join(params, " ")))
 
 			// Postchecks
 			if notLastCommand {
@@ -222,6 +371,15 @@ func (te *Template) Revert() (*Template, error) {
 				if cmd.Action == "create" && cmd.Entity == "database" {
 					lines = append(lines, fmt.Sprintf("check database id=%s state=not-found timeout=900", quoteParamIfNeeded(cmd.CmdResult)))
 				}
+				if cmd.Action == "create" && cmd.Entity == "cachecluster" {
+					lines = append(lines, fmt.Sprintf("check cachecluster id=%s state=not-found timeout=900", quoteParamIfNeeded(cmd.CmdResult)))
+				}
+				if cmd.Action == "create" && cmd.Entity == "cluster" {
+					lines = append(lines, fmt.Sprintf("check cluster id=%s state=not-found timeout=900", quoteParamIfNeeded(cmd.CmdResult)))
+				}
+				if cmd.Action == "create" && cmd.Entity == "kubernetescluster" {
+					lines = append(lines, fmt.Sprintf("check kubernetescluster name=%s state=not-found timeout=900", quoteParamIfNeeded(cmd.CmdResult)))
+				}
 				if cmd.Action == "create" && cmd.Entity == "loadbalancer" {
 					lines = append(lines, fmt.Sprintf("check loadbalancer id=%s state=not-found timeout=180", quoteParamIfNeeded(cmd.CmdResult)))
 				}
@@ -231,8 +389,20 @@ func (te *Template) Revert() (*Template, error) {
 				if cmd.Action == "create" && cmd.Entity == "natgateway" {
 					lines = append(lines, fmt.Sprintf("check natgateway id=%s state=deleted timeout=180", quoteParamIfNeeded(cmd.CmdResult)))
 				}
+				if cmd.Action == "create" && cmd.Entity == "vpnconnection" {
+					lines = append(lines, fmt.Sprintf("check vpnconnection id=%s state=deleted timeout=180", quoteParamIfNeeded(cmd.CmdResult)))
+				}
+				if cmd.Action == "create" && cmd.Entity == "transitgatewayattachment" {
+					lines = append(lines, fmt.Sprintf("check transitgatewayattachment id=%s state=deleted timeout=600", quoteParamIfNeeded(cmd.CmdResult)))
+				}
 			}
 		}
+		for j := first; j < len(lines); j++ {
+			lines[j] += modifiers
+		}
+		for origin := originFn(cmd); len(origins) < len(lines); {
+			origins = append(origins, origin)
+		}
 	}
 
 	text := strings.Join(lines, "\n")
@@ -240,10 +410,138 @@ func (te *Template) Revert() (*Template, error) {
 	if err != nil {
 		return nil, fmt.Errorf("revert: \n%s\n%s", text, err)
 	}
+	if revertCmds := tpl.CommandNodesIterator(); len(revertCmds) == len(origins) {
+		for i, revertCmd := range revertCmds {
+			revertCmd.Origin = origins[i]
+		}
+	}
 
 	return tpl, nil
 }
 
+// RevertSelection restricts the commands of a template to revert, either
+// by entities (ex: instance, subnet) and/or by a range of command positions.
+// Positions start at 1 and To is inclusive. A zero value means unbounded.
+type RevertSelection struct {
+	Entities []string
+	From, To int
+}
+
+// ParseRevertRange parses ranges of commands positions such as "3", "2:5", "2:" or ":5"
+func ParseRevertRange(s string) (from, to int, err error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, 0, nil
+	}
+	splits := strings.SplitN(s, ":", 2)
+	if from, err = parseRangeBound(splits[0]); err != nil {
+		return
+	}
+	if len(splits) == 1 {
+		return from, from, nil
+	}
+	if to, err = parseRangeBound(splits[1]); err != nil {
+		return
+	}
+	if to > 0 && from > to {
+		err = fmt.Errorf("invalid range '%s': start is after end", s)
+	}
+	return
+}
+
+func parseRangeBound(s string) (int, error) {
+	if s = strings.TrimSpace(s); s == "" {
+		return 0, nil
+	}
+	i, err := strconv.Atoi(s)
+	if err != nil || i < 1 {
+		return 0, fmt.Errorf("invalid range bound '%s': expecting a positive number", s)
+	}
+	return i, nil
+}
+
+func (sel *RevertSelection) selects(pos int, cmd *ast.CommandNode) bool {
+	if sel == nil {
+		return true
+	}
+	if sel.From > 0 && pos < sel.From {
+		return false
+	}
+	if sel.To > 0 && pos > sel.To {
+		return false
+	}
+	if len(sel.Entities) > 0 && !contains(sel.Entities, cmd.Entity) {
+		return false
+	}
+	return true
+}
+
+// PartialRevert returns the revert template of the selected commands only.
+// It fails if a selected resource creation is referenced by a command that is not reverted
+// (ex: reverting the creation of a VPC while keeping the creation of its subnets)
+func (te *Template) PartialRevert(sel *RevertSelection) (*Template, error) {
+	partial := &Template{ID: te.ID, AST: &ast.AST{}}
+	var kept []*ast.CommandNode
+	var selected []*ast.CommandNode
+	for i, cmd := range te.CommandNodesIterator() {
+		if sel.selects(i+1, cmd) {
+			selected = append(selected, cmd)
+			partial.Statements = append(partial.Statements, &ast.Statement{Node: cmd})
+		} else {
+			kept = append(kept, cmd)
+		}
+	}
+
+	if !IsRevertible(partial) {
+		return nil, errors.New("revert: no revertible command in selection")
+	}
+
+	for _, cmd := range selected {
+		if !isRevertible(cmd) || (cmd.Action != "create" && cmd.Action != "copy") {
+			continue
+		}
+		created, ok := cmd.CmdResult.(string)
+		if !ok || created == "" {
+			continue
+		}
+		for _, other := range kept {
+			if other.CmdErr != nil || other.Action == "check" || other.Action == "delete" || other.Action == "terminate" || other.Action == "cancel" {
+				continue
+			}
+			if paramsReference(other, created) {
+				return nil, fmt.Errorf("revert: cannot revert '%s %s' (%s): still used by '%s' which is not reverted", cmd.Action, cmd.Entity, created, other.String())
+			}
+		}
+	}
+
+	return partial.Revert()
+}
+
+func paramsReference(cmd *ast.CommandNode, value string) bool {
+	for _, v := range cmd.ToDriverParams() {
+		var values []interface{}
+		switch vv := v.(type) {
+		case []interface{}:
+			values = vv
+		case ast.ListNode:
+			for _, e := range vv.Elems() {
+				if n, ok := e.(ast.InterfaceNode); ok {
+					e = n.Value()
+				}
+				values = append(values, e)
+			}
+		default:
+			values = append(values, vv)
+		}
+		for _, e := range values {
+			if fmt.Sprint(e) == value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func IsRevertible(t *Template) bool {
 	revertible := false
 	t.visitCommandNodes(func(cmd *ast.CommandNode) {
@@ -259,11 +557,22 @@ func isRevertible(cmd *ast.CommandNode) bool {
 		return false
 	}
 
-	if cmd.Action == "check" {
+	if cmd.Action == "check" || cmd.Action == "wait" {
+		return false
+	}
+
+	if cmd.Entity == "invalidation" || cmd.Entity == "query" || cmd.Entity == "presignedurl" {
 		return false
 	}
 
-	if cmd.Action == "detach" && cmd.Entity == "routetable" {
+	// uploads of directories result in the prefix of their objects
+	if cmd.Entity == "s3object" && cmd.Action == "create" {
+		if prefix, ok := cmd.CmdResult.(string); ok && strings.HasSuffix(prefix, "/") {
+			return false
+		}
+	}
+
+	if cmd.Action == "detach" && (cmd.Entity == "routetable" || cmd.Entity == "target") {
 		return false
 	}
 
@@ -283,6 +592,10 @@ func isRevertible(cmd *ast.CommandNode) bool {
 		return true
 	}
 
+	if cmd.Entity == "key" && (cmd.Action == "enable" || cmd.Action == "disable") {
+		return true
+	}
+
 	if cmd.Entity == "containertask" && cmd.Action == "start" {
 		t, ok := cmd.ToDriverParams()["type"].(string)
 		return ok && (t == "service" || t == "task")
@@ -300,18 +613,39 @@ func isRevertible(cmd *ast.CommandNode) bool {
 		return true
 	}
 
+	if (cmd.Entity == "account" || cmd.Entity == "hostreservation") && cmd.Action == "create" {
+		return false
+	}
+
+	if cmd.Entity == "networkacl" && (cmd.Action == "attach" || cmd.Action == "detach") {
+		return cmd.CmdPriorState["id"] != nil
+	}
+
+	if (cmd.Entity == "elasticip" || cmd.Entity == "networkinterface") && cmd.Action == "detach" {
+		return cmd.CmdPriorState["id"] != nil
+	}
+
+	if (cmd.Action == "update" || cmd.Action == "resize" || cmd.Action == "move") && len(cmd.CmdPriorState) > 0 {
+		return true
+	}
+
 	if cmd.Entity == "appscalingpolicy" && cmd.Action == "create" {
 		return true
 	}
 
+	if cmd.Entity == "subscription" && cmd.Action == "create" && cmd.CmdResult == "pending confirmation" {
+		return false
+	}
+
 	if v, ok := cmd.CmdResult.(string); ok && v != "" {
-		if cmd.Action == "create" || cmd.Action == "start" || cmd.Action == "stop" || cmd.Action == "copy" {
+		if cmd.Action == "create" || cmd.Action == "start" || cmd.Action == "stop" || cmd.Action == "copy" || cmd.Action == "restore" || cmd.Action == "register" {
 			return true
 		}
 	}
 
 	return cmd.Action == "attach" || cmd.Action == "detach" || cmd.Action == "check" ||
-		(cmd.Action == "create" && cmd.Entity == "tag") || (cmd.Action == "create" && cmd.Entity == "route")
+		(cmd.Action == "create" && cmd.Entity == "tag") || (cmd.Action == "create" && cmd.Entity == "route") ||
+		(cmd.Action == "create" && cmd.Entity == "networkaclrule")
 }
 
 func printItem(i interface{}) string {
@@ -24,6 +24,8 @@ func TestRevertOneliner(t *testing.T) {
 		{in: "detach mfadevice id=my-mfa-device-id user=toto", exp: "attach mfadevice id=my-mfa-device-id user=toto"},
 		{in: "stop database id=my-db-id", exp: "start database id=my-db-id"},
 		{in: "start database id=my-db-id", exp: "stop database id=my-db-id"},
+		{in: "enable key id=my-key-id", exp: "disable key id=my-key-id"},
+		{in: "disable key id=my-key-id", exp: "enable key id=my-key-id"},
 		{in: "create instanceprofile name='my funny name with spaces'", exp: "delete instanceprofile name='my funny name with spaces'"},
 		{in: "create appscalingtarget dimension=dim max-capacity=10 min-capacity=4 resource=['1','2','3'] role=role service-namespace=ecs", exp: "delete appscalingtarget dimension=dim resource=['1','2','3'] service-namespace=ecs"},
 	}
@@ -181,6 +183,38 @@ delete securitygroup id=sg-54321`
 		}
 	})
 
+	t.Run("Delete the copy of an image in another region", func(t *testing.T) {
+		tpl := MustParse("copy image name=backup source-id=ami-87654321 region=eu-west-3")
+		for _, cmd := range tpl.CommandNodesIterator() {
+			cmd.CmdResult = "ami-12345678"
+		}
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := `delete image delete-snapshots=true id=ami-12345678 region=eu-west-3`
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
+	t.Run("Delete the snapshots of the image of an instance", func(t *testing.T) {
+		tpl := MustParse("create image name=redis instance=i-12345\ncreate image name=restored snapshot=snap-12345")
+		for i, cmd := range tpl.CommandNodesIterator() {
+			cmd.CmdResult = []string{"ami-redis", "ami-restored"}[i]
+		}
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := "delete image id=ami-restored\ndelete image delete-snapshots=true id=ami-redis"
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
 	t.Run("Revert detach a volume removes the force param", func(t *testing.T) {
 		tpl := MustParse("detach volume device=/dev/sdh force=true id=vol-12345 instance=i-12345")
 		reverted, err := tpl.Revert()
@@ -222,6 +256,19 @@ attach volume device=/dev/sdh id=vol-12345 instance=i-12345`
 		}
 	})
 
+	t.Run("Revert create networkaclrule", func(t *testing.T) {
+		tpl := MustParse("create networkaclrule action=allow cidr=10.0.0.0/8 networkacl=acl-12345 number=100 outbound=true portrange=443 protocol=tcp")
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := `delete networkaclrule networkacl=acl-12345 number=100 outbound=true`
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
 	t.Run("Revert attach instance", func(t *testing.T) {
 		tpl := MustParse("attach instance id=i-123456 port=80 targetgroup=mytargetgrouparn")
 		reverted, err := tpl.Revert()
@@ -315,12 +362,15 @@ delete scalinggroup force=true name=my-scalinggroup`
 	})
 
 	t.Run("Revert create database", func(t *testing.T) {
-		tpl := MustParse("dbsubgroup = create dbsubnetgroup\ncreate database subnetgroup=$dbsubgroup")
+		tpl := MustParse("dbsubgroup = create dbsubnetgroup\ndbparams = create dbparametergroup\ncreate database subnetgroup=$dbsubgroup parametergroup=$dbparams")
 		for i, cmd := range tpl.CommandNodesIterator() {
 			if i == 0 {
 				cmd.CmdResult = "my-dbsubgroup"
 			}
 			if i == 1 {
+				cmd.CmdResult = "my-dbparams"
+			}
+			if i == 2 {
 				cmd.CmdResult = "my-database"
 			}
 		}
@@ -331,12 +381,174 @@ delete scalinggroup force=true name=my-scalinggroup`
 
 		exp := `delete database id=my-database skip-snapshot=true
 check database id=my-database state=not-found timeout=900
+delete dbparametergroup name=my-dbparams
 delete dbsubnetgroup name=my-dbsubgroup`
 		if got, want := reverted.String(), exp; got != want {
 			t.Fatalf("got: %s\nwant: %s\n", got, want)
 		}
 	})
 
+	t.Run("Revert create table", func(t *testing.T) {
+		tpl := MustParse("create table name=users hash-key=id")
+		for _, cmd := range tpl.CommandNodesIterator() {
+			cmd.CmdResult = "users"
+		}
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := `delete table name=users`
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
+	t.Run("Revert create cachecluster", func(t *testing.T) {
+		tpl := MustParse("cachesubgroup = create cachesubnetgroup\ncreate cachecluster engine=redis id=my-cache type=cache.t2.micro subnetgroup=$cachesubgroup")
+		for i, cmd := range tpl.CommandNodesIterator() {
+			if i == 0 {
+				cmd.CmdResult = "my-cachesubgroup"
+			}
+			if i == 1 {
+				cmd.CmdResult = "my-cache"
+			}
+		}
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := `delete cachecluster id=my-cache
+check cachecluster id=my-cache state=not-found timeout=900
+delete cachesubnetgroup name=my-cachesubgroup`
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
+	t.Run("Revert create cluster", func(t *testing.T) {
+		tpl := MustParse("create cluster id=my-warehouse type=dc2.large nodes=2 username=admin password=secret1234\ncreate dbsubnetgroup name=other")
+		for i, cmd := range tpl.CommandNodesIterator() {
+			if i == 0 {
+				cmd.CmdResult = "my-warehouse"
+			}
+			if i == 1 {
+				cmd.CmdResult = "other"
+			}
+		}
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := `delete dbsubnetgroup name=other
+delete cluster id=my-warehouse skip-snapshot=true`
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
+	t.Run("Revert create kubernetescluster", func(t *testing.T) {
+		tpl := MustParse("create role name=eks-role principal-service=eks.amazonaws.com\ncreate kubernetescluster name=my-k8s role=eks-role subnets=[subnet-1,subnet-2]")
+		for i, cmd := range tpl.CommandNodesIterator() {
+			if i == 0 {
+				cmd.CmdResult = "arn:aws:iam::123456789012:role/eks-role"
+			}
+			if i == 1 {
+				cmd.CmdResult = "my-k8s"
+			}
+		}
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := `check kubernetescluster name=my-k8s state=active timeout=1800
+delete kubernetescluster name=my-k8s
+check kubernetescluster name=my-k8s state=not-found timeout=900
+delete role name=eks-role`
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
+	t.Run("Revert transit gateway hub", func(t *testing.T) {
+		tpl := MustParse("create transitgateway name=hub\ncreate transitgatewayattachment transitgateway=tgw-1 vpc=vpc-1 subnets=subnet-1\ncreate transitgatewayroutetable transitgateway=tgw-1\nattach transitgatewayroutetable id=tgw-rtb-1 attachment=tgw-attach-1")
+		for i, cmd := range tpl.CommandNodesIterator() {
+			switch i {
+			case 0:
+				cmd.CmdResult = "tgw-1"
+			case 1:
+				cmd.CmdResult = "tgw-attach-1"
+			case 2:
+				cmd.CmdResult = "tgw-rtb-1"
+			}
+		}
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := `detach transitgatewayroutetable attachment=tgw-attach-1 id=tgw-rtb-1
+check transitgatewayroutetable id=tgw-rtb-1 state=available timeout=600
+delete transitgatewayroutetable id=tgw-rtb-1
+check transitgatewayattachment id=tgw-attach-1 state=available timeout=600
+delete transitgatewayattachment id=tgw-attach-1
+check transitgatewayattachment id=tgw-attach-1 state=deleted timeout=600
+check transitgateway id=tgw-1 state=available timeout=600
+delete transitgateway id=tgw-1`
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
+	t.Run("Revert create rule with target", func(t *testing.T) {
+		tpl := MustParse("create rule name=nightly schedule='cron(0 2 * * ? *)'\nattach target rule=nightly function=arn:aws:lambda:eu-west-1:0123456789:function:backup")
+		for i, cmd := range tpl.CommandNodesIterator() {
+			if i == 0 {
+				cmd.CmdResult = "nightly"
+			}
+			if i == 1 {
+				cmd.CmdResult = "backup"
+			}
+		}
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := `detach target id=backup rule=nightly
+delete rule name=nightly`
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
+	t.Run("Revert create rest api", func(t *testing.T) {
+		tpl := MustParse(`create restapi name=hello
+create resource restapi=a1b2c3 path=greetings
+create method restapi=a1b2c3 resource=r4s5t6 http-method=GET function=arn:aws:lambda:eu-west-1:0123456789:function:hello
+create deployment restapi=a1b2c3 stage=prod`)
+		results := []string{"a1b2c3", "r4s5t6", "GET", "d7e8f9"}
+		for i, cmd := range tpl.CommandNodesIterator() {
+			cmd.CmdResult = results[i]
+		}
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := `delete stage name=prod restapi=a1b2c3
+delete deployment id=d7e8f9 restapi=a1b2c3
+delete method http-method=GET resource=r4s5t6 restapi=a1b2c3
+delete resource id=r4s5t6 restapi=a1b2c3
+delete restapi id=a1b2c3`
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
 	t.Run("Revert start containertask type=service", func(t *testing.T) {
 		tpl := MustParse("start containertask cluster=cl desired-count=2 name=taskname deployment-name=dpname type=service")
 		reverted, err := tpl.Revert()
@@ -392,38 +604,141 @@ stop containertask cluster=cl deployment-name=dpname type=service`
 			t.Fatalf("got: %s\nwant: %s\n", got, want)
 		}
 	})
+
+	t.Run("Cancel spot requests", func(t *testing.T) {
+		tpl := MustParse("create spotinstance image=ami-1234 subnet=sub-1234 type=t2.nano price=0.01\ncreate spotfleet capacity=4 fleet-role=arn:my:role image=ami-1234 types=[m4.large,c4.large]")
+		results := []string{"sir-1234", "sfr-1234"}
+		for i, cmd := range tpl.CommandNodesIterator() {
+			cmd.CmdResult = results[i]
+		}
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := "cancel spotrequest id=sfr-1234 terminate-instances=true\ncancel spotrequest id=sir-1234 terminate-instances=true"
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
+	t.Run("Delete nat gateways releasing allocated elastic IPs", func(t *testing.T) {
+		tpl := MustParse("create natgateway subnet=sub-1234\ncreate natgateway subnet=sub-2345 elasticip-id=eipalloc-1234\ncreate peeringconnection vpc=vpc-1234 peer-vpc=vpc-2345\naccept peeringconnection id=pcx-1234\ncreate vpcendpoint vpc=vpc-1234 service=com.amazonaws.eu-west-1.s3")
+		results := []string{"nat-1234", "nat-2345", "pcx-1234", "pcx-1234", "vpce-1234"}
+		for i, cmd := range tpl.CommandNodesIterator() {
+			cmd.CmdResult = results[i]
+		}
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := "delete vpcendpoint id=vpce-1234\ndelete peeringconnection id=pcx-1234\ndelete natgateway id=nat-2345\ncheck natgateway id=nat-2345 state=deleted timeout=180\ndelete natgateway id=nat-1234 release-elasticip=true"
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
+	t.Run("Wait for vpn connections deletion before deleting their gateways", func(t *testing.T) {
+		tpl := MustParse("create vpngateway\nattach vpngateway id=vgw-1234 vpc=vpc-1234\ncreate customergateway publicip=203.0.113.12 bgp-asn=65000\ncreate vpnconnection customergateway=cgw-1234 vpngateway=vgw-1234 config-file=./vpn.txt")
+		results := []string{"vgw-1234", "", "cgw-1234", "vpn-1234"}
+		for i, cmd := range tpl.CommandNodesIterator() {
+			cmd.CmdResult = results[i]
+		}
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := "delete vpnconnection id=vpn-1234\ncheck vpnconnection id=vpn-1234 state=deleted timeout=180\ndelete customergateway id=cgw-1234\ndetach vpngateway id=vgw-1234 vpc=vpc-1234\ndelete vpngateway id=vgw-1234"
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
 }
 
 func TestCmdNodeIsRevertible(t *testing.T) {
 	tcases := []struct {
 		line, result string
 		params       map[string]interface{}
+		prior        map[string]interface{}
 		err          error
 		revertible   bool
 	}{
 		{line: "update vpc", result: "any", revertible: false},
+		{line: "update instance", prior: map[string]interface{}{"type": "t2.nano"}, revertible: true},
+		{line: "update instance", prior: map[string]interface{}{"type": "t2.nano"}, err: errors.New("any"), revertible: false},
+		{line: "resize cluster", result: "any", revertible: false},
+		{line: "resize cluster", result: "any", prior: map[string]interface{}{"nodes": 2}, revertible: true},
 		{line: "delete vpc", result: "any", revertible: false},
 		{line: "create vpc", result: "any", err: errors.New("any"), revertible: false},
 		{line: "create vpc", revertible: false},
 		{line: "start instance", revertible: false},
 		{line: "create vpc", result: "any", revertible: true},
+		{line: "create invalidation", result: "any", revertible: false},
+		{line: "create s3object", result: "site/index.html", revertible: true},
+		{line: "create s3object", result: "site/", revertible: false},
+		{line: "download s3object", result: "./report.csv", revertible: false},
+		{line: "create presignedurl", result: "https://my-bucket.s3.amazonaws.com/report.csv?X-Amz-Expires=3600", revertible: false},
+		{line: "wait distribution", revertible: false},
 		{line: "stop instance", result: "any", revertible: true},
 		{line: "attach policy", revertible: true},
 		{line: "detach policy", revertible: true},
 		{line: "create record", revertible: true},
 		{line: "delete record", revertible: true},
 		{line: "copy image", result: "any", revertible: true},
+		{line: "register jobdefinition", revertible: false},
+		{line: "register jobdefinition", result: "arn:aws:batch:us-east-1:0123456789:job-definition/my-def:1", revertible: true},
+		{line: "submit job", result: "any", revertible: false},
+		{line: "create spotinstance", result: "sir-1234", revertible: true},
+		{line: "create spotfleet", result: "sfr-1234", revertible: true},
+		{line: "cancel spotrequest", revertible: false},
+		{line: "create peeringconnection", result: "pcx-1234", revertible: true},
+		{line: "accept peeringconnection", result: "pcx-1234", revertible: false},
+		{line: "create vpnconnection", result: "vpn-1234", revertible: true},
+		{line: "attach vpngateway", revertible: true},
+		{line: "start query", result: "s3://my-bucket/results/a1b2c3d4.csv", revertible: false},
 		{line: "detach routetable", revertible: false},
+		{line: "attach target", result: "my-function", revertible: true},
+		{line: "detach target", revertible: false},
 		{line: "start alarm", revertible: true},
 		{line: "stop alarm", revertible: true},
+		{line: "enable key", revertible: true},
+		{line: "disable key", revertible: true},
 		{line: "start containertask", params: map[string]interface{}{"type": "service"}, revertible: true},
 		{line: "start containertask", params: map[string]interface{}{"type": "task"}, revertible: true},
+		{line: "create subscription", result: "arn:aws:sns:eu-west-1:0123456789:alerts:e3f1", revertible: true},
+		{line: "create subscription", result: "pending confirmation", revertible: false},
+		{line: "create account", result: "111111111111", revertible: false},
+		{line: "move account", revertible: false},
+		{line: "move account", prior: map[string]interface{}{"destination": "r-1234"}, revertible: true},
+		{line: "attach servicecontrolpolicy", revertible: true},
+		{line: "detach servicecontrolpolicy", revertible: true},
+		{line: "attach networkacl", revertible: false},
+		{line: "attach networkacl", prior: map[string]interface{}{"id": "acl-1234"}, revertible: true},
+		{line: "detach networkacl", prior: map[string]interface{}{"id": "acl-1234"}, revertible: true},
+		{line: "create networkaclrule", revertible: true},
+		{line: "attach elasticip", result: "eipassoc-1234", revertible: true},
+		{line: "detach elasticip", revertible: false},
+		{line: "detach elasticip", prior: map[string]interface{}{"id": "eipalloc-1234", "instance": "i-1234"}, revertible: true},
+		{line: "detach networkinterface", revertible: false},
+		{line: "detach networkinterface", prior: map[string]interface{}{"id": "eni-1234", "instance": "i-1234", "device-index": 1}, revertible: true},
+		{line: "update networkinterface", prior: map[string]interface{}{"source-dest-check": true}, revertible: true},
+		{line: "update networkaclrule", prior: map[string]interface{}{"action": "allow"}, revertible: true},
+		{line: "delete networkaclrule", revertible: false},
+		{line: "create placementgroup", result: "my-cluster", revertible: true},
+		{line: "create host", result: "h-1234", revertible: true},
+		{line: "create hostreservation", result: "hr-1234", revertible: false},
+		{line: "reboot instance", result: "any", revertible: false},
+		{line: "get consoleoutput", result: "console.log", revertible: false},
+		{line: "get screenshot", result: "i-1234.jpg", revertible: false},
+		{line: "run command", result: "any", revertible: false},
 	}
 
 	for _, tc := range tcases {
 		splits := strings.SplitN(tc.line, " ", 2)
 		action, entity := splits[0], splits[1]
-		cmd := &ast.CommandNode{Action: action, Entity: entity, CmdResult: tc.result, CmdErr: tc.err}
+		cmd := &ast.CommandNode{Action: action, Entity: entity, CmdResult: tc.result, CmdErr: tc.err, CmdPriorState: tc.prior}
 		if tc.params != nil {
 			cmd.ParamNodes = tc.params
 		}
@@ -432,3 +747,118 @@ func TestCmdNodeIsRevertible(t *testing.T) {
 		}
 	}
 }
+
+func TestRevertUpdateWithPriorState(t *testing.T) {
+	tplExec := &TemplateExecution{}
+	err := tplExec.UnmarshalJSON([]byte(`{"id": "123456", "commands": [
+		{"line": "update securitygroup id=sg-1234 inbound-rules=[tcp:443:0.0.0.0/0]", "prior": {"inbound-rules": ["tcp:22:10.0.0.0/8", "any:any:sg-5678"]}},
+		{"line": "update networkaclrule networkacl=acl-1234 number=100 action=deny protocol=any cidr=0.0.0.0/0", "prior": {"action": "allow", "protocol": "tcp", "cidr": "10.0.0.0/8", "portrange": "22"}},
+		{"line": "attach networkacl id=acl-1234 subnet=subnet-1234", "results": ["aclassoc-1234"], "prior": {"id": "acl-5678"}},
+		{"line": "detach elasticip association=eipassoc-1234", "prior": {"id": "eipalloc-1234", "networkinterface": "eni-1234", "privateip": "10.0.0.42"}},
+		{"line": "update instance id=i-1234 type=t2.large", "prior": {"type": "t2.micro"}},
+		{"line": "update scalinggroup name=my-group max-size=10 min-size=4", "prior": {"max-size": 3, "min-size": 1}},
+		{"line": "update subnet id=sub-1234 public=true"},
+		{"line": "resize cluster id=my-warehouse nodes=4", "prior": {"nodes": 2}},
+		{"line": "update loggroup name=my-logs retention=30", "prior": {"retention": 0}},
+		{"line": "create environment application=my-app name=my-env solution-stack=docker", "results": ["e-1234"]},
+		{"line": "update environment id=e-1234 version=v2", "prior": {"version": "v1"}},
+		{"line": "move account id=111111111111 destination=ou-1234-abcd", "prior": {"destination": "r-1234"}},
+		{"line": "update policy arn=arn:my:policy document-file=./policy.json", "prior": {"default-version": "v1"}},
+		{"line": "create policyversion arn=arn:my:policy document-file=./policy.json default=true", "results": ["v3"], "prior": {"default-version": "v2"}}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	reverted, err := tplExec.Template.Revert()
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := "update policy arn=arn:my:policy default-version=v2\ndelete policyversion arn=arn:my:policy version=v3\nupdate policy arn=arn:my:policy default-version=v1\nmove account destination=r-1234 id=111111111111 source=ou-1234-abcd\nupdate environment id=e-1234 version=v1\nterminate environment id=e-1234\nupdate loggroup name=my-logs retention=0\ncheck cluster id=my-warehouse state=available timeout=3600\nresize cluster id=my-warehouse nodes=2\nupdate scalinggroup max-size=3 min-size=1 name=my-group\nupdate instance id=i-1234 type=t2.micro\nattach elasticip id=eipalloc-1234 networkinterface=eni-1234 privateip=10.0.0.42\nattach networkacl id=acl-5678 subnet=subnet-1234\nupdate networkaclrule action=allow cidr=10.0.0.0/8 networkacl=acl-1234 number=100 portrange=22 protocol=tcp\nupdate securitygroup id=sg-1234 inbound-rules=[tcp:22:10.0.0.0/8,any:any:sg-5678]"
+	if got, want := reverted.String(), exp; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestPartialRevert(t *testing.T) {
+	executed := func() *Template {
+		tpl := MustParse("create vpc cidr=10.0.0.0/16\ncreate subnet vpc=vpc-1234 cidr=10.0.0.0/24\ncreate instance subnet=sub-1234 type=t2.nano\ncreate keypair name=mykey")
+		results := []string{"vpc-1234", "sub-1234", "i-1234", "mykey"}
+		for i, cmd := range tpl.CommandNodesIterator() {
+			cmd.CmdResult = results[i]
+		}
+		return tpl
+	}
+
+	tcases := []struct {
+		sel    *RevertSelection
+		exp    string
+		expErr string
+	}{
+		{sel: &RevertSelection{Entities: []string{"instance"}}, exp: "delete instance id=i-1234"},
+		{sel: &RevertSelection{Entities: []string{"instance", "subnet"}}, exp: "delete instance id=i-1234\ncheck instance id=i-1234 state=terminated timeout=180\ndelete subnet id=sub-1234"},
+		{sel: &RevertSelection{From: 3}, exp: "delete keypair name=mykey\ndelete instance id=i-1234"},
+		{sel: &RevertSelection{From: 2, To: 3}, exp: "delete instance id=i-1234\ncheck instance id=i-1234 state=terminated timeout=180\ndelete subnet id=sub-1234"},
+		{sel: &RevertSelection{Entities: []string{"vpc"}}, expErr: "still used by 'create subnet"},
+		{sel: &RevertSelection{Entities: []string{"subnet"}}, expErr: "still used by 'create instance"},
+		{sel: &RevertSelection{Entities: []string{"subnet"}, From: 3}, expErr: "no revertible command"},
+	}
+
+	for i, tcase := range tcases {
+		reverted, err := executed().PartialRevert(tcase.sel)
+		if tcase.expErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tcase.expErr) {
+				t.Fatalf("%d: expected error containing '%s', got %v", i+1, tcase.expErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if got, want := reverted.String(), tcase.exp; got != want {
+			t.Fatalf("%d: got\n%s\nwant\n%s", i+1, got, want)
+		}
+	}
+
+	t.Run("referenced in list", func(t *testing.T) {
+		tpl := MustParse("create subnet vpc=vpc-1234 cidr=10.0.0.0/24\ncreate loadbalancer name=mylb subnets=[sub-1234,sub-5678]")
+		for i, cmd := range tpl.CommandNodesIterator() {
+			cmd.CmdResult = []string{"sub-1234", "arn:lb"}[i]
+		}
+		_, err := tpl.PartialRevert(&RevertSelection{Entities: []string{"subnet"}})
+		if err == nil || !strings.Contains(err.Error(), "still used by 'create loadbalancer") {
+			t.Fatalf("expected error as subnet used in list, got %v", err)
+		}
+	})
+}
+
+func TestParseRevertRange(t *testing.T) {
+	tcases := []struct {
+		in       string
+		from, to int
+		err      bool
+	}{
+		{in: "", from: 0, to: 0},
+		{in: "3", from: 3, to: 3},
+		{in: "2:5", from: 2, to: 5},
+		{in: "2:", from: 2, to: 0},
+		{in: ":4", from: 0, to: 4},
+		{in: "5:2", err: true},
+		{in: "0", err: true},
+		{in: "a:b", err: true},
+	}
+	for _, tcase := range tcases {
+		from, to, err := ParseRevertRange(tcase.in)
+		if tcase.err {
+			if err == nil {
+				t.Fatalf("%s: expected error", tcase.in)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if from != tcase.from || to != tcase.to {
+			t.Fatalf("%s: got %d:%d, want %d:%d", tcase.in, from, to, tcase.from, tcase.to)
+		}
+	}
+}
@@ -1,11 +1,15 @@
 package template
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
+	"time"
 
+	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/driver"
 	"github.com/wallix/awless/template/env"
 )
 
@@ -15,16 +19,46 @@ type Runner struct {
 	Log                                    *logger.Logger
 	Fillers                                []map[string]interface{}
 	AliasFunc                              func(paramPath, alias string) string
+	AliasQueryFunc                         func(paramPath, query string) (string, error)
 	MissingHolesFunc                       func(string, []string, bool) string
+	AvailabilityZonesFunc                  func() ([]string, error)
+	StackRefFunc                           func(stack, name string) (string, error)
+	LookupGraph                            LookupGraphFunc
 	CmdLookuper                            func(tokens ...string) interface{}
 	Validators                             []Validator
 	ParamsSuggested                        int
+	Parallelism                            int
+	Observer                               env.Observer
+	// Middlewares wrap the commands run, the first one being the outermost (optional)
+	Middlewares []driver.Middleware
+	// Context interrupts the run when cancelled (optional)
+	Context context.Context
+	// AccountCmdLookuper builds the commands of the statements run in another account (optional)
+	AccountCmdLookuper func(account, role, key string) (interface{}, error)
+	// FetchGraph fetches the resources of a type from the cloud, checked by ensure commands (optional)
+	FetchGraph func(resourceType string) (cloud.GraphAPI, error)
+	// Preflight checks the compiled template can run before its dry run, failing the execution otherwise (optional)
+	Preflight func(*Template) error
 
 	BeforeRun func(*TemplateExecution) (bool, error)
 	AfterRun  func(*TemplateExecution) error
 }
 
+// Run executes the template, exiting when some of its commands failed
 func (ru *Runner) Run() error {
+	tplExec, err := ru.Execute()
+	if err != nil {
+		return err
+	}
+	if tplExec.Stats().KOCount > 0 {
+		os.Exit(1)
+	}
+	return nil
+}
+
+// Execute compiles, checks with the preflight if any, dry runs then runs the template when confirmed by BeforeRun,
+// returning its execution
+func (ru *Runner) Execute() (*TemplateExecution, error) {
 	tplExec := &TemplateExecution{
 		Template: ru.Template,
 		Path:     ru.TemplatePath,
@@ -33,15 +67,18 @@ func (ru *Runner) Run() error {
 		Source:   ru.Template.String(),
 	}
 	tplExec.SetMessage(ru.Message)
+	tplExec.Template.setOriginFile(ru.TemplatePath)
 
-	cenv := NewEnv().WithAliasFunc(ru.AliasFunc).WithMissingHolesFunc(ru.MissingHolesFunc).
-		WithLookupCommandFunc(ru.CmdLookuper).WithLog(ru.Log).WithParamsMode(ru.ParamsSuggested).Build()
+	cenv := NewEnv().WithAliasFunc(ru.AliasFunc).WithAliasQueryFunc(ru.AliasQueryFunc).WithMissingHolesFunc(ru.MissingHolesFunc).
+		WithAvailabilityZonesFunc(ru.AvailabilityZonesFunc).WithStackRefFunc(ru.StackRefFunc).WithLookupCommandFunc(ru.CmdLookuper).WithLog(ru.Log).
+		WithLookupGraphFunc(ru.LookupGraph).WithFetchGraphFunc(ru.FetchGraph).WithParallelism(ru.Parallelism).WithObserver(ru.Observer).WithParamsMode(ru.ParamsSuggested).
+		WithMiddlewares(ru.Middlewares...).WithAccountLookupCommandFunc(ru.AccountCmdLookuper).Build()
 	cenv.Push(env.FILLERS, ru.Fillers...)
 
 	var err error
 	tplExec.Template, cenv, err = Compile(tplExec.Template, cenv, NewRunnerCompileMode)
 	if err != nil {
-		return err
+		return tplExec, err
 	}
 
 	tplExec.Fillers = cenv.Get(env.PROCESSED_FILLERS)
@@ -54,6 +91,12 @@ func (ru *Runner) Run() error {
 		fmt.Fprintln(os.Stderr)
 	}
 
+	if ru.Preflight != nil {
+		if err = ru.Preflight(tplExec.Template); err != nil {
+			return tplExec, err
+		}
+	}
+
 	if tplExec.IsOneLiner() {
 		logger.Verbose("Dry running template ...")
 	} else {
@@ -71,27 +114,29 @@ func (ru *Runner) Run() error {
 		default:
 			logger.Error(err)
 		}
-		return errors.New("Dry run failed")
+		return tplExec, errors.New("Dry run failed")
 	}
 
 	ok, err := ru.BeforeRun(tplExec)
 	if err != nil {
-		return err
+		return tplExec, err
 	}
 
 	if ok {
-		tplExec.Template, err = tplExec.Template.Run(renv)
+		start := time.Now()
+		ctx := ru.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		tplExec.Template, err = tplExec.Template.RunWithContext(ctx, renv)
+		tplExec.Duration = time.Since(start)
 		if err != nil {
 			logger.Errorf("Running template error: %s", err)
 		}
 		if err := ru.AfterRun(tplExec); err != nil {
-			return err
+			return tplExec, err
 		}
 	}
 
-	if tplExec.Stats().KOCount > 0 {
-		os.Exit(1)
-	}
-
-	return nil
+	return tplExec, nil
 }
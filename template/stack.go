@@ -0,0 +1,136 @@
+package template
+
+import (
+	"crypto/rand"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/oklog/ulid"
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// Stack groups the executions of the templates run with the same stack name,
+// so that their resources are torn down together, possibly when their TTL expires
+type Stack struct {
+	Name string
+	// Executions are the live executions of the stack, oldest first
+	Executions []*TemplateExecution
+}
+
+// Stacks groups the executions per stack, sorted by name. Executions preceding the last
+// successful teardown of a stack are not live anymore: torn down stacks are omitted
+func Stacks(execs []*TemplateExecution) []*Stack {
+	perName := make(map[string][]*TemplateExecution)
+	for _, exec := range execs {
+		if exec.Stack != "" {
+			perName[exec.Stack] = append(perName[exec.Stack], exec)
+		}
+	}
+	var stacks []*Stack
+	for name, all := range perName {
+		sort.Slice(all, func(i, j int) bool { return all[i].ID < all[j].ID })
+		var live []*TemplateExecution
+		for _, exec := range all {
+			switch {
+			case exec.Teardown && exec.Stats().KOCount == 0:
+				live = nil
+			case !exec.Teardown:
+				live = append(live, exec)
+			}
+		}
+		if len(live) > 0 {
+			stacks = append(stacks, &Stack{Name: name, Executions: live})
+		}
+	}
+	sort.Slice(stacks, func(i, j int) bool { return stacks[i].Name < stacks[j].Name })
+	return stacks
+}
+
+// Locale is the region of the stack, the one of its first execution
+func (s *Stack) Locale() string {
+	return s.Executions[0].Locale
+}
+
+// Profile is the profile of the stack, the one of its first execution
+func (s *Stack) Profile() string {
+	return s.Executions[0].Profile
+}
+
+// ExpiresAt returns the latest expiration of the executions of the stack,
+// being zero if none of them was run with a TTL
+func (s *Stack) ExpiresAt() (expires time.Time) {
+	for _, exec := range s.Executions {
+		if exec.ExpiresAt.After(expires) {
+			expires = exec.ExpiresAt
+		}
+	}
+	return
+}
+
+func (s *Stack) IsExpired(now time.Time) bool {
+	expires := s.ExpiresAt()
+	return !expires.IsZero() && !expires.After(now)
+}
+
+// Teardown returns the template, with a new ID, reverting all the live executions
+// of the stack, the latest being reverted first. The template is empty when none
+// of the commands of the stack are revertible
+func (s *Stack) Teardown() (*Template, error) {
+	id := ulid.MustNew(ulid.Timestamp(time.Now()), rand.Reader).String()
+	all := &Template{AST: &ast.AST{}}
+	execOf := make(map[*ast.CommandNode]*TemplateExecution)
+	for _, exec := range s.Executions {
+		if exec.Template == nil {
+			continue
+		}
+		all.Statements = append(all.Statements, exec.Statements...)
+		for _, cmd := range exec.CommandNodesIterator() {
+			execOf[cmd] = exec
+		}
+	}
+	if !IsRevertible(all) {
+		return &Template{ID: id, AST: &ast.AST{}}, nil
+	}
+	tpl, err := all.revert(func(cmd *ast.CommandNode) *ast.Origin {
+		exec := execOf[cmd]
+		origin := cmd.Origin.Expand(fmt.Sprintf("stack %s", s.Name), fmt.Sprintf("revert of %s", exec.ID))
+		if origin.File == "" {
+			origin.File = exec.Path
+		}
+		return origin
+	})
+	if err != nil {
+		return nil, fmt.Errorf("stack %s: %s", s.Name, err)
+	}
+	tpl.ID = id
+	return tpl, nil
+}
+
+// Output returns the result of the latest successful command of the stack creating
+// an entity with the given name (ex: the id of "create securitygroup name=web")
+func (s *Stack) Output(entity, name string) (string, bool) {
+	for i := len(s.Executions) - 1; i >= 0; i-- {
+		exec := s.Executions[i]
+		if exec.Template == nil {
+			continue
+		}
+		for _, cmd := range exec.CommandNodesReverseIterator() {
+			if cmd.Action != "create" || cmd.Entity != entity || cmd.CmdErr != nil {
+				continue
+			}
+			result, ok := cmd.CmdResult.(string)
+			if !ok || result == "" {
+				continue
+			}
+			var value interface{} = cmd.ParamNodes["name"]
+			if n, ok := value.(ast.InterfaceNode); ok {
+				value = n.Value()
+			}
+			if value == name {
+				return result, true
+			}
+		}
+	}
+	return "", false
+}
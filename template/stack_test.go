@@ -0,0 +1,161 @@
+package template
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestStacks(t *testing.T) {
+	now := time.Date(2017, 10, 1, 12, 0, 0, 0, time.UTC)
+	newExec := func(id, stack, text string, results ...string) *TemplateExecution {
+		tpl := MustParse(text)
+		tpl.ID = id
+		for i, cmd := range tpl.CommandNodesIterator() {
+			if i < len(results) {
+				cmd.CmdResult = results[i]
+			}
+		}
+		return &TemplateExecution{Template: tpl, Stack: stack, Locale: "eu-west-1", Profile: "dev"}
+	}
+
+	vpc := newExec("01BTT1AA3N36VCKSNKAKN4WX2A", "test", "create vpc cidr=10.0.0.0/16", "vpc-1")
+	vpc.ExpiresAt = now.Add(-time.Hour)
+	subnet := newExec("01BTT1AA3N36VCKSNKAKN4WX2B", "test", "create subnet cidr=10.0.0.0/24 vpc=vpc-1", "sub-1")
+	other := newExec("01BTT1AA3N36VCKSNKAKN4WX2C", "other", "create keypair name=mykey", "mykey")
+	unstacked := newExec("01BTT1AA3N36VCKSNKAKN4WX2D", "", "create vpc cidr=10.1.0.0/16", "vpc-2")
+
+	stacks := Stacks([]*TemplateExecution{subnet, other, unstacked, vpc})
+	if got, want := len(stacks), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	test := stacks[1]
+	if got, want := test.Name, "test"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := len(test.Executions), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := test.Locale(), "eu-west-1"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if !test.IsExpired(now) {
+		t.Fatal("expected expired stack")
+	}
+	if stacks[0].IsExpired(now) {
+		t.Fatal("expected stack without TTL not to expire")
+	}
+	subnet.ExpiresAt = now.Add(time.Hour)
+	if test.IsExpired(now) {
+		t.Fatal("expected stack TTL to be extended by its latest execution")
+	}
+
+	subnet.Path = "subnet.aws"
+	teardown, err := test.Teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := teardown.String(), "delete subnet id=sub-1\ndelete vpc id=vpc-1"; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+	var origins []string
+	for _, cmd := range teardown.CommandNodesIterator() {
+		origins = append(origins, cmd.Origin.String())
+	}
+	if got, want := origins, []string{
+		"subnet.aws:1 (stack test > revert of 01BTT1AA3N36VCKSNKAKN4WX2B)",
+		"template:1 (stack test > revert of 01BTT1AA3N36VCKSNKAKN4WX2A)",
+	}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	failedTeardown := newExec("01BTT1AA3N36VCKSNKAKN4WX2E", "test", "delete subnet id=sub-1")
+	failedTeardown.Teardown = true
+	failedTeardown.CommandNodesIterator()[0].CmdErr = errors.New("dependency violation")
+	if stacks = Stacks([]*TemplateExecution{vpc, subnet, failedTeardown}); len(stacks) != 1 || len(stacks[0].Executions) != 2 {
+		t.Fatalf("expected stack still live after failed teardown, got %v", stacks)
+	}
+	teardownDone := newExec("01BTT1AA3N36VCKSNKAKN4WX2F", "test", "delete subnet id=sub-1", "sub-1")
+	teardownDone.Teardown = true
+	if stacks = Stacks([]*TemplateExecution{vpc, subnet, failedTeardown, teardownDone}); len(stacks) != 0 {
+		t.Fatalf("expected torn down stack, got %v", stacks)
+	}
+	recreated := newExec("01BTT1AA3N36VCKSNKAKN4WX2G", "test", "create vpc cidr=10.0.0.0/16", "vpc-3")
+	if stacks = Stacks([]*TemplateExecution{vpc, subnet, teardownDone, recreated}); len(stacks) != 1 || stacks[0].Executions[0] != recreated {
+		t.Fatalf("expected stack recreated after teardown, got %v", stacks)
+	}
+
+	failed := newExec("01BTT1AA3N36VCKSNKAKN4WX2H", "failed", "create vpc cidr=10.0.0.0/16")
+	failed.CommandNodesIterator()[0].CmdErr = errors.New("limit exceeded")
+	if teardown, err = Stacks([]*TemplateExecution{failed})[0].Teardown(); err != nil {
+		t.Fatal(err)
+	}
+	if len(teardown.Statements) != 0 || teardown.ID == "" {
+		t.Fatalf("expected empty teardown with ID, got %#v", teardown)
+	}
+}
+
+func TestStackOutput(t *testing.T) {
+	newExec := func(id, text string, results ...string) *TemplateExecution {
+		tpl := MustParse(text)
+		tpl.ID = id
+		for i, cmd := range tpl.CommandNodesIterator() {
+			if i < len(results) {
+				cmd.CmdResult = results[i]
+			}
+		}
+		return &TemplateExecution{Template: tpl, Stack: "web"}
+	}
+	first := newExec("01BTT1AA3N36VCKSNKAKN4WX2A", "create securitygroup vpc=vpc-1 name=web description=web\ncreate securitygroup vpc=vpc-1 name=db description=db", "sg-1", "sg-2")
+	second := newExec("01BTT1AA3N36VCKSNKAKN4WX2B", "create securitygroup vpc=vpc-1 name=web description=web", "sg-3")
+	failed := newExec("01BTT1AA3N36VCKSNKAKN4WX2C", "create securitygroup vpc=vpc-1 name=db description=db", "sg-4")
+	failed.CommandNodesIterator()[0].CmdErr = errors.New("limit exceeded")
+	stack := Stacks([]*TemplateExecution{first, second, failed})[0]
+
+	tcases := []struct {
+		entity, name string
+		expOK        bool
+		exp          string
+	}{
+		{"securitygroup", "web", true, "sg-3"},
+		{"securitygroup", "db", true, "sg-2"},
+		{"securitygroup", "none", false, ""},
+		{"keypair", "web", false, ""},
+	}
+	for i, tcase := range tcases {
+		got, ok := stack.Output(tcase.entity, tcase.name)
+		if ok != tcase.expOK || got != tcase.exp {
+			t.Fatalf("%d: got %s (%t), want %s (%t)", i+1, got, ok, tcase.exp, tcase.expOK)
+		}
+	}
+}
+
+func TestStackExecutionMarshaling(t *testing.T) {
+	expires := time.Date(2017, 10, 1, 20, 0, 0, 0, time.UTC)
+	tpl := MustParse("create vpc cidr=10.0.0.0/16")
+	tpl.ID = "01BTT1AA3N36VCKSNKAKN4WX2A"
+	exec := &TemplateExecution{Template: tpl, Stack: "test", ExpiresAt: expires, Teardown: true}
+	b, err := exec.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	unmarshaled := &TemplateExecution{}
+	if err = unmarshaled.UnmarshalJSON(b); err != nil {
+		t.Fatal(err)
+	}
+	if unmarshaled.Stack != "test" || !unmarshaled.ExpiresAt.Equal(expires) || !unmarshaled.Teardown {
+		t.Fatalf("unexpected execution %#v", unmarshaled)
+	}
+
+	exec = &TemplateExecution{Template: tpl}
+	if b, err = exec.MarshalJSON(); err != nil {
+		t.Fatal(err)
+	}
+	if err = unmarshaled.UnmarshalJSON(b); err != nil {
+		t.Fatal(err)
+	}
+	if unmarshaled.Stack != "" || !unmarshaled.ExpiresAt.IsZero() || unmarshaled.Teardown {
+		t.Fatalf("unexpected execution %#v", unmarshaled)
+	}
+}
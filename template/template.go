@@ -17,6 +17,7 @@ limitations under the License.
 package template
 
 import (
+	"context"
 	"crypto/rand"
 	"fmt"
 	"strings"
@@ -24,6 +25,7 @@ import (
 
 	"github.com/fatih/color"
 	"github.com/oklog/ulid"
+	"github.com/wallix/awless/template/driver"
 	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/internal/ast"
 )
@@ -56,20 +58,59 @@ func (s *Template) DryRun(renv env.Running) (tpl *Template, err error) {
 	return
 }
 
+// RunWithContext runs the template until ctx is cancelled. The context is given to
+// the drivers through env.Running.Ctx(), and the statements not run because of the
+// cancellation are reported in the returned template with a SkippedError
+func (s *Template) RunWithContext(ctx context.Context, renv env.Running) (*Template, error) {
+	return s.Run(&contextEnv{Running: renv, ctx: ctx})
+}
+
 func (s *Template) Run(renv env.Running) (*Template, error) {
 	vars := map[string]interface{}{}
 
 	current := &Template{AST: &ast.AST{}}
 	current.ID = ulid.MustNew(ulid.Timestamp(time.Now()), rand.Reader).String()
 
-	for _, sts := range s.Statements {
+	ctx := renv.Ctx()
+	stopAt := func(remaining []*ast.Statement) (*Template, error) {
+		if ctx.Err() == nil {
+			return current, nil
+		}
+		for _, sts := range remaining {
+			current.Statements = append(current.Statements, skipStatement(sts, ctx.Err()))
+		}
+		var skipped []string
+		for _, cmd := range current.CommandNodesIterator() {
+			if IsSkipped(cmd.CmdErr) {
+				skipped = append(skipped, cmd.String())
+			}
+		}
+		renv.Log().Warningf("run interrupted (%s), %d statement(s) skipped:\n\t%s", ctx.Err(), len(skipped), strings.Join(skipped, "\n\t"))
+		return current, nil
+	}
+
+	total := len(s.Statements)
+	for i := 0; i < len(s.Statements); i++ {
+		if ctx.Err() != nil {
+			return stopAt(s.Statements[i:])
+		}
+		if groups, count := independentDeletes(s.Statements[i:]); len(groups) > 1 && !renv.IsDryRun() && renv.Parallelism() > 1 {
+			processed, stop := processStatementsConcurrently(renv, s.Statements[i:i+count], groups, vars, i+1, total)
+			current.Statements = append(current.Statements, processed...)
+			if stop {
+				return stopAt(s.Statements[i+count:])
+			}
+			i += count - 1
+			continue
+		}
+		sts := s.Statements[i]
 		clone := sts.Clone()
 		current.Statements = append(current.Statements, clone)
 		switch n := clone.Node.(type) {
 		case *ast.CommandNode:
 			n.ProcessRefs(vars)
-			if stop := processCmdNode(renv, n); stop {
-				return current, nil
+			if stop := processCmdNode(renv, n, i+1, total); stop {
+				return stopAt(s.Statements[i+1:])
 			}
 		case *ast.DeclarationNode:
 			ident := n.Ident
@@ -77,8 +118,8 @@ func (s *Template) Run(renv env.Running) (*Template, error) {
 			switch n := expr.(type) {
 			case *ast.CommandNode:
 				n.ProcessRefs(vars)
-				if stop := processCmdNode(renv, n); stop {
-					return current, nil
+				if stop := processCmdNode(renv, n, i+1, total); stop {
+					return stopAt(s.Statements[i+1:])
 				}
 				vars[ident] = n.Result()
 			default:
@@ -92,12 +133,42 @@ func (s *Template) Run(renv env.Running) (*Template, error) {
 	return current, nil
 }
 
-func processCmdNode(renv env.Running, n *ast.CommandNode) bool {
+func processCmdNode(renv env.Running, n *ast.CommandNode, index, total int) bool {
+	if n.Action == "ensure" {
+		existing, err := findEnsuredResource(renv, n)
+		if err != nil {
+			n.CmdErr = prefixOrigin(prefixError(err, fmt.Sprintf("%s %s", n.Action, n.Entity)), n)
+			if !renv.IsDryRun() {
+				renv.Log().MultiLineError(n.CmdErr)
+			}
+			return true
+		}
+		if existing != "" {
+			n.CmdResult = existing
+			if !renv.IsDryRun() {
+				renv.Log().Infof("%s %s %s (%s) already exists", color.New(color.FgGreen).Sprint("OK"), n.Action, n.Entity, color.New(color.FgCyan).Sprint(existing))
+				st := env.Statement{Index: index, Total: total, Action: n.Action, Entity: n.Entity, Line: n.String(), Origin: originOf(n)}
+				renv.Observer().OnStatementStart(st)
+				st.Result = existing
+				renv.Observer().OnStatementDone(st)
+			}
+			return false
+		}
+		n.Action = "create"
+	}
 	if renv.IsDryRun() {
-		n.CmdResult, n.CmdErr = n.Command.Run(renv, n.ToDriverParams())
-		n.CmdErr = prefixError(n.CmdErr, fmt.Sprintf("dry run: %s %s", n.Action, n.Entity))
+		n.CmdResult, n.CmdErr = driverOf(n).Run(renv, n.ToDriverParams())
+		n.CmdErr = prefixOrigin(prefixError(n.CmdErr, fmt.Sprintf("dry run: %s %s", n.Action, n.Entity)), n)
 	} else {
-		n.CmdResult, n.CmdErr = n.Run(renv, n.ToDriverParams())
+		capturePriorState(renv, n)
+		st := env.Statement{Index: index, Total: total, Action: n.Action, Entity: n.Entity, Line: n.String(), Origin: originOf(n)}
+		renv.Observer().OnStatementStart(st)
+		start := time.Now()
+		n.CmdResult, n.CmdErr = driverOf(n).Run(&statementEnv{Running: renv, statement: st}, n.ToDriverParams())
+		n.CmdDuration = time.Since(start)
+		n.CmdErr = prefixOrigin(n.CmdErr, n)
+		st.Result, st.Err, st.Duration = n.CmdResult, n.CmdErr, n.CmdDuration
+		renv.Observer().OnStatementDone(st)
 		var res, status string
 		if n.CmdResult != nil {
 			res = " (" + color.New(color.FgCyan).Sprint(n.CmdResult) + ") "
@@ -115,6 +186,60 @@ func processCmdNode(renv env.Running, n *ast.CommandNode) bool {
 	return n.CmdErr != nil
 }
 
+// driverOf returns the command of the node wrapped by its middlewares
+func driverOf(n *ast.CommandNode) driver.Driver {
+	if n.Driver != nil {
+		return n.Driver
+	}
+	return driver.Chain(n.Command, driver.DryRun)
+}
+
+// SkippedError is the error of the statements not run because the run was cancelled
+type SkippedError struct {
+	Reason error
+}
+
+func (e *SkippedError) Error() string {
+	return fmt.Sprintf("skipped: %s", e.Reason)
+}
+
+func IsSkipped(err error) bool {
+	_, ok := err.(*SkippedError)
+	return ok
+}
+
+func skipStatement(sts *ast.Statement, reason error) *ast.Statement {
+	clone := sts.Clone()
+	switch n := clone.Node.(type) {
+	case *ast.CommandNode:
+		n.CmdErr = &SkippedError{Reason: reason}
+	case *ast.DeclarationNode:
+		if cmd, ok := n.Expr.(*ast.CommandNode); ok {
+			cmd.CmdErr = &SkippedError{Reason: reason}
+		}
+	}
+	return clone
+}
+
+// PriorStateCapturer is implemented by commands able to fetch, before running,
+// the current values of the params they are about to modify, so that they can be reverted
+type PriorStateCapturer interface {
+	PriorState(env.Running, map[string]interface{}) (map[string]interface{}, error)
+}
+
+func capturePriorState(renv env.Running, n *ast.CommandNode) {
+	capturer, ok := n.Command.(PriorStateCapturer)
+	if !ok {
+		return
+	}
+	state, err := capturer.PriorState(renv, n.ToDriverParams())
+	if err != nil {
+		renv.Log().Warningf("%s %s: cannot capture prior state, it will not be revertible: %s", n.Action, n.Entity, err)
+		return
+	}
+	n.CmdPriorState = state
+}
+
 func prefixError(err error, prefix string) error {
 	if err == nil {
 		return err
@@ -122,6 +247,23 @@ func prefixError(err error, prefix string) error {
 	return fmt.Errorf("%s: %s", prefix, err.Error())
 }
 
+// prefixOrigin prefixes the error of a command with its origin, when it tells where the
+// command comes from, so that a failing generated command is traceable to its source
+func prefixOrigin(err error, n *ast.CommandNode) error {
+	if err == nil || !n.Origin.IsTraceable() || IsSkipped(err) {
+		return err
+	}
+	return prefixError(err, n.Origin.String())
+}
+
+// originOf returns the origin of a command when it tells where the command comes from
+func originOf(n *ast.CommandNode) string {
+	if !n.Origin.IsTraceable() {
+		return ""
+	}
+	return n.Origin.String()
+}
+
 func (s *Template) Validate(rules ...Validator) (all []error) {
 	for _, rule := range rules {
 		errs := rule.Execute(s)
@@ -143,7 +285,7 @@ func (t *Template) HasErrors() bool {
 func (t *Template) UniqueDefinitions(apis map[string]string) (res []string) {
 	unique := make(map[string]struct{})
 	for _, cmd := range t.CommandNodesIterator() {
-		key := fmt.Sprintf("%s%s", cmd.Action, cmd.Entity)
+		key := fmt.Sprintf("%s%s", DefinitionAction(cmd.Action), cmd.Entity)
 		if api, found := apis[key]; found {
 			unique[api] = struct{}{}
 		}
@@ -0,0 +1,71 @@
+package template
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+func TestRunWithContext(t *testing.T) {
+	tpl := "delete snapshot id=snap-1\ndelete snapshot id=snap-2\ndelete snapshot id=snap-3\ndelete snapshot id=snap-4\nvol = create volume size=1"
+	for _, parallelism := range []int{1, 2} {
+		ctx, cancel := context.WithCancel(context.Background())
+		cenv := NewEnv().WithLookupCommandFunc(func(tokens ...string) interface{} {
+			return &mockCancellingCommand{cancelOn: "snap-1", cancel: cancel}
+		}).WithParallelism(parallelism).Build()
+
+		pass := newMultiPass(injectCommandsInNodesPass, resolveParamsAndExtractRefsPass)
+		compiled, cenv, err := pass.compile(MustParse(tpl), cenv)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ran, err := compiled.RunWithContext(ctx, NewRunEnv(cenv))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var statuses []string
+		for _, st := range (&TemplateExecution{Template: ran}).Result().Statements {
+			statuses = append(statuses, st.Status)
+		}
+		expected := []string{SuccessStatus, SkippedStatus, SkippedStatus, SkippedStatus, SkippedStatus}
+		if parallelism > 1 {
+			expected[1] = SuccessStatus // started along with snap-1, before the cancellation
+		}
+		if got, want := statuses, expected; !reflect.DeepEqual(got, want) {
+			t.Fatalf("parallelism %d: got %v, want %v", parallelism, got, want)
+		}
+		cmds := ran.CommandNodesIterator()
+		if got, want := cmds[4].Err().Error(), "skipped: context canceled"; got != want {
+			t.Fatalf("parallelism %d: got %s, want %s", parallelism, got, want)
+		}
+		stats := (&TemplateExecution{Template: ran}).Stats()
+		if got, want := stats.SkippedCount, len(expected)-stats.OKCount; got != want {
+			t.Fatalf("parallelism %d: got %d, want %d", parallelism, got, want)
+		}
+		if got, want := (&TemplateExecution{Template: ran}).Result().Status, InterruptedStatus; got != want {
+			t.Fatalf("parallelism %d: got %s, want %s", parallelism, got, want)
+		}
+	}
+}
+
+type mockCancellingCommand struct {
+	cancelOn string
+	cancel   context.CancelFunc
+}
+
+func (c *mockCancellingCommand) ParamsSpec() params.Spec { return params.NewSpec(nil) }
+func (c *mockCancellingCommand) Run(renv env.Running, p map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return nil, nil
+	}
+	if p["id"] == c.cancelOn {
+		c.cancel()
+	}
+	time.Sleep(20 * time.Millisecond)
+	return p["id"], nil
+}
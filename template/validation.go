@@ -20,7 +20,8 @@ type UniqueNameValidator struct {
 
 func (v *UniqueNameValidator) Execute(t *Template) (errs []error) {
 	for _, cmd := range t.CommandNodesIterator() {
-		if cmd.Action == "create" {
+		// the local graphs are the ones of the account of the session
+		if cmd.Action == "create" && !cmd.IsModifier(AccountModifier) {
 			name := cmd.ParamNodes["name"]
 			g, ok := v.LookupGraph(cmd.Entity)
 			if !ok {